@@ -1,108 +1,262 @@
 package xgb
 
 /*
-auth.go contains functions to facilitate the parsing of .Xauthority files.
+auth.go contains functions to facilitate the parsing of .Xauthority files,
+and to compute the authorization data sent to the X server from them.
 
-It is largely unmodified from the original XGB package that I forked.
+The matching rules follow libXau, and the authorization protocols follow
+libxcb. It was originally taken from the XGB package that I forked.
 */
 
 import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/des"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
+	"net"
 	"os"
+	"sync/atomic"
+	"time"
 )
 
-// readAuthority reads the X authority file for the DISPLAY.
-// If hostname == "" or hostname == "localhost",
-// then use the system's hostname (as returned by os.Hostname) instead.
-func readAuthority(hostname, display string) (
-	name string, data []byte, err error) {
+// As per /usr/include/X11/Xauth.h.
+const (
+	familyInternet  = 0
+	familyInternet6 = 6
+	familyLocal     = 256
+	familyWild      = 65535
+)
 
-	// b is a scratch buffer to use and should be at least 256 bytes long
-	// (i.e. it should be able to hold a hostname).
-	b := make([]byte, 256)
+// The authorization protocols that are supported, best first.
+const (
+	authXdm    = "XDM-AUTHORIZATION-1"
+	authCookie = "MIT-MAGIC-COOKIE-1"
+)
 
-	// As per /usr/include/X11/Xauth.h.
-	const familyLocal = 256
-	const familyWild = 65535
+var authNames = []string{authXdm, authCookie}
 
-	if len(hostname) == 0 || hostname == "localhost" {
-		hostname, err = os.Hostname()
-		if err != nil {
-			return "", nil, err
-		}
+// authEntry is an entry of an X authority file.
+type authEntry struct {
+	family  uint16
+	addr    []byte
+	display string
+	name    string
+	data    []byte
+}
+
+// readAuthority reads the entries of the X authority file 'fname' that can
+// be used to connect to the X server at 'server' with the display number
+// 'display', best first: entries with a better authorization protocol come
+// first, and are otherwise in the order of the file. If 'fname' is empty,
+// the file is $XAUTHORITY, or else $HOME/.Xauthority.
+//
+// The X server's address is matched like libXau does: an X server on this
+// machine is matched by the hostname (as returned by os.Hostname, or as set
+// in $XAUTHLOCALHOSTNAME), and any other by its IPv4 or IPv6 address.
+func readAuthority(fname string, server net.Addr,
+	display string) ([]authEntry, error) {
+
+	family, addr, err := authAddress(server)
+	if err != nil {
+		return nil, err
 	}
+	localName := os.Getenv("XAUTHLOCALHOSTNAME")
 
-	fname := os.Getenv("XAUTHORITY")
+	if len(fname) == 0 {
+		fname = os.Getenv("XAUTHORITY")
+	}
 	if len(fname) == 0 {
 		home := os.Getenv("HOME")
 		if len(home) == 0 {
 			err = errors.New("Xauthority not found: $XAUTHORITY, $HOME not set")
-			return "", nil, err
+			return nil, err
 		}
 		fname = home + "/.Xauthority"
 	}
 
 	r, err := os.Open(fname)
 	if err != nil {
-		return "", nil, err
+		return nil, err
 	}
 	defer r.Close()
 
+	// Matching entries go into one bucket for each supported protocol.
+	buckets := make([][]authEntry, len(authNames))
 	for {
-		var family uint16
-		if err := binary.Read(r, binary.BigEndian, &family); err != nil {
-			return "", nil, err
+		entry, err := readAuthEntry(r)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
 		}
 
-		addr, err := getString(r, b)
-		if err != nil {
-			return "", nil, err
+		addrmatch := (entry.family == familyWild) ||
+			(entry.family == family && bytes.Equal(entry.addr, addr)) ||
+			(entry.family == familyLocal && family == familyLocal &&
+				len(localName) > 0 && string(entry.addr) == localName)
+		dispmatch := (entry.display == "") || (display == "") ||
+			(entry.display == display)
+		if !addrmatch || !dispmatch {
+			continue
 		}
 
-		disp, err := getString(r, b)
-		if err != nil {
-			return "", nil, err
+		for i, name := range authNames {
+			if entry.name == name {
+				buckets[i] = append(buckets[i], entry)
+			}
 		}
+	}
 
-		name0, err := getString(r, b)
-		if err != nil {
-			return "", nil, err
-		}
+	var entries []authEntry
+	for _, bucket := range buckets {
+		entries = append(entries, bucket...)
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no usable entry for display %q in %s",
+			display, fname)
+	}
+	return entries, nil
+}
 
-		data0, err := getBytes(r, b)
-		if err != nil {
-			return "", nil, err
+// authAddress returns the family and address that X authority entries for
+// the X server at 'server' are listed under. Like in libxcb, connections to
+// the loopback address are treated like local connections.
+func authAddress(server net.Addr) (uint16, []byte, error) {
+	if tcp, ok := server.(*net.TCPAddr); ok {
+		if ip4 := tcp.IP.To4(); ip4 != nil {
+			if !ip4.Equal(net.IPv4(127, 0, 0, 1)) {
+				return familyInternet, []byte(ip4), nil
+			}
+		} else if !tcp.IP.IsLoopback() {
+			return familyInternet6, []byte(tcp.IP.To16()), nil
 		}
+	}
 
-		addrmatch := (family == familyWild) ||
-			(family == familyLocal && addr == hostname)
-		dispmatch := (disp == "") || (disp == display)
+	hostname, err := os.Hostname()
+	if err != nil {
+		return 0, nil, err
+	}
+	return familyLocal, []byte(hostname), nil
+}
 
-		if addrmatch && dispmatch {
-			return name0, data0, nil
+// readAuthEntry reads the next entry of an X authority file.
+// io.EOF is only returned at the end of the file, not in an entry.
+func readAuthEntry(r io.Reader) (authEntry, error) {
+	var entry authEntry
+	if err := binary.Read(r, binary.BigEndian, &entry.family); err != nil {
+		return entry, err
+	}
+
+	var err error
+	entry.addr, err = getBytes(r)
+	if err == nil {
+		entry.display, err = getString(r)
+	}
+	if err == nil {
+		entry.name, err = getString(r)
+	}
+	if err == nil {
+		entry.data, err = getBytes(r)
+	}
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return entry, err
+}
+
+// check returns an error if this entry can't be used to connect.
+func (entry authEntry) check() error {
+	switch entry.name {
+	case authCookie:
+		if len(entry.data) != 16 {
+			return errors.New("cookie is not 16 bytes long")
+		}
+	case authXdm:
+		if len(entry.data) != 16 {
+			return errors.New("key is not 16 bytes long")
 		}
+	default:
+		return errors.New("unsupported auth protocol " + entry.name)
+	}
+	return nil
+}
+
+// compute returns the authorization data to send to the X server over
+// 'conn' for this entry.
+func (entry authEntry) compute(conn net.Conn) ([]byte, error) {
+	if err := entry.check(); err != nil {
+		return nil, err
+	}
+	if entry.name == authXdm {
+		return xdmAuth(entry.data, conn.LocalAddr(), time.Now())
+	}
+	return entry.data, nil
+}
+
+// xdmNonce counts the XDM-AUTHORIZATION-1 data computed for connections
+// without an IP address, so that their (fake) addresses differ.
+var xdmNonce uint32
+
+// xdmAuth computes XDM-AUTHORIZATION-1 data at time 'now' for a client at
+// address 'client', given the 16 bytes of an X authority entry: the
+// authorization id followed by a DES key. Like libxcb, it sends the id, the
+// client's IPv4 address and port, and the time, encrypted with the key.
+// A fake address and port are used for clients without an IPv4 address.
+func xdmAuth(data []byte, client net.Addr, now time.Time) ([]byte, error) {
+	buf := make([]byte, 24)
+	copy(buf, data[:8])
+	tcp, ok := client.(*net.TCPAddr)
+	switch {
+	case ok && tcp.IP.To4() != nil:
+		copy(buf[8:], tcp.IP.To4())
+		binary.BigEndian.PutUint16(buf[12:], uint16(tcp.Port))
+	case ok: // IPv6 addresses don't fit, so they're sent as zeros.
+	default:
+		nonce := atomic.AddUint32(&xdmNonce, 1) - 1
+		binary.BigEndian.PutUint32(buf[8:], 0xffffffff-nonce)
+		binary.BigEndian.PutUint16(buf[12:], uint16(os.Getpid()))
+	}
+	binary.BigEndian.PutUint32(buf[14:], uint32(now.Unix()))
+
+	block, err := des.NewCipher(xdmKey(data[8:]))
+	if err != nil {
+		return nil, err
+	}
+	cipher.NewCBCEncrypter(block, make([]byte, 8)).CryptBlocks(buf, buf)
+	return buf, nil
+}
+
+// xdmKey expands the 56 bit key in the last 7 bytes of 'wrapper' to a 64
+// bit DES key, by spreading it over the top 7 bits of each byte. (DES
+// ignores the bottom bit, which is for parity.)
+func xdmKey(wrapper []byte) []byte {
+	key := make([]byte, 8)
+	for i := 0; i < 7; i++ {
+		c := wrapper[i]<<uint(7-i) | wrapper[i+1]>>uint(i+1)
+		key[i] = c << 1
 	}
-	panic("unreachable")
+	key[7] = wrapper[7] << 1
+	return key
 }
 
-func getBytes(r io.Reader, b []byte) ([]byte, error) {
+func getBytes(r io.Reader) ([]byte, error) {
 	var n uint16
 	if err := binary.Read(r, binary.BigEndian, &n); err != nil {
 		return nil, err
-	} else if n > uint16(len(b)) {
-		return nil, errors.New("bytes too long for buffer")
 	}
 
-	if _, err := io.ReadFull(r, b[0:n]); err != nil {
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
 		return nil, err
 	}
-	return b[0:n], nil
+	return b, nil
 }
 
-func getString(r io.Reader, b []byte) (string, error) {
-	b, err := getBytes(r, b)
+func getString(r io.Reader) (string, error) {
+	b, err := getBytes(r)
 	if err != nil {
 		return "", err
 	}
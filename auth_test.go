@@ -0,0 +1,124 @@
+package xgb
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/des"
+	"encoding/binary"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeAuthority writes the entries to a new X authority file, and makes
+// it the one that readAuthority reads.
+func writeAuthority(t *testing.T, entries []authEntry) {
+	var buf bytes.Buffer
+	putBytes := func(b []byte) {
+		binary.Write(&buf, binary.BigEndian, uint16(len(b)))
+		buf.Write(b)
+	}
+	for _, entry := range entries {
+		binary.Write(&buf, binary.BigEndian, entry.family)
+		putBytes(entry.addr)
+		putBytes([]byte(entry.display))
+		putBytes([]byte(entry.name))
+		putBytes(entry.data)
+	}
+
+	fname := filepath.Join(t.TempDir(), "Xauthority")
+	if err := os.WriteFile(fname, buf.Bytes(), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("XAUTHORITY", fname)
+}
+
+func TestReadAuthority(t *testing.T) {
+	server := net.ParseIP("10.0.0.1")
+	entries := []authEntry{
+		{familyLocal, []byte("otherhost"), "0", authCookie, []byte("a")},
+		{familyInternet, server.To4(), "0", authCookie, []byte("b")},
+		{familyInternet, server.To4(), "1", authCookie, []byte("c")},
+		{familyWild, nil, "", authCookie, []byte("d")},
+		{familyInternet, server.To4(), "0", "UNKNOWN-1", []byte("e")},
+		{familyInternet, server.To4(), "0", authXdm, []byte("f")},
+		{familyLocal, []byte("box"), "0", authCookie, []byte("g")},
+	}
+	writeAuthority(t, entries)
+
+	tests := []struct {
+		server    net.Addr
+		localName string
+		expected  string
+	}{
+		{&net.TCPAddr{IP: server, Port: 6000}, "", "fbd"},
+		{&net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 6000}, "", "d"},
+		{&net.TCPAddr{IP: net.ParseIP("::ffff:10.0.0.1")}, "", "fbd"},
+		{&net.UnixAddr{Name: "/tmp/.X11-unix/X0"}, "", "d"},
+		{&net.UnixAddr{Name: "/tmp/.X11-unix/X0"}, "box", "dg"},
+		{&net.TCPAddr{IP: net.ParseIP("127.0.0.1")}, "box", "dg"},
+	}
+	for _, test := range tests {
+		t.Setenv("XAUTHLOCALHOSTNAME", test.localName)
+		auths, err := readAuthority("", test.server, "0")
+		if err != nil {
+			t.Fatalf("%s: %s", test.server, err)
+		}
+		var got []byte
+		for _, auth := range auths {
+			got = append(got, auth.data...)
+		}
+		if string(got) != test.expected {
+			t.Fatalf("%s with XAUTHLOCALHOSTNAME=%q: got entries %q, but "+
+				"expected %q.", test.server, test.localName, got,
+				test.expected)
+		}
+	}
+}
+
+func TestXdmKey(t *testing.T) {
+	ones := []byte{0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	if key := xdmKey(ones); !bytes.Equal(key, bytes.Repeat([]byte{0xfe}, 8)) {
+		t.Fatalf("Expected every bit of the key to be set, but got % x.", key)
+	}
+
+	// The top bit of the 56 bit key is the top bit of the first byte, and
+	// the bottom bit is the second to last bit of the last byte.
+	top := []byte{0, 0x80, 0, 0, 0, 0, 0, 0}
+	bottom := []byte{0, 0, 0, 0, 0, 0, 0, 1}
+	if key := xdmKey(top); key[0] != 0x80 {
+		t.Fatalf("Expected the top bit of the key to be set, got % x.", key)
+	}
+	if key := xdmKey(bottom); key[7] != 0x02 {
+		t.Fatalf("Expected the bottom bit of the key to be set, got % x.",
+			key)
+	}
+}
+
+func TestXdmAuth(t *testing.T) {
+	data := []byte("authid01\x00secret!")
+	client := &net.TCPAddr{IP: net.ParseIP("192.168.1.2"), Port: 40000}
+	now := time.Unix(1234567890, 0)
+	auth, err := xdmAuth(data, client, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block, err := des.NewCipher(xdmKey(data[8:]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plain := make([]byte, len(auth))
+	cipher.NewCBCDecrypter(block, make([]byte, 8)).CryptBlocks(plain, auth)
+
+	expected := []byte("authid01")
+	expected = append(expected, 192, 168, 1, 2, 40000>>8, 40000&0xff)
+	expected = append(expected, 0x49, 0x96, 0x02, 0xd2) // the time
+	expected = append(expected, make([]byte, 6)...)
+	if !bytes.Equal(plain, expected) {
+		t.Fatalf("Decrypted XDM-AUTHORIZATION-1 data is % x, but expected "+
+			"% x.", plain, expected)
+	}
+}
@@ -0,0 +1,119 @@
+package xgb
+
+// Batch collects requests so that they are written to the X server all at
+// once, with a single write. Together with the Append functions of the
+// generated packages (i.e., xproto.AppendMapWindowRequest), which encode a
+// request at the end of a byte slice, it lets a program send many requests
+// without allocating anything but their cookies.
+//
+// Requests are added by appending them to Bytes, and passing the result to
+// Add along with the request's cookie:
+//
+//	b := X.NewBatch()
+//	for _, win := range windows {
+//		b.Add(xproto.AppendMapWindowRequest(b.Bytes(), X, win),
+//			X.NewCookie(false, false))
+//	}
+//	b.Flush()
+//
+// A cookie made with NewCookie can be wrapped in the cookie type of the
+// request to get its reply, i.e., xproto.GetGeometryCookie{Cookie: cookie}.
+//
+// Nothing is sent until Flush is called. Afterwards, the batch is empty, and
+// its memory is used again by the requests added next. A Batch must not be
+// used by more than one goroutine at a time.
+type Batch struct {
+	conn    *Conn
+	buf     []byte
+	ends    []int // where each request in buf ends
+	cookies []*Cookie
+}
+
+// NewBatch returns a new, empty batch of requests to send over this
+// connection.
+func (c *Conn) NewBatch() *Batch {
+	return &Batch{conn: c}
+}
+
+// Bytes returns the requests added to the batch so far. A request is added
+// by appending it to the returned slice, and passing the result to Add.
+func (b *Batch) Bytes() []byte {
+	return b.buf
+}
+
+// Add adds a request to the batch. 'buf' must be the slice returned by
+// Bytes with exactly one request appended to it, and 'cookie' must have
+// been made with NewCookie for it.
+//
+// Like with NewRequest, a request that is too long is sent with the
+// BIG-REQUESTS extension, or else dropped, in which case its cookie reports
+// ErrRequestTooLarge.
+func (b *Batch) Add(buf []byte, cookie *Cookie) {
+	start := len(b.buf)
+	fitted, err := b.conn.fitRequest(buf[start:])
+	if err != nil {
+		b.conn.logger().Printf("Dropping request of %d bytes: %s",
+			len(buf)-start, err)
+		cookie.err = err
+		b.buf = buf[:start]
+		return
+	}
+	if len(fitted) != len(buf)-start {
+		buf = append(buf[:start], fitted...)
+	}
+	b.buf = buf
+	b.ends = append(b.ends, len(buf))
+	b.cookies = append(b.cookies, cookie)
+}
+
+// Len returns the number of requests in the batch.
+func (b *Batch) Len() int {
+	return len(b.cookies)
+}
+
+// Flush sends every request in the batch to the X server, in the order they
+// were added, and empties the batch. It returns once they have been written
+// to the connection.
+//
+// If the connection has been closed, the requests are dropped and their
+// cookies report ErrConnClosed.
+func (b *Batch) Flush() {
+	if len(b.cookies) == 0 {
+		return
+	}
+	req := requestPool.Get().(*request)
+	req.batch = b
+	b.conn.queueRequest(req)
+
+	for i := range b.cookies {
+		b.cookies[i] = nil
+	}
+	b.buf, b.ends, b.cookies = b.buf[:0], b.ends[:0], b.cookies[:0]
+}
+
+// sendBatch sequences each request of 'b', adds its cookie to the cookie
+// queue, and writes them all to the wire.
+func (c *Conn) sendBatch(b *Batch) error {
+	start, written := 0, 0
+	for i, cookie := range b.cookies {
+		// Like in sendRequest, a round trip is forced when the cookie
+		// channel is nearly full. The requests before it go first.
+		if len(c.cookieChan) == cookieBuffer-1 {
+			if start > written {
+				if err := c.writeBuffer(b.buf[written:start]); err != nil {
+					return err
+				}
+				written = start
+			}
+			if err := c.noop(); err != nil {
+				return err
+			}
+		}
+		cookie.Sequence = c.newSequenceId()
+		cookie.major, cookie.minor = b.buf[start], b.buf[start+1]
+		c.cookieChan <- cookie
+		c.traceRequest(cookie.Sequence, b.buf[start:b.ends[i]])
+		start = b.ends[i]
+	}
+	return c.writeBuffer(b.buf[written:])
+}
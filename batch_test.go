@@ -0,0 +1,224 @@
+package xgb_test
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xgbtest"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// TestBatch checks that the requests of a batch are sent in order, that
+// their cookies work, and that a batch can be used again once flushed.
+func TestBatch(t *testing.T) {
+	s := xgbtest.NewServer()
+	defer s.Close()
+	s.Handle(8, 0, xgbtest.Error{Code: xproto.BadWindow}) // MapWindow
+	c, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	b := c.NewBatch()
+	for round := 0; round < 2; round++ {
+		mapped := c.NewCookie(true, false)
+		b.Add(xproto.AppendMapWindowRequest(b.Bytes(), c, 1), mapped)
+		b.Add(xproto.AppendUnmapWindowRequest(b.Bytes(), c, 2),
+			c.NewCookie(false, false))
+		focus := c.NewCookie(true, true)
+		b.Add(xproto.AppendGetInputFocusRequest(b.Bytes(), c), focus)
+		if b.Len() != 3 {
+			t.Fatalf("Batch has %d requests, want 3.", b.Len())
+		}
+		b.Flush()
+		if b.Len() != 0 || len(b.Bytes()) != 0 {
+			t.Fatalf("Batch isn't empty after Flush.")
+		}
+
+		err := xproto.MapWindowCookie{Cookie: mapped}.Check()
+		if err == nil {
+			t.Fatalf("MapWindow should have failed.")
+		}
+		_, err = xproto.GetInputFocusCookie{Cookie: focus}.Reply()
+		if err != nil {
+			t.Fatalf("GetInputFocus: %s", err)
+		}
+	}
+
+	reqs := s.Requests()
+	want := []byte{8, 10, 43, 8, 10, 43}
+	if len(reqs) != len(want) {
+		t.Fatalf("Server received %d requests, want %d.", len(reqs),
+			len(want))
+	}
+	for i, req := range reqs {
+		if req.Opcode != want[i] {
+			t.Errorf("Request %d has opcode %d, want %d.", i, req.Opcode,
+				want[i])
+		}
+	}
+	if xgb.Get32(reqs[4].Bytes[4:]) != 2 {
+		t.Errorf("UnmapWindow was sent for window %d, want 2.",
+			xgb.Get32(reqs[4].Bytes[4:]))
+	}
+}
+
+// TestBatchCookieQueue checks that a batch with more requests than fit in
+// the cookie queue is sent whole, in order.
+func TestBatchCookieQueue(t *testing.T) {
+	s := xgbtest.NewServer()
+	defer s.Close()
+	c, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	const n = 2500
+	b := c.NewBatch()
+	for i := 0; i < n; i++ {
+		b.Add(xproto.AppendMapWindowRequest(b.Bytes(), c, xproto.Window(i)),
+			c.NewCookie(false, false))
+	}
+	b.Flush()
+	if _, err := xproto.GetInputFocus(c).Reply(); err != nil {
+		t.Fatalf("GetInputFocus: %s", err)
+	}
+
+	var windows []uint32
+	for _, req := range s.Requests() {
+		if req.Opcode == 8 {
+			windows = append(windows, xgb.Get32(req.Bytes[4:]))
+		}
+	}
+	if len(windows) != n {
+		t.Fatalf("Server received %d MapWindow requests, want %d.",
+			len(windows), n)
+	}
+	for i, win := range windows {
+		if win != uint32(i) {
+			t.Fatalf("MapWindow request %d is for window %d.", i, win)
+		}
+	}
+}
+
+// TestBatchTooLarge checks that a request too long to be sent is dropped
+// from a batch, and reported by its cookie.
+func TestBatchTooLarge(t *testing.T) {
+	s := xgbtest.NewServer()
+	defer s.Close()
+	c, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	b := c.NewBatch()
+	big := c.NewCookie(true, false)
+	data := make([]byte, 1<<18)
+	b.Add(xproto.AppendPutImageRequest(b.Bytes(), c,
+		xproto.ImageFormatZPixmap, 1, 2, 16, 16, 0, 0, 0, 24, data), big)
+	if b.Len() != 0 || len(b.Bytes()) != 0 {
+		t.Fatalf("Batch kept a request that is too large.")
+	}
+	b.Flush()
+	err = xproto.PutImageCookie{Cookie: big}.Check()
+	if err != xgb.ErrRequestTooLarge {
+		t.Fatalf("PutImage: got %v, want ErrRequestTooLarge.", err)
+	}
+}
+
+// sinkConn returns a connection to a fake X server that discards every
+// request as quickly as it can, so that benchmarks measure XGB only. It only
+// answers GetInputFocus requests, which XGB sends to empty its cookie queue.
+func sinkConn(b *testing.B) *xgb.Conn {
+	client, server := net.Pipe()
+	go func() {
+		defer server.Close()
+		r := bufio.NewReaderSize(server, 1<<16)
+		head := make([]byte, 12)
+		if _, err := io.ReadFull(r, head); err != nil {
+			return
+		}
+		auth := xgb.Pad(int(xgb.Get16(head[6:]))) +
+			xgb.Pad(int(xgb.Get16(head[8:])))
+		if _, err := r.Discard(auth); err != nil {
+			return
+		}
+
+		// The setup information holds only what XGB needs to get started.
+		setup := make([]byte, 40)
+		setup[0] = 1
+		xgb.Put16(setup[2:], 11)
+		xgb.Put16(setup[6:], 8)
+		xgb.Put32(setup[12:], 0x200000) // resource id base
+		xgb.Put32(setup[16:], 0x1fffff) // resource id mask
+		xgb.Put16(setup[26:], 0xffff)   // maximum request length
+		if _, err := server.Write(setup); err != nil {
+			return
+		}
+
+		reply := make([]byte, 32)
+		reply[0] = 1
+		var seq uint16
+		for {
+			if _, err := io.ReadFull(r, head[:4]); err != nil {
+				return
+			}
+			seq++
+			size := int(xgb.Get16(head[2:])) * 4
+			if _, err := r.Discard(size - 4); err != nil {
+				return
+			}
+			if head[0] == 43 { // GetInputFocus
+				xgb.Put16(reply[2:], seq)
+				if _, err := server.Write(reply); err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	c, err := xgb.NewConnNet(client)
+	if err != nil {
+		b.Fatal(err)
+	}
+	return c
+}
+
+// BenchmarkMapWindow sends MapWindow requests one by one.
+func BenchmarkMapWindow(b *testing.B) {
+	c := sinkConn(b)
+	defer c.Close()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		xproto.MapWindow(c, xproto.Window(i))
+	}
+	xproto.GetInputFocus(c).Reply()
+}
+
+// BenchmarkMapWindowBatch sends MapWindow requests in batches of 100, with
+// buffers that are used again for each batch.
+func BenchmarkMapWindowBatch(b *testing.B) {
+	c := sinkConn(b)
+	defer c.Close()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	batch := c.NewBatch()
+	for i := 0; i < b.N; i++ {
+		batch.Add(xproto.AppendMapWindowRequest(batch.Bytes(), c,
+			xproto.Window(i)), c.NewCookie(false, false))
+		if batch.Len() == 100 {
+			batch.Flush()
+		}
+	}
+	batch.Flush()
+	xproto.GetInputFocus(c).Reply()
+}
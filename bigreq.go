@@ -0,0 +1,100 @@
+package xgb
+
+/*
+bigreq.go lets requests be longer than the 16 bit length field in a request
+header allows (i.e., longer than 256KiB) by transparently switching to the
+BIG-REQUESTS extension's extended length encoding.
+
+The request used to enable BIG-REQUESTS is duplicated from bigreq/bigreq.go,
+since this package can't import it.
+*/
+
+import (
+	"errors"
+)
+
+// ErrRequestTooLarge is reported by the cookie of a request that is longer
+// than the X server accepts. Such a request is never sent.
+var ErrRequestTooLarge = errors.New(
+	"xgb: request is longer than the maximum request length of the X server")
+
+// setupMaxRequestLength returns the maximum request length (in 4-byte units)
+// reported by the X server in the connection setup.
+func (c *Conn) setupMaxRequestLength() uint32 {
+	max := uint32(c.order.Get16(c.SetupBytes[26:]))
+	// No real X server says zero, but don't make every request "big" if
+	// one does.
+	if max == 0 {
+		max = 1<<16 - 1
+	}
+	return max
+}
+
+// fitRequest returns 'buf' as it should be sent to the X server. Requests
+// that are too long for the length field in their header are rewritten to
+// use the extended length encoding of BIG-REQUESTS, which is enabled the
+// first time it's needed. If the request can't be sent at all,
+// ErrRequestTooLarge is returned.
+func (c *Conn) fitRequest(buf []byte) ([]byte, error) {
+	length := uint32(len(buf) / 4)
+	if length <= c.setupMaxRequestLength() {
+		return buf, nil
+	}
+
+	// The extended length field is four more bytes.
+	c.bigReqOnce.Do(c.enableBigRequests)
+	if length+1 > c.bigReqMaxLength {
+		return nil, ErrRequestTooLarge
+	}
+
+	// An extended request has a zero length in its header, followed by the
+	// real length as a 32 bit integer.
+	bigBuf := make([]byte, len(buf)+4)
+	copy(bigBuf, buf[:4])
+	c.order.Put16(bigBuf[2:], 0)
+	c.order.Put32(bigBuf[4:], length+1)
+	copy(bigBuf[8:], buf[4:])
+	return bigBuf, nil
+}
+
+// enableBigRequests asks the X server to enable BIG-REQUESTS, and records
+// the new maximum request length in c.bigReqMaxLength. If the server does
+// not support BIG-REQUESTS, the maximum request length is left at zero.
+// (It is safe for a program to also call bigreq.Enable itself.)
+func (c *Conn) enableBigRequests() {
+	opcode, err := c.queryExtension("BIG-REQUESTS")
+	if err != nil {
+		c.logger().Printf("Could not enable BIG-REQUESTS: %s", err)
+		return
+	}
+
+	cookie := c.NewCookie(true, true)
+	c.NewRequest(c.bigReqEnableRequest(opcode), cookie)
+	reply, err := cookie.Reply()
+	if err != nil {
+		c.logger().Printf("Could not enable the BIG-REQUESTS extension: %s",
+			err)
+		return
+	}
+	c.bigReqMaxLength = c.order.Get32(reply[8:])
+}
+
+// bigReqEnableRequest writes the raw bytes of a BIG-REQUESTS Enable request
+// to a buffer, given the major opcode of the extension.
+// It is duplicated from bigreq/bigreq.go.
+func (c *Conn) bigReqEnableRequest(opcode byte) []byte {
+	size := 4
+	b := 0
+	buf := make([]byte, size)
+
+	buf[b] = opcode
+	b += 1
+
+	buf[b] = 0 // request opcode
+	b += 1
+
+	c.order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	return buf
+}
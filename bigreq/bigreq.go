@@ -4,6 +4,8 @@ package bigreq
 // This file is automatically generated from bigreq.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xproto"
@@ -22,18 +24,16 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["BIG-REQUESTS"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["BIG-REQUESTS"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["BIG-REQUESTS"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["BIG-REQUESTS"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["BIG-REQUESTS"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "BIG-REQUESTS", xgb.ExtRequestInfos["BIG-REQUESTS"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["BIG-REQUESTS"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["BIG-REQUESTS"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["BIG-REQUESTS"] = make(map[int]xgb.RequestInfo)
 }
 
 // Skipping definition for base type 'Bool'
@@ -60,6 +60,8 @@ func init() {
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // EnableCookie is a cookie used only for Enable requests.
 type EnableCookie struct {
 	*xgb.Cookie
@@ -108,23 +110,35 @@ func (cook EnableCookie) Reply() (*EnableReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return enableReply(buf), nil
+	return enableReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a Enable request arrives.
+func (cook EnableCookie) ReplyContext(ctx context.Context) (*EnableReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return enableReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// enableReply reads a byte slice into a EnableReply value.
-func enableReply(buf []byte) *EnableReply {
+// enableReply reads a byte slice in the byte order 'order' into a EnableReply value.
+func enableReply(buf []byte, order xgb.ByteOrder) *EnableReply {
 	v := new(EnableReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.MaximumRequestLength = xgb.Get32(buf[b:])
+	v.MaximumRequestLength = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -133,9 +147,18 @@ func enableReply(buf []byte) *EnableReply {
 // Write request to wire for Enable
 // enableRequest writes a Enable request to a byte slice.
 func enableRequest(c *xgb.Conn) []byte {
+	return AppendEnableRequest(nil, c)
+}
+
+// AppendEnableRequest appends a Enable request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendEnableRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["BIG-REQUESTS"]
@@ -145,8 +168,30 @@ func enableRequest(c *xgb.Conn) []byte {
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
+
+// EnableRequest represents the data of a Enable request, as it is read when tracing a connection.
+type EnableRequest struct {
+}
+
+// enableRequestRead reads a byte slice in the byte order 'order' into a EnableRequest value.
+func enableRequestRead(buf []byte, order xgb.ByteOrder) *EnableRequest {
+	v := new(EnableRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["BIG-REQUESTS"][0] = xgb.RequestInfo{
+		Name: "Enable",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return enableRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return enableReply(buf, order)
+		},
+	}
+}
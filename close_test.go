@@ -0,0 +1,98 @@
+package xgb_test
+
+import (
+	"testing"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xgbtest"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// unansweredOpcode is the opcode of a made up request that the fake X
+// server never answers.
+const unansweredOpcode = 201
+
+func unansweredRequest() []byte {
+	buf := make([]byte, 4)
+	buf[0] = unansweredOpcode
+	xgb.Put16(buf[2:], 1)
+	return buf
+}
+
+// checkClosed checks that 'c' is done with 'want' as its error, and that
+// it can't be used any more.
+func checkClosed(t *testing.T, c *xgb.Conn, want error) {
+	<-c.Done()
+	if err := c.Err(); err != want {
+		t.Fatalf("Err: got %v, but expected %v.", err, want)
+	}
+	if ev, xerr := c.WaitForEvent(); ev != nil || xerr != nil {
+		t.Fatalf("WaitForEvent on a closed connection: got %v, %v.", ev, xerr)
+	}
+	if _, err := xproto.GetInputFocus(c).Reply(); err != xgb.ErrConnClosed {
+		t.Fatalf("GetInputFocus on a closed connection: got %v, but "+
+			"expected ErrConnClosed.", err)
+	}
+	if _, err := c.NewId(); err != xgb.ErrConnClosed {
+		t.Fatalf("NewId on a closed connection: got %v, but expected "+
+			"ErrConnClosed.", err)
+	}
+}
+
+// TestClosePending checks that Close still delivers the replies to the
+// requests made before it, and wakes up the cookies that will never get
+// theirs.
+func TestClosePending(t *testing.T) {
+	s := xgbtest.NewServer()
+	defer s.Close()
+	s.Handle(internAtomOpcode, 0, atomReply(42))
+	c, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	atom := xproto.InternAtom(c, false, 4, "TEST")
+	unanswered := c.NewCookie(true, true)
+	c.NewRequest(unansweredRequest(), unanswered)
+	c.Close()
+	c.Close() // closing twice is fine
+
+	r, err := atom.Reply()
+	if err != nil {
+		t.Fatalf("InternAtom made before Close: %s", err)
+	}
+	if r.Atom != 42 {
+		t.Fatalf("InternAtom: got atom %d, but expected 42.", r.Atom)
+	}
+	if _, err := unanswered.Reply(); err != xgb.ErrConnClosed {
+		t.Fatalf("Unanswered request: got %v, but expected ErrConnClosed.",
+			err)
+	}
+	checkClosed(t, c, xgb.ErrConnClosed)
+}
+
+// TestServerClose checks that a connection the X server goes away from is
+// shut down, with the read error as the reason, and that pending cookies
+// are woken up.
+func TestServerClose(t *testing.T) {
+	s := xgbtest.NewServer()
+	c, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unanswered := c.NewCookie(true, true)
+	c.NewRequest(unansweredRequest(), unanswered)
+	s.Close()
+
+	if _, err := unanswered.Reply(); err != xgb.ErrConnClosed {
+		t.Fatalf("Unanswered request: got %v, but expected ErrConnClosed.",
+			err)
+	}
+	<-c.Done()
+	err = c.Err()
+	if err == nil || err == xgb.ErrConnClosed {
+		t.Fatalf("Err: got %v, but expected the read error.", err)
+	}
+	checkClosed(t, c, err)
+}
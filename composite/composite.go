@@ -4,6 +4,8 @@ package composite
 // This file is automatically generated from composite.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xfixes"
@@ -23,25 +25,36 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["Composite"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["Composite"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["Composite"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["Composite"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["Composite"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "Composite", xgb.ExtRequestInfos["Composite"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["Composite"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["Composite"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["Composite"] = make(map[int]xgb.RequestInfo)
 }
 
+type Redirect byte
+
 const (
-	RedirectAutomatic = 0
-	RedirectManual    = 1
+	RedirectAutomatic Redirect = 0
+	RedirectManual    Redirect = 1
 )
 
+// String returns the name of the Redirect item with this value.
+func (v Redirect) String() string {
+	switch v {
+	case RedirectAutomatic:
+		return "Automatic"
+	case RedirectManual:
+		return "Manual"
+	}
+	return xgb.Sprintf("Redirect(%d)", byte(v))
+}
+
 // Skipping definition for base type 'Bool'
 
 // Skipping definition for base type 'Byte'
@@ -66,6 +79,8 @@ const (
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // CreateRegionFromBorderClipCookie is a cookie used only for CreateRegionFromBorderClip requests.
 type CreateRegionFromBorderClipCookie struct {
 	*xgb.Cookie
@@ -103,12 +118,26 @@ func (cook CreateRegionFromBorderClipCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateRegionFromBorderClipCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateRegionFromBorderClip
 // createRegionFromBorderClipRequest writes a CreateRegionFromBorderClip request to a byte slice.
 func createRegionFromBorderClipRequest(c *xgb.Conn, Region xfixes.Region, Window xproto.Window) []byte {
+	return AppendCreateRegionFromBorderClipRequest(nil, c, Region, Window)
+}
+
+// AppendCreateRegionFromBorderClipRequest appends a CreateRegionFromBorderClip request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateRegionFromBorderClipRequest(buf []byte, c *xgb.Conn, Region xfixes.Region, Window xproto.Window) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["Composite"]
@@ -118,18 +147,47 @@ func createRegionFromBorderClipRequest(c *xgb.Conn, Region xfixes.Region, Window
 	buf[b] = 5 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Region))
+	order.Put32(buf[b:], uint32(Region))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// CreateRegionFromBorderClipRequest represents the data of a CreateRegionFromBorderClip request, as it is read when tracing a connection.
+type CreateRegionFromBorderClipRequest struct {
+	Region xfixes.Region
+	Window xproto.Window
+}
+
+// createRegionFromBorderClipRequestRead reads a byte slice in the byte order 'order' into a CreateRegionFromBorderClipRequest value.
+func createRegionFromBorderClipRequestRead(buf []byte, order xgb.ByteOrder) *CreateRegionFromBorderClipRequest {
+	v := new(CreateRegionFromBorderClipRequest)
+	b := 4 // skip request header
+
+	v.Region = xfixes.Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["Composite"][5] = xgb.RequestInfo{
+		Name: "CreateRegionFromBorderClip",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createRegionFromBorderClipRequestRead(buf, order)
+		},
+	}
+}
+
 // GetOverlayWindowCookie is a cookie used only for GetOverlayWindow requests.
 type GetOverlayWindowCookie struct {
 	*xgb.Cookie
@@ -179,23 +237,35 @@ func (cook GetOverlayWindowCookie) Reply() (*GetOverlayWindowReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getOverlayWindowReply(buf), nil
+	return getOverlayWindowReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetOverlayWindow request arrives.
+func (cook GetOverlayWindowCookie) ReplyContext(ctx context.Context) (*GetOverlayWindowReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getOverlayWindowReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getOverlayWindowReply reads a byte slice into a GetOverlayWindowReply value.
-func getOverlayWindowReply(buf []byte) *GetOverlayWindowReply {
+// getOverlayWindowReply reads a byte slice in the byte order 'order' into a GetOverlayWindowReply value.
+func getOverlayWindowReply(buf []byte, order xgb.ByteOrder) *GetOverlayWindowReply {
 	v := new(GetOverlayWindowReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.OverlayWin = xproto.Window(xgb.Get32(buf[b:]))
+	v.OverlayWin = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
 	b += 20 // padding
@@ -206,9 +276,18 @@ func getOverlayWindowReply(buf []byte) *GetOverlayWindowReply {
 // Write request to wire for GetOverlayWindow
 // getOverlayWindowRequest writes a GetOverlayWindow request to a byte slice.
 func getOverlayWindowRequest(c *xgb.Conn, Window xproto.Window) []byte {
+	return AppendGetOverlayWindowRequest(nil, c, Window)
+}
+
+// AppendGetOverlayWindowRequest appends a GetOverlayWindow request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetOverlayWindowRequest(buf []byte, c *xgb.Conn, Window xproto.Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["Composite"]
@@ -218,15 +297,43 @@ func getOverlayWindowRequest(c *xgb.Conn, Window xproto.Window) []byte {
 	buf[b] = 7 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// GetOverlayWindowRequest represents the data of a GetOverlayWindow request, as it is read when tracing a connection.
+type GetOverlayWindowRequest struct {
+	Window xproto.Window
+}
+
+// getOverlayWindowRequestRead reads a byte slice in the byte order 'order' into a GetOverlayWindowRequest value.
+func getOverlayWindowRequestRead(buf []byte, order xgb.ByteOrder) *GetOverlayWindowRequest {
+	v := new(GetOverlayWindowRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["Composite"][7] = xgb.RequestInfo{
+		Name: "GetOverlayWindow",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getOverlayWindowRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getOverlayWindowReply(buf, order)
+		},
+	}
+}
+
 // NameWindowPixmapCookie is a cookie used only for NameWindowPixmap requests.
 type NameWindowPixmapCookie struct {
 	*xgb.Cookie
@@ -264,12 +371,26 @@ func (cook NameWindowPixmapCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook NameWindowPixmapCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for NameWindowPixmap
 // nameWindowPixmapRequest writes a NameWindowPixmap request to a byte slice.
 func nameWindowPixmapRequest(c *xgb.Conn, Window xproto.Window, Pixmap xproto.Pixmap) []byte {
+	return AppendNameWindowPixmapRequest(nil, c, Window, Pixmap)
+}
+
+// AppendNameWindowPixmapRequest appends a NameWindowPixmap request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendNameWindowPixmapRequest(buf []byte, c *xgb.Conn, Window xproto.Window, Pixmap xproto.Pixmap) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["Composite"]
@@ -279,18 +400,47 @@ func nameWindowPixmapRequest(c *xgb.Conn, Window xproto.Window, Pixmap xproto.Pi
 	buf[b] = 6 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Pixmap))
+	order.Put32(buf[b:], uint32(Pixmap))
 	b += 4
 
 	return buf
 }
 
+// NameWindowPixmapRequest represents the data of a NameWindowPixmap request, as it is read when tracing a connection.
+type NameWindowPixmapRequest struct {
+	Window xproto.Window
+	Pixmap xproto.Pixmap
+}
+
+// nameWindowPixmapRequestRead reads a byte slice in the byte order 'order' into a NameWindowPixmapRequest value.
+func nameWindowPixmapRequestRead(buf []byte, order xgb.ByteOrder) *NameWindowPixmapRequest {
+	v := new(NameWindowPixmapRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Pixmap = xproto.Pixmap(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["Composite"][6] = xgb.RequestInfo{
+		Name: "NameWindowPixmap",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return nameWindowPixmapRequestRead(buf, order)
+		},
+	}
+}
+
 // QueryVersionCookie is a cookie used only for QueryVersion requests.
 type QueryVersionCookie struct {
 	*xgb.Cookie
@@ -341,26 +491,38 @@ func (cook QueryVersionCookie) Reply() (*QueryVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryVersionReply(buf), nil
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryVersion request arrives.
+func (cook QueryVersionCookie) ReplyContext(ctx context.Context) (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryVersionReply reads a byte slice into a QueryVersionReply value.
-func queryVersionReply(buf []byte) *QueryVersionReply {
+// queryVersionReply reads a byte slice in the byte order 'order' into a QueryVersionReply value.
+func queryVersionReply(buf []byte, order xgb.ByteOrder) *QueryVersionReply {
 	v := new(QueryVersionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.MajorVersion = xgb.Get32(buf[b:])
+	v.MajorVersion = order.Get32(buf[b:])
 	b += 4
 
-	v.MinorVersion = xgb.Get32(buf[b:])
+	v.MinorVersion = order.Get32(buf[b:])
 	b += 4
 
 	b += 16 // padding
@@ -371,9 +533,18 @@ func queryVersionReply(buf []byte) *QueryVersionReply {
 // Write request to wire for QueryVersion
 // queryVersionRequest writes a QueryVersion request to a byte slice.
 func queryVersionRequest(c *xgb.Conn, ClientMajorVersion uint32, ClientMinorVersion uint32) []byte {
+	return AppendQueryVersionRequest(nil, c, ClientMajorVersion, ClientMinorVersion)
+}
+
+// AppendQueryVersionRequest appends a QueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryVersionRequest(buf []byte, c *xgb.Conn, ClientMajorVersion uint32, ClientMinorVersion uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["Composite"]
@@ -383,18 +554,50 @@ func queryVersionRequest(c *xgb.Conn, ClientMajorVersion uint32, ClientMinorVers
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], ClientMajorVersion)
+	order.Put32(buf[b:], ClientMajorVersion)
 	b += 4
 
-	xgb.Put32(buf[b:], ClientMinorVersion)
+	order.Put32(buf[b:], ClientMinorVersion)
 	b += 4
 
 	return buf
 }
 
+// QueryVersionRequest represents the data of a QueryVersion request, as it is read when tracing a connection.
+type QueryVersionRequest struct {
+	ClientMajorVersion uint32
+	ClientMinorVersion uint32
+}
+
+// queryVersionRequestRead reads a byte slice in the byte order 'order' into a QueryVersionRequest value.
+func queryVersionRequestRead(buf []byte, order xgb.ByteOrder) *QueryVersionRequest {
+	v := new(QueryVersionRequest)
+	b := 4 // skip request header
+
+	v.ClientMajorVersion = order.Get32(buf[b:])
+	b += 4
+
+	v.ClientMinorVersion = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["Composite"][0] = xgb.RequestInfo{
+		Name: "QueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionReply(buf, order)
+		},
+	}
+}
+
 // RedirectSubwindowsCookie is a cookie used only for RedirectSubwindows requests.
 type RedirectSubwindowsCookie struct {
 	*xgb.Cookie
@@ -402,7 +605,7 @@ type RedirectSubwindowsCookie struct {
 
 // RedirectSubwindows sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func RedirectSubwindows(c *xgb.Conn, Window xproto.Window, Update byte) RedirectSubwindowsCookie {
+func RedirectSubwindows(c *xgb.Conn, Window xproto.Window, Update Redirect) RedirectSubwindowsCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["Composite"]; !ok {
@@ -415,7 +618,7 @@ func RedirectSubwindows(c *xgb.Conn, Window xproto.Window, Update byte) Redirect
 
 // RedirectSubwindowsChecked sends a checked request.
 // If an error occurs, it can be retrieved using RedirectSubwindowsCookie.Check()
-func RedirectSubwindowsChecked(c *xgb.Conn, Window xproto.Window, Update byte) RedirectSubwindowsCookie {
+func RedirectSubwindowsChecked(c *xgb.Conn, Window xproto.Window, Update Redirect) RedirectSubwindowsCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["Composite"]; !ok {
@@ -432,12 +635,26 @@ func (cook RedirectSubwindowsCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook RedirectSubwindowsCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for RedirectSubwindows
 // redirectSubwindowsRequest writes a RedirectSubwindows request to a byte slice.
-func redirectSubwindowsRequest(c *xgb.Conn, Window xproto.Window, Update byte) []byte {
+func redirectSubwindowsRequest(c *xgb.Conn, Window xproto.Window, Update Redirect) []byte {
+	return AppendRedirectSubwindowsRequest(nil, c, Window, Update)
+}
+
+// AppendRedirectSubwindowsRequest appends a RedirectSubwindows request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendRedirectSubwindowsRequest(buf []byte, c *xgb.Conn, Window xproto.Window, Update Redirect) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["Composite"]
@@ -447,13 +664,13 @@ func redirectSubwindowsRequest(c *xgb.Conn, Window xproto.Window, Update byte) [
 	buf[b] = 2 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	buf[b] = Update
+	buf[b] = byte(Update)
 	b += 1
 
 	b += 3 // padding
@@ -461,6 +678,38 @@ func redirectSubwindowsRequest(c *xgb.Conn, Window xproto.Window, Update byte) [
 	return buf
 }
 
+// RedirectSubwindowsRequest represents the data of a RedirectSubwindows request, as it is read when tracing a connection.
+type RedirectSubwindowsRequest struct {
+	Window xproto.Window
+	Update Redirect
+	// padding: 3 bytes
+}
+
+// redirectSubwindowsRequestRead reads a byte slice in the byte order 'order' into a RedirectSubwindowsRequest value.
+func redirectSubwindowsRequestRead(buf []byte, order xgb.ByteOrder) *RedirectSubwindowsRequest {
+	v := new(RedirectSubwindowsRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Update = Redirect(buf[b])
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["Composite"][2] = xgb.RequestInfo{
+		Name: "RedirectSubwindows",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return redirectSubwindowsRequestRead(buf, order)
+		},
+	}
+}
+
 // RedirectWindowCookie is a cookie used only for RedirectWindow requests.
 type RedirectWindowCookie struct {
 	*xgb.Cookie
@@ -468,7 +717,7 @@ type RedirectWindowCookie struct {
 
 // RedirectWindow sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func RedirectWindow(c *xgb.Conn, Window xproto.Window, Update byte) RedirectWindowCookie {
+func RedirectWindow(c *xgb.Conn, Window xproto.Window, Update Redirect) RedirectWindowCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["Composite"]; !ok {
@@ -481,7 +730,7 @@ func RedirectWindow(c *xgb.Conn, Window xproto.Window, Update byte) RedirectWind
 
 // RedirectWindowChecked sends a checked request.
 // If an error occurs, it can be retrieved using RedirectWindowCookie.Check()
-func RedirectWindowChecked(c *xgb.Conn, Window xproto.Window, Update byte) RedirectWindowCookie {
+func RedirectWindowChecked(c *xgb.Conn, Window xproto.Window, Update Redirect) RedirectWindowCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["Composite"]; !ok {
@@ -498,12 +747,26 @@ func (cook RedirectWindowCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook RedirectWindowCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for RedirectWindow
 // redirectWindowRequest writes a RedirectWindow request to a byte slice.
-func redirectWindowRequest(c *xgb.Conn, Window xproto.Window, Update byte) []byte {
+func redirectWindowRequest(c *xgb.Conn, Window xproto.Window, Update Redirect) []byte {
+	return AppendRedirectWindowRequest(nil, c, Window, Update)
+}
+
+// AppendRedirectWindowRequest appends a RedirectWindow request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendRedirectWindowRequest(buf []byte, c *xgb.Conn, Window xproto.Window, Update Redirect) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["Composite"]
@@ -513,13 +776,13 @@ func redirectWindowRequest(c *xgb.Conn, Window xproto.Window, Update byte) []byt
 	buf[b] = 1 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	buf[b] = Update
+	buf[b] = byte(Update)
 	b += 1
 
 	b += 3 // padding
@@ -527,6 +790,38 @@ func redirectWindowRequest(c *xgb.Conn, Window xproto.Window, Update byte) []byt
 	return buf
 }
 
+// RedirectWindowRequest represents the data of a RedirectWindow request, as it is read when tracing a connection.
+type RedirectWindowRequest struct {
+	Window xproto.Window
+	Update Redirect
+	// padding: 3 bytes
+}
+
+// redirectWindowRequestRead reads a byte slice in the byte order 'order' into a RedirectWindowRequest value.
+func redirectWindowRequestRead(buf []byte, order xgb.ByteOrder) *RedirectWindowRequest {
+	v := new(RedirectWindowRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Update = Redirect(buf[b])
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["Composite"][1] = xgb.RequestInfo{
+		Name: "RedirectWindow",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return redirectWindowRequestRead(buf, order)
+		},
+	}
+}
+
 // ReleaseOverlayWindowCookie is a cookie used only for ReleaseOverlayWindow requests.
 type ReleaseOverlayWindowCookie struct {
 	*xgb.Cookie
@@ -564,12 +859,26 @@ func (cook ReleaseOverlayWindowCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ReleaseOverlayWindowCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ReleaseOverlayWindow
 // releaseOverlayWindowRequest writes a ReleaseOverlayWindow request to a byte slice.
 func releaseOverlayWindowRequest(c *xgb.Conn, Window xproto.Window) []byte {
+	return AppendReleaseOverlayWindowRequest(nil, c, Window)
+}
+
+// AppendReleaseOverlayWindowRequest appends a ReleaseOverlayWindow request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendReleaseOverlayWindowRequest(buf []byte, c *xgb.Conn, Window xproto.Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["Composite"]
@@ -579,15 +888,40 @@ func releaseOverlayWindowRequest(c *xgb.Conn, Window xproto.Window) []byte {
 	buf[b] = 8 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// ReleaseOverlayWindowRequest represents the data of a ReleaseOverlayWindow request, as it is read when tracing a connection.
+type ReleaseOverlayWindowRequest struct {
+	Window xproto.Window
+}
+
+// releaseOverlayWindowRequestRead reads a byte slice in the byte order 'order' into a ReleaseOverlayWindowRequest value.
+func releaseOverlayWindowRequestRead(buf []byte, order xgb.ByteOrder) *ReleaseOverlayWindowRequest {
+	v := new(ReleaseOverlayWindowRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["Composite"][8] = xgb.RequestInfo{
+		Name: "ReleaseOverlayWindow",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return releaseOverlayWindowRequestRead(buf, order)
+		},
+	}
+}
+
 // UnredirectSubwindowsCookie is a cookie used only for UnredirectSubwindows requests.
 type UnredirectSubwindowsCookie struct {
 	*xgb.Cookie
@@ -595,7 +929,7 @@ type UnredirectSubwindowsCookie struct {
 
 // UnredirectSubwindows sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func UnredirectSubwindows(c *xgb.Conn, Window xproto.Window, Update byte) UnredirectSubwindowsCookie {
+func UnredirectSubwindows(c *xgb.Conn, Window xproto.Window, Update Redirect) UnredirectSubwindowsCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["Composite"]; !ok {
@@ -608,7 +942,7 @@ func UnredirectSubwindows(c *xgb.Conn, Window xproto.Window, Update byte) Unredi
 
 // UnredirectSubwindowsChecked sends a checked request.
 // If an error occurs, it can be retrieved using UnredirectSubwindowsCookie.Check()
-func UnredirectSubwindowsChecked(c *xgb.Conn, Window xproto.Window, Update byte) UnredirectSubwindowsCookie {
+func UnredirectSubwindowsChecked(c *xgb.Conn, Window xproto.Window, Update Redirect) UnredirectSubwindowsCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["Composite"]; !ok {
@@ -625,12 +959,26 @@ func (cook UnredirectSubwindowsCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook UnredirectSubwindowsCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for UnredirectSubwindows
 // unredirectSubwindowsRequest writes a UnredirectSubwindows request to a byte slice.
-func unredirectSubwindowsRequest(c *xgb.Conn, Window xproto.Window, Update byte) []byte {
+func unredirectSubwindowsRequest(c *xgb.Conn, Window xproto.Window, Update Redirect) []byte {
+	return AppendUnredirectSubwindowsRequest(nil, c, Window, Update)
+}
+
+// AppendUnredirectSubwindowsRequest appends a UnredirectSubwindows request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendUnredirectSubwindowsRequest(buf []byte, c *xgb.Conn, Window xproto.Window, Update Redirect) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["Composite"]
@@ -640,13 +988,13 @@ func unredirectSubwindowsRequest(c *xgb.Conn, Window xproto.Window, Update byte)
 	buf[b] = 4 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	buf[b] = Update
+	buf[b] = byte(Update)
 	b += 1
 
 	b += 3 // padding
@@ -654,6 +1002,38 @@ func unredirectSubwindowsRequest(c *xgb.Conn, Window xproto.Window, Update byte)
 	return buf
 }
 
+// UnredirectSubwindowsRequest represents the data of a UnredirectSubwindows request, as it is read when tracing a connection.
+type UnredirectSubwindowsRequest struct {
+	Window xproto.Window
+	Update Redirect
+	// padding: 3 bytes
+}
+
+// unredirectSubwindowsRequestRead reads a byte slice in the byte order 'order' into a UnredirectSubwindowsRequest value.
+func unredirectSubwindowsRequestRead(buf []byte, order xgb.ByteOrder) *UnredirectSubwindowsRequest {
+	v := new(UnredirectSubwindowsRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Update = Redirect(buf[b])
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["Composite"][4] = xgb.RequestInfo{
+		Name: "UnredirectSubwindows",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return unredirectSubwindowsRequestRead(buf, order)
+		},
+	}
+}
+
 // UnredirectWindowCookie is a cookie used only for UnredirectWindow requests.
 type UnredirectWindowCookie struct {
 	*xgb.Cookie
@@ -661,7 +1041,7 @@ type UnredirectWindowCookie struct {
 
 // UnredirectWindow sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func UnredirectWindow(c *xgb.Conn, Window xproto.Window, Update byte) UnredirectWindowCookie {
+func UnredirectWindow(c *xgb.Conn, Window xproto.Window, Update Redirect) UnredirectWindowCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["Composite"]; !ok {
@@ -674,7 +1054,7 @@ func UnredirectWindow(c *xgb.Conn, Window xproto.Window, Update byte) Unredirect
 
 // UnredirectWindowChecked sends a checked request.
 // If an error occurs, it can be retrieved using UnredirectWindowCookie.Check()
-func UnredirectWindowChecked(c *xgb.Conn, Window xproto.Window, Update byte) UnredirectWindowCookie {
+func UnredirectWindowChecked(c *xgb.Conn, Window xproto.Window, Update Redirect) UnredirectWindowCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["Composite"]; !ok {
@@ -691,12 +1071,26 @@ func (cook UnredirectWindowCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook UnredirectWindowCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for UnredirectWindow
 // unredirectWindowRequest writes a UnredirectWindow request to a byte slice.
-func unredirectWindowRequest(c *xgb.Conn, Window xproto.Window, Update byte) []byte {
+func unredirectWindowRequest(c *xgb.Conn, Window xproto.Window, Update Redirect) []byte {
+	return AppendUnredirectWindowRequest(nil, c, Window, Update)
+}
+
+// AppendUnredirectWindowRequest appends a UnredirectWindow request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendUnredirectWindowRequest(buf []byte, c *xgb.Conn, Window xproto.Window, Update Redirect) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["Composite"]
@@ -706,16 +1100,48 @@ func unredirectWindowRequest(c *xgb.Conn, Window xproto.Window, Update byte) []b
 	buf[b] = 3 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	buf[b] = Update
+	buf[b] = byte(Update)
 	b += 1
 
 	b += 3 // padding
 
 	return buf
 }
+
+// UnredirectWindowRequest represents the data of a UnredirectWindow request, as it is read when tracing a connection.
+type UnredirectWindowRequest struct {
+	Window xproto.Window
+	Update Redirect
+	// padding: 3 bytes
+}
+
+// unredirectWindowRequestRead reads a byte slice in the byte order 'order' into a UnredirectWindowRequest value.
+func unredirectWindowRequestRead(buf []byte, order xgb.ByteOrder) *UnredirectWindowRequest {
+	v := new(UnredirectWindowRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Update = Redirect(buf[b])
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["Composite"][3] = xgb.RequestInfo{
+		Name: "UnredirectWindow",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return unredirectWindowRequestRead(buf, order)
+		},
+	}
+}
@@ -8,92 +8,219 @@ This code is largely unmodified from the original XGB package that I forked.
 */
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"net"
 	"os"
+	"runtime"
 	"strconv"
 	"strings"
+	"time"
 )
 
-// connect connects to the X server given in the 'display' string,
+// connect connects to the X server given in the 'opts.Display' string,
 // and does all the necessary setup handshaking.
-// If 'display' is empty it will be taken from os.Getenv("DISPLAY").
+// If 'opts.Display' is empty it will be taken from os.Getenv("DISPLAY").
 // Note that you should read and understand the "Connection Setup" of the
 // X Protocol Reference Manual before changing this function:
 // http://goo.gl/4zGQg
-func (c *Conn) connect(display string) error {
-	err := c.dial(display)
+// If the X server refuses the connection, it is tried again with the next
+// matching entry of the X authority file, if there is one.
+// Connecting is given up on once 'ctx' is done.
+func (c *Conn) connect(ctx context.Context, opts Options) error {
+	auths, err := optionsAuth(opts)
 	if err != nil {
 		return err
 	}
+	err = c.dial(ctx, opts.Display, opts.Dial)
+	if err != nil {
+		return err
+	}
+	if opts.Screen != nil {
+		c.DefaultScreen = *opts.Screen
+	}
 
-	return c.postConnect()
+	if auths == nil {
+		auths = c.authorities(opts.AuthorityFile)
+	}
+	for {
+		stop := watchContext(ctx, c.conn)
+		auths, err = c.postConnect(auths)
+		stop()
+		err = contextErr(ctx, err)
+
+		if _, refused := err.(refusedError); !refused || len(auths) == 0 {
+			if err != nil {
+				c.conn.Close()
+			}
+			return err
+		}
+		c.conn.Close()
+		if err := c.dial(ctx, opts.Display, opts.Dial); err != nil {
+			return err
+		}
+	}
 }
 
-// connect init from to the net.Conn,
-func (c *Conn) connectNet(netConn net.Conn) error {
+// connectNet does the setup handshaking over 'netConn', like connect does
+// once it has dialed the X server. The connection is not tried again if
+// the X server refuses it.
+func (c *Conn) connectNet(ctx context.Context, netConn net.Conn,
+	opts Options) error {
+
+	auths, err := optionsAuth(opts)
+	if err != nil {
+		return err
+	}
 	c.conn = netConn
-	return c.postConnect()
+	if opts.Screen != nil {
+		c.DefaultScreen = *opts.Screen
+	}
+	if auths == nil {
+		auths = c.authorities(opts.AuthorityFile)
+	}
+
+	stop := watchContext(ctx, c.conn)
+	_, err = c.postConnect(auths)
+	stop()
+	return contextErr(ctx, err)
 }
 
-// do the postConnect action after Conn get it's underly net.Conn
-func (c *Conn) postConnect() error {
-	// Get authentication data
-	authName, authData, err := readAuthority(c.host, c.display)
-	noauth := false
+// optionsAuth returns the authorization set in 'opts' as the only entry to
+// try, or nil if there is none. An error is returned if it can't be used.
+func optionsAuth(opts Options) ([]authEntry, error) {
+	if len(opts.AuthName) == 0 {
+		return nil, nil
+	}
+	auth := authEntry{name: opts.AuthName, data: opts.AuthData}
+	if err := auth.check(); err != nil {
+		return nil, Errorf("Cannot use the %s authorization protocol: %s",
+			auth.name, err)
+	}
+	return []authEntry{auth}, nil
+}
+
+// watchContext makes I/O on 'conn' fail once 'ctx' is done, or once its
+// deadline has passed, until the function returned is called.
+func watchContext(ctx context.Context, conn net.Conn) (stop func()) {
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	quit, done := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(done)
+		select {
+		case <-ctx.Done():
+			conn.SetDeadline(time.Unix(1, 0)) // in the past
+		case <-quit:
+		}
+	}()
+	return func() {
+		close(quit)
+		<-done
+		conn.SetDeadline(time.Time{})
+	}
+}
+
+// contextErr returns the error of 'ctx' instead of 'err' if 'err' is
+// likely due to 'ctx' being done. Since the deadline of the connection is
+// set to that of 'ctx', I/O can fail right before 'ctx' knows that its
+// deadline has passed.
+func contextErr(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if deadline, ok := ctx.Deadline(); ok && !time.Now().Before(deadline) {
+		return context.DeadlineExceeded
+	}
+	return err
+}
+
+// refusedError is returned by postConnect when the X server refuses the
+// connection, i.e., because the authorization data is wrong.
+type refusedError string
+
+func (reason refusedError) Error() string {
+	return "x protocol authentication refused: " + string(reason)
+}
+
+// authorities returns the entries of the X authority file 'fname' that
+// match the X server, best first. If 'fname' is empty, the file is found
+// like readAuthority does. If there are no entries, the connection is made
+// without authorization.
+func (c *Conn) authorities(fname string) []authEntry {
+	auths, err := readAuthority(fname, c.conn.RemoteAddr(), c.display)
 	if err != nil {
-		Logger.Printf("Could not get authority info: %v", err)
-		Logger.Println("Trying connection without authority info...")
-		authName = ""
-		authData = []byte{}
-		noauth = true
+		c.logger().Printf("Could not get authority info: %v", err)
+		c.logger().Println("Trying connection without authority info...")
 	}
+	return auths
+}
+
+// postConnect does the setup handshake after Conn gets its underlying
+// net.Conn. The first entry in 'auths' that can be used is sent to the X
+// server, and the entries after it are returned, so that they can be tried
+// next.
+func (c *Conn) postConnect(auths []authEntry) ([]authEntry, error) {
+	// Get authentication data
+	authName, authData := "", []byte{}
+	for len(auths) > 0 {
+		auth := auths[0]
+		auths = auths[1:]
 
-	// Assume that the authentication protocol is "MIT-MAGIC-COOKIE-1".
-	if !noauth && (authName != "MIT-MAGIC-COOKIE-1" || len(authData) != 16) {
-		return errors.New("unsupported auth protocol " + authName)
+		data, err := auth.compute(c.conn)
+		if err != nil {
+			c.logger().Printf("Skipping %s authority info: %v",
+				auth.name, err)
+			continue
+		}
+		authName, authData = auth.name, data
+		break
 	}
 
 	buf := make([]byte, 12+Pad(len(authName))+Pad(len(authData)))
-	buf[0] = 0x6c
+	buf[0] = byte(c.order)
 	buf[1] = 0
-	Put16(buf[2:], 11)
-	Put16(buf[4:], 0)
-	Put16(buf[6:], uint16(len(authName)))
-	Put16(buf[8:], uint16(len(authData)))
-	Put16(buf[10:], 0)
+	c.order.Put16(buf[2:], 11)
+	c.order.Put16(buf[4:], 0)
+	c.order.Put16(buf[6:], uint16(len(authName)))
+	c.order.Put16(buf[8:], uint16(len(authData)))
+	c.order.Put16(buf[10:], 0)
 	copy(buf[12:], []byte(authName))
 	copy(buf[12+Pad(len(authName)):], authData)
-	if _, err = c.conn.Write(buf); err != nil {
-		return err
+	if _, err := c.conn.Write(buf); err != nil {
+		return auths, err
 	}
 
 	head := make([]byte, 8)
-	if _, err = io.ReadFull(c.conn, head[0:8]); err != nil {
-		return err
+	if _, err := io.ReadFull(c.conn, head[0:8]); err != nil {
+		return auths, err
 	}
 	code := head[0]
 	reasonLen := head[1]
-	major := Get16(head[2:])
-	minor := Get16(head[4:])
-	dataLen := Get16(head[6:])
+	major := c.order.Get16(head[2:])
+	minor := c.order.Get16(head[4:])
+	dataLen := c.order.Get16(head[6:])
 
 	if major != 11 || minor != 0 {
-		return fmt.Errorf("x protocol version mismatch: %d.%d", major, minor)
+		return auths, fmt.Errorf("x protocol version mismatch: %d.%d",
+			major, minor)
 	}
 
 	buf = make([]byte, int(dataLen)*4+8, int(dataLen)*4+8)
 	copy(buf, head)
-	if _, err = io.ReadFull(c.conn, buf[8:]); err != nil {
-		return err
+	if _, err := io.ReadFull(c.conn, buf[8:]); err != nil {
+		return auths, err
 	}
 
 	if code == 0 {
 		reason := buf[8 : 8+reasonLen]
-		return fmt.Errorf("x protocol authentication refused: %s",
-			string(reason))
+		return auths, refusedError(reason)
 	}
 
 	// Unfortunately, it isn't really feasible to read the setup bytes here,
@@ -102,14 +229,23 @@ func (c *Conn) postConnect() error {
 	c.SetupBytes = buf
 
 	// But also read stuff that we *need* to get started.
-	c.setupResourceIdBase = Get32(buf[12:])
-	c.setupResourceIdMask = Get32(buf[16:])
+	c.setupResourceIdBase = c.order.Get32(buf[12:])
+	c.setupResourceIdMask = c.order.Get32(buf[16:])
 
-	return nil
+	return auths, nil
 }
 
-// dial initializes the actual net connection with X.
-func (c *Conn) dial(display string) error {
+// dial initializes the actual net connection with X, using 'dialFn' if it
+// isn't nil.
+func (c *Conn) dial(ctx context.Context, display string,
+	dialFn func(ctx context.Context, network, address string) (net.Conn,
+		error)) error {
+
+	if dialFn == nil {
+		var d net.Dialer
+		dialFn = d.DialContext
+	}
+
 	if len(display) == 0 {
 		display = os.Getenv("DISPLAY")
 	}
@@ -119,6 +255,14 @@ func (c *Conn) dial(display string) error {
 		return errors.New("empty display string")
 	}
 
+	if display[0] == '/' {
+		if path, scr, ok := socketPath(display); ok {
+			c.host, c.display = "", "0"
+			c.DisplayNumber, c.DefaultScreen = 0, scr
+			return c.dialAny(ctx, dialFn, display0, "unix", []string{path})
+		}
+	}
+
 	colonIdx := strings.LastIndex(display, ":")
 	if colonIdx < 0 {
 		return errors.New("bad display string: " + display0)
@@ -167,20 +311,66 @@ func (c *Conn) dial(display string) error {
 
 	// Connect to server
 	if len(socket) != 0 {
-		c.conn, err = net.Dial("unix", socket+":"+c.display)
-	} else if len(c.host) != 0 && c.host != "unix" {
+		return c.dialAny(ctx, dialFn, display0, "unix",
+			[]string{socket + ":" + c.display})
+	} else if len(c.host) != 0 && c.host != "unix" && protocol != "unix" {
 		if protocol == "" {
 			protocol = "tcp"
 		}
-		c.conn, err = net.Dial(protocol,
-			c.host+":"+strconv.Itoa(6000+c.DisplayNumber))
-	} else {
-		c.host = ""
-		c.conn, err = net.Dial("unix", "/tmp/.X11-unix/X"+c.display)
+		return c.dialAny(ctx, dialFn, display0, protocol,
+			[]string{c.host + ":" + strconv.Itoa(6000+c.DisplayNumber)})
 	}
 
-	if err != nil {
-		return errors.New("cannot connect to " + display0 + ": " + err.Error())
+	// Like libxcb, the socket in the abstract namespace is tried first on
+	// Linux, since some sandboxes only let that one through.
+	c.host = ""
+	path := "/tmp/.X11-unix/X" + c.display
+	addrs := []string{path}
+	if runtime.GOOS == "linux" {
+		addrs = []string{"@" + path, path}
 	}
-	return nil
+	return c.dialAny(ctx, dialFn, display0, "unix", addrs)
+}
+
+// dialAny connects to the first of 'addrs' on 'network' that can be
+// connected to. If none can, the error of the last one is returned.
+func (c *Conn) dialAny(ctx context.Context,
+	dialFn func(ctx context.Context, network, address string) (net.Conn,
+		error), display, network string, addrs []string) error {
+
+	var err error
+	for _, addr := range addrs {
+		c.conn, err = dialFn(ctx, network, addr)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return errors.New("cannot connect to " + display + ": " + err.Error())
+}
+
+// socketPath returns the path of the Unix domain socket if 'display' is the
+// path to one, like libxcb accepts, and the screen number that may follow
+// it as in "/path/to/socket.1".
+func socketPath(display string) (path string, screen int, ok bool) {
+	if isSocket(display) {
+		return display, 0, true
+	}
+	dotIdx := strings.LastIndex(display, ".")
+	if dotIdx < 0 {
+		return "", 0, false
+	}
+	screen, err := strconv.Atoi(display[dotIdx+1:])
+	if err != nil || screen < 0 || !isSocket(display[:dotIdx]) {
+		return "", 0, false
+	}
+	return display[:dotIdx], screen, true
+}
+
+// isSocket returns whether there is a Unix domain socket at 'path'.
+func isSocket(path string) bool {
+	fi, err := os.Stat(path)
+	return err == nil && fi.Mode()&os.ModeSocket != 0
 }
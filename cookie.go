@@ -1,7 +1,9 @@
 package xgb
 
 import (
+	"context"
 	"errors"
+	"sync/atomic"
 )
 
 // Cookie is the internal representation of a cookie, where one is generated
@@ -14,8 +16,40 @@ type Cookie struct {
 	replyChan chan []byte
 	errorChan chan error
 	pingChan  chan bool
+
+	// major and minor are the opcodes of the request, so that its reply
+	// can be traced. (See Conn.Trace.)
+	major, minor byte
+
+	// err is set if the request was never sent. It is reported instead of
+	// waiting for a response that will never come.
+	err error
+
+	// last is set for requests that are answered by a stream of replies.
+	// It reports whether a reply is the last one in the stream.
+	last func(reply []byte) bool
+
+	// fds points to the file descriptors received along with the reply, if
+	// any. They are set by readResponses before the reply is sent on
+	// replyChan. (It's a pointer since cookies are copied around by value.)
+	fds *[]int
+
+	// state is one of the cookie states below, and is only accessed
+	// atomically. A caller that gives up waiting on this cookie, e.g.,
+	// because a context was canceled, abandons it, so that readResponses
+	// discards its late response instead of delivering it. Once a response
+	// is being delivered, the cookie can no longer be abandoned.
+	state int32
 }
 
+// The states of a cookie. Cookies made with NewMultiCookie are never
+// delivered, since they get more than one response.
+const (
+	cookieWaiting int32 = iota
+	cookieAbandoned
+	cookieDelivered
+)
+
 // NewCookie creates a new cookie with the correct channels initialized
 // depending upon the values of 'checked' and 'reply'. Together, there are
 // four different kinds of cookies. (See more detailed comments in the
@@ -55,6 +89,7 @@ func (c *Conn) NewCookie(checked, reply bool) *Cookie {
 	}
 	if reply {
 		cookie.replyChan = make(chan []byte, 1)
+		cookie.fds = new([]int)
 		if !checked {
 			cookie.pingChan = make(chan bool, 1)
 		}
@@ -63,12 +98,64 @@ func (c *Conn) NewCookie(checked, reply bool) *Cookie {
 	return cookie
 }
 
+// NewMultiCookie creates a new cookie for a request that the X server
+// answers with a stream of replies, all with the request's sequence number,
+// e.g., RECORD's EnableContext. 'last' must report whether a reply is the
+// last one of the stream. Each call to Reply returns the next reply, and
+// once the last reply has been returned, Reply returns ErrNoMoreReplies.
+// An error ends the stream just like the last reply does.
+//
+// Replies are buffered, but not without limit: the connection stops reading
+// from the X server while the buffer is full. So the replies must be read
+// until ErrNoMoreReplies, or the cookie must be abandoned by canceling the
+// context given to ReplyContext.
+//
+// Unless you're building requests from bytes by hand, this method should
+// not be used.
+func (c *Conn) NewMultiCookie(checked bool,
+	last func(reply []byte) bool) *Cookie {
+
+	cookie := &Cookie{
+		conn:      c,
+		replyChan: make(chan []byte, multiReplyBuffer),
+		last:      last,
+	}
+	if checked {
+		cookie.errorChan = make(chan error, 1)
+	}
+	return cookie
+}
+
+// Fds returns the file descriptors that the X server sent along with the
+// reply to this cookie's request. It is only meaningful after Reply has
+// returned. The caller owns the file descriptors, and must close them.
+//
+// Unless you're building requests from bytes by hand, this method should
+// not be used.
+func (c *Cookie) Fds() []int {
+	if c.fds == nil {
+		return nil
+	}
+	return *c.fds
+}
+
+// ByteOrder returns the byte order of the connection that this cookie's
+// request was sent on, which is the one its reply is in.
+//
+// Unless you're building requests from bytes by hand, this method should
+// not be used.
+func (c *Cookie) ByteOrder() ByteOrder {
+	return c.conn.order
+}
+
 // Reply detects whether this is a checked or unchecked cookie, and calls
 // 'replyChecked' or 'replyUnchecked' appropriately.
+// If the connection is closed before a response arrives, ErrConnClosed is
+// returned.
 //
 // Unless you're building requests from bytes by hand, this method should
 // not be used.
-func (c Cookie) Reply() ([]byte, error) {
+func (c *Cookie) Reply() ([]byte, error) {
 	// checked
 	if c.errorChan != nil {
 		return c.replyChecked()
@@ -83,7 +170,7 @@ func (c Cookie) Reply() ([]byte, error) {
 //
 // Unless you're building requests from bytes by hand, this method should
 // not be used.
-func (c Cookie) replyChecked() ([]byte, error) {
+func (c *Cookie) replyChecked() ([]byte, error) {
 	if c.replyChan == nil {
 		return nil, errors.New("Cannot call 'replyChecked' on a cookie that " +
 			"is not expecting a *reply* or an error.")
@@ -93,12 +180,7 @@ func (c Cookie) replyChecked() ([]byte, error) {
 			"is not expecting a reply or an *error*.")
 	}
 
-	select {
-	case reply := <-c.replyChan:
-		return reply, nil
-	case err := <-c.errorChan:
-		return nil, err
-	}
+	return c.wait(nil)
 }
 
 // replyUnchecked waits for a response on either the replyChan or pingChan
@@ -110,18 +192,13 @@ func (c Cookie) replyChecked() ([]byte, error) {
 //
 // Unless you're building requests from bytes by hand, this method should
 // not be used.
-func (c Cookie) replyUnchecked() ([]byte, error) {
+func (c *Cookie) replyUnchecked() ([]byte, error) {
 	if c.replyChan == nil {
 		return nil, errors.New("Cannot call 'replyUnchecked' on a cookie " +
 			"that is not expecting a *reply*.")
 	}
 
-	select {
-	case reply := <-c.replyChan:
-		return reply, nil
-	case <-c.pingChan:
-		return nil, nil
-	}
+	return c.wait(nil)
 }
 
 // Check is used for checked requests that have no replies. It is a mechanism
@@ -132,10 +209,12 @@ func (c Cookie) replyUnchecked() ([]byte, error) {
 // Thus, pingChan is sent a value when the *next* reply is read.
 // If no more replies are being processed, we force a round trip request with
 // GetInputFocus.
+// If the connection is closed before a response arrives, ErrConnClosed is
+// returned.
 //
 // Unless you're building requests from bytes by hand, this method should
 // not be used.
-func (c Cookie) Check() error {
+func (c *Cookie) Check() error {
 	if c.replyChan != nil {
 		return errors.New("Cannot call 'Check' on a cookie that is " +
 			"expecting a *reply*. Use 'Reply' instead.")
@@ -156,10 +235,155 @@ func (c Cookie) Check() error {
 
 	// Now force a round trip and try again, but block this time.
 	c.conn.Sync()
+	_, err := c.wait(nil)
+	return err
+}
+
+// ReplyContext is just like Reply, but returns ctx.Err() if ctx is done
+// before a reply or an error arrives. In that case, the cookie is abandoned
+// and its reply is discarded if it ever shows up, closing any file
+// descriptors that came with it. Errors for an abandoned cookie are sent to
+// the event queue, as if the request were unchecked.
+//
+// Unless you're building requests from bytes by hand, this method should
+// not be used.
+func (c *Cookie) ReplyContext(ctx context.Context) ([]byte, error) {
+	if c.replyChan == nil {
+		return nil, errors.New("Cannot call 'ReplyContext' on a cookie " +
+			"that is not expecting a *reply*.")
+	}
+
+	reply, err := c.wait(ctx.Done())
+	if err == errCanceled {
+		if c.abandon() {
+			return nil, ctx.Err()
+		}
+		// The response is on its way, so take it instead of leaving it
+		// (and its file descriptors) behind.
+		return c.wait(nil)
+	}
+	return reply, err
+}
+
+// CheckContext is just like Check, but returns ctx.Err() if ctx is done
+// before the request is known to have succeeded or failed. In that case,
+// the cookie is abandoned and any error it produces is sent to the event
+// queue instead.
+//
+// Unless you're building requests from bytes by hand, this method should
+// not be used.
+func (c *Cookie) CheckContext(ctx context.Context) error {
+	if c.replyChan != nil {
+		return errors.New("Cannot call 'CheckContext' on a cookie that is " +
+			"expecting a *reply*. Use 'ReplyContext' instead.")
+	}
+	if c.errorChan == nil {
+		return errors.New("Cannot call 'CheckContext' on a cookie that is " +
+			"not expecting a possible *error*.")
+	}
+
+	// First do a quick non-blocking check to see if we've been pinged.
 	select {
 	case err := <-c.errorChan:
 		return err
 	case <-c.pingChan:
 		return nil
+	default:
 	}
+
+	// Now force a round trip, but don't wait on its reply. Our ping will
+	// arrive as soon as the reply to the round trip is read.
+	sync := c.conn.NewCookie(true, true)
+	c.conn.NewRequest(c.conn.getInputFocusRequest(), sync)
+	sync.abandon()
+
+	_, err := c.wait(ctx.Done())
+	if err == errCanceled {
+		if c.abandon() {
+			return ctx.Err()
+		}
+		_, err = c.wait(nil)
+	}
+	return err
+}
+
+// errCanceled is returned by wait when its cancel channel is closed.
+var errCanceled = errors.New("cookie wait canceled")
+
+// wait blocks until this cookie receives a reply, an error or a ping, until
+// the connection is closed, or until 'cancel' is closed. (A nil cancel
+// channel is never closed.) A ping results in a nil reply and a nil error.
+// Channels that this kind of cookie doesn't have are nil, and receiving from
+// a nil channel blocks forever, so they are simply never selected.
+// The error that ends the replies of a multi-reply cookie must come after
+// the replies before it, so it is only looked at once the reply channel is
+// closed (see noMoreReplies).
+func (c *Cookie) wait(cancel <-chan struct{}) ([]byte, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	errorChan := c.errorChan
+	if c.last != nil {
+		errorChan = nil
+	}
+
+	select {
+	case reply, ok := <-c.replyChan:
+		if !ok {
+			return c.noMoreReplies()
+		}
+		return reply, nil
+	case err := <-errorChan:
+		return nil, err
+	case <-c.pingChan:
+		return nil, nil
+	case <-cancel:
+		return nil, errCanceled
+	case <-c.conn.done:
+	}
+
+	// The connection is gone, but a response might have been delivered
+	// right before it went away. Prefer that over reporting an error.
+	select {
+	case reply, ok := <-c.replyChan:
+		if !ok {
+			return c.noMoreReplies()
+		}
+		return reply, nil
+	case err := <-errorChan:
+		return nil, err
+	case <-c.pingChan:
+		return nil, nil
+	default:
+		return nil, ErrConnClosed
+	}
+}
+
+// noMoreReplies is called when the reply channel of a multi-reply cookie
+// has been closed. If the stream was ended by an error that hasn't been
+// reported yet, that error is returned. Otherwise, ErrNoMoreReplies is.
+func (c *Cookie) noMoreReplies() ([]byte, error) {
+	select {
+	case err := <-c.errorChan:
+		return nil, err
+	default:
+		return nil, ErrNoMoreReplies
+	}
+}
+
+// abandon marks the cookie as no longer being waited on. It reports false
+// if that's too late, because its response is already being delivered.
+func (c *Cookie) abandon() bool {
+	return atomic.CompareAndSwapInt32(&c.state, cookieWaiting, cookieAbandoned)
+}
+
+// deliver marks the cookie as getting its response. It reports false if
+// the cookie has been abandoned, in which case the response must be dropped.
+func (c *Cookie) deliver() bool {
+	return atomic.CompareAndSwapInt32(&c.state, cookieWaiting, cookieDelivered)
+}
+
+// isAbandoned returns whether anyone is still waiting on this cookie.
+func (c *Cookie) isAbandoned() bool {
+	return atomic.LoadInt32(&c.state) == cookieAbandoned
 }
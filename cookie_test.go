@@ -0,0 +1,161 @@
+package xgb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BurntSushi/xgb/xgbtest"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// Core protocol opcodes used in these tests.
+const (
+	mapWindowOpcode     = 8
+	internAtomOpcode    = 16
+	getInputFocusOpcode = 43
+)
+
+// atomReply is the reply to InternAtom with the atom 'atom'.
+func atomReply(atom byte) xgbtest.Reply {
+	reply := make(xgbtest.Reply, 12)
+	reply[8] = atom
+	return reply
+}
+
+// canceled returns a context that is already done.
+func canceled() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
+
+// TestReplyContextCanceled checks that a canceled ReplyContext returns
+// right away, and that the reply which shows up later is dropped without
+// disturbing the replies to the requests after it.
+func TestReplyContextCanceled(t *testing.T) {
+	s := xgbtest.NewServer()
+	defer s.Close()
+	release := make(chan struct{})
+	s.HandleFunc(internAtomOpcode, 0,
+		func(*xgbtest.Request) []xgbtest.Response {
+			<-release
+			return []xgbtest.Response{atomReply(42)}
+		})
+	c, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	cookie := xproto.InternAtom(c, false, 4, "TEST")
+	if _, err := cookie.ReplyContext(canceled()); err != context.Canceled {
+		t.Fatalf("Expected context.Canceled, but got %v.", err)
+	}
+	close(release)
+
+	r, err := xproto.InternAtom(c, false, 4, "TEST").Reply()
+	if err != nil {
+		t.Fatalf("InternAtom after an abandoned one: %s", err)
+	}
+	if r.Atom != 42 {
+		t.Fatalf("InternAtom: got atom %d, but expected 42.", r.Atom)
+	}
+	if ev, xerr := c.PollForEvent(); ev != nil || xerr != nil {
+		t.Fatalf("The abandoned reply ended up in the event queue as %v, %v.",
+			ev, xerr)
+	}
+}
+
+// TestReplyContextDelivered checks that a reply that has already arrived is
+// returned by ReplyContext even if its context is done, instead of being
+// left behind in the abandoned cookie.
+func TestReplyContextDelivered(t *testing.T) {
+	s := xgbtest.NewServer()
+	defer s.Close()
+	s.Handle(internAtomOpcode, 0, atomReply(42))
+	c, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	// Which of the reply and the canceled context is seen first would be
+	// up to chance, so try a few times.
+	for i := 0; i < 20; i++ {
+		cookie := xproto.InternAtom(c, false, 4, "TEST")
+		// The reply to InternAtom is read before the one to GetInputFocus.
+		if _, err := xproto.GetInputFocus(c).Reply(); err != nil {
+			t.Fatalf("GetInputFocus: %s", err)
+		}
+		r, err := cookie.ReplyContext(canceled())
+		if err != nil {
+			t.Fatalf("ReplyContext with a reply waiting: %s", err)
+		}
+		if r.Atom != 42 {
+			t.Fatalf("InternAtom: got atom %d, but expected 42.", r.Atom)
+		}
+	}
+}
+
+// TestReplyContextError checks that the error to a request whose
+// ReplyContext was canceled goes to the event queue.
+func TestReplyContextError(t *testing.T) {
+	s := xgbtest.NewServer()
+	defer s.Close()
+	release := make(chan struct{})
+	s.HandleFunc(internAtomOpcode, 0,
+		func(*xgbtest.Request) []xgbtest.Response {
+			<-release
+			return []xgbtest.Response{
+				xgbtest.Error{Code: xproto.BadAlloc},
+			}
+		})
+	c, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	cookie := xproto.InternAtom(c, false, 4, "TEST")
+	if _, err := cookie.ReplyContext(canceled()); err != context.Canceled {
+		t.Fatalf("Expected context.Canceled, but got %v.", err)
+	}
+	close(release)
+
+	ev, xerr := c.WaitForEvent()
+	if _, ok := xerr.(xproto.AllocError); !ok {
+		t.Fatalf("Expected the BadAlloc error of the abandoned request in "+
+			"the event queue, but got %v, %v.", ev, xerr)
+	}
+}
+
+// TestCheckContextCanceled checks that CheckContext returns when its
+// context is done, even if the round trip it makes is never answered, and
+// that the connection carries on without the abandoned cookies.
+func TestCheckContextCanceled(t *testing.T) {
+	s := xgbtest.NewServer()
+	defer s.Close()
+	answered := false
+	s.HandleFunc(getInputFocusOpcode, 0,
+		func(*xgbtest.Request) []xgbtest.Response {
+			// Leave the first round trip hanging.
+			if !answered {
+				answered = true
+				return nil
+			}
+			return []xgbtest.Response{xgbtest.Reply{}}
+		})
+	c, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	cookie := xproto.MapWindowChecked(c, 7)
+	if err := cookie.CheckContext(canceled()); err != context.Canceled {
+		t.Fatalf("Expected context.Canceled, but got %v.", err)
+	}
+	if _, err := xproto.GetInputFocus(c).Reply(); err != nil {
+		t.Fatalf("GetInputFocus after an abandoned round trip: %s", err)
+	}
+}
@@ -4,6 +4,8 @@ package damage
 // This file is automatically generated from damage.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xfixes"
@@ -23,18 +25,16 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["DAMAGE"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["DAMAGE"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["DAMAGE"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["DAMAGE"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["DAMAGE"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "DAMAGE", xgb.ExtRequestInfos["DAMAGE"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["DAMAGE"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["DAMAGE"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["DAMAGE"] = make(map[int]xgb.RequestInfo)
 }
 
 // BadBadDamage is the error number for a BadBadDamage.
@@ -45,15 +45,15 @@ type BadDamageError struct {
 	NiceName string
 }
 
-// BadDamageErrorNew constructs a BadDamageError value that implements xgb.Error from a byte slice.
-func BadDamageErrorNew(buf []byte) xgb.Error {
+// BadDamageErrorNew constructs a BadDamageError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadDamageErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := BadDamageError{}
 	v.NiceName = "BadDamage"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -98,7 +98,7 @@ const Notify = 0
 
 type NotifyEvent struct {
 	Sequence  uint16
-	Level     byte
+	Level     ReportLevel
 	Drawable  xproto.Drawable
 	Damage    Damage
 	Timestamp xproto.Timestamp
@@ -106,37 +106,37 @@ type NotifyEvent struct {
 	Geometry  xproto.Rectangle
 }
 
-// NotifyEventNew constructs a NotifyEvent value that implements xgb.Event from a byte slice.
-func NotifyEventNew(buf []byte) xgb.Event {
+// NotifyEventNew constructs a NotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func NotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := NotifyEvent{}
 	b := 1 // don't read event number
 
-	v.Level = buf[b]
+	v.Level = ReportLevel(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Drawable = xproto.Drawable(xgb.Get32(buf[b:]))
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
 	b += 4
 
-	v.Damage = Damage(xgb.Get32(buf[b:]))
+	v.Damage = Damage(order.Get32(buf[b:]))
 	b += 4
 
-	v.Timestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
 	v.Area = xproto.Rectangle{}
-	b += xproto.RectangleRead(buf[b:], &v.Area)
+	b += xproto.RectangleRead(buf[b:], order, &v.Area)
 
 	v.Geometry = xproto.Rectangle{}
-	b += xproto.RectangleRead(buf[b:], &v.Geometry)
+	b += xproto.RectangleRead(buf[b:], order, &v.Geometry)
 
 	return v
 }
 
-// Bytes writes a NotifyEvent value to a byte slice.
-func (v NotifyEvent) Bytes() []byte {
+// Bytes writes a NotifyEvent value to a byte slice, in the byte order 'order'.
+func (v NotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -144,28 +144,28 @@ func (v NotifyEvent) Bytes() []byte {
 	buf[b] = 0
 	b += 1
 
-	buf[b] = v.Level
+	buf[b] = byte(v.Level)
 	b += 1
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Drawable))
+	order.Put32(buf[b:], uint32(v.Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Damage))
+	order.Put32(buf[b:], uint32(v.Damage))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Timestamp))
+	order.Put32(buf[b:], uint32(v.Timestamp))
 	b += 4
 
 	{
-		structBytes := v.Area.Bytes()
+		structBytes := v.Area.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
 	{
-		structBytes := v.Geometry.Bytes()
+		structBytes := v.Geometry.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -184,7 +184,7 @@ func (v NotifyEvent) SequenceId() uint16 {
 func (v NotifyEvent) String() string {
 	fieldVals := make([]string, 0, 6)
 	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
-	fieldVals = append(fieldVals, xgb.Sprintf("Level: %d", v.Level))
+	fieldVals = append(fieldVals, xgb.Sprintf("Level: %s", v.Level))
 	fieldVals = append(fieldVals, xgb.Sprintf("Drawable: %d", v.Drawable))
 	fieldVals = append(fieldVals, xgb.Sprintf("Damage: %d", v.Damage))
 	fieldVals = append(fieldVals, xgb.Sprintf("Timestamp: %d", v.Timestamp))
@@ -195,13 +195,30 @@ func init() {
 	xgb.NewExtEventFuncs["DAMAGE"][0] = NotifyEventNew
 }
 
+type ReportLevel byte
+
 const (
-	ReportLevelRawRectangles   = 0
-	ReportLevelDeltaRectangles = 1
-	ReportLevelBoundingBox     = 2
-	ReportLevelNonEmpty        = 3
+	ReportLevelRawRectangles   ReportLevel = 0
+	ReportLevelDeltaRectangles ReportLevel = 1
+	ReportLevelBoundingBox     ReportLevel = 2
+	ReportLevelNonEmpty        ReportLevel = 3
 )
 
+// String returns the name of the ReportLevel item with this value.
+func (v ReportLevel) String() string {
+	switch v {
+	case ReportLevelRawRectangles:
+		return "RawRectangles"
+	case ReportLevelDeltaRectangles:
+		return "DeltaRectangles"
+	case ReportLevelBoundingBox:
+		return "BoundingBox"
+	case ReportLevelNonEmpty:
+		return "NonEmpty"
+	}
+	return xgb.Sprintf("ReportLevel(%d)", byte(v))
+}
+
 // Skipping definition for base type 'Bool'
 
 // Skipping definition for base type 'Byte'
@@ -226,6 +243,8 @@ const (
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // AddCookie is a cookie used only for Add requests.
 type AddCookie struct {
 	*xgb.Cookie
@@ -263,12 +282,26 @@ func (cook AddCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook AddCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for Add
 // addRequest writes a Add request to a byte slice.
 func addRequest(c *xgb.Conn, Drawable xproto.Drawable, Region xfixes.Region) []byte {
+	return AppendAddRequest(nil, c, Drawable, Region)
+}
+
+// AppendAddRequest appends a Add request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendAddRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable, Region xfixes.Region) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DAMAGE"]
@@ -278,18 +311,47 @@ func addRequest(c *xgb.Conn, Drawable xproto.Drawable, Region xfixes.Region) []b
 	buf[b] = 4 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Region))
+	order.Put32(buf[b:], uint32(Region))
 	b += 4
 
 	return buf
 }
 
+// AddRequest represents the data of a Add request, as it is read when tracing a connection.
+type AddRequest struct {
+	Drawable xproto.Drawable
+	Region   xfixes.Region
+}
+
+// addRequestRead reads a byte slice in the byte order 'order' into a AddRequest value.
+func addRequestRead(buf []byte, order xgb.ByteOrder) *AddRequest {
+	v := new(AddRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Region = xfixes.Region(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DAMAGE"][4] = xgb.RequestInfo{
+		Name: "Add",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return addRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateCookie is a cookie used only for Create requests.
 type CreateCookie struct {
 	*xgb.Cookie
@@ -297,7 +359,7 @@ type CreateCookie struct {
 
 // Create sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func Create(c *xgb.Conn, Damage Damage, Drawable xproto.Drawable, Level byte) CreateCookie {
+func Create(c *xgb.Conn, Damage Damage, Drawable xproto.Drawable, Level ReportLevel) CreateCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["DAMAGE"]; !ok {
@@ -310,7 +372,7 @@ func Create(c *xgb.Conn, Damage Damage, Drawable xproto.Drawable, Level byte) Cr
 
 // CreateChecked sends a checked request.
 // If an error occurs, it can be retrieved using CreateCookie.Check()
-func CreateChecked(c *xgb.Conn, Damage Damage, Drawable xproto.Drawable, Level byte) CreateCookie {
+func CreateChecked(c *xgb.Conn, Damage Damage, Drawable xproto.Drawable, Level ReportLevel) CreateCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["DAMAGE"]; !ok {
@@ -327,12 +389,26 @@ func (cook CreateCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for Create
 // createRequest writes a Create request to a byte slice.
-func createRequest(c *xgb.Conn, Damage Damage, Drawable xproto.Drawable, Level byte) []byte {
+func createRequest(c *xgb.Conn, Damage Damage, Drawable xproto.Drawable, Level ReportLevel) []byte {
+	return AppendCreateRequest(nil, c, Damage, Drawable, Level)
+}
+
+// AppendCreateRequest appends a Create request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateRequest(buf []byte, c *xgb.Conn, Damage Damage, Drawable xproto.Drawable, Level ReportLevel) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DAMAGE"]
@@ -342,16 +418,16 @@ func createRequest(c *xgb.Conn, Damage Damage, Drawable xproto.Drawable, Level b
 	buf[b] = 1 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Damage))
+	order.Put32(buf[b:], uint32(Damage))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	buf[b] = Level
+	buf[b] = byte(Level)
 	b += 1
 
 	b += 3 // padding
@@ -359,6 +435,42 @@ func createRequest(c *xgb.Conn, Damage Damage, Drawable xproto.Drawable, Level b
 	return buf
 }
 
+// CreateRequest represents the data of a Create request, as it is read when tracing a connection.
+type CreateRequest struct {
+	Damage   Damage
+	Drawable xproto.Drawable
+	Level    ReportLevel
+	// padding: 3 bytes
+}
+
+// createRequestRead reads a byte slice in the byte order 'order' into a CreateRequest value.
+func createRequestRead(buf []byte, order xgb.ByteOrder) *CreateRequest {
+	v := new(CreateRequest)
+	b := 4 // skip request header
+
+	v.Damage = Damage(order.Get32(buf[b:]))
+	b += 4
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Level = ReportLevel(buf[b])
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DAMAGE"][1] = xgb.RequestInfo{
+		Name: "Create",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createRequestRead(buf, order)
+		},
+	}
+}
+
 // DestroyCookie is a cookie used only for Destroy requests.
 type DestroyCookie struct {
 	*xgb.Cookie
@@ -396,12 +508,26 @@ func (cook DestroyCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DestroyCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for Destroy
 // destroyRequest writes a Destroy request to a byte slice.
 func destroyRequest(c *xgb.Conn, Damage Damage) []byte {
+	return AppendDestroyRequest(nil, c, Damage)
+}
+
+// AppendDestroyRequest appends a Destroy request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDestroyRequest(buf []byte, c *xgb.Conn, Damage Damage) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DAMAGE"]
@@ -411,15 +537,40 @@ func destroyRequest(c *xgb.Conn, Damage Damage) []byte {
 	buf[b] = 2 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Damage))
+	order.Put32(buf[b:], uint32(Damage))
 	b += 4
 
 	return buf
 }
 
+// DestroyRequest represents the data of a Destroy request, as it is read when tracing a connection.
+type DestroyRequest struct {
+	Damage Damage
+}
+
+// destroyRequestRead reads a byte slice in the byte order 'order' into a DestroyRequest value.
+func destroyRequestRead(buf []byte, order xgb.ByteOrder) *DestroyRequest {
+	v := new(DestroyRequest)
+	b := 4 // skip request header
+
+	v.Damage = Damage(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DAMAGE"][2] = xgb.RequestInfo{
+		Name: "Destroy",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return destroyRequestRead(buf, order)
+		},
+	}
+}
+
 // QueryVersionCookie is a cookie used only for QueryVersion requests.
 type QueryVersionCookie struct {
 	*xgb.Cookie
@@ -470,26 +621,38 @@ func (cook QueryVersionCookie) Reply() (*QueryVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryVersionReply(buf), nil
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryVersion request arrives.
+func (cook QueryVersionCookie) ReplyContext(ctx context.Context) (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryVersionReply reads a byte slice into a QueryVersionReply value.
-func queryVersionReply(buf []byte) *QueryVersionReply {
+// queryVersionReply reads a byte slice in the byte order 'order' into a QueryVersionReply value.
+func queryVersionReply(buf []byte, order xgb.ByteOrder) *QueryVersionReply {
 	v := new(QueryVersionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.MajorVersion = xgb.Get32(buf[b:])
+	v.MajorVersion = order.Get32(buf[b:])
 	b += 4
 
-	v.MinorVersion = xgb.Get32(buf[b:])
+	v.MinorVersion = order.Get32(buf[b:])
 	b += 4
 
 	b += 16 // padding
@@ -500,9 +663,18 @@ func queryVersionReply(buf []byte) *QueryVersionReply {
 // Write request to wire for QueryVersion
 // queryVersionRequest writes a QueryVersion request to a byte slice.
 func queryVersionRequest(c *xgb.Conn, ClientMajorVersion uint32, ClientMinorVersion uint32) []byte {
+	return AppendQueryVersionRequest(nil, c, ClientMajorVersion, ClientMinorVersion)
+}
+
+// AppendQueryVersionRequest appends a QueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryVersionRequest(buf []byte, c *xgb.Conn, ClientMajorVersion uint32, ClientMinorVersion uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DAMAGE"]
@@ -512,18 +684,50 @@ func queryVersionRequest(c *xgb.Conn, ClientMajorVersion uint32, ClientMinorVers
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], ClientMajorVersion)
+	order.Put32(buf[b:], ClientMajorVersion)
 	b += 4
 
-	xgb.Put32(buf[b:], ClientMinorVersion)
+	order.Put32(buf[b:], ClientMinorVersion)
 	b += 4
 
 	return buf
 }
 
+// QueryVersionRequest represents the data of a QueryVersion request, as it is read when tracing a connection.
+type QueryVersionRequest struct {
+	ClientMajorVersion uint32
+	ClientMinorVersion uint32
+}
+
+// queryVersionRequestRead reads a byte slice in the byte order 'order' into a QueryVersionRequest value.
+func queryVersionRequestRead(buf []byte, order xgb.ByteOrder) *QueryVersionRequest {
+	v := new(QueryVersionRequest)
+	b := 4 // skip request header
+
+	v.ClientMajorVersion = order.Get32(buf[b:])
+	b += 4
+
+	v.ClientMinorVersion = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DAMAGE"][0] = xgb.RequestInfo{
+		Name: "QueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionReply(buf, order)
+		},
+	}
+}
+
 // SubtractCookie is a cookie used only for Subtract requests.
 type SubtractCookie struct {
 	*xgb.Cookie
@@ -561,12 +765,26 @@ func (cook SubtractCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SubtractCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for Subtract
 // subtractRequest writes a Subtract request to a byte slice.
 func subtractRequest(c *xgb.Conn, Damage Damage, Repair xfixes.Region, Parts xfixes.Region) []byte {
+	return AppendSubtractRequest(nil, c, Damage, Repair, Parts)
+}
+
+// AppendSubtractRequest appends a Subtract request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSubtractRequest(buf []byte, c *xgb.Conn, Damage Damage, Repair xfixes.Region, Parts xfixes.Region) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DAMAGE"]
@@ -576,17 +794,50 @@ func subtractRequest(c *xgb.Conn, Damage Damage, Repair xfixes.Region, Parts xfi
 	buf[b] = 3 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Damage))
+	order.Put32(buf[b:], uint32(Damage))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Repair))
+	order.Put32(buf[b:], uint32(Repair))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Parts))
+	order.Put32(buf[b:], uint32(Parts))
 	b += 4
 
 	return buf
 }
+
+// SubtractRequest represents the data of a Subtract request, as it is read when tracing a connection.
+type SubtractRequest struct {
+	Damage Damage
+	Repair xfixes.Region
+	Parts  xfixes.Region
+}
+
+// subtractRequestRead reads a byte slice in the byte order 'order' into a SubtractRequest value.
+func subtractRequestRead(buf []byte, order xgb.ByteOrder) *SubtractRequest {
+	v := new(SubtractRequest)
+	b := 4 // skip request header
+
+	v.Damage = Damage(order.Get32(buf[b:]))
+	b += 4
+
+	v.Repair = xfixes.Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.Parts = xfixes.Region(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DAMAGE"][3] = xgb.RequestInfo{
+		Name: "Subtract",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return subtractRequestRead(buf, order)
+		},
+	}
+}
@@ -36,15 +36,15 @@ accompanying documentation can be found in examples/create-window.
 
 		wid, _ := xproto.NewWindowId(X)
 		screen := xproto.Setup(X).DefaultScreen(X)
+		values := new(xproto.CwValues).
+			SetBackPixel(0xffffffff).
+			SetEventMask(xproto.EventMaskStructureNotify |
+				xproto.EventMaskKeyPress |
+				xproto.EventMaskKeyRelease)
 		xproto.CreateWindow(X, screen.RootDepth, wid, screen.Root,
 			0, 0, 500, 500, 0,
 			xproto.WindowClassInputOutput, screen.RootVisual,
-			xproto.CwBackPixel | xproto.CwEventMask,
-			[]uint32{ // values must be in the order defined by the protocol
-				0xffffffff,
-				xproto.EventMaskStructureNotify |
-				xproto.EventMaskKeyPress |
-				xproto.EventMaskKeyRelease})
+			values.Mask(), values.List())
 
 		xproto.MapWindow(X, wid)
 		for {
@@ -109,6 +109,76 @@ Parallelism
 XGB can benefit greatly from parallelism due to its concurrent design. For
 evidence of this claim, please see the benchmarks in xproto/xproto_test.go.
 
+Subscribing to Events
+
+Rather than taking every event from WaitForEvent, independent parts of a
+program can each subscribe to the events they care about, by type and
+optionally by window:
+
+	sub := X.Subscribe(xgb.Filter{Type: xproto.ExposeEvent{}, Window: win},
+		100, xgb.OverflowDropOldest)
+	for ev := range sub.Events() {
+		expose := ev.(xproto.ExposeEvent)
+		...
+	}
+
+Use SubscribeFunc to have a function called with each event instead, and
+a Filter with Unhandled set to get the events no other subscription gets.
+Events that no subscription gets are still returned by WaitForEvent.
+
+Value Lists
+
+Requests like CreateWindow and ChangeGC take a value mask and a list of
+values, which must be in the order defined by the protocol. Rather than
+writing them out by hand, build them with the Values type of the mask's
+enum, which computes the mask and orders the values no matter the order in
+which they are set:
+
+	values := new(xproto.GcValues).
+		SetForeground(screen.BlackPixel).
+		SetGraphicsExposures(0)
+	xproto.CreateGC(X, gc, xproto.Drawable(wid), values.Mask(), values.List())
+
+Requests whose values are described by a switch (i.e., sync.CreateAlarm) get
+a Values type of their own instead, named after the request.
+
+Enums that the protocol description says a field takes its values from, and
+those naming the bits of a value mask, get a type of their own, which the
+field and any request parameter for it are given. Passing an
+xproto.EventMask where an xproto.Cw mask goes doesn't compile. Their String
+methods print the names of values and of the bits set in masks (i.e.,
+xproto.EventMaskKeyPress|xproto.EventMaskExposure prints as
+"KeyPress|Exposure"). Enums whose items are only special values of another
+type (i.e., xproto.WindowNone) remain untyped constants.
+
+Batching Requests
+
+Every request function allocates a buffer for its request, and every
+request is written to the connection on its own. For requests sent in bulk,
+each request also has an Append function, which encodes it at the end of a
+byte slice. Together with a Batch, which sends the requests it collects with
+a single write and then reuses its buffer, nothing is allocated per request
+but its cookie:
+
+	b := X.NewBatch()
+	for _, win := range windows {
+		b.Add(xproto.AppendMapWindowRequest(b.Bytes(), X, win),
+			X.NewCookie(false, false))
+	}
+	b.Flush()
+
+Tracing
+
+To see everything that goes over a connection, turn on its tracer. Every
+request, reply, event and error is logged with its sequence number, and
+decoded using the core protocol and the extensions initialized on the
+connection:
+
+	X.Trace(os.Stderr, xgb.TraceText)
+
+Use xgb.TraceJSON instead to get one JSON object per line, and X.Trace(nil, 0)
+to turn the tracer off again.
+
 Tests
 
 xproto/xproto_test.go contains a number of contrived tests that stress
@@ -137,10 +207,8 @@ included in this package, but I am currently unsure of their status. They
 
 What does not work
 
-XKB is the only extension that intentionally does not work, although I suspect
-that GLX also does not work (however, there is Go source code for GLX that
-compiles, unlike XKB). I don't currently have any intention of getting XKB
-working, due to its complexity and my current mental incapacity to test it.
+XKB and XInput are not generated yet. I suspect that GLX does not work,
+although there is Go source code for GLX that compiles.
 
 */
 package xgb
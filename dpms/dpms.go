@@ -4,6 +4,8 @@ package dpms
 // This file is automatically generated from dpms.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xproto"
@@ -22,27 +24,42 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["DPMS"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["DPMS"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["DPMS"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["DPMS"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["DPMS"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "DPMS", xgb.ExtRequestInfos["DPMS"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["DPMS"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["DPMS"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["DPMS"] = make(map[int]xgb.RequestInfo)
 }
 
+type DPMSMode uint16
+
 const (
-	DPMSModeOn      = 0
-	DPMSModeStandby = 1
-	DPMSModeSuspend = 2
-	DPMSModeOff     = 3
+	DPMSModeOn      DPMSMode = 0
+	DPMSModeStandby DPMSMode = 1
+	DPMSModeSuspend DPMSMode = 2
+	DPMSModeOff     DPMSMode = 3
 )
 
+// String returns the name of the DPMSMode item with this value.
+func (v DPMSMode) String() string {
+	switch v {
+	case DPMSModeOn:
+		return "On"
+	case DPMSModeStandby:
+		return "Standby"
+	case DPMSModeSuspend:
+		return "Suspend"
+	case DPMSModeOff:
+		return "Off"
+	}
+	return xgb.Sprintf("DPMSMode(%d)", uint16(v))
+}
+
 // Skipping definition for base type 'Bool'
 
 // Skipping definition for base type 'Byte'
@@ -67,6 +84,8 @@ const (
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // CapableCookie is a cookie used only for Capable requests.
 type CapableCookie struct {
 	*xgb.Cookie
@@ -116,20 +135,32 @@ func (cook CapableCookie) Reply() (*CapableReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return capableReply(buf), nil
+	return capableReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a Capable request arrives.
+func (cook CapableCookie) ReplyContext(ctx context.Context) (*CapableReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return capableReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// capableReply reads a byte slice into a CapableReply value.
-func capableReply(buf []byte) *CapableReply {
+// capableReply reads a byte slice in the byte order 'order' into a CapableReply value.
+func capableReply(buf []byte, order xgb.ByteOrder) *CapableReply {
 	v := new(CapableReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	if buf[b] == 1 {
@@ -147,9 +178,18 @@ func capableReply(buf []byte) *CapableReply {
 // Write request to wire for Capable
 // capableRequest writes a Capable request to a byte slice.
 func capableRequest(c *xgb.Conn) []byte {
+	return AppendCapableRequest(nil, c)
+}
+
+// AppendCapableRequest appends a Capable request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCapableRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DPMS"]
@@ -159,12 +199,34 @@ func capableRequest(c *xgb.Conn) []byte {
 	buf[b] = 1 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// CapableRequest represents the data of a Capable request, as it is read when tracing a connection.
+type CapableRequest struct {
+}
+
+// capableRequestRead reads a byte slice in the byte order 'order' into a CapableRequest value.
+func capableRequestRead(buf []byte, order xgb.ByteOrder) *CapableRequest {
+	v := new(CapableRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DPMS"][1] = xgb.RequestInfo{
+		Name: "Capable",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return capableRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return capableReply(buf, order)
+		},
+	}
+}
+
 // DisableCookie is a cookie used only for Disable requests.
 type DisableCookie struct {
 	*xgb.Cookie
@@ -202,12 +264,26 @@ func (cook DisableCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DisableCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for Disable
 // disableRequest writes a Disable request to a byte slice.
 func disableRequest(c *xgb.Conn) []byte {
+	return AppendDisableRequest(nil, c)
+}
+
+// AppendDisableRequest appends a Disable request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDisableRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DPMS"]
@@ -217,12 +293,31 @@ func disableRequest(c *xgb.Conn) []byte {
 	buf[b] = 5 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// DisableRequest represents the data of a Disable request, as it is read when tracing a connection.
+type DisableRequest struct {
+}
+
+// disableRequestRead reads a byte slice in the byte order 'order' into a DisableRequest value.
+func disableRequestRead(buf []byte, order xgb.ByteOrder) *DisableRequest {
+	v := new(DisableRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DPMS"][5] = xgb.RequestInfo{
+		Name: "Disable",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return disableRequestRead(buf, order)
+		},
+	}
+}
+
 // EnableCookie is a cookie used only for Enable requests.
 type EnableCookie struct {
 	*xgb.Cookie
@@ -260,12 +355,26 @@ func (cook EnableCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook EnableCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for Enable
 // enableRequest writes a Enable request to a byte slice.
 func enableRequest(c *xgb.Conn) []byte {
+	return AppendEnableRequest(nil, c)
+}
+
+// AppendEnableRequest appends a Enable request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendEnableRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DPMS"]
@@ -275,12 +384,31 @@ func enableRequest(c *xgb.Conn) []byte {
 	buf[b] = 4 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// EnableRequest represents the data of a Enable request, as it is read when tracing a connection.
+type EnableRequest struct {
+}
+
+// enableRequestRead reads a byte slice in the byte order 'order' into a EnableRequest value.
+func enableRequestRead(buf []byte, order xgb.ByteOrder) *EnableRequest {
+	v := new(EnableRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DPMS"][4] = xgb.RequestInfo{
+		Name: "Enable",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return enableRequestRead(buf, order)
+		},
+	}
+}
+
 // ForceLevelCookie is a cookie used only for ForceLevel requests.
 type ForceLevelCookie struct {
 	*xgb.Cookie
@@ -288,7 +416,7 @@ type ForceLevelCookie struct {
 
 // ForceLevel sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func ForceLevel(c *xgb.Conn, PowerLevel uint16) ForceLevelCookie {
+func ForceLevel(c *xgb.Conn, PowerLevel DPMSMode) ForceLevelCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["DPMS"]; !ok {
@@ -301,7 +429,7 @@ func ForceLevel(c *xgb.Conn, PowerLevel uint16) ForceLevelCookie {
 
 // ForceLevelChecked sends a checked request.
 // If an error occurs, it can be retrieved using ForceLevelCookie.Check()
-func ForceLevelChecked(c *xgb.Conn, PowerLevel uint16) ForceLevelCookie {
+func ForceLevelChecked(c *xgb.Conn, PowerLevel DPMSMode) ForceLevelCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["DPMS"]; !ok {
@@ -318,12 +446,26 @@ func (cook ForceLevelCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ForceLevelCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ForceLevel
 // forceLevelRequest writes a ForceLevel request to a byte slice.
-func forceLevelRequest(c *xgb.Conn, PowerLevel uint16) []byte {
+func forceLevelRequest(c *xgb.Conn, PowerLevel DPMSMode) []byte {
+	return AppendForceLevelRequest(nil, c, PowerLevel)
+}
+
+// AppendForceLevelRequest appends a ForceLevel request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendForceLevelRequest(buf []byte, c *xgb.Conn, PowerLevel DPMSMode) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DPMS"]
@@ -333,15 +475,40 @@ func forceLevelRequest(c *xgb.Conn, PowerLevel uint16) []byte {
 	buf[b] = 6 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], PowerLevel)
+	order.Put16(buf[b:], uint16(PowerLevel))
 	b += 2
 
 	return buf
 }
 
+// ForceLevelRequest represents the data of a ForceLevel request, as it is read when tracing a connection.
+type ForceLevelRequest struct {
+	PowerLevel DPMSMode
+}
+
+// forceLevelRequestRead reads a byte slice in the byte order 'order' into a ForceLevelRequest value.
+func forceLevelRequestRead(buf []byte, order xgb.ByteOrder) *ForceLevelRequest {
+	v := new(ForceLevelRequest)
+	b := 4 // skip request header
+
+	v.PowerLevel = DPMSMode(order.Get16(buf[b:]))
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DPMS"][6] = xgb.RequestInfo{
+		Name: "ForceLevel",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return forceLevelRequestRead(buf, order)
+		},
+	}
+}
+
 // GetTimeoutsCookie is a cookie used only for GetTimeouts requests.
 type GetTimeoutsCookie struct {
 	*xgb.Cookie
@@ -393,29 +560,41 @@ func (cook GetTimeoutsCookie) Reply() (*GetTimeoutsReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getTimeoutsReply(buf), nil
+	return getTimeoutsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetTimeouts request arrives.
+func (cook GetTimeoutsCookie) ReplyContext(ctx context.Context) (*GetTimeoutsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getTimeoutsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getTimeoutsReply reads a byte slice into a GetTimeoutsReply value.
-func getTimeoutsReply(buf []byte) *GetTimeoutsReply {
+// getTimeoutsReply reads a byte slice in the byte order 'order' into a GetTimeoutsReply value.
+func getTimeoutsReply(buf []byte, order xgb.ByteOrder) *GetTimeoutsReply {
 	v := new(GetTimeoutsReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.StandbyTimeout = xgb.Get16(buf[b:])
+	v.StandbyTimeout = order.Get16(buf[b:])
 	b += 2
 
-	v.SuspendTimeout = xgb.Get16(buf[b:])
+	v.SuspendTimeout = order.Get16(buf[b:])
 	b += 2
 
-	v.OffTimeout = xgb.Get16(buf[b:])
+	v.OffTimeout = order.Get16(buf[b:])
 	b += 2
 
 	b += 18 // padding
@@ -426,9 +605,18 @@ func getTimeoutsReply(buf []byte) *GetTimeoutsReply {
 // Write request to wire for GetTimeouts
 // getTimeoutsRequest writes a GetTimeouts request to a byte slice.
 func getTimeoutsRequest(c *xgb.Conn) []byte {
+	return AppendGetTimeoutsRequest(nil, c)
+}
+
+// AppendGetTimeoutsRequest appends a GetTimeouts request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetTimeoutsRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DPMS"]
@@ -438,12 +626,34 @@ func getTimeoutsRequest(c *xgb.Conn) []byte {
 	buf[b] = 2 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// GetTimeoutsRequest represents the data of a GetTimeouts request, as it is read when tracing a connection.
+type GetTimeoutsRequest struct {
+}
+
+// getTimeoutsRequestRead reads a byte slice in the byte order 'order' into a GetTimeoutsRequest value.
+func getTimeoutsRequestRead(buf []byte, order xgb.ByteOrder) *GetTimeoutsRequest {
+	v := new(GetTimeoutsRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DPMS"][2] = xgb.RequestInfo{
+		Name: "GetTimeouts",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTimeoutsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTimeoutsReply(buf, order)
+		},
+	}
+}
+
 // GetVersionCookie is a cookie used only for GetVersion requests.
 type GetVersionCookie struct {
 	*xgb.Cookie
@@ -493,26 +703,38 @@ func (cook GetVersionCookie) Reply() (*GetVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getVersionReply(buf), nil
+	return getVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetVersion request arrives.
+func (cook GetVersionCookie) ReplyContext(ctx context.Context) (*GetVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getVersionReply reads a byte slice into a GetVersionReply value.
-func getVersionReply(buf []byte) *GetVersionReply {
+// getVersionReply reads a byte slice in the byte order 'order' into a GetVersionReply value.
+func getVersionReply(buf []byte, order xgb.ByteOrder) *GetVersionReply {
 	v := new(GetVersionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ServerMajorVersion = xgb.Get16(buf[b:])
+	v.ServerMajorVersion = order.Get16(buf[b:])
 	b += 2
 
-	v.ServerMinorVersion = xgb.Get16(buf[b:])
+	v.ServerMinorVersion = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -521,9 +743,18 @@ func getVersionReply(buf []byte) *GetVersionReply {
 // Write request to wire for GetVersion
 // getVersionRequest writes a GetVersion request to a byte slice.
 func getVersionRequest(c *xgb.Conn, ClientMajorVersion uint16, ClientMinorVersion uint16) []byte {
+	return AppendGetVersionRequest(nil, c, ClientMajorVersion, ClientMinorVersion)
+}
+
+// AppendGetVersionRequest appends a GetVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetVersionRequest(buf []byte, c *xgb.Conn, ClientMajorVersion uint16, ClientMinorVersion uint16) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DPMS"]
@@ -533,18 +764,50 @@ func getVersionRequest(c *xgb.Conn, ClientMajorVersion uint16, ClientMinorVersio
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], ClientMajorVersion)
+	order.Put16(buf[b:], ClientMajorVersion)
 	b += 2
 
-	xgb.Put16(buf[b:], ClientMinorVersion)
+	order.Put16(buf[b:], ClientMinorVersion)
 	b += 2
 
 	return buf
 }
 
+// GetVersionRequest represents the data of a GetVersion request, as it is read when tracing a connection.
+type GetVersionRequest struct {
+	ClientMajorVersion uint16
+	ClientMinorVersion uint16
+}
+
+// getVersionRequestRead reads a byte slice in the byte order 'order' into a GetVersionRequest value.
+func getVersionRequestRead(buf []byte, order xgb.ByteOrder) *GetVersionRequest {
+	v := new(GetVersionRequest)
+	b := 4 // skip request header
+
+	v.ClientMajorVersion = order.Get16(buf[b:])
+	b += 2
+
+	v.ClientMinorVersion = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DPMS"][0] = xgb.RequestInfo{
+		Name: "GetVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getVersionReply(buf, order)
+		},
+	}
+}
+
 // InfoCookie is a cookie used only for Info requests.
 type InfoCookie struct {
 	*xgb.Cookie
@@ -581,7 +844,7 @@ type InfoReply struct {
 	Sequence uint16 // sequence number of the request for this reply
 	Length   uint32 // number of bytes in this reply
 	// padding: 1 bytes
-	PowerLevel uint16
+	PowerLevel DPMSMode
 	State      bool
 	// padding: 21 bytes
 }
@@ -595,23 +858,35 @@ func (cook InfoCookie) Reply() (*InfoReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return infoReply(buf), nil
+	return infoReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// infoReply reads a byte slice into a InfoReply value.
-func infoReply(buf []byte) *InfoReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a Info request arrives.
+func (cook InfoCookie) ReplyContext(ctx context.Context) (*InfoReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return infoReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// infoReply reads a byte slice in the byte order 'order' into a InfoReply value.
+func infoReply(buf []byte, order xgb.ByteOrder) *InfoReply {
 	v := new(InfoReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.PowerLevel = xgb.Get16(buf[b:])
+	v.PowerLevel = DPMSMode(order.Get16(buf[b:]))
 	b += 2
 
 	if buf[b] == 1 {
@@ -629,9 +904,18 @@ func infoReply(buf []byte) *InfoReply {
 // Write request to wire for Info
 // infoRequest writes a Info request to a byte slice.
 func infoRequest(c *xgb.Conn) []byte {
+	return AppendInfoRequest(nil, c)
+}
+
+// AppendInfoRequest appends a Info request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendInfoRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DPMS"]
@@ -641,12 +925,34 @@ func infoRequest(c *xgb.Conn) []byte {
 	buf[b] = 7 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// InfoRequest represents the data of a Info request, as it is read when tracing a connection.
+type InfoRequest struct {
+}
+
+// infoRequestRead reads a byte slice in the byte order 'order' into a InfoRequest value.
+func infoRequestRead(buf []byte, order xgb.ByteOrder) *InfoRequest {
+	v := new(InfoRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DPMS"][7] = xgb.RequestInfo{
+		Name: "Info",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return infoRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return infoReply(buf, order)
+		},
+	}
+}
+
 // SetTimeoutsCookie is a cookie used only for SetTimeouts requests.
 type SetTimeoutsCookie struct {
 	*xgb.Cookie
@@ -684,12 +990,26 @@ func (cook SetTimeoutsCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetTimeoutsCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetTimeouts
 // setTimeoutsRequest writes a SetTimeouts request to a byte slice.
 func setTimeoutsRequest(c *xgb.Conn, StandbyTimeout uint16, SuspendTimeout uint16, OffTimeout uint16) []byte {
+	return AppendSetTimeoutsRequest(nil, c, StandbyTimeout, SuspendTimeout, OffTimeout)
+}
+
+// AppendSetTimeoutsRequest appends a SetTimeouts request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetTimeoutsRequest(buf []byte, c *xgb.Conn, StandbyTimeout uint16, SuspendTimeout uint16, OffTimeout uint16) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DPMS"]
@@ -699,17 +1019,50 @@ func setTimeoutsRequest(c *xgb.Conn, StandbyTimeout uint16, SuspendTimeout uint1
 	buf[b] = 3 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], StandbyTimeout)
+	order.Put16(buf[b:], StandbyTimeout)
 	b += 2
 
-	xgb.Put16(buf[b:], SuspendTimeout)
+	order.Put16(buf[b:], SuspendTimeout)
 	b += 2
 
-	xgb.Put16(buf[b:], OffTimeout)
+	order.Put16(buf[b:], OffTimeout)
 	b += 2
 
 	return buf
 }
+
+// SetTimeoutsRequest represents the data of a SetTimeouts request, as it is read when tracing a connection.
+type SetTimeoutsRequest struct {
+	StandbyTimeout uint16
+	SuspendTimeout uint16
+	OffTimeout     uint16
+}
+
+// setTimeoutsRequestRead reads a byte slice in the byte order 'order' into a SetTimeoutsRequest value.
+func setTimeoutsRequestRead(buf []byte, order xgb.ByteOrder) *SetTimeoutsRequest {
+	v := new(SetTimeoutsRequest)
+	b := 4 // skip request header
+
+	v.StandbyTimeout = order.Get16(buf[b:])
+	b += 2
+
+	v.SuspendTimeout = order.Get16(buf[b:])
+	b += 2
+
+	v.OffTimeout = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DPMS"][3] = xgb.RequestInfo{
+		Name: "SetTimeouts",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setTimeoutsRequestRead(buf, order)
+		},
+	}
+}
@@ -4,6 +4,8 @@ package dri2
 // This file is automatically generated from dri2.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xproto"
@@ -22,95 +24,124 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["DRI2"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["DRI2"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["DRI2"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["DRI2"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["DRI2"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "DRI2", xgb.ExtRequestInfos["DRI2"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["DRI2"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["DRI2"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["DRI2"] = make(map[int]xgb.RequestInfo)
 }
 
 type AttachFormat struct {
-	Attachment uint32
+	Attachment AttachmentBuffer
 	Format     uint32
 }
 
 // AttachFormatRead reads a byte slice into a AttachFormat value.
-func AttachFormatRead(buf []byte, v *AttachFormat) int {
+func AttachFormatRead(buf []byte, order xgb.ByteOrder, v *AttachFormat) int {
 	b := 0
 
-	v.Attachment = xgb.Get32(buf[b:])
+	v.Attachment = AttachmentBuffer(order.Get32(buf[b:]))
 	b += 4
 
-	v.Format = xgb.Get32(buf[b:])
+	v.Format = order.Get32(buf[b:])
 	b += 4
 
 	return b
 }
 
 // AttachFormatReadList reads a byte slice into a list of AttachFormat values.
-func AttachFormatReadList(buf []byte, dest []AttachFormat) int {
+func AttachFormatReadList(buf []byte, order xgb.ByteOrder, dest []AttachFormat) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = AttachFormat{}
-		b += AttachFormatRead(buf[b:], &dest[i])
+		b += AttachFormatRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a AttachFormat value to a byte slice.
-func (v AttachFormat) Bytes() []byte {
+// Bytes writes a AttachFormat value to a byte slice, in the byte order 'order'.
+func (v AttachFormat) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 8)
 	b := 0
 
-	xgb.Put32(buf[b:], v.Attachment)
+	order.Put32(buf[b:], uint32(v.Attachment))
 	b += 4
 
-	xgb.Put32(buf[b:], v.Format)
+	order.Put32(buf[b:], v.Format)
 	b += 4
 
 	return buf[:b]
 }
 
 // AttachFormatListBytes writes a list of AttachFormat values to a byte slice.
-func AttachFormatListBytes(buf []byte, list []AttachFormat) int {
+func AttachFormatListBytes(buf []byte, order xgb.ByteOrder, list []AttachFormat) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 	return xgb.Pad(b)
 }
 
+type AttachmentBuffer uint32
+
 const (
-	AttachmentBufferFrontLeft      = 0
-	AttachmentBufferBackLeft       = 1
-	AttachmentBufferFrontRight     = 2
-	AttachmentBufferBackRight      = 3
-	AttachmentBufferDepth          = 4
-	AttachmentBufferStencil        = 5
-	AttachmentBufferAccum          = 6
-	AttachmentBufferFakeFrontLeft  = 7
-	AttachmentBufferFakeFrontRight = 8
-	AttachmentBufferDepthStencil   = 9
-	AttachmentBufferHiz            = 10
+	AttachmentBufferFrontLeft      AttachmentBuffer = 0
+	AttachmentBufferBackLeft       AttachmentBuffer = 1
+	AttachmentBufferFrontRight     AttachmentBuffer = 2
+	AttachmentBufferBackRight      AttachmentBuffer = 3
+	AttachmentBufferDepth          AttachmentBuffer = 4
+	AttachmentBufferStencil        AttachmentBuffer = 5
+	AttachmentBufferAccum          AttachmentBuffer = 6
+	AttachmentBufferFakeFrontLeft  AttachmentBuffer = 7
+	AttachmentBufferFakeFrontRight AttachmentBuffer = 8
+	AttachmentBufferDepthStencil   AttachmentBuffer = 9
+	AttachmentBufferHiz            AttachmentBuffer = 10
 )
 
+// String returns the name of the AttachmentBuffer item with this value.
+func (v AttachmentBuffer) String() string {
+	switch v {
+	case AttachmentBufferFrontLeft:
+		return "FrontLeft"
+	case AttachmentBufferBackLeft:
+		return "BackLeft"
+	case AttachmentBufferFrontRight:
+		return "FrontRight"
+	case AttachmentBufferBackRight:
+		return "BackRight"
+	case AttachmentBufferDepth:
+		return "Depth"
+	case AttachmentBufferStencil:
+		return "Stencil"
+	case AttachmentBufferAccum:
+		return "Accum"
+	case AttachmentBufferFakeFrontLeft:
+		return "FakeFrontLeft"
+	case AttachmentBufferFakeFrontRight:
+		return "FakeFrontRight"
+	case AttachmentBufferDepthStencil:
+		return "DepthStencil"
+	case AttachmentBufferHiz:
+		return "Hiz"
+	}
+	return xgb.Sprintf("AttachmentBuffer(%d)", uint32(v))
+}
+
 // BufferSwapComplete is the event number for a BufferSwapCompleteEvent.
 const BufferSwapComplete = 0
 
 type BufferSwapCompleteEvent struct {
 	Sequence uint16
 	// padding: 1 bytes
-	EventType uint16
+	EventType EventType
 	// padding: 2 bytes
 	Drawable xproto.Drawable
 	UstHi    uint32
@@ -120,44 +151,44 @@ type BufferSwapCompleteEvent struct {
 	Sbc      uint32
 }
 
-// BufferSwapCompleteEventNew constructs a BufferSwapCompleteEvent value that implements xgb.Event from a byte slice.
-func BufferSwapCompleteEventNew(buf []byte) xgb.Event {
+// BufferSwapCompleteEventNew constructs a BufferSwapCompleteEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func BufferSwapCompleteEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := BufferSwapCompleteEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.EventType = xgb.Get16(buf[b:])
+	v.EventType = EventType(order.Get16(buf[b:]))
 	b += 2
 
 	b += 2 // padding
 
-	v.Drawable = xproto.Drawable(xgb.Get32(buf[b:]))
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
 	b += 4
 
-	v.UstHi = xgb.Get32(buf[b:])
+	v.UstHi = order.Get32(buf[b:])
 	b += 4
 
-	v.UstLo = xgb.Get32(buf[b:])
+	v.UstLo = order.Get32(buf[b:])
 	b += 4
 
-	v.MscHi = xgb.Get32(buf[b:])
+	v.MscHi = order.Get32(buf[b:])
 	b += 4
 
-	v.MscLo = xgb.Get32(buf[b:])
+	v.MscLo = order.Get32(buf[b:])
 	b += 4
 
-	v.Sbc = xgb.Get32(buf[b:])
+	v.Sbc = order.Get32(buf[b:])
 	b += 4
 
 	return v
 }
 
-// Bytes writes a BufferSwapCompleteEvent value to a byte slice.
-func (v BufferSwapCompleteEvent) Bytes() []byte {
+// Bytes writes a BufferSwapCompleteEvent value to a byte slice, in the byte order 'order'.
+func (v BufferSwapCompleteEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -169,27 +200,27 @@ func (v BufferSwapCompleteEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put16(buf[b:], v.EventType)
+	order.Put16(buf[b:], uint16(v.EventType))
 	b += 2
 
 	b += 2 // padding
 
-	xgb.Put32(buf[b:], uint32(v.Drawable))
+	order.Put32(buf[b:], uint32(v.Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], v.UstHi)
+	order.Put32(buf[b:], v.UstHi)
 	b += 4
 
-	xgb.Put32(buf[b:], v.UstLo)
+	order.Put32(buf[b:], v.UstLo)
 	b += 4
 
-	xgb.Put32(buf[b:], v.MscHi)
+	order.Put32(buf[b:], v.MscHi)
 	b += 4
 
-	xgb.Put32(buf[b:], v.MscLo)
+	order.Put32(buf[b:], v.MscLo)
 	b += 4
 
-	xgb.Put32(buf[b:], v.Sbc)
+	order.Put32(buf[b:], v.Sbc)
 	b += 4
 
 	return buf
@@ -206,7 +237,7 @@ func (v BufferSwapCompleteEvent) SequenceId() uint16 {
 func (v BufferSwapCompleteEvent) String() string {
 	fieldVals := make([]string, 0, 9)
 	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
-	fieldVals = append(fieldVals, xgb.Sprintf("EventType: %d", v.EventType))
+	fieldVals = append(fieldVals, xgb.Sprintf("EventType: %s", v.EventType))
 	fieldVals = append(fieldVals, xgb.Sprintf("Drawable: %d", v.Drawable))
 	fieldVals = append(fieldVals, xgb.Sprintf("UstHi: %d", v.UstHi))
 	fieldVals = append(fieldVals, xgb.Sprintf("UstLo: %d", v.UstLo))
@@ -221,7 +252,7 @@ func init() {
 }
 
 type DRI2Buffer struct {
-	Attachment uint32
+	Attachment AttachmentBuffer
 	Name       uint32
 	Pitch      uint32
 	Cpp        uint32
@@ -229,83 +260,111 @@ type DRI2Buffer struct {
 }
 
 // DRI2BufferRead reads a byte slice into a DRI2Buffer value.
-func DRI2BufferRead(buf []byte, v *DRI2Buffer) int {
+func DRI2BufferRead(buf []byte, order xgb.ByteOrder, v *DRI2Buffer) int {
 	b := 0
 
-	v.Attachment = xgb.Get32(buf[b:])
+	v.Attachment = AttachmentBuffer(order.Get32(buf[b:]))
 	b += 4
 
-	v.Name = xgb.Get32(buf[b:])
+	v.Name = order.Get32(buf[b:])
 	b += 4
 
-	v.Pitch = xgb.Get32(buf[b:])
+	v.Pitch = order.Get32(buf[b:])
 	b += 4
 
-	v.Cpp = xgb.Get32(buf[b:])
+	v.Cpp = order.Get32(buf[b:])
 	b += 4
 
-	v.Flags = xgb.Get32(buf[b:])
+	v.Flags = order.Get32(buf[b:])
 	b += 4
 
 	return b
 }
 
 // DRI2BufferReadList reads a byte slice into a list of DRI2Buffer values.
-func DRI2BufferReadList(buf []byte, dest []DRI2Buffer) int {
+func DRI2BufferReadList(buf []byte, order xgb.ByteOrder, dest []DRI2Buffer) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = DRI2Buffer{}
-		b += DRI2BufferRead(buf[b:], &dest[i])
+		b += DRI2BufferRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a DRI2Buffer value to a byte slice.
-func (v DRI2Buffer) Bytes() []byte {
+// Bytes writes a DRI2Buffer value to a byte slice, in the byte order 'order'.
+func (v DRI2Buffer) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 20)
 	b := 0
 
-	xgb.Put32(buf[b:], v.Attachment)
+	order.Put32(buf[b:], uint32(v.Attachment))
 	b += 4
 
-	xgb.Put32(buf[b:], v.Name)
+	order.Put32(buf[b:], v.Name)
 	b += 4
 
-	xgb.Put32(buf[b:], v.Pitch)
+	order.Put32(buf[b:], v.Pitch)
 	b += 4
 
-	xgb.Put32(buf[b:], v.Cpp)
+	order.Put32(buf[b:], v.Cpp)
 	b += 4
 
-	xgb.Put32(buf[b:], v.Flags)
+	order.Put32(buf[b:], v.Flags)
 	b += 4
 
 	return buf[:b]
 }
 
 // DRI2BufferListBytes writes a list of DRI2Buffer values to a byte slice.
-func DRI2BufferListBytes(buf []byte, list []DRI2Buffer) int {
+func DRI2BufferListBytes(buf []byte, order xgb.ByteOrder, list []DRI2Buffer) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 	return xgb.Pad(b)
 }
 
+type DriverType uint32
+
 const (
-	DriverTypeDri   = 0
-	DriverTypeVdpau = 1
+	DriverTypeDri   DriverType = 0
+	DriverTypeVdpau DriverType = 1
 )
 
+// String returns the name of the DriverType item with this value.
+func (v DriverType) String() string {
+	switch v {
+	case DriverTypeDri:
+		return "Dri"
+	case DriverTypeVdpau:
+		return "Vdpau"
+	}
+	return xgb.Sprintf("DriverType(%d)", uint32(v))
+}
+
+type EventType uint16
+
 const (
-	EventTypeExchangeComplete = 1
-	EventTypeBlitComplete     = 2
-	EventTypeFlipComplete     = 3
+	EventTypeExchangeComplete EventType = 1
+	EventTypeBlitComplete     EventType = 2
+	EventTypeFlipComplete     EventType = 3
 )
 
+// String returns the name of the EventType item with this value.
+func (v EventType) String() string {
+	switch v {
+	case EventTypeExchangeComplete:
+		return "ExchangeComplete"
+	case EventTypeBlitComplete:
+		return "BlitComplete"
+	case EventTypeFlipComplete:
+		return "FlipComplete"
+	}
+	return xgb.Sprintf("EventType(%d)", uint16(v))
+}
+
 // InvalidateBuffers is the event number for a InvalidateBuffersEvent.
 const InvalidateBuffers = 1
 
@@ -315,24 +374,24 @@ type InvalidateBuffersEvent struct {
 	Drawable xproto.Drawable
 }
 
-// InvalidateBuffersEventNew constructs a InvalidateBuffersEvent value that implements xgb.Event from a byte slice.
-func InvalidateBuffersEventNew(buf []byte) xgb.Event {
+// InvalidateBuffersEventNew constructs a InvalidateBuffersEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func InvalidateBuffersEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := InvalidateBuffersEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Drawable = xproto.Drawable(xgb.Get32(buf[b:]))
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
 	b += 4
 
 	return v
 }
 
-// Bytes writes a InvalidateBuffersEvent value to a byte slice.
-func (v InvalidateBuffersEvent) Bytes() []byte {
+// Bytes writes a InvalidateBuffersEvent value to a byte slice, in the byte order 'order'.
+func (v InvalidateBuffersEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -344,7 +403,7 @@ func (v InvalidateBuffersEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Drawable))
+	order.Put32(buf[b:], uint32(v.Drawable))
 	b += 4
 
 	return buf
@@ -393,6 +452,8 @@ func init() {
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // AuthenticateCookie is a cookie used only for Authenticate requests.
 type AuthenticateCookie struct {
 	*xgb.Cookie
@@ -441,23 +502,35 @@ func (cook AuthenticateCookie) Reply() (*AuthenticateReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return authenticateReply(buf), nil
+	return authenticateReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a Authenticate request arrives.
+func (cook AuthenticateCookie) ReplyContext(ctx context.Context) (*AuthenticateReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return authenticateReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// authenticateReply reads a byte slice into a AuthenticateReply value.
-func authenticateReply(buf []byte) *AuthenticateReply {
+// authenticateReply reads a byte slice in the byte order 'order' into a AuthenticateReply value.
+func authenticateReply(buf []byte, order xgb.ByteOrder) *AuthenticateReply {
 	v := new(AuthenticateReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Authenticated = xgb.Get32(buf[b:])
+	v.Authenticated = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -466,9 +539,18 @@ func authenticateReply(buf []byte) *AuthenticateReply {
 // Write request to wire for Authenticate
 // authenticateRequest writes a Authenticate request to a byte slice.
 func authenticateRequest(c *xgb.Conn, Window xproto.Window, Magic uint32) []byte {
+	return AppendAuthenticateRequest(nil, c, Window, Magic)
+}
+
+// AppendAuthenticateRequest appends a Authenticate request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendAuthenticateRequest(buf []byte, c *xgb.Conn, Window xproto.Window, Magic uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DRI2"]
@@ -478,18 +560,50 @@ func authenticateRequest(c *xgb.Conn, Window xproto.Window, Magic uint32) []byte
 	buf[b] = 2 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put32(buf[b:], Magic)
+	order.Put32(buf[b:], Magic)
 	b += 4
 
 	return buf
 }
 
+// AuthenticateRequest represents the data of a Authenticate request, as it is read when tracing a connection.
+type AuthenticateRequest struct {
+	Window xproto.Window
+	Magic  uint32
+}
+
+// authenticateRequestRead reads a byte slice in the byte order 'order' into a AuthenticateRequest value.
+func authenticateRequestRead(buf []byte, order xgb.ByteOrder) *AuthenticateRequest {
+	v := new(AuthenticateRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Magic = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI2"][2] = xgb.RequestInfo{
+		Name: "Authenticate",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return authenticateRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return authenticateReply(buf, order)
+		},
+	}
+}
+
 // ConnectCookie is a cookie used only for Connect requests.
 type ConnectCookie struct {
 	*xgb.Cookie
@@ -497,7 +611,7 @@ type ConnectCookie struct {
 
 // Connect sends a checked request.
 // If an error occurs, it will be returned with the reply by calling ConnectCookie.Reply()
-func Connect(c *xgb.Conn, Window xproto.Window, DriverType uint32) ConnectCookie {
+func Connect(c *xgb.Conn, Window xproto.Window, DriverType DriverType) ConnectCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["DRI2"]; !ok {
@@ -510,7 +624,7 @@ func Connect(c *xgb.Conn, Window xproto.Window, DriverType uint32) ConnectCookie
 
 // ConnectUnchecked sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func ConnectUnchecked(c *xgb.Conn, Window xproto.Window, DriverType uint32) ConnectCookie {
+func ConnectUnchecked(c *xgb.Conn, Window xproto.Window, DriverType DriverType) ConnectCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["DRI2"]; !ok {
@@ -543,26 +657,38 @@ func (cook ConnectCookie) Reply() (*ConnectReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return connectReply(buf), nil
+	return connectReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// connectReply reads a byte slice into a ConnectReply value.
-func connectReply(buf []byte) *ConnectReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a Connect request arrives.
+func (cook ConnectCookie) ReplyContext(ctx context.Context) (*ConnectReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return connectReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// connectReply reads a byte slice in the byte order 'order' into a ConnectReply value.
+func connectReply(buf []byte, order xgb.ByteOrder) *ConnectReply {
 	v := new(ConnectReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.DriverNameLength = xgb.Get32(buf[b:])
+	v.DriverNameLength = order.Get32(buf[b:])
 	b += 4
 
-	v.DeviceNameLength = xgb.Get32(buf[b:])
+	v.DeviceNameLength = order.Get32(buf[b:])
 	b += 4
 
 	b += 16 // padding
@@ -590,10 +716,19 @@ func connectReply(buf []byte) *ConnectReply {
 
 // Write request to wire for Connect
 // connectRequest writes a Connect request to a byte slice.
-func connectRequest(c *xgb.Conn, Window xproto.Window, DriverType uint32) []byte {
+func connectRequest(c *xgb.Conn, Window xproto.Window, DriverType DriverType) []byte {
+	return AppendConnectRequest(nil, c, Window, DriverType)
+}
+
+// AppendConnectRequest appends a Connect request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendConnectRequest(buf []byte, c *xgb.Conn, Window xproto.Window, DriverType DriverType) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DRI2"]
@@ -603,18 +738,50 @@ func connectRequest(c *xgb.Conn, Window xproto.Window, DriverType uint32) []byte
 	buf[b] = 1 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put32(buf[b:], DriverType)
+	order.Put32(buf[b:], uint32(DriverType))
 	b += 4
 
 	return buf
 }
 
+// ConnectRequest represents the data of a Connect request, as it is read when tracing a connection.
+type ConnectRequest struct {
+	Window     xproto.Window
+	DriverType DriverType
+}
+
+// connectRequestRead reads a byte slice in the byte order 'order' into a ConnectRequest value.
+func connectRequestRead(buf []byte, order xgb.ByteOrder) *ConnectRequest {
+	v := new(ConnectRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.DriverType = DriverType(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI2"][1] = xgb.RequestInfo{
+		Name: "Connect",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return connectRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return connectReply(buf, order)
+		},
+	}
+}
+
 // CopyRegionCookie is a cookie used only for CopyRegion requests.
 type CopyRegionCookie struct {
 	*xgb.Cookie
@@ -662,20 +829,32 @@ func (cook CopyRegionCookie) Reply() (*CopyRegionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return copyRegionReply(buf), nil
+	return copyRegionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// copyRegionReply reads a byte slice into a CopyRegionReply value.
-func copyRegionReply(buf []byte) *CopyRegionReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a CopyRegion request arrives.
+func (cook CopyRegionCookie) ReplyContext(ctx context.Context) (*CopyRegionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return copyRegionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// copyRegionReply reads a byte slice in the byte order 'order' into a CopyRegionReply value.
+func copyRegionReply(buf []byte, order xgb.ByteOrder) *CopyRegionReply {
 	v := new(CopyRegionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	return v
@@ -684,9 +863,18 @@ func copyRegionReply(buf []byte) *CopyRegionReply {
 // Write request to wire for CopyRegion
 // copyRegionRequest writes a CopyRegion request to a byte slice.
 func copyRegionRequest(c *xgb.Conn, Drawable xproto.Drawable, Region uint32, Dest uint32, Src uint32) []byte {
+	return AppendCopyRegionRequest(nil, c, Drawable, Region, Dest, Src)
+}
+
+// AppendCopyRegionRequest appends a CopyRegion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCopyRegionRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable, Region uint32, Dest uint32, Src uint32) []byte {
 	size := 20
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DRI2"]
@@ -696,24 +884,64 @@ func copyRegionRequest(c *xgb.Conn, Drawable xproto.Drawable, Region uint32, Des
 	buf[b] = 6 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], Region)
+	order.Put32(buf[b:], Region)
 	b += 4
 
-	xgb.Put32(buf[b:], Dest)
+	order.Put32(buf[b:], Dest)
 	b += 4
 
-	xgb.Put32(buf[b:], Src)
+	order.Put32(buf[b:], Src)
 	b += 4
 
 	return buf
 }
 
+// CopyRegionRequest represents the data of a CopyRegion request, as it is read when tracing a connection.
+type CopyRegionRequest struct {
+	Drawable xproto.Drawable
+	Region   uint32
+	Dest     uint32
+	Src      uint32
+}
+
+// copyRegionRequestRead reads a byte slice in the byte order 'order' into a CopyRegionRequest value.
+func copyRegionRequestRead(buf []byte, order xgb.ByteOrder) *CopyRegionRequest {
+	v := new(CopyRegionRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Region = order.Get32(buf[b:])
+	b += 4
+
+	v.Dest = order.Get32(buf[b:])
+	b += 4
+
+	v.Src = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI2"][6] = xgb.RequestInfo{
+		Name: "CopyRegion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return copyRegionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return copyRegionReply(buf, order)
+		},
+	}
+}
+
 // CreateDrawableCookie is a cookie used only for CreateDrawable requests.
 type CreateDrawableCookie struct {
 	*xgb.Cookie
@@ -751,12 +979,26 @@ func (cook CreateDrawableCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateDrawableCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateDrawable
 // createDrawableRequest writes a CreateDrawable request to a byte slice.
 func createDrawableRequest(c *xgb.Conn, Drawable xproto.Drawable) []byte {
+	return AppendCreateDrawableRequest(nil, c, Drawable)
+}
+
+// AppendCreateDrawableRequest appends a CreateDrawable request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateDrawableRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DRI2"]
@@ -766,15 +1008,40 @@ func createDrawableRequest(c *xgb.Conn, Drawable xproto.Drawable) []byte {
 	buf[b] = 3 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
 	return buf
 }
 
+// CreateDrawableRequest represents the data of a CreateDrawable request, as it is read when tracing a connection.
+type CreateDrawableRequest struct {
+	Drawable xproto.Drawable
+}
+
+// createDrawableRequestRead reads a byte slice in the byte order 'order' into a CreateDrawableRequest value.
+func createDrawableRequestRead(buf []byte, order xgb.ByteOrder) *CreateDrawableRequest {
+	v := new(CreateDrawableRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI2"][3] = xgb.RequestInfo{
+		Name: "CreateDrawable",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createDrawableRequestRead(buf, order)
+		},
+	}
+}
+
 // DestroyDrawableCookie is a cookie used only for DestroyDrawable requests.
 type DestroyDrawableCookie struct {
 	*xgb.Cookie
@@ -812,12 +1079,26 @@ func (cook DestroyDrawableCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DestroyDrawableCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DestroyDrawable
 // destroyDrawableRequest writes a DestroyDrawable request to a byte slice.
 func destroyDrawableRequest(c *xgb.Conn, Drawable xproto.Drawable) []byte {
+	return AppendDestroyDrawableRequest(nil, c, Drawable)
+}
+
+// AppendDestroyDrawableRequest appends a DestroyDrawable request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDestroyDrawableRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DRI2"]
@@ -827,15 +1108,40 @@ func destroyDrawableRequest(c *xgb.Conn, Drawable xproto.Drawable) []byte {
 	buf[b] = 4 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
 	return buf
 }
 
+// DestroyDrawableRequest represents the data of a DestroyDrawable request, as it is read when tracing a connection.
+type DestroyDrawableRequest struct {
+	Drawable xproto.Drawable
+}
+
+// destroyDrawableRequestRead reads a byte slice in the byte order 'order' into a DestroyDrawableRequest value.
+func destroyDrawableRequestRead(buf []byte, order xgb.ByteOrder) *DestroyDrawableRequest {
+	v := new(DestroyDrawableRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI2"][4] = xgb.RequestInfo{
+		Name: "DestroyDrawable",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return destroyDrawableRequestRead(buf, order)
+		},
+	}
+}
+
 // GetBuffersCookie is a cookie used only for GetBuffers requests.
 type GetBuffersCookie struct {
 	*xgb.Cookie
@@ -888,35 +1194,47 @@ func (cook GetBuffersCookie) Reply() (*GetBuffersReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getBuffersReply(buf), nil
+	return getBuffersReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetBuffers request arrives.
+func (cook GetBuffersCookie) ReplyContext(ctx context.Context) (*GetBuffersReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getBuffersReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getBuffersReply reads a byte slice into a GetBuffersReply value.
-func getBuffersReply(buf []byte) *GetBuffersReply {
+// getBuffersReply reads a byte slice in the byte order 'order' into a GetBuffersReply value.
+func getBuffersReply(buf []byte, order xgb.ByteOrder) *GetBuffersReply {
 	v := new(GetBuffersReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Width = xgb.Get32(buf[b:])
+	v.Width = order.Get32(buf[b:])
 	b += 4
 
-	v.Height = xgb.Get32(buf[b:])
+	v.Height = order.Get32(buf[b:])
 	b += 4
 
-	v.Count = xgb.Get32(buf[b:])
+	v.Count = order.Get32(buf[b:])
 	b += 4
 
 	b += 12 // padding
 
 	v.Buffers = make([]DRI2Buffer, v.Count)
-	b += DRI2BufferReadList(buf[b:], v.Buffers)
+	b += DRI2BufferReadList(buf[b:], order, v.Buffers)
 
 	return v
 }
@@ -924,9 +1242,18 @@ func getBuffersReply(buf []byte) *GetBuffersReply {
 // Write request to wire for GetBuffers
 // getBuffersRequest writes a GetBuffers request to a byte slice.
 func getBuffersRequest(c *xgb.Conn, Drawable xproto.Drawable, Count uint32, Attachments []uint32) []byte {
+	return AppendGetBuffersRequest(nil, c, Drawable, Count, Attachments)
+}
+
+// AppendGetBuffersRequest appends a GetBuffers request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetBuffersRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable, Count uint32, Attachments []uint32) []byte {
 	size := xgb.Pad((12 + xgb.Pad((len(Attachments) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DRI2"]
@@ -936,23 +1263,62 @@ func getBuffersRequest(c *xgb.Conn, Drawable xproto.Drawable, Count uint32, Atta
 	buf[b] = 5 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], Count)
+	order.Put32(buf[b:], Count)
 	b += 4
 
 	for i := 0; i < int(len(Attachments)); i++ {
-		xgb.Put32(buf[b:], Attachments[i])
+		order.Put32(buf[b:], Attachments[i])
 		b += 4
 	}
 
 	return buf
 }
 
+// GetBuffersRequest represents the data of a GetBuffers request, as it is read when tracing a connection.
+type GetBuffersRequest struct {
+	Drawable    xproto.Drawable
+	Count       uint32
+	Attachments []uint32 // size: xgb.Pad((len(Attachments) * 4))
+}
+
+// getBuffersRequestRead reads a byte slice in the byte order 'order' into a GetBuffersRequest value.
+func getBuffersRequestRead(buf []byte, order xgb.ByteOrder) *GetBuffersRequest {
+	v := new(GetBuffersRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Count = order.Get32(buf[b:])
+	b += 4
+
+	v.Attachments = make([]uint32, (len(buf)-b)/4)
+	for i := 0; i < int((len(buf)-b)/4); i++ {
+		v.Attachments[i] = order.Get32(buf[b:])
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI2"][5] = xgb.RequestInfo{
+		Name: "GetBuffers",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getBuffersRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getBuffersReply(buf, order)
+		},
+	}
+}
+
 // GetBuffersWithFormatCookie is a cookie used only for GetBuffersWithFormat requests.
 type GetBuffersWithFormatCookie struct {
 	*xgb.Cookie
@@ -1005,35 +1371,47 @@ func (cook GetBuffersWithFormatCookie) Reply() (*GetBuffersWithFormatReply, erro
 	if buf == nil {
 		return nil, nil
 	}
-	return getBuffersWithFormatReply(buf), nil
+	return getBuffersWithFormatReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getBuffersWithFormatReply reads a byte slice into a GetBuffersWithFormatReply value.
-func getBuffersWithFormatReply(buf []byte) *GetBuffersWithFormatReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetBuffersWithFormat request arrives.
+func (cook GetBuffersWithFormatCookie) ReplyContext(ctx context.Context) (*GetBuffersWithFormatReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getBuffersWithFormatReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// getBuffersWithFormatReply reads a byte slice in the byte order 'order' into a GetBuffersWithFormatReply value.
+func getBuffersWithFormatReply(buf []byte, order xgb.ByteOrder) *GetBuffersWithFormatReply {
 	v := new(GetBuffersWithFormatReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Width = xgb.Get32(buf[b:])
+	v.Width = order.Get32(buf[b:])
 	b += 4
 
-	v.Height = xgb.Get32(buf[b:])
+	v.Height = order.Get32(buf[b:])
 	b += 4
 
-	v.Count = xgb.Get32(buf[b:])
+	v.Count = order.Get32(buf[b:])
 	b += 4
 
 	b += 12 // padding
 
 	v.Buffers = make([]DRI2Buffer, v.Count)
-	b += DRI2BufferReadList(buf[b:], v.Buffers)
+	b += DRI2BufferReadList(buf[b:], order, v.Buffers)
 
 	return v
 }
@@ -1041,9 +1419,18 @@ func getBuffersWithFormatReply(buf []byte) *GetBuffersWithFormatReply {
 // Write request to wire for GetBuffersWithFormat
 // getBuffersWithFormatRequest writes a GetBuffersWithFormat request to a byte slice.
 func getBuffersWithFormatRequest(c *xgb.Conn, Drawable xproto.Drawable, Count uint32, Attachments []AttachFormat) []byte {
+	return AppendGetBuffersWithFormatRequest(nil, c, Drawable, Count, Attachments)
+}
+
+// AppendGetBuffersWithFormatRequest appends a GetBuffersWithFormat request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetBuffersWithFormatRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable, Count uint32, Attachments []AttachFormat) []byte {
 	size := xgb.Pad((12 + xgb.Pad((len(Attachments) * 8))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DRI2"]
@@ -1053,20 +1440,56 @@ func getBuffersWithFormatRequest(c *xgb.Conn, Drawable xproto.Drawable, Count ui
 	buf[b] = 7 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], Count)
+	order.Put32(buf[b:], Count)
 	b += 4
 
-	b += AttachFormatListBytes(buf[b:], Attachments)
+	b += AttachFormatListBytes(buf[b:], order, Attachments)
 
 	return buf
 }
 
+// GetBuffersWithFormatRequest represents the data of a GetBuffersWithFormat request, as it is read when tracing a connection.
+type GetBuffersWithFormatRequest struct {
+	Drawable    xproto.Drawable
+	Count       uint32
+	Attachments []AttachFormat // size: xgb.Pad((len(Attachments) * 8))
+}
+
+// getBuffersWithFormatRequestRead reads a byte slice in the byte order 'order' into a GetBuffersWithFormatRequest value.
+func getBuffersWithFormatRequestRead(buf []byte, order xgb.ByteOrder) *GetBuffersWithFormatRequest {
+	v := new(GetBuffersWithFormatRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Count = order.Get32(buf[b:])
+	b += 4
+
+	v.Attachments = make([]AttachFormat, (len(buf)-b)/8)
+	b += AttachFormatReadList(buf[b:], order, v.Attachments)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI2"][7] = xgb.RequestInfo{
+		Name: "GetBuffersWithFormat",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getBuffersWithFormatRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getBuffersWithFormatReply(buf, order)
+		},
+	}
+}
+
 // GetMSCCookie is a cookie used only for GetMSC requests.
 type GetMSCCookie struct {
 	*xgb.Cookie
@@ -1120,38 +1543,50 @@ func (cook GetMSCCookie) Reply() (*GetMSCReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getMSCReply(buf), nil
+	return getMSCReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetMSC request arrives.
+func (cook GetMSCCookie) ReplyContext(ctx context.Context) (*GetMSCReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getMSCReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getMSCReply reads a byte slice into a GetMSCReply value.
-func getMSCReply(buf []byte) *GetMSCReply {
+// getMSCReply reads a byte slice in the byte order 'order' into a GetMSCReply value.
+func getMSCReply(buf []byte, order xgb.ByteOrder) *GetMSCReply {
 	v := new(GetMSCReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.UstHi = xgb.Get32(buf[b:])
+	v.UstHi = order.Get32(buf[b:])
 	b += 4
 
-	v.UstLo = xgb.Get32(buf[b:])
+	v.UstLo = order.Get32(buf[b:])
 	b += 4
 
-	v.MscHi = xgb.Get32(buf[b:])
+	v.MscHi = order.Get32(buf[b:])
 	b += 4
 
-	v.MscLo = xgb.Get32(buf[b:])
+	v.MscLo = order.Get32(buf[b:])
 	b += 4
 
-	v.SbcHi = xgb.Get32(buf[b:])
+	v.SbcHi = order.Get32(buf[b:])
 	b += 4
 
-	v.SbcLo = xgb.Get32(buf[b:])
+	v.SbcLo = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -1160,9 +1595,18 @@ func getMSCReply(buf []byte) *GetMSCReply {
 // Write request to wire for GetMSC
 // getMSCRequest writes a GetMSC request to a byte slice.
 func getMSCRequest(c *xgb.Conn, Drawable xproto.Drawable) []byte {
+	return AppendGetMSCRequest(nil, c, Drawable)
+}
+
+// AppendGetMSCRequest appends a GetMSC request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetMSCRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DRI2"]
@@ -1172,15 +1616,43 @@ func getMSCRequest(c *xgb.Conn, Drawable xproto.Drawable) []byte {
 	buf[b] = 9 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
 	return buf
 }
 
+// GetMSCRequest represents the data of a GetMSC request, as it is read when tracing a connection.
+type GetMSCRequest struct {
+	Drawable xproto.Drawable
+}
+
+// getMSCRequestRead reads a byte slice in the byte order 'order' into a GetMSCRequest value.
+func getMSCRequestRead(buf []byte, order xgb.ByteOrder) *GetMSCRequest {
+	v := new(GetMSCRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI2"][9] = xgb.RequestInfo{
+		Name: "GetMSC",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMSCRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMSCReply(buf, order)
+		},
+	}
+}
+
 // GetParamCookie is a cookie used only for GetParam requests.
 type GetParamCookie struct {
 	*xgb.Cookie
@@ -1230,11 +1702,23 @@ func (cook GetParamCookie) Reply() (*GetParamReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getParamReply(buf), nil
+	return getParamReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetParam request arrives.
+func (cook GetParamCookie) ReplyContext(ctx context.Context) (*GetParamReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getParamReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getParamReply reads a byte slice into a GetParamReply value.
-func getParamReply(buf []byte) *GetParamReply {
+// getParamReply reads a byte slice in the byte order 'order' into a GetParamReply value.
+func getParamReply(buf []byte, order xgb.ByteOrder) *GetParamReply {
 	v := new(GetParamReply)
 	b := 1 // skip reply determinant
 
@@ -1245,16 +1729,16 @@ func getParamReply(buf []byte) *GetParamReply {
 	}
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ValueHi = xgb.Get32(buf[b:])
+	v.ValueHi = order.Get32(buf[b:])
 	b += 4
 
-	v.ValueLo = xgb.Get32(buf[b:])
+	v.ValueLo = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -1263,9 +1747,18 @@ func getParamReply(buf []byte) *GetParamReply {
 // Write request to wire for GetParam
 // getParamRequest writes a GetParam request to a byte slice.
 func getParamRequest(c *xgb.Conn, Drawable xproto.Drawable, Param uint32) []byte {
+	return AppendGetParamRequest(nil, c, Drawable, Param)
+}
+
+// AppendGetParamRequest appends a GetParam request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetParamRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable, Param uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DRI2"]
@@ -1275,18 +1768,50 @@ func getParamRequest(c *xgb.Conn, Drawable xproto.Drawable, Param uint32) []byte
 	buf[b] = 13 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], Param)
+	order.Put32(buf[b:], Param)
 	b += 4
 
 	return buf
 }
 
+// GetParamRequest represents the data of a GetParam request, as it is read when tracing a connection.
+type GetParamRequest struct {
+	Drawable xproto.Drawable
+	Param    uint32
+}
+
+// getParamRequestRead reads a byte slice in the byte order 'order' into a GetParamRequest value.
+func getParamRequestRead(buf []byte, order xgb.ByteOrder) *GetParamRequest {
+	v := new(GetParamRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Param = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI2"][13] = xgb.RequestInfo{
+		Name: "GetParam",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getParamRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getParamReply(buf, order)
+		},
+	}
+}
+
 // QueryVersionCookie is a cookie used only for QueryVersion requests.
 type QueryVersionCookie struct {
 	*xgb.Cookie
@@ -1336,26 +1861,38 @@ func (cook QueryVersionCookie) Reply() (*QueryVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryVersionReply(buf), nil
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryVersionReply reads a byte slice into a QueryVersionReply value.
-func queryVersionReply(buf []byte) *QueryVersionReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryVersion request arrives.
+func (cook QueryVersionCookie) ReplyContext(ctx context.Context) (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryVersionReply reads a byte slice in the byte order 'order' into a QueryVersionReply value.
+func queryVersionReply(buf []byte, order xgb.ByteOrder) *QueryVersionReply {
 	v := new(QueryVersionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.MajorVersion = xgb.Get32(buf[b:])
+	v.MajorVersion = order.Get32(buf[b:])
 	b += 4
 
-	v.MinorVersion = xgb.Get32(buf[b:])
+	v.MinorVersion = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -1364,9 +1901,18 @@ func queryVersionReply(buf []byte) *QueryVersionReply {
 // Write request to wire for QueryVersion
 // queryVersionRequest writes a QueryVersion request to a byte slice.
 func queryVersionRequest(c *xgb.Conn, MajorVersion uint32, MinorVersion uint32) []byte {
+	return AppendQueryVersionRequest(nil, c, MajorVersion, MinorVersion)
+}
+
+// AppendQueryVersionRequest appends a QueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryVersionRequest(buf []byte, c *xgb.Conn, MajorVersion uint32, MinorVersion uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DRI2"]
@@ -1376,18 +1922,50 @@ func queryVersionRequest(c *xgb.Conn, MajorVersion uint32, MinorVersion uint32)
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], MajorVersion)
+	order.Put32(buf[b:], MajorVersion)
 	b += 4
 
-	xgb.Put32(buf[b:], MinorVersion)
+	order.Put32(buf[b:], MinorVersion)
 	b += 4
 
 	return buf
 }
 
+// QueryVersionRequest represents the data of a QueryVersion request, as it is read when tracing a connection.
+type QueryVersionRequest struct {
+	MajorVersion uint32
+	MinorVersion uint32
+}
+
+// queryVersionRequestRead reads a byte slice in the byte order 'order' into a QueryVersionRequest value.
+func queryVersionRequestRead(buf []byte, order xgb.ByteOrder) *QueryVersionRequest {
+	v := new(QueryVersionRequest)
+	b := 4 // skip request header
+
+	v.MajorVersion = order.Get32(buf[b:])
+	b += 4
+
+	v.MinorVersion = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI2"][0] = xgb.RequestInfo{
+		Name: "QueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionReply(buf, order)
+		},
+	}
+}
+
 // SwapBuffersCookie is a cookie used only for SwapBuffers requests.
 type SwapBuffersCookie struct {
 	*xgb.Cookie
@@ -1437,26 +2015,38 @@ func (cook SwapBuffersCookie) Reply() (*SwapBuffersReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return swapBuffersReply(buf), nil
+	return swapBuffersReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a SwapBuffers request arrives.
+func (cook SwapBuffersCookie) ReplyContext(ctx context.Context) (*SwapBuffersReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return swapBuffersReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// swapBuffersReply reads a byte slice into a SwapBuffersReply value.
-func swapBuffersReply(buf []byte) *SwapBuffersReply {
+// swapBuffersReply reads a byte slice in the byte order 'order' into a SwapBuffersReply value.
+func swapBuffersReply(buf []byte, order xgb.ByteOrder) *SwapBuffersReply {
 	v := new(SwapBuffersReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.SwapHi = xgb.Get32(buf[b:])
+	v.SwapHi = order.Get32(buf[b:])
 	b += 4
 
-	v.SwapLo = xgb.Get32(buf[b:])
+	v.SwapLo = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -1465,9 +2055,18 @@ func swapBuffersReply(buf []byte) *SwapBuffersReply {
 // Write request to wire for SwapBuffers
 // swapBuffersRequest writes a SwapBuffers request to a byte slice.
 func swapBuffersRequest(c *xgb.Conn, Drawable xproto.Drawable, TargetMscHi uint32, TargetMscLo uint32, DivisorHi uint32, DivisorLo uint32, RemainderHi uint32, RemainderLo uint32) []byte {
+	return AppendSwapBuffersRequest(nil, c, Drawable, TargetMscHi, TargetMscLo, DivisorHi, DivisorLo, RemainderHi, RemainderLo)
+}
+
+// AppendSwapBuffersRequest appends a SwapBuffers request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSwapBuffersRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable, TargetMscHi uint32, TargetMscLo uint32, DivisorHi uint32, DivisorLo uint32, RemainderHi uint32, RemainderLo uint32) []byte {
 	size := 32
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DRI2"]
@@ -1477,33 +2076,85 @@ func swapBuffersRequest(c *xgb.Conn, Drawable xproto.Drawable, TargetMscHi uint3
 	buf[b] = 8 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], TargetMscHi)
+	order.Put32(buf[b:], TargetMscHi)
 	b += 4
 
-	xgb.Put32(buf[b:], TargetMscLo)
+	order.Put32(buf[b:], TargetMscLo)
 	b += 4
 
-	xgb.Put32(buf[b:], DivisorHi)
+	order.Put32(buf[b:], DivisorHi)
 	b += 4
 
-	xgb.Put32(buf[b:], DivisorLo)
+	order.Put32(buf[b:], DivisorLo)
 	b += 4
 
-	xgb.Put32(buf[b:], RemainderHi)
+	order.Put32(buf[b:], RemainderHi)
 	b += 4
 
-	xgb.Put32(buf[b:], RemainderLo)
+	order.Put32(buf[b:], RemainderLo)
 	b += 4
 
 	return buf
 }
 
+// SwapBuffersRequest represents the data of a SwapBuffers request, as it is read when tracing a connection.
+type SwapBuffersRequest struct {
+	Drawable    xproto.Drawable
+	TargetMscHi uint32
+	TargetMscLo uint32
+	DivisorHi   uint32
+	DivisorLo   uint32
+	RemainderHi uint32
+	RemainderLo uint32
+}
+
+// swapBuffersRequestRead reads a byte slice in the byte order 'order' into a SwapBuffersRequest value.
+func swapBuffersRequestRead(buf []byte, order xgb.ByteOrder) *SwapBuffersRequest {
+	v := new(SwapBuffersRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.TargetMscHi = order.Get32(buf[b:])
+	b += 4
+
+	v.TargetMscLo = order.Get32(buf[b:])
+	b += 4
+
+	v.DivisorHi = order.Get32(buf[b:])
+	b += 4
+
+	v.DivisorLo = order.Get32(buf[b:])
+	b += 4
+
+	v.RemainderHi = order.Get32(buf[b:])
+	b += 4
+
+	v.RemainderLo = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI2"][8] = xgb.RequestInfo{
+		Name: "SwapBuffers",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return swapBuffersRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return swapBuffersReply(buf, order)
+		},
+	}
+}
+
 // SwapIntervalCookie is a cookie used only for SwapInterval requests.
 type SwapIntervalCookie struct {
 	*xgb.Cookie
@@ -1541,12 +2192,26 @@ func (cook SwapIntervalCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SwapIntervalCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SwapInterval
 // swapIntervalRequest writes a SwapInterval request to a byte slice.
 func swapIntervalRequest(c *xgb.Conn, Drawable xproto.Drawable, Interval uint32) []byte {
+	return AppendSwapIntervalRequest(nil, c, Drawable, Interval)
+}
+
+// AppendSwapIntervalRequest appends a SwapInterval request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSwapIntervalRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable, Interval uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DRI2"]
@@ -1556,18 +2221,47 @@ func swapIntervalRequest(c *xgb.Conn, Drawable xproto.Drawable, Interval uint32)
 	buf[b] = 12 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], Interval)
+	order.Put32(buf[b:], Interval)
 	b += 4
 
 	return buf
 }
 
+// SwapIntervalRequest represents the data of a SwapInterval request, as it is read when tracing a connection.
+type SwapIntervalRequest struct {
+	Drawable xproto.Drawable
+	Interval uint32
+}
+
+// swapIntervalRequestRead reads a byte slice in the byte order 'order' into a SwapIntervalRequest value.
+func swapIntervalRequestRead(buf []byte, order xgb.ByteOrder) *SwapIntervalRequest {
+	v := new(SwapIntervalRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Interval = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI2"][12] = xgb.RequestInfo{
+		Name: "SwapInterval",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return swapIntervalRequestRead(buf, order)
+		},
+	}
+}
+
 // WaitMSCCookie is a cookie used only for WaitMSC requests.
 type WaitMSCCookie struct {
 	*xgb.Cookie
@@ -1621,38 +2315,50 @@ func (cook WaitMSCCookie) Reply() (*WaitMSCReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return waitMSCReply(buf), nil
+	return waitMSCReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a WaitMSC request arrives.
+func (cook WaitMSCCookie) ReplyContext(ctx context.Context) (*WaitMSCReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return waitMSCReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// waitMSCReply reads a byte slice into a WaitMSCReply value.
-func waitMSCReply(buf []byte) *WaitMSCReply {
+// waitMSCReply reads a byte slice in the byte order 'order' into a WaitMSCReply value.
+func waitMSCReply(buf []byte, order xgb.ByteOrder) *WaitMSCReply {
 	v := new(WaitMSCReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.UstHi = xgb.Get32(buf[b:])
+	v.UstHi = order.Get32(buf[b:])
 	b += 4
 
-	v.UstLo = xgb.Get32(buf[b:])
+	v.UstLo = order.Get32(buf[b:])
 	b += 4
 
-	v.MscHi = xgb.Get32(buf[b:])
+	v.MscHi = order.Get32(buf[b:])
 	b += 4
 
-	v.MscLo = xgb.Get32(buf[b:])
+	v.MscLo = order.Get32(buf[b:])
 	b += 4
 
-	v.SbcHi = xgb.Get32(buf[b:])
+	v.SbcHi = order.Get32(buf[b:])
 	b += 4
 
-	v.SbcLo = xgb.Get32(buf[b:])
+	v.SbcLo = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -1661,9 +2367,18 @@ func waitMSCReply(buf []byte) *WaitMSCReply {
 // Write request to wire for WaitMSC
 // waitMSCRequest writes a WaitMSC request to a byte slice.
 func waitMSCRequest(c *xgb.Conn, Drawable xproto.Drawable, TargetMscHi uint32, TargetMscLo uint32, DivisorHi uint32, DivisorLo uint32, RemainderHi uint32, RemainderLo uint32) []byte {
+	return AppendWaitMSCRequest(nil, c, Drawable, TargetMscHi, TargetMscLo, DivisorHi, DivisorLo, RemainderHi, RemainderLo)
+}
+
+// AppendWaitMSCRequest appends a WaitMSC request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendWaitMSCRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable, TargetMscHi uint32, TargetMscLo uint32, DivisorHi uint32, DivisorLo uint32, RemainderHi uint32, RemainderLo uint32) []byte {
 	size := 32
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DRI2"]
@@ -1673,33 +2388,85 @@ func waitMSCRequest(c *xgb.Conn, Drawable xproto.Drawable, TargetMscHi uint32, T
 	buf[b] = 10 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], TargetMscHi)
+	order.Put32(buf[b:], TargetMscHi)
 	b += 4
 
-	xgb.Put32(buf[b:], TargetMscLo)
+	order.Put32(buf[b:], TargetMscLo)
 	b += 4
 
-	xgb.Put32(buf[b:], DivisorHi)
+	order.Put32(buf[b:], DivisorHi)
 	b += 4
 
-	xgb.Put32(buf[b:], DivisorLo)
+	order.Put32(buf[b:], DivisorLo)
 	b += 4
 
-	xgb.Put32(buf[b:], RemainderHi)
+	order.Put32(buf[b:], RemainderHi)
 	b += 4
 
-	xgb.Put32(buf[b:], RemainderLo)
+	order.Put32(buf[b:], RemainderLo)
 	b += 4
 
 	return buf
 }
 
+// WaitMSCRequest represents the data of a WaitMSC request, as it is read when tracing a connection.
+type WaitMSCRequest struct {
+	Drawable    xproto.Drawable
+	TargetMscHi uint32
+	TargetMscLo uint32
+	DivisorHi   uint32
+	DivisorLo   uint32
+	RemainderHi uint32
+	RemainderLo uint32
+}
+
+// waitMSCRequestRead reads a byte slice in the byte order 'order' into a WaitMSCRequest value.
+func waitMSCRequestRead(buf []byte, order xgb.ByteOrder) *WaitMSCRequest {
+	v := new(WaitMSCRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.TargetMscHi = order.Get32(buf[b:])
+	b += 4
+
+	v.TargetMscLo = order.Get32(buf[b:])
+	b += 4
+
+	v.DivisorHi = order.Get32(buf[b:])
+	b += 4
+
+	v.DivisorLo = order.Get32(buf[b:])
+	b += 4
+
+	v.RemainderHi = order.Get32(buf[b:])
+	b += 4
+
+	v.RemainderLo = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI2"][10] = xgb.RequestInfo{
+		Name: "WaitMSC",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return waitMSCRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return waitMSCReply(buf, order)
+		},
+	}
+}
+
 // WaitSBCCookie is a cookie used only for WaitSBC requests.
 type WaitSBCCookie struct {
 	*xgb.Cookie
@@ -1753,38 +2520,50 @@ func (cook WaitSBCCookie) Reply() (*WaitSBCReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return waitSBCReply(buf), nil
+	return waitSBCReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// waitSBCReply reads a byte slice into a WaitSBCReply value.
-func waitSBCReply(buf []byte) *WaitSBCReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a WaitSBC request arrives.
+func (cook WaitSBCCookie) ReplyContext(ctx context.Context) (*WaitSBCReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return waitSBCReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// waitSBCReply reads a byte slice in the byte order 'order' into a WaitSBCReply value.
+func waitSBCReply(buf []byte, order xgb.ByteOrder) *WaitSBCReply {
 	v := new(WaitSBCReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.UstHi = xgb.Get32(buf[b:])
+	v.UstHi = order.Get32(buf[b:])
 	b += 4
 
-	v.UstLo = xgb.Get32(buf[b:])
+	v.UstLo = order.Get32(buf[b:])
 	b += 4
 
-	v.MscHi = xgb.Get32(buf[b:])
+	v.MscHi = order.Get32(buf[b:])
 	b += 4
 
-	v.MscLo = xgb.Get32(buf[b:])
+	v.MscLo = order.Get32(buf[b:])
 	b += 4
 
-	v.SbcHi = xgb.Get32(buf[b:])
+	v.SbcHi = order.Get32(buf[b:])
 	b += 4
 
-	v.SbcLo = xgb.Get32(buf[b:])
+	v.SbcLo = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -1793,9 +2572,18 @@ func waitSBCReply(buf []byte) *WaitSBCReply {
 // Write request to wire for WaitSBC
 // waitSBCRequest writes a WaitSBC request to a byte slice.
 func waitSBCRequest(c *xgb.Conn, Drawable xproto.Drawable, TargetSbcHi uint32, TargetSbcLo uint32) []byte {
+	return AppendWaitSBCRequest(nil, c, Drawable, TargetSbcHi, TargetSbcLo)
+}
+
+// AppendWaitSBCRequest appends a WaitSBC request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendWaitSBCRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable, TargetSbcHi uint32, TargetSbcLo uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["DRI2"]
@@ -1805,17 +2593,53 @@ func waitSBCRequest(c *xgb.Conn, Drawable xproto.Drawable, TargetSbcHi uint32, T
 	buf[b] = 11 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], TargetSbcHi)
+	order.Put32(buf[b:], TargetSbcHi)
 	b += 4
 
-	xgb.Put32(buf[b:], TargetSbcLo)
+	order.Put32(buf[b:], TargetSbcLo)
 	b += 4
 
 	return buf
 }
+
+// WaitSBCRequest represents the data of a WaitSBC request, as it is read when tracing a connection.
+type WaitSBCRequest struct {
+	Drawable    xproto.Drawable
+	TargetSbcHi uint32
+	TargetSbcLo uint32
+}
+
+// waitSBCRequestRead reads a byte slice in the byte order 'order' into a WaitSBCRequest value.
+func waitSBCRequestRead(buf []byte, order xgb.ByteOrder) *WaitSBCRequest {
+	v := new(WaitSBCRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.TargetSbcHi = order.Get32(buf[b:])
+	b += 4
+
+	v.TargetSbcLo = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI2"][11] = xgb.RequestInfo{
+		Name: "WaitSBC",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return waitSBCRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return waitSBCReply(buf, order)
+		},
+	}
+}
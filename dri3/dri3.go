@@ -0,0 +1,1598 @@
+// Package dri3 is the X client API for the DRI3 extension.
+package dri3
+
+// This file is automatically generated from dri3.xml. Edit at your peril!
+
+import (
+	"context"
+
+	"github.com/BurntSushi/xgb"
+
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// Init must be called before using the DRI3 extension.
+func Init(c *xgb.Conn) error {
+	reply, err := xproto.QueryExtension(c, 4, "DRI3").Reply()
+	switch {
+	case err != nil:
+		return err
+	case !reply.Present:
+		return xgb.Errorf("No extension named DRI3 could be found on on the server.")
+	}
+
+	c.ExtLock.Lock()
+	c.Extensions["DRI3"] = reply.MajorOpcode
+	c.ExtLock.Unlock()
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["DRI3"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["DRI3"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "DRI3", xgb.ExtRequestInfos["DRI3"])
+	return nil
+}
+
+func init() {
+	xgb.NewExtEventFuncs["DRI3"] = make(map[int]xgb.NewEventFun)
+	xgb.NewExtErrorFuncs["DRI3"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["DRI3"] = make(map[int]xgb.RequestInfo)
+}
+
+// Skipping definition for base type 'Bool'
+
+// Skipping definition for base type 'Byte'
+
+// Skipping definition for base type 'Card8'
+
+// Skipping definition for base type 'Char'
+
+// Skipping definition for base type 'Void'
+
+// Skipping definition for base type 'Double'
+
+// Skipping definition for base type 'Float'
+
+// Skipping definition for base type 'Int16'
+
+// Skipping definition for base type 'Int32'
+
+// Skipping definition for base type 'Int8'
+
+// Skipping definition for base type 'Card16'
+
+// Skipping definition for base type 'Card32'
+
+// Skipping definition for base type 'Card64'
+
+// BufferFromPixmapCookie is a cookie used only for BufferFromPixmap requests.
+type BufferFromPixmapCookie struct {
+	*xgb.Cookie
+}
+
+// BufferFromPixmap sends a checked request.
+// If an error occurs, it will be returned with the reply by calling BufferFromPixmapCookie.Reply()
+func BufferFromPixmap(c *xgb.Conn, Pixmap xproto.Pixmap) BufferFromPixmapCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["DRI3"]; !ok {
+		panic("Cannot issue request 'BufferFromPixmap' using the uninitialized extension 'DRI3'. dri3.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, true)
+	c.NewRequest(bufferFromPixmapRequest(c, Pixmap), cookie)
+	return BufferFromPixmapCookie{cookie}
+}
+
+// BufferFromPixmapUnchecked sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func BufferFromPixmapUnchecked(c *xgb.Conn, Pixmap xproto.Pixmap) BufferFromPixmapCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["DRI3"]; !ok {
+		panic("Cannot issue request 'BufferFromPixmap' using the uninitialized extension 'DRI3'. dri3.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, true)
+	c.NewRequest(bufferFromPixmapRequest(c, Pixmap), cookie)
+	return BufferFromPixmapCookie{cookie}
+}
+
+// BufferFromPixmapReply represents the data returned from a BufferFromPixmap request.
+type BufferFromPixmapReply struct {
+	Sequence uint16 // sequence number of the request for this reply
+	Length   uint32 // number of bytes in this reply
+	Nfd      byte
+	Size     uint32
+	Width    uint16
+	Height   uint16
+	Stride   uint16
+	Depth    byte
+	Bpp      byte
+	PixmapFd int // file descriptor
+	// padding: 12 bytes
+}
+
+// Reply blocks and returns the reply data for a BufferFromPixmap request.
+func (cook BufferFromPixmapCookie) Reply() (*BufferFromPixmapReply, error) {
+	buf, err := cook.Cookie.Reply()
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	fds := cook.Cookie.Fds()
+	if len(fds) != 1 {
+		xgb.CloseFds(fds)
+		return nil, xgb.Errorf("Expected 1 file descriptor(s) with the BufferFromPixmap reply, but got %d.", len(fds))
+	}
+	return bufferFromPixmapReply(buf, cook.Cookie.ByteOrder(), fds), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a BufferFromPixmap request arrives.
+func (cook BufferFromPixmapCookie) ReplyContext(ctx context.Context) (*BufferFromPixmapReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	fds := cook.Cookie.Fds()
+	if len(fds) != 1 {
+		xgb.CloseFds(fds)
+		return nil, xgb.Errorf("Expected 1 file descriptor(s) with the BufferFromPixmap reply, but got %d.", len(fds))
+	}
+	return bufferFromPixmapReply(buf, cook.Cookie.ByteOrder(), fds), nil
+}
+
+// bufferFromPixmapReply reads a byte slice in the byte order 'order' into a BufferFromPixmapReply value.
+func bufferFromPixmapReply(buf []byte, order xgb.ByteOrder, fds []int) *BufferFromPixmapReply {
+	v := new(BufferFromPixmapReply)
+	b := 1 // skip reply determinant
+
+	v.Nfd = buf[b]
+	b += 1
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	v.Length = order.Get32(buf[b:]) // 4-byte units
+	b += 4
+
+	v.Size = order.Get32(buf[b:])
+	b += 4
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	v.Stride = order.Get16(buf[b:])
+	b += 2
+
+	v.Depth = buf[b]
+	b += 1
+
+	v.Bpp = buf[b]
+	b += 1
+
+	v.PixmapFd = fds[0]
+
+	b += 12 // padding
+
+	return v
+}
+
+// Write request to wire for BufferFromPixmap
+// bufferFromPixmapRequest writes a BufferFromPixmap request to a byte slice.
+func bufferFromPixmapRequest(c *xgb.Conn, Pixmap xproto.Pixmap) []byte {
+	return AppendBufferFromPixmapRequest(nil, c, Pixmap)
+}
+
+// AppendBufferFromPixmapRequest appends a BufferFromPixmap request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendBufferFromPixmapRequest(buf []byte, c *xgb.Conn, Pixmap xproto.Pixmap) []byte {
+	size := 8
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["DRI3"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 3 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Pixmap))
+	b += 4
+
+	return buf
+}
+
+// BufferFromPixmapRequest represents the data of a BufferFromPixmap request, as it is read when tracing a connection.
+type BufferFromPixmapRequest struct {
+	Pixmap xproto.Pixmap
+}
+
+// bufferFromPixmapRequestRead reads a byte slice in the byte order 'order' into a BufferFromPixmapRequest value.
+func bufferFromPixmapRequestRead(buf []byte, order xgb.ByteOrder) *BufferFromPixmapRequest {
+	v := new(BufferFromPixmapRequest)
+	b := 4 // skip request header
+
+	v.Pixmap = xproto.Pixmap(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI3"][3] = xgb.RequestInfo{
+		Name: "BufferFromPixmap",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return bufferFromPixmapRequestRead(buf, order)
+		},
+	}
+}
+
+// BuffersFromPixmapCookie is a cookie used only for BuffersFromPixmap requests.
+type BuffersFromPixmapCookie struct {
+	*xgb.Cookie
+}
+
+// BuffersFromPixmap sends a checked request.
+// If an error occurs, it will be returned with the reply by calling BuffersFromPixmapCookie.Reply()
+func BuffersFromPixmap(c *xgb.Conn, Pixmap xproto.Pixmap) BuffersFromPixmapCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["DRI3"]; !ok {
+		panic("Cannot issue request 'BuffersFromPixmap' using the uninitialized extension 'DRI3'. dri3.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, true)
+	c.NewRequest(buffersFromPixmapRequest(c, Pixmap), cookie)
+	return BuffersFromPixmapCookie{cookie}
+}
+
+// BuffersFromPixmapUnchecked sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func BuffersFromPixmapUnchecked(c *xgb.Conn, Pixmap xproto.Pixmap) BuffersFromPixmapCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["DRI3"]; !ok {
+		panic("Cannot issue request 'BuffersFromPixmap' using the uninitialized extension 'DRI3'. dri3.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, true)
+	c.NewRequest(buffersFromPixmapRequest(c, Pixmap), cookie)
+	return BuffersFromPixmapCookie{cookie}
+}
+
+// BuffersFromPixmapReply represents the data returned from a BuffersFromPixmap request.
+type BuffersFromPixmapReply struct {
+	Sequence uint16 // sequence number of the request for this reply
+	Length   uint32 // number of bytes in this reply
+	Nfd      byte
+	Width    uint16
+	Height   uint16
+	// padding: 4 bytes
+	Modifier uint64
+	Depth    byte
+	Bpp      byte
+	// padding: 6 bytes
+	Strides []uint32 // size: xgb.Pad((int(Nfd) * 4))
+	// alignment gap to multiple of 4
+	Offsets []uint32 // size: xgb.Pad((int(Nfd) * 4))
+	Buffers []int    // file descriptors
+}
+
+// Reply blocks and returns the reply data for a BuffersFromPixmap request.
+func (cook BuffersFromPixmapCookie) Reply() (*BuffersFromPixmapReply, error) {
+	buf, err := cook.Cookie.Reply()
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	fds := cook.Cookie.Fds()
+	v := buffersFromPixmapReply(buf, cook.Cookie.ByteOrder(), fds)
+	if n := 0 + int(v.Nfd); len(fds) != n {
+		xgb.CloseFds(fds)
+		return nil, xgb.Errorf("Expected %d file descriptor(s) with the BuffersFromPixmap reply, but got %d.", n, len(fds))
+	}
+	return v, nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a BuffersFromPixmap request arrives.
+func (cook BuffersFromPixmapCookie) ReplyContext(ctx context.Context) (*BuffersFromPixmapReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	fds := cook.Cookie.Fds()
+	v := buffersFromPixmapReply(buf, cook.Cookie.ByteOrder(), fds)
+	if n := 0 + int(v.Nfd); len(fds) != n {
+		xgb.CloseFds(fds)
+		return nil, xgb.Errorf("Expected %d file descriptor(s) with the BuffersFromPixmap reply, but got %d.", n, len(fds))
+	}
+	return v, nil
+}
+
+// buffersFromPixmapReply reads a byte slice in the byte order 'order' into a BuffersFromPixmapReply value.
+func buffersFromPixmapReply(buf []byte, order xgb.ByteOrder, fds []int) *BuffersFromPixmapReply {
+	v := new(BuffersFromPixmapReply)
+	b := 1 // skip reply determinant
+
+	v.Nfd = buf[b]
+	b += 1
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	v.Length = order.Get32(buf[b:]) // 4-byte units
+	b += 4
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	b += 4 // padding
+
+	v.Modifier = order.Get64(buf[b:])
+	b += 8
+
+	v.Depth = buf[b]
+	b += 1
+
+	v.Bpp = buf[b]
+	b += 1
+
+	b += 6 // padding
+
+	v.Strides = make([]uint32, v.Nfd)
+	for i := 0; i < int(v.Nfd); i++ {
+		v.Strides[i] = order.Get32(buf[b:])
+		b += 4
+	}
+
+	b = (b + 3) & ^3 // alignment gap
+
+	v.Offsets = make([]uint32, v.Nfd)
+	for i := 0; i < int(v.Nfd); i++ {
+		v.Offsets[i] = order.Get32(buf[b:])
+		b += 4
+	}
+
+	{
+		n := int(v.Nfd)
+		if n > len(fds) {
+			n = len(fds)
+		}
+		v.Buffers = fds[:n]
+	}
+
+	return v
+}
+
+// Write request to wire for BuffersFromPixmap
+// buffersFromPixmapRequest writes a BuffersFromPixmap request to a byte slice.
+func buffersFromPixmapRequest(c *xgb.Conn, Pixmap xproto.Pixmap) []byte {
+	return AppendBuffersFromPixmapRequest(nil, c, Pixmap)
+}
+
+// AppendBuffersFromPixmapRequest appends a BuffersFromPixmap request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendBuffersFromPixmapRequest(buf []byte, c *xgb.Conn, Pixmap xproto.Pixmap) []byte {
+	size := 8
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["DRI3"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 8 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Pixmap))
+	b += 4
+
+	return buf
+}
+
+// BuffersFromPixmapRequest represents the data of a BuffersFromPixmap request, as it is read when tracing a connection.
+type BuffersFromPixmapRequest struct {
+	Pixmap xproto.Pixmap
+}
+
+// buffersFromPixmapRequestRead reads a byte slice in the byte order 'order' into a BuffersFromPixmapRequest value.
+func buffersFromPixmapRequestRead(buf []byte, order xgb.ByteOrder) *BuffersFromPixmapRequest {
+	v := new(BuffersFromPixmapRequest)
+	b := 4 // skip request header
+
+	v.Pixmap = xproto.Pixmap(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI3"][8] = xgb.RequestInfo{
+		Name: "BuffersFromPixmap",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return buffersFromPixmapRequestRead(buf, order)
+		},
+	}
+}
+
+// FDFromFenceCookie is a cookie used only for FDFromFence requests.
+type FDFromFenceCookie struct {
+	*xgb.Cookie
+}
+
+// FDFromFence sends a checked request.
+// If an error occurs, it will be returned with the reply by calling FDFromFenceCookie.Reply()
+func FDFromFence(c *xgb.Conn, Drawable xproto.Drawable, Fence uint32) FDFromFenceCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["DRI3"]; !ok {
+		panic("Cannot issue request 'FDFromFence' using the uninitialized extension 'DRI3'. dri3.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, true)
+	c.NewRequest(fDFromFenceRequest(c, Drawable, Fence), cookie)
+	return FDFromFenceCookie{cookie}
+}
+
+// FDFromFenceUnchecked sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func FDFromFenceUnchecked(c *xgb.Conn, Drawable xproto.Drawable, Fence uint32) FDFromFenceCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["DRI3"]; !ok {
+		panic("Cannot issue request 'FDFromFence' using the uninitialized extension 'DRI3'. dri3.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, true)
+	c.NewRequest(fDFromFenceRequest(c, Drawable, Fence), cookie)
+	return FDFromFenceCookie{cookie}
+}
+
+// FDFromFenceReply represents the data returned from a FDFromFence request.
+type FDFromFenceReply struct {
+	Sequence uint16 // sequence number of the request for this reply
+	Length   uint32 // number of bytes in this reply
+	Nfd      byte
+	FenceFd  int // file descriptor
+	// padding: 24 bytes
+}
+
+// Reply blocks and returns the reply data for a FDFromFence request.
+func (cook FDFromFenceCookie) Reply() (*FDFromFenceReply, error) {
+	buf, err := cook.Cookie.Reply()
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	fds := cook.Cookie.Fds()
+	if len(fds) != 1 {
+		xgb.CloseFds(fds)
+		return nil, xgb.Errorf("Expected 1 file descriptor(s) with the FDFromFence reply, but got %d.", len(fds))
+	}
+	return fDFromFenceReply(buf, cook.Cookie.ByteOrder(), fds), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a FDFromFence request arrives.
+func (cook FDFromFenceCookie) ReplyContext(ctx context.Context) (*FDFromFenceReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	fds := cook.Cookie.Fds()
+	if len(fds) != 1 {
+		xgb.CloseFds(fds)
+		return nil, xgb.Errorf("Expected 1 file descriptor(s) with the FDFromFence reply, but got %d.", len(fds))
+	}
+	return fDFromFenceReply(buf, cook.Cookie.ByteOrder(), fds), nil
+}
+
+// fDFromFenceReply reads a byte slice in the byte order 'order' into a FDFromFenceReply value.
+func fDFromFenceReply(buf []byte, order xgb.ByteOrder, fds []int) *FDFromFenceReply {
+	v := new(FDFromFenceReply)
+	b := 1 // skip reply determinant
+
+	v.Nfd = buf[b]
+	b += 1
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	v.Length = order.Get32(buf[b:]) // 4-byte units
+	b += 4
+
+	v.FenceFd = fds[0]
+
+	b += 24 // padding
+
+	return v
+}
+
+// Write request to wire for FDFromFence
+// fDFromFenceRequest writes a FDFromFence request to a byte slice.
+func fDFromFenceRequest(c *xgb.Conn, Drawable xproto.Drawable, Fence uint32) []byte {
+	return AppendFDFromFenceRequest(nil, c, Drawable, Fence)
+}
+
+// AppendFDFromFenceRequest appends a FDFromFence request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendFDFromFenceRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable, Fence uint32) []byte {
+	size := 12
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["DRI3"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 5 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Drawable))
+	b += 4
+
+	order.Put32(buf[b:], Fence)
+	b += 4
+
+	return buf
+}
+
+// FDFromFenceRequest represents the data of a FDFromFence request, as it is read when tracing a connection.
+type FDFromFenceRequest struct {
+	Drawable xproto.Drawable
+	Fence    uint32
+}
+
+// fDFromFenceRequestRead reads a byte slice in the byte order 'order' into a FDFromFenceRequest value.
+func fDFromFenceRequestRead(buf []byte, order xgb.ByteOrder) *FDFromFenceRequest {
+	v := new(FDFromFenceRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Fence = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI3"][5] = xgb.RequestInfo{
+		Name: "FDFromFence",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return fDFromFenceRequestRead(buf, order)
+		},
+	}
+}
+
+// FenceFromFDCookie is a cookie used only for FenceFromFD requests.
+type FenceFromFDCookie struct {
+	*xgb.Cookie
+}
+
+// FenceFromFD sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func FenceFromFD(c *xgb.Conn, Drawable xproto.Drawable, Fence uint32, InitiallyTriggered bool, FenceFd xgb.Fd) FenceFromFDCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["DRI3"]; !ok {
+		panic("Cannot issue request 'FenceFromFD' using the uninitialized extension 'DRI3'. dri3.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequestFds(fenceFromFDRequest(c, Drawable, Fence, InitiallyTriggered, FenceFd), []xgb.Fd{FenceFd}, cookie)
+	return FenceFromFDCookie{cookie}
+}
+
+// FenceFromFDChecked sends a checked request.
+// If an error occurs, it can be retrieved using FenceFromFDCookie.Check()
+func FenceFromFDChecked(c *xgb.Conn, Drawable xproto.Drawable, Fence uint32, InitiallyTriggered bool, FenceFd xgb.Fd) FenceFromFDCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["DRI3"]; !ok {
+		panic("Cannot issue request 'FenceFromFD' using the uninitialized extension 'DRI3'. dri3.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, false)
+	c.NewRequestFds(fenceFromFDRequest(c, Drawable, Fence, InitiallyTriggered, FenceFd), []xgb.Fd{FenceFd}, cookie)
+	return FenceFromFDCookie{cookie}
+}
+
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook FenceFromFDCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook FenceFromFDCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for FenceFromFD
+// fenceFromFDRequest writes a FenceFromFD request to a byte slice.
+func fenceFromFDRequest(c *xgb.Conn, Drawable xproto.Drawable, Fence uint32, InitiallyTriggered bool, FenceFd xgb.Fd) []byte {
+	return AppendFenceFromFDRequest(nil, c, Drawable, Fence, InitiallyTriggered, FenceFd)
+}
+
+// AppendFenceFromFDRequest appends a FenceFromFD request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// Since the request carries file descriptors, it must be sent with xgb.Conn.NewRequestFds.
+func AppendFenceFromFDRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable, Fence uint32, InitiallyTriggered bool, FenceFd xgb.Fd) []byte {
+	size := 16
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["DRI3"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 4 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Drawable))
+	b += 4
+
+	order.Put32(buf[b:], Fence)
+	b += 4
+
+	if InitiallyTriggered {
+		buf[b] = 1
+	} else {
+		buf[b] = 0
+	}
+	b += 1
+
+	b += 3 // padding
+
+	// file descriptor FenceFd is sent out of band
+
+	return buf
+}
+
+// FenceFromFDRequest represents the data of a FenceFromFD request, as it is read when tracing a connection.
+type FenceFromFDRequest struct {
+	Drawable           xproto.Drawable
+	Fence              uint32
+	InitiallyTriggered bool
+	// padding: 3 bytes
+}
+
+// fenceFromFDRequestRead reads a byte slice in the byte order 'order' into a FenceFromFDRequest value.
+func fenceFromFDRequestRead(buf []byte, order xgb.ByteOrder) *FenceFromFDRequest {
+	v := new(FenceFromFDRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Fence = order.Get32(buf[b:])
+	b += 4
+
+	if buf[b] == 1 {
+		v.InitiallyTriggered = true
+	} else {
+		v.InitiallyTriggered = false
+	}
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI3"][4] = xgb.RequestInfo{
+		Name: "FenceFromFD",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return fenceFromFDRequestRead(buf, order)
+		},
+	}
+}
+
+// GetSupportedModifiersCookie is a cookie used only for GetSupportedModifiers requests.
+type GetSupportedModifiersCookie struct {
+	*xgb.Cookie
+}
+
+// GetSupportedModifiers sends a checked request.
+// If an error occurs, it will be returned with the reply by calling GetSupportedModifiersCookie.Reply()
+func GetSupportedModifiers(c *xgb.Conn, Window uint32, Depth byte, Bpp byte) GetSupportedModifiersCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["DRI3"]; !ok {
+		panic("Cannot issue request 'GetSupportedModifiers' using the uninitialized extension 'DRI3'. dri3.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, true)
+	c.NewRequest(getSupportedModifiersRequest(c, Window, Depth, Bpp), cookie)
+	return GetSupportedModifiersCookie{cookie}
+}
+
+// GetSupportedModifiersUnchecked sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func GetSupportedModifiersUnchecked(c *xgb.Conn, Window uint32, Depth byte, Bpp byte) GetSupportedModifiersCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["DRI3"]; !ok {
+		panic("Cannot issue request 'GetSupportedModifiers' using the uninitialized extension 'DRI3'. dri3.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, true)
+	c.NewRequest(getSupportedModifiersRequest(c, Window, Depth, Bpp), cookie)
+	return GetSupportedModifiersCookie{cookie}
+}
+
+// GetSupportedModifiersReply represents the data returned from a GetSupportedModifiers request.
+type GetSupportedModifiersReply struct {
+	Sequence uint16 // sequence number of the request for this reply
+	Length   uint32 // number of bytes in this reply
+	// padding: 1 bytes
+	NumWindowModifiers uint32
+	NumScreenModifiers uint32
+	// padding: 16 bytes
+	WindowModifiers []uint64 // size: xgb.Pad((int(NumWindowModifiers) * 8))
+	// alignment gap to multiple of 4
+	ScreenModifiers []uint64 // size: xgb.Pad((int(NumScreenModifiers) * 8))
+}
+
+// Reply blocks and returns the reply data for a GetSupportedModifiers request.
+func (cook GetSupportedModifiersCookie) Reply() (*GetSupportedModifiersReply, error) {
+	buf, err := cook.Cookie.Reply()
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getSupportedModifiersReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetSupportedModifiers request arrives.
+func (cook GetSupportedModifiersCookie) ReplyContext(ctx context.Context) (*GetSupportedModifiersReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getSupportedModifiersReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// getSupportedModifiersReply reads a byte slice in the byte order 'order' into a GetSupportedModifiersReply value.
+func getSupportedModifiersReply(buf []byte, order xgb.ByteOrder) *GetSupportedModifiersReply {
+	v := new(GetSupportedModifiersReply)
+	b := 1 // skip reply determinant
+
+	b += 1 // padding
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	v.Length = order.Get32(buf[b:]) // 4-byte units
+	b += 4
+
+	v.NumWindowModifiers = order.Get32(buf[b:])
+	b += 4
+
+	v.NumScreenModifiers = order.Get32(buf[b:])
+	b += 4
+
+	b += 16 // padding
+
+	v.WindowModifiers = make([]uint64, v.NumWindowModifiers)
+	for i := 0; i < int(v.NumWindowModifiers); i++ {
+		v.WindowModifiers[i] = order.Get64(buf[b:])
+		b += 8
+	}
+
+	b = (b + 3) & ^3 // alignment gap
+
+	v.ScreenModifiers = make([]uint64, v.NumScreenModifiers)
+	for i := 0; i < int(v.NumScreenModifiers); i++ {
+		v.ScreenModifiers[i] = order.Get64(buf[b:])
+		b += 8
+	}
+
+	return v
+}
+
+// Write request to wire for GetSupportedModifiers
+// getSupportedModifiersRequest writes a GetSupportedModifiers request to a byte slice.
+func getSupportedModifiersRequest(c *xgb.Conn, Window uint32, Depth byte, Bpp byte) []byte {
+	return AppendGetSupportedModifiersRequest(nil, c, Window, Depth, Bpp)
+}
+
+// AppendGetSupportedModifiersRequest appends a GetSupportedModifiers request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetSupportedModifiersRequest(buf []byte, c *xgb.Conn, Window uint32, Depth byte, Bpp byte) []byte {
+	size := 12
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["DRI3"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 6 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], Window)
+	b += 4
+
+	buf[b] = Depth
+	b += 1
+
+	buf[b] = Bpp
+	b += 1
+
+	b += 2 // padding
+
+	return buf
+}
+
+// GetSupportedModifiersRequest represents the data of a GetSupportedModifiers request, as it is read when tracing a connection.
+type GetSupportedModifiersRequest struct {
+	Window uint32
+	Depth  byte
+	Bpp    byte
+	// padding: 2 bytes
+}
+
+// getSupportedModifiersRequestRead reads a byte slice in the byte order 'order' into a GetSupportedModifiersRequest value.
+func getSupportedModifiersRequestRead(buf []byte, order xgb.ByteOrder) *GetSupportedModifiersRequest {
+	v := new(GetSupportedModifiersRequest)
+	b := 4 // skip request header
+
+	v.Window = order.Get32(buf[b:])
+	b += 4
+
+	v.Depth = buf[b]
+	b += 1
+
+	v.Bpp = buf[b]
+	b += 1
+
+	b += 2 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI3"][6] = xgb.RequestInfo{
+		Name: "GetSupportedModifiers",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getSupportedModifiersRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getSupportedModifiersReply(buf, order)
+		},
+	}
+}
+
+// OpenCookie is a cookie used only for Open requests.
+type OpenCookie struct {
+	*xgb.Cookie
+}
+
+// Open sends a checked request.
+// If an error occurs, it will be returned with the reply by calling OpenCookie.Reply()
+func Open(c *xgb.Conn, Drawable xproto.Drawable, Provider uint32) OpenCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["DRI3"]; !ok {
+		panic("Cannot issue request 'Open' using the uninitialized extension 'DRI3'. dri3.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, true)
+	c.NewRequest(openRequest(c, Drawable, Provider), cookie)
+	return OpenCookie{cookie}
+}
+
+// OpenUnchecked sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func OpenUnchecked(c *xgb.Conn, Drawable xproto.Drawable, Provider uint32) OpenCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["DRI3"]; !ok {
+		panic("Cannot issue request 'Open' using the uninitialized extension 'DRI3'. dri3.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, true)
+	c.NewRequest(openRequest(c, Drawable, Provider), cookie)
+	return OpenCookie{cookie}
+}
+
+// OpenReply represents the data returned from a Open request.
+type OpenReply struct {
+	Sequence uint16 // sequence number of the request for this reply
+	Length   uint32 // number of bytes in this reply
+	Nfd      byte
+	DeviceFd int // file descriptor
+	// padding: 24 bytes
+}
+
+// Reply blocks and returns the reply data for a Open request.
+func (cook OpenCookie) Reply() (*OpenReply, error) {
+	buf, err := cook.Cookie.Reply()
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	fds := cook.Cookie.Fds()
+	if len(fds) != 1 {
+		xgb.CloseFds(fds)
+		return nil, xgb.Errorf("Expected 1 file descriptor(s) with the Open reply, but got %d.", len(fds))
+	}
+	return openReply(buf, cook.Cookie.ByteOrder(), fds), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a Open request arrives.
+func (cook OpenCookie) ReplyContext(ctx context.Context) (*OpenReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	fds := cook.Cookie.Fds()
+	if len(fds) != 1 {
+		xgb.CloseFds(fds)
+		return nil, xgb.Errorf("Expected 1 file descriptor(s) with the Open reply, but got %d.", len(fds))
+	}
+	return openReply(buf, cook.Cookie.ByteOrder(), fds), nil
+}
+
+// openReply reads a byte slice in the byte order 'order' into a OpenReply value.
+func openReply(buf []byte, order xgb.ByteOrder, fds []int) *OpenReply {
+	v := new(OpenReply)
+	b := 1 // skip reply determinant
+
+	v.Nfd = buf[b]
+	b += 1
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	v.Length = order.Get32(buf[b:]) // 4-byte units
+	b += 4
+
+	v.DeviceFd = fds[0]
+
+	b += 24 // padding
+
+	return v
+}
+
+// Write request to wire for Open
+// openRequest writes a Open request to a byte slice.
+func openRequest(c *xgb.Conn, Drawable xproto.Drawable, Provider uint32) []byte {
+	return AppendOpenRequest(nil, c, Drawable, Provider)
+}
+
+// AppendOpenRequest appends a Open request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendOpenRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable, Provider uint32) []byte {
+	size := 12
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["DRI3"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 1 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Drawable))
+	b += 4
+
+	order.Put32(buf[b:], Provider)
+	b += 4
+
+	return buf
+}
+
+// OpenRequest represents the data of a Open request, as it is read when tracing a connection.
+type OpenRequest struct {
+	Drawable xproto.Drawable
+	Provider uint32
+}
+
+// openRequestRead reads a byte slice in the byte order 'order' into a OpenRequest value.
+func openRequestRead(buf []byte, order xgb.ByteOrder) *OpenRequest {
+	v := new(OpenRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Provider = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI3"][1] = xgb.RequestInfo{
+		Name: "Open",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return openRequestRead(buf, order)
+		},
+	}
+}
+
+// PixmapFromBufferCookie is a cookie used only for PixmapFromBuffer requests.
+type PixmapFromBufferCookie struct {
+	*xgb.Cookie
+}
+
+// PixmapFromBuffer sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func PixmapFromBuffer(c *xgb.Conn, Pixmap xproto.Pixmap, Drawable xproto.Drawable, Size uint32, Width uint16, Height uint16, Stride uint16, Depth byte, Bpp byte, PixmapFd xgb.Fd) PixmapFromBufferCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["DRI3"]; !ok {
+		panic("Cannot issue request 'PixmapFromBuffer' using the uninitialized extension 'DRI3'. dri3.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequestFds(pixmapFromBufferRequest(c, Pixmap, Drawable, Size, Width, Height, Stride, Depth, Bpp, PixmapFd), []xgb.Fd{PixmapFd}, cookie)
+	return PixmapFromBufferCookie{cookie}
+}
+
+// PixmapFromBufferChecked sends a checked request.
+// If an error occurs, it can be retrieved using PixmapFromBufferCookie.Check()
+func PixmapFromBufferChecked(c *xgb.Conn, Pixmap xproto.Pixmap, Drawable xproto.Drawable, Size uint32, Width uint16, Height uint16, Stride uint16, Depth byte, Bpp byte, PixmapFd xgb.Fd) PixmapFromBufferCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["DRI3"]; !ok {
+		panic("Cannot issue request 'PixmapFromBuffer' using the uninitialized extension 'DRI3'. dri3.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, false)
+	c.NewRequestFds(pixmapFromBufferRequest(c, Pixmap, Drawable, Size, Width, Height, Stride, Depth, Bpp, PixmapFd), []xgb.Fd{PixmapFd}, cookie)
+	return PixmapFromBufferCookie{cookie}
+}
+
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook PixmapFromBufferCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PixmapFromBufferCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for PixmapFromBuffer
+// pixmapFromBufferRequest writes a PixmapFromBuffer request to a byte slice.
+func pixmapFromBufferRequest(c *xgb.Conn, Pixmap xproto.Pixmap, Drawable xproto.Drawable, Size uint32, Width uint16, Height uint16, Stride uint16, Depth byte, Bpp byte, PixmapFd xgb.Fd) []byte {
+	return AppendPixmapFromBufferRequest(nil, c, Pixmap, Drawable, Size, Width, Height, Stride, Depth, Bpp, PixmapFd)
+}
+
+// AppendPixmapFromBufferRequest appends a PixmapFromBuffer request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// Since the request carries file descriptors, it must be sent with xgb.Conn.NewRequestFds.
+func AppendPixmapFromBufferRequest(buf []byte, c *xgb.Conn, Pixmap xproto.Pixmap, Drawable xproto.Drawable, Size uint32, Width uint16, Height uint16, Stride uint16, Depth byte, Bpp byte, PixmapFd xgb.Fd) []byte {
+	size := 24
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["DRI3"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 2 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Pixmap))
+	b += 4
+
+	order.Put32(buf[b:], uint32(Drawable))
+	b += 4
+
+	order.Put32(buf[b:], Size)
+	b += 4
+
+	order.Put16(buf[b:], Width)
+	b += 2
+
+	order.Put16(buf[b:], Height)
+	b += 2
+
+	order.Put16(buf[b:], Stride)
+	b += 2
+
+	buf[b] = Depth
+	b += 1
+
+	buf[b] = Bpp
+	b += 1
+
+	// file descriptor PixmapFd is sent out of band
+
+	return buf
+}
+
+// PixmapFromBufferRequest represents the data of a PixmapFromBuffer request, as it is read when tracing a connection.
+type PixmapFromBufferRequest struct {
+	Pixmap   xproto.Pixmap
+	Drawable xproto.Drawable
+	Size     uint32
+	Width    uint16
+	Height   uint16
+	Stride   uint16
+	Depth    byte
+	Bpp      byte
+}
+
+// pixmapFromBufferRequestRead reads a byte slice in the byte order 'order' into a PixmapFromBufferRequest value.
+func pixmapFromBufferRequestRead(buf []byte, order xgb.ByteOrder) *PixmapFromBufferRequest {
+	v := new(PixmapFromBufferRequest)
+	b := 4 // skip request header
+
+	v.Pixmap = xproto.Pixmap(order.Get32(buf[b:]))
+	b += 4
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Size = order.Get32(buf[b:])
+	b += 4
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	v.Stride = order.Get16(buf[b:])
+	b += 2
+
+	v.Depth = buf[b]
+	b += 1
+
+	v.Bpp = buf[b]
+	b += 1
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI3"][2] = xgb.RequestInfo{
+		Name: "PixmapFromBuffer",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return pixmapFromBufferRequestRead(buf, order)
+		},
+	}
+}
+
+// PixmapFromBuffersCookie is a cookie used only for PixmapFromBuffers requests.
+type PixmapFromBuffersCookie struct {
+	*xgb.Cookie
+}
+
+// PixmapFromBuffers sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func PixmapFromBuffers(c *xgb.Conn, Pixmap xproto.Pixmap, Window xproto.Window, NumBuffers byte, Width uint16, Height uint16, Stride0 uint32, Offset0 uint32, Stride1 uint32, Offset1 uint32, Stride2 uint32, Offset2 uint32, Stride3 uint32, Offset3 uint32, Depth byte, Bpp byte, Modifier uint64, Buffers []xgb.Fd) PixmapFromBuffersCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["DRI3"]; !ok {
+		panic("Cannot issue request 'PixmapFromBuffers' using the uninitialized extension 'DRI3'. dri3.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequestFds(pixmapFromBuffersRequest(c, Pixmap, Window, NumBuffers, Width, Height, Stride0, Offset0, Stride1, Offset1, Stride2, Offset2, Stride3, Offset3, Depth, Bpp, Modifier, Buffers), Buffers, cookie)
+	return PixmapFromBuffersCookie{cookie}
+}
+
+// PixmapFromBuffersChecked sends a checked request.
+// If an error occurs, it can be retrieved using PixmapFromBuffersCookie.Check()
+func PixmapFromBuffersChecked(c *xgb.Conn, Pixmap xproto.Pixmap, Window xproto.Window, NumBuffers byte, Width uint16, Height uint16, Stride0 uint32, Offset0 uint32, Stride1 uint32, Offset1 uint32, Stride2 uint32, Offset2 uint32, Stride3 uint32, Offset3 uint32, Depth byte, Bpp byte, Modifier uint64, Buffers []xgb.Fd) PixmapFromBuffersCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["DRI3"]; !ok {
+		panic("Cannot issue request 'PixmapFromBuffers' using the uninitialized extension 'DRI3'. dri3.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, false)
+	c.NewRequestFds(pixmapFromBuffersRequest(c, Pixmap, Window, NumBuffers, Width, Height, Stride0, Offset0, Stride1, Offset1, Stride2, Offset2, Stride3, Offset3, Depth, Bpp, Modifier, Buffers), Buffers, cookie)
+	return PixmapFromBuffersCookie{cookie}
+}
+
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook PixmapFromBuffersCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PixmapFromBuffersCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for PixmapFromBuffers
+// pixmapFromBuffersRequest writes a PixmapFromBuffers request to a byte slice.
+func pixmapFromBuffersRequest(c *xgb.Conn, Pixmap xproto.Pixmap, Window xproto.Window, NumBuffers byte, Width uint16, Height uint16, Stride0 uint32, Offset0 uint32, Stride1 uint32, Offset1 uint32, Stride2 uint32, Offset2 uint32, Stride3 uint32, Offset3 uint32, Depth byte, Bpp byte, Modifier uint64, Buffers []xgb.Fd) []byte {
+	return AppendPixmapFromBuffersRequest(nil, c, Pixmap, Window, NumBuffers, Width, Height, Stride0, Offset0, Stride1, Offset1, Stride2, Offset2, Stride3, Offset3, Depth, Bpp, Modifier, Buffers)
+}
+
+// AppendPixmapFromBuffersRequest appends a PixmapFromBuffers request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// Since the request carries file descriptors, it must be sent with xgb.Conn.NewRequestFds.
+func AppendPixmapFromBuffersRequest(buf []byte, c *xgb.Conn, Pixmap xproto.Pixmap, Window xproto.Window, NumBuffers byte, Width uint16, Height uint16, Stride0 uint32, Offset0 uint32, Stride1 uint32, Offset1 uint32, Stride2 uint32, Offset2 uint32, Stride3 uint32, Offset3 uint32, Depth byte, Bpp byte, Modifier uint64, Buffers []xgb.Fd) []byte {
+	size := 64
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["DRI3"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 7 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Pixmap))
+	b += 4
+
+	order.Put32(buf[b:], uint32(Window))
+	b += 4
+
+	buf[b] = NumBuffers
+	b += 1
+
+	b += 3 // padding
+
+	order.Put16(buf[b:], Width)
+	b += 2
+
+	order.Put16(buf[b:], Height)
+	b += 2
+
+	order.Put32(buf[b:], Stride0)
+	b += 4
+
+	order.Put32(buf[b:], Offset0)
+	b += 4
+
+	order.Put32(buf[b:], Stride1)
+	b += 4
+
+	order.Put32(buf[b:], Offset1)
+	b += 4
+
+	order.Put32(buf[b:], Stride2)
+	b += 4
+
+	order.Put32(buf[b:], Offset2)
+	b += 4
+
+	order.Put32(buf[b:], Stride3)
+	b += 4
+
+	order.Put32(buf[b:], Offset3)
+	b += 4
+
+	buf[b] = Depth
+	b += 1
+
+	buf[b] = Bpp
+	b += 1
+
+	b += 2 // padding
+
+	order.Put64(buf[b:], Modifier)
+	b += 8
+
+	// file descriptors Buffers are sent out of band
+
+	return buf
+}
+
+// PixmapFromBuffersRequest represents the data of a PixmapFromBuffers request, as it is read when tracing a connection.
+type PixmapFromBuffersRequest struct {
+	Pixmap     xproto.Pixmap
+	Window     xproto.Window
+	NumBuffers byte
+	// padding: 3 bytes
+	Width   uint16
+	Height  uint16
+	Stride0 uint32
+	Offset0 uint32
+	Stride1 uint32
+	Offset1 uint32
+	Stride2 uint32
+	Offset2 uint32
+	Stride3 uint32
+	Offset3 uint32
+	Depth   byte
+	Bpp     byte
+	// padding: 2 bytes
+	Modifier uint64
+}
+
+// pixmapFromBuffersRequestRead reads a byte slice in the byte order 'order' into a PixmapFromBuffersRequest value.
+func pixmapFromBuffersRequestRead(buf []byte, order xgb.ByteOrder) *PixmapFromBuffersRequest {
+	v := new(PixmapFromBuffersRequest)
+	b := 4 // skip request header
+
+	v.Pixmap = xproto.Pixmap(order.Get32(buf[b:]))
+	b += 4
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.NumBuffers = buf[b]
+	b += 1
+
+	b += 3 // padding
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	v.Stride0 = order.Get32(buf[b:])
+	b += 4
+
+	v.Offset0 = order.Get32(buf[b:])
+	b += 4
+
+	v.Stride1 = order.Get32(buf[b:])
+	b += 4
+
+	v.Offset1 = order.Get32(buf[b:])
+	b += 4
+
+	v.Stride2 = order.Get32(buf[b:])
+	b += 4
+
+	v.Offset2 = order.Get32(buf[b:])
+	b += 4
+
+	v.Stride3 = order.Get32(buf[b:])
+	b += 4
+
+	v.Offset3 = order.Get32(buf[b:])
+	b += 4
+
+	v.Depth = buf[b]
+	b += 1
+
+	v.Bpp = buf[b]
+	b += 1
+
+	b += 2 // padding
+
+	v.Modifier = order.Get64(buf[b:])
+	b += 8
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI3"][7] = xgb.RequestInfo{
+		Name: "PixmapFromBuffers",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return pixmapFromBuffersRequestRead(buf, order)
+		},
+	}
+}
+
+// QueryVersionCookie is a cookie used only for QueryVersion requests.
+type QueryVersionCookie struct {
+	*xgb.Cookie
+}
+
+// QueryVersion sends a checked request.
+// If an error occurs, it will be returned with the reply by calling QueryVersionCookie.Reply()
+func QueryVersion(c *xgb.Conn, MajorVersion uint32, MinorVersion uint32) QueryVersionCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["DRI3"]; !ok {
+		panic("Cannot issue request 'QueryVersion' using the uninitialized extension 'DRI3'. dri3.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, true)
+	c.NewRequest(queryVersionRequest(c, MajorVersion, MinorVersion), cookie)
+	return QueryVersionCookie{cookie}
+}
+
+// QueryVersionUnchecked sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func QueryVersionUnchecked(c *xgb.Conn, MajorVersion uint32, MinorVersion uint32) QueryVersionCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["DRI3"]; !ok {
+		panic("Cannot issue request 'QueryVersion' using the uninitialized extension 'DRI3'. dri3.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, true)
+	c.NewRequest(queryVersionRequest(c, MajorVersion, MinorVersion), cookie)
+	return QueryVersionCookie{cookie}
+}
+
+// QueryVersionReply represents the data returned from a QueryVersion request.
+type QueryVersionReply struct {
+	Sequence uint16 // sequence number of the request for this reply
+	Length   uint32 // number of bytes in this reply
+	// padding: 1 bytes
+	MajorVersion uint32
+	MinorVersion uint32
+}
+
+// Reply blocks and returns the reply data for a QueryVersion request.
+func (cook QueryVersionCookie) Reply() (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.Reply()
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryVersion request arrives.
+func (cook QueryVersionCookie) ReplyContext(ctx context.Context) (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryVersionReply reads a byte slice in the byte order 'order' into a QueryVersionReply value.
+func queryVersionReply(buf []byte, order xgb.ByteOrder) *QueryVersionReply {
+	v := new(QueryVersionReply)
+	b := 1 // skip reply determinant
+
+	b += 1 // padding
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	v.Length = order.Get32(buf[b:]) // 4-byte units
+	b += 4
+
+	v.MajorVersion = order.Get32(buf[b:])
+	b += 4
+
+	v.MinorVersion = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+// Write request to wire for QueryVersion
+// queryVersionRequest writes a QueryVersion request to a byte slice.
+func queryVersionRequest(c *xgb.Conn, MajorVersion uint32, MinorVersion uint32) []byte {
+	return AppendQueryVersionRequest(nil, c, MajorVersion, MinorVersion)
+}
+
+// AppendQueryVersionRequest appends a QueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryVersionRequest(buf []byte, c *xgb.Conn, MajorVersion uint32, MinorVersion uint32) []byte {
+	size := 12
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["DRI3"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 0 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], MajorVersion)
+	b += 4
+
+	order.Put32(buf[b:], MinorVersion)
+	b += 4
+
+	return buf
+}
+
+// QueryVersionRequest represents the data of a QueryVersion request, as it is read when tracing a connection.
+type QueryVersionRequest struct {
+	MajorVersion uint32
+	MinorVersion uint32
+}
+
+// queryVersionRequestRead reads a byte slice in the byte order 'order' into a QueryVersionRequest value.
+func queryVersionRequestRead(buf []byte, order xgb.ByteOrder) *QueryVersionRequest {
+	v := new(QueryVersionRequest)
+	b := 4 // skip request header
+
+	v.MajorVersion = order.Get32(buf[b:])
+	b += 4
+
+	v.MinorVersion = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["DRI3"][0] = xgb.RequestInfo{
+		Name: "QueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionReply(buf, order)
+		},
+	}
+}
@@ -0,0 +1,220 @@
+package dri3
+
+// Tests for the DRI3 extension, against a fake X server. File descriptors
+// are passed when the fake server is connected over a Unix domain socket.
+
+import (
+	"io"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xgbtest"
+)
+
+// The opcode of DRI3 on the fake server.
+const dri3Opcode = 149
+
+// newFakeDri3 connects to a fake X server with DRI3, over a Unix domain
+// socket if 'unix' is set. The test is skipped if there are none.
+func newFakeDri3(t *testing.T, unix bool) (*xgbtest.Server, *xgb.Conn) {
+	s := xgbtest.NewServer()
+	s.Unix = unix
+	s.AddExtension("DRI3", dri3Opcode, 0, 0)
+	c, err := s.Conn()
+	if err != nil {
+		s.Close()
+		if unix {
+			t.Skipf("No Unix domain sockets: %s", err)
+		}
+		t.Fatal(err)
+	}
+	if err := Init(c); err != nil {
+		c.Close()
+		s.Close()
+		t.Fatalf("Init: %s", err)
+	}
+	return s, c
+}
+
+// TestGetSupportedModifiers checks that lists of 64-bit values are read.
+func TestGetSupportedModifiers(t *testing.T) {
+	s, c := newFakeDri3(t, false)
+	defer s.Close()
+	defer c.Close()
+
+	window, screen := []uint64{1 << 56, 2}, []uint64{3}
+	reply := make(xgbtest.Reply, 32)
+	xgb.Put32(reply[8:], uint32(len(window)))
+	xgb.Put32(reply[12:], uint32(len(screen)))
+	for _, modifier := range append(window, screen...) {
+		reply = append(reply, make([]byte, 8)...)
+		xgb.Put64(reply[len(reply)-8:], modifier)
+	}
+	s.Handle(dri3Opcode, 6, reply)
+
+	got, err := GetSupportedModifiers(c, 1, 24, 32).Reply()
+	if err != nil {
+		t.Fatalf("GetSupportedModifiers: %s", err)
+	}
+	if !reflect.DeepEqual(got.WindowModifiers, window) ||
+		!reflect.DeepEqual(got.ScreenModifiers, screen) {
+
+		t.Fatalf("Got modifiers %v and %v, want %v and %v.",
+			got.WindowModifiers, got.ScreenModifiers, window, screen)
+	}
+}
+
+// newPipe returns the file descriptors of both ends of a new pipe, which
+// are closed when the test is done.
+func newPipe(t *testing.T) []int {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		r.Close()
+		w.Close()
+	})
+	return []int{int(r.Fd()), int(w.Fd())}
+}
+
+// TestPixmapFromBuffers checks the encoding of a request with a list of file
+// descriptors, which is only as long as its fixed fields, and that the file
+// descriptors are passed along with it.
+func TestPixmapFromBuffers(t *testing.T) {
+	s, c := newFakeDri3(t, true)
+	defer s.Close()
+	defer c.Close()
+
+	fds := newPipe(t)
+	PixmapFromBuffers(c, 1, 2, 2, 640, 480, 2560, 0, 1280, 1<<20, 0, 0,
+		0, 0, 24, 32, 1<<56+1, []xgb.Fd{xgb.RawFd(fds[0]), xgb.RawFd(fds[1])})
+	c.Sync()
+
+	reqs := s.Requests()
+	req := reqs[len(reqs)-2] // the last one is from Sync
+	if req.Opcode != dri3Opcode || req.Minor != 7 {
+		t.Fatalf("Got request %d.%d, want PixmapFromBuffers.", req.Opcode,
+			req.Minor)
+	}
+	if len(req.Bytes) != 64 {
+		t.Fatalf("PixmapFromBuffers is %d bytes long, want 64.",
+			len(req.Bytes))
+	}
+	if req.Bytes[12] != 2 {
+		t.Errorf("NumBuffers is %d, want 2.", req.Bytes[12])
+	}
+	if got := xgb.Get32(req.Bytes[32:]); got != 1<<20 {
+		t.Errorf("Offset1 is %d, want %d.", got, 1<<20)
+	}
+	if got := xgb.Get64(req.Bytes[56:]); got != 1<<56+1 {
+		t.Errorf("Modifier is %d, want %d.", got, uint64(1<<56+1))
+	}
+	if len(req.Fds) != 2 {
+		t.Errorf("Got %d file descriptors, want 2.", len(req.Fds))
+	}
+	for _, fd := range req.Fds {
+		os.NewFile(uintptr(fd), "buffer").Close()
+	}
+}
+
+// TestBuffersFromPixmap checks that a reply with a list of file descriptors
+// gets as many of them as it says it has.
+func TestBuffersFromPixmap(t *testing.T) {
+	reply := make([]byte, 48)
+	reply[0], reply[1] = 1, 2 // reply, nfd
+	xgb.Put32(reply[4:], 4)
+	xgb.Put16(reply[8:], 640)
+	xgb.Put16(reply[10:], 480)
+	xgb.Put64(reply[16:], 1<<56+1)
+	reply[24], reply[25] = 24, 32
+	xgb.Put32(reply[32:], 2560) // strides
+	xgb.Put32(reply[36:], 1280)
+	xgb.Put32(reply[40:], 0) // offsets
+	xgb.Put32(reply[44:], 1<<20)
+
+	v := buffersFromPixmapReply(reply, xgb.LSBFirst, []int{10, 11})
+	want := &BuffersFromPixmapReply{
+		Length:   4,
+		Nfd:      2,
+		Width:    640,
+		Height:   480,
+		Modifier: 1<<56 + 1,
+		Depth:    24,
+		Bpp:      32,
+		Strides:  []uint32{2560, 1280},
+		Offsets:  []uint32{0, 1 << 20},
+		Buffers:  []int{10, 11},
+	}
+	if !reflect.DeepEqual(v, want) {
+		t.Fatalf("Got %v, want %v.", v, want)
+	}
+
+	// Without its file descriptors, the reply is an error.
+	s, c := newFakeDri3(t, false)
+	defer s.Close()
+	defer c.Close()
+	s.Handle(dri3Opcode, 8, xgbtest.Reply(reply))
+	if _, err := BuffersFromPixmap(c, 1).Reply(); err == nil {
+		t.Fatalf("BuffersFromPixmap without file descriptors should " +
+			"have failed.")
+	}
+}
+
+// TestBuffersFromPixmapFds checks that the file descriptors sent with a
+// reply end up in it.
+func TestBuffersFromPixmapFds(t *testing.T) {
+	s, c := newFakeDri3(t, true)
+	defer s.Close()
+	defer c.Close()
+
+	reply := make(xgbtest.Reply, 48)
+	reply[1] = 2 // nfd
+	xgb.Put32(reply[4:], 4)
+	s.Handle(dri3Opcode, 8, xgbtest.ReplyFds{Reply: reply, Fds: newPipe(t)})
+
+	v, err := BuffersFromPixmap(c, 1).Reply()
+	if err != nil {
+		t.Fatalf("BuffersFromPixmap: %s", err)
+	}
+	if len(v.Buffers) != 2 {
+		t.Fatalf("Got %d buffers, want 2.", len(v.Buffers))
+	}
+	for _, fd := range v.Buffers {
+		os.NewFile(uintptr(fd), "buffer").Close()
+	}
+}
+
+// TestOpenFdsClosed checks that the file descriptors sent with a reply are
+// closed if there are more of them than the reply has room for.
+func TestOpenFdsClosed(t *testing.T) {
+	s, c := newFakeDri3(t, true)
+	defer s.Close()
+	defer c.Close()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	reply := make(xgbtest.Reply, 32)
+	reply[1] = 1 // nfd
+	fd := int(w.Fd())
+	s.Handle(dri3Opcode, 1, xgbtest.ReplyFds{Reply: reply, Fds: []int{fd, fd}})
+
+	if _, err := Open(c, 1, 0).Reply(); err == nil {
+		t.Fatalf("Open with two file descriptors should have failed.")
+	}
+	w.Close()
+
+	// The pipe is only at its end if the copies of its write end that came
+	// with the reply have been closed.
+	r.SetReadDeadline(time.Now().Add(10 * time.Second))
+	if _, err := io.ReadAll(r); err != nil {
+		t.Fatalf("The file descriptors sent with the reply were left "+
+			"open: %s", err)
+	}
+}
@@ -0,0 +1,118 @@
+package xgb
+
+import (
+	"sync/atomic"
+)
+
+// OverflowPolicy says what happens to an event that arrives when the queue
+// it goes to is full.
+type OverflowPolicy int
+
+const (
+	// OverflowBlock waits until there is room in the queue. In the meantime,
+	// nothing else is read from the X server, not even replies.
+	OverflowBlock OverflowPolicy = iota
+
+	// OverflowDropNewest drops the event that arrived.
+	OverflowDropNewest
+
+	// OverflowDropOldest drops the oldest event in the queue to make room
+	// for the one that arrived.
+	OverflowDropOldest
+
+	// OverflowUnbounded keeps the events that don't fit in the queue in
+	// memory until there is room for them, however many there are.
+	OverflowUnbounded
+)
+
+// String returns the name of the policy's constant.
+func (p OverflowPolicy) String() string {
+	switch p {
+	case OverflowBlock:
+		return "OverflowBlock"
+	case OverflowDropNewest:
+		return "OverflowDropNewest"
+	case OverflowDropOldest:
+		return "OverflowDropOldest"
+	case OverflowUnbounded:
+		return "OverflowUnbounded"
+	}
+	return Sprintf("OverflowPolicy(%d)", int(p))
+}
+
+// queueEvent adds an event or error to the queue that WaitForEvent and
+// PollForEvent take from, applying the connection's overflow policy if
+// the queue is full.
+func (c *Conn) queueEvent(everr eventOrError) {
+	switch c.eventOverflow {
+	case OverflowDropNewest:
+		select {
+		case c.eventChan <- everr:
+		default:
+			atomic.AddUint64(&c.droppedEvents, 1)
+		}
+	case OverflowDropOldest:
+		for {
+			select {
+			case c.eventChan <- everr:
+				return
+			default:
+			}
+			select {
+			case <-c.eventChan:
+				atomic.AddUint64(&c.droppedEvents, 1)
+			default:
+			}
+		}
+	case OverflowUnbounded:
+		c.eventSpill <- everr
+	default:
+		c.eventChan <- everr
+	}
+}
+
+// closeEvents closes the event queue once nothing more is read from the X
+// server. Events that were kept in memory can still be taken.
+func (c *Conn) closeEvents() {
+	if c.eventSpill != nil {
+		close(c.eventSpill) // pumpEvents closes c.eventChan
+	} else {
+		close(c.eventChan)
+	}
+}
+
+// pumpEvents moves the events and errors sent on c.eventSpill to
+// c.eventChan, keeping those that don't fit yet in memory, until
+// c.eventSpill is closed and they are all taken.
+func (c *Conn) pumpEvents() {
+	defer close(c.eventChan)
+
+	var queue []eventOrError
+	in := c.eventSpill
+	for in != nil || len(queue) > 0 {
+		var out chan eventOrError
+		var next eventOrError
+		if len(queue) > 0 {
+			out, next = c.eventChan, queue[0]
+		}
+		select {
+		case everr, ok := <-in:
+			if !ok {
+				in = nil
+				continue
+			}
+			queue = append(queue, everr)
+		case out <- next:
+			queue[0] = nil
+			queue = queue[1:]
+		}
+	}
+}
+
+// DroppedEvents returns the number of events and errors that were dropped
+// because the queue that WaitForEvent takes from was full. (See the
+// EventOverflow option.) Events dropped by subscriptions are counted by
+// their own Dropped method.
+func (c *Conn) DroppedEvents() uint64 {
+	return atomic.LoadUint64(&c.droppedEvents)
+}
@@ -0,0 +1,106 @@
+package xgb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xgbtest"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// mapNotifies makes MapNotify events for the windows 1 to n.
+func mapNotifies(n int) []xgb.Event {
+	evs := make([]xgb.Event, n)
+	for i := range evs {
+		evs[i] = xproto.MapNotifyEvent{Window: xproto.Window(i + 1)}
+	}
+	return evs
+}
+
+// windows returns the windows of the MapNotify events in 'evs'.
+func windows(evs []xgb.Event) []xproto.Window {
+	ws := make([]xproto.Window, len(evs))
+	for i, ev := range evs {
+		ws[i] = ev.(xproto.MapNotifyEvent).Window
+	}
+	return ws
+}
+
+func TestEventOverflow(t *testing.T) {
+	tests := []struct {
+		policy  xgb.OverflowPolicy
+		windows []xproto.Window
+		dropped uint64
+	}{
+		{xgb.OverflowDropNewest, []xproto.Window{1, 2}, 3},
+		{xgb.OverflowDropOldest, []xproto.Window{4, 5}, 3},
+		{xgb.OverflowUnbounded, []xproto.Window{1, 2, 3, 4, 5}, 0},
+	}
+	for _, test := range tests {
+		s := xgbtest.NewServer()
+		X, err := xgb.NewConnOptions(context.Background(), xgb.Options{
+			Display:        ":0",
+			Dial:           s.Dial,
+			AuthName:       "MIT-MAGIC-COOKIE-1",
+			AuthData:       make([]byte, 16),
+			EventQueueSize: 2,
+			EventOverflow:  test.policy,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// With OverflowBlock, the reply would never be read.
+		sendEvents(t, s, X, mapNotifies(5)...)
+		var evs []xgb.Event
+		for len(evs) < len(test.windows) {
+			ev, _ := X.WaitForEvent()
+			evs = append(evs, ev)
+		}
+		if ev, err := X.PollForEvent(); ev != nil || err != nil {
+			t.Errorf("%s: got %v, %v after the last event", test.policy,
+				ev, err)
+		}
+		got := windows(evs)
+		for i := range got {
+			if got[i] != test.windows[i] {
+				t.Errorf("%s: got events for windows %v, want %v",
+					test.policy, got, test.windows)
+				break
+			}
+		}
+		if n := X.DroppedEvents(); n != test.dropped {
+			t.Errorf("%s: dropped %d events, want %d", test.policy, n,
+				test.dropped)
+		}
+
+		X.Close()
+		<-X.Done()
+		s.Close()
+	}
+}
+
+func TestSubscribeUnbounded(t *testing.T) {
+	s := xgbtest.NewServer()
+	defer s.Close()
+	X, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer X.Close()
+
+	sub := X.Subscribe(xgb.Filter{}, 1, xgb.OverflowUnbounded)
+	sendEvents(t, s, X, mapNotifies(5)...)
+	sub.Unsubscribe()
+
+	var evs []xgb.Event
+	for ev := range sub.Events() {
+		evs = append(evs, ev)
+	}
+	got := windows(evs)
+	if len(got) != 5 || got[0] != 1 || got[4] != 5 || sub.Dropped() != 0 {
+		t.Errorf("got events for windows %v, with %d dropped", got,
+			sub.Dropped())
+	}
+}
@@ -43,13 +43,15 @@ func main() {
 	// etc.) and when a key press or a key release has been made when the
 	// window has focus.
 	// We also set the 'BackPixel' to white so that the window isn't butt ugly.
-	xproto.ChangeWindowAttributes(X, wid,
-		xproto.CwBackPixel|xproto.CwEventMask,
-		[]uint32{ // values must be in the order defined by the protocol
-			0xffffffff,
-			xproto.EventMaskStructureNotify |
-				xproto.EventMaskKeyPress |
-				xproto.EventMaskKeyRelease})
+	// CwValues computes the value mask from the values that are set, and
+	// lists them in the order defined by the protocol. (The mask and the
+	// list may also be written out by hand, as long as the order is right.)
+	values := new(xproto.CwValues).
+		SetEventMask(xproto.EventMaskStructureNotify |
+			xproto.EventMaskKeyPress |
+			xproto.EventMaskKeyRelease).
+		SetBackPixel(0xffffffff)
+	xproto.ChangeWindowAttributes(X, wid, values.Mask(), values.List())
 
 	// MapWindow makes the window we've created appear on the screen.
 	// We demonstrated the use of a 'checked' request here.
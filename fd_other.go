@@ -0,0 +1,46 @@
+//go:build windows || plan9
+// +build windows plan9
+
+package xgb
+
+import (
+	"errors"
+	"log"
+	"net"
+)
+
+// ErrNoFdPassing is reported by the cookie of a request that passes file
+// descriptors, since they cannot be passed to the X server on this platform.
+// Such a request is never sent.
+var ErrNoFdPassing = errors.New(
+	"xgb: file descriptor passing is not supported on this platform")
+
+// fdReader reads from the connection to the X server. File descriptors are
+// never received on this platform.
+type fdReader struct {
+	conn net.Conn
+}
+
+func newFdReader(conn net.Conn, logger *log.Logger) *fdReader {
+	return &fdReader{conn: conn}
+}
+
+func (r *fdReader) Read(p []byte) (int, error) {
+	return r.conn.Read(p)
+}
+
+func (r *fdReader) take() []int {
+	return nil
+}
+
+func canPassFds(conn net.Conn) bool {
+	return false
+}
+
+func writeFds(conn net.Conn, buf []byte, fds []int) error {
+	return ErrNoFdPassing
+}
+
+// CloseFds closes file descriptors that nobody is going to use. Since none
+// are ever received on this platform, it does nothing.
+func CloseFds(fds []int) {}
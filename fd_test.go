@@ -0,0 +1,56 @@
+package xgb_test
+
+import (
+	"testing"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xgbtest"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// fdOpcode is the opcode of a made up request that passes a file
+// descriptor. The fake X server sends the file descriptors it gets back
+// with the reply.
+const fdOpcode = 202
+
+func fdRequest() []byte {
+	buf := make([]byte, 4)
+	buf[0] = fdOpcode
+	xgb.Put16(buf[2:], 1)
+	return buf
+}
+
+func echoFds(req *xgbtest.Request) []xgbtest.Response {
+	return []xgbtest.Response{xgbtest.ReplyFds{
+		Reply: xgbtest.Reply{},
+		Fds:   req.Fds,
+	}}
+}
+
+// TestNoFdPassing checks that a request passing file descriptors over a
+// connection that can't is never sent, and fails with ErrNoFdPassing.
+func TestNoFdPassing(t *testing.T) {
+	s := xgbtest.NewServer()
+	defer s.Close()
+	s.HandleFunc(fdOpcode, 0, echoFds)
+	c, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	cookie := c.NewCookie(true, true)
+	c.NewRequestFds(fdRequest(), []xgb.Fd{xgb.RawFd(0)}, cookie)
+	if _, err := cookie.Reply(); err != xgb.ErrNoFdPassing {
+		t.Fatalf("Expected ErrNoFdPassing, but got %v.", err)
+	}
+
+	if _, err := xproto.GetInputFocus(c).Reply(); err != nil {
+		t.Fatalf("GetInputFocus: %s", err)
+	}
+	for _, req := range s.Requests() {
+		if req.Opcode == fdOpcode {
+			t.Fatalf("The request was sent without its file descriptor.")
+		}
+	}
+}
@@ -0,0 +1,119 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package xgb
+
+/*
+fd_unix.go contains the dirty work of passing file descriptors to and from
+the X server as SCM_RIGHTS ancillary data on a Unix domain socket. Some
+extensions (i.e., MIT-SHM and DRI3) use this to share memory buffers.
+*/
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net"
+	"syscall"
+)
+
+// maxFds is the most file descriptors that can be received along with a
+// single read. The X server never sends more than a handful with one reply.
+const maxFds = 16
+
+// ErrNoFdPassing is reported by the cookie of a request that passes file
+// descriptors when the connection to the X server is not a Unix domain
+// socket. Such a request is never sent.
+var ErrNoFdPassing = errors.New(
+	"xgb: file descriptors can only be passed over Unix domain sockets")
+
+// fdReader reads from the connection to the X server. If the connection
+// is a Unix domain socket, any file descriptors that come along with the
+// data are kept until they are taken with take.
+type fdReader struct {
+	conn net.Conn
+	unix *net.UnixConn // nil if conn is not a Unix domain socket
+	oob  []byte
+	fds  []int
+	log  *log.Logger
+}
+
+func newFdReader(conn net.Conn, logger *log.Logger) *fdReader {
+	r := &fdReader{conn: conn, log: logger}
+	if unix, ok := conn.(*net.UnixConn); ok {
+		r.unix = unix
+		r.oob = make([]byte, syscall.CmsgSpace(maxFds*4))
+	}
+	return r
+}
+
+func (r *fdReader) Read(p []byte) (int, error) {
+	if r.unix == nil {
+		return r.conn.Read(p)
+	}
+	n, oobn, _, _, err := r.unix.ReadMsgUnix(p, r.oob)
+	if oobn > 0 {
+		r.parse(r.oob[:oobn])
+	}
+	// Unlike Read, ReadMsgUnix does not report the end of the stream.
+	if n == 0 && err == nil && len(p) > 0 {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// parse adds the file descriptors found in the control messages 'oob' to
+// the ones already received.
+func (r *fdReader) parse(oob []byte) {
+	msgs, err := syscall.ParseSocketControlMessage(oob)
+	if err != nil {
+		r.log.Printf("Could not parse ancillary data from the X server: %s",
+			err)
+		return
+	}
+	for i := range msgs {
+		fds, err := syscall.ParseUnixRights(&msgs[i])
+		if err != nil { // not SCM_RIGHTS
+			continue
+		}
+		r.fds = append(r.fds, fds...)
+	}
+}
+
+// take returns all file descriptors received so far, and forgets them.
+func (r *fdReader) take() []int {
+	fds := r.fds
+	r.fds = nil
+	return fds
+}
+
+// canPassFds reports whether file descriptors can be passed over 'conn'.
+func canPassFds(conn net.Conn) bool {
+	_, ok := conn.(*net.UnixConn)
+	return ok
+}
+
+// writeFds writes 'buf' to the wire, and passes the file descriptors in
+// 'fds' along with it.
+func writeFds(conn net.Conn, buf []byte, fds []int) error {
+	unix, ok := conn.(*net.UnixConn)
+	if !ok {
+		return ErrNoFdPassing
+	}
+	n, _, err := unix.WriteMsgUnix(buf, syscall.UnixRights(fds...), nil)
+	if err != nil {
+		return err
+	}
+	if n < len(buf) {
+		_, err = unix.Write(buf[n:])
+	}
+	return err
+}
+
+// CloseFds closes file descriptors that nobody is going to use, such as
+// those received with a reply that turned out to be malformed.
+func CloseFds(fds []int) {
+	for _, fd := range fds {
+		syscall.Close(fd)
+	}
+}
@@ -0,0 +1,68 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package xgb_test
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xgbtest"
+)
+
+// TestFdPassing checks that file descriptors are passed both ways over a
+// Unix domain socket: the write end of a pipe is sent to the fake X
+// server, which sends it back with its reply.
+func TestFdPassing(t *testing.T) {
+	s := xgbtest.NewServer()
+	s.Unix = true
+	defer s.Close()
+	s.HandleFunc(fdOpcode, 0, echoFds)
+	c, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	cookie := c.NewCookie(true, true)
+	c.NewRequestFds(fdRequest(), []xgb.Fd{w}, cookie)
+	w.Close()
+	if _, err := cookie.Reply(); err != nil {
+		t.Fatalf("Reply: %s", err)
+	}
+	fds := cookie.Fds()
+	if len(fds) != 1 {
+		t.Fatalf("Got %d file descriptors with the reply, but expected 1.",
+			len(fds))
+	}
+	for _, req := range s.Requests() {
+		if req.Opcode == fdOpcode && len(req.Fds) != 1 {
+			t.Fatalf("The server got %d file descriptors with the "+
+				"request, but expected 1.", len(req.Fds))
+		}
+		for _, fd := range req.Fds {
+			os.NewFile(uintptr(fd), "server's copy").Close()
+		}
+	}
+
+	// The pipe must only be open for writing through the file descriptor
+	// that came back.
+	back := os.NewFile(uintptr(fds[0]), "pipe")
+	if _, err := back.Write([]byte("xgb")); err != nil {
+		t.Fatalf("Writing to the file descriptor that came back: %s", err)
+	}
+	back.Close()
+	got, err := io.ReadAll(r)
+	if err != nil || string(got) != "xgb" {
+		t.Fatalf("Read %q from the pipe (error %v), but expected \"xgb\".",
+			got, err)
+	}
+}
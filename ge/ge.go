@@ -4,6 +4,8 @@ package ge
 // This file is automatically generated from ge.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xproto"
@@ -22,18 +24,16 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["Generic Event Extension"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["Generic Event Extension"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["Generic Event Extension"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["Generic Event Extension"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["Generic Event Extension"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "Generic Event Extension", xgb.ExtRequestInfos["Generic Event Extension"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["Generic Event Extension"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["Generic Event Extension"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["Generic Event Extension"] = make(map[int]xgb.RequestInfo)
 }
 
 // Skipping definition for base type 'Bool'
@@ -60,6 +60,8 @@ func init() {
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // QueryVersionCookie is a cookie used only for QueryVersion requests.
 type QueryVersionCookie struct {
 	*xgb.Cookie
@@ -110,26 +112,38 @@ func (cook QueryVersionCookie) Reply() (*QueryVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryVersionReply(buf), nil
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryVersion request arrives.
+func (cook QueryVersionCookie) ReplyContext(ctx context.Context) (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryVersionReply reads a byte slice into a QueryVersionReply value.
-func queryVersionReply(buf []byte) *QueryVersionReply {
+// queryVersionReply reads a byte slice in the byte order 'order' into a QueryVersionReply value.
+func queryVersionReply(buf []byte, order xgb.ByteOrder) *QueryVersionReply {
 	v := new(QueryVersionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.MajorVersion = xgb.Get16(buf[b:])
+	v.MajorVersion = order.Get16(buf[b:])
 	b += 2
 
-	v.MinorVersion = xgb.Get16(buf[b:])
+	v.MinorVersion = order.Get16(buf[b:])
 	b += 2
 
 	b += 20 // padding
@@ -140,9 +154,18 @@ func queryVersionReply(buf []byte) *QueryVersionReply {
 // Write request to wire for QueryVersion
 // queryVersionRequest writes a QueryVersion request to a byte slice.
 func queryVersionRequest(c *xgb.Conn, ClientMajorVersion uint16, ClientMinorVersion uint16) []byte {
+	return AppendQueryVersionRequest(nil, c, ClientMajorVersion, ClientMinorVersion)
+}
+
+// AppendQueryVersionRequest appends a QueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryVersionRequest(buf []byte, c *xgb.Conn, ClientMajorVersion uint16, ClientMinorVersion uint16) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["Generic Event Extension"]
@@ -152,14 +175,46 @@ func queryVersionRequest(c *xgb.Conn, ClientMajorVersion uint16, ClientMinorVers
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], ClientMajorVersion)
+	order.Put16(buf[b:], ClientMajorVersion)
 	b += 2
 
-	xgb.Put16(buf[b:], ClientMinorVersion)
+	order.Put16(buf[b:], ClientMinorVersion)
 	b += 2
 
 	return buf
 }
+
+// QueryVersionRequest represents the data of a QueryVersion request, as it is read when tracing a connection.
+type QueryVersionRequest struct {
+	ClientMajorVersion uint16
+	ClientMinorVersion uint16
+}
+
+// queryVersionRequestRead reads a byte slice in the byte order 'order' into a QueryVersionRequest value.
+func queryVersionRequestRead(buf []byte, order xgb.ByteOrder) *QueryVersionRequest {
+	v := new(QueryVersionRequest)
+	b := 4 // skip request header
+
+	v.ClientMajorVersion = order.Get16(buf[b:])
+	b += 2
+
+	v.ClientMinorVersion = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["Generic Event Extension"][0] = xgb.RequestInfo{
+		Name: "QueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionReply(buf, order)
+		},
+	}
+}
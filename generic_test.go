@@ -0,0 +1,87 @@
+package xgb_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xgbtest"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// genericExtension is the major opcode of a made up extension sending
+// generic events.
+const genericExtension = 140
+
+// rawEvent is an event that is kept as it was read.
+type rawEvent []byte
+
+func (ev rawEvent) Bytes(order xgb.ByteOrder) []byte {
+	return ev
+}
+
+func (ev rawEvent) String() string {
+	return fmt.Sprintf("rawEvent(%d bytes)", len(ev))
+}
+
+// genericEvent makes a generic event of genericExtension with type 'evType',
+// which is 32 bytes longer than the ones in the core protocol. The bytes
+// after the first 32 count up from 1.
+func genericEvent(evType uint16) xgbtest.Event {
+	buf := make([]byte, 64)
+	buf[0] = 35 // GenericEvent
+	buf[1] = genericExtension
+	xgb.Put32(buf[4:], 8) // length after the first 32 bytes
+	xgb.Put16(buf[8:], evType)
+	for i := 32; i < len(buf); i++ {
+		buf[i] = byte(i - 31)
+	}
+	return xgbtest.Event(buf)
+}
+
+// TestGenericEvent checks that generic events longer than 32 bytes are
+// read in full, and that the events after them are read properly, whether
+// or not there is a constructor for them.
+func TestGenericEvent(t *testing.T) {
+	s := xgbtest.NewServer()
+	defer s.Close()
+	c, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	c.RegisterGenericEventFuncs(genericExtension, map[int]xgb.NewEventFun{
+		1: func(buf []byte, order xgb.ByteOrder) xgb.Event {
+			return rawEvent(buf)
+		},
+	})
+
+	// The second event has no constructor, so it is dropped.
+	for _, ev := range []xgbtest.Event{genericEvent(1), genericEvent(2)} {
+		if err := s.SendEvent(ev); err != nil {
+			t.Fatalf("SendEvent: %s", err)
+		}
+	}
+	focus := xproto.FocusInEvent{Event: 9}
+	err = s.SendEvent(xgbtest.Event(focus.Bytes(c.ByteOrder())))
+	if err != nil {
+		t.Fatalf("SendEvent: %s", err)
+	}
+
+	ev, xerr := c.WaitForEvent()
+	raw, ok := ev.(rawEvent)
+	if !ok {
+		t.Fatalf("Expected the generic event, but got %v (error %v).",
+			ev, xerr)
+	}
+	if want := genericEvent(1); !bytes.Equal(raw[32:], want[32:]) {
+		t.Fatalf("The generic event ends with % x, but expected % x.",
+			raw[32:], want[32:])
+	}
+	ev, xerr = c.WaitForEvent()
+	if ev, ok := ev.(xproto.FocusInEvent); !ok || ev.Event != 9 {
+		t.Fatalf("Expected a FocusIn event for window 9 after the generic "+
+			"events, but got %v (error %v).", ev, xerr)
+	}
+}
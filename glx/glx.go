@@ -4,6 +4,8 @@ package glx
 // This file is automatically generated from glx.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xproto"
@@ -22,18 +24,16 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["GLX"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["GLX"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["GLX"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["GLX"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["GLX"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "GLX", xgb.ExtRequestInfos["GLX"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["GLX"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["GLX"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["GLX"] = make(map[int]xgb.RequestInfo)
 }
 
 // BadBadContext is the error number for a BadBadContext.
@@ -41,9 +41,9 @@ const BadBadContext = 0
 
 type BadContextError GenericError
 
-// BadContextErrorNew constructs a BadContextError value that implements xgb.Error from a byte slice.
-func BadContextErrorNew(buf []byte) xgb.Error {
-	v := BadContextError(GenericErrorNew(buf).(GenericError))
+// BadContextErrorNew constructs a BadContextError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadContextErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := BadContextError(GenericErrorNew(buf, order).(GenericError))
 	v.NiceName = "BadContext"
 	return v
 }
@@ -79,9 +79,9 @@ const BadBadContextState = 1
 
 type BadContextStateError GenericError
 
-// BadContextStateErrorNew constructs a BadContextStateError value that implements xgb.Error from a byte slice.
-func BadContextStateErrorNew(buf []byte) xgb.Error {
-	v := BadContextStateError(GenericErrorNew(buf).(GenericError))
+// BadContextStateErrorNew constructs a BadContextStateError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadContextStateErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := BadContextStateError(GenericErrorNew(buf, order).(GenericError))
 	v.NiceName = "BadContextState"
 	return v
 }
@@ -117,9 +117,9 @@ const BadBadContextTag = 4
 
 type BadContextTagError GenericError
 
-// BadContextTagErrorNew constructs a BadContextTagError value that implements xgb.Error from a byte slice.
-func BadContextTagErrorNew(buf []byte) xgb.Error {
-	v := BadContextTagError(GenericErrorNew(buf).(GenericError))
+// BadContextTagErrorNew constructs a BadContextTagError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadContextTagErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := BadContextTagError(GenericErrorNew(buf, order).(GenericError))
 	v.NiceName = "BadContextTag"
 	return v
 }
@@ -155,9 +155,9 @@ const BadBadCurrentDrawable = 11
 
 type BadCurrentDrawableError GenericError
 
-// BadCurrentDrawableErrorNew constructs a BadCurrentDrawableError value that implements xgb.Error from a byte slice.
-func BadCurrentDrawableErrorNew(buf []byte) xgb.Error {
-	v := BadCurrentDrawableError(GenericErrorNew(buf).(GenericError))
+// BadCurrentDrawableErrorNew constructs a BadCurrentDrawableError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadCurrentDrawableErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := BadCurrentDrawableError(GenericErrorNew(buf, order).(GenericError))
 	v.NiceName = "BadCurrentDrawable"
 	return v
 }
@@ -193,9 +193,9 @@ const BadBadCurrentWindow = 5
 
 type BadCurrentWindowError GenericError
 
-// BadCurrentWindowErrorNew constructs a BadCurrentWindowError value that implements xgb.Error from a byte slice.
-func BadCurrentWindowErrorNew(buf []byte) xgb.Error {
-	v := BadCurrentWindowError(GenericErrorNew(buf).(GenericError))
+// BadCurrentWindowErrorNew constructs a BadCurrentWindowError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadCurrentWindowErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := BadCurrentWindowError(GenericErrorNew(buf, order).(GenericError))
 	v.NiceName = "BadCurrentWindow"
 	return v
 }
@@ -231,9 +231,9 @@ const BadBadDrawable = 2
 
 type BadDrawableError GenericError
 
-// BadDrawableErrorNew constructs a BadDrawableError value that implements xgb.Error from a byte slice.
-func BadDrawableErrorNew(buf []byte) xgb.Error {
-	v := BadDrawableError(GenericErrorNew(buf).(GenericError))
+// BadDrawableErrorNew constructs a BadDrawableError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadDrawableErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := BadDrawableError(GenericErrorNew(buf, order).(GenericError))
 	v.NiceName = "BadDrawable"
 	return v
 }
@@ -269,9 +269,9 @@ const BadBadFBConfig = 9
 
 type BadFBConfigError GenericError
 
-// BadFBConfigErrorNew constructs a BadFBConfigError value that implements xgb.Error from a byte slice.
-func BadFBConfigErrorNew(buf []byte) xgb.Error {
-	v := BadFBConfigError(GenericErrorNew(buf).(GenericError))
+// BadFBConfigErrorNew constructs a BadFBConfigError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadFBConfigErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := BadFBConfigError(GenericErrorNew(buf, order).(GenericError))
 	v.NiceName = "BadFBConfig"
 	return v
 }
@@ -307,9 +307,9 @@ const BadBadLargeRequest = 7
 
 type BadLargeRequestError GenericError
 
-// BadLargeRequestErrorNew constructs a BadLargeRequestError value that implements xgb.Error from a byte slice.
-func BadLargeRequestErrorNew(buf []byte) xgb.Error {
-	v := BadLargeRequestError(GenericErrorNew(buf).(GenericError))
+// BadLargeRequestErrorNew constructs a BadLargeRequestError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadLargeRequestErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := BadLargeRequestError(GenericErrorNew(buf, order).(GenericError))
 	v.NiceName = "BadLargeRequest"
 	return v
 }
@@ -345,9 +345,9 @@ const BadBadPbuffer = 10
 
 type BadPbufferError GenericError
 
-// BadPbufferErrorNew constructs a BadPbufferError value that implements xgb.Error from a byte slice.
-func BadPbufferErrorNew(buf []byte) xgb.Error {
-	v := BadPbufferError(GenericErrorNew(buf).(GenericError))
+// BadPbufferErrorNew constructs a BadPbufferError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadPbufferErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := BadPbufferError(GenericErrorNew(buf, order).(GenericError))
 	v.NiceName = "BadPbuffer"
 	return v
 }
@@ -383,9 +383,9 @@ const BadBadPixmap = 3
 
 type BadPixmapError GenericError
 
-// BadPixmapErrorNew constructs a BadPixmapError value that implements xgb.Error from a byte slice.
-func BadPixmapErrorNew(buf []byte) xgb.Error {
-	v := BadPixmapError(GenericErrorNew(buf).(GenericError))
+// BadPixmapErrorNew constructs a BadPixmapError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadPixmapErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := BadPixmapError(GenericErrorNew(buf, order).(GenericError))
 	v.NiceName = "BadPixmap"
 	return v
 }
@@ -421,9 +421,9 @@ const BadBadRenderRequest = 6
 
 type BadRenderRequestError GenericError
 
-// BadRenderRequestErrorNew constructs a BadRenderRequestError value that implements xgb.Error from a byte slice.
-func BadRenderRequestErrorNew(buf []byte) xgb.Error {
-	v := BadRenderRequestError(GenericErrorNew(buf).(GenericError))
+// BadRenderRequestErrorNew constructs a BadRenderRequestError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadRenderRequestErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := BadRenderRequestError(GenericErrorNew(buf, order).(GenericError))
 	v.NiceName = "BadRenderRequest"
 	return v
 }
@@ -459,9 +459,9 @@ const BadBadWindow = 12
 
 type BadWindowError GenericError
 
-// BadWindowErrorNew constructs a BadWindowError value that implements xgb.Error from a byte slice.
-func BadWindowErrorNew(buf []byte) xgb.Error {
-	v := BadWindowError(GenericErrorNew(buf).(GenericError))
+// BadWindowErrorNew constructs a BadWindowError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadWindowErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := BadWindowError(GenericErrorNew(buf, order).(GenericError))
 	v.NiceName = "BadWindow"
 	return v
 }
@@ -510,44 +510,44 @@ type BufferSwapCompleteEvent struct {
 	Sbc      uint32
 }
 
-// BufferSwapCompleteEventNew constructs a BufferSwapCompleteEvent value that implements xgb.Event from a byte slice.
-func BufferSwapCompleteEventNew(buf []byte) xgb.Event {
+// BufferSwapCompleteEventNew constructs a BufferSwapCompleteEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func BufferSwapCompleteEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := BufferSwapCompleteEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.EventType = xgb.Get16(buf[b:])
+	v.EventType = order.Get16(buf[b:])
 	b += 2
 
 	b += 2 // padding
 
-	v.Drawable = Drawable(xgb.Get32(buf[b:]))
+	v.Drawable = Drawable(order.Get32(buf[b:]))
 	b += 4
 
-	v.UstHi = xgb.Get32(buf[b:])
+	v.UstHi = order.Get32(buf[b:])
 	b += 4
 
-	v.UstLo = xgb.Get32(buf[b:])
+	v.UstLo = order.Get32(buf[b:])
 	b += 4
 
-	v.MscHi = xgb.Get32(buf[b:])
+	v.MscHi = order.Get32(buf[b:])
 	b += 4
 
-	v.MscLo = xgb.Get32(buf[b:])
+	v.MscLo = order.Get32(buf[b:])
 	b += 4
 
-	v.Sbc = xgb.Get32(buf[b:])
+	v.Sbc = order.Get32(buf[b:])
 	b += 4
 
 	return v
 }
 
-// Bytes writes a BufferSwapCompleteEvent value to a byte slice.
-func (v BufferSwapCompleteEvent) Bytes() []byte {
+// Bytes writes a BufferSwapCompleteEvent value to a byte slice, in the byte order 'order'.
+func (v BufferSwapCompleteEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -559,27 +559,27 @@ func (v BufferSwapCompleteEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put16(buf[b:], v.EventType)
+	order.Put16(buf[b:], v.EventType)
 	b += 2
 
 	b += 2 // padding
 
-	xgb.Put32(buf[b:], uint32(v.Drawable))
+	order.Put32(buf[b:], uint32(v.Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], v.UstHi)
+	order.Put32(buf[b:], v.UstHi)
 	b += 4
 
-	xgb.Put32(buf[b:], v.UstLo)
+	order.Put32(buf[b:], v.UstLo)
 	b += 4
 
-	xgb.Put32(buf[b:], v.MscHi)
+	order.Put32(buf[b:], v.MscHi)
 	b += 4
 
-	xgb.Put32(buf[b:], v.MscLo)
+	order.Put32(buf[b:], v.MscLo)
 	b += 4
 
-	xgb.Put32(buf[b:], v.Sbc)
+	order.Put32(buf[b:], v.Sbc)
 	b += 4
 
 	return buf
@@ -651,9 +651,9 @@ const BadGLXBadProfileARB = 13
 
 type GLXBadProfileARBError GenericError
 
-// GLXBadProfileARBErrorNew constructs a GLXBadProfileARBError value that implements xgb.Error from a byte slice.
-func GLXBadProfileARBErrorNew(buf []byte) xgb.Error {
-	v := GLXBadProfileARBError(GenericErrorNew(buf).(GenericError))
+// GLXBadProfileARBErrorNew constructs a GLXBadProfileARBError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func GLXBadProfileARBErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := GLXBadProfileARBError(GenericErrorNew(buf, order).(GenericError))
 	v.NiceName = "GLXBadProfileARB"
 	return v
 }
@@ -720,21 +720,21 @@ type GenericError struct {
 	// padding: 21 bytes
 }
 
-// GenericErrorNew constructs a GenericError value that implements xgb.Error from a byte slice.
-func GenericErrorNew(buf []byte) xgb.Error {
+// GenericErrorNew constructs a GenericError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func GenericErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := GenericError{}
 	v.NiceName = "Generic"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.BadValue = xgb.Get32(buf[b:])
+	v.BadValue = order.Get32(buf[b:])
 	b += 4
 
-	v.MinorOpcode = xgb.Get16(buf[b:])
+	v.MinorOpcode = order.Get16(buf[b:])
 	b += 2
 
 	v.MajorOpcode = buf[b]
@@ -811,44 +811,44 @@ type PbufferClobberEvent struct {
 	// padding: 4 bytes
 }
 
-// PbufferClobberEventNew constructs a PbufferClobberEvent value that implements xgb.Event from a byte slice.
-func PbufferClobberEventNew(buf []byte) xgb.Event {
+// PbufferClobberEventNew constructs a PbufferClobberEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func PbufferClobberEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := PbufferClobberEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.EventType = xgb.Get16(buf[b:])
+	v.EventType = order.Get16(buf[b:])
 	b += 2
 
-	v.DrawType = xgb.Get16(buf[b:])
+	v.DrawType = order.Get16(buf[b:])
 	b += 2
 
-	v.Drawable = Drawable(xgb.Get32(buf[b:]))
+	v.Drawable = Drawable(order.Get32(buf[b:]))
 	b += 4
 
-	v.BMask = xgb.Get32(buf[b:])
+	v.BMask = order.Get32(buf[b:])
 	b += 4
 
-	v.AuxBuffer = xgb.Get16(buf[b:])
+	v.AuxBuffer = order.Get16(buf[b:])
 	b += 2
 
-	v.X = xgb.Get16(buf[b:])
+	v.X = order.Get16(buf[b:])
 	b += 2
 
-	v.Y = xgb.Get16(buf[b:])
+	v.Y = order.Get16(buf[b:])
 	b += 2
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
-	v.Count = xgb.Get16(buf[b:])
+	v.Count = order.Get16(buf[b:])
 	b += 2
 
 	b += 4 // padding
@@ -856,8 +856,8 @@ func PbufferClobberEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a PbufferClobberEvent value to a byte slice.
-func (v PbufferClobberEvent) Bytes() []byte {
+// Bytes writes a PbufferClobberEvent value to a byte slice, in the byte order 'order'.
+func (v PbufferClobberEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -869,34 +869,34 @@ func (v PbufferClobberEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put16(buf[b:], v.EventType)
+	order.Put16(buf[b:], v.EventType)
 	b += 2
 
-	xgb.Put16(buf[b:], v.DrawType)
+	order.Put16(buf[b:], v.DrawType)
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(v.Drawable))
+	order.Put32(buf[b:], uint32(v.Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], v.BMask)
+	order.Put32(buf[b:], v.BMask)
 	b += 4
 
-	xgb.Put16(buf[b:], v.AuxBuffer)
+	order.Put16(buf[b:], v.AuxBuffer)
 	b += 2
 
-	xgb.Put16(buf[b:], v.X)
+	order.Put16(buf[b:], v.X)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Y)
+	order.Put16(buf[b:], v.Y)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Width)
+	order.Put16(buf[b:], v.Width)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Height)
+	order.Put16(buf[b:], v.Height)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Count)
+	order.Put16(buf[b:], v.Count)
 	b += 2
 
 	b += 4 // padding
@@ -953,9 +953,9 @@ const BadUnsupportedPrivateRequest = 8
 
 type UnsupportedPrivateRequestError GenericError
 
-// UnsupportedPrivateRequestErrorNew constructs a UnsupportedPrivateRequestError value that implements xgb.Error from a byte slice.
-func UnsupportedPrivateRequestErrorNew(buf []byte) xgb.Error {
-	v := UnsupportedPrivateRequestError(GenericErrorNew(buf).(GenericError))
+// UnsupportedPrivateRequestErrorNew constructs a UnsupportedPrivateRequestError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func UnsupportedPrivateRequestErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := UnsupportedPrivateRequestError(GenericErrorNew(buf, order).(GenericError))
 	v.NiceName = "UnsupportedPrivateRequest"
 	return v
 }
@@ -1020,6 +1020,8 @@ func NewWindowId(c *xgb.Conn) (Window, error) {
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // AreTexturesResidentCookie is a cookie used only for AreTexturesResident requests.
 type AreTexturesResidentCookie struct {
 	*xgb.Cookie
@@ -1070,23 +1072,35 @@ func (cook AreTexturesResidentCookie) Reply() (*AreTexturesResidentReply, error)
 	if buf == nil {
 		return nil, nil
 	}
-	return areTexturesResidentReply(buf), nil
+	return areTexturesResidentReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a AreTexturesResident request arrives.
+func (cook AreTexturesResidentCookie) ReplyContext(ctx context.Context) (*AreTexturesResidentReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return areTexturesResidentReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// areTexturesResidentReply reads a byte slice into a AreTexturesResidentReply value.
-func areTexturesResidentReply(buf []byte) *AreTexturesResidentReply {
+// areTexturesResidentReply reads a byte slice in the byte order 'order' into a AreTexturesResidentReply value.
+func areTexturesResidentReply(buf []byte, order xgb.ByteOrder) *AreTexturesResidentReply {
 	v := new(AreTexturesResidentReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.RetVal = Bool32(xgb.Get32(buf[b:]))
+	v.RetVal = Bool32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 20 // padding
@@ -1107,9 +1121,18 @@ func areTexturesResidentReply(buf []byte) *AreTexturesResidentReply {
 // Write request to wire for AreTexturesResident
 // areTexturesResidentRequest writes a AreTexturesResident request to a byte slice.
 func areTexturesResidentRequest(c *xgb.Conn, ContextTag ContextTag, N int32, Textures []uint32) []byte {
+	return AppendAreTexturesResidentRequest(nil, c, ContextTag, N, Textures)
+}
+
+// AppendAreTexturesResidentRequest appends a AreTexturesResident request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendAreTexturesResidentRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, N int32, Textures []uint32) []byte {
 	size := xgb.Pad((12 + xgb.Pad((int(N) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -1119,23 +1142,62 @@ func areTexturesResidentRequest(c *xgb.Conn, ContextTag ContextTag, N int32, Tex
 	buf[b] = 143 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(N))
+	order.Put32(buf[b:], uint32(N))
 	b += 4
 
 	for i := 0; i < int(N); i++ {
-		xgb.Put32(buf[b:], Textures[i])
+		order.Put32(buf[b:], Textures[i])
 		b += 4
 	}
 
 	return buf
 }
 
+// AreTexturesResidentRequest represents the data of a AreTexturesResident request, as it is read when tracing a connection.
+type AreTexturesResidentRequest struct {
+	ContextTag ContextTag
+	N          int32
+	Textures   []uint32 // size: xgb.Pad((int(N) * 4))
+}
+
+// areTexturesResidentRequestRead reads a byte slice in the byte order 'order' into a AreTexturesResidentRequest value.
+func areTexturesResidentRequestRead(buf []byte, order xgb.ByteOrder) *AreTexturesResidentRequest {
+	v := new(AreTexturesResidentRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.N = int32(order.Get32(buf[b:]))
+	b += 4
+
+	v.Textures = make([]uint32, v.N)
+	for i := 0; i < int(v.N); i++ {
+		v.Textures[i] = order.Get32(buf[b:])
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][143] = xgb.RequestInfo{
+		Name: "AreTexturesResident",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return areTexturesResidentRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return areTexturesResidentReply(buf, order)
+		},
+	}
+}
+
 // ChangeDrawableAttributesCookie is a cookie used only for ChangeDrawableAttributes requests.
 type ChangeDrawableAttributesCookie struct {
 	*xgb.Cookie
@@ -1173,12 +1235,26 @@ func (cook ChangeDrawableAttributesCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ChangeDrawableAttributesCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ChangeDrawableAttributes
 // changeDrawableAttributesRequest writes a ChangeDrawableAttributes request to a byte slice.
 func changeDrawableAttributesRequest(c *xgb.Conn, Drawable Drawable, NumAttribs uint32, Attribs []uint32) []byte {
+	return AppendChangeDrawableAttributesRequest(nil, c, Drawable, NumAttribs, Attribs)
+}
+
+// AppendChangeDrawableAttributesRequest appends a ChangeDrawableAttributes request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendChangeDrawableAttributesRequest(buf []byte, c *xgb.Conn, Drawable Drawable, NumAttribs uint32, Attribs []uint32) []byte {
 	size := xgb.Pad((12 + xgb.Pad(((int(NumAttribs) * 2) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -1188,23 +1264,59 @@ func changeDrawableAttributesRequest(c *xgb.Conn, Drawable Drawable, NumAttribs
 	buf[b] = 30 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], NumAttribs)
+	order.Put32(buf[b:], NumAttribs)
 	b += 4
 
 	for i := 0; i < int((int(NumAttribs) * 2)); i++ {
-		xgb.Put32(buf[b:], Attribs[i])
+		order.Put32(buf[b:], Attribs[i])
 		b += 4
 	}
 
 	return buf
 }
 
+// ChangeDrawableAttributesRequest represents the data of a ChangeDrawableAttributes request, as it is read when tracing a connection.
+type ChangeDrawableAttributesRequest struct {
+	Drawable   Drawable
+	NumAttribs uint32
+	Attribs    []uint32 // size: xgb.Pad(((int(NumAttribs) * 2) * 4))
+}
+
+// changeDrawableAttributesRequestRead reads a byte slice in the byte order 'order' into a ChangeDrawableAttributesRequest value.
+func changeDrawableAttributesRequestRead(buf []byte, order xgb.ByteOrder) *ChangeDrawableAttributesRequest {
+	v := new(ChangeDrawableAttributesRequest)
+	b := 4 // skip request header
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.NumAttribs = order.Get32(buf[b:])
+	b += 4
+
+	v.Attribs = make([]uint32, (int(v.NumAttribs) * 2))
+	for i := 0; i < int((int(v.NumAttribs) * 2)); i++ {
+		v.Attribs[i] = order.Get32(buf[b:])
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][30] = xgb.RequestInfo{
+		Name: "ChangeDrawableAttributes",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return changeDrawableAttributesRequestRead(buf, order)
+		},
+	}
+}
+
 // ClientInfoCookie is a cookie used only for ClientInfo requests.
 type ClientInfoCookie struct {
 	*xgb.Cookie
@@ -1242,12 +1354,26 @@ func (cook ClientInfoCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ClientInfoCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ClientInfo
 // clientInfoRequest writes a ClientInfo request to a byte slice.
 func clientInfoRequest(c *xgb.Conn, MajorVersion uint32, MinorVersion uint32, StrLen uint32, String string) []byte {
+	return AppendClientInfoRequest(nil, c, MajorVersion, MinorVersion, StrLen, String)
+}
+
+// AppendClientInfoRequest appends a ClientInfo request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendClientInfoRequest(buf []byte, c *xgb.Conn, MajorVersion uint32, MinorVersion uint32, StrLen uint32, String string) []byte {
 	size := xgb.Pad((16 + xgb.Pad((int(StrLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -1257,16 +1383,16 @@ func clientInfoRequest(c *xgb.Conn, MajorVersion uint32, MinorVersion uint32, St
 	buf[b] = 20 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], MajorVersion)
+	order.Put32(buf[b:], MajorVersion)
 	b += 4
 
-	xgb.Put32(buf[b:], MinorVersion)
+	order.Put32(buf[b:], MinorVersion)
 	b += 4
 
-	xgb.Put32(buf[b:], StrLen)
+	order.Put32(buf[b:], StrLen)
 	b += 4
 
 	copy(buf[b:], String[:StrLen])
@@ -1275,6 +1401,47 @@ func clientInfoRequest(c *xgb.Conn, MajorVersion uint32, MinorVersion uint32, St
 	return buf
 }
 
+// ClientInfoRequest represents the data of a ClientInfo request, as it is read when tracing a connection.
+type ClientInfoRequest struct {
+	MajorVersion uint32
+	MinorVersion uint32
+	StrLen       uint32
+	String       string // size: xgb.Pad((int(StrLen) * 1))
+}
+
+// clientInfoRequestRead reads a byte slice in the byte order 'order' into a ClientInfoRequest value.
+func clientInfoRequestRead(buf []byte, order xgb.ByteOrder) *ClientInfoRequest {
+	v := new(ClientInfoRequest)
+	b := 4 // skip request header
+
+	v.MajorVersion = order.Get32(buf[b:])
+	b += 4
+
+	v.MinorVersion = order.Get32(buf[b:])
+	b += 4
+
+	v.StrLen = order.Get32(buf[b:])
+	b += 4
+
+	{
+		byteString := make([]byte, v.StrLen)
+		copy(byteString[:v.StrLen], buf[b:])
+		v.String = string(byteString)
+		b += int(v.StrLen)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][20] = xgb.RequestInfo{
+		Name: "ClientInfo",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return clientInfoRequestRead(buf, order)
+		},
+	}
+}
+
 // CopyContextCookie is a cookie used only for CopyContext requests.
 type CopyContextCookie struct {
 	*xgb.Cookie
@@ -1312,12 +1479,26 @@ func (cook CopyContextCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CopyContextCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CopyContext
 // copyContextRequest writes a CopyContext request to a byte slice.
 func copyContextRequest(c *xgb.Conn, Src Context, Dest Context, Mask uint32, SrcContextTag ContextTag) []byte {
+	return AppendCopyContextRequest(nil, c, Src, Dest, Mask, SrcContextTag)
+}
+
+// AppendCopyContextRequest appends a CopyContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCopyContextRequest(buf []byte, c *xgb.Conn, Src Context, Dest Context, Mask uint32, SrcContextTag ContextTag) []byte {
 	size := 20
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -1327,24 +1508,61 @@ func copyContextRequest(c *xgb.Conn, Src Context, Dest Context, Mask uint32, Src
 	buf[b] = 10 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Src))
+	order.Put32(buf[b:], uint32(Src))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Dest))
+	order.Put32(buf[b:], uint32(Dest))
 	b += 4
 
-	xgb.Put32(buf[b:], Mask)
+	order.Put32(buf[b:], Mask)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(SrcContextTag))
+	order.Put32(buf[b:], uint32(SrcContextTag))
 	b += 4
 
 	return buf
 }
 
+// CopyContextRequest represents the data of a CopyContext request, as it is read when tracing a connection.
+type CopyContextRequest struct {
+	Src           Context
+	Dest          Context
+	Mask          uint32
+	SrcContextTag ContextTag
+}
+
+// copyContextRequestRead reads a byte slice in the byte order 'order' into a CopyContextRequest value.
+func copyContextRequestRead(buf []byte, order xgb.ByteOrder) *CopyContextRequest {
+	v := new(CopyContextRequest)
+	b := 4 // skip request header
+
+	v.Src = Context(order.Get32(buf[b:]))
+	b += 4
+
+	v.Dest = Context(order.Get32(buf[b:]))
+	b += 4
+
+	v.Mask = order.Get32(buf[b:])
+	b += 4
+
+	v.SrcContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][10] = xgb.RequestInfo{
+		Name: "CopyContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return copyContextRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateContextCookie is a cookie used only for CreateContext requests.
 type CreateContextCookie struct {
 	*xgb.Cookie
@@ -1382,12 +1600,26 @@ func (cook CreateContextCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateContextCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateContext
 // createContextRequest writes a CreateContext request to a byte slice.
 func createContextRequest(c *xgb.Conn, Context Context, Visual xproto.Visualid, Screen uint32, ShareList Context, IsDirect bool) []byte {
+	return AppendCreateContextRequest(nil, c, Context, Visual, Screen, ShareList, IsDirect)
+}
+
+// AppendCreateContextRequest appends a CreateContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateContextRequest(buf []byte, c *xgb.Conn, Context Context, Visual xproto.Visualid, Screen uint32, ShareList Context, IsDirect bool) []byte {
 	size := 24
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -1397,19 +1629,19 @@ func createContextRequest(c *xgb.Conn, Context Context, Visual xproto.Visualid,
 	buf[b] = 3 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Visual))
+	order.Put32(buf[b:], uint32(Visual))
 	b += 4
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(ShareList))
+	order.Put32(buf[b:], uint32(ShareList))
 	b += 4
 
 	if IsDirect {
@@ -1424,6 +1656,54 @@ func createContextRequest(c *xgb.Conn, Context Context, Visual xproto.Visualid,
 	return buf
 }
 
+// CreateContextRequest represents the data of a CreateContext request, as it is read when tracing a connection.
+type CreateContextRequest struct {
+	Context   Context
+	Visual    xproto.Visualid
+	Screen    uint32
+	ShareList Context
+	IsDirect  bool
+	// padding: 3 bytes
+}
+
+// createContextRequestRead reads a byte slice in the byte order 'order' into a CreateContextRequest value.
+func createContextRequestRead(buf []byte, order xgb.ByteOrder) *CreateContextRequest {
+	v := new(CreateContextRequest)
+	b := 4 // skip request header
+
+	v.Context = Context(order.Get32(buf[b:]))
+	b += 4
+
+	v.Visual = xproto.Visualid(order.Get32(buf[b:]))
+	b += 4
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	v.ShareList = Context(order.Get32(buf[b:]))
+	b += 4
+
+	if buf[b] == 1 {
+		v.IsDirect = true
+	} else {
+		v.IsDirect = false
+	}
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][3] = xgb.RequestInfo{
+		Name: "CreateContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createContextRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateContextAttribsARBCookie is a cookie used only for CreateContextAttribsARB requests.
 type CreateContextAttribsARBCookie struct {
 	*xgb.Cookie
@@ -1461,12 +1741,26 @@ func (cook CreateContextAttribsARBCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateContextAttribsARBCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateContextAttribsARB
 // createContextAttribsARBRequest writes a CreateContextAttribsARB request to a byte slice.
 func createContextAttribsARBRequest(c *xgb.Conn, Context Context, Fbconfig Fbconfig, Screen uint32, ShareList Context, IsDirect bool, NumAttribs uint32, Attribs []uint32) []byte {
+	return AppendCreateContextAttribsARBRequest(nil, c, Context, Fbconfig, Screen, ShareList, IsDirect, NumAttribs, Attribs)
+}
+
+// AppendCreateContextAttribsARBRequest appends a CreateContextAttribsARB request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateContextAttribsARBRequest(buf []byte, c *xgb.Conn, Context Context, Fbconfig Fbconfig, Screen uint32, ShareList Context, IsDirect bool, NumAttribs uint32, Attribs []uint32) []byte {
 	size := xgb.Pad((28 + xgb.Pad(((int(NumAttribs) * 2) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -1476,19 +1770,19 @@ func createContextAttribsARBRequest(c *xgb.Conn, Context Context, Fbconfig Fbcon
 	buf[b] = 34 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Fbconfig))
+	order.Put32(buf[b:], uint32(Fbconfig))
 	b += 4
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(ShareList))
+	order.Put32(buf[b:], uint32(ShareList))
 	b += 4
 
 	if IsDirect {
@@ -1500,17 +1794,76 @@ func createContextAttribsARBRequest(c *xgb.Conn, Context Context, Fbconfig Fbcon
 
 	b += 3 // padding
 
-	xgb.Put32(buf[b:], NumAttribs)
+	order.Put32(buf[b:], NumAttribs)
 	b += 4
 
 	for i := 0; i < int((int(NumAttribs) * 2)); i++ {
-		xgb.Put32(buf[b:], Attribs[i])
+		order.Put32(buf[b:], Attribs[i])
 		b += 4
 	}
 
 	return buf
 }
 
+// CreateContextAttribsARBRequest represents the data of a CreateContextAttribsARB request, as it is read when tracing a connection.
+type CreateContextAttribsARBRequest struct {
+	Context   Context
+	Fbconfig  Fbconfig
+	Screen    uint32
+	ShareList Context
+	IsDirect  bool
+	// padding: 3 bytes
+	NumAttribs uint32
+	Attribs    []uint32 // size: xgb.Pad(((int(NumAttribs) * 2) * 4))
+}
+
+// createContextAttribsARBRequestRead reads a byte slice in the byte order 'order' into a CreateContextAttribsARBRequest value.
+func createContextAttribsARBRequestRead(buf []byte, order xgb.ByteOrder) *CreateContextAttribsARBRequest {
+	v := new(CreateContextAttribsARBRequest)
+	b := 4 // skip request header
+
+	v.Context = Context(order.Get32(buf[b:]))
+	b += 4
+
+	v.Fbconfig = Fbconfig(order.Get32(buf[b:]))
+	b += 4
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	v.ShareList = Context(order.Get32(buf[b:]))
+	b += 4
+
+	if buf[b] == 1 {
+		v.IsDirect = true
+	} else {
+		v.IsDirect = false
+	}
+	b += 1
+
+	b += 3 // padding
+
+	v.NumAttribs = order.Get32(buf[b:])
+	b += 4
+
+	v.Attribs = make([]uint32, (int(v.NumAttribs) * 2))
+	for i := 0; i < int((int(v.NumAttribs) * 2)); i++ {
+		v.Attribs[i] = order.Get32(buf[b:])
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][34] = xgb.RequestInfo{
+		Name: "CreateContextAttribsARB",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createContextAttribsARBRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateGLXPixmapCookie is a cookie used only for CreateGLXPixmap requests.
 type CreateGLXPixmapCookie struct {
 	*xgb.Cookie
@@ -1548,12 +1901,26 @@ func (cook CreateGLXPixmapCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateGLXPixmapCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateGLXPixmap
 // createGLXPixmapRequest writes a CreateGLXPixmap request to a byte slice.
 func createGLXPixmapRequest(c *xgb.Conn, Screen uint32, Visual xproto.Visualid, Pixmap xproto.Pixmap, GlxPixmap Pixmap) []byte {
+	return AppendCreateGLXPixmapRequest(nil, c, Screen, Visual, Pixmap, GlxPixmap)
+}
+
+// AppendCreateGLXPixmapRequest appends a CreateGLXPixmap request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateGLXPixmapRequest(buf []byte, c *xgb.Conn, Screen uint32, Visual xproto.Visualid, Pixmap xproto.Pixmap, GlxPixmap Pixmap) []byte {
 	size := 20
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -1563,24 +1930,61 @@ func createGLXPixmapRequest(c *xgb.Conn, Screen uint32, Visual xproto.Visualid,
 	buf[b] = 13 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Visual))
+	order.Put32(buf[b:], uint32(Visual))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Pixmap))
+	order.Put32(buf[b:], uint32(Pixmap))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(GlxPixmap))
+	order.Put32(buf[b:], uint32(GlxPixmap))
 	b += 4
 
 	return buf
 }
 
+// CreateGLXPixmapRequest represents the data of a CreateGLXPixmap request, as it is read when tracing a connection.
+type CreateGLXPixmapRequest struct {
+	Screen    uint32
+	Visual    xproto.Visualid
+	Pixmap    xproto.Pixmap
+	GlxPixmap Pixmap
+}
+
+// createGLXPixmapRequestRead reads a byte slice in the byte order 'order' into a CreateGLXPixmapRequest value.
+func createGLXPixmapRequestRead(buf []byte, order xgb.ByteOrder) *CreateGLXPixmapRequest {
+	v := new(CreateGLXPixmapRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	v.Visual = xproto.Visualid(order.Get32(buf[b:]))
+	b += 4
+
+	v.Pixmap = xproto.Pixmap(order.Get32(buf[b:]))
+	b += 4
+
+	v.GlxPixmap = Pixmap(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][13] = xgb.RequestInfo{
+		Name: "CreateGLXPixmap",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createGLXPixmapRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateNewContextCookie is a cookie used only for CreateNewContext requests.
 type CreateNewContextCookie struct {
 	*xgb.Cookie
@@ -1618,12 +2022,26 @@ func (cook CreateNewContextCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateNewContextCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateNewContext
 // createNewContextRequest writes a CreateNewContext request to a byte slice.
 func createNewContextRequest(c *xgb.Conn, Context Context, Fbconfig Fbconfig, Screen uint32, RenderType uint32, ShareList Context, IsDirect bool) []byte {
+	return AppendCreateNewContextRequest(nil, c, Context, Fbconfig, Screen, RenderType, ShareList, IsDirect)
+}
+
+// AppendCreateNewContextRequest appends a CreateNewContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateNewContextRequest(buf []byte, c *xgb.Conn, Context Context, Fbconfig Fbconfig, Screen uint32, RenderType uint32, ShareList Context, IsDirect bool) []byte {
 	size := 28
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -1633,22 +2051,22 @@ func createNewContextRequest(c *xgb.Conn, Context Context, Fbconfig Fbconfig, Sc
 	buf[b] = 24 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Fbconfig))
+	order.Put32(buf[b:], uint32(Fbconfig))
 	b += 4
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
-	xgb.Put32(buf[b:], RenderType)
+	order.Put32(buf[b:], RenderType)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(ShareList))
+	order.Put32(buf[b:], uint32(ShareList))
 	b += 4
 
 	if IsDirect {
@@ -1663,6 +2081,58 @@ func createNewContextRequest(c *xgb.Conn, Context Context, Fbconfig Fbconfig, Sc
 	return buf
 }
 
+// CreateNewContextRequest represents the data of a CreateNewContext request, as it is read when tracing a connection.
+type CreateNewContextRequest struct {
+	Context    Context
+	Fbconfig   Fbconfig
+	Screen     uint32
+	RenderType uint32
+	ShareList  Context
+	IsDirect   bool
+	// padding: 3 bytes
+}
+
+// createNewContextRequestRead reads a byte slice in the byte order 'order' into a CreateNewContextRequest value.
+func createNewContextRequestRead(buf []byte, order xgb.ByteOrder) *CreateNewContextRequest {
+	v := new(CreateNewContextRequest)
+	b := 4 // skip request header
+
+	v.Context = Context(order.Get32(buf[b:]))
+	b += 4
+
+	v.Fbconfig = Fbconfig(order.Get32(buf[b:]))
+	b += 4
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	v.RenderType = order.Get32(buf[b:])
+	b += 4
+
+	v.ShareList = Context(order.Get32(buf[b:]))
+	b += 4
+
+	if buf[b] == 1 {
+		v.IsDirect = true
+	} else {
+		v.IsDirect = false
+	}
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][24] = xgb.RequestInfo{
+		Name: "CreateNewContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createNewContextRequestRead(buf, order)
+		},
+	}
+}
+
 // CreatePbufferCookie is a cookie used only for CreatePbuffer requests.
 type CreatePbufferCookie struct {
 	*xgb.Cookie
@@ -1700,12 +2170,26 @@ func (cook CreatePbufferCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreatePbufferCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreatePbuffer
 // createPbufferRequest writes a CreatePbuffer request to a byte slice.
 func createPbufferRequest(c *xgb.Conn, Screen uint32, Fbconfig Fbconfig, Pbuffer Pbuffer, NumAttribs uint32, Attribs []uint32) []byte {
+	return AppendCreatePbufferRequest(nil, c, Screen, Fbconfig, Pbuffer, NumAttribs, Attribs)
+}
+
+// AppendCreatePbufferRequest appends a CreatePbuffer request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreatePbufferRequest(buf []byte, c *xgb.Conn, Screen uint32, Fbconfig Fbconfig, Pbuffer Pbuffer, NumAttribs uint32, Attribs []uint32) []byte {
 	size := xgb.Pad((20 + xgb.Pad(((int(NumAttribs) * 2) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -1715,40 +2199,84 @@ func createPbufferRequest(c *xgb.Conn, Screen uint32, Fbconfig Fbconfig, Pbuffer
 	buf[b] = 27 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Fbconfig))
+	order.Put32(buf[b:], uint32(Fbconfig))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Pbuffer))
+	order.Put32(buf[b:], uint32(Pbuffer))
 	b += 4
 
-	xgb.Put32(buf[b:], NumAttribs)
+	order.Put32(buf[b:], NumAttribs)
 	b += 4
 
 	for i := 0; i < int((int(NumAttribs) * 2)); i++ {
-		xgb.Put32(buf[b:], Attribs[i])
+		order.Put32(buf[b:], Attribs[i])
 		b += 4
 	}
 
 	return buf
 }
 
-// CreatePixmapCookie is a cookie used only for CreatePixmap requests.
-type CreatePixmapCookie struct {
-	*xgb.Cookie
+// CreatePbufferRequest represents the data of a CreatePbuffer request, as it is read when tracing a connection.
+type CreatePbufferRequest struct {
+	Screen     uint32
+	Fbconfig   Fbconfig
+	Pbuffer    Pbuffer
+	NumAttribs uint32
+	Attribs    []uint32 // size: xgb.Pad(((int(NumAttribs) * 2) * 4))
 }
 
-// CreatePixmap sends an unchecked request.
-// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func CreatePixmap(c *xgb.Conn, Screen uint32, Fbconfig Fbconfig, Pixmap xproto.Pixmap, GlxPixmap Pixmap, NumAttribs uint32, Attribs []uint32) CreatePixmapCookie {
-	c.ExtLock.RLock()
-	defer c.ExtLock.RUnlock()
-	if _, ok := c.Extensions["GLX"]; !ok {
+// createPbufferRequestRead reads a byte slice in the byte order 'order' into a CreatePbufferRequest value.
+func createPbufferRequestRead(buf []byte, order xgb.ByteOrder) *CreatePbufferRequest {
+	v := new(CreatePbufferRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	v.Fbconfig = Fbconfig(order.Get32(buf[b:]))
+	b += 4
+
+	v.Pbuffer = Pbuffer(order.Get32(buf[b:]))
+	b += 4
+
+	v.NumAttribs = order.Get32(buf[b:])
+	b += 4
+
+	v.Attribs = make([]uint32, (int(v.NumAttribs) * 2))
+	for i := 0; i < int((int(v.NumAttribs) * 2)); i++ {
+		v.Attribs[i] = order.Get32(buf[b:])
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][27] = xgb.RequestInfo{
+		Name: "CreatePbuffer",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createPbufferRequestRead(buf, order)
+		},
+	}
+}
+
+// CreatePixmapCookie is a cookie used only for CreatePixmap requests.
+type CreatePixmapCookie struct {
+	*xgb.Cookie
+}
+
+// CreatePixmap sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func CreatePixmap(c *xgb.Conn, Screen uint32, Fbconfig Fbconfig, Pixmap xproto.Pixmap, GlxPixmap Pixmap, NumAttribs uint32, Attribs []uint32) CreatePixmapCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["GLX"]; !ok {
 		panic("Cannot issue request 'CreatePixmap' using the uninitialized extension 'GLX'. glx.Init(connObj) must be called first.")
 	}
 	cookie := c.NewCookie(false, false)
@@ -1775,12 +2303,26 @@ func (cook CreatePixmapCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreatePixmapCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreatePixmap
 // createPixmapRequest writes a CreatePixmap request to a byte slice.
 func createPixmapRequest(c *xgb.Conn, Screen uint32, Fbconfig Fbconfig, Pixmap xproto.Pixmap, GlxPixmap Pixmap, NumAttribs uint32, Attribs []uint32) []byte {
+	return AppendCreatePixmapRequest(nil, c, Screen, Fbconfig, Pixmap, GlxPixmap, NumAttribs, Attribs)
+}
+
+// AppendCreatePixmapRequest appends a CreatePixmap request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreatePixmapRequest(buf []byte, c *xgb.Conn, Screen uint32, Fbconfig Fbconfig, Pixmap xproto.Pixmap, GlxPixmap Pixmap, NumAttribs uint32, Attribs []uint32) []byte {
 	size := xgb.Pad((24 + xgb.Pad(((int(NumAttribs) * 2) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -1790,32 +2332,80 @@ func createPixmapRequest(c *xgb.Conn, Screen uint32, Fbconfig Fbconfig, Pixmap x
 	buf[b] = 22 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Fbconfig))
+	order.Put32(buf[b:], uint32(Fbconfig))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Pixmap))
+	order.Put32(buf[b:], uint32(Pixmap))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(GlxPixmap))
+	order.Put32(buf[b:], uint32(GlxPixmap))
 	b += 4
 
-	xgb.Put32(buf[b:], NumAttribs)
+	order.Put32(buf[b:], NumAttribs)
 	b += 4
 
 	for i := 0; i < int((int(NumAttribs) * 2)); i++ {
-		xgb.Put32(buf[b:], Attribs[i])
+		order.Put32(buf[b:], Attribs[i])
 		b += 4
 	}
 
 	return buf
 }
 
+// CreatePixmapRequest represents the data of a CreatePixmap request, as it is read when tracing a connection.
+type CreatePixmapRequest struct {
+	Screen     uint32
+	Fbconfig   Fbconfig
+	Pixmap     xproto.Pixmap
+	GlxPixmap  Pixmap
+	NumAttribs uint32
+	Attribs    []uint32 // size: xgb.Pad(((int(NumAttribs) * 2) * 4))
+}
+
+// createPixmapRequestRead reads a byte slice in the byte order 'order' into a CreatePixmapRequest value.
+func createPixmapRequestRead(buf []byte, order xgb.ByteOrder) *CreatePixmapRequest {
+	v := new(CreatePixmapRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	v.Fbconfig = Fbconfig(order.Get32(buf[b:]))
+	b += 4
+
+	v.Pixmap = xproto.Pixmap(order.Get32(buf[b:]))
+	b += 4
+
+	v.GlxPixmap = Pixmap(order.Get32(buf[b:]))
+	b += 4
+
+	v.NumAttribs = order.Get32(buf[b:])
+	b += 4
+
+	v.Attribs = make([]uint32, (int(v.NumAttribs) * 2))
+	for i := 0; i < int((int(v.NumAttribs) * 2)); i++ {
+		v.Attribs[i] = order.Get32(buf[b:])
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][22] = xgb.RequestInfo{
+		Name: "CreatePixmap",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createPixmapRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateWindowCookie is a cookie used only for CreateWindow requests.
 type CreateWindowCookie struct {
 	*xgb.Cookie
@@ -1853,12 +2443,26 @@ func (cook CreateWindowCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateWindowCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateWindow
 // createWindowRequest writes a CreateWindow request to a byte slice.
 func createWindowRequest(c *xgb.Conn, Screen uint32, Fbconfig Fbconfig, Window xproto.Window, GlxWindow Window, NumAttribs uint32, Attribs []uint32) []byte {
+	return AppendCreateWindowRequest(nil, c, Screen, Fbconfig, Window, GlxWindow, NumAttribs, Attribs)
+}
+
+// AppendCreateWindowRequest appends a CreateWindow request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateWindowRequest(buf []byte, c *xgb.Conn, Screen uint32, Fbconfig Fbconfig, Window xproto.Window, GlxWindow Window, NumAttribs uint32, Attribs []uint32) []byte {
 	size := xgb.Pad((24 + xgb.Pad(((int(NumAttribs) * 2) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -1868,32 +2472,80 @@ func createWindowRequest(c *xgb.Conn, Screen uint32, Fbconfig Fbconfig, Window x
 	buf[b] = 31 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Fbconfig))
+	order.Put32(buf[b:], uint32(Fbconfig))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(GlxWindow))
+	order.Put32(buf[b:], uint32(GlxWindow))
 	b += 4
 
-	xgb.Put32(buf[b:], NumAttribs)
+	order.Put32(buf[b:], NumAttribs)
 	b += 4
 
 	for i := 0; i < int((int(NumAttribs) * 2)); i++ {
-		xgb.Put32(buf[b:], Attribs[i])
+		order.Put32(buf[b:], Attribs[i])
 		b += 4
 	}
 
 	return buf
 }
 
+// CreateWindowRequest represents the data of a CreateWindow request, as it is read when tracing a connection.
+type CreateWindowRequest struct {
+	Screen     uint32
+	Fbconfig   Fbconfig
+	Window     xproto.Window
+	GlxWindow  Window
+	NumAttribs uint32
+	Attribs    []uint32 // size: xgb.Pad(((int(NumAttribs) * 2) * 4))
+}
+
+// createWindowRequestRead reads a byte slice in the byte order 'order' into a CreateWindowRequest value.
+func createWindowRequestRead(buf []byte, order xgb.ByteOrder) *CreateWindowRequest {
+	v := new(CreateWindowRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	v.Fbconfig = Fbconfig(order.Get32(buf[b:]))
+	b += 4
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.GlxWindow = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.NumAttribs = order.Get32(buf[b:])
+	b += 4
+
+	v.Attribs = make([]uint32, (int(v.NumAttribs) * 2))
+	for i := 0; i < int((int(v.NumAttribs) * 2)); i++ {
+		v.Attribs[i] = order.Get32(buf[b:])
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][31] = xgb.RequestInfo{
+		Name: "CreateWindow",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createWindowRequestRead(buf, order)
+		},
+	}
+}
+
 // DeleteListsCookie is a cookie used only for DeleteLists requests.
 type DeleteListsCookie struct {
 	*xgb.Cookie
@@ -1931,12 +2583,26 @@ func (cook DeleteListsCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DeleteListsCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DeleteLists
 // deleteListsRequest writes a DeleteLists request to a byte slice.
 func deleteListsRequest(c *xgb.Conn, ContextTag ContextTag, List uint32, Range int32) []byte {
+	return AppendDeleteListsRequest(nil, c, ContextTag, List, Range)
+}
+
+// AppendDeleteListsRequest appends a DeleteLists request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDeleteListsRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, List uint32, Range int32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -1946,21 +2612,54 @@ func deleteListsRequest(c *xgb.Conn, ContextTag ContextTag, List uint32, Range i
 	buf[b] = 103 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], List)
+	order.Put32(buf[b:], List)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Range))
+	order.Put32(buf[b:], uint32(Range))
 	b += 4
 
 	return buf
 }
 
+// DeleteListsRequest represents the data of a DeleteLists request, as it is read when tracing a connection.
+type DeleteListsRequest struct {
+	ContextTag ContextTag
+	List       uint32
+	Range      int32
+}
+
+// deleteListsRequestRead reads a byte slice in the byte order 'order' into a DeleteListsRequest value.
+func deleteListsRequestRead(buf []byte, order xgb.ByteOrder) *DeleteListsRequest {
+	v := new(DeleteListsRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.List = order.Get32(buf[b:])
+	b += 4
+
+	v.Range = int32(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][103] = xgb.RequestInfo{
+		Name: "DeleteLists",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return deleteListsRequestRead(buf, order)
+		},
+	}
+}
+
 // DeleteQueriesARBCookie is a cookie used only for DeleteQueriesARB requests.
 type DeleteQueriesARBCookie struct {
 	*xgb.Cookie
@@ -1998,12 +2697,26 @@ func (cook DeleteQueriesARBCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DeleteQueriesARBCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DeleteQueriesARB
 // deleteQueriesARBRequest writes a DeleteQueriesARB request to a byte slice.
 func deleteQueriesARBRequest(c *xgb.Conn, ContextTag ContextTag, N int32, Ids []uint32) []byte {
+	return AppendDeleteQueriesARBRequest(nil, c, ContextTag, N, Ids)
+}
+
+// AppendDeleteQueriesARBRequest appends a DeleteQueriesARB request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDeleteQueriesARBRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, N int32, Ids []uint32) []byte {
 	size := xgb.Pad((12 + xgb.Pad((int(N) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -2013,23 +2726,59 @@ func deleteQueriesARBRequest(c *xgb.Conn, ContextTag ContextTag, N int32, Ids []
 	buf[b] = 161 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(N))
+	order.Put32(buf[b:], uint32(N))
 	b += 4
 
 	for i := 0; i < int(N); i++ {
-		xgb.Put32(buf[b:], Ids[i])
+		order.Put32(buf[b:], Ids[i])
 		b += 4
 	}
 
 	return buf
 }
 
+// DeleteQueriesARBRequest represents the data of a DeleteQueriesARB request, as it is read when tracing a connection.
+type DeleteQueriesARBRequest struct {
+	ContextTag ContextTag
+	N          int32
+	Ids        []uint32 // size: xgb.Pad((int(N) * 4))
+}
+
+// deleteQueriesARBRequestRead reads a byte slice in the byte order 'order' into a DeleteQueriesARBRequest value.
+func deleteQueriesARBRequestRead(buf []byte, order xgb.ByteOrder) *DeleteQueriesARBRequest {
+	v := new(DeleteQueriesARBRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.N = int32(order.Get32(buf[b:]))
+	b += 4
+
+	v.Ids = make([]uint32, v.N)
+	for i := 0; i < int(v.N); i++ {
+		v.Ids[i] = order.Get32(buf[b:])
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][161] = xgb.RequestInfo{
+		Name: "DeleteQueriesARB",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return deleteQueriesARBRequestRead(buf, order)
+		},
+	}
+}
+
 // DeleteTexturesCookie is a cookie used only for DeleteTextures requests.
 type DeleteTexturesCookie struct {
 	*xgb.Cookie
@@ -2067,12 +2816,26 @@ func (cook DeleteTexturesCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DeleteTexturesCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DeleteTextures
 // deleteTexturesRequest writes a DeleteTextures request to a byte slice.
 func deleteTexturesRequest(c *xgb.Conn, ContextTag ContextTag, N int32, Textures []uint32) []byte {
+	return AppendDeleteTexturesRequest(nil, c, ContextTag, N, Textures)
+}
+
+// AppendDeleteTexturesRequest appends a DeleteTextures request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDeleteTexturesRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, N int32, Textures []uint32) []byte {
 	size := xgb.Pad((12 + xgb.Pad((int(N) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -2082,23 +2845,59 @@ func deleteTexturesRequest(c *xgb.Conn, ContextTag ContextTag, N int32, Textures
 	buf[b] = 144 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(N))
+	order.Put32(buf[b:], uint32(N))
 	b += 4
 
 	for i := 0; i < int(N); i++ {
-		xgb.Put32(buf[b:], Textures[i])
+		order.Put32(buf[b:], Textures[i])
 		b += 4
 	}
 
 	return buf
 }
 
+// DeleteTexturesRequest represents the data of a DeleteTextures request, as it is read when tracing a connection.
+type DeleteTexturesRequest struct {
+	ContextTag ContextTag
+	N          int32
+	Textures   []uint32 // size: xgb.Pad((int(N) * 4))
+}
+
+// deleteTexturesRequestRead reads a byte slice in the byte order 'order' into a DeleteTexturesRequest value.
+func deleteTexturesRequestRead(buf []byte, order xgb.ByteOrder) *DeleteTexturesRequest {
+	v := new(DeleteTexturesRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.N = int32(order.Get32(buf[b:]))
+	b += 4
+
+	v.Textures = make([]uint32, v.N)
+	for i := 0; i < int(v.N); i++ {
+		v.Textures[i] = order.Get32(buf[b:])
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][144] = xgb.RequestInfo{
+		Name: "DeleteTextures",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return deleteTexturesRequestRead(buf, order)
+		},
+	}
+}
+
 // DeleteWindowCookie is a cookie used only for DeleteWindow requests.
 type DeleteWindowCookie struct {
 	*xgb.Cookie
@@ -2136,12 +2935,26 @@ func (cook DeleteWindowCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DeleteWindowCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DeleteWindow
 // deleteWindowRequest writes a DeleteWindow request to a byte slice.
 func deleteWindowRequest(c *xgb.Conn, Glxwindow Window) []byte {
+	return AppendDeleteWindowRequest(nil, c, Glxwindow)
+}
+
+// AppendDeleteWindowRequest appends a DeleteWindow request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDeleteWindowRequest(buf []byte, c *xgb.Conn, Glxwindow Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -2151,15 +2964,40 @@ func deleteWindowRequest(c *xgb.Conn, Glxwindow Window) []byte {
 	buf[b] = 32 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Glxwindow))
+	order.Put32(buf[b:], uint32(Glxwindow))
 	b += 4
 
 	return buf
 }
 
+// DeleteWindowRequest represents the data of a DeleteWindow request, as it is read when tracing a connection.
+type DeleteWindowRequest struct {
+	Glxwindow Window
+}
+
+// deleteWindowRequestRead reads a byte slice in the byte order 'order' into a DeleteWindowRequest value.
+func deleteWindowRequestRead(buf []byte, order xgb.ByteOrder) *DeleteWindowRequest {
+	v := new(DeleteWindowRequest)
+	b := 4 // skip request header
+
+	v.Glxwindow = Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][32] = xgb.RequestInfo{
+		Name: "DeleteWindow",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return deleteWindowRequestRead(buf, order)
+		},
+	}
+}
+
 // DestroyContextCookie is a cookie used only for DestroyContext requests.
 type DestroyContextCookie struct {
 	*xgb.Cookie
@@ -2197,12 +3035,26 @@ func (cook DestroyContextCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DestroyContextCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DestroyContext
 // destroyContextRequest writes a DestroyContext request to a byte slice.
 func destroyContextRequest(c *xgb.Conn, Context Context) []byte {
+	return AppendDestroyContextRequest(nil, c, Context)
+}
+
+// AppendDestroyContextRequest appends a DestroyContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDestroyContextRequest(buf []byte, c *xgb.Conn, Context Context) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -2212,15 +3064,40 @@ func destroyContextRequest(c *xgb.Conn, Context Context) []byte {
 	buf[b] = 4 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
 	return buf
 }
 
+// DestroyContextRequest represents the data of a DestroyContext request, as it is read when tracing a connection.
+type DestroyContextRequest struct {
+	Context Context
+}
+
+// destroyContextRequestRead reads a byte slice in the byte order 'order' into a DestroyContextRequest value.
+func destroyContextRequestRead(buf []byte, order xgb.ByteOrder) *DestroyContextRequest {
+	v := new(DestroyContextRequest)
+	b := 4 // skip request header
+
+	v.Context = Context(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][4] = xgb.RequestInfo{
+		Name: "DestroyContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return destroyContextRequestRead(buf, order)
+		},
+	}
+}
+
 // DestroyGLXPixmapCookie is a cookie used only for DestroyGLXPixmap requests.
 type DestroyGLXPixmapCookie struct {
 	*xgb.Cookie
@@ -2258,12 +3135,26 @@ func (cook DestroyGLXPixmapCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DestroyGLXPixmapCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DestroyGLXPixmap
 // destroyGLXPixmapRequest writes a DestroyGLXPixmap request to a byte slice.
 func destroyGLXPixmapRequest(c *xgb.Conn, GlxPixmap Pixmap) []byte {
+	return AppendDestroyGLXPixmapRequest(nil, c, GlxPixmap)
+}
+
+// AppendDestroyGLXPixmapRequest appends a DestroyGLXPixmap request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDestroyGLXPixmapRequest(buf []byte, c *xgb.Conn, GlxPixmap Pixmap) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -2273,15 +3164,40 @@ func destroyGLXPixmapRequest(c *xgb.Conn, GlxPixmap Pixmap) []byte {
 	buf[b] = 15 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(GlxPixmap))
+	order.Put32(buf[b:], uint32(GlxPixmap))
 	b += 4
 
 	return buf
 }
 
+// DestroyGLXPixmapRequest represents the data of a DestroyGLXPixmap request, as it is read when tracing a connection.
+type DestroyGLXPixmapRequest struct {
+	GlxPixmap Pixmap
+}
+
+// destroyGLXPixmapRequestRead reads a byte slice in the byte order 'order' into a DestroyGLXPixmapRequest value.
+func destroyGLXPixmapRequestRead(buf []byte, order xgb.ByteOrder) *DestroyGLXPixmapRequest {
+	v := new(DestroyGLXPixmapRequest)
+	b := 4 // skip request header
+
+	v.GlxPixmap = Pixmap(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][15] = xgb.RequestInfo{
+		Name: "DestroyGLXPixmap",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return destroyGLXPixmapRequestRead(buf, order)
+		},
+	}
+}
+
 // DestroyPbufferCookie is a cookie used only for DestroyPbuffer requests.
 type DestroyPbufferCookie struct {
 	*xgb.Cookie
@@ -2319,12 +3235,26 @@ func (cook DestroyPbufferCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DestroyPbufferCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DestroyPbuffer
 // destroyPbufferRequest writes a DestroyPbuffer request to a byte slice.
 func destroyPbufferRequest(c *xgb.Conn, Pbuffer Pbuffer) []byte {
+	return AppendDestroyPbufferRequest(nil, c, Pbuffer)
+}
+
+// AppendDestroyPbufferRequest appends a DestroyPbuffer request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDestroyPbufferRequest(buf []byte, c *xgb.Conn, Pbuffer Pbuffer) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -2334,15 +3264,40 @@ func destroyPbufferRequest(c *xgb.Conn, Pbuffer Pbuffer) []byte {
 	buf[b] = 28 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Pbuffer))
+	order.Put32(buf[b:], uint32(Pbuffer))
 	b += 4
 
 	return buf
 }
 
+// DestroyPbufferRequest represents the data of a DestroyPbuffer request, as it is read when tracing a connection.
+type DestroyPbufferRequest struct {
+	Pbuffer Pbuffer
+}
+
+// destroyPbufferRequestRead reads a byte slice in the byte order 'order' into a DestroyPbufferRequest value.
+func destroyPbufferRequestRead(buf []byte, order xgb.ByteOrder) *DestroyPbufferRequest {
+	v := new(DestroyPbufferRequest)
+	b := 4 // skip request header
+
+	v.Pbuffer = Pbuffer(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][28] = xgb.RequestInfo{
+		Name: "DestroyPbuffer",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return destroyPbufferRequestRead(buf, order)
+		},
+	}
+}
+
 // DestroyPixmapCookie is a cookie used only for DestroyPixmap requests.
 type DestroyPixmapCookie struct {
 	*xgb.Cookie
@@ -2380,12 +3335,26 @@ func (cook DestroyPixmapCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DestroyPixmapCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DestroyPixmap
 // destroyPixmapRequest writes a DestroyPixmap request to a byte slice.
 func destroyPixmapRequest(c *xgb.Conn, GlxPixmap Pixmap) []byte {
+	return AppendDestroyPixmapRequest(nil, c, GlxPixmap)
+}
+
+// AppendDestroyPixmapRequest appends a DestroyPixmap request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDestroyPixmapRequest(buf []byte, c *xgb.Conn, GlxPixmap Pixmap) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -2395,15 +3364,40 @@ func destroyPixmapRequest(c *xgb.Conn, GlxPixmap Pixmap) []byte {
 	buf[b] = 23 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(GlxPixmap))
+	order.Put32(buf[b:], uint32(GlxPixmap))
 	b += 4
 
 	return buf
 }
 
+// DestroyPixmapRequest represents the data of a DestroyPixmap request, as it is read when tracing a connection.
+type DestroyPixmapRequest struct {
+	GlxPixmap Pixmap
+}
+
+// destroyPixmapRequestRead reads a byte slice in the byte order 'order' into a DestroyPixmapRequest value.
+func destroyPixmapRequestRead(buf []byte, order xgb.ByteOrder) *DestroyPixmapRequest {
+	v := new(DestroyPixmapRequest)
+	b := 4 // skip request header
+
+	v.GlxPixmap = Pixmap(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][23] = xgb.RequestInfo{
+		Name: "DestroyPixmap",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return destroyPixmapRequestRead(buf, order)
+		},
+	}
+}
+
 // EndListCookie is a cookie used only for EndList requests.
 type EndListCookie struct {
 	*xgb.Cookie
@@ -2441,12 +3435,26 @@ func (cook EndListCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook EndListCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for EndList
 // endListRequest writes a EndList request to a byte slice.
 func endListRequest(c *xgb.Conn, ContextTag ContextTag) []byte {
+	return AppendEndListRequest(nil, c, ContextTag)
+}
+
+// AppendEndListRequest appends a EndList request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendEndListRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -2456,15 +3464,40 @@ func endListRequest(c *xgb.Conn, ContextTag ContextTag) []byte {
 	buf[b] = 102 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
 	return buf
 }
 
+// EndListRequest represents the data of a EndList request, as it is read when tracing a connection.
+type EndListRequest struct {
+	ContextTag ContextTag
+}
+
+// endListRequestRead reads a byte slice in the byte order 'order' into a EndListRequest value.
+func endListRequestRead(buf []byte, order xgb.ByteOrder) *EndListRequest {
+	v := new(EndListRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][102] = xgb.RequestInfo{
+		Name: "EndList",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return endListRequestRead(buf, order)
+		},
+	}
+}
+
 // FeedbackBufferCookie is a cookie used only for FeedbackBuffer requests.
 type FeedbackBufferCookie struct {
 	*xgb.Cookie
@@ -2502,12 +3535,26 @@ func (cook FeedbackBufferCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook FeedbackBufferCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for FeedbackBuffer
 // feedbackBufferRequest writes a FeedbackBuffer request to a byte slice.
 func feedbackBufferRequest(c *xgb.Conn, ContextTag ContextTag, Size int32, Type int32) []byte {
+	return AppendFeedbackBufferRequest(nil, c, ContextTag, Size, Type)
+}
+
+// AppendFeedbackBufferRequest appends a FeedbackBuffer request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendFeedbackBufferRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Size int32, Type int32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -2517,21 +3564,54 @@ func feedbackBufferRequest(c *xgb.Conn, ContextTag ContextTag, Size int32, Type
 	buf[b] = 105 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Size))
+	order.Put32(buf[b:], uint32(Size))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Type))
+	order.Put32(buf[b:], uint32(Type))
 	b += 4
 
 	return buf
 }
 
+// FeedbackBufferRequest represents the data of a FeedbackBuffer request, as it is read when tracing a connection.
+type FeedbackBufferRequest struct {
+	ContextTag ContextTag
+	Size       int32
+	Type       int32
+}
+
+// feedbackBufferRequestRead reads a byte slice in the byte order 'order' into a FeedbackBufferRequest value.
+func feedbackBufferRequestRead(buf []byte, order xgb.ByteOrder) *FeedbackBufferRequest {
+	v := new(FeedbackBufferRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Size = int32(order.Get32(buf[b:]))
+	b += 4
+
+	v.Type = int32(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][105] = xgb.RequestInfo{
+		Name: "FeedbackBuffer",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return feedbackBufferRequestRead(buf, order)
+		},
+	}
+}
+
 // FinishCookie is a cookie used only for Finish requests.
 type FinishCookie struct {
 	*xgb.Cookie
@@ -2579,20 +3659,32 @@ func (cook FinishCookie) Reply() (*FinishReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return finishReply(buf), nil
+	return finishReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// finishReply reads a byte slice into a FinishReply value.
-func finishReply(buf []byte) *FinishReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a Finish request arrives.
+func (cook FinishCookie) ReplyContext(ctx context.Context) (*FinishReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return finishReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// finishReply reads a byte slice in the byte order 'order' into a FinishReply value.
+func finishReply(buf []byte, order xgb.ByteOrder) *FinishReply {
 	v := new(FinishReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	return v
@@ -2601,9 +3693,18 @@ func finishReply(buf []byte) *FinishReply {
 // Write request to wire for Finish
 // finishRequest writes a Finish request to a byte slice.
 func finishRequest(c *xgb.Conn, ContextTag ContextTag) []byte {
+	return AppendFinishRequest(nil, c, ContextTag)
+}
+
+// AppendFinishRequest appends a Finish request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendFinishRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -2613,15 +3714,43 @@ func finishRequest(c *xgb.Conn, ContextTag ContextTag) []byte {
 	buf[b] = 108 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
 	return buf
 }
 
+// FinishRequest represents the data of a Finish request, as it is read when tracing a connection.
+type FinishRequest struct {
+	ContextTag ContextTag
+}
+
+// finishRequestRead reads a byte slice in the byte order 'order' into a FinishRequest value.
+func finishRequestRead(buf []byte, order xgb.ByteOrder) *FinishRequest {
+	v := new(FinishRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][108] = xgb.RequestInfo{
+		Name: "Finish",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return finishRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return finishReply(buf, order)
+		},
+	}
+}
+
 // FlushCookie is a cookie used only for Flush requests.
 type FlushCookie struct {
 	*xgb.Cookie
@@ -2659,12 +3788,26 @@ func (cook FlushCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook FlushCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for Flush
 // flushRequest writes a Flush request to a byte slice.
 func flushRequest(c *xgb.Conn, ContextTag ContextTag) []byte {
+	return AppendFlushRequest(nil, c, ContextTag)
+}
+
+// AppendFlushRequest appends a Flush request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendFlushRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -2674,15 +3817,40 @@ func flushRequest(c *xgb.Conn, ContextTag ContextTag) []byte {
 	buf[b] = 142 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
 	return buf
 }
 
+// FlushRequest represents the data of a Flush request, as it is read when tracing a connection.
+type FlushRequest struct {
+	ContextTag ContextTag
+}
+
+// flushRequestRead reads a byte slice in the byte order 'order' into a FlushRequest value.
+func flushRequestRead(buf []byte, order xgb.ByteOrder) *FlushRequest {
+	v := new(FlushRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][142] = xgb.RequestInfo{
+		Name: "Flush",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return flushRequestRead(buf, order)
+		},
+	}
+}
+
 // GenListsCookie is a cookie used only for GenLists requests.
 type GenListsCookie struct {
 	*xgb.Cookie
@@ -2731,23 +3899,35 @@ func (cook GenListsCookie) Reply() (*GenListsReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return genListsReply(buf), nil
+	return genListsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// genListsReply reads a byte slice into a GenListsReply value.
-func genListsReply(buf []byte) *GenListsReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GenLists request arrives.
+func (cook GenListsCookie) ReplyContext(ctx context.Context) (*GenListsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return genListsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// genListsReply reads a byte slice in the byte order 'order' into a GenListsReply value.
+func genListsReply(buf []byte, order xgb.ByteOrder) *GenListsReply {
 	v := new(GenListsReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.RetVal = xgb.Get32(buf[b:])
+	v.RetVal = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -2756,9 +3936,18 @@ func genListsReply(buf []byte) *GenListsReply {
 // Write request to wire for GenLists
 // genListsRequest writes a GenLists request to a byte slice.
 func genListsRequest(c *xgb.Conn, ContextTag ContextTag, Range int32) []byte {
+	return AppendGenListsRequest(nil, c, ContextTag, Range)
+}
+
+// AppendGenListsRequest appends a GenLists request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGenListsRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Range int32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -2768,18 +3957,50 @@ func genListsRequest(c *xgb.Conn, ContextTag ContextTag, Range int32) []byte {
 	buf[b] = 104 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Range))
+	order.Put32(buf[b:], uint32(Range))
 	b += 4
 
 	return buf
 }
 
+// GenListsRequest represents the data of a GenLists request, as it is read when tracing a connection.
+type GenListsRequest struct {
+	ContextTag ContextTag
+	Range      int32
+}
+
+// genListsRequestRead reads a byte slice in the byte order 'order' into a GenListsRequest value.
+func genListsRequestRead(buf []byte, order xgb.ByteOrder) *GenListsRequest {
+	v := new(GenListsRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Range = int32(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][104] = xgb.RequestInfo{
+		Name: "GenLists",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return genListsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return genListsReply(buf, order)
+		},
+	}
+}
+
 // GenQueriesARBCookie is a cookie used only for GenQueriesARB requests.
 type GenQueriesARBCookie struct {
 	*xgb.Cookie
@@ -2829,27 +4050,39 @@ func (cook GenQueriesARBCookie) Reply() (*GenQueriesARBReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return genQueriesARBReply(buf), nil
+	return genQueriesARBReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// genQueriesARBReply reads a byte slice into a GenQueriesARBReply value.
-func genQueriesARBReply(buf []byte) *GenQueriesARBReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GenQueriesARB request arrives.
+func (cook GenQueriesARBCookie) ReplyContext(ctx context.Context) (*GenQueriesARBReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return genQueriesARBReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// genQueriesARBReply reads a byte slice in the byte order 'order' into a GenQueriesARBReply value.
+func genQueriesARBReply(buf []byte, order xgb.ByteOrder) *GenQueriesARBReply {
 	v := new(GenQueriesARBReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 24 // padding
 
 	v.Data = make([]uint32, v.Length)
 	for i := 0; i < int(v.Length); i++ {
-		v.Data[i] = xgb.Get32(buf[b:])
+		v.Data[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -2859,9 +4092,18 @@ func genQueriesARBReply(buf []byte) *GenQueriesARBReply {
 // Write request to wire for GenQueriesARB
 // genQueriesARBRequest writes a GenQueriesARB request to a byte slice.
 func genQueriesARBRequest(c *xgb.Conn, ContextTag ContextTag, N int32) []byte {
+	return AppendGenQueriesARBRequest(nil, c, ContextTag, N)
+}
+
+// AppendGenQueriesARBRequest appends a GenQueriesARB request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGenQueriesARBRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, N int32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -2871,18 +4113,50 @@ func genQueriesARBRequest(c *xgb.Conn, ContextTag ContextTag, N int32) []byte {
 	buf[b] = 162 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(N))
+	order.Put32(buf[b:], uint32(N))
 	b += 4
 
 	return buf
 }
 
+// GenQueriesARBRequest represents the data of a GenQueriesARB request, as it is read when tracing a connection.
+type GenQueriesARBRequest struct {
+	ContextTag ContextTag
+	N          int32
+}
+
+// genQueriesARBRequestRead reads a byte slice in the byte order 'order' into a GenQueriesARBRequest value.
+func genQueriesARBRequestRead(buf []byte, order xgb.ByteOrder) *GenQueriesARBRequest {
+	v := new(GenQueriesARBRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.N = int32(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][162] = xgb.RequestInfo{
+		Name: "GenQueriesARB",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return genQueriesARBRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return genQueriesARBReply(buf, order)
+		},
+	}
+}
+
 // GenTexturesCookie is a cookie used only for GenTextures requests.
 type GenTexturesCookie struct {
 	*xgb.Cookie
@@ -2932,27 +4206,39 @@ func (cook GenTexturesCookie) Reply() (*GenTexturesReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return genTexturesReply(buf), nil
+	return genTexturesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// genTexturesReply reads a byte slice into a GenTexturesReply value.
-func genTexturesReply(buf []byte) *GenTexturesReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GenTextures request arrives.
+func (cook GenTexturesCookie) ReplyContext(ctx context.Context) (*GenTexturesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return genTexturesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// genTexturesReply reads a byte slice in the byte order 'order' into a GenTexturesReply value.
+func genTexturesReply(buf []byte, order xgb.ByteOrder) *GenTexturesReply {
 	v := new(GenTexturesReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 24 // padding
 
 	v.Data = make([]uint32, v.Length)
 	for i := 0; i < int(v.Length); i++ {
-		v.Data[i] = xgb.Get32(buf[b:])
+		v.Data[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -2962,9 +4248,18 @@ func genTexturesReply(buf []byte) *GenTexturesReply {
 // Write request to wire for GenTextures
 // genTexturesRequest writes a GenTextures request to a byte slice.
 func genTexturesRequest(c *xgb.Conn, ContextTag ContextTag, N int32) []byte {
+	return AppendGenTexturesRequest(nil, c, ContextTag, N)
+}
+
+// AppendGenTexturesRequest appends a GenTextures request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGenTexturesRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, N int32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -2974,18 +4269,50 @@ func genTexturesRequest(c *xgb.Conn, ContextTag ContextTag, N int32) []byte {
 	buf[b] = 145 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(N))
+	order.Put32(buf[b:], uint32(N))
 	b += 4
 
 	return buf
 }
 
+// GenTexturesRequest represents the data of a GenTextures request, as it is read when tracing a connection.
+type GenTexturesRequest struct {
+	ContextTag ContextTag
+	N          int32
+}
+
+// genTexturesRequestRead reads a byte slice in the byte order 'order' into a GenTexturesRequest value.
+func genTexturesRequestRead(buf []byte, order xgb.ByteOrder) *GenTexturesRequest {
+	v := new(GenTexturesRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.N = int32(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][145] = xgb.RequestInfo{
+		Name: "GenTextures",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return genTexturesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return genTexturesReply(buf, order)
+		},
+	}
+}
+
 // GetBooleanvCookie is a cookie used only for GetBooleanv requests.
 type GetBooleanvCookie struct {
 	*xgb.Cookie
@@ -3029,34 +4356,46 @@ type GetBooleanvReply struct {
 	Data []bool // size: xgb.Pad((int(N) * 1))
 }
 
-// Reply blocks and returns the reply data for a GetBooleanv request.
-func (cook GetBooleanvCookie) Reply() (*GetBooleanvReply, error) {
-	buf, err := cook.Cookie.Reply()
+// Reply blocks and returns the reply data for a GetBooleanv request.
+func (cook GetBooleanvCookie) Reply() (*GetBooleanvReply, error) {
+	buf, err := cook.Cookie.Reply()
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getBooleanvReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetBooleanv request arrives.
+func (cook GetBooleanvCookie) ReplyContext(ctx context.Context) (*GetBooleanvReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 	if buf == nil {
 		return nil, nil
 	}
-	return getBooleanvReply(buf), nil
+	return getBooleanvReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getBooleanvReply reads a byte slice into a GetBooleanvReply value.
-func getBooleanvReply(buf []byte) *GetBooleanvReply {
+// getBooleanvReply reads a byte slice in the byte order 'order' into a GetBooleanvReply value.
+func getBooleanvReply(buf []byte, order xgb.ByteOrder) *GetBooleanvReply {
 	v := new(GetBooleanvReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
 	if buf[b] == 1 {
@@ -3084,9 +4423,18 @@ func getBooleanvReply(buf []byte) *GetBooleanvReply {
 // Write request to wire for GetBooleanv
 // getBooleanvRequest writes a GetBooleanv request to a byte slice.
 func getBooleanvRequest(c *xgb.Conn, ContextTag ContextTag, Pname int32) []byte {
+	return AppendGetBooleanvRequest(nil, c, ContextTag, Pname)
+}
+
+// AppendGetBooleanvRequest appends a GetBooleanv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetBooleanvRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Pname int32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -3096,18 +4444,50 @@ func getBooleanvRequest(c *xgb.Conn, ContextTag ContextTag, Pname int32) []byte
 	buf[b] = 112 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Pname))
+	order.Put32(buf[b:], uint32(Pname))
 	b += 4
 
 	return buf
 }
 
+// GetBooleanvRequest represents the data of a GetBooleanv request, as it is read when tracing a connection.
+type GetBooleanvRequest struct {
+	ContextTag ContextTag
+	Pname      int32
+}
+
+// getBooleanvRequestRead reads a byte slice in the byte order 'order' into a GetBooleanvRequest value.
+func getBooleanvRequestRead(buf []byte, order xgb.ByteOrder) *GetBooleanvRequest {
+	v := new(GetBooleanvRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Pname = int32(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][112] = xgb.RequestInfo{
+		Name: "GetBooleanv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getBooleanvRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getBooleanvReply(buf, order)
+		},
+	}
+}
+
 // GetClipPlaneCookie is a cookie used only for GetClipPlane requests.
 type GetClipPlaneCookie struct {
 	*xgb.Cookie
@@ -3157,27 +4537,39 @@ func (cook GetClipPlaneCookie) Reply() (*GetClipPlaneReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getClipPlaneReply(buf), nil
+	return getClipPlaneReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetClipPlane request arrives.
+func (cook GetClipPlaneCookie) ReplyContext(ctx context.Context) (*GetClipPlaneReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getClipPlaneReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getClipPlaneReply reads a byte slice into a GetClipPlaneReply value.
-func getClipPlaneReply(buf []byte) *GetClipPlaneReply {
+// getClipPlaneReply reads a byte slice in the byte order 'order' into a GetClipPlaneReply value.
+func getClipPlaneReply(buf []byte, order xgb.ByteOrder) *GetClipPlaneReply {
 	v := new(GetClipPlaneReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 24 // padding
 
 	v.Data = make([]Float64, (int(v.Length) / 2))
 	for i := 0; i < int((int(v.Length) / 2)); i++ {
-		v.Data[i] = Float64(xgb.Get64(buf[b:]))
+		v.Data[i] = Float64(order.Get64(buf[b:]))
 		b += 8
 	}
 
@@ -3187,9 +4579,18 @@ func getClipPlaneReply(buf []byte) *GetClipPlaneReply {
 // Write request to wire for GetClipPlane
 // getClipPlaneRequest writes a GetClipPlane request to a byte slice.
 func getClipPlaneRequest(c *xgb.Conn, ContextTag ContextTag, Plane int32) []byte {
+	return AppendGetClipPlaneRequest(nil, c, ContextTag, Plane)
+}
+
+// AppendGetClipPlaneRequest appends a GetClipPlane request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetClipPlaneRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Plane int32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -3199,18 +4600,50 @@ func getClipPlaneRequest(c *xgb.Conn, ContextTag ContextTag, Plane int32) []byte
 	buf[b] = 113 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Plane))
+	order.Put32(buf[b:], uint32(Plane))
 	b += 4
 
 	return buf
 }
 
+// GetClipPlaneRequest represents the data of a GetClipPlane request, as it is read when tracing a connection.
+type GetClipPlaneRequest struct {
+	ContextTag ContextTag
+	Plane      int32
+}
+
+// getClipPlaneRequestRead reads a byte slice in the byte order 'order' into a GetClipPlaneRequest value.
+func getClipPlaneRequestRead(buf []byte, order xgb.ByteOrder) *GetClipPlaneRequest {
+	v := new(GetClipPlaneRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Plane = int32(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][113] = xgb.RequestInfo{
+		Name: "GetClipPlane",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getClipPlaneRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getClipPlaneReply(buf, order)
+		},
+	}
+}
+
 // GetColorTableCookie is a cookie used only for GetColorTable requests.
 type GetColorTableCookie struct {
 	*xgb.Cookie
@@ -3262,25 +4695,37 @@ func (cook GetColorTableCookie) Reply() (*GetColorTableReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getColorTableReply(buf), nil
+	return getColorTableReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetColorTable request arrives.
+func (cook GetColorTableCookie) ReplyContext(ctx context.Context) (*GetColorTableReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getColorTableReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getColorTableReply reads a byte slice into a GetColorTableReply value.
-func getColorTableReply(buf []byte) *GetColorTableReply {
+// getColorTableReply reads a byte slice in the byte order 'order' into a GetColorTableReply value.
+func getColorTableReply(buf []byte, order xgb.ByteOrder) *GetColorTableReply {
 	v := new(GetColorTableReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 8 // padding
 
-	v.Width = int32(xgb.Get32(buf[b:]))
+	v.Width = int32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
@@ -3295,9 +4740,18 @@ func getColorTableReply(buf []byte) *GetColorTableReply {
 // Write request to wire for GetColorTable
 // getColorTableRequest writes a GetColorTable request to a byte slice.
 func getColorTableRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Format uint32, Type uint32, SwapBytes bool) []byte {
+	return AppendGetColorTableRequest(nil, c, ContextTag, Target, Format, Type, SwapBytes)
+}
+
+// AppendGetColorTableRequest appends a GetColorTable request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetColorTableRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Format uint32, Type uint32, SwapBytes bool) []byte {
 	size := 24
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -3307,19 +4761,19 @@ func getColorTableRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, For
 	buf[b] = 147 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], Format)
+	order.Put32(buf[b:], Format)
 	b += 4
 
-	xgb.Put32(buf[b:], Type)
+	order.Put32(buf[b:], Type)
 	b += 4
 
 	if SwapBytes {
@@ -3332,6 +4786,54 @@ func getColorTableRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, For
 	return buf
 }
 
+// GetColorTableRequest represents the data of a GetColorTable request, as it is read when tracing a connection.
+type GetColorTableRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Format     uint32
+	Type       uint32
+	SwapBytes  bool
+}
+
+// getColorTableRequestRead reads a byte slice in the byte order 'order' into a GetColorTableRequest value.
+func getColorTableRequestRead(buf []byte, order xgb.ByteOrder) *GetColorTableRequest {
+	v := new(GetColorTableRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Format = order.Get32(buf[b:])
+	b += 4
+
+	v.Type = order.Get32(buf[b:])
+	b += 4
+
+	if buf[b] == 1 {
+		v.SwapBytes = true
+	} else {
+		v.SwapBytes = false
+	}
+	b += 1
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][147] = xgb.RequestInfo{
+		Name: "GetColorTable",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getColorTableRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getColorTableReply(buf, order)
+		},
+	}
+}
+
 // GetColorTableParameterfvCookie is a cookie used only for GetColorTableParameterfv requests.
 type GetColorTableParameterfvCookie struct {
 	*xgb.Cookie
@@ -3384,35 +4886,47 @@ func (cook GetColorTableParameterfvCookie) Reply() (*GetColorTableParameterfvRep
 	if buf == nil {
 		return nil, nil
 	}
-	return getColorTableParameterfvReply(buf), nil
+	return getColorTableParameterfvReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetColorTableParameterfv request arrives.
+func (cook GetColorTableParameterfvCookie) ReplyContext(ctx context.Context) (*GetColorTableParameterfvReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getColorTableParameterfvReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getColorTableParameterfvReply reads a byte slice into a GetColorTableParameterfvReply value.
-func getColorTableParameterfvReply(buf []byte) *GetColorTableParameterfvReply {
+// getColorTableParameterfvReply reads a byte slice in the byte order 'order' into a GetColorTableParameterfvReply value.
+func getColorTableParameterfvReply(buf []byte, order xgb.ByteOrder) *GetColorTableParameterfvReply {
 	v := new(GetColorTableParameterfvReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = Float32(xgb.Get32(buf[b:]))
+	v.Datum = Float32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]Float32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = Float32(xgb.Get32(buf[b:]))
+		v.Data[i] = Float32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -3422,9 +4936,18 @@ func getColorTableParameterfvReply(buf []byte) *GetColorTableParameterfvReply {
 // Write request to wire for GetColorTableParameterfv
 // getColorTableParameterfvRequest writes a GetColorTableParameterfv request to a byte slice.
 func getColorTableParameterfvRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
+	return AppendGetColorTableParameterfvRequest(nil, c, ContextTag, Target, Pname)
+}
+
+// AppendGetColorTableParameterfvRequest appends a GetColorTableParameterfv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetColorTableParameterfvRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -3434,21 +4957,57 @@ func getColorTableParameterfvRequest(c *xgb.Conn, ContextTag ContextTag, Target
 	buf[b] = 148 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetColorTableParameterfvRequest represents the data of a GetColorTableParameterfv request, as it is read when tracing a connection.
+type GetColorTableParameterfvRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Pname      uint32
+}
+
+// getColorTableParameterfvRequestRead reads a byte slice in the byte order 'order' into a GetColorTableParameterfvRequest value.
+func getColorTableParameterfvRequestRead(buf []byte, order xgb.ByteOrder) *GetColorTableParameterfvRequest {
+	v := new(GetColorTableParameterfvRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][148] = xgb.RequestInfo{
+		Name: "GetColorTableParameterfv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getColorTableParameterfvRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getColorTableParameterfvReply(buf, order)
+		},
+	}
+}
+
 // GetColorTableParameterivCookie is a cookie used only for GetColorTableParameteriv requests.
 type GetColorTableParameterivCookie struct {
 	*xgb.Cookie
@@ -3501,35 +5060,47 @@ func (cook GetColorTableParameterivCookie) Reply() (*GetColorTableParameterivRep
 	if buf == nil {
 		return nil, nil
 	}
-	return getColorTableParameterivReply(buf), nil
+	return getColorTableParameterivReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetColorTableParameteriv request arrives.
+func (cook GetColorTableParameterivCookie) ReplyContext(ctx context.Context) (*GetColorTableParameterivReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getColorTableParameterivReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getColorTableParameterivReply reads a byte slice into a GetColorTableParameterivReply value.
-func getColorTableParameterivReply(buf []byte) *GetColorTableParameterivReply {
+// getColorTableParameterivReply reads a byte slice in the byte order 'order' into a GetColorTableParameterivReply value.
+func getColorTableParameterivReply(buf []byte, order xgb.ByteOrder) *GetColorTableParameterivReply {
 	v := new(GetColorTableParameterivReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = int32(xgb.Get32(buf[b:]))
+	v.Datum = int32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]int32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = int32(xgb.Get32(buf[b:]))
+		v.Data[i] = int32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -3539,9 +5110,18 @@ func getColorTableParameterivReply(buf []byte) *GetColorTableParameterivReply {
 // Write request to wire for GetColorTableParameteriv
 // getColorTableParameterivRequest writes a GetColorTableParameteriv request to a byte slice.
 func getColorTableParameterivRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
+	return AppendGetColorTableParameterivRequest(nil, c, ContextTag, Target, Pname)
+}
+
+// AppendGetColorTableParameterivRequest appends a GetColorTableParameteriv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetColorTableParameterivRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -3551,21 +5131,57 @@ func getColorTableParameterivRequest(c *xgb.Conn, ContextTag ContextTag, Target
 	buf[b] = 149 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetColorTableParameterivRequest represents the data of a GetColorTableParameteriv request, as it is read when tracing a connection.
+type GetColorTableParameterivRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Pname      uint32
+}
+
+// getColorTableParameterivRequestRead reads a byte slice in the byte order 'order' into a GetColorTableParameterivRequest value.
+func getColorTableParameterivRequestRead(buf []byte, order xgb.ByteOrder) *GetColorTableParameterivRequest {
+	v := new(GetColorTableParameterivRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][149] = xgb.RequestInfo{
+		Name: "GetColorTableParameteriv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getColorTableParameterivRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getColorTableParameterivReply(buf, order)
+		},
+	}
+}
+
 // GetCompressedTexImageARBCookie is a cookie used only for GetCompressedTexImageARB requests.
 type GetCompressedTexImageARBCookie struct {
 	*xgb.Cookie
@@ -3617,25 +5233,37 @@ func (cook GetCompressedTexImageARBCookie) Reply() (*GetCompressedTexImageARBRep
 	if buf == nil {
 		return nil, nil
 	}
-	return getCompressedTexImageARBReply(buf), nil
+	return getCompressedTexImageARBReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetCompressedTexImageARB request arrives.
+func (cook GetCompressedTexImageARBCookie) ReplyContext(ctx context.Context) (*GetCompressedTexImageARBReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getCompressedTexImageARBReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getCompressedTexImageARBReply reads a byte slice into a GetCompressedTexImageARBReply value.
-func getCompressedTexImageARBReply(buf []byte) *GetCompressedTexImageARBReply {
+// getCompressedTexImageARBReply reads a byte slice in the byte order 'order' into a GetCompressedTexImageARBReply value.
+func getCompressedTexImageARBReply(buf []byte, order xgb.ByteOrder) *GetCompressedTexImageARBReply {
 	v := new(GetCompressedTexImageARBReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 8 // padding
 
-	v.Size = int32(xgb.Get32(buf[b:]))
+	v.Size = int32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
@@ -3650,9 +5278,18 @@ func getCompressedTexImageARBReply(buf []byte) *GetCompressedTexImageARBReply {
 // Write request to wire for GetCompressedTexImageARB
 // getCompressedTexImageARBRequest writes a GetCompressedTexImageARB request to a byte slice.
 func getCompressedTexImageARBRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Level int32) []byte {
+	return AppendGetCompressedTexImageARBRequest(nil, c, ContextTag, Target, Level)
+}
+
+// AppendGetCompressedTexImageARBRequest appends a GetCompressedTexImageARB request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetCompressedTexImageARBRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Level int32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -3662,21 +5299,57 @@ func getCompressedTexImageARBRequest(c *xgb.Conn, ContextTag ContextTag, Target
 	buf[b] = 160 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Level))
+	order.Put32(buf[b:], uint32(Level))
 	b += 4
 
 	return buf
 }
 
+// GetCompressedTexImageARBRequest represents the data of a GetCompressedTexImageARB request, as it is read when tracing a connection.
+type GetCompressedTexImageARBRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Level      int32
+}
+
+// getCompressedTexImageARBRequestRead reads a byte slice in the byte order 'order' into a GetCompressedTexImageARBRequest value.
+func getCompressedTexImageARBRequestRead(buf []byte, order xgb.ByteOrder) *GetCompressedTexImageARBRequest {
+	v := new(GetCompressedTexImageARBRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Level = int32(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][160] = xgb.RequestInfo{
+		Name: "GetCompressedTexImageARB",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getCompressedTexImageARBRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getCompressedTexImageARBReply(buf, order)
+		},
+	}
+}
+
 // GetConvolutionFilterCookie is a cookie used only for GetConvolutionFilter requests.
 type GetConvolutionFilterCookie struct {
 	*xgb.Cookie
@@ -3729,28 +5402,40 @@ func (cook GetConvolutionFilterCookie) Reply() (*GetConvolutionFilterReply, erro
 	if buf == nil {
 		return nil, nil
 	}
-	return getConvolutionFilterReply(buf), nil
+	return getConvolutionFilterReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetConvolutionFilter request arrives.
+func (cook GetConvolutionFilterCookie) ReplyContext(ctx context.Context) (*GetConvolutionFilterReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getConvolutionFilterReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getConvolutionFilterReply reads a byte slice into a GetConvolutionFilterReply value.
-func getConvolutionFilterReply(buf []byte) *GetConvolutionFilterReply {
+// getConvolutionFilterReply reads a byte slice in the byte order 'order' into a GetConvolutionFilterReply value.
+func getConvolutionFilterReply(buf []byte, order xgb.ByteOrder) *GetConvolutionFilterReply {
 	v := new(GetConvolutionFilterReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 8 // padding
 
-	v.Width = int32(xgb.Get32(buf[b:]))
+	v.Width = int32(order.Get32(buf[b:]))
 	b += 4
 
-	v.Height = int32(xgb.Get32(buf[b:]))
+	v.Height = int32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 8 // padding
@@ -3765,9 +5450,18 @@ func getConvolutionFilterReply(buf []byte) *GetConvolutionFilterReply {
 // Write request to wire for GetConvolutionFilter
 // getConvolutionFilterRequest writes a GetConvolutionFilter request to a byte slice.
 func getConvolutionFilterRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Format uint32, Type uint32, SwapBytes bool) []byte {
+	return AppendGetConvolutionFilterRequest(nil, c, ContextTag, Target, Format, Type, SwapBytes)
+}
+
+// AppendGetConvolutionFilterRequest appends a GetConvolutionFilter request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetConvolutionFilterRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Format uint32, Type uint32, SwapBytes bool) []byte {
 	size := 24
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -3777,19 +5471,19 @@ func getConvolutionFilterRequest(c *xgb.Conn, ContextTag ContextTag, Target uint
 	buf[b] = 150 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], Format)
+	order.Put32(buf[b:], Format)
 	b += 4
 
-	xgb.Put32(buf[b:], Type)
+	order.Put32(buf[b:], Type)
 	b += 4
 
 	if SwapBytes {
@@ -3802,6 +5496,54 @@ func getConvolutionFilterRequest(c *xgb.Conn, ContextTag ContextTag, Target uint
 	return buf
 }
 
+// GetConvolutionFilterRequest represents the data of a GetConvolutionFilter request, as it is read when tracing a connection.
+type GetConvolutionFilterRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Format     uint32
+	Type       uint32
+	SwapBytes  bool
+}
+
+// getConvolutionFilterRequestRead reads a byte slice in the byte order 'order' into a GetConvolutionFilterRequest value.
+func getConvolutionFilterRequestRead(buf []byte, order xgb.ByteOrder) *GetConvolutionFilterRequest {
+	v := new(GetConvolutionFilterRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Format = order.Get32(buf[b:])
+	b += 4
+
+	v.Type = order.Get32(buf[b:])
+	b += 4
+
+	if buf[b] == 1 {
+		v.SwapBytes = true
+	} else {
+		v.SwapBytes = false
+	}
+	b += 1
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][150] = xgb.RequestInfo{
+		Name: "GetConvolutionFilter",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getConvolutionFilterRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getConvolutionFilterReply(buf, order)
+		},
+	}
+}
+
 // GetConvolutionParameterfvCookie is a cookie used only for GetConvolutionParameterfv requests.
 type GetConvolutionParameterfvCookie struct {
 	*xgb.Cookie
@@ -3854,35 +5596,47 @@ func (cook GetConvolutionParameterfvCookie) Reply() (*GetConvolutionParameterfvR
 	if buf == nil {
 		return nil, nil
 	}
-	return getConvolutionParameterfvReply(buf), nil
+	return getConvolutionParameterfvReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetConvolutionParameterfv request arrives.
+func (cook GetConvolutionParameterfvCookie) ReplyContext(ctx context.Context) (*GetConvolutionParameterfvReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getConvolutionParameterfvReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getConvolutionParameterfvReply reads a byte slice into a GetConvolutionParameterfvReply value.
-func getConvolutionParameterfvReply(buf []byte) *GetConvolutionParameterfvReply {
+// getConvolutionParameterfvReply reads a byte slice in the byte order 'order' into a GetConvolutionParameterfvReply value.
+func getConvolutionParameterfvReply(buf []byte, order xgb.ByteOrder) *GetConvolutionParameterfvReply {
 	v := new(GetConvolutionParameterfvReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = Float32(xgb.Get32(buf[b:]))
+	v.Datum = Float32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]Float32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = Float32(xgb.Get32(buf[b:]))
+		v.Data[i] = Float32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -3892,9 +5646,18 @@ func getConvolutionParameterfvReply(buf []byte) *GetConvolutionParameterfvReply
 // Write request to wire for GetConvolutionParameterfv
 // getConvolutionParameterfvRequest writes a GetConvolutionParameterfv request to a byte slice.
 func getConvolutionParameterfvRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
+	return AppendGetConvolutionParameterfvRequest(nil, c, ContextTag, Target, Pname)
+}
+
+// AppendGetConvolutionParameterfvRequest appends a GetConvolutionParameterfv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetConvolutionParameterfvRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -3904,21 +5667,57 @@ func getConvolutionParameterfvRequest(c *xgb.Conn, ContextTag ContextTag, Target
 	buf[b] = 151 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetConvolutionParameterfvRequest represents the data of a GetConvolutionParameterfv request, as it is read when tracing a connection.
+type GetConvolutionParameterfvRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Pname      uint32
+}
+
+// getConvolutionParameterfvRequestRead reads a byte slice in the byte order 'order' into a GetConvolutionParameterfvRequest value.
+func getConvolutionParameterfvRequestRead(buf []byte, order xgb.ByteOrder) *GetConvolutionParameterfvRequest {
+	v := new(GetConvolutionParameterfvRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][151] = xgb.RequestInfo{
+		Name: "GetConvolutionParameterfv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getConvolutionParameterfvRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getConvolutionParameterfvReply(buf, order)
+		},
+	}
+}
+
 // GetConvolutionParameterivCookie is a cookie used only for GetConvolutionParameteriv requests.
 type GetConvolutionParameterivCookie struct {
 	*xgb.Cookie
@@ -3971,35 +5770,47 @@ func (cook GetConvolutionParameterivCookie) Reply() (*GetConvolutionParameterivR
 	if buf == nil {
 		return nil, nil
 	}
-	return getConvolutionParameterivReply(buf), nil
+	return getConvolutionParameterivReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetConvolutionParameteriv request arrives.
+func (cook GetConvolutionParameterivCookie) ReplyContext(ctx context.Context) (*GetConvolutionParameterivReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getConvolutionParameterivReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getConvolutionParameterivReply reads a byte slice into a GetConvolutionParameterivReply value.
-func getConvolutionParameterivReply(buf []byte) *GetConvolutionParameterivReply {
+// getConvolutionParameterivReply reads a byte slice in the byte order 'order' into a GetConvolutionParameterivReply value.
+func getConvolutionParameterivReply(buf []byte, order xgb.ByteOrder) *GetConvolutionParameterivReply {
 	v := new(GetConvolutionParameterivReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = int32(xgb.Get32(buf[b:]))
+	v.Datum = int32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]int32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = int32(xgb.Get32(buf[b:]))
+		v.Data[i] = int32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -4009,9 +5820,18 @@ func getConvolutionParameterivReply(buf []byte) *GetConvolutionParameterivReply
 // Write request to wire for GetConvolutionParameteriv
 // getConvolutionParameterivRequest writes a GetConvolutionParameteriv request to a byte slice.
 func getConvolutionParameterivRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
+	return AppendGetConvolutionParameterivRequest(nil, c, ContextTag, Target, Pname)
+}
+
+// AppendGetConvolutionParameterivRequest appends a GetConvolutionParameteriv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetConvolutionParameterivRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -4021,21 +5841,57 @@ func getConvolutionParameterivRequest(c *xgb.Conn, ContextTag ContextTag, Target
 	buf[b] = 152 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetConvolutionParameterivRequest represents the data of a GetConvolutionParameteriv request, as it is read when tracing a connection.
+type GetConvolutionParameterivRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Pname      uint32
+}
+
+// getConvolutionParameterivRequestRead reads a byte slice in the byte order 'order' into a GetConvolutionParameterivRequest value.
+func getConvolutionParameterivRequestRead(buf []byte, order xgb.ByteOrder) *GetConvolutionParameterivRequest {
+	v := new(GetConvolutionParameterivRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][152] = xgb.RequestInfo{
+		Name: "GetConvolutionParameteriv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getConvolutionParameterivRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getConvolutionParameterivReply(buf, order)
+		},
+	}
+}
+
 // GetDoublevCookie is a cookie used only for GetDoublev requests.
 type GetDoublevCookie struct {
 	*xgb.Cookie
@@ -4088,35 +5944,47 @@ func (cook GetDoublevCookie) Reply() (*GetDoublevReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getDoublevReply(buf), nil
+	return getDoublevReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetDoublev request arrives.
+func (cook GetDoublevCookie) ReplyContext(ctx context.Context) (*GetDoublevReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getDoublevReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getDoublevReply reads a byte slice into a GetDoublevReply value.
-func getDoublevReply(buf []byte) *GetDoublevReply {
+// getDoublevReply reads a byte slice in the byte order 'order' into a GetDoublevReply value.
+func getDoublevReply(buf []byte, order xgb.ByteOrder) *GetDoublevReply {
 	v := new(GetDoublevReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = Float64(xgb.Get64(buf[b:]))
+	v.Datum = Float64(order.Get64(buf[b:]))
 	b += 8
 
 	b += 8 // padding
 
 	v.Data = make([]Float64, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = Float64(xgb.Get64(buf[b:]))
+		v.Data[i] = Float64(order.Get64(buf[b:]))
 		b += 8
 	}
 
@@ -4126,9 +5994,18 @@ func getDoublevReply(buf []byte) *GetDoublevReply {
 // Write request to wire for GetDoublev
 // getDoublevRequest writes a GetDoublev request to a byte slice.
 func getDoublevRequest(c *xgb.Conn, ContextTag ContextTag, Pname uint32) []byte {
+	return AppendGetDoublevRequest(nil, c, ContextTag, Pname)
+}
+
+// AppendGetDoublevRequest appends a GetDoublev request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetDoublevRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Pname uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -4138,18 +6015,50 @@ func getDoublevRequest(c *xgb.Conn, ContextTag ContextTag, Pname uint32) []byte
 	buf[b] = 114 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetDoublevRequest represents the data of a GetDoublev request, as it is read when tracing a connection.
+type GetDoublevRequest struct {
+	ContextTag ContextTag
+	Pname      uint32
+}
+
+// getDoublevRequestRead reads a byte slice in the byte order 'order' into a GetDoublevRequest value.
+func getDoublevRequestRead(buf []byte, order xgb.ByteOrder) *GetDoublevRequest {
+	v := new(GetDoublevRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][114] = xgb.RequestInfo{
+		Name: "GetDoublev",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getDoublevRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getDoublevReply(buf, order)
+		},
+	}
+}
+
 // GetDrawableAttributesCookie is a cookie used only for GetDrawableAttributes requests.
 type GetDrawableAttributesCookie struct {
 	*xgb.Cookie
@@ -4200,30 +6109,42 @@ func (cook GetDrawableAttributesCookie) Reply() (*GetDrawableAttributesReply, er
 	if buf == nil {
 		return nil, nil
 	}
-	return getDrawableAttributesReply(buf), nil
+	return getDrawableAttributesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetDrawableAttributes request arrives.
+func (cook GetDrawableAttributesCookie) ReplyContext(ctx context.Context) (*GetDrawableAttributesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getDrawableAttributesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getDrawableAttributesReply reads a byte slice into a GetDrawableAttributesReply value.
-func getDrawableAttributesReply(buf []byte) *GetDrawableAttributesReply {
+// getDrawableAttributesReply reads a byte slice in the byte order 'order' into a GetDrawableAttributesReply value.
+func getDrawableAttributesReply(buf []byte, order xgb.ByteOrder) *GetDrawableAttributesReply {
 	v := new(GetDrawableAttributesReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.NumAttribs = xgb.Get32(buf[b:])
+	v.NumAttribs = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
 
 	v.Attribs = make([]uint32, (int(v.NumAttribs) * 2))
 	for i := 0; i < int((int(v.NumAttribs) * 2)); i++ {
-		v.Attribs[i] = xgb.Get32(buf[b:])
+		v.Attribs[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -4233,9 +6154,18 @@ func getDrawableAttributesReply(buf []byte) *GetDrawableAttributesReply {
 // Write request to wire for GetDrawableAttributes
 // getDrawableAttributesRequest writes a GetDrawableAttributes request to a byte slice.
 func getDrawableAttributesRequest(c *xgb.Conn, Drawable Drawable) []byte {
+	return AppendGetDrawableAttributesRequest(nil, c, Drawable)
+}
+
+// AppendGetDrawableAttributesRequest appends a GetDrawableAttributes request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetDrawableAttributesRequest(buf []byte, c *xgb.Conn, Drawable Drawable) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -4245,15 +6175,43 @@ func getDrawableAttributesRequest(c *xgb.Conn, Drawable Drawable) []byte {
 	buf[b] = 29 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
 	return buf
 }
 
+// GetDrawableAttributesRequest represents the data of a GetDrawableAttributes request, as it is read when tracing a connection.
+type GetDrawableAttributesRequest struct {
+	Drawable Drawable
+}
+
+// getDrawableAttributesRequestRead reads a byte slice in the byte order 'order' into a GetDrawableAttributesRequest value.
+func getDrawableAttributesRequestRead(buf []byte, order xgb.ByteOrder) *GetDrawableAttributesRequest {
+	v := new(GetDrawableAttributesRequest)
+	b := 4 // skip request header
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][29] = xgb.RequestInfo{
+		Name: "GetDrawableAttributes",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getDrawableAttributesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getDrawableAttributesReply(buf, order)
+		},
+	}
+}
+
 // GetErrorCookie is a cookie used only for GetError requests.
 type GetErrorCookie struct {
 	*xgb.Cookie
@@ -4302,23 +6260,35 @@ func (cook GetErrorCookie) Reply() (*GetErrorReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getErrorReply(buf), nil
+	return getErrorReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetError request arrives.
+func (cook GetErrorCookie) ReplyContext(ctx context.Context) (*GetErrorReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getErrorReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getErrorReply reads a byte slice into a GetErrorReply value.
-func getErrorReply(buf []byte) *GetErrorReply {
+// getErrorReply reads a byte slice in the byte order 'order' into a GetErrorReply value.
+func getErrorReply(buf []byte, order xgb.ByteOrder) *GetErrorReply {
 	v := new(GetErrorReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Error = int32(xgb.Get32(buf[b:]))
+	v.Error = int32(order.Get32(buf[b:]))
 	b += 4
 
 	return v
@@ -4327,9 +6297,18 @@ func getErrorReply(buf []byte) *GetErrorReply {
 // Write request to wire for GetError
 // getErrorRequest writes a GetError request to a byte slice.
 func getErrorRequest(c *xgb.Conn, ContextTag ContextTag) []byte {
+	return AppendGetErrorRequest(nil, c, ContextTag)
+}
+
+// AppendGetErrorRequest appends a GetError request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetErrorRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -4339,15 +6318,43 @@ func getErrorRequest(c *xgb.Conn, ContextTag ContextTag) []byte {
 	buf[b] = 115 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
 	return buf
 }
 
+// GetErrorRequest represents the data of a GetError request, as it is read when tracing a connection.
+type GetErrorRequest struct {
+	ContextTag ContextTag
+}
+
+// getErrorRequestRead reads a byte slice in the byte order 'order' into a GetErrorRequest value.
+func getErrorRequestRead(buf []byte, order xgb.ByteOrder) *GetErrorRequest {
+	v := new(GetErrorRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][115] = xgb.RequestInfo{
+		Name: "GetError",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getErrorRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getErrorReply(buf, order)
+		},
+	}
+}
+
 // GetFBConfigsCookie is a cookie used only for GetFBConfigs requests.
 type GetFBConfigsCookie struct {
 	*xgb.Cookie
@@ -4399,33 +6406,45 @@ func (cook GetFBConfigsCookie) Reply() (*GetFBConfigsReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getFBConfigsReply(buf), nil
+	return getFBConfigsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetFBConfigs request arrives.
+func (cook GetFBConfigsCookie) ReplyContext(ctx context.Context) (*GetFBConfigsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getFBConfigsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getFBConfigsReply reads a byte slice into a GetFBConfigsReply value.
-func getFBConfigsReply(buf []byte) *GetFBConfigsReply {
+// getFBConfigsReply reads a byte slice in the byte order 'order' into a GetFBConfigsReply value.
+func getFBConfigsReply(buf []byte, order xgb.ByteOrder) *GetFBConfigsReply {
 	v := new(GetFBConfigsReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.NumFbConfigs = xgb.Get32(buf[b:])
+	v.NumFbConfigs = order.Get32(buf[b:])
 	b += 4
 
-	v.NumProperties = xgb.Get32(buf[b:])
+	v.NumProperties = order.Get32(buf[b:])
 	b += 4
 
 	b += 16 // padding
 
 	v.PropertyList = make([]uint32, v.Length)
 	for i := 0; i < int(v.Length); i++ {
-		v.PropertyList[i] = xgb.Get32(buf[b:])
+		v.PropertyList[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -4435,9 +6454,18 @@ func getFBConfigsReply(buf []byte) *GetFBConfigsReply {
 // Write request to wire for GetFBConfigs
 // getFBConfigsRequest writes a GetFBConfigs request to a byte slice.
 func getFBConfigsRequest(c *xgb.Conn, Screen uint32) []byte {
+	return AppendGetFBConfigsRequest(nil, c, Screen)
+}
+
+// AppendGetFBConfigsRequest appends a GetFBConfigs request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetFBConfigsRequest(buf []byte, c *xgb.Conn, Screen uint32) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -4447,15 +6475,43 @@ func getFBConfigsRequest(c *xgb.Conn, Screen uint32) []byte {
 	buf[b] = 21 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
 	return buf
 }
 
+// GetFBConfigsRequest represents the data of a GetFBConfigs request, as it is read when tracing a connection.
+type GetFBConfigsRequest struct {
+	Screen uint32
+}
+
+// getFBConfigsRequestRead reads a byte slice in the byte order 'order' into a GetFBConfigsRequest value.
+func getFBConfigsRequestRead(buf []byte, order xgb.ByteOrder) *GetFBConfigsRequest {
+	v := new(GetFBConfigsRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][21] = xgb.RequestInfo{
+		Name: "GetFBConfigs",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getFBConfigsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getFBConfigsReply(buf, order)
+		},
+	}
+}
+
 // GetFloatvCookie is a cookie used only for GetFloatv requests.
 type GetFloatvCookie struct {
 	*xgb.Cookie
@@ -4508,35 +6564,47 @@ func (cook GetFloatvCookie) Reply() (*GetFloatvReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getFloatvReply(buf), nil
+	return getFloatvReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetFloatv request arrives.
+func (cook GetFloatvCookie) ReplyContext(ctx context.Context) (*GetFloatvReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getFloatvReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getFloatvReply reads a byte slice into a GetFloatvReply value.
-func getFloatvReply(buf []byte) *GetFloatvReply {
+// getFloatvReply reads a byte slice in the byte order 'order' into a GetFloatvReply value.
+func getFloatvReply(buf []byte, order xgb.ByteOrder) *GetFloatvReply {
 	v := new(GetFloatvReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = Float32(xgb.Get32(buf[b:]))
+	v.Datum = Float32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]Float32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = Float32(xgb.Get32(buf[b:]))
+		v.Data[i] = Float32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -4546,9 +6614,18 @@ func getFloatvReply(buf []byte) *GetFloatvReply {
 // Write request to wire for GetFloatv
 // getFloatvRequest writes a GetFloatv request to a byte slice.
 func getFloatvRequest(c *xgb.Conn, ContextTag ContextTag, Pname uint32) []byte {
+	return AppendGetFloatvRequest(nil, c, ContextTag, Pname)
+}
+
+// AppendGetFloatvRequest appends a GetFloatv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetFloatvRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Pname uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -4558,18 +6635,50 @@ func getFloatvRequest(c *xgb.Conn, ContextTag ContextTag, Pname uint32) []byte {
 	buf[b] = 116 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetFloatvRequest represents the data of a GetFloatv request, as it is read when tracing a connection.
+type GetFloatvRequest struct {
+	ContextTag ContextTag
+	Pname      uint32
+}
+
+// getFloatvRequestRead reads a byte slice in the byte order 'order' into a GetFloatvRequest value.
+func getFloatvRequestRead(buf []byte, order xgb.ByteOrder) *GetFloatvRequest {
+	v := new(GetFloatvRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][116] = xgb.RequestInfo{
+		Name: "GetFloatv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getFloatvRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getFloatvReply(buf, order)
+		},
+	}
+}
+
 // GetHistogramCookie is a cookie used only for GetHistogram requests.
 type GetHistogramCookie struct {
 	*xgb.Cookie
@@ -4621,25 +6730,37 @@ func (cook GetHistogramCookie) Reply() (*GetHistogramReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getHistogramReply(buf), nil
+	return getHistogramReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetHistogram request arrives.
+func (cook GetHistogramCookie) ReplyContext(ctx context.Context) (*GetHistogramReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getHistogramReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getHistogramReply reads a byte slice into a GetHistogramReply value.
-func getHistogramReply(buf []byte) *GetHistogramReply {
+// getHistogramReply reads a byte slice in the byte order 'order' into a GetHistogramReply value.
+func getHistogramReply(buf []byte, order xgb.ByteOrder) *GetHistogramReply {
 	v := new(GetHistogramReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 8 // padding
 
-	v.Width = int32(xgb.Get32(buf[b:]))
+	v.Width = int32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
@@ -4654,9 +6775,18 @@ func getHistogramReply(buf []byte) *GetHistogramReply {
 // Write request to wire for GetHistogram
 // getHistogramRequest writes a GetHistogram request to a byte slice.
 func getHistogramRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Format uint32, Type uint32, SwapBytes bool, Reset bool) []byte {
+	return AppendGetHistogramRequest(nil, c, ContextTag, Target, Format, Type, SwapBytes, Reset)
+}
+
+// AppendGetHistogramRequest appends a GetHistogram request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetHistogramRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Format uint32, Type uint32, SwapBytes bool, Reset bool) []byte {
 	size := 24
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -4666,19 +6796,19 @@ func getHistogramRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Form
 	buf[b] = 154 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], Format)
+	order.Put32(buf[b:], Format)
 	b += 4
 
-	xgb.Put32(buf[b:], Type)
+	order.Put32(buf[b:], Type)
 	b += 4
 
 	if SwapBytes {
@@ -4698,6 +6828,62 @@ func getHistogramRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Form
 	return buf
 }
 
+// GetHistogramRequest represents the data of a GetHistogram request, as it is read when tracing a connection.
+type GetHistogramRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Format     uint32
+	Type       uint32
+	SwapBytes  bool
+	Reset      bool
+}
+
+// getHistogramRequestRead reads a byte slice in the byte order 'order' into a GetHistogramRequest value.
+func getHistogramRequestRead(buf []byte, order xgb.ByteOrder) *GetHistogramRequest {
+	v := new(GetHistogramRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Format = order.Get32(buf[b:])
+	b += 4
+
+	v.Type = order.Get32(buf[b:])
+	b += 4
+
+	if buf[b] == 1 {
+		v.SwapBytes = true
+	} else {
+		v.SwapBytes = false
+	}
+	b += 1
+
+	if buf[b] == 1 {
+		v.Reset = true
+	} else {
+		v.Reset = false
+	}
+	b += 1
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][154] = xgb.RequestInfo{
+		Name: "GetHistogram",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getHistogramRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getHistogramReply(buf, order)
+		},
+	}
+}
+
 // GetHistogramParameterfvCookie is a cookie used only for GetHistogramParameterfv requests.
 type GetHistogramParameterfvCookie struct {
 	*xgb.Cookie
@@ -4750,35 +6936,47 @@ func (cook GetHistogramParameterfvCookie) Reply() (*GetHistogramParameterfvReply
 	if buf == nil {
 		return nil, nil
 	}
-	return getHistogramParameterfvReply(buf), nil
+	return getHistogramParameterfvReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetHistogramParameterfv request arrives.
+func (cook GetHistogramParameterfvCookie) ReplyContext(ctx context.Context) (*GetHistogramParameterfvReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getHistogramParameterfvReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getHistogramParameterfvReply reads a byte slice into a GetHistogramParameterfvReply value.
-func getHistogramParameterfvReply(buf []byte) *GetHistogramParameterfvReply {
+// getHistogramParameterfvReply reads a byte slice in the byte order 'order' into a GetHistogramParameterfvReply value.
+func getHistogramParameterfvReply(buf []byte, order xgb.ByteOrder) *GetHistogramParameterfvReply {
 	v := new(GetHistogramParameterfvReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = Float32(xgb.Get32(buf[b:]))
+	v.Datum = Float32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]Float32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = Float32(xgb.Get32(buf[b:]))
+		v.Data[i] = Float32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -4788,9 +6986,18 @@ func getHistogramParameterfvReply(buf []byte) *GetHistogramParameterfvReply {
 // Write request to wire for GetHistogramParameterfv
 // getHistogramParameterfvRequest writes a GetHistogramParameterfv request to a byte slice.
 func getHistogramParameterfvRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
+	return AppendGetHistogramParameterfvRequest(nil, c, ContextTag, Target, Pname)
+}
+
+// AppendGetHistogramParameterfvRequest appends a GetHistogramParameterfv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetHistogramParameterfvRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -4800,21 +7007,57 @@ func getHistogramParameterfvRequest(c *xgb.Conn, ContextTag ContextTag, Target u
 	buf[b] = 155 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetHistogramParameterfvRequest represents the data of a GetHistogramParameterfv request, as it is read when tracing a connection.
+type GetHistogramParameterfvRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Pname      uint32
+}
+
+// getHistogramParameterfvRequestRead reads a byte slice in the byte order 'order' into a GetHistogramParameterfvRequest value.
+func getHistogramParameterfvRequestRead(buf []byte, order xgb.ByteOrder) *GetHistogramParameterfvRequest {
+	v := new(GetHistogramParameterfvRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][155] = xgb.RequestInfo{
+		Name: "GetHistogramParameterfv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getHistogramParameterfvRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getHistogramParameterfvReply(buf, order)
+		},
+	}
+}
+
 // GetHistogramParameterivCookie is a cookie used only for GetHistogramParameteriv requests.
 type GetHistogramParameterivCookie struct {
 	*xgb.Cookie
@@ -4867,35 +7110,47 @@ func (cook GetHistogramParameterivCookie) Reply() (*GetHistogramParameterivReply
 	if buf == nil {
 		return nil, nil
 	}
-	return getHistogramParameterivReply(buf), nil
+	return getHistogramParameterivReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getHistogramParameterivReply reads a byte slice into a GetHistogramParameterivReply value.
-func getHistogramParameterivReply(buf []byte) *GetHistogramParameterivReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetHistogramParameteriv request arrives.
+func (cook GetHistogramParameterivCookie) ReplyContext(ctx context.Context) (*GetHistogramParameterivReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getHistogramParameterivReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// getHistogramParameterivReply reads a byte slice in the byte order 'order' into a GetHistogramParameterivReply value.
+func getHistogramParameterivReply(buf []byte, order xgb.ByteOrder) *GetHistogramParameterivReply {
 	v := new(GetHistogramParameterivReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = int32(xgb.Get32(buf[b:]))
+	v.Datum = int32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]int32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = int32(xgb.Get32(buf[b:]))
+		v.Data[i] = int32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -4905,9 +7160,18 @@ func getHistogramParameterivReply(buf []byte) *GetHistogramParameterivReply {
 // Write request to wire for GetHistogramParameteriv
 // getHistogramParameterivRequest writes a GetHistogramParameteriv request to a byte slice.
 func getHistogramParameterivRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
+	return AppendGetHistogramParameterivRequest(nil, c, ContextTag, Target, Pname)
+}
+
+// AppendGetHistogramParameterivRequest appends a GetHistogramParameteriv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetHistogramParameterivRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -4917,21 +7181,57 @@ func getHistogramParameterivRequest(c *xgb.Conn, ContextTag ContextTag, Target u
 	buf[b] = 156 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetHistogramParameterivRequest represents the data of a GetHistogramParameteriv request, as it is read when tracing a connection.
+type GetHistogramParameterivRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Pname      uint32
+}
+
+// getHistogramParameterivRequestRead reads a byte slice in the byte order 'order' into a GetHistogramParameterivRequest value.
+func getHistogramParameterivRequestRead(buf []byte, order xgb.ByteOrder) *GetHistogramParameterivRequest {
+	v := new(GetHistogramParameterivRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][156] = xgb.RequestInfo{
+		Name: "GetHistogramParameteriv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getHistogramParameterivRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getHistogramParameterivReply(buf, order)
+		},
+	}
+}
+
 // GetIntegervCookie is a cookie used only for GetIntegerv requests.
 type GetIntegervCookie struct {
 	*xgb.Cookie
@@ -4984,35 +7284,47 @@ func (cook GetIntegervCookie) Reply() (*GetIntegervReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getIntegervReply(buf), nil
+	return getIntegervReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetIntegerv request arrives.
+func (cook GetIntegervCookie) ReplyContext(ctx context.Context) (*GetIntegervReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getIntegervReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getIntegervReply reads a byte slice into a GetIntegervReply value.
-func getIntegervReply(buf []byte) *GetIntegervReply {
+// getIntegervReply reads a byte slice in the byte order 'order' into a GetIntegervReply value.
+func getIntegervReply(buf []byte, order xgb.ByteOrder) *GetIntegervReply {
 	v := new(GetIntegervReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = int32(xgb.Get32(buf[b:]))
+	v.Datum = int32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]int32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = int32(xgb.Get32(buf[b:]))
+		v.Data[i] = int32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -5022,9 +7334,18 @@ func getIntegervReply(buf []byte) *GetIntegervReply {
 // Write request to wire for GetIntegerv
 // getIntegervRequest writes a GetIntegerv request to a byte slice.
 func getIntegervRequest(c *xgb.Conn, ContextTag ContextTag, Pname uint32) []byte {
+	return AppendGetIntegervRequest(nil, c, ContextTag, Pname)
+}
+
+// AppendGetIntegervRequest appends a GetIntegerv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetIntegervRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Pname uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -5034,18 +7355,50 @@ func getIntegervRequest(c *xgb.Conn, ContextTag ContextTag, Pname uint32) []byte
 	buf[b] = 117 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetIntegervRequest represents the data of a GetIntegerv request, as it is read when tracing a connection.
+type GetIntegervRequest struct {
+	ContextTag ContextTag
+	Pname      uint32
+}
+
+// getIntegervRequestRead reads a byte slice in the byte order 'order' into a GetIntegervRequest value.
+func getIntegervRequestRead(buf []byte, order xgb.ByteOrder) *GetIntegervRequest {
+	v := new(GetIntegervRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][117] = xgb.RequestInfo{
+		Name: "GetIntegerv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getIntegervRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getIntegervReply(buf, order)
+		},
+	}
+}
+
 // GetLightfvCookie is a cookie used only for GetLightfv requests.
 type GetLightfvCookie struct {
 	*xgb.Cookie
@@ -5089,44 +7442,56 @@ type GetLightfvReply struct {
 	Data []Float32 // size: xgb.Pad((int(N) * 4))
 }
 
-// Reply blocks and returns the reply data for a GetLightfv request.
-func (cook GetLightfvCookie) Reply() (*GetLightfvReply, error) {
-	buf, err := cook.Cookie.Reply()
+// Reply blocks and returns the reply data for a GetLightfv request.
+func (cook GetLightfvCookie) Reply() (*GetLightfvReply, error) {
+	buf, err := cook.Cookie.Reply()
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getLightfvReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetLightfv request arrives.
+func (cook GetLightfvCookie) ReplyContext(ctx context.Context) (*GetLightfvReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 	if buf == nil {
 		return nil, nil
 	}
-	return getLightfvReply(buf), nil
+	return getLightfvReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getLightfvReply reads a byte slice into a GetLightfvReply value.
-func getLightfvReply(buf []byte) *GetLightfvReply {
+// getLightfvReply reads a byte slice in the byte order 'order' into a GetLightfvReply value.
+func getLightfvReply(buf []byte, order xgb.ByteOrder) *GetLightfvReply {
 	v := new(GetLightfvReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = Float32(xgb.Get32(buf[b:]))
+	v.Datum = Float32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]Float32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = Float32(xgb.Get32(buf[b:]))
+		v.Data[i] = Float32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -5136,9 +7501,18 @@ func getLightfvReply(buf []byte) *GetLightfvReply {
 // Write request to wire for GetLightfv
 // getLightfvRequest writes a GetLightfv request to a byte slice.
 func getLightfvRequest(c *xgb.Conn, ContextTag ContextTag, Light uint32, Pname uint32) []byte {
+	return AppendGetLightfvRequest(nil, c, ContextTag, Light, Pname)
+}
+
+// AppendGetLightfvRequest appends a GetLightfv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetLightfvRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Light uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -5148,21 +7522,57 @@ func getLightfvRequest(c *xgb.Conn, ContextTag ContextTag, Light uint32, Pname u
 	buf[b] = 118 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Light)
+	order.Put32(buf[b:], Light)
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetLightfvRequest represents the data of a GetLightfv request, as it is read when tracing a connection.
+type GetLightfvRequest struct {
+	ContextTag ContextTag
+	Light      uint32
+	Pname      uint32
+}
+
+// getLightfvRequestRead reads a byte slice in the byte order 'order' into a GetLightfvRequest value.
+func getLightfvRequestRead(buf []byte, order xgb.ByteOrder) *GetLightfvRequest {
+	v := new(GetLightfvRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Light = order.Get32(buf[b:])
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][118] = xgb.RequestInfo{
+		Name: "GetLightfv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getLightfvRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getLightfvReply(buf, order)
+		},
+	}
+}
+
 // GetLightivCookie is a cookie used only for GetLightiv requests.
 type GetLightivCookie struct {
 	*xgb.Cookie
@@ -5215,35 +7625,47 @@ func (cook GetLightivCookie) Reply() (*GetLightivReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getLightivReply(buf), nil
+	return getLightivReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetLightiv request arrives.
+func (cook GetLightivCookie) ReplyContext(ctx context.Context) (*GetLightivReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getLightivReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getLightivReply reads a byte slice into a GetLightivReply value.
-func getLightivReply(buf []byte) *GetLightivReply {
+// getLightivReply reads a byte slice in the byte order 'order' into a GetLightivReply value.
+func getLightivReply(buf []byte, order xgb.ByteOrder) *GetLightivReply {
 	v := new(GetLightivReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = int32(xgb.Get32(buf[b:]))
+	v.Datum = int32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]int32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = int32(xgb.Get32(buf[b:]))
+		v.Data[i] = int32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -5253,9 +7675,18 @@ func getLightivReply(buf []byte) *GetLightivReply {
 // Write request to wire for GetLightiv
 // getLightivRequest writes a GetLightiv request to a byte slice.
 func getLightivRequest(c *xgb.Conn, ContextTag ContextTag, Light uint32, Pname uint32) []byte {
+	return AppendGetLightivRequest(nil, c, ContextTag, Light, Pname)
+}
+
+// AppendGetLightivRequest appends a GetLightiv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetLightivRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Light uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -5265,21 +7696,57 @@ func getLightivRequest(c *xgb.Conn, ContextTag ContextTag, Light uint32, Pname u
 	buf[b] = 119 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Light)
+	order.Put32(buf[b:], Light)
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetLightivRequest represents the data of a GetLightiv request, as it is read when tracing a connection.
+type GetLightivRequest struct {
+	ContextTag ContextTag
+	Light      uint32
+	Pname      uint32
+}
+
+// getLightivRequestRead reads a byte slice in the byte order 'order' into a GetLightivRequest value.
+func getLightivRequestRead(buf []byte, order xgb.ByteOrder) *GetLightivRequest {
+	v := new(GetLightivRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Light = order.Get32(buf[b:])
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][119] = xgb.RequestInfo{
+		Name: "GetLightiv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getLightivRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getLightivReply(buf, order)
+		},
+	}
+}
+
 // GetMapdvCookie is a cookie used only for GetMapdv requests.
 type GetMapdvCookie struct {
 	*xgb.Cookie
@@ -5332,35 +7799,47 @@ func (cook GetMapdvCookie) Reply() (*GetMapdvReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getMapdvReply(buf), nil
+	return getMapdvReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetMapdv request arrives.
+func (cook GetMapdvCookie) ReplyContext(ctx context.Context) (*GetMapdvReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getMapdvReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getMapdvReply reads a byte slice into a GetMapdvReply value.
-func getMapdvReply(buf []byte) *GetMapdvReply {
+// getMapdvReply reads a byte slice in the byte order 'order' into a GetMapdvReply value.
+func getMapdvReply(buf []byte, order xgb.ByteOrder) *GetMapdvReply {
 	v := new(GetMapdvReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = Float64(xgb.Get64(buf[b:]))
+	v.Datum = Float64(order.Get64(buf[b:]))
 	b += 8
 
 	b += 8 // padding
 
 	v.Data = make([]Float64, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = Float64(xgb.Get64(buf[b:]))
+		v.Data[i] = Float64(order.Get64(buf[b:]))
 		b += 8
 	}
 
@@ -5370,9 +7849,18 @@ func getMapdvReply(buf []byte) *GetMapdvReply {
 // Write request to wire for GetMapdv
 // getMapdvRequest writes a GetMapdv request to a byte slice.
 func getMapdvRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Query uint32) []byte {
+	return AppendGetMapdvRequest(nil, c, ContextTag, Target, Query)
+}
+
+// AppendGetMapdvRequest appends a GetMapdv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetMapdvRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Query uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -5382,21 +7870,57 @@ func getMapdvRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Query ui
 	buf[b] = 120 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], Query)
+	order.Put32(buf[b:], Query)
 	b += 4
 
 	return buf
 }
 
+// GetMapdvRequest represents the data of a GetMapdv request, as it is read when tracing a connection.
+type GetMapdvRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Query      uint32
+}
+
+// getMapdvRequestRead reads a byte slice in the byte order 'order' into a GetMapdvRequest value.
+func getMapdvRequestRead(buf []byte, order xgb.ByteOrder) *GetMapdvRequest {
+	v := new(GetMapdvRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Query = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][120] = xgb.RequestInfo{
+		Name: "GetMapdv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMapdvRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMapdvReply(buf, order)
+		},
+	}
+}
+
 // GetMapfvCookie is a cookie used only for GetMapfv requests.
 type GetMapfvCookie struct {
 	*xgb.Cookie
@@ -5449,35 +7973,47 @@ func (cook GetMapfvCookie) Reply() (*GetMapfvReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getMapfvReply(buf), nil
+	return getMapfvReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getMapfvReply reads a byte slice into a GetMapfvReply value.
-func getMapfvReply(buf []byte) *GetMapfvReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetMapfv request arrives.
+func (cook GetMapfvCookie) ReplyContext(ctx context.Context) (*GetMapfvReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getMapfvReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// getMapfvReply reads a byte slice in the byte order 'order' into a GetMapfvReply value.
+func getMapfvReply(buf []byte, order xgb.ByteOrder) *GetMapfvReply {
 	v := new(GetMapfvReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = Float32(xgb.Get32(buf[b:]))
+	v.Datum = Float32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]Float32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = Float32(xgb.Get32(buf[b:]))
+		v.Data[i] = Float32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -5487,9 +8023,18 @@ func getMapfvReply(buf []byte) *GetMapfvReply {
 // Write request to wire for GetMapfv
 // getMapfvRequest writes a GetMapfv request to a byte slice.
 func getMapfvRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Query uint32) []byte {
+	return AppendGetMapfvRequest(nil, c, ContextTag, Target, Query)
+}
+
+// AppendGetMapfvRequest appends a GetMapfv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetMapfvRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Query uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -5499,21 +8044,57 @@ func getMapfvRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Query ui
 	buf[b] = 121 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], Query)
+	order.Put32(buf[b:], Query)
 	b += 4
 
 	return buf
 }
 
+// GetMapfvRequest represents the data of a GetMapfv request, as it is read when tracing a connection.
+type GetMapfvRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Query      uint32
+}
+
+// getMapfvRequestRead reads a byte slice in the byte order 'order' into a GetMapfvRequest value.
+func getMapfvRequestRead(buf []byte, order xgb.ByteOrder) *GetMapfvRequest {
+	v := new(GetMapfvRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Query = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][121] = xgb.RequestInfo{
+		Name: "GetMapfv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMapfvRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMapfvReply(buf, order)
+		},
+	}
+}
+
 // GetMapivCookie is a cookie used only for GetMapiv requests.
 type GetMapivCookie struct {
 	*xgb.Cookie
@@ -5566,35 +8147,47 @@ func (cook GetMapivCookie) Reply() (*GetMapivReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getMapivReply(buf), nil
+	return getMapivReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetMapiv request arrives.
+func (cook GetMapivCookie) ReplyContext(ctx context.Context) (*GetMapivReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getMapivReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getMapivReply reads a byte slice into a GetMapivReply value.
-func getMapivReply(buf []byte) *GetMapivReply {
+// getMapivReply reads a byte slice in the byte order 'order' into a GetMapivReply value.
+func getMapivReply(buf []byte, order xgb.ByteOrder) *GetMapivReply {
 	v := new(GetMapivReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = int32(xgb.Get32(buf[b:]))
+	v.Datum = int32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]int32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = int32(xgb.Get32(buf[b:]))
+		v.Data[i] = int32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -5604,9 +8197,18 @@ func getMapivReply(buf []byte) *GetMapivReply {
 // Write request to wire for GetMapiv
 // getMapivRequest writes a GetMapiv request to a byte slice.
 func getMapivRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Query uint32) []byte {
+	return AppendGetMapivRequest(nil, c, ContextTag, Target, Query)
+}
+
+// AppendGetMapivRequest appends a GetMapiv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetMapivRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Query uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -5616,21 +8218,57 @@ func getMapivRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Query ui
 	buf[b] = 122 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], Query)
+	order.Put32(buf[b:], Query)
 	b += 4
 
 	return buf
 }
 
+// GetMapivRequest represents the data of a GetMapiv request, as it is read when tracing a connection.
+type GetMapivRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Query      uint32
+}
+
+// getMapivRequestRead reads a byte slice in the byte order 'order' into a GetMapivRequest value.
+func getMapivRequestRead(buf []byte, order xgb.ByteOrder) *GetMapivRequest {
+	v := new(GetMapivRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Query = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][122] = xgb.RequestInfo{
+		Name: "GetMapiv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMapivRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMapivReply(buf, order)
+		},
+	}
+}
+
 // GetMaterialfvCookie is a cookie used only for GetMaterialfv requests.
 type GetMaterialfvCookie struct {
 	*xgb.Cookie
@@ -5683,35 +8321,47 @@ func (cook GetMaterialfvCookie) Reply() (*GetMaterialfvReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getMaterialfvReply(buf), nil
+	return getMaterialfvReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetMaterialfv request arrives.
+func (cook GetMaterialfvCookie) ReplyContext(ctx context.Context) (*GetMaterialfvReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getMaterialfvReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getMaterialfvReply reads a byte slice into a GetMaterialfvReply value.
-func getMaterialfvReply(buf []byte) *GetMaterialfvReply {
+// getMaterialfvReply reads a byte slice in the byte order 'order' into a GetMaterialfvReply value.
+func getMaterialfvReply(buf []byte, order xgb.ByteOrder) *GetMaterialfvReply {
 	v := new(GetMaterialfvReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = Float32(xgb.Get32(buf[b:]))
+	v.Datum = Float32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]Float32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = Float32(xgb.Get32(buf[b:]))
+		v.Data[i] = Float32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -5721,9 +8371,18 @@ func getMaterialfvReply(buf []byte) *GetMaterialfvReply {
 // Write request to wire for GetMaterialfv
 // getMaterialfvRequest writes a GetMaterialfv request to a byte slice.
 func getMaterialfvRequest(c *xgb.Conn, ContextTag ContextTag, Face uint32, Pname uint32) []byte {
+	return AppendGetMaterialfvRequest(nil, c, ContextTag, Face, Pname)
+}
+
+// AppendGetMaterialfvRequest appends a GetMaterialfv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetMaterialfvRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Face uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -5733,21 +8392,57 @@ func getMaterialfvRequest(c *xgb.Conn, ContextTag ContextTag, Face uint32, Pname
 	buf[b] = 123 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Face)
+	order.Put32(buf[b:], Face)
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetMaterialfvRequest represents the data of a GetMaterialfv request, as it is read when tracing a connection.
+type GetMaterialfvRequest struct {
+	ContextTag ContextTag
+	Face       uint32
+	Pname      uint32
+}
+
+// getMaterialfvRequestRead reads a byte slice in the byte order 'order' into a GetMaterialfvRequest value.
+func getMaterialfvRequestRead(buf []byte, order xgb.ByteOrder) *GetMaterialfvRequest {
+	v := new(GetMaterialfvRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Face = order.Get32(buf[b:])
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][123] = xgb.RequestInfo{
+		Name: "GetMaterialfv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMaterialfvRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMaterialfvReply(buf, order)
+		},
+	}
+}
+
 // GetMaterialivCookie is a cookie used only for GetMaterialiv requests.
 type GetMaterialivCookie struct {
 	*xgb.Cookie
@@ -5800,35 +8495,47 @@ func (cook GetMaterialivCookie) Reply() (*GetMaterialivReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getMaterialivReply(buf), nil
+	return getMaterialivReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetMaterialiv request arrives.
+func (cook GetMaterialivCookie) ReplyContext(ctx context.Context) (*GetMaterialivReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getMaterialivReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getMaterialivReply reads a byte slice into a GetMaterialivReply value.
-func getMaterialivReply(buf []byte) *GetMaterialivReply {
+// getMaterialivReply reads a byte slice in the byte order 'order' into a GetMaterialivReply value.
+func getMaterialivReply(buf []byte, order xgb.ByteOrder) *GetMaterialivReply {
 	v := new(GetMaterialivReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = int32(xgb.Get32(buf[b:]))
+	v.Datum = int32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]int32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = int32(xgb.Get32(buf[b:]))
+		v.Data[i] = int32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -5838,9 +8545,18 @@ func getMaterialivReply(buf []byte) *GetMaterialivReply {
 // Write request to wire for GetMaterialiv
 // getMaterialivRequest writes a GetMaterialiv request to a byte slice.
 func getMaterialivRequest(c *xgb.Conn, ContextTag ContextTag, Face uint32, Pname uint32) []byte {
+	return AppendGetMaterialivRequest(nil, c, ContextTag, Face, Pname)
+}
+
+// AppendGetMaterialivRequest appends a GetMaterialiv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetMaterialivRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Face uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -5850,21 +8566,57 @@ func getMaterialivRequest(c *xgb.Conn, ContextTag ContextTag, Face uint32, Pname
 	buf[b] = 124 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Face)
+	order.Put32(buf[b:], Face)
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetMaterialivRequest represents the data of a GetMaterialiv request, as it is read when tracing a connection.
+type GetMaterialivRequest struct {
+	ContextTag ContextTag
+	Face       uint32
+	Pname      uint32
+}
+
+// getMaterialivRequestRead reads a byte slice in the byte order 'order' into a GetMaterialivRequest value.
+func getMaterialivRequestRead(buf []byte, order xgb.ByteOrder) *GetMaterialivRequest {
+	v := new(GetMaterialivRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Face = order.Get32(buf[b:])
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][124] = xgb.RequestInfo{
+		Name: "GetMaterialiv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMaterialivRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMaterialivReply(buf, order)
+		},
+	}
+}
+
 // GetMinmaxCookie is a cookie used only for GetMinmax requests.
 type GetMinmaxCookie struct {
 	*xgb.Cookie
@@ -5914,20 +8666,32 @@ func (cook GetMinmaxCookie) Reply() (*GetMinmaxReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getMinmaxReply(buf), nil
+	return getMinmaxReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getMinmaxReply reads a byte slice into a GetMinmaxReply value.
-func getMinmaxReply(buf []byte) *GetMinmaxReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetMinmax request arrives.
+func (cook GetMinmaxCookie) ReplyContext(ctx context.Context) (*GetMinmaxReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getMinmaxReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// getMinmaxReply reads a byte slice in the byte order 'order' into a GetMinmaxReply value.
+func getMinmaxReply(buf []byte, order xgb.ByteOrder) *GetMinmaxReply {
 	v := new(GetMinmaxReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 24 // padding
@@ -5942,9 +8706,18 @@ func getMinmaxReply(buf []byte) *GetMinmaxReply {
 // Write request to wire for GetMinmax
 // getMinmaxRequest writes a GetMinmax request to a byte slice.
 func getMinmaxRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Format uint32, Type uint32, SwapBytes bool, Reset bool) []byte {
+	return AppendGetMinmaxRequest(nil, c, ContextTag, Target, Format, Type, SwapBytes, Reset)
+}
+
+// AppendGetMinmaxRequest appends a GetMinmax request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetMinmaxRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Format uint32, Type uint32, SwapBytes bool, Reset bool) []byte {
 	size := 24
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -5954,19 +8727,19 @@ func getMinmaxRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Format
 	buf[b] = 157 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], Format)
+	order.Put32(buf[b:], Format)
 	b += 4
 
-	xgb.Put32(buf[b:], Type)
+	order.Put32(buf[b:], Type)
 	b += 4
 
 	if SwapBytes {
@@ -5986,6 +8759,62 @@ func getMinmaxRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Format
 	return buf
 }
 
+// GetMinmaxRequest represents the data of a GetMinmax request, as it is read when tracing a connection.
+type GetMinmaxRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Format     uint32
+	Type       uint32
+	SwapBytes  bool
+	Reset      bool
+}
+
+// getMinmaxRequestRead reads a byte slice in the byte order 'order' into a GetMinmaxRequest value.
+func getMinmaxRequestRead(buf []byte, order xgb.ByteOrder) *GetMinmaxRequest {
+	v := new(GetMinmaxRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Format = order.Get32(buf[b:])
+	b += 4
+
+	v.Type = order.Get32(buf[b:])
+	b += 4
+
+	if buf[b] == 1 {
+		v.SwapBytes = true
+	} else {
+		v.SwapBytes = false
+	}
+	b += 1
+
+	if buf[b] == 1 {
+		v.Reset = true
+	} else {
+		v.Reset = false
+	}
+	b += 1
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][157] = xgb.RequestInfo{
+		Name: "GetMinmax",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMinmaxRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMinmaxReply(buf, order)
+		},
+	}
+}
+
 // GetMinmaxParameterfvCookie is a cookie used only for GetMinmaxParameterfv requests.
 type GetMinmaxParameterfvCookie struct {
 	*xgb.Cookie
@@ -6038,35 +8867,47 @@ func (cook GetMinmaxParameterfvCookie) Reply() (*GetMinmaxParameterfvReply, erro
 	if buf == nil {
 		return nil, nil
 	}
-	return getMinmaxParameterfvReply(buf), nil
+	return getMinmaxParameterfvReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetMinmaxParameterfv request arrives.
+func (cook GetMinmaxParameterfvCookie) ReplyContext(ctx context.Context) (*GetMinmaxParameterfvReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getMinmaxParameterfvReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getMinmaxParameterfvReply reads a byte slice into a GetMinmaxParameterfvReply value.
-func getMinmaxParameterfvReply(buf []byte) *GetMinmaxParameterfvReply {
+// getMinmaxParameterfvReply reads a byte slice in the byte order 'order' into a GetMinmaxParameterfvReply value.
+func getMinmaxParameterfvReply(buf []byte, order xgb.ByteOrder) *GetMinmaxParameterfvReply {
 	v := new(GetMinmaxParameterfvReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = Float32(xgb.Get32(buf[b:]))
+	v.Datum = Float32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]Float32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = Float32(xgb.Get32(buf[b:]))
+		v.Data[i] = Float32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -6076,9 +8917,18 @@ func getMinmaxParameterfvReply(buf []byte) *GetMinmaxParameterfvReply {
 // Write request to wire for GetMinmaxParameterfv
 // getMinmaxParameterfvRequest writes a GetMinmaxParameterfv request to a byte slice.
 func getMinmaxParameterfvRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
+	return AppendGetMinmaxParameterfvRequest(nil, c, ContextTag, Target, Pname)
+}
+
+// AppendGetMinmaxParameterfvRequest appends a GetMinmaxParameterfv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetMinmaxParameterfvRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -6088,19 +8938,55 @@ func getMinmaxParameterfvRequest(c *xgb.Conn, ContextTag ContextTag, Target uint
 	buf[b] = 158 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
+	b += 4
+
+	order.Put32(buf[b:], Target)
+	b += 4
+
+	order.Put32(buf[b:], Pname)
+	b += 4
+
+	return buf
+}
+
+// GetMinmaxParameterfvRequest represents the data of a GetMinmaxParameterfv request, as it is read when tracing a connection.
+type GetMinmaxParameterfvRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Pname      uint32
+}
+
+// getMinmaxParameterfvRequestRead reads a byte slice in the byte order 'order' into a GetMinmaxParameterfvRequest value.
+func getMinmaxParameterfvRequestRead(buf []byte, order xgb.ByteOrder) *GetMinmaxParameterfvRequest {
+	v := new(GetMinmaxParameterfvRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	v.Target = order.Get32(buf[b:])
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	v.Pname = order.Get32(buf[b:])
 	b += 4
 
-	return buf
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][158] = xgb.RequestInfo{
+		Name: "GetMinmaxParameterfv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMinmaxParameterfvRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMinmaxParameterfvReply(buf, order)
+		},
+	}
 }
 
 // GetMinmaxParameterivCookie is a cookie used only for GetMinmaxParameteriv requests.
@@ -6155,35 +9041,47 @@ func (cook GetMinmaxParameterivCookie) Reply() (*GetMinmaxParameterivReply, erro
 	if buf == nil {
 		return nil, nil
 	}
-	return getMinmaxParameterivReply(buf), nil
+	return getMinmaxParameterivReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetMinmaxParameteriv request arrives.
+func (cook GetMinmaxParameterivCookie) ReplyContext(ctx context.Context) (*GetMinmaxParameterivReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getMinmaxParameterivReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getMinmaxParameterivReply reads a byte slice into a GetMinmaxParameterivReply value.
-func getMinmaxParameterivReply(buf []byte) *GetMinmaxParameterivReply {
+// getMinmaxParameterivReply reads a byte slice in the byte order 'order' into a GetMinmaxParameterivReply value.
+func getMinmaxParameterivReply(buf []byte, order xgb.ByteOrder) *GetMinmaxParameterivReply {
 	v := new(GetMinmaxParameterivReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = int32(xgb.Get32(buf[b:]))
+	v.Datum = int32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]int32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = int32(xgb.Get32(buf[b:]))
+		v.Data[i] = int32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -6193,9 +9091,18 @@ func getMinmaxParameterivReply(buf []byte) *GetMinmaxParameterivReply {
 // Write request to wire for GetMinmaxParameteriv
 // getMinmaxParameterivRequest writes a GetMinmaxParameteriv request to a byte slice.
 func getMinmaxParameterivRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
+	return AppendGetMinmaxParameterivRequest(nil, c, ContextTag, Target, Pname)
+}
+
+// AppendGetMinmaxParameterivRequest appends a GetMinmaxParameteriv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetMinmaxParameterivRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -6205,21 +9112,57 @@ func getMinmaxParameterivRequest(c *xgb.Conn, ContextTag ContextTag, Target uint
 	buf[b] = 159 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetMinmaxParameterivRequest represents the data of a GetMinmaxParameteriv request, as it is read when tracing a connection.
+type GetMinmaxParameterivRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Pname      uint32
+}
+
+// getMinmaxParameterivRequestRead reads a byte slice in the byte order 'order' into a GetMinmaxParameterivRequest value.
+func getMinmaxParameterivRequestRead(buf []byte, order xgb.ByteOrder) *GetMinmaxParameterivRequest {
+	v := new(GetMinmaxParameterivRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][159] = xgb.RequestInfo{
+		Name: "GetMinmaxParameteriv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMinmaxParameterivRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMinmaxParameterivReply(buf, order)
+		},
+	}
+}
+
 // GetPixelMapfvCookie is a cookie used only for GetPixelMapfv requests.
 type GetPixelMapfvCookie struct {
 	*xgb.Cookie
@@ -6272,35 +9215,47 @@ func (cook GetPixelMapfvCookie) Reply() (*GetPixelMapfvReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getPixelMapfvReply(buf), nil
+	return getPixelMapfvReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetPixelMapfv request arrives.
+func (cook GetPixelMapfvCookie) ReplyContext(ctx context.Context) (*GetPixelMapfvReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getPixelMapfvReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getPixelMapfvReply reads a byte slice into a GetPixelMapfvReply value.
-func getPixelMapfvReply(buf []byte) *GetPixelMapfvReply {
+// getPixelMapfvReply reads a byte slice in the byte order 'order' into a GetPixelMapfvReply value.
+func getPixelMapfvReply(buf []byte, order xgb.ByteOrder) *GetPixelMapfvReply {
 	v := new(GetPixelMapfvReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = Float32(xgb.Get32(buf[b:]))
+	v.Datum = Float32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]Float32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = Float32(xgb.Get32(buf[b:]))
+		v.Data[i] = Float32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -6310,9 +9265,18 @@ func getPixelMapfvReply(buf []byte) *GetPixelMapfvReply {
 // Write request to wire for GetPixelMapfv
 // getPixelMapfvRequest writes a GetPixelMapfv request to a byte slice.
 func getPixelMapfvRequest(c *xgb.Conn, ContextTag ContextTag, Map uint32) []byte {
+	return AppendGetPixelMapfvRequest(nil, c, ContextTag, Map)
+}
+
+// AppendGetPixelMapfvRequest appends a GetPixelMapfv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetPixelMapfvRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Map uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -6322,18 +9286,50 @@ func getPixelMapfvRequest(c *xgb.Conn, ContextTag ContextTag, Map uint32) []byte
 	buf[b] = 125 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Map)
+	order.Put32(buf[b:], Map)
 	b += 4
 
 	return buf
 }
 
+// GetPixelMapfvRequest represents the data of a GetPixelMapfv request, as it is read when tracing a connection.
+type GetPixelMapfvRequest struct {
+	ContextTag ContextTag
+	Map        uint32
+}
+
+// getPixelMapfvRequestRead reads a byte slice in the byte order 'order' into a GetPixelMapfvRequest value.
+func getPixelMapfvRequestRead(buf []byte, order xgb.ByteOrder) *GetPixelMapfvRequest {
+	v := new(GetPixelMapfvRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Map = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][125] = xgb.RequestInfo{
+		Name: "GetPixelMapfv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPixelMapfvRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPixelMapfvReply(buf, order)
+		},
+	}
+}
+
 // GetPixelMapuivCookie is a cookie used only for GetPixelMapuiv requests.
 type GetPixelMapuivCookie struct {
 	*xgb.Cookie
@@ -6386,35 +9382,47 @@ func (cook GetPixelMapuivCookie) Reply() (*GetPixelMapuivReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getPixelMapuivReply(buf), nil
+	return getPixelMapuivReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetPixelMapuiv request arrives.
+func (cook GetPixelMapuivCookie) ReplyContext(ctx context.Context) (*GetPixelMapuivReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getPixelMapuivReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getPixelMapuivReply reads a byte slice into a GetPixelMapuivReply value.
-func getPixelMapuivReply(buf []byte) *GetPixelMapuivReply {
+// getPixelMapuivReply reads a byte slice in the byte order 'order' into a GetPixelMapuivReply value.
+func getPixelMapuivReply(buf []byte, order xgb.ByteOrder) *GetPixelMapuivReply {
 	v := new(GetPixelMapuivReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = xgb.Get32(buf[b:])
+	v.Datum = order.Get32(buf[b:])
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]uint32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = xgb.Get32(buf[b:])
+		v.Data[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -6424,9 +9432,18 @@ func getPixelMapuivReply(buf []byte) *GetPixelMapuivReply {
 // Write request to wire for GetPixelMapuiv
 // getPixelMapuivRequest writes a GetPixelMapuiv request to a byte slice.
 func getPixelMapuivRequest(c *xgb.Conn, ContextTag ContextTag, Map uint32) []byte {
+	return AppendGetPixelMapuivRequest(nil, c, ContextTag, Map)
+}
+
+// AppendGetPixelMapuivRequest appends a GetPixelMapuiv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetPixelMapuivRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Map uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -6436,18 +9453,50 @@ func getPixelMapuivRequest(c *xgb.Conn, ContextTag ContextTag, Map uint32) []byt
 	buf[b] = 126 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Map)
+	order.Put32(buf[b:], Map)
 	b += 4
 
 	return buf
 }
 
+// GetPixelMapuivRequest represents the data of a GetPixelMapuiv request, as it is read when tracing a connection.
+type GetPixelMapuivRequest struct {
+	ContextTag ContextTag
+	Map        uint32
+}
+
+// getPixelMapuivRequestRead reads a byte slice in the byte order 'order' into a GetPixelMapuivRequest value.
+func getPixelMapuivRequestRead(buf []byte, order xgb.ByteOrder) *GetPixelMapuivRequest {
+	v := new(GetPixelMapuivRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Map = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][126] = xgb.RequestInfo{
+		Name: "GetPixelMapuiv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPixelMapuivRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPixelMapuivReply(buf, order)
+		},
+	}
+}
+
 // GetPixelMapusvCookie is a cookie used only for GetPixelMapusv requests.
 type GetPixelMapusvCookie struct {
 	*xgb.Cookie
@@ -6500,35 +9549,47 @@ func (cook GetPixelMapusvCookie) Reply() (*GetPixelMapusvReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getPixelMapusvReply(buf), nil
+	return getPixelMapusvReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetPixelMapusv request arrives.
+func (cook GetPixelMapusvCookie) ReplyContext(ctx context.Context) (*GetPixelMapusvReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getPixelMapusvReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getPixelMapusvReply reads a byte slice into a GetPixelMapusvReply value.
-func getPixelMapusvReply(buf []byte) *GetPixelMapusvReply {
+// getPixelMapusvReply reads a byte slice in the byte order 'order' into a GetPixelMapusvReply value.
+func getPixelMapusvReply(buf []byte, order xgb.ByteOrder) *GetPixelMapusvReply {
 	v := new(GetPixelMapusvReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = xgb.Get16(buf[b:])
+	v.Datum = order.Get16(buf[b:])
 	b += 2
 
 	b += 16 // padding
 
 	v.Data = make([]uint16, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = xgb.Get16(buf[b:])
+		v.Data[i] = order.Get16(buf[b:])
 		b += 2
 	}
 
@@ -6538,9 +9599,18 @@ func getPixelMapusvReply(buf []byte) *GetPixelMapusvReply {
 // Write request to wire for GetPixelMapusv
 // getPixelMapusvRequest writes a GetPixelMapusv request to a byte slice.
 func getPixelMapusvRequest(c *xgb.Conn, ContextTag ContextTag, Map uint32) []byte {
+	return AppendGetPixelMapusvRequest(nil, c, ContextTag, Map)
+}
+
+// AppendGetPixelMapusvRequest appends a GetPixelMapusv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetPixelMapusvRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Map uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -6550,18 +9620,50 @@ func getPixelMapusvRequest(c *xgb.Conn, ContextTag ContextTag, Map uint32) []byt
 	buf[b] = 127 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Map)
+	order.Put32(buf[b:], Map)
 	b += 4
 
 	return buf
 }
 
+// GetPixelMapusvRequest represents the data of a GetPixelMapusv request, as it is read when tracing a connection.
+type GetPixelMapusvRequest struct {
+	ContextTag ContextTag
+	Map        uint32
+}
+
+// getPixelMapusvRequestRead reads a byte slice in the byte order 'order' into a GetPixelMapusvRequest value.
+func getPixelMapusvRequestRead(buf []byte, order xgb.ByteOrder) *GetPixelMapusvRequest {
+	v := new(GetPixelMapusvRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Map = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][127] = xgb.RequestInfo{
+		Name: "GetPixelMapusv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPixelMapusvRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPixelMapusvReply(buf, order)
+		},
+	}
+}
+
 // GetPolygonStippleCookie is a cookie used only for GetPolygonStipple requests.
 type GetPolygonStippleCookie struct {
 	*xgb.Cookie
@@ -6611,20 +9713,32 @@ func (cook GetPolygonStippleCookie) Reply() (*GetPolygonStippleReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getPolygonStippleReply(buf), nil
+	return getPolygonStippleReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getPolygonStippleReply reads a byte slice into a GetPolygonStippleReply value.
-func getPolygonStippleReply(buf []byte) *GetPolygonStippleReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetPolygonStipple request arrives.
+func (cook GetPolygonStippleCookie) ReplyContext(ctx context.Context) (*GetPolygonStippleReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getPolygonStippleReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// getPolygonStippleReply reads a byte slice in the byte order 'order' into a GetPolygonStippleReply value.
+func getPolygonStippleReply(buf []byte, order xgb.ByteOrder) *GetPolygonStippleReply {
 	v := new(GetPolygonStippleReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 24 // padding
@@ -6639,9 +9753,18 @@ func getPolygonStippleReply(buf []byte) *GetPolygonStippleReply {
 // Write request to wire for GetPolygonStipple
 // getPolygonStippleRequest writes a GetPolygonStipple request to a byte slice.
 func getPolygonStippleRequest(c *xgb.Conn, ContextTag ContextTag, LsbFirst bool) []byte {
+	return AppendGetPolygonStippleRequest(nil, c, ContextTag, LsbFirst)
+}
+
+// AppendGetPolygonStippleRequest appends a GetPolygonStipple request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetPolygonStippleRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, LsbFirst bool) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -6651,10 +9774,10 @@ func getPolygonStippleRequest(c *xgb.Conn, ContextTag ContextTag, LsbFirst bool)
 	buf[b] = 128 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
 	if LsbFirst {
@@ -6667,6 +9790,42 @@ func getPolygonStippleRequest(c *xgb.Conn, ContextTag ContextTag, LsbFirst bool)
 	return buf
 }
 
+// GetPolygonStippleRequest represents the data of a GetPolygonStipple request, as it is read when tracing a connection.
+type GetPolygonStippleRequest struct {
+	ContextTag ContextTag
+	LsbFirst   bool
+}
+
+// getPolygonStippleRequestRead reads a byte slice in the byte order 'order' into a GetPolygonStippleRequest value.
+func getPolygonStippleRequestRead(buf []byte, order xgb.ByteOrder) *GetPolygonStippleRequest {
+	v := new(GetPolygonStippleRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	if buf[b] == 1 {
+		v.LsbFirst = true
+	} else {
+		v.LsbFirst = false
+	}
+	b += 1
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][128] = xgb.RequestInfo{
+		Name: "GetPolygonStipple",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPolygonStippleRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPolygonStippleReply(buf, order)
+		},
+	}
+}
+
 // GetQueryObjectivARBCookie is a cookie used only for GetQueryObjectivARB requests.
 type GetQueryObjectivARBCookie struct {
 	*xgb.Cookie
@@ -6719,35 +9878,47 @@ func (cook GetQueryObjectivARBCookie) Reply() (*GetQueryObjectivARBReply, error)
 	if buf == nil {
 		return nil, nil
 	}
-	return getQueryObjectivARBReply(buf), nil
+	return getQueryObjectivARBReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetQueryObjectivARB request arrives.
+func (cook GetQueryObjectivARBCookie) ReplyContext(ctx context.Context) (*GetQueryObjectivARBReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getQueryObjectivARBReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getQueryObjectivARBReply reads a byte slice into a GetQueryObjectivARBReply value.
-func getQueryObjectivARBReply(buf []byte) *GetQueryObjectivARBReply {
+// getQueryObjectivARBReply reads a byte slice in the byte order 'order' into a GetQueryObjectivARBReply value.
+func getQueryObjectivARBReply(buf []byte, order xgb.ByteOrder) *GetQueryObjectivARBReply {
 	v := new(GetQueryObjectivARBReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = int32(xgb.Get32(buf[b:]))
+	v.Datum = int32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]int32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = int32(xgb.Get32(buf[b:]))
+		v.Data[i] = int32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -6757,9 +9928,18 @@ func getQueryObjectivARBReply(buf []byte) *GetQueryObjectivARBReply {
 // Write request to wire for GetQueryObjectivARB
 // getQueryObjectivARBRequest writes a GetQueryObjectivARB request to a byte slice.
 func getQueryObjectivARBRequest(c *xgb.Conn, ContextTag ContextTag, Id uint32, Pname uint32) []byte {
+	return AppendGetQueryObjectivARBRequest(nil, c, ContextTag, Id, Pname)
+}
+
+// AppendGetQueryObjectivARBRequest appends a GetQueryObjectivARB request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetQueryObjectivARBRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Id uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -6769,21 +9949,57 @@ func getQueryObjectivARBRequest(c *xgb.Conn, ContextTag ContextTag, Id uint32, P
 	buf[b] = 165 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Id)
+	order.Put32(buf[b:], Id)
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetQueryObjectivARBRequest represents the data of a GetQueryObjectivARB request, as it is read when tracing a connection.
+type GetQueryObjectivARBRequest struct {
+	ContextTag ContextTag
+	Id         uint32
+	Pname      uint32
+}
+
+// getQueryObjectivARBRequestRead reads a byte slice in the byte order 'order' into a GetQueryObjectivARBRequest value.
+func getQueryObjectivARBRequestRead(buf []byte, order xgb.ByteOrder) *GetQueryObjectivARBRequest {
+	v := new(GetQueryObjectivARBRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Id = order.Get32(buf[b:])
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][165] = xgb.RequestInfo{
+		Name: "GetQueryObjectivARB",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getQueryObjectivARBRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getQueryObjectivARBReply(buf, order)
+		},
+	}
+}
+
 // GetQueryObjectuivARBCookie is a cookie used only for GetQueryObjectuivARB requests.
 type GetQueryObjectuivARBCookie struct {
 	*xgb.Cookie
@@ -6836,35 +10052,47 @@ func (cook GetQueryObjectuivARBCookie) Reply() (*GetQueryObjectuivARBReply, erro
 	if buf == nil {
 		return nil, nil
 	}
-	return getQueryObjectuivARBReply(buf), nil
+	return getQueryObjectuivARBReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetQueryObjectuivARB request arrives.
+func (cook GetQueryObjectuivARBCookie) ReplyContext(ctx context.Context) (*GetQueryObjectuivARBReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getQueryObjectuivARBReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getQueryObjectuivARBReply reads a byte slice into a GetQueryObjectuivARBReply value.
-func getQueryObjectuivARBReply(buf []byte) *GetQueryObjectuivARBReply {
+// getQueryObjectuivARBReply reads a byte slice in the byte order 'order' into a GetQueryObjectuivARBReply value.
+func getQueryObjectuivARBReply(buf []byte, order xgb.ByteOrder) *GetQueryObjectuivARBReply {
 	v := new(GetQueryObjectuivARBReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = xgb.Get32(buf[b:])
+	v.Datum = order.Get32(buf[b:])
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]uint32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = xgb.Get32(buf[b:])
+		v.Data[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -6874,9 +10102,18 @@ func getQueryObjectuivARBReply(buf []byte) *GetQueryObjectuivARBReply {
 // Write request to wire for GetQueryObjectuivARB
 // getQueryObjectuivARBRequest writes a GetQueryObjectuivARB request to a byte slice.
 func getQueryObjectuivARBRequest(c *xgb.Conn, ContextTag ContextTag, Id uint32, Pname uint32) []byte {
+	return AppendGetQueryObjectuivARBRequest(nil, c, ContextTag, Id, Pname)
+}
+
+// AppendGetQueryObjectuivARBRequest appends a GetQueryObjectuivARB request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetQueryObjectuivARBRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Id uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -6886,21 +10123,57 @@ func getQueryObjectuivARBRequest(c *xgb.Conn, ContextTag ContextTag, Id uint32,
 	buf[b] = 166 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Id)
+	order.Put32(buf[b:], Id)
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetQueryObjectuivARBRequest represents the data of a GetQueryObjectuivARB request, as it is read when tracing a connection.
+type GetQueryObjectuivARBRequest struct {
+	ContextTag ContextTag
+	Id         uint32
+	Pname      uint32
+}
+
+// getQueryObjectuivARBRequestRead reads a byte slice in the byte order 'order' into a GetQueryObjectuivARBRequest value.
+func getQueryObjectuivARBRequestRead(buf []byte, order xgb.ByteOrder) *GetQueryObjectuivARBRequest {
+	v := new(GetQueryObjectuivARBRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Id = order.Get32(buf[b:])
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][166] = xgb.RequestInfo{
+		Name: "GetQueryObjectuivARB",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getQueryObjectuivARBRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getQueryObjectuivARBReply(buf, order)
+		},
+	}
+}
+
 // GetQueryivARBCookie is a cookie used only for GetQueryivARB requests.
 type GetQueryivARBCookie struct {
 	*xgb.Cookie
@@ -6953,35 +10226,47 @@ func (cook GetQueryivARBCookie) Reply() (*GetQueryivARBReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getQueryivARBReply(buf), nil
+	return getQueryivARBReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetQueryivARB request arrives.
+func (cook GetQueryivARBCookie) ReplyContext(ctx context.Context) (*GetQueryivARBReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getQueryivARBReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getQueryivARBReply reads a byte slice into a GetQueryivARBReply value.
-func getQueryivARBReply(buf []byte) *GetQueryivARBReply {
+// getQueryivARBReply reads a byte slice in the byte order 'order' into a GetQueryivARBReply value.
+func getQueryivARBReply(buf []byte, order xgb.ByteOrder) *GetQueryivARBReply {
 	v := new(GetQueryivARBReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = int32(xgb.Get32(buf[b:]))
+	v.Datum = int32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]int32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = int32(xgb.Get32(buf[b:]))
+		v.Data[i] = int32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -6991,9 +10276,18 @@ func getQueryivARBReply(buf []byte) *GetQueryivARBReply {
 // Write request to wire for GetQueryivARB
 // getQueryivARBRequest writes a GetQueryivARB request to a byte slice.
 func getQueryivARBRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
+	return AppendGetQueryivARBRequest(nil, c, ContextTag, Target, Pname)
+}
+
+// AppendGetQueryivARBRequest appends a GetQueryivARB request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetQueryivARBRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -7003,21 +10297,57 @@ func getQueryivARBRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Pna
 	buf[b] = 164 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetQueryivARBRequest represents the data of a GetQueryivARB request, as it is read when tracing a connection.
+type GetQueryivARBRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Pname      uint32
+}
+
+// getQueryivARBRequestRead reads a byte slice in the byte order 'order' into a GetQueryivARBRequest value.
+func getQueryivARBRequestRead(buf []byte, order xgb.ByteOrder) *GetQueryivARBRequest {
+	v := new(GetQueryivARBRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][164] = xgb.RequestInfo{
+		Name: "GetQueryivARB",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getQueryivARBRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getQueryivARBReply(buf, order)
+		},
+	}
+}
+
 // GetSeparableFilterCookie is a cookie used only for GetSeparableFilter requests.
 type GetSeparableFilterCookie struct {
 	*xgb.Cookie
@@ -7070,28 +10400,40 @@ func (cook GetSeparableFilterCookie) Reply() (*GetSeparableFilterReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getSeparableFilterReply(buf), nil
+	return getSeparableFilterReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetSeparableFilter request arrives.
+func (cook GetSeparableFilterCookie) ReplyContext(ctx context.Context) (*GetSeparableFilterReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getSeparableFilterReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getSeparableFilterReply reads a byte slice into a GetSeparableFilterReply value.
-func getSeparableFilterReply(buf []byte) *GetSeparableFilterReply {
+// getSeparableFilterReply reads a byte slice in the byte order 'order' into a GetSeparableFilterReply value.
+func getSeparableFilterReply(buf []byte, order xgb.ByteOrder) *GetSeparableFilterReply {
 	v := new(GetSeparableFilterReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 8 // padding
 
-	v.RowW = int32(xgb.Get32(buf[b:]))
+	v.RowW = int32(order.Get32(buf[b:]))
 	b += 4
 
-	v.ColH = int32(xgb.Get32(buf[b:]))
+	v.ColH = int32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 8 // padding
@@ -7106,9 +10448,18 @@ func getSeparableFilterReply(buf []byte) *GetSeparableFilterReply {
 // Write request to wire for GetSeparableFilter
 // getSeparableFilterRequest writes a GetSeparableFilter request to a byte slice.
 func getSeparableFilterRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Format uint32, Type uint32, SwapBytes bool) []byte {
+	return AppendGetSeparableFilterRequest(nil, c, ContextTag, Target, Format, Type, SwapBytes)
+}
+
+// AppendGetSeparableFilterRequest appends a GetSeparableFilter request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetSeparableFilterRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Format uint32, Type uint32, SwapBytes bool) []byte {
 	size := 24
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -7118,29 +10469,77 @@ func getSeparableFilterRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32
 	buf[b] = 153 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
-	b += 2
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(ContextTag))
+	b += 4
+
+	order.Put32(buf[b:], Target)
+	b += 4
+
+	order.Put32(buf[b:], Format)
+	b += 4
+
+	order.Put32(buf[b:], Type)
+	b += 4
+
+	if SwapBytes {
+		buf[b] = 1
+	} else {
+		buf[b] = 0
+	}
+	b += 1
+
+	return buf
+}
+
+// GetSeparableFilterRequest represents the data of a GetSeparableFilter request, as it is read when tracing a connection.
+type GetSeparableFilterRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Format     uint32
+	Type       uint32
+	SwapBytes  bool
+}
+
+// getSeparableFilterRequestRead reads a byte slice in the byte order 'order' into a GetSeparableFilterRequest value.
+func getSeparableFilterRequestRead(buf []byte, order xgb.ByteOrder) *GetSeparableFilterRequest {
+	v := new(GetSeparableFilterRequest)
+	b := 4 // skip request header
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	v.Target = order.Get32(buf[b:])
 	b += 4
 
-	xgb.Put32(buf[b:], Format)
+	v.Format = order.Get32(buf[b:])
 	b += 4
 
-	xgb.Put32(buf[b:], Type)
+	v.Type = order.Get32(buf[b:])
 	b += 4
 
-	if SwapBytes {
-		buf[b] = 1
+	if buf[b] == 1 {
+		v.SwapBytes = true
 	} else {
-		buf[b] = 0
+		v.SwapBytes = false
 	}
 	b += 1
 
-	return buf
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][153] = xgb.RequestInfo{
+		Name: "GetSeparableFilter",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getSeparableFilterRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getSeparableFilterReply(buf, order)
+		},
+	}
 }
 
 // GetStringCookie is a cookie used only for GetString requests.
@@ -7194,25 +10593,37 @@ func (cook GetStringCookie) Reply() (*GetStringReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getStringReply(buf), nil
+	return getStringReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetString request arrives.
+func (cook GetStringCookie) ReplyContext(ctx context.Context) (*GetStringReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getStringReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getStringReply reads a byte slice into a GetStringReply value.
-func getStringReply(buf []byte) *GetStringReply {
+// getStringReply reads a byte slice in the byte order 'order' into a GetStringReply value.
+func getStringReply(buf []byte, order xgb.ByteOrder) *GetStringReply {
 	v := new(GetStringReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
 	b += 16 // padding
@@ -7230,9 +10641,18 @@ func getStringReply(buf []byte) *GetStringReply {
 // Write request to wire for GetString
 // getStringRequest writes a GetString request to a byte slice.
 func getStringRequest(c *xgb.Conn, ContextTag ContextTag, Name uint32) []byte {
+	return AppendGetStringRequest(nil, c, ContextTag, Name)
+}
+
+// AppendGetStringRequest appends a GetString request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetStringRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Name uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -7242,18 +10662,50 @@ func getStringRequest(c *xgb.Conn, ContextTag ContextTag, Name uint32) []byte {
 	buf[b] = 129 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Name)
+	order.Put32(buf[b:], Name)
 	b += 4
 
 	return buf
 }
 
+// GetStringRequest represents the data of a GetString request, as it is read when tracing a connection.
+type GetStringRequest struct {
+	ContextTag ContextTag
+	Name       uint32
+}
+
+// getStringRequestRead reads a byte slice in the byte order 'order' into a GetStringRequest value.
+func getStringRequestRead(buf []byte, order xgb.ByteOrder) *GetStringRequest {
+	v := new(GetStringRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Name = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][129] = xgb.RequestInfo{
+		Name: "GetString",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getStringRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getStringReply(buf, order)
+		},
+	}
+}
+
 // GetTexEnvfvCookie is a cookie used only for GetTexEnvfv requests.
 type GetTexEnvfvCookie struct {
 	*xgb.Cookie
@@ -7306,35 +10758,47 @@ func (cook GetTexEnvfvCookie) Reply() (*GetTexEnvfvReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getTexEnvfvReply(buf), nil
+	return getTexEnvfvReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetTexEnvfv request arrives.
+func (cook GetTexEnvfvCookie) ReplyContext(ctx context.Context) (*GetTexEnvfvReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getTexEnvfvReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getTexEnvfvReply reads a byte slice into a GetTexEnvfvReply value.
-func getTexEnvfvReply(buf []byte) *GetTexEnvfvReply {
+// getTexEnvfvReply reads a byte slice in the byte order 'order' into a GetTexEnvfvReply value.
+func getTexEnvfvReply(buf []byte, order xgb.ByteOrder) *GetTexEnvfvReply {
 	v := new(GetTexEnvfvReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = Float32(xgb.Get32(buf[b:]))
+	v.Datum = Float32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]Float32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = Float32(xgb.Get32(buf[b:]))
+		v.Data[i] = Float32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -7344,9 +10808,18 @@ func getTexEnvfvReply(buf []byte) *GetTexEnvfvReply {
 // Write request to wire for GetTexEnvfv
 // getTexEnvfvRequest writes a GetTexEnvfv request to a byte slice.
 func getTexEnvfvRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
+	return AppendGetTexEnvfvRequest(nil, c, ContextTag, Target, Pname)
+}
+
+// AppendGetTexEnvfvRequest appends a GetTexEnvfv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetTexEnvfvRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -7356,21 +10829,57 @@ func getTexEnvfvRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname
 	buf[b] = 130 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetTexEnvfvRequest represents the data of a GetTexEnvfv request, as it is read when tracing a connection.
+type GetTexEnvfvRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Pname      uint32
+}
+
+// getTexEnvfvRequestRead reads a byte slice in the byte order 'order' into a GetTexEnvfvRequest value.
+func getTexEnvfvRequestRead(buf []byte, order xgb.ByteOrder) *GetTexEnvfvRequest {
+	v := new(GetTexEnvfvRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][130] = xgb.RequestInfo{
+		Name: "GetTexEnvfv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTexEnvfvRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTexEnvfvReply(buf, order)
+		},
+	}
+}
+
 // GetTexEnvivCookie is a cookie used only for GetTexEnviv requests.
 type GetTexEnvivCookie struct {
 	*xgb.Cookie
@@ -7423,35 +10932,47 @@ func (cook GetTexEnvivCookie) Reply() (*GetTexEnvivReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getTexEnvivReply(buf), nil
+	return getTexEnvivReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetTexEnviv request arrives.
+func (cook GetTexEnvivCookie) ReplyContext(ctx context.Context) (*GetTexEnvivReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getTexEnvivReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getTexEnvivReply reads a byte slice into a GetTexEnvivReply value.
-func getTexEnvivReply(buf []byte) *GetTexEnvivReply {
+// getTexEnvivReply reads a byte slice in the byte order 'order' into a GetTexEnvivReply value.
+func getTexEnvivReply(buf []byte, order xgb.ByteOrder) *GetTexEnvivReply {
 	v := new(GetTexEnvivReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = int32(xgb.Get32(buf[b:]))
+	v.Datum = int32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]int32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = int32(xgb.Get32(buf[b:]))
+		v.Data[i] = int32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -7461,9 +10982,18 @@ func getTexEnvivReply(buf []byte) *GetTexEnvivReply {
 // Write request to wire for GetTexEnviv
 // getTexEnvivRequest writes a GetTexEnviv request to a byte slice.
 func getTexEnvivRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
+	return AppendGetTexEnvivRequest(nil, c, ContextTag, Target, Pname)
+}
+
+// AppendGetTexEnvivRequest appends a GetTexEnviv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetTexEnvivRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -7473,21 +11003,57 @@ func getTexEnvivRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname
 	buf[b] = 131 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetTexEnvivRequest represents the data of a GetTexEnviv request, as it is read when tracing a connection.
+type GetTexEnvivRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Pname      uint32
+}
+
+// getTexEnvivRequestRead reads a byte slice in the byte order 'order' into a GetTexEnvivRequest value.
+func getTexEnvivRequestRead(buf []byte, order xgb.ByteOrder) *GetTexEnvivRequest {
+	v := new(GetTexEnvivRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][131] = xgb.RequestInfo{
+		Name: "GetTexEnviv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTexEnvivRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTexEnvivReply(buf, order)
+		},
+	}
+}
+
 // GetTexGendvCookie is a cookie used only for GetTexGendv requests.
 type GetTexGendvCookie struct {
 	*xgb.Cookie
@@ -7540,35 +11106,47 @@ func (cook GetTexGendvCookie) Reply() (*GetTexGendvReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getTexGendvReply(buf), nil
+	return getTexGendvReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getTexGendvReply reads a byte slice into a GetTexGendvReply value.
-func getTexGendvReply(buf []byte) *GetTexGendvReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetTexGendv request arrives.
+func (cook GetTexGendvCookie) ReplyContext(ctx context.Context) (*GetTexGendvReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getTexGendvReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// getTexGendvReply reads a byte slice in the byte order 'order' into a GetTexGendvReply value.
+func getTexGendvReply(buf []byte, order xgb.ByteOrder) *GetTexGendvReply {
 	v := new(GetTexGendvReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = Float64(xgb.Get64(buf[b:]))
+	v.Datum = Float64(order.Get64(buf[b:]))
 	b += 8
 
 	b += 8 // padding
 
 	v.Data = make([]Float64, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = Float64(xgb.Get64(buf[b:]))
+		v.Data[i] = Float64(order.Get64(buf[b:]))
 		b += 8
 	}
 
@@ -7578,9 +11156,18 @@ func getTexGendvReply(buf []byte) *GetTexGendvReply {
 // Write request to wire for GetTexGendv
 // getTexGendvRequest writes a GetTexGendv request to a byte slice.
 func getTexGendvRequest(c *xgb.Conn, ContextTag ContextTag, Coord uint32, Pname uint32) []byte {
+	return AppendGetTexGendvRequest(nil, c, ContextTag, Coord, Pname)
+}
+
+// AppendGetTexGendvRequest appends a GetTexGendv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetTexGendvRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Coord uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -7590,21 +11177,57 @@ func getTexGendvRequest(c *xgb.Conn, ContextTag ContextTag, Coord uint32, Pname
 	buf[b] = 132 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Coord)
+	order.Put32(buf[b:], Coord)
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetTexGendvRequest represents the data of a GetTexGendv request, as it is read when tracing a connection.
+type GetTexGendvRequest struct {
+	ContextTag ContextTag
+	Coord      uint32
+	Pname      uint32
+}
+
+// getTexGendvRequestRead reads a byte slice in the byte order 'order' into a GetTexGendvRequest value.
+func getTexGendvRequestRead(buf []byte, order xgb.ByteOrder) *GetTexGendvRequest {
+	v := new(GetTexGendvRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Coord = order.Get32(buf[b:])
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][132] = xgb.RequestInfo{
+		Name: "GetTexGendv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTexGendvRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTexGendvReply(buf, order)
+		},
+	}
+}
+
 // GetTexGenfvCookie is a cookie used only for GetTexGenfv requests.
 type GetTexGenfvCookie struct {
 	*xgb.Cookie
@@ -7657,35 +11280,47 @@ func (cook GetTexGenfvCookie) Reply() (*GetTexGenfvReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getTexGenfvReply(buf), nil
+	return getTexGenfvReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetTexGenfv request arrives.
+func (cook GetTexGenfvCookie) ReplyContext(ctx context.Context) (*GetTexGenfvReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getTexGenfvReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getTexGenfvReply reads a byte slice into a GetTexGenfvReply value.
-func getTexGenfvReply(buf []byte) *GetTexGenfvReply {
+// getTexGenfvReply reads a byte slice in the byte order 'order' into a GetTexGenfvReply value.
+func getTexGenfvReply(buf []byte, order xgb.ByteOrder) *GetTexGenfvReply {
 	v := new(GetTexGenfvReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = Float32(xgb.Get32(buf[b:]))
+	v.Datum = Float32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]Float32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = Float32(xgb.Get32(buf[b:]))
+		v.Data[i] = Float32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -7695,9 +11330,18 @@ func getTexGenfvReply(buf []byte) *GetTexGenfvReply {
 // Write request to wire for GetTexGenfv
 // getTexGenfvRequest writes a GetTexGenfv request to a byte slice.
 func getTexGenfvRequest(c *xgb.Conn, ContextTag ContextTag, Coord uint32, Pname uint32) []byte {
+	return AppendGetTexGenfvRequest(nil, c, ContextTag, Coord, Pname)
+}
+
+// AppendGetTexGenfvRequest appends a GetTexGenfv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetTexGenfvRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Coord uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -7707,21 +11351,57 @@ func getTexGenfvRequest(c *xgb.Conn, ContextTag ContextTag, Coord uint32, Pname
 	buf[b] = 133 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Coord)
+	order.Put32(buf[b:], Coord)
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetTexGenfvRequest represents the data of a GetTexGenfv request, as it is read when tracing a connection.
+type GetTexGenfvRequest struct {
+	ContextTag ContextTag
+	Coord      uint32
+	Pname      uint32
+}
+
+// getTexGenfvRequestRead reads a byte slice in the byte order 'order' into a GetTexGenfvRequest value.
+func getTexGenfvRequestRead(buf []byte, order xgb.ByteOrder) *GetTexGenfvRequest {
+	v := new(GetTexGenfvRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Coord = order.Get32(buf[b:])
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][133] = xgb.RequestInfo{
+		Name: "GetTexGenfv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTexGenfvRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTexGenfvReply(buf, order)
+		},
+	}
+}
+
 // GetTexGenivCookie is a cookie used only for GetTexGeniv requests.
 type GetTexGenivCookie struct {
 	*xgb.Cookie
@@ -7774,35 +11454,47 @@ func (cook GetTexGenivCookie) Reply() (*GetTexGenivReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getTexGenivReply(buf), nil
+	return getTexGenivReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetTexGeniv request arrives.
+func (cook GetTexGenivCookie) ReplyContext(ctx context.Context) (*GetTexGenivReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getTexGenivReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getTexGenivReply reads a byte slice into a GetTexGenivReply value.
-func getTexGenivReply(buf []byte) *GetTexGenivReply {
+// getTexGenivReply reads a byte slice in the byte order 'order' into a GetTexGenivReply value.
+func getTexGenivReply(buf []byte, order xgb.ByteOrder) *GetTexGenivReply {
 	v := new(GetTexGenivReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = int32(xgb.Get32(buf[b:]))
+	v.Datum = int32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]int32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = int32(xgb.Get32(buf[b:]))
+		v.Data[i] = int32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -7812,9 +11504,18 @@ func getTexGenivReply(buf []byte) *GetTexGenivReply {
 // Write request to wire for GetTexGeniv
 // getTexGenivRequest writes a GetTexGeniv request to a byte slice.
 func getTexGenivRequest(c *xgb.Conn, ContextTag ContextTag, Coord uint32, Pname uint32) []byte {
+	return AppendGetTexGenivRequest(nil, c, ContextTag, Coord, Pname)
+}
+
+// AppendGetTexGenivRequest appends a GetTexGeniv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetTexGenivRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Coord uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -7824,21 +11525,57 @@ func getTexGenivRequest(c *xgb.Conn, ContextTag ContextTag, Coord uint32, Pname
 	buf[b] = 134 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Coord)
+	order.Put32(buf[b:], Coord)
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetTexGenivRequest represents the data of a GetTexGeniv request, as it is read when tracing a connection.
+type GetTexGenivRequest struct {
+	ContextTag ContextTag
+	Coord      uint32
+	Pname      uint32
+}
+
+// getTexGenivRequestRead reads a byte slice in the byte order 'order' into a GetTexGenivRequest value.
+func getTexGenivRequestRead(buf []byte, order xgb.ByteOrder) *GetTexGenivRequest {
+	v := new(GetTexGenivRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Coord = order.Get32(buf[b:])
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][134] = xgb.RequestInfo{
+		Name: "GetTexGeniv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTexGenivRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTexGenivReply(buf, order)
+		},
+	}
+}
+
 // GetTexImageCookie is a cookie used only for GetTexImage requests.
 type GetTexImageCookie struct {
 	*xgb.Cookie
@@ -7892,31 +11629,43 @@ func (cook GetTexImageCookie) Reply() (*GetTexImageReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getTexImageReply(buf), nil
+	return getTexImageReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetTexImage request arrives.
+func (cook GetTexImageCookie) ReplyContext(ctx context.Context) (*GetTexImageReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getTexImageReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getTexImageReply reads a byte slice into a GetTexImageReply value.
-func getTexImageReply(buf []byte) *GetTexImageReply {
+// getTexImageReply reads a byte slice in the byte order 'order' into a GetTexImageReply value.
+func getTexImageReply(buf []byte, order xgb.ByteOrder) *GetTexImageReply {
 	v := new(GetTexImageReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 8 // padding
 
-	v.Width = int32(xgb.Get32(buf[b:]))
+	v.Width = int32(order.Get32(buf[b:]))
 	b += 4
 
-	v.Height = int32(xgb.Get32(buf[b:]))
+	v.Height = int32(order.Get32(buf[b:]))
 	b += 4
 
-	v.Depth = int32(xgb.Get32(buf[b:]))
+	v.Depth = int32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 4 // padding
@@ -7931,9 +11680,18 @@ func getTexImageReply(buf []byte) *GetTexImageReply {
 // Write request to wire for GetTexImage
 // getTexImageRequest writes a GetTexImage request to a byte slice.
 func getTexImageRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Level int32, Format uint32, Type uint32, SwapBytes bool) []byte {
+	return AppendGetTexImageRequest(nil, c, ContextTag, Target, Level, Format, Type, SwapBytes)
+}
+
+// AppendGetTexImageRequest appends a GetTexImage request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetTexImageRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Level int32, Format uint32, Type uint32, SwapBytes bool) []byte {
 	size := 28
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -7943,22 +11701,22 @@ func getTexImageRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Level
 	buf[b] = 135 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Level))
+	order.Put32(buf[b:], uint32(Level))
 	b += 4
 
-	xgb.Put32(buf[b:], Format)
+	order.Put32(buf[b:], Format)
 	b += 4
 
-	xgb.Put32(buf[b:], Type)
+	order.Put32(buf[b:], Type)
 	b += 4
 
 	if SwapBytes {
@@ -7971,6 +11729,58 @@ func getTexImageRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Level
 	return buf
 }
 
+// GetTexImageRequest represents the data of a GetTexImage request, as it is read when tracing a connection.
+type GetTexImageRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Level      int32
+	Format     uint32
+	Type       uint32
+	SwapBytes  bool
+}
+
+// getTexImageRequestRead reads a byte slice in the byte order 'order' into a GetTexImageRequest value.
+func getTexImageRequestRead(buf []byte, order xgb.ByteOrder) *GetTexImageRequest {
+	v := new(GetTexImageRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Level = int32(order.Get32(buf[b:]))
+	b += 4
+
+	v.Format = order.Get32(buf[b:])
+	b += 4
+
+	v.Type = order.Get32(buf[b:])
+	b += 4
+
+	if buf[b] == 1 {
+		v.SwapBytes = true
+	} else {
+		v.SwapBytes = false
+	}
+	b += 1
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][135] = xgb.RequestInfo{
+		Name: "GetTexImage",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTexImageRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTexImageReply(buf, order)
+		},
+	}
+}
+
 // GetTexLevelParameterfvCookie is a cookie used only for GetTexLevelParameterfv requests.
 type GetTexLevelParameterfvCookie struct {
 	*xgb.Cookie
@@ -8023,35 +11833,47 @@ func (cook GetTexLevelParameterfvCookie) Reply() (*GetTexLevelParameterfvReply,
 	if buf == nil {
 		return nil, nil
 	}
-	return getTexLevelParameterfvReply(buf), nil
+	return getTexLevelParameterfvReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetTexLevelParameterfv request arrives.
+func (cook GetTexLevelParameterfvCookie) ReplyContext(ctx context.Context) (*GetTexLevelParameterfvReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getTexLevelParameterfvReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getTexLevelParameterfvReply reads a byte slice into a GetTexLevelParameterfvReply value.
-func getTexLevelParameterfvReply(buf []byte) *GetTexLevelParameterfvReply {
+// getTexLevelParameterfvReply reads a byte slice in the byte order 'order' into a GetTexLevelParameterfvReply value.
+func getTexLevelParameterfvReply(buf []byte, order xgb.ByteOrder) *GetTexLevelParameterfvReply {
 	v := new(GetTexLevelParameterfvReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = Float32(xgb.Get32(buf[b:]))
+	v.Datum = Float32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]Float32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = Float32(xgb.Get32(buf[b:]))
+		v.Data[i] = Float32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -8061,9 +11883,18 @@ func getTexLevelParameterfvReply(buf []byte) *GetTexLevelParameterfvReply {
 // Write request to wire for GetTexLevelParameterfv
 // getTexLevelParameterfvRequest writes a GetTexLevelParameterfv request to a byte slice.
 func getTexLevelParameterfvRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Level int32, Pname uint32) []byte {
+	return AppendGetTexLevelParameterfvRequest(nil, c, ContextTag, Target, Level, Pname)
+}
+
+// AppendGetTexLevelParameterfvRequest appends a GetTexLevelParameterfv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetTexLevelParameterfvRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Level int32, Pname uint32) []byte {
 	size := 20
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -8073,24 +11904,64 @@ func getTexLevelParameterfvRequest(c *xgb.Conn, ContextTag ContextTag, Target ui
 	buf[b] = 138 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Level))
+	order.Put32(buf[b:], uint32(Level))
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetTexLevelParameterfvRequest represents the data of a GetTexLevelParameterfv request, as it is read when tracing a connection.
+type GetTexLevelParameterfvRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Level      int32
+	Pname      uint32
+}
+
+// getTexLevelParameterfvRequestRead reads a byte slice in the byte order 'order' into a GetTexLevelParameterfvRequest value.
+func getTexLevelParameterfvRequestRead(buf []byte, order xgb.ByteOrder) *GetTexLevelParameterfvRequest {
+	v := new(GetTexLevelParameterfvRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Level = int32(order.Get32(buf[b:]))
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][138] = xgb.RequestInfo{
+		Name: "GetTexLevelParameterfv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTexLevelParameterfvRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTexLevelParameterfvReply(buf, order)
+		},
+	}
+}
+
 // GetTexLevelParameterivCookie is a cookie used only for GetTexLevelParameteriv requests.
 type GetTexLevelParameterivCookie struct {
 	*xgb.Cookie
@@ -8143,35 +12014,47 @@ func (cook GetTexLevelParameterivCookie) Reply() (*GetTexLevelParameterivReply,
 	if buf == nil {
 		return nil, nil
 	}
-	return getTexLevelParameterivReply(buf), nil
+	return getTexLevelParameterivReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetTexLevelParameteriv request arrives.
+func (cook GetTexLevelParameterivCookie) ReplyContext(ctx context.Context) (*GetTexLevelParameterivReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getTexLevelParameterivReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getTexLevelParameterivReply reads a byte slice into a GetTexLevelParameterivReply value.
-func getTexLevelParameterivReply(buf []byte) *GetTexLevelParameterivReply {
+// getTexLevelParameterivReply reads a byte slice in the byte order 'order' into a GetTexLevelParameterivReply value.
+func getTexLevelParameterivReply(buf []byte, order xgb.ByteOrder) *GetTexLevelParameterivReply {
 	v := new(GetTexLevelParameterivReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = int32(xgb.Get32(buf[b:]))
+	v.Datum = int32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]int32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = int32(xgb.Get32(buf[b:]))
+		v.Data[i] = int32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -8181,9 +12064,18 @@ func getTexLevelParameterivReply(buf []byte) *GetTexLevelParameterivReply {
 // Write request to wire for GetTexLevelParameteriv
 // getTexLevelParameterivRequest writes a GetTexLevelParameteriv request to a byte slice.
 func getTexLevelParameterivRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Level int32, Pname uint32) []byte {
+	return AppendGetTexLevelParameterivRequest(nil, c, ContextTag, Target, Level, Pname)
+}
+
+// AppendGetTexLevelParameterivRequest appends a GetTexLevelParameteriv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetTexLevelParameterivRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Level int32, Pname uint32) []byte {
 	size := 20
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -8193,24 +12085,64 @@ func getTexLevelParameterivRequest(c *xgb.Conn, ContextTag ContextTag, Target ui
 	buf[b] = 139 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Level))
+	order.Put32(buf[b:], uint32(Level))
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetTexLevelParameterivRequest represents the data of a GetTexLevelParameteriv request, as it is read when tracing a connection.
+type GetTexLevelParameterivRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Level      int32
+	Pname      uint32
+}
+
+// getTexLevelParameterivRequestRead reads a byte slice in the byte order 'order' into a GetTexLevelParameterivRequest value.
+func getTexLevelParameterivRequestRead(buf []byte, order xgb.ByteOrder) *GetTexLevelParameterivRequest {
+	v := new(GetTexLevelParameterivRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Level = int32(order.Get32(buf[b:]))
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][139] = xgb.RequestInfo{
+		Name: "GetTexLevelParameteriv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTexLevelParameterivRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTexLevelParameterivReply(buf, order)
+		},
+	}
+}
+
 // GetTexParameterfvCookie is a cookie used only for GetTexParameterfv requests.
 type GetTexParameterfvCookie struct {
 	*xgb.Cookie
@@ -8263,35 +12195,47 @@ func (cook GetTexParameterfvCookie) Reply() (*GetTexParameterfvReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getTexParameterfvReply(buf), nil
+	return getTexParameterfvReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetTexParameterfv request arrives.
+func (cook GetTexParameterfvCookie) ReplyContext(ctx context.Context) (*GetTexParameterfvReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getTexParameterfvReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getTexParameterfvReply reads a byte slice into a GetTexParameterfvReply value.
-func getTexParameterfvReply(buf []byte) *GetTexParameterfvReply {
+// getTexParameterfvReply reads a byte slice in the byte order 'order' into a GetTexParameterfvReply value.
+func getTexParameterfvReply(buf []byte, order xgb.ByteOrder) *GetTexParameterfvReply {
 	v := new(GetTexParameterfvReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = Float32(xgb.Get32(buf[b:]))
+	v.Datum = Float32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]Float32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = Float32(xgb.Get32(buf[b:]))
+		v.Data[i] = Float32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -8301,9 +12245,18 @@ func getTexParameterfvReply(buf []byte) *GetTexParameterfvReply {
 // Write request to wire for GetTexParameterfv
 // getTexParameterfvRequest writes a GetTexParameterfv request to a byte slice.
 func getTexParameterfvRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
+	return AppendGetTexParameterfvRequest(nil, c, ContextTag, Target, Pname)
+}
+
+// AppendGetTexParameterfvRequest appends a GetTexParameterfv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetTexParameterfvRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -8313,21 +12266,57 @@ func getTexParameterfvRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32,
 	buf[b] = 136 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetTexParameterfvRequest represents the data of a GetTexParameterfv request, as it is read when tracing a connection.
+type GetTexParameterfvRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Pname      uint32
+}
+
+// getTexParameterfvRequestRead reads a byte slice in the byte order 'order' into a GetTexParameterfvRequest value.
+func getTexParameterfvRequestRead(buf []byte, order xgb.ByteOrder) *GetTexParameterfvRequest {
+	v := new(GetTexParameterfvRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][136] = xgb.RequestInfo{
+		Name: "GetTexParameterfv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTexParameterfvRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTexParameterfvReply(buf, order)
+		},
+	}
+}
+
 // GetTexParameterivCookie is a cookie used only for GetTexParameteriv requests.
 type GetTexParameterivCookie struct {
 	*xgb.Cookie
@@ -8380,35 +12369,47 @@ func (cook GetTexParameterivCookie) Reply() (*GetTexParameterivReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getTexParameterivReply(buf), nil
+	return getTexParameterivReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetTexParameteriv request arrives.
+func (cook GetTexParameterivCookie) ReplyContext(ctx context.Context) (*GetTexParameterivReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getTexParameterivReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getTexParameterivReply reads a byte slice into a GetTexParameterivReply value.
-func getTexParameterivReply(buf []byte) *GetTexParameterivReply {
+// getTexParameterivReply reads a byte slice in the byte order 'order' into a GetTexParameterivReply value.
+func getTexParameterivReply(buf []byte, order xgb.ByteOrder) *GetTexParameterivReply {
 	v := new(GetTexParameterivReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.Datum = int32(xgb.Get32(buf[b:]))
+	v.Datum = int32(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]int32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = int32(xgb.Get32(buf[b:]))
+		v.Data[i] = int32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -8418,9 +12419,18 @@ func getTexParameterivReply(buf []byte) *GetTexParameterivReply {
 // Write request to wire for GetTexParameteriv
 // getTexParameterivRequest writes a GetTexParameteriv request to a byte slice.
 func getTexParameterivRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
+	return AppendGetTexParameterivRequest(nil, c, ContextTag, Target, Pname)
+}
+
+// AppendGetTexParameterivRequest appends a GetTexParameteriv request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetTexParameterivRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Target uint32, Pname uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -8430,21 +12440,57 @@ func getTexParameterivRequest(c *xgb.Conn, ContextTag ContextTag, Target uint32,
 	buf[b] = 137 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Target)
+	order.Put32(buf[b:], Target)
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
 	return buf
 }
 
+// GetTexParameterivRequest represents the data of a GetTexParameteriv request, as it is read when tracing a connection.
+type GetTexParameterivRequest struct {
+	ContextTag ContextTag
+	Target     uint32
+	Pname      uint32
+}
+
+// getTexParameterivRequestRead reads a byte slice in the byte order 'order' into a GetTexParameterivRequest value.
+func getTexParameterivRequestRead(buf []byte, order xgb.ByteOrder) *GetTexParameterivRequest {
+	v := new(GetTexParameterivRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][137] = xgb.RequestInfo{
+		Name: "GetTexParameteriv",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTexParameterivRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getTexParameterivReply(buf, order)
+		},
+	}
+}
+
 // GetVisualConfigsCookie is a cookie used only for GetVisualConfigs requests.
 type GetVisualConfigsCookie struct {
 	*xgb.Cookie
@@ -8496,33 +12542,45 @@ func (cook GetVisualConfigsCookie) Reply() (*GetVisualConfigsReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getVisualConfigsReply(buf), nil
+	return getVisualConfigsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetVisualConfigs request arrives.
+func (cook GetVisualConfigsCookie) ReplyContext(ctx context.Context) (*GetVisualConfigsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getVisualConfigsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getVisualConfigsReply reads a byte slice into a GetVisualConfigsReply value.
-func getVisualConfigsReply(buf []byte) *GetVisualConfigsReply {
+// getVisualConfigsReply reads a byte slice in the byte order 'order' into a GetVisualConfigsReply value.
+func getVisualConfigsReply(buf []byte, order xgb.ByteOrder) *GetVisualConfigsReply {
 	v := new(GetVisualConfigsReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.NumVisuals = xgb.Get32(buf[b:])
+	v.NumVisuals = order.Get32(buf[b:])
 	b += 4
 
-	v.NumProperties = xgb.Get32(buf[b:])
+	v.NumProperties = order.Get32(buf[b:])
 	b += 4
 
 	b += 16 // padding
 
 	v.PropertyList = make([]uint32, v.Length)
 	for i := 0; i < int(v.Length); i++ {
-		v.PropertyList[i] = xgb.Get32(buf[b:])
+		v.PropertyList[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -8532,9 +12590,18 @@ func getVisualConfigsReply(buf []byte) *GetVisualConfigsReply {
 // Write request to wire for GetVisualConfigs
 // getVisualConfigsRequest writes a GetVisualConfigs request to a byte slice.
 func getVisualConfigsRequest(c *xgb.Conn, Screen uint32) []byte {
+	return AppendGetVisualConfigsRequest(nil, c, Screen)
+}
+
+// AppendGetVisualConfigsRequest appends a GetVisualConfigs request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetVisualConfigsRequest(buf []byte, c *xgb.Conn, Screen uint32) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -8544,15 +12611,43 @@ func getVisualConfigsRequest(c *xgb.Conn, Screen uint32) []byte {
 	buf[b] = 14 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
 	return buf
 }
 
+// GetVisualConfigsRequest represents the data of a GetVisualConfigs request, as it is read when tracing a connection.
+type GetVisualConfigsRequest struct {
+	Screen uint32
+}
+
+// getVisualConfigsRequestRead reads a byte slice in the byte order 'order' into a GetVisualConfigsRequest value.
+func getVisualConfigsRequestRead(buf []byte, order xgb.ByteOrder) *GetVisualConfigsRequest {
+	v := new(GetVisualConfigsRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][14] = xgb.RequestInfo{
+		Name: "GetVisualConfigs",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getVisualConfigsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getVisualConfigsReply(buf, order)
+		},
+	}
+}
+
 // IsDirectCookie is a cookie used only for IsDirect requests.
 type IsDirectCookie struct {
 	*xgb.Cookie
@@ -8602,20 +12697,32 @@ func (cook IsDirectCookie) Reply() (*IsDirectReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return isDirectReply(buf), nil
+	return isDirectReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a IsDirect request arrives.
+func (cook IsDirectCookie) ReplyContext(ctx context.Context) (*IsDirectReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return isDirectReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// isDirectReply reads a byte slice into a IsDirectReply value.
-func isDirectReply(buf []byte) *IsDirectReply {
+// isDirectReply reads a byte slice in the byte order 'order' into a IsDirectReply value.
+func isDirectReply(buf []byte, order xgb.ByteOrder) *IsDirectReply {
 	v := new(IsDirectReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	if buf[b] == 1 {
@@ -8633,9 +12740,18 @@ func isDirectReply(buf []byte) *IsDirectReply {
 // Write request to wire for IsDirect
 // isDirectRequest writes a IsDirect request to a byte slice.
 func isDirectRequest(c *xgb.Conn, Context Context) []byte {
+	return AppendIsDirectRequest(nil, c, Context)
+}
+
+// AppendIsDirectRequest appends a IsDirect request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendIsDirectRequest(buf []byte, c *xgb.Conn, Context Context) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -8645,15 +12761,43 @@ func isDirectRequest(c *xgb.Conn, Context Context) []byte {
 	buf[b] = 6 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
 	return buf
 }
 
+// IsDirectRequest represents the data of a IsDirect request, as it is read when tracing a connection.
+type IsDirectRequest struct {
+	Context Context
+}
+
+// isDirectRequestRead reads a byte slice in the byte order 'order' into a IsDirectRequest value.
+func isDirectRequestRead(buf []byte, order xgb.ByteOrder) *IsDirectRequest {
+	v := new(IsDirectRequest)
+	b := 4 // skip request header
+
+	v.Context = Context(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][6] = xgb.RequestInfo{
+		Name: "IsDirect",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return isDirectRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return isDirectReply(buf, order)
+		},
+	}
+}
+
 // IsListCookie is a cookie used only for IsList requests.
 type IsListCookie struct {
 	*xgb.Cookie
@@ -8702,23 +12846,35 @@ func (cook IsListCookie) Reply() (*IsListReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return isListReply(buf), nil
+	return isListReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a IsList request arrives.
+func (cook IsListCookie) ReplyContext(ctx context.Context) (*IsListReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return isListReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// isListReply reads a byte slice into a IsListReply value.
-func isListReply(buf []byte) *IsListReply {
+// isListReply reads a byte slice in the byte order 'order' into a IsListReply value.
+func isListReply(buf []byte, order xgb.ByteOrder) *IsListReply {
 	v := new(IsListReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.RetVal = Bool32(xgb.Get32(buf[b:]))
+	v.RetVal = Bool32(order.Get32(buf[b:]))
 	b += 4
 
 	return v
@@ -8727,9 +12883,18 @@ func isListReply(buf []byte) *IsListReply {
 // Write request to wire for IsList
 // isListRequest writes a IsList request to a byte slice.
 func isListRequest(c *xgb.Conn, ContextTag ContextTag, List uint32) []byte {
+	return AppendIsListRequest(nil, c, ContextTag, List)
+}
+
+// AppendIsListRequest appends a IsList request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendIsListRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, List uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -8739,18 +12904,50 @@ func isListRequest(c *xgb.Conn, ContextTag ContextTag, List uint32) []byte {
 	buf[b] = 141 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], List)
+	order.Put32(buf[b:], List)
 	b += 4
 
 	return buf
 }
 
+// IsListRequest represents the data of a IsList request, as it is read when tracing a connection.
+type IsListRequest struct {
+	ContextTag ContextTag
+	List       uint32
+}
+
+// isListRequestRead reads a byte slice in the byte order 'order' into a IsListRequest value.
+func isListRequestRead(buf []byte, order xgb.ByteOrder) *IsListRequest {
+	v := new(IsListRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.List = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][141] = xgb.RequestInfo{
+		Name: "IsList",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return isListRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return isListReply(buf, order)
+		},
+	}
+}
+
 // IsQueryARBCookie is a cookie used only for IsQueryARB requests.
 type IsQueryARBCookie struct {
 	*xgb.Cookie
@@ -8799,23 +12996,35 @@ func (cook IsQueryARBCookie) Reply() (*IsQueryARBReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return isQueryARBReply(buf), nil
+	return isQueryARBReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a IsQueryARB request arrives.
+func (cook IsQueryARBCookie) ReplyContext(ctx context.Context) (*IsQueryARBReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return isQueryARBReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// isQueryARBReply reads a byte slice into a IsQueryARBReply value.
-func isQueryARBReply(buf []byte) *IsQueryARBReply {
+// isQueryARBReply reads a byte slice in the byte order 'order' into a IsQueryARBReply value.
+func isQueryARBReply(buf []byte, order xgb.ByteOrder) *IsQueryARBReply {
 	v := new(IsQueryARBReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.RetVal = Bool32(xgb.Get32(buf[b:]))
+	v.RetVal = Bool32(order.Get32(buf[b:]))
 	b += 4
 
 	return v
@@ -8824,9 +13033,18 @@ func isQueryARBReply(buf []byte) *IsQueryARBReply {
 // Write request to wire for IsQueryARB
 // isQueryARBRequest writes a IsQueryARB request to a byte slice.
 func isQueryARBRequest(c *xgb.Conn, ContextTag ContextTag, Id uint32) []byte {
+	return AppendIsQueryARBRequest(nil, c, ContextTag, Id)
+}
+
+// AppendIsQueryARBRequest appends a IsQueryARB request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendIsQueryARBRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Id uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -8836,18 +13054,50 @@ func isQueryARBRequest(c *xgb.Conn, ContextTag ContextTag, Id uint32) []byte {
 	buf[b] = 163 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Id)
+	order.Put32(buf[b:], Id)
 	b += 4
 
 	return buf
 }
 
+// IsQueryARBRequest represents the data of a IsQueryARB request, as it is read when tracing a connection.
+type IsQueryARBRequest struct {
+	ContextTag ContextTag
+	Id         uint32
+}
+
+// isQueryARBRequestRead reads a byte slice in the byte order 'order' into a IsQueryARBRequest value.
+func isQueryARBRequestRead(buf []byte, order xgb.ByteOrder) *IsQueryARBRequest {
+	v := new(IsQueryARBRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Id = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][163] = xgb.RequestInfo{
+		Name: "IsQueryARB",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return isQueryARBRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return isQueryARBReply(buf, order)
+		},
+	}
+}
+
 // IsTextureCookie is a cookie used only for IsTexture requests.
 type IsTextureCookie struct {
 	*xgb.Cookie
@@ -8896,23 +13146,35 @@ func (cook IsTextureCookie) Reply() (*IsTextureReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return isTextureReply(buf), nil
+	return isTextureReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a IsTexture request arrives.
+func (cook IsTextureCookie) ReplyContext(ctx context.Context) (*IsTextureReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return isTextureReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// isTextureReply reads a byte slice into a IsTextureReply value.
-func isTextureReply(buf []byte) *IsTextureReply {
+// isTextureReply reads a byte slice in the byte order 'order' into a IsTextureReply value.
+func isTextureReply(buf []byte, order xgb.ByteOrder) *IsTextureReply {
 	v := new(IsTextureReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.RetVal = Bool32(xgb.Get32(buf[b:]))
+	v.RetVal = Bool32(order.Get32(buf[b:]))
 	b += 4
 
 	return v
@@ -8921,9 +13183,18 @@ func isTextureReply(buf []byte) *IsTextureReply {
 // Write request to wire for IsTexture
 // isTextureRequest writes a IsTexture request to a byte slice.
 func isTextureRequest(c *xgb.Conn, ContextTag ContextTag, Texture uint32) []byte {
+	return AppendIsTextureRequest(nil, c, ContextTag, Texture)
+}
+
+// AppendIsTextureRequest appends a IsTexture request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendIsTextureRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Texture uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -8933,18 +13204,50 @@ func isTextureRequest(c *xgb.Conn, ContextTag ContextTag, Texture uint32) []byte
 	buf[b] = 146 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Texture)
+	order.Put32(buf[b:], Texture)
 	b += 4
 
 	return buf
 }
 
+// IsTextureRequest represents the data of a IsTexture request, as it is read when tracing a connection.
+type IsTextureRequest struct {
+	ContextTag ContextTag
+	Texture    uint32
+}
+
+// isTextureRequestRead reads a byte slice in the byte order 'order' into a IsTextureRequest value.
+func isTextureRequestRead(buf []byte, order xgb.ByteOrder) *IsTextureRequest {
+	v := new(IsTextureRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Texture = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][146] = xgb.RequestInfo{
+		Name: "IsTexture",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return isTextureRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return isTextureReply(buf, order)
+		},
+	}
+}
+
 // MakeContextCurrentCookie is a cookie used only for MakeContextCurrent requests.
 type MakeContextCurrentCookie struct {
 	*xgb.Cookie
@@ -8994,23 +13297,35 @@ func (cook MakeContextCurrentCookie) Reply() (*MakeContextCurrentReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return makeContextCurrentReply(buf), nil
+	return makeContextCurrentReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a MakeContextCurrent request arrives.
+func (cook MakeContextCurrentCookie) ReplyContext(ctx context.Context) (*MakeContextCurrentReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return makeContextCurrentReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// makeContextCurrentReply reads a byte slice into a MakeContextCurrentReply value.
-func makeContextCurrentReply(buf []byte) *MakeContextCurrentReply {
+// makeContextCurrentReply reads a byte slice in the byte order 'order' into a MakeContextCurrentReply value.
+func makeContextCurrentReply(buf []byte, order xgb.ByteOrder) *MakeContextCurrentReply {
 	v := new(MakeContextCurrentReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ContextTag = ContextTag(xgb.Get32(buf[b:]))
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
 	b += 4
 
 	b += 20 // padding
@@ -9021,9 +13336,18 @@ func makeContextCurrentReply(buf []byte) *MakeContextCurrentReply {
 // Write request to wire for MakeContextCurrent
 // makeContextCurrentRequest writes a MakeContextCurrent request to a byte slice.
 func makeContextCurrentRequest(c *xgb.Conn, OldContextTag ContextTag, Drawable Drawable, ReadDrawable Drawable, Context Context) []byte {
+	return AppendMakeContextCurrentRequest(nil, c, OldContextTag, Drawable, ReadDrawable, Context)
+}
+
+// AppendMakeContextCurrentRequest appends a MakeContextCurrent request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendMakeContextCurrentRequest(buf []byte, c *xgb.Conn, OldContextTag ContextTag, Drawable Drawable, ReadDrawable Drawable, Context Context) []byte {
 	size := 20
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -9033,24 +13357,64 @@ func makeContextCurrentRequest(c *xgb.Conn, OldContextTag ContextTag, Drawable D
 	buf[b] = 26 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(OldContextTag))
+	order.Put32(buf[b:], uint32(OldContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(ReadDrawable))
+	order.Put32(buf[b:], uint32(ReadDrawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
 	return buf
 }
 
+// MakeContextCurrentRequest represents the data of a MakeContextCurrent request, as it is read when tracing a connection.
+type MakeContextCurrentRequest struct {
+	OldContextTag ContextTag
+	Drawable      Drawable
+	ReadDrawable  Drawable
+	Context       Context
+}
+
+// makeContextCurrentRequestRead reads a byte slice in the byte order 'order' into a MakeContextCurrentRequest value.
+func makeContextCurrentRequestRead(buf []byte, order xgb.ByteOrder) *MakeContextCurrentRequest {
+	v := new(MakeContextCurrentRequest)
+	b := 4 // skip request header
+
+	v.OldContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.ReadDrawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Context = Context(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][26] = xgb.RequestInfo{
+		Name: "MakeContextCurrent",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return makeContextCurrentRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return makeContextCurrentReply(buf, order)
+		},
+	}
+}
+
 // MakeCurrentCookie is a cookie used only for MakeCurrent requests.
 type MakeCurrentCookie struct {
 	*xgb.Cookie
@@ -9100,23 +13464,35 @@ func (cook MakeCurrentCookie) Reply() (*MakeCurrentReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return makeCurrentReply(buf), nil
+	return makeCurrentReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a MakeCurrent request arrives.
+func (cook MakeCurrentCookie) ReplyContext(ctx context.Context) (*MakeCurrentReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return makeCurrentReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// makeCurrentReply reads a byte slice into a MakeCurrentReply value.
-func makeCurrentReply(buf []byte) *MakeCurrentReply {
+// makeCurrentReply reads a byte slice in the byte order 'order' into a MakeCurrentReply value.
+func makeCurrentReply(buf []byte, order xgb.ByteOrder) *MakeCurrentReply {
 	v := new(MakeCurrentReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ContextTag = ContextTag(xgb.Get32(buf[b:]))
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
 	b += 4
 
 	b += 20 // padding
@@ -9127,9 +13503,18 @@ func makeCurrentReply(buf []byte) *MakeCurrentReply {
 // Write request to wire for MakeCurrent
 // makeCurrentRequest writes a MakeCurrent request to a byte slice.
 func makeCurrentRequest(c *xgb.Conn, Drawable Drawable, Context Context, OldContextTag ContextTag) []byte {
+	return AppendMakeCurrentRequest(nil, c, Drawable, Context, OldContextTag)
+}
+
+// AppendMakeCurrentRequest appends a MakeCurrent request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendMakeCurrentRequest(buf []byte, c *xgb.Conn, Drawable Drawable, Context Context, OldContextTag ContextTag) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -9139,19 +13524,55 @@ func makeCurrentRequest(c *xgb.Conn, Drawable Drawable, Context Context, OldCont
 	buf[b] = 5 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
+	b += 4
+
+	order.Put32(buf[b:], uint32(Context))
+	b += 4
+
+	order.Put32(buf[b:], uint32(OldContextTag))
+	b += 4
+
+	return buf
+}
+
+// MakeCurrentRequest represents the data of a MakeCurrent request, as it is read when tracing a connection.
+type MakeCurrentRequest struct {
+	Drawable      Drawable
+	Context       Context
+	OldContextTag ContextTag
+}
+
+// makeCurrentRequestRead reads a byte slice in the byte order 'order' into a MakeCurrentRequest value.
+func makeCurrentRequestRead(buf []byte, order xgb.ByteOrder) *MakeCurrentRequest {
+	v := new(MakeCurrentRequest)
+	b := 4 // skip request header
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Context))
+	v.Context = Context(order.Get32(buf[b:]))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(OldContextTag))
+	v.OldContextTag = ContextTag(order.Get32(buf[b:]))
 	b += 4
 
-	return buf
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][5] = xgb.RequestInfo{
+		Name: "MakeCurrent",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return makeCurrentRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return makeCurrentReply(buf, order)
+		},
+	}
 }
 
 // NewListCookie is a cookie used only for NewList requests.
@@ -9191,12 +13612,26 @@ func (cook NewListCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook NewListCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for NewList
 // newListRequest writes a NewList request to a byte slice.
 func newListRequest(c *xgb.Conn, ContextTag ContextTag, List uint32, Mode uint32) []byte {
+	return AppendNewListRequest(nil, c, ContextTag, List, Mode)
+}
+
+// AppendNewListRequest appends a NewList request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendNewListRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, List uint32, Mode uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -9206,21 +13641,54 @@ func newListRequest(c *xgb.Conn, ContextTag ContextTag, List uint32, Mode uint32
 	buf[b] = 101 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], List)
+	order.Put32(buf[b:], List)
 	b += 4
 
-	xgb.Put32(buf[b:], Mode)
+	order.Put32(buf[b:], Mode)
 	b += 4
 
 	return buf
 }
 
+// NewListRequest represents the data of a NewList request, as it is read when tracing a connection.
+type NewListRequest struct {
+	ContextTag ContextTag
+	List       uint32
+	Mode       uint32
+}
+
+// newListRequestRead reads a byte slice in the byte order 'order' into a NewListRequest value.
+func newListRequestRead(buf []byte, order xgb.ByteOrder) *NewListRequest {
+	v := new(NewListRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.List = order.Get32(buf[b:])
+	b += 4
+
+	v.Mode = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][101] = xgb.RequestInfo{
+		Name: "NewList",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return newListRequestRead(buf, order)
+		},
+	}
+}
+
 // PixelStorefCookie is a cookie used only for PixelStoref requests.
 type PixelStorefCookie struct {
 	*xgb.Cookie
@@ -9258,12 +13726,26 @@ func (cook PixelStorefCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PixelStorefCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PixelStoref
 // pixelStorefRequest writes a PixelStoref request to a byte slice.
 func pixelStorefRequest(c *xgb.Conn, ContextTag ContextTag, Pname uint32, Datum Float32) []byte {
+	return AppendPixelStorefRequest(nil, c, ContextTag, Pname, Datum)
+}
+
+// AppendPixelStorefRequest appends a PixelStoref request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPixelStorefRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Pname uint32, Datum Float32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -9273,21 +13755,54 @@ func pixelStorefRequest(c *xgb.Conn, ContextTag ContextTag, Pname uint32, Datum
 	buf[b] = 109 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Datum))
+	order.Put32(buf[b:], uint32(Datum))
 	b += 4
 
 	return buf
 }
 
+// PixelStorefRequest represents the data of a PixelStoref request, as it is read when tracing a connection.
+type PixelStorefRequest struct {
+	ContextTag ContextTag
+	Pname      uint32
+	Datum      Float32
+}
+
+// pixelStorefRequestRead reads a byte slice in the byte order 'order' into a PixelStorefRequest value.
+func pixelStorefRequestRead(buf []byte, order xgb.ByteOrder) *PixelStorefRequest {
+	v := new(PixelStorefRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	v.Datum = Float32(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][109] = xgb.RequestInfo{
+		Name: "PixelStoref",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return pixelStorefRequestRead(buf, order)
+		},
+	}
+}
+
 // PixelStoreiCookie is a cookie used only for PixelStorei requests.
 type PixelStoreiCookie struct {
 	*xgb.Cookie
@@ -9325,12 +13840,26 @@ func (cook PixelStoreiCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PixelStoreiCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PixelStorei
 // pixelStoreiRequest writes a PixelStorei request to a byte slice.
 func pixelStoreiRequest(c *xgb.Conn, ContextTag ContextTag, Pname uint32, Datum int32) []byte {
+	return AppendPixelStoreiRequest(nil, c, ContextTag, Pname, Datum)
+}
+
+// AppendPixelStoreiRequest appends a PixelStorei request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPixelStoreiRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Pname uint32, Datum int32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -9340,21 +13869,54 @@ func pixelStoreiRequest(c *xgb.Conn, ContextTag ContextTag, Pname uint32, Datum
 	buf[b] = 110 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Pname)
+	order.Put32(buf[b:], Pname)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Datum))
+	order.Put32(buf[b:], uint32(Datum))
 	b += 4
 
 	return buf
 }
 
+// PixelStoreiRequest represents the data of a PixelStorei request, as it is read when tracing a connection.
+type PixelStoreiRequest struct {
+	ContextTag ContextTag
+	Pname      uint32
+	Datum      int32
+}
+
+// pixelStoreiRequestRead reads a byte slice in the byte order 'order' into a PixelStoreiRequest value.
+func pixelStoreiRequestRead(buf []byte, order xgb.ByteOrder) *PixelStoreiRequest {
+	v := new(PixelStoreiRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Pname = order.Get32(buf[b:])
+	b += 4
+
+	v.Datum = int32(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][110] = xgb.RequestInfo{
+		Name: "PixelStorei",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return pixelStoreiRequestRead(buf, order)
+		},
+	}
+}
+
 // QueryContextCookie is a cookie used only for QueryContext requests.
 type QueryContextCookie struct {
 	*xgb.Cookie
@@ -9405,30 +13967,42 @@ func (cook QueryContextCookie) Reply() (*QueryContextReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryContextReply(buf), nil
+	return queryContextReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryContext request arrives.
+func (cook QueryContextCookie) ReplyContext(ctx context.Context) (*QueryContextReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryContextReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryContextReply reads a byte slice into a QueryContextReply value.
-func queryContextReply(buf []byte) *QueryContextReply {
+// queryContextReply reads a byte slice in the byte order 'order' into a QueryContextReply value.
+func queryContextReply(buf []byte, order xgb.ByteOrder) *QueryContextReply {
 	v := new(QueryContextReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.NumAttribs = xgb.Get32(buf[b:])
+	v.NumAttribs = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
 
 	v.Attribs = make([]uint32, (int(v.NumAttribs) * 2))
 	for i := 0; i < int((int(v.NumAttribs) * 2)); i++ {
-		v.Attribs[i] = xgb.Get32(buf[b:])
+		v.Attribs[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -9438,9 +14012,18 @@ func queryContextReply(buf []byte) *QueryContextReply {
 // Write request to wire for QueryContext
 // queryContextRequest writes a QueryContext request to a byte slice.
 func queryContextRequest(c *xgb.Conn, Context Context) []byte {
+	return AppendQueryContextRequest(nil, c, Context)
+}
+
+// AppendQueryContextRequest appends a QueryContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryContextRequest(buf []byte, c *xgb.Conn, Context Context) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -9450,15 +14033,43 @@ func queryContextRequest(c *xgb.Conn, Context Context) []byte {
 	buf[b] = 25 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
 	return buf
 }
 
+// QueryContextRequest represents the data of a QueryContext request, as it is read when tracing a connection.
+type QueryContextRequest struct {
+	Context Context
+}
+
+// queryContextRequestRead reads a byte slice in the byte order 'order' into a QueryContextRequest value.
+func queryContextRequestRead(buf []byte, order xgb.ByteOrder) *QueryContextRequest {
+	v := new(QueryContextRequest)
+	b := 4 // skip request header
+
+	v.Context = Context(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][25] = xgb.RequestInfo{
+		Name: "QueryContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryContextRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryContextReply(buf, order)
+		},
+	}
+}
+
 // QueryExtensionsStringCookie is a cookie used only for QueryExtensionsString requests.
 type QueryExtensionsStringCookie struct {
 	*xgb.Cookie
@@ -9509,25 +14120,37 @@ func (cook QueryExtensionsStringCookie) Reply() (*QueryExtensionsStringReply, er
 	if buf == nil {
 		return nil, nil
 	}
-	return queryExtensionsStringReply(buf), nil
+	return queryExtensionsStringReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryExtensionsString request arrives.
+func (cook QueryExtensionsStringCookie) ReplyContext(ctx context.Context) (*QueryExtensionsStringReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryExtensionsStringReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryExtensionsStringReply reads a byte slice into a QueryExtensionsStringReply value.
-func queryExtensionsStringReply(buf []byte) *QueryExtensionsStringReply {
+// queryExtensionsStringReply reads a byte slice in the byte order 'order' into a QueryExtensionsStringReply value.
+func queryExtensionsStringReply(buf []byte, order xgb.ByteOrder) *QueryExtensionsStringReply {
 	v := new(QueryExtensionsStringReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
 	b += 16 // padding
@@ -9538,9 +14161,18 @@ func queryExtensionsStringReply(buf []byte) *QueryExtensionsStringReply {
 // Write request to wire for QueryExtensionsString
 // queryExtensionsStringRequest writes a QueryExtensionsString request to a byte slice.
 func queryExtensionsStringRequest(c *xgb.Conn, Screen uint32) []byte {
+	return AppendQueryExtensionsStringRequest(nil, c, Screen)
+}
+
+// AppendQueryExtensionsStringRequest appends a QueryExtensionsString request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryExtensionsStringRequest(buf []byte, c *xgb.Conn, Screen uint32) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -9550,15 +14182,43 @@ func queryExtensionsStringRequest(c *xgb.Conn, Screen uint32) []byte {
 	buf[b] = 18 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
 	return buf
 }
 
+// QueryExtensionsStringRequest represents the data of a QueryExtensionsString request, as it is read when tracing a connection.
+type QueryExtensionsStringRequest struct {
+	Screen uint32
+}
+
+// queryExtensionsStringRequestRead reads a byte slice in the byte order 'order' into a QueryExtensionsStringRequest value.
+func queryExtensionsStringRequestRead(buf []byte, order xgb.ByteOrder) *QueryExtensionsStringRequest {
+	v := new(QueryExtensionsStringRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][18] = xgb.RequestInfo{
+		Name: "QueryExtensionsString",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryExtensionsStringRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryExtensionsStringReply(buf, order)
+		},
+	}
+}
+
 // QueryServerStringCookie is a cookie used only for QueryServerString requests.
 type QueryServerStringCookie struct {
 	*xgb.Cookie
@@ -9610,25 +14270,37 @@ func (cook QueryServerStringCookie) Reply() (*QueryServerStringReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryServerStringReply(buf), nil
+	return queryServerStringReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryServerString request arrives.
+func (cook QueryServerStringCookie) ReplyContext(ctx context.Context) (*QueryServerStringReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryServerStringReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryServerStringReply reads a byte slice into a QueryServerStringReply value.
-func queryServerStringReply(buf []byte) *QueryServerStringReply {
+// queryServerStringReply reads a byte slice in the byte order 'order' into a QueryServerStringReply value.
+func queryServerStringReply(buf []byte, order xgb.ByteOrder) *QueryServerStringReply {
 	v := new(QueryServerStringReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 4 // padding
 
-	v.StrLen = xgb.Get32(buf[b:])
+	v.StrLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 16 // padding
@@ -9646,9 +14318,18 @@ func queryServerStringReply(buf []byte) *QueryServerStringReply {
 // Write request to wire for QueryServerString
 // queryServerStringRequest writes a QueryServerString request to a byte slice.
 func queryServerStringRequest(c *xgb.Conn, Screen uint32, Name uint32) []byte {
+	return AppendQueryServerStringRequest(nil, c, Screen, Name)
+}
+
+// AppendQueryServerStringRequest appends a QueryServerString request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryServerStringRequest(buf []byte, c *xgb.Conn, Screen uint32, Name uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -9658,18 +14339,50 @@ func queryServerStringRequest(c *xgb.Conn, Screen uint32, Name uint32) []byte {
 	buf[b] = 19 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
-	xgb.Put32(buf[b:], Name)
+	order.Put32(buf[b:], Name)
 	b += 4
 
 	return buf
 }
 
+// QueryServerStringRequest represents the data of a QueryServerString request, as it is read when tracing a connection.
+type QueryServerStringRequest struct {
+	Screen uint32
+	Name   uint32
+}
+
+// queryServerStringRequestRead reads a byte slice in the byte order 'order' into a QueryServerStringRequest value.
+func queryServerStringRequestRead(buf []byte, order xgb.ByteOrder) *QueryServerStringRequest {
+	v := new(QueryServerStringRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	v.Name = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][19] = xgb.RequestInfo{
+		Name: "QueryServerString",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryServerStringRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryServerStringReply(buf, order)
+		},
+	}
+}
+
 // QueryVersionCookie is a cookie used only for QueryVersion requests.
 type QueryVersionCookie struct {
 	*xgb.Cookie
@@ -9720,26 +14433,38 @@ func (cook QueryVersionCookie) Reply() (*QueryVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryVersionReply(buf), nil
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryVersion request arrives.
+func (cook QueryVersionCookie) ReplyContext(ctx context.Context) (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryVersionReply reads a byte slice into a QueryVersionReply value.
-func queryVersionReply(buf []byte) *QueryVersionReply {
+// queryVersionReply reads a byte slice in the byte order 'order' into a QueryVersionReply value.
+func queryVersionReply(buf []byte, order xgb.ByteOrder) *QueryVersionReply {
 	v := new(QueryVersionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.MajorVersion = xgb.Get32(buf[b:])
+	v.MajorVersion = order.Get32(buf[b:])
 	b += 4
 
-	v.MinorVersion = xgb.Get32(buf[b:])
+	v.MinorVersion = order.Get32(buf[b:])
 	b += 4
 
 	b += 16 // padding
@@ -9750,9 +14475,18 @@ func queryVersionReply(buf []byte) *QueryVersionReply {
 // Write request to wire for QueryVersion
 // queryVersionRequest writes a QueryVersion request to a byte slice.
 func queryVersionRequest(c *xgb.Conn, MajorVersion uint32, MinorVersion uint32) []byte {
+	return AppendQueryVersionRequest(nil, c, MajorVersion, MinorVersion)
+}
+
+// AppendQueryVersionRequest appends a QueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryVersionRequest(buf []byte, c *xgb.Conn, MajorVersion uint32, MinorVersion uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -9762,18 +14496,50 @@ func queryVersionRequest(c *xgb.Conn, MajorVersion uint32, MinorVersion uint32)
 	buf[b] = 7 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], MajorVersion)
+	order.Put32(buf[b:], MajorVersion)
 	b += 4
 
-	xgb.Put32(buf[b:], MinorVersion)
+	order.Put32(buf[b:], MinorVersion)
 	b += 4
 
 	return buf
 }
 
+// QueryVersionRequest represents the data of a QueryVersion request, as it is read when tracing a connection.
+type QueryVersionRequest struct {
+	MajorVersion uint32
+	MinorVersion uint32
+}
+
+// queryVersionRequestRead reads a byte slice in the byte order 'order' into a QueryVersionRequest value.
+func queryVersionRequestRead(buf []byte, order xgb.ByteOrder) *QueryVersionRequest {
+	v := new(QueryVersionRequest)
+	b := 4 // skip request header
+
+	v.MajorVersion = order.Get32(buf[b:])
+	b += 4
+
+	v.MinorVersion = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][7] = xgb.RequestInfo{
+		Name: "QueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionReply(buf, order)
+		},
+	}
+}
+
 // ReadPixelsCookie is a cookie used only for ReadPixels requests.
 type ReadPixelsCookie struct {
 	*xgb.Cookie
@@ -9823,20 +14589,32 @@ func (cook ReadPixelsCookie) Reply() (*ReadPixelsReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return readPixelsReply(buf), nil
+	return readPixelsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a ReadPixels request arrives.
+func (cook ReadPixelsCookie) ReplyContext(ctx context.Context) (*ReadPixelsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return readPixelsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// readPixelsReply reads a byte slice into a ReadPixelsReply value.
-func readPixelsReply(buf []byte) *ReadPixelsReply {
+// readPixelsReply reads a byte slice in the byte order 'order' into a ReadPixelsReply value.
+func readPixelsReply(buf []byte, order xgb.ByteOrder) *ReadPixelsReply {
 	v := new(ReadPixelsReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 24 // padding
@@ -9851,9 +14629,18 @@ func readPixelsReply(buf []byte) *ReadPixelsReply {
 // Write request to wire for ReadPixels
 // readPixelsRequest writes a ReadPixels request to a byte slice.
 func readPixelsRequest(c *xgb.Conn, ContextTag ContextTag, X int32, Y int32, Width int32, Height int32, Format uint32, Type uint32, SwapBytes bool, LsbFirst bool) []byte {
+	return AppendReadPixelsRequest(nil, c, ContextTag, X, Y, Width, Height, Format, Type, SwapBytes, LsbFirst)
+}
+
+// AppendReadPixelsRequest appends a ReadPixels request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendReadPixelsRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, X int32, Y int32, Width int32, Height int32, Format uint32, Type uint32, SwapBytes bool, LsbFirst bool) []byte {
 	size := 36
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -9863,28 +14650,28 @@ func readPixelsRequest(c *xgb.Conn, ContextTag ContextTag, X int32, Y int32, Wid
 	buf[b] = 111 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(X))
+	order.Put32(buf[b:], uint32(X))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Y))
+	order.Put32(buf[b:], uint32(Y))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Width))
+	order.Put32(buf[b:], uint32(Width))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Height))
+	order.Put32(buf[b:], uint32(Height))
 	b += 4
 
-	xgb.Put32(buf[b:], Format)
+	order.Put32(buf[b:], Format)
 	b += 4
 
-	xgb.Put32(buf[b:], Type)
+	order.Put32(buf[b:], Type)
 	b += 4
 
 	if SwapBytes {
@@ -9904,6 +14691,74 @@ func readPixelsRequest(c *xgb.Conn, ContextTag ContextTag, X int32, Y int32, Wid
 	return buf
 }
 
+// ReadPixelsRequest represents the data of a ReadPixels request, as it is read when tracing a connection.
+type ReadPixelsRequest struct {
+	ContextTag ContextTag
+	X          int32
+	Y          int32
+	Width      int32
+	Height     int32
+	Format     uint32
+	Type       uint32
+	SwapBytes  bool
+	LsbFirst   bool
+}
+
+// readPixelsRequestRead reads a byte slice in the byte order 'order' into a ReadPixelsRequest value.
+func readPixelsRequestRead(buf []byte, order xgb.ByteOrder) *ReadPixelsRequest {
+	v := new(ReadPixelsRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.X = int32(order.Get32(buf[b:]))
+	b += 4
+
+	v.Y = int32(order.Get32(buf[b:]))
+	b += 4
+
+	v.Width = int32(order.Get32(buf[b:]))
+	b += 4
+
+	v.Height = int32(order.Get32(buf[b:]))
+	b += 4
+
+	v.Format = order.Get32(buf[b:])
+	b += 4
+
+	v.Type = order.Get32(buf[b:])
+	b += 4
+
+	if buf[b] == 1 {
+		v.SwapBytes = true
+	} else {
+		v.SwapBytes = false
+	}
+	b += 1
+
+	if buf[b] == 1 {
+		v.LsbFirst = true
+	} else {
+		v.LsbFirst = false
+	}
+	b += 1
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][111] = xgb.RequestInfo{
+		Name: "ReadPixels",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return readPixelsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return readPixelsReply(buf, order)
+		},
+	}
+}
+
 // RenderCookie is a cookie used only for Render requests.
 type RenderCookie struct {
 	*xgb.Cookie
@@ -9941,12 +14796,26 @@ func (cook RenderCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook RenderCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for Render
 // renderRequest writes a Render request to a byte slice.
 func renderRequest(c *xgb.Conn, ContextTag ContextTag, Data []byte) []byte {
+	return AppendRenderRequest(nil, c, ContextTag, Data)
+}
+
+// AppendRenderRequest appends a Render request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendRenderRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Data []byte) []byte {
 	size := xgb.Pad((8 + xgb.Pad((len(Data) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -9956,10 +14825,10 @@ func renderRequest(c *xgb.Conn, ContextTag ContextTag, Data []byte) []byte {
 	buf[b] = 1 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
 	copy(buf[b:], Data[:len(Data)])
@@ -9968,6 +14837,36 @@ func renderRequest(c *xgb.Conn, ContextTag ContextTag, Data []byte) []byte {
 	return buf
 }
 
+// RenderRequest represents the data of a Render request, as it is read when tracing a connection.
+type RenderRequest struct {
+	ContextTag ContextTag
+	Data       []byte // size: xgb.Pad((len(Data) * 1))
+}
+
+// renderRequestRead reads a byte slice in the byte order 'order' into a RenderRequest value.
+func renderRequestRead(buf []byte, order xgb.ByteOrder) *RenderRequest {
+	v := new(RenderRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Data = make([]byte, (len(buf)-b)/1)
+	copy(v.Data[:(len(buf)-b)/1], buf[b:])
+	b += int((len(buf) - b) / 1)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][1] = xgb.RequestInfo{
+		Name: "Render",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return renderRequestRead(buf, order)
+		},
+	}
+}
+
 // RenderLargeCookie is a cookie used only for RenderLarge requests.
 type RenderLargeCookie struct {
 	*xgb.Cookie
@@ -10005,40 +14904,96 @@ func (cook RenderLargeCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook RenderLargeCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for RenderLarge
 // renderLargeRequest writes a RenderLarge request to a byte slice.
 func renderLargeRequest(c *xgb.Conn, ContextTag ContextTag, RequestNum uint16, RequestTotal uint16, DataLen uint32, Data []byte) []byte {
+	return AppendRenderLargeRequest(nil, c, ContextTag, RequestNum, RequestTotal, DataLen, Data)
+}
+
+// AppendRenderLargeRequest appends a RenderLarge request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendRenderLargeRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, RequestNum uint16, RequestTotal uint16, DataLen uint32, Data []byte) []byte {
 	size := xgb.Pad((16 + xgb.Pad((int(DataLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
 	c.ExtLock.RUnlock()
 	b += 1
 
-	buf[b] = 2 // request opcode
-	b += 1
+	buf[b] = 2 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(ContextTag))
+	b += 4
+
+	order.Put16(buf[b:], RequestNum)
+	b += 2
+
+	order.Put16(buf[b:], RequestTotal)
+	b += 2
+
+	order.Put32(buf[b:], DataLen)
+	b += 4
+
+	copy(buf[b:], Data[:DataLen])
+	b += int(DataLen)
+
+	return buf
+}
+
+// RenderLargeRequest represents the data of a RenderLarge request, as it is read when tracing a connection.
+type RenderLargeRequest struct {
+	ContextTag   ContextTag
+	RequestNum   uint16
+	RequestTotal uint16
+	DataLen      uint32
+	Data         []byte // size: xgb.Pad((int(DataLen) * 1))
+}
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
-	b += 2
+// renderLargeRequestRead reads a byte slice in the byte order 'order' into a RenderLargeRequest value.
+func renderLargeRequestRead(buf []byte, order xgb.ByteOrder) *RenderLargeRequest {
+	v := new(RenderLargeRequest)
+	b := 4 // skip request header
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
 	b += 4
 
-	xgb.Put16(buf[b:], RequestNum)
+	v.RequestNum = order.Get16(buf[b:])
 	b += 2
 
-	xgb.Put16(buf[b:], RequestTotal)
+	v.RequestTotal = order.Get16(buf[b:])
 	b += 2
 
-	xgb.Put32(buf[b:], DataLen)
+	v.DataLen = order.Get32(buf[b:])
 	b += 4
 
-	copy(buf[b:], Data[:DataLen])
-	b += int(DataLen)
+	v.Data = make([]byte, v.DataLen)
+	copy(v.Data[:v.DataLen], buf[b:])
+	b += int(v.DataLen)
 
-	return buf
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][2] = xgb.RequestInfo{
+		Name: "RenderLarge",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return renderLargeRequestRead(buf, order)
+		},
+	}
 }
 
 // RenderModeCookie is a cookie used only for RenderMode requests.
@@ -10093,36 +15048,48 @@ func (cook RenderModeCookie) Reply() (*RenderModeReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return renderModeReply(buf), nil
+	return renderModeReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a RenderMode request arrives.
+func (cook RenderModeCookie) ReplyContext(ctx context.Context) (*RenderModeReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return renderModeReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// renderModeReply reads a byte slice into a RenderModeReply value.
-func renderModeReply(buf []byte) *RenderModeReply {
+// renderModeReply reads a byte slice in the byte order 'order' into a RenderModeReply value.
+func renderModeReply(buf []byte, order xgb.ByteOrder) *RenderModeReply {
 	v := new(RenderModeReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.RetVal = xgb.Get32(buf[b:])
+	v.RetVal = order.Get32(buf[b:])
 	b += 4
 
-	v.N = xgb.Get32(buf[b:])
+	v.N = order.Get32(buf[b:])
 	b += 4
 
-	v.NewMode = xgb.Get32(buf[b:])
+	v.NewMode = order.Get32(buf[b:])
 	b += 4
 
 	b += 12 // padding
 
 	v.Data = make([]uint32, v.N)
 	for i := 0; i < int(v.N); i++ {
-		v.Data[i] = xgb.Get32(buf[b:])
+		v.Data[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -10132,9 +15099,18 @@ func renderModeReply(buf []byte) *RenderModeReply {
 // Write request to wire for RenderMode
 // renderModeRequest writes a RenderMode request to a byte slice.
 func renderModeRequest(c *xgb.Conn, ContextTag ContextTag, Mode uint32) []byte {
+	return AppendRenderModeRequest(nil, c, ContextTag, Mode)
+}
+
+// AppendRenderModeRequest appends a RenderMode request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendRenderModeRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Mode uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -10144,18 +15120,50 @@ func renderModeRequest(c *xgb.Conn, ContextTag ContextTag, Mode uint32) []byte {
 	buf[b] = 107 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], Mode)
+	order.Put32(buf[b:], Mode)
 	b += 4
 
 	return buf
 }
 
+// RenderModeRequest represents the data of a RenderMode request, as it is read when tracing a connection.
+type RenderModeRequest struct {
+	ContextTag ContextTag
+	Mode       uint32
+}
+
+// renderModeRequestRead reads a byte slice in the byte order 'order' into a RenderModeRequest value.
+func renderModeRequestRead(buf []byte, order xgb.ByteOrder) *RenderModeRequest {
+	v := new(RenderModeRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Mode = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][107] = xgb.RequestInfo{
+		Name: "RenderMode",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return renderModeRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return renderModeReply(buf, order)
+		},
+	}
+}
+
 // SelectBufferCookie is a cookie used only for SelectBuffer requests.
 type SelectBufferCookie struct {
 	*xgb.Cookie
@@ -10193,12 +15201,26 @@ func (cook SelectBufferCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SelectBufferCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SelectBuffer
 // selectBufferRequest writes a SelectBuffer request to a byte slice.
 func selectBufferRequest(c *xgb.Conn, ContextTag ContextTag, Size int32) []byte {
+	return AppendSelectBufferRequest(nil, c, ContextTag, Size)
+}
+
+// AppendSelectBufferRequest appends a SelectBuffer request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSelectBufferRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Size int32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -10208,18 +15230,47 @@ func selectBufferRequest(c *xgb.Conn, ContextTag ContextTag, Size int32) []byte
 	buf[b] = 106 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Size))
+	order.Put32(buf[b:], uint32(Size))
 	b += 4
 
 	return buf
 }
 
+// SelectBufferRequest represents the data of a SelectBuffer request, as it is read when tracing a connection.
+type SelectBufferRequest struct {
+	ContextTag ContextTag
+	Size       int32
+}
+
+// selectBufferRequestRead reads a byte slice in the byte order 'order' into a SelectBufferRequest value.
+func selectBufferRequestRead(buf []byte, order xgb.ByteOrder) *SelectBufferRequest {
+	v := new(SelectBufferRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Size = int32(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][106] = xgb.RequestInfo{
+		Name: "SelectBuffer",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return selectBufferRequestRead(buf, order)
+		},
+	}
+}
+
 // SetClientInfo2ARBCookie is a cookie used only for SetClientInfo2ARB requests.
 type SetClientInfo2ARBCookie struct {
 	*xgb.Cookie
@@ -10257,12 +15308,26 @@ func (cook SetClientInfo2ARBCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetClientInfo2ARBCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetClientInfo2ARB
 // setClientInfo2ARBRequest writes a SetClientInfo2ARB request to a byte slice.
 func setClientInfo2ARBRequest(c *xgb.Conn, MajorVersion uint32, MinorVersion uint32, NumVersions uint32, GlStrLen uint32, GlxStrLen uint32, GlVersions []uint32, GlExtensionString string, GlxExtensionString string) []byte {
+	return AppendSetClientInfo2ARBRequest(nil, c, MajorVersion, MinorVersion, NumVersions, GlStrLen, GlxStrLen, GlVersions, GlExtensionString, GlxExtensionString)
+}
+
+// AppendSetClientInfo2ARBRequest appends a SetClientInfo2ARB request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetClientInfo2ARBRequest(buf []byte, c *xgb.Conn, MajorVersion uint32, MinorVersion uint32, NumVersions uint32, GlStrLen uint32, GlxStrLen uint32, GlVersions []uint32, GlExtensionString string, GlxExtensionString string) []byte {
 	size := xgb.Pad((((24 + xgb.Pad(((int(NumVersions) * 3) * 4))) + xgb.Pad((int(GlStrLen) * 1))) + xgb.Pad((int(GlxStrLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -10272,26 +15337,26 @@ func setClientInfo2ARBRequest(c *xgb.Conn, MajorVersion uint32, MinorVersion uin
 	buf[b] = 35 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], MajorVersion)
+	order.Put32(buf[b:], MajorVersion)
 	b += 4
 
-	xgb.Put32(buf[b:], MinorVersion)
+	order.Put32(buf[b:], MinorVersion)
 	b += 4
 
-	xgb.Put32(buf[b:], NumVersions)
+	order.Put32(buf[b:], NumVersions)
 	b += 4
 
-	xgb.Put32(buf[b:], GlStrLen)
+	order.Put32(buf[b:], GlStrLen)
 	b += 4
 
-	xgb.Put32(buf[b:], GlxStrLen)
+	order.Put32(buf[b:], GlxStrLen)
 	b += 4
 
 	for i := 0; i < int((int(NumVersions) * 3)); i++ {
-		xgb.Put32(buf[b:], GlVersions[i])
+		order.Put32(buf[b:], GlVersions[i])
 		b += 4
 	}
 
@@ -10304,6 +15369,70 @@ func setClientInfo2ARBRequest(c *xgb.Conn, MajorVersion uint32, MinorVersion uin
 	return buf
 }
 
+// SetClientInfo2ARBRequest represents the data of a SetClientInfo2ARB request, as it is read when tracing a connection.
+type SetClientInfo2ARBRequest struct {
+	MajorVersion       uint32
+	MinorVersion       uint32
+	NumVersions        uint32
+	GlStrLen           uint32
+	GlxStrLen          uint32
+	GlVersions         []uint32 // size: xgb.Pad(((int(NumVersions) * 3) * 4))
+	GlExtensionString  string   // size: xgb.Pad((int(GlStrLen) * 1))
+	GlxExtensionString string   // size: xgb.Pad((int(GlxStrLen) * 1))
+}
+
+// setClientInfo2ARBRequestRead reads a byte slice in the byte order 'order' into a SetClientInfo2ARBRequest value.
+func setClientInfo2ARBRequestRead(buf []byte, order xgb.ByteOrder) *SetClientInfo2ARBRequest {
+	v := new(SetClientInfo2ARBRequest)
+	b := 4 // skip request header
+
+	v.MajorVersion = order.Get32(buf[b:])
+	b += 4
+
+	v.MinorVersion = order.Get32(buf[b:])
+	b += 4
+
+	v.NumVersions = order.Get32(buf[b:])
+	b += 4
+
+	v.GlStrLen = order.Get32(buf[b:])
+	b += 4
+
+	v.GlxStrLen = order.Get32(buf[b:])
+	b += 4
+
+	v.GlVersions = make([]uint32, (int(v.NumVersions) * 3))
+	for i := 0; i < int((int(v.NumVersions) * 3)); i++ {
+		v.GlVersions[i] = order.Get32(buf[b:])
+		b += 4
+	}
+
+	{
+		byteString := make([]byte, v.GlStrLen)
+		copy(byteString[:v.GlStrLen], buf[b:])
+		v.GlExtensionString = string(byteString)
+		b += int(v.GlStrLen)
+	}
+
+	{
+		byteString := make([]byte, v.GlxStrLen)
+		copy(byteString[:v.GlxStrLen], buf[b:])
+		v.GlxExtensionString = string(byteString)
+		b += int(v.GlxStrLen)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][35] = xgb.RequestInfo{
+		Name: "SetClientInfo2ARB",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setClientInfo2ARBRequestRead(buf, order)
+		},
+	}
+}
+
 // SetClientInfoARBCookie is a cookie used only for SetClientInfoARB requests.
 type SetClientInfoARBCookie struct {
 	*xgb.Cookie
@@ -10341,12 +15470,26 @@ func (cook SetClientInfoARBCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetClientInfoARBCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetClientInfoARB
 // setClientInfoARBRequest writes a SetClientInfoARB request to a byte slice.
 func setClientInfoARBRequest(c *xgb.Conn, MajorVersion uint32, MinorVersion uint32, NumVersions uint32, GlStrLen uint32, GlxStrLen uint32, GlVersions []uint32, GlExtensionString string, GlxExtensionString string) []byte {
+	return AppendSetClientInfoARBRequest(nil, c, MajorVersion, MinorVersion, NumVersions, GlStrLen, GlxStrLen, GlVersions, GlExtensionString, GlxExtensionString)
+}
+
+// AppendSetClientInfoARBRequest appends a SetClientInfoARB request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetClientInfoARBRequest(buf []byte, c *xgb.Conn, MajorVersion uint32, MinorVersion uint32, NumVersions uint32, GlStrLen uint32, GlxStrLen uint32, GlVersions []uint32, GlExtensionString string, GlxExtensionString string) []byte {
 	size := xgb.Pad((((24 + xgb.Pad(((int(NumVersions) * 2) * 4))) + xgb.Pad((int(GlStrLen) * 1))) + xgb.Pad((int(GlxStrLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -10356,26 +15499,26 @@ func setClientInfoARBRequest(c *xgb.Conn, MajorVersion uint32, MinorVersion uint
 	buf[b] = 33 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], MajorVersion)
+	order.Put32(buf[b:], MajorVersion)
 	b += 4
 
-	xgb.Put32(buf[b:], MinorVersion)
+	order.Put32(buf[b:], MinorVersion)
 	b += 4
 
-	xgb.Put32(buf[b:], NumVersions)
+	order.Put32(buf[b:], NumVersions)
 	b += 4
 
-	xgb.Put32(buf[b:], GlStrLen)
+	order.Put32(buf[b:], GlStrLen)
 	b += 4
 
-	xgb.Put32(buf[b:], GlxStrLen)
+	order.Put32(buf[b:], GlxStrLen)
 	b += 4
 
 	for i := 0; i < int((int(NumVersions) * 2)); i++ {
-		xgb.Put32(buf[b:], GlVersions[i])
+		order.Put32(buf[b:], GlVersions[i])
 		b += 4
 	}
 
@@ -10388,6 +15531,70 @@ func setClientInfoARBRequest(c *xgb.Conn, MajorVersion uint32, MinorVersion uint
 	return buf
 }
 
+// SetClientInfoARBRequest represents the data of a SetClientInfoARB request, as it is read when tracing a connection.
+type SetClientInfoARBRequest struct {
+	MajorVersion       uint32
+	MinorVersion       uint32
+	NumVersions        uint32
+	GlStrLen           uint32
+	GlxStrLen          uint32
+	GlVersions         []uint32 // size: xgb.Pad(((int(NumVersions) * 2) * 4))
+	GlExtensionString  string   // size: xgb.Pad((int(GlStrLen) * 1))
+	GlxExtensionString string   // size: xgb.Pad((int(GlxStrLen) * 1))
+}
+
+// setClientInfoARBRequestRead reads a byte slice in the byte order 'order' into a SetClientInfoARBRequest value.
+func setClientInfoARBRequestRead(buf []byte, order xgb.ByteOrder) *SetClientInfoARBRequest {
+	v := new(SetClientInfoARBRequest)
+	b := 4 // skip request header
+
+	v.MajorVersion = order.Get32(buf[b:])
+	b += 4
+
+	v.MinorVersion = order.Get32(buf[b:])
+	b += 4
+
+	v.NumVersions = order.Get32(buf[b:])
+	b += 4
+
+	v.GlStrLen = order.Get32(buf[b:])
+	b += 4
+
+	v.GlxStrLen = order.Get32(buf[b:])
+	b += 4
+
+	v.GlVersions = make([]uint32, (int(v.NumVersions) * 2))
+	for i := 0; i < int((int(v.NumVersions) * 2)); i++ {
+		v.GlVersions[i] = order.Get32(buf[b:])
+		b += 4
+	}
+
+	{
+		byteString := make([]byte, v.GlStrLen)
+		copy(byteString[:v.GlStrLen], buf[b:])
+		v.GlExtensionString = string(byteString)
+		b += int(v.GlStrLen)
+	}
+
+	{
+		byteString := make([]byte, v.GlxStrLen)
+		copy(byteString[:v.GlxStrLen], buf[b:])
+		v.GlxExtensionString = string(byteString)
+		b += int(v.GlxStrLen)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][33] = xgb.RequestInfo{
+		Name: "SetClientInfoARB",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setClientInfoARBRequestRead(buf, order)
+		},
+	}
+}
+
 // SwapBuffersCookie is a cookie used only for SwapBuffers requests.
 type SwapBuffersCookie struct {
 	*xgb.Cookie
@@ -10425,12 +15632,26 @@ func (cook SwapBuffersCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SwapBuffersCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SwapBuffers
 // swapBuffersRequest writes a SwapBuffers request to a byte slice.
 func swapBuffersRequest(c *xgb.Conn, ContextTag ContextTag, Drawable Drawable) []byte {
+	return AppendSwapBuffersRequest(nil, c, ContextTag, Drawable)
+}
+
+// AppendSwapBuffersRequest appends a SwapBuffers request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSwapBuffersRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Drawable Drawable) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -10440,18 +15661,47 @@ func swapBuffersRequest(c *xgb.Conn, ContextTag ContextTag, Drawable Drawable) [
 	buf[b] = 11 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
 	return buf
 }
 
+// SwapBuffersRequest represents the data of a SwapBuffers request, as it is read when tracing a connection.
+type SwapBuffersRequest struct {
+	ContextTag ContextTag
+	Drawable   Drawable
+}
+
+// swapBuffersRequestRead reads a byte slice in the byte order 'order' into a SwapBuffersRequest value.
+func swapBuffersRequestRead(buf []byte, order xgb.ByteOrder) *SwapBuffersRequest {
+	v := new(SwapBuffersRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][11] = xgb.RequestInfo{
+		Name: "SwapBuffers",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return swapBuffersRequestRead(buf, order)
+		},
+	}
+}
+
 // UseXFontCookie is a cookie used only for UseXFont requests.
 type UseXFontCookie struct {
 	*xgb.Cookie
@@ -10489,12 +15739,26 @@ func (cook UseXFontCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook UseXFontCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for UseXFont
 // useXFontRequest writes a UseXFont request to a byte slice.
 func useXFontRequest(c *xgb.Conn, ContextTag ContextTag, Font xproto.Font, First uint32, Count uint32, ListBase uint32) []byte {
+	return AppendUseXFontRequest(nil, c, ContextTag, Font, First, Count, ListBase)
+}
+
+// AppendUseXFontRequest appends a UseXFont request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendUseXFontRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag, Font xproto.Font, First uint32, Count uint32, ListBase uint32) []byte {
 	size := 24
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -10504,27 +15768,68 @@ func useXFontRequest(c *xgb.Conn, ContextTag ContextTag, Font xproto.Font, First
 	buf[b] = 12 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Font))
+	order.Put32(buf[b:], uint32(Font))
 	b += 4
 
-	xgb.Put32(buf[b:], First)
+	order.Put32(buf[b:], First)
 	b += 4
 
-	xgb.Put32(buf[b:], Count)
+	order.Put32(buf[b:], Count)
 	b += 4
 
-	xgb.Put32(buf[b:], ListBase)
+	order.Put32(buf[b:], ListBase)
 	b += 4
 
 	return buf
 }
 
+// UseXFontRequest represents the data of a UseXFont request, as it is read when tracing a connection.
+type UseXFontRequest struct {
+	ContextTag ContextTag
+	Font       xproto.Font
+	First      uint32
+	Count      uint32
+	ListBase   uint32
+}
+
+// useXFontRequestRead reads a byte slice in the byte order 'order' into a UseXFontRequest value.
+func useXFontRequestRead(buf []byte, order xgb.ByteOrder) *UseXFontRequest {
+	v := new(UseXFontRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Font = xproto.Font(order.Get32(buf[b:]))
+	b += 4
+
+	v.First = order.Get32(buf[b:])
+	b += 4
+
+	v.Count = order.Get32(buf[b:])
+	b += 4
+
+	v.ListBase = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][12] = xgb.RequestInfo{
+		Name: "UseXFont",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return useXFontRequestRead(buf, order)
+		},
+	}
+}
+
 // VendorPrivateCookie is a cookie used only for VendorPrivate requests.
 type VendorPrivateCookie struct {
 	*xgb.Cookie
@@ -10562,12 +15867,26 @@ func (cook VendorPrivateCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook VendorPrivateCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for VendorPrivate
 // vendorPrivateRequest writes a VendorPrivate request to a byte slice.
 func vendorPrivateRequest(c *xgb.Conn, VendorCode uint32, ContextTag ContextTag, Data []byte) []byte {
+	return AppendVendorPrivateRequest(nil, c, VendorCode, ContextTag, Data)
+}
+
+// AppendVendorPrivateRequest appends a VendorPrivate request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendVendorPrivateRequest(buf []byte, c *xgb.Conn, VendorCode uint32, ContextTag ContextTag, Data []byte) []byte {
 	size := xgb.Pad((12 + xgb.Pad((len(Data) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -10577,13 +15896,13 @@ func vendorPrivateRequest(c *xgb.Conn, VendorCode uint32, ContextTag ContextTag,
 	buf[b] = 16 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], VendorCode)
+	order.Put32(buf[b:], VendorCode)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
 	copy(buf[b:], Data[:len(Data)])
@@ -10592,6 +15911,40 @@ func vendorPrivateRequest(c *xgb.Conn, VendorCode uint32, ContextTag ContextTag,
 	return buf
 }
 
+// VendorPrivateRequest represents the data of a VendorPrivate request, as it is read when tracing a connection.
+type VendorPrivateRequest struct {
+	VendorCode uint32
+	ContextTag ContextTag
+	Data       []byte // size: xgb.Pad((len(Data) * 1))
+}
+
+// vendorPrivateRequestRead reads a byte slice in the byte order 'order' into a VendorPrivateRequest value.
+func vendorPrivateRequestRead(buf []byte, order xgb.ByteOrder) *VendorPrivateRequest {
+	v := new(VendorPrivateRequest)
+	b := 4 // skip request header
+
+	v.VendorCode = order.Get32(buf[b:])
+	b += 4
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Data = make([]byte, (len(buf)-b)/1)
+	copy(v.Data[:(len(buf)-b)/1], buf[b:])
+	b += int((len(buf) - b) / 1)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][16] = xgb.RequestInfo{
+		Name: "VendorPrivate",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return vendorPrivateRequestRead(buf, order)
+		},
+	}
+}
+
 // VendorPrivateWithReplyCookie is a cookie used only for VendorPrivateWithReply requests.
 type VendorPrivateWithReplyCookie struct {
 	*xgb.Cookie
@@ -10642,23 +15995,35 @@ func (cook VendorPrivateWithReplyCookie) Reply() (*VendorPrivateWithReplyReply,
 	if buf == nil {
 		return nil, nil
 	}
-	return vendorPrivateWithReplyReply(buf), nil
+	return vendorPrivateWithReplyReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a VendorPrivateWithReply request arrives.
+func (cook VendorPrivateWithReplyCookie) ReplyContext(ctx context.Context) (*VendorPrivateWithReplyReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return vendorPrivateWithReplyReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// vendorPrivateWithReplyReply reads a byte slice into a VendorPrivateWithReplyReply value.
-func vendorPrivateWithReplyReply(buf []byte) *VendorPrivateWithReplyReply {
+// vendorPrivateWithReplyReply reads a byte slice in the byte order 'order' into a VendorPrivateWithReplyReply value.
+func vendorPrivateWithReplyReply(buf []byte, order xgb.ByteOrder) *VendorPrivateWithReplyReply {
 	v := new(VendorPrivateWithReplyReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Retval = xgb.Get32(buf[b:])
+	v.Retval = order.Get32(buf[b:])
 	b += 4
 
 	v.Data1 = make([]byte, 24)
@@ -10675,9 +16040,18 @@ func vendorPrivateWithReplyReply(buf []byte) *VendorPrivateWithReplyReply {
 // Write request to wire for VendorPrivateWithReply
 // vendorPrivateWithReplyRequest writes a VendorPrivateWithReply request to a byte slice.
 func vendorPrivateWithReplyRequest(c *xgb.Conn, VendorCode uint32, ContextTag ContextTag, Data []byte) []byte {
+	return AppendVendorPrivateWithReplyRequest(nil, c, VendorCode, ContextTag, Data)
+}
+
+// AppendVendorPrivateWithReplyRequest appends a VendorPrivateWithReply request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendVendorPrivateWithReplyRequest(buf []byte, c *xgb.Conn, VendorCode uint32, ContextTag ContextTag, Data []byte) []byte {
 	size := xgb.Pad((12 + xgb.Pad((len(Data) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -10687,13 +16061,13 @@ func vendorPrivateWithReplyRequest(c *xgb.Conn, VendorCode uint32, ContextTag Co
 	buf[b] = 17 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], VendorCode)
+	order.Put32(buf[b:], VendorCode)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
 	copy(buf[b:], Data[:len(Data)])
@@ -10702,6 +16076,43 @@ func vendorPrivateWithReplyRequest(c *xgb.Conn, VendorCode uint32, ContextTag Co
 	return buf
 }
 
+// VendorPrivateWithReplyRequest represents the data of a VendorPrivateWithReply request, as it is read when tracing a connection.
+type VendorPrivateWithReplyRequest struct {
+	VendorCode uint32
+	ContextTag ContextTag
+	Data       []byte // size: xgb.Pad((len(Data) * 1))
+}
+
+// vendorPrivateWithReplyRequestRead reads a byte slice in the byte order 'order' into a VendorPrivateWithReplyRequest value.
+func vendorPrivateWithReplyRequestRead(buf []byte, order xgb.ByteOrder) *VendorPrivateWithReplyRequest {
+	v := new(VendorPrivateWithReplyRequest)
+	b := 4 // skip request header
+
+	v.VendorCode = order.Get32(buf[b:])
+	b += 4
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	v.Data = make([]byte, (len(buf)-b)/1)
+	copy(v.Data[:(len(buf)-b)/1], buf[b:])
+	b += int((len(buf) - b) / 1)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][17] = xgb.RequestInfo{
+		Name: "VendorPrivateWithReply",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return vendorPrivateWithReplyRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return vendorPrivateWithReplyReply(buf, order)
+		},
+	}
+}
+
 // WaitGLCookie is a cookie used only for WaitGL requests.
 type WaitGLCookie struct {
 	*xgb.Cookie
@@ -10739,12 +16150,26 @@ func (cook WaitGLCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook WaitGLCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for WaitGL
 // waitGLRequest writes a WaitGL request to a byte slice.
 func waitGLRequest(c *xgb.Conn, ContextTag ContextTag) []byte {
+	return AppendWaitGLRequest(nil, c, ContextTag)
+}
+
+// AppendWaitGLRequest appends a WaitGL request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendWaitGLRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -10754,15 +16179,40 @@ func waitGLRequest(c *xgb.Conn, ContextTag ContextTag) []byte {
 	buf[b] = 8 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
 	return buf
 }
 
+// WaitGLRequest represents the data of a WaitGL request, as it is read when tracing a connection.
+type WaitGLRequest struct {
+	ContextTag ContextTag
+}
+
+// waitGLRequestRead reads a byte slice in the byte order 'order' into a WaitGLRequest value.
+func waitGLRequestRead(buf []byte, order xgb.ByteOrder) *WaitGLRequest {
+	v := new(WaitGLRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][8] = xgb.RequestInfo{
+		Name: "WaitGL",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return waitGLRequestRead(buf, order)
+		},
+	}
+}
+
 // WaitXCookie is a cookie used only for WaitX requests.
 type WaitXCookie struct {
 	*xgb.Cookie
@@ -10800,12 +16250,26 @@ func (cook WaitXCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook WaitXCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for WaitX
 // waitXRequest writes a WaitX request to a byte slice.
 func waitXRequest(c *xgb.Conn, ContextTag ContextTag) []byte {
+	return AppendWaitXRequest(nil, c, ContextTag)
+}
+
+// AppendWaitXRequest appends a WaitX request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendWaitXRequest(buf []byte, c *xgb.Conn, ContextTag ContextTag) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["GLX"]
@@ -10815,11 +16279,36 @@ func waitXRequest(c *xgb.Conn, ContextTag ContextTag) []byte {
 	buf[b] = 9 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextTag))
+	order.Put32(buf[b:], uint32(ContextTag))
 	b += 4
 
 	return buf
 }
+
+// WaitXRequest represents the data of a WaitX request, as it is read when tracing a connection.
+type WaitXRequest struct {
+	ContextTag ContextTag
+}
+
+// waitXRequestRead reads a byte slice in the byte order 'order' into a WaitXRequest value.
+func waitXRequestRead(buf []byte, order xgb.ByteOrder) *WaitXRequest {
+	v := new(WaitXRequest)
+	b := 4 // skip request header
+
+	v.ContextTag = ContextTag(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["GLX"][9] = xgb.RequestInfo{
+		Name: "WaitX",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return waitXRequestRead(buf, order)
+		},
+	}
+}
@@ -7,7 +7,8 @@ wrappers so that the sub-packages don't need to be smart about which stdlib
 packages to import.
 
 Also, the 'Get..' and 'Put..' functions are used through the core xgb package
-too. (xgbutil uses them too.)
+too. (xgbutil uses them too.) They use the LSBFirst byte order; the methods
+of ByteOrder with the same names work in either byte order.
 */
 
 import (
@@ -37,6 +38,22 @@ func Pad(n int) int {
 	return (n + 3) & ^3
 }
 
+// Grow returns 'buf' extended by 'n' zero bytes. A new byte slice is only
+// allocated if 'buf' doesn't have the room.
+func Grow(buf []byte, n int) []byte {
+	size := len(buf) + n
+	if size > cap(buf) {
+		grown := make([]byte, size, size+len(buf))
+		copy(grown, buf)
+		return grown
+	}
+	buf = buf[:size]
+	for i := size - n; i < size; i++ {
+		buf[i] = 0
+	}
+	return buf
+}
+
 // PopCount counts the number of bits set in a value list mask.
 func PopCount(mask0 int) int {
 	mask := uint32(mask0)
@@ -0,0 +1,94 @@
+package xgb_test
+
+import (
+	"testing"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xgbtest"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// streamOpcode is the opcode of a made up request that is answered by a
+// stream of replies. Its last reply has a zero in its second byte.
+const streamOpcode = 200
+
+func lastStreamReply(buf []byte) bool {
+	return buf[1] == 0
+}
+
+func streamRequest() []byte {
+	buf := make([]byte, 4)
+	buf[0] = streamOpcode
+	xgb.Put16(buf[2:], 1)
+	return buf
+}
+
+// streamReplies makes the replies to a stream request, counting down to
+// the last one in their second byte.
+func streamReplies(n int) []xgbtest.Response {
+	resps := make([]xgbtest.Response, n)
+	for i := range resps {
+		reply := make(xgbtest.Reply, 32)
+		reply[1] = byte(n - 1 - i)
+		resps[i] = reply
+	}
+	return resps
+}
+
+func TestMultiReply(t *testing.T) {
+	s := xgbtest.NewServer()
+	defer s.Close()
+	s.Handle(streamOpcode, 0, streamReplies(3)...)
+	c, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, checked := range []bool{true, false} {
+		cookie := c.NewMultiCookie(checked, lastStreamReply)
+		c.NewRequest(streamRequest(), cookie)
+		for i := 2; i >= 0; i-- {
+			reply, err := cookie.Reply()
+			if err != nil {
+				t.Fatalf("Reply %d: %s", 2-i, err)
+			}
+			if int(reply[1]) != i {
+				t.Fatalf("Reply %d counts down to %d, but expected %d.",
+					2-i, reply[1], i)
+			}
+		}
+		if _, err := cookie.Reply(); err != xgb.ErrNoMoreReplies {
+			t.Fatalf("Expected ErrNoMoreReplies after the last reply, "+
+				"but got %v.", err)
+		}
+	}
+
+	// The connection must still be in sync after the stream.
+	if _, err := xproto.GetInputFocus(c).Reply(); err != nil {
+		t.Fatalf("GetInputFocus: %s", err)
+	}
+}
+
+func TestMultiReplyError(t *testing.T) {
+	s := xgbtest.NewServer()
+	defer s.Close()
+	resps := append(streamReplies(3)[:1], xgbtest.Error{Code: xproto.BadValue})
+	s.Handle(streamOpcode, 0, resps...)
+	c, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cookie := c.NewMultiCookie(true, lastStreamReply)
+	c.NewRequest(streamRequest(), cookie)
+	if _, err := cookie.Reply(); err != nil {
+		t.Fatalf("First reply: %s", err)
+	}
+	if _, err := cookie.Reply(); err == nil || err == xgb.ErrNoMoreReplies {
+		t.Fatalf("Expected the error ending the stream, but got %v.", err)
+	}
+	if _, err := cookie.Reply(); err != xgb.ErrNoMoreReplies {
+		t.Fatalf("Expected ErrNoMoreReplies after the error, but got %v.",
+			err)
+	}
+}
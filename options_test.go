@@ -0,0 +1,222 @@
+package xgb_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xgbtest"
+)
+
+func TestNewConnOptions(t *testing.T) {
+	s := xgbtest.NewServer()
+	defer s.Close()
+
+	var network, address string
+	cookie := []byte("0123456789abcdef")
+	screen := 0 // overrides the screen of the display
+	X, err := xgb.NewConnOptions(context.Background(), xgb.Options{
+		Display: "hostname:5.1",
+		Dial: func(ctx context.Context, n, a string) (net.Conn, error) {
+			network, address = n, a
+			return s.Dial(ctx, n, a)
+		},
+		AuthName: "MIT-MAGIC-COOKIE-1",
+		AuthData: cookie,
+		Screen:   &screen,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer X.Close()
+
+	if network != "tcp" || address != "hostname:6005" {
+		t.Errorf("dialed %s %q, want tcp %q", network, address,
+			"hostname:6005")
+	}
+	if X.DisplayNumber != 5 || X.DefaultScreen != 0 {
+		t.Errorf("display %d, screen %d; want display 5, screen 0",
+			X.DisplayNumber, X.DefaultScreen)
+	}
+	name, data := s.Auth()
+	if name != "MIT-MAGIC-COOKIE-1" || !bytes.Equal(data, cookie) {
+		t.Errorf("server got auth %q %q, want %q %q", name, data,
+			"MIT-MAGIC-COOKIE-1", cookie)
+	}
+}
+
+func TestNewConnOptionsAuth(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"MIT-MAGIC-COOKIE-1", make([]byte, 15)},
+		{"XDM-AUTHORIZATION-1", make([]byte, 8)},
+		{"SUN-DES-1", []byte("unix.1000@example.com")},
+	}
+	for _, test := range tests {
+		dialed := false
+		_, err := xgb.NewConnOptions(context.Background(), xgb.Options{
+			Display: ":0",
+			Dial: func(ctx context.Context, n, a string) (net.Conn, error) {
+				dialed = true
+				return silentDial(ctx, n, a)
+			},
+			AuthName: test.name,
+			AuthData: test.data,
+		})
+		if err == nil || dialed {
+			t.Errorf("%s with %d bytes: got error %v after dialing %t, "+
+				"want an error before dialing", test.name, len(test.data),
+				err, dialed)
+		}
+	}
+}
+
+func TestNewConnNetOptions(t *testing.T) {
+	s := xgbtest.NewServer()
+	defer s.Close()
+	client, err := s.Dial(context.Background(), "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cookie := []byte("0123456789abcdef")
+	screen := 1
+	X, err := xgb.NewConnNetOptions(context.Background(), client,
+		xgb.Options{
+			AuthName:       "MIT-MAGIC-COOKIE-1",
+			AuthData:       cookie,
+			Screen:         &screen,
+			EventQueueSize: 1,
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer X.Close()
+
+	if X.DefaultScreen != 1 {
+		t.Errorf("screen %d, want 1", X.DefaultScreen)
+	}
+	name, data := s.Auth()
+	if name != "MIT-MAGIC-COOKIE-1" || !bytes.Equal(data, cookie) {
+		t.Errorf("server got auth %q %q, want %q %q", name, data,
+			"MIT-MAGIC-COOKIE-1", cookie)
+	}
+}
+
+// silentDial connects to a server that never answers.
+func silentDial(ctx context.Context, network,
+	address string) (net.Conn, error) {
+
+	client, server := net.Pipe()
+	go func() {
+		buf := make([]byte, 1024)
+		for {
+			if _, err := server.Read(buf); err != nil {
+				return
+			}
+		}
+	}()
+	return client, nil
+}
+
+func TestNewConnOptionsTimeout(t *testing.T) {
+	start := time.Now()
+	_, err := xgb.NewConnOptions(context.Background(), xgb.Options{
+		Display:          ":0",
+		Dial:             silentDial,
+		AuthName:         "MIT-MAGIC-COOKIE-1",
+		AuthData:         make([]byte, 16),
+		HandshakeTimeout: 50 * time.Millisecond,
+	})
+	if err != context.DeadlineExceeded {
+		t.Fatalf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if d := time.Since(start); d > 5*time.Second {
+		t.Errorf("took %s to time out", d)
+	}
+}
+
+func TestNewConnOptionsCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	_, err := xgb.NewConnOptions(ctx, xgb.Options{
+		Display: ":0",
+		Dial:    silentDial,
+		Logger:  log.New(io.Discard, "", 0),
+	})
+	if err != context.Canceled {
+		t.Fatalf("got error %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestDialDisplay(t *testing.T) {
+	dir := t.TempDir()
+	socket := filepath.Join(dir, "x.sock")
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	local := []string{"unix /tmp/.X11-unix/X1"}
+	if runtime.GOOS == "linux" {
+		local = []string{"unix @/tmp/.X11-unix/X1", "unix /tmp/.X11-unix/X1"}
+	}
+	tests := []struct {
+		display string
+		dialed  []string
+		screen  int
+	}{
+		{":1", local, 0},
+		{"unix:1.2", local, 2},
+		{"unix/:1", local, 0},
+		{"/tmp/launch-12/:0", []string{"unix /tmp/launch-12/:0"}, 0},
+		{socket, []string{"unix " + socket}, 0},
+		{socket + ".3", []string{"unix " + socket}, 3},
+		{"hostname:2.1", []string{"tcp hostname:6002"}, 1},
+		{"tcp/hostname:1.0", []string{"tcp hostname:6001"}, 0},
+	}
+	for _, test := range tests {
+		var dialed []string
+		var screen int
+		s := xgbtest.NewServer()
+		X, err := xgb.NewConnOptions(context.Background(), xgb.Options{
+			Display: test.display,
+			Dial: func(ctx context.Context, n, a string) (net.Conn, error) {
+				dialed = append(dialed, n+" "+a)
+				if len(dialed) < len(test.dialed) {
+					return nil, errors.New("connection refused")
+				}
+				return s.Dial(ctx, n, a)
+			},
+			AuthName: "MIT-MAGIC-COOKIE-1",
+			AuthData: make([]byte, 16),
+			Logger:   log.New(io.Discard, "", 0),
+		})
+		if err == nil {
+			screen = X.DefaultScreen
+			X.Close()
+		}
+		s.Close()
+
+		if err != nil {
+			t.Errorf("%q: %s", test.display, err)
+		} else if !reflect.DeepEqual(dialed, test.dialed) ||
+			screen != test.screen {
+
+			t.Errorf("%q: dialed %q with screen %d, want %q with screen %d",
+				test.display, dialed, screen, test.dialed, test.screen)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package xgb
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// ByteOrder is the byte order that numbers are sent in over a connection
+// to the X server, in both directions. It is the byte that starts the setup
+// request.
+//
+// Each connection has its own byte order, which the generated code passes
+// along to the functions that read and write values from and to the wire.
+// The Get.. and Put.. methods of a ByteOrder are what they use in the end.
+type ByteOrder byte
+
+const (
+	// LSBFirst is the little-endian byte order. It is the default.
+	LSBFirst ByteOrder = 'l'
+
+	// MSBFirst is the big-endian byte order.
+	MSBFirst ByteOrder = 'B'
+)
+
+// String returns the name the X protocol gives to the byte order.
+func (o ByteOrder) String() string {
+	switch o {
+	case LSBFirst:
+		return "LSBFirst"
+	case MSBFirst:
+		return "MSBFirst"
+	}
+	return Sprintf("ByteOrder(%d)", byte(o))
+}
+
+// checkByteOrder returns an error if 'order' is neither LSBFirst nor
+// MSBFirst.
+func checkByteOrder(order ByteOrder) error {
+	if order != LSBFirst && order != MSBFirst {
+		return errors.New("xgb: bad byte order " + order.String())
+	}
+	return nil
+}
+
+// Put16 takes a 16 bit integer and copies it into a byte slice, in this
+// byte order.
+func (o ByteOrder) Put16(buf []byte, v uint16) {
+	if o == MSBFirst {
+		binary.BigEndian.PutUint16(buf, v)
+		return
+	}
+	Put16(buf, v)
+}
+
+// Put32 takes a 32 bit integer and copies it into a byte slice, in this
+// byte order.
+func (o ByteOrder) Put32(buf []byte, v uint32) {
+	if o == MSBFirst {
+		binary.BigEndian.PutUint32(buf, v)
+		return
+	}
+	Put32(buf, v)
+}
+
+// Put64 takes a 64 bit integer and copies it into a byte slice, in this
+// byte order.
+func (o ByteOrder) Put64(buf []byte, v uint64) {
+	if o == MSBFirst {
+		binary.BigEndian.PutUint64(buf, v)
+		return
+	}
+	Put64(buf, v)
+}
+
+// Get16 constructs a 16 bit integer from the beginning of a byte slice, in
+// this byte order.
+func (o ByteOrder) Get16(buf []byte) uint16 {
+	if o == MSBFirst {
+		return binary.BigEndian.Uint16(buf)
+	}
+	return Get16(buf)
+}
+
+// Get32 constructs a 32 bit integer from the beginning of a byte slice, in
+// this byte order.
+func (o ByteOrder) Get32(buf []byte) uint32 {
+	if o == MSBFirst {
+		return binary.BigEndian.Uint32(buf)
+	}
+	return Get32(buf)
+}
+
+// Get64 constructs a 64 bit integer from the beginning of a byte slice, in
+// this byte order.
+func (o ByteOrder) Get64(buf []byte) uint64 {
+	if o == MSBFirst {
+		return binary.BigEndian.Uint64(buf)
+	}
+	return Get64(buf)
+}
+
+// ByteOrder returns the byte order of the connection. (See Options.)
+func (c *Conn) ByteOrder() ByteOrder {
+	return c.order
+}
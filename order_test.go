@@ -0,0 +1,158 @@
+package xgb_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xgbtest"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// geometryServer returns a fake X server that answers GetGeometry with the
+// same geometry in whatever byte order its client uses, and keeps the last
+// GetGeometry request in 'request'.
+func geometryServer(request *[]byte) *xgbtest.Server {
+	s := xgbtest.NewServer()
+	s.HandleFunc(14, 0, func(req *xgbtest.Request) []xgbtest.Response {
+		*request = req.Bytes
+		reply := make(xgbtest.Reply, 24)
+		reply[1] = 24 // depth
+		req.ByteOrder.Put32(reply[8:], 0x123)
+		req.ByteOrder.Put16(reply[12:], 0xfffe)
+		req.ByteOrder.Put16(reply[14:], 6)
+		req.ByteOrder.Put16(reply[16:], 256)
+		req.ByteOrder.Put16(reply[18:], 512)
+		req.ByteOrder.Put16(reply[20:], 1)
+		return []xgbtest.Response{reply}
+	})
+	return s
+}
+
+// checkGeometry checks that GetGeometry is sent as 'want' and that its reply
+// from a geometryServer is read properly.
+func checkGeometry(t *testing.T, X *xgb.Conn, request *[]byte, want []byte) {
+	geom, err := xproto.GetGeometry(X, 0x01020304).Reply()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(*request, want) {
+		t.Errorf("%s: sent % x, want % x", X.ByteOrder(), *request, want)
+	}
+	wantGeom := xproto.GetGeometryReply{
+		Sequence: geom.Sequence, Depth: 24, Root: 0x123, X: -2, Y: 6,
+		Width: 256, Height: 512, BorderWidth: 1,
+	}
+	if *geom != wantGeom {
+		t.Errorf("%s: got %+v, want %+v", X.ByteOrder(), *geom, wantGeom)
+	}
+}
+
+// TestMSBFirst checks that a connection made with MSBFirst reads and writes
+// big-endian numbers, while a LSBFirst connection is open at the same time.
+func TestMSBFirst(t *testing.T) {
+	var msbRequest, lsbRequest []byte
+	s := geometryServer(&msbRequest)
+	defer s.Close()
+	X, err := xgb.NewConnOptions(context.Background(), xgb.Options{
+		Dial:      s.Dial,
+		Display:   ":0",
+		AuthName:  "MIT-MAGIC-COOKIE-1",
+		AuthData:  make([]byte, 16),
+		ByteOrder: xgb.MSBFirst,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer X.Close()
+	if X.ByteOrder() != xgb.MSBFirst {
+		t.Errorf("byte order %s, want %s", X.ByteOrder(), xgb.MSBFirst)
+	}
+	if order := s.ClientByteOrder(); order != xgb.MSBFirst {
+		t.Errorf("the server saw byte order %s, want %s", order,
+			xgb.MSBFirst)
+	}
+	screen := xproto.Setup(X).DefaultScreen(X)
+	if screen.WidthInPixels != 1024 || screen.HeightInPixels != 768 {
+		t.Errorf("got a %dx%d screen, want 1024x768", screen.WidthInPixels,
+			screen.HeightInPixels)
+	}
+
+	lsb := geometryServer(&lsbRequest)
+	defer lsb.Close()
+	L, err := lsb.Conn()
+	if err != nil {
+		t.Fatalf("connecting with LSBFirst while an MSBFirst connection "+
+			"is open: %s", err)
+	}
+	defer L.Close()
+
+	checkGeometry(t, X, &msbRequest, []byte{14, 0, 0, 2, 1, 2, 3, 4})
+	checkGeometry(t, L, &lsbRequest, []byte{14, 0, 2, 0, 4, 3, 2, 1})
+	checkGeometry(t, X, &msbRequest, []byte{14, 0, 0, 2, 1, 2, 3, 4})
+
+	// Events are read in the byte order of the connection too.
+	focus := xproto.FocusInEvent{Event: 0x01020304}
+	err = s.SendEvent(xgbtest.Event(focus.Bytes(xgb.MSBFirst)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev, xerr := X.WaitForEvent()
+	if ev, ok := ev.(xproto.FocusInEvent); !ok || ev.Event != focus.Event {
+		t.Errorf("got %v (error %v), want a FocusIn event for window %#x",
+			ev, xerr, focus.Event)
+	}
+}
+
+// TestBadByteOrder checks that connecting with a byte order that is neither
+// LSBFirst nor MSBFirst fails.
+func TestBadByteOrder(t *testing.T) {
+	s := xgbtest.NewServer()
+	defer s.Close()
+	s.ByteOrder = 'x'
+	if X, err := s.Conn(); err == nil {
+		X.Close()
+		t.Fatal("connected with a bad byte order")
+	}
+}
+
+// TestByteOrderSymmetric checks that values written in either byte order
+// read back the same.
+func TestByteOrderSymmetric(t *testing.T) {
+	for _, order := range []xgb.ByteOrder{xgb.LSBFirst, xgb.MSBFirst} {
+		setup := xproto.SetupInfo{
+			Status:               1,
+			ProtocolMajorVersion: 11,
+			ResourceIdBase:       0x200000,
+			ResourceIdMask:       0x1fffff,
+			MaximumRequestLength: 0xfffe,
+			Vendor:               "test",
+			VendorLen:            4,
+			PixmapFormatsLen:     1,
+			PixmapFormats: []xproto.Format{
+				{Depth: 24, BitsPerPixel: 32, ScanlinePad: 32},
+			},
+		}
+		var got xproto.SetupInfo
+		xproto.SetupInfoRead(setup.Bytes(order), order, &got)
+		if got.ResourceIdBase != setup.ResourceIdBase ||
+			got.MaximumRequestLength != setup.MaximumRequestLength ||
+			got.Vendor != setup.Vendor ||
+			len(got.PixmapFormats) != 1 ||
+			got.PixmapFormats[0] != setup.PixmapFormats[0] {
+
+			t.Errorf("%s: got %+v, want %+v", order, got, setup)
+		}
+
+		ev := xproto.ConfigureNotifyEvent{
+			Event: 1, Window: 2, AboveSibling: 3, X: -4, Y: 5,
+			Width: 600, Height: 700, BorderWidth: 8,
+		}
+		if back := xproto.ConfigureNotifyEventNew(ev.Bytes(order),
+			order); back != ev {
+
+			t.Errorf("%s: got %v, want %v", order, back, ev)
+		}
+	}
+}
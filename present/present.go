@@ -0,0 +1,1727 @@
+// Package present is the X client API for the Present extension.
+package present
+
+// This file is automatically generated from present.xml. Edit at your peril!
+
+import (
+	"context"
+
+	"github.com/BurntSushi/xgb"
+
+	"github.com/BurntSushi/xgb/ge"
+	"github.com/BurntSushi/xgb/randr"
+	"github.com/BurntSushi/xgb/sync"
+	"github.com/BurntSushi/xgb/xfixes"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// Init must be called before using the Present extension.
+func Init(c *xgb.Conn) error {
+	reply, err := xproto.QueryExtension(c, 7, "Present").Reply()
+	switch {
+	case err != nil:
+		return err
+	case !reply.Present:
+		return xgb.Errorf("No extension named Present could be found on on the server.")
+	}
+
+	if err := ge.Init(c); err != nil {
+		return err
+	}
+	if _, err := ge.QueryVersion(c, 1, 0).Reply(); err != nil {
+		return err
+	}
+
+	c.ExtLock.Lock()
+	c.Extensions["Present"] = reply.MajorOpcode
+	c.ExtLock.Unlock()
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["Present"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["Present"])
+	c.RegisterGenericEventFuncs(reply.MajorOpcode, xgb.NewExtGenericEventFuncs["Present"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "Present", xgb.ExtRequestInfos["Present"])
+	return nil
+}
+
+func init() {
+	xgb.NewExtEventFuncs["Present"] = make(map[int]xgb.NewEventFun)
+	xgb.NewExtErrorFuncs["Present"] = make(map[int]xgb.NewErrorFun)
+	xgb.NewExtGenericEventFuncs["Present"] = make(map[int]xgb.NewEventFun)
+	xgb.ExtRequestInfos["Present"] = make(map[int]xgb.RequestInfo)
+}
+
+const (
+	CapabilityNone  = 0
+	CapabilityAsync = 1
+	CapabilityFence = 2
+	CapabilityUst   = 4
+)
+
+type CompleteKind byte
+
+const (
+	CompleteKindPixmap    CompleteKind = 0
+	CompleteKindNotifyMSC CompleteKind = 1
+)
+
+// String returns the name of the CompleteKind item with this value.
+func (v CompleteKind) String() string {
+	switch v {
+	case CompleteKindPixmap:
+		return "Pixmap"
+	case CompleteKindNotifyMSC:
+		return "NotifyMSC"
+	}
+	return xgb.Sprintf("CompleteKind(%d)", byte(v))
+}
+
+type CompleteMode byte
+
+const (
+	CompleteModeCopy           CompleteMode = 0
+	CompleteModeFlip           CompleteMode = 1
+	CompleteModeSkip           CompleteMode = 2
+	CompleteModeSuboptimalCopy CompleteMode = 3
+)
+
+// String returns the name of the CompleteMode item with this value.
+func (v CompleteMode) String() string {
+	switch v {
+	case CompleteModeCopy:
+		return "Copy"
+	case CompleteModeFlip:
+		return "Flip"
+	case CompleteModeSkip:
+		return "Skip"
+	case CompleteModeSuboptimalCopy:
+		return "SuboptimalCopy"
+	}
+	return xgb.Sprintf("CompleteMode(%d)", byte(v))
+}
+
+// CompleteNotify is the event number for a CompleteNotifyEvent.
+const CompleteNotify = 1
+
+type CompleteNotifyEvent struct {
+	Sequence uint16
+	Kind     CompleteKind
+	Mode     CompleteMode
+	Event    Event
+	Window   xproto.Window
+	Serial   uint32
+	Ust      uint64
+	Msc      uint64
+}
+
+// CompleteNotifyEventNew constructs a CompleteNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func CompleteNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
+	v := CompleteNotifyEvent{}
+	b := 1 // don't read event number
+	b += 1 // don't read extension opcode
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	b += 4 // don't read length
+	b += 2 // don't read event type
+
+	v.Kind = CompleteKind(buf[b])
+	b += 1
+
+	v.Mode = CompleteMode(buf[b])
+	b += 1
+
+	v.Event = Event(order.Get32(buf[b:]))
+	b += 4
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Serial = order.Get32(buf[b:])
+	b += 4
+
+	v.Ust = order.Get64(buf[b:])
+	b += 8
+
+	v.Msc = order.Get64(buf[b:])
+	b += 8
+
+	return v
+}
+
+// Bytes writes a CompleteNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v CompleteNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
+	size := xgb.Pad(40)
+	if size < 32 {
+		size = 32
+	}
+	buf := make([]byte, size)
+	b := 0
+
+	// write event number
+	buf[b] = 35
+	b += 1
+
+	b += 1 // skip extension opcode
+	b += 2 // skip sequence number
+
+	order.Put32(buf[b:], uint32((size-32)/4)) // write length beyond 32 bytes in 4-byte units
+	b += 4
+
+	order.Put16(buf[b:], 1) // write event type
+	b += 2
+
+	buf[b] = byte(v.Kind)
+	b += 1
+
+	buf[b] = byte(v.Mode)
+	b += 1
+
+	order.Put32(buf[b:], uint32(v.Event))
+	b += 4
+
+	order.Put32(buf[b:], uint32(v.Window))
+	b += 4
+
+	order.Put32(buf[b:], v.Serial)
+	b += 4
+
+	order.Put64(buf[b:], v.Ust)
+	b += 8
+
+	order.Put64(buf[b:], v.Msc)
+	b += 8
+
+	return buf
+}
+
+// SequenceId returns the sequence id attached to the CompleteNotify event.
+// Events without a sequence number (KeymapNotify) return 0.
+// This is mostly used internally.
+func (v CompleteNotifyEvent) SequenceId() uint16 {
+	return v.Sequence
+}
+
+// String is a rudimentary string representation of CompleteNotifyEvent.
+func (v CompleteNotifyEvent) String() string {
+	fieldVals := make([]string, 0, 7)
+	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
+	fieldVals = append(fieldVals, xgb.Sprintf("Kind: %s", v.Kind))
+	fieldVals = append(fieldVals, xgb.Sprintf("Mode: %s", v.Mode))
+	fieldVals = append(fieldVals, xgb.Sprintf("Event: %d", v.Event))
+	fieldVals = append(fieldVals, xgb.Sprintf("Window: %d", v.Window))
+	fieldVals = append(fieldVals, xgb.Sprintf("Serial: %d", v.Serial))
+	fieldVals = append(fieldVals, xgb.Sprintf("Ust: %d", v.Ust))
+	fieldVals = append(fieldVals, xgb.Sprintf("Msc: %d", v.Msc))
+	return "CompleteNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
+}
+
+// EventWindow returns the window that the CompleteNotify event is about.
+// It is used to subscribe to the events of a single window.
+func (v CompleteNotifyEvent) EventWindow() uint32 {
+	return uint32(v.Window)
+}
+
+func init() {
+	xgb.NewExtGenericEventFuncs["Present"][1] = CompleteNotifyEventNew
+}
+
+// ConfigureNotify is the event number for a ConfigureNotifyEvent.
+const ConfigureNotify = 0
+
+type ConfigureNotifyEvent struct {
+	Sequence uint16
+	// padding: 2 bytes
+	Event        Event
+	Window       xproto.Window
+	X            int16
+	Y            int16
+	Width        uint16
+	Height       uint16
+	OffX         int16
+	OffY         int16
+	PixmapWidth  uint16
+	PixmapHeight uint16
+	PixmapFlags  uint32
+}
+
+// ConfigureNotifyEventNew constructs a ConfigureNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func ConfigureNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
+	v := ConfigureNotifyEvent{}
+	b := 1 // don't read event number
+	b += 1 // don't read extension opcode
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	b += 4 // don't read length
+	b += 2 // don't read event type
+
+	b += 2 // padding
+
+	v.Event = Event(order.Get32(buf[b:]))
+	b += 4
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.X = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Y = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	v.OffX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.OffY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.PixmapWidth = order.Get16(buf[b:])
+	b += 2
+
+	v.PixmapHeight = order.Get16(buf[b:])
+	b += 2
+
+	v.PixmapFlags = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+// Bytes writes a ConfigureNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v ConfigureNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
+	size := xgb.Pad(40)
+	if size < 32 {
+		size = 32
+	}
+	buf := make([]byte, size)
+	b := 0
+
+	// write event number
+	buf[b] = 35
+	b += 1
+
+	b += 1 // skip extension opcode
+	b += 2 // skip sequence number
+
+	order.Put32(buf[b:], uint32((size-32)/4)) // write length beyond 32 bytes in 4-byte units
+	b += 4
+
+	order.Put16(buf[b:], 0) // write event type
+	b += 2
+
+	b += 2 // padding
+
+	order.Put32(buf[b:], uint32(v.Event))
+	b += 4
+
+	order.Put32(buf[b:], uint32(v.Window))
+	b += 4
+
+	order.Put16(buf[b:], uint16(v.X))
+	b += 2
+
+	order.Put16(buf[b:], uint16(v.Y))
+	b += 2
+
+	order.Put16(buf[b:], v.Width)
+	b += 2
+
+	order.Put16(buf[b:], v.Height)
+	b += 2
+
+	order.Put16(buf[b:], uint16(v.OffX))
+	b += 2
+
+	order.Put16(buf[b:], uint16(v.OffY))
+	b += 2
+
+	order.Put16(buf[b:], v.PixmapWidth)
+	b += 2
+
+	order.Put16(buf[b:], v.PixmapHeight)
+	b += 2
+
+	order.Put32(buf[b:], v.PixmapFlags)
+	b += 4
+
+	return buf
+}
+
+// SequenceId returns the sequence id attached to the ConfigureNotify event.
+// Events without a sequence number (KeymapNotify) return 0.
+// This is mostly used internally.
+func (v ConfigureNotifyEvent) SequenceId() uint16 {
+	return v.Sequence
+}
+
+// String is a rudimentary string representation of ConfigureNotifyEvent.
+func (v ConfigureNotifyEvent) String() string {
+	fieldVals := make([]string, 0, 12)
+	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
+	fieldVals = append(fieldVals, xgb.Sprintf("Event: %d", v.Event))
+	fieldVals = append(fieldVals, xgb.Sprintf("Window: %d", v.Window))
+	fieldVals = append(fieldVals, xgb.Sprintf("X: %d", v.X))
+	fieldVals = append(fieldVals, xgb.Sprintf("Y: %d", v.Y))
+	fieldVals = append(fieldVals, xgb.Sprintf("Width: %d", v.Width))
+	fieldVals = append(fieldVals, xgb.Sprintf("Height: %d", v.Height))
+	fieldVals = append(fieldVals, xgb.Sprintf("OffX: %d", v.OffX))
+	fieldVals = append(fieldVals, xgb.Sprintf("OffY: %d", v.OffY))
+	fieldVals = append(fieldVals, xgb.Sprintf("PixmapWidth: %d", v.PixmapWidth))
+	fieldVals = append(fieldVals, xgb.Sprintf("PixmapHeight: %d", v.PixmapHeight))
+	fieldVals = append(fieldVals, xgb.Sprintf("PixmapFlags: %d", v.PixmapFlags))
+	return "ConfigureNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
+}
+
+// EventWindow returns the window that the ConfigureNotify event is about.
+// It is used to subscribe to the events of a single window.
+func (v ConfigureNotifyEvent) EventWindow() uint32 {
+	return uint32(v.Window)
+}
+
+func init() {
+	xgb.NewExtGenericEventFuncs["Present"][0] = ConfigureNotifyEventNew
+}
+
+type Event uint32
+
+func NewEventId(c *xgb.Conn) (Event, error) {
+	id, err := c.NewId()
+	if err != nil {
+		return 0, err
+	}
+	return Event(id), nil
+}
+
+const (
+	EventConfigureNotify = 0
+	EventCompleteNotify  = 1
+	EventIdleNotify      = 2
+	EventRedirectNotify  = 3
+)
+
+type EventMask uint32
+
+const (
+	EventMaskNoEvent         EventMask = 0
+	EventMaskConfigureNotify EventMask = 1
+	EventMaskCompleteNotify  EventMask = 2
+	EventMaskIdleNotify      EventMask = 4
+	EventMaskRedirectNotify  EventMask = 8
+)
+
+// String returns the names of the EventMask bits set in a value.
+func (v EventMask) String() string {
+	if v == 0 {
+		return "NoEvent"
+	}
+	names := make([]string, 0, 4)
+	if v&EventMaskConfigureNotify != 0 {
+		names = append(names, "ConfigureNotify")
+		v &^= EventMaskConfigureNotify
+	}
+	if v&EventMaskCompleteNotify != 0 {
+		names = append(names, "CompleteNotify")
+		v &^= EventMaskCompleteNotify
+	}
+	if v&EventMaskIdleNotify != 0 {
+		names = append(names, "IdleNotify")
+		v &^= EventMaskIdleNotify
+	}
+	if v&EventMaskRedirectNotify != 0 {
+		names = append(names, "RedirectNotify")
+		v &^= EventMaskRedirectNotify
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint32(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
+// Generic is the event number for a GenericEvent.
+const Generic = 0
+
+type GenericEvent struct {
+	Sequence  uint16
+	Extension byte
+	Length    uint32
+	Evtype    uint16
+	// padding: 2 bytes
+	Event Event
+}
+
+// GenericEventNew constructs a GenericEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func GenericEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
+	v := GenericEvent{}
+	b := 1 // don't read event number
+
+	v.Extension = buf[b]
+	b += 1
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	v.Length = order.Get32(buf[b:])
+	b += 4
+
+	v.Evtype = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	v.Event = Event(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+// Bytes writes a GenericEvent value to a byte slice, in the byte order 'order'.
+func (v GenericEvent) Bytes(order xgb.ByteOrder) []byte {
+	buf := make([]byte, 32)
+	b := 0
+
+	// write event number
+	buf[b] = 0
+	b += 1
+
+	buf[b] = v.Extension
+	b += 1
+
+	b += 2 // skip sequence number
+
+	order.Put32(buf[b:], v.Length)
+	b += 4
+
+	order.Put16(buf[b:], v.Evtype)
+	b += 2
+
+	b += 2 // padding
+
+	order.Put32(buf[b:], uint32(v.Event))
+	b += 4
+
+	return buf
+}
+
+// SequenceId returns the sequence id attached to the Generic event.
+// Events without a sequence number (KeymapNotify) return 0.
+// This is mostly used internally.
+func (v GenericEvent) SequenceId() uint16 {
+	return v.Sequence
+}
+
+// String is a rudimentary string representation of GenericEvent.
+func (v GenericEvent) String() string {
+	fieldVals := make([]string, 0, 5)
+	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
+	fieldVals = append(fieldVals, xgb.Sprintf("Extension: %d", v.Extension))
+	fieldVals = append(fieldVals, xgb.Sprintf("Length: %d", v.Length))
+	fieldVals = append(fieldVals, xgb.Sprintf("Evtype: %d", v.Evtype))
+	fieldVals = append(fieldVals, xgb.Sprintf("Event: %d", v.Event))
+	return "Generic {" + xgb.StringsJoin(fieldVals, ", ") + "}"
+}
+
+func init() {
+	xgb.NewExtEventFuncs["Present"][0] = GenericEventNew
+}
+
+// IdleNotify is the event number for a IdleNotifyEvent.
+const IdleNotify = 2
+
+type IdleNotifyEvent struct {
+	Sequence uint16
+	// padding: 2 bytes
+	Event     Event
+	Window    xproto.Window
+	Serial    uint32
+	Pixmap    xproto.Pixmap
+	IdleFence sync.Fence
+}
+
+// IdleNotifyEventNew constructs a IdleNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func IdleNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
+	v := IdleNotifyEvent{}
+	b := 1 // don't read event number
+	b += 1 // don't read extension opcode
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	b += 4 // don't read length
+	b += 2 // don't read event type
+
+	b += 2 // padding
+
+	v.Event = Event(order.Get32(buf[b:]))
+	b += 4
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Serial = order.Get32(buf[b:])
+	b += 4
+
+	v.Pixmap = xproto.Pixmap(order.Get32(buf[b:]))
+	b += 4
+
+	v.IdleFence = sync.Fence(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+// Bytes writes a IdleNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v IdleNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
+	size := xgb.Pad(32)
+	if size < 32 {
+		size = 32
+	}
+	buf := make([]byte, size)
+	b := 0
+
+	// write event number
+	buf[b] = 35
+	b += 1
+
+	b += 1 // skip extension opcode
+	b += 2 // skip sequence number
+
+	order.Put32(buf[b:], uint32((size-32)/4)) // write length beyond 32 bytes in 4-byte units
+	b += 4
+
+	order.Put16(buf[b:], 2) // write event type
+	b += 2
+
+	b += 2 // padding
+
+	order.Put32(buf[b:], uint32(v.Event))
+	b += 4
+
+	order.Put32(buf[b:], uint32(v.Window))
+	b += 4
+
+	order.Put32(buf[b:], v.Serial)
+	b += 4
+
+	order.Put32(buf[b:], uint32(v.Pixmap))
+	b += 4
+
+	order.Put32(buf[b:], uint32(v.IdleFence))
+	b += 4
+
+	return buf
+}
+
+// SequenceId returns the sequence id attached to the IdleNotify event.
+// Events without a sequence number (KeymapNotify) return 0.
+// This is mostly used internally.
+func (v IdleNotifyEvent) SequenceId() uint16 {
+	return v.Sequence
+}
+
+// String is a rudimentary string representation of IdleNotifyEvent.
+func (v IdleNotifyEvent) String() string {
+	fieldVals := make([]string, 0, 6)
+	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
+	fieldVals = append(fieldVals, xgb.Sprintf("Event: %d", v.Event))
+	fieldVals = append(fieldVals, xgb.Sprintf("Window: %d", v.Window))
+	fieldVals = append(fieldVals, xgb.Sprintf("Serial: %d", v.Serial))
+	fieldVals = append(fieldVals, xgb.Sprintf("Pixmap: %d", v.Pixmap))
+	fieldVals = append(fieldVals, xgb.Sprintf("IdleFence: %d", v.IdleFence))
+	return "IdleNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
+}
+
+// EventWindow returns the window that the IdleNotify event is about.
+// It is used to subscribe to the events of a single window.
+func (v IdleNotifyEvent) EventWindow() uint32 {
+	return uint32(v.Window)
+}
+
+func init() {
+	xgb.NewExtGenericEventFuncs["Present"][2] = IdleNotifyEventNew
+}
+
+type Notify struct {
+	Window xproto.Window
+	Serial uint32
+}
+
+// NotifyRead reads a byte slice into a Notify value.
+func NotifyRead(buf []byte, order xgb.ByteOrder, v *Notify) int {
+	b := 0
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Serial = order.Get32(buf[b:])
+	b += 4
+
+	return b
+}
+
+// NotifyReadList reads a byte slice into a list of Notify values.
+func NotifyReadList(buf []byte, order xgb.ByteOrder, dest []Notify) int {
+	b := 0
+	for i := 0; i < len(dest); i++ {
+		dest[i] = Notify{}
+		b += NotifyRead(buf[b:], order, &dest[i])
+	}
+	return xgb.Pad(b)
+}
+
+// Bytes writes a Notify value to a byte slice, in the byte order 'order'.
+func (v Notify) Bytes(order xgb.ByteOrder) []byte {
+	buf := make([]byte, 8)
+	b := 0
+
+	order.Put32(buf[b:], uint32(v.Window))
+	b += 4
+
+	order.Put32(buf[b:], v.Serial)
+	b += 4
+
+	return buf[:b]
+}
+
+// NotifyListBytes writes a list of Notify values to a byte slice.
+func NotifyListBytes(buf []byte, order xgb.ByteOrder, list []Notify) int {
+	b := 0
+	var structBytes []byte
+	for _, item := range list {
+		structBytes = item.Bytes(order)
+		copy(buf[b:], structBytes)
+		b += len(structBytes)
+	}
+	return xgb.Pad(b)
+}
+
+const (
+	OptionNone       = 0
+	OptionAsync      = 1
+	OptionCopy       = 2
+	OptionUst        = 4
+	OptionSuboptimal = 8
+)
+
+// RedirectNotify is the event number for a RedirectNotifyEvent.
+const RedirectNotify = 3
+
+type RedirectNotifyEvent struct {
+	Sequence     uint16
+	UpdateWindow bool
+	// padding: 1 bytes
+	Event        Event
+	EventWindow  xproto.Window
+	Window       xproto.Window
+	Pixmap       xproto.Pixmap
+	Serial       uint32
+	ValidRegion  xfixes.Region
+	UpdateRegion xfixes.Region
+	ValidRect    xproto.Rectangle
+	UpdateRect   xproto.Rectangle
+	XOff         int16
+	YOff         int16
+	TargetCrtc   randr.Crtc
+	WaitFence    sync.Fence
+	IdleFence    sync.Fence
+	Options      uint32
+	// padding: 4 bytes
+	TargetMsc uint64
+	Divisor   uint64
+	Remainder uint64
+	Notifies  []Notify // size: xgb.Pad((len(Notifies) * 8))
+}
+
+// RedirectNotifyEventNew constructs a RedirectNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func RedirectNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
+	v := RedirectNotifyEvent{}
+	b := 1 // don't read event number
+	b += 1 // don't read extension opcode
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	b += 4 // don't read length
+	b += 2 // don't read event type
+
+	if buf[b] == 1 {
+		v.UpdateWindow = true
+	} else {
+		v.UpdateWindow = false
+	}
+	b += 1
+
+	b += 1 // padding
+
+	v.Event = Event(order.Get32(buf[b:]))
+	b += 4
+
+	v.EventWindow = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Pixmap = xproto.Pixmap(order.Get32(buf[b:]))
+	b += 4
+
+	v.Serial = order.Get32(buf[b:])
+	b += 4
+
+	v.ValidRegion = xfixes.Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.UpdateRegion = xfixes.Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.ValidRect = xproto.Rectangle{}
+	b += xproto.RectangleRead(buf[b:], order, &v.ValidRect)
+
+	v.UpdateRect = xproto.Rectangle{}
+	b += xproto.RectangleRead(buf[b:], order, &v.UpdateRect)
+
+	v.XOff = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.YOff = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.TargetCrtc = randr.Crtc(order.Get32(buf[b:]))
+	b += 4
+
+	v.WaitFence = sync.Fence(order.Get32(buf[b:]))
+	b += 4
+
+	v.IdleFence = sync.Fence(order.Get32(buf[b:]))
+	b += 4
+
+	v.Options = order.Get32(buf[b:])
+	b += 4
+
+	b += 4 // padding
+
+	v.TargetMsc = order.Get64(buf[b:])
+	b += 8
+
+	v.Divisor = order.Get64(buf[b:])
+	b += 8
+
+	v.Remainder = order.Get64(buf[b:])
+	b += 8
+
+	v.Notifies = make([]Notify, (len(buf)-b)/8)
+	b += NotifyReadList(buf[b:], order, v.Notifies)
+
+	return v
+}
+
+// Bytes writes a RedirectNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v RedirectNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
+	size := xgb.Pad((104 + xgb.Pad((len(v.Notifies) * 8))))
+	if size < 32 {
+		size = 32
+	}
+	buf := make([]byte, size)
+	b := 0
+
+	// write event number
+	buf[b] = 35
+	b += 1
+
+	b += 1 // skip extension opcode
+	b += 2 // skip sequence number
+
+	order.Put32(buf[b:], uint32((size-32)/4)) // write length beyond 32 bytes in 4-byte units
+	b += 4
+
+	order.Put16(buf[b:], 3) // write event type
+	b += 2
+
+	if v.UpdateWindow {
+		buf[b] = 1
+	} else {
+		buf[b] = 0
+	}
+	b += 1
+
+	b += 1 // padding
+
+	order.Put32(buf[b:], uint32(v.Event))
+	b += 4
+
+	order.Put32(buf[b:], uint32(v.EventWindow))
+	b += 4
+
+	order.Put32(buf[b:], uint32(v.Window))
+	b += 4
+
+	order.Put32(buf[b:], uint32(v.Pixmap))
+	b += 4
+
+	order.Put32(buf[b:], v.Serial)
+	b += 4
+
+	order.Put32(buf[b:], uint32(v.ValidRegion))
+	b += 4
+
+	order.Put32(buf[b:], uint32(v.UpdateRegion))
+	b += 4
+
+	{
+		structBytes := v.ValidRect.Bytes(order)
+		copy(buf[b:], structBytes)
+		b += len(structBytes)
+	}
+
+	{
+		structBytes := v.UpdateRect.Bytes(order)
+		copy(buf[b:], structBytes)
+		b += len(structBytes)
+	}
+
+	order.Put16(buf[b:], uint16(v.XOff))
+	b += 2
+
+	order.Put16(buf[b:], uint16(v.YOff))
+	b += 2
+
+	order.Put32(buf[b:], uint32(v.TargetCrtc))
+	b += 4
+
+	order.Put32(buf[b:], uint32(v.WaitFence))
+	b += 4
+
+	order.Put32(buf[b:], uint32(v.IdleFence))
+	b += 4
+
+	order.Put32(buf[b:], v.Options)
+	b += 4
+
+	b += 4 // padding
+
+	order.Put64(buf[b:], v.TargetMsc)
+	b += 8
+
+	order.Put64(buf[b:], v.Divisor)
+	b += 8
+
+	order.Put64(buf[b:], v.Remainder)
+	b += 8
+
+	b += NotifyListBytes(buf[b:], order, v.Notifies)
+
+	return buf
+}
+
+// SequenceId returns the sequence id attached to the RedirectNotify event.
+// Events without a sequence number (KeymapNotify) return 0.
+// This is mostly used internally.
+func (v RedirectNotifyEvent) SequenceId() uint16 {
+	return v.Sequence
+}
+
+// String is a rudimentary string representation of RedirectNotifyEvent.
+func (v RedirectNotifyEvent) String() string {
+	fieldVals := make([]string, 0, 22)
+	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
+	fieldVals = append(fieldVals, xgb.Sprintf("UpdateWindow: %t", v.UpdateWindow))
+	fieldVals = append(fieldVals, xgb.Sprintf("Event: %d", v.Event))
+	fieldVals = append(fieldVals, xgb.Sprintf("EventWindow: %d", v.EventWindow))
+	fieldVals = append(fieldVals, xgb.Sprintf("Window: %d", v.Window))
+	fieldVals = append(fieldVals, xgb.Sprintf("Pixmap: %d", v.Pixmap))
+	fieldVals = append(fieldVals, xgb.Sprintf("Serial: %d", v.Serial))
+	fieldVals = append(fieldVals, xgb.Sprintf("ValidRegion: %d", v.ValidRegion))
+	fieldVals = append(fieldVals, xgb.Sprintf("UpdateRegion: %d", v.UpdateRegion))
+	fieldVals = append(fieldVals, xgb.Sprintf("XOff: %d", v.XOff))
+	fieldVals = append(fieldVals, xgb.Sprintf("YOff: %d", v.YOff))
+	fieldVals = append(fieldVals, xgb.Sprintf("TargetCrtc: %d", v.TargetCrtc))
+	fieldVals = append(fieldVals, xgb.Sprintf("WaitFence: %d", v.WaitFence))
+	fieldVals = append(fieldVals, xgb.Sprintf("IdleFence: %d", v.IdleFence))
+	fieldVals = append(fieldVals, xgb.Sprintf("Options: %d", v.Options))
+	fieldVals = append(fieldVals, xgb.Sprintf("TargetMsc: %d", v.TargetMsc))
+	fieldVals = append(fieldVals, xgb.Sprintf("Divisor: %d", v.Divisor))
+	fieldVals = append(fieldVals, xgb.Sprintf("Remainder: %d", v.Remainder))
+	return "RedirectNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
+}
+
+func init() {
+	xgb.NewExtGenericEventFuncs["Present"][3] = RedirectNotifyEventNew
+}
+
+// Skipping definition for base type 'Bool'
+
+// Skipping definition for base type 'Byte'
+
+// Skipping definition for base type 'Card8'
+
+// Skipping definition for base type 'Char'
+
+// Skipping definition for base type 'Void'
+
+// Skipping definition for base type 'Double'
+
+// Skipping definition for base type 'Float'
+
+// Skipping definition for base type 'Int16'
+
+// Skipping definition for base type 'Int32'
+
+// Skipping definition for base type 'Int8'
+
+// Skipping definition for base type 'Card16'
+
+// Skipping definition for base type 'Card32'
+
+// Skipping definition for base type 'Card64'
+
+// NotifyMSCCookie is a cookie used only for NotifyMSC requests.
+type NotifyMSCCookie struct {
+	*xgb.Cookie
+}
+
+// NotifyMSC sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func NotifyMSC(c *xgb.Conn, Window xproto.Window, Serial uint32, TargetMsc uint64, Divisor uint64, Remainder uint64) NotifyMSCCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["Present"]; !ok {
+		panic("Cannot issue request 'NotifyMSC' using the uninitialized extension 'Present'. present.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequest(notifyMSCRequest(c, Window, Serial, TargetMsc, Divisor, Remainder), cookie)
+	return NotifyMSCCookie{cookie}
+}
+
+// NotifyMSCChecked sends a checked request.
+// If an error occurs, it can be retrieved using NotifyMSCCookie.Check()
+func NotifyMSCChecked(c *xgb.Conn, Window xproto.Window, Serial uint32, TargetMsc uint64, Divisor uint64, Remainder uint64) NotifyMSCCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["Present"]; !ok {
+		panic("Cannot issue request 'NotifyMSC' using the uninitialized extension 'Present'. present.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, false)
+	c.NewRequest(notifyMSCRequest(c, Window, Serial, TargetMsc, Divisor, Remainder), cookie)
+	return NotifyMSCCookie{cookie}
+}
+
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook NotifyMSCCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook NotifyMSCCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for NotifyMSC
+// notifyMSCRequest writes a NotifyMSC request to a byte slice.
+func notifyMSCRequest(c *xgb.Conn, Window xproto.Window, Serial uint32, TargetMsc uint64, Divisor uint64, Remainder uint64) []byte {
+	return AppendNotifyMSCRequest(nil, c, Window, Serial, TargetMsc, Divisor, Remainder)
+}
+
+// AppendNotifyMSCRequest appends a NotifyMSC request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendNotifyMSCRequest(buf []byte, c *xgb.Conn, Window xproto.Window, Serial uint32, TargetMsc uint64, Divisor uint64, Remainder uint64) []byte {
+	size := 40
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["Present"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 2 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Window))
+	b += 4
+
+	order.Put32(buf[b:], Serial)
+	b += 4
+
+	b += 4 // padding
+
+	order.Put64(buf[b:], TargetMsc)
+	b += 8
+
+	order.Put64(buf[b:], Divisor)
+	b += 8
+
+	order.Put64(buf[b:], Remainder)
+	b += 8
+
+	return buf
+}
+
+// NotifyMSCRequest represents the data of a NotifyMSC request, as it is read when tracing a connection.
+type NotifyMSCRequest struct {
+	Window xproto.Window
+	Serial uint32
+	// padding: 4 bytes
+	TargetMsc uint64
+	Divisor   uint64
+	Remainder uint64
+}
+
+// notifyMSCRequestRead reads a byte slice in the byte order 'order' into a NotifyMSCRequest value.
+func notifyMSCRequestRead(buf []byte, order xgb.ByteOrder) *NotifyMSCRequest {
+	v := new(NotifyMSCRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Serial = order.Get32(buf[b:])
+	b += 4
+
+	b += 4 // padding
+
+	v.TargetMsc = order.Get64(buf[b:])
+	b += 8
+
+	v.Divisor = order.Get64(buf[b:])
+	b += 8
+
+	v.Remainder = order.Get64(buf[b:])
+	b += 8
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["Present"][2] = xgb.RequestInfo{
+		Name: "NotifyMSC",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return notifyMSCRequestRead(buf, order)
+		},
+	}
+}
+
+// PixmapCookie is a cookie used only for Pixmap requests.
+type PixmapCookie struct {
+	*xgb.Cookie
+}
+
+// Pixmap sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func Pixmap(c *xgb.Conn, Window xproto.Window, Pixmap xproto.Pixmap, Serial uint32, Valid xfixes.Region, Update xfixes.Region, XOff int16, YOff int16, TargetCrtc randr.Crtc, WaitFence sync.Fence, IdleFence sync.Fence, Options uint32, TargetMsc uint64, Divisor uint64, Remainder uint64, Notifies []Notify) PixmapCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["Present"]; !ok {
+		panic("Cannot issue request 'Pixmap' using the uninitialized extension 'Present'. present.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequest(pixmapRequest(c, Window, Pixmap, Serial, Valid, Update, XOff, YOff, TargetCrtc, WaitFence, IdleFence, Options, TargetMsc, Divisor, Remainder, Notifies), cookie)
+	return PixmapCookie{cookie}
+}
+
+// PixmapChecked sends a checked request.
+// If an error occurs, it can be retrieved using PixmapCookie.Check()
+func PixmapChecked(c *xgb.Conn, Window xproto.Window, Pixmap xproto.Pixmap, Serial uint32, Valid xfixes.Region, Update xfixes.Region, XOff int16, YOff int16, TargetCrtc randr.Crtc, WaitFence sync.Fence, IdleFence sync.Fence, Options uint32, TargetMsc uint64, Divisor uint64, Remainder uint64, Notifies []Notify) PixmapCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["Present"]; !ok {
+		panic("Cannot issue request 'Pixmap' using the uninitialized extension 'Present'. present.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, false)
+	c.NewRequest(pixmapRequest(c, Window, Pixmap, Serial, Valid, Update, XOff, YOff, TargetCrtc, WaitFence, IdleFence, Options, TargetMsc, Divisor, Remainder, Notifies), cookie)
+	return PixmapCookie{cookie}
+}
+
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook PixmapCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PixmapCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for Pixmap
+// pixmapRequest writes a Pixmap request to a byte slice.
+func pixmapRequest(c *xgb.Conn, Window xproto.Window, Pixmap xproto.Pixmap, Serial uint32, Valid xfixes.Region, Update xfixes.Region, XOff int16, YOff int16, TargetCrtc randr.Crtc, WaitFence sync.Fence, IdleFence sync.Fence, Options uint32, TargetMsc uint64, Divisor uint64, Remainder uint64, Notifies []Notify) []byte {
+	return AppendPixmapRequest(nil, c, Window, Pixmap, Serial, Valid, Update, XOff, YOff, TargetCrtc, WaitFence, IdleFence, Options, TargetMsc, Divisor, Remainder, Notifies)
+}
+
+// AppendPixmapRequest appends a Pixmap request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPixmapRequest(buf []byte, c *xgb.Conn, Window xproto.Window, Pixmap xproto.Pixmap, Serial uint32, Valid xfixes.Region, Update xfixes.Region, XOff int16, YOff int16, TargetCrtc randr.Crtc, WaitFence sync.Fence, IdleFence sync.Fence, Options uint32, TargetMsc uint64, Divisor uint64, Remainder uint64, Notifies []Notify) []byte {
+	size := xgb.Pad((72 + xgb.Pad((len(Notifies) * 8))))
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["Present"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 1 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Window))
+	b += 4
+
+	order.Put32(buf[b:], uint32(Pixmap))
+	b += 4
+
+	order.Put32(buf[b:], Serial)
+	b += 4
+
+	order.Put32(buf[b:], uint32(Valid))
+	b += 4
+
+	order.Put32(buf[b:], uint32(Update))
+	b += 4
+
+	order.Put16(buf[b:], uint16(XOff))
+	b += 2
+
+	order.Put16(buf[b:], uint16(YOff))
+	b += 2
+
+	order.Put32(buf[b:], uint32(TargetCrtc))
+	b += 4
+
+	order.Put32(buf[b:], uint32(WaitFence))
+	b += 4
+
+	order.Put32(buf[b:], uint32(IdleFence))
+	b += 4
+
+	order.Put32(buf[b:], Options)
+	b += 4
+
+	b += 4 // padding
+
+	order.Put64(buf[b:], TargetMsc)
+	b += 8
+
+	order.Put64(buf[b:], Divisor)
+	b += 8
+
+	order.Put64(buf[b:], Remainder)
+	b += 8
+
+	b += NotifyListBytes(buf[b:], order, Notifies)
+
+	return buf
+}
+
+// PixmapRequest represents the data of a Pixmap request, as it is read when tracing a connection.
+type PixmapRequest struct {
+	Window     xproto.Window
+	Pixmap     xproto.Pixmap
+	Serial     uint32
+	Valid      xfixes.Region
+	Update     xfixes.Region
+	XOff       int16
+	YOff       int16
+	TargetCrtc randr.Crtc
+	WaitFence  sync.Fence
+	IdleFence  sync.Fence
+	Options    uint32
+	// padding: 4 bytes
+	TargetMsc uint64
+	Divisor   uint64
+	Remainder uint64
+	Notifies  []Notify // size: xgb.Pad((len(Notifies) * 8))
+}
+
+// pixmapRequestRead reads a byte slice in the byte order 'order' into a PixmapRequest value.
+func pixmapRequestRead(buf []byte, order xgb.ByteOrder) *PixmapRequest {
+	v := new(PixmapRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Pixmap = xproto.Pixmap(order.Get32(buf[b:]))
+	b += 4
+
+	v.Serial = order.Get32(buf[b:])
+	b += 4
+
+	v.Valid = xfixes.Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.Update = xfixes.Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.XOff = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.YOff = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.TargetCrtc = randr.Crtc(order.Get32(buf[b:]))
+	b += 4
+
+	v.WaitFence = sync.Fence(order.Get32(buf[b:]))
+	b += 4
+
+	v.IdleFence = sync.Fence(order.Get32(buf[b:]))
+	b += 4
+
+	v.Options = order.Get32(buf[b:])
+	b += 4
+
+	b += 4 // padding
+
+	v.TargetMsc = order.Get64(buf[b:])
+	b += 8
+
+	v.Divisor = order.Get64(buf[b:])
+	b += 8
+
+	v.Remainder = order.Get64(buf[b:])
+	b += 8
+
+	v.Notifies = make([]Notify, (len(buf)-b)/8)
+	b += NotifyReadList(buf[b:], order, v.Notifies)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["Present"][1] = xgb.RequestInfo{
+		Name: "Pixmap",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return pixmapRequestRead(buf, order)
+		},
+	}
+}
+
+// QueryCapabilitiesCookie is a cookie used only for QueryCapabilities requests.
+type QueryCapabilitiesCookie struct {
+	*xgb.Cookie
+}
+
+// QueryCapabilities sends a checked request.
+// If an error occurs, it will be returned with the reply by calling QueryCapabilitiesCookie.Reply()
+func QueryCapabilities(c *xgb.Conn, Target uint32) QueryCapabilitiesCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["Present"]; !ok {
+		panic("Cannot issue request 'QueryCapabilities' using the uninitialized extension 'Present'. present.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, true)
+	c.NewRequest(queryCapabilitiesRequest(c, Target), cookie)
+	return QueryCapabilitiesCookie{cookie}
+}
+
+// QueryCapabilitiesUnchecked sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func QueryCapabilitiesUnchecked(c *xgb.Conn, Target uint32) QueryCapabilitiesCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["Present"]; !ok {
+		panic("Cannot issue request 'QueryCapabilities' using the uninitialized extension 'Present'. present.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, true)
+	c.NewRequest(queryCapabilitiesRequest(c, Target), cookie)
+	return QueryCapabilitiesCookie{cookie}
+}
+
+// QueryCapabilitiesReply represents the data returned from a QueryCapabilities request.
+type QueryCapabilitiesReply struct {
+	Sequence uint16 // sequence number of the request for this reply
+	Length   uint32 // number of bytes in this reply
+	// padding: 1 bytes
+	Capabilities uint32
+}
+
+// Reply blocks and returns the reply data for a QueryCapabilities request.
+func (cook QueryCapabilitiesCookie) Reply() (*QueryCapabilitiesReply, error) {
+	buf, err := cook.Cookie.Reply()
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryCapabilitiesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryCapabilities request arrives.
+func (cook QueryCapabilitiesCookie) ReplyContext(ctx context.Context) (*QueryCapabilitiesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryCapabilitiesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryCapabilitiesReply reads a byte slice in the byte order 'order' into a QueryCapabilitiesReply value.
+func queryCapabilitiesReply(buf []byte, order xgb.ByteOrder) *QueryCapabilitiesReply {
+	v := new(QueryCapabilitiesReply)
+	b := 1 // skip reply determinant
+
+	b += 1 // padding
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	v.Length = order.Get32(buf[b:]) // 4-byte units
+	b += 4
+
+	v.Capabilities = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+// Write request to wire for QueryCapabilities
+// queryCapabilitiesRequest writes a QueryCapabilities request to a byte slice.
+func queryCapabilitiesRequest(c *xgb.Conn, Target uint32) []byte {
+	return AppendQueryCapabilitiesRequest(nil, c, Target)
+}
+
+// AppendQueryCapabilitiesRequest appends a QueryCapabilities request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryCapabilitiesRequest(buf []byte, c *xgb.Conn, Target uint32) []byte {
+	size := 8
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["Present"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 4 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], Target)
+	b += 4
+
+	return buf
+}
+
+// QueryCapabilitiesRequest represents the data of a QueryCapabilities request, as it is read when tracing a connection.
+type QueryCapabilitiesRequest struct {
+	Target uint32
+}
+
+// queryCapabilitiesRequestRead reads a byte slice in the byte order 'order' into a QueryCapabilitiesRequest value.
+func queryCapabilitiesRequestRead(buf []byte, order xgb.ByteOrder) *QueryCapabilitiesRequest {
+	v := new(QueryCapabilitiesRequest)
+	b := 4 // skip request header
+
+	v.Target = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["Present"][4] = xgb.RequestInfo{
+		Name: "QueryCapabilities",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryCapabilitiesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryCapabilitiesReply(buf, order)
+		},
+	}
+}
+
+// QueryVersionCookie is a cookie used only for QueryVersion requests.
+type QueryVersionCookie struct {
+	*xgb.Cookie
+}
+
+// QueryVersion sends a checked request.
+// If an error occurs, it will be returned with the reply by calling QueryVersionCookie.Reply()
+func QueryVersion(c *xgb.Conn, MajorVersion uint32, MinorVersion uint32) QueryVersionCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["Present"]; !ok {
+		panic("Cannot issue request 'QueryVersion' using the uninitialized extension 'Present'. present.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, true)
+	c.NewRequest(queryVersionRequest(c, MajorVersion, MinorVersion), cookie)
+	return QueryVersionCookie{cookie}
+}
+
+// QueryVersionUnchecked sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func QueryVersionUnchecked(c *xgb.Conn, MajorVersion uint32, MinorVersion uint32) QueryVersionCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["Present"]; !ok {
+		panic("Cannot issue request 'QueryVersion' using the uninitialized extension 'Present'. present.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, true)
+	c.NewRequest(queryVersionRequest(c, MajorVersion, MinorVersion), cookie)
+	return QueryVersionCookie{cookie}
+}
+
+// QueryVersionReply represents the data returned from a QueryVersion request.
+type QueryVersionReply struct {
+	Sequence uint16 // sequence number of the request for this reply
+	Length   uint32 // number of bytes in this reply
+	// padding: 1 bytes
+	MajorVersion uint32
+	MinorVersion uint32
+}
+
+// Reply blocks and returns the reply data for a QueryVersion request.
+func (cook QueryVersionCookie) Reply() (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.Reply()
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryVersion request arrives.
+func (cook QueryVersionCookie) ReplyContext(ctx context.Context) (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryVersionReply reads a byte slice in the byte order 'order' into a QueryVersionReply value.
+func queryVersionReply(buf []byte, order xgb.ByteOrder) *QueryVersionReply {
+	v := new(QueryVersionReply)
+	b := 1 // skip reply determinant
+
+	b += 1 // padding
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	v.Length = order.Get32(buf[b:]) // 4-byte units
+	b += 4
+
+	v.MajorVersion = order.Get32(buf[b:])
+	b += 4
+
+	v.MinorVersion = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+// Write request to wire for QueryVersion
+// queryVersionRequest writes a QueryVersion request to a byte slice.
+func queryVersionRequest(c *xgb.Conn, MajorVersion uint32, MinorVersion uint32) []byte {
+	return AppendQueryVersionRequest(nil, c, MajorVersion, MinorVersion)
+}
+
+// AppendQueryVersionRequest appends a QueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryVersionRequest(buf []byte, c *xgb.Conn, MajorVersion uint32, MinorVersion uint32) []byte {
+	size := 12
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["Present"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 0 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], MajorVersion)
+	b += 4
+
+	order.Put32(buf[b:], MinorVersion)
+	b += 4
+
+	return buf
+}
+
+// QueryVersionRequest represents the data of a QueryVersion request, as it is read when tracing a connection.
+type QueryVersionRequest struct {
+	MajorVersion uint32
+	MinorVersion uint32
+}
+
+// queryVersionRequestRead reads a byte slice in the byte order 'order' into a QueryVersionRequest value.
+func queryVersionRequestRead(buf []byte, order xgb.ByteOrder) *QueryVersionRequest {
+	v := new(QueryVersionRequest)
+	b := 4 // skip request header
+
+	v.MajorVersion = order.Get32(buf[b:])
+	b += 4
+
+	v.MinorVersion = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["Present"][0] = xgb.RequestInfo{
+		Name: "QueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionReply(buf, order)
+		},
+	}
+}
+
+// SelectInputCookie is a cookie used only for SelectInput requests.
+type SelectInputCookie struct {
+	*xgb.Cookie
+}
+
+// SelectInput sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func SelectInput(c *xgb.Conn, Eid Event, Window xproto.Window, EventMask EventMask) SelectInputCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["Present"]; !ok {
+		panic("Cannot issue request 'SelectInput' using the uninitialized extension 'Present'. present.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequest(selectInputRequest(c, Eid, Window, EventMask), cookie)
+	return SelectInputCookie{cookie}
+}
+
+// SelectInputChecked sends a checked request.
+// If an error occurs, it can be retrieved using SelectInputCookie.Check()
+func SelectInputChecked(c *xgb.Conn, Eid Event, Window xproto.Window, EventMask EventMask) SelectInputCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["Present"]; !ok {
+		panic("Cannot issue request 'SelectInput' using the uninitialized extension 'Present'. present.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, false)
+	c.NewRequest(selectInputRequest(c, Eid, Window, EventMask), cookie)
+	return SelectInputCookie{cookie}
+}
+
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook SelectInputCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SelectInputCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for SelectInput
+// selectInputRequest writes a SelectInput request to a byte slice.
+func selectInputRequest(c *xgb.Conn, Eid Event, Window xproto.Window, EventMask EventMask) []byte {
+	return AppendSelectInputRequest(nil, c, Eid, Window, EventMask)
+}
+
+// AppendSelectInputRequest appends a SelectInput request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSelectInputRequest(buf []byte, c *xgb.Conn, Eid Event, Window xproto.Window, EventMask EventMask) []byte {
+	size := 16
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["Present"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 3 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Eid))
+	b += 4
+
+	order.Put32(buf[b:], uint32(Window))
+	b += 4
+
+	order.Put32(buf[b:], uint32(EventMask))
+	b += 4
+
+	return buf
+}
+
+// SelectInputRequest represents the data of a SelectInput request, as it is read when tracing a connection.
+type SelectInputRequest struct {
+	Eid       Event
+	Window    xproto.Window
+	EventMask EventMask
+}
+
+// selectInputRequestRead reads a byte slice in the byte order 'order' into a SelectInputRequest value.
+func selectInputRequestRead(buf []byte, order xgb.ByteOrder) *SelectInputRequest {
+	v := new(SelectInputRequest)
+	b := 4 // skip request header
+
+	v.Eid = Event(order.Get32(buf[b:]))
+	b += 4
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.EventMask = EventMask(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["Present"][3] = xgb.RequestInfo{
+		Name: "SelectInput",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return selectInputRequestRead(buf, order)
+		},
+	}
+}
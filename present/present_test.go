@@ -0,0 +1,172 @@
+package present
+
+// Tests for the Present extension, against a fake X server that sends its
+// events as generic events.
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xgbtest"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// The opcodes of Present and the Generic Event Extension on the fake server.
+const (
+	presentOpcode = 145
+	geOpcode      = 128
+)
+
+func newFakePresent(t *testing.T) (*xgbtest.Server, *xgb.Conn) {
+	s := xgbtest.NewServer()
+	s.AddExtension("Present", presentOpcode, 0, 0)
+	s.AddExtension("Generic Event Extension", geOpcode, 0, 0)
+	geVersion := make(xgbtest.Reply, 32)
+	xgb.Put16(geVersion[8:], 1)
+	s.Handle(geOpcode, 0, geVersion)
+	queryVersion := make(xgbtest.Reply, 32)
+	xgb.Put32(queryVersion[8:], 1)
+	xgb.Put32(queryVersion[12:], 2)
+	s.Handle(presentOpcode, 0, queryVersion)
+
+	c, err := s.Conn()
+	if err != nil {
+		s.Close()
+		t.Fatal(err)
+	}
+	if err := Init(c); err != nil {
+		c.Close()
+		s.Close()
+		t.Fatalf("Init: %s", err)
+	}
+	return s, c
+}
+
+// lastRequest returns the last request received by 's' before the round
+// trip made by Sync.
+func lastRequest(s *xgbtest.Server) *xgbtest.Request {
+	reqs := s.Requests()
+	return reqs[len(reqs)-2]
+}
+
+func TestQueryVersion(t *testing.T) {
+	s, c := newFakePresent(t)
+	defer s.Close()
+	defer c.Close()
+
+	reply, err := QueryVersion(c, 1, 2).Reply()
+	if err != nil {
+		t.Fatalf("QueryVersion: %s", err)
+	}
+	if reply.MajorVersion != 1 || reply.MinorVersion != 2 {
+		t.Fatalf("Got version %d.%d, want 1.2.", reply.MajorVersion,
+			reply.MinorVersion)
+	}
+}
+
+// TestPixmap checks the encoding of the 64-bit fields and the list of
+// notifies of a Pixmap request.
+func TestPixmap(t *testing.T) {
+	s, c := newFakePresent(t)
+	defer s.Close()
+	defer c.Close()
+
+	notifies := []Notify{{Window: 7, Serial: 8}, {Window: 9, Serial: 10}}
+	Pixmap(c, 1, 2, 3, 4, 5, -6, 6, 11, 12, 13, OptionAsync|OptionCopy,
+		1<<40+1, 2, 1, notifies)
+	c.Sync()
+
+	req := lastRequest(s)
+	if req.Opcode != presentOpcode || req.Minor != 1 {
+		t.Fatalf("Got request %d.%d, want Pixmap.", req.Opcode, req.Minor)
+	}
+	if len(req.Bytes) != 72+8*len(notifies) {
+		t.Fatalf("Pixmap is %d bytes long, want %d.", len(req.Bytes),
+			72+8*len(notifies))
+	}
+	if got := int16(xgb.Get16(req.Bytes[24:])); got != -6 {
+		t.Errorf("XOff is %d, want -6.", got)
+	}
+	if got := xgb.Get32(req.Bytes[40:]); got != OptionAsync|OptionCopy {
+		t.Errorf("Options are %d, want %d.", got, OptionAsync|OptionCopy)
+	}
+	want := []uint64{1<<40 + 1, 2, 1}
+	for i, v := range want {
+		if got := xgb.Get64(req.Bytes[48+8*i:]); got != v {
+			t.Errorf("64-bit field %d is %d, want %d.", i, got, v)
+		}
+	}
+	got := make([]Notify, len(notifies))
+	NotifyReadList(req.Bytes[72:], req.ByteOrder, got)
+	if !reflect.DeepEqual(got, notifies) {
+		t.Errorf("Got notifies %v, want %v.", got, notifies)
+	}
+}
+
+// TestEvents checks that Present's events are read as generic events, by
+// sending them as the X server would.
+func TestEvents(t *testing.T) {
+	s, c := newFakePresent(t)
+	defer s.Close()
+	defer c.Close()
+
+	events := []xgb.Event{
+		CompleteNotifyEvent{
+			Kind:   CompleteKindPixmap,
+			Mode:   CompleteModeFlip,
+			Event:  1,
+			Window: 2,
+			Serial: 3,
+			Ust:    1<<50 + 4,
+			Msc:    1<<33 + 5,
+		},
+		IdleNotifyEvent{
+			Event:     1,
+			Window:    2,
+			Serial:    3,
+			Pixmap:    4,
+			IdleFence: 5,
+		},
+		RedirectNotifyEvent{
+			UpdateWindow: true,
+			Event:        1,
+			EventWindow:  2,
+			Window:       3,
+			ValidRect:    xproto.Rectangle{X: -1, Width: 10, Height: 20},
+			TargetMsc:    1 << 40,
+			Notifies:     []Notify{{Window: 3, Serial: 1}},
+		},
+	}
+	for _, ev := range events {
+		buf := ev.Bytes(c.ByteOrder())
+		buf[1] = presentOpcode
+		if err := s.SendEvent(buf); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The sequence numbers are those of the last request, so they're left
+	// out of the comparison.
+	for _, want := range events {
+		ev, err := c.WaitForEvent()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got xgb.Event
+		switch ev := ev.(type) {
+		case CompleteNotifyEvent:
+			ev.Sequence = 0
+			got = ev
+		case IdleNotifyEvent:
+			ev.Sequence = 0
+			got = ev
+		case RedirectNotifyEvent:
+			ev.Sequence = 0
+			got = ev
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Got %v, want %v.", ev, want)
+		}
+	}
+}
@@ -4,6 +4,8 @@ package randr
 // This file is automatically generated from randr.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/render"
@@ -23,18 +25,16 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["RANDR"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["RANDR"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["RANDR"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["RANDR"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["RANDR"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "RANDR", xgb.ExtRequestInfos["RANDR"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["RANDR"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["RANDR"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["RANDR"] = make(map[int]xgb.RequestInfo)
 }
 
 // BadBadCrtc is the error number for a BadBadCrtc.
@@ -45,15 +45,15 @@ type BadCrtcError struct {
 	NiceName string
 }
 
-// BadCrtcErrorNew constructs a BadCrtcError value that implements xgb.Error from a byte slice.
-func BadCrtcErrorNew(buf []byte) xgb.Error {
+// BadCrtcErrorNew constructs a BadCrtcError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadCrtcErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := BadCrtcError{}
 	v.NiceName = "BadCrtc"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -91,15 +91,15 @@ type BadModeError struct {
 	NiceName string
 }
 
-// BadModeErrorNew constructs a BadModeError value that implements xgb.Error from a byte slice.
-func BadModeErrorNew(buf []byte) xgb.Error {
+// BadModeErrorNew constructs a BadModeError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadModeErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := BadModeError{}
 	v.NiceName = "BadMode"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -137,15 +137,15 @@ type BadOutputError struct {
 	NiceName string
 }
 
-// BadOutputErrorNew constructs a BadOutputError value that implements xgb.Error from a byte slice.
-func BadOutputErrorNew(buf []byte) xgb.Error {
+// BadOutputErrorNew constructs a BadOutputError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadOutputErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := BadOutputError{}
 	v.NiceName = "BadOutput"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -183,15 +183,15 @@ type BadProviderError struct {
 	NiceName string
 }
 
-// BadProviderErrorNew constructs a BadProviderError value that implements xgb.Error from a byte slice.
-func BadProviderErrorNew(buf []byte) xgb.Error {
+// BadProviderErrorNew constructs a BadProviderError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadProviderErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := BadProviderError{}
 	v.NiceName = "BadProvider"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -221,12 +221,27 @@ func init() {
 	xgb.NewExtErrorFuncs["RANDR"][3] = BadProviderErrorNew
 }
 
+type Connection byte
+
 const (
-	ConnectionConnected    = 0
-	ConnectionDisconnected = 1
-	ConnectionUnknown      = 2
+	ConnectionConnected    Connection = 0
+	ConnectionDisconnected Connection = 1
+	ConnectionUnknown      Connection = 2
 )
 
+// String returns the name of the Connection item with this value.
+func (v Connection) String() string {
+	switch v {
+	case ConnectionConnected:
+		return "Connected"
+	case ConnectionDisconnected:
+		return "Disconnected"
+	case ConnectionUnknown:
+		return "Unknown"
+	}
+	return xgb.Sprintf("Connection(%d)", byte(v))
+}
+
 type Crtc uint32
 
 func NewCrtcId(c *xgb.Conn) (Crtc, error) {
@@ -242,7 +257,7 @@ type CrtcChange struct {
 	Window    xproto.Window
 	Crtc      Crtc
 	Mode      Mode
-	Rotation  uint16
+	Rotation  Rotation
 	// padding: 2 bytes
 	X      int16
 	Y      int16
@@ -251,94 +266,94 @@ type CrtcChange struct {
 }
 
 // CrtcChangeRead reads a byte slice into a CrtcChange value.
-func CrtcChangeRead(buf []byte, v *CrtcChange) int {
+func CrtcChangeRead(buf []byte, order xgb.ByteOrder, v *CrtcChange) int {
 	b := 0
 
-	v.Timestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.Window = xproto.Window(xgb.Get32(buf[b:]))
+	v.Window = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Crtc = Crtc(xgb.Get32(buf[b:]))
+	v.Crtc = Crtc(order.Get32(buf[b:]))
 	b += 4
 
-	v.Mode = Mode(xgb.Get32(buf[b:]))
+	v.Mode = Mode(order.Get32(buf[b:]))
 	b += 4
 
-	v.Rotation = xgb.Get16(buf[b:])
+	v.Rotation = Rotation(order.Get16(buf[b:]))
 	b += 2
 
 	b += 2 // padding
 
-	v.X = int16(xgb.Get16(buf[b:]))
+	v.X = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Y = int16(xgb.Get16(buf[b:]))
+	v.Y = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
 	return b
 }
 
 // CrtcChangeReadList reads a byte slice into a list of CrtcChange values.
-func CrtcChangeReadList(buf []byte, dest []CrtcChange) int {
+func CrtcChangeReadList(buf []byte, order xgb.ByteOrder, dest []CrtcChange) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = CrtcChange{}
-		b += CrtcChangeRead(buf[b:], &dest[i])
+		b += CrtcChangeRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a CrtcChange value to a byte slice.
-func (v CrtcChange) Bytes() []byte {
+// Bytes writes a CrtcChange value to a byte slice, in the byte order 'order'.
+func (v CrtcChange) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 28)
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.Timestamp))
+	order.Put32(buf[b:], uint32(v.Timestamp))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Crtc))
+	order.Put32(buf[b:], uint32(v.Crtc))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Mode))
+	order.Put32(buf[b:], uint32(v.Mode))
 	b += 4
 
-	xgb.Put16(buf[b:], v.Rotation)
+	order.Put16(buf[b:], uint16(v.Rotation))
 	b += 2
 
 	b += 2 // padding
 
-	xgb.Put16(buf[b:], uint16(v.X))
+	order.Put16(buf[b:], uint16(v.X))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.Y))
+	order.Put16(buf[b:], uint16(v.Y))
 	b += 2
 
-	xgb.Put16(buf[b:], v.Width)
+	order.Put16(buf[b:], v.Width)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Height)
+	order.Put16(buf[b:], v.Height)
 	b += 2
 
 	return buf[:b]
 }
 
 // CrtcChangeListBytes writes a list of CrtcChange values to a byte slice.
-func CrtcChangeListBytes(buf []byte, list []CrtcChange) int {
+func CrtcChangeListBytes(buf []byte, order xgb.ByteOrder, list []CrtcChange) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -355,23 +370,93 @@ func NewModeId(c *xgb.Conn) (Mode, error) {
 	return Mode(id), nil
 }
 
+type ModeFlag uint32
+
 const (
-	ModeFlagHsyncPositive  = 1
-	ModeFlagHsyncNegative  = 2
-	ModeFlagVsyncPositive  = 4
-	ModeFlagVsyncNegative  = 8
-	ModeFlagInterlace      = 16
-	ModeFlagDoubleScan     = 32
-	ModeFlagCsync          = 64
-	ModeFlagCsyncPositive  = 128
-	ModeFlagCsyncNegative  = 256
-	ModeFlagHskewPresent   = 512
-	ModeFlagBcast          = 1024
-	ModeFlagPixelMultiplex = 2048
-	ModeFlagDoubleClock    = 4096
-	ModeFlagHalveClock     = 8192
+	ModeFlagHsyncPositive  ModeFlag = 1
+	ModeFlagHsyncNegative  ModeFlag = 2
+	ModeFlagVsyncPositive  ModeFlag = 4
+	ModeFlagVsyncNegative  ModeFlag = 8
+	ModeFlagInterlace      ModeFlag = 16
+	ModeFlagDoubleScan     ModeFlag = 32
+	ModeFlagCsync          ModeFlag = 64
+	ModeFlagCsyncPositive  ModeFlag = 128
+	ModeFlagCsyncNegative  ModeFlag = 256
+	ModeFlagHskewPresent   ModeFlag = 512
+	ModeFlagBcast          ModeFlag = 1024
+	ModeFlagPixelMultiplex ModeFlag = 2048
+	ModeFlagDoubleClock    ModeFlag = 4096
+	ModeFlagHalveClock     ModeFlag = 8192
 )
 
+// String returns the names of the ModeFlag bits set in a value.
+func (v ModeFlag) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 14)
+	if v&ModeFlagHsyncPositive != 0 {
+		names = append(names, "HsyncPositive")
+		v &^= ModeFlagHsyncPositive
+	}
+	if v&ModeFlagHsyncNegative != 0 {
+		names = append(names, "HsyncNegative")
+		v &^= ModeFlagHsyncNegative
+	}
+	if v&ModeFlagVsyncPositive != 0 {
+		names = append(names, "VsyncPositive")
+		v &^= ModeFlagVsyncPositive
+	}
+	if v&ModeFlagVsyncNegative != 0 {
+		names = append(names, "VsyncNegative")
+		v &^= ModeFlagVsyncNegative
+	}
+	if v&ModeFlagInterlace != 0 {
+		names = append(names, "Interlace")
+		v &^= ModeFlagInterlace
+	}
+	if v&ModeFlagDoubleScan != 0 {
+		names = append(names, "DoubleScan")
+		v &^= ModeFlagDoubleScan
+	}
+	if v&ModeFlagCsync != 0 {
+		names = append(names, "Csync")
+		v &^= ModeFlagCsync
+	}
+	if v&ModeFlagCsyncPositive != 0 {
+		names = append(names, "CsyncPositive")
+		v &^= ModeFlagCsyncPositive
+	}
+	if v&ModeFlagCsyncNegative != 0 {
+		names = append(names, "CsyncNegative")
+		v &^= ModeFlagCsyncNegative
+	}
+	if v&ModeFlagHskewPresent != 0 {
+		names = append(names, "HskewPresent")
+		v &^= ModeFlagHskewPresent
+	}
+	if v&ModeFlagBcast != 0 {
+		names = append(names, "Bcast")
+		v &^= ModeFlagBcast
+	}
+	if v&ModeFlagPixelMultiplex != 0 {
+		names = append(names, "PixelMultiplex")
+		v &^= ModeFlagPixelMultiplex
+	}
+	if v&ModeFlagDoubleClock != 0 {
+		names = append(names, "DoubleClock")
+		v &^= ModeFlagDoubleClock
+	}
+	if v&ModeFlagHalveClock != 0 {
+		names = append(names, "HalveClock")
+		v &^= ModeFlagHalveClock
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint32(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
 type ModeInfo struct {
 	Id         uint32
 	Width      uint16
@@ -385,118 +470,118 @@ type ModeInfo struct {
 	VsyncEnd   uint16
 	Vtotal     uint16
 	NameLen    uint16
-	ModeFlags  uint32
+	ModeFlags  ModeFlag
 }
 
 // ModeInfoRead reads a byte slice into a ModeInfo value.
-func ModeInfoRead(buf []byte, v *ModeInfo) int {
+func ModeInfoRead(buf []byte, order xgb.ByteOrder, v *ModeInfo) int {
 	b := 0
 
-	v.Id = xgb.Get32(buf[b:])
+	v.Id = order.Get32(buf[b:])
 	b += 4
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
-	v.DotClock = xgb.Get32(buf[b:])
+	v.DotClock = order.Get32(buf[b:])
 	b += 4
 
-	v.HsyncStart = xgb.Get16(buf[b:])
+	v.HsyncStart = order.Get16(buf[b:])
 	b += 2
 
-	v.HsyncEnd = xgb.Get16(buf[b:])
+	v.HsyncEnd = order.Get16(buf[b:])
 	b += 2
 
-	v.Htotal = xgb.Get16(buf[b:])
+	v.Htotal = order.Get16(buf[b:])
 	b += 2
 
-	v.Hskew = xgb.Get16(buf[b:])
+	v.Hskew = order.Get16(buf[b:])
 	b += 2
 
-	v.VsyncStart = xgb.Get16(buf[b:])
+	v.VsyncStart = order.Get16(buf[b:])
 	b += 2
 
-	v.VsyncEnd = xgb.Get16(buf[b:])
+	v.VsyncEnd = order.Get16(buf[b:])
 	b += 2
 
-	v.Vtotal = xgb.Get16(buf[b:])
+	v.Vtotal = order.Get16(buf[b:])
 	b += 2
 
-	v.NameLen = xgb.Get16(buf[b:])
+	v.NameLen = order.Get16(buf[b:])
 	b += 2
 
-	v.ModeFlags = xgb.Get32(buf[b:])
+	v.ModeFlags = ModeFlag(order.Get32(buf[b:]))
 	b += 4
 
 	return b
 }
 
 // ModeInfoReadList reads a byte slice into a list of ModeInfo values.
-func ModeInfoReadList(buf []byte, dest []ModeInfo) int {
+func ModeInfoReadList(buf []byte, order xgb.ByteOrder, dest []ModeInfo) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = ModeInfo{}
-		b += ModeInfoRead(buf[b:], &dest[i])
+		b += ModeInfoRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a ModeInfo value to a byte slice.
-func (v ModeInfo) Bytes() []byte {
+// Bytes writes a ModeInfo value to a byte slice, in the byte order 'order'.
+func (v ModeInfo) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
-	xgb.Put32(buf[b:], v.Id)
+	order.Put32(buf[b:], v.Id)
 	b += 4
 
-	xgb.Put16(buf[b:], v.Width)
+	order.Put16(buf[b:], v.Width)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Height)
+	order.Put16(buf[b:], v.Height)
 	b += 2
 
-	xgb.Put32(buf[b:], v.DotClock)
+	order.Put32(buf[b:], v.DotClock)
 	b += 4
 
-	xgb.Put16(buf[b:], v.HsyncStart)
+	order.Put16(buf[b:], v.HsyncStart)
 	b += 2
 
-	xgb.Put16(buf[b:], v.HsyncEnd)
+	order.Put16(buf[b:], v.HsyncEnd)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Htotal)
+	order.Put16(buf[b:], v.Htotal)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Hskew)
+	order.Put16(buf[b:], v.Hskew)
 	b += 2
 
-	xgb.Put16(buf[b:], v.VsyncStart)
+	order.Put16(buf[b:], v.VsyncStart)
 	b += 2
 
-	xgb.Put16(buf[b:], v.VsyncEnd)
+	order.Put16(buf[b:], v.VsyncEnd)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Vtotal)
+	order.Put16(buf[b:], v.Vtotal)
 	b += 2
 
-	xgb.Put16(buf[b:], v.NameLen)
+	order.Put16(buf[b:], v.NameLen)
 	b += 2
 
-	xgb.Put32(buf[b:], v.ModeFlags)
+	order.Put32(buf[b:], uint32(v.ModeFlags))
 	b += 4
 
 	return buf[:b]
 }
 
 // ModeInfoListBytes writes a list of ModeInfo values to a byte slice.
-func ModeInfoListBytes(buf []byte, list []ModeInfo) int {
+func ModeInfoListBytes(buf []byte, order xgb.ByteOrder, list []ModeInfo) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -521,25 +606,25 @@ type NotifyEvent struct {
 	U        NotifyDataUnion
 }
 
-// NotifyEventNew constructs a NotifyEvent value that implements xgb.Event from a byte slice.
-func NotifyEventNew(buf []byte) xgb.Event {
+// NotifyEventNew constructs a NotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func NotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := NotifyEvent{}
 	b := 1 // don't read event number
 
 	v.SubCode = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	v.U = NotifyDataUnion{}
-	b += NotifyDataUnionRead(buf[b:], &v.U)
+	b += NotifyDataUnionRead(buf[b:], order, &v.U)
 
 	return v
 }
 
-// Bytes writes a NotifyEvent value to a byte slice.
-func (v NotifyEvent) Bytes() []byte {
+// Bytes writes a NotifyEvent value to a byte slice, in the byte order 'order'.
+func (v NotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -553,7 +638,7 @@ func (v NotifyEvent) Bytes() []byte {
 	b += 2 // skip sequence number
 
 	{
-		unionBytes := v.U.Bytes()
+		unionBytes := v.U.Bytes(order)
 		copy(buf[b:], unionBytes)
 		b += len(unionBytes)
 	}
@@ -584,12 +669,13 @@ func init() {
 // Note that to *create* a Union, you should *never* create
 // this struct directly (unless you know what you're doing).
 // Instead use one of the following constructors for 'NotifyDataUnion':
-//     NotifyDataUnionCcNew(Cc CrtcChange) NotifyDataUnion
-//     NotifyDataUnionOcNew(Oc OutputChange) NotifyDataUnion
-//     NotifyDataUnionOpNew(Op OutputProperty) NotifyDataUnion
-//     NotifyDataUnionPcNew(Pc ProviderChange) NotifyDataUnion
-//     NotifyDataUnionPpNew(Pp ProviderProperty) NotifyDataUnion
-//     NotifyDataUnionRcNew(Rc ResourceChange) NotifyDataUnion
+//
+//	NotifyDataUnionCcNew(order xgb.ByteOrder, Cc CrtcChange) NotifyDataUnion
+//	NotifyDataUnionOcNew(order xgb.ByteOrder, Oc OutputChange) NotifyDataUnion
+//	NotifyDataUnionOpNew(order xgb.ByteOrder, Op OutputProperty) NotifyDataUnion
+//	NotifyDataUnionPcNew(order xgb.ByteOrder, Pc ProviderChange) NotifyDataUnion
+//	NotifyDataUnionPpNew(order xgb.ByteOrder, Pp ProviderProperty) NotifyDataUnion
+//	NotifyDataUnionRcNew(order xgb.ByteOrder, Rc ResourceChange) NotifyDataUnion
 type NotifyDataUnion struct {
 	Cc CrtcChange
 	Oc OutputChange
@@ -600,12 +686,14 @@ type NotifyDataUnion struct {
 }
 
 // NotifyDataUnionCcNew constructs a new NotifyDataUnion union type with the Cc field.
-func NotifyDataUnionCcNew(Cc CrtcChange) NotifyDataUnion {
+// Its fields are read back in the byte order 'order', so it must be sent over
+// a connection with the same byte order.
+func NotifyDataUnionCcNew(order xgb.ByteOrder, Cc CrtcChange) NotifyDataUnion {
 	var b int
 	buf := make([]byte, 28)
 
 	{
-		structBytes := Cc.Bytes()
+		structBytes := Cc.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -617,38 +705,40 @@ func NotifyDataUnionCcNew(Cc CrtcChange) NotifyDataUnion {
 
 	b = 0 // always read the same bytes
 	v.Cc = CrtcChange{}
-	b += CrtcChangeRead(buf[b:], &v.Cc)
+	b += CrtcChangeRead(buf[b:], order, &v.Cc)
 
 	b = 0 // always read the same bytes
 	v.Oc = OutputChange{}
-	b += OutputChangeRead(buf[b:], &v.Oc)
+	b += OutputChangeRead(buf[b:], order, &v.Oc)
 
 	b = 0 // always read the same bytes
 	v.Op = OutputProperty{}
-	b += OutputPropertyRead(buf[b:], &v.Op)
+	b += OutputPropertyRead(buf[b:], order, &v.Op)
 
 	b = 0 // always read the same bytes
 	v.Pc = ProviderChange{}
-	b += ProviderChangeRead(buf[b:], &v.Pc)
+	b += ProviderChangeRead(buf[b:], order, &v.Pc)
 
 	b = 0 // always read the same bytes
 	v.Pp = ProviderProperty{}
-	b += ProviderPropertyRead(buf[b:], &v.Pp)
+	b += ProviderPropertyRead(buf[b:], order, &v.Pp)
 
 	b = 0 // always read the same bytes
 	v.Rc = ResourceChange{}
-	b += ResourceChangeRead(buf[b:], &v.Rc)
+	b += ResourceChangeRead(buf[b:], order, &v.Rc)
 
 	return v
 }
 
 // NotifyDataUnionOcNew constructs a new NotifyDataUnion union type with the Oc field.
-func NotifyDataUnionOcNew(Oc OutputChange) NotifyDataUnion {
+// Its fields are read back in the byte order 'order', so it must be sent over
+// a connection with the same byte order.
+func NotifyDataUnionOcNew(order xgb.ByteOrder, Oc OutputChange) NotifyDataUnion {
 	var b int
 	buf := make([]byte, 28)
 
 	{
-		structBytes := Oc.Bytes()
+		structBytes := Oc.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -660,38 +750,40 @@ func NotifyDataUnionOcNew(Oc OutputChange) NotifyDataUnion {
 
 	b = 0 // always read the same bytes
 	v.Cc = CrtcChange{}
-	b += CrtcChangeRead(buf[b:], &v.Cc)
+	b += CrtcChangeRead(buf[b:], order, &v.Cc)
 
 	b = 0 // always read the same bytes
 	v.Oc = OutputChange{}
-	b += OutputChangeRead(buf[b:], &v.Oc)
+	b += OutputChangeRead(buf[b:], order, &v.Oc)
 
 	b = 0 // always read the same bytes
 	v.Op = OutputProperty{}
-	b += OutputPropertyRead(buf[b:], &v.Op)
+	b += OutputPropertyRead(buf[b:], order, &v.Op)
 
 	b = 0 // always read the same bytes
 	v.Pc = ProviderChange{}
-	b += ProviderChangeRead(buf[b:], &v.Pc)
+	b += ProviderChangeRead(buf[b:], order, &v.Pc)
 
 	b = 0 // always read the same bytes
 	v.Pp = ProviderProperty{}
-	b += ProviderPropertyRead(buf[b:], &v.Pp)
+	b += ProviderPropertyRead(buf[b:], order, &v.Pp)
 
 	b = 0 // always read the same bytes
 	v.Rc = ResourceChange{}
-	b += ResourceChangeRead(buf[b:], &v.Rc)
+	b += ResourceChangeRead(buf[b:], order, &v.Rc)
 
 	return v
 }
 
 // NotifyDataUnionOpNew constructs a new NotifyDataUnion union type with the Op field.
-func NotifyDataUnionOpNew(Op OutputProperty) NotifyDataUnion {
+// Its fields are read back in the byte order 'order', so it must be sent over
+// a connection with the same byte order.
+func NotifyDataUnionOpNew(order xgb.ByteOrder, Op OutputProperty) NotifyDataUnion {
 	var b int
 	buf := make([]byte, 28)
 
 	{
-		structBytes := Op.Bytes()
+		structBytes := Op.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -703,38 +795,40 @@ func NotifyDataUnionOpNew(Op OutputProperty) NotifyDataUnion {
 
 	b = 0 // always read the same bytes
 	v.Cc = CrtcChange{}
-	b += CrtcChangeRead(buf[b:], &v.Cc)
+	b += CrtcChangeRead(buf[b:], order, &v.Cc)
 
 	b = 0 // always read the same bytes
 	v.Oc = OutputChange{}
-	b += OutputChangeRead(buf[b:], &v.Oc)
+	b += OutputChangeRead(buf[b:], order, &v.Oc)
 
 	b = 0 // always read the same bytes
 	v.Op = OutputProperty{}
-	b += OutputPropertyRead(buf[b:], &v.Op)
+	b += OutputPropertyRead(buf[b:], order, &v.Op)
 
 	b = 0 // always read the same bytes
 	v.Pc = ProviderChange{}
-	b += ProviderChangeRead(buf[b:], &v.Pc)
+	b += ProviderChangeRead(buf[b:], order, &v.Pc)
 
 	b = 0 // always read the same bytes
 	v.Pp = ProviderProperty{}
-	b += ProviderPropertyRead(buf[b:], &v.Pp)
+	b += ProviderPropertyRead(buf[b:], order, &v.Pp)
 
 	b = 0 // always read the same bytes
 	v.Rc = ResourceChange{}
-	b += ResourceChangeRead(buf[b:], &v.Rc)
+	b += ResourceChangeRead(buf[b:], order, &v.Rc)
 
 	return v
 }
 
 // NotifyDataUnionPcNew constructs a new NotifyDataUnion union type with the Pc field.
-func NotifyDataUnionPcNew(Pc ProviderChange) NotifyDataUnion {
+// Its fields are read back in the byte order 'order', so it must be sent over
+// a connection with the same byte order.
+func NotifyDataUnionPcNew(order xgb.ByteOrder, Pc ProviderChange) NotifyDataUnion {
 	var b int
 	buf := make([]byte, 28)
 
 	{
-		structBytes := Pc.Bytes()
+		structBytes := Pc.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -746,38 +840,40 @@ func NotifyDataUnionPcNew(Pc ProviderChange) NotifyDataUnion {
 
 	b = 0 // always read the same bytes
 	v.Cc = CrtcChange{}
-	b += CrtcChangeRead(buf[b:], &v.Cc)
+	b += CrtcChangeRead(buf[b:], order, &v.Cc)
 
 	b = 0 // always read the same bytes
 	v.Oc = OutputChange{}
-	b += OutputChangeRead(buf[b:], &v.Oc)
+	b += OutputChangeRead(buf[b:], order, &v.Oc)
 
 	b = 0 // always read the same bytes
 	v.Op = OutputProperty{}
-	b += OutputPropertyRead(buf[b:], &v.Op)
+	b += OutputPropertyRead(buf[b:], order, &v.Op)
 
 	b = 0 // always read the same bytes
 	v.Pc = ProviderChange{}
-	b += ProviderChangeRead(buf[b:], &v.Pc)
+	b += ProviderChangeRead(buf[b:], order, &v.Pc)
 
 	b = 0 // always read the same bytes
 	v.Pp = ProviderProperty{}
-	b += ProviderPropertyRead(buf[b:], &v.Pp)
+	b += ProviderPropertyRead(buf[b:], order, &v.Pp)
 
 	b = 0 // always read the same bytes
 	v.Rc = ResourceChange{}
-	b += ResourceChangeRead(buf[b:], &v.Rc)
+	b += ResourceChangeRead(buf[b:], order, &v.Rc)
 
 	return v
 }
 
 // NotifyDataUnionPpNew constructs a new NotifyDataUnion union type with the Pp field.
-func NotifyDataUnionPpNew(Pp ProviderProperty) NotifyDataUnion {
+// Its fields are read back in the byte order 'order', so it must be sent over
+// a connection with the same byte order.
+func NotifyDataUnionPpNew(order xgb.ByteOrder, Pp ProviderProperty) NotifyDataUnion {
 	var b int
 	buf := make([]byte, 28)
 
 	{
-		structBytes := Pp.Bytes()
+		structBytes := Pp.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -789,38 +885,40 @@ func NotifyDataUnionPpNew(Pp ProviderProperty) NotifyDataUnion {
 
 	b = 0 // always read the same bytes
 	v.Cc = CrtcChange{}
-	b += CrtcChangeRead(buf[b:], &v.Cc)
+	b += CrtcChangeRead(buf[b:], order, &v.Cc)
 
 	b = 0 // always read the same bytes
 	v.Oc = OutputChange{}
-	b += OutputChangeRead(buf[b:], &v.Oc)
+	b += OutputChangeRead(buf[b:], order, &v.Oc)
 
 	b = 0 // always read the same bytes
 	v.Op = OutputProperty{}
-	b += OutputPropertyRead(buf[b:], &v.Op)
+	b += OutputPropertyRead(buf[b:], order, &v.Op)
 
 	b = 0 // always read the same bytes
 	v.Pc = ProviderChange{}
-	b += ProviderChangeRead(buf[b:], &v.Pc)
+	b += ProviderChangeRead(buf[b:], order, &v.Pc)
 
 	b = 0 // always read the same bytes
 	v.Pp = ProviderProperty{}
-	b += ProviderPropertyRead(buf[b:], &v.Pp)
+	b += ProviderPropertyRead(buf[b:], order, &v.Pp)
 
 	b = 0 // always read the same bytes
 	v.Rc = ResourceChange{}
-	b += ResourceChangeRead(buf[b:], &v.Rc)
+	b += ResourceChangeRead(buf[b:], order, &v.Rc)
 
 	return v
 }
 
 // NotifyDataUnionRcNew constructs a new NotifyDataUnion union type with the Rc field.
-func NotifyDataUnionRcNew(Rc ResourceChange) NotifyDataUnion {
+// Its fields are read back in the byte order 'order', so it must be sent over
+// a connection with the same byte order.
+func NotifyDataUnionRcNew(order xgb.ByteOrder, Rc ResourceChange) NotifyDataUnion {
 	var b int
 	buf := make([]byte, 28)
 
 	{
-		structBytes := Rc.Bytes()
+		structBytes := Rc.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -832,81 +930,81 @@ func NotifyDataUnionRcNew(Rc ResourceChange) NotifyDataUnion {
 
 	b = 0 // always read the same bytes
 	v.Cc = CrtcChange{}
-	b += CrtcChangeRead(buf[b:], &v.Cc)
+	b += CrtcChangeRead(buf[b:], order, &v.Cc)
 
 	b = 0 // always read the same bytes
 	v.Oc = OutputChange{}
-	b += OutputChangeRead(buf[b:], &v.Oc)
+	b += OutputChangeRead(buf[b:], order, &v.Oc)
 
 	b = 0 // always read the same bytes
 	v.Op = OutputProperty{}
-	b += OutputPropertyRead(buf[b:], &v.Op)
+	b += OutputPropertyRead(buf[b:], order, &v.Op)
 
 	b = 0 // always read the same bytes
 	v.Pc = ProviderChange{}
-	b += ProviderChangeRead(buf[b:], &v.Pc)
+	b += ProviderChangeRead(buf[b:], order, &v.Pc)
 
 	b = 0 // always read the same bytes
 	v.Pp = ProviderProperty{}
-	b += ProviderPropertyRead(buf[b:], &v.Pp)
+	b += ProviderPropertyRead(buf[b:], order, &v.Pp)
 
 	b = 0 // always read the same bytes
 	v.Rc = ResourceChange{}
-	b += ResourceChangeRead(buf[b:], &v.Rc)
+	b += ResourceChangeRead(buf[b:], order, &v.Rc)
 
 	return v
 }
 
 // NotifyDataUnionRead reads a byte slice into a NotifyDataUnion value.
-func NotifyDataUnionRead(buf []byte, v *NotifyDataUnion) int {
+func NotifyDataUnionRead(buf []byte, order xgb.ByteOrder, v *NotifyDataUnion) int {
 	var b int
 
 	b = 0 // re-read the same bytes
 	v.Cc = CrtcChange{}
-	b += CrtcChangeRead(buf[b:], &v.Cc)
+	b += CrtcChangeRead(buf[b:], order, &v.Cc)
 
 	b = 0 // re-read the same bytes
 	v.Oc = OutputChange{}
-	b += OutputChangeRead(buf[b:], &v.Oc)
+	b += OutputChangeRead(buf[b:], order, &v.Oc)
 
 	b = 0 // re-read the same bytes
 	v.Op = OutputProperty{}
-	b += OutputPropertyRead(buf[b:], &v.Op)
+	b += OutputPropertyRead(buf[b:], order, &v.Op)
 
 	b = 0 // re-read the same bytes
 	v.Pc = ProviderChange{}
-	b += ProviderChangeRead(buf[b:], &v.Pc)
+	b += ProviderChangeRead(buf[b:], order, &v.Pc)
 
 	b = 0 // re-read the same bytes
 	v.Pp = ProviderProperty{}
-	b += ProviderPropertyRead(buf[b:], &v.Pp)
+	b += ProviderPropertyRead(buf[b:], order, &v.Pp)
 
 	b = 0 // re-read the same bytes
 	v.Rc = ResourceChange{}
-	b += ResourceChangeRead(buf[b:], &v.Rc)
+	b += ResourceChangeRead(buf[b:], order, &v.Rc)
 
 	return 28
 }
 
 // NotifyDataUnionReadList reads a byte slice into a list of NotifyDataUnion values.
-func NotifyDataUnionReadList(buf []byte, dest []NotifyDataUnion) int {
+func NotifyDataUnionReadList(buf []byte, order xgb.ByteOrder, dest []NotifyDataUnion) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = NotifyDataUnion{}
-		b += NotifyDataUnionRead(buf[b:], &dest[i])
+		b += NotifyDataUnionRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a NotifyDataUnion value to a byte slice.
+// Bytes writes a NotifyDataUnion value to a byte slice, in the byte order 'order'.
 // Each field in a union must contain the same data.
 // So simply pick the first field and write that to the wire.
-func (v NotifyDataUnion) Bytes() []byte {
+func (v NotifyDataUnion) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 28)
 	b := 0
 
 	{
-		structBytes := v.Cc.Bytes()
+		structBytes := v.Cc.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -914,27 +1012,69 @@ func (v NotifyDataUnion) Bytes() []byte {
 }
 
 // NotifyDataUnionListBytes writes a list of NotifyDataUnion values to a byte slice.
-func NotifyDataUnionListBytes(buf []byte, list []NotifyDataUnion) int {
+func NotifyDataUnionListBytes(buf []byte, order xgb.ByteOrder, list []NotifyDataUnion) int {
 	b := 0
 	var unionBytes []byte
 	for _, item := range list {
-		unionBytes = item.Bytes()
+		unionBytes = item.Bytes(order)
 		copy(buf[b:], unionBytes)
 		b += xgb.Pad(len(unionBytes))
 	}
 	return b
 }
 
+type NotifyMask uint16
+
 const (
-	NotifyMaskScreenChange     = 1
-	NotifyMaskCrtcChange       = 2
-	NotifyMaskOutputChange     = 4
-	NotifyMaskOutputProperty   = 8
-	NotifyMaskProviderChange   = 16
-	NotifyMaskProviderProperty = 32
-	NotifyMaskResourceChange   = 64
+	NotifyMaskScreenChange     NotifyMask = 1
+	NotifyMaskCrtcChange       NotifyMask = 2
+	NotifyMaskOutputChange     NotifyMask = 4
+	NotifyMaskOutputProperty   NotifyMask = 8
+	NotifyMaskProviderChange   NotifyMask = 16
+	NotifyMaskProviderProperty NotifyMask = 32
+	NotifyMaskResourceChange   NotifyMask = 64
 )
 
+// String returns the names of the NotifyMask bits set in a value.
+func (v NotifyMask) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 7)
+	if v&NotifyMaskScreenChange != 0 {
+		names = append(names, "ScreenChange")
+		v &^= NotifyMaskScreenChange
+	}
+	if v&NotifyMaskCrtcChange != 0 {
+		names = append(names, "CrtcChange")
+		v &^= NotifyMaskCrtcChange
+	}
+	if v&NotifyMaskOutputChange != 0 {
+		names = append(names, "OutputChange")
+		v &^= NotifyMaskOutputChange
+	}
+	if v&NotifyMaskOutputProperty != 0 {
+		names = append(names, "OutputProperty")
+		v &^= NotifyMaskOutputProperty
+	}
+	if v&NotifyMaskProviderChange != 0 {
+		names = append(names, "ProviderChange")
+		v &^= NotifyMaskProviderChange
+	}
+	if v&NotifyMaskProviderProperty != 0 {
+		names = append(names, "ProviderProperty")
+		v &^= NotifyMaskProviderProperty
+	}
+	if v&NotifyMaskResourceChange != 0 {
+		names = append(names, "ResourceChange")
+		v &^= NotifyMaskResourceChange
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint16(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
 type Output uint32
 
 func NewOutputId(c *xgb.Conn) (Output, error) {
@@ -952,37 +1092,37 @@ type OutputChange struct {
 	Output          Output
 	Crtc            Crtc
 	Mode            Mode
-	Rotation        uint16
-	Connection      byte
+	Rotation        Rotation
+	Connection      Connection
 	SubpixelOrder   byte
 }
 
 // OutputChangeRead reads a byte slice into a OutputChange value.
-func OutputChangeRead(buf []byte, v *OutputChange) int {
+func OutputChangeRead(buf []byte, order xgb.ByteOrder, v *OutputChange) int {
 	b := 0
 
-	v.Timestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.ConfigTimestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.ConfigTimestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.Window = xproto.Window(xgb.Get32(buf[b:]))
+	v.Window = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Output = Output(xgb.Get32(buf[b:]))
+	v.Output = Output(order.Get32(buf[b:]))
 	b += 4
 
-	v.Crtc = Crtc(xgb.Get32(buf[b:]))
+	v.Crtc = Crtc(order.Get32(buf[b:]))
 	b += 4
 
-	v.Mode = Mode(xgb.Get32(buf[b:]))
+	v.Mode = Mode(order.Get32(buf[b:]))
 	b += 4
 
-	v.Rotation = xgb.Get16(buf[b:])
+	v.Rotation = Rotation(order.Get16(buf[b:]))
 	b += 2
 
-	v.Connection = buf[b]
+	v.Connection = Connection(buf[b])
 	b += 1
 
 	v.SubpixelOrder = buf[b]
@@ -992,42 +1132,42 @@ func OutputChangeRead(buf []byte, v *OutputChange) int {
 }
 
 // OutputChangeReadList reads a byte slice into a list of OutputChange values.
-func OutputChangeReadList(buf []byte, dest []OutputChange) int {
+func OutputChangeReadList(buf []byte, order xgb.ByteOrder, dest []OutputChange) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = OutputChange{}
-		b += OutputChangeRead(buf[b:], &dest[i])
+		b += OutputChangeRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a OutputChange value to a byte slice.
-func (v OutputChange) Bytes() []byte {
+// Bytes writes a OutputChange value to a byte slice, in the byte order 'order'.
+func (v OutputChange) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 28)
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.Timestamp))
+	order.Put32(buf[b:], uint32(v.Timestamp))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.ConfigTimestamp))
+	order.Put32(buf[b:], uint32(v.ConfigTimestamp))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Output))
+	order.Put32(buf[b:], uint32(v.Output))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Crtc))
+	order.Put32(buf[b:], uint32(v.Crtc))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Mode))
+	order.Put32(buf[b:], uint32(v.Mode))
 	b += 4
 
-	xgb.Put16(buf[b:], v.Rotation)
+	order.Put16(buf[b:], uint16(v.Rotation))
 	b += 2
 
-	buf[b] = v.Connection
+	buf[b] = byte(v.Connection)
 	b += 1
 
 	buf[b] = v.SubpixelOrder
@@ -1037,11 +1177,11 @@ func (v OutputChange) Bytes() []byte {
 }
 
 // OutputChangeListBytes writes a list of OutputChange values to a byte slice.
-func OutputChangeListBytes(buf []byte, list []OutputChange) int {
+func OutputChangeListBytes(buf []byte, order xgb.ByteOrder, list []OutputChange) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -1053,27 +1193,27 @@ type OutputProperty struct {
 	Output    Output
 	Atom      xproto.Atom
 	Timestamp xproto.Timestamp
-	Status    byte
+	Status    xproto.Property
 	// padding: 11 bytes
 }
 
 // OutputPropertyRead reads a byte slice into a OutputProperty value.
-func OutputPropertyRead(buf []byte, v *OutputProperty) int {
+func OutputPropertyRead(buf []byte, order xgb.ByteOrder, v *OutputProperty) int {
 	b := 0
 
-	v.Window = xproto.Window(xgb.Get32(buf[b:]))
+	v.Window = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Output = Output(xgb.Get32(buf[b:]))
+	v.Output = Output(order.Get32(buf[b:]))
 	b += 4
 
-	v.Atom = xproto.Atom(xgb.Get32(buf[b:]))
+	v.Atom = xproto.Atom(order.Get32(buf[b:]))
 	b += 4
 
-	v.Timestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.Status = buf[b]
+	v.Status = xproto.Property(buf[b])
 	b += 1
 
 	b += 11 // padding
@@ -1082,33 +1222,33 @@ func OutputPropertyRead(buf []byte, v *OutputProperty) int {
 }
 
 // OutputPropertyReadList reads a byte slice into a list of OutputProperty values.
-func OutputPropertyReadList(buf []byte, dest []OutputProperty) int {
+func OutputPropertyReadList(buf []byte, order xgb.ByteOrder, dest []OutputProperty) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = OutputProperty{}
-		b += OutputPropertyRead(buf[b:], &dest[i])
+		b += OutputPropertyRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a OutputProperty value to a byte slice.
-func (v OutputProperty) Bytes() []byte {
+// Bytes writes a OutputProperty value to a byte slice, in the byte order 'order'.
+func (v OutputProperty) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 28)
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Output))
+	order.Put32(buf[b:], uint32(v.Output))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Atom))
+	order.Put32(buf[b:], uint32(v.Atom))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Timestamp))
+	order.Put32(buf[b:], uint32(v.Timestamp))
 	b += 4
 
-	buf[b] = v.Status
+	buf[b] = byte(v.Status)
 	b += 1
 
 	b += 11 // padding
@@ -1117,11 +1257,11 @@ func (v OutputProperty) Bytes() []byte {
 }
 
 // OutputPropertyListBytes writes a list of OutputProperty values to a byte slice.
-func OutputPropertyListBytes(buf []byte, list []OutputProperty) int {
+func OutputPropertyListBytes(buf []byte, order xgb.ByteOrder, list []OutputProperty) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -1138,13 +1278,43 @@ func NewProviderId(c *xgb.Conn) (Provider, error) {
 	return Provider(id), nil
 }
 
+type ProviderCapability uint32
+
 const (
-	ProviderCapabilitySourceOutput  = 1
-	ProviderCapabilitySinkOutput    = 2
-	ProviderCapabilitySourceOffload = 4
-	ProviderCapabilitySinkOffload   = 8
+	ProviderCapabilitySourceOutput  ProviderCapability = 1
+	ProviderCapabilitySinkOutput    ProviderCapability = 2
+	ProviderCapabilitySourceOffload ProviderCapability = 4
+	ProviderCapabilitySinkOffload   ProviderCapability = 8
 )
 
+// String returns the names of the ProviderCapability bits set in a value.
+func (v ProviderCapability) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 4)
+	if v&ProviderCapabilitySourceOutput != 0 {
+		names = append(names, "SourceOutput")
+		v &^= ProviderCapabilitySourceOutput
+	}
+	if v&ProviderCapabilitySinkOutput != 0 {
+		names = append(names, "SinkOutput")
+		v &^= ProviderCapabilitySinkOutput
+	}
+	if v&ProviderCapabilitySourceOffload != 0 {
+		names = append(names, "SourceOffload")
+		v &^= ProviderCapabilitySourceOffload
+	}
+	if v&ProviderCapabilitySinkOffload != 0 {
+		names = append(names, "SinkOffload")
+		v &^= ProviderCapabilitySinkOffload
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint32(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
 type ProviderChange struct {
 	Timestamp xproto.Timestamp
 	Window    xproto.Window
@@ -1153,16 +1323,16 @@ type ProviderChange struct {
 }
 
 // ProviderChangeRead reads a byte slice into a ProviderChange value.
-func ProviderChangeRead(buf []byte, v *ProviderChange) int {
+func ProviderChangeRead(buf []byte, order xgb.ByteOrder, v *ProviderChange) int {
 	b := 0
 
-	v.Timestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.Window = xproto.Window(xgb.Get32(buf[b:]))
+	v.Window = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Provider = Provider(xgb.Get32(buf[b:]))
+	v.Provider = Provider(order.Get32(buf[b:]))
 	b += 4
 
 	b += 16 // padding
@@ -1171,27 +1341,27 @@ func ProviderChangeRead(buf []byte, v *ProviderChange) int {
 }
 
 // ProviderChangeReadList reads a byte slice into a list of ProviderChange values.
-func ProviderChangeReadList(buf []byte, dest []ProviderChange) int {
+func ProviderChangeReadList(buf []byte, order xgb.ByteOrder, dest []ProviderChange) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = ProviderChange{}
-		b += ProviderChangeRead(buf[b:], &dest[i])
+		b += ProviderChangeRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a ProviderChange value to a byte slice.
-func (v ProviderChange) Bytes() []byte {
+// Bytes writes a ProviderChange value to a byte slice, in the byte order 'order'.
+func (v ProviderChange) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 28)
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.Timestamp))
+	order.Put32(buf[b:], uint32(v.Timestamp))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Provider))
+	order.Put32(buf[b:], uint32(v.Provider))
 	b += 4
 
 	b += 16 // padding
@@ -1200,11 +1370,11 @@ func (v ProviderChange) Bytes() []byte {
 }
 
 // ProviderChangeListBytes writes a list of ProviderChange values to a byte slice.
-func ProviderChangeListBytes(buf []byte, list []ProviderChange) int {
+func ProviderChangeListBytes(buf []byte, order xgb.ByteOrder, list []ProviderChange) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -1216,27 +1386,27 @@ type ProviderProperty struct {
 	Provider  Provider
 	Atom      xproto.Atom
 	Timestamp xproto.Timestamp
-	State     byte
+	State     xproto.Property
 	// padding: 11 bytes
 }
 
 // ProviderPropertyRead reads a byte slice into a ProviderProperty value.
-func ProviderPropertyRead(buf []byte, v *ProviderProperty) int {
+func ProviderPropertyRead(buf []byte, order xgb.ByteOrder, v *ProviderProperty) int {
 	b := 0
 
-	v.Window = xproto.Window(xgb.Get32(buf[b:]))
+	v.Window = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Provider = Provider(xgb.Get32(buf[b:]))
+	v.Provider = Provider(order.Get32(buf[b:]))
 	b += 4
 
-	v.Atom = xproto.Atom(xgb.Get32(buf[b:]))
+	v.Atom = xproto.Atom(order.Get32(buf[b:]))
 	b += 4
 
-	v.Timestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.State = buf[b]
+	v.State = xproto.Property(buf[b])
 	b += 1
 
 	b += 11 // padding
@@ -1245,33 +1415,33 @@ func ProviderPropertyRead(buf []byte, v *ProviderProperty) int {
 }
 
 // ProviderPropertyReadList reads a byte slice into a list of ProviderProperty values.
-func ProviderPropertyReadList(buf []byte, dest []ProviderProperty) int {
+func ProviderPropertyReadList(buf []byte, order xgb.ByteOrder, dest []ProviderProperty) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = ProviderProperty{}
-		b += ProviderPropertyRead(buf[b:], &dest[i])
+		b += ProviderPropertyRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a ProviderProperty value to a byte slice.
-func (v ProviderProperty) Bytes() []byte {
+// Bytes writes a ProviderProperty value to a byte slice, in the byte order 'order'.
+func (v ProviderProperty) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 28)
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Provider))
+	order.Put32(buf[b:], uint32(v.Provider))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Atom))
+	order.Put32(buf[b:], uint32(v.Atom))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Timestamp))
+	order.Put32(buf[b:], uint32(v.Timestamp))
 	b += 4
 
-	buf[b] = v.State
+	buf[b] = byte(v.State)
 	b += 1
 
 	b += 11 // padding
@@ -1280,11 +1450,11 @@ func (v ProviderProperty) Bytes() []byte {
 }
 
 // ProviderPropertyListBytes writes a list of ProviderProperty values to a byte slice.
-func ProviderPropertyListBytes(buf []byte, list []ProviderProperty) int {
+func ProviderPropertyListBytes(buf []byte, order xgb.ByteOrder, list []ProviderProperty) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -1297,15 +1467,15 @@ type RefreshRates struct {
 }
 
 // RefreshRatesRead reads a byte slice into a RefreshRates value.
-func RefreshRatesRead(buf []byte, v *RefreshRates) int {
+func RefreshRatesRead(buf []byte, order xgb.ByteOrder, v *RefreshRates) int {
 	b := 0
 
-	v.NRates = xgb.Get16(buf[b:])
+	v.NRates = order.Get16(buf[b:])
 	b += 2
 
 	v.Rates = make([]uint16, v.NRates)
 	for i := 0; i < int(v.NRates); i++ {
-		v.Rates[i] = xgb.Get16(buf[b:])
+		v.Rates[i] = order.Get16(buf[b:])
 		b += 2
 	}
 
@@ -1313,25 +1483,25 @@ func RefreshRatesRead(buf []byte, v *RefreshRates) int {
 }
 
 // RefreshRatesReadList reads a byte slice into a list of RefreshRates values.
-func RefreshRatesReadList(buf []byte, dest []RefreshRates) int {
+func RefreshRatesReadList(buf []byte, order xgb.ByteOrder, dest []RefreshRates) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = RefreshRates{}
-		b += RefreshRatesRead(buf[b:], &dest[i])
+		b += RefreshRatesRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a RefreshRates value to a byte slice.
-func (v RefreshRates) Bytes() []byte {
+// Bytes writes a RefreshRates value to a byte slice, in the byte order 'order'.
+func (v RefreshRates) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, (2 + xgb.Pad((int(v.NRates) * 2))))
 	b := 0
 
-	xgb.Put16(buf[b:], v.NRates)
+	order.Put16(buf[b:], v.NRates)
 	b += 2
 
 	for i := 0; i < int(v.NRates); i++ {
-		xgb.Put16(buf[b:], v.Rates[i])
+		order.Put16(buf[b:], v.Rates[i])
 		b += 2
 	}
 
@@ -1339,11 +1509,11 @@ func (v RefreshRates) Bytes() []byte {
 }
 
 // RefreshRatesListBytes writes a list of RefreshRates values to a byte slice.
-func RefreshRatesListBytes(buf []byte, list []RefreshRates) int {
+func RefreshRatesListBytes(buf []byte, order xgb.ByteOrder, list []RefreshRates) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -1366,13 +1536,13 @@ type ResourceChange struct {
 }
 
 // ResourceChangeRead reads a byte slice into a ResourceChange value.
-func ResourceChangeRead(buf []byte, v *ResourceChange) int {
+func ResourceChangeRead(buf []byte, order xgb.ByteOrder, v *ResourceChange) int {
 	b := 0
 
-	v.Timestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.Window = xproto.Window(xgb.Get32(buf[b:]))
+	v.Window = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
 	b += 20 // padding
@@ -1381,24 +1551,24 @@ func ResourceChangeRead(buf []byte, v *ResourceChange) int {
 }
 
 // ResourceChangeReadList reads a byte slice into a list of ResourceChange values.
-func ResourceChangeReadList(buf []byte, dest []ResourceChange) int {
+func ResourceChangeReadList(buf []byte, order xgb.ByteOrder, dest []ResourceChange) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = ResourceChange{}
-		b += ResourceChangeRead(buf[b:], &dest[i])
+		b += ResourceChangeRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a ResourceChange value to a byte slice.
-func (v ResourceChange) Bytes() []byte {
+// Bytes writes a ResourceChange value to a byte slice, in the byte order 'order'.
+func (v ResourceChange) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 28)
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.Timestamp))
+	order.Put32(buf[b:], uint32(v.Timestamp))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
 	b += 20 // padding
@@ -1407,32 +1577,70 @@ func (v ResourceChange) Bytes() []byte {
 }
 
 // ResourceChangeListBytes writes a list of ResourceChange values to a byte slice.
-func ResourceChangeListBytes(buf []byte, list []ResourceChange) int {
+func ResourceChangeListBytes(buf []byte, order xgb.ByteOrder, list []ResourceChange) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 	return xgb.Pad(b)
 }
 
+type Rotation uint16
+
 const (
-	RotationRotate0   = 1
-	RotationRotate90  = 2
-	RotationRotate180 = 4
-	RotationRotate270 = 8
-	RotationReflectX  = 16
-	RotationReflectY  = 32
+	RotationRotate0   Rotation = 1
+	RotationRotate90  Rotation = 2
+	RotationRotate180 Rotation = 4
+	RotationRotate270 Rotation = 8
+	RotationReflectX  Rotation = 16
+	RotationReflectY  Rotation = 32
 )
 
+// String returns the names of the Rotation bits set in a value.
+func (v Rotation) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 6)
+	if v&RotationRotate0 != 0 {
+		names = append(names, "Rotate0")
+		v &^= RotationRotate0
+	}
+	if v&RotationRotate90 != 0 {
+		names = append(names, "Rotate90")
+		v &^= RotationRotate90
+	}
+	if v&RotationRotate180 != 0 {
+		names = append(names, "Rotate180")
+		v &^= RotationRotate180
+	}
+	if v&RotationRotate270 != 0 {
+		names = append(names, "Rotate270")
+		v &^= RotationRotate270
+	}
+	if v&RotationReflectX != 0 {
+		names = append(names, "ReflectX")
+		v &^= RotationReflectX
+	}
+	if v&RotationReflectY != 0 {
+		names = append(names, "ReflectY")
+		v &^= RotationReflectY
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint16(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
 // ScreenChangeNotify is the event number for a ScreenChangeNotifyEvent.
 const ScreenChangeNotify = 0
 
 type ScreenChangeNotifyEvent struct {
 	Sequence        uint16
-	Rotation        byte
+	Rotation        Rotation
 	Timestamp       xproto.Timestamp
 	ConfigTimestamp xproto.Timestamp
 	Root            xproto.Window
@@ -1445,52 +1653,52 @@ type ScreenChangeNotifyEvent struct {
 	Mheight         uint16
 }
 
-// ScreenChangeNotifyEventNew constructs a ScreenChangeNotifyEvent value that implements xgb.Event from a byte slice.
-func ScreenChangeNotifyEventNew(buf []byte) xgb.Event {
+// ScreenChangeNotifyEventNew constructs a ScreenChangeNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func ScreenChangeNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := ScreenChangeNotifyEvent{}
 	b := 1 // don't read event number
 
-	v.Rotation = buf[b]
+	v.Rotation = Rotation(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Timestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.ConfigTimestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.ConfigTimestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.Root = xproto.Window(xgb.Get32(buf[b:]))
+	v.Root = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.RequestWindow = xproto.Window(xgb.Get32(buf[b:]))
+	v.RequestWindow = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.SizeID = xgb.Get16(buf[b:])
+	v.SizeID = order.Get16(buf[b:])
 	b += 2
 
-	v.SubpixelOrder = xgb.Get16(buf[b:])
+	v.SubpixelOrder = order.Get16(buf[b:])
 	b += 2
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
-	v.Mwidth = xgb.Get16(buf[b:])
+	v.Mwidth = order.Get16(buf[b:])
 	b += 2
 
-	v.Mheight = xgb.Get16(buf[b:])
+	v.Mheight = order.Get16(buf[b:])
 	b += 2
 
 	return v
 }
 
-// Bytes writes a ScreenChangeNotifyEvent value to a byte slice.
-func (v ScreenChangeNotifyEvent) Bytes() []byte {
+// Bytes writes a ScreenChangeNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v ScreenChangeNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -1498,39 +1706,39 @@ func (v ScreenChangeNotifyEvent) Bytes() []byte {
 	buf[b] = 0
 	b += 1
 
-	buf[b] = v.Rotation
+	buf[b] = byte(v.Rotation)
 	b += 1
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Timestamp))
+	order.Put32(buf[b:], uint32(v.Timestamp))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.ConfigTimestamp))
+	order.Put32(buf[b:], uint32(v.ConfigTimestamp))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Root))
+	order.Put32(buf[b:], uint32(v.Root))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.RequestWindow))
+	order.Put32(buf[b:], uint32(v.RequestWindow))
 	b += 4
 
-	xgb.Put16(buf[b:], v.SizeID)
+	order.Put16(buf[b:], v.SizeID)
 	b += 2
 
-	xgb.Put16(buf[b:], v.SubpixelOrder)
+	order.Put16(buf[b:], v.SubpixelOrder)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Width)
+	order.Put16(buf[b:], v.Width)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Height)
+	order.Put16(buf[b:], v.Height)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Mwidth)
+	order.Put16(buf[b:], v.Mwidth)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Mheight)
+	order.Put16(buf[b:], v.Mheight)
 	b += 2
 
 	return buf
@@ -1547,7 +1755,7 @@ func (v ScreenChangeNotifyEvent) SequenceId() uint16 {
 func (v ScreenChangeNotifyEvent) String() string {
 	fieldVals := make([]string, 0, 11)
 	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
-	fieldVals = append(fieldVals, xgb.Sprintf("Rotation: %d", v.Rotation))
+	fieldVals = append(fieldVals, xgb.Sprintf("Rotation: %s", v.Rotation))
 	fieldVals = append(fieldVals, xgb.Sprintf("Timestamp: %d", v.Timestamp))
 	fieldVals = append(fieldVals, xgb.Sprintf("ConfigTimestamp: %d", v.ConfigTimestamp))
 	fieldVals = append(fieldVals, xgb.Sprintf("Root: %d", v.Root))
@@ -1561,6 +1769,12 @@ func (v ScreenChangeNotifyEvent) String() string {
 	return "ScreenChangeNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the ScreenChangeNotify event is about.
+// It is used to subscribe to the events of a single window.
+func (v ScreenChangeNotifyEvent) EventWindow() uint32 {
+	return uint32(v.RequestWindow)
+}
+
 func init() {
 	xgb.NewExtEventFuncs["RANDR"][0] = ScreenChangeNotifyEventNew
 }
@@ -1573,73 +1787,90 @@ type ScreenSize struct {
 }
 
 // ScreenSizeRead reads a byte slice into a ScreenSize value.
-func ScreenSizeRead(buf []byte, v *ScreenSize) int {
+func ScreenSizeRead(buf []byte, order xgb.ByteOrder, v *ScreenSize) int {
 	b := 0
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
-	v.Mwidth = xgb.Get16(buf[b:])
+	v.Mwidth = order.Get16(buf[b:])
 	b += 2
 
-	v.Mheight = xgb.Get16(buf[b:])
+	v.Mheight = order.Get16(buf[b:])
 	b += 2
 
 	return b
 }
 
 // ScreenSizeReadList reads a byte slice into a list of ScreenSize values.
-func ScreenSizeReadList(buf []byte, dest []ScreenSize) int {
+func ScreenSizeReadList(buf []byte, order xgb.ByteOrder, dest []ScreenSize) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = ScreenSize{}
-		b += ScreenSizeRead(buf[b:], &dest[i])
+		b += ScreenSizeRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a ScreenSize value to a byte slice.
-func (v ScreenSize) Bytes() []byte {
+// Bytes writes a ScreenSize value to a byte slice, in the byte order 'order'.
+func (v ScreenSize) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 8)
 	b := 0
 
-	xgb.Put16(buf[b:], v.Width)
+	order.Put16(buf[b:], v.Width)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Height)
+	order.Put16(buf[b:], v.Height)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Mwidth)
+	order.Put16(buf[b:], v.Mwidth)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Mheight)
+	order.Put16(buf[b:], v.Mheight)
 	b += 2
 
 	return buf[:b]
 }
 
 // ScreenSizeListBytes writes a list of ScreenSize values to a byte slice.
-func ScreenSizeListBytes(buf []byte, list []ScreenSize) int {
+func ScreenSizeListBytes(buf []byte, order xgb.ByteOrder, list []ScreenSize) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 	return xgb.Pad(b)
 }
 
+type SetConfig byte
+
 const (
-	SetConfigSuccess           = 0
-	SetConfigInvalidConfigTime = 1
-	SetConfigInvalidTime       = 2
-	SetConfigFailed            = 3
+	SetConfigSuccess           SetConfig = 0
+	SetConfigInvalidConfigTime SetConfig = 1
+	SetConfigInvalidTime       SetConfig = 2
+	SetConfigFailed            SetConfig = 3
 )
 
+// String returns the name of the SetConfig item with this value.
+func (v SetConfig) String() string {
+	switch v {
+	case SetConfigSuccess:
+		return "Success"
+	case SetConfigInvalidConfigTime:
+		return "InvalidConfigTime"
+	case SetConfigInvalidTime:
+		return "InvalidTime"
+	case SetConfigFailed:
+		return "Failed"
+	}
+	return xgb.Sprintf("SetConfig(%d)", byte(v))
+}
+
 const (
 	TransformUnit       = 1
 	TransformScaleUp    = 2
@@ -1671,6 +1902,8 @@ const (
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // AddOutputModeCookie is a cookie used only for AddOutputMode requests.
 type AddOutputModeCookie struct {
 	*xgb.Cookie
@@ -1708,12 +1941,26 @@ func (cook AddOutputModeCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook AddOutputModeCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for AddOutputMode
 // addOutputModeRequest writes a AddOutputMode request to a byte slice.
 func addOutputModeRequest(c *xgb.Conn, Output Output, Mode Mode) []byte {
+	return AppendAddOutputModeRequest(nil, c, Output, Mode)
+}
+
+// AppendAddOutputModeRequest appends a AddOutputMode request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendAddOutputModeRequest(buf []byte, c *xgb.Conn, Output Output, Mode Mode) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -1723,18 +1970,47 @@ func addOutputModeRequest(c *xgb.Conn, Output Output, Mode Mode) []byte {
 	buf[b] = 18 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Output))
+	order.Put32(buf[b:], uint32(Output))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Mode))
+	order.Put32(buf[b:], uint32(Mode))
 	b += 4
 
 	return buf
 }
 
+// AddOutputModeRequest represents the data of a AddOutputMode request, as it is read when tracing a connection.
+type AddOutputModeRequest struct {
+	Output Output
+	Mode   Mode
+}
+
+// addOutputModeRequestRead reads a byte slice in the byte order 'order' into a AddOutputModeRequest value.
+func addOutputModeRequestRead(buf []byte, order xgb.ByteOrder) *AddOutputModeRequest {
+	v := new(AddOutputModeRequest)
+	b := 4 // skip request header
+
+	v.Output = Output(order.Get32(buf[b:]))
+	b += 4
+
+	v.Mode = Mode(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][18] = xgb.RequestInfo{
+		Name: "AddOutputMode",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return addOutputModeRequestRead(buf, order)
+		},
+	}
+}
+
 // ChangeOutputPropertyCookie is a cookie used only for ChangeOutputProperty requests.
 type ChangeOutputPropertyCookie struct {
 	*xgb.Cookie
@@ -1742,7 +2018,7 @@ type ChangeOutputPropertyCookie struct {
 
 // ChangeOutputProperty sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func ChangeOutputProperty(c *xgb.Conn, Output Output, Property xproto.Atom, Type xproto.Atom, Format byte, Mode byte, NumUnits uint32, Data []byte) ChangeOutputPropertyCookie {
+func ChangeOutputProperty(c *xgb.Conn, Output Output, Property xproto.Atom, Type xproto.Atom, Format byte, Mode xproto.PropMode, NumUnits uint32, Data []byte) ChangeOutputPropertyCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RANDR"]; !ok {
@@ -1755,7 +2031,7 @@ func ChangeOutputProperty(c *xgb.Conn, Output Output, Property xproto.Atom, Type
 
 // ChangeOutputPropertyChecked sends a checked request.
 // If an error occurs, it can be retrieved using ChangeOutputPropertyCookie.Check()
-func ChangeOutputPropertyChecked(c *xgb.Conn, Output Output, Property xproto.Atom, Type xproto.Atom, Format byte, Mode byte, NumUnits uint32, Data []byte) ChangeOutputPropertyCookie {
+func ChangeOutputPropertyChecked(c *xgb.Conn, Output Output, Property xproto.Atom, Type xproto.Atom, Format byte, Mode xproto.PropMode, NumUnits uint32, Data []byte) ChangeOutputPropertyCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RANDR"]; !ok {
@@ -1772,12 +2048,26 @@ func (cook ChangeOutputPropertyCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ChangeOutputPropertyCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ChangeOutputProperty
 // changeOutputPropertyRequest writes a ChangeOutputProperty request to a byte slice.
-func changeOutputPropertyRequest(c *xgb.Conn, Output Output, Property xproto.Atom, Type xproto.Atom, Format byte, Mode byte, NumUnits uint32, Data []byte) []byte {
+func changeOutputPropertyRequest(c *xgb.Conn, Output Output, Property xproto.Atom, Type xproto.Atom, Format byte, Mode xproto.PropMode, NumUnits uint32, Data []byte) []byte {
+	return AppendChangeOutputPropertyRequest(nil, c, Output, Property, Type, Format, Mode, NumUnits, Data)
+}
+
+// AppendChangeOutputPropertyRequest appends a ChangeOutputProperty request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendChangeOutputPropertyRequest(buf []byte, c *xgb.Conn, Output Output, Property xproto.Atom, Type xproto.Atom, Format byte, Mode xproto.PropMode, NumUnits uint32, Data []byte) []byte {
 	size := xgb.Pad((24 + xgb.Pad((((int(NumUnits) * int(Format)) / 8) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -1787,27 +2077,27 @@ func changeOutputPropertyRequest(c *xgb.Conn, Output Output, Property xproto.Ato
 	buf[b] = 13 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Output))
+	order.Put32(buf[b:], uint32(Output))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Property))
+	order.Put32(buf[b:], uint32(Property))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Type))
+	order.Put32(buf[b:], uint32(Type))
 	b += 4
 
 	buf[b] = Format
 	b += 1
 
-	buf[b] = Mode
+	buf[b] = byte(Mode)
 	b += 1
 
 	b += 2 // padding
 
-	xgb.Put32(buf[b:], NumUnits)
+	order.Put32(buf[b:], NumUnits)
 	b += 4
 
 	copy(buf[b:], Data[:((int(NumUnits)*int(Format))/8)])
@@ -1816,6 +2106,59 @@ func changeOutputPropertyRequest(c *xgb.Conn, Output Output, Property xproto.Ato
 	return buf
 }
 
+// ChangeOutputPropertyRequest represents the data of a ChangeOutputProperty request, as it is read when tracing a connection.
+type ChangeOutputPropertyRequest struct {
+	Output   Output
+	Property xproto.Atom
+	Type     xproto.Atom
+	Format   byte
+	Mode     xproto.PropMode
+	// padding: 2 bytes
+	NumUnits uint32
+	Data     []byte // size: xgb.Pad((((int(NumUnits) * int(Format)) / 8) * 1))
+}
+
+// changeOutputPropertyRequestRead reads a byte slice in the byte order 'order' into a ChangeOutputPropertyRequest value.
+func changeOutputPropertyRequestRead(buf []byte, order xgb.ByteOrder) *ChangeOutputPropertyRequest {
+	v := new(ChangeOutputPropertyRequest)
+	b := 4 // skip request header
+
+	v.Output = Output(order.Get32(buf[b:]))
+	b += 4
+
+	v.Property = xproto.Atom(order.Get32(buf[b:]))
+	b += 4
+
+	v.Type = xproto.Atom(order.Get32(buf[b:]))
+	b += 4
+
+	v.Format = buf[b]
+	b += 1
+
+	v.Mode = xproto.PropMode(buf[b])
+	b += 1
+
+	b += 2 // padding
+
+	v.NumUnits = order.Get32(buf[b:])
+	b += 4
+
+	v.Data = make([]byte, ((int(v.NumUnits) * int(v.Format)) / 8))
+	copy(v.Data[:((int(v.NumUnits)*int(v.Format))/8)], buf[b:])
+	b += int(((int(v.NumUnits) * int(v.Format)) / 8))
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][13] = xgb.RequestInfo{
+		Name: "ChangeOutputProperty",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return changeOutputPropertyRequestRead(buf, order)
+		},
+	}
+}
+
 // ChangeProviderPropertyCookie is a cookie used only for ChangeProviderProperty requests.
 type ChangeProviderPropertyCookie struct {
 	*xgb.Cookie
@@ -1823,7 +2166,7 @@ type ChangeProviderPropertyCookie struct {
 
 // ChangeProviderProperty sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func ChangeProviderProperty(c *xgb.Conn, Provider Provider, Property xproto.Atom, Type xproto.Atom, Format byte, Mode byte, NumItems uint32, Data []byte) ChangeProviderPropertyCookie {
+func ChangeProviderProperty(c *xgb.Conn, Provider Provider, Property xproto.Atom, Type xproto.Atom, Format byte, Mode xproto.PropMode, NumItems uint32, Data []byte) ChangeProviderPropertyCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RANDR"]; !ok {
@@ -1836,7 +2179,7 @@ func ChangeProviderProperty(c *xgb.Conn, Provider Provider, Property xproto.Atom
 
 // ChangeProviderPropertyChecked sends a checked request.
 // If an error occurs, it can be retrieved using ChangeProviderPropertyCookie.Check()
-func ChangeProviderPropertyChecked(c *xgb.Conn, Provider Provider, Property xproto.Atom, Type xproto.Atom, Format byte, Mode byte, NumItems uint32, Data []byte) ChangeProviderPropertyCookie {
+func ChangeProviderPropertyChecked(c *xgb.Conn, Provider Provider, Property xproto.Atom, Type xproto.Atom, Format byte, Mode xproto.PropMode, NumItems uint32, Data []byte) ChangeProviderPropertyCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RANDR"]; !ok {
@@ -1853,12 +2196,26 @@ func (cook ChangeProviderPropertyCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ChangeProviderPropertyCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ChangeProviderProperty
 // changeProviderPropertyRequest writes a ChangeProviderProperty request to a byte slice.
-func changeProviderPropertyRequest(c *xgb.Conn, Provider Provider, Property xproto.Atom, Type xproto.Atom, Format byte, Mode byte, NumItems uint32, Data []byte) []byte {
+func changeProviderPropertyRequest(c *xgb.Conn, Provider Provider, Property xproto.Atom, Type xproto.Atom, Format byte, Mode xproto.PropMode, NumItems uint32, Data []byte) []byte {
+	return AppendChangeProviderPropertyRequest(nil, c, Provider, Property, Type, Format, Mode, NumItems, Data)
+}
+
+// AppendChangeProviderPropertyRequest appends a ChangeProviderProperty request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendChangeProviderPropertyRequest(buf []byte, c *xgb.Conn, Provider Provider, Property xproto.Atom, Type xproto.Atom, Format byte, Mode xproto.PropMode, NumItems uint32, Data []byte) []byte {
 	size := xgb.Pad((24 + xgb.Pad(((int(NumItems) * (int(Format) / 8)) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -1868,27 +2225,27 @@ func changeProviderPropertyRequest(c *xgb.Conn, Provider Provider, Property xpro
 	buf[b] = 39 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Provider))
+	order.Put32(buf[b:], uint32(Provider))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Property))
+	order.Put32(buf[b:], uint32(Property))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Type))
+	order.Put32(buf[b:], uint32(Type))
 	b += 4
 
 	buf[b] = Format
 	b += 1
 
-	buf[b] = Mode
+	buf[b] = byte(Mode)
 	b += 1
 
 	b += 2 // padding
 
-	xgb.Put32(buf[b:], NumItems)
+	order.Put32(buf[b:], NumItems)
 	b += 4
 
 	copy(buf[b:], Data[:(int(NumItems)*(int(Format)/8))])
@@ -1897,6 +2254,59 @@ func changeProviderPropertyRequest(c *xgb.Conn, Provider Provider, Property xpro
 	return buf
 }
 
+// ChangeProviderPropertyRequest represents the data of a ChangeProviderProperty request, as it is read when tracing a connection.
+type ChangeProviderPropertyRequest struct {
+	Provider Provider
+	Property xproto.Atom
+	Type     xproto.Atom
+	Format   byte
+	Mode     xproto.PropMode
+	// padding: 2 bytes
+	NumItems uint32
+	Data     []byte // size: xgb.Pad(((int(NumItems) * (int(Format) / 8)) * 1))
+}
+
+// changeProviderPropertyRequestRead reads a byte slice in the byte order 'order' into a ChangeProviderPropertyRequest value.
+func changeProviderPropertyRequestRead(buf []byte, order xgb.ByteOrder) *ChangeProviderPropertyRequest {
+	v := new(ChangeProviderPropertyRequest)
+	b := 4 // skip request header
+
+	v.Provider = Provider(order.Get32(buf[b:]))
+	b += 4
+
+	v.Property = xproto.Atom(order.Get32(buf[b:]))
+	b += 4
+
+	v.Type = xproto.Atom(order.Get32(buf[b:]))
+	b += 4
+
+	v.Format = buf[b]
+	b += 1
+
+	v.Mode = xproto.PropMode(buf[b])
+	b += 1
+
+	b += 2 // padding
+
+	v.NumItems = order.Get32(buf[b:])
+	b += 4
+
+	v.Data = make([]byte, (int(v.NumItems) * (int(v.Format) / 8)))
+	copy(v.Data[:(int(v.NumItems)*(int(v.Format)/8))], buf[b:])
+	b += int((int(v.NumItems) * (int(v.Format) / 8)))
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][39] = xgb.RequestInfo{
+		Name: "ChangeProviderProperty",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return changeProviderPropertyRequestRead(buf, order)
+		},
+	}
+}
+
 // ConfigureOutputPropertyCookie is a cookie used only for ConfigureOutputProperty requests.
 type ConfigureOutputPropertyCookie struct {
 	*xgb.Cookie
@@ -1934,12 +2344,26 @@ func (cook ConfigureOutputPropertyCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ConfigureOutputPropertyCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ConfigureOutputProperty
 // configureOutputPropertyRequest writes a ConfigureOutputProperty request to a byte slice.
 func configureOutputPropertyRequest(c *xgb.Conn, Output Output, Property xproto.Atom, Pending bool, Range bool, Values []int32) []byte {
+	return AppendConfigureOutputPropertyRequest(nil, c, Output, Property, Pending, Range, Values)
+}
+
+// AppendConfigureOutputPropertyRequest appends a ConfigureOutputProperty request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendConfigureOutputPropertyRequest(buf []byte, c *xgb.Conn, Output Output, Property xproto.Atom, Pending bool, Range bool, Values []int32) []byte {
 	size := xgb.Pad((16 + xgb.Pad((len(Values) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -1949,13 +2373,13 @@ func configureOutputPropertyRequest(c *xgb.Conn, Output Output, Property xproto.
 	buf[b] = 12 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Output))
+	order.Put32(buf[b:], uint32(Output))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Property))
+	order.Put32(buf[b:], uint32(Property))
 	b += 4
 
 	if Pending {
@@ -1975,13 +2399,68 @@ func configureOutputPropertyRequest(c *xgb.Conn, Output Output, Property xproto.
 	b += 2 // padding
 
 	for i := 0; i < int(len(Values)); i++ {
-		xgb.Put32(buf[b:], uint32(Values[i]))
+		order.Put32(buf[b:], uint32(Values[i]))
 		b += 4
 	}
 
 	return buf
 }
 
+// ConfigureOutputPropertyRequest represents the data of a ConfigureOutputProperty request, as it is read when tracing a connection.
+type ConfigureOutputPropertyRequest struct {
+	Output   Output
+	Property xproto.Atom
+	Pending  bool
+	Range    bool
+	// padding: 2 bytes
+	Values []int32 // size: xgb.Pad((len(Values) * 4))
+}
+
+// configureOutputPropertyRequestRead reads a byte slice in the byte order 'order' into a ConfigureOutputPropertyRequest value.
+func configureOutputPropertyRequestRead(buf []byte, order xgb.ByteOrder) *ConfigureOutputPropertyRequest {
+	v := new(ConfigureOutputPropertyRequest)
+	b := 4 // skip request header
+
+	v.Output = Output(order.Get32(buf[b:]))
+	b += 4
+
+	v.Property = xproto.Atom(order.Get32(buf[b:]))
+	b += 4
+
+	if buf[b] == 1 {
+		v.Pending = true
+	} else {
+		v.Pending = false
+	}
+	b += 1
+
+	if buf[b] == 1 {
+		v.Range = true
+	} else {
+		v.Range = false
+	}
+	b += 1
+
+	b += 2 // padding
+
+	v.Values = make([]int32, (len(buf)-b)/4)
+	for i := 0; i < int((len(buf)-b)/4); i++ {
+		v.Values[i] = int32(order.Get32(buf[b:]))
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][12] = xgb.RequestInfo{
+		Name: "ConfigureOutputProperty",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return configureOutputPropertyRequestRead(buf, order)
+		},
+	}
+}
+
 // ConfigureProviderPropertyCookie is a cookie used only for ConfigureProviderProperty requests.
 type ConfigureProviderPropertyCookie struct {
 	*xgb.Cookie
@@ -2019,12 +2498,26 @@ func (cook ConfigureProviderPropertyCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ConfigureProviderPropertyCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ConfigureProviderProperty
 // configureProviderPropertyRequest writes a ConfigureProviderProperty request to a byte slice.
 func configureProviderPropertyRequest(c *xgb.Conn, Provider Provider, Property xproto.Atom, Pending bool, Range bool, Values []int32) []byte {
+	return AppendConfigureProviderPropertyRequest(nil, c, Provider, Property, Pending, Range, Values)
+}
+
+// AppendConfigureProviderPropertyRequest appends a ConfigureProviderProperty request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendConfigureProviderPropertyRequest(buf []byte, c *xgb.Conn, Provider Provider, Property xproto.Atom, Pending bool, Range bool, Values []int32) []byte {
 	size := xgb.Pad((16 + xgb.Pad((len(Values) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -2034,13 +2527,13 @@ func configureProviderPropertyRequest(c *xgb.Conn, Provider Provider, Property x
 	buf[b] = 38 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Provider))
+	order.Put32(buf[b:], uint32(Provider))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Property))
+	order.Put32(buf[b:], uint32(Property))
 	b += 4
 
 	if Pending {
@@ -2060,13 +2553,68 @@ func configureProviderPropertyRequest(c *xgb.Conn, Provider Provider, Property x
 	b += 2 // padding
 
 	for i := 0; i < int(len(Values)); i++ {
-		xgb.Put32(buf[b:], uint32(Values[i]))
+		order.Put32(buf[b:], uint32(Values[i]))
 		b += 4
 	}
 
 	return buf
 }
 
+// ConfigureProviderPropertyRequest represents the data of a ConfigureProviderProperty request, as it is read when tracing a connection.
+type ConfigureProviderPropertyRequest struct {
+	Provider Provider
+	Property xproto.Atom
+	Pending  bool
+	Range    bool
+	// padding: 2 bytes
+	Values []int32 // size: xgb.Pad((len(Values) * 4))
+}
+
+// configureProviderPropertyRequestRead reads a byte slice in the byte order 'order' into a ConfigureProviderPropertyRequest value.
+func configureProviderPropertyRequestRead(buf []byte, order xgb.ByteOrder) *ConfigureProviderPropertyRequest {
+	v := new(ConfigureProviderPropertyRequest)
+	b := 4 // skip request header
+
+	v.Provider = Provider(order.Get32(buf[b:]))
+	b += 4
+
+	v.Property = xproto.Atom(order.Get32(buf[b:]))
+	b += 4
+
+	if buf[b] == 1 {
+		v.Pending = true
+	} else {
+		v.Pending = false
+	}
+	b += 1
+
+	if buf[b] == 1 {
+		v.Range = true
+	} else {
+		v.Range = false
+	}
+	b += 1
+
+	b += 2 // padding
+
+	v.Values = make([]int32, (len(buf)-b)/4)
+	for i := 0; i < int((len(buf)-b)/4); i++ {
+		v.Values[i] = int32(order.Get32(buf[b:]))
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][38] = xgb.RequestInfo{
+		Name: "ConfigureProviderProperty",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return configureProviderPropertyRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateModeCookie is a cookie used only for CreateMode requests.
 type CreateModeCookie struct {
 	*xgb.Cookie
@@ -2116,23 +2664,35 @@ func (cook CreateModeCookie) Reply() (*CreateModeReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return createModeReply(buf), nil
+	return createModeReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a CreateMode request arrives.
+func (cook CreateModeCookie) ReplyContext(ctx context.Context) (*CreateModeReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return createModeReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// createModeReply reads a byte slice into a CreateModeReply value.
-func createModeReply(buf []byte) *CreateModeReply {
+// createModeReply reads a byte slice in the byte order 'order' into a CreateModeReply value.
+func createModeReply(buf []byte, order xgb.ByteOrder) *CreateModeReply {
 	v := new(CreateModeReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Mode = Mode(xgb.Get32(buf[b:]))
+	v.Mode = Mode(order.Get32(buf[b:]))
 	b += 4
 
 	b += 20 // padding
@@ -2143,9 +2703,18 @@ func createModeReply(buf []byte) *CreateModeReply {
 // Write request to wire for CreateMode
 // createModeRequest writes a CreateMode request to a byte slice.
 func createModeRequest(c *xgb.Conn, Window xproto.Window, ModeInfo ModeInfo, Name string) []byte {
+	return AppendCreateModeRequest(nil, c, Window, ModeInfo, Name)
+}
+
+// AppendCreateModeRequest appends a CreateMode request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateModeRequest(buf []byte, c *xgb.Conn, Window xproto.Window, ModeInfo ModeInfo, Name string) []byte {
 	size := xgb.Pad((40 + xgb.Pad((len(Name) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -2155,14 +2724,14 @@ func createModeRequest(c *xgb.Conn, Window xproto.Window, ModeInfo ModeInfo, Nam
 	buf[b] = 16 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	{
-		structBytes := ModeInfo.Bytes()
+		structBytes := ModeInfo.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -2173,22 +2742,62 @@ func createModeRequest(c *xgb.Conn, Window xproto.Window, ModeInfo ModeInfo, Nam
 	return buf
 }
 
-// DeleteOutputModeCookie is a cookie used only for DeleteOutputMode requests.
-type DeleteOutputModeCookie struct {
-	*xgb.Cookie
+// CreateModeRequest represents the data of a CreateMode request, as it is read when tracing a connection.
+type CreateModeRequest struct {
+	Window   xproto.Window
+	ModeInfo ModeInfo
+	Name     string // size: xgb.Pad((len(Name) * 1))
 }
 
-// DeleteOutputMode sends an unchecked request.
-// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func DeleteOutputMode(c *xgb.Conn, Output Output, Mode Mode) DeleteOutputModeCookie {
-	c.ExtLock.RLock()
-	defer c.ExtLock.RUnlock()
-	if _, ok := c.Extensions["RANDR"]; !ok {
-		panic("Cannot issue request 'DeleteOutputMode' using the uninitialized extension 'RANDR'. randr.Init(connObj) must be called first.")
-	}
-	cookie := c.NewCookie(false, false)
-	c.NewRequest(deleteOutputModeRequest(c, Output, Mode), cookie)
-	return DeleteOutputModeCookie{cookie}
+// createModeRequestRead reads a byte slice in the byte order 'order' into a CreateModeRequest value.
+func createModeRequestRead(buf []byte, order xgb.ByteOrder) *CreateModeRequest {
+	v := new(CreateModeRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.ModeInfo = ModeInfo{}
+	b += ModeInfoRead(buf[b:], order, &v.ModeInfo)
+
+	{
+		byteString := make([]byte, (len(buf)-b)/1)
+		copy(byteString[:(len(buf)-b)/1], buf[b:])
+		v.Name = string(byteString)
+		b += int((len(buf) - b) / 1)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][16] = xgb.RequestInfo{
+		Name: "CreateMode",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createModeRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createModeReply(buf, order)
+		},
+	}
+}
+
+// DeleteOutputModeCookie is a cookie used only for DeleteOutputMode requests.
+type DeleteOutputModeCookie struct {
+	*xgb.Cookie
+}
+
+// DeleteOutputMode sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func DeleteOutputMode(c *xgb.Conn, Output Output, Mode Mode) DeleteOutputModeCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["RANDR"]; !ok {
+		panic("Cannot issue request 'DeleteOutputMode' using the uninitialized extension 'RANDR'. randr.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequest(deleteOutputModeRequest(c, Output, Mode), cookie)
+	return DeleteOutputModeCookie{cookie}
 }
 
 // DeleteOutputModeChecked sends a checked request.
@@ -2210,12 +2819,26 @@ func (cook DeleteOutputModeCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DeleteOutputModeCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DeleteOutputMode
 // deleteOutputModeRequest writes a DeleteOutputMode request to a byte slice.
 func deleteOutputModeRequest(c *xgb.Conn, Output Output, Mode Mode) []byte {
+	return AppendDeleteOutputModeRequest(nil, c, Output, Mode)
+}
+
+// AppendDeleteOutputModeRequest appends a DeleteOutputMode request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDeleteOutputModeRequest(buf []byte, c *xgb.Conn, Output Output, Mode Mode) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -2225,18 +2848,47 @@ func deleteOutputModeRequest(c *xgb.Conn, Output Output, Mode Mode) []byte {
 	buf[b] = 19 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Output))
+	order.Put32(buf[b:], uint32(Output))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Mode))
+	order.Put32(buf[b:], uint32(Mode))
 	b += 4
 
 	return buf
 }
 
+// DeleteOutputModeRequest represents the data of a DeleteOutputMode request, as it is read when tracing a connection.
+type DeleteOutputModeRequest struct {
+	Output Output
+	Mode   Mode
+}
+
+// deleteOutputModeRequestRead reads a byte slice in the byte order 'order' into a DeleteOutputModeRequest value.
+func deleteOutputModeRequestRead(buf []byte, order xgb.ByteOrder) *DeleteOutputModeRequest {
+	v := new(DeleteOutputModeRequest)
+	b := 4 // skip request header
+
+	v.Output = Output(order.Get32(buf[b:]))
+	b += 4
+
+	v.Mode = Mode(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][19] = xgb.RequestInfo{
+		Name: "DeleteOutputMode",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return deleteOutputModeRequestRead(buf, order)
+		},
+	}
+}
+
 // DeleteOutputPropertyCookie is a cookie used only for DeleteOutputProperty requests.
 type DeleteOutputPropertyCookie struct {
 	*xgb.Cookie
@@ -2274,12 +2926,26 @@ func (cook DeleteOutputPropertyCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DeleteOutputPropertyCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DeleteOutputProperty
 // deleteOutputPropertyRequest writes a DeleteOutputProperty request to a byte slice.
 func deleteOutputPropertyRequest(c *xgb.Conn, Output Output, Property xproto.Atom) []byte {
+	return AppendDeleteOutputPropertyRequest(nil, c, Output, Property)
+}
+
+// AppendDeleteOutputPropertyRequest appends a DeleteOutputProperty request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDeleteOutputPropertyRequest(buf []byte, c *xgb.Conn, Output Output, Property xproto.Atom) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -2289,18 +2955,47 @@ func deleteOutputPropertyRequest(c *xgb.Conn, Output Output, Property xproto.Ato
 	buf[b] = 14 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Output))
+	order.Put32(buf[b:], uint32(Output))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Property))
+	order.Put32(buf[b:], uint32(Property))
 	b += 4
 
 	return buf
 }
 
+// DeleteOutputPropertyRequest represents the data of a DeleteOutputProperty request, as it is read when tracing a connection.
+type DeleteOutputPropertyRequest struct {
+	Output   Output
+	Property xproto.Atom
+}
+
+// deleteOutputPropertyRequestRead reads a byte slice in the byte order 'order' into a DeleteOutputPropertyRequest value.
+func deleteOutputPropertyRequestRead(buf []byte, order xgb.ByteOrder) *DeleteOutputPropertyRequest {
+	v := new(DeleteOutputPropertyRequest)
+	b := 4 // skip request header
+
+	v.Output = Output(order.Get32(buf[b:]))
+	b += 4
+
+	v.Property = xproto.Atom(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][14] = xgb.RequestInfo{
+		Name: "DeleteOutputProperty",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return deleteOutputPropertyRequestRead(buf, order)
+		},
+	}
+}
+
 // DeleteProviderPropertyCookie is a cookie used only for DeleteProviderProperty requests.
 type DeleteProviderPropertyCookie struct {
 	*xgb.Cookie
@@ -2338,12 +3033,26 @@ func (cook DeleteProviderPropertyCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DeleteProviderPropertyCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DeleteProviderProperty
 // deleteProviderPropertyRequest writes a DeleteProviderProperty request to a byte slice.
 func deleteProviderPropertyRequest(c *xgb.Conn, Provider Provider, Property xproto.Atom) []byte {
+	return AppendDeleteProviderPropertyRequest(nil, c, Provider, Property)
+}
+
+// AppendDeleteProviderPropertyRequest appends a DeleteProviderProperty request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDeleteProviderPropertyRequest(buf []byte, c *xgb.Conn, Provider Provider, Property xproto.Atom) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -2353,18 +3062,47 @@ func deleteProviderPropertyRequest(c *xgb.Conn, Provider Provider, Property xpro
 	buf[b] = 40 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Provider))
+	order.Put32(buf[b:], uint32(Provider))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Property))
+	order.Put32(buf[b:], uint32(Property))
 	b += 4
 
 	return buf
 }
 
+// DeleteProviderPropertyRequest represents the data of a DeleteProviderProperty request, as it is read when tracing a connection.
+type DeleteProviderPropertyRequest struct {
+	Provider Provider
+	Property xproto.Atom
+}
+
+// deleteProviderPropertyRequestRead reads a byte slice in the byte order 'order' into a DeleteProviderPropertyRequest value.
+func deleteProviderPropertyRequestRead(buf []byte, order xgb.ByteOrder) *DeleteProviderPropertyRequest {
+	v := new(DeleteProviderPropertyRequest)
+	b := 4 // skip request header
+
+	v.Provider = Provider(order.Get32(buf[b:]))
+	b += 4
+
+	v.Property = xproto.Atom(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][40] = xgb.RequestInfo{
+		Name: "DeleteProviderProperty",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return deleteProviderPropertyRequestRead(buf, order)
+		},
+	}
+}
+
 // DestroyModeCookie is a cookie used only for DestroyMode requests.
 type DestroyModeCookie struct {
 	*xgb.Cookie
@@ -2402,12 +3140,26 @@ func (cook DestroyModeCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DestroyModeCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DestroyMode
 // destroyModeRequest writes a DestroyMode request to a byte slice.
 func destroyModeRequest(c *xgb.Conn, Mode Mode) []byte {
+	return AppendDestroyModeRequest(nil, c, Mode)
+}
+
+// AppendDestroyModeRequest appends a DestroyMode request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDestroyModeRequest(buf []byte, c *xgb.Conn, Mode Mode) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -2417,15 +3169,40 @@ func destroyModeRequest(c *xgb.Conn, Mode Mode) []byte {
 	buf[b] = 17 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Mode))
+	order.Put32(buf[b:], uint32(Mode))
 	b += 4
 
 	return buf
 }
 
+// DestroyModeRequest represents the data of a DestroyMode request, as it is read when tracing a connection.
+type DestroyModeRequest struct {
+	Mode Mode
+}
+
+// destroyModeRequestRead reads a byte slice in the byte order 'order' into a DestroyModeRequest value.
+func destroyModeRequestRead(buf []byte, order xgb.ByteOrder) *DestroyModeRequest {
+	v := new(DestroyModeRequest)
+	b := 4 // skip request header
+
+	v.Mode = Mode(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][17] = xgb.RequestInfo{
+		Name: "DestroyMode",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return destroyModeRequestRead(buf, order)
+		},
+	}
+}
+
 // GetCrtcGammaCookie is a cookie used only for GetCrtcGamma requests.
 type GetCrtcGammaCookie struct {
 	*xgb.Cookie
@@ -2480,30 +3257,42 @@ func (cook GetCrtcGammaCookie) Reply() (*GetCrtcGammaReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getCrtcGammaReply(buf), nil
+	return getCrtcGammaReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetCrtcGamma request arrives.
+func (cook GetCrtcGammaCookie) ReplyContext(ctx context.Context) (*GetCrtcGammaReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getCrtcGammaReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getCrtcGammaReply reads a byte slice into a GetCrtcGammaReply value.
-func getCrtcGammaReply(buf []byte) *GetCrtcGammaReply {
+// getCrtcGammaReply reads a byte slice in the byte order 'order' into a GetCrtcGammaReply value.
+func getCrtcGammaReply(buf []byte, order xgb.ByteOrder) *GetCrtcGammaReply {
 	v := new(GetCrtcGammaReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Size = xgb.Get16(buf[b:])
+	v.Size = order.Get16(buf[b:])
 	b += 2
 
 	b += 22 // padding
 
 	v.Red = make([]uint16, v.Size)
 	for i := 0; i < int(v.Size); i++ {
-		v.Red[i] = xgb.Get16(buf[b:])
+		v.Red[i] = order.Get16(buf[b:])
 		b += 2
 	}
 
@@ -2511,7 +3300,7 @@ func getCrtcGammaReply(buf []byte) *GetCrtcGammaReply {
 
 	v.Green = make([]uint16, v.Size)
 	for i := 0; i < int(v.Size); i++ {
-		v.Green[i] = xgb.Get16(buf[b:])
+		v.Green[i] = order.Get16(buf[b:])
 		b += 2
 	}
 
@@ -2519,7 +3308,7 @@ func getCrtcGammaReply(buf []byte) *GetCrtcGammaReply {
 
 	v.Blue = make([]uint16, v.Size)
 	for i := 0; i < int(v.Size); i++ {
-		v.Blue[i] = xgb.Get16(buf[b:])
+		v.Blue[i] = order.Get16(buf[b:])
 		b += 2
 	}
 
@@ -2529,9 +3318,18 @@ func getCrtcGammaReply(buf []byte) *GetCrtcGammaReply {
 // Write request to wire for GetCrtcGamma
 // getCrtcGammaRequest writes a GetCrtcGamma request to a byte slice.
 func getCrtcGammaRequest(c *xgb.Conn, Crtc Crtc) []byte {
+	return AppendGetCrtcGammaRequest(nil, c, Crtc)
+}
+
+// AppendGetCrtcGammaRequest appends a GetCrtcGamma request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetCrtcGammaRequest(buf []byte, c *xgb.Conn, Crtc Crtc) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -2541,15 +3339,43 @@ func getCrtcGammaRequest(c *xgb.Conn, Crtc Crtc) []byte {
 	buf[b] = 23 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Crtc))
+	order.Put32(buf[b:], uint32(Crtc))
 	b += 4
 
 	return buf
 }
 
+// GetCrtcGammaRequest represents the data of a GetCrtcGamma request, as it is read when tracing a connection.
+type GetCrtcGammaRequest struct {
+	Crtc Crtc
+}
+
+// getCrtcGammaRequestRead reads a byte slice in the byte order 'order' into a GetCrtcGammaRequest value.
+func getCrtcGammaRequestRead(buf []byte, order xgb.ByteOrder) *GetCrtcGammaRequest {
+	v := new(GetCrtcGammaRequest)
+	b := 4 // skip request header
+
+	v.Crtc = Crtc(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][23] = xgb.RequestInfo{
+		Name: "GetCrtcGamma",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getCrtcGammaRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getCrtcGammaReply(buf, order)
+		},
+	}
+}
+
 // GetCrtcGammaSizeCookie is a cookie used only for GetCrtcGammaSize requests.
 type GetCrtcGammaSizeCookie struct {
 	*xgb.Cookie
@@ -2599,23 +3425,35 @@ func (cook GetCrtcGammaSizeCookie) Reply() (*GetCrtcGammaSizeReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getCrtcGammaSizeReply(buf), nil
+	return getCrtcGammaSizeReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetCrtcGammaSize request arrives.
+func (cook GetCrtcGammaSizeCookie) ReplyContext(ctx context.Context) (*GetCrtcGammaSizeReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getCrtcGammaSizeReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getCrtcGammaSizeReply reads a byte slice into a GetCrtcGammaSizeReply value.
-func getCrtcGammaSizeReply(buf []byte) *GetCrtcGammaSizeReply {
+// getCrtcGammaSizeReply reads a byte slice in the byte order 'order' into a GetCrtcGammaSizeReply value.
+func getCrtcGammaSizeReply(buf []byte, order xgb.ByteOrder) *GetCrtcGammaSizeReply {
 	v := new(GetCrtcGammaSizeReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Size = xgb.Get16(buf[b:])
+	v.Size = order.Get16(buf[b:])
 	b += 2
 
 	b += 22 // padding
@@ -2626,9 +3464,18 @@ func getCrtcGammaSizeReply(buf []byte) *GetCrtcGammaSizeReply {
 // Write request to wire for GetCrtcGammaSize
 // getCrtcGammaSizeRequest writes a GetCrtcGammaSize request to a byte slice.
 func getCrtcGammaSizeRequest(c *xgb.Conn, Crtc Crtc) []byte {
+	return AppendGetCrtcGammaSizeRequest(nil, c, Crtc)
+}
+
+// AppendGetCrtcGammaSizeRequest appends a GetCrtcGammaSize request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetCrtcGammaSizeRequest(buf []byte, c *xgb.Conn, Crtc Crtc) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -2638,15 +3485,43 @@ func getCrtcGammaSizeRequest(c *xgb.Conn, Crtc Crtc) []byte {
 	buf[b] = 22 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Crtc))
+	order.Put32(buf[b:], uint32(Crtc))
 	b += 4
 
 	return buf
 }
 
+// GetCrtcGammaSizeRequest represents the data of a GetCrtcGammaSize request, as it is read when tracing a connection.
+type GetCrtcGammaSizeRequest struct {
+	Crtc Crtc
+}
+
+// getCrtcGammaSizeRequestRead reads a byte slice in the byte order 'order' into a GetCrtcGammaSizeRequest value.
+func getCrtcGammaSizeRequestRead(buf []byte, order xgb.ByteOrder) *GetCrtcGammaSizeRequest {
+	v := new(GetCrtcGammaSizeRequest)
+	b := 4 // skip request header
+
+	v.Crtc = Crtc(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][22] = xgb.RequestInfo{
+		Name: "GetCrtcGammaSize",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getCrtcGammaSizeRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getCrtcGammaSizeReply(buf, order)
+		},
+	}
+}
+
 // GetCrtcInfoCookie is a cookie used only for GetCrtcInfo requests.
 type GetCrtcInfoCookie struct {
 	*xgb.Cookie
@@ -2682,15 +3557,15 @@ func GetCrtcInfoUnchecked(c *xgb.Conn, Crtc Crtc, ConfigTimestamp xproto.Timesta
 type GetCrtcInfoReply struct {
 	Sequence           uint16 // sequence number of the request for this reply
 	Length             uint32 // number of bytes in this reply
-	Status             byte
+	Status             SetConfig
 	Timestamp          xproto.Timestamp
 	X                  int16
 	Y                  int16
 	Width              uint16
 	Height             uint16
 	Mode               Mode
-	Rotation           uint16
-	Rotations          uint16
+	Rotation           Rotation
+	Rotations          Rotation
 	NumOutputs         uint16
 	NumPossibleOutputs uint16
 	Outputs            []Output // size: xgb.Pad((int(NumOutputs) * 4))
@@ -2707,56 +3582,68 @@ func (cook GetCrtcInfoCookie) Reply() (*GetCrtcInfoReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getCrtcInfoReply(buf), nil
+	return getCrtcInfoReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetCrtcInfo request arrives.
+func (cook GetCrtcInfoCookie) ReplyContext(ctx context.Context) (*GetCrtcInfoReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getCrtcInfoReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getCrtcInfoReply reads a byte slice into a GetCrtcInfoReply value.
-func getCrtcInfoReply(buf []byte) *GetCrtcInfoReply {
+// getCrtcInfoReply reads a byte slice in the byte order 'order' into a GetCrtcInfoReply value.
+func getCrtcInfoReply(buf []byte, order xgb.ByteOrder) *GetCrtcInfoReply {
 	v := new(GetCrtcInfoReply)
 	b := 1 // skip reply determinant
 
-	v.Status = buf[b]
+	v.Status = SetConfig(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Timestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.X = int16(xgb.Get16(buf[b:]))
+	v.X = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Y = int16(xgb.Get16(buf[b:]))
+	v.Y = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
-	v.Mode = Mode(xgb.Get32(buf[b:]))
+	v.Mode = Mode(order.Get32(buf[b:]))
 	b += 4
 
-	v.Rotation = xgb.Get16(buf[b:])
+	v.Rotation = Rotation(order.Get16(buf[b:]))
 	b += 2
 
-	v.Rotations = xgb.Get16(buf[b:])
+	v.Rotations = Rotation(order.Get16(buf[b:]))
 	b += 2
 
-	v.NumOutputs = xgb.Get16(buf[b:])
+	v.NumOutputs = order.Get16(buf[b:])
 	b += 2
 
-	v.NumPossibleOutputs = xgb.Get16(buf[b:])
+	v.NumPossibleOutputs = order.Get16(buf[b:])
 	b += 2
 
 	v.Outputs = make([]Output, v.NumOutputs)
 	for i := 0; i < int(v.NumOutputs); i++ {
-		v.Outputs[i] = Output(xgb.Get32(buf[b:]))
+		v.Outputs[i] = Output(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -2764,7 +3651,7 @@ func getCrtcInfoReply(buf []byte) *GetCrtcInfoReply {
 
 	v.Possible = make([]Output, v.NumPossibleOutputs)
 	for i := 0; i < int(v.NumPossibleOutputs); i++ {
-		v.Possible[i] = Output(xgb.Get32(buf[b:]))
+		v.Possible[i] = Output(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -2774,9 +3661,18 @@ func getCrtcInfoReply(buf []byte) *GetCrtcInfoReply {
 // Write request to wire for GetCrtcInfo
 // getCrtcInfoRequest writes a GetCrtcInfo request to a byte slice.
 func getCrtcInfoRequest(c *xgb.Conn, Crtc Crtc, ConfigTimestamp xproto.Timestamp) []byte {
+	return AppendGetCrtcInfoRequest(nil, c, Crtc, ConfigTimestamp)
+}
+
+// AppendGetCrtcInfoRequest appends a GetCrtcInfo request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetCrtcInfoRequest(buf []byte, c *xgb.Conn, Crtc Crtc, ConfigTimestamp xproto.Timestamp) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -2786,18 +3682,50 @@ func getCrtcInfoRequest(c *xgb.Conn, Crtc Crtc, ConfigTimestamp xproto.Timestamp
 	buf[b] = 20 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Crtc))
+	order.Put32(buf[b:], uint32(Crtc))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(ConfigTimestamp))
+	order.Put32(buf[b:], uint32(ConfigTimestamp))
 	b += 4
 
 	return buf
 }
 
+// GetCrtcInfoRequest represents the data of a GetCrtcInfo request, as it is read when tracing a connection.
+type GetCrtcInfoRequest struct {
+	Crtc            Crtc
+	ConfigTimestamp xproto.Timestamp
+}
+
+// getCrtcInfoRequestRead reads a byte slice in the byte order 'order' into a GetCrtcInfoRequest value.
+func getCrtcInfoRequestRead(buf []byte, order xgb.ByteOrder) *GetCrtcInfoRequest {
+	v := new(GetCrtcInfoRequest)
+	b := 4 // skip request header
+
+	v.Crtc = Crtc(order.Get32(buf[b:]))
+	b += 4
+
+	v.ConfigTimestamp = xproto.Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][20] = xgb.RequestInfo{
+		Name: "GetCrtcInfo",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getCrtcInfoRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getCrtcInfoReply(buf, order)
+		},
+	}
+}
+
 // GetCrtcTransformCookie is a cookie used only for GetCrtcTransform requests.
 type GetCrtcTransformCookie struct {
 	*xgb.Cookie
@@ -2860,24 +3788,36 @@ func (cook GetCrtcTransformCookie) Reply() (*GetCrtcTransformReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getCrtcTransformReply(buf), nil
+	return getCrtcTransformReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetCrtcTransform request arrives.
+func (cook GetCrtcTransformCookie) ReplyContext(ctx context.Context) (*GetCrtcTransformReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getCrtcTransformReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getCrtcTransformReply reads a byte slice into a GetCrtcTransformReply value.
-func getCrtcTransformReply(buf []byte) *GetCrtcTransformReply {
+// getCrtcTransformReply reads a byte slice in the byte order 'order' into a GetCrtcTransformReply value.
+func getCrtcTransformReply(buf []byte, order xgb.ByteOrder) *GetCrtcTransformReply {
 	v := new(GetCrtcTransformReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	v.PendingTransform = render.Transform{}
-	b += render.TransformRead(buf[b:], &v.PendingTransform)
+	b += render.TransformRead(buf[b:], order, &v.PendingTransform)
 
 	if buf[b] == 1 {
 		v.HasTransforms = true
@@ -2889,20 +3829,20 @@ func getCrtcTransformReply(buf []byte) *GetCrtcTransformReply {
 	b += 3 // padding
 
 	v.CurrentTransform = render.Transform{}
-	b += render.TransformRead(buf[b:], &v.CurrentTransform)
+	b += render.TransformRead(buf[b:], order, &v.CurrentTransform)
 
 	b += 4 // padding
 
-	v.PendingLen = xgb.Get16(buf[b:])
+	v.PendingLen = order.Get16(buf[b:])
 	b += 2
 
-	v.PendingNparams = xgb.Get16(buf[b:])
+	v.PendingNparams = order.Get16(buf[b:])
 	b += 2
 
-	v.CurrentLen = xgb.Get16(buf[b:])
+	v.CurrentLen = order.Get16(buf[b:])
 	b += 2
 
-	v.CurrentNparams = xgb.Get16(buf[b:])
+	v.CurrentNparams = order.Get16(buf[b:])
 	b += 2
 
 	{
@@ -2916,7 +3856,7 @@ func getCrtcTransformReply(buf []byte) *GetCrtcTransformReply {
 
 	v.PendingParams = make([]render.Fixed, v.PendingNparams)
 	for i := 0; i < int(v.PendingNparams); i++ {
-		v.PendingParams[i] = render.Fixed(xgb.Get32(buf[b:]))
+		v.PendingParams[i] = render.Fixed(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -2931,7 +3871,7 @@ func getCrtcTransformReply(buf []byte) *GetCrtcTransformReply {
 
 	v.CurrentParams = make([]render.Fixed, v.CurrentNparams)
 	for i := 0; i < int(v.CurrentNparams); i++ {
-		v.CurrentParams[i] = render.Fixed(xgb.Get32(buf[b:]))
+		v.CurrentParams[i] = render.Fixed(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -2941,9 +3881,18 @@ func getCrtcTransformReply(buf []byte) *GetCrtcTransformReply {
 // Write request to wire for GetCrtcTransform
 // getCrtcTransformRequest writes a GetCrtcTransform request to a byte slice.
 func getCrtcTransformRequest(c *xgb.Conn, Crtc Crtc) []byte {
+	return AppendGetCrtcTransformRequest(nil, c, Crtc)
+}
+
+// AppendGetCrtcTransformRequest appends a GetCrtcTransform request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetCrtcTransformRequest(buf []byte, c *xgb.Conn, Crtc Crtc) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -2953,15 +3902,43 @@ func getCrtcTransformRequest(c *xgb.Conn, Crtc Crtc) []byte {
 	buf[b] = 27 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Crtc))
+	order.Put32(buf[b:], uint32(Crtc))
 	b += 4
 
 	return buf
 }
 
+// GetCrtcTransformRequest represents the data of a GetCrtcTransform request, as it is read when tracing a connection.
+type GetCrtcTransformRequest struct {
+	Crtc Crtc
+}
+
+// getCrtcTransformRequestRead reads a byte slice in the byte order 'order' into a GetCrtcTransformRequest value.
+func getCrtcTransformRequestRead(buf []byte, order xgb.ByteOrder) *GetCrtcTransformRequest {
+	v := new(GetCrtcTransformRequest)
+	b := 4 // skip request header
+
+	v.Crtc = Crtc(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][27] = xgb.RequestInfo{
+		Name: "GetCrtcTransform",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getCrtcTransformRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getCrtcTransformReply(buf, order)
+		},
+	}
+}
+
 // GetOutputInfoCookie is a cookie used only for GetOutputInfo requests.
 type GetOutputInfoCookie struct {
 	*xgb.Cookie
@@ -2997,12 +3974,12 @@ func GetOutputInfoUnchecked(c *xgb.Conn, Output Output, ConfigTimestamp xproto.T
 type GetOutputInfoReply struct {
 	Sequence      uint16 // sequence number of the request for this reply
 	Length        uint32 // number of bytes in this reply
-	Status        byte
+	Status        SetConfig
 	Timestamp     xproto.Timestamp
 	Crtc          Crtc
 	MmWidth       uint32
 	MmHeight      uint32
-	Connection    byte
+	Connection    Connection
 	SubpixelOrder byte
 	NumCrtcs      uint16
 	NumModes      uint16
@@ -3026,59 +4003,71 @@ func (cook GetOutputInfoCookie) Reply() (*GetOutputInfoReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getOutputInfoReply(buf), nil
+	return getOutputInfoReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetOutputInfo request arrives.
+func (cook GetOutputInfoCookie) ReplyContext(ctx context.Context) (*GetOutputInfoReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getOutputInfoReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getOutputInfoReply reads a byte slice into a GetOutputInfoReply value.
-func getOutputInfoReply(buf []byte) *GetOutputInfoReply {
+// getOutputInfoReply reads a byte slice in the byte order 'order' into a GetOutputInfoReply value.
+func getOutputInfoReply(buf []byte, order xgb.ByteOrder) *GetOutputInfoReply {
 	v := new(GetOutputInfoReply)
 	b := 1 // skip reply determinant
 
-	v.Status = buf[b]
+	v.Status = SetConfig(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Timestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.Crtc = Crtc(xgb.Get32(buf[b:]))
+	v.Crtc = Crtc(order.Get32(buf[b:]))
 	b += 4
 
-	v.MmWidth = xgb.Get32(buf[b:])
+	v.MmWidth = order.Get32(buf[b:])
 	b += 4
 
-	v.MmHeight = xgb.Get32(buf[b:])
+	v.MmHeight = order.Get32(buf[b:])
 	b += 4
 
-	v.Connection = buf[b]
+	v.Connection = Connection(buf[b])
 	b += 1
 
 	v.SubpixelOrder = buf[b]
 	b += 1
 
-	v.NumCrtcs = xgb.Get16(buf[b:])
+	v.NumCrtcs = order.Get16(buf[b:])
 	b += 2
 
-	v.NumModes = xgb.Get16(buf[b:])
+	v.NumModes = order.Get16(buf[b:])
 	b += 2
 
-	v.NumPreferred = xgb.Get16(buf[b:])
+	v.NumPreferred = order.Get16(buf[b:])
 	b += 2
 
-	v.NumClones = xgb.Get16(buf[b:])
+	v.NumClones = order.Get16(buf[b:])
 	b += 2
 
-	v.NameLen = xgb.Get16(buf[b:])
+	v.NameLen = order.Get16(buf[b:])
 	b += 2
 
 	v.Crtcs = make([]Crtc, v.NumCrtcs)
 	for i := 0; i < int(v.NumCrtcs); i++ {
-		v.Crtcs[i] = Crtc(xgb.Get32(buf[b:]))
+		v.Crtcs[i] = Crtc(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -3086,7 +4075,7 @@ func getOutputInfoReply(buf []byte) *GetOutputInfoReply {
 
 	v.Modes = make([]Mode, v.NumModes)
 	for i := 0; i < int(v.NumModes); i++ {
-		v.Modes[i] = Mode(xgb.Get32(buf[b:]))
+		v.Modes[i] = Mode(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -3094,7 +4083,7 @@ func getOutputInfoReply(buf []byte) *GetOutputInfoReply {
 
 	v.Clones = make([]Output, v.NumClones)
 	for i := 0; i < int(v.NumClones); i++ {
-		v.Clones[i] = Output(xgb.Get32(buf[b:]))
+		v.Clones[i] = Output(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -3108,9 +4097,18 @@ func getOutputInfoReply(buf []byte) *GetOutputInfoReply {
 // Write request to wire for GetOutputInfo
 // getOutputInfoRequest writes a GetOutputInfo request to a byte slice.
 func getOutputInfoRequest(c *xgb.Conn, Output Output, ConfigTimestamp xproto.Timestamp) []byte {
+	return AppendGetOutputInfoRequest(nil, c, Output, ConfigTimestamp)
+}
+
+// AppendGetOutputInfoRequest appends a GetOutputInfo request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetOutputInfoRequest(buf []byte, c *xgb.Conn, Output Output, ConfigTimestamp xproto.Timestamp) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -3120,18 +4118,50 @@ func getOutputInfoRequest(c *xgb.Conn, Output Output, ConfigTimestamp xproto.Tim
 	buf[b] = 9 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Output))
+	order.Put32(buf[b:], uint32(Output))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(ConfigTimestamp))
+	order.Put32(buf[b:], uint32(ConfigTimestamp))
 	b += 4
 
 	return buf
 }
 
+// GetOutputInfoRequest represents the data of a GetOutputInfo request, as it is read when tracing a connection.
+type GetOutputInfoRequest struct {
+	Output          Output
+	ConfigTimestamp xproto.Timestamp
+}
+
+// getOutputInfoRequestRead reads a byte slice in the byte order 'order' into a GetOutputInfoRequest value.
+func getOutputInfoRequestRead(buf []byte, order xgb.ByteOrder) *GetOutputInfoRequest {
+	v := new(GetOutputInfoRequest)
+	b := 4 // skip request header
+
+	v.Output = Output(order.Get32(buf[b:]))
+	b += 4
+
+	v.ConfigTimestamp = xproto.Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][9] = xgb.RequestInfo{
+		Name: "GetOutputInfo",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getOutputInfoRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getOutputInfoReply(buf, order)
+		},
+	}
+}
+
 // GetOutputPrimaryCookie is a cookie used only for GetOutputPrimary requests.
 type GetOutputPrimaryCookie struct {
 	*xgb.Cookie
@@ -3180,23 +4210,35 @@ func (cook GetOutputPrimaryCookie) Reply() (*GetOutputPrimaryReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getOutputPrimaryReply(buf), nil
+	return getOutputPrimaryReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetOutputPrimary request arrives.
+func (cook GetOutputPrimaryCookie) ReplyContext(ctx context.Context) (*GetOutputPrimaryReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getOutputPrimaryReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getOutputPrimaryReply reads a byte slice into a GetOutputPrimaryReply value.
-func getOutputPrimaryReply(buf []byte) *GetOutputPrimaryReply {
+// getOutputPrimaryReply reads a byte slice in the byte order 'order' into a GetOutputPrimaryReply value.
+func getOutputPrimaryReply(buf []byte, order xgb.ByteOrder) *GetOutputPrimaryReply {
 	v := new(GetOutputPrimaryReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Output = Output(xgb.Get32(buf[b:]))
+	v.Output = Output(order.Get32(buf[b:]))
 	b += 4
 
 	return v
@@ -3205,9 +4247,18 @@ func getOutputPrimaryReply(buf []byte) *GetOutputPrimaryReply {
 // Write request to wire for GetOutputPrimary
 // getOutputPrimaryRequest writes a GetOutputPrimary request to a byte slice.
 func getOutputPrimaryRequest(c *xgb.Conn, Window xproto.Window) []byte {
+	return AppendGetOutputPrimaryRequest(nil, c, Window)
+}
+
+// AppendGetOutputPrimaryRequest appends a GetOutputPrimary request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetOutputPrimaryRequest(buf []byte, c *xgb.Conn, Window xproto.Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -3217,15 +4268,43 @@ func getOutputPrimaryRequest(c *xgb.Conn, Window xproto.Window) []byte {
 	buf[b] = 31 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// GetOutputPrimaryRequest represents the data of a GetOutputPrimary request, as it is read when tracing a connection.
+type GetOutputPrimaryRequest struct {
+	Window xproto.Window
+}
+
+// getOutputPrimaryRequestRead reads a byte slice in the byte order 'order' into a GetOutputPrimaryRequest value.
+func getOutputPrimaryRequestRead(buf []byte, order xgb.ByteOrder) *GetOutputPrimaryRequest {
+	v := new(GetOutputPrimaryRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][31] = xgb.RequestInfo{
+		Name: "GetOutputPrimary",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getOutputPrimaryRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getOutputPrimaryReply(buf, order)
+		},
+	}
+}
+
 // GetOutputPropertyCookie is a cookie used only for GetOutputProperty requests.
 type GetOutputPropertyCookie struct {
 	*xgb.Cookie
@@ -3278,30 +4357,42 @@ func (cook GetOutputPropertyCookie) Reply() (*GetOutputPropertyReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getOutputPropertyReply(buf), nil
+	return getOutputPropertyReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getOutputPropertyReply reads a byte slice into a GetOutputPropertyReply value.
-func getOutputPropertyReply(buf []byte) *GetOutputPropertyReply {
-	v := new(GetOutputPropertyReply)
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetOutputProperty request arrives.
+func (cook GetOutputPropertyCookie) ReplyContext(ctx context.Context) (*GetOutputPropertyReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getOutputPropertyReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// getOutputPropertyReply reads a byte slice in the byte order 'order' into a GetOutputPropertyReply value.
+func getOutputPropertyReply(buf []byte, order xgb.ByteOrder) *GetOutputPropertyReply {
+	v := new(GetOutputPropertyReply)
 	b := 1 // skip reply determinant
 
 	v.Format = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Type = xproto.Atom(xgb.Get32(buf[b:]))
+	v.Type = xproto.Atom(order.Get32(buf[b:]))
 	b += 4
 
-	v.BytesAfter = xgb.Get32(buf[b:])
+	v.BytesAfter = order.Get32(buf[b:])
 	b += 4
 
-	v.NumItems = xgb.Get32(buf[b:])
+	v.NumItems = order.Get32(buf[b:])
 	b += 4
 
 	b += 12 // padding
@@ -3316,9 +4407,18 @@ func getOutputPropertyReply(buf []byte) *GetOutputPropertyReply {
 // Write request to wire for GetOutputProperty
 // getOutputPropertyRequest writes a GetOutputProperty request to a byte slice.
 func getOutputPropertyRequest(c *xgb.Conn, Output Output, Property xproto.Atom, Type xproto.Atom, LongOffset uint32, LongLength uint32, Delete bool, Pending bool) []byte {
+	return AppendGetOutputPropertyRequest(nil, c, Output, Property, Type, LongOffset, LongLength, Delete, Pending)
+}
+
+// AppendGetOutputPropertyRequest appends a GetOutputProperty request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetOutputPropertyRequest(buf []byte, c *xgb.Conn, Output Output, Property xproto.Atom, Type xproto.Atom, LongOffset uint32, LongLength uint32, Delete bool, Pending bool) []byte {
 	size := 28
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -3328,22 +4428,22 @@ func getOutputPropertyRequest(c *xgb.Conn, Output Output, Property xproto.Atom,
 	buf[b] = 15 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Output))
+	order.Put32(buf[b:], uint32(Output))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Property))
+	order.Put32(buf[b:], uint32(Property))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Type))
+	order.Put32(buf[b:], uint32(Type))
 	b += 4
 
-	xgb.Put32(buf[b:], LongOffset)
+	order.Put32(buf[b:], LongOffset)
 	b += 4
 
-	xgb.Put32(buf[b:], LongLength)
+	order.Put32(buf[b:], LongLength)
 	b += 4
 
 	if Delete {
@@ -3365,6 +4465,69 @@ func getOutputPropertyRequest(c *xgb.Conn, Output Output, Property xproto.Atom,
 	return buf
 }
 
+// GetOutputPropertyRequest represents the data of a GetOutputProperty request, as it is read when tracing a connection.
+type GetOutputPropertyRequest struct {
+	Output     Output
+	Property   xproto.Atom
+	Type       xproto.Atom
+	LongOffset uint32
+	LongLength uint32
+	Delete     bool
+	Pending    bool
+	// padding: 2 bytes
+}
+
+// getOutputPropertyRequestRead reads a byte slice in the byte order 'order' into a GetOutputPropertyRequest value.
+func getOutputPropertyRequestRead(buf []byte, order xgb.ByteOrder) *GetOutputPropertyRequest {
+	v := new(GetOutputPropertyRequest)
+	b := 4 // skip request header
+
+	v.Output = Output(order.Get32(buf[b:]))
+	b += 4
+
+	v.Property = xproto.Atom(order.Get32(buf[b:]))
+	b += 4
+
+	v.Type = xproto.Atom(order.Get32(buf[b:]))
+	b += 4
+
+	v.LongOffset = order.Get32(buf[b:])
+	b += 4
+
+	v.LongLength = order.Get32(buf[b:])
+	b += 4
+
+	if buf[b] == 1 {
+		v.Delete = true
+	} else {
+		v.Delete = false
+	}
+	b += 1
+
+	if buf[b] == 1 {
+		v.Pending = true
+	} else {
+		v.Pending = false
+	}
+	b += 1
+
+	b += 2 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][15] = xgb.RequestInfo{
+		Name: "GetOutputProperty",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getOutputPropertyRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getOutputPropertyReply(buf, order)
+		},
+	}
+}
+
 // GetPanningCookie is a cookie used only for GetPanning requests.
 type GetPanningCookie struct {
 	*xgb.Cookie
@@ -3400,7 +4563,7 @@ func GetPanningUnchecked(c *xgb.Conn, Crtc Crtc) GetPanningCookie {
 type GetPanningReply struct {
 	Sequence     uint16 // sequence number of the request for this reply
 	Length       uint32 // number of bytes in this reply
-	Status       byte
+	Status       SetConfig
 	Timestamp    xproto.Timestamp
 	Left         uint16
 	Top          uint16
@@ -3425,60 +4588,72 @@ func (cook GetPanningCookie) Reply() (*GetPanningReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getPanningReply(buf), nil
+	return getPanningReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getPanningReply reads a byte slice into a GetPanningReply value.
-func getPanningReply(buf []byte) *GetPanningReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetPanning request arrives.
+func (cook GetPanningCookie) ReplyContext(ctx context.Context) (*GetPanningReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getPanningReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// getPanningReply reads a byte slice in the byte order 'order' into a GetPanningReply value.
+func getPanningReply(buf []byte, order xgb.ByteOrder) *GetPanningReply {
 	v := new(GetPanningReply)
 	b := 1 // skip reply determinant
 
-	v.Status = buf[b]
+	v.Status = SetConfig(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Timestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.Left = xgb.Get16(buf[b:])
+	v.Left = order.Get16(buf[b:])
 	b += 2
 
-	v.Top = xgb.Get16(buf[b:])
+	v.Top = order.Get16(buf[b:])
 	b += 2
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
-	v.TrackLeft = xgb.Get16(buf[b:])
+	v.TrackLeft = order.Get16(buf[b:])
 	b += 2
 
-	v.TrackTop = xgb.Get16(buf[b:])
+	v.TrackTop = order.Get16(buf[b:])
 	b += 2
 
-	v.TrackWidth = xgb.Get16(buf[b:])
+	v.TrackWidth = order.Get16(buf[b:])
 	b += 2
 
-	v.TrackHeight = xgb.Get16(buf[b:])
+	v.TrackHeight = order.Get16(buf[b:])
 	b += 2
 
-	v.BorderLeft = int16(xgb.Get16(buf[b:]))
+	v.BorderLeft = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.BorderTop = int16(xgb.Get16(buf[b:]))
+	v.BorderTop = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.BorderRight = int16(xgb.Get16(buf[b:]))
+	v.BorderRight = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.BorderBottom = int16(xgb.Get16(buf[b:]))
+	v.BorderBottom = int16(order.Get16(buf[b:]))
 	b += 2
 
 	return v
@@ -3487,9 +4662,18 @@ func getPanningReply(buf []byte) *GetPanningReply {
 // Write request to wire for GetPanning
 // getPanningRequest writes a GetPanning request to a byte slice.
 func getPanningRequest(c *xgb.Conn, Crtc Crtc) []byte {
+	return AppendGetPanningRequest(nil, c, Crtc)
+}
+
+// AppendGetPanningRequest appends a GetPanning request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetPanningRequest(buf []byte, c *xgb.Conn, Crtc Crtc) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -3499,15 +4683,43 @@ func getPanningRequest(c *xgb.Conn, Crtc Crtc) []byte {
 	buf[b] = 28 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Crtc))
+	order.Put32(buf[b:], uint32(Crtc))
 	b += 4
 
 	return buf
 }
 
+// GetPanningRequest represents the data of a GetPanning request, as it is read when tracing a connection.
+type GetPanningRequest struct {
+	Crtc Crtc
+}
+
+// getPanningRequestRead reads a byte slice in the byte order 'order' into a GetPanningRequest value.
+func getPanningRequestRead(buf []byte, order xgb.ByteOrder) *GetPanningRequest {
+	v := new(GetPanningRequest)
+	b := 4 // skip request header
+
+	v.Crtc = Crtc(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][28] = xgb.RequestInfo{
+		Name: "GetPanning",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPanningRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPanningReply(buf, order)
+		},
+	}
+}
+
 // GetProviderInfoCookie is a cookie used only for GetProviderInfo requests.
 type GetProviderInfoCookie struct {
 	*xgb.Cookie
@@ -3545,7 +4757,7 @@ type GetProviderInfoReply struct {
 	Length                 uint32 // number of bytes in this reply
 	Status                 byte
 	Timestamp              xproto.Timestamp
-	Capabilities           uint32
+	Capabilities           ProviderCapability
 	NumCrtcs               uint16
 	NumOutputs             uint16
 	NumAssociatedProviders uint16
@@ -3570,46 +4782,58 @@ func (cook GetProviderInfoCookie) Reply() (*GetProviderInfoReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getProviderInfoReply(buf), nil
+	return getProviderInfoReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetProviderInfo request arrives.
+func (cook GetProviderInfoCookie) ReplyContext(ctx context.Context) (*GetProviderInfoReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getProviderInfoReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getProviderInfoReply reads a byte slice into a GetProviderInfoReply value.
-func getProviderInfoReply(buf []byte) *GetProviderInfoReply {
+// getProviderInfoReply reads a byte slice in the byte order 'order' into a GetProviderInfoReply value.
+func getProviderInfoReply(buf []byte, order xgb.ByteOrder) *GetProviderInfoReply {
 	v := new(GetProviderInfoReply)
 	b := 1 // skip reply determinant
 
 	v.Status = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Timestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.Capabilities = xgb.Get32(buf[b:])
+	v.Capabilities = ProviderCapability(order.Get32(buf[b:]))
 	b += 4
 
-	v.NumCrtcs = xgb.Get16(buf[b:])
+	v.NumCrtcs = order.Get16(buf[b:])
 	b += 2
 
-	v.NumOutputs = xgb.Get16(buf[b:])
+	v.NumOutputs = order.Get16(buf[b:])
 	b += 2
 
-	v.NumAssociatedProviders = xgb.Get16(buf[b:])
+	v.NumAssociatedProviders = order.Get16(buf[b:])
 	b += 2
 
-	v.NameLen = xgb.Get16(buf[b:])
+	v.NameLen = order.Get16(buf[b:])
 	b += 2
 
 	b += 8 // padding
 
 	v.Crtcs = make([]Crtc, v.NumCrtcs)
 	for i := 0; i < int(v.NumCrtcs); i++ {
-		v.Crtcs[i] = Crtc(xgb.Get32(buf[b:]))
+		v.Crtcs[i] = Crtc(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -3617,7 +4841,7 @@ func getProviderInfoReply(buf []byte) *GetProviderInfoReply {
 
 	v.Outputs = make([]Output, v.NumOutputs)
 	for i := 0; i < int(v.NumOutputs); i++ {
-		v.Outputs[i] = Output(xgb.Get32(buf[b:]))
+		v.Outputs[i] = Output(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -3625,7 +4849,7 @@ func getProviderInfoReply(buf []byte) *GetProviderInfoReply {
 
 	v.AssociatedProviders = make([]Provider, v.NumAssociatedProviders)
 	for i := 0; i < int(v.NumAssociatedProviders); i++ {
-		v.AssociatedProviders[i] = Provider(xgb.Get32(buf[b:]))
+		v.AssociatedProviders[i] = Provider(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -3633,7 +4857,7 @@ func getProviderInfoReply(buf []byte) *GetProviderInfoReply {
 
 	v.AssociatedCapability = make([]uint32, v.NumAssociatedProviders)
 	for i := 0; i < int(v.NumAssociatedProviders); i++ {
-		v.AssociatedCapability[i] = xgb.Get32(buf[b:])
+		v.AssociatedCapability[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -3650,9 +4874,18 @@ func getProviderInfoReply(buf []byte) *GetProviderInfoReply {
 // Write request to wire for GetProviderInfo
 // getProviderInfoRequest writes a GetProviderInfo request to a byte slice.
 func getProviderInfoRequest(c *xgb.Conn, Provider Provider, ConfigTimestamp xproto.Timestamp) []byte {
+	return AppendGetProviderInfoRequest(nil, c, Provider, ConfigTimestamp)
+}
+
+// AppendGetProviderInfoRequest appends a GetProviderInfo request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetProviderInfoRequest(buf []byte, c *xgb.Conn, Provider Provider, ConfigTimestamp xproto.Timestamp) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -3662,18 +4895,50 @@ func getProviderInfoRequest(c *xgb.Conn, Provider Provider, ConfigTimestamp xpro
 	buf[b] = 33 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Provider))
+	order.Put32(buf[b:], uint32(Provider))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(ConfigTimestamp))
+	order.Put32(buf[b:], uint32(ConfigTimestamp))
 	b += 4
 
 	return buf
 }
 
+// GetProviderInfoRequest represents the data of a GetProviderInfo request, as it is read when tracing a connection.
+type GetProviderInfoRequest struct {
+	Provider        Provider
+	ConfigTimestamp xproto.Timestamp
+}
+
+// getProviderInfoRequestRead reads a byte slice in the byte order 'order' into a GetProviderInfoRequest value.
+func getProviderInfoRequestRead(buf []byte, order xgb.ByteOrder) *GetProviderInfoRequest {
+	v := new(GetProviderInfoRequest)
+	b := 4 // skip request header
+
+	v.Provider = Provider(order.Get32(buf[b:]))
+	b += 4
+
+	v.ConfigTimestamp = xproto.Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][33] = xgb.RequestInfo{
+		Name: "GetProviderInfo",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getProviderInfoRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getProviderInfoReply(buf, order)
+		},
+	}
+}
+
 // GetProviderPropertyCookie is a cookie used only for GetProviderProperty requests.
 type GetProviderPropertyCookie struct {
 	*xgb.Cookie
@@ -3726,30 +4991,42 @@ func (cook GetProviderPropertyCookie) Reply() (*GetProviderPropertyReply, error)
 	if buf == nil {
 		return nil, nil
 	}
-	return getProviderPropertyReply(buf), nil
+	return getProviderPropertyReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetProviderProperty request arrives.
+func (cook GetProviderPropertyCookie) ReplyContext(ctx context.Context) (*GetProviderPropertyReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getProviderPropertyReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getProviderPropertyReply reads a byte slice into a GetProviderPropertyReply value.
-func getProviderPropertyReply(buf []byte) *GetProviderPropertyReply {
+// getProviderPropertyReply reads a byte slice in the byte order 'order' into a GetProviderPropertyReply value.
+func getProviderPropertyReply(buf []byte, order xgb.ByteOrder) *GetProviderPropertyReply {
 	v := new(GetProviderPropertyReply)
 	b := 1 // skip reply determinant
 
 	v.Format = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Type = xproto.Atom(xgb.Get32(buf[b:]))
+	v.Type = xproto.Atom(order.Get32(buf[b:]))
 	b += 4
 
-	v.BytesAfter = xgb.Get32(buf[b:])
+	v.BytesAfter = order.Get32(buf[b:])
 	b += 4
 
-	v.NumItems = xgb.Get32(buf[b:])
+	v.NumItems = order.Get32(buf[b:])
 	b += 4
 
 	b += 12 // padding
@@ -3764,9 +5041,18 @@ func getProviderPropertyReply(buf []byte) *GetProviderPropertyReply {
 // Write request to wire for GetProviderProperty
 // getProviderPropertyRequest writes a GetProviderProperty request to a byte slice.
 func getProviderPropertyRequest(c *xgb.Conn, Provider Provider, Property xproto.Atom, Type xproto.Atom, LongOffset uint32, LongLength uint32, Delete bool, Pending bool) []byte {
+	return AppendGetProviderPropertyRequest(nil, c, Provider, Property, Type, LongOffset, LongLength, Delete, Pending)
+}
+
+// AppendGetProviderPropertyRequest appends a GetProviderProperty request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetProviderPropertyRequest(buf []byte, c *xgb.Conn, Provider Provider, Property xproto.Atom, Type xproto.Atom, LongOffset uint32, LongLength uint32, Delete bool, Pending bool) []byte {
 	size := 28
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -3776,22 +5062,22 @@ func getProviderPropertyRequest(c *xgb.Conn, Provider Provider, Property xproto.
 	buf[b] = 41 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Provider))
+	order.Put32(buf[b:], uint32(Provider))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Property))
+	order.Put32(buf[b:], uint32(Property))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Type))
+	order.Put32(buf[b:], uint32(Type))
 	b += 4
 
-	xgb.Put32(buf[b:], LongOffset)
+	order.Put32(buf[b:], LongOffset)
 	b += 4
 
-	xgb.Put32(buf[b:], LongLength)
+	order.Put32(buf[b:], LongLength)
 	b += 4
 
 	if Delete {
@@ -3813,6 +5099,69 @@ func getProviderPropertyRequest(c *xgb.Conn, Provider Provider, Property xproto.
 	return buf
 }
 
+// GetProviderPropertyRequest represents the data of a GetProviderProperty request, as it is read when tracing a connection.
+type GetProviderPropertyRequest struct {
+	Provider   Provider
+	Property   xproto.Atom
+	Type       xproto.Atom
+	LongOffset uint32
+	LongLength uint32
+	Delete     bool
+	Pending    bool
+	// padding: 2 bytes
+}
+
+// getProviderPropertyRequestRead reads a byte slice in the byte order 'order' into a GetProviderPropertyRequest value.
+func getProviderPropertyRequestRead(buf []byte, order xgb.ByteOrder) *GetProviderPropertyRequest {
+	v := new(GetProviderPropertyRequest)
+	b := 4 // skip request header
+
+	v.Provider = Provider(order.Get32(buf[b:]))
+	b += 4
+
+	v.Property = xproto.Atom(order.Get32(buf[b:]))
+	b += 4
+
+	v.Type = xproto.Atom(order.Get32(buf[b:]))
+	b += 4
+
+	v.LongOffset = order.Get32(buf[b:])
+	b += 4
+
+	v.LongLength = order.Get32(buf[b:])
+	b += 4
+
+	if buf[b] == 1 {
+		v.Delete = true
+	} else {
+		v.Delete = false
+	}
+	b += 1
+
+	if buf[b] == 1 {
+		v.Pending = true
+	} else {
+		v.Pending = false
+	}
+	b += 1
+
+	b += 2 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][41] = xgb.RequestInfo{
+		Name: "GetProviderProperty",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getProviderPropertyRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getProviderPropertyReply(buf, order)
+		},
+	}
+}
+
 // GetProvidersCookie is a cookie used only for GetProviders requests.
 type GetProvidersCookie struct {
 	*xgb.Cookie
@@ -3864,33 +5213,45 @@ func (cook GetProvidersCookie) Reply() (*GetProvidersReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getProvidersReply(buf), nil
+	return getProvidersReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetProviders request arrives.
+func (cook GetProvidersCookie) ReplyContext(ctx context.Context) (*GetProvidersReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getProvidersReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getProvidersReply reads a byte slice into a GetProvidersReply value.
-func getProvidersReply(buf []byte) *GetProvidersReply {
+// getProvidersReply reads a byte slice in the byte order 'order' into a GetProvidersReply value.
+func getProvidersReply(buf []byte, order xgb.ByteOrder) *GetProvidersReply {
 	v := new(GetProvidersReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Timestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.NumProviders = xgb.Get16(buf[b:])
+	v.NumProviders = order.Get16(buf[b:])
 	b += 2
 
 	b += 18 // padding
 
 	v.Providers = make([]Provider, v.NumProviders)
 	for i := 0; i < int(v.NumProviders); i++ {
-		v.Providers[i] = Provider(xgb.Get32(buf[b:]))
+		v.Providers[i] = Provider(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -3900,9 +5261,18 @@ func getProvidersReply(buf []byte) *GetProvidersReply {
 // Write request to wire for GetProviders
 // getProvidersRequest writes a GetProviders request to a byte slice.
 func getProvidersRequest(c *xgb.Conn, Window xproto.Window) []byte {
+	return AppendGetProvidersRequest(nil, c, Window)
+}
+
+// AppendGetProvidersRequest appends a GetProviders request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetProvidersRequest(buf []byte, c *xgb.Conn, Window xproto.Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -3912,15 +5282,43 @@ func getProvidersRequest(c *xgb.Conn, Window xproto.Window) []byte {
 	buf[b] = 32 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// GetProvidersRequest represents the data of a GetProviders request, as it is read when tracing a connection.
+type GetProvidersRequest struct {
+	Window xproto.Window
+}
+
+// getProvidersRequestRead reads a byte slice in the byte order 'order' into a GetProvidersRequest value.
+func getProvidersRequestRead(buf []byte, order xgb.ByteOrder) *GetProvidersRequest {
+	v := new(GetProvidersRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][32] = xgb.RequestInfo{
+		Name: "GetProviders",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getProvidersRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getProvidersReply(buf, order)
+		},
+	}
+}
+
 // GetScreenInfoCookie is a cookie used only for GetScreenInfo requests.
 type GetScreenInfoCookie struct {
 	*xgb.Cookie
@@ -3956,13 +5354,13 @@ func GetScreenInfoUnchecked(c *xgb.Conn, Window xproto.Window) GetScreenInfoCook
 type GetScreenInfoReply struct {
 	Sequence        uint16 // sequence number of the request for this reply
 	Length          uint32 // number of bytes in this reply
-	Rotations       byte
+	Rotations       Rotation
 	Root            xproto.Window
 	Timestamp       xproto.Timestamp
 	ConfigTimestamp xproto.Timestamp
 	NSizes          uint16
 	SizeID          uint16
-	Rotation        uint16
+	Rotation        Rotation
 	Rate            uint16
 	NInfo           uint16
 	// padding: 2 bytes
@@ -3980,56 +5378,68 @@ func (cook GetScreenInfoCookie) Reply() (*GetScreenInfoReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getScreenInfoReply(buf), nil
+	return getScreenInfoReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetScreenInfo request arrives.
+func (cook GetScreenInfoCookie) ReplyContext(ctx context.Context) (*GetScreenInfoReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getScreenInfoReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getScreenInfoReply reads a byte slice into a GetScreenInfoReply value.
-func getScreenInfoReply(buf []byte) *GetScreenInfoReply {
+// getScreenInfoReply reads a byte slice in the byte order 'order' into a GetScreenInfoReply value.
+func getScreenInfoReply(buf []byte, order xgb.ByteOrder) *GetScreenInfoReply {
 	v := new(GetScreenInfoReply)
 	b := 1 // skip reply determinant
 
-	v.Rotations = buf[b]
+	v.Rotations = Rotation(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Root = xproto.Window(xgb.Get32(buf[b:]))
+	v.Root = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Timestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.ConfigTimestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.ConfigTimestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.NSizes = xgb.Get16(buf[b:])
+	v.NSizes = order.Get16(buf[b:])
 	b += 2
 
-	v.SizeID = xgb.Get16(buf[b:])
+	v.SizeID = order.Get16(buf[b:])
 	b += 2
 
-	v.Rotation = xgb.Get16(buf[b:])
+	v.Rotation = Rotation(order.Get16(buf[b:]))
 	b += 2
 
-	v.Rate = xgb.Get16(buf[b:])
+	v.Rate = order.Get16(buf[b:])
 	b += 2
 
-	v.NInfo = xgb.Get16(buf[b:])
+	v.NInfo = order.Get16(buf[b:])
 	b += 2
 
 	b += 2 // padding
 
 	v.Sizes = make([]ScreenSize, v.NSizes)
-	b += ScreenSizeReadList(buf[b:], v.Sizes)
+	b += ScreenSizeReadList(buf[b:], order, v.Sizes)
 
 	b = (b + 1) & ^1 // alignment gap
 
 	v.Rates = make([]RefreshRates, (int(v.NInfo) - int(v.NSizes)))
-	b += RefreshRatesReadList(buf[b:], v.Rates)
+	b += RefreshRatesReadList(buf[b:], order, v.Rates)
 
 	return v
 }
@@ -4037,9 +5447,18 @@ func getScreenInfoReply(buf []byte) *GetScreenInfoReply {
 // Write request to wire for GetScreenInfo
 // getScreenInfoRequest writes a GetScreenInfo request to a byte slice.
 func getScreenInfoRequest(c *xgb.Conn, Window xproto.Window) []byte {
+	return AppendGetScreenInfoRequest(nil, c, Window)
+}
+
+// AppendGetScreenInfoRequest appends a GetScreenInfo request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetScreenInfoRequest(buf []byte, c *xgb.Conn, Window xproto.Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -4049,15 +5468,43 @@ func getScreenInfoRequest(c *xgb.Conn, Window xproto.Window) []byte {
 	buf[b] = 5 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// GetScreenInfoRequest represents the data of a GetScreenInfo request, as it is read when tracing a connection.
+type GetScreenInfoRequest struct {
+	Window xproto.Window
+}
+
+// getScreenInfoRequestRead reads a byte slice in the byte order 'order' into a GetScreenInfoRequest value.
+func getScreenInfoRequestRead(buf []byte, order xgb.ByteOrder) *GetScreenInfoRequest {
+	v := new(GetScreenInfoRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][5] = xgb.RequestInfo{
+		Name: "GetScreenInfo",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getScreenInfoRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getScreenInfoReply(buf, order)
+		},
+	}
+}
+
 // GetScreenResourcesCookie is a cookie used only for GetScreenResources requests.
 type GetScreenResourcesCookie struct {
 	*xgb.Cookie
@@ -4118,45 +5565,57 @@ func (cook GetScreenResourcesCookie) Reply() (*GetScreenResourcesReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getScreenResourcesReply(buf), nil
+	return getScreenResourcesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetScreenResources request arrives.
+func (cook GetScreenResourcesCookie) ReplyContext(ctx context.Context) (*GetScreenResourcesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getScreenResourcesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getScreenResourcesReply reads a byte slice into a GetScreenResourcesReply value.
-func getScreenResourcesReply(buf []byte) *GetScreenResourcesReply {
+// getScreenResourcesReply reads a byte slice in the byte order 'order' into a GetScreenResourcesReply value.
+func getScreenResourcesReply(buf []byte, order xgb.ByteOrder) *GetScreenResourcesReply {
 	v := new(GetScreenResourcesReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Timestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.ConfigTimestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.ConfigTimestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.NumCrtcs = xgb.Get16(buf[b:])
+	v.NumCrtcs = order.Get16(buf[b:])
 	b += 2
 
-	v.NumOutputs = xgb.Get16(buf[b:])
+	v.NumOutputs = order.Get16(buf[b:])
 	b += 2
 
-	v.NumModes = xgb.Get16(buf[b:])
+	v.NumModes = order.Get16(buf[b:])
 	b += 2
 
-	v.NamesLen = xgb.Get16(buf[b:])
+	v.NamesLen = order.Get16(buf[b:])
 	b += 2
 
 	b += 8 // padding
 
 	v.Crtcs = make([]Crtc, v.NumCrtcs)
 	for i := 0; i < int(v.NumCrtcs); i++ {
-		v.Crtcs[i] = Crtc(xgb.Get32(buf[b:]))
+		v.Crtcs[i] = Crtc(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -4164,14 +5623,14 @@ func getScreenResourcesReply(buf []byte) *GetScreenResourcesReply {
 
 	v.Outputs = make([]Output, v.NumOutputs)
 	for i := 0; i < int(v.NumOutputs); i++ {
-		v.Outputs[i] = Output(xgb.Get32(buf[b:]))
+		v.Outputs[i] = Output(order.Get32(buf[b:]))
 		b += 4
 	}
 
 	b = (b + 3) & ^3 // alignment gap
 
 	v.Modes = make([]ModeInfo, v.NumModes)
-	b += ModeInfoReadList(buf[b:], v.Modes)
+	b += ModeInfoReadList(buf[b:], order, v.Modes)
 
 	v.Names = make([]byte, v.NamesLen)
 	copy(v.Names[:v.NamesLen], buf[b:])
@@ -4183,9 +5642,18 @@ func getScreenResourcesReply(buf []byte) *GetScreenResourcesReply {
 // Write request to wire for GetScreenResources
 // getScreenResourcesRequest writes a GetScreenResources request to a byte slice.
 func getScreenResourcesRequest(c *xgb.Conn, Window xproto.Window) []byte {
+	return AppendGetScreenResourcesRequest(nil, c, Window)
+}
+
+// AppendGetScreenResourcesRequest appends a GetScreenResources request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetScreenResourcesRequest(buf []byte, c *xgb.Conn, Window xproto.Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -4195,15 +5663,43 @@ func getScreenResourcesRequest(c *xgb.Conn, Window xproto.Window) []byte {
 	buf[b] = 8 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// GetScreenResourcesRequest represents the data of a GetScreenResources request, as it is read when tracing a connection.
+type GetScreenResourcesRequest struct {
+	Window xproto.Window
+}
+
+// getScreenResourcesRequestRead reads a byte slice in the byte order 'order' into a GetScreenResourcesRequest value.
+func getScreenResourcesRequestRead(buf []byte, order xgb.ByteOrder) *GetScreenResourcesRequest {
+	v := new(GetScreenResourcesRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][8] = xgb.RequestInfo{
+		Name: "GetScreenResources",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getScreenResourcesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getScreenResourcesReply(buf, order)
+		},
+	}
+}
+
 // GetScreenResourcesCurrentCookie is a cookie used only for GetScreenResourcesCurrent requests.
 type GetScreenResourcesCurrentCookie struct {
 	*xgb.Cookie
@@ -4264,45 +5760,57 @@ func (cook GetScreenResourcesCurrentCookie) Reply() (*GetScreenResourcesCurrentR
 	if buf == nil {
 		return nil, nil
 	}
-	return getScreenResourcesCurrentReply(buf), nil
+	return getScreenResourcesCurrentReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetScreenResourcesCurrent request arrives.
+func (cook GetScreenResourcesCurrentCookie) ReplyContext(ctx context.Context) (*GetScreenResourcesCurrentReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getScreenResourcesCurrentReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getScreenResourcesCurrentReply reads a byte slice into a GetScreenResourcesCurrentReply value.
-func getScreenResourcesCurrentReply(buf []byte) *GetScreenResourcesCurrentReply {
+// getScreenResourcesCurrentReply reads a byte slice in the byte order 'order' into a GetScreenResourcesCurrentReply value.
+func getScreenResourcesCurrentReply(buf []byte, order xgb.ByteOrder) *GetScreenResourcesCurrentReply {
 	v := new(GetScreenResourcesCurrentReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Timestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.ConfigTimestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.ConfigTimestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.NumCrtcs = xgb.Get16(buf[b:])
+	v.NumCrtcs = order.Get16(buf[b:])
 	b += 2
 
-	v.NumOutputs = xgb.Get16(buf[b:])
+	v.NumOutputs = order.Get16(buf[b:])
 	b += 2
 
-	v.NumModes = xgb.Get16(buf[b:])
+	v.NumModes = order.Get16(buf[b:])
 	b += 2
 
-	v.NamesLen = xgb.Get16(buf[b:])
+	v.NamesLen = order.Get16(buf[b:])
 	b += 2
 
 	b += 8 // padding
 
 	v.Crtcs = make([]Crtc, v.NumCrtcs)
 	for i := 0; i < int(v.NumCrtcs); i++ {
-		v.Crtcs[i] = Crtc(xgb.Get32(buf[b:]))
+		v.Crtcs[i] = Crtc(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -4310,14 +5818,14 @@ func getScreenResourcesCurrentReply(buf []byte) *GetScreenResourcesCurrentReply
 
 	v.Outputs = make([]Output, v.NumOutputs)
 	for i := 0; i < int(v.NumOutputs); i++ {
-		v.Outputs[i] = Output(xgb.Get32(buf[b:]))
+		v.Outputs[i] = Output(order.Get32(buf[b:]))
 		b += 4
 	}
 
 	b = (b + 3) & ^3 // alignment gap
 
 	v.Modes = make([]ModeInfo, v.NumModes)
-	b += ModeInfoReadList(buf[b:], v.Modes)
+	b += ModeInfoReadList(buf[b:], order, v.Modes)
 
 	v.Names = make([]byte, v.NamesLen)
 	copy(v.Names[:v.NamesLen], buf[b:])
@@ -4329,9 +5837,18 @@ func getScreenResourcesCurrentReply(buf []byte) *GetScreenResourcesCurrentReply
 // Write request to wire for GetScreenResourcesCurrent
 // getScreenResourcesCurrentRequest writes a GetScreenResourcesCurrent request to a byte slice.
 func getScreenResourcesCurrentRequest(c *xgb.Conn, Window xproto.Window) []byte {
+	return AppendGetScreenResourcesCurrentRequest(nil, c, Window)
+}
+
+// AppendGetScreenResourcesCurrentRequest appends a GetScreenResourcesCurrent request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetScreenResourcesCurrentRequest(buf []byte, c *xgb.Conn, Window xproto.Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -4341,15 +5858,43 @@ func getScreenResourcesCurrentRequest(c *xgb.Conn, Window xproto.Window) []byte
 	buf[b] = 25 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// GetScreenResourcesCurrentRequest represents the data of a GetScreenResourcesCurrent request, as it is read when tracing a connection.
+type GetScreenResourcesCurrentRequest struct {
+	Window xproto.Window
+}
+
+// getScreenResourcesCurrentRequestRead reads a byte slice in the byte order 'order' into a GetScreenResourcesCurrentRequest value.
+func getScreenResourcesCurrentRequestRead(buf []byte, order xgb.ByteOrder) *GetScreenResourcesCurrentRequest {
+	v := new(GetScreenResourcesCurrentRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][25] = xgb.RequestInfo{
+		Name: "GetScreenResourcesCurrent",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getScreenResourcesCurrentRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getScreenResourcesCurrentReply(buf, order)
+		},
+	}
+}
+
 // GetScreenSizeRangeCookie is a cookie used only for GetScreenSizeRange requests.
 type GetScreenSizeRangeCookie struct {
 	*xgb.Cookie
@@ -4402,32 +5947,44 @@ func (cook GetScreenSizeRangeCookie) Reply() (*GetScreenSizeRangeReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getScreenSizeRangeReply(buf), nil
+	return getScreenSizeRangeReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetScreenSizeRange request arrives.
+func (cook GetScreenSizeRangeCookie) ReplyContext(ctx context.Context) (*GetScreenSizeRangeReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getScreenSizeRangeReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getScreenSizeRangeReply reads a byte slice into a GetScreenSizeRangeReply value.
-func getScreenSizeRangeReply(buf []byte) *GetScreenSizeRangeReply {
+// getScreenSizeRangeReply reads a byte slice in the byte order 'order' into a GetScreenSizeRangeReply value.
+func getScreenSizeRangeReply(buf []byte, order xgb.ByteOrder) *GetScreenSizeRangeReply {
 	v := new(GetScreenSizeRangeReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.MinWidth = xgb.Get16(buf[b:])
+	v.MinWidth = order.Get16(buf[b:])
 	b += 2
 
-	v.MinHeight = xgb.Get16(buf[b:])
+	v.MinHeight = order.Get16(buf[b:])
 	b += 2
 
-	v.MaxWidth = xgb.Get16(buf[b:])
+	v.MaxWidth = order.Get16(buf[b:])
 	b += 2
 
-	v.MaxHeight = xgb.Get16(buf[b:])
+	v.MaxHeight = order.Get16(buf[b:])
 	b += 2
 
 	b += 16 // padding
@@ -4438,9 +5995,18 @@ func getScreenSizeRangeReply(buf []byte) *GetScreenSizeRangeReply {
 // Write request to wire for GetScreenSizeRange
 // getScreenSizeRangeRequest writes a GetScreenSizeRange request to a byte slice.
 func getScreenSizeRangeRequest(c *xgb.Conn, Window xproto.Window) []byte {
+	return AppendGetScreenSizeRangeRequest(nil, c, Window)
+}
+
+// AppendGetScreenSizeRangeRequest appends a GetScreenSizeRange request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetScreenSizeRangeRequest(buf []byte, c *xgb.Conn, Window xproto.Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -4450,25 +6016,53 @@ func getScreenSizeRangeRequest(c *xgb.Conn, Window xproto.Window) []byte {
 	buf[b] = 6 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
-// ListOutputPropertiesCookie is a cookie used only for ListOutputProperties requests.
-type ListOutputPropertiesCookie struct {
-	*xgb.Cookie
+// GetScreenSizeRangeRequest represents the data of a GetScreenSizeRange request, as it is read when tracing a connection.
+type GetScreenSizeRangeRequest struct {
+	Window xproto.Window
 }
 
-// ListOutputProperties sends a checked request.
-// If an error occurs, it will be returned with the reply by calling ListOutputPropertiesCookie.Reply()
-func ListOutputProperties(c *xgb.Conn, Output Output) ListOutputPropertiesCookie {
-	c.ExtLock.RLock()
-	defer c.ExtLock.RUnlock()
+// getScreenSizeRangeRequestRead reads a byte slice in the byte order 'order' into a GetScreenSizeRangeRequest value.
+func getScreenSizeRangeRequestRead(buf []byte, order xgb.ByteOrder) *GetScreenSizeRangeRequest {
+	v := new(GetScreenSizeRangeRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][6] = xgb.RequestInfo{
+		Name: "GetScreenSizeRange",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getScreenSizeRangeRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getScreenSizeRangeReply(buf, order)
+		},
+	}
+}
+
+// ListOutputPropertiesCookie is a cookie used only for ListOutputProperties requests.
+type ListOutputPropertiesCookie struct {
+	*xgb.Cookie
+}
+
+// ListOutputProperties sends a checked request.
+// If an error occurs, it will be returned with the reply by calling ListOutputPropertiesCookie.Reply()
+func ListOutputProperties(c *xgb.Conn, Output Output) ListOutputPropertiesCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RANDR"]; !ok {
 		panic("Cannot issue request 'ListOutputProperties' using the uninitialized extension 'RANDR'. randr.Init(connObj) must be called first.")
 	}
@@ -4509,30 +6103,42 @@ func (cook ListOutputPropertiesCookie) Reply() (*ListOutputPropertiesReply, erro
 	if buf == nil {
 		return nil, nil
 	}
-	return listOutputPropertiesReply(buf), nil
+	return listOutputPropertiesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// listOutputPropertiesReply reads a byte slice into a ListOutputPropertiesReply value.
-func listOutputPropertiesReply(buf []byte) *ListOutputPropertiesReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a ListOutputProperties request arrives.
+func (cook ListOutputPropertiesCookie) ReplyContext(ctx context.Context) (*ListOutputPropertiesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return listOutputPropertiesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// listOutputPropertiesReply reads a byte slice in the byte order 'order' into a ListOutputPropertiesReply value.
+func listOutputPropertiesReply(buf []byte, order xgb.ByteOrder) *ListOutputPropertiesReply {
 	v := new(ListOutputPropertiesReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.NumAtoms = xgb.Get16(buf[b:])
+	v.NumAtoms = order.Get16(buf[b:])
 	b += 2
 
 	b += 22 // padding
 
 	v.Atoms = make([]xproto.Atom, v.NumAtoms)
 	for i := 0; i < int(v.NumAtoms); i++ {
-		v.Atoms[i] = xproto.Atom(xgb.Get32(buf[b:]))
+		v.Atoms[i] = xproto.Atom(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -4542,9 +6148,18 @@ func listOutputPropertiesReply(buf []byte) *ListOutputPropertiesReply {
 // Write request to wire for ListOutputProperties
 // listOutputPropertiesRequest writes a ListOutputProperties request to a byte slice.
 func listOutputPropertiesRequest(c *xgb.Conn, Output Output) []byte {
+	return AppendListOutputPropertiesRequest(nil, c, Output)
+}
+
+// AppendListOutputPropertiesRequest appends a ListOutputProperties request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendListOutputPropertiesRequest(buf []byte, c *xgb.Conn, Output Output) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -4554,15 +6169,43 @@ func listOutputPropertiesRequest(c *xgb.Conn, Output Output) []byte {
 	buf[b] = 10 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Output))
+	order.Put32(buf[b:], uint32(Output))
 	b += 4
 
 	return buf
 }
 
+// ListOutputPropertiesRequest represents the data of a ListOutputProperties request, as it is read when tracing a connection.
+type ListOutputPropertiesRequest struct {
+	Output Output
+}
+
+// listOutputPropertiesRequestRead reads a byte slice in the byte order 'order' into a ListOutputPropertiesRequest value.
+func listOutputPropertiesRequestRead(buf []byte, order xgb.ByteOrder) *ListOutputPropertiesRequest {
+	v := new(ListOutputPropertiesRequest)
+	b := 4 // skip request header
+
+	v.Output = Output(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][10] = xgb.RequestInfo{
+		Name: "ListOutputProperties",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listOutputPropertiesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listOutputPropertiesReply(buf, order)
+		},
+	}
+}
+
 // ListProviderPropertiesCookie is a cookie used only for ListProviderProperties requests.
 type ListProviderPropertiesCookie struct {
 	*xgb.Cookie
@@ -4613,30 +6256,42 @@ func (cook ListProviderPropertiesCookie) Reply() (*ListProviderPropertiesReply,
 	if buf == nil {
 		return nil, nil
 	}
-	return listProviderPropertiesReply(buf), nil
+	return listProviderPropertiesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a ListProviderProperties request arrives.
+func (cook ListProviderPropertiesCookie) ReplyContext(ctx context.Context) (*ListProviderPropertiesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return listProviderPropertiesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// listProviderPropertiesReply reads a byte slice into a ListProviderPropertiesReply value.
-func listProviderPropertiesReply(buf []byte) *ListProviderPropertiesReply {
+// listProviderPropertiesReply reads a byte slice in the byte order 'order' into a ListProviderPropertiesReply value.
+func listProviderPropertiesReply(buf []byte, order xgb.ByteOrder) *ListProviderPropertiesReply {
 	v := new(ListProviderPropertiesReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.NumAtoms = xgb.Get16(buf[b:])
+	v.NumAtoms = order.Get16(buf[b:])
 	b += 2
 
 	b += 22 // padding
 
 	v.Atoms = make([]xproto.Atom, v.NumAtoms)
 	for i := 0; i < int(v.NumAtoms); i++ {
-		v.Atoms[i] = xproto.Atom(xgb.Get32(buf[b:]))
+		v.Atoms[i] = xproto.Atom(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -4646,9 +6301,18 @@ func listProviderPropertiesReply(buf []byte) *ListProviderPropertiesReply {
 // Write request to wire for ListProviderProperties
 // listProviderPropertiesRequest writes a ListProviderProperties request to a byte slice.
 func listProviderPropertiesRequest(c *xgb.Conn, Provider Provider) []byte {
+	return AppendListProviderPropertiesRequest(nil, c, Provider)
+}
+
+// AppendListProviderPropertiesRequest appends a ListProviderProperties request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendListProviderPropertiesRequest(buf []byte, c *xgb.Conn, Provider Provider) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -4658,15 +6322,43 @@ func listProviderPropertiesRequest(c *xgb.Conn, Provider Provider) []byte {
 	buf[b] = 36 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Provider))
+	order.Put32(buf[b:], uint32(Provider))
 	b += 4
 
 	return buf
 }
 
+// ListProviderPropertiesRequest represents the data of a ListProviderProperties request, as it is read when tracing a connection.
+type ListProviderPropertiesRequest struct {
+	Provider Provider
+}
+
+// listProviderPropertiesRequestRead reads a byte slice in the byte order 'order' into a ListProviderPropertiesRequest value.
+func listProviderPropertiesRequestRead(buf []byte, order xgb.ByteOrder) *ListProviderPropertiesRequest {
+	v := new(ListProviderPropertiesRequest)
+	b := 4 // skip request header
+
+	v.Provider = Provider(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][36] = xgb.RequestInfo{
+		Name: "ListProviderProperties",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listProviderPropertiesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listProviderPropertiesReply(buf, order)
+		},
+	}
+}
+
 // QueryOutputPropertyCookie is a cookie used only for QueryOutputProperty requests.
 type QueryOutputPropertyCookie struct {
 	*xgb.Cookie
@@ -4719,20 +6411,32 @@ func (cook QueryOutputPropertyCookie) Reply() (*QueryOutputPropertyReply, error)
 	if buf == nil {
 		return nil, nil
 	}
-	return queryOutputPropertyReply(buf), nil
+	return queryOutputPropertyReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryOutputProperty request arrives.
+func (cook QueryOutputPropertyCookie) ReplyContext(ctx context.Context) (*QueryOutputPropertyReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryOutputPropertyReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryOutputPropertyReply reads a byte slice into a QueryOutputPropertyReply value.
-func queryOutputPropertyReply(buf []byte) *QueryOutputPropertyReply {
+// queryOutputPropertyReply reads a byte slice in the byte order 'order' into a QueryOutputPropertyReply value.
+func queryOutputPropertyReply(buf []byte, order xgb.ByteOrder) *QueryOutputPropertyReply {
 	v := new(QueryOutputPropertyReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	if buf[b] == 1 {
@@ -4760,7 +6464,7 @@ func queryOutputPropertyReply(buf []byte) *QueryOutputPropertyReply {
 
 	v.ValidValues = make([]int32, v.Length)
 	for i := 0; i < int(v.Length); i++ {
-		v.ValidValues[i] = int32(xgb.Get32(buf[b:]))
+		v.ValidValues[i] = int32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -4770,9 +6474,18 @@ func queryOutputPropertyReply(buf []byte) *QueryOutputPropertyReply {
 // Write request to wire for QueryOutputProperty
 // queryOutputPropertyRequest writes a QueryOutputProperty request to a byte slice.
 func queryOutputPropertyRequest(c *xgb.Conn, Output Output, Property xproto.Atom) []byte {
+	return AppendQueryOutputPropertyRequest(nil, c, Output, Property)
+}
+
+// AppendQueryOutputPropertyRequest appends a QueryOutputProperty request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryOutputPropertyRequest(buf []byte, c *xgb.Conn, Output Output, Property xproto.Atom) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -4782,18 +6495,50 @@ func queryOutputPropertyRequest(c *xgb.Conn, Output Output, Property xproto.Atom
 	buf[b] = 11 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Output))
+	order.Put32(buf[b:], uint32(Output))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Property))
+	order.Put32(buf[b:], uint32(Property))
 	b += 4
 
 	return buf
 }
 
+// QueryOutputPropertyRequest represents the data of a QueryOutputProperty request, as it is read when tracing a connection.
+type QueryOutputPropertyRequest struct {
+	Output   Output
+	Property xproto.Atom
+}
+
+// queryOutputPropertyRequestRead reads a byte slice in the byte order 'order' into a QueryOutputPropertyRequest value.
+func queryOutputPropertyRequestRead(buf []byte, order xgb.ByteOrder) *QueryOutputPropertyRequest {
+	v := new(QueryOutputPropertyRequest)
+	b := 4 // skip request header
+
+	v.Output = Output(order.Get32(buf[b:]))
+	b += 4
+
+	v.Property = xproto.Atom(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][11] = xgb.RequestInfo{
+		Name: "QueryOutputProperty",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryOutputPropertyRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryOutputPropertyReply(buf, order)
+		},
+	}
+}
+
 // QueryProviderPropertyCookie is a cookie used only for QueryProviderProperty requests.
 type QueryProviderPropertyCookie struct {
 	*xgb.Cookie
@@ -4846,20 +6591,32 @@ func (cook QueryProviderPropertyCookie) Reply() (*QueryProviderPropertyReply, er
 	if buf == nil {
 		return nil, nil
 	}
-	return queryProviderPropertyReply(buf), nil
+	return queryProviderPropertyReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryProviderPropertyReply reads a byte slice into a QueryProviderPropertyReply value.
-func queryProviderPropertyReply(buf []byte) *QueryProviderPropertyReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryProviderProperty request arrives.
+func (cook QueryProviderPropertyCookie) ReplyContext(ctx context.Context) (*QueryProviderPropertyReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryProviderPropertyReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryProviderPropertyReply reads a byte slice in the byte order 'order' into a QueryProviderPropertyReply value.
+func queryProviderPropertyReply(buf []byte, order xgb.ByteOrder) *QueryProviderPropertyReply {
 	v := new(QueryProviderPropertyReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	if buf[b] == 1 {
@@ -4887,7 +6644,7 @@ func queryProviderPropertyReply(buf []byte) *QueryProviderPropertyReply {
 
 	v.ValidValues = make([]int32, v.Length)
 	for i := 0; i < int(v.Length); i++ {
-		v.ValidValues[i] = int32(xgb.Get32(buf[b:]))
+		v.ValidValues[i] = int32(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -4897,9 +6654,18 @@ func queryProviderPropertyReply(buf []byte) *QueryProviderPropertyReply {
 // Write request to wire for QueryProviderProperty
 // queryProviderPropertyRequest writes a QueryProviderProperty request to a byte slice.
 func queryProviderPropertyRequest(c *xgb.Conn, Provider Provider, Property xproto.Atom) []byte {
+	return AppendQueryProviderPropertyRequest(nil, c, Provider, Property)
+}
+
+// AppendQueryProviderPropertyRequest appends a QueryProviderProperty request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryProviderPropertyRequest(buf []byte, c *xgb.Conn, Provider Provider, Property xproto.Atom) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -4909,18 +6675,50 @@ func queryProviderPropertyRequest(c *xgb.Conn, Provider Provider, Property xprot
 	buf[b] = 37 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Provider))
+	order.Put32(buf[b:], uint32(Provider))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Property))
+	order.Put32(buf[b:], uint32(Property))
 	b += 4
 
 	return buf
 }
 
+// QueryProviderPropertyRequest represents the data of a QueryProviderProperty request, as it is read when tracing a connection.
+type QueryProviderPropertyRequest struct {
+	Provider Provider
+	Property xproto.Atom
+}
+
+// queryProviderPropertyRequestRead reads a byte slice in the byte order 'order' into a QueryProviderPropertyRequest value.
+func queryProviderPropertyRequestRead(buf []byte, order xgb.ByteOrder) *QueryProviderPropertyRequest {
+	v := new(QueryProviderPropertyRequest)
+	b := 4 // skip request header
+
+	v.Provider = Provider(order.Get32(buf[b:]))
+	b += 4
+
+	v.Property = xproto.Atom(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][37] = xgb.RequestInfo{
+		Name: "QueryProviderProperty",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryProviderPropertyRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryProviderPropertyReply(buf, order)
+		},
+	}
+}
+
 // QueryVersionCookie is a cookie used only for QueryVersion requests.
 type QueryVersionCookie struct {
 	*xgb.Cookie
@@ -4971,26 +6769,38 @@ func (cook QueryVersionCookie) Reply() (*QueryVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryVersionReply(buf), nil
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryVersionReply reads a byte slice into a QueryVersionReply value.
-func queryVersionReply(buf []byte) *QueryVersionReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryVersion request arrives.
+func (cook QueryVersionCookie) ReplyContext(ctx context.Context) (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryVersionReply reads a byte slice in the byte order 'order' into a QueryVersionReply value.
+func queryVersionReply(buf []byte, order xgb.ByteOrder) *QueryVersionReply {
 	v := new(QueryVersionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.MajorVersion = xgb.Get32(buf[b:])
+	v.MajorVersion = order.Get32(buf[b:])
 	b += 4
 
-	v.MinorVersion = xgb.Get32(buf[b:])
+	v.MinorVersion = order.Get32(buf[b:])
 	b += 4
 
 	b += 16 // padding
@@ -5001,9 +6811,18 @@ func queryVersionReply(buf []byte) *QueryVersionReply {
 // Write request to wire for QueryVersion
 // queryVersionRequest writes a QueryVersion request to a byte slice.
 func queryVersionRequest(c *xgb.Conn, MajorVersion uint32, MinorVersion uint32) []byte {
+	return AppendQueryVersionRequest(nil, c, MajorVersion, MinorVersion)
+}
+
+// AppendQueryVersionRequest appends a QueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryVersionRequest(buf []byte, c *xgb.Conn, MajorVersion uint32, MinorVersion uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -5013,18 +6832,50 @@ func queryVersionRequest(c *xgb.Conn, MajorVersion uint32, MinorVersion uint32)
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], MajorVersion)
+	order.Put32(buf[b:], MajorVersion)
 	b += 4
 
-	xgb.Put32(buf[b:], MinorVersion)
+	order.Put32(buf[b:], MinorVersion)
 	b += 4
 
 	return buf
 }
 
+// QueryVersionRequest represents the data of a QueryVersion request, as it is read when tracing a connection.
+type QueryVersionRequest struct {
+	MajorVersion uint32
+	MinorVersion uint32
+}
+
+// queryVersionRequestRead reads a byte slice in the byte order 'order' into a QueryVersionRequest value.
+func queryVersionRequestRead(buf []byte, order xgb.ByteOrder) *QueryVersionRequest {
+	v := new(QueryVersionRequest)
+	b := 4 // skip request header
+
+	v.MajorVersion = order.Get32(buf[b:])
+	b += 4
+
+	v.MinorVersion = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][0] = xgb.RequestInfo{
+		Name: "QueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionReply(buf, order)
+		},
+	}
+}
+
 // SelectInputCookie is a cookie used only for SelectInput requests.
 type SelectInputCookie struct {
 	*xgb.Cookie
@@ -5032,7 +6883,7 @@ type SelectInputCookie struct {
 
 // SelectInput sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func SelectInput(c *xgb.Conn, Window xproto.Window, Enable uint16) SelectInputCookie {
+func SelectInput(c *xgb.Conn, Window xproto.Window, Enable NotifyMask) SelectInputCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RANDR"]; !ok {
@@ -5045,7 +6896,7 @@ func SelectInput(c *xgb.Conn, Window xproto.Window, Enable uint16) SelectInputCo
 
 // SelectInputChecked sends a checked request.
 // If an error occurs, it can be retrieved using SelectInputCookie.Check()
-func SelectInputChecked(c *xgb.Conn, Window xproto.Window, Enable uint16) SelectInputCookie {
+func SelectInputChecked(c *xgb.Conn, Window xproto.Window, Enable NotifyMask) SelectInputCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RANDR"]; !ok {
@@ -5062,12 +6913,26 @@ func (cook SelectInputCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SelectInputCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SelectInput
 // selectInputRequest writes a SelectInput request to a byte slice.
-func selectInputRequest(c *xgb.Conn, Window xproto.Window, Enable uint16) []byte {
+func selectInputRequest(c *xgb.Conn, Window xproto.Window, Enable NotifyMask) []byte {
+	return AppendSelectInputRequest(nil, c, Window, Enable)
+}
+
+// AppendSelectInputRequest appends a SelectInput request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSelectInputRequest(buf []byte, c *xgb.Conn, Window xproto.Window, Enable NotifyMask) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -5077,13 +6942,13 @@ func selectInputRequest(c *xgb.Conn, Window xproto.Window, Enable uint16) []byte
 	buf[b] = 4 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put16(buf[b:], Enable)
+	order.Put16(buf[b:], uint16(Enable))
 	b += 2
 
 	b += 2 // padding
@@ -5091,6 +6956,38 @@ func selectInputRequest(c *xgb.Conn, Window xproto.Window, Enable uint16) []byte
 	return buf
 }
 
+// SelectInputRequest represents the data of a SelectInput request, as it is read when tracing a connection.
+type SelectInputRequest struct {
+	Window xproto.Window
+	Enable NotifyMask
+	// padding: 2 bytes
+}
+
+// selectInputRequestRead reads a byte slice in the byte order 'order' into a SelectInputRequest value.
+func selectInputRequestRead(buf []byte, order xgb.ByteOrder) *SelectInputRequest {
+	v := new(SelectInputRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Enable = NotifyMask(order.Get16(buf[b:]))
+	b += 2
+
+	b += 2 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][4] = xgb.RequestInfo{
+		Name: "SelectInput",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return selectInputRequestRead(buf, order)
+		},
+	}
+}
+
 // SetCrtcConfigCookie is a cookie used only for SetCrtcConfig requests.
 type SetCrtcConfigCookie struct {
 	*xgb.Cookie
@@ -5098,7 +6995,7 @@ type SetCrtcConfigCookie struct {
 
 // SetCrtcConfig sends a checked request.
 // If an error occurs, it will be returned with the reply by calling SetCrtcConfigCookie.Reply()
-func SetCrtcConfig(c *xgb.Conn, Crtc Crtc, Timestamp xproto.Timestamp, ConfigTimestamp xproto.Timestamp, X int16, Y int16, Mode Mode, Rotation uint16, Outputs []Output) SetCrtcConfigCookie {
+func SetCrtcConfig(c *xgb.Conn, Crtc Crtc, Timestamp xproto.Timestamp, ConfigTimestamp xproto.Timestamp, X int16, Y int16, Mode Mode, Rotation Rotation, Outputs []Output) SetCrtcConfigCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RANDR"]; !ok {
@@ -5111,7 +7008,7 @@ func SetCrtcConfig(c *xgb.Conn, Crtc Crtc, Timestamp xproto.Timestamp, ConfigTim
 
 // SetCrtcConfigUnchecked sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func SetCrtcConfigUnchecked(c *xgb.Conn, Crtc Crtc, Timestamp xproto.Timestamp, ConfigTimestamp xproto.Timestamp, X int16, Y int16, Mode Mode, Rotation uint16, Outputs []Output) SetCrtcConfigCookie {
+func SetCrtcConfigUnchecked(c *xgb.Conn, Crtc Crtc, Timestamp xproto.Timestamp, ConfigTimestamp xproto.Timestamp, X int16, Y int16, Mode Mode, Rotation Rotation, Outputs []Output) SetCrtcConfigCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RANDR"]; !ok {
@@ -5126,7 +7023,7 @@ func SetCrtcConfigUnchecked(c *xgb.Conn, Crtc Crtc, Timestamp xproto.Timestamp,
 type SetCrtcConfigReply struct {
 	Sequence  uint16 // sequence number of the request for this reply
 	Length    uint32 // number of bytes in this reply
-	Status    byte
+	Status    SetConfig
 	Timestamp xproto.Timestamp
 	// padding: 20 bytes
 }
@@ -5140,24 +7037,36 @@ func (cook SetCrtcConfigCookie) Reply() (*SetCrtcConfigReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return setCrtcConfigReply(buf), nil
+	return setCrtcConfigReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a SetCrtcConfig request arrives.
+func (cook SetCrtcConfigCookie) ReplyContext(ctx context.Context) (*SetCrtcConfigReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return setCrtcConfigReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// setCrtcConfigReply reads a byte slice into a SetCrtcConfigReply value.
-func setCrtcConfigReply(buf []byte) *SetCrtcConfigReply {
+// setCrtcConfigReply reads a byte slice in the byte order 'order' into a SetCrtcConfigReply value.
+func setCrtcConfigReply(buf []byte, order xgb.ByteOrder) *SetCrtcConfigReply {
 	v := new(SetCrtcConfigReply)
 	b := 1 // skip reply determinant
 
-	v.Status = buf[b]
+	v.Status = SetConfig(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Timestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
 	b += 20 // padding
@@ -5167,10 +7076,19 @@ func setCrtcConfigReply(buf []byte) *SetCrtcConfigReply {
 
 // Write request to wire for SetCrtcConfig
 // setCrtcConfigRequest writes a SetCrtcConfig request to a byte slice.
-func setCrtcConfigRequest(c *xgb.Conn, Crtc Crtc, Timestamp xproto.Timestamp, ConfigTimestamp xproto.Timestamp, X int16, Y int16, Mode Mode, Rotation uint16, Outputs []Output) []byte {
+func setCrtcConfigRequest(c *xgb.Conn, Crtc Crtc, Timestamp xproto.Timestamp, ConfigTimestamp xproto.Timestamp, X int16, Y int16, Mode Mode, Rotation Rotation, Outputs []Output) []byte {
+	return AppendSetCrtcConfigRequest(nil, c, Crtc, Timestamp, ConfigTimestamp, X, Y, Mode, Rotation, Outputs)
+}
+
+// AppendSetCrtcConfigRequest appends a SetCrtcConfig request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetCrtcConfigRequest(buf []byte, c *xgb.Conn, Crtc Crtc, Timestamp xproto.Timestamp, ConfigTimestamp xproto.Timestamp, X int16, Y int16, Mode Mode, Rotation Rotation, Outputs []Output) []byte {
 	size := xgb.Pad((28 + xgb.Pad((len(Outputs) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -5180,40 +7098,102 @@ func setCrtcConfigRequest(c *xgb.Conn, Crtc Crtc, Timestamp xproto.Timestamp, Co
 	buf[b] = 21 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Crtc))
+	order.Put32(buf[b:], uint32(Crtc))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Timestamp))
+	order.Put32(buf[b:], uint32(Timestamp))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(ConfigTimestamp))
+	order.Put32(buf[b:], uint32(ConfigTimestamp))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(X))
+	order.Put16(buf[b:], uint16(X))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(Y))
+	order.Put16(buf[b:], uint16(Y))
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Mode))
+	order.Put32(buf[b:], uint32(Mode))
 	b += 4
 
-	xgb.Put16(buf[b:], Rotation)
+	order.Put16(buf[b:], uint16(Rotation))
 	b += 2
 
 	b += 2 // padding
 
 	for i := 0; i < int(len(Outputs)); i++ {
-		xgb.Put32(buf[b:], uint32(Outputs[i]))
+		order.Put32(buf[b:], uint32(Outputs[i]))
 		b += 4
 	}
 
 	return buf
 }
 
+// SetCrtcConfigRequest represents the data of a SetCrtcConfig request, as it is read when tracing a connection.
+type SetCrtcConfigRequest struct {
+	Crtc            Crtc
+	Timestamp       xproto.Timestamp
+	ConfigTimestamp xproto.Timestamp
+	X               int16
+	Y               int16
+	Mode            Mode
+	Rotation        Rotation
+	// padding: 2 bytes
+	Outputs []Output // size: xgb.Pad((len(Outputs) * 4))
+}
+
+// setCrtcConfigRequestRead reads a byte slice in the byte order 'order' into a SetCrtcConfigRequest value.
+func setCrtcConfigRequestRead(buf []byte, order xgb.ByteOrder) *SetCrtcConfigRequest {
+	v := new(SetCrtcConfigRequest)
+	b := 4 // skip request header
+
+	v.Crtc = Crtc(order.Get32(buf[b:]))
+	b += 4
+
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	v.ConfigTimestamp = xproto.Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	v.X = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Y = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Mode = Mode(order.Get32(buf[b:]))
+	b += 4
+
+	v.Rotation = Rotation(order.Get16(buf[b:]))
+	b += 2
+
+	b += 2 // padding
+
+	v.Outputs = make([]Output, (len(buf)-b)/4)
+	for i := 0; i < int((len(buf)-b)/4); i++ {
+		v.Outputs[i] = Output(order.Get32(buf[b:]))
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][21] = xgb.RequestInfo{
+		Name: "SetCrtcConfig",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setCrtcConfigRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setCrtcConfigReply(buf, order)
+		},
+	}
+}
+
 // SetCrtcGammaCookie is a cookie used only for SetCrtcGamma requests.
 type SetCrtcGammaCookie struct {
 	*xgb.Cookie
@@ -5251,12 +7231,26 @@ func (cook SetCrtcGammaCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetCrtcGammaCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetCrtcGamma
 // setCrtcGammaRequest writes a SetCrtcGamma request to a byte slice.
 func setCrtcGammaRequest(c *xgb.Conn, Crtc Crtc, Size uint16, Red []uint16, Green []uint16, Blue []uint16) []byte {
+	return AppendSetCrtcGammaRequest(nil, c, Crtc, Size, Red, Green, Blue)
+}
+
+// AppendSetCrtcGammaRequest appends a SetCrtcGamma request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetCrtcGammaRequest(buf []byte, c *xgb.Conn, Crtc Crtc, Size uint16, Red []uint16, Green []uint16, Blue []uint16) []byte {
 	size := xgb.Pad((((((12 + xgb.Pad((int(Size) * 2))) + 2) + xgb.Pad((int(Size) * 2))) + 2) + xgb.Pad((int(Size) * 2))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -5269,38 +7263,97 @@ func setCrtcGammaRequest(c *xgb.Conn, Crtc Crtc, Size uint16, Red []uint16, Gree
 	blen := b
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Crtc))
+	order.Put32(buf[b:], uint32(Crtc))
 	b += 4
 
-	xgb.Put16(buf[b:], Size)
+	order.Put16(buf[b:], Size)
 	b += 2
 
 	b += 2 // padding
 
 	for i := 0; i < int(Size); i++ {
-		xgb.Put16(buf[b:], Red[i])
+		order.Put16(buf[b:], Red[i])
 		b += 2
 	}
 
 	b = (b + 1) & ^1 // alignment gap
 
 	for i := 0; i < int(Size); i++ {
-		xgb.Put16(buf[b:], Green[i])
+		order.Put16(buf[b:], Green[i])
 		b += 2
 	}
 
 	b = (b + 1) & ^1 // alignment gap
 
 	for i := 0; i < int(Size); i++ {
-		xgb.Put16(buf[b:], Blue[i])
+		order.Put16(buf[b:], Blue[i])
 		b += 2
 	}
 
-	b = xgb.Pad(b)
-	xgb.Put16(buf[blen:], uint16(b/4)) // write request size in 4-byte units
+	b = start + xgb.Pad(b-start)
+	order.Put16(buf[blen:], uint16((b-start)/4)) // write request size in 4-byte units
 	return buf[:b]
 }
 
+// SetCrtcGammaRequest represents the data of a SetCrtcGamma request, as it is read when tracing a connection.
+type SetCrtcGammaRequest struct {
+	Crtc Crtc
+	Size uint16
+	// padding: 2 bytes
+	Red []uint16 // size: xgb.Pad((int(Size) * 2))
+	// alignment gap to multiple of 2
+	Green []uint16 // size: xgb.Pad((int(Size) * 2))
+	// alignment gap to multiple of 2
+	Blue []uint16 // size: xgb.Pad((int(Size) * 2))
+}
+
+// setCrtcGammaRequestRead reads a byte slice in the byte order 'order' into a SetCrtcGammaRequest value.
+func setCrtcGammaRequestRead(buf []byte, order xgb.ByteOrder) *SetCrtcGammaRequest {
+	v := new(SetCrtcGammaRequest)
+	b := 4 // skip request header
+
+	v.Crtc = Crtc(order.Get32(buf[b:]))
+	b += 4
+
+	v.Size = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	v.Red = make([]uint16, v.Size)
+	for i := 0; i < int(v.Size); i++ {
+		v.Red[i] = order.Get16(buf[b:])
+		b += 2
+	}
+
+	b = (b + 1) & ^1 // alignment gap
+
+	v.Green = make([]uint16, v.Size)
+	for i := 0; i < int(v.Size); i++ {
+		v.Green[i] = order.Get16(buf[b:])
+		b += 2
+	}
+
+	b = (b + 1) & ^1 // alignment gap
+
+	v.Blue = make([]uint16, v.Size)
+	for i := 0; i < int(v.Size); i++ {
+		v.Blue[i] = order.Get16(buf[b:])
+		b += 2
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][24] = xgb.RequestInfo{
+		Name: "SetCrtcGamma",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setCrtcGammaRequestRead(buf, order)
+		},
+	}
+}
+
 // SetCrtcTransformCookie is a cookie used only for SetCrtcTransform requests.
 type SetCrtcTransformCookie struct {
 	*xgb.Cookie
@@ -5338,12 +7391,26 @@ func (cook SetCrtcTransformCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetCrtcTransformCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetCrtcTransform
 // setCrtcTransformRequest writes a SetCrtcTransform request to a byte slice.
 func setCrtcTransformRequest(c *xgb.Conn, Crtc Crtc, Transform render.Transform, FilterLen uint16, FilterName string, FilterParams []render.Fixed) []byte {
+	return AppendSetCrtcTransformRequest(nil, c, Crtc, Transform, FilterLen, FilterName, FilterParams)
+}
+
+// AppendSetCrtcTransformRequest appends a SetCrtcTransform request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetCrtcTransformRequest(buf []byte, c *xgb.Conn, Crtc Crtc, Transform render.Transform, FilterLen uint16, FilterName string, FilterParams []render.Fixed) []byte {
 	size := xgb.Pad((((48 + xgb.Pad((int(FilterLen) * 1))) + 4) + xgb.Pad((len(FilterParams) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -5356,16 +7423,16 @@ func setCrtcTransformRequest(c *xgb.Conn, Crtc Crtc, Transform render.Transform,
 	blen := b
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Crtc))
+	order.Put32(buf[b:], uint32(Crtc))
 	b += 4
 
 	{
-		structBytes := Transform.Bytes()
+		structBytes := Transform.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
-	xgb.Put16(buf[b:], FilterLen)
+	order.Put16(buf[b:], FilterLen)
 	b += 2
 
 	b += 2 // padding
@@ -5376,15 +7443,69 @@ func setCrtcTransformRequest(c *xgb.Conn, Crtc Crtc, Transform render.Transform,
 	b = (b + 3) & ^3 // alignment gap
 
 	for i := 0; i < int(len(FilterParams)); i++ {
-		xgb.Put32(buf[b:], uint32(FilterParams[i]))
+		order.Put32(buf[b:], uint32(FilterParams[i]))
 		b += 4
 	}
 
-	b = xgb.Pad(b)
-	xgb.Put16(buf[blen:], uint16(b/4)) // write request size in 4-byte units
+	b = start + xgb.Pad(b-start)
+	order.Put16(buf[blen:], uint16((b-start)/4)) // write request size in 4-byte units
 	return buf[:b]
 }
 
+// SetCrtcTransformRequest represents the data of a SetCrtcTransform request, as it is read when tracing a connection.
+type SetCrtcTransformRequest struct {
+	Crtc      Crtc
+	Transform render.Transform
+	FilterLen uint16
+	// padding: 2 bytes
+	FilterName string // size: xgb.Pad((int(FilterLen) * 1))
+	// alignment gap to multiple of 4
+	FilterParams []render.Fixed // size: xgb.Pad((len(FilterParams) * 4))
+}
+
+// setCrtcTransformRequestRead reads a byte slice in the byte order 'order' into a SetCrtcTransformRequest value.
+func setCrtcTransformRequestRead(buf []byte, order xgb.ByteOrder) *SetCrtcTransformRequest {
+	v := new(SetCrtcTransformRequest)
+	b := 4 // skip request header
+
+	v.Crtc = Crtc(order.Get32(buf[b:]))
+	b += 4
+
+	v.Transform = render.Transform{}
+	b += render.TransformRead(buf[b:], order, &v.Transform)
+
+	v.FilterLen = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	{
+		byteString := make([]byte, v.FilterLen)
+		copy(byteString[:v.FilterLen], buf[b:])
+		v.FilterName = string(byteString)
+		b += int(v.FilterLen)
+	}
+
+	b = (b + 3) & ^3 // alignment gap
+
+	v.FilterParams = make([]render.Fixed, (len(buf)-b)/4)
+	for i := 0; i < int((len(buf)-b)/4); i++ {
+		v.FilterParams[i] = render.Fixed(order.Get32(buf[b:]))
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][26] = xgb.RequestInfo{
+		Name: "SetCrtcTransform",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setCrtcTransformRequestRead(buf, order)
+		},
+	}
+}
+
 // SetOutputPrimaryCookie is a cookie used only for SetOutputPrimary requests.
 type SetOutputPrimaryCookie struct {
 	*xgb.Cookie
@@ -5422,12 +7543,26 @@ func (cook SetOutputPrimaryCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetOutputPrimaryCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetOutputPrimary
 // setOutputPrimaryRequest writes a SetOutputPrimary request to a byte slice.
 func setOutputPrimaryRequest(c *xgb.Conn, Window xproto.Window, Output Output) []byte {
+	return AppendSetOutputPrimaryRequest(nil, c, Window, Output)
+}
+
+// AppendSetOutputPrimaryRequest appends a SetOutputPrimary request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetOutputPrimaryRequest(buf []byte, c *xgb.Conn, Window xproto.Window, Output Output) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -5437,18 +7572,47 @@ func setOutputPrimaryRequest(c *xgb.Conn, Window xproto.Window, Output Output) [
 	buf[b] = 30 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Output))
+	order.Put32(buf[b:], uint32(Output))
 	b += 4
 
 	return buf
 }
 
+// SetOutputPrimaryRequest represents the data of a SetOutputPrimary request, as it is read when tracing a connection.
+type SetOutputPrimaryRequest struct {
+	Window xproto.Window
+	Output Output
+}
+
+// setOutputPrimaryRequestRead reads a byte slice in the byte order 'order' into a SetOutputPrimaryRequest value.
+func setOutputPrimaryRequestRead(buf []byte, order xgb.ByteOrder) *SetOutputPrimaryRequest {
+	v := new(SetOutputPrimaryRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Output = Output(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][30] = xgb.RequestInfo{
+		Name: "SetOutputPrimary",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setOutputPrimaryRequestRead(buf, order)
+		},
+	}
+}
+
 // SetPanningCookie is a cookie used only for SetPanning requests.
 type SetPanningCookie struct {
 	*xgb.Cookie
@@ -5484,7 +7648,7 @@ func SetPanningUnchecked(c *xgb.Conn, Crtc Crtc, Timestamp xproto.Timestamp, Lef
 type SetPanningReply struct {
 	Sequence  uint16 // sequence number of the request for this reply
 	Length    uint32 // number of bytes in this reply
-	Status    byte
+	Status    SetConfig
 	Timestamp xproto.Timestamp
 }
 
@@ -5497,24 +7661,36 @@ func (cook SetPanningCookie) Reply() (*SetPanningReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return setPanningReply(buf), nil
+	return setPanningReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a SetPanning request arrives.
+func (cook SetPanningCookie) ReplyContext(ctx context.Context) (*SetPanningReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return setPanningReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// setPanningReply reads a byte slice into a SetPanningReply value.
-func setPanningReply(buf []byte) *SetPanningReply {
+// setPanningReply reads a byte slice in the byte order 'order' into a SetPanningReply value.
+func setPanningReply(buf []byte, order xgb.ByteOrder) *SetPanningReply {
 	v := new(SetPanningReply)
 	b := 1 // skip reply determinant
 
-	v.Status = buf[b]
+	v.Status = SetConfig(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Timestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
 	return v
@@ -5523,9 +7699,18 @@ func setPanningReply(buf []byte) *SetPanningReply {
 // Write request to wire for SetPanning
 // setPanningRequest writes a SetPanning request to a byte slice.
 func setPanningRequest(c *xgb.Conn, Crtc Crtc, Timestamp xproto.Timestamp, Left uint16, Top uint16, Width uint16, Height uint16, TrackLeft uint16, TrackTop uint16, TrackWidth uint16, TrackHeight uint16, BorderLeft int16, BorderTop int16, BorderRight int16, BorderBottom int16) []byte {
+	return AppendSetPanningRequest(nil, c, Crtc, Timestamp, Left, Top, Width, Height, TrackLeft, TrackTop, TrackWidth, TrackHeight, BorderLeft, BorderTop, BorderRight, BorderBottom)
+}
+
+// AppendSetPanningRequest appends a SetPanning request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetPanningRequest(buf []byte, c *xgb.Conn, Crtc Crtc, Timestamp xproto.Timestamp, Left uint16, Top uint16, Width uint16, Height uint16, TrackLeft uint16, TrackTop uint16, TrackWidth uint16, TrackHeight uint16, BorderLeft int16, BorderTop int16, BorderRight int16, BorderBottom int16) []byte {
 	size := 36
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -5535,54 +7720,134 @@ func setPanningRequest(c *xgb.Conn, Crtc Crtc, Timestamp xproto.Timestamp, Left
 	buf[b] = 29 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Crtc))
+	order.Put32(buf[b:], uint32(Crtc))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Timestamp))
+	order.Put32(buf[b:], uint32(Timestamp))
 	b += 4
 
-	xgb.Put16(buf[b:], Left)
+	order.Put16(buf[b:], Left)
 	b += 2
 
-	xgb.Put16(buf[b:], Top)
+	order.Put16(buf[b:], Top)
 	b += 2
 
-	xgb.Put16(buf[b:], Width)
+	order.Put16(buf[b:], Width)
 	b += 2
 
-	xgb.Put16(buf[b:], Height)
+	order.Put16(buf[b:], Height)
 	b += 2
 
-	xgb.Put16(buf[b:], TrackLeft)
+	order.Put16(buf[b:], TrackLeft)
 	b += 2
 
-	xgb.Put16(buf[b:], TrackTop)
+	order.Put16(buf[b:], TrackTop)
 	b += 2
 
-	xgb.Put16(buf[b:], TrackWidth)
+	order.Put16(buf[b:], TrackWidth)
 	b += 2
 
-	xgb.Put16(buf[b:], TrackHeight)
+	order.Put16(buf[b:], TrackHeight)
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(BorderLeft))
+	order.Put16(buf[b:], uint16(BorderLeft))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(BorderTop))
+	order.Put16(buf[b:], uint16(BorderTop))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(BorderRight))
+	order.Put16(buf[b:], uint16(BorderRight))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(BorderBottom))
+	order.Put16(buf[b:], uint16(BorderBottom))
 	b += 2
 
 	return buf
 }
 
+// SetPanningRequest represents the data of a SetPanning request, as it is read when tracing a connection.
+type SetPanningRequest struct {
+	Crtc         Crtc
+	Timestamp    xproto.Timestamp
+	Left         uint16
+	Top          uint16
+	Width        uint16
+	Height       uint16
+	TrackLeft    uint16
+	TrackTop     uint16
+	TrackWidth   uint16
+	TrackHeight  uint16
+	BorderLeft   int16
+	BorderTop    int16
+	BorderRight  int16
+	BorderBottom int16
+}
+
+// setPanningRequestRead reads a byte slice in the byte order 'order' into a SetPanningRequest value.
+func setPanningRequestRead(buf []byte, order xgb.ByteOrder) *SetPanningRequest {
+	v := new(SetPanningRequest)
+	b := 4 // skip request header
+
+	v.Crtc = Crtc(order.Get32(buf[b:]))
+	b += 4
+
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	v.Left = order.Get16(buf[b:])
+	b += 2
+
+	v.Top = order.Get16(buf[b:])
+	b += 2
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	v.TrackLeft = order.Get16(buf[b:])
+	b += 2
+
+	v.TrackTop = order.Get16(buf[b:])
+	b += 2
+
+	v.TrackWidth = order.Get16(buf[b:])
+	b += 2
+
+	v.TrackHeight = order.Get16(buf[b:])
+	b += 2
+
+	v.BorderLeft = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.BorderTop = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.BorderRight = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.BorderBottom = int16(order.Get16(buf[b:]))
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][29] = xgb.RequestInfo{
+		Name: "SetPanning",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setPanningRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setPanningReply(buf, order)
+		},
+	}
+}
+
 // SetProviderOffloadSinkCookie is a cookie used only for SetProviderOffloadSink requests.
 type SetProviderOffloadSinkCookie struct {
 	*xgb.Cookie
@@ -5620,12 +7885,26 @@ func (cook SetProviderOffloadSinkCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetProviderOffloadSinkCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetProviderOffloadSink
 // setProviderOffloadSinkRequest writes a SetProviderOffloadSink request to a byte slice.
 func setProviderOffloadSinkRequest(c *xgb.Conn, Provider Provider, SinkProvider Provider, ConfigTimestamp xproto.Timestamp) []byte {
+	return AppendSetProviderOffloadSinkRequest(nil, c, Provider, SinkProvider, ConfigTimestamp)
+}
+
+// AppendSetProviderOffloadSinkRequest appends a SetProviderOffloadSink request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetProviderOffloadSinkRequest(buf []byte, c *xgb.Conn, Provider Provider, SinkProvider Provider, ConfigTimestamp xproto.Timestamp) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -5635,21 +7914,54 @@ func setProviderOffloadSinkRequest(c *xgb.Conn, Provider Provider, SinkProvider
 	buf[b] = 34 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Provider))
+	order.Put32(buf[b:], uint32(Provider))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(SinkProvider))
+	order.Put32(buf[b:], uint32(SinkProvider))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(ConfigTimestamp))
+	order.Put32(buf[b:], uint32(ConfigTimestamp))
 	b += 4
 
 	return buf
 }
 
+// SetProviderOffloadSinkRequest represents the data of a SetProviderOffloadSink request, as it is read when tracing a connection.
+type SetProviderOffloadSinkRequest struct {
+	Provider        Provider
+	SinkProvider    Provider
+	ConfigTimestamp xproto.Timestamp
+}
+
+// setProviderOffloadSinkRequestRead reads a byte slice in the byte order 'order' into a SetProviderOffloadSinkRequest value.
+func setProviderOffloadSinkRequestRead(buf []byte, order xgb.ByteOrder) *SetProviderOffloadSinkRequest {
+	v := new(SetProviderOffloadSinkRequest)
+	b := 4 // skip request header
+
+	v.Provider = Provider(order.Get32(buf[b:]))
+	b += 4
+
+	v.SinkProvider = Provider(order.Get32(buf[b:]))
+	b += 4
+
+	v.ConfigTimestamp = xproto.Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][34] = xgb.RequestInfo{
+		Name: "SetProviderOffloadSink",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setProviderOffloadSinkRequestRead(buf, order)
+		},
+	}
+}
+
 // SetProviderOutputSourceCookie is a cookie used only for SetProviderOutputSource requests.
 type SetProviderOutputSourceCookie struct {
 	*xgb.Cookie
@@ -5687,12 +7999,26 @@ func (cook SetProviderOutputSourceCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetProviderOutputSourceCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetProviderOutputSource
 // setProviderOutputSourceRequest writes a SetProviderOutputSource request to a byte slice.
 func setProviderOutputSourceRequest(c *xgb.Conn, Provider Provider, SourceProvider Provider, ConfigTimestamp xproto.Timestamp) []byte {
+	return AppendSetProviderOutputSourceRequest(nil, c, Provider, SourceProvider, ConfigTimestamp)
+}
+
+// AppendSetProviderOutputSourceRequest appends a SetProviderOutputSource request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetProviderOutputSourceRequest(buf []byte, c *xgb.Conn, Provider Provider, SourceProvider Provider, ConfigTimestamp xproto.Timestamp) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -5702,21 +8028,54 @@ func setProviderOutputSourceRequest(c *xgb.Conn, Provider Provider, SourceProvid
 	buf[b] = 35 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Provider))
+	order.Put32(buf[b:], uint32(Provider))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(SourceProvider))
+	order.Put32(buf[b:], uint32(SourceProvider))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(ConfigTimestamp))
+	order.Put32(buf[b:], uint32(ConfigTimestamp))
 	b += 4
 
 	return buf
 }
 
+// SetProviderOutputSourceRequest represents the data of a SetProviderOutputSource request, as it is read when tracing a connection.
+type SetProviderOutputSourceRequest struct {
+	Provider        Provider
+	SourceProvider  Provider
+	ConfigTimestamp xproto.Timestamp
+}
+
+// setProviderOutputSourceRequestRead reads a byte slice in the byte order 'order' into a SetProviderOutputSourceRequest value.
+func setProviderOutputSourceRequestRead(buf []byte, order xgb.ByteOrder) *SetProviderOutputSourceRequest {
+	v := new(SetProviderOutputSourceRequest)
+	b := 4 // skip request header
+
+	v.Provider = Provider(order.Get32(buf[b:]))
+	b += 4
+
+	v.SourceProvider = Provider(order.Get32(buf[b:]))
+	b += 4
+
+	v.ConfigTimestamp = xproto.Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][35] = xgb.RequestInfo{
+		Name: "SetProviderOutputSource",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setProviderOutputSourceRequestRead(buf, order)
+		},
+	}
+}
+
 // SetScreenConfigCookie is a cookie used only for SetScreenConfig requests.
 type SetScreenConfigCookie struct {
 	*xgb.Cookie
@@ -5724,7 +8083,7 @@ type SetScreenConfigCookie struct {
 
 // SetScreenConfig sends a checked request.
 // If an error occurs, it will be returned with the reply by calling SetScreenConfigCookie.Reply()
-func SetScreenConfig(c *xgb.Conn, Window xproto.Window, Timestamp xproto.Timestamp, ConfigTimestamp xproto.Timestamp, SizeID uint16, Rotation uint16, Rate uint16) SetScreenConfigCookie {
+func SetScreenConfig(c *xgb.Conn, Window xproto.Window, Timestamp xproto.Timestamp, ConfigTimestamp xproto.Timestamp, SizeID uint16, Rotation Rotation, Rate uint16) SetScreenConfigCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RANDR"]; !ok {
@@ -5737,7 +8096,7 @@ func SetScreenConfig(c *xgb.Conn, Window xproto.Window, Timestamp xproto.Timesta
 
 // SetScreenConfigUnchecked sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func SetScreenConfigUnchecked(c *xgb.Conn, Window xproto.Window, Timestamp xproto.Timestamp, ConfigTimestamp xproto.Timestamp, SizeID uint16, Rotation uint16, Rate uint16) SetScreenConfigCookie {
+func SetScreenConfigUnchecked(c *xgb.Conn, Window xproto.Window, Timestamp xproto.Timestamp, ConfigTimestamp xproto.Timestamp, SizeID uint16, Rotation Rotation, Rate uint16) SetScreenConfigCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RANDR"]; !ok {
@@ -5752,7 +8111,7 @@ func SetScreenConfigUnchecked(c *xgb.Conn, Window xproto.Window, Timestamp xprot
 type SetScreenConfigReply struct {
 	Sequence        uint16 // sequence number of the request for this reply
 	Length          uint32 // number of bytes in this reply
-	Status          byte
+	Status          SetConfig
 	NewTimestamp    xproto.Timestamp
 	ConfigTimestamp xproto.Timestamp
 	Root            xproto.Window
@@ -5769,33 +8128,45 @@ func (cook SetScreenConfigCookie) Reply() (*SetScreenConfigReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return setScreenConfigReply(buf), nil
+	return setScreenConfigReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// setScreenConfigReply reads a byte slice into a SetScreenConfigReply value.
-func setScreenConfigReply(buf []byte) *SetScreenConfigReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a SetScreenConfig request arrives.
+func (cook SetScreenConfigCookie) ReplyContext(ctx context.Context) (*SetScreenConfigReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return setScreenConfigReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// setScreenConfigReply reads a byte slice in the byte order 'order' into a SetScreenConfigReply value.
+func setScreenConfigReply(buf []byte, order xgb.ByteOrder) *SetScreenConfigReply {
 	v := new(SetScreenConfigReply)
 	b := 1 // skip reply determinant
 
-	v.Status = buf[b]
+	v.Status = SetConfig(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.NewTimestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.NewTimestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.ConfigTimestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.ConfigTimestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.Root = xproto.Window(xgb.Get32(buf[b:]))
+	v.Root = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.SubpixelOrder = xgb.Get16(buf[b:])
+	v.SubpixelOrder = order.Get16(buf[b:])
 	b += 2
 
 	b += 10 // padding
@@ -5805,10 +8176,19 @@ func setScreenConfigReply(buf []byte) *SetScreenConfigReply {
 
 // Write request to wire for SetScreenConfig
 // setScreenConfigRequest writes a SetScreenConfig request to a byte slice.
-func setScreenConfigRequest(c *xgb.Conn, Window xproto.Window, Timestamp xproto.Timestamp, ConfigTimestamp xproto.Timestamp, SizeID uint16, Rotation uint16, Rate uint16) []byte {
+func setScreenConfigRequest(c *xgb.Conn, Window xproto.Window, Timestamp xproto.Timestamp, ConfigTimestamp xproto.Timestamp, SizeID uint16, Rotation Rotation, Rate uint16) []byte {
+	return AppendSetScreenConfigRequest(nil, c, Window, Timestamp, ConfigTimestamp, SizeID, Rotation, Rate)
+}
+
+// AppendSetScreenConfigRequest appends a SetScreenConfig request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetScreenConfigRequest(buf []byte, c *xgb.Conn, Window xproto.Window, Timestamp xproto.Timestamp, ConfigTimestamp xproto.Timestamp, SizeID uint16, Rotation Rotation, Rate uint16) []byte {
 	size := 24
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -5818,25 +8198,25 @@ func setScreenConfigRequest(c *xgb.Conn, Window xproto.Window, Timestamp xproto.
 	buf[b] = 2 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Timestamp))
+	order.Put32(buf[b:], uint32(Timestamp))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(ConfigTimestamp))
+	order.Put32(buf[b:], uint32(ConfigTimestamp))
 	b += 4
 
-	xgb.Put16(buf[b:], SizeID)
+	order.Put16(buf[b:], SizeID)
 	b += 2
 
-	xgb.Put16(buf[b:], Rotation)
+	order.Put16(buf[b:], uint16(Rotation))
 	b += 2
 
-	xgb.Put16(buf[b:], Rate)
+	order.Put16(buf[b:], Rate)
 	b += 2
 
 	b += 2 // padding
@@ -5844,6 +8224,57 @@ func setScreenConfigRequest(c *xgb.Conn, Window xproto.Window, Timestamp xproto.
 	return buf
 }
 
+// SetScreenConfigRequest represents the data of a SetScreenConfig request, as it is read when tracing a connection.
+type SetScreenConfigRequest struct {
+	Window          xproto.Window
+	Timestamp       xproto.Timestamp
+	ConfigTimestamp xproto.Timestamp
+	SizeID          uint16
+	Rotation        Rotation
+	Rate            uint16
+	// padding: 2 bytes
+}
+
+// setScreenConfigRequestRead reads a byte slice in the byte order 'order' into a SetScreenConfigRequest value.
+func setScreenConfigRequestRead(buf []byte, order xgb.ByteOrder) *SetScreenConfigRequest {
+	v := new(SetScreenConfigRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	v.ConfigTimestamp = xproto.Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	v.SizeID = order.Get16(buf[b:])
+	b += 2
+
+	v.Rotation = Rotation(order.Get16(buf[b:]))
+	b += 2
+
+	v.Rate = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][2] = xgb.RequestInfo{
+		Name: "SetScreenConfig",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setScreenConfigRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setScreenConfigReply(buf, order)
+		},
+	}
+}
+
 // SetScreenSizeCookie is a cookie used only for SetScreenSize requests.
 type SetScreenSizeCookie struct {
 	*xgb.Cookie
@@ -5881,12 +8312,26 @@ func (cook SetScreenSizeCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetScreenSizeCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetScreenSize
 // setScreenSizeRequest writes a SetScreenSize request to a byte slice.
 func setScreenSizeRequest(c *xgb.Conn, Window xproto.Window, Width uint16, Height uint16, MmWidth uint32, MmHeight uint32) []byte {
+	return AppendSetScreenSizeRequest(nil, c, Window, Width, Height, MmWidth, MmHeight)
+}
+
+// AppendSetScreenSizeRequest appends a SetScreenSize request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetScreenSizeRequest(buf []byte, c *xgb.Conn, Window xproto.Window, Width uint16, Height uint16, MmWidth uint32, MmHeight uint32) []byte {
 	size := 20
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RANDR"]
@@ -5896,23 +8341,64 @@ func setScreenSizeRequest(c *xgb.Conn, Window xproto.Window, Width uint16, Heigh
 	buf[b] = 7 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put16(buf[b:], Width)
+	order.Put16(buf[b:], Width)
 	b += 2
 
-	xgb.Put16(buf[b:], Height)
+	order.Put16(buf[b:], Height)
 	b += 2
 
-	xgb.Put32(buf[b:], MmWidth)
+	order.Put32(buf[b:], MmWidth)
 	b += 4
 
-	xgb.Put32(buf[b:], MmHeight)
+	order.Put32(buf[b:], MmHeight)
 	b += 4
 
 	return buf
 }
+
+// SetScreenSizeRequest represents the data of a SetScreenSize request, as it is read when tracing a connection.
+type SetScreenSizeRequest struct {
+	Window   xproto.Window
+	Width    uint16
+	Height   uint16
+	MmWidth  uint32
+	MmHeight uint32
+}
+
+// setScreenSizeRequestRead reads a byte slice in the byte order 'order' into a SetScreenSizeRequest value.
+func setScreenSizeRequestRead(buf []byte, order xgb.ByteOrder) *SetScreenSizeRequest {
+	v := new(SetScreenSizeRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	v.MmWidth = order.Get32(buf[b:])
+	b += 4
+
+	v.MmHeight = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RANDR"][7] = xgb.RequestInfo{
+		Name: "SetScreenSize",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setScreenSizeRequestRead(buf, order)
+		},
+	}
+}
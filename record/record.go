@@ -4,6 +4,8 @@ package record
 // This file is automatically generated from record.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xproto"
@@ -22,18 +24,16 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["RECORD"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["RECORD"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["RECORD"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["RECORD"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["RECORD"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "RECORD", xgb.ExtRequestInfos["RECORD"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["RECORD"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["RECORD"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["RECORD"] = make(map[int]xgb.RequestInfo)
 }
 
 // BadBadContext is the error number for a BadBadContext.
@@ -45,18 +45,18 @@ type BadContextError struct {
 	InvalidRecord uint32
 }
 
-// BadContextErrorNew constructs a BadContextError value that implements xgb.Error from a byte slice.
-func BadContextErrorNew(buf []byte) xgb.Error {
+// BadContextErrorNew constructs a BadContextError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadContextErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := BadContextError{}
 	v.NiceName = "BadContext"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.InvalidRecord = xgb.Get32(buf[b:])
+	v.InvalidRecord = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -94,53 +94,53 @@ type ClientInfo struct {
 }
 
 // ClientInfoRead reads a byte slice into a ClientInfo value.
-func ClientInfoRead(buf []byte, v *ClientInfo) int {
+func ClientInfoRead(buf []byte, order xgb.ByteOrder, v *ClientInfo) int {
 	b := 0
 
-	v.ClientResource = ClientSpec(xgb.Get32(buf[b:]))
+	v.ClientResource = ClientSpec(order.Get32(buf[b:]))
 	b += 4
 
-	v.NumRanges = xgb.Get32(buf[b:])
+	v.NumRanges = order.Get32(buf[b:])
 	b += 4
 
 	v.Ranges = make([]Range, v.NumRanges)
-	b += RangeReadList(buf[b:], v.Ranges)
+	b += RangeReadList(buf[b:], order, v.Ranges)
 
 	return b
 }
 
 // ClientInfoReadList reads a byte slice into a list of ClientInfo values.
-func ClientInfoReadList(buf []byte, dest []ClientInfo) int {
+func ClientInfoReadList(buf []byte, order xgb.ByteOrder, dest []ClientInfo) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = ClientInfo{}
-		b += ClientInfoRead(buf[b:], &dest[i])
+		b += ClientInfoRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a ClientInfo value to a byte slice.
-func (v ClientInfo) Bytes() []byte {
+// Bytes writes a ClientInfo value to a byte slice, in the byte order 'order'.
+func (v ClientInfo) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, (8 + xgb.Pad((int(v.NumRanges) * 24))))
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.ClientResource))
+	order.Put32(buf[b:], uint32(v.ClientResource))
 	b += 4
 
-	xgb.Put32(buf[b:], v.NumRanges)
+	order.Put32(buf[b:], v.NumRanges)
 	b += 4
 
-	b += RangeListBytes(buf[b:], v.Ranges)
+	b += RangeListBytes(buf[b:], order, v.Ranges)
 
 	return buf[:b]
 }
 
 // ClientInfoListBytes writes a list of ClientInfo values to a byte slice.
-func ClientInfoListBytes(buf []byte, list []ClientInfo) int {
+func ClientInfoListBytes(buf []byte, order xgb.ByteOrder, list []ClientInfo) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -182,41 +182,41 @@ type ExtRange struct {
 }
 
 // ExtRangeRead reads a byte slice into a ExtRange value.
-func ExtRangeRead(buf []byte, v *ExtRange) int {
+func ExtRangeRead(buf []byte, order xgb.ByteOrder, v *ExtRange) int {
 	b := 0
 
 	v.Major = Range8{}
-	b += Range8Read(buf[b:], &v.Major)
+	b += Range8Read(buf[b:], order, &v.Major)
 
 	v.Minor = Range16{}
-	b += Range16Read(buf[b:], &v.Minor)
+	b += Range16Read(buf[b:], order, &v.Minor)
 
 	return b
 }
 
 // ExtRangeReadList reads a byte slice into a list of ExtRange values.
-func ExtRangeReadList(buf []byte, dest []ExtRange) int {
+func ExtRangeReadList(buf []byte, order xgb.ByteOrder, dest []ExtRange) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = ExtRange{}
-		b += ExtRangeRead(buf[b:], &dest[i])
+		b += ExtRangeRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a ExtRange value to a byte slice.
-func (v ExtRange) Bytes() []byte {
+// Bytes writes a ExtRange value to a byte slice, in the byte order 'order'.
+func (v ExtRange) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 6)
 	b := 0
 
 	{
-		structBytes := v.Major.Bytes()
+		structBytes := v.Major.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
 	{
-		structBytes := v.Minor.Bytes()
+		structBytes := v.Minor.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -225,11 +225,11 @@ func (v ExtRange) Bytes() []byte {
 }
 
 // ExtRangeListBytes writes a list of ExtRange values to a byte slice.
-func ExtRangeListBytes(buf []byte, list []ExtRange) int {
+func ExtRangeListBytes(buf []byte, order xgb.ByteOrder, list []ExtRange) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -255,29 +255,29 @@ type Range struct {
 }
 
 // RangeRead reads a byte slice into a Range value.
-func RangeRead(buf []byte, v *Range) int {
+func RangeRead(buf []byte, order xgb.ByteOrder, v *Range) int {
 	b := 0
 
 	v.CoreRequests = Range8{}
-	b += Range8Read(buf[b:], &v.CoreRequests)
+	b += Range8Read(buf[b:], order, &v.CoreRequests)
 
 	v.CoreReplies = Range8{}
-	b += Range8Read(buf[b:], &v.CoreReplies)
+	b += Range8Read(buf[b:], order, &v.CoreReplies)
 
 	v.ExtRequests = ExtRange{}
-	b += ExtRangeRead(buf[b:], &v.ExtRequests)
+	b += ExtRangeRead(buf[b:], order, &v.ExtRequests)
 
 	v.ExtReplies = ExtRange{}
-	b += ExtRangeRead(buf[b:], &v.ExtReplies)
+	b += ExtRangeRead(buf[b:], order, &v.ExtReplies)
 
 	v.DeliveredEvents = Range8{}
-	b += Range8Read(buf[b:], &v.DeliveredEvents)
+	b += Range8Read(buf[b:], order, &v.DeliveredEvents)
 
 	v.DeviceEvents = Range8{}
-	b += Range8Read(buf[b:], &v.DeviceEvents)
+	b += Range8Read(buf[b:], order, &v.DeviceEvents)
 
 	v.Errors = Range8{}
-	b += Range8Read(buf[b:], &v.Errors)
+	b += Range8Read(buf[b:], order, &v.Errors)
 
 	if buf[b] == 1 {
 		v.ClientStarted = true
@@ -297,58 +297,58 @@ func RangeRead(buf []byte, v *Range) int {
 }
 
 // RangeReadList reads a byte slice into a list of Range values.
-func RangeReadList(buf []byte, dest []Range) int {
+func RangeReadList(buf []byte, order xgb.ByteOrder, dest []Range) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Range{}
-		b += RangeRead(buf[b:], &dest[i])
+		b += RangeRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Range value to a byte slice.
-func (v Range) Bytes() []byte {
+// Bytes writes a Range value to a byte slice, in the byte order 'order'.
+func (v Range) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 24)
 	b := 0
 
 	{
-		structBytes := v.CoreRequests.Bytes()
+		structBytes := v.CoreRequests.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
 	{
-		structBytes := v.CoreReplies.Bytes()
+		structBytes := v.CoreReplies.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
 	{
-		structBytes := v.ExtRequests.Bytes()
+		structBytes := v.ExtRequests.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
 	{
-		structBytes := v.ExtReplies.Bytes()
+		structBytes := v.ExtReplies.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
 	{
-		structBytes := v.DeliveredEvents.Bytes()
+		structBytes := v.DeliveredEvents.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
 	{
-		structBytes := v.DeviceEvents.Bytes()
+		structBytes := v.DeviceEvents.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
 	{
-		structBytes := v.Errors.Bytes()
+		structBytes := v.Errors.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -371,11 +371,11 @@ func (v Range) Bytes() []byte {
 }
 
 // RangeListBytes writes a list of Range values to a byte slice.
-func RangeListBytes(buf []byte, list []Range) int {
+func RangeListBytes(buf []byte, order xgb.ByteOrder, list []Range) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -388,48 +388,48 @@ type Range16 struct {
 }
 
 // Range16Read reads a byte slice into a Range16 value.
-func Range16Read(buf []byte, v *Range16) int {
+func Range16Read(buf []byte, order xgb.ByteOrder, v *Range16) int {
 	b := 0
 
-	v.First = xgb.Get16(buf[b:])
+	v.First = order.Get16(buf[b:])
 	b += 2
 
-	v.Last = xgb.Get16(buf[b:])
+	v.Last = order.Get16(buf[b:])
 	b += 2
 
 	return b
 }
 
 // Range16ReadList reads a byte slice into a list of Range16 values.
-func Range16ReadList(buf []byte, dest []Range16) int {
+func Range16ReadList(buf []byte, order xgb.ByteOrder, dest []Range16) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Range16{}
-		b += Range16Read(buf[b:], &dest[i])
+		b += Range16Read(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Range16 value to a byte slice.
-func (v Range16) Bytes() []byte {
+// Bytes writes a Range16 value to a byte slice, in the byte order 'order'.
+func (v Range16) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 4)
 	b := 0
 
-	xgb.Put16(buf[b:], v.First)
+	order.Put16(buf[b:], v.First)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Last)
+	order.Put16(buf[b:], v.Last)
 	b += 2
 
 	return buf[:b]
 }
 
 // Range16ListBytes writes a list of Range16 values to a byte slice.
-func Range16ListBytes(buf []byte, list []Range16) int {
+func Range16ListBytes(buf []byte, order xgb.ByteOrder, list []Range16) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -442,7 +442,7 @@ type Range8 struct {
 }
 
 // Range8Read reads a byte slice into a Range8 value.
-func Range8Read(buf []byte, v *Range8) int {
+func Range8Read(buf []byte, order xgb.ByteOrder, v *Range8) int {
 	b := 0
 
 	v.First = buf[b]
@@ -455,17 +455,17 @@ func Range8Read(buf []byte, v *Range8) int {
 }
 
 // Range8ReadList reads a byte slice into a list of Range8 values.
-func Range8ReadList(buf []byte, dest []Range8) int {
+func Range8ReadList(buf []byte, order xgb.ByteOrder, dest []Range8) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Range8{}
-		b += Range8Read(buf[b:], &dest[i])
+		b += Range8Read(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Range8 value to a byte slice.
-func (v Range8) Bytes() []byte {
+// Bytes writes a Range8 value to a byte slice, in the byte order 'order'.
+func (v Range8) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 2)
 	b := 0
 
@@ -479,11 +479,11 @@ func (v Range8) Bytes() []byte {
 }
 
 // Range8ListBytes writes a list of Range8 values to a byte slice.
-func Range8ListBytes(buf []byte, list []Range8) int {
+func Range8ListBytes(buf []byte, order xgb.ByteOrder, list []Range8) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -514,6 +514,8 @@ func Range8ListBytes(buf []byte, list []Range8) int {
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // CreateContextCookie is a cookie used only for CreateContext requests.
 type CreateContextCookie struct {
 	*xgb.Cookie
@@ -551,12 +553,26 @@ func (cook CreateContextCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateContextCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateContext
 // createContextRequest writes a CreateContext request to a byte slice.
 func createContextRequest(c *xgb.Conn, Context Context, ElementHeader ElementHeader, NumClientSpecs uint32, NumRanges uint32, ClientSpecs []ClientSpec, Ranges []Range) []byte {
+	return AppendCreateContextRequest(nil, c, Context, ElementHeader, NumClientSpecs, NumRanges, ClientSpecs, Ranges)
+}
+
+// AppendCreateContextRequest appends a CreateContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateContextRequest(buf []byte, c *xgb.Conn, Context Context, ElementHeader ElementHeader, NumClientSpecs uint32, NumRanges uint32, ClientSpecs []ClientSpec, Ranges []Range) []byte {
 	size := xgb.Pad((((20 + xgb.Pad((int(NumClientSpecs) * 4))) + 4) + xgb.Pad((int(NumRanges) * 24))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RECORD"]
@@ -569,7 +585,7 @@ func createContextRequest(c *xgb.Conn, Context Context, ElementHeader ElementHea
 	blen := b
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
 	buf[b] = byte(ElementHeader)
@@ -577,26 +593,80 @@ func createContextRequest(c *xgb.Conn, Context Context, ElementHeader ElementHea
 
 	b += 3 // padding
 
-	xgb.Put32(buf[b:], NumClientSpecs)
+	order.Put32(buf[b:], NumClientSpecs)
 	b += 4
 
-	xgb.Put32(buf[b:], NumRanges)
+	order.Put32(buf[b:], NumRanges)
 	b += 4
 
 	for i := 0; i < int(NumClientSpecs); i++ {
-		xgb.Put32(buf[b:], uint32(ClientSpecs[i]))
+		order.Put32(buf[b:], uint32(ClientSpecs[i]))
 		b += 4
 	}
 
 	b = (b + 3) & ^3 // alignment gap
 
-	b += RangeListBytes(buf[b:], Ranges)
+	b += RangeListBytes(buf[b:], order, Ranges)
 
-	b = xgb.Pad(b)
-	xgb.Put16(buf[blen:], uint16(b/4)) // write request size in 4-byte units
+	b = start + xgb.Pad(b-start)
+	order.Put16(buf[blen:], uint16((b-start)/4)) // write request size in 4-byte units
 	return buf[:b]
 }
 
+// CreateContextRequest represents the data of a CreateContext request, as it is read when tracing a connection.
+type CreateContextRequest struct {
+	Context       Context
+	ElementHeader ElementHeader
+	// padding: 3 bytes
+	NumClientSpecs uint32
+	NumRanges      uint32
+	ClientSpecs    []ClientSpec // size: xgb.Pad((int(NumClientSpecs) * 4))
+	// alignment gap to multiple of 4
+	Ranges []Range // size: xgb.Pad((int(NumRanges) * 24))
+}
+
+// createContextRequestRead reads a byte slice in the byte order 'order' into a CreateContextRequest value.
+func createContextRequestRead(buf []byte, order xgb.ByteOrder) *CreateContextRequest {
+	v := new(CreateContextRequest)
+	b := 4 // skip request header
+
+	v.Context = Context(order.Get32(buf[b:]))
+	b += 4
+
+	v.ElementHeader = ElementHeader(buf[b])
+	b += 1
+
+	b += 3 // padding
+
+	v.NumClientSpecs = order.Get32(buf[b:])
+	b += 4
+
+	v.NumRanges = order.Get32(buf[b:])
+	b += 4
+
+	v.ClientSpecs = make([]ClientSpec, v.NumClientSpecs)
+	for i := 0; i < int(v.NumClientSpecs); i++ {
+		v.ClientSpecs[i] = ClientSpec(order.Get32(buf[b:]))
+		b += 4
+	}
+
+	b = (b + 3) & ^3 // alignment gap
+
+	v.Ranges = make([]Range, v.NumRanges)
+	b += RangeReadList(buf[b:], order, v.Ranges)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RECORD"][1] = xgb.RequestInfo{
+		Name: "CreateContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createContextRequestRead(buf, order)
+		},
+	}
+}
+
 // DisableContextCookie is a cookie used only for DisableContext requests.
 type DisableContextCookie struct {
 	*xgb.Cookie
@@ -634,12 +704,26 @@ func (cook DisableContextCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DisableContextCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DisableContext
 // disableContextRequest writes a DisableContext request to a byte slice.
 func disableContextRequest(c *xgb.Conn, Context Context) []byte {
+	return AppendDisableContextRequest(nil, c, Context)
+}
+
+// AppendDisableContextRequest appends a DisableContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDisableContextRequest(buf []byte, c *xgb.Conn, Context Context) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RECORD"]
@@ -649,15 +733,40 @@ func disableContextRequest(c *xgb.Conn, Context Context) []byte {
 	buf[b] = 6 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
 	return buf
 }
 
+// DisableContextRequest represents the data of a DisableContext request, as it is read when tracing a connection.
+type DisableContextRequest struct {
+	Context Context
+}
+
+// disableContextRequestRead reads a byte slice in the byte order 'order' into a DisableContextRequest value.
+func disableContextRequestRead(buf []byte, order xgb.ByteOrder) *DisableContextRequest {
+	v := new(DisableContextRequest)
+	b := 4 // skip request header
+
+	v.Context = Context(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RECORD"][6] = xgb.RequestInfo{
+		Name: "DisableContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return disableContextRequestRead(buf, order)
+		},
+	}
+}
+
 // EnableContextCookie is a cookie used only for EnableContext requests.
 type EnableContextCookie struct {
 	*xgb.Cookie
@@ -671,7 +780,7 @@ func EnableContext(c *xgb.Conn, Context Context) EnableContextCookie {
 	if _, ok := c.Extensions["RECORD"]; !ok {
 		panic("Cannot issue request 'EnableContext' using the uninitialized extension 'RECORD'. record.Init(connObj) must be called first.")
 	}
-	cookie := c.NewCookie(true, true)
+	cookie := c.NewMultiCookie(true, enableContextLastReply)
 	c.NewRequest(enableContextRequest(c, Context), cookie)
 	return EnableContextCookie{cookie}
 }
@@ -684,7 +793,7 @@ func EnableContextUnchecked(c *xgb.Conn, Context Context) EnableContextCookie {
 	if _, ok := c.Extensions["RECORD"]; !ok {
 		panic("Cannot issue request 'EnableContext' using the uninitialized extension 'RECORD'. record.Init(connObj) must be called first.")
 	}
-	cookie := c.NewCookie(false, true)
+	cookie := c.NewMultiCookie(false, enableContextLastReply)
 	c.NewRequest(enableContextRequest(c, Context), cookie)
 	return EnableContextCookie{cookie}
 }
@@ -704,7 +813,8 @@ type EnableContextReply struct {
 	Data []byte // size: xgb.Pad(((int(Length) * 4) * 1))
 }
 
-// Reply blocks and returns the reply data for a EnableContext request.
+// Reply blocks and returns the next reply for a EnableContext request.
+// Once the last reply has been returned, Reply returns xgb.ErrNoMoreReplies.
 func (cook EnableContextCookie) Reply() (*EnableContextReply, error) {
 	buf, err := cook.Cookie.Reply()
 	if err != nil {
@@ -713,21 +823,33 @@ func (cook EnableContextCookie) Reply() (*EnableContextReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return enableContextReply(buf), nil
+	return enableContextReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the next reply for a EnableContext request arrives.
+func (cook EnableContextCookie) ReplyContext(ctx context.Context) (*EnableContextReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return enableContextReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// enableContextReply reads a byte slice into a EnableContextReply value.
-func enableContextReply(buf []byte) *EnableContextReply {
+// enableContextReply reads a byte slice in the byte order 'order' into a EnableContextReply value.
+func enableContextReply(buf []byte, order xgb.ByteOrder) *EnableContextReply {
 	v := new(EnableContextReply)
 	b := 1 // skip reply determinant
 
 	v.Category = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	v.ElementHeader = ElementHeader(buf[b])
@@ -742,13 +864,13 @@ func enableContextReply(buf []byte) *EnableContextReply {
 
 	b += 2 // padding
 
-	v.XidBase = xgb.Get32(buf[b:])
+	v.XidBase = order.Get32(buf[b:])
 	b += 4
 
-	v.ServerTime = xgb.Get32(buf[b:])
+	v.ServerTime = order.Get32(buf[b:])
 	b += 4
 
-	v.RecSequenceNum = xgb.Get32(buf[b:])
+	v.RecSequenceNum = order.Get32(buf[b:])
 	b += 4
 
 	b += 8 // padding
@@ -760,12 +882,26 @@ func enableContextReply(buf []byte) *EnableContextReply {
 	return v
 }
 
+// enableContextLastReply reports whether a byte slice holds the last reply to a EnableContext request.
+func enableContextLastReply(buf []byte) bool {
+	return buf[1] == 5
+}
+
 // Write request to wire for EnableContext
 // enableContextRequest writes a EnableContext request to a byte slice.
 func enableContextRequest(c *xgb.Conn, Context Context) []byte {
+	return AppendEnableContextRequest(nil, c, Context)
+}
+
+// AppendEnableContextRequest appends a EnableContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendEnableContextRequest(buf []byte, c *xgb.Conn, Context Context) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RECORD"]
@@ -775,15 +911,43 @@ func enableContextRequest(c *xgb.Conn, Context Context) []byte {
 	buf[b] = 5 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
 	return buf
 }
 
+// EnableContextRequest represents the data of a EnableContext request, as it is read when tracing a connection.
+type EnableContextRequest struct {
+	Context Context
+}
+
+// enableContextRequestRead reads a byte slice in the byte order 'order' into a EnableContextRequest value.
+func enableContextRequestRead(buf []byte, order xgb.ByteOrder) *EnableContextRequest {
+	v := new(EnableContextRequest)
+	b := 4 // skip request header
+
+	v.Context = Context(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RECORD"][5] = xgb.RequestInfo{
+		Name: "EnableContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return enableContextRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return enableContextReply(buf, order)
+		},
+	}
+}
+
 // FreeContextCookie is a cookie used only for FreeContext requests.
 type FreeContextCookie struct {
 	*xgb.Cookie
@@ -821,12 +985,26 @@ func (cook FreeContextCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook FreeContextCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for FreeContext
 // freeContextRequest writes a FreeContext request to a byte slice.
 func freeContextRequest(c *xgb.Conn, Context Context) []byte {
+	return AppendFreeContextRequest(nil, c, Context)
+}
+
+// AppendFreeContextRequest appends a FreeContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendFreeContextRequest(buf []byte, c *xgb.Conn, Context Context) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RECORD"]
@@ -836,15 +1014,40 @@ func freeContextRequest(c *xgb.Conn, Context Context) []byte {
 	buf[b] = 7 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
 	return buf
 }
 
+// FreeContextRequest represents the data of a FreeContext request, as it is read when tracing a connection.
+type FreeContextRequest struct {
+	Context Context
+}
+
+// freeContextRequestRead reads a byte slice in the byte order 'order' into a FreeContextRequest value.
+func freeContextRequestRead(buf []byte, order xgb.ByteOrder) *FreeContextRequest {
+	v := new(FreeContextRequest)
+	b := 4 // skip request header
+
+	v.Context = Context(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RECORD"][7] = xgb.RequestInfo{
+		Name: "FreeContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return freeContextRequestRead(buf, order)
+		},
+	}
+}
+
 // GetContextCookie is a cookie used only for GetContext requests.
 type GetContextCookie struct {
 	*xgb.Cookie
@@ -897,11 +1100,23 @@ func (cook GetContextCookie) Reply() (*GetContextReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getContextReply(buf), nil
+	return getContextReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getContextReply reads a byte slice into a GetContextReply value.
-func getContextReply(buf []byte) *GetContextReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetContext request arrives.
+func (cook GetContextCookie) ReplyContext(ctx context.Context) (*GetContextReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getContextReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// getContextReply reads a byte slice in the byte order 'order' into a GetContextReply value.
+func getContextReply(buf []byte, order xgb.ByteOrder) *GetContextReply {
 	v := new(GetContextReply)
 	b := 1 // skip reply determinant
 
@@ -912,10 +1127,10 @@ func getContextReply(buf []byte) *GetContextReply {
 	}
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	v.ElementHeader = ElementHeader(buf[b])
@@ -923,13 +1138,13 @@ func getContextReply(buf []byte) *GetContextReply {
 
 	b += 3 // padding
 
-	v.NumInterceptedClients = xgb.Get32(buf[b:])
+	v.NumInterceptedClients = order.Get32(buf[b:])
 	b += 4
 
 	b += 16 // padding
 
 	v.InterceptedClients = make([]ClientInfo, v.NumInterceptedClients)
-	b += ClientInfoReadList(buf[b:], v.InterceptedClients)
+	b += ClientInfoReadList(buf[b:], order, v.InterceptedClients)
 
 	return v
 }
@@ -937,9 +1152,18 @@ func getContextReply(buf []byte) *GetContextReply {
 // Write request to wire for GetContext
 // getContextRequest writes a GetContext request to a byte slice.
 func getContextRequest(c *xgb.Conn, Context Context) []byte {
+	return AppendGetContextRequest(nil, c, Context)
+}
+
+// AppendGetContextRequest appends a GetContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetContextRequest(buf []byte, c *xgb.Conn, Context Context) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RECORD"]
@@ -949,15 +1173,43 @@ func getContextRequest(c *xgb.Conn, Context Context) []byte {
 	buf[b] = 4 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
 	return buf
 }
 
+// GetContextRequest represents the data of a GetContext request, as it is read when tracing a connection.
+type GetContextRequest struct {
+	Context Context
+}
+
+// getContextRequestRead reads a byte slice in the byte order 'order' into a GetContextRequest value.
+func getContextRequestRead(buf []byte, order xgb.ByteOrder) *GetContextRequest {
+	v := new(GetContextRequest)
+	b := 4 // skip request header
+
+	v.Context = Context(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RECORD"][4] = xgb.RequestInfo{
+		Name: "GetContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getContextRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getContextReply(buf, order)
+		},
+	}
+}
+
 // QueryVersionCookie is a cookie used only for QueryVersion requests.
 type QueryVersionCookie struct {
 	*xgb.Cookie
@@ -1007,26 +1259,38 @@ func (cook QueryVersionCookie) Reply() (*QueryVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryVersionReply(buf), nil
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryVersion request arrives.
+func (cook QueryVersionCookie) ReplyContext(ctx context.Context) (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryVersionReply reads a byte slice into a QueryVersionReply value.
-func queryVersionReply(buf []byte) *QueryVersionReply {
+// queryVersionReply reads a byte slice in the byte order 'order' into a QueryVersionReply value.
+func queryVersionReply(buf []byte, order xgb.ByteOrder) *QueryVersionReply {
 	v := new(QueryVersionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.MajorVersion = xgb.Get16(buf[b:])
+	v.MajorVersion = order.Get16(buf[b:])
 	b += 2
 
-	v.MinorVersion = xgb.Get16(buf[b:])
+	v.MinorVersion = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -1035,9 +1299,18 @@ func queryVersionReply(buf []byte) *QueryVersionReply {
 // Write request to wire for QueryVersion
 // queryVersionRequest writes a QueryVersion request to a byte slice.
 func queryVersionRequest(c *xgb.Conn, MajorVersion uint16, MinorVersion uint16) []byte {
+	return AppendQueryVersionRequest(nil, c, MajorVersion, MinorVersion)
+}
+
+// AppendQueryVersionRequest appends a QueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryVersionRequest(buf []byte, c *xgb.Conn, MajorVersion uint16, MinorVersion uint16) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RECORD"]
@@ -1047,18 +1320,50 @@ func queryVersionRequest(c *xgb.Conn, MajorVersion uint16, MinorVersion uint16)
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], MajorVersion)
+	order.Put16(buf[b:], MajorVersion)
 	b += 2
 
-	xgb.Put16(buf[b:], MinorVersion)
+	order.Put16(buf[b:], MinorVersion)
 	b += 2
 
 	return buf
 }
 
+// QueryVersionRequest represents the data of a QueryVersion request, as it is read when tracing a connection.
+type QueryVersionRequest struct {
+	MajorVersion uint16
+	MinorVersion uint16
+}
+
+// queryVersionRequestRead reads a byte slice in the byte order 'order' into a QueryVersionRequest value.
+func queryVersionRequestRead(buf []byte, order xgb.ByteOrder) *QueryVersionRequest {
+	v := new(QueryVersionRequest)
+	b := 4 // skip request header
+
+	v.MajorVersion = order.Get16(buf[b:])
+	b += 2
+
+	v.MinorVersion = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RECORD"][0] = xgb.RequestInfo{
+		Name: "QueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionReply(buf, order)
+		},
+	}
+}
+
 // RegisterClientsCookie is a cookie used only for RegisterClients requests.
 type RegisterClientsCookie struct {
 	*xgb.Cookie
@@ -1096,12 +1401,26 @@ func (cook RegisterClientsCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook RegisterClientsCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for RegisterClients
 // registerClientsRequest writes a RegisterClients request to a byte slice.
 func registerClientsRequest(c *xgb.Conn, Context Context, ElementHeader ElementHeader, NumClientSpecs uint32, NumRanges uint32, ClientSpecs []ClientSpec, Ranges []Range) []byte {
+	return AppendRegisterClientsRequest(nil, c, Context, ElementHeader, NumClientSpecs, NumRanges, ClientSpecs, Ranges)
+}
+
+// AppendRegisterClientsRequest appends a RegisterClients request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendRegisterClientsRequest(buf []byte, c *xgb.Conn, Context Context, ElementHeader ElementHeader, NumClientSpecs uint32, NumRanges uint32, ClientSpecs []ClientSpec, Ranges []Range) []byte {
 	size := xgb.Pad((((20 + xgb.Pad((int(NumClientSpecs) * 4))) + 4) + xgb.Pad((int(NumRanges) * 24))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RECORD"]
@@ -1114,7 +1433,7 @@ func registerClientsRequest(c *xgb.Conn, Context Context, ElementHeader ElementH
 	blen := b
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
 	buf[b] = byte(ElementHeader)
@@ -1122,26 +1441,80 @@ func registerClientsRequest(c *xgb.Conn, Context Context, ElementHeader ElementH
 
 	b += 3 // padding
 
-	xgb.Put32(buf[b:], NumClientSpecs)
+	order.Put32(buf[b:], NumClientSpecs)
 	b += 4
 
-	xgb.Put32(buf[b:], NumRanges)
+	order.Put32(buf[b:], NumRanges)
 	b += 4
 
 	for i := 0; i < int(NumClientSpecs); i++ {
-		xgb.Put32(buf[b:], uint32(ClientSpecs[i]))
+		order.Put32(buf[b:], uint32(ClientSpecs[i]))
 		b += 4
 	}
 
 	b = (b + 3) & ^3 // alignment gap
 
-	b += RangeListBytes(buf[b:], Ranges)
+	b += RangeListBytes(buf[b:], order, Ranges)
 
-	b = xgb.Pad(b)
-	xgb.Put16(buf[blen:], uint16(b/4)) // write request size in 4-byte units
+	b = start + xgb.Pad(b-start)
+	order.Put16(buf[blen:], uint16((b-start)/4)) // write request size in 4-byte units
 	return buf[:b]
 }
 
+// RegisterClientsRequest represents the data of a RegisterClients request, as it is read when tracing a connection.
+type RegisterClientsRequest struct {
+	Context       Context
+	ElementHeader ElementHeader
+	// padding: 3 bytes
+	NumClientSpecs uint32
+	NumRanges      uint32
+	ClientSpecs    []ClientSpec // size: xgb.Pad((int(NumClientSpecs) * 4))
+	// alignment gap to multiple of 4
+	Ranges []Range // size: xgb.Pad((int(NumRanges) * 24))
+}
+
+// registerClientsRequestRead reads a byte slice in the byte order 'order' into a RegisterClientsRequest value.
+func registerClientsRequestRead(buf []byte, order xgb.ByteOrder) *RegisterClientsRequest {
+	v := new(RegisterClientsRequest)
+	b := 4 // skip request header
+
+	v.Context = Context(order.Get32(buf[b:]))
+	b += 4
+
+	v.ElementHeader = ElementHeader(buf[b])
+	b += 1
+
+	b += 3 // padding
+
+	v.NumClientSpecs = order.Get32(buf[b:])
+	b += 4
+
+	v.NumRanges = order.Get32(buf[b:])
+	b += 4
+
+	v.ClientSpecs = make([]ClientSpec, v.NumClientSpecs)
+	for i := 0; i < int(v.NumClientSpecs); i++ {
+		v.ClientSpecs[i] = ClientSpec(order.Get32(buf[b:]))
+		b += 4
+	}
+
+	b = (b + 3) & ^3 // alignment gap
+
+	v.Ranges = make([]Range, v.NumRanges)
+	b += RangeReadList(buf[b:], order, v.Ranges)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RECORD"][2] = xgb.RequestInfo{
+		Name: "RegisterClients",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return registerClientsRequestRead(buf, order)
+		},
+	}
+}
+
 // UnregisterClientsCookie is a cookie used only for UnregisterClients requests.
 type UnregisterClientsCookie struct {
 	*xgb.Cookie
@@ -1179,12 +1552,26 @@ func (cook UnregisterClientsCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook UnregisterClientsCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for UnregisterClients
 // unregisterClientsRequest writes a UnregisterClients request to a byte slice.
 func unregisterClientsRequest(c *xgb.Conn, Context Context, NumClientSpecs uint32, ClientSpecs []ClientSpec) []byte {
+	return AppendUnregisterClientsRequest(nil, c, Context, NumClientSpecs, ClientSpecs)
+}
+
+// AppendUnregisterClientsRequest appends a UnregisterClients request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendUnregisterClientsRequest(buf []byte, c *xgb.Conn, Context Context, NumClientSpecs uint32, ClientSpecs []ClientSpec) []byte {
 	size := xgb.Pad((12 + xgb.Pad((int(NumClientSpecs) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RECORD"]
@@ -1194,19 +1581,55 @@ func unregisterClientsRequest(c *xgb.Conn, Context Context, NumClientSpecs uint3
 	buf[b] = 3 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
-	xgb.Put32(buf[b:], NumClientSpecs)
+	order.Put32(buf[b:], NumClientSpecs)
 	b += 4
 
 	for i := 0; i < int(NumClientSpecs); i++ {
-		xgb.Put32(buf[b:], uint32(ClientSpecs[i]))
+		order.Put32(buf[b:], uint32(ClientSpecs[i]))
 		b += 4
 	}
 
 	return buf
 }
+
+// UnregisterClientsRequest represents the data of a UnregisterClients request, as it is read when tracing a connection.
+type UnregisterClientsRequest struct {
+	Context        Context
+	NumClientSpecs uint32
+	ClientSpecs    []ClientSpec // size: xgb.Pad((int(NumClientSpecs) * 4))
+}
+
+// unregisterClientsRequestRead reads a byte slice in the byte order 'order' into a UnregisterClientsRequest value.
+func unregisterClientsRequestRead(buf []byte, order xgb.ByteOrder) *UnregisterClientsRequest {
+	v := new(UnregisterClientsRequest)
+	b := 4 // skip request header
+
+	v.Context = Context(order.Get32(buf[b:]))
+	b += 4
+
+	v.NumClientSpecs = order.Get32(buf[b:])
+	b += 4
+
+	v.ClientSpecs = make([]ClientSpec, v.NumClientSpecs)
+	for i := 0; i < int(v.NumClientSpecs); i++ {
+		v.ClientSpecs[i] = ClientSpec(order.Get32(buf[b:]))
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RECORD"][3] = xgb.RequestInfo{
+		Name: "UnregisterClients",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return unregisterClientsRequestRead(buf, order)
+		},
+	}
+}
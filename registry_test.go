@@ -0,0 +1,64 @@
+package xgb_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xgbtest"
+)
+
+// testEvent is an extension event that remembers which constructor made it.
+type testEvent string
+
+func (ev testEvent) Bytes(xgb.ByteOrder) []byte { return nil }
+func (ev testEvent) String() string             { return string(ev) }
+
+// TestRegistriesPerConn registers the same extension at different event
+// numbers with two connections at the same time, and checks that each
+// connection constructs events using its own numbers.
+func TestRegistriesPerConn(t *testing.T) {
+	ext := map[int]xgb.NewEventFun{
+		0: func([]byte, xgb.ByteOrder) xgb.Event { return testEvent("first") },
+		1: func([]byte, xgb.ByteOrder) xgb.Event { return testEvent("second") },
+	}
+	firsts := []int{64, 90}
+	servers := make([]*xgbtest.Server, len(firsts))
+	conns := make([]*xgb.Conn, len(firsts))
+	for i := range firsts {
+		servers[i] = xgbtest.NewServer()
+		c, err := servers[i].Conn()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer servers[i].Close()
+		conns[i] = c
+	}
+
+	var wg sync.WaitGroup
+	for i := range conns {
+		wg.Add(1)
+		go func(c *xgb.Conn, first int) {
+			defer wg.Done()
+			c.RegisterEventFuncs(first, ext)
+		}(conns[i], firsts[i])
+	}
+	wg.Wait()
+
+	// Each server sends the second event of the extension, at the number
+	// that its own connection registered it at.
+	for i, s := range servers {
+		ev := make(xgbtest.Event, 32)
+		ev[0] = byte(firsts[i] + 1)
+		if err := s.SendEvent(ev); err != nil {
+			t.Fatalf("SendEvent: %s", err)
+		}
+	}
+	for i, c := range conns {
+		ev, err := c.WaitForEvent()
+		if ev != testEvent("second") {
+			t.Fatalf("Connection %d got %v (error %v), but expected the "+
+				"second event.", i, ev, err)
+		}
+	}
+}
@@ -4,6 +4,8 @@ package render
 // This file is automatically generated from render.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xproto"
@@ -22,18 +24,16 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["RENDER"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["RENDER"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["RENDER"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["RENDER"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["RENDER"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "RENDER", xgb.ExtRequestInfos["RENDER"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["RENDER"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["RENDER"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["RENDER"] = make(map[int]xgb.RequestInfo)
 }
 
 type Animcursorelt struct {
@@ -42,48 +42,48 @@ type Animcursorelt struct {
 }
 
 // AnimcursoreltRead reads a byte slice into a Animcursorelt value.
-func AnimcursoreltRead(buf []byte, v *Animcursorelt) int {
+func AnimcursoreltRead(buf []byte, order xgb.ByteOrder, v *Animcursorelt) int {
 	b := 0
 
-	v.Cursor = xproto.Cursor(xgb.Get32(buf[b:]))
+	v.Cursor = xproto.Cursor(order.Get32(buf[b:]))
 	b += 4
 
-	v.Delay = xgb.Get32(buf[b:])
+	v.Delay = order.Get32(buf[b:])
 	b += 4
 
 	return b
 }
 
 // AnimcursoreltReadList reads a byte slice into a list of Animcursorelt values.
-func AnimcursoreltReadList(buf []byte, dest []Animcursorelt) int {
+func AnimcursoreltReadList(buf []byte, order xgb.ByteOrder, dest []Animcursorelt) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Animcursorelt{}
-		b += AnimcursoreltRead(buf[b:], &dest[i])
+		b += AnimcursoreltRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Animcursorelt value to a byte slice.
-func (v Animcursorelt) Bytes() []byte {
+// Bytes writes a Animcursorelt value to a byte slice, in the byte order 'order'.
+func (v Animcursorelt) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 8)
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.Cursor))
+	order.Put32(buf[b:], uint32(v.Cursor))
 	b += 4
 
-	xgb.Put32(buf[b:], v.Delay)
+	order.Put32(buf[b:], v.Delay)
 	b += 4
 
 	return buf[:b]
 }
 
 // AnimcursoreltListBytes writes a list of Animcursorelt values to a byte slice.
-func AnimcursoreltListBytes(buf []byte, list []Animcursorelt) int {
+func AnimcursoreltListBytes(buf []byte, order xgb.ByteOrder, list []Animcursorelt) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -98,82 +98,262 @@ type Color struct {
 }
 
 // ColorRead reads a byte slice into a Color value.
-func ColorRead(buf []byte, v *Color) int {
+func ColorRead(buf []byte, order xgb.ByteOrder, v *Color) int {
 	b := 0
 
-	v.Red = xgb.Get16(buf[b:])
+	v.Red = order.Get16(buf[b:])
 	b += 2
 
-	v.Green = xgb.Get16(buf[b:])
+	v.Green = order.Get16(buf[b:])
 	b += 2
 
-	v.Blue = xgb.Get16(buf[b:])
+	v.Blue = order.Get16(buf[b:])
 	b += 2
 
-	v.Alpha = xgb.Get16(buf[b:])
+	v.Alpha = order.Get16(buf[b:])
 	b += 2
 
 	return b
 }
 
 // ColorReadList reads a byte slice into a list of Color values.
-func ColorReadList(buf []byte, dest []Color) int {
+func ColorReadList(buf []byte, order xgb.ByteOrder, dest []Color) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Color{}
-		b += ColorRead(buf[b:], &dest[i])
+		b += ColorRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Color value to a byte slice.
-func (v Color) Bytes() []byte {
+// Bytes writes a Color value to a byte slice, in the byte order 'order'.
+func (v Color) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 8)
 	b := 0
 
-	xgb.Put16(buf[b:], v.Red)
+	order.Put16(buf[b:], v.Red)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Green)
+	order.Put16(buf[b:], v.Green)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Blue)
+	order.Put16(buf[b:], v.Blue)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Alpha)
+	order.Put16(buf[b:], v.Alpha)
 	b += 2
 
 	return buf[:b]
 }
 
 // ColorListBytes writes a list of Color values to a byte slice.
-func ColorListBytes(buf []byte, list []Color) int {
+func ColorListBytes(buf []byte, order xgb.ByteOrder, list []Color) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 	return xgb.Pad(b)
 }
 
+type Cp uint32
+
 const (
-	CpRepeat           = 1
-	CpAlphaMap         = 2
-	CpAlphaXOrigin     = 4
-	CpAlphaYOrigin     = 8
-	CpClipXOrigin      = 16
-	CpClipYOrigin      = 32
-	CpClipMask         = 64
-	CpGraphicsExposure = 128
-	CpSubwindowMode    = 256
-	CpPolyEdge         = 512
-	CpPolyMode         = 1024
-	CpDither           = 2048
-	CpComponentAlpha   = 4096
+	CpRepeat           Cp = 1
+	CpAlphaMap         Cp = 2
+	CpAlphaXOrigin     Cp = 4
+	CpAlphaYOrigin     Cp = 8
+	CpClipXOrigin      Cp = 16
+	CpClipYOrigin      Cp = 32
+	CpClipMask         Cp = 64
+	CpGraphicsExposure Cp = 128
+	CpSubwindowMode    Cp = 256
+	CpPolyEdge         Cp = 512
+	CpPolyMode         Cp = 1024
+	CpDither           Cp = 2048
+	CpComponentAlpha   Cp = 4096
 )
 
+// String returns the names of the Cp bits set in a value.
+func (v Cp) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 13)
+	if v&CpRepeat != 0 {
+		names = append(names, "Repeat")
+		v &^= CpRepeat
+	}
+	if v&CpAlphaMap != 0 {
+		names = append(names, "AlphaMap")
+		v &^= CpAlphaMap
+	}
+	if v&CpAlphaXOrigin != 0 {
+		names = append(names, "AlphaXOrigin")
+		v &^= CpAlphaXOrigin
+	}
+	if v&CpAlphaYOrigin != 0 {
+		names = append(names, "AlphaYOrigin")
+		v &^= CpAlphaYOrigin
+	}
+	if v&CpClipXOrigin != 0 {
+		names = append(names, "ClipXOrigin")
+		v &^= CpClipXOrigin
+	}
+	if v&CpClipYOrigin != 0 {
+		names = append(names, "ClipYOrigin")
+		v &^= CpClipYOrigin
+	}
+	if v&CpClipMask != 0 {
+		names = append(names, "ClipMask")
+		v &^= CpClipMask
+	}
+	if v&CpGraphicsExposure != 0 {
+		names = append(names, "GraphicsExposure")
+		v &^= CpGraphicsExposure
+	}
+	if v&CpSubwindowMode != 0 {
+		names = append(names, "SubwindowMode")
+		v &^= CpSubwindowMode
+	}
+	if v&CpPolyEdge != 0 {
+		names = append(names, "PolyEdge")
+		v &^= CpPolyEdge
+	}
+	if v&CpPolyMode != 0 {
+		names = append(names, "PolyMode")
+		v &^= CpPolyMode
+	}
+	if v&CpDither != 0 {
+		names = append(names, "Dither")
+		v &^= CpDither
+	}
+	if v&CpComponentAlpha != 0 {
+		names = append(names, "ComponentAlpha")
+		v &^= CpComponentAlpha
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint32(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
+// CpValues builds a value mask and a value list out of Cp values.
+// The values are listed in the order defined by the protocol, no matter the order they are set in.
+type CpValues struct {
+	mask   Cp
+	values [13]uint32
+}
+
+// SetRepeat sets the value for CpRepeat.
+func (v *CpValues) SetRepeat(Repeat Repeat) *CpValues {
+	v.mask |= CpRepeat
+	v.values[0] = uint32(Repeat)
+	return v
+}
+
+// SetAlphaMap sets the value for CpAlphaMap.
+func (v *CpValues) SetAlphaMap(AlphaMap uint32) *CpValues {
+	v.mask |= CpAlphaMap
+	v.values[1] = AlphaMap
+	return v
+}
+
+// SetAlphaXOrigin sets the value for CpAlphaXOrigin.
+func (v *CpValues) SetAlphaXOrigin(AlphaXOrigin uint32) *CpValues {
+	v.mask |= CpAlphaXOrigin
+	v.values[2] = AlphaXOrigin
+	return v
+}
+
+// SetAlphaYOrigin sets the value for CpAlphaYOrigin.
+func (v *CpValues) SetAlphaYOrigin(AlphaYOrigin uint32) *CpValues {
+	v.mask |= CpAlphaYOrigin
+	v.values[3] = AlphaYOrigin
+	return v
+}
+
+// SetClipXOrigin sets the value for CpClipXOrigin.
+func (v *CpValues) SetClipXOrigin(ClipXOrigin uint32) *CpValues {
+	v.mask |= CpClipXOrigin
+	v.values[4] = ClipXOrigin
+	return v
+}
+
+// SetClipYOrigin sets the value for CpClipYOrigin.
+func (v *CpValues) SetClipYOrigin(ClipYOrigin uint32) *CpValues {
+	v.mask |= CpClipYOrigin
+	v.values[5] = ClipYOrigin
+	return v
+}
+
+// SetClipMask sets the value for CpClipMask.
+func (v *CpValues) SetClipMask(ClipMask uint32) *CpValues {
+	v.mask |= CpClipMask
+	v.values[6] = ClipMask
+	return v
+}
+
+// SetGraphicsExposure sets the value for CpGraphicsExposure.
+func (v *CpValues) SetGraphicsExposure(GraphicsExposure uint32) *CpValues {
+	v.mask |= CpGraphicsExposure
+	v.values[7] = GraphicsExposure
+	return v
+}
+
+// SetSubwindowMode sets the value for CpSubwindowMode.
+func (v *CpValues) SetSubwindowMode(SubwindowMode xproto.SubwindowMode) *CpValues {
+	v.mask |= CpSubwindowMode
+	v.values[8] = uint32(SubwindowMode)
+	return v
+}
+
+// SetPolyEdge sets the value for CpPolyEdge.
+func (v *CpValues) SetPolyEdge(PolyEdge PolyEdge) *CpValues {
+	v.mask |= CpPolyEdge
+	v.values[9] = uint32(PolyEdge)
+	return v
+}
+
+// SetPolyMode sets the value for CpPolyMode.
+func (v *CpValues) SetPolyMode(PolyMode PolyMode) *CpValues {
+	v.mask |= CpPolyMode
+	v.values[10] = uint32(PolyMode)
+	return v
+}
+
+// SetDither sets the value for CpDither.
+func (v *CpValues) SetDither(Dither uint32) *CpValues {
+	v.mask |= CpDither
+	v.values[11] = Dither
+	return v
+}
+
+// SetComponentAlpha sets the value for CpComponentAlpha.
+func (v *CpValues) SetComponentAlpha(ComponentAlpha uint32) *CpValues {
+	v.mask |= CpComponentAlpha
+	v.values[12] = ComponentAlpha
+	return v
+}
+
+// Mask returns the value mask of the values that have been set.
+func (v *CpValues) Mask() Cp {
+	return v.mask
+}
+
+// List returns the values that have been set, in the order defined by the protocol.
+func (v *CpValues) List() []uint32 {
+	list := make([]uint32, 0, len(v.values))
+	for i, value := range v.values {
+		if v.mask&(1<<uint(i)) != 0 {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
 type Directformat struct {
 	RedShift   uint16
 	RedMask    uint16
@@ -186,84 +366,84 @@ type Directformat struct {
 }
 
 // DirectformatRead reads a byte slice into a Directformat value.
-func DirectformatRead(buf []byte, v *Directformat) int {
+func DirectformatRead(buf []byte, order xgb.ByteOrder, v *Directformat) int {
 	b := 0
 
-	v.RedShift = xgb.Get16(buf[b:])
+	v.RedShift = order.Get16(buf[b:])
 	b += 2
 
-	v.RedMask = xgb.Get16(buf[b:])
+	v.RedMask = order.Get16(buf[b:])
 	b += 2
 
-	v.GreenShift = xgb.Get16(buf[b:])
+	v.GreenShift = order.Get16(buf[b:])
 	b += 2
 
-	v.GreenMask = xgb.Get16(buf[b:])
+	v.GreenMask = order.Get16(buf[b:])
 	b += 2
 
-	v.BlueShift = xgb.Get16(buf[b:])
+	v.BlueShift = order.Get16(buf[b:])
 	b += 2
 
-	v.BlueMask = xgb.Get16(buf[b:])
+	v.BlueMask = order.Get16(buf[b:])
 	b += 2
 
-	v.AlphaShift = xgb.Get16(buf[b:])
+	v.AlphaShift = order.Get16(buf[b:])
 	b += 2
 
-	v.AlphaMask = xgb.Get16(buf[b:])
+	v.AlphaMask = order.Get16(buf[b:])
 	b += 2
 
 	return b
 }
 
 // DirectformatReadList reads a byte slice into a list of Directformat values.
-func DirectformatReadList(buf []byte, dest []Directformat) int {
+func DirectformatReadList(buf []byte, order xgb.ByteOrder, dest []Directformat) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Directformat{}
-		b += DirectformatRead(buf[b:], &dest[i])
+		b += DirectformatRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Directformat value to a byte slice.
-func (v Directformat) Bytes() []byte {
+// Bytes writes a Directformat value to a byte slice, in the byte order 'order'.
+func (v Directformat) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 16)
 	b := 0
 
-	xgb.Put16(buf[b:], v.RedShift)
+	order.Put16(buf[b:], v.RedShift)
 	b += 2
 
-	xgb.Put16(buf[b:], v.RedMask)
+	order.Put16(buf[b:], v.RedMask)
 	b += 2
 
-	xgb.Put16(buf[b:], v.GreenShift)
+	order.Put16(buf[b:], v.GreenShift)
 	b += 2
 
-	xgb.Put16(buf[b:], v.GreenMask)
+	order.Put16(buf[b:], v.GreenMask)
 	b += 2
 
-	xgb.Put16(buf[b:], v.BlueShift)
+	order.Put16(buf[b:], v.BlueShift)
 	b += 2
 
-	xgb.Put16(buf[b:], v.BlueMask)
+	order.Put16(buf[b:], v.BlueMask)
 	b += 2
 
-	xgb.Put16(buf[b:], v.AlphaShift)
+	order.Put16(buf[b:], v.AlphaShift)
 	b += 2
 
-	xgb.Put16(buf[b:], v.AlphaMask)
+	order.Put16(buf[b:], v.AlphaMask)
 	b += 2
 
 	return buf[:b]
 }
 
 // DirectformatListBytes writes a list of Directformat values to a byte slice.
-func DirectformatListBytes(buf []byte, list []Directformat) int {
+func DirectformatListBytes(buf []byte, order xgb.ByteOrder, list []Directformat) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -282,15 +462,15 @@ type GlyphError struct {
 	NiceName string
 }
 
-// GlyphErrorNew constructs a GlyphError value that implements xgb.Error from a byte slice.
-func GlyphErrorNew(buf []byte) xgb.Error {
+// GlyphErrorNew constructs a GlyphError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func GlyphErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := GlyphError{}
 	v.NiceName = "Glyph"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -328,15 +508,15 @@ type GlyphSetError struct {
 	NiceName string
 }
 
-// GlyphSetErrorNew constructs a GlyphSetError value that implements xgb.Error from a byte slice.
-func GlyphSetErrorNew(buf []byte) xgb.Error {
+// GlyphSetErrorNew constructs a GlyphSetError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func GlyphSetErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := GlyphSetError{}
 	v.NiceName = "GlyphSet"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -376,72 +556,72 @@ type Glyphinfo struct {
 }
 
 // GlyphinfoRead reads a byte slice into a Glyphinfo value.
-func GlyphinfoRead(buf []byte, v *Glyphinfo) int {
+func GlyphinfoRead(buf []byte, order xgb.ByteOrder, v *Glyphinfo) int {
 	b := 0
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
-	v.X = int16(xgb.Get16(buf[b:]))
+	v.X = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Y = int16(xgb.Get16(buf[b:]))
+	v.Y = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.XOff = int16(xgb.Get16(buf[b:]))
+	v.XOff = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.YOff = int16(xgb.Get16(buf[b:]))
+	v.YOff = int16(order.Get16(buf[b:]))
 	b += 2
 
 	return b
 }
 
 // GlyphinfoReadList reads a byte slice into a list of Glyphinfo values.
-func GlyphinfoReadList(buf []byte, dest []Glyphinfo) int {
+func GlyphinfoReadList(buf []byte, order xgb.ByteOrder, dest []Glyphinfo) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Glyphinfo{}
-		b += GlyphinfoRead(buf[b:], &dest[i])
+		b += GlyphinfoRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Glyphinfo value to a byte slice.
-func (v Glyphinfo) Bytes() []byte {
+// Bytes writes a Glyphinfo value to a byte slice, in the byte order 'order'.
+func (v Glyphinfo) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 12)
 	b := 0
 
-	xgb.Put16(buf[b:], v.Width)
+	order.Put16(buf[b:], v.Width)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Height)
+	order.Put16(buf[b:], v.Height)
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.X))
+	order.Put16(buf[b:], uint16(v.X))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.Y))
+	order.Put16(buf[b:], uint16(v.Y))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.XOff))
+	order.Put16(buf[b:], uint16(v.XOff))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.YOff))
+	order.Put16(buf[b:], uint16(v.YOff))
 	b += 2
 
 	return buf[:b]
 }
 
 // GlyphinfoListBytes writes a list of Glyphinfo values to a byte slice.
-func GlyphinfoListBytes(buf []byte, list []Glyphinfo) int {
+func GlyphinfoListBytes(buf []byte, order xgb.ByteOrder, list []Glyphinfo) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -467,66 +647,66 @@ type Indexvalue struct {
 }
 
 // IndexvalueRead reads a byte slice into a Indexvalue value.
-func IndexvalueRead(buf []byte, v *Indexvalue) int {
+func IndexvalueRead(buf []byte, order xgb.ByteOrder, v *Indexvalue) int {
 	b := 0
 
-	v.Pixel = xgb.Get32(buf[b:])
+	v.Pixel = order.Get32(buf[b:])
 	b += 4
 
-	v.Red = xgb.Get16(buf[b:])
+	v.Red = order.Get16(buf[b:])
 	b += 2
 
-	v.Green = xgb.Get16(buf[b:])
+	v.Green = order.Get16(buf[b:])
 	b += 2
 
-	v.Blue = xgb.Get16(buf[b:])
+	v.Blue = order.Get16(buf[b:])
 	b += 2
 
-	v.Alpha = xgb.Get16(buf[b:])
+	v.Alpha = order.Get16(buf[b:])
 	b += 2
 
 	return b
 }
 
 // IndexvalueReadList reads a byte slice into a list of Indexvalue values.
-func IndexvalueReadList(buf []byte, dest []Indexvalue) int {
+func IndexvalueReadList(buf []byte, order xgb.ByteOrder, dest []Indexvalue) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Indexvalue{}
-		b += IndexvalueRead(buf[b:], &dest[i])
+		b += IndexvalueRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Indexvalue value to a byte slice.
-func (v Indexvalue) Bytes() []byte {
+// Bytes writes a Indexvalue value to a byte slice, in the byte order 'order'.
+func (v Indexvalue) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 12)
 	b := 0
 
-	xgb.Put32(buf[b:], v.Pixel)
+	order.Put32(buf[b:], v.Pixel)
 	b += 4
 
-	xgb.Put16(buf[b:], v.Red)
+	order.Put16(buf[b:], v.Red)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Green)
+	order.Put16(buf[b:], v.Green)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Blue)
+	order.Put16(buf[b:], v.Blue)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Alpha)
+	order.Put16(buf[b:], v.Alpha)
 	b += 2
 
 	return buf[:b]
 }
 
 // IndexvalueListBytes writes a list of Indexvalue values to a byte slice.
-func IndexvalueListBytes(buf []byte, list []Indexvalue) int {
+func IndexvalueListBytes(buf []byte, order xgb.ByteOrder, list []Indexvalue) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -539,41 +719,41 @@ type Linefix struct {
 }
 
 // LinefixRead reads a byte slice into a Linefix value.
-func LinefixRead(buf []byte, v *Linefix) int {
+func LinefixRead(buf []byte, order xgb.ByteOrder, v *Linefix) int {
 	b := 0
 
 	v.P1 = Pointfix{}
-	b += PointfixRead(buf[b:], &v.P1)
+	b += PointfixRead(buf[b:], order, &v.P1)
 
 	v.P2 = Pointfix{}
-	b += PointfixRead(buf[b:], &v.P2)
+	b += PointfixRead(buf[b:], order, &v.P2)
 
 	return b
 }
 
 // LinefixReadList reads a byte slice into a list of Linefix values.
-func LinefixReadList(buf []byte, dest []Linefix) int {
+func LinefixReadList(buf []byte, order xgb.ByteOrder, dest []Linefix) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Linefix{}
-		b += LinefixRead(buf[b:], &dest[i])
+		b += LinefixRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Linefix value to a byte slice.
-func (v Linefix) Bytes() []byte {
+// Bytes writes a Linefix value to a byte slice, in the byte order 'order'.
+func (v Linefix) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 16)
 	b := 0
 
 	{
-		structBytes := v.P1.Bytes()
+		structBytes := v.P1.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
 	{
-		structBytes := v.P2.Bytes()
+		structBytes := v.P2.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -582,11 +762,11 @@ func (v Linefix) Bytes() []byte {
 }
 
 // LinefixListBytes writes a list of Linefix values to a byte slice.
-func LinefixListBytes(buf []byte, list []Linefix) int {
+func LinefixListBytes(buf []byte, order xgb.ByteOrder, list []Linefix) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -601,15 +781,15 @@ type PictFormatError struct {
 	NiceName string
 }
 
-// PictFormatErrorNew constructs a PictFormatError value that implements xgb.Error from a byte slice.
-func PictFormatErrorNew(buf []byte) xgb.Error {
+// PictFormatErrorNew constructs a PictFormatError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func PictFormatErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := PictFormatError{}
 	v.NiceName = "PictFormat"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -647,15 +827,15 @@ type PictOpError struct {
 	NiceName string
 }
 
-// PictOpErrorNew constructs a PictOpError value that implements xgb.Error from a byte slice.
-func PictOpErrorNew(buf []byte) xgb.Error {
+// PictOpErrorNew constructs a PictOpError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func PictOpErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := PictOpError{}
 	v.NiceName = "PictOp"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -685,67 +865,195 @@ func init() {
 	xgb.NewExtErrorFuncs["RENDER"][2] = PictOpErrorNew
 }
 
+type PictOp byte
+
 const (
-	PictOpClear               = 0
-	PictOpSrc                 = 1
-	PictOpDst                 = 2
-	PictOpOver                = 3
-	PictOpOverReverse         = 4
-	PictOpIn                  = 5
-	PictOpInReverse           = 6
-	PictOpOut                 = 7
-	PictOpOutReverse          = 8
-	PictOpAtop                = 9
-	PictOpAtopReverse         = 10
-	PictOpXor                 = 11
-	PictOpAdd                 = 12
-	PictOpSaturate            = 13
-	PictOpDisjointClear       = 16
-	PictOpDisjointSrc         = 17
-	PictOpDisjointDst         = 18
-	PictOpDisjointOver        = 19
-	PictOpDisjointOverReverse = 20
-	PictOpDisjointIn          = 21
-	PictOpDisjointInReverse   = 22
-	PictOpDisjointOut         = 23
-	PictOpDisjointOutReverse  = 24
-	PictOpDisjointAtop        = 25
-	PictOpDisjointAtopReverse = 26
-	PictOpDisjointXor         = 27
-	PictOpConjointClear       = 32
-	PictOpConjointSrc         = 33
-	PictOpConjointDst         = 34
-	PictOpConjointOver        = 35
-	PictOpConjointOverReverse = 36
-	PictOpConjointIn          = 37
-	PictOpConjointInReverse   = 38
-	PictOpConjointOut         = 39
-	PictOpConjointOutReverse  = 40
-	PictOpConjointAtop        = 41
-	PictOpConjointAtopReverse = 42
-	PictOpConjointXor         = 43
-	PictOpMultiply            = 48
-	PictOpScreen              = 49
-	PictOpOverlay             = 50
-	PictOpDarken              = 51
-	PictOpLighten             = 52
-	PictOpColorDodge          = 53
-	PictOpColorBurn           = 54
-	PictOpHardLight           = 55
-	PictOpSoftLight           = 56
-	PictOpDifference          = 57
-	PictOpExclusion           = 58
-	PictOpHSLHue              = 59
-	PictOpHSLSaturation       = 60
-	PictOpHSLColor            = 61
-	PictOpHSLLuminosity       = 62
+	PictOpClear               PictOp = 0
+	PictOpSrc                 PictOp = 1
+	PictOpDst                 PictOp = 2
+	PictOpOver                PictOp = 3
+	PictOpOverReverse         PictOp = 4
+	PictOpIn                  PictOp = 5
+	PictOpInReverse           PictOp = 6
+	PictOpOut                 PictOp = 7
+	PictOpOutReverse          PictOp = 8
+	PictOpAtop                PictOp = 9
+	PictOpAtopReverse         PictOp = 10
+	PictOpXor                 PictOp = 11
+	PictOpAdd                 PictOp = 12
+	PictOpSaturate            PictOp = 13
+	PictOpDisjointClear       PictOp = 16
+	PictOpDisjointSrc         PictOp = 17
+	PictOpDisjointDst         PictOp = 18
+	PictOpDisjointOver        PictOp = 19
+	PictOpDisjointOverReverse PictOp = 20
+	PictOpDisjointIn          PictOp = 21
+	PictOpDisjointInReverse   PictOp = 22
+	PictOpDisjointOut         PictOp = 23
+	PictOpDisjointOutReverse  PictOp = 24
+	PictOpDisjointAtop        PictOp = 25
+	PictOpDisjointAtopReverse PictOp = 26
+	PictOpDisjointXor         PictOp = 27
+	PictOpConjointClear       PictOp = 32
+	PictOpConjointSrc         PictOp = 33
+	PictOpConjointDst         PictOp = 34
+	PictOpConjointOver        PictOp = 35
+	PictOpConjointOverReverse PictOp = 36
+	PictOpConjointIn          PictOp = 37
+	PictOpConjointInReverse   PictOp = 38
+	PictOpConjointOut         PictOp = 39
+	PictOpConjointOutReverse  PictOp = 40
+	PictOpConjointAtop        PictOp = 41
+	PictOpConjointAtopReverse PictOp = 42
+	PictOpConjointXor         PictOp = 43
+	PictOpMultiply            PictOp = 48
+	PictOpScreen              PictOp = 49
+	PictOpOverlay             PictOp = 50
+	PictOpDarken              PictOp = 51
+	PictOpLighten             PictOp = 52
+	PictOpColorDodge          PictOp = 53
+	PictOpColorBurn           PictOp = 54
+	PictOpHardLight           PictOp = 55
+	PictOpSoftLight           PictOp = 56
+	PictOpDifference          PictOp = 57
+	PictOpExclusion           PictOp = 58
+	PictOpHSLHue              PictOp = 59
+	PictOpHSLSaturation       PictOp = 60
+	PictOpHSLColor            PictOp = 61
+	PictOpHSLLuminosity       PictOp = 62
 )
 
+// String returns the name of the PictOp item with this value.
+func (v PictOp) String() string {
+	switch v {
+	case PictOpClear:
+		return "Clear"
+	case PictOpSrc:
+		return "Src"
+	case PictOpDst:
+		return "Dst"
+	case PictOpOver:
+		return "Over"
+	case PictOpOverReverse:
+		return "OverReverse"
+	case PictOpIn:
+		return "In"
+	case PictOpInReverse:
+		return "InReverse"
+	case PictOpOut:
+		return "Out"
+	case PictOpOutReverse:
+		return "OutReverse"
+	case PictOpAtop:
+		return "Atop"
+	case PictOpAtopReverse:
+		return "AtopReverse"
+	case PictOpXor:
+		return "Xor"
+	case PictOpAdd:
+		return "Add"
+	case PictOpSaturate:
+		return "Saturate"
+	case PictOpDisjointClear:
+		return "DisjointClear"
+	case PictOpDisjointSrc:
+		return "DisjointSrc"
+	case PictOpDisjointDst:
+		return "DisjointDst"
+	case PictOpDisjointOver:
+		return "DisjointOver"
+	case PictOpDisjointOverReverse:
+		return "DisjointOverReverse"
+	case PictOpDisjointIn:
+		return "DisjointIn"
+	case PictOpDisjointInReverse:
+		return "DisjointInReverse"
+	case PictOpDisjointOut:
+		return "DisjointOut"
+	case PictOpDisjointOutReverse:
+		return "DisjointOutReverse"
+	case PictOpDisjointAtop:
+		return "DisjointAtop"
+	case PictOpDisjointAtopReverse:
+		return "DisjointAtopReverse"
+	case PictOpDisjointXor:
+		return "DisjointXor"
+	case PictOpConjointClear:
+		return "ConjointClear"
+	case PictOpConjointSrc:
+		return "ConjointSrc"
+	case PictOpConjointDst:
+		return "ConjointDst"
+	case PictOpConjointOver:
+		return "ConjointOver"
+	case PictOpConjointOverReverse:
+		return "ConjointOverReverse"
+	case PictOpConjointIn:
+		return "ConjointIn"
+	case PictOpConjointInReverse:
+		return "ConjointInReverse"
+	case PictOpConjointOut:
+		return "ConjointOut"
+	case PictOpConjointOutReverse:
+		return "ConjointOutReverse"
+	case PictOpConjointAtop:
+		return "ConjointAtop"
+	case PictOpConjointAtopReverse:
+		return "ConjointAtopReverse"
+	case PictOpConjointXor:
+		return "ConjointXor"
+	case PictOpMultiply:
+		return "Multiply"
+	case PictOpScreen:
+		return "Screen"
+	case PictOpOverlay:
+		return "Overlay"
+	case PictOpDarken:
+		return "Darken"
+	case PictOpLighten:
+		return "Lighten"
+	case PictOpColorDodge:
+		return "ColorDodge"
+	case PictOpColorBurn:
+		return "ColorBurn"
+	case PictOpHardLight:
+		return "HardLight"
+	case PictOpSoftLight:
+		return "SoftLight"
+	case PictOpDifference:
+		return "Difference"
+	case PictOpExclusion:
+		return "Exclusion"
+	case PictOpHSLHue:
+		return "HSLHue"
+	case PictOpHSLSaturation:
+		return "HSLSaturation"
+	case PictOpHSLColor:
+		return "HSLColor"
+	case PictOpHSLLuminosity:
+		return "HSLLuminosity"
+	}
+	return xgb.Sprintf("PictOp(%d)", byte(v))
+}
+
+type PictType byte
+
 const (
-	PictTypeIndexed = 0
-	PictTypeDirect  = 1
+	PictTypeIndexed PictType = 0
+	PictTypeDirect  PictType = 1
 )
 
+// String returns the name of the PictType item with this value.
+func (v PictType) String() string {
+	switch v {
+	case PictTypeIndexed:
+		return "Indexed"
+	case PictTypeDirect:
+		return "Direct"
+	}
+	return xgb.Sprintf("PictType(%d)", byte(v))
+}
+
 type Pictdepth struct {
 	Depth byte
 	// padding: 1 bytes
@@ -755,7 +1063,7 @@ type Pictdepth struct {
 }
 
 // PictdepthRead reads a byte slice into a Pictdepth value.
-func PictdepthRead(buf []byte, v *Pictdepth) int {
+func PictdepthRead(buf []byte, order xgb.ByteOrder, v *Pictdepth) int {
 	b := 0
 
 	v.Depth = buf[b]
@@ -763,29 +1071,29 @@ func PictdepthRead(buf []byte, v *Pictdepth) int {
 
 	b += 1 // padding
 
-	v.NumVisuals = xgb.Get16(buf[b:])
+	v.NumVisuals = order.Get16(buf[b:])
 	b += 2
 
 	b += 4 // padding
 
 	v.Visuals = make([]Pictvisual, v.NumVisuals)
-	b += PictvisualReadList(buf[b:], v.Visuals)
+	b += PictvisualReadList(buf[b:], order, v.Visuals)
 
 	return b
 }
 
 // PictdepthReadList reads a byte slice into a list of Pictdepth values.
-func PictdepthReadList(buf []byte, dest []Pictdepth) int {
+func PictdepthReadList(buf []byte, order xgb.ByteOrder, dest []Pictdepth) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Pictdepth{}
-		b += PictdepthRead(buf[b:], &dest[i])
+		b += PictdepthRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Pictdepth value to a byte slice.
-func (v Pictdepth) Bytes() []byte {
+// Bytes writes a Pictdepth value to a byte slice, in the byte order 'order'.
+func (v Pictdepth) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, (8 + xgb.Pad((int(v.NumVisuals) * 8))))
 	b := 0
 
@@ -794,22 +1102,22 @@ func (v Pictdepth) Bytes() []byte {
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], v.NumVisuals)
+	order.Put16(buf[b:], v.NumVisuals)
 	b += 2
 
 	b += 4 // padding
 
-	b += PictvisualListBytes(buf[b:], v.Visuals)
+	b += PictvisualListBytes(buf[b:], order, v.Visuals)
 
 	return buf[:b]
 }
 
 // PictdepthListBytes writes a list of Pictdepth values to a byte slice.
-func PictdepthListBytes(buf []byte, list []Pictdepth) int {
+func PictdepthListBytes(buf []byte, order xgb.ByteOrder, list []Pictdepth) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -837,7 +1145,7 @@ func NewPictformatId(c *xgb.Conn) (Pictformat, error) {
 
 type Pictforminfo struct {
 	Id    Pictformat
-	Type  byte
+	Type  PictType
 	Depth byte
 	// padding: 2 bytes
 	Direct   Directformat
@@ -845,13 +1153,13 @@ type Pictforminfo struct {
 }
 
 // PictforminfoRead reads a byte slice into a Pictforminfo value.
-func PictforminfoRead(buf []byte, v *Pictforminfo) int {
+func PictforminfoRead(buf []byte, order xgb.ByteOrder, v *Pictforminfo) int {
 	b := 0
 
-	v.Id = Pictformat(xgb.Get32(buf[b:]))
+	v.Id = Pictformat(order.Get32(buf[b:]))
 	b += 4
 
-	v.Type = buf[b]
+	v.Type = PictType(buf[b])
 	b += 1
 
 	v.Depth = buf[b]
@@ -860,33 +1168,33 @@ func PictforminfoRead(buf []byte, v *Pictforminfo) int {
 	b += 2 // padding
 
 	v.Direct = Directformat{}
-	b += DirectformatRead(buf[b:], &v.Direct)
+	b += DirectformatRead(buf[b:], order, &v.Direct)
 
-	v.Colormap = xproto.Colormap(xgb.Get32(buf[b:]))
+	v.Colormap = xproto.Colormap(order.Get32(buf[b:]))
 	b += 4
 
 	return b
 }
 
 // PictforminfoReadList reads a byte slice into a list of Pictforminfo values.
-func PictforminfoReadList(buf []byte, dest []Pictforminfo) int {
+func PictforminfoReadList(buf []byte, order xgb.ByteOrder, dest []Pictforminfo) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Pictforminfo{}
-		b += PictforminfoRead(buf[b:], &dest[i])
+		b += PictforminfoRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Pictforminfo value to a byte slice.
-func (v Pictforminfo) Bytes() []byte {
+// Bytes writes a Pictforminfo value to a byte slice, in the byte order 'order'.
+func (v Pictforminfo) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 28)
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.Id))
+	order.Put32(buf[b:], uint32(v.Id))
 	b += 4
 
-	buf[b] = v.Type
+	buf[b] = byte(v.Type)
 	b += 1
 
 	buf[b] = v.Depth
@@ -895,23 +1203,23 @@ func (v Pictforminfo) Bytes() []byte {
 	b += 2 // padding
 
 	{
-		structBytes := v.Direct.Bytes()
+		structBytes := v.Direct.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
-	xgb.Put32(buf[b:], uint32(v.Colormap))
+	order.Put32(buf[b:], uint32(v.Colormap))
 	b += 4
 
 	return buf[:b]
 }
 
 // PictforminfoListBytes writes a list of Pictforminfo values to a byte slice.
-func PictforminfoListBytes(buf []byte, list []Pictforminfo) int {
+func PictforminfoListBytes(buf []byte, order xgb.ByteOrder, list []Pictforminfo) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -925,53 +1233,53 @@ type Pictscreen struct {
 }
 
 // PictscreenRead reads a byte slice into a Pictscreen value.
-func PictscreenRead(buf []byte, v *Pictscreen) int {
+func PictscreenRead(buf []byte, order xgb.ByteOrder, v *Pictscreen) int {
 	b := 0
 
-	v.NumDepths = xgb.Get32(buf[b:])
+	v.NumDepths = order.Get32(buf[b:])
 	b += 4
 
-	v.Fallback = Pictformat(xgb.Get32(buf[b:]))
+	v.Fallback = Pictformat(order.Get32(buf[b:]))
 	b += 4
 
 	v.Depths = make([]Pictdepth, v.NumDepths)
-	b += PictdepthReadList(buf[b:], v.Depths)
+	b += PictdepthReadList(buf[b:], order, v.Depths)
 
 	return b
 }
 
 // PictscreenReadList reads a byte slice into a list of Pictscreen values.
-func PictscreenReadList(buf []byte, dest []Pictscreen) int {
+func PictscreenReadList(buf []byte, order xgb.ByteOrder, dest []Pictscreen) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Pictscreen{}
-		b += PictscreenRead(buf[b:], &dest[i])
+		b += PictscreenRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Pictscreen value to a byte slice.
-func (v Pictscreen) Bytes() []byte {
+// Bytes writes a Pictscreen value to a byte slice, in the byte order 'order'.
+func (v Pictscreen) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, (8 + PictdepthListSize(v.Depths)))
 	b := 0
 
-	xgb.Put32(buf[b:], v.NumDepths)
+	order.Put32(buf[b:], v.NumDepths)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Fallback))
+	order.Put32(buf[b:], uint32(v.Fallback))
 	b += 4
 
-	b += PictdepthListBytes(buf[b:], v.Depths)
+	b += PictdepthListBytes(buf[b:], order, v.Depths)
 
 	return buf[:b]
 }
 
 // PictscreenListBytes writes a list of Pictscreen values to a byte slice.
-func PictscreenListBytes(buf []byte, list []Pictscreen) int {
+func PictscreenListBytes(buf []byte, order xgb.ByteOrder, list []Pictscreen) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -1005,15 +1313,15 @@ type PictureError struct {
 	NiceName string
 }
 
-// PictureErrorNew constructs a PictureError value that implements xgb.Error from a byte slice.
-func PictureErrorNew(buf []byte) xgb.Error {
+// PictureErrorNew constructs a PictureError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func PictureErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := PictureError{}
 	v.NiceName = "Picture"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -1053,48 +1361,48 @@ type Pictvisual struct {
 }
 
 // PictvisualRead reads a byte slice into a Pictvisual value.
-func PictvisualRead(buf []byte, v *Pictvisual) int {
+func PictvisualRead(buf []byte, order xgb.ByteOrder, v *Pictvisual) int {
 	b := 0
 
-	v.Visual = xproto.Visualid(xgb.Get32(buf[b:]))
+	v.Visual = xproto.Visualid(order.Get32(buf[b:]))
 	b += 4
 
-	v.Format = Pictformat(xgb.Get32(buf[b:]))
+	v.Format = Pictformat(order.Get32(buf[b:]))
 	b += 4
 
 	return b
 }
 
 // PictvisualReadList reads a byte slice into a list of Pictvisual values.
-func PictvisualReadList(buf []byte, dest []Pictvisual) int {
+func PictvisualReadList(buf []byte, order xgb.ByteOrder, dest []Pictvisual) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Pictvisual{}
-		b += PictvisualRead(buf[b:], &dest[i])
+		b += PictvisualRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Pictvisual value to a byte slice.
-func (v Pictvisual) Bytes() []byte {
+// Bytes writes a Pictvisual value to a byte slice, in the byte order 'order'.
+func (v Pictvisual) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 8)
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.Visual))
+	order.Put32(buf[b:], uint32(v.Visual))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Format))
+	order.Put32(buf[b:], uint32(v.Format))
 	b += 4
 
 	return buf[:b]
 }
 
 // PictvisualListBytes writes a list of Pictvisual values to a byte slice.
-func PictvisualListBytes(buf []byte, list []Pictvisual) int {
+func PictvisualListBytes(buf []byte, order xgb.ByteOrder, list []Pictvisual) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -1107,71 +1415,114 @@ type Pointfix struct {
 }
 
 // PointfixRead reads a byte slice into a Pointfix value.
-func PointfixRead(buf []byte, v *Pointfix) int {
+func PointfixRead(buf []byte, order xgb.ByteOrder, v *Pointfix) int {
 	b := 0
 
-	v.X = Fixed(xgb.Get32(buf[b:]))
+	v.X = Fixed(order.Get32(buf[b:]))
 	b += 4
 
-	v.Y = Fixed(xgb.Get32(buf[b:]))
+	v.Y = Fixed(order.Get32(buf[b:]))
 	b += 4
 
 	return b
 }
 
 // PointfixReadList reads a byte slice into a list of Pointfix values.
-func PointfixReadList(buf []byte, dest []Pointfix) int {
+func PointfixReadList(buf []byte, order xgb.ByteOrder, dest []Pointfix) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Pointfix{}
-		b += PointfixRead(buf[b:], &dest[i])
+		b += PointfixRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Pointfix value to a byte slice.
-func (v Pointfix) Bytes() []byte {
+// Bytes writes a Pointfix value to a byte slice, in the byte order 'order'.
+func (v Pointfix) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 8)
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.X))
+	order.Put32(buf[b:], uint32(v.X))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Y))
+	order.Put32(buf[b:], uint32(v.Y))
 	b += 4
 
 	return buf[:b]
 }
 
 // PointfixListBytes writes a list of Pointfix values to a byte slice.
-func PointfixListBytes(buf []byte, list []Pointfix) int {
+func PointfixListBytes(buf []byte, order xgb.ByteOrder, list []Pointfix) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 	return xgb.Pad(b)
 }
 
+type PolyEdge uint32
+
 const (
-	PolyEdgeSharp  = 0
-	PolyEdgeSmooth = 1
+	PolyEdgeSharp  PolyEdge = 0
+	PolyEdgeSmooth PolyEdge = 1
 )
 
+// String returns the name of the PolyEdge item with this value.
+func (v PolyEdge) String() string {
+	switch v {
+	case PolyEdgeSharp:
+		return "Sharp"
+	case PolyEdgeSmooth:
+		return "Smooth"
+	}
+	return xgb.Sprintf("PolyEdge(%d)", uint32(v))
+}
+
+type PolyMode uint32
+
 const (
-	PolyModePrecise   = 0
-	PolyModeImprecise = 1
+	PolyModePrecise   PolyMode = 0
+	PolyModeImprecise PolyMode = 1
 )
 
+// String returns the name of the PolyMode item with this value.
+func (v PolyMode) String() string {
+	switch v {
+	case PolyModePrecise:
+		return "Precise"
+	case PolyModeImprecise:
+		return "Imprecise"
+	}
+	return xgb.Sprintf("PolyMode(%d)", uint32(v))
+}
+
+type Repeat uint32
+
 const (
-	RepeatNone    = 0
-	RepeatNormal  = 1
-	RepeatPad     = 2
-	RepeatReflect = 3
+	RepeatNone    Repeat = 0
+	RepeatNormal  Repeat = 1
+	RepeatPad     Repeat = 2
+	RepeatReflect Repeat = 3
 )
 
+// String returns the name of the Repeat item with this value.
+func (v Repeat) String() string {
+	switch v {
+	case RepeatNone:
+		return "None"
+	case RepeatNormal:
+		return "Normal"
+	case RepeatPad:
+		return "Pad"
+	case RepeatReflect:
+		return "Reflect"
+	}
+	return xgb.Sprintf("Repeat(%d)", uint32(v))
+}
+
 type Spanfix struct {
 	L Fixed
 	R Fixed
@@ -1179,54 +1530,54 @@ type Spanfix struct {
 }
 
 // SpanfixRead reads a byte slice into a Spanfix value.
-func SpanfixRead(buf []byte, v *Spanfix) int {
+func SpanfixRead(buf []byte, order xgb.ByteOrder, v *Spanfix) int {
 	b := 0
 
-	v.L = Fixed(xgb.Get32(buf[b:]))
+	v.L = Fixed(order.Get32(buf[b:]))
 	b += 4
 
-	v.R = Fixed(xgb.Get32(buf[b:]))
+	v.R = Fixed(order.Get32(buf[b:]))
 	b += 4
 
-	v.Y = Fixed(xgb.Get32(buf[b:]))
+	v.Y = Fixed(order.Get32(buf[b:]))
 	b += 4
 
 	return b
 }
 
 // SpanfixReadList reads a byte slice into a list of Spanfix values.
-func SpanfixReadList(buf []byte, dest []Spanfix) int {
+func SpanfixReadList(buf []byte, order xgb.ByteOrder, dest []Spanfix) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Spanfix{}
-		b += SpanfixRead(buf[b:], &dest[i])
+		b += SpanfixRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Spanfix value to a byte slice.
-func (v Spanfix) Bytes() []byte {
+// Bytes writes a Spanfix value to a byte slice, in the byte order 'order'.
+func (v Spanfix) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 12)
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.L))
+	order.Put32(buf[b:], uint32(v.L))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.R))
+	order.Put32(buf[b:], uint32(v.R))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Y))
+	order.Put32(buf[b:], uint32(v.Y))
 	b += 4
 
 	return buf[:b]
 }
 
 // SpanfixListBytes writes a list of Spanfix values to a byte slice.
-func SpanfixListBytes(buf []byte, list []Spanfix) int {
+func SpanfixListBytes(buf []byte, order xgb.ByteOrder, list []Spanfix) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -1255,90 +1606,90 @@ type Transform struct {
 }
 
 // TransformRead reads a byte slice into a Transform value.
-func TransformRead(buf []byte, v *Transform) int {
+func TransformRead(buf []byte, order xgb.ByteOrder, v *Transform) int {
 	b := 0
 
-	v.Matrix11 = Fixed(xgb.Get32(buf[b:]))
+	v.Matrix11 = Fixed(order.Get32(buf[b:]))
 	b += 4
 
-	v.Matrix12 = Fixed(xgb.Get32(buf[b:]))
+	v.Matrix12 = Fixed(order.Get32(buf[b:]))
 	b += 4
 
-	v.Matrix13 = Fixed(xgb.Get32(buf[b:]))
+	v.Matrix13 = Fixed(order.Get32(buf[b:]))
 	b += 4
 
-	v.Matrix21 = Fixed(xgb.Get32(buf[b:]))
+	v.Matrix21 = Fixed(order.Get32(buf[b:]))
 	b += 4
 
-	v.Matrix22 = Fixed(xgb.Get32(buf[b:]))
+	v.Matrix22 = Fixed(order.Get32(buf[b:]))
 	b += 4
 
-	v.Matrix23 = Fixed(xgb.Get32(buf[b:]))
+	v.Matrix23 = Fixed(order.Get32(buf[b:]))
 	b += 4
 
-	v.Matrix31 = Fixed(xgb.Get32(buf[b:]))
+	v.Matrix31 = Fixed(order.Get32(buf[b:]))
 	b += 4
 
-	v.Matrix32 = Fixed(xgb.Get32(buf[b:]))
+	v.Matrix32 = Fixed(order.Get32(buf[b:]))
 	b += 4
 
-	v.Matrix33 = Fixed(xgb.Get32(buf[b:]))
+	v.Matrix33 = Fixed(order.Get32(buf[b:]))
 	b += 4
 
 	return b
 }
 
 // TransformReadList reads a byte slice into a list of Transform values.
-func TransformReadList(buf []byte, dest []Transform) int {
+func TransformReadList(buf []byte, order xgb.ByteOrder, dest []Transform) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Transform{}
-		b += TransformRead(buf[b:], &dest[i])
+		b += TransformRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Transform value to a byte slice.
-func (v Transform) Bytes() []byte {
+// Bytes writes a Transform value to a byte slice, in the byte order 'order'.
+func (v Transform) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 36)
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.Matrix11))
+	order.Put32(buf[b:], uint32(v.Matrix11))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Matrix12))
+	order.Put32(buf[b:], uint32(v.Matrix12))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Matrix13))
+	order.Put32(buf[b:], uint32(v.Matrix13))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Matrix21))
+	order.Put32(buf[b:], uint32(v.Matrix21))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Matrix22))
+	order.Put32(buf[b:], uint32(v.Matrix22))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Matrix23))
+	order.Put32(buf[b:], uint32(v.Matrix23))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Matrix31))
+	order.Put32(buf[b:], uint32(v.Matrix31))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Matrix32))
+	order.Put32(buf[b:], uint32(v.Matrix32))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Matrix33))
+	order.Put32(buf[b:], uint32(v.Matrix33))
 	b += 4
 
 	return buf[:b]
 }
 
 // TransformListBytes writes a list of Transform values to a byte slice.
-func TransformListBytes(buf []byte, list []Transform) int {
+func TransformListBytes(buf []byte, order xgb.ByteOrder, list []Transform) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -1351,41 +1702,41 @@ type Trap struct {
 }
 
 // TrapRead reads a byte slice into a Trap value.
-func TrapRead(buf []byte, v *Trap) int {
+func TrapRead(buf []byte, order xgb.ByteOrder, v *Trap) int {
 	b := 0
 
 	v.Top = Spanfix{}
-	b += SpanfixRead(buf[b:], &v.Top)
+	b += SpanfixRead(buf[b:], order, &v.Top)
 
 	v.Bot = Spanfix{}
-	b += SpanfixRead(buf[b:], &v.Bot)
+	b += SpanfixRead(buf[b:], order, &v.Bot)
 
 	return b
 }
 
 // TrapReadList reads a byte slice into a list of Trap values.
-func TrapReadList(buf []byte, dest []Trap) int {
+func TrapReadList(buf []byte, order xgb.ByteOrder, dest []Trap) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Trap{}
-		b += TrapRead(buf[b:], &dest[i])
+		b += TrapRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Trap value to a byte slice.
-func (v Trap) Bytes() []byte {
+// Bytes writes a Trap value to a byte slice, in the byte order 'order'.
+func (v Trap) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 24)
 	b := 0
 
 	{
-		structBytes := v.Top.Bytes()
+		structBytes := v.Top.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
 	{
-		structBytes := v.Bot.Bytes()
+		structBytes := v.Bot.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -1394,11 +1745,11 @@ func (v Trap) Bytes() []byte {
 }
 
 // TrapListBytes writes a list of Trap values to a byte slice.
-func TrapListBytes(buf []byte, list []Trap) int {
+func TrapListBytes(buf []byte, order xgb.ByteOrder, list []Trap) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -1413,53 +1764,53 @@ type Trapezoid struct {
 }
 
 // TrapezoidRead reads a byte slice into a Trapezoid value.
-func TrapezoidRead(buf []byte, v *Trapezoid) int {
+func TrapezoidRead(buf []byte, order xgb.ByteOrder, v *Trapezoid) int {
 	b := 0
 
-	v.Top = Fixed(xgb.Get32(buf[b:]))
+	v.Top = Fixed(order.Get32(buf[b:]))
 	b += 4
 
-	v.Bottom = Fixed(xgb.Get32(buf[b:]))
+	v.Bottom = Fixed(order.Get32(buf[b:]))
 	b += 4
 
 	v.Left = Linefix{}
-	b += LinefixRead(buf[b:], &v.Left)
+	b += LinefixRead(buf[b:], order, &v.Left)
 
 	v.Right = Linefix{}
-	b += LinefixRead(buf[b:], &v.Right)
+	b += LinefixRead(buf[b:], order, &v.Right)
 
 	return b
 }
 
 // TrapezoidReadList reads a byte slice into a list of Trapezoid values.
-func TrapezoidReadList(buf []byte, dest []Trapezoid) int {
+func TrapezoidReadList(buf []byte, order xgb.ByteOrder, dest []Trapezoid) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Trapezoid{}
-		b += TrapezoidRead(buf[b:], &dest[i])
+		b += TrapezoidRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Trapezoid value to a byte slice.
-func (v Trapezoid) Bytes() []byte {
+// Bytes writes a Trapezoid value to a byte slice, in the byte order 'order'.
+func (v Trapezoid) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 40)
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.Top))
+	order.Put32(buf[b:], uint32(v.Top))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Bottom))
+	order.Put32(buf[b:], uint32(v.Bottom))
 	b += 4
 
 	{
-		structBytes := v.Left.Bytes()
+		structBytes := v.Left.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
 	{
-		structBytes := v.Right.Bytes()
+		structBytes := v.Right.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -1468,11 +1819,11 @@ func (v Trapezoid) Bytes() []byte {
 }
 
 // TrapezoidListBytes writes a list of Trapezoid values to a byte slice.
-func TrapezoidListBytes(buf []byte, list []Trapezoid) int {
+func TrapezoidListBytes(buf []byte, order xgb.ByteOrder, list []Trapezoid) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -1486,50 +1837,50 @@ type Triangle struct {
 }
 
 // TriangleRead reads a byte slice into a Triangle value.
-func TriangleRead(buf []byte, v *Triangle) int {
+func TriangleRead(buf []byte, order xgb.ByteOrder, v *Triangle) int {
 	b := 0
 
 	v.P1 = Pointfix{}
-	b += PointfixRead(buf[b:], &v.P1)
+	b += PointfixRead(buf[b:], order, &v.P1)
 
 	v.P2 = Pointfix{}
-	b += PointfixRead(buf[b:], &v.P2)
+	b += PointfixRead(buf[b:], order, &v.P2)
 
 	v.P3 = Pointfix{}
-	b += PointfixRead(buf[b:], &v.P3)
+	b += PointfixRead(buf[b:], order, &v.P3)
 
 	return b
 }
 
 // TriangleReadList reads a byte slice into a list of Triangle values.
-func TriangleReadList(buf []byte, dest []Triangle) int {
+func TriangleReadList(buf []byte, order xgb.ByteOrder, dest []Triangle) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Triangle{}
-		b += TriangleRead(buf[b:], &dest[i])
+		b += TriangleRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Triangle value to a byte slice.
-func (v Triangle) Bytes() []byte {
+// Bytes writes a Triangle value to a byte slice, in the byte order 'order'.
+func (v Triangle) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 24)
 	b := 0
 
 	{
-		structBytes := v.P1.Bytes()
+		structBytes := v.P1.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
 	{
-		structBytes := v.P2.Bytes()
+		structBytes := v.P2.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
 	{
-		structBytes := v.P3.Bytes()
+		structBytes := v.P3.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -1538,11 +1889,11 @@ func (v Triangle) Bytes() []byte {
 }
 
 // TriangleListBytes writes a list of Triangle values to a byte slice.
-func TriangleListBytes(buf []byte, list []Triangle) int {
+func TriangleListBytes(buf []byte, order xgb.ByteOrder, list []Triangle) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -1573,6 +1924,8 @@ func TriangleListBytes(buf []byte, list []Triangle) int {
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // AddGlyphsCookie is a cookie used only for AddGlyphs requests.
 type AddGlyphsCookie struct {
 	*xgb.Cookie
@@ -1610,12 +1963,26 @@ func (cook AddGlyphsCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook AddGlyphsCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for AddGlyphs
 // addGlyphsRequest writes a AddGlyphs request to a byte slice.
 func addGlyphsRequest(c *xgb.Conn, Glyphset Glyphset, GlyphsLen uint32, Glyphids []uint32, Glyphs []Glyphinfo, Data []byte) []byte {
+	return AppendAddGlyphsRequest(nil, c, Glyphset, GlyphsLen, Glyphids, Glyphs, Data)
+}
+
+// AppendAddGlyphsRequest appends a AddGlyphs request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendAddGlyphsRequest(buf []byte, c *xgb.Conn, Glyphset Glyphset, GlyphsLen uint32, Glyphids []uint32, Glyphs []Glyphinfo, Data []byte) []byte {
 	size := xgb.Pad(((((12 + xgb.Pad((int(GlyphsLen) * 4))) + 4) + xgb.Pad((int(GlyphsLen) * 12))) + xgb.Pad((len(Data) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -1628,29 +1995,77 @@ func addGlyphsRequest(c *xgb.Conn, Glyphset Glyphset, GlyphsLen uint32, Glyphids
 	blen := b
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Glyphset))
+	order.Put32(buf[b:], uint32(Glyphset))
 	b += 4
 
-	xgb.Put32(buf[b:], GlyphsLen)
+	order.Put32(buf[b:], GlyphsLen)
 	b += 4
 
 	for i := 0; i < int(GlyphsLen); i++ {
-		xgb.Put32(buf[b:], Glyphids[i])
+		order.Put32(buf[b:], Glyphids[i])
 		b += 4
 	}
 
 	b = (b + 3) & ^3 // alignment gap
 
-	b += GlyphinfoListBytes(buf[b:], Glyphs)
+	b += GlyphinfoListBytes(buf[b:], order, Glyphs)
 
 	copy(buf[b:], Data[:len(Data)])
 	b += int(len(Data))
 
-	b = xgb.Pad(b)
-	xgb.Put16(buf[blen:], uint16(b/4)) // write request size in 4-byte units
+	b = start + xgb.Pad(b-start)
+	order.Put16(buf[blen:], uint16((b-start)/4)) // write request size in 4-byte units
 	return buf[:b]
 }
 
+// AddGlyphsRequest represents the data of a AddGlyphs request, as it is read when tracing a connection.
+type AddGlyphsRequest struct {
+	Glyphset  Glyphset
+	GlyphsLen uint32
+	Glyphids  []uint32 // size: xgb.Pad((int(GlyphsLen) * 4))
+	// alignment gap to multiple of 4
+	Glyphs []Glyphinfo // size: xgb.Pad((int(GlyphsLen) * 12))
+	Data   []byte      // size: xgb.Pad((len(Data) * 1))
+}
+
+// addGlyphsRequestRead reads a byte slice in the byte order 'order' into a AddGlyphsRequest value.
+func addGlyphsRequestRead(buf []byte, order xgb.ByteOrder) *AddGlyphsRequest {
+	v := new(AddGlyphsRequest)
+	b := 4 // skip request header
+
+	v.Glyphset = Glyphset(order.Get32(buf[b:]))
+	b += 4
+
+	v.GlyphsLen = order.Get32(buf[b:])
+	b += 4
+
+	v.Glyphids = make([]uint32, v.GlyphsLen)
+	for i := 0; i < int(v.GlyphsLen); i++ {
+		v.Glyphids[i] = order.Get32(buf[b:])
+		b += 4
+	}
+
+	b = (b + 3) & ^3 // alignment gap
+
+	v.Glyphs = make([]Glyphinfo, v.GlyphsLen)
+	b += GlyphinfoReadList(buf[b:], order, v.Glyphs)
+
+	v.Data = make([]byte, (len(buf)-b)/1)
+	copy(v.Data[:(len(buf)-b)/1], buf[b:])
+	b += int((len(buf) - b) / 1)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][20] = xgb.RequestInfo{
+		Name: "AddGlyphs",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return addGlyphsRequestRead(buf, order)
+		},
+	}
+}
+
 // AddTrapsCookie is a cookie used only for AddTraps requests.
 type AddTrapsCookie struct {
 	*xgb.Cookie
@@ -1688,12 +2103,26 @@ func (cook AddTrapsCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook AddTrapsCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for AddTraps
 // addTrapsRequest writes a AddTraps request to a byte slice.
 func addTrapsRequest(c *xgb.Conn, Picture Picture, XOff int16, YOff int16, Traps []Trap) []byte {
+	return AppendAddTrapsRequest(nil, c, Picture, XOff, YOff, Traps)
+}
+
+// AppendAddTrapsRequest appends a AddTraps request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendAddTrapsRequest(buf []byte, c *xgb.Conn, Picture Picture, XOff int16, YOff int16, Traps []Trap) []byte {
 	size := xgb.Pad((12 + xgb.Pad((len(Traps) * 24))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -1703,23 +2132,60 @@ func addTrapsRequest(c *xgb.Conn, Picture Picture, XOff int16, YOff int16, Traps
 	buf[b] = 32 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Picture))
+	order.Put32(buf[b:], uint32(Picture))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(XOff))
+	order.Put16(buf[b:], uint16(XOff))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(YOff))
+	order.Put16(buf[b:], uint16(YOff))
 	b += 2
 
-	b += TrapListBytes(buf[b:], Traps)
+	b += TrapListBytes(buf[b:], order, Traps)
 
 	return buf
 }
 
+// AddTrapsRequest represents the data of a AddTraps request, as it is read when tracing a connection.
+type AddTrapsRequest struct {
+	Picture Picture
+	XOff    int16
+	YOff    int16
+	Traps   []Trap // size: xgb.Pad((len(Traps) * 24))
+}
+
+// addTrapsRequestRead reads a byte slice in the byte order 'order' into a AddTrapsRequest value.
+func addTrapsRequestRead(buf []byte, order xgb.ByteOrder) *AddTrapsRequest {
+	v := new(AddTrapsRequest)
+	b := 4 // skip request header
+
+	v.Picture = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.XOff = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.YOff = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Traps = make([]Trap, (len(buf)-b)/24)
+	b += TrapReadList(buf[b:], order, v.Traps)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][32] = xgb.RequestInfo{
+		Name: "AddTraps",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return addTrapsRequestRead(buf, order)
+		},
+	}
+}
+
 // ChangePictureCookie is a cookie used only for ChangePicture requests.
 type ChangePictureCookie struct {
 	*xgb.Cookie
@@ -1727,7 +2193,7 @@ type ChangePictureCookie struct {
 
 // ChangePicture sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func ChangePicture(c *xgb.Conn, Picture Picture, ValueMask uint32, ValueList []uint32) ChangePictureCookie {
+func ChangePicture(c *xgb.Conn, Picture Picture, ValueMask Cp, ValueList []uint32) ChangePictureCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RENDER"]; !ok {
@@ -1740,7 +2206,7 @@ func ChangePicture(c *xgb.Conn, Picture Picture, ValueMask uint32, ValueList []u
 
 // ChangePictureChecked sends a checked request.
 // If an error occurs, it can be retrieved using ChangePictureCookie.Check()
-func ChangePictureChecked(c *xgb.Conn, Picture Picture, ValueMask uint32, ValueList []uint32) ChangePictureCookie {
+func ChangePictureChecked(c *xgb.Conn, Picture Picture, ValueMask Cp, ValueList []uint32) ChangePictureCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RENDER"]; !ok {
@@ -1757,12 +2223,26 @@ func (cook ChangePictureCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ChangePictureCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ChangePicture
 // changePictureRequest writes a ChangePicture request to a byte slice.
-func changePictureRequest(c *xgb.Conn, Picture Picture, ValueMask uint32, ValueList []uint32) []byte {
+func changePictureRequest(c *xgb.Conn, Picture Picture, ValueMask Cp, ValueList []uint32) []byte {
+	return AppendChangePictureRequest(nil, c, Picture, ValueMask, ValueList)
+}
+
+// AppendChangePictureRequest appends a ChangePicture request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendChangePictureRequest(buf []byte, c *xgb.Conn, Picture Picture, ValueMask Cp, ValueList []uint32) []byte {
 	size := xgb.Pad((8 + (4 + xgb.Pad((4 * xgb.PopCount(int(ValueMask)))))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -1772,16 +2252,16 @@ func changePictureRequest(c *xgb.Conn, Picture Picture, ValueMask uint32, ValueL
 	buf[b] = 5 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Picture))
+	order.Put32(buf[b:], uint32(Picture))
 	b += 4
 
-	xgb.Put32(buf[b:], ValueMask)
+	order.Put32(buf[b:], uint32(ValueMask))
 	b += 4
 	for i := 0; i < xgb.PopCount(int(ValueMask)); i++ {
-		xgb.Put32(buf[b:], ValueList[i])
+		order.Put32(buf[b:], ValueList[i])
 		b += 4
 	}
 	b = xgb.Pad(b)
@@ -1789,6 +2269,43 @@ func changePictureRequest(c *xgb.Conn, Picture Picture, ValueMask uint32, ValueL
 	return buf
 }
 
+// ChangePictureRequest represents the data of a ChangePicture request, as it is read when tracing a connection.
+type ChangePictureRequest struct {
+	Picture   Picture
+	ValueMask Cp
+	ValueList []uint32
+}
+
+// changePictureRequestRead reads a byte slice in the byte order 'order' into a ChangePictureRequest value.
+func changePictureRequestRead(buf []byte, order xgb.ByteOrder) *ChangePictureRequest {
+	v := new(ChangePictureRequest)
+	b := 4 // skip request header
+
+	v.Picture = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.ValueMask = Cp(order.Get32(buf[b:]))
+	b += 4
+
+	v.ValueList = make([]uint32, xgb.PopCount(int(v.ValueMask)))
+	for i := 0; i < xgb.PopCount(int(v.ValueMask)); i++ {
+		v.ValueList[i] = order.Get32(buf[b:])
+		b += 4
+	}
+	b = xgb.Pad(b)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][5] = xgb.RequestInfo{
+		Name: "ChangePicture",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return changePictureRequestRead(buf, order)
+		},
+	}
+}
+
 // CompositeCookie is a cookie used only for Composite requests.
 type CompositeCookie struct {
 	*xgb.Cookie
@@ -1796,7 +2313,7 @@ type CompositeCookie struct {
 
 // Composite sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func Composite(c *xgb.Conn, Op byte, Src Picture, Mask Picture, Dst Picture, SrcX int16, SrcY int16, MaskX int16, MaskY int16, DstX int16, DstY int16, Width uint16, Height uint16) CompositeCookie {
+func Composite(c *xgb.Conn, Op PictOp, Src Picture, Mask Picture, Dst Picture, SrcX int16, SrcY int16, MaskX int16, MaskY int16, DstX int16, DstY int16, Width uint16, Height uint16) CompositeCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RENDER"]; !ok {
@@ -1809,7 +2326,7 @@ func Composite(c *xgb.Conn, Op byte, Src Picture, Mask Picture, Dst Picture, Src
 
 // CompositeChecked sends a checked request.
 // If an error occurs, it can be retrieved using CompositeCookie.Check()
-func CompositeChecked(c *xgb.Conn, Op byte, Src Picture, Mask Picture, Dst Picture, SrcX int16, SrcY int16, MaskX int16, MaskY int16, DstX int16, DstY int16, Width uint16, Height uint16) CompositeCookie {
+func CompositeChecked(c *xgb.Conn, Op PictOp, Src Picture, Mask Picture, Dst Picture, SrcX int16, SrcY int16, MaskX int16, MaskY int16, DstX int16, DstY int16, Width uint16, Height uint16) CompositeCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RENDER"]; !ok {
@@ -1826,12 +2343,26 @@ func (cook CompositeCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CompositeCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for Composite
 // compositeRequest writes a Composite request to a byte slice.
-func compositeRequest(c *xgb.Conn, Op byte, Src Picture, Mask Picture, Dst Picture, SrcX int16, SrcY int16, MaskX int16, MaskY int16, DstX int16, DstY int16, Width uint16, Height uint16) []byte {
+func compositeRequest(c *xgb.Conn, Op PictOp, Src Picture, Mask Picture, Dst Picture, SrcX int16, SrcY int16, MaskX int16, MaskY int16, DstX int16, DstY int16, Width uint16, Height uint16) []byte {
+	return AppendCompositeRequest(nil, c, Op, Src, Mask, Dst, SrcX, SrcY, MaskX, MaskY, DstX, DstY, Width, Height)
+}
+
+// AppendCompositeRequest appends a Composite request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCompositeRequest(buf []byte, c *xgb.Conn, Op PictOp, Src Picture, Mask Picture, Dst Picture, SrcX int16, SrcY int16, MaskX int16, MaskY int16, DstX int16, DstY int16, Width uint16, Height uint16) []byte {
 	size := 36
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -1841,50 +2372,122 @@ func compositeRequest(c *xgb.Conn, Op byte, Src Picture, Mask Picture, Dst Pictu
 	buf[b] = 8 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	buf[b] = Op
+	buf[b] = byte(Op)
 	b += 1
 
 	b += 3 // padding
 
-	xgb.Put32(buf[b:], uint32(Src))
+	order.Put32(buf[b:], uint32(Src))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Mask))
+	order.Put32(buf[b:], uint32(Mask))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Dst))
+	order.Put32(buf[b:], uint32(Dst))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(SrcX))
+	order.Put16(buf[b:], uint16(SrcX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(SrcY))
+	order.Put16(buf[b:], uint16(SrcY))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(MaskX))
+	order.Put16(buf[b:], uint16(MaskX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(MaskY))
+	order.Put16(buf[b:], uint16(MaskY))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DstX))
+	order.Put16(buf[b:], uint16(DstX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DstY))
+	order.Put16(buf[b:], uint16(DstY))
 	b += 2
 
-	xgb.Put16(buf[b:], Width)
+	order.Put16(buf[b:], Width)
 	b += 2
 
-	xgb.Put16(buf[b:], Height)
+	order.Put16(buf[b:], Height)
 	b += 2
 
 	return buf
 }
 
+// CompositeRequest represents the data of a Composite request, as it is read when tracing a connection.
+type CompositeRequest struct {
+	Op PictOp
+	// padding: 3 bytes
+	Src    Picture
+	Mask   Picture
+	Dst    Picture
+	SrcX   int16
+	SrcY   int16
+	MaskX  int16
+	MaskY  int16
+	DstX   int16
+	DstY   int16
+	Width  uint16
+	Height uint16
+}
+
+// compositeRequestRead reads a byte slice in the byte order 'order' into a CompositeRequest value.
+func compositeRequestRead(buf []byte, order xgb.ByteOrder) *CompositeRequest {
+	v := new(CompositeRequest)
+	b := 4 // skip request header
+
+	v.Op = PictOp(buf[b])
+	b += 1
+
+	b += 3 // padding
+
+	v.Src = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.Mask = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.Dst = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.SrcX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.SrcY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.MaskX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.MaskY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.DstX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.DstY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][8] = xgb.RequestInfo{
+		Name: "Composite",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return compositeRequestRead(buf, order)
+		},
+	}
+}
+
 // CompositeGlyphs16Cookie is a cookie used only for CompositeGlyphs16 requests.
 type CompositeGlyphs16Cookie struct {
 	*xgb.Cookie
@@ -1892,7 +2495,7 @@ type CompositeGlyphs16Cookie struct {
 
 // CompositeGlyphs16 sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func CompositeGlyphs16(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictformat, Glyphset Glyphset, SrcX int16, SrcY int16, Glyphcmds []byte) CompositeGlyphs16Cookie {
+func CompositeGlyphs16(c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, Glyphset Glyphset, SrcX int16, SrcY int16, Glyphcmds []byte) CompositeGlyphs16Cookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RENDER"]; !ok {
@@ -1905,7 +2508,7 @@ func CompositeGlyphs16(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskForma
 
 // CompositeGlyphs16Checked sends a checked request.
 // If an error occurs, it can be retrieved using CompositeGlyphs16Cookie.Check()
-func CompositeGlyphs16Checked(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictformat, Glyphset Glyphset, SrcX int16, SrcY int16, Glyphcmds []byte) CompositeGlyphs16Cookie {
+func CompositeGlyphs16Checked(c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, Glyphset Glyphset, SrcX int16, SrcY int16, Glyphcmds []byte) CompositeGlyphs16Cookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RENDER"]; !ok {
@@ -1922,12 +2525,26 @@ func (cook CompositeGlyphs16Cookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CompositeGlyphs16Cookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CompositeGlyphs16
 // compositeGlyphs16Request writes a CompositeGlyphs16 request to a byte slice.
-func compositeGlyphs16Request(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictformat, Glyphset Glyphset, SrcX int16, SrcY int16, Glyphcmds []byte) []byte {
+func compositeGlyphs16Request(c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, Glyphset Glyphset, SrcX int16, SrcY int16, Glyphcmds []byte) []byte {
+	return AppendCompositeGlyphs16Request(nil, c, Op, Src, Dst, MaskFormat, Glyphset, SrcX, SrcY, Glyphcmds)
+}
+
+// AppendCompositeGlyphs16Request appends a CompositeGlyphs16 request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCompositeGlyphs16Request(buf []byte, c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, Glyphset Glyphset, SrcX int16, SrcY int16, Glyphcmds []byte) []byte {
 	size := xgb.Pad((28 + xgb.Pad((len(Glyphcmds) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -1937,30 +2554,30 @@ func compositeGlyphs16Request(c *xgb.Conn, Op byte, Src Picture, Dst Picture, Ma
 	buf[b] = 24 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	buf[b] = Op
+	buf[b] = byte(Op)
 	b += 1
 
 	b += 3 // padding
 
-	xgb.Put32(buf[b:], uint32(Src))
+	order.Put32(buf[b:], uint32(Src))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Dst))
+	order.Put32(buf[b:], uint32(Dst))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(MaskFormat))
+	order.Put32(buf[b:], uint32(MaskFormat))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Glyphset))
+	order.Put32(buf[b:], uint32(Glyphset))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(SrcX))
+	order.Put16(buf[b:], uint16(SrcX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(SrcY))
+	order.Put16(buf[b:], uint16(SrcY))
 	b += 2
 
 	copy(buf[b:], Glyphcmds[:len(Glyphcmds)])
@@ -1969,6 +2586,63 @@ func compositeGlyphs16Request(c *xgb.Conn, Op byte, Src Picture, Dst Picture, Ma
 	return buf
 }
 
+// CompositeGlyphs16Request represents the data of a CompositeGlyphs16 request, as it is read when tracing a connection.
+type CompositeGlyphs16Request struct {
+	Op PictOp
+	// padding: 3 bytes
+	Src        Picture
+	Dst        Picture
+	MaskFormat Pictformat
+	Glyphset   Glyphset
+	SrcX       int16
+	SrcY       int16
+	Glyphcmds  []byte // size: xgb.Pad((len(Glyphcmds) * 1))
+}
+
+// compositeGlyphs16RequestRead reads a byte slice in the byte order 'order' into a CompositeGlyphs16Request value.
+func compositeGlyphs16RequestRead(buf []byte, order xgb.ByteOrder) *CompositeGlyphs16Request {
+	v := new(CompositeGlyphs16Request)
+	b := 4 // skip request header
+
+	v.Op = PictOp(buf[b])
+	b += 1
+
+	b += 3 // padding
+
+	v.Src = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.Dst = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.MaskFormat = Pictformat(order.Get32(buf[b:]))
+	b += 4
+
+	v.Glyphset = Glyphset(order.Get32(buf[b:]))
+	b += 4
+
+	v.SrcX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.SrcY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Glyphcmds = make([]byte, (len(buf)-b)/1)
+	copy(v.Glyphcmds[:(len(buf)-b)/1], buf[b:])
+	b += int((len(buf) - b) / 1)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][24] = xgb.RequestInfo{
+		Name: "CompositeGlyphs16",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return compositeGlyphs16RequestRead(buf, order)
+		},
+	}
+}
+
 // CompositeGlyphs32Cookie is a cookie used only for CompositeGlyphs32 requests.
 type CompositeGlyphs32Cookie struct {
 	*xgb.Cookie
@@ -1976,7 +2650,7 @@ type CompositeGlyphs32Cookie struct {
 
 // CompositeGlyphs32 sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func CompositeGlyphs32(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictformat, Glyphset Glyphset, SrcX int16, SrcY int16, Glyphcmds []byte) CompositeGlyphs32Cookie {
+func CompositeGlyphs32(c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, Glyphset Glyphset, SrcX int16, SrcY int16, Glyphcmds []byte) CompositeGlyphs32Cookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RENDER"]; !ok {
@@ -1989,7 +2663,7 @@ func CompositeGlyphs32(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskForma
 
 // CompositeGlyphs32Checked sends a checked request.
 // If an error occurs, it can be retrieved using CompositeGlyphs32Cookie.Check()
-func CompositeGlyphs32Checked(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictformat, Glyphset Glyphset, SrcX int16, SrcY int16, Glyphcmds []byte) CompositeGlyphs32Cookie {
+func CompositeGlyphs32Checked(c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, Glyphset Glyphset, SrcX int16, SrcY int16, Glyphcmds []byte) CompositeGlyphs32Cookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RENDER"]; !ok {
@@ -2006,12 +2680,26 @@ func (cook CompositeGlyphs32Cookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CompositeGlyphs32Cookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CompositeGlyphs32
 // compositeGlyphs32Request writes a CompositeGlyphs32 request to a byte slice.
-func compositeGlyphs32Request(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictformat, Glyphset Glyphset, SrcX int16, SrcY int16, Glyphcmds []byte) []byte {
+func compositeGlyphs32Request(c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, Glyphset Glyphset, SrcX int16, SrcY int16, Glyphcmds []byte) []byte {
+	return AppendCompositeGlyphs32Request(nil, c, Op, Src, Dst, MaskFormat, Glyphset, SrcX, SrcY, Glyphcmds)
+}
+
+// AppendCompositeGlyphs32Request appends a CompositeGlyphs32 request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCompositeGlyphs32Request(buf []byte, c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, Glyphset Glyphset, SrcX int16, SrcY int16, Glyphcmds []byte) []byte {
 	size := xgb.Pad((28 + xgb.Pad((len(Glyphcmds) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -2021,30 +2709,30 @@ func compositeGlyphs32Request(c *xgb.Conn, Op byte, Src Picture, Dst Picture, Ma
 	buf[b] = 25 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	buf[b] = Op
+	buf[b] = byte(Op)
 	b += 1
 
 	b += 3 // padding
 
-	xgb.Put32(buf[b:], uint32(Src))
+	order.Put32(buf[b:], uint32(Src))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Dst))
+	order.Put32(buf[b:], uint32(Dst))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(MaskFormat))
+	order.Put32(buf[b:], uint32(MaskFormat))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Glyphset))
+	order.Put32(buf[b:], uint32(Glyphset))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(SrcX))
+	order.Put16(buf[b:], uint16(SrcX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(SrcY))
+	order.Put16(buf[b:], uint16(SrcY))
 	b += 2
 
 	copy(buf[b:], Glyphcmds[:len(Glyphcmds)])
@@ -2053,29 +2741,86 @@ func compositeGlyphs32Request(c *xgb.Conn, Op byte, Src Picture, Dst Picture, Ma
 	return buf
 }
 
-// CompositeGlyphs8Cookie is a cookie used only for CompositeGlyphs8 requests.
-type CompositeGlyphs8Cookie struct {
-	*xgb.Cookie
+// CompositeGlyphs32Request represents the data of a CompositeGlyphs32 request, as it is read when tracing a connection.
+type CompositeGlyphs32Request struct {
+	Op PictOp
+	// padding: 3 bytes
+	Src        Picture
+	Dst        Picture
+	MaskFormat Pictformat
+	Glyphset   Glyphset
+	SrcX       int16
+	SrcY       int16
+	Glyphcmds  []byte // size: xgb.Pad((len(Glyphcmds) * 1))
 }
 
-// CompositeGlyphs8 sends an unchecked request.
-// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func CompositeGlyphs8(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictformat, Glyphset Glyphset, SrcX int16, SrcY int16, Glyphcmds []byte) CompositeGlyphs8Cookie {
-	c.ExtLock.RLock()
-	defer c.ExtLock.RUnlock()
-	if _, ok := c.Extensions["RENDER"]; !ok {
-		panic("Cannot issue request 'CompositeGlyphs8' using the uninitialized extension 'RENDER'. render.Init(connObj) must be called first.")
-	}
-	cookie := c.NewCookie(false, false)
-	c.NewRequest(compositeGlyphs8Request(c, Op, Src, Dst, MaskFormat, Glyphset, SrcX, SrcY, Glyphcmds), cookie)
-	return CompositeGlyphs8Cookie{cookie}
-}
+// compositeGlyphs32RequestRead reads a byte slice in the byte order 'order' into a CompositeGlyphs32Request value.
+func compositeGlyphs32RequestRead(buf []byte, order xgb.ByteOrder) *CompositeGlyphs32Request {
+	v := new(CompositeGlyphs32Request)
+	b := 4 // skip request header
 
-// CompositeGlyphs8Checked sends a checked request.
-// If an error occurs, it can be retrieved using CompositeGlyphs8Cookie.Check()
-func CompositeGlyphs8Checked(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictformat, Glyphset Glyphset, SrcX int16, SrcY int16, Glyphcmds []byte) CompositeGlyphs8Cookie {
-	c.ExtLock.RLock()
-	defer c.ExtLock.RUnlock()
+	v.Op = PictOp(buf[b])
+	b += 1
+
+	b += 3 // padding
+
+	v.Src = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.Dst = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.MaskFormat = Pictformat(order.Get32(buf[b:]))
+	b += 4
+
+	v.Glyphset = Glyphset(order.Get32(buf[b:]))
+	b += 4
+
+	v.SrcX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.SrcY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Glyphcmds = make([]byte, (len(buf)-b)/1)
+	copy(v.Glyphcmds[:(len(buf)-b)/1], buf[b:])
+	b += int((len(buf) - b) / 1)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][25] = xgb.RequestInfo{
+		Name: "CompositeGlyphs32",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return compositeGlyphs32RequestRead(buf, order)
+		},
+	}
+}
+
+// CompositeGlyphs8Cookie is a cookie used only for CompositeGlyphs8 requests.
+type CompositeGlyphs8Cookie struct {
+	*xgb.Cookie
+}
+
+// CompositeGlyphs8 sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func CompositeGlyphs8(c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, Glyphset Glyphset, SrcX int16, SrcY int16, Glyphcmds []byte) CompositeGlyphs8Cookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["RENDER"]; !ok {
+		panic("Cannot issue request 'CompositeGlyphs8' using the uninitialized extension 'RENDER'. render.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequest(compositeGlyphs8Request(c, Op, Src, Dst, MaskFormat, Glyphset, SrcX, SrcY, Glyphcmds), cookie)
+	return CompositeGlyphs8Cookie{cookie}
+}
+
+// CompositeGlyphs8Checked sends a checked request.
+// If an error occurs, it can be retrieved using CompositeGlyphs8Cookie.Check()
+func CompositeGlyphs8Checked(c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, Glyphset Glyphset, SrcX int16, SrcY int16, Glyphcmds []byte) CompositeGlyphs8Cookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RENDER"]; !ok {
 		panic("Cannot issue request 'CompositeGlyphs8' using the uninitialized extension 'RENDER'. render.Init(connObj) must be called first.")
 	}
@@ -2090,12 +2835,26 @@ func (cook CompositeGlyphs8Cookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CompositeGlyphs8Cookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CompositeGlyphs8
 // compositeGlyphs8Request writes a CompositeGlyphs8 request to a byte slice.
-func compositeGlyphs8Request(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictformat, Glyphset Glyphset, SrcX int16, SrcY int16, Glyphcmds []byte) []byte {
+func compositeGlyphs8Request(c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, Glyphset Glyphset, SrcX int16, SrcY int16, Glyphcmds []byte) []byte {
+	return AppendCompositeGlyphs8Request(nil, c, Op, Src, Dst, MaskFormat, Glyphset, SrcX, SrcY, Glyphcmds)
+}
+
+// AppendCompositeGlyphs8Request appends a CompositeGlyphs8 request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCompositeGlyphs8Request(buf []byte, c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, Glyphset Glyphset, SrcX int16, SrcY int16, Glyphcmds []byte) []byte {
 	size := xgb.Pad((28 + xgb.Pad((len(Glyphcmds) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -2105,30 +2864,30 @@ func compositeGlyphs8Request(c *xgb.Conn, Op byte, Src Picture, Dst Picture, Mas
 	buf[b] = 23 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	buf[b] = Op
+	buf[b] = byte(Op)
 	b += 1
 
 	b += 3 // padding
 
-	xgb.Put32(buf[b:], uint32(Src))
+	order.Put32(buf[b:], uint32(Src))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Dst))
+	order.Put32(buf[b:], uint32(Dst))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(MaskFormat))
+	order.Put32(buf[b:], uint32(MaskFormat))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Glyphset))
+	order.Put32(buf[b:], uint32(Glyphset))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(SrcX))
+	order.Put16(buf[b:], uint16(SrcX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(SrcY))
+	order.Put16(buf[b:], uint16(SrcY))
 	b += 2
 
 	copy(buf[b:], Glyphcmds[:len(Glyphcmds)])
@@ -2137,6 +2896,63 @@ func compositeGlyphs8Request(c *xgb.Conn, Op byte, Src Picture, Dst Picture, Mas
 	return buf
 }
 
+// CompositeGlyphs8Request represents the data of a CompositeGlyphs8 request, as it is read when tracing a connection.
+type CompositeGlyphs8Request struct {
+	Op PictOp
+	// padding: 3 bytes
+	Src        Picture
+	Dst        Picture
+	MaskFormat Pictformat
+	Glyphset   Glyphset
+	SrcX       int16
+	SrcY       int16
+	Glyphcmds  []byte // size: xgb.Pad((len(Glyphcmds) * 1))
+}
+
+// compositeGlyphs8RequestRead reads a byte slice in the byte order 'order' into a CompositeGlyphs8Request value.
+func compositeGlyphs8RequestRead(buf []byte, order xgb.ByteOrder) *CompositeGlyphs8Request {
+	v := new(CompositeGlyphs8Request)
+	b := 4 // skip request header
+
+	v.Op = PictOp(buf[b])
+	b += 1
+
+	b += 3 // padding
+
+	v.Src = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.Dst = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.MaskFormat = Pictformat(order.Get32(buf[b:]))
+	b += 4
+
+	v.Glyphset = Glyphset(order.Get32(buf[b:]))
+	b += 4
+
+	v.SrcX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.SrcY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Glyphcmds = make([]byte, (len(buf)-b)/1)
+	copy(v.Glyphcmds[:(len(buf)-b)/1], buf[b:])
+	b += int((len(buf) - b) / 1)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][23] = xgb.RequestInfo{
+		Name: "CompositeGlyphs8",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return compositeGlyphs8RequestRead(buf, order)
+		},
+	}
+}
+
 // CreateAnimCursorCookie is a cookie used only for CreateAnimCursor requests.
 type CreateAnimCursorCookie struct {
 	*xgb.Cookie
@@ -2174,12 +2990,26 @@ func (cook CreateAnimCursorCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateAnimCursorCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateAnimCursor
 // createAnimCursorRequest writes a CreateAnimCursor request to a byte slice.
 func createAnimCursorRequest(c *xgb.Conn, Cid xproto.Cursor, Cursors []Animcursorelt) []byte {
+	return AppendCreateAnimCursorRequest(nil, c, Cid, Cursors)
+}
+
+// AppendCreateAnimCursorRequest appends a CreateAnimCursor request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateAnimCursorRequest(buf []byte, c *xgb.Conn, Cid xproto.Cursor, Cursors []Animcursorelt) []byte {
 	size := xgb.Pad((8 + xgb.Pad((len(Cursors) * 8))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -2189,17 +3019,46 @@ func createAnimCursorRequest(c *xgb.Conn, Cid xproto.Cursor, Cursors []Animcurso
 	buf[b] = 31 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Cid))
+	order.Put32(buf[b:], uint32(Cid))
 	b += 4
 
-	b += AnimcursoreltListBytes(buf[b:], Cursors)
+	b += AnimcursoreltListBytes(buf[b:], order, Cursors)
 
 	return buf
 }
 
+// CreateAnimCursorRequest represents the data of a CreateAnimCursor request, as it is read when tracing a connection.
+type CreateAnimCursorRequest struct {
+	Cid     xproto.Cursor
+	Cursors []Animcursorelt // size: xgb.Pad((len(Cursors) * 8))
+}
+
+// createAnimCursorRequestRead reads a byte slice in the byte order 'order' into a CreateAnimCursorRequest value.
+func createAnimCursorRequestRead(buf []byte, order xgb.ByteOrder) *CreateAnimCursorRequest {
+	v := new(CreateAnimCursorRequest)
+	b := 4 // skip request header
+
+	v.Cid = xproto.Cursor(order.Get32(buf[b:]))
+	b += 4
+
+	v.Cursors = make([]Animcursorelt, (len(buf)-b)/8)
+	b += AnimcursoreltReadList(buf[b:], order, v.Cursors)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][31] = xgb.RequestInfo{
+		Name: "CreateAnimCursor",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createAnimCursorRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateConicalGradientCookie is a cookie used only for CreateConicalGradient requests.
 type CreateConicalGradientCookie struct {
 	*xgb.Cookie
@@ -2237,12 +3096,26 @@ func (cook CreateConicalGradientCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateConicalGradientCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateConicalGradient
 // createConicalGradientRequest writes a CreateConicalGradient request to a byte slice.
 func createConicalGradientRequest(c *xgb.Conn, Picture Picture, Center Pointfix, Angle Fixed, NumStops uint32, Stops []Fixed, Colors []Color) []byte {
+	return AppendCreateConicalGradientRequest(nil, c, Picture, Center, Angle, NumStops, Stops, Colors)
+}
+
+// AppendCreateConicalGradientRequest appends a CreateConicalGradient request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateConicalGradientRequest(buf []byte, c *xgb.Conn, Picture Picture, Center Pointfix, Angle Fixed, NumStops uint32, Stops []Fixed, Colors []Color) []byte {
 	size := xgb.Pad((((24 + xgb.Pad((int(NumStops) * 4))) + 4) + xgb.Pad((int(NumStops) * 8))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -2255,35 +3128,86 @@ func createConicalGradientRequest(c *xgb.Conn, Picture Picture, Center Pointfix,
 	blen := b
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Picture))
+	order.Put32(buf[b:], uint32(Picture))
 	b += 4
 
 	{
-		structBytes := Center.Bytes()
+		structBytes := Center.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
-	xgb.Put32(buf[b:], uint32(Angle))
+	order.Put32(buf[b:], uint32(Angle))
 	b += 4
 
-	xgb.Put32(buf[b:], NumStops)
+	order.Put32(buf[b:], NumStops)
 	b += 4
 
 	for i := 0; i < int(NumStops); i++ {
-		xgb.Put32(buf[b:], uint32(Stops[i]))
+		order.Put32(buf[b:], uint32(Stops[i]))
 		b += 4
 	}
 
 	b = (b + 3) & ^3 // alignment gap
 
-	b += ColorListBytes(buf[b:], Colors)
+	b += ColorListBytes(buf[b:], order, Colors)
 
-	b = xgb.Pad(b)
-	xgb.Put16(buf[blen:], uint16(b/4)) // write request size in 4-byte units
+	b = start + xgb.Pad(b-start)
+	order.Put16(buf[blen:], uint16((b-start)/4)) // write request size in 4-byte units
 	return buf[:b]
 }
 
+// CreateConicalGradientRequest represents the data of a CreateConicalGradient request, as it is read when tracing a connection.
+type CreateConicalGradientRequest struct {
+	Picture  Picture
+	Center   Pointfix
+	Angle    Fixed
+	NumStops uint32
+	Stops    []Fixed // size: xgb.Pad((int(NumStops) * 4))
+	// alignment gap to multiple of 4
+	Colors []Color // size: xgb.Pad((int(NumStops) * 8))
+}
+
+// createConicalGradientRequestRead reads a byte slice in the byte order 'order' into a CreateConicalGradientRequest value.
+func createConicalGradientRequestRead(buf []byte, order xgb.ByteOrder) *CreateConicalGradientRequest {
+	v := new(CreateConicalGradientRequest)
+	b := 4 // skip request header
+
+	v.Picture = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.Center = Pointfix{}
+	b += PointfixRead(buf[b:], order, &v.Center)
+
+	v.Angle = Fixed(order.Get32(buf[b:]))
+	b += 4
+
+	v.NumStops = order.Get32(buf[b:])
+	b += 4
+
+	v.Stops = make([]Fixed, v.NumStops)
+	for i := 0; i < int(v.NumStops); i++ {
+		v.Stops[i] = Fixed(order.Get32(buf[b:]))
+		b += 4
+	}
+
+	b = (b + 3) & ^3 // alignment gap
+
+	v.Colors = make([]Color, v.NumStops)
+	b += ColorReadList(buf[b:], order, v.Colors)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][36] = xgb.RequestInfo{
+		Name: "CreateConicalGradient",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createConicalGradientRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateCursorCookie is a cookie used only for CreateCursor requests.
 type CreateCursorCookie struct {
 	*xgb.Cookie
@@ -2321,12 +3245,26 @@ func (cook CreateCursorCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateCursorCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateCursor
 // createCursorRequest writes a CreateCursor request to a byte slice.
 func createCursorRequest(c *xgb.Conn, Cid xproto.Cursor, Source Picture, X uint16, Y uint16) []byte {
+	return AppendCreateCursorRequest(nil, c, Cid, Source, X, Y)
+}
+
+// AppendCreateCursorRequest appends a CreateCursor request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateCursorRequest(buf []byte, c *xgb.Conn, Cid xproto.Cursor, Source Picture, X uint16, Y uint16) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -2336,24 +3274,61 @@ func createCursorRequest(c *xgb.Conn, Cid xproto.Cursor, Source Picture, X uint1
 	buf[b] = 27 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Cid))
+	order.Put32(buf[b:], uint32(Cid))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Source))
+	order.Put32(buf[b:], uint32(Source))
 	b += 4
 
-	xgb.Put16(buf[b:], X)
+	order.Put16(buf[b:], X)
 	b += 2
 
-	xgb.Put16(buf[b:], Y)
+	order.Put16(buf[b:], Y)
 	b += 2
 
 	return buf
 }
 
+// CreateCursorRequest represents the data of a CreateCursor request, as it is read when tracing a connection.
+type CreateCursorRequest struct {
+	Cid    xproto.Cursor
+	Source Picture
+	X      uint16
+	Y      uint16
+}
+
+// createCursorRequestRead reads a byte slice in the byte order 'order' into a CreateCursorRequest value.
+func createCursorRequestRead(buf []byte, order xgb.ByteOrder) *CreateCursorRequest {
+	v := new(CreateCursorRequest)
+	b := 4 // skip request header
+
+	v.Cid = xproto.Cursor(order.Get32(buf[b:]))
+	b += 4
+
+	v.Source = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.X = order.Get16(buf[b:])
+	b += 2
+
+	v.Y = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][27] = xgb.RequestInfo{
+		Name: "CreateCursor",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createCursorRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateGlyphSetCookie is a cookie used only for CreateGlyphSet requests.
 type CreateGlyphSetCookie struct {
 	*xgb.Cookie
@@ -2391,12 +3366,26 @@ func (cook CreateGlyphSetCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateGlyphSetCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateGlyphSet
 // createGlyphSetRequest writes a CreateGlyphSet request to a byte slice.
 func createGlyphSetRequest(c *xgb.Conn, Gsid Glyphset, Format Pictformat) []byte {
+	return AppendCreateGlyphSetRequest(nil, c, Gsid, Format)
+}
+
+// AppendCreateGlyphSetRequest appends a CreateGlyphSet request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateGlyphSetRequest(buf []byte, c *xgb.Conn, Gsid Glyphset, Format Pictformat) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -2406,18 +3395,47 @@ func createGlyphSetRequest(c *xgb.Conn, Gsid Glyphset, Format Pictformat) []byte
 	buf[b] = 17 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Gsid))
+	order.Put32(buf[b:], uint32(Gsid))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Format))
+	order.Put32(buf[b:], uint32(Format))
 	b += 4
 
 	return buf
 }
 
+// CreateGlyphSetRequest represents the data of a CreateGlyphSet request, as it is read when tracing a connection.
+type CreateGlyphSetRequest struct {
+	Gsid   Glyphset
+	Format Pictformat
+}
+
+// createGlyphSetRequestRead reads a byte slice in the byte order 'order' into a CreateGlyphSetRequest value.
+func createGlyphSetRequestRead(buf []byte, order xgb.ByteOrder) *CreateGlyphSetRequest {
+	v := new(CreateGlyphSetRequest)
+	b := 4 // skip request header
+
+	v.Gsid = Glyphset(order.Get32(buf[b:]))
+	b += 4
+
+	v.Format = Pictformat(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][17] = xgb.RequestInfo{
+		Name: "CreateGlyphSet",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createGlyphSetRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateLinearGradientCookie is a cookie used only for CreateLinearGradient requests.
 type CreateLinearGradientCookie struct {
 	*xgb.Cookie
@@ -2455,12 +3473,26 @@ func (cook CreateLinearGradientCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateLinearGradientCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateLinearGradient
 // createLinearGradientRequest writes a CreateLinearGradient request to a byte slice.
 func createLinearGradientRequest(c *xgb.Conn, Picture Picture, P1 Pointfix, P2 Pointfix, NumStops uint32, Stops []Fixed, Colors []Color) []byte {
+	return AppendCreateLinearGradientRequest(nil, c, Picture, P1, P2, NumStops, Stops, Colors)
+}
+
+// AppendCreateLinearGradientRequest appends a CreateLinearGradient request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateLinearGradientRequest(buf []byte, c *xgb.Conn, Picture Picture, P1 Pointfix, P2 Pointfix, NumStops uint32, Stops []Fixed, Colors []Color) []byte {
 	size := xgb.Pad((((28 + xgb.Pad((int(NumStops) * 4))) + 4) + xgb.Pad((int(NumStops) * 8))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -2473,38 +3505,89 @@ func createLinearGradientRequest(c *xgb.Conn, Picture Picture, P1 Pointfix, P2 P
 	blen := b
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Picture))
+	order.Put32(buf[b:], uint32(Picture))
 	b += 4
 
 	{
-		structBytes := P1.Bytes()
+		structBytes := P1.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
 	{
-		structBytes := P2.Bytes()
+		structBytes := P2.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
-	xgb.Put32(buf[b:], NumStops)
+	order.Put32(buf[b:], NumStops)
 	b += 4
 
 	for i := 0; i < int(NumStops); i++ {
-		xgb.Put32(buf[b:], uint32(Stops[i]))
+		order.Put32(buf[b:], uint32(Stops[i]))
 		b += 4
 	}
 
 	b = (b + 3) & ^3 // alignment gap
 
-	b += ColorListBytes(buf[b:], Colors)
+	b += ColorListBytes(buf[b:], order, Colors)
 
-	b = xgb.Pad(b)
-	xgb.Put16(buf[blen:], uint16(b/4)) // write request size in 4-byte units
+	b = start + xgb.Pad(b-start)
+	order.Put16(buf[blen:], uint16((b-start)/4)) // write request size in 4-byte units
 	return buf[:b]
 }
 
+// CreateLinearGradientRequest represents the data of a CreateLinearGradient request, as it is read when tracing a connection.
+type CreateLinearGradientRequest struct {
+	Picture  Picture
+	P1       Pointfix
+	P2       Pointfix
+	NumStops uint32
+	Stops    []Fixed // size: xgb.Pad((int(NumStops) * 4))
+	// alignment gap to multiple of 4
+	Colors []Color // size: xgb.Pad((int(NumStops) * 8))
+}
+
+// createLinearGradientRequestRead reads a byte slice in the byte order 'order' into a CreateLinearGradientRequest value.
+func createLinearGradientRequestRead(buf []byte, order xgb.ByteOrder) *CreateLinearGradientRequest {
+	v := new(CreateLinearGradientRequest)
+	b := 4 // skip request header
+
+	v.Picture = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.P1 = Pointfix{}
+	b += PointfixRead(buf[b:], order, &v.P1)
+
+	v.P2 = Pointfix{}
+	b += PointfixRead(buf[b:], order, &v.P2)
+
+	v.NumStops = order.Get32(buf[b:])
+	b += 4
+
+	v.Stops = make([]Fixed, v.NumStops)
+	for i := 0; i < int(v.NumStops); i++ {
+		v.Stops[i] = Fixed(order.Get32(buf[b:]))
+		b += 4
+	}
+
+	b = (b + 3) & ^3 // alignment gap
+
+	v.Colors = make([]Color, v.NumStops)
+	b += ColorReadList(buf[b:], order, v.Colors)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][34] = xgb.RequestInfo{
+		Name: "CreateLinearGradient",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createLinearGradientRequestRead(buf, order)
+		},
+	}
+}
+
 // CreatePictureCookie is a cookie used only for CreatePicture requests.
 type CreatePictureCookie struct {
 	*xgb.Cookie
@@ -2512,7 +3595,7 @@ type CreatePictureCookie struct {
 
 // CreatePicture sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func CreatePicture(c *xgb.Conn, Pid Picture, Drawable xproto.Drawable, Format Pictformat, ValueMask uint32, ValueList []uint32) CreatePictureCookie {
+func CreatePicture(c *xgb.Conn, Pid Picture, Drawable xproto.Drawable, Format Pictformat, ValueMask Cp, ValueList []uint32) CreatePictureCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RENDER"]; !ok {
@@ -2525,7 +3608,7 @@ func CreatePicture(c *xgb.Conn, Pid Picture, Drawable xproto.Drawable, Format Pi
 
 // CreatePictureChecked sends a checked request.
 // If an error occurs, it can be retrieved using CreatePictureCookie.Check()
-func CreatePictureChecked(c *xgb.Conn, Pid Picture, Drawable xproto.Drawable, Format Pictformat, ValueMask uint32, ValueList []uint32) CreatePictureCookie {
+func CreatePictureChecked(c *xgb.Conn, Pid Picture, Drawable xproto.Drawable, Format Pictformat, ValueMask Cp, ValueList []uint32) CreatePictureCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RENDER"]; !ok {
@@ -2542,12 +3625,26 @@ func (cook CreatePictureCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreatePictureCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreatePicture
 // createPictureRequest writes a CreatePicture request to a byte slice.
-func createPictureRequest(c *xgb.Conn, Pid Picture, Drawable xproto.Drawable, Format Pictformat, ValueMask uint32, ValueList []uint32) []byte {
+func createPictureRequest(c *xgb.Conn, Pid Picture, Drawable xproto.Drawable, Format Pictformat, ValueMask Cp, ValueList []uint32) []byte {
+	return AppendCreatePictureRequest(nil, c, Pid, Drawable, Format, ValueMask, ValueList)
+}
+
+// AppendCreatePictureRequest appends a CreatePicture request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreatePictureRequest(buf []byte, c *xgb.Conn, Pid Picture, Drawable xproto.Drawable, Format Pictformat, ValueMask Cp, ValueList []uint32) []byte {
 	size := xgb.Pad((16 + (4 + xgb.Pad((4 * xgb.PopCount(int(ValueMask)))))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -2557,22 +3654,22 @@ func createPictureRequest(c *xgb.Conn, Pid Picture, Drawable xproto.Drawable, Fo
 	buf[b] = 4 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Pid))
+	order.Put32(buf[b:], uint32(Pid))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Format))
+	order.Put32(buf[b:], uint32(Format))
 	b += 4
 
-	xgb.Put32(buf[b:], ValueMask)
+	order.Put32(buf[b:], uint32(ValueMask))
 	b += 4
 	for i := 0; i < xgb.PopCount(int(ValueMask)); i++ {
-		xgb.Put32(buf[b:], ValueList[i])
+		order.Put32(buf[b:], ValueList[i])
 		b += 4
 	}
 	b = xgb.Pad(b)
@@ -2580,6 +3677,51 @@ func createPictureRequest(c *xgb.Conn, Pid Picture, Drawable xproto.Drawable, Fo
 	return buf
 }
 
+// CreatePictureRequest represents the data of a CreatePicture request, as it is read when tracing a connection.
+type CreatePictureRequest struct {
+	Pid       Picture
+	Drawable  xproto.Drawable
+	Format    Pictformat
+	ValueMask Cp
+	ValueList []uint32
+}
+
+// createPictureRequestRead reads a byte slice in the byte order 'order' into a CreatePictureRequest value.
+func createPictureRequestRead(buf []byte, order xgb.ByteOrder) *CreatePictureRequest {
+	v := new(CreatePictureRequest)
+	b := 4 // skip request header
+
+	v.Pid = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Format = Pictformat(order.Get32(buf[b:]))
+	b += 4
+
+	v.ValueMask = Cp(order.Get32(buf[b:]))
+	b += 4
+
+	v.ValueList = make([]uint32, xgb.PopCount(int(v.ValueMask)))
+	for i := 0; i < xgb.PopCount(int(v.ValueMask)); i++ {
+		v.ValueList[i] = order.Get32(buf[b:])
+		b += 4
+	}
+	b = xgb.Pad(b)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][4] = xgb.RequestInfo{
+		Name: "CreatePicture",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createPictureRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateRadialGradientCookie is a cookie used only for CreateRadialGradient requests.
 type CreateRadialGradientCookie struct {
 	*xgb.Cookie
@@ -2617,12 +3759,26 @@ func (cook CreateRadialGradientCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateRadialGradientCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateRadialGradient
 // createRadialGradientRequest writes a CreateRadialGradient request to a byte slice.
 func createRadialGradientRequest(c *xgb.Conn, Picture Picture, Inner Pointfix, Outer Pointfix, InnerRadius Fixed, OuterRadius Fixed, NumStops uint32, Stops []Fixed, Colors []Color) []byte {
+	return AppendCreateRadialGradientRequest(nil, c, Picture, Inner, Outer, InnerRadius, OuterRadius, NumStops, Stops, Colors)
+}
+
+// AppendCreateRadialGradientRequest appends a CreateRadialGradient request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateRadialGradientRequest(buf []byte, c *xgb.Conn, Picture Picture, Inner Pointfix, Outer Pointfix, InnerRadius Fixed, OuterRadius Fixed, NumStops uint32, Stops []Fixed, Colors []Color) []byte {
 	size := xgb.Pad((((36 + xgb.Pad((int(NumStops) * 4))) + 4) + xgb.Pad((int(NumStops) * 8))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -2635,44 +3791,103 @@ func createRadialGradientRequest(c *xgb.Conn, Picture Picture, Inner Pointfix, O
 	blen := b
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Picture))
+	order.Put32(buf[b:], uint32(Picture))
 	b += 4
 
 	{
-		structBytes := Inner.Bytes()
+		structBytes := Inner.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
 	{
-		structBytes := Outer.Bytes()
+		structBytes := Outer.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
-	xgb.Put32(buf[b:], uint32(InnerRadius))
+	order.Put32(buf[b:], uint32(InnerRadius))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(OuterRadius))
+	order.Put32(buf[b:], uint32(OuterRadius))
 	b += 4
 
-	xgb.Put32(buf[b:], NumStops)
+	order.Put32(buf[b:], NumStops)
 	b += 4
 
 	for i := 0; i < int(NumStops); i++ {
-		xgb.Put32(buf[b:], uint32(Stops[i]))
+		order.Put32(buf[b:], uint32(Stops[i]))
 		b += 4
 	}
 
 	b = (b + 3) & ^3 // alignment gap
 
-	b += ColorListBytes(buf[b:], Colors)
+	b += ColorListBytes(buf[b:], order, Colors)
 
-	b = xgb.Pad(b)
-	xgb.Put16(buf[blen:], uint16(b/4)) // write request size in 4-byte units
+	b = start + xgb.Pad(b-start)
+	order.Put16(buf[blen:], uint16((b-start)/4)) // write request size in 4-byte units
 	return buf[:b]
 }
 
+// CreateRadialGradientRequest represents the data of a CreateRadialGradient request, as it is read when tracing a connection.
+type CreateRadialGradientRequest struct {
+	Picture     Picture
+	Inner       Pointfix
+	Outer       Pointfix
+	InnerRadius Fixed
+	OuterRadius Fixed
+	NumStops    uint32
+	Stops       []Fixed // size: xgb.Pad((int(NumStops) * 4))
+	// alignment gap to multiple of 4
+	Colors []Color // size: xgb.Pad((int(NumStops) * 8))
+}
+
+// createRadialGradientRequestRead reads a byte slice in the byte order 'order' into a CreateRadialGradientRequest value.
+func createRadialGradientRequestRead(buf []byte, order xgb.ByteOrder) *CreateRadialGradientRequest {
+	v := new(CreateRadialGradientRequest)
+	b := 4 // skip request header
+
+	v.Picture = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.Inner = Pointfix{}
+	b += PointfixRead(buf[b:], order, &v.Inner)
+
+	v.Outer = Pointfix{}
+	b += PointfixRead(buf[b:], order, &v.Outer)
+
+	v.InnerRadius = Fixed(order.Get32(buf[b:]))
+	b += 4
+
+	v.OuterRadius = Fixed(order.Get32(buf[b:]))
+	b += 4
+
+	v.NumStops = order.Get32(buf[b:])
+	b += 4
+
+	v.Stops = make([]Fixed, v.NumStops)
+	for i := 0; i < int(v.NumStops); i++ {
+		v.Stops[i] = Fixed(order.Get32(buf[b:]))
+		b += 4
+	}
+
+	b = (b + 3) & ^3 // alignment gap
+
+	v.Colors = make([]Color, v.NumStops)
+	b += ColorReadList(buf[b:], order, v.Colors)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][35] = xgb.RequestInfo{
+		Name: "CreateRadialGradient",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createRadialGradientRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateSolidFillCookie is a cookie used only for CreateSolidFill requests.
 type CreateSolidFillCookie struct {
 	*xgb.Cookie
@@ -2710,12 +3925,26 @@ func (cook CreateSolidFillCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateSolidFillCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateSolidFill
 // createSolidFillRequest writes a CreateSolidFill request to a byte slice.
 func createSolidFillRequest(c *xgb.Conn, Picture Picture, Color Color) []byte {
+	return AppendCreateSolidFillRequest(nil, c, Picture, Color)
+}
+
+// AppendCreateSolidFillRequest appends a CreateSolidFill request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateSolidFillRequest(buf []byte, c *xgb.Conn, Picture Picture, Color Color) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -2725,14 +3954,14 @@ func createSolidFillRequest(c *xgb.Conn, Picture Picture, Color Color) []byte {
 	buf[b] = 33 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Picture))
+	order.Put32(buf[b:], uint32(Picture))
 	b += 4
 
 	{
-		structBytes := Color.Bytes()
+		structBytes := Color.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -2740,6 +3969,35 @@ func createSolidFillRequest(c *xgb.Conn, Picture Picture, Color Color) []byte {
 	return buf
 }
 
+// CreateSolidFillRequest represents the data of a CreateSolidFill request, as it is read when tracing a connection.
+type CreateSolidFillRequest struct {
+	Picture Picture
+	Color   Color
+}
+
+// createSolidFillRequestRead reads a byte slice in the byte order 'order' into a CreateSolidFillRequest value.
+func createSolidFillRequestRead(buf []byte, order xgb.ByteOrder) *CreateSolidFillRequest {
+	v := new(CreateSolidFillRequest)
+	b := 4 // skip request header
+
+	v.Picture = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.Color = Color{}
+	b += ColorRead(buf[b:], order, &v.Color)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][33] = xgb.RequestInfo{
+		Name: "CreateSolidFill",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createSolidFillRequestRead(buf, order)
+		},
+	}
+}
+
 // FillRectanglesCookie is a cookie used only for FillRectangles requests.
 type FillRectanglesCookie struct {
 	*xgb.Cookie
@@ -2747,7 +4005,7 @@ type FillRectanglesCookie struct {
 
 // FillRectangles sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func FillRectangles(c *xgb.Conn, Op byte, Dst Picture, Color Color, Rects []xproto.Rectangle) FillRectanglesCookie {
+func FillRectangles(c *xgb.Conn, Op PictOp, Dst Picture, Color Color, Rects []xproto.Rectangle) FillRectanglesCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RENDER"]; !ok {
@@ -2760,7 +4018,7 @@ func FillRectangles(c *xgb.Conn, Op byte, Dst Picture, Color Color, Rects []xpro
 
 // FillRectanglesChecked sends a checked request.
 // If an error occurs, it can be retrieved using FillRectanglesCookie.Check()
-func FillRectanglesChecked(c *xgb.Conn, Op byte, Dst Picture, Color Color, Rects []xproto.Rectangle) FillRectanglesCookie {
+func FillRectanglesChecked(c *xgb.Conn, Op PictOp, Dst Picture, Color Color, Rects []xproto.Rectangle) FillRectanglesCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RENDER"]; !ok {
@@ -2777,12 +4035,26 @@ func (cook FillRectanglesCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook FillRectanglesCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for FillRectangles
 // fillRectanglesRequest writes a FillRectangles request to a byte slice.
-func fillRectanglesRequest(c *xgb.Conn, Op byte, Dst Picture, Color Color, Rects []xproto.Rectangle) []byte {
+func fillRectanglesRequest(c *xgb.Conn, Op PictOp, Dst Picture, Color Color, Rects []xproto.Rectangle) []byte {
+	return AppendFillRectanglesRequest(nil, c, Op, Dst, Color, Rects)
+}
+
+// AppendFillRectanglesRequest appends a FillRectangles request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendFillRectanglesRequest(buf []byte, c *xgb.Conn, Op PictOp, Dst Picture, Color Color, Rects []xproto.Rectangle) []byte {
 	size := xgb.Pad((20 + xgb.Pad((len(Rects) * 8))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -2792,28 +4064,68 @@ func fillRectanglesRequest(c *xgb.Conn, Op byte, Dst Picture, Color Color, Rects
 	buf[b] = 26 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	buf[b] = Op
+	buf[b] = byte(Op)
 	b += 1
 
 	b += 3 // padding
 
-	xgb.Put32(buf[b:], uint32(Dst))
+	order.Put32(buf[b:], uint32(Dst))
 	b += 4
 
 	{
-		structBytes := Color.Bytes()
+		structBytes := Color.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
-	b += xproto.RectangleListBytes(buf[b:], Rects)
+	b += xproto.RectangleListBytes(buf[b:], order, Rects)
 
 	return buf
 }
 
+// FillRectanglesRequest represents the data of a FillRectangles request, as it is read when tracing a connection.
+type FillRectanglesRequest struct {
+	Op PictOp
+	// padding: 3 bytes
+	Dst   Picture
+	Color Color
+	Rects []xproto.Rectangle // size: xgb.Pad((len(Rects) * 8))
+}
+
+// fillRectanglesRequestRead reads a byte slice in the byte order 'order' into a FillRectanglesRequest value.
+func fillRectanglesRequestRead(buf []byte, order xgb.ByteOrder) *FillRectanglesRequest {
+	v := new(FillRectanglesRequest)
+	b := 4 // skip request header
+
+	v.Op = PictOp(buf[b])
+	b += 1
+
+	b += 3 // padding
+
+	v.Dst = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.Color = Color{}
+	b += ColorRead(buf[b:], order, &v.Color)
+
+	v.Rects = make([]xproto.Rectangle, (len(buf)-b)/8)
+	b += xproto.RectangleReadList(buf[b:], order, v.Rects)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][26] = xgb.RequestInfo{
+		Name: "FillRectangles",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return fillRectanglesRequestRead(buf, order)
+		},
+	}
+}
+
 // FreeGlyphSetCookie is a cookie used only for FreeGlyphSet requests.
 type FreeGlyphSetCookie struct {
 	*xgb.Cookie
@@ -2851,12 +4163,26 @@ func (cook FreeGlyphSetCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook FreeGlyphSetCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for FreeGlyphSet
 // freeGlyphSetRequest writes a FreeGlyphSet request to a byte slice.
 func freeGlyphSetRequest(c *xgb.Conn, Glyphset Glyphset) []byte {
+	return AppendFreeGlyphSetRequest(nil, c, Glyphset)
+}
+
+// AppendFreeGlyphSetRequest appends a FreeGlyphSet request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendFreeGlyphSetRequest(buf []byte, c *xgb.Conn, Glyphset Glyphset) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -2866,15 +4192,40 @@ func freeGlyphSetRequest(c *xgb.Conn, Glyphset Glyphset) []byte {
 	buf[b] = 19 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Glyphset))
+	order.Put32(buf[b:], uint32(Glyphset))
 	b += 4
 
 	return buf
 }
 
+// FreeGlyphSetRequest represents the data of a FreeGlyphSet request, as it is read when tracing a connection.
+type FreeGlyphSetRequest struct {
+	Glyphset Glyphset
+}
+
+// freeGlyphSetRequestRead reads a byte slice in the byte order 'order' into a FreeGlyphSetRequest value.
+func freeGlyphSetRequestRead(buf []byte, order xgb.ByteOrder) *FreeGlyphSetRequest {
+	v := new(FreeGlyphSetRequest)
+	b := 4 // skip request header
+
+	v.Glyphset = Glyphset(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][19] = xgb.RequestInfo{
+		Name: "FreeGlyphSet",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return freeGlyphSetRequestRead(buf, order)
+		},
+	}
+}
+
 // FreeGlyphsCookie is a cookie used only for FreeGlyphs requests.
 type FreeGlyphsCookie struct {
 	*xgb.Cookie
@@ -2912,12 +4263,26 @@ func (cook FreeGlyphsCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook FreeGlyphsCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for FreeGlyphs
 // freeGlyphsRequest writes a FreeGlyphs request to a byte slice.
 func freeGlyphsRequest(c *xgb.Conn, Glyphset Glyphset, Glyphs []Glyph) []byte {
+	return AppendFreeGlyphsRequest(nil, c, Glyphset, Glyphs)
+}
+
+// AppendFreeGlyphsRequest appends a FreeGlyphs request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendFreeGlyphsRequest(buf []byte, c *xgb.Conn, Glyphset Glyphset, Glyphs []Glyph) []byte {
 	size := xgb.Pad((8 + xgb.Pad((len(Glyphs) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -2927,20 +4292,52 @@ func freeGlyphsRequest(c *xgb.Conn, Glyphset Glyphset, Glyphs []Glyph) []byte {
 	buf[b] = 22 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Glyphset))
+	order.Put32(buf[b:], uint32(Glyphset))
 	b += 4
 
 	for i := 0; i < int(len(Glyphs)); i++ {
-		xgb.Put32(buf[b:], uint32(Glyphs[i]))
+		order.Put32(buf[b:], uint32(Glyphs[i]))
 		b += 4
 	}
 
 	return buf
 }
 
+// FreeGlyphsRequest represents the data of a FreeGlyphs request, as it is read when tracing a connection.
+type FreeGlyphsRequest struct {
+	Glyphset Glyphset
+	Glyphs   []Glyph // size: xgb.Pad((len(Glyphs) * 4))
+}
+
+// freeGlyphsRequestRead reads a byte slice in the byte order 'order' into a FreeGlyphsRequest value.
+func freeGlyphsRequestRead(buf []byte, order xgb.ByteOrder) *FreeGlyphsRequest {
+	v := new(FreeGlyphsRequest)
+	b := 4 // skip request header
+
+	v.Glyphset = Glyphset(order.Get32(buf[b:]))
+	b += 4
+
+	v.Glyphs = make([]Glyph, (len(buf)-b)/4)
+	for i := 0; i < int((len(buf)-b)/4); i++ {
+		v.Glyphs[i] = Glyph(order.Get32(buf[b:]))
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][22] = xgb.RequestInfo{
+		Name: "FreeGlyphs",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return freeGlyphsRequestRead(buf, order)
+		},
+	}
+}
+
 // FreePictureCookie is a cookie used only for FreePicture requests.
 type FreePictureCookie struct {
 	*xgb.Cookie
@@ -2978,12 +4375,26 @@ func (cook FreePictureCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook FreePictureCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for FreePicture
 // freePictureRequest writes a FreePicture request to a byte slice.
 func freePictureRequest(c *xgb.Conn, Picture Picture) []byte {
+	return AppendFreePictureRequest(nil, c, Picture)
+}
+
+// AppendFreePictureRequest appends a FreePicture request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendFreePictureRequest(buf []byte, c *xgb.Conn, Picture Picture) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -2993,15 +4404,40 @@ func freePictureRequest(c *xgb.Conn, Picture Picture) []byte {
 	buf[b] = 7 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Picture))
+	order.Put32(buf[b:], uint32(Picture))
 	b += 4
 
 	return buf
 }
 
+// FreePictureRequest represents the data of a FreePicture request, as it is read when tracing a connection.
+type FreePictureRequest struct {
+	Picture Picture
+}
+
+// freePictureRequestRead reads a byte slice in the byte order 'order' into a FreePictureRequest value.
+func freePictureRequestRead(buf []byte, order xgb.ByteOrder) *FreePictureRequest {
+	v := new(FreePictureRequest)
+	b := 4 // skip request header
+
+	v.Picture = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][7] = xgb.RequestInfo{
+		Name: "FreePicture",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return freePictureRequestRead(buf, order)
+		},
+	}
+}
+
 // QueryFiltersCookie is a cookie used only for QueryFilters requests.
 type QueryFiltersCookie struct {
 	*xgb.Cookie
@@ -3054,38 +4490,50 @@ func (cook QueryFiltersCookie) Reply() (*QueryFiltersReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryFiltersReply(buf), nil
+	return queryFiltersReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryFiltersReply reads a byte slice into a QueryFiltersReply value.
-func queryFiltersReply(buf []byte) *QueryFiltersReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryFilters request arrives.
+func (cook QueryFiltersCookie) ReplyContext(ctx context.Context) (*QueryFiltersReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryFiltersReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryFiltersReply reads a byte slice in the byte order 'order' into a QueryFiltersReply value.
+func queryFiltersReply(buf []byte, order xgb.ByteOrder) *QueryFiltersReply {
 	v := new(QueryFiltersReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.NumAliases = xgb.Get32(buf[b:])
+	v.NumAliases = order.Get32(buf[b:])
 	b += 4
 
-	v.NumFilters = xgb.Get32(buf[b:])
+	v.NumFilters = order.Get32(buf[b:])
 	b += 4
 
 	b += 16 // padding
 
 	v.Aliases = make([]uint16, v.NumAliases)
 	for i := 0; i < int(v.NumAliases); i++ {
-		v.Aliases[i] = xgb.Get16(buf[b:])
+		v.Aliases[i] = order.Get16(buf[b:])
 		b += 2
 	}
 
 	v.Filters = make([]xproto.Str, v.NumFilters)
-	b += xproto.StrReadList(buf[b:], v.Filters)
+	b += xproto.StrReadList(buf[b:], order, v.Filters)
 
 	return v
 }
@@ -3093,9 +4541,18 @@ func queryFiltersReply(buf []byte) *QueryFiltersReply {
 // Write request to wire for QueryFilters
 // queryFiltersRequest writes a QueryFilters request to a byte slice.
 func queryFiltersRequest(c *xgb.Conn, Drawable xproto.Drawable) []byte {
+	return AppendQueryFiltersRequest(nil, c, Drawable)
+}
+
+// AppendQueryFiltersRequest appends a QueryFilters request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryFiltersRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -3105,15 +4562,43 @@ func queryFiltersRequest(c *xgb.Conn, Drawable xproto.Drawable) []byte {
 	buf[b] = 29 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
 	return buf
 }
 
+// QueryFiltersRequest represents the data of a QueryFilters request, as it is read when tracing a connection.
+type QueryFiltersRequest struct {
+	Drawable xproto.Drawable
+}
+
+// queryFiltersRequestRead reads a byte slice in the byte order 'order' into a QueryFiltersRequest value.
+func queryFiltersRequestRead(buf []byte, order xgb.ByteOrder) *QueryFiltersRequest {
+	v := new(QueryFiltersRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][29] = xgb.RequestInfo{
+		Name: "QueryFilters",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryFiltersRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryFiltersReply(buf, order)
+		},
+	}
+}
+
 // QueryPictFormatsCookie is a cookie used only for QueryPictFormats requests.
 type QueryPictFormatsCookie struct {
 	*xgb.Cookie
@@ -3172,52 +4657,64 @@ func (cook QueryPictFormatsCookie) Reply() (*QueryPictFormatsReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryPictFormatsReply(buf), nil
+	return queryPictFormatsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryPictFormats request arrives.
+func (cook QueryPictFormatsCookie) ReplyContext(ctx context.Context) (*QueryPictFormatsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryPictFormatsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryPictFormatsReply reads a byte slice into a QueryPictFormatsReply value.
-func queryPictFormatsReply(buf []byte) *QueryPictFormatsReply {
+// queryPictFormatsReply reads a byte slice in the byte order 'order' into a QueryPictFormatsReply value.
+func queryPictFormatsReply(buf []byte, order xgb.ByteOrder) *QueryPictFormatsReply {
 	v := new(QueryPictFormatsReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.NumFormats = xgb.Get32(buf[b:])
+	v.NumFormats = order.Get32(buf[b:])
 	b += 4
 
-	v.NumScreens = xgb.Get32(buf[b:])
+	v.NumScreens = order.Get32(buf[b:])
 	b += 4
 
-	v.NumDepths = xgb.Get32(buf[b:])
+	v.NumDepths = order.Get32(buf[b:])
 	b += 4
 
-	v.NumVisuals = xgb.Get32(buf[b:])
+	v.NumVisuals = order.Get32(buf[b:])
 	b += 4
 
-	v.NumSubpixel = xgb.Get32(buf[b:])
+	v.NumSubpixel = order.Get32(buf[b:])
 	b += 4
 
 	b += 4 // padding
 
 	v.Formats = make([]Pictforminfo, v.NumFormats)
-	b += PictforminfoReadList(buf[b:], v.Formats)
+	b += PictforminfoReadList(buf[b:], order, v.Formats)
 
 	b = (b + 3) & ^3 // alignment gap
 
 	v.Screens = make([]Pictscreen, v.NumScreens)
-	b += PictscreenReadList(buf[b:], v.Screens)
+	b += PictscreenReadList(buf[b:], order, v.Screens)
 
 	b = (b + 3) & ^3 // alignment gap
 
 	v.Subpixels = make([]uint32, v.NumSubpixel)
 	for i := 0; i < int(v.NumSubpixel); i++ {
-		v.Subpixels[i] = xgb.Get32(buf[b:])
+		v.Subpixels[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -3227,9 +4724,18 @@ func queryPictFormatsReply(buf []byte) *QueryPictFormatsReply {
 // Write request to wire for QueryPictFormats
 // queryPictFormatsRequest writes a QueryPictFormats request to a byte slice.
 func queryPictFormatsRequest(c *xgb.Conn) []byte {
+	return AppendQueryPictFormatsRequest(nil, c)
+}
+
+// AppendQueryPictFormatsRequest appends a QueryPictFormats request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryPictFormatsRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -3239,12 +4745,34 @@ func queryPictFormatsRequest(c *xgb.Conn) []byte {
 	buf[b] = 1 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// QueryPictFormatsRequest represents the data of a QueryPictFormats request, as it is read when tracing a connection.
+type QueryPictFormatsRequest struct {
+}
+
+// queryPictFormatsRequestRead reads a byte slice in the byte order 'order' into a QueryPictFormatsRequest value.
+func queryPictFormatsRequestRead(buf []byte, order xgb.ByteOrder) *QueryPictFormatsRequest {
+	v := new(QueryPictFormatsRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][1] = xgb.RequestInfo{
+		Name: "QueryPictFormats",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryPictFormatsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryPictFormatsReply(buf, order)
+		},
+	}
+}
+
 // QueryPictIndexValuesCookie is a cookie used only for QueryPictIndexValues requests.
 type QueryPictIndexValuesCookie struct {
 	*xgb.Cookie
@@ -3286,38 +4814,50 @@ type QueryPictIndexValuesReply struct {
 	Values []Indexvalue // size: xgb.Pad((int(NumValues) * 12))
 }
 
-// Reply blocks and returns the reply data for a QueryPictIndexValues request.
-func (cook QueryPictIndexValuesCookie) Reply() (*QueryPictIndexValuesReply, error) {
-	buf, err := cook.Cookie.Reply()
+// Reply blocks and returns the reply data for a QueryPictIndexValues request.
+func (cook QueryPictIndexValuesCookie) Reply() (*QueryPictIndexValuesReply, error) {
+	buf, err := cook.Cookie.Reply()
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryPictIndexValuesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryPictIndexValues request arrives.
+func (cook QueryPictIndexValuesCookie) ReplyContext(ctx context.Context) (*QueryPictIndexValuesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 	if buf == nil {
 		return nil, nil
 	}
-	return queryPictIndexValuesReply(buf), nil
+	return queryPictIndexValuesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryPictIndexValuesReply reads a byte slice into a QueryPictIndexValuesReply value.
-func queryPictIndexValuesReply(buf []byte) *QueryPictIndexValuesReply {
+// queryPictIndexValuesReply reads a byte slice in the byte order 'order' into a QueryPictIndexValuesReply value.
+func queryPictIndexValuesReply(buf []byte, order xgb.ByteOrder) *QueryPictIndexValuesReply {
 	v := new(QueryPictIndexValuesReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.NumValues = xgb.Get32(buf[b:])
+	v.NumValues = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
 
 	v.Values = make([]Indexvalue, v.NumValues)
-	b += IndexvalueReadList(buf[b:], v.Values)
+	b += IndexvalueReadList(buf[b:], order, v.Values)
 
 	return v
 }
@@ -3325,9 +4865,18 @@ func queryPictIndexValuesReply(buf []byte) *QueryPictIndexValuesReply {
 // Write request to wire for QueryPictIndexValues
 // queryPictIndexValuesRequest writes a QueryPictIndexValues request to a byte slice.
 func queryPictIndexValuesRequest(c *xgb.Conn, Format Pictformat) []byte {
+	return AppendQueryPictIndexValuesRequest(nil, c, Format)
+}
+
+// AppendQueryPictIndexValuesRequest appends a QueryPictIndexValues request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryPictIndexValuesRequest(buf []byte, c *xgb.Conn, Format Pictformat) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -3337,15 +4886,43 @@ func queryPictIndexValuesRequest(c *xgb.Conn, Format Pictformat) []byte {
 	buf[b] = 2 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Format))
+	order.Put32(buf[b:], uint32(Format))
 	b += 4
 
 	return buf
 }
 
+// QueryPictIndexValuesRequest represents the data of a QueryPictIndexValues request, as it is read when tracing a connection.
+type QueryPictIndexValuesRequest struct {
+	Format Pictformat
+}
+
+// queryPictIndexValuesRequestRead reads a byte slice in the byte order 'order' into a QueryPictIndexValuesRequest value.
+func queryPictIndexValuesRequestRead(buf []byte, order xgb.ByteOrder) *QueryPictIndexValuesRequest {
+	v := new(QueryPictIndexValuesRequest)
+	b := 4 // skip request header
+
+	v.Format = Pictformat(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][2] = xgb.RequestInfo{
+		Name: "QueryPictIndexValues",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryPictIndexValuesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryPictIndexValuesReply(buf, order)
+		},
+	}
+}
+
 // QueryVersionCookie is a cookie used only for QueryVersion requests.
 type QueryVersionCookie struct {
 	*xgb.Cookie
@@ -3396,26 +4973,38 @@ func (cook QueryVersionCookie) Reply() (*QueryVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryVersionReply(buf), nil
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryVersion request arrives.
+func (cook QueryVersionCookie) ReplyContext(ctx context.Context) (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryVersionReply reads a byte slice into a QueryVersionReply value.
-func queryVersionReply(buf []byte) *QueryVersionReply {
+// queryVersionReply reads a byte slice in the byte order 'order' into a QueryVersionReply value.
+func queryVersionReply(buf []byte, order xgb.ByteOrder) *QueryVersionReply {
 	v := new(QueryVersionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.MajorVersion = xgb.Get32(buf[b:])
+	v.MajorVersion = order.Get32(buf[b:])
 	b += 4
 
-	v.MinorVersion = xgb.Get32(buf[b:])
+	v.MinorVersion = order.Get32(buf[b:])
 	b += 4
 
 	b += 16 // padding
@@ -3426,9 +5015,18 @@ func queryVersionReply(buf []byte) *QueryVersionReply {
 // Write request to wire for QueryVersion
 // queryVersionRequest writes a QueryVersion request to a byte slice.
 func queryVersionRequest(c *xgb.Conn, ClientMajorVersion uint32, ClientMinorVersion uint32) []byte {
+	return AppendQueryVersionRequest(nil, c, ClientMajorVersion, ClientMinorVersion)
+}
+
+// AppendQueryVersionRequest appends a QueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryVersionRequest(buf []byte, c *xgb.Conn, ClientMajorVersion uint32, ClientMinorVersion uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -3438,18 +5036,50 @@ func queryVersionRequest(c *xgb.Conn, ClientMajorVersion uint32, ClientMinorVers
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], ClientMajorVersion)
+	order.Put32(buf[b:], ClientMajorVersion)
 	b += 4
 
-	xgb.Put32(buf[b:], ClientMinorVersion)
+	order.Put32(buf[b:], ClientMinorVersion)
 	b += 4
 
 	return buf
 }
 
+// QueryVersionRequest represents the data of a QueryVersion request, as it is read when tracing a connection.
+type QueryVersionRequest struct {
+	ClientMajorVersion uint32
+	ClientMinorVersion uint32
+}
+
+// queryVersionRequestRead reads a byte slice in the byte order 'order' into a QueryVersionRequest value.
+func queryVersionRequestRead(buf []byte, order xgb.ByteOrder) *QueryVersionRequest {
+	v := new(QueryVersionRequest)
+	b := 4 // skip request header
+
+	v.ClientMajorVersion = order.Get32(buf[b:])
+	b += 4
+
+	v.ClientMinorVersion = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][0] = xgb.RequestInfo{
+		Name: "QueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionReply(buf, order)
+		},
+	}
+}
+
 // ReferenceGlyphSetCookie is a cookie used only for ReferenceGlyphSet requests.
 type ReferenceGlyphSetCookie struct {
 	*xgb.Cookie
@@ -3487,12 +5117,26 @@ func (cook ReferenceGlyphSetCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ReferenceGlyphSetCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ReferenceGlyphSet
 // referenceGlyphSetRequest writes a ReferenceGlyphSet request to a byte slice.
 func referenceGlyphSetRequest(c *xgb.Conn, Gsid Glyphset, Existing Glyphset) []byte {
+	return AppendReferenceGlyphSetRequest(nil, c, Gsid, Existing)
+}
+
+// AppendReferenceGlyphSetRequest appends a ReferenceGlyphSet request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendReferenceGlyphSetRequest(buf []byte, c *xgb.Conn, Gsid Glyphset, Existing Glyphset) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -3502,18 +5146,47 @@ func referenceGlyphSetRequest(c *xgb.Conn, Gsid Glyphset, Existing Glyphset) []b
 	buf[b] = 18 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Gsid))
+	order.Put32(buf[b:], uint32(Gsid))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Existing))
+	order.Put32(buf[b:], uint32(Existing))
 	b += 4
 
 	return buf
 }
 
+// ReferenceGlyphSetRequest represents the data of a ReferenceGlyphSet request, as it is read when tracing a connection.
+type ReferenceGlyphSetRequest struct {
+	Gsid     Glyphset
+	Existing Glyphset
+}
+
+// referenceGlyphSetRequestRead reads a byte slice in the byte order 'order' into a ReferenceGlyphSetRequest value.
+func referenceGlyphSetRequestRead(buf []byte, order xgb.ByteOrder) *ReferenceGlyphSetRequest {
+	v := new(ReferenceGlyphSetRequest)
+	b := 4 // skip request header
+
+	v.Gsid = Glyphset(order.Get32(buf[b:]))
+	b += 4
+
+	v.Existing = Glyphset(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][18] = xgb.RequestInfo{
+		Name: "ReferenceGlyphSet",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return referenceGlyphSetRequestRead(buf, order)
+		},
+	}
+}
+
 // SetPictureClipRectanglesCookie is a cookie used only for SetPictureClipRectangles requests.
 type SetPictureClipRectanglesCookie struct {
 	*xgb.Cookie
@@ -3551,12 +5224,26 @@ func (cook SetPictureClipRectanglesCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetPictureClipRectanglesCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetPictureClipRectangles
 // setPictureClipRectanglesRequest writes a SetPictureClipRectangles request to a byte slice.
 func setPictureClipRectanglesRequest(c *xgb.Conn, Picture Picture, ClipXOrigin int16, ClipYOrigin int16, Rectangles []xproto.Rectangle) []byte {
+	return AppendSetPictureClipRectanglesRequest(nil, c, Picture, ClipXOrigin, ClipYOrigin, Rectangles)
+}
+
+// AppendSetPictureClipRectanglesRequest appends a SetPictureClipRectangles request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetPictureClipRectanglesRequest(buf []byte, c *xgb.Conn, Picture Picture, ClipXOrigin int16, ClipYOrigin int16, Rectangles []xproto.Rectangle) []byte {
 	size := xgb.Pad((12 + xgb.Pad((len(Rectangles) * 8))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -3566,23 +5253,60 @@ func setPictureClipRectanglesRequest(c *xgb.Conn, Picture Picture, ClipXOrigin i
 	buf[b] = 6 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Picture))
+	order.Put32(buf[b:], uint32(Picture))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(ClipXOrigin))
+	order.Put16(buf[b:], uint16(ClipXOrigin))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(ClipYOrigin))
+	order.Put16(buf[b:], uint16(ClipYOrigin))
 	b += 2
 
-	b += xproto.RectangleListBytes(buf[b:], Rectangles)
+	b += xproto.RectangleListBytes(buf[b:], order, Rectangles)
 
 	return buf
 }
 
+// SetPictureClipRectanglesRequest represents the data of a SetPictureClipRectangles request, as it is read when tracing a connection.
+type SetPictureClipRectanglesRequest struct {
+	Picture     Picture
+	ClipXOrigin int16
+	ClipYOrigin int16
+	Rectangles  []xproto.Rectangle // size: xgb.Pad((len(Rectangles) * 8))
+}
+
+// setPictureClipRectanglesRequestRead reads a byte slice in the byte order 'order' into a SetPictureClipRectanglesRequest value.
+func setPictureClipRectanglesRequestRead(buf []byte, order xgb.ByteOrder) *SetPictureClipRectanglesRequest {
+	v := new(SetPictureClipRectanglesRequest)
+	b := 4 // skip request header
+
+	v.Picture = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.ClipXOrigin = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.ClipYOrigin = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Rectangles = make([]xproto.Rectangle, (len(buf)-b)/8)
+	b += xproto.RectangleReadList(buf[b:], order, v.Rectangles)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][6] = xgb.RequestInfo{
+		Name: "SetPictureClipRectangles",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setPictureClipRectanglesRequestRead(buf, order)
+		},
+	}
+}
+
 // SetPictureFilterCookie is a cookie used only for SetPictureFilter requests.
 type SetPictureFilterCookie struct {
 	*xgb.Cookie
@@ -3620,12 +5344,26 @@ func (cook SetPictureFilterCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetPictureFilterCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetPictureFilter
 // setPictureFilterRequest writes a SetPictureFilter request to a byte slice.
 func setPictureFilterRequest(c *xgb.Conn, Picture Picture, FilterLen uint16, Filter string, Values []Fixed) []byte {
+	return AppendSetPictureFilterRequest(nil, c, Picture, FilterLen, Filter, Values)
+}
+
+// AppendSetPictureFilterRequest appends a SetPictureFilter request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetPictureFilterRequest(buf []byte, c *xgb.Conn, Picture Picture, FilterLen uint16, Filter string, Values []Fixed) []byte {
 	size := xgb.Pad((((12 + xgb.Pad((int(FilterLen) * 1))) + 4) + xgb.Pad((len(Values) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -3638,10 +5376,10 @@ func setPictureFilterRequest(c *xgb.Conn, Picture Picture, FilterLen uint16, Fil
 	blen := b
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Picture))
+	order.Put32(buf[b:], uint32(Picture))
 	b += 4
 
-	xgb.Put16(buf[b:], FilterLen)
+	order.Put16(buf[b:], FilterLen)
 	b += 2
 
 	b += 2 // padding
@@ -3652,15 +5390,65 @@ func setPictureFilterRequest(c *xgb.Conn, Picture Picture, FilterLen uint16, Fil
 	b = (b + 3) & ^3 // alignment gap
 
 	for i := 0; i < int(len(Values)); i++ {
-		xgb.Put32(buf[b:], uint32(Values[i]))
+		order.Put32(buf[b:], uint32(Values[i]))
 		b += 4
 	}
 
-	b = xgb.Pad(b)
-	xgb.Put16(buf[blen:], uint16(b/4)) // write request size in 4-byte units
+	b = start + xgb.Pad(b-start)
+	order.Put16(buf[blen:], uint16((b-start)/4)) // write request size in 4-byte units
 	return buf[:b]
 }
 
+// SetPictureFilterRequest represents the data of a SetPictureFilter request, as it is read when tracing a connection.
+type SetPictureFilterRequest struct {
+	Picture   Picture
+	FilterLen uint16
+	// padding: 2 bytes
+	Filter string // size: xgb.Pad((int(FilterLen) * 1))
+	// alignment gap to multiple of 4
+	Values []Fixed // size: xgb.Pad((len(Values) * 4))
+}
+
+// setPictureFilterRequestRead reads a byte slice in the byte order 'order' into a SetPictureFilterRequest value.
+func setPictureFilterRequestRead(buf []byte, order xgb.ByteOrder) *SetPictureFilterRequest {
+	v := new(SetPictureFilterRequest)
+	b := 4 // skip request header
+
+	v.Picture = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.FilterLen = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	{
+		byteString := make([]byte, v.FilterLen)
+		copy(byteString[:v.FilterLen], buf[b:])
+		v.Filter = string(byteString)
+		b += int(v.FilterLen)
+	}
+
+	b = (b + 3) & ^3 // alignment gap
+
+	v.Values = make([]Fixed, (len(buf)-b)/4)
+	for i := 0; i < int((len(buf)-b)/4); i++ {
+		v.Values[i] = Fixed(order.Get32(buf[b:]))
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][30] = xgb.RequestInfo{
+		Name: "SetPictureFilter",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setPictureFilterRequestRead(buf, order)
+		},
+	}
+}
+
 // SetPictureTransformCookie is a cookie used only for SetPictureTransform requests.
 type SetPictureTransformCookie struct {
 	*xgb.Cookie
@@ -3698,12 +5486,26 @@ func (cook SetPictureTransformCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetPictureTransformCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetPictureTransform
 // setPictureTransformRequest writes a SetPictureTransform request to a byte slice.
 func setPictureTransformRequest(c *xgb.Conn, Picture Picture, Transform Transform) []byte {
+	return AppendSetPictureTransformRequest(nil, c, Picture, Transform)
+}
+
+// AppendSetPictureTransformRequest appends a SetPictureTransform request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetPictureTransformRequest(buf []byte, c *xgb.Conn, Picture Picture, Transform Transform) []byte {
 	size := 44
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -3713,14 +5515,14 @@ func setPictureTransformRequest(c *xgb.Conn, Picture Picture, Transform Transfor
 	buf[b] = 28 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Picture))
+	order.Put32(buf[b:], uint32(Picture))
 	b += 4
 
 	{
-		structBytes := Transform.Bytes()
+		structBytes := Transform.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -3728,6 +5530,35 @@ func setPictureTransformRequest(c *xgb.Conn, Picture Picture, Transform Transfor
 	return buf
 }
 
+// SetPictureTransformRequest represents the data of a SetPictureTransform request, as it is read when tracing a connection.
+type SetPictureTransformRequest struct {
+	Picture   Picture
+	Transform Transform
+}
+
+// setPictureTransformRequestRead reads a byte slice in the byte order 'order' into a SetPictureTransformRequest value.
+func setPictureTransformRequestRead(buf []byte, order xgb.ByteOrder) *SetPictureTransformRequest {
+	v := new(SetPictureTransformRequest)
+	b := 4 // skip request header
+
+	v.Picture = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.Transform = Transform{}
+	b += TransformRead(buf[b:], order, &v.Transform)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][28] = xgb.RequestInfo{
+		Name: "SetPictureTransform",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setPictureTransformRequestRead(buf, order)
+		},
+	}
+}
+
 // TrapezoidsCookie is a cookie used only for Trapezoids requests.
 type TrapezoidsCookie struct {
 	*xgb.Cookie
@@ -3735,7 +5566,7 @@ type TrapezoidsCookie struct {
 
 // Trapezoids sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func Trapezoids(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Traps []Trapezoid) TrapezoidsCookie {
+func Trapezoids(c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Traps []Trapezoid) TrapezoidsCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RENDER"]; !ok {
@@ -3748,7 +5579,7 @@ func Trapezoids(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictf
 
 // TrapezoidsChecked sends a checked request.
 // If an error occurs, it can be retrieved using TrapezoidsCookie.Check()
-func TrapezoidsChecked(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Traps []Trapezoid) TrapezoidsCookie {
+func TrapezoidsChecked(c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Traps []Trapezoid) TrapezoidsCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RENDER"]; !ok {
@@ -3765,12 +5596,26 @@ func (cook TrapezoidsCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook TrapezoidsCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for Trapezoids
 // trapezoidsRequest writes a Trapezoids request to a byte slice.
-func trapezoidsRequest(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Traps []Trapezoid) []byte {
+func trapezoidsRequest(c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Traps []Trapezoid) []byte {
+	return AppendTrapezoidsRequest(nil, c, Op, Src, Dst, MaskFormat, SrcX, SrcY, Traps)
+}
+
+// AppendTrapezoidsRequest appends a Trapezoids request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendTrapezoidsRequest(buf []byte, c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Traps []Trapezoid) []byte {
 	size := xgb.Pad((24 + xgb.Pad((len(Traps) * 40))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -3780,34 +5625,86 @@ func trapezoidsRequest(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskForma
 	buf[b] = 10 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	buf[b] = Op
+	buf[b] = byte(Op)
 	b += 1
 
 	b += 3 // padding
 
-	xgb.Put32(buf[b:], uint32(Src))
+	order.Put32(buf[b:], uint32(Src))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Dst))
+	order.Put32(buf[b:], uint32(Dst))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(MaskFormat))
+	order.Put32(buf[b:], uint32(MaskFormat))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(SrcX))
+	order.Put16(buf[b:], uint16(SrcX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(SrcY))
+	order.Put16(buf[b:], uint16(SrcY))
 	b += 2
 
-	b += TrapezoidListBytes(buf[b:], Traps)
+	b += TrapezoidListBytes(buf[b:], order, Traps)
 
 	return buf
 }
 
+// TrapezoidsRequest represents the data of a Trapezoids request, as it is read when tracing a connection.
+type TrapezoidsRequest struct {
+	Op PictOp
+	// padding: 3 bytes
+	Src        Picture
+	Dst        Picture
+	MaskFormat Pictformat
+	SrcX       int16
+	SrcY       int16
+	Traps      []Trapezoid // size: xgb.Pad((len(Traps) * 40))
+}
+
+// trapezoidsRequestRead reads a byte slice in the byte order 'order' into a TrapezoidsRequest value.
+func trapezoidsRequestRead(buf []byte, order xgb.ByteOrder) *TrapezoidsRequest {
+	v := new(TrapezoidsRequest)
+	b := 4 // skip request header
+
+	v.Op = PictOp(buf[b])
+	b += 1
+
+	b += 3 // padding
+
+	v.Src = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.Dst = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.MaskFormat = Pictformat(order.Get32(buf[b:]))
+	b += 4
+
+	v.SrcX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.SrcY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Traps = make([]Trapezoid, (len(buf)-b)/40)
+	b += TrapezoidReadList(buf[b:], order, v.Traps)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][10] = xgb.RequestInfo{
+		Name: "Trapezoids",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return trapezoidsRequestRead(buf, order)
+		},
+	}
+}
+
 // TriFanCookie is a cookie used only for TriFan requests.
 type TriFanCookie struct {
 	*xgb.Cookie
@@ -3815,7 +5712,7 @@ type TriFanCookie struct {
 
 // TriFan sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func TriFan(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Points []Pointfix) TriFanCookie {
+func TriFan(c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Points []Pointfix) TriFanCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RENDER"]; !ok {
@@ -3828,7 +5725,7 @@ func TriFan(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictforma
 
 // TriFanChecked sends a checked request.
 // If an error occurs, it can be retrieved using TriFanCookie.Check()
-func TriFanChecked(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Points []Pointfix) TriFanCookie {
+func TriFanChecked(c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Points []Pointfix) TriFanCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RENDER"]; !ok {
@@ -3845,12 +5742,26 @@ func (cook TriFanCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook TriFanCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for TriFan
 // triFanRequest writes a TriFan request to a byte slice.
-func triFanRequest(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Points []Pointfix) []byte {
+func triFanRequest(c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Points []Pointfix) []byte {
+	return AppendTriFanRequest(nil, c, Op, Src, Dst, MaskFormat, SrcX, SrcY, Points)
+}
+
+// AppendTriFanRequest appends a TriFan request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendTriFanRequest(buf []byte, c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Points []Pointfix) []byte {
 	size := xgb.Pad((24 + xgb.Pad((len(Points) * 8))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -3860,34 +5771,86 @@ func triFanRequest(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pi
 	buf[b] = 13 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	buf[b] = Op
+	buf[b] = byte(Op)
 	b += 1
 
 	b += 3 // padding
 
-	xgb.Put32(buf[b:], uint32(Src))
+	order.Put32(buf[b:], uint32(Src))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Dst))
+	order.Put32(buf[b:], uint32(Dst))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(MaskFormat))
+	order.Put32(buf[b:], uint32(MaskFormat))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(SrcX))
+	order.Put16(buf[b:], uint16(SrcX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(SrcY))
+	order.Put16(buf[b:], uint16(SrcY))
 	b += 2
 
-	b += PointfixListBytes(buf[b:], Points)
+	b += PointfixListBytes(buf[b:], order, Points)
 
 	return buf
 }
 
+// TriFanRequest represents the data of a TriFan request, as it is read when tracing a connection.
+type TriFanRequest struct {
+	Op PictOp
+	// padding: 3 bytes
+	Src        Picture
+	Dst        Picture
+	MaskFormat Pictformat
+	SrcX       int16
+	SrcY       int16
+	Points     []Pointfix // size: xgb.Pad((len(Points) * 8))
+}
+
+// triFanRequestRead reads a byte slice in the byte order 'order' into a TriFanRequest value.
+func triFanRequestRead(buf []byte, order xgb.ByteOrder) *TriFanRequest {
+	v := new(TriFanRequest)
+	b := 4 // skip request header
+
+	v.Op = PictOp(buf[b])
+	b += 1
+
+	b += 3 // padding
+
+	v.Src = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.Dst = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.MaskFormat = Pictformat(order.Get32(buf[b:]))
+	b += 4
+
+	v.SrcX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.SrcY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Points = make([]Pointfix, (len(buf)-b)/8)
+	b += PointfixReadList(buf[b:], order, v.Points)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][13] = xgb.RequestInfo{
+		Name: "TriFan",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return triFanRequestRead(buf, order)
+		},
+	}
+}
+
 // TriStripCookie is a cookie used only for TriStrip requests.
 type TriStripCookie struct {
 	*xgb.Cookie
@@ -3895,7 +5858,7 @@ type TriStripCookie struct {
 
 // TriStrip sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func TriStrip(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Points []Pointfix) TriStripCookie {
+func TriStrip(c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Points []Pointfix) TriStripCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RENDER"]; !ok {
@@ -3908,7 +5871,7 @@ func TriStrip(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictfor
 
 // TriStripChecked sends a checked request.
 // If an error occurs, it can be retrieved using TriStripCookie.Check()
-func TriStripChecked(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Points []Pointfix) TriStripCookie {
+func TriStripChecked(c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Points []Pointfix) TriStripCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RENDER"]; !ok {
@@ -3925,12 +5888,26 @@ func (cook TriStripCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook TriStripCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for TriStrip
 // triStripRequest writes a TriStrip request to a byte slice.
-func triStripRequest(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Points []Pointfix) []byte {
+func triStripRequest(c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Points []Pointfix) []byte {
+	return AppendTriStripRequest(nil, c, Op, Src, Dst, MaskFormat, SrcX, SrcY, Points)
+}
+
+// AppendTriStripRequest appends a TriStrip request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendTriStripRequest(buf []byte, c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Points []Pointfix) []byte {
 	size := xgb.Pad((24 + xgb.Pad((len(Points) * 8))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -3940,34 +5917,86 @@ func triStripRequest(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat
 	buf[b] = 12 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	buf[b] = Op
+	buf[b] = byte(Op)
 	b += 1
 
 	b += 3 // padding
 
-	xgb.Put32(buf[b:], uint32(Src))
+	order.Put32(buf[b:], uint32(Src))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Dst))
+	order.Put32(buf[b:], uint32(Dst))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(MaskFormat))
+	order.Put32(buf[b:], uint32(MaskFormat))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(SrcX))
+	order.Put16(buf[b:], uint16(SrcX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(SrcY))
+	order.Put16(buf[b:], uint16(SrcY))
 	b += 2
 
-	b += PointfixListBytes(buf[b:], Points)
+	b += PointfixListBytes(buf[b:], order, Points)
 
 	return buf
 }
 
+// TriStripRequest represents the data of a TriStrip request, as it is read when tracing a connection.
+type TriStripRequest struct {
+	Op PictOp
+	// padding: 3 bytes
+	Src        Picture
+	Dst        Picture
+	MaskFormat Pictformat
+	SrcX       int16
+	SrcY       int16
+	Points     []Pointfix // size: xgb.Pad((len(Points) * 8))
+}
+
+// triStripRequestRead reads a byte slice in the byte order 'order' into a TriStripRequest value.
+func triStripRequestRead(buf []byte, order xgb.ByteOrder) *TriStripRequest {
+	v := new(TriStripRequest)
+	b := 4 // skip request header
+
+	v.Op = PictOp(buf[b])
+	b += 1
+
+	b += 3 // padding
+
+	v.Src = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.Dst = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.MaskFormat = Pictformat(order.Get32(buf[b:]))
+	b += 4
+
+	v.SrcX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.SrcY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Points = make([]Pointfix, (len(buf)-b)/8)
+	b += PointfixReadList(buf[b:], order, v.Points)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][12] = xgb.RequestInfo{
+		Name: "TriStrip",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return triStripRequestRead(buf, order)
+		},
+	}
+}
+
 // TrianglesCookie is a cookie used only for Triangles requests.
 type TrianglesCookie struct {
 	*xgb.Cookie
@@ -3975,7 +6004,7 @@ type TrianglesCookie struct {
 
 // Triangles sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func Triangles(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Triangles []Triangle) TrianglesCookie {
+func Triangles(c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Triangles []Triangle) TrianglesCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RENDER"]; !ok {
@@ -3988,7 +6017,7 @@ func Triangles(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictfo
 
 // TrianglesChecked sends a checked request.
 // If an error occurs, it can be retrieved using TrianglesCookie.Check()
-func TrianglesChecked(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Triangles []Triangle) TrianglesCookie {
+func TrianglesChecked(c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Triangles []Triangle) TrianglesCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["RENDER"]; !ok {
@@ -4005,12 +6034,26 @@ func (cook TrianglesCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook TrianglesCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for Triangles
 // trianglesRequest writes a Triangles request to a byte slice.
-func trianglesRequest(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Triangles []Triangle) []byte {
+func trianglesRequest(c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Triangles []Triangle) []byte {
+	return AppendTrianglesRequest(nil, c, Op, Src, Dst, MaskFormat, SrcX, SrcY, Triangles)
+}
+
+// AppendTrianglesRequest appends a Triangles request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendTrianglesRequest(buf []byte, c *xgb.Conn, Op PictOp, Src Picture, Dst Picture, MaskFormat Pictformat, SrcX int16, SrcY int16, Triangles []Triangle) []byte {
 	size := xgb.Pad((24 + xgb.Pad((len(Triangles) * 24))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["RENDER"]
@@ -4020,30 +6063,82 @@ func trianglesRequest(c *xgb.Conn, Op byte, Src Picture, Dst Picture, MaskFormat
 	buf[b] = 11 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	buf[b] = Op
+	buf[b] = byte(Op)
 	b += 1
 
 	b += 3 // padding
 
-	xgb.Put32(buf[b:], uint32(Src))
+	order.Put32(buf[b:], uint32(Src))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Dst))
+	order.Put32(buf[b:], uint32(Dst))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(MaskFormat))
+	order.Put32(buf[b:], uint32(MaskFormat))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(SrcX))
+	order.Put16(buf[b:], uint16(SrcX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(SrcY))
+	order.Put16(buf[b:], uint16(SrcY))
 	b += 2
 
-	b += TriangleListBytes(buf[b:], Triangles)
+	b += TriangleListBytes(buf[b:], order, Triangles)
 
 	return buf
 }
+
+// TrianglesRequest represents the data of a Triangles request, as it is read when tracing a connection.
+type TrianglesRequest struct {
+	Op PictOp
+	// padding: 3 bytes
+	Src        Picture
+	Dst        Picture
+	MaskFormat Pictformat
+	SrcX       int16
+	SrcY       int16
+	Triangles  []Triangle // size: xgb.Pad((len(Triangles) * 24))
+}
+
+// trianglesRequestRead reads a byte slice in the byte order 'order' into a TrianglesRequest value.
+func trianglesRequestRead(buf []byte, order xgb.ByteOrder) *TrianglesRequest {
+	v := new(TrianglesRequest)
+	b := 4 // skip request header
+
+	v.Op = PictOp(buf[b])
+	b += 1
+
+	b += 3 // padding
+
+	v.Src = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.Dst = Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.MaskFormat = Pictformat(order.Get32(buf[b:]))
+	b += 4
+
+	v.SrcX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.SrcY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Triangles = make([]Triangle, (len(buf)-b)/24)
+	b += TriangleReadList(buf[b:], order, v.Triangles)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["RENDER"][11] = xgb.RequestInfo{
+		Name: "Triangles",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return trianglesRequestRead(buf, order)
+		},
+	}
+}
@@ -4,6 +4,8 @@ package res
 // This file is automatically generated from res.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xproto"
@@ -22,18 +24,16 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["X-Resource"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["X-Resource"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["X-Resource"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["X-Resource"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["X-Resource"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "X-Resource", xgb.ExtRequestInfos["X-Resource"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["X-Resource"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["X-Resource"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["X-Resource"] = make(map[int]xgb.RequestInfo)
 }
 
 type Client struct {
@@ -42,107 +42,129 @@ type Client struct {
 }
 
 // ClientRead reads a byte slice into a Client value.
-func ClientRead(buf []byte, v *Client) int {
+func ClientRead(buf []byte, order xgb.ByteOrder, v *Client) int {
 	b := 0
 
-	v.ResourceBase = xgb.Get32(buf[b:])
+	v.ResourceBase = order.Get32(buf[b:])
 	b += 4
 
-	v.ResourceMask = xgb.Get32(buf[b:])
+	v.ResourceMask = order.Get32(buf[b:])
 	b += 4
 
 	return b
 }
 
 // ClientReadList reads a byte slice into a list of Client values.
-func ClientReadList(buf []byte, dest []Client) int {
+func ClientReadList(buf []byte, order xgb.ByteOrder, dest []Client) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Client{}
-		b += ClientRead(buf[b:], &dest[i])
+		b += ClientRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Client value to a byte slice.
-func (v Client) Bytes() []byte {
+// Bytes writes a Client value to a byte slice, in the byte order 'order'.
+func (v Client) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 8)
 	b := 0
 
-	xgb.Put32(buf[b:], v.ResourceBase)
+	order.Put32(buf[b:], v.ResourceBase)
 	b += 4
 
-	xgb.Put32(buf[b:], v.ResourceMask)
+	order.Put32(buf[b:], v.ResourceMask)
 	b += 4
 
 	return buf[:b]
 }
 
 // ClientListBytes writes a list of Client values to a byte slice.
-func ClientListBytes(buf []byte, list []Client) int {
+func ClientListBytes(buf []byte, order xgb.ByteOrder, list []Client) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 	return xgb.Pad(b)
 }
 
+type ClientIdMask uint32
+
 const (
-	ClientIdMaskClientXID      = 1
-	ClientIdMaskLocalClientPID = 2
+	ClientIdMaskClientXID      ClientIdMask = 1
+	ClientIdMaskLocalClientPID ClientIdMask = 2
 )
 
+// String returns the names of the ClientIdMask bits set in a value.
+func (v ClientIdMask) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 2)
+	if v&ClientIdMaskClientXID != 0 {
+		names = append(names, "ClientXID")
+		v &^= ClientIdMaskClientXID
+	}
+	if v&ClientIdMaskLocalClientPID != 0 {
+		names = append(names, "LocalClientPID")
+		v &^= ClientIdMaskLocalClientPID
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint32(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
 type ClientIdSpec struct {
 	Client uint32
-	Mask   uint32
+	Mask   ClientIdMask
 }
 
 // ClientIdSpecRead reads a byte slice into a ClientIdSpec value.
-func ClientIdSpecRead(buf []byte, v *ClientIdSpec) int {
+func ClientIdSpecRead(buf []byte, order xgb.ByteOrder, v *ClientIdSpec) int {
 	b := 0
 
-	v.Client = xgb.Get32(buf[b:])
+	v.Client = order.Get32(buf[b:])
 	b += 4
 
-	v.Mask = xgb.Get32(buf[b:])
+	v.Mask = ClientIdMask(order.Get32(buf[b:]))
 	b += 4
 
 	return b
 }
 
 // ClientIdSpecReadList reads a byte slice into a list of ClientIdSpec values.
-func ClientIdSpecReadList(buf []byte, dest []ClientIdSpec) int {
+func ClientIdSpecReadList(buf []byte, order xgb.ByteOrder, dest []ClientIdSpec) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = ClientIdSpec{}
-		b += ClientIdSpecRead(buf[b:], &dest[i])
+		b += ClientIdSpecRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a ClientIdSpec value to a byte slice.
-func (v ClientIdSpec) Bytes() []byte {
+// Bytes writes a ClientIdSpec value to a byte slice, in the byte order 'order'.
+func (v ClientIdSpec) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 8)
 	b := 0
 
-	xgb.Put32(buf[b:], v.Client)
+	order.Put32(buf[b:], v.Client)
 	b += 4
 
-	xgb.Put32(buf[b:], v.Mask)
+	order.Put32(buf[b:], uint32(v.Mask))
 	b += 4
 
 	return buf[:b]
 }
 
 // ClientIdSpecListBytes writes a list of ClientIdSpec values to a byte slice.
-func ClientIdSpecListBytes(buf []byte, list []ClientIdSpec) int {
+func ClientIdSpecListBytes(buf []byte, order xgb.ByteOrder, list []ClientIdSpec) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -156,18 +178,18 @@ type ClientIdValue struct {
 }
 
 // ClientIdValueRead reads a byte slice into a ClientIdValue value.
-func ClientIdValueRead(buf []byte, v *ClientIdValue) int {
+func ClientIdValueRead(buf []byte, order xgb.ByteOrder, v *ClientIdValue) int {
 	b := 0
 
 	v.Spec = ClientIdSpec{}
-	b += ClientIdSpecRead(buf[b:], &v.Spec)
+	b += ClientIdSpecRead(buf[b:], order, &v.Spec)
 
-	v.Length = xgb.Get32(buf[b:])
+	v.Length = order.Get32(buf[b:])
 	b += 4
 
 	v.Value = make([]uint32, v.Length)
 	for i := 0; i < int(v.Length); i++ {
-		v.Value[i] = xgb.Get32(buf[b:])
+		v.Value[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -175,31 +197,31 @@ func ClientIdValueRead(buf []byte, v *ClientIdValue) int {
 }
 
 // ClientIdValueReadList reads a byte slice into a list of ClientIdValue values.
-func ClientIdValueReadList(buf []byte, dest []ClientIdValue) int {
+func ClientIdValueReadList(buf []byte, order xgb.ByteOrder, dest []ClientIdValue) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = ClientIdValue{}
-		b += ClientIdValueRead(buf[b:], &dest[i])
+		b += ClientIdValueRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a ClientIdValue value to a byte slice.
-func (v ClientIdValue) Bytes() []byte {
+// Bytes writes a ClientIdValue value to a byte slice, in the byte order 'order'.
+func (v ClientIdValue) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, (12 + xgb.Pad((int(v.Length) * 4))))
 	b := 0
 
 	{
-		structBytes := v.Spec.Bytes()
+		structBytes := v.Spec.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
-	xgb.Put32(buf[b:], v.Length)
+	order.Put32(buf[b:], v.Length)
 	b += 4
 
 	for i := 0; i < int(v.Length); i++ {
-		xgb.Put32(buf[b:], v.Value[i])
+		order.Put32(buf[b:], v.Value[i])
 		b += 4
 	}
 
@@ -207,11 +229,11 @@ func (v ClientIdValue) Bytes() []byte {
 }
 
 // ClientIdValueListBytes writes a list of ClientIdValue values to a byte slice.
-func ClientIdValueListBytes(buf []byte, list []ClientIdValue) int {
+func ClientIdValueListBytes(buf []byte, order xgb.ByteOrder, list []ClientIdValue) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -233,48 +255,48 @@ type ResourceIdSpec struct {
 }
 
 // ResourceIdSpecRead reads a byte slice into a ResourceIdSpec value.
-func ResourceIdSpecRead(buf []byte, v *ResourceIdSpec) int {
+func ResourceIdSpecRead(buf []byte, order xgb.ByteOrder, v *ResourceIdSpec) int {
 	b := 0
 
-	v.Resource = xgb.Get32(buf[b:])
+	v.Resource = order.Get32(buf[b:])
 	b += 4
 
-	v.Type = xgb.Get32(buf[b:])
+	v.Type = order.Get32(buf[b:])
 	b += 4
 
 	return b
 }
 
 // ResourceIdSpecReadList reads a byte slice into a list of ResourceIdSpec values.
-func ResourceIdSpecReadList(buf []byte, dest []ResourceIdSpec) int {
+func ResourceIdSpecReadList(buf []byte, order xgb.ByteOrder, dest []ResourceIdSpec) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = ResourceIdSpec{}
-		b += ResourceIdSpecRead(buf[b:], &dest[i])
+		b += ResourceIdSpecRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a ResourceIdSpec value to a byte slice.
-func (v ResourceIdSpec) Bytes() []byte {
+// Bytes writes a ResourceIdSpec value to a byte slice, in the byte order 'order'.
+func (v ResourceIdSpec) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 8)
 	b := 0
 
-	xgb.Put32(buf[b:], v.Resource)
+	order.Put32(buf[b:], v.Resource)
 	b += 4
 
-	xgb.Put32(buf[b:], v.Type)
+	order.Put32(buf[b:], v.Type)
 	b += 4
 
 	return buf[:b]
 }
 
 // ResourceIdSpecListBytes writes a list of ResourceIdSpec values to a byte slice.
-func ResourceIdSpecListBytes(buf []byte, list []ResourceIdSpec) int {
+func ResourceIdSpecListBytes(buf []byte, order xgb.ByteOrder, list []ResourceIdSpec) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -289,63 +311,63 @@ type ResourceSizeSpec struct {
 }
 
 // ResourceSizeSpecRead reads a byte slice into a ResourceSizeSpec value.
-func ResourceSizeSpecRead(buf []byte, v *ResourceSizeSpec) int {
+func ResourceSizeSpecRead(buf []byte, order xgb.ByteOrder, v *ResourceSizeSpec) int {
 	b := 0
 
 	v.Spec = ResourceIdSpec{}
-	b += ResourceIdSpecRead(buf[b:], &v.Spec)
+	b += ResourceIdSpecRead(buf[b:], order, &v.Spec)
 
-	v.Bytes_ = xgb.Get32(buf[b:])
+	v.Bytes_ = order.Get32(buf[b:])
 	b += 4
 
-	v.RefCount = xgb.Get32(buf[b:])
+	v.RefCount = order.Get32(buf[b:])
 	b += 4
 
-	v.UseCount = xgb.Get32(buf[b:])
+	v.UseCount = order.Get32(buf[b:])
 	b += 4
 
 	return b
 }
 
 // ResourceSizeSpecReadList reads a byte slice into a list of ResourceSizeSpec values.
-func ResourceSizeSpecReadList(buf []byte, dest []ResourceSizeSpec) int {
+func ResourceSizeSpecReadList(buf []byte, order xgb.ByteOrder, dest []ResourceSizeSpec) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = ResourceSizeSpec{}
-		b += ResourceSizeSpecRead(buf[b:], &dest[i])
+		b += ResourceSizeSpecRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a ResourceSizeSpec value to a byte slice.
-func (v ResourceSizeSpec) Bytes() []byte {
+// Bytes writes a ResourceSizeSpec value to a byte slice, in the byte order 'order'.
+func (v ResourceSizeSpec) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 20)
 	b := 0
 
 	{
-		structBytes := v.Spec.Bytes()
+		structBytes := v.Spec.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
-	xgb.Put32(buf[b:], v.Bytes_)
+	order.Put32(buf[b:], v.Bytes_)
 	b += 4
 
-	xgb.Put32(buf[b:], v.RefCount)
+	order.Put32(buf[b:], v.RefCount)
 	b += 4
 
-	xgb.Put32(buf[b:], v.UseCount)
+	order.Put32(buf[b:], v.UseCount)
 	b += 4
 
 	return buf[:b]
 }
 
 // ResourceSizeSpecListBytes writes a list of ResourceSizeSpec values to a byte slice.
-func ResourceSizeSpecListBytes(buf []byte, list []ResourceSizeSpec) int {
+func ResourceSizeSpecListBytes(buf []byte, order xgb.ByteOrder, list []ResourceSizeSpec) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -359,56 +381,56 @@ type ResourceSizeValue struct {
 }
 
 // ResourceSizeValueRead reads a byte slice into a ResourceSizeValue value.
-func ResourceSizeValueRead(buf []byte, v *ResourceSizeValue) int {
+func ResourceSizeValueRead(buf []byte, order xgb.ByteOrder, v *ResourceSizeValue) int {
 	b := 0
 
 	v.Size = ResourceSizeSpec{}
-	b += ResourceSizeSpecRead(buf[b:], &v.Size)
+	b += ResourceSizeSpecRead(buf[b:], order, &v.Size)
 
-	v.NumCrossReferences = xgb.Get32(buf[b:])
+	v.NumCrossReferences = order.Get32(buf[b:])
 	b += 4
 
 	v.CrossReferences = make([]ResourceSizeSpec, v.NumCrossReferences)
-	b += ResourceSizeSpecReadList(buf[b:], v.CrossReferences)
+	b += ResourceSizeSpecReadList(buf[b:], order, v.CrossReferences)
 
 	return b
 }
 
 // ResourceSizeValueReadList reads a byte slice into a list of ResourceSizeValue values.
-func ResourceSizeValueReadList(buf []byte, dest []ResourceSizeValue) int {
+func ResourceSizeValueReadList(buf []byte, order xgb.ByteOrder, dest []ResourceSizeValue) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = ResourceSizeValue{}
-		b += ResourceSizeValueRead(buf[b:], &dest[i])
+		b += ResourceSizeValueRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a ResourceSizeValue value to a byte slice.
-func (v ResourceSizeValue) Bytes() []byte {
+// Bytes writes a ResourceSizeValue value to a byte slice, in the byte order 'order'.
+func (v ResourceSizeValue) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, (24 + xgb.Pad((int(v.NumCrossReferences) * 20))))
 	b := 0
 
 	{
-		structBytes := v.Size.Bytes()
+		structBytes := v.Size.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
-	xgb.Put32(buf[b:], v.NumCrossReferences)
+	order.Put32(buf[b:], v.NumCrossReferences)
 	b += 4
 
-	b += ResourceSizeSpecListBytes(buf[b:], v.CrossReferences)
+	b += ResourceSizeSpecListBytes(buf[b:], order, v.CrossReferences)
 
 	return buf[:b]
 }
 
 // ResourceSizeValueListBytes writes a list of ResourceSizeValue values to a byte slice.
-func ResourceSizeValueListBytes(buf []byte, list []ResourceSizeValue) int {
+func ResourceSizeValueListBytes(buf []byte, order xgb.ByteOrder, list []ResourceSizeValue) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -430,48 +452,48 @@ type Type struct {
 }
 
 // TypeRead reads a byte slice into a Type value.
-func TypeRead(buf []byte, v *Type) int {
+func TypeRead(buf []byte, order xgb.ByteOrder, v *Type) int {
 	b := 0
 
-	v.ResourceType = xproto.Atom(xgb.Get32(buf[b:]))
+	v.ResourceType = xproto.Atom(order.Get32(buf[b:]))
 	b += 4
 
-	v.Count = xgb.Get32(buf[b:])
+	v.Count = order.Get32(buf[b:])
 	b += 4
 
 	return b
 }
 
 // TypeReadList reads a byte slice into a list of Type values.
-func TypeReadList(buf []byte, dest []Type) int {
+func TypeReadList(buf []byte, order xgb.ByteOrder, dest []Type) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Type{}
-		b += TypeRead(buf[b:], &dest[i])
+		b += TypeRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Type value to a byte slice.
-func (v Type) Bytes() []byte {
+// Bytes writes a Type value to a byte slice, in the byte order 'order'.
+func (v Type) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 8)
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.ResourceType))
+	order.Put32(buf[b:], uint32(v.ResourceType))
 	b += 4
 
-	xgb.Put32(buf[b:], v.Count)
+	order.Put32(buf[b:], v.Count)
 	b += 4
 
 	return buf[:b]
 }
 
 // TypeListBytes writes a list of Type values to a byte slice.
-func TypeListBytes(buf []byte, list []Type) int {
+func TypeListBytes(buf []byte, order xgb.ByteOrder, list []Type) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -502,6 +524,8 @@ func TypeListBytes(buf []byte, list []Type) int {
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // QueryClientIdsCookie is a cookie used only for QueryClientIds requests.
 type QueryClientIdsCookie struct {
 	*xgb.Cookie
@@ -552,29 +576,41 @@ func (cook QueryClientIdsCookie) Reply() (*QueryClientIdsReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryClientIdsReply(buf), nil
+	return queryClientIdsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryClientIds request arrives.
+func (cook QueryClientIdsCookie) ReplyContext(ctx context.Context) (*QueryClientIdsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryClientIdsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryClientIdsReply reads a byte slice into a QueryClientIdsReply value.
-func queryClientIdsReply(buf []byte) *QueryClientIdsReply {
+// queryClientIdsReply reads a byte slice in the byte order 'order' into a QueryClientIdsReply value.
+func queryClientIdsReply(buf []byte, order xgb.ByteOrder) *QueryClientIdsReply {
 	v := new(QueryClientIdsReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.NumIds = xgb.Get32(buf[b:])
+	v.NumIds = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
 
 	v.Ids = make([]ClientIdValue, v.NumIds)
-	b += ClientIdValueReadList(buf[b:], v.Ids)
+	b += ClientIdValueReadList(buf[b:], order, v.Ids)
 
 	return v
 }
@@ -582,9 +618,18 @@ func queryClientIdsReply(buf []byte) *QueryClientIdsReply {
 // Write request to wire for QueryClientIds
 // queryClientIdsRequest writes a QueryClientIds request to a byte slice.
 func queryClientIdsRequest(c *xgb.Conn, NumSpecs uint32, Specs []ClientIdSpec) []byte {
+	return AppendQueryClientIdsRequest(nil, c, NumSpecs, Specs)
+}
+
+// AppendQueryClientIdsRequest appends a QueryClientIds request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryClientIdsRequest(buf []byte, c *xgb.Conn, NumSpecs uint32, Specs []ClientIdSpec) []byte {
 	size := xgb.Pad((8 + xgb.Pad((int(NumSpecs) * 8))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["X-Resource"]
@@ -594,17 +639,49 @@ func queryClientIdsRequest(c *xgb.Conn, NumSpecs uint32, Specs []ClientIdSpec) [
 	buf[b] = 4 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], NumSpecs)
+	order.Put32(buf[b:], NumSpecs)
 	b += 4
 
-	b += ClientIdSpecListBytes(buf[b:], Specs)
+	b += ClientIdSpecListBytes(buf[b:], order, Specs)
 
 	return buf
 }
 
+// QueryClientIdsRequest represents the data of a QueryClientIds request, as it is read when tracing a connection.
+type QueryClientIdsRequest struct {
+	NumSpecs uint32
+	Specs    []ClientIdSpec // size: xgb.Pad((int(NumSpecs) * 8))
+}
+
+// queryClientIdsRequestRead reads a byte slice in the byte order 'order' into a QueryClientIdsRequest value.
+func queryClientIdsRequestRead(buf []byte, order xgb.ByteOrder) *QueryClientIdsRequest {
+	v := new(QueryClientIdsRequest)
+	b := 4 // skip request header
+
+	v.NumSpecs = order.Get32(buf[b:])
+	b += 4
+
+	v.Specs = make([]ClientIdSpec, v.NumSpecs)
+	b += ClientIdSpecReadList(buf[b:], order, v.Specs)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["X-Resource"][4] = xgb.RequestInfo{
+		Name: "QueryClientIds",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryClientIdsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryClientIdsReply(buf, order)
+		},
+	}
+}
+
 // QueryClientPixmapBytesCookie is a cookie used only for QueryClientPixmapBytes requests.
 type QueryClientPixmapBytesCookie struct {
 	*xgb.Cookie
@@ -654,26 +731,38 @@ func (cook QueryClientPixmapBytesCookie) Reply() (*QueryClientPixmapBytesReply,
 	if buf == nil {
 		return nil, nil
 	}
-	return queryClientPixmapBytesReply(buf), nil
+	return queryClientPixmapBytesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryClientPixmapBytes request arrives.
+func (cook QueryClientPixmapBytesCookie) ReplyContext(ctx context.Context) (*QueryClientPixmapBytesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryClientPixmapBytesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryClientPixmapBytesReply reads a byte slice into a QueryClientPixmapBytesReply value.
-func queryClientPixmapBytesReply(buf []byte) *QueryClientPixmapBytesReply {
+// queryClientPixmapBytesReply reads a byte slice in the byte order 'order' into a QueryClientPixmapBytesReply value.
+func queryClientPixmapBytesReply(buf []byte, order xgb.ByteOrder) *QueryClientPixmapBytesReply {
 	v := new(QueryClientPixmapBytesReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Bytes_ = xgb.Get32(buf[b:])
+	v.Bytes_ = order.Get32(buf[b:])
 	b += 4
 
-	v.BytesOverflow = xgb.Get32(buf[b:])
+	v.BytesOverflow = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -682,9 +771,18 @@ func queryClientPixmapBytesReply(buf []byte) *QueryClientPixmapBytesReply {
 // Write request to wire for QueryClientPixmapBytes
 // queryClientPixmapBytesRequest writes a QueryClientPixmapBytes request to a byte slice.
 func queryClientPixmapBytesRequest(c *xgb.Conn, Xid uint32) []byte {
+	return AppendQueryClientPixmapBytesRequest(nil, c, Xid)
+}
+
+// AppendQueryClientPixmapBytesRequest appends a QueryClientPixmapBytes request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryClientPixmapBytesRequest(buf []byte, c *xgb.Conn, Xid uint32) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["X-Resource"]
@@ -694,15 +792,43 @@ func queryClientPixmapBytesRequest(c *xgb.Conn, Xid uint32) []byte {
 	buf[b] = 3 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Xid)
+	order.Put32(buf[b:], Xid)
 	b += 4
 
 	return buf
 }
 
+// QueryClientPixmapBytesRequest represents the data of a QueryClientPixmapBytes request, as it is read when tracing a connection.
+type QueryClientPixmapBytesRequest struct {
+	Xid uint32
+}
+
+// queryClientPixmapBytesRequestRead reads a byte slice in the byte order 'order' into a QueryClientPixmapBytesRequest value.
+func queryClientPixmapBytesRequestRead(buf []byte, order xgb.ByteOrder) *QueryClientPixmapBytesRequest {
+	v := new(QueryClientPixmapBytesRequest)
+	b := 4 // skip request header
+
+	v.Xid = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["X-Resource"][3] = xgb.RequestInfo{
+		Name: "QueryClientPixmapBytes",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryClientPixmapBytesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryClientPixmapBytesReply(buf, order)
+		},
+	}
+}
+
 // QueryClientResourcesCookie is a cookie used only for QueryClientResources requests.
 type QueryClientResourcesCookie struct {
 	*xgb.Cookie
@@ -753,29 +879,41 @@ func (cook QueryClientResourcesCookie) Reply() (*QueryClientResourcesReply, erro
 	if buf == nil {
 		return nil, nil
 	}
-	return queryClientResourcesReply(buf), nil
+	return queryClientResourcesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryClientResources request arrives.
+func (cook QueryClientResourcesCookie) ReplyContext(ctx context.Context) (*QueryClientResourcesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryClientResourcesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryClientResourcesReply reads a byte slice into a QueryClientResourcesReply value.
-func queryClientResourcesReply(buf []byte) *QueryClientResourcesReply {
+// queryClientResourcesReply reads a byte slice in the byte order 'order' into a QueryClientResourcesReply value.
+func queryClientResourcesReply(buf []byte, order xgb.ByteOrder) *QueryClientResourcesReply {
 	v := new(QueryClientResourcesReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.NumTypes = xgb.Get32(buf[b:])
+	v.NumTypes = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
 
 	v.Types = make([]Type, v.NumTypes)
-	b += TypeReadList(buf[b:], v.Types)
+	b += TypeReadList(buf[b:], order, v.Types)
 
 	return v
 }
@@ -783,9 +921,18 @@ func queryClientResourcesReply(buf []byte) *QueryClientResourcesReply {
 // Write request to wire for QueryClientResources
 // queryClientResourcesRequest writes a QueryClientResources request to a byte slice.
 func queryClientResourcesRequest(c *xgb.Conn, Xid uint32) []byte {
+	return AppendQueryClientResourcesRequest(nil, c, Xid)
+}
+
+// AppendQueryClientResourcesRequest appends a QueryClientResources request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryClientResourcesRequest(buf []byte, c *xgb.Conn, Xid uint32) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["X-Resource"]
@@ -795,15 +942,43 @@ func queryClientResourcesRequest(c *xgb.Conn, Xid uint32) []byte {
 	buf[b] = 2 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Xid)
+	order.Put32(buf[b:], Xid)
 	b += 4
 
 	return buf
 }
 
+// QueryClientResourcesRequest represents the data of a QueryClientResources request, as it is read when tracing a connection.
+type QueryClientResourcesRequest struct {
+	Xid uint32
+}
+
+// queryClientResourcesRequestRead reads a byte slice in the byte order 'order' into a QueryClientResourcesRequest value.
+func queryClientResourcesRequestRead(buf []byte, order xgb.ByteOrder) *QueryClientResourcesRequest {
+	v := new(QueryClientResourcesRequest)
+	b := 4 // skip request header
+
+	v.Xid = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["X-Resource"][2] = xgb.RequestInfo{
+		Name: "QueryClientResources",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryClientResourcesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryClientResourcesReply(buf, order)
+		},
+	}
+}
+
 // QueryClientsCookie is a cookie used only for QueryClients requests.
 type QueryClientsCookie struct {
 	*xgb.Cookie
@@ -854,29 +1029,41 @@ func (cook QueryClientsCookie) Reply() (*QueryClientsReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryClientsReply(buf), nil
+	return queryClientsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryClientsReply reads a byte slice into a QueryClientsReply value.
-func queryClientsReply(buf []byte) *QueryClientsReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryClients request arrives.
+func (cook QueryClientsCookie) ReplyContext(ctx context.Context) (*QueryClientsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryClientsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryClientsReply reads a byte slice in the byte order 'order' into a QueryClientsReply value.
+func queryClientsReply(buf []byte, order xgb.ByteOrder) *QueryClientsReply {
 	v := new(QueryClientsReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.NumClients = xgb.Get32(buf[b:])
+	v.NumClients = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
 
 	v.Clients = make([]Client, v.NumClients)
-	b += ClientReadList(buf[b:], v.Clients)
+	b += ClientReadList(buf[b:], order, v.Clients)
 
 	return v
 }
@@ -884,9 +1071,18 @@ func queryClientsReply(buf []byte) *QueryClientsReply {
 // Write request to wire for QueryClients
 // queryClientsRequest writes a QueryClients request to a byte slice.
 func queryClientsRequest(c *xgb.Conn) []byte {
+	return AppendQueryClientsRequest(nil, c)
+}
+
+// AppendQueryClientsRequest appends a QueryClients request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryClientsRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["X-Resource"]
@@ -896,12 +1092,34 @@ func queryClientsRequest(c *xgb.Conn) []byte {
 	buf[b] = 1 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// QueryClientsRequest represents the data of a QueryClients request, as it is read when tracing a connection.
+type QueryClientsRequest struct {
+}
+
+// queryClientsRequestRead reads a byte slice in the byte order 'order' into a QueryClientsRequest value.
+func queryClientsRequestRead(buf []byte, order xgb.ByteOrder) *QueryClientsRequest {
+	v := new(QueryClientsRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["X-Resource"][1] = xgb.RequestInfo{
+		Name: "QueryClients",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryClientsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryClientsReply(buf, order)
+		},
+	}
+}
+
 // QueryResourceBytesCookie is a cookie used only for QueryResourceBytes requests.
 type QueryResourceBytesCookie struct {
 	*xgb.Cookie
@@ -952,29 +1170,41 @@ func (cook QueryResourceBytesCookie) Reply() (*QueryResourceBytesReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryResourceBytesReply(buf), nil
+	return queryResourceBytesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryResourceBytesReply reads a byte slice into a QueryResourceBytesReply value.
-func queryResourceBytesReply(buf []byte) *QueryResourceBytesReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryResourceBytes request arrives.
+func (cook QueryResourceBytesCookie) ReplyContext(ctx context.Context) (*QueryResourceBytesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryResourceBytesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryResourceBytesReply reads a byte slice in the byte order 'order' into a QueryResourceBytesReply value.
+func queryResourceBytesReply(buf []byte, order xgb.ByteOrder) *QueryResourceBytesReply {
 	v := new(QueryResourceBytesReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.NumSizes = xgb.Get32(buf[b:])
+	v.NumSizes = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
 
 	v.Sizes = make([]ResourceSizeValue, v.NumSizes)
-	b += ResourceSizeValueReadList(buf[b:], v.Sizes)
+	b += ResourceSizeValueReadList(buf[b:], order, v.Sizes)
 
 	return v
 }
@@ -982,9 +1212,18 @@ func queryResourceBytesReply(buf []byte) *QueryResourceBytesReply {
 // Write request to wire for QueryResourceBytes
 // queryResourceBytesRequest writes a QueryResourceBytes request to a byte slice.
 func queryResourceBytesRequest(c *xgb.Conn, Client uint32, NumSpecs uint32, Specs []ResourceIdSpec) []byte {
+	return AppendQueryResourceBytesRequest(nil, c, Client, NumSpecs, Specs)
+}
+
+// AppendQueryResourceBytesRequest appends a QueryResourceBytes request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryResourceBytesRequest(buf []byte, c *xgb.Conn, Client uint32, NumSpecs uint32, Specs []ResourceIdSpec) []byte {
 	size := xgb.Pad((12 + xgb.Pad((int(NumSpecs) * 8))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["X-Resource"]
@@ -994,20 +1233,56 @@ func queryResourceBytesRequest(c *xgb.Conn, Client uint32, NumSpecs uint32, Spec
 	buf[b] = 5 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Client)
+	order.Put32(buf[b:], Client)
 	b += 4
 
-	xgb.Put32(buf[b:], NumSpecs)
+	order.Put32(buf[b:], NumSpecs)
 	b += 4
 
-	b += ResourceIdSpecListBytes(buf[b:], Specs)
+	b += ResourceIdSpecListBytes(buf[b:], order, Specs)
 
 	return buf
 }
 
+// QueryResourceBytesRequest represents the data of a QueryResourceBytes request, as it is read when tracing a connection.
+type QueryResourceBytesRequest struct {
+	Client   uint32
+	NumSpecs uint32
+	Specs    []ResourceIdSpec // size: xgb.Pad((int(NumSpecs) * 8))
+}
+
+// queryResourceBytesRequestRead reads a byte slice in the byte order 'order' into a QueryResourceBytesRequest value.
+func queryResourceBytesRequestRead(buf []byte, order xgb.ByteOrder) *QueryResourceBytesRequest {
+	v := new(QueryResourceBytesRequest)
+	b := 4 // skip request header
+
+	v.Client = order.Get32(buf[b:])
+	b += 4
+
+	v.NumSpecs = order.Get32(buf[b:])
+	b += 4
+
+	v.Specs = make([]ResourceIdSpec, v.NumSpecs)
+	b += ResourceIdSpecReadList(buf[b:], order, v.Specs)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["X-Resource"][5] = xgb.RequestInfo{
+		Name: "QueryResourceBytes",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryResourceBytesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryResourceBytesReply(buf, order)
+		},
+	}
+}
+
 // QueryVersionCookie is a cookie used only for QueryVersion requests.
 type QueryVersionCookie struct {
 	*xgb.Cookie
@@ -1057,26 +1332,38 @@ func (cook QueryVersionCookie) Reply() (*QueryVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryVersionReply(buf), nil
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryVersion request arrives.
+func (cook QueryVersionCookie) ReplyContext(ctx context.Context) (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryVersionReply reads a byte slice into a QueryVersionReply value.
-func queryVersionReply(buf []byte) *QueryVersionReply {
+// queryVersionReply reads a byte slice in the byte order 'order' into a QueryVersionReply value.
+func queryVersionReply(buf []byte, order xgb.ByteOrder) *QueryVersionReply {
 	v := new(QueryVersionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ServerMajor = xgb.Get16(buf[b:])
+	v.ServerMajor = order.Get16(buf[b:])
 	b += 2
 
-	v.ServerMinor = xgb.Get16(buf[b:])
+	v.ServerMinor = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -1085,9 +1372,18 @@ func queryVersionReply(buf []byte) *QueryVersionReply {
 // Write request to wire for QueryVersion
 // queryVersionRequest writes a QueryVersion request to a byte slice.
 func queryVersionRequest(c *xgb.Conn, ClientMajor byte, ClientMinor byte) []byte {
+	return AppendQueryVersionRequest(nil, c, ClientMajor, ClientMinor)
+}
+
+// AppendQueryVersionRequest appends a QueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryVersionRequest(buf []byte, c *xgb.Conn, ClientMajor byte, ClientMinor byte) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["X-Resource"]
@@ -1097,7 +1393,7 @@ func queryVersionRequest(c *xgb.Conn, ClientMajor byte, ClientMinor byte) []byte
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	buf[b] = ClientMajor
@@ -1108,3 +1404,35 @@ func queryVersionRequest(c *xgb.Conn, ClientMajor byte, ClientMinor byte) []byte
 
 	return buf
 }
+
+// QueryVersionRequest represents the data of a QueryVersion request, as it is read when tracing a connection.
+type QueryVersionRequest struct {
+	ClientMajor byte
+	ClientMinor byte
+}
+
+// queryVersionRequestRead reads a byte slice in the byte order 'order' into a QueryVersionRequest value.
+func queryVersionRequestRead(buf []byte, order xgb.ByteOrder) *QueryVersionRequest {
+	v := new(QueryVersionRequest)
+	b := 4 // skip request header
+
+	v.ClientMajor = buf[b]
+	b += 1
+
+	v.ClientMinor = buf[b]
+	b += 1
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["X-Resource"][0] = xgb.RequestInfo{
+		Name: "QueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionReply(buf, order)
+		},
+	}
+}
@@ -0,0 +1,72 @@
+package xgb
+
+// Sync sends a round trip request and waits for the response.
+// This forces all pending cookies to be dealt with.
+// You actually shouldn't need to use this like you might with Xlib. Namely,
+// buffers are automatically flushed using Go's channels and round trip requests
+// are forced where appropriate automatically.
+func (c *Conn) Sync() {
+	cookie := c.NewCookie(true, true)
+	c.NewRequest(c.getInputFocusRequest(), cookie)
+	cookie.Reply() // wait for the buffer to clear
+}
+
+// getInputFocusRequest writes the raw bytes to a buffer.
+// It is duplicated from xproto/xproto.go.
+func (c *Conn) getInputFocusRequest() []byte {
+	size := 4
+	b := 0
+	buf := make([]byte, size)
+
+	buf[b] = 43 // request opcode
+	b += 1
+
+	b += 1                                 // padding
+	c.order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	return buf
+}
+
+// queryExtension asks the X server for the major opcode of the extension
+// called 'name'. An error is returned if the server doesn't support it.
+// It is used for the extensions that XGB itself relies on, since this
+// package can't import xproto.
+func (c *Conn) queryExtension(name string) (byte, error) {
+	cookie := c.NewCookie(true, true)
+	c.NewRequest(c.queryExtensionRequest(name), cookie)
+	reply, err := cookie.Reply()
+	if err != nil {
+		return 0, err
+	}
+	if reply[8] == 0 { // present
+		return 0, Errorf("No extension named %s could be found on the "+
+			"server.", name)
+	}
+	return reply[9], nil // major opcode
+}
+
+// queryExtensionRequest writes the raw bytes of a QueryExtension request
+// for the extension called 'name' to a buffer.
+// It is duplicated from xproto/xproto.go.
+func (c *Conn) queryExtensionRequest(name string) []byte {
+	size := Pad(8 + len(name))
+	b := 0
+	buf := make([]byte, size)
+
+	buf[b] = 98 // request opcode
+	b += 1
+
+	b += 1                                 // padding
+	c.order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	c.order.Put16(buf[b:], uint16(len(name)))
+	b += 2
+
+	b += 2 // padding
+
+	copy(buf[b:], name)
+
+	return buf
+}
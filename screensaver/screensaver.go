@@ -4,6 +4,8 @@ package screensaver
 // This file is automatically generated from screensaver.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xproto"
@@ -22,66 +24,101 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["MIT-SCREEN-SAVER"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["MIT-SCREEN-SAVER"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["MIT-SCREEN-SAVER"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["MIT-SCREEN-SAVER"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["MIT-SCREEN-SAVER"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "MIT-SCREEN-SAVER", xgb.ExtRequestInfos["MIT-SCREEN-SAVER"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["MIT-SCREEN-SAVER"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["MIT-SCREEN-SAVER"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["MIT-SCREEN-SAVER"] = make(map[int]xgb.RequestInfo)
 }
 
+type Event uint32
+
 const (
-	EventNotifyMask = 1
-	EventCycleMask  = 2
+	EventNotifyMask Event = 1
+	EventCycleMask  Event = 2
 )
 
+// String returns the names of the Event bits set in a value.
+func (v Event) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 2)
+	if v&EventNotifyMask != 0 {
+		names = append(names, "NotifyMask")
+		v &^= EventNotifyMask
+	}
+	if v&EventCycleMask != 0 {
+		names = append(names, "CycleMask")
+		v &^= EventCycleMask
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint32(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
+type Kind byte
+
 const (
-	KindBlanked  = 0
-	KindInternal = 1
-	KindExternal = 2
+	KindBlanked  Kind = 0
+	KindInternal Kind = 1
+	KindExternal Kind = 2
 )
 
+// String returns the name of the Kind item with this value.
+func (v Kind) String() string {
+	switch v {
+	case KindBlanked:
+		return "Blanked"
+	case KindInternal:
+		return "Internal"
+	case KindExternal:
+		return "External"
+	}
+	return xgb.Sprintf("Kind(%d)", byte(v))
+}
+
 // Notify is the event number for a NotifyEvent.
 const Notify = 0
 
 type NotifyEvent struct {
 	Sequence uint16
-	State    byte
+	State    State
 	Time     xproto.Timestamp
 	Root     xproto.Window
 	Window   xproto.Window
-	Kind     byte
+	Kind     Kind
 	Forced   bool
 	// padding: 14 bytes
 }
 
-// NotifyEventNew constructs a NotifyEvent value that implements xgb.Event from a byte slice.
-func NotifyEventNew(buf []byte) xgb.Event {
+// NotifyEventNew constructs a NotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func NotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := NotifyEvent{}
 	b := 1 // don't read event number
 
-	v.State = buf[b]
+	v.State = State(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Time = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Time = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.Root = xproto.Window(xgb.Get32(buf[b:]))
+	v.Root = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Window = xproto.Window(xgb.Get32(buf[b:]))
+	v.Window = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Kind = buf[b]
+	v.Kind = Kind(buf[b])
 	b += 1
 
 	if buf[b] == 1 {
@@ -96,8 +133,8 @@ func NotifyEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a NotifyEvent value to a byte slice.
-func (v NotifyEvent) Bytes() []byte {
+// Bytes writes a NotifyEvent value to a byte slice, in the byte order 'order'.
+func (v NotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -105,21 +142,21 @@ func (v NotifyEvent) Bytes() []byte {
 	buf[b] = 0
 	b += 1
 
-	buf[b] = v.State
+	buf[b] = byte(v.State)
 	b += 1
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Time))
+	order.Put32(buf[b:], uint32(v.Time))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Root))
+	order.Put32(buf[b:], uint32(v.Root))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
-	buf[b] = v.Kind
+	buf[b] = byte(v.Kind)
 	b += 1
 
 	if v.Forced {
@@ -145,26 +182,49 @@ func (v NotifyEvent) SequenceId() uint16 {
 func (v NotifyEvent) String() string {
 	fieldVals := make([]string, 0, 7)
 	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
-	fieldVals = append(fieldVals, xgb.Sprintf("State: %d", v.State))
+	fieldVals = append(fieldVals, xgb.Sprintf("State: %s", v.State))
 	fieldVals = append(fieldVals, xgb.Sprintf("Time: %d", v.Time))
 	fieldVals = append(fieldVals, xgb.Sprintf("Root: %d", v.Root))
 	fieldVals = append(fieldVals, xgb.Sprintf("Window: %d", v.Window))
-	fieldVals = append(fieldVals, xgb.Sprintf("Kind: %d", v.Kind))
+	fieldVals = append(fieldVals, xgb.Sprintf("Kind: %s", v.Kind))
 	fieldVals = append(fieldVals, xgb.Sprintf("Forced: %t", v.Forced))
 	return "Notify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the Notify event is about.
+// It is used to subscribe to the events of a single window.
+func (v NotifyEvent) EventWindow() uint32 {
+	return uint32(v.Window)
+}
+
 func init() {
 	xgb.NewExtEventFuncs["MIT-SCREEN-SAVER"][0] = NotifyEventNew
 }
 
+type State byte
+
 const (
-	StateOff      = 0
-	StateOn       = 1
-	StateCycle    = 2
-	StateDisabled = 3
+	StateOff      State = 0
+	StateOn       State = 1
+	StateCycle    State = 2
+	StateDisabled State = 3
 )
 
+// String returns the name of the State item with this value.
+func (v State) String() string {
+	switch v {
+	case StateOff:
+		return "Off"
+	case StateOn:
+		return "On"
+	case StateCycle:
+		return "Cycle"
+	case StateDisabled:
+		return "Disabled"
+	}
+	return xgb.Sprintf("State(%d)", byte(v))
+}
+
 // Skipping definition for base type 'Bool'
 
 // Skipping definition for base type 'Byte'
@@ -189,6 +249,8 @@ const (
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // QueryInfoCookie is a cookie used only for QueryInfo requests.
 type QueryInfoCookie struct {
 	*xgb.Cookie
@@ -224,12 +286,12 @@ func QueryInfoUnchecked(c *xgb.Conn, Drawable xproto.Drawable) QueryInfoCookie {
 type QueryInfoReply struct {
 	Sequence         uint16 // sequence number of the request for this reply
 	Length           uint32 // number of bytes in this reply
-	State            byte
+	State            State
 	SaverWindow      xproto.Window
 	MsUntilServer    uint32
 	MsSinceUserInput uint32
-	EventMask        uint32
-	Kind             byte
+	EventMask        Event
+	Kind             Kind
 	// padding: 7 bytes
 }
 
@@ -242,36 +304,48 @@ func (cook QueryInfoCookie) Reply() (*QueryInfoReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryInfoReply(buf), nil
+	return queryInfoReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryInfoReply reads a byte slice into a QueryInfoReply value.
-func queryInfoReply(buf []byte) *QueryInfoReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryInfo request arrives.
+func (cook QueryInfoCookie) ReplyContext(ctx context.Context) (*QueryInfoReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryInfoReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryInfoReply reads a byte slice in the byte order 'order' into a QueryInfoReply value.
+func queryInfoReply(buf []byte, order xgb.ByteOrder) *QueryInfoReply {
 	v := new(QueryInfoReply)
 	b := 1 // skip reply determinant
 
-	v.State = buf[b]
+	v.State = State(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.SaverWindow = xproto.Window(xgb.Get32(buf[b:]))
+	v.SaverWindow = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.MsUntilServer = xgb.Get32(buf[b:])
+	v.MsUntilServer = order.Get32(buf[b:])
 	b += 4
 
-	v.MsSinceUserInput = xgb.Get32(buf[b:])
+	v.MsSinceUserInput = order.Get32(buf[b:])
 	b += 4
 
-	v.EventMask = xgb.Get32(buf[b:])
+	v.EventMask = Event(order.Get32(buf[b:]))
 	b += 4
 
-	v.Kind = buf[b]
+	v.Kind = Kind(buf[b])
 	b += 1
 
 	b += 7 // padding
@@ -282,9 +356,18 @@ func queryInfoReply(buf []byte) *QueryInfoReply {
 // Write request to wire for QueryInfo
 // queryInfoRequest writes a QueryInfo request to a byte slice.
 func queryInfoRequest(c *xgb.Conn, Drawable xproto.Drawable) []byte {
+	return AppendQueryInfoRequest(nil, c, Drawable)
+}
+
+// AppendQueryInfoRequest appends a QueryInfo request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryInfoRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["MIT-SCREEN-SAVER"]
@@ -294,15 +377,43 @@ func queryInfoRequest(c *xgb.Conn, Drawable xproto.Drawable) []byte {
 	buf[b] = 1 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
 	return buf
 }
 
+// QueryInfoRequest represents the data of a QueryInfo request, as it is read when tracing a connection.
+type QueryInfoRequest struct {
+	Drawable xproto.Drawable
+}
+
+// queryInfoRequestRead reads a byte slice in the byte order 'order' into a QueryInfoRequest value.
+func queryInfoRequestRead(buf []byte, order xgb.ByteOrder) *QueryInfoRequest {
+	v := new(QueryInfoRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["MIT-SCREEN-SAVER"][1] = xgb.RequestInfo{
+		Name: "QueryInfo",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryInfoRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryInfoReply(buf, order)
+		},
+	}
+}
+
 // QueryVersionCookie is a cookie used only for QueryVersion requests.
 type QueryVersionCookie struct {
 	*xgb.Cookie
@@ -353,26 +464,38 @@ func (cook QueryVersionCookie) Reply() (*QueryVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryVersionReply(buf), nil
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryVersionReply reads a byte slice into a QueryVersionReply value.
-func queryVersionReply(buf []byte) *QueryVersionReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryVersion request arrives.
+func (cook QueryVersionCookie) ReplyContext(ctx context.Context) (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryVersionReply reads a byte slice in the byte order 'order' into a QueryVersionReply value.
+func queryVersionReply(buf []byte, order xgb.ByteOrder) *QueryVersionReply {
 	v := new(QueryVersionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ServerMajorVersion = xgb.Get16(buf[b:])
+	v.ServerMajorVersion = order.Get16(buf[b:])
 	b += 2
 
-	v.ServerMinorVersion = xgb.Get16(buf[b:])
+	v.ServerMinorVersion = order.Get16(buf[b:])
 	b += 2
 
 	b += 20 // padding
@@ -383,9 +506,18 @@ func queryVersionReply(buf []byte) *QueryVersionReply {
 // Write request to wire for QueryVersion
 // queryVersionRequest writes a QueryVersion request to a byte slice.
 func queryVersionRequest(c *xgb.Conn, ClientMajorVersion byte, ClientMinorVersion byte) []byte {
+	return AppendQueryVersionRequest(nil, c, ClientMajorVersion, ClientMinorVersion)
+}
+
+// AppendQueryVersionRequest appends a QueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryVersionRequest(buf []byte, c *xgb.Conn, ClientMajorVersion byte, ClientMinorVersion byte) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["MIT-SCREEN-SAVER"]
@@ -395,7 +527,7 @@ func queryVersionRequest(c *xgb.Conn, ClientMajorVersion byte, ClientMinorVersio
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	buf[b] = ClientMajorVersion
@@ -409,6 +541,41 @@ func queryVersionRequest(c *xgb.Conn, ClientMajorVersion byte, ClientMinorVersio
 	return buf
 }
 
+// QueryVersionRequest represents the data of a QueryVersion request, as it is read when tracing a connection.
+type QueryVersionRequest struct {
+	ClientMajorVersion byte
+	ClientMinorVersion byte
+	// padding: 2 bytes
+}
+
+// queryVersionRequestRead reads a byte slice in the byte order 'order' into a QueryVersionRequest value.
+func queryVersionRequestRead(buf []byte, order xgb.ByteOrder) *QueryVersionRequest {
+	v := new(QueryVersionRequest)
+	b := 4 // skip request header
+
+	v.ClientMajorVersion = buf[b]
+	b += 1
+
+	v.ClientMinorVersion = buf[b]
+	b += 1
+
+	b += 2 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["MIT-SCREEN-SAVER"][0] = xgb.RequestInfo{
+		Name: "QueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionReply(buf, order)
+		},
+	}
+}
+
 // SelectInputCookie is a cookie used only for SelectInput requests.
 type SelectInputCookie struct {
 	*xgb.Cookie
@@ -416,7 +583,7 @@ type SelectInputCookie struct {
 
 // SelectInput sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func SelectInput(c *xgb.Conn, Drawable xproto.Drawable, EventMask uint32) SelectInputCookie {
+func SelectInput(c *xgb.Conn, Drawable xproto.Drawable, EventMask Event) SelectInputCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["MIT-SCREEN-SAVER"]; !ok {
@@ -429,7 +596,7 @@ func SelectInput(c *xgb.Conn, Drawable xproto.Drawable, EventMask uint32) Select
 
 // SelectInputChecked sends a checked request.
 // If an error occurs, it can be retrieved using SelectInputCookie.Check()
-func SelectInputChecked(c *xgb.Conn, Drawable xproto.Drawable, EventMask uint32) SelectInputCookie {
+func SelectInputChecked(c *xgb.Conn, Drawable xproto.Drawable, EventMask Event) SelectInputCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["MIT-SCREEN-SAVER"]; !ok {
@@ -446,12 +613,26 @@ func (cook SelectInputCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SelectInputCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SelectInput
 // selectInputRequest writes a SelectInput request to a byte slice.
-func selectInputRequest(c *xgb.Conn, Drawable xproto.Drawable, EventMask uint32) []byte {
+func selectInputRequest(c *xgb.Conn, Drawable xproto.Drawable, EventMask Event) []byte {
+	return AppendSelectInputRequest(nil, c, Drawable, EventMask)
+}
+
+// AppendSelectInputRequest appends a SelectInput request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSelectInputRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable, EventMask Event) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["MIT-SCREEN-SAVER"]
@@ -461,18 +642,47 @@ func selectInputRequest(c *xgb.Conn, Drawable xproto.Drawable, EventMask uint32)
 	buf[b] = 2 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], EventMask)
+	order.Put32(buf[b:], uint32(EventMask))
 	b += 4
 
 	return buf
 }
 
+// SelectInputRequest represents the data of a SelectInput request, as it is read when tracing a connection.
+type SelectInputRequest struct {
+	Drawable  xproto.Drawable
+	EventMask Event
+}
+
+// selectInputRequestRead reads a byte slice in the byte order 'order' into a SelectInputRequest value.
+func selectInputRequestRead(buf []byte, order xgb.ByteOrder) *SelectInputRequest {
+	v := new(SelectInputRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.EventMask = Event(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["MIT-SCREEN-SAVER"][2] = xgb.RequestInfo{
+		Name: "SelectInput",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return selectInputRequestRead(buf, order)
+		},
+	}
+}
+
 // SetAttributesCookie is a cookie used only for SetAttributes requests.
 type SetAttributesCookie struct {
 	*xgb.Cookie
@@ -480,7 +690,7 @@ type SetAttributesCookie struct {
 
 // SetAttributes sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func SetAttributes(c *xgb.Conn, Drawable xproto.Drawable, X int16, Y int16, Width uint16, Height uint16, BorderWidth uint16, Class byte, Depth byte, Visual xproto.Visualid, ValueMask uint32, ValueList []uint32) SetAttributesCookie {
+func SetAttributes(c *xgb.Conn, Drawable xproto.Drawable, X int16, Y int16, Width uint16, Height uint16, BorderWidth uint16, Class xproto.WindowClass, Depth byte, Visual xproto.Visualid, ValueMask xproto.Cw, ValueList []uint32) SetAttributesCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["MIT-SCREEN-SAVER"]; !ok {
@@ -493,7 +703,7 @@ func SetAttributes(c *xgb.Conn, Drawable xproto.Drawable, X int16, Y int16, Widt
 
 // SetAttributesChecked sends a checked request.
 // If an error occurs, it can be retrieved using SetAttributesCookie.Check()
-func SetAttributesChecked(c *xgb.Conn, Drawable xproto.Drawable, X int16, Y int16, Width uint16, Height uint16, BorderWidth uint16, Class byte, Depth byte, Visual xproto.Visualid, ValueMask uint32, ValueList []uint32) SetAttributesCookie {
+func SetAttributesChecked(c *xgb.Conn, Drawable xproto.Drawable, X int16, Y int16, Width uint16, Height uint16, BorderWidth uint16, Class xproto.WindowClass, Depth byte, Visual xproto.Visualid, ValueMask xproto.Cw, ValueList []uint32) SetAttributesCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["MIT-SCREEN-SAVER"]; !ok {
@@ -510,12 +720,26 @@ func (cook SetAttributesCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetAttributesCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetAttributes
 // setAttributesRequest writes a SetAttributes request to a byte slice.
-func setAttributesRequest(c *xgb.Conn, Drawable xproto.Drawable, X int16, Y int16, Width uint16, Height uint16, BorderWidth uint16, Class byte, Depth byte, Visual xproto.Visualid, ValueMask uint32, ValueList []uint32) []byte {
+func setAttributesRequest(c *xgb.Conn, Drawable xproto.Drawable, X int16, Y int16, Width uint16, Height uint16, BorderWidth uint16, Class xproto.WindowClass, Depth byte, Visual xproto.Visualid, ValueMask xproto.Cw, ValueList []uint32) []byte {
+	return AppendSetAttributesRequest(nil, c, Drawable, X, Y, Width, Height, BorderWidth, Class, Depth, Visual, ValueMask, ValueList)
+}
+
+// AppendSetAttributesRequest appends a SetAttributes request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetAttributesRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable, X int16, Y int16, Width uint16, Height uint16, BorderWidth uint16, Class xproto.WindowClass, Depth byte, Visual xproto.Visualid, ValueMask xproto.Cw, ValueList []uint32) []byte {
 	size := xgb.Pad((24 + (4 + xgb.Pad((4 * xgb.PopCount(int(ValueMask)))))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["MIT-SCREEN-SAVER"]
@@ -525,40 +749,40 @@ func setAttributesRequest(c *xgb.Conn, Drawable xproto.Drawable, X int16, Y int1
 	buf[b] = 3 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(X))
+	order.Put16(buf[b:], uint16(X))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(Y))
+	order.Put16(buf[b:], uint16(Y))
 	b += 2
 
-	xgb.Put16(buf[b:], Width)
+	order.Put16(buf[b:], Width)
 	b += 2
 
-	xgb.Put16(buf[b:], Height)
+	order.Put16(buf[b:], Height)
 	b += 2
 
-	xgb.Put16(buf[b:], BorderWidth)
+	order.Put16(buf[b:], BorderWidth)
 	b += 2
 
-	buf[b] = Class
+	buf[b] = byte(Class)
 	b += 1
 
 	buf[b] = Depth
 	b += 1
 
-	xgb.Put32(buf[b:], uint32(Visual))
+	order.Put32(buf[b:], uint32(Visual))
 	b += 4
 
-	xgb.Put32(buf[b:], ValueMask)
+	order.Put32(buf[b:], uint32(ValueMask))
 	b += 4
 	for i := 0; i < xgb.PopCount(int(ValueMask)); i++ {
-		xgb.Put32(buf[b:], ValueList[i])
+		order.Put32(buf[b:], ValueList[i])
 		b += 4
 	}
 	b = xgb.Pad(b)
@@ -566,6 +790,75 @@ func setAttributesRequest(c *xgb.Conn, Drawable xproto.Drawable, X int16, Y int1
 	return buf
 }
 
+// SetAttributesRequest represents the data of a SetAttributes request, as it is read when tracing a connection.
+type SetAttributesRequest struct {
+	Drawable    xproto.Drawable
+	X           int16
+	Y           int16
+	Width       uint16
+	Height      uint16
+	BorderWidth uint16
+	Class       xproto.WindowClass
+	Depth       byte
+	Visual      xproto.Visualid
+	ValueMask   xproto.Cw
+	ValueList   []uint32
+}
+
+// setAttributesRequestRead reads a byte slice in the byte order 'order' into a SetAttributesRequest value.
+func setAttributesRequestRead(buf []byte, order xgb.ByteOrder) *SetAttributesRequest {
+	v := new(SetAttributesRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.X = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Y = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	v.BorderWidth = order.Get16(buf[b:])
+	b += 2
+
+	v.Class = xproto.WindowClass(buf[b])
+	b += 1
+
+	v.Depth = buf[b]
+	b += 1
+
+	v.Visual = xproto.Visualid(order.Get32(buf[b:]))
+	b += 4
+
+	v.ValueMask = xproto.Cw(order.Get32(buf[b:]))
+	b += 4
+
+	v.ValueList = make([]uint32, xgb.PopCount(int(v.ValueMask)))
+	for i := 0; i < xgb.PopCount(int(v.ValueMask)); i++ {
+		v.ValueList[i] = order.Get32(buf[b:])
+		b += 4
+	}
+	b = xgb.Pad(b)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["MIT-SCREEN-SAVER"][3] = xgb.RequestInfo{
+		Name: "SetAttributes",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setAttributesRequestRead(buf, order)
+		},
+	}
+}
+
 // SuspendCookie is a cookie used only for Suspend requests.
 type SuspendCookie struct {
 	*xgb.Cookie
@@ -603,12 +896,26 @@ func (cook SuspendCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SuspendCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for Suspend
 // suspendRequest writes a Suspend request to a byte slice.
 func suspendRequest(c *xgb.Conn, Suspend bool) []byte {
+	return AppendSuspendRequest(nil, c, Suspend)
+}
+
+// AppendSuspendRequest appends a Suspend request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSuspendRequest(buf []byte, c *xgb.Conn, Suspend bool) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["MIT-SCREEN-SAVER"]
@@ -618,7 +925,7 @@ func suspendRequest(c *xgb.Conn, Suspend bool) []byte {
 	buf[b] = 5 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	if Suspend {
@@ -633,6 +940,38 @@ func suspendRequest(c *xgb.Conn, Suspend bool) []byte {
 	return buf
 }
 
+// SuspendRequest represents the data of a Suspend request, as it is read when tracing a connection.
+type SuspendRequest struct {
+	Suspend bool
+	// padding: 3 bytes
+}
+
+// suspendRequestRead reads a byte slice in the byte order 'order' into a SuspendRequest value.
+func suspendRequestRead(buf []byte, order xgb.ByteOrder) *SuspendRequest {
+	v := new(SuspendRequest)
+	b := 4 // skip request header
+
+	if buf[b] == 1 {
+		v.Suspend = true
+	} else {
+		v.Suspend = false
+	}
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["MIT-SCREEN-SAVER"][5] = xgb.RequestInfo{
+		Name: "Suspend",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return suspendRequestRead(buf, order)
+		},
+	}
+}
+
 // UnsetAttributesCookie is a cookie used only for UnsetAttributes requests.
 type UnsetAttributesCookie struct {
 	*xgb.Cookie
@@ -670,12 +1009,26 @@ func (cook UnsetAttributesCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook UnsetAttributesCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for UnsetAttributes
 // unsetAttributesRequest writes a UnsetAttributes request to a byte slice.
 func unsetAttributesRequest(c *xgb.Conn, Drawable xproto.Drawable) []byte {
+	return AppendUnsetAttributesRequest(nil, c, Drawable)
+}
+
+// AppendUnsetAttributesRequest appends a UnsetAttributes request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendUnsetAttributesRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["MIT-SCREEN-SAVER"]
@@ -685,11 +1038,36 @@ func unsetAttributesRequest(c *xgb.Conn, Drawable xproto.Drawable) []byte {
 	buf[b] = 4 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
 	return buf
 }
+
+// UnsetAttributesRequest represents the data of a UnsetAttributes request, as it is read when tracing a connection.
+type UnsetAttributesRequest struct {
+	Drawable xproto.Drawable
+}
+
+// unsetAttributesRequestRead reads a byte slice in the byte order 'order' into a UnsetAttributesRequest value.
+func unsetAttributesRequestRead(buf []byte, order xgb.ByteOrder) *UnsetAttributesRequest {
+	v := new(UnsetAttributesRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["MIT-SCREEN-SAVER"][4] = xgb.RequestInfo{
+		Name: "UnsetAttributes",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return unsetAttributesRequestRead(buf, order)
+		},
+	}
+}
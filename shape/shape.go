@@ -4,6 +4,8 @@ package shape
 // This file is automatically generated from shape.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xproto"
@@ -22,18 +24,16 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["SHAPE"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["SHAPE"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["SHAPE"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["SHAPE"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["SHAPE"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "SHAPE", xgb.ExtRequestInfos["SHAPE"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["SHAPE"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["SHAPE"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["SHAPE"] = make(map[int]xgb.RequestInfo)
 }
 
 type Kind byte
@@ -54,33 +54,33 @@ type NotifyEvent struct {
 	// padding: 11 bytes
 }
 
-// NotifyEventNew constructs a NotifyEvent value that implements xgb.Event from a byte slice.
-func NotifyEventNew(buf []byte) xgb.Event {
+// NotifyEventNew constructs a NotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func NotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := NotifyEvent{}
 	b := 1 // don't read event number
 
 	v.ShapeKind = Kind(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.AffectedWindow = xproto.Window(xgb.Get32(buf[b:]))
+	v.AffectedWindow = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.ExtentsX = int16(xgb.Get16(buf[b:]))
+	v.ExtentsX = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.ExtentsY = int16(xgb.Get16(buf[b:]))
+	v.ExtentsY = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.ExtentsWidth = xgb.Get16(buf[b:])
+	v.ExtentsWidth = order.Get16(buf[b:])
 	b += 2
 
-	v.ExtentsHeight = xgb.Get16(buf[b:])
+	v.ExtentsHeight = order.Get16(buf[b:])
 	b += 2
 
-	v.ServerTime = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.ServerTime = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
 	if buf[b] == 1 {
@@ -95,8 +95,8 @@ func NotifyEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a NotifyEvent value to a byte slice.
-func (v NotifyEvent) Bytes() []byte {
+// Bytes writes a NotifyEvent value to a byte slice, in the byte order 'order'.
+func (v NotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -109,22 +109,22 @@ func (v NotifyEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.AffectedWindow))
+	order.Put32(buf[b:], uint32(v.AffectedWindow))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(v.ExtentsX))
+	order.Put16(buf[b:], uint16(v.ExtentsX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.ExtentsY))
+	order.Put16(buf[b:], uint16(v.ExtentsY))
 	b += 2
 
-	xgb.Put16(buf[b:], v.ExtentsWidth)
+	order.Put16(buf[b:], v.ExtentsWidth)
 	b += 2
 
-	xgb.Put16(buf[b:], v.ExtentsHeight)
+	order.Put16(buf[b:], v.ExtentsHeight)
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(v.ServerTime))
+	order.Put32(buf[b:], uint32(v.ServerTime))
 	b += 4
 
 	if v.Shaped {
@@ -205,6 +205,8 @@ const (
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // CombineCookie is a cookie used only for Combine requests.
 type CombineCookie struct {
 	*xgb.Cookie
@@ -242,12 +244,26 @@ func (cook CombineCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CombineCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for Combine
 // combineRequest writes a Combine request to a byte slice.
 func combineRequest(c *xgb.Conn, Operation Op, DestinationKind Kind, SourceKind Kind, DestinationWindow xproto.Window, XOffset int16, YOffset int16, SourceWindow xproto.Window) []byte {
+	return AppendCombineRequest(nil, c, Operation, DestinationKind, SourceKind, DestinationWindow, XOffset, YOffset, SourceWindow)
+}
+
+// AppendCombineRequest appends a Combine request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCombineRequest(buf []byte, c *xgb.Conn, Operation Op, DestinationKind Kind, SourceKind Kind, DestinationWindow xproto.Window, XOffset int16, YOffset int16, SourceWindow xproto.Window) []byte {
 	size := 20
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SHAPE"]
@@ -257,7 +273,7 @@ func combineRequest(c *xgb.Conn, Operation Op, DestinationKind Kind, SourceKind
 	buf[b] = 3 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	buf[b] = byte(Operation)
@@ -271,21 +287,73 @@ func combineRequest(c *xgb.Conn, Operation Op, DestinationKind Kind, SourceKind
 
 	b += 1 // padding
 
-	xgb.Put32(buf[b:], uint32(DestinationWindow))
+	order.Put32(buf[b:], uint32(DestinationWindow))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(XOffset))
+	order.Put16(buf[b:], uint16(XOffset))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(YOffset))
+	order.Put16(buf[b:], uint16(YOffset))
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(SourceWindow))
+	order.Put32(buf[b:], uint32(SourceWindow))
 	b += 4
 
 	return buf
 }
 
+// CombineRequest represents the data of a Combine request, as it is read when tracing a connection.
+type CombineRequest struct {
+	Operation       Op
+	DestinationKind Kind
+	SourceKind      Kind
+	// padding: 1 bytes
+	DestinationWindow xproto.Window
+	XOffset           int16
+	YOffset           int16
+	SourceWindow      xproto.Window
+}
+
+// combineRequestRead reads a byte slice in the byte order 'order' into a CombineRequest value.
+func combineRequestRead(buf []byte, order xgb.ByteOrder) *CombineRequest {
+	v := new(CombineRequest)
+	b := 4 // skip request header
+
+	v.Operation = Op(buf[b])
+	b += 1
+
+	v.DestinationKind = Kind(buf[b])
+	b += 1
+
+	v.SourceKind = Kind(buf[b])
+	b += 1
+
+	b += 1 // padding
+
+	v.DestinationWindow = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.XOffset = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.YOffset = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.SourceWindow = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SHAPE"][3] = xgb.RequestInfo{
+		Name: "Combine",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return combineRequestRead(buf, order)
+		},
+	}
+}
+
 // GetRectanglesCookie is a cookie used only for GetRectangles requests.
 type GetRectanglesCookie struct {
 	*xgb.Cookie
@@ -321,7 +389,7 @@ func GetRectanglesUnchecked(c *xgb.Conn, Window xproto.Window, SourceKind Kind)
 type GetRectanglesReply struct {
 	Sequence      uint16 // sequence number of the request for this reply
 	Length        uint32 // number of bytes in this reply
-	Ordering      byte
+	Ordering      xproto.ClipOrdering
 	RectanglesLen uint32
 	// padding: 20 bytes
 	Rectangles []xproto.Rectangle // size: xgb.Pad((int(RectanglesLen) * 8))
@@ -336,30 +404,42 @@ func (cook GetRectanglesCookie) Reply() (*GetRectanglesReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getRectanglesReply(buf), nil
+	return getRectanglesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getRectanglesReply reads a byte slice into a GetRectanglesReply value.
-func getRectanglesReply(buf []byte) *GetRectanglesReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetRectangles request arrives.
+func (cook GetRectanglesCookie) ReplyContext(ctx context.Context) (*GetRectanglesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getRectanglesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// getRectanglesReply reads a byte slice in the byte order 'order' into a GetRectanglesReply value.
+func getRectanglesReply(buf []byte, order xgb.ByteOrder) *GetRectanglesReply {
 	v := new(GetRectanglesReply)
 	b := 1 // skip reply determinant
 
-	v.Ordering = buf[b]
+	v.Ordering = xproto.ClipOrdering(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.RectanglesLen = xgb.Get32(buf[b:])
+	v.RectanglesLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
 
 	v.Rectangles = make([]xproto.Rectangle, v.RectanglesLen)
-	b += xproto.RectangleReadList(buf[b:], v.Rectangles)
+	b += xproto.RectangleReadList(buf[b:], order, v.Rectangles)
 
 	return v
 }
@@ -367,9 +447,18 @@ func getRectanglesReply(buf []byte) *GetRectanglesReply {
 // Write request to wire for GetRectangles
 // getRectanglesRequest writes a GetRectangles request to a byte slice.
 func getRectanglesRequest(c *xgb.Conn, Window xproto.Window, SourceKind Kind) []byte {
+	return AppendGetRectanglesRequest(nil, c, Window, SourceKind)
+}
+
+// AppendGetRectanglesRequest appends a GetRectangles request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetRectanglesRequest(buf []byte, c *xgb.Conn, Window xproto.Window, SourceKind Kind) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SHAPE"]
@@ -379,10 +468,10 @@ func getRectanglesRequest(c *xgb.Conn, Window xproto.Window, SourceKind Kind) []
 	buf[b] = 8 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	buf[b] = byte(SourceKind)
@@ -393,6 +482,41 @@ func getRectanglesRequest(c *xgb.Conn, Window xproto.Window, SourceKind Kind) []
 	return buf
 }
 
+// GetRectanglesRequest represents the data of a GetRectangles request, as it is read when tracing a connection.
+type GetRectanglesRequest struct {
+	Window     xproto.Window
+	SourceKind Kind
+	// padding: 3 bytes
+}
+
+// getRectanglesRequestRead reads a byte slice in the byte order 'order' into a GetRectanglesRequest value.
+func getRectanglesRequestRead(buf []byte, order xgb.ByteOrder) *GetRectanglesRequest {
+	v := new(GetRectanglesRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.SourceKind = Kind(buf[b])
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SHAPE"][8] = xgb.RequestInfo{
+		Name: "GetRectangles",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getRectanglesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getRectanglesReply(buf, order)
+		},
+	}
+}
+
 // InputSelectedCookie is a cookie used only for InputSelected requests.
 type InputSelectedCookie struct {
 	*xgb.Cookie
@@ -440,11 +564,23 @@ func (cook InputSelectedCookie) Reply() (*InputSelectedReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return inputSelectedReply(buf), nil
+	return inputSelectedReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// inputSelectedReply reads a byte slice into a InputSelectedReply value.
-func inputSelectedReply(buf []byte) *InputSelectedReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a InputSelected request arrives.
+func (cook InputSelectedCookie) ReplyContext(ctx context.Context) (*InputSelectedReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return inputSelectedReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// inputSelectedReply reads a byte slice in the byte order 'order' into a InputSelectedReply value.
+func inputSelectedReply(buf []byte, order xgb.ByteOrder) *InputSelectedReply {
 	v := new(InputSelectedReply)
 	b := 1 // skip reply determinant
 
@@ -455,10 +591,10 @@ func inputSelectedReply(buf []byte) *InputSelectedReply {
 	}
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	return v
@@ -467,9 +603,18 @@ func inputSelectedReply(buf []byte) *InputSelectedReply {
 // Write request to wire for InputSelected
 // inputSelectedRequest writes a InputSelected request to a byte slice.
 func inputSelectedRequest(c *xgb.Conn, DestinationWindow xproto.Window) []byte {
+	return AppendInputSelectedRequest(nil, c, DestinationWindow)
+}
+
+// AppendInputSelectedRequest appends a InputSelected request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendInputSelectedRequest(buf []byte, c *xgb.Conn, DestinationWindow xproto.Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SHAPE"]
@@ -479,15 +624,43 @@ func inputSelectedRequest(c *xgb.Conn, DestinationWindow xproto.Window) []byte {
 	buf[b] = 7 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(DestinationWindow))
+	order.Put32(buf[b:], uint32(DestinationWindow))
 	b += 4
 
 	return buf
 }
 
+// InputSelectedRequest represents the data of a InputSelected request, as it is read when tracing a connection.
+type InputSelectedRequest struct {
+	DestinationWindow xproto.Window
+}
+
+// inputSelectedRequestRead reads a byte slice in the byte order 'order' into a InputSelectedRequest value.
+func inputSelectedRequestRead(buf []byte, order xgb.ByteOrder) *InputSelectedRequest {
+	v := new(InputSelectedRequest)
+	b := 4 // skip request header
+
+	v.DestinationWindow = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SHAPE"][7] = xgb.RequestInfo{
+		Name: "InputSelected",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return inputSelectedRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return inputSelectedReply(buf, order)
+		},
+	}
+}
+
 // MaskCookie is a cookie used only for Mask requests.
 type MaskCookie struct {
 	*xgb.Cookie
@@ -525,12 +698,26 @@ func (cook MaskCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook MaskCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for Mask
 // maskRequest writes a Mask request to a byte slice.
 func maskRequest(c *xgb.Conn, Operation Op, DestinationKind Kind, DestinationWindow xproto.Window, XOffset int16, YOffset int16, SourceBitmap xproto.Pixmap) []byte {
+	return AppendMaskRequest(nil, c, Operation, DestinationKind, DestinationWindow, XOffset, YOffset, SourceBitmap)
+}
+
+// AppendMaskRequest appends a Mask request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendMaskRequest(buf []byte, c *xgb.Conn, Operation Op, DestinationKind Kind, DestinationWindow xproto.Window, XOffset int16, YOffset int16, SourceBitmap xproto.Pixmap) []byte {
 	size := 20
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SHAPE"]
@@ -540,7 +727,7 @@ func maskRequest(c *xgb.Conn, Operation Op, DestinationKind Kind, DestinationWin
 	buf[b] = 2 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	buf[b] = byte(Operation)
@@ -551,21 +738,69 @@ func maskRequest(c *xgb.Conn, Operation Op, DestinationKind Kind, DestinationWin
 
 	b += 2 // padding
 
-	xgb.Put32(buf[b:], uint32(DestinationWindow))
+	order.Put32(buf[b:], uint32(DestinationWindow))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(XOffset))
+	order.Put16(buf[b:], uint16(XOffset))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(YOffset))
+	order.Put16(buf[b:], uint16(YOffset))
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(SourceBitmap))
+	order.Put32(buf[b:], uint32(SourceBitmap))
 	b += 4
 
 	return buf
 }
 
+// MaskRequest represents the data of a Mask request, as it is read when tracing a connection.
+type MaskRequest struct {
+	Operation       Op
+	DestinationKind Kind
+	// padding: 2 bytes
+	DestinationWindow xproto.Window
+	XOffset           int16
+	YOffset           int16
+	SourceBitmap      xproto.Pixmap
+}
+
+// maskRequestRead reads a byte slice in the byte order 'order' into a MaskRequest value.
+func maskRequestRead(buf []byte, order xgb.ByteOrder) *MaskRequest {
+	v := new(MaskRequest)
+	b := 4 // skip request header
+
+	v.Operation = Op(buf[b])
+	b += 1
+
+	v.DestinationKind = Kind(buf[b])
+	b += 1
+
+	b += 2 // padding
+
+	v.DestinationWindow = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.XOffset = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.YOffset = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.SourceBitmap = xproto.Pixmap(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SHAPE"][2] = xgb.RequestInfo{
+		Name: "Mask",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return maskRequestRead(buf, order)
+		},
+	}
+}
+
 // OffsetCookie is a cookie used only for Offset requests.
 type OffsetCookie struct {
 	*xgb.Cookie
@@ -603,12 +838,26 @@ func (cook OffsetCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook OffsetCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for Offset
 // offsetRequest writes a Offset request to a byte slice.
 func offsetRequest(c *xgb.Conn, DestinationKind Kind, DestinationWindow xproto.Window, XOffset int16, YOffset int16) []byte {
+	return AppendOffsetRequest(nil, c, DestinationKind, DestinationWindow, XOffset, YOffset)
+}
+
+// AppendOffsetRequest appends a Offset request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendOffsetRequest(buf []byte, c *xgb.Conn, DestinationKind Kind, DestinationWindow xproto.Window, XOffset int16, YOffset int16) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SHAPE"]
@@ -618,7 +867,7 @@ func offsetRequest(c *xgb.Conn, DestinationKind Kind, DestinationWindow xproto.W
 	buf[b] = 4 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	buf[b] = byte(DestinationKind)
@@ -626,18 +875,58 @@ func offsetRequest(c *xgb.Conn, DestinationKind Kind, DestinationWindow xproto.W
 
 	b += 3 // padding
 
-	xgb.Put32(buf[b:], uint32(DestinationWindow))
+	order.Put32(buf[b:], uint32(DestinationWindow))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(XOffset))
+	order.Put16(buf[b:], uint16(XOffset))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(YOffset))
+	order.Put16(buf[b:], uint16(YOffset))
 	b += 2
 
 	return buf
 }
 
+// OffsetRequest represents the data of a Offset request, as it is read when tracing a connection.
+type OffsetRequest struct {
+	DestinationKind Kind
+	// padding: 3 bytes
+	DestinationWindow xproto.Window
+	XOffset           int16
+	YOffset           int16
+}
+
+// offsetRequestRead reads a byte slice in the byte order 'order' into a OffsetRequest value.
+func offsetRequestRead(buf []byte, order xgb.ByteOrder) *OffsetRequest {
+	v := new(OffsetRequest)
+	b := 4 // skip request header
+
+	v.DestinationKind = Kind(buf[b])
+	b += 1
+
+	b += 3 // padding
+
+	v.DestinationWindow = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.XOffset = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.YOffset = int16(order.Get16(buf[b:]))
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SHAPE"][4] = xgb.RequestInfo{
+		Name: "Offset",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return offsetRequestRead(buf, order)
+		},
+	}
+}
+
 // QueryExtentsCookie is a cookie used only for QueryExtents requests.
 type QueryExtentsCookie struct {
 	*xgb.Cookie
@@ -696,20 +985,32 @@ func (cook QueryExtentsCookie) Reply() (*QueryExtentsReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryExtentsReply(buf), nil
+	return queryExtentsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryExtentsReply reads a byte slice into a QueryExtentsReply value.
-func queryExtentsReply(buf []byte) *QueryExtentsReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryExtents request arrives.
+func (cook QueryExtentsCookie) ReplyContext(ctx context.Context) (*QueryExtentsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryExtentsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryExtentsReply reads a byte slice in the byte order 'order' into a QueryExtentsReply value.
+func queryExtentsReply(buf []byte, order xgb.ByteOrder) *QueryExtentsReply {
 	v := new(QueryExtentsReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	if buf[b] == 1 {
@@ -728,28 +1029,28 @@ func queryExtentsReply(buf []byte) *QueryExtentsReply {
 
 	b += 2 // padding
 
-	v.BoundingShapeExtentsX = int16(xgb.Get16(buf[b:]))
+	v.BoundingShapeExtentsX = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.BoundingShapeExtentsY = int16(xgb.Get16(buf[b:]))
+	v.BoundingShapeExtentsY = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.BoundingShapeExtentsWidth = xgb.Get16(buf[b:])
+	v.BoundingShapeExtentsWidth = order.Get16(buf[b:])
 	b += 2
 
-	v.BoundingShapeExtentsHeight = xgb.Get16(buf[b:])
+	v.BoundingShapeExtentsHeight = order.Get16(buf[b:])
 	b += 2
 
-	v.ClipShapeExtentsX = int16(xgb.Get16(buf[b:]))
+	v.ClipShapeExtentsX = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.ClipShapeExtentsY = int16(xgb.Get16(buf[b:]))
+	v.ClipShapeExtentsY = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.ClipShapeExtentsWidth = xgb.Get16(buf[b:])
+	v.ClipShapeExtentsWidth = order.Get16(buf[b:])
 	b += 2
 
-	v.ClipShapeExtentsHeight = xgb.Get16(buf[b:])
+	v.ClipShapeExtentsHeight = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -758,9 +1059,18 @@ func queryExtentsReply(buf []byte) *QueryExtentsReply {
 // Write request to wire for QueryExtents
 // queryExtentsRequest writes a QueryExtents request to a byte slice.
 func queryExtentsRequest(c *xgb.Conn, DestinationWindow xproto.Window) []byte {
+	return AppendQueryExtentsRequest(nil, c, DestinationWindow)
+}
+
+// AppendQueryExtentsRequest appends a QueryExtents request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryExtentsRequest(buf []byte, c *xgb.Conn, DestinationWindow xproto.Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SHAPE"]
@@ -770,15 +1080,43 @@ func queryExtentsRequest(c *xgb.Conn, DestinationWindow xproto.Window) []byte {
 	buf[b] = 5 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(DestinationWindow))
+	order.Put32(buf[b:], uint32(DestinationWindow))
 	b += 4
 
 	return buf
 }
 
+// QueryExtentsRequest represents the data of a QueryExtents request, as it is read when tracing a connection.
+type QueryExtentsRequest struct {
+	DestinationWindow xproto.Window
+}
+
+// queryExtentsRequestRead reads a byte slice in the byte order 'order' into a QueryExtentsRequest value.
+func queryExtentsRequestRead(buf []byte, order xgb.ByteOrder) *QueryExtentsRequest {
+	v := new(QueryExtentsRequest)
+	b := 4 // skip request header
+
+	v.DestinationWindow = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SHAPE"][5] = xgb.RequestInfo{
+		Name: "QueryExtents",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryExtentsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryExtentsReply(buf, order)
+		},
+	}
+}
+
 // QueryVersionCookie is a cookie used only for QueryVersion requests.
 type QueryVersionCookie struct {
 	*xgb.Cookie
@@ -828,26 +1166,38 @@ func (cook QueryVersionCookie) Reply() (*QueryVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryVersionReply(buf), nil
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryVersion request arrives.
+func (cook QueryVersionCookie) ReplyContext(ctx context.Context) (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryVersionReply reads a byte slice into a QueryVersionReply value.
-func queryVersionReply(buf []byte) *QueryVersionReply {
+// queryVersionReply reads a byte slice in the byte order 'order' into a QueryVersionReply value.
+func queryVersionReply(buf []byte, order xgb.ByteOrder) *QueryVersionReply {
 	v := new(QueryVersionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.MajorVersion = xgb.Get16(buf[b:])
+	v.MajorVersion = order.Get16(buf[b:])
 	b += 2
 
-	v.MinorVersion = xgb.Get16(buf[b:])
+	v.MinorVersion = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -856,9 +1206,18 @@ func queryVersionReply(buf []byte) *QueryVersionReply {
 // Write request to wire for QueryVersion
 // queryVersionRequest writes a QueryVersion request to a byte slice.
 func queryVersionRequest(c *xgb.Conn) []byte {
+	return AppendQueryVersionRequest(nil, c)
+}
+
+// AppendQueryVersionRequest appends a QueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryVersionRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SHAPE"]
@@ -868,12 +1227,34 @@ func queryVersionRequest(c *xgb.Conn) []byte {
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// QueryVersionRequest represents the data of a QueryVersion request, as it is read when tracing a connection.
+type QueryVersionRequest struct {
+}
+
+// queryVersionRequestRead reads a byte slice in the byte order 'order' into a QueryVersionRequest value.
+func queryVersionRequestRead(buf []byte, order xgb.ByteOrder) *QueryVersionRequest {
+	v := new(QueryVersionRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SHAPE"][0] = xgb.RequestInfo{
+		Name: "QueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionReply(buf, order)
+		},
+	}
+}
+
 // RectanglesCookie is a cookie used only for Rectangles requests.
 type RectanglesCookie struct {
 	*xgb.Cookie
@@ -881,7 +1262,7 @@ type RectanglesCookie struct {
 
 // Rectangles sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func Rectangles(c *xgb.Conn, Operation Op, DestinationKind Kind, Ordering byte, DestinationWindow xproto.Window, XOffset int16, YOffset int16, Rectangles []xproto.Rectangle) RectanglesCookie {
+func Rectangles(c *xgb.Conn, Operation Op, DestinationKind Kind, Ordering xproto.ClipOrdering, DestinationWindow xproto.Window, XOffset int16, YOffset int16, Rectangles []xproto.Rectangle) RectanglesCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["SHAPE"]; !ok {
@@ -894,7 +1275,7 @@ func Rectangles(c *xgb.Conn, Operation Op, DestinationKind Kind, Ordering byte,
 
 // RectanglesChecked sends a checked request.
 // If an error occurs, it can be retrieved using RectanglesCookie.Check()
-func RectanglesChecked(c *xgb.Conn, Operation Op, DestinationKind Kind, Ordering byte, DestinationWindow xproto.Window, XOffset int16, YOffset int16, Rectangles []xproto.Rectangle) RectanglesCookie {
+func RectanglesChecked(c *xgb.Conn, Operation Op, DestinationKind Kind, Ordering xproto.ClipOrdering, DestinationWindow xproto.Window, XOffset int16, YOffset int16, Rectangles []xproto.Rectangle) RectanglesCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["SHAPE"]; !ok {
@@ -911,12 +1292,26 @@ func (cook RectanglesCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook RectanglesCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for Rectangles
 // rectanglesRequest writes a Rectangles request to a byte slice.
-func rectanglesRequest(c *xgb.Conn, Operation Op, DestinationKind Kind, Ordering byte, DestinationWindow xproto.Window, XOffset int16, YOffset int16, Rectangles []xproto.Rectangle) []byte {
+func rectanglesRequest(c *xgb.Conn, Operation Op, DestinationKind Kind, Ordering xproto.ClipOrdering, DestinationWindow xproto.Window, XOffset int16, YOffset int16, Rectangles []xproto.Rectangle) []byte {
+	return AppendRectanglesRequest(nil, c, Operation, DestinationKind, Ordering, DestinationWindow, XOffset, YOffset, Rectangles)
+}
+
+// AppendRectanglesRequest appends a Rectangles request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendRectanglesRequest(buf []byte, c *xgb.Conn, Operation Op, DestinationKind Kind, Ordering xproto.ClipOrdering, DestinationWindow xproto.Window, XOffset int16, YOffset int16, Rectangles []xproto.Rectangle) []byte {
 	size := xgb.Pad((16 + xgb.Pad((len(Rectangles) * 8))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SHAPE"]
@@ -926,7 +1321,7 @@ func rectanglesRequest(c *xgb.Conn, Operation Op, DestinationKind Kind, Ordering
 	buf[b] = 1 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	buf[b] = byte(Operation)
@@ -935,25 +1330,77 @@ func rectanglesRequest(c *xgb.Conn, Operation Op, DestinationKind Kind, Ordering
 	buf[b] = byte(DestinationKind)
 	b += 1
 
-	buf[b] = Ordering
+	buf[b] = byte(Ordering)
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put32(buf[b:], uint32(DestinationWindow))
+	order.Put32(buf[b:], uint32(DestinationWindow))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(XOffset))
+	order.Put16(buf[b:], uint16(XOffset))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(YOffset))
+	order.Put16(buf[b:], uint16(YOffset))
 	b += 2
 
-	b += xproto.RectangleListBytes(buf[b:], Rectangles)
+	b += xproto.RectangleListBytes(buf[b:], order, Rectangles)
 
 	return buf
 }
 
+// RectanglesRequest represents the data of a Rectangles request, as it is read when tracing a connection.
+type RectanglesRequest struct {
+	Operation       Op
+	DestinationKind Kind
+	Ordering        xproto.ClipOrdering
+	// padding: 1 bytes
+	DestinationWindow xproto.Window
+	XOffset           int16
+	YOffset           int16
+	Rectangles        []xproto.Rectangle // size: xgb.Pad((len(Rectangles) * 8))
+}
+
+// rectanglesRequestRead reads a byte slice in the byte order 'order' into a RectanglesRequest value.
+func rectanglesRequestRead(buf []byte, order xgb.ByteOrder) *RectanglesRequest {
+	v := new(RectanglesRequest)
+	b := 4 // skip request header
+
+	v.Operation = Op(buf[b])
+	b += 1
+
+	v.DestinationKind = Kind(buf[b])
+	b += 1
+
+	v.Ordering = xproto.ClipOrdering(buf[b])
+	b += 1
+
+	b += 1 // padding
+
+	v.DestinationWindow = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.XOffset = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.YOffset = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Rectangles = make([]xproto.Rectangle, (len(buf)-b)/8)
+	b += xproto.RectangleReadList(buf[b:], order, v.Rectangles)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SHAPE"][1] = xgb.RequestInfo{
+		Name: "Rectangles",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return rectanglesRequestRead(buf, order)
+		},
+	}
+}
+
 // SelectInputCookie is a cookie used only for SelectInput requests.
 type SelectInputCookie struct {
 	*xgb.Cookie
@@ -991,12 +1438,26 @@ func (cook SelectInputCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SelectInputCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SelectInput
 // selectInputRequest writes a SelectInput request to a byte slice.
 func selectInputRequest(c *xgb.Conn, DestinationWindow xproto.Window, Enable bool) []byte {
+	return AppendSelectInputRequest(nil, c, DestinationWindow, Enable)
+}
+
+// AppendSelectInputRequest appends a SelectInput request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSelectInputRequest(buf []byte, c *xgb.Conn, DestinationWindow xproto.Window, Enable bool) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SHAPE"]
@@ -1006,10 +1467,10 @@ func selectInputRequest(c *xgb.Conn, DestinationWindow xproto.Window, Enable boo
 	buf[b] = 6 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(DestinationWindow))
+	order.Put32(buf[b:], uint32(DestinationWindow))
 	b += 4
 
 	if Enable {
@@ -1023,3 +1484,39 @@ func selectInputRequest(c *xgb.Conn, DestinationWindow xproto.Window, Enable boo
 
 	return buf
 }
+
+// SelectInputRequest represents the data of a SelectInput request, as it is read when tracing a connection.
+type SelectInputRequest struct {
+	DestinationWindow xproto.Window
+	Enable            bool
+	// padding: 3 bytes
+}
+
+// selectInputRequestRead reads a byte slice in the byte order 'order' into a SelectInputRequest value.
+func selectInputRequestRead(buf []byte, order xgb.ByteOrder) *SelectInputRequest {
+	v := new(SelectInputRequest)
+	b := 4 // skip request header
+
+	v.DestinationWindow = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	if buf[b] == 1 {
+		v.Enable = true
+	} else {
+		v.Enable = false
+	}
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SHAPE"][6] = xgb.RequestInfo{
+		Name: "SelectInput",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return selectInputRequestRead(buf, order)
+		},
+	}
+}
@@ -4,6 +4,8 @@ package shm
 // This file is automatically generated from shm.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xproto"
@@ -22,18 +24,16 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["MIT-SHM"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["MIT-SHM"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["MIT-SHM"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["MIT-SHM"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["MIT-SHM"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "MIT-SHM", xgb.ExtRequestInfos["MIT-SHM"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["MIT-SHM"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["MIT-SHM"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["MIT-SHM"] = make(map[int]xgb.RequestInfo)
 }
 
 // BadBadSeg is the error number for a BadBadSeg.
@@ -41,9 +41,9 @@ const BadBadSeg = 0
 
 type BadSegError xproto.ValueError
 
-// BadSegErrorNew constructs a BadSegError value that implements xgb.Error from a byte slice.
-func BadSegErrorNew(buf []byte) xgb.Error {
-	v := BadSegError(xproto.ValueErrorNew(buf).(xproto.ValueError))
+// BadSegErrorNew constructs a BadSegError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadSegErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := BadSegError(xproto.ValueErrorNew(buf, order).(xproto.ValueError))
 	v.NiceName = "BadSeg"
 	return v
 }
@@ -88,20 +88,20 @@ type CompletionEvent struct {
 	Offset uint32
 }
 
-// CompletionEventNew constructs a CompletionEvent value that implements xgb.Event from a byte slice.
-func CompletionEventNew(buf []byte) xgb.Event {
+// CompletionEventNew constructs a CompletionEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func CompletionEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := CompletionEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Drawable = xproto.Drawable(xgb.Get32(buf[b:]))
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
 	b += 4
 
-	v.MinorEvent = xgb.Get16(buf[b:])
+	v.MinorEvent = order.Get16(buf[b:])
 	b += 2
 
 	v.MajorEvent = buf[b]
@@ -109,17 +109,17 @@ func CompletionEventNew(buf []byte) xgb.Event {
 
 	b += 1 // padding
 
-	v.Shmseg = Seg(xgb.Get32(buf[b:]))
+	v.Shmseg = Seg(order.Get32(buf[b:]))
 	b += 4
 
-	v.Offset = xgb.Get32(buf[b:])
+	v.Offset = order.Get32(buf[b:])
 	b += 4
 
 	return v
 }
 
-// Bytes writes a CompletionEvent value to a byte slice.
-func (v CompletionEvent) Bytes() []byte {
+// Bytes writes a CompletionEvent value to a byte slice, in the byte order 'order'.
+func (v CompletionEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -131,10 +131,10 @@ func (v CompletionEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Drawable))
+	order.Put32(buf[b:], uint32(v.Drawable))
 	b += 4
 
-	xgb.Put16(buf[b:], v.MinorEvent)
+	order.Put16(buf[b:], v.MinorEvent)
 	b += 2
 
 	buf[b] = v.MajorEvent
@@ -142,10 +142,10 @@ func (v CompletionEvent) Bytes() []byte {
 
 	b += 1 // padding
 
-	xgb.Put32(buf[b:], uint32(v.Shmseg))
+	order.Put32(buf[b:], uint32(v.Shmseg))
 	b += 4
 
-	xgb.Put32(buf[b:], v.Offset)
+	order.Put32(buf[b:], v.Offset)
 	b += 4
 
 	return buf
@@ -208,6 +208,8 @@ func NewSegId(c *xgb.Conn) (Seg, error) {
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // AttachCookie is a cookie used only for Attach requests.
 type AttachCookie struct {
 	*xgb.Cookie
@@ -245,12 +247,26 @@ func (cook AttachCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook AttachCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for Attach
 // attachRequest writes a Attach request to a byte slice.
 func attachRequest(c *xgb.Conn, Shmseg Seg, Shmid uint32, ReadOnly bool) []byte {
+	return AppendAttachRequest(nil, c, Shmseg, Shmid, ReadOnly)
+}
+
+// AppendAttachRequest appends a Attach request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendAttachRequest(buf []byte, c *xgb.Conn, Shmseg Seg, Shmid uint32, ReadOnly bool) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["MIT-SHM"]
@@ -260,13 +276,13 @@ func attachRequest(c *xgb.Conn, Shmseg Seg, Shmid uint32, ReadOnly bool) []byte
 	buf[b] = 1 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Shmseg))
+	order.Put32(buf[b:], uint32(Shmseg))
 	b += 4
 
-	xgb.Put32(buf[b:], Shmid)
+	order.Put32(buf[b:], Shmid)
 	b += 4
 
 	if ReadOnly {
@@ -281,6 +297,46 @@ func attachRequest(c *xgb.Conn, Shmseg Seg, Shmid uint32, ReadOnly bool) []byte
 	return buf
 }
 
+// AttachRequest represents the data of a Attach request, as it is read when tracing a connection.
+type AttachRequest struct {
+	Shmseg   Seg
+	Shmid    uint32
+	ReadOnly bool
+	// padding: 3 bytes
+}
+
+// attachRequestRead reads a byte slice in the byte order 'order' into a AttachRequest value.
+func attachRequestRead(buf []byte, order xgb.ByteOrder) *AttachRequest {
+	v := new(AttachRequest)
+	b := 4 // skip request header
+
+	v.Shmseg = Seg(order.Get32(buf[b:]))
+	b += 4
+
+	v.Shmid = order.Get32(buf[b:])
+	b += 4
+
+	if buf[b] == 1 {
+		v.ReadOnly = true
+	} else {
+		v.ReadOnly = false
+	}
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["MIT-SHM"][1] = xgb.RequestInfo{
+		Name: "Attach",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return attachRequestRead(buf, order)
+		},
+	}
+}
+
 // AttachFdCookie is a cookie used only for AttachFd requests.
 type AttachFdCookie struct {
 	*xgb.Cookie
@@ -288,27 +344,27 @@ type AttachFdCookie struct {
 
 // AttachFd sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func AttachFd(c *xgb.Conn, Shmseg Seg, ReadOnly bool) AttachFdCookie {
+func AttachFd(c *xgb.Conn, Shmseg Seg, ShmFd xgb.Fd, ReadOnly bool) AttachFdCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["MIT-SHM"]; !ok {
 		panic("Cannot issue request 'AttachFd' using the uninitialized extension 'MIT-SHM'. shm.Init(connObj) must be called first.")
 	}
 	cookie := c.NewCookie(false, false)
-	c.NewRequest(attachFdRequest(c, Shmseg, ReadOnly), cookie)
+	c.NewRequestFds(attachFdRequest(c, Shmseg, ShmFd, ReadOnly), []xgb.Fd{ShmFd}, cookie)
 	return AttachFdCookie{cookie}
 }
 
 // AttachFdChecked sends a checked request.
 // If an error occurs, it can be retrieved using AttachFdCookie.Check()
-func AttachFdChecked(c *xgb.Conn, Shmseg Seg, ReadOnly bool) AttachFdCookie {
+func AttachFdChecked(c *xgb.Conn, Shmseg Seg, ShmFd xgb.Fd, ReadOnly bool) AttachFdCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["MIT-SHM"]; !ok {
 		panic("Cannot issue request 'AttachFd' using the uninitialized extension 'MIT-SHM'. shm.Init(connObj) must be called first.")
 	}
 	cookie := c.NewCookie(true, false)
-	c.NewRequest(attachFdRequest(c, Shmseg, ReadOnly), cookie)
+	c.NewRequestFds(attachFdRequest(c, Shmseg, ShmFd, ReadOnly), []xgb.Fd{ShmFd}, cookie)
 	return AttachFdCookie{cookie}
 }
 
@@ -318,12 +374,26 @@ func (cook AttachFdCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook AttachFdCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for AttachFd
 // attachFdRequest writes a AttachFd request to a byte slice.
-func attachFdRequest(c *xgb.Conn, Shmseg Seg, ReadOnly bool) []byte {
+func attachFdRequest(c *xgb.Conn, Shmseg Seg, ShmFd xgb.Fd, ReadOnly bool) []byte {
+	return AppendAttachFdRequest(nil, c, Shmseg, ShmFd, ReadOnly)
+}
+
+// AppendAttachFdRequest appends a AttachFd request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// Since the request carries file descriptors, it must be sent with xgb.Conn.NewRequestFds.
+func AppendAttachFdRequest(buf []byte, c *xgb.Conn, Shmseg Seg, ShmFd xgb.Fd, ReadOnly bool) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["MIT-SHM"]
@@ -333,12 +403,14 @@ func attachFdRequest(c *xgb.Conn, Shmseg Seg, ReadOnly bool) []byte {
 	buf[b] = 6 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Shmseg))
+	order.Put32(buf[b:], uint32(Shmseg))
 	b += 4
 
+	// file descriptor ShmFd is sent out of band
+
 	if ReadOnly {
 		buf[b] = 1
 	} else {
@@ -351,6 +423,42 @@ func attachFdRequest(c *xgb.Conn, Shmseg Seg, ReadOnly bool) []byte {
 	return buf
 }
 
+// AttachFdRequest represents the data of a AttachFd request, as it is read when tracing a connection.
+type AttachFdRequest struct {
+	Shmseg   Seg
+	ReadOnly bool
+	// padding: 3 bytes
+}
+
+// attachFdRequestRead reads a byte slice in the byte order 'order' into a AttachFdRequest value.
+func attachFdRequestRead(buf []byte, order xgb.ByteOrder) *AttachFdRequest {
+	v := new(AttachFdRequest)
+	b := 4 // skip request header
+
+	v.Shmseg = Seg(order.Get32(buf[b:]))
+	b += 4
+
+	if buf[b] == 1 {
+		v.ReadOnly = true
+	} else {
+		v.ReadOnly = false
+	}
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["MIT-SHM"][6] = xgb.RequestInfo{
+		Name: "AttachFd",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return attachFdRequestRead(buf, order)
+		},
+	}
+}
+
 // CreatePixmapCookie is a cookie used only for CreatePixmap requests.
 type CreatePixmapCookie struct {
 	*xgb.Cookie
@@ -388,12 +496,26 @@ func (cook CreatePixmapCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreatePixmapCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreatePixmap
 // createPixmapRequest writes a CreatePixmap request to a byte slice.
 func createPixmapRequest(c *xgb.Conn, Pid xproto.Pixmap, Drawable xproto.Drawable, Width uint16, Height uint16, Depth byte, Shmseg Seg, Offset uint32) []byte {
+	return AppendCreatePixmapRequest(nil, c, Pid, Drawable, Width, Height, Depth, Shmseg, Offset)
+}
+
+// AppendCreatePixmapRequest appends a CreatePixmap request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreatePixmapRequest(buf []byte, c *xgb.Conn, Pid xproto.Pixmap, Drawable xproto.Drawable, Width uint16, Height uint16, Depth byte, Shmseg Seg, Offset uint32) []byte {
 	size := 28
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["MIT-SHM"]
@@ -403,19 +525,19 @@ func createPixmapRequest(c *xgb.Conn, Pid xproto.Pixmap, Drawable xproto.Drawabl
 	buf[b] = 5 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Pid))
+	order.Put32(buf[b:], uint32(Pid))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put16(buf[b:], Width)
+	order.Put16(buf[b:], Width)
 	b += 2
 
-	xgb.Put16(buf[b:], Height)
+	order.Put16(buf[b:], Height)
 	b += 2
 
 	buf[b] = Depth
@@ -423,15 +545,67 @@ func createPixmapRequest(c *xgb.Conn, Pid xproto.Pixmap, Drawable xproto.Drawabl
 
 	b += 3 // padding
 
-	xgb.Put32(buf[b:], uint32(Shmseg))
+	order.Put32(buf[b:], uint32(Shmseg))
 	b += 4
 
-	xgb.Put32(buf[b:], Offset)
+	order.Put32(buf[b:], Offset)
 	b += 4
 
 	return buf
 }
 
+// CreatePixmapRequest represents the data of a CreatePixmap request, as it is read when tracing a connection.
+type CreatePixmapRequest struct {
+	Pid      xproto.Pixmap
+	Drawable xproto.Drawable
+	Width    uint16
+	Height   uint16
+	Depth    byte
+	// padding: 3 bytes
+	Shmseg Seg
+	Offset uint32
+}
+
+// createPixmapRequestRead reads a byte slice in the byte order 'order' into a CreatePixmapRequest value.
+func createPixmapRequestRead(buf []byte, order xgb.ByteOrder) *CreatePixmapRequest {
+	v := new(CreatePixmapRequest)
+	b := 4 // skip request header
+
+	v.Pid = xproto.Pixmap(order.Get32(buf[b:]))
+	b += 4
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	v.Depth = buf[b]
+	b += 1
+
+	b += 3 // padding
+
+	v.Shmseg = Seg(order.Get32(buf[b:]))
+	b += 4
+
+	v.Offset = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["MIT-SHM"][5] = xgb.RequestInfo{
+		Name: "CreatePixmap",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createPixmapRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateSegmentCookie is a cookie used only for CreateSegment requests.
 type CreateSegmentCookie struct {
 	*xgb.Cookie
@@ -468,6 +642,7 @@ type CreateSegmentReply struct {
 	Sequence uint16 // sequence number of the request for this reply
 	Length   uint32 // number of bytes in this reply
 	Nfd      byte
+	ShmFd    int // file descriptor
 	// padding: 24 bytes
 }
 
@@ -480,23 +655,47 @@ func (cook CreateSegmentCookie) Reply() (*CreateSegmentReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return createSegmentReply(buf), nil
+	fds := cook.Cookie.Fds()
+	if len(fds) != 1 {
+		xgb.CloseFds(fds)
+		return nil, xgb.Errorf("Expected 1 file descriptor(s) with the CreateSegment reply, but got %d.", len(fds))
+	}
+	return createSegmentReply(buf, cook.Cookie.ByteOrder(), fds), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a CreateSegment request arrives.
+func (cook CreateSegmentCookie) ReplyContext(ctx context.Context) (*CreateSegmentReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	fds := cook.Cookie.Fds()
+	if len(fds) != 1 {
+		xgb.CloseFds(fds)
+		return nil, xgb.Errorf("Expected 1 file descriptor(s) with the CreateSegment reply, but got %d.", len(fds))
+	}
+	return createSegmentReply(buf, cook.Cookie.ByteOrder(), fds), nil
 }
 
-// createSegmentReply reads a byte slice into a CreateSegmentReply value.
-func createSegmentReply(buf []byte) *CreateSegmentReply {
+// createSegmentReply reads a byte slice in the byte order 'order' into a CreateSegmentReply value.
+func createSegmentReply(buf []byte, order xgb.ByteOrder, fds []int) *CreateSegmentReply {
 	v := new(CreateSegmentReply)
 	b := 1 // skip reply determinant
 
 	v.Nfd = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
+	v.ShmFd = fds[0]
+
 	b += 24 // padding
 
 	return v
@@ -505,9 +704,18 @@ func createSegmentReply(buf []byte) *CreateSegmentReply {
 // Write request to wire for CreateSegment
 // createSegmentRequest writes a CreateSegment request to a byte slice.
 func createSegmentRequest(c *xgb.Conn, Shmseg Seg, Size uint32, ReadOnly bool) []byte {
+	return AppendCreateSegmentRequest(nil, c, Shmseg, Size, ReadOnly)
+}
+
+// AppendCreateSegmentRequest appends a CreateSegment request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateSegmentRequest(buf []byte, c *xgb.Conn, Shmseg Seg, Size uint32, ReadOnly bool) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["MIT-SHM"]
@@ -517,13 +725,13 @@ func createSegmentRequest(c *xgb.Conn, Shmseg Seg, Size uint32, ReadOnly bool) [
 	buf[b] = 7 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Shmseg))
+	order.Put32(buf[b:], uint32(Shmseg))
 	b += 4
 
-	xgb.Put32(buf[b:], Size)
+	order.Put32(buf[b:], Size)
 	b += 4
 
 	if ReadOnly {
@@ -538,6 +746,46 @@ func createSegmentRequest(c *xgb.Conn, Shmseg Seg, Size uint32, ReadOnly bool) [
 	return buf
 }
 
+// CreateSegmentRequest represents the data of a CreateSegment request, as it is read when tracing a connection.
+type CreateSegmentRequest struct {
+	Shmseg   Seg
+	Size     uint32
+	ReadOnly bool
+	// padding: 3 bytes
+}
+
+// createSegmentRequestRead reads a byte slice in the byte order 'order' into a CreateSegmentRequest value.
+func createSegmentRequestRead(buf []byte, order xgb.ByteOrder) *CreateSegmentRequest {
+	v := new(CreateSegmentRequest)
+	b := 4 // skip request header
+
+	v.Shmseg = Seg(order.Get32(buf[b:]))
+	b += 4
+
+	v.Size = order.Get32(buf[b:])
+	b += 4
+
+	if buf[b] == 1 {
+		v.ReadOnly = true
+	} else {
+		v.ReadOnly = false
+	}
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["MIT-SHM"][7] = xgb.RequestInfo{
+		Name: "CreateSegment",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createSegmentRequestRead(buf, order)
+		},
+	}
+}
+
 // DetachCookie is a cookie used only for Detach requests.
 type DetachCookie struct {
 	*xgb.Cookie
@@ -575,12 +823,26 @@ func (cook DetachCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DetachCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for Detach
 // detachRequest writes a Detach request to a byte slice.
 func detachRequest(c *xgb.Conn, Shmseg Seg) []byte {
+	return AppendDetachRequest(nil, c, Shmseg)
+}
+
+// AppendDetachRequest appends a Detach request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDetachRequest(buf []byte, c *xgb.Conn, Shmseg Seg) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["MIT-SHM"]
@@ -590,15 +852,40 @@ func detachRequest(c *xgb.Conn, Shmseg Seg) []byte {
 	buf[b] = 2 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Shmseg))
+	order.Put32(buf[b:], uint32(Shmseg))
 	b += 4
 
 	return buf
 }
 
+// DetachRequest represents the data of a Detach request, as it is read when tracing a connection.
+type DetachRequest struct {
+	Shmseg Seg
+}
+
+// detachRequestRead reads a byte slice in the byte order 'order' into a DetachRequest value.
+func detachRequestRead(buf []byte, order xgb.ByteOrder) *DetachRequest {
+	v := new(DetachRequest)
+	b := 4 // skip request header
+
+	v.Shmseg = Seg(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["MIT-SHM"][2] = xgb.RequestInfo{
+		Name: "Detach",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return detachRequestRead(buf, order)
+		},
+	}
+}
+
 // GetImageCookie is a cookie used only for GetImage requests.
 type GetImageCookie struct {
 	*xgb.Cookie
@@ -648,27 +935,39 @@ func (cook GetImageCookie) Reply() (*GetImageReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getImageReply(buf), nil
+	return getImageReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getImageReply reads a byte slice into a GetImageReply value.
-func getImageReply(buf []byte) *GetImageReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetImage request arrives.
+func (cook GetImageCookie) ReplyContext(ctx context.Context) (*GetImageReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getImageReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// getImageReply reads a byte slice in the byte order 'order' into a GetImageReply value.
+func getImageReply(buf []byte, order xgb.ByteOrder) *GetImageReply {
 	v := new(GetImageReply)
 	b := 1 // skip reply determinant
 
 	v.Depth = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Visual = xproto.Visualid(xgb.Get32(buf[b:]))
+	v.Visual = xproto.Visualid(order.Get32(buf[b:]))
 	b += 4
 
-	v.Size = xgb.Get32(buf[b:])
+	v.Size = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -677,9 +976,18 @@ func getImageReply(buf []byte) *GetImageReply {
 // Write request to wire for GetImage
 // getImageRequest writes a GetImage request to a byte slice.
 func getImageRequest(c *xgb.Conn, Drawable xproto.Drawable, X int16, Y int16, Width uint16, Height uint16, PlaneMask uint32, Format byte, Shmseg Seg, Offset uint32) []byte {
+	return AppendGetImageRequest(nil, c, Drawable, X, Y, Width, Height, PlaneMask, Format, Shmseg, Offset)
+}
+
+// AppendGetImageRequest appends a GetImage request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetImageRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable, X int16, Y int16, Width uint16, Height uint16, PlaneMask uint32, Format byte, Shmseg Seg, Offset uint32) []byte {
 	size := 32
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["MIT-SHM"]
@@ -689,25 +997,25 @@ func getImageRequest(c *xgb.Conn, Drawable xproto.Drawable, X int16, Y int16, Wi
 	buf[b] = 4 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(X))
+	order.Put16(buf[b:], uint16(X))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(Y))
+	order.Put16(buf[b:], uint16(Y))
 	b += 2
 
-	xgb.Put16(buf[b:], Width)
+	order.Put16(buf[b:], Width)
 	b += 2
 
-	xgb.Put16(buf[b:], Height)
+	order.Put16(buf[b:], Height)
 	b += 2
 
-	xgb.Put32(buf[b:], PlaneMask)
+	order.Put32(buf[b:], PlaneMask)
 	b += 4
 
 	buf[b] = Format
@@ -715,15 +1023,78 @@ func getImageRequest(c *xgb.Conn, Drawable xproto.Drawable, X int16, Y int16, Wi
 
 	b += 3 // padding
 
-	xgb.Put32(buf[b:], uint32(Shmseg))
+	order.Put32(buf[b:], uint32(Shmseg))
 	b += 4
 
-	xgb.Put32(buf[b:], Offset)
+	order.Put32(buf[b:], Offset)
 	b += 4
 
 	return buf
 }
 
+// GetImageRequest represents the data of a GetImage request, as it is read when tracing a connection.
+type GetImageRequest struct {
+	Drawable  xproto.Drawable
+	X         int16
+	Y         int16
+	Width     uint16
+	Height    uint16
+	PlaneMask uint32
+	Format    byte
+	// padding: 3 bytes
+	Shmseg Seg
+	Offset uint32
+}
+
+// getImageRequestRead reads a byte slice in the byte order 'order' into a GetImageRequest value.
+func getImageRequestRead(buf []byte, order xgb.ByteOrder) *GetImageRequest {
+	v := new(GetImageRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.X = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Y = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	v.PlaneMask = order.Get32(buf[b:])
+	b += 4
+
+	v.Format = buf[b]
+	b += 1
+
+	b += 3 // padding
+
+	v.Shmseg = Seg(order.Get32(buf[b:]))
+	b += 4
+
+	v.Offset = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["MIT-SHM"][4] = xgb.RequestInfo{
+		Name: "GetImage",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getImageRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getImageReply(buf, order)
+		},
+	}
+}
+
 // PutImageCookie is a cookie used only for PutImage requests.
 type PutImageCookie struct {
 	*xgb.Cookie
@@ -761,12 +1132,26 @@ func (cook PutImageCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PutImageCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PutImage
 // putImageRequest writes a PutImage request to a byte slice.
 func putImageRequest(c *xgb.Conn, Drawable xproto.Drawable, Gc xproto.Gcontext, TotalWidth uint16, TotalHeight uint16, SrcX uint16, SrcY uint16, SrcWidth uint16, SrcHeight uint16, DstX int16, DstY int16, Depth byte, Format byte, SendEvent byte, Shmseg Seg, Offset uint32) []byte {
+	return AppendPutImageRequest(nil, c, Drawable, Gc, TotalWidth, TotalHeight, SrcX, SrcY, SrcWidth, SrcHeight, DstX, DstY, Depth, Format, SendEvent, Shmseg, Offset)
+}
+
+// AppendPutImageRequest appends a PutImage request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPutImageRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable, Gc xproto.Gcontext, TotalWidth uint16, TotalHeight uint16, SrcX uint16, SrcY uint16, SrcWidth uint16, SrcHeight uint16, DstX int16, DstY int16, Depth byte, Format byte, SendEvent byte, Shmseg Seg, Offset uint32) []byte {
 	size := 40
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["MIT-SHM"]
@@ -776,37 +1161,37 @@ func putImageRequest(c *xgb.Conn, Drawable xproto.Drawable, Gc xproto.Gcontext,
 	buf[b] = 3 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	xgb.Put16(buf[b:], TotalWidth)
+	order.Put16(buf[b:], TotalWidth)
 	b += 2
 
-	xgb.Put16(buf[b:], TotalHeight)
+	order.Put16(buf[b:], TotalHeight)
 	b += 2
 
-	xgb.Put16(buf[b:], SrcX)
+	order.Put16(buf[b:], SrcX)
 	b += 2
 
-	xgb.Put16(buf[b:], SrcY)
+	order.Put16(buf[b:], SrcY)
 	b += 2
 
-	xgb.Put16(buf[b:], SrcWidth)
+	order.Put16(buf[b:], SrcWidth)
 	b += 2
 
-	xgb.Put16(buf[b:], SrcHeight)
+	order.Put16(buf[b:], SrcHeight)
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DstX))
+	order.Put16(buf[b:], uint16(DstX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DstY))
+	order.Put16(buf[b:], uint16(DstY))
 	b += 2
 
 	buf[b] = Depth
@@ -820,15 +1205,99 @@ func putImageRequest(c *xgb.Conn, Drawable xproto.Drawable, Gc xproto.Gcontext,
 
 	b += 1 // padding
 
-	xgb.Put32(buf[b:], uint32(Shmseg))
+	order.Put32(buf[b:], uint32(Shmseg))
 	b += 4
 
-	xgb.Put32(buf[b:], Offset)
+	order.Put32(buf[b:], Offset)
 	b += 4
 
 	return buf
 }
 
+// PutImageRequest represents the data of a PutImage request, as it is read when tracing a connection.
+type PutImageRequest struct {
+	Drawable    xproto.Drawable
+	Gc          xproto.Gcontext
+	TotalWidth  uint16
+	TotalHeight uint16
+	SrcX        uint16
+	SrcY        uint16
+	SrcWidth    uint16
+	SrcHeight   uint16
+	DstX        int16
+	DstY        int16
+	Depth       byte
+	Format      byte
+	SendEvent   byte
+	// padding: 1 bytes
+	Shmseg Seg
+	Offset uint32
+}
+
+// putImageRequestRead reads a byte slice in the byte order 'order' into a PutImageRequest value.
+func putImageRequestRead(buf []byte, order xgb.ByteOrder) *PutImageRequest {
+	v := new(PutImageRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = xproto.Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.TotalWidth = order.Get16(buf[b:])
+	b += 2
+
+	v.TotalHeight = order.Get16(buf[b:])
+	b += 2
+
+	v.SrcX = order.Get16(buf[b:])
+	b += 2
+
+	v.SrcY = order.Get16(buf[b:])
+	b += 2
+
+	v.SrcWidth = order.Get16(buf[b:])
+	b += 2
+
+	v.SrcHeight = order.Get16(buf[b:])
+	b += 2
+
+	v.DstX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.DstY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Depth = buf[b]
+	b += 1
+
+	v.Format = buf[b]
+	b += 1
+
+	v.SendEvent = buf[b]
+	b += 1
+
+	b += 1 // padding
+
+	v.Shmseg = Seg(order.Get32(buf[b:]))
+	b += 4
+
+	v.Offset = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["MIT-SHM"][3] = xgb.RequestInfo{
+		Name: "PutImage",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return putImageRequestRead(buf, order)
+		},
+	}
+}
+
 // QueryVersionCookie is a cookie used only for QueryVersion requests.
 type QueryVersionCookie struct {
 	*xgb.Cookie
@@ -882,11 +1351,23 @@ func (cook QueryVersionCookie) Reply() (*QueryVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryVersionReply(buf), nil
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryVersion request arrives.
+func (cook QueryVersionCookie) ReplyContext(ctx context.Context) (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryVersionReply reads a byte slice into a QueryVersionReply value.
-func queryVersionReply(buf []byte) *QueryVersionReply {
+// queryVersionReply reads a byte slice in the byte order 'order' into a QueryVersionReply value.
+func queryVersionReply(buf []byte, order xgb.ByteOrder) *QueryVersionReply {
 	v := new(QueryVersionReply)
 	b := 1 // skip reply determinant
 
@@ -897,22 +1378,22 @@ func queryVersionReply(buf []byte) *QueryVersionReply {
 	}
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.MajorVersion = xgb.Get16(buf[b:])
+	v.MajorVersion = order.Get16(buf[b:])
 	b += 2
 
-	v.MinorVersion = xgb.Get16(buf[b:])
+	v.MinorVersion = order.Get16(buf[b:])
 	b += 2
 
-	v.Uid = xgb.Get16(buf[b:])
+	v.Uid = order.Get16(buf[b:])
 	b += 2
 
-	v.Gid = xgb.Get16(buf[b:])
+	v.Gid = order.Get16(buf[b:])
 	b += 2
 
 	v.PixmapFormat = buf[b]
@@ -926,9 +1407,18 @@ func queryVersionReply(buf []byte) *QueryVersionReply {
 // Write request to wire for QueryVersion
 // queryVersionRequest writes a QueryVersion request to a byte slice.
 func queryVersionRequest(c *xgb.Conn) []byte {
+	return AppendQueryVersionRequest(nil, c)
+}
+
+// AppendQueryVersionRequest appends a QueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryVersionRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["MIT-SHM"]
@@ -938,8 +1428,30 @@ func queryVersionRequest(c *xgb.Conn) []byte {
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
+
+// QueryVersionRequest represents the data of a QueryVersion request, as it is read when tracing a connection.
+type QueryVersionRequest struct {
+}
+
+// queryVersionRequestRead reads a byte slice in the byte order 'order' into a QueryVersionRequest value.
+func queryVersionRequestRead(buf []byte, order xgb.ByteOrder) *QueryVersionRequest {
+	v := new(QueryVersionRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["MIT-SHM"][0] = xgb.RequestInfo{
+		Name: "QueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionReply(buf, order)
+		},
+	}
+}
@@ -0,0 +1,291 @@
+package xgb
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// WindowEvent is implemented by events that are about a window, so that
+// they can be subscribed to for a single window. EventWindow returns the
+// window the event was selected on if there is one (i.e., the 'Event' field
+// of a ConfigureNotify event), or else the window it reports on.
+type WindowEvent interface {
+	Event
+	EventWindow() uint32
+}
+
+// Filter selects the events that a subscription receives.
+type Filter struct {
+	// Type is a value of the type of the events to receive, i.e.,
+	// xproto.KeyPressEvent{}. If it is nil, events of any type are
+	// received.
+	Type Event
+
+	// Window, if it isn't zero, only lets through the events about that
+	// window. (See WindowEvent.)
+	Window uint32
+
+	// Unhandled only lets through the events that no subscription without
+	// Unhandled receives.
+	Unhandled bool
+}
+
+// Subscription is a queue of the events that match a filter, made with
+// Subscribe or SubscribeFunc. Events received by a subscription are not
+// returned by WaitForEvent and PollForEvent.
+type Subscription struct {
+	// dropped counts the events dropped by the overflow policy. It is only
+	// accessed atomically, and comes first so that it is 64-bit aligned on
+	// 32-bit platforms.
+	dropped uint64
+
+	conn   *Conn
+	filter Filter
+	typ    reflect.Type // of filter.Type
+	policy OverflowPolicy
+	events chan Event
+	spill  chan Event // feeds events with OverflowUnbounded (see pump)
+
+	// quit is closed when the subscription is canceled. Events are only
+	// sent while holding sendLock, so that events is closed after the
+	// last one was sent.
+	quit     chan struct{}
+	quitOnce sync.Once
+	sendLock sync.Mutex
+}
+
+// Subscribe returns a subscription to the events that match 'filter', which
+// are queued until they are taken from its Events channel. The queue holds
+// 'size' events (at least one), and 'policy' says what happens to events
+// that arrive when it is full.
+//
+// A typical subscription takes the events of a single type:
+//
+//	sub := X.Subscribe(xgb.Filter{Type: xproto.ExposeEvent{}}, 100,
+//		xgb.OverflowDropOldest)
+//	for ev := range sub.Events() {
+//		expose := ev.(xproto.ExposeEvent)
+//		...
+//	}
+//
+// The Events channel is closed when Unsubscribe is called, or when the
+// connection is closed.
+func (c *Conn) Subscribe(filter Filter, size int,
+	policy OverflowPolicy) *Subscription {
+
+	if size < 1 {
+		size = 1
+	}
+	s := &Subscription{
+		conn:   c,
+		filter: filter,
+		policy: policy,
+		events: make(chan Event, size),
+		quit:   make(chan struct{}),
+	}
+	if filter.Type != nil {
+		s.typ = reflect.TypeOf(filter.Type)
+	}
+	if policy == OverflowUnbounded {
+		s.spill = make(chan Event)
+		go s.pump()
+	}
+
+	c.subLock.Lock()
+	defer c.subLock.Unlock()
+	if c.subsClosed {
+		s.close()
+		return s
+	}
+	c.subs = append(c.subs, s)
+	return s
+}
+
+// SubscribeFunc is just like Subscribe, but calls 'fn' with each event
+// instead. Calls to 'fn' are made one at a time, in the order the events
+// arrived, on a goroutine of the subscription's own. They may cancel the
+// subscription, and may send requests.
+//
+// With OverflowBlock, though, nothing is read from the X server while the
+// subscription's queue is full, not even replies, and only 'fn' empties the
+// queue. So 'fn' must not wait for a reply (or Check a request) then, since
+// it would wait forever. Subscribe with another policy, i.e.,
+// OverflowUnbounded, if 'fn' waits on the X server.
+func (c *Conn) SubscribeFunc(filter Filter, size int, policy OverflowPolicy,
+	fn func(ev Event)) *Subscription {
+
+	s := c.Subscribe(filter, size, policy)
+	go func() {
+		for ev := range s.events {
+			fn(ev)
+		}
+	}()
+	return s
+}
+
+// Events returns the channel that the events of the subscription are sent
+// on. It must not be used with a subscription made with SubscribeFunc.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Dropped returns the number of events the subscription has dropped
+// because its queue was full.
+func (s *Subscription) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// Unsubscribe cancels the subscription. Events that are still queued are
+// received before its Events channel is closed. It is safe to call
+// Unsubscribe more than once.
+func (s *Subscription) Unsubscribe() {
+	c := s.conn
+	c.subLock.Lock()
+	for i, sub := range c.subs {
+		if sub == s {
+			c.subs = append(c.subs[:i:i], c.subs[i+1:]...)
+			break
+		}
+	}
+	c.subLock.Unlock()
+	s.close()
+}
+
+// close stops sending events to the subscription, and closes its Events
+// channel.
+func (s *Subscription) close() {
+	s.quitOnce.Do(func() {
+		close(s.quit)
+		s.sendLock.Lock()
+		if s.spill != nil {
+			close(s.spill) // pump closes s.events
+		} else {
+			close(s.events)
+		}
+		s.sendLock.Unlock()
+	})
+}
+
+// matches returns whether the subscription receives 'ev', leaving aside
+// whether it only receives unhandled events.
+func (s *Subscription) matches(ev Event) bool {
+	if s.typ != nil && reflect.TypeOf(ev) != s.typ {
+		return false
+	}
+	if s.filter.Window != 0 {
+		wev, ok := ev.(WindowEvent)
+		if !ok || wev.EventWindow() != s.filter.Window {
+			return false
+		}
+	}
+	return true
+}
+
+// send queues 'ev', unless the subscription has been canceled or the
+// connection has been closed.
+func (s *Subscription) send(ev Event) {
+	s.sendLock.Lock()
+	defer s.sendLock.Unlock()
+	select {
+	case <-s.quit:
+		return
+	default:
+	}
+
+	switch s.policy {
+	case OverflowDropNewest:
+		select {
+		case s.events <- ev:
+		default:
+			atomic.AddUint64(&s.dropped, 1)
+		}
+	case OverflowDropOldest:
+		for {
+			select {
+			case s.events <- ev:
+				return
+			default:
+			}
+			select {
+			case <-s.events:
+				atomic.AddUint64(&s.dropped, 1)
+			default:
+			}
+		}
+	case OverflowUnbounded:
+		s.spill <- ev
+	default:
+		select {
+		case s.events <- ev:
+		case <-s.quit:
+		case <-s.conn.done:
+		}
+	}
+}
+
+// pump moves the events sent on s.spill to s.events, keeping those that
+// don't fit yet in memory, until s.spill is closed and they are all taken.
+func (s *Subscription) pump() {
+	defer close(s.events)
+
+	var queue []Event
+	in := s.spill
+	for in != nil || len(queue) > 0 {
+		var out chan Event
+		var next Event
+		if len(queue) > 0 {
+			out, next = s.events, queue[0]
+		}
+		select {
+		case ev, ok := <-in:
+			if !ok {
+				in = nil
+				continue
+			}
+			queue = append(queue, ev)
+		case out <- next:
+			queue[0] = nil
+			queue = queue[1:]
+		}
+	}
+}
+
+// dispatch sends 'ev' to the subscriptions that receive it, or to the event
+// channel if there are none.
+func (c *Conn) dispatch(ev Event) {
+	c.subLock.RLock()
+	var matched []*Subscription
+	for _, unhandled := range []bool{false, true} {
+		for _, s := range c.subs {
+			if s.filter.Unhandled == unhandled && s.matches(ev) {
+				matched = append(matched, s)
+			}
+		}
+		if len(matched) > 0 {
+			break
+		}
+	}
+	c.subLock.RUnlock()
+
+	if len(matched) == 0 {
+		c.queueEvent(ev)
+		return
+	}
+	for _, s := range matched {
+		s.send(ev)
+	}
+}
+
+// closeSubscriptions closes the Events channels of all subscriptions, once
+// the connection is closed.
+func (c *Conn) closeSubscriptions() {
+	c.subLock.Lock()
+	subs := c.subs
+	c.subs, c.subsClosed = nil, true
+	c.subLock.Unlock()
+
+	for _, s := range subs {
+		s.close()
+	}
+}
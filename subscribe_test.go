@@ -0,0 +1,167 @@
+package xgb_test
+
+import (
+	"testing"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xgbtest"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// sendEvents sends the events to the client, and waits until they have
+// been dispatched.
+func sendEvents(t *testing.T, s *xgbtest.Server, X *xgb.Conn,
+	evs ...xgb.Event) {
+
+	for _, ev := range evs {
+		if err := s.SendEvent(ev.Bytes(X.ByteOrder())); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := xproto.GetInputFocus(X).Reply(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// receive returns the events queued for the subscription.
+func receive(sub *xgb.Subscription) []xgb.Event {
+	var evs []xgb.Event
+	for {
+		select {
+		case ev := <-sub.Events():
+			evs = append(evs, ev)
+		default:
+			return evs
+		}
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	s := xgbtest.NewServer()
+	defer s.Close()
+	X, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer X.Close()
+
+	configure := X.Subscribe(xgb.Filter{
+		Type:   xproto.ConfigureNotifyEvent{},
+		Window: 5,
+	}, 10, xgb.OverflowBlock)
+	unhandled := X.Subscribe(xgb.Filter{Unhandled: true}, 10,
+		xgb.OverflowBlock)
+
+	evs := []xgb.Event{
+		xproto.ConfigureNotifyEvent{Event: 5, Window: 7, Width: 1},
+		xproto.ConfigureNotifyEvent{Event: 6, Window: 5, Width: 2},
+		xproto.MapNotifyEvent{Event: 5, Window: 5},
+	}
+	sendEvents(t, s, X, evs...)
+
+	got := receive(configure)
+	if len(got) != 1 || got[0].(xproto.ConfigureNotifyEvent).Width != 1 {
+		t.Errorf("subscription to window 5 got %v", got)
+	}
+	got = receive(unhandled)
+	if len(got) != 2 || got[0].(xproto.ConfigureNotifyEvent).Width != 2 {
+		t.Errorf("subscription to unhandled events got %v", got)
+	} else if _, ok := got[1].(xproto.MapNotifyEvent); !ok {
+		t.Errorf("subscription to unhandled events got %v", got)
+	}
+	if ev, err := X.PollForEvent(); ev != nil || err != nil {
+		t.Errorf("PollForEvent returned %v, %v", ev, err)
+	}
+
+	unhandled.Unsubscribe()
+	if _, ok := <-unhandled.Events(); ok {
+		t.Errorf("Unsubscribe did not close the channel")
+	}
+	sendEvents(t, s, X, evs[2])
+	if ev, err := X.PollForEvent(); ev == nil || err != nil {
+		t.Errorf("PollForEvent returned %v, %v after unsubscribing", ev,
+			err)
+	}
+
+	X.Close()
+	<-X.Done()
+	if _, ok := <-configure.Events(); ok {
+		t.Errorf("closing the connection did not close the channel")
+	}
+}
+
+func TestSubscribeOverflow(t *testing.T) {
+	s := xgbtest.NewServer()
+	defer s.Close()
+	X, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer X.Close()
+
+	newest := X.Subscribe(xgb.Filter{}, 1, xgb.OverflowDropNewest)
+	oldest := X.Subscribe(xgb.Filter{}, 1, xgb.OverflowDropOldest)
+	sendEvents(t, s, X,
+		xproto.MapNotifyEvent{Window: 1},
+		xproto.MapNotifyEvent{Window: 2},
+		xproto.MapNotifyEvent{Window: 3})
+
+	tests := []struct {
+		name   string
+		sub    *xgb.Subscription
+		window xproto.Window
+	}{
+		{"OverflowDropNewest", newest, 1},
+		{"OverflowDropOldest", oldest, 3},
+	}
+	for _, test := range tests {
+		got := receive(test.sub)
+		if len(got) != 1 ||
+			got[0].(xproto.MapNotifyEvent).Window != test.window {
+
+			t.Errorf("%s: got %v, want the event for window %d", test.name,
+				got, test.window)
+		}
+		if n := test.sub.Dropped(); n != 2 {
+			t.Errorf("%s: dropped %d events, want 2", test.name, n)
+		}
+	}
+}
+
+func TestSubscribeFunc(t *testing.T) {
+	s := xgbtest.NewServer()
+	defer s.Close()
+	X, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer X.Close()
+
+	// The callback makes a request, and cancels the subscription after
+	// the second event, which is only seen by WaitForEvent then.
+	got := make(chan xproto.Window, 3)
+	var sub *xgb.Subscription
+	sub = X.SubscribeFunc(xgb.Filter{Type: xproto.MapNotifyEvent{}}, 0,
+		xgb.OverflowBlock, func(ev xgb.Event) {
+			if _, err := xproto.GetInputFocus(X).Reply(); err != nil {
+				t.Error(err)
+			}
+			window := ev.(xproto.MapNotifyEvent).Window
+			if window == 2 {
+				sub.Unsubscribe()
+			}
+			got <- window
+		})
+	sendEvents(t, s, X,
+		xproto.MapNotifyEvent{Window: 1},
+		xproto.MapNotifyEvent{Window: 2})
+	if w1, w2 := <-got, <-got; w1 != 1 || w2 != 2 {
+		t.Errorf("callback got windows %d and %d, want 1 and 2", w1, w2)
+	}
+
+	sendEvents(t, s, X, xproto.MapNotifyEvent{Window: 3})
+	ev, xerr := X.WaitForEvent()
+	if ev, ok := ev.(xproto.MapNotifyEvent); !ok || ev.Window != 3 {
+		t.Errorf("WaitForEvent returned %v, %v", ev, xerr)
+	}
+}
@@ -0,0 +1,3508 @@
+// Package sync is the X client API for the SYNC extension.
+package sync
+
+// This file is automatically generated from sync.xml. Edit at your peril!
+
+import (
+	"context"
+
+	"github.com/BurntSushi/xgb"
+
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// Init must be called before using the SYNC extension.
+func Init(c *xgb.Conn) error {
+	reply, err := xproto.QueryExtension(c, 4, "SYNC").Reply()
+	switch {
+	case err != nil:
+		return err
+	case !reply.Present:
+		return xgb.Errorf("No extension named SYNC could be found on on the server.")
+	}
+
+	c.ExtLock.Lock()
+	c.Extensions["SYNC"] = reply.MajorOpcode
+	c.ExtLock.Unlock()
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["SYNC"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["SYNC"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "SYNC", xgb.ExtRequestInfos["SYNC"])
+	return nil
+}
+
+func init() {
+	xgb.NewExtEventFuncs["SYNC"] = make(map[int]xgb.NewEventFun)
+	xgb.NewExtErrorFuncs["SYNC"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["SYNC"] = make(map[int]xgb.RequestInfo)
+}
+
+type Alarm uint32
+
+func NewAlarmId(c *xgb.Conn) (Alarm, error) {
+	id, err := c.NewId()
+	if err != nil {
+		return 0, err
+	}
+	return Alarm(id), nil
+}
+
+// BadAlarm is the error number for a BadAlarm.
+const BadAlarm = 1
+
+type AlarmError struct {
+	Sequence    uint16
+	NiceName    string
+	BadAlarm    uint32
+	MinorOpcode uint16
+	MajorOpcode byte
+}
+
+// AlarmErrorNew constructs a AlarmError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func AlarmErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := AlarmError{}
+	v.NiceName = "Alarm"
+
+	b := 1 // skip error determinant
+	b += 1 // don't read error number
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	v.BadAlarm = order.Get32(buf[b:])
+	b += 4
+
+	v.MinorOpcode = order.Get16(buf[b:])
+	b += 2
+
+	v.MajorOpcode = buf[b]
+	b += 1
+
+	return v
+}
+
+// SequenceId returns the sequence id attached to the BadAlarm error.
+// This is mostly used internally.
+func (err AlarmError) SequenceId() uint16 {
+	return err.Sequence
+}
+
+// BadId returns the 'BadValue' number if one exists for the BadAlarm error. If no bad value exists, 0 is returned.
+func (err AlarmError) BadId() uint32 {
+	return 0
+}
+
+// Error returns a rudimentary string representation of the BadAlarm error.
+
+func (err AlarmError) Error() string {
+	fieldVals := make([]string, 0, 3)
+	fieldVals = append(fieldVals, "NiceName: "+err.NiceName)
+	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", err.Sequence))
+	fieldVals = append(fieldVals, xgb.Sprintf("BadAlarm: %d", err.BadAlarm))
+	fieldVals = append(fieldVals, xgb.Sprintf("MinorOpcode: %d", err.MinorOpcode))
+	fieldVals = append(fieldVals, xgb.Sprintf("MajorOpcode: %d", err.MajorOpcode))
+	return "BadAlarm {" + xgb.StringsJoin(fieldVals, ", ") + "}"
+}
+
+func init() {
+	xgb.NewExtErrorFuncs["SYNC"][1] = AlarmErrorNew
+}
+
+// AlarmNotify is the event number for a AlarmNotifyEvent.
+const AlarmNotify = 1
+
+type AlarmNotifyEvent struct {
+	Sequence     uint16
+	Kind         byte
+	Alarm        Alarm
+	CounterValue Int64
+	AlarmValue   Int64
+	Timestamp    xproto.Timestamp
+	State        AlarmState
+	// padding: 3 bytes
+}
+
+// AlarmNotifyEventNew constructs a AlarmNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func AlarmNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
+	v := AlarmNotifyEvent{}
+	b := 1 // don't read event number
+
+	v.Kind = buf[b]
+	b += 1
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	v.Alarm = Alarm(order.Get32(buf[b:]))
+	b += 4
+
+	v.CounterValue = Int64{}
+	b += Int64Read(buf[b:], order, &v.CounterValue)
+
+	v.AlarmValue = Int64{}
+	b += Int64Read(buf[b:], order, &v.AlarmValue)
+
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	v.State = AlarmState(buf[b])
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+// Bytes writes a AlarmNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v AlarmNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
+	buf := make([]byte, 32)
+	b := 0
+
+	// write event number
+	buf[b] = 1
+	b += 1
+
+	buf[b] = v.Kind
+	b += 1
+
+	b += 2 // skip sequence number
+
+	order.Put32(buf[b:], uint32(v.Alarm))
+	b += 4
+
+	{
+		structBytes := v.CounterValue.Bytes(order)
+		copy(buf[b:], structBytes)
+		b += len(structBytes)
+	}
+
+	{
+		structBytes := v.AlarmValue.Bytes(order)
+		copy(buf[b:], structBytes)
+		b += len(structBytes)
+	}
+
+	order.Put32(buf[b:], uint32(v.Timestamp))
+	b += 4
+
+	buf[b] = byte(v.State)
+	b += 1
+
+	b += 3 // padding
+
+	return buf
+}
+
+// SequenceId returns the sequence id attached to the AlarmNotify event.
+// Events without a sequence number (KeymapNotify) return 0.
+// This is mostly used internally.
+func (v AlarmNotifyEvent) SequenceId() uint16 {
+	return v.Sequence
+}
+
+// String is a rudimentary string representation of AlarmNotifyEvent.
+func (v AlarmNotifyEvent) String() string {
+	fieldVals := make([]string, 0, 7)
+	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
+	fieldVals = append(fieldVals, xgb.Sprintf("Kind: %d", v.Kind))
+	fieldVals = append(fieldVals, xgb.Sprintf("Alarm: %d", v.Alarm))
+	fieldVals = append(fieldVals, xgb.Sprintf("Timestamp: %d", v.Timestamp))
+	fieldVals = append(fieldVals, xgb.Sprintf("State: %s", v.State))
+	return "AlarmNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
+}
+
+func init() {
+	xgb.NewExtEventFuncs["SYNC"][1] = AlarmNotifyEventNew
+}
+
+type AlarmState byte
+
+const (
+	AlarmStateActive    AlarmState = 0
+	AlarmStateInactive  AlarmState = 1
+	AlarmStateDestroyed AlarmState = 2
+)
+
+// String returns the name of the AlarmState item with this value.
+func (v AlarmState) String() string {
+	switch v {
+	case AlarmStateActive:
+		return "Active"
+	case AlarmStateInactive:
+		return "Inactive"
+	case AlarmStateDestroyed:
+		return "Destroyed"
+	}
+	return xgb.Sprintf("AlarmState(%d)", byte(v))
+}
+
+type CA uint32
+
+const (
+	CACounter   CA = 1
+	CAValueType CA = 2
+	CAValue     CA = 4
+	CATestType  CA = 8
+	CADelta     CA = 16
+	CAEvents    CA = 32
+)
+
+// String returns the names of the CA bits set in a value.
+func (v CA) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 6)
+	if v&CACounter != 0 {
+		names = append(names, "Counter")
+		v &^= CACounter
+	}
+	if v&CAValueType != 0 {
+		names = append(names, "ValueType")
+		v &^= CAValueType
+	}
+	if v&CAValue != 0 {
+		names = append(names, "Value")
+		v &^= CAValue
+	}
+	if v&CATestType != 0 {
+		names = append(names, "TestType")
+		v &^= CATestType
+	}
+	if v&CADelta != 0 {
+		names = append(names, "Delta")
+		v &^= CADelta
+	}
+	if v&CAEvents != 0 {
+		names = append(names, "Events")
+		v &^= CAEvents
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint32(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
+type Counter uint32
+
+func NewCounterId(c *xgb.Conn) (Counter, error) {
+	id, err := c.NewId()
+	if err != nil {
+		return 0, err
+	}
+	return Counter(id), nil
+}
+
+// BadCounter is the error number for a BadCounter.
+const BadCounter = 0
+
+type CounterError struct {
+	Sequence    uint16
+	NiceName    string
+	BadCounter  uint32
+	MinorOpcode uint16
+	MajorOpcode byte
+}
+
+// CounterErrorNew constructs a CounterError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func CounterErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := CounterError{}
+	v.NiceName = "Counter"
+
+	b := 1 // skip error determinant
+	b += 1 // don't read error number
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	v.BadCounter = order.Get32(buf[b:])
+	b += 4
+
+	v.MinorOpcode = order.Get16(buf[b:])
+	b += 2
+
+	v.MajorOpcode = buf[b]
+	b += 1
+
+	return v
+}
+
+// SequenceId returns the sequence id attached to the BadCounter error.
+// This is mostly used internally.
+func (err CounterError) SequenceId() uint16 {
+	return err.Sequence
+}
+
+// BadId returns the 'BadValue' number if one exists for the BadCounter error. If no bad value exists, 0 is returned.
+func (err CounterError) BadId() uint32 {
+	return 0
+}
+
+// Error returns a rudimentary string representation of the BadCounter error.
+
+func (err CounterError) Error() string {
+	fieldVals := make([]string, 0, 3)
+	fieldVals = append(fieldVals, "NiceName: "+err.NiceName)
+	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", err.Sequence))
+	fieldVals = append(fieldVals, xgb.Sprintf("BadCounter: %d", err.BadCounter))
+	fieldVals = append(fieldVals, xgb.Sprintf("MinorOpcode: %d", err.MinorOpcode))
+	fieldVals = append(fieldVals, xgb.Sprintf("MajorOpcode: %d", err.MajorOpcode))
+	return "BadCounter {" + xgb.StringsJoin(fieldVals, ", ") + "}"
+}
+
+func init() {
+	xgb.NewExtErrorFuncs["SYNC"][0] = CounterErrorNew
+}
+
+// CounterNotify is the event number for a CounterNotifyEvent.
+const CounterNotify = 0
+
+type CounterNotifyEvent struct {
+	Sequence     uint16
+	Kind         byte
+	Counter      Counter
+	WaitValue    Int64
+	CounterValue Int64
+	Timestamp    xproto.Timestamp
+	Count        uint16
+	Destroyed    bool
+	// padding: 1 bytes
+}
+
+// CounterNotifyEventNew constructs a CounterNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func CounterNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
+	v := CounterNotifyEvent{}
+	b := 1 // don't read event number
+
+	v.Kind = buf[b]
+	b += 1
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	v.Counter = Counter(order.Get32(buf[b:]))
+	b += 4
+
+	v.WaitValue = Int64{}
+	b += Int64Read(buf[b:], order, &v.WaitValue)
+
+	v.CounterValue = Int64{}
+	b += Int64Read(buf[b:], order, &v.CounterValue)
+
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	v.Count = order.Get16(buf[b:])
+	b += 2
+
+	if buf[b] == 1 {
+		v.Destroyed = true
+	} else {
+		v.Destroyed = false
+	}
+	b += 1
+
+	b += 1 // padding
+
+	return v
+}
+
+// Bytes writes a CounterNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v CounterNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
+	buf := make([]byte, 32)
+	b := 0
+
+	// write event number
+	buf[b] = 0
+	b += 1
+
+	buf[b] = v.Kind
+	b += 1
+
+	b += 2 // skip sequence number
+
+	order.Put32(buf[b:], uint32(v.Counter))
+	b += 4
+
+	{
+		structBytes := v.WaitValue.Bytes(order)
+		copy(buf[b:], structBytes)
+		b += len(structBytes)
+	}
+
+	{
+		structBytes := v.CounterValue.Bytes(order)
+		copy(buf[b:], structBytes)
+		b += len(structBytes)
+	}
+
+	order.Put32(buf[b:], uint32(v.Timestamp))
+	b += 4
+
+	order.Put16(buf[b:], v.Count)
+	b += 2
+
+	if v.Destroyed {
+		buf[b] = 1
+	} else {
+		buf[b] = 0
+	}
+	b += 1
+
+	b += 1 // padding
+
+	return buf
+}
+
+// SequenceId returns the sequence id attached to the CounterNotify event.
+// Events without a sequence number (KeymapNotify) return 0.
+// This is mostly used internally.
+func (v CounterNotifyEvent) SequenceId() uint16 {
+	return v.Sequence
+}
+
+// String is a rudimentary string representation of CounterNotifyEvent.
+func (v CounterNotifyEvent) String() string {
+	fieldVals := make([]string, 0, 8)
+	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
+	fieldVals = append(fieldVals, xgb.Sprintf("Kind: %d", v.Kind))
+	fieldVals = append(fieldVals, xgb.Sprintf("Counter: %d", v.Counter))
+	fieldVals = append(fieldVals, xgb.Sprintf("Timestamp: %d", v.Timestamp))
+	fieldVals = append(fieldVals, xgb.Sprintf("Count: %d", v.Count))
+	fieldVals = append(fieldVals, xgb.Sprintf("Destroyed: %t", v.Destroyed))
+	return "CounterNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
+}
+
+func init() {
+	xgb.NewExtEventFuncs["SYNC"][0] = CounterNotifyEventNew
+}
+
+type Fence uint32
+
+func NewFenceId(c *xgb.Conn) (Fence, error) {
+	id, err := c.NewId()
+	if err != nil {
+		return 0, err
+	}
+	return Fence(id), nil
+}
+
+type Int64 struct {
+	Hi int32
+	Lo uint32
+}
+
+// Int64Read reads a byte slice into a Int64 value.
+func Int64Read(buf []byte, order xgb.ByteOrder, v *Int64) int {
+	b := 0
+
+	v.Hi = int32(order.Get32(buf[b:]))
+	b += 4
+
+	v.Lo = order.Get32(buf[b:])
+	b += 4
+
+	return b
+}
+
+// Int64ReadList reads a byte slice into a list of Int64 values.
+func Int64ReadList(buf []byte, order xgb.ByteOrder, dest []Int64) int {
+	b := 0
+	for i := 0; i < len(dest); i++ {
+		dest[i] = Int64{}
+		b += Int64Read(buf[b:], order, &dest[i])
+	}
+	return xgb.Pad(b)
+}
+
+// Bytes writes a Int64 value to a byte slice, in the byte order 'order'.
+func (v Int64) Bytes(order xgb.ByteOrder) []byte {
+	buf := make([]byte, 8)
+	b := 0
+
+	order.Put32(buf[b:], uint32(v.Hi))
+	b += 4
+
+	order.Put32(buf[b:], v.Lo)
+	b += 4
+
+	return buf[:b]
+}
+
+// Int64ListBytes writes a list of Int64 values to a byte slice.
+func Int64ListBytes(buf []byte, order xgb.ByteOrder, list []Int64) int {
+	b := 0
+	var structBytes []byte
+	for _, item := range list {
+		structBytes = item.Bytes(order)
+		copy(buf[b:], structBytes)
+		b += len(structBytes)
+	}
+	return xgb.Pad(b)
+}
+
+type Systemcounter struct {
+	Counter    Counter
+	Resolution Int64
+	NameLen    uint16
+	Name       string // size: xgb.Pad((int(NameLen) * 1))
+	// alignment gap to multiple of 4
+}
+
+// SystemcounterRead reads a byte slice into a Systemcounter value.
+func SystemcounterRead(buf []byte, order xgb.ByteOrder, v *Systemcounter) int {
+	b := 0
+
+	v.Counter = Counter(order.Get32(buf[b:]))
+	b += 4
+
+	v.Resolution = Int64{}
+	b += Int64Read(buf[b:], order, &v.Resolution)
+
+	v.NameLen = order.Get16(buf[b:])
+	b += 2
+
+	{
+		byteString := make([]byte, v.NameLen)
+		copy(byteString[:v.NameLen], buf[b:])
+		v.Name = string(byteString)
+		b += int(v.NameLen)
+	}
+
+	b = (b + 3) & ^3 // alignment gap
+
+	return b
+}
+
+// SystemcounterReadList reads a byte slice into a list of Systemcounter values.
+func SystemcounterReadList(buf []byte, order xgb.ByteOrder, dest []Systemcounter) int {
+	b := 0
+	for i := 0; i < len(dest); i++ {
+		dest[i] = Systemcounter{}
+		b += SystemcounterRead(buf[b:], order, &dest[i])
+	}
+	return xgb.Pad(b)
+}
+
+// Bytes writes a Systemcounter value to a byte slice, in the byte order 'order'.
+func (v Systemcounter) Bytes(order xgb.ByteOrder) []byte {
+	buf := make([]byte, ((14 + xgb.Pad((int(v.NameLen) * 1))) + 4))
+	b := 0
+
+	order.Put32(buf[b:], uint32(v.Counter))
+	b += 4
+
+	{
+		structBytes := v.Resolution.Bytes(order)
+		copy(buf[b:], structBytes)
+		b += len(structBytes)
+	}
+
+	order.Put16(buf[b:], v.NameLen)
+	b += 2
+
+	copy(buf[b:], v.Name[:v.NameLen])
+	b += int(v.NameLen)
+
+	b = (b + 3) & ^3 // alignment gap
+
+	return buf[:b]
+}
+
+// SystemcounterListBytes writes a list of Systemcounter values to a byte slice.
+func SystemcounterListBytes(buf []byte, order xgb.ByteOrder, list []Systemcounter) int {
+	b := 0
+	var structBytes []byte
+	for _, item := range list {
+		structBytes = item.Bytes(order)
+		copy(buf[b:], structBytes)
+		b += len(structBytes)
+	}
+	return xgb.Pad(b)
+}
+
+// SystemcounterListSize computes the size (bytes) of a list of Systemcounter values.
+func SystemcounterListSize(list []Systemcounter) int {
+	size := 0
+	for _, item := range list {
+		size += ((14 + xgb.Pad((int(item.NameLen) * 1))) + 4)
+	}
+	return size
+}
+
+type TestType uint32
+
+const (
+	TestTypePositiveTransition TestType = 0
+	TestTypeNegativeTransition TestType = 1
+	TestTypePositiveComparison TestType = 2
+	TestTypeNegativeComparison TestType = 3
+)
+
+// String returns the name of the TestType item with this value.
+func (v TestType) String() string {
+	switch v {
+	case TestTypePositiveTransition:
+		return "PositiveTransition"
+	case TestTypeNegativeTransition:
+		return "NegativeTransition"
+	case TestTypePositiveComparison:
+		return "PositiveComparison"
+	case TestTypeNegativeComparison:
+		return "NegativeComparison"
+	}
+	return xgb.Sprintf("TestType(%d)", uint32(v))
+}
+
+type Trigger struct {
+	Counter   Counter
+	WaitType  ValueType
+	WaitValue Int64
+	TestType  TestType
+}
+
+// TriggerRead reads a byte slice into a Trigger value.
+func TriggerRead(buf []byte, order xgb.ByteOrder, v *Trigger) int {
+	b := 0
+
+	v.Counter = Counter(order.Get32(buf[b:]))
+	b += 4
+
+	v.WaitType = ValueType(order.Get32(buf[b:]))
+	b += 4
+
+	v.WaitValue = Int64{}
+	b += Int64Read(buf[b:], order, &v.WaitValue)
+
+	v.TestType = TestType(order.Get32(buf[b:]))
+	b += 4
+
+	return b
+}
+
+// TriggerReadList reads a byte slice into a list of Trigger values.
+func TriggerReadList(buf []byte, order xgb.ByteOrder, dest []Trigger) int {
+	b := 0
+	for i := 0; i < len(dest); i++ {
+		dest[i] = Trigger{}
+		b += TriggerRead(buf[b:], order, &dest[i])
+	}
+	return xgb.Pad(b)
+}
+
+// Bytes writes a Trigger value to a byte slice, in the byte order 'order'.
+func (v Trigger) Bytes(order xgb.ByteOrder) []byte {
+	buf := make([]byte, 20)
+	b := 0
+
+	order.Put32(buf[b:], uint32(v.Counter))
+	b += 4
+
+	order.Put32(buf[b:], uint32(v.WaitType))
+	b += 4
+
+	{
+		structBytes := v.WaitValue.Bytes(order)
+		copy(buf[b:], structBytes)
+		b += len(structBytes)
+	}
+
+	order.Put32(buf[b:], uint32(v.TestType))
+	b += 4
+
+	return buf[:b]
+}
+
+// TriggerListBytes writes a list of Trigger values to a byte slice.
+func TriggerListBytes(buf []byte, order xgb.ByteOrder, list []Trigger) int {
+	b := 0
+	var structBytes []byte
+	for _, item := range list {
+		structBytes = item.Bytes(order)
+		copy(buf[b:], structBytes)
+		b += len(structBytes)
+	}
+	return xgb.Pad(b)
+}
+
+type ValueType uint32
+
+const (
+	ValueTypeAbsolute ValueType = 0
+	ValueTypeRelative ValueType = 1
+)
+
+// String returns the name of the ValueType item with this value.
+func (v ValueType) String() string {
+	switch v {
+	case ValueTypeAbsolute:
+		return "Absolute"
+	case ValueTypeRelative:
+		return "Relative"
+	}
+	return xgb.Sprintf("ValueType(%d)", uint32(v))
+}
+
+type Waitcondition struct {
+	Trigger        Trigger
+	EventThreshold Int64
+}
+
+// WaitconditionRead reads a byte slice into a Waitcondition value.
+func WaitconditionRead(buf []byte, order xgb.ByteOrder, v *Waitcondition) int {
+	b := 0
+
+	v.Trigger = Trigger{}
+	b += TriggerRead(buf[b:], order, &v.Trigger)
+
+	v.EventThreshold = Int64{}
+	b += Int64Read(buf[b:], order, &v.EventThreshold)
+
+	return b
+}
+
+// WaitconditionReadList reads a byte slice into a list of Waitcondition values.
+func WaitconditionReadList(buf []byte, order xgb.ByteOrder, dest []Waitcondition) int {
+	b := 0
+	for i := 0; i < len(dest); i++ {
+		dest[i] = Waitcondition{}
+		b += WaitconditionRead(buf[b:], order, &dest[i])
+	}
+	return xgb.Pad(b)
+}
+
+// Bytes writes a Waitcondition value to a byte slice, in the byte order 'order'.
+func (v Waitcondition) Bytes(order xgb.ByteOrder) []byte {
+	buf := make([]byte, 28)
+	b := 0
+
+	{
+		structBytes := v.Trigger.Bytes(order)
+		copy(buf[b:], structBytes)
+		b += len(structBytes)
+	}
+
+	{
+		structBytes := v.EventThreshold.Bytes(order)
+		copy(buf[b:], structBytes)
+		b += len(structBytes)
+	}
+
+	return buf[:b]
+}
+
+// WaitconditionListBytes writes a list of Waitcondition values to a byte slice.
+func WaitconditionListBytes(buf []byte, order xgb.ByteOrder, list []Waitcondition) int {
+	b := 0
+	var structBytes []byte
+	for _, item := range list {
+		structBytes = item.Bytes(order)
+		copy(buf[b:], structBytes)
+		b += len(structBytes)
+	}
+	return xgb.Pad(b)
+}
+
+// Skipping definition for base type 'Bool'
+
+// Skipping definition for base type 'Byte'
+
+// Skipping definition for base type 'Card8'
+
+// Skipping definition for base type 'Char'
+
+// Skipping definition for base type 'Void'
+
+// Skipping definition for base type 'Double'
+
+// Skipping definition for base type 'Float'
+
+// Skipping definition for base type 'Int16'
+
+// Skipping definition for base type 'Int32'
+
+// Skipping definition for base type 'Int8'
+
+// Skipping definition for base type 'Card16'
+
+// Skipping definition for base type 'Card32'
+
+// Skipping definition for base type 'Card64'
+
+// AwaitCookie is a cookie used only for Await requests.
+type AwaitCookie struct {
+	*xgb.Cookie
+}
+
+// Await sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func Await(c *xgb.Conn, WaitList []Waitcondition) AwaitCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'Await' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequest(awaitRequest(c, WaitList), cookie)
+	return AwaitCookie{cookie}
+}
+
+// AwaitChecked sends a checked request.
+// If an error occurs, it can be retrieved using AwaitCookie.Check()
+func AwaitChecked(c *xgb.Conn, WaitList []Waitcondition) AwaitCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'Await' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, false)
+	c.NewRequest(awaitRequest(c, WaitList), cookie)
+	return AwaitCookie{cookie}
+}
+
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook AwaitCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook AwaitCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for Await
+// awaitRequest writes a Await request to a byte slice.
+func awaitRequest(c *xgb.Conn, WaitList []Waitcondition) []byte {
+	return AppendAwaitRequest(nil, c, WaitList)
+}
+
+// AppendAwaitRequest appends a Await request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendAwaitRequest(buf []byte, c *xgb.Conn, WaitList []Waitcondition) []byte {
+	size := xgb.Pad((4 + xgb.Pad((len(WaitList) * 28))))
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["SYNC"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 7 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	b += WaitconditionListBytes(buf[b:], order, WaitList)
+
+	return buf
+}
+
+// AwaitRequest represents the data of a Await request, as it is read when tracing a connection.
+type AwaitRequest struct {
+	WaitList []Waitcondition // size: xgb.Pad((len(WaitList) * 28))
+}
+
+// awaitRequestRead reads a byte slice in the byte order 'order' into a AwaitRequest value.
+func awaitRequestRead(buf []byte, order xgb.ByteOrder) *AwaitRequest {
+	v := new(AwaitRequest)
+	b := 4 // skip request header
+
+	v.WaitList = make([]Waitcondition, (len(buf)-b)/28)
+	b += WaitconditionReadList(buf[b:], order, v.WaitList)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SYNC"][7] = xgb.RequestInfo{
+		Name: "Await",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return awaitRequestRead(buf, order)
+		},
+	}
+}
+
+// AwaitFenceCookie is a cookie used only for AwaitFence requests.
+type AwaitFenceCookie struct {
+	*xgb.Cookie
+}
+
+// AwaitFence sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func AwaitFence(c *xgb.Conn, FenceList []Fence) AwaitFenceCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'AwaitFence' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequest(awaitFenceRequest(c, FenceList), cookie)
+	return AwaitFenceCookie{cookie}
+}
+
+// AwaitFenceChecked sends a checked request.
+// If an error occurs, it can be retrieved using AwaitFenceCookie.Check()
+func AwaitFenceChecked(c *xgb.Conn, FenceList []Fence) AwaitFenceCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'AwaitFence' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, false)
+	c.NewRequest(awaitFenceRequest(c, FenceList), cookie)
+	return AwaitFenceCookie{cookie}
+}
+
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook AwaitFenceCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook AwaitFenceCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for AwaitFence
+// awaitFenceRequest writes a AwaitFence request to a byte slice.
+func awaitFenceRequest(c *xgb.Conn, FenceList []Fence) []byte {
+	return AppendAwaitFenceRequest(nil, c, FenceList)
+}
+
+// AppendAwaitFenceRequest appends a AwaitFence request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendAwaitFenceRequest(buf []byte, c *xgb.Conn, FenceList []Fence) []byte {
+	size := xgb.Pad((4 + xgb.Pad((len(FenceList) * 4))))
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["SYNC"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 19 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	for i := 0; i < int(len(FenceList)); i++ {
+		order.Put32(buf[b:], uint32(FenceList[i]))
+		b += 4
+	}
+
+	return buf
+}
+
+// AwaitFenceRequest represents the data of a AwaitFence request, as it is read when tracing a connection.
+type AwaitFenceRequest struct {
+	FenceList []Fence // size: xgb.Pad((len(FenceList) * 4))
+}
+
+// awaitFenceRequestRead reads a byte slice in the byte order 'order' into a AwaitFenceRequest value.
+func awaitFenceRequestRead(buf []byte, order xgb.ByteOrder) *AwaitFenceRequest {
+	v := new(AwaitFenceRequest)
+	b := 4 // skip request header
+
+	v.FenceList = make([]Fence, (len(buf)-b)/4)
+	for i := 0; i < int((len(buf)-b)/4); i++ {
+		v.FenceList[i] = Fence(order.Get32(buf[b:]))
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SYNC"][19] = xgb.RequestInfo{
+		Name: "AwaitFence",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return awaitFenceRequestRead(buf, order)
+		},
+	}
+}
+
+// ChangeAlarmValueList is a switch on ValueMask. Only the fields of the cases that match are sent over the wire.
+type ChangeAlarmValueList struct {
+	// int(ValueMask)&int(CACounter) != 0
+	Counter Counter
+	// int(ValueMask)&int(CAValueType) != 0
+	ValueType ValueType
+	// int(ValueMask)&int(CAValue) != 0
+	Value Int64
+	// int(ValueMask)&int(CATestType) != 0
+	TestType TestType
+	// int(ValueMask)&int(CADelta) != 0
+	Delta Int64
+	// int(ValueMask)&int(CAEvents) != 0
+	Events uint32
+}
+
+// ChangeAlarmValues builds the ValueMask and ValueList of a ChangeAlarm request.
+// Only the values that are set are sent, in the order defined by the protocol.
+type ChangeAlarmValues struct {
+	mask CA
+	list ChangeAlarmValueList
+}
+
+// SetCounter sets Counter, the value for CACounter.
+func (v *ChangeAlarmValues) SetCounter(Counter Counter) *ChangeAlarmValues {
+	v.mask |= CACounter
+	v.list.Counter = Counter
+	return v
+}
+
+// SetValueType sets ValueType, the value for CAValueType.
+func (v *ChangeAlarmValues) SetValueType(ValueType ValueType) *ChangeAlarmValues {
+	v.mask |= CAValueType
+	v.list.ValueType = ValueType
+	return v
+}
+
+// SetValue sets Value, the value for CAValue.
+func (v *ChangeAlarmValues) SetValue(Value Int64) *ChangeAlarmValues {
+	v.mask |= CAValue
+	v.list.Value = Value
+	return v
+}
+
+// SetTestType sets TestType, the value for CATestType.
+func (v *ChangeAlarmValues) SetTestType(TestType TestType) *ChangeAlarmValues {
+	v.mask |= CATestType
+	v.list.TestType = TestType
+	return v
+}
+
+// SetDelta sets Delta, the value for CADelta.
+func (v *ChangeAlarmValues) SetDelta(Delta Int64) *ChangeAlarmValues {
+	v.mask |= CADelta
+	v.list.Delta = Delta
+	return v
+}
+
+// SetEvents sets Events, the value for CAEvents.
+func (v *ChangeAlarmValues) SetEvents(Events uint32) *ChangeAlarmValues {
+	v.mask |= CAEvents
+	v.list.Events = Events
+	return v
+}
+
+// Mask returns the ValueMask of the values that have been set.
+func (v *ChangeAlarmValues) Mask() CA {
+	return v.mask
+}
+
+// List returns the ValueList holding the values that have been set.
+func (v *ChangeAlarmValues) List() ChangeAlarmValueList {
+	return v.list
+}
+
+// ChangeAlarmCookie is a cookie used only for ChangeAlarm requests.
+type ChangeAlarmCookie struct {
+	*xgb.Cookie
+}
+
+// ChangeAlarm sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func ChangeAlarm(c *xgb.Conn, Id Alarm, ValueMask CA, ValueList ChangeAlarmValueList) ChangeAlarmCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'ChangeAlarm' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequest(changeAlarmRequest(c, Id, ValueMask, ValueList), cookie)
+	return ChangeAlarmCookie{cookie}
+}
+
+// ChangeAlarmChecked sends a checked request.
+// If an error occurs, it can be retrieved using ChangeAlarmCookie.Check()
+func ChangeAlarmChecked(c *xgb.Conn, Id Alarm, ValueMask CA, ValueList ChangeAlarmValueList) ChangeAlarmCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'ChangeAlarm' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, false)
+	c.NewRequest(changeAlarmRequest(c, Id, ValueMask, ValueList), cookie)
+	return ChangeAlarmCookie{cookie}
+}
+
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook ChangeAlarmCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ChangeAlarmCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for ChangeAlarm
+// changeAlarmRequest writes a ChangeAlarm request to a byte slice.
+func changeAlarmRequest(c *xgb.Conn, Id Alarm, ValueMask CA, ValueList ChangeAlarmValueList) []byte {
+	return AppendChangeAlarmRequest(nil, c, Id, ValueMask, ValueList)
+}
+
+// AppendChangeAlarmRequest appends a ChangeAlarm request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendChangeAlarmRequest(buf []byte, c *xgb.Conn, Id Alarm, ValueMask CA, ValueList ChangeAlarmValueList) []byte {
+	size := xgb.Pad((12 + func() int {
+		size := 0
+		if int(ValueMask)&int(CACounter) != 0 {
+			size += 4
+		}
+		if int(ValueMask)&int(CAValueType) != 0 {
+			size += 4
+		}
+		if int(ValueMask)&int(CAValue) != 0 {
+			size += 8
+		}
+		if int(ValueMask)&int(CATestType) != 0 {
+			size += 4
+		}
+		if int(ValueMask)&int(CADelta) != 0 {
+			size += 8
+		}
+		if int(ValueMask)&int(CAEvents) != 0 {
+			size += 4
+		}
+		return size
+	}()))
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["SYNC"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 9 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Id))
+	b += 4
+
+	order.Put32(buf[b:], uint32(ValueMask))
+	b += 4
+
+	if int(ValueMask)&int(CACounter) != 0 {
+		order.Put32(buf[b:], uint32(ValueList.Counter))
+		b += 4
+	}
+	if int(ValueMask)&int(CAValueType) != 0 {
+		order.Put32(buf[b:], uint32(ValueList.ValueType))
+		b += 4
+	}
+	if int(ValueMask)&int(CAValue) != 0 {
+		{
+			structBytes := ValueList.Value.Bytes(order)
+			copy(buf[b:], structBytes)
+			b += len(structBytes)
+		}
+	}
+	if int(ValueMask)&int(CATestType) != 0 {
+		order.Put32(buf[b:], uint32(ValueList.TestType))
+		b += 4
+	}
+	if int(ValueMask)&int(CADelta) != 0 {
+		{
+			structBytes := ValueList.Delta.Bytes(order)
+			copy(buf[b:], structBytes)
+			b += len(structBytes)
+		}
+	}
+	if int(ValueMask)&int(CAEvents) != 0 {
+		order.Put32(buf[b:], ValueList.Events)
+		b += 4
+	}
+
+	return buf
+}
+
+// ChangeAlarmRequest represents the data of a ChangeAlarm request, as it is read when tracing a connection.
+type ChangeAlarmRequest struct {
+	Id        Alarm
+	ValueMask CA
+	ValueList ChangeAlarmValueList
+}
+
+// changeAlarmRequestRead reads a byte slice in the byte order 'order' into a ChangeAlarmRequest value.
+func changeAlarmRequestRead(buf []byte, order xgb.ByteOrder) *ChangeAlarmRequest {
+	v := new(ChangeAlarmRequest)
+	b := 4 // skip request header
+
+	v.Id = Alarm(order.Get32(buf[b:]))
+	b += 4
+
+	v.ValueMask = CA(order.Get32(buf[b:]))
+	b += 4
+
+	if int(v.ValueMask)&int(CACounter) != 0 {
+		v.ValueList.Counter = Counter(order.Get32(buf[b:]))
+		b += 4
+	}
+	if int(v.ValueMask)&int(CAValueType) != 0 {
+		v.ValueList.ValueType = ValueType(order.Get32(buf[b:]))
+		b += 4
+	}
+	if int(v.ValueMask)&int(CAValue) != 0 {
+		v.ValueList.Value = Int64{}
+		b += Int64Read(buf[b:], order, &v.ValueList.Value)
+	}
+	if int(v.ValueMask)&int(CATestType) != 0 {
+		v.ValueList.TestType = TestType(order.Get32(buf[b:]))
+		b += 4
+	}
+	if int(v.ValueMask)&int(CADelta) != 0 {
+		v.ValueList.Delta = Int64{}
+		b += Int64Read(buf[b:], order, &v.ValueList.Delta)
+	}
+	if int(v.ValueMask)&int(CAEvents) != 0 {
+		v.ValueList.Events = order.Get32(buf[b:])
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SYNC"][9] = xgb.RequestInfo{
+		Name: "ChangeAlarm",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return changeAlarmRequestRead(buf, order)
+		},
+	}
+}
+
+// ChangeCounterCookie is a cookie used only for ChangeCounter requests.
+type ChangeCounterCookie struct {
+	*xgb.Cookie
+}
+
+// ChangeCounter sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func ChangeCounter(c *xgb.Conn, Counter Counter, Amount Int64) ChangeCounterCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'ChangeCounter' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequest(changeCounterRequest(c, Counter, Amount), cookie)
+	return ChangeCounterCookie{cookie}
+}
+
+// ChangeCounterChecked sends a checked request.
+// If an error occurs, it can be retrieved using ChangeCounterCookie.Check()
+func ChangeCounterChecked(c *xgb.Conn, Counter Counter, Amount Int64) ChangeCounterCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'ChangeCounter' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, false)
+	c.NewRequest(changeCounterRequest(c, Counter, Amount), cookie)
+	return ChangeCounterCookie{cookie}
+}
+
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook ChangeCounterCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ChangeCounterCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for ChangeCounter
+// changeCounterRequest writes a ChangeCounter request to a byte slice.
+func changeCounterRequest(c *xgb.Conn, Counter Counter, Amount Int64) []byte {
+	return AppendChangeCounterRequest(nil, c, Counter, Amount)
+}
+
+// AppendChangeCounterRequest appends a ChangeCounter request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendChangeCounterRequest(buf []byte, c *xgb.Conn, Counter Counter, Amount Int64) []byte {
+	size := 16
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["SYNC"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 4 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Counter))
+	b += 4
+
+	{
+		structBytes := Amount.Bytes(order)
+		copy(buf[b:], structBytes)
+		b += len(structBytes)
+	}
+
+	return buf
+}
+
+// ChangeCounterRequest represents the data of a ChangeCounter request, as it is read when tracing a connection.
+type ChangeCounterRequest struct {
+	Counter Counter
+	Amount  Int64
+}
+
+// changeCounterRequestRead reads a byte slice in the byte order 'order' into a ChangeCounterRequest value.
+func changeCounterRequestRead(buf []byte, order xgb.ByteOrder) *ChangeCounterRequest {
+	v := new(ChangeCounterRequest)
+	b := 4 // skip request header
+
+	v.Counter = Counter(order.Get32(buf[b:]))
+	b += 4
+
+	v.Amount = Int64{}
+	b += Int64Read(buf[b:], order, &v.Amount)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SYNC"][4] = xgb.RequestInfo{
+		Name: "ChangeCounter",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return changeCounterRequestRead(buf, order)
+		},
+	}
+}
+
+// CreateAlarmValueList is a switch on ValueMask. Only the fields of the cases that match are sent over the wire.
+type CreateAlarmValueList struct {
+	// int(ValueMask)&int(CACounter) != 0
+	Counter Counter
+	// int(ValueMask)&int(CAValueType) != 0
+	ValueType ValueType
+	// int(ValueMask)&int(CAValue) != 0
+	Value Int64
+	// int(ValueMask)&int(CATestType) != 0
+	TestType TestType
+	// int(ValueMask)&int(CADelta) != 0
+	Delta Int64
+	// int(ValueMask)&int(CAEvents) != 0
+	Events uint32
+}
+
+// CreateAlarmValues builds the ValueMask and ValueList of a CreateAlarm request.
+// Only the values that are set are sent, in the order defined by the protocol.
+type CreateAlarmValues struct {
+	mask CA
+	list CreateAlarmValueList
+}
+
+// SetCounter sets Counter, the value for CACounter.
+func (v *CreateAlarmValues) SetCounter(Counter Counter) *CreateAlarmValues {
+	v.mask |= CACounter
+	v.list.Counter = Counter
+	return v
+}
+
+// SetValueType sets ValueType, the value for CAValueType.
+func (v *CreateAlarmValues) SetValueType(ValueType ValueType) *CreateAlarmValues {
+	v.mask |= CAValueType
+	v.list.ValueType = ValueType
+	return v
+}
+
+// SetValue sets Value, the value for CAValue.
+func (v *CreateAlarmValues) SetValue(Value Int64) *CreateAlarmValues {
+	v.mask |= CAValue
+	v.list.Value = Value
+	return v
+}
+
+// SetTestType sets TestType, the value for CATestType.
+func (v *CreateAlarmValues) SetTestType(TestType TestType) *CreateAlarmValues {
+	v.mask |= CATestType
+	v.list.TestType = TestType
+	return v
+}
+
+// SetDelta sets Delta, the value for CADelta.
+func (v *CreateAlarmValues) SetDelta(Delta Int64) *CreateAlarmValues {
+	v.mask |= CADelta
+	v.list.Delta = Delta
+	return v
+}
+
+// SetEvents sets Events, the value for CAEvents.
+func (v *CreateAlarmValues) SetEvents(Events uint32) *CreateAlarmValues {
+	v.mask |= CAEvents
+	v.list.Events = Events
+	return v
+}
+
+// Mask returns the ValueMask of the values that have been set.
+func (v *CreateAlarmValues) Mask() CA {
+	return v.mask
+}
+
+// List returns the ValueList holding the values that have been set.
+func (v *CreateAlarmValues) List() CreateAlarmValueList {
+	return v.list
+}
+
+// CreateAlarmCookie is a cookie used only for CreateAlarm requests.
+type CreateAlarmCookie struct {
+	*xgb.Cookie
+}
+
+// CreateAlarm sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func CreateAlarm(c *xgb.Conn, Id Alarm, ValueMask CA, ValueList CreateAlarmValueList) CreateAlarmCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'CreateAlarm' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequest(createAlarmRequest(c, Id, ValueMask, ValueList), cookie)
+	return CreateAlarmCookie{cookie}
+}
+
+// CreateAlarmChecked sends a checked request.
+// If an error occurs, it can be retrieved using CreateAlarmCookie.Check()
+func CreateAlarmChecked(c *xgb.Conn, Id Alarm, ValueMask CA, ValueList CreateAlarmValueList) CreateAlarmCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'CreateAlarm' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, false)
+	c.NewRequest(createAlarmRequest(c, Id, ValueMask, ValueList), cookie)
+	return CreateAlarmCookie{cookie}
+}
+
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook CreateAlarmCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateAlarmCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for CreateAlarm
+// createAlarmRequest writes a CreateAlarm request to a byte slice.
+func createAlarmRequest(c *xgb.Conn, Id Alarm, ValueMask CA, ValueList CreateAlarmValueList) []byte {
+	return AppendCreateAlarmRequest(nil, c, Id, ValueMask, ValueList)
+}
+
+// AppendCreateAlarmRequest appends a CreateAlarm request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateAlarmRequest(buf []byte, c *xgb.Conn, Id Alarm, ValueMask CA, ValueList CreateAlarmValueList) []byte {
+	size := xgb.Pad((12 + func() int {
+		size := 0
+		if int(ValueMask)&int(CACounter) != 0 {
+			size += 4
+		}
+		if int(ValueMask)&int(CAValueType) != 0 {
+			size += 4
+		}
+		if int(ValueMask)&int(CAValue) != 0 {
+			size += 8
+		}
+		if int(ValueMask)&int(CATestType) != 0 {
+			size += 4
+		}
+		if int(ValueMask)&int(CADelta) != 0 {
+			size += 8
+		}
+		if int(ValueMask)&int(CAEvents) != 0 {
+			size += 4
+		}
+		return size
+	}()))
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["SYNC"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 8 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Id))
+	b += 4
+
+	order.Put32(buf[b:], uint32(ValueMask))
+	b += 4
+
+	if int(ValueMask)&int(CACounter) != 0 {
+		order.Put32(buf[b:], uint32(ValueList.Counter))
+		b += 4
+	}
+	if int(ValueMask)&int(CAValueType) != 0 {
+		order.Put32(buf[b:], uint32(ValueList.ValueType))
+		b += 4
+	}
+	if int(ValueMask)&int(CAValue) != 0 {
+		{
+			structBytes := ValueList.Value.Bytes(order)
+			copy(buf[b:], structBytes)
+			b += len(structBytes)
+		}
+	}
+	if int(ValueMask)&int(CATestType) != 0 {
+		order.Put32(buf[b:], uint32(ValueList.TestType))
+		b += 4
+	}
+	if int(ValueMask)&int(CADelta) != 0 {
+		{
+			structBytes := ValueList.Delta.Bytes(order)
+			copy(buf[b:], structBytes)
+			b += len(structBytes)
+		}
+	}
+	if int(ValueMask)&int(CAEvents) != 0 {
+		order.Put32(buf[b:], ValueList.Events)
+		b += 4
+	}
+
+	return buf
+}
+
+// CreateAlarmRequest represents the data of a CreateAlarm request, as it is read when tracing a connection.
+type CreateAlarmRequest struct {
+	Id        Alarm
+	ValueMask CA
+	ValueList CreateAlarmValueList
+}
+
+// createAlarmRequestRead reads a byte slice in the byte order 'order' into a CreateAlarmRequest value.
+func createAlarmRequestRead(buf []byte, order xgb.ByteOrder) *CreateAlarmRequest {
+	v := new(CreateAlarmRequest)
+	b := 4 // skip request header
+
+	v.Id = Alarm(order.Get32(buf[b:]))
+	b += 4
+
+	v.ValueMask = CA(order.Get32(buf[b:]))
+	b += 4
+
+	if int(v.ValueMask)&int(CACounter) != 0 {
+		v.ValueList.Counter = Counter(order.Get32(buf[b:]))
+		b += 4
+	}
+	if int(v.ValueMask)&int(CAValueType) != 0 {
+		v.ValueList.ValueType = ValueType(order.Get32(buf[b:]))
+		b += 4
+	}
+	if int(v.ValueMask)&int(CAValue) != 0 {
+		v.ValueList.Value = Int64{}
+		b += Int64Read(buf[b:], order, &v.ValueList.Value)
+	}
+	if int(v.ValueMask)&int(CATestType) != 0 {
+		v.ValueList.TestType = TestType(order.Get32(buf[b:]))
+		b += 4
+	}
+	if int(v.ValueMask)&int(CADelta) != 0 {
+		v.ValueList.Delta = Int64{}
+		b += Int64Read(buf[b:], order, &v.ValueList.Delta)
+	}
+	if int(v.ValueMask)&int(CAEvents) != 0 {
+		v.ValueList.Events = order.Get32(buf[b:])
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SYNC"][8] = xgb.RequestInfo{
+		Name: "CreateAlarm",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createAlarmRequestRead(buf, order)
+		},
+	}
+}
+
+// CreateCounterCookie is a cookie used only for CreateCounter requests.
+type CreateCounterCookie struct {
+	*xgb.Cookie
+}
+
+// CreateCounter sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func CreateCounter(c *xgb.Conn, Id Counter, InitialValue Int64) CreateCounterCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'CreateCounter' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequest(createCounterRequest(c, Id, InitialValue), cookie)
+	return CreateCounterCookie{cookie}
+}
+
+// CreateCounterChecked sends a checked request.
+// If an error occurs, it can be retrieved using CreateCounterCookie.Check()
+func CreateCounterChecked(c *xgb.Conn, Id Counter, InitialValue Int64) CreateCounterCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'CreateCounter' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, false)
+	c.NewRequest(createCounterRequest(c, Id, InitialValue), cookie)
+	return CreateCounterCookie{cookie}
+}
+
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook CreateCounterCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateCounterCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for CreateCounter
+// createCounterRequest writes a CreateCounter request to a byte slice.
+func createCounterRequest(c *xgb.Conn, Id Counter, InitialValue Int64) []byte {
+	return AppendCreateCounterRequest(nil, c, Id, InitialValue)
+}
+
+// AppendCreateCounterRequest appends a CreateCounter request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateCounterRequest(buf []byte, c *xgb.Conn, Id Counter, InitialValue Int64) []byte {
+	size := 16
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["SYNC"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 2 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Id))
+	b += 4
+
+	{
+		structBytes := InitialValue.Bytes(order)
+		copy(buf[b:], structBytes)
+		b += len(structBytes)
+	}
+
+	return buf
+}
+
+// CreateCounterRequest represents the data of a CreateCounter request, as it is read when tracing a connection.
+type CreateCounterRequest struct {
+	Id           Counter
+	InitialValue Int64
+}
+
+// createCounterRequestRead reads a byte slice in the byte order 'order' into a CreateCounterRequest value.
+func createCounterRequestRead(buf []byte, order xgb.ByteOrder) *CreateCounterRequest {
+	v := new(CreateCounterRequest)
+	b := 4 // skip request header
+
+	v.Id = Counter(order.Get32(buf[b:]))
+	b += 4
+
+	v.InitialValue = Int64{}
+	b += Int64Read(buf[b:], order, &v.InitialValue)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SYNC"][2] = xgb.RequestInfo{
+		Name: "CreateCounter",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createCounterRequestRead(buf, order)
+		},
+	}
+}
+
+// CreateFenceCookie is a cookie used only for CreateFence requests.
+type CreateFenceCookie struct {
+	*xgb.Cookie
+}
+
+// CreateFence sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func CreateFence(c *xgb.Conn, Drawable xproto.Drawable, Fence Fence, InitiallyTriggered bool) CreateFenceCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'CreateFence' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequest(createFenceRequest(c, Drawable, Fence, InitiallyTriggered), cookie)
+	return CreateFenceCookie{cookie}
+}
+
+// CreateFenceChecked sends a checked request.
+// If an error occurs, it can be retrieved using CreateFenceCookie.Check()
+func CreateFenceChecked(c *xgb.Conn, Drawable xproto.Drawable, Fence Fence, InitiallyTriggered bool) CreateFenceCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'CreateFence' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, false)
+	c.NewRequest(createFenceRequest(c, Drawable, Fence, InitiallyTriggered), cookie)
+	return CreateFenceCookie{cookie}
+}
+
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook CreateFenceCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateFenceCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for CreateFence
+// createFenceRequest writes a CreateFence request to a byte slice.
+func createFenceRequest(c *xgb.Conn, Drawable xproto.Drawable, Fence Fence, InitiallyTriggered bool) []byte {
+	return AppendCreateFenceRequest(nil, c, Drawable, Fence, InitiallyTriggered)
+}
+
+// AppendCreateFenceRequest appends a CreateFence request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateFenceRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable, Fence Fence, InitiallyTriggered bool) []byte {
+	size := 16
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["SYNC"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 14 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Drawable))
+	b += 4
+
+	order.Put32(buf[b:], uint32(Fence))
+	b += 4
+
+	if InitiallyTriggered {
+		buf[b] = 1
+	} else {
+		buf[b] = 0
+	}
+	b += 1
+
+	return buf
+}
+
+// CreateFenceRequest represents the data of a CreateFence request, as it is read when tracing a connection.
+type CreateFenceRequest struct {
+	Drawable           xproto.Drawable
+	Fence              Fence
+	InitiallyTriggered bool
+}
+
+// createFenceRequestRead reads a byte slice in the byte order 'order' into a CreateFenceRequest value.
+func createFenceRequestRead(buf []byte, order xgb.ByteOrder) *CreateFenceRequest {
+	v := new(CreateFenceRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Fence = Fence(order.Get32(buf[b:]))
+	b += 4
+
+	if buf[b] == 1 {
+		v.InitiallyTriggered = true
+	} else {
+		v.InitiallyTriggered = false
+	}
+	b += 1
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SYNC"][14] = xgb.RequestInfo{
+		Name: "CreateFence",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createFenceRequestRead(buf, order)
+		},
+	}
+}
+
+// DestroyAlarmCookie is a cookie used only for DestroyAlarm requests.
+type DestroyAlarmCookie struct {
+	*xgb.Cookie
+}
+
+// DestroyAlarm sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func DestroyAlarm(c *xgb.Conn, Alarm Alarm) DestroyAlarmCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'DestroyAlarm' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequest(destroyAlarmRequest(c, Alarm), cookie)
+	return DestroyAlarmCookie{cookie}
+}
+
+// DestroyAlarmChecked sends a checked request.
+// If an error occurs, it can be retrieved using DestroyAlarmCookie.Check()
+func DestroyAlarmChecked(c *xgb.Conn, Alarm Alarm) DestroyAlarmCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'DestroyAlarm' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, false)
+	c.NewRequest(destroyAlarmRequest(c, Alarm), cookie)
+	return DestroyAlarmCookie{cookie}
+}
+
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook DestroyAlarmCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DestroyAlarmCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for DestroyAlarm
+// destroyAlarmRequest writes a DestroyAlarm request to a byte slice.
+func destroyAlarmRequest(c *xgb.Conn, Alarm Alarm) []byte {
+	return AppendDestroyAlarmRequest(nil, c, Alarm)
+}
+
+// AppendDestroyAlarmRequest appends a DestroyAlarm request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDestroyAlarmRequest(buf []byte, c *xgb.Conn, Alarm Alarm) []byte {
+	size := 8
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["SYNC"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 11 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Alarm))
+	b += 4
+
+	return buf
+}
+
+// DestroyAlarmRequest represents the data of a DestroyAlarm request, as it is read when tracing a connection.
+type DestroyAlarmRequest struct {
+	Alarm Alarm
+}
+
+// destroyAlarmRequestRead reads a byte slice in the byte order 'order' into a DestroyAlarmRequest value.
+func destroyAlarmRequestRead(buf []byte, order xgb.ByteOrder) *DestroyAlarmRequest {
+	v := new(DestroyAlarmRequest)
+	b := 4 // skip request header
+
+	v.Alarm = Alarm(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SYNC"][11] = xgb.RequestInfo{
+		Name: "DestroyAlarm",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return destroyAlarmRequestRead(buf, order)
+		},
+	}
+}
+
+// DestroyCounterCookie is a cookie used only for DestroyCounter requests.
+type DestroyCounterCookie struct {
+	*xgb.Cookie
+}
+
+// DestroyCounter sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func DestroyCounter(c *xgb.Conn, Counter Counter) DestroyCounterCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'DestroyCounter' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequest(destroyCounterRequest(c, Counter), cookie)
+	return DestroyCounterCookie{cookie}
+}
+
+// DestroyCounterChecked sends a checked request.
+// If an error occurs, it can be retrieved using DestroyCounterCookie.Check()
+func DestroyCounterChecked(c *xgb.Conn, Counter Counter) DestroyCounterCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'DestroyCounter' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, false)
+	c.NewRequest(destroyCounterRequest(c, Counter), cookie)
+	return DestroyCounterCookie{cookie}
+}
+
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook DestroyCounterCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DestroyCounterCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for DestroyCounter
+// destroyCounterRequest writes a DestroyCounter request to a byte slice.
+func destroyCounterRequest(c *xgb.Conn, Counter Counter) []byte {
+	return AppendDestroyCounterRequest(nil, c, Counter)
+}
+
+// AppendDestroyCounterRequest appends a DestroyCounter request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDestroyCounterRequest(buf []byte, c *xgb.Conn, Counter Counter) []byte {
+	size := 8
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["SYNC"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 6 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Counter))
+	b += 4
+
+	return buf
+}
+
+// DestroyCounterRequest represents the data of a DestroyCounter request, as it is read when tracing a connection.
+type DestroyCounterRequest struct {
+	Counter Counter
+}
+
+// destroyCounterRequestRead reads a byte slice in the byte order 'order' into a DestroyCounterRequest value.
+func destroyCounterRequestRead(buf []byte, order xgb.ByteOrder) *DestroyCounterRequest {
+	v := new(DestroyCounterRequest)
+	b := 4 // skip request header
+
+	v.Counter = Counter(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SYNC"][6] = xgb.RequestInfo{
+		Name: "DestroyCounter",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return destroyCounterRequestRead(buf, order)
+		},
+	}
+}
+
+// DestroyFenceCookie is a cookie used only for DestroyFence requests.
+type DestroyFenceCookie struct {
+	*xgb.Cookie
+}
+
+// DestroyFence sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func DestroyFence(c *xgb.Conn, Fence Fence) DestroyFenceCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'DestroyFence' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequest(destroyFenceRequest(c, Fence), cookie)
+	return DestroyFenceCookie{cookie}
+}
+
+// DestroyFenceChecked sends a checked request.
+// If an error occurs, it can be retrieved using DestroyFenceCookie.Check()
+func DestroyFenceChecked(c *xgb.Conn, Fence Fence) DestroyFenceCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'DestroyFence' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, false)
+	c.NewRequest(destroyFenceRequest(c, Fence), cookie)
+	return DestroyFenceCookie{cookie}
+}
+
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook DestroyFenceCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DestroyFenceCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for DestroyFence
+// destroyFenceRequest writes a DestroyFence request to a byte slice.
+func destroyFenceRequest(c *xgb.Conn, Fence Fence) []byte {
+	return AppendDestroyFenceRequest(nil, c, Fence)
+}
+
+// AppendDestroyFenceRequest appends a DestroyFence request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDestroyFenceRequest(buf []byte, c *xgb.Conn, Fence Fence) []byte {
+	size := 8
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["SYNC"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 17 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Fence))
+	b += 4
+
+	return buf
+}
+
+// DestroyFenceRequest represents the data of a DestroyFence request, as it is read when tracing a connection.
+type DestroyFenceRequest struct {
+	Fence Fence
+}
+
+// destroyFenceRequestRead reads a byte slice in the byte order 'order' into a DestroyFenceRequest value.
+func destroyFenceRequestRead(buf []byte, order xgb.ByteOrder) *DestroyFenceRequest {
+	v := new(DestroyFenceRequest)
+	b := 4 // skip request header
+
+	v.Fence = Fence(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SYNC"][17] = xgb.RequestInfo{
+		Name: "DestroyFence",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return destroyFenceRequestRead(buf, order)
+		},
+	}
+}
+
+// GetPriorityCookie is a cookie used only for GetPriority requests.
+type GetPriorityCookie struct {
+	*xgb.Cookie
+}
+
+// GetPriority sends a checked request.
+// If an error occurs, it will be returned with the reply by calling GetPriorityCookie.Reply()
+func GetPriority(c *xgb.Conn, Id uint32) GetPriorityCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'GetPriority' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, true)
+	c.NewRequest(getPriorityRequest(c, Id), cookie)
+	return GetPriorityCookie{cookie}
+}
+
+// GetPriorityUnchecked sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func GetPriorityUnchecked(c *xgb.Conn, Id uint32) GetPriorityCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'GetPriority' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, true)
+	c.NewRequest(getPriorityRequest(c, Id), cookie)
+	return GetPriorityCookie{cookie}
+}
+
+// GetPriorityReply represents the data returned from a GetPriority request.
+type GetPriorityReply struct {
+	Sequence uint16 // sequence number of the request for this reply
+	Length   uint32 // number of bytes in this reply
+	// padding: 1 bytes
+	Priority int32
+}
+
+// Reply blocks and returns the reply data for a GetPriority request.
+func (cook GetPriorityCookie) Reply() (*GetPriorityReply, error) {
+	buf, err := cook.Cookie.Reply()
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getPriorityReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetPriority request arrives.
+func (cook GetPriorityCookie) ReplyContext(ctx context.Context) (*GetPriorityReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getPriorityReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// getPriorityReply reads a byte slice in the byte order 'order' into a GetPriorityReply value.
+func getPriorityReply(buf []byte, order xgb.ByteOrder) *GetPriorityReply {
+	v := new(GetPriorityReply)
+	b := 1 // skip reply determinant
+
+	b += 1 // padding
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	v.Length = order.Get32(buf[b:]) // 4-byte units
+	b += 4
+
+	v.Priority = int32(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+// Write request to wire for GetPriority
+// getPriorityRequest writes a GetPriority request to a byte slice.
+func getPriorityRequest(c *xgb.Conn, Id uint32) []byte {
+	return AppendGetPriorityRequest(nil, c, Id)
+}
+
+// AppendGetPriorityRequest appends a GetPriority request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetPriorityRequest(buf []byte, c *xgb.Conn, Id uint32) []byte {
+	size := 8
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["SYNC"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 13 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], Id)
+	b += 4
+
+	return buf
+}
+
+// GetPriorityRequest represents the data of a GetPriority request, as it is read when tracing a connection.
+type GetPriorityRequest struct {
+	Id uint32
+}
+
+// getPriorityRequestRead reads a byte slice in the byte order 'order' into a GetPriorityRequest value.
+func getPriorityRequestRead(buf []byte, order xgb.ByteOrder) *GetPriorityRequest {
+	v := new(GetPriorityRequest)
+	b := 4 // skip request header
+
+	v.Id = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SYNC"][13] = xgb.RequestInfo{
+		Name: "GetPriority",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPriorityRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPriorityReply(buf, order)
+		},
+	}
+}
+
+// InitializeCookie is a cookie used only for Initialize requests.
+type InitializeCookie struct {
+	*xgb.Cookie
+}
+
+// Initialize sends a checked request.
+// If an error occurs, it will be returned with the reply by calling InitializeCookie.Reply()
+func Initialize(c *xgb.Conn, DesiredMajorVersion byte, DesiredMinorVersion byte) InitializeCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'Initialize' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, true)
+	c.NewRequest(initializeRequest(c, DesiredMajorVersion, DesiredMinorVersion), cookie)
+	return InitializeCookie{cookie}
+}
+
+// InitializeUnchecked sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func InitializeUnchecked(c *xgb.Conn, DesiredMajorVersion byte, DesiredMinorVersion byte) InitializeCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'Initialize' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, true)
+	c.NewRequest(initializeRequest(c, DesiredMajorVersion, DesiredMinorVersion), cookie)
+	return InitializeCookie{cookie}
+}
+
+// InitializeReply represents the data returned from a Initialize request.
+type InitializeReply struct {
+	Sequence uint16 // sequence number of the request for this reply
+	Length   uint32 // number of bytes in this reply
+	// padding: 1 bytes
+	MajorVersion byte
+	MinorVersion byte
+	// padding: 22 bytes
+}
+
+// Reply blocks and returns the reply data for a Initialize request.
+func (cook InitializeCookie) Reply() (*InitializeReply, error) {
+	buf, err := cook.Cookie.Reply()
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return initializeReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a Initialize request arrives.
+func (cook InitializeCookie) ReplyContext(ctx context.Context) (*InitializeReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return initializeReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// initializeReply reads a byte slice in the byte order 'order' into a InitializeReply value.
+func initializeReply(buf []byte, order xgb.ByteOrder) *InitializeReply {
+	v := new(InitializeReply)
+	b := 1 // skip reply determinant
+
+	b += 1 // padding
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	v.Length = order.Get32(buf[b:]) // 4-byte units
+	b += 4
+
+	v.MajorVersion = buf[b]
+	b += 1
+
+	v.MinorVersion = buf[b]
+	b += 1
+
+	b += 22 // padding
+
+	return v
+}
+
+// Write request to wire for Initialize
+// initializeRequest writes a Initialize request to a byte slice.
+func initializeRequest(c *xgb.Conn, DesiredMajorVersion byte, DesiredMinorVersion byte) []byte {
+	return AppendInitializeRequest(nil, c, DesiredMajorVersion, DesiredMinorVersion)
+}
+
+// AppendInitializeRequest appends a Initialize request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendInitializeRequest(buf []byte, c *xgb.Conn, DesiredMajorVersion byte, DesiredMinorVersion byte) []byte {
+	size := 8
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["SYNC"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 0 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	buf[b] = DesiredMajorVersion
+	b += 1
+
+	buf[b] = DesiredMinorVersion
+	b += 1
+
+	return buf
+}
+
+// InitializeRequest represents the data of a Initialize request, as it is read when tracing a connection.
+type InitializeRequest struct {
+	DesiredMajorVersion byte
+	DesiredMinorVersion byte
+}
+
+// initializeRequestRead reads a byte slice in the byte order 'order' into a InitializeRequest value.
+func initializeRequestRead(buf []byte, order xgb.ByteOrder) *InitializeRequest {
+	v := new(InitializeRequest)
+	b := 4 // skip request header
+
+	v.DesiredMajorVersion = buf[b]
+	b += 1
+
+	v.DesiredMinorVersion = buf[b]
+	b += 1
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SYNC"][0] = xgb.RequestInfo{
+		Name: "Initialize",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return initializeRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return initializeReply(buf, order)
+		},
+	}
+}
+
+// ListSystemCountersCookie is a cookie used only for ListSystemCounters requests.
+type ListSystemCountersCookie struct {
+	*xgb.Cookie
+}
+
+// ListSystemCounters sends a checked request.
+// If an error occurs, it will be returned with the reply by calling ListSystemCountersCookie.Reply()
+func ListSystemCounters(c *xgb.Conn) ListSystemCountersCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'ListSystemCounters' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, true)
+	c.NewRequest(listSystemCountersRequest(c), cookie)
+	return ListSystemCountersCookie{cookie}
+}
+
+// ListSystemCountersUnchecked sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func ListSystemCountersUnchecked(c *xgb.Conn) ListSystemCountersCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'ListSystemCounters' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, true)
+	c.NewRequest(listSystemCountersRequest(c), cookie)
+	return ListSystemCountersCookie{cookie}
+}
+
+// ListSystemCountersReply represents the data returned from a ListSystemCounters request.
+type ListSystemCountersReply struct {
+	Sequence uint16 // sequence number of the request for this reply
+	Length   uint32 // number of bytes in this reply
+	// padding: 1 bytes
+	CountersLen uint32
+	// padding: 20 bytes
+	Counters []Systemcounter // size: SystemcounterListSize(Counters)
+}
+
+// Reply blocks and returns the reply data for a ListSystemCounters request.
+func (cook ListSystemCountersCookie) Reply() (*ListSystemCountersReply, error) {
+	buf, err := cook.Cookie.Reply()
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return listSystemCountersReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a ListSystemCounters request arrives.
+func (cook ListSystemCountersCookie) ReplyContext(ctx context.Context) (*ListSystemCountersReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return listSystemCountersReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// listSystemCountersReply reads a byte slice in the byte order 'order' into a ListSystemCountersReply value.
+func listSystemCountersReply(buf []byte, order xgb.ByteOrder) *ListSystemCountersReply {
+	v := new(ListSystemCountersReply)
+	b := 1 // skip reply determinant
+
+	b += 1 // padding
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	v.Length = order.Get32(buf[b:]) // 4-byte units
+	b += 4
+
+	v.CountersLen = order.Get32(buf[b:])
+	b += 4
+
+	b += 20 // padding
+
+	v.Counters = make([]Systemcounter, v.CountersLen)
+	b += SystemcounterReadList(buf[b:], order, v.Counters)
+
+	return v
+}
+
+// Write request to wire for ListSystemCounters
+// listSystemCountersRequest writes a ListSystemCounters request to a byte slice.
+func listSystemCountersRequest(c *xgb.Conn) []byte {
+	return AppendListSystemCountersRequest(nil, c)
+}
+
+// AppendListSystemCountersRequest appends a ListSystemCounters request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendListSystemCountersRequest(buf []byte, c *xgb.Conn) []byte {
+	size := 4
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["SYNC"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 1 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	return buf
+}
+
+// ListSystemCountersRequest represents the data of a ListSystemCounters request, as it is read when tracing a connection.
+type ListSystemCountersRequest struct {
+}
+
+// listSystemCountersRequestRead reads a byte slice in the byte order 'order' into a ListSystemCountersRequest value.
+func listSystemCountersRequestRead(buf []byte, order xgb.ByteOrder) *ListSystemCountersRequest {
+	v := new(ListSystemCountersRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SYNC"][1] = xgb.RequestInfo{
+		Name: "ListSystemCounters",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listSystemCountersRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listSystemCountersReply(buf, order)
+		},
+	}
+}
+
+// QueryAlarmCookie is a cookie used only for QueryAlarm requests.
+type QueryAlarmCookie struct {
+	*xgb.Cookie
+}
+
+// QueryAlarm sends a checked request.
+// If an error occurs, it will be returned with the reply by calling QueryAlarmCookie.Reply()
+func QueryAlarm(c *xgb.Conn, Alarm Alarm) QueryAlarmCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'QueryAlarm' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, true)
+	c.NewRequest(queryAlarmRequest(c, Alarm), cookie)
+	return QueryAlarmCookie{cookie}
+}
+
+// QueryAlarmUnchecked sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func QueryAlarmUnchecked(c *xgb.Conn, Alarm Alarm) QueryAlarmCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'QueryAlarm' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, true)
+	c.NewRequest(queryAlarmRequest(c, Alarm), cookie)
+	return QueryAlarmCookie{cookie}
+}
+
+// QueryAlarmReply represents the data returned from a QueryAlarm request.
+type QueryAlarmReply struct {
+	Sequence uint16 // sequence number of the request for this reply
+	Length   uint32 // number of bytes in this reply
+	// padding: 1 bytes
+	Trigger Trigger
+	Delta   Int64
+	Events  bool
+	State   AlarmState
+	// padding: 2 bytes
+}
+
+// Reply blocks and returns the reply data for a QueryAlarm request.
+func (cook QueryAlarmCookie) Reply() (*QueryAlarmReply, error) {
+	buf, err := cook.Cookie.Reply()
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryAlarmReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryAlarm request arrives.
+func (cook QueryAlarmCookie) ReplyContext(ctx context.Context) (*QueryAlarmReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryAlarmReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryAlarmReply reads a byte slice in the byte order 'order' into a QueryAlarmReply value.
+func queryAlarmReply(buf []byte, order xgb.ByteOrder) *QueryAlarmReply {
+	v := new(QueryAlarmReply)
+	b := 1 // skip reply determinant
+
+	b += 1 // padding
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	v.Length = order.Get32(buf[b:]) // 4-byte units
+	b += 4
+
+	v.Trigger = Trigger{}
+	b += TriggerRead(buf[b:], order, &v.Trigger)
+
+	v.Delta = Int64{}
+	b += Int64Read(buf[b:], order, &v.Delta)
+
+	if buf[b] == 1 {
+		v.Events = true
+	} else {
+		v.Events = false
+	}
+	b += 1
+
+	v.State = AlarmState(buf[b])
+	b += 1
+
+	b += 2 // padding
+
+	return v
+}
+
+// Write request to wire for QueryAlarm
+// queryAlarmRequest writes a QueryAlarm request to a byte slice.
+func queryAlarmRequest(c *xgb.Conn, Alarm Alarm) []byte {
+	return AppendQueryAlarmRequest(nil, c, Alarm)
+}
+
+// AppendQueryAlarmRequest appends a QueryAlarm request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryAlarmRequest(buf []byte, c *xgb.Conn, Alarm Alarm) []byte {
+	size := 8
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["SYNC"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 10 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Alarm))
+	b += 4
+
+	return buf
+}
+
+// QueryAlarmRequest represents the data of a QueryAlarm request, as it is read when tracing a connection.
+type QueryAlarmRequest struct {
+	Alarm Alarm
+}
+
+// queryAlarmRequestRead reads a byte slice in the byte order 'order' into a QueryAlarmRequest value.
+func queryAlarmRequestRead(buf []byte, order xgb.ByteOrder) *QueryAlarmRequest {
+	v := new(QueryAlarmRequest)
+	b := 4 // skip request header
+
+	v.Alarm = Alarm(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SYNC"][10] = xgb.RequestInfo{
+		Name: "QueryAlarm",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryAlarmRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryAlarmReply(buf, order)
+		},
+	}
+}
+
+// QueryCounterCookie is a cookie used only for QueryCounter requests.
+type QueryCounterCookie struct {
+	*xgb.Cookie
+}
+
+// QueryCounter sends a checked request.
+// If an error occurs, it will be returned with the reply by calling QueryCounterCookie.Reply()
+func QueryCounter(c *xgb.Conn, Counter Counter) QueryCounterCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'QueryCounter' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, true)
+	c.NewRequest(queryCounterRequest(c, Counter), cookie)
+	return QueryCounterCookie{cookie}
+}
+
+// QueryCounterUnchecked sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func QueryCounterUnchecked(c *xgb.Conn, Counter Counter) QueryCounterCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'QueryCounter' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, true)
+	c.NewRequest(queryCounterRequest(c, Counter), cookie)
+	return QueryCounterCookie{cookie}
+}
+
+// QueryCounterReply represents the data returned from a QueryCounter request.
+type QueryCounterReply struct {
+	Sequence uint16 // sequence number of the request for this reply
+	Length   uint32 // number of bytes in this reply
+	// padding: 1 bytes
+	CounterValue Int64
+}
+
+// Reply blocks and returns the reply data for a QueryCounter request.
+func (cook QueryCounterCookie) Reply() (*QueryCounterReply, error) {
+	buf, err := cook.Cookie.Reply()
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryCounterReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryCounter request arrives.
+func (cook QueryCounterCookie) ReplyContext(ctx context.Context) (*QueryCounterReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryCounterReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryCounterReply reads a byte slice in the byte order 'order' into a QueryCounterReply value.
+func queryCounterReply(buf []byte, order xgb.ByteOrder) *QueryCounterReply {
+	v := new(QueryCounterReply)
+	b := 1 // skip reply determinant
+
+	b += 1 // padding
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	v.Length = order.Get32(buf[b:]) // 4-byte units
+	b += 4
+
+	v.CounterValue = Int64{}
+	b += Int64Read(buf[b:], order, &v.CounterValue)
+
+	return v
+}
+
+// Write request to wire for QueryCounter
+// queryCounterRequest writes a QueryCounter request to a byte slice.
+func queryCounterRequest(c *xgb.Conn, Counter Counter) []byte {
+	return AppendQueryCounterRequest(nil, c, Counter)
+}
+
+// AppendQueryCounterRequest appends a QueryCounter request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryCounterRequest(buf []byte, c *xgb.Conn, Counter Counter) []byte {
+	size := 8
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["SYNC"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 5 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Counter))
+	b += 4
+
+	return buf
+}
+
+// QueryCounterRequest represents the data of a QueryCounter request, as it is read when tracing a connection.
+type QueryCounterRequest struct {
+	Counter Counter
+}
+
+// queryCounterRequestRead reads a byte slice in the byte order 'order' into a QueryCounterRequest value.
+func queryCounterRequestRead(buf []byte, order xgb.ByteOrder) *QueryCounterRequest {
+	v := new(QueryCounterRequest)
+	b := 4 // skip request header
+
+	v.Counter = Counter(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SYNC"][5] = xgb.RequestInfo{
+		Name: "QueryCounter",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryCounterRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryCounterReply(buf, order)
+		},
+	}
+}
+
+// QueryFenceCookie is a cookie used only for QueryFence requests.
+type QueryFenceCookie struct {
+	*xgb.Cookie
+}
+
+// QueryFence sends a checked request.
+// If an error occurs, it will be returned with the reply by calling QueryFenceCookie.Reply()
+func QueryFence(c *xgb.Conn, Fence Fence) QueryFenceCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'QueryFence' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, true)
+	c.NewRequest(queryFenceRequest(c, Fence), cookie)
+	return QueryFenceCookie{cookie}
+}
+
+// QueryFenceUnchecked sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func QueryFenceUnchecked(c *xgb.Conn, Fence Fence) QueryFenceCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'QueryFence' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, true)
+	c.NewRequest(queryFenceRequest(c, Fence), cookie)
+	return QueryFenceCookie{cookie}
+}
+
+// QueryFenceReply represents the data returned from a QueryFence request.
+type QueryFenceReply struct {
+	Sequence uint16 // sequence number of the request for this reply
+	Length   uint32 // number of bytes in this reply
+	// padding: 1 bytes
+	Triggered bool
+	// padding: 23 bytes
+}
+
+// Reply blocks and returns the reply data for a QueryFence request.
+func (cook QueryFenceCookie) Reply() (*QueryFenceReply, error) {
+	buf, err := cook.Cookie.Reply()
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryFenceReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryFence request arrives.
+func (cook QueryFenceCookie) ReplyContext(ctx context.Context) (*QueryFenceReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryFenceReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryFenceReply reads a byte slice in the byte order 'order' into a QueryFenceReply value.
+func queryFenceReply(buf []byte, order xgb.ByteOrder) *QueryFenceReply {
+	v := new(QueryFenceReply)
+	b := 1 // skip reply determinant
+
+	b += 1 // padding
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	v.Length = order.Get32(buf[b:]) // 4-byte units
+	b += 4
+
+	if buf[b] == 1 {
+		v.Triggered = true
+	} else {
+		v.Triggered = false
+	}
+	b += 1
+
+	b += 23 // padding
+
+	return v
+}
+
+// Write request to wire for QueryFence
+// queryFenceRequest writes a QueryFence request to a byte slice.
+func queryFenceRequest(c *xgb.Conn, Fence Fence) []byte {
+	return AppendQueryFenceRequest(nil, c, Fence)
+}
+
+// AppendQueryFenceRequest appends a QueryFence request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryFenceRequest(buf []byte, c *xgb.Conn, Fence Fence) []byte {
+	size := 8
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["SYNC"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 18 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Fence))
+	b += 4
+
+	return buf
+}
+
+// QueryFenceRequest represents the data of a QueryFence request, as it is read when tracing a connection.
+type QueryFenceRequest struct {
+	Fence Fence
+}
+
+// queryFenceRequestRead reads a byte slice in the byte order 'order' into a QueryFenceRequest value.
+func queryFenceRequestRead(buf []byte, order xgb.ByteOrder) *QueryFenceRequest {
+	v := new(QueryFenceRequest)
+	b := 4 // skip request header
+
+	v.Fence = Fence(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SYNC"][18] = xgb.RequestInfo{
+		Name: "QueryFence",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryFenceRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryFenceReply(buf, order)
+		},
+	}
+}
+
+// ResetFenceCookie is a cookie used only for ResetFence requests.
+type ResetFenceCookie struct {
+	*xgb.Cookie
+}
+
+// ResetFence sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func ResetFence(c *xgb.Conn, Fence Fence) ResetFenceCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'ResetFence' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequest(resetFenceRequest(c, Fence), cookie)
+	return ResetFenceCookie{cookie}
+}
+
+// ResetFenceChecked sends a checked request.
+// If an error occurs, it can be retrieved using ResetFenceCookie.Check()
+func ResetFenceChecked(c *xgb.Conn, Fence Fence) ResetFenceCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'ResetFence' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, false)
+	c.NewRequest(resetFenceRequest(c, Fence), cookie)
+	return ResetFenceCookie{cookie}
+}
+
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook ResetFenceCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ResetFenceCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for ResetFence
+// resetFenceRequest writes a ResetFence request to a byte slice.
+func resetFenceRequest(c *xgb.Conn, Fence Fence) []byte {
+	return AppendResetFenceRequest(nil, c, Fence)
+}
+
+// AppendResetFenceRequest appends a ResetFence request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendResetFenceRequest(buf []byte, c *xgb.Conn, Fence Fence) []byte {
+	size := 8
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["SYNC"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 16 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Fence))
+	b += 4
+
+	return buf
+}
+
+// ResetFenceRequest represents the data of a ResetFence request, as it is read when tracing a connection.
+type ResetFenceRequest struct {
+	Fence Fence
+}
+
+// resetFenceRequestRead reads a byte slice in the byte order 'order' into a ResetFenceRequest value.
+func resetFenceRequestRead(buf []byte, order xgb.ByteOrder) *ResetFenceRequest {
+	v := new(ResetFenceRequest)
+	b := 4 // skip request header
+
+	v.Fence = Fence(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SYNC"][16] = xgb.RequestInfo{
+		Name: "ResetFence",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return resetFenceRequestRead(buf, order)
+		},
+	}
+}
+
+// SetCounterCookie is a cookie used only for SetCounter requests.
+type SetCounterCookie struct {
+	*xgb.Cookie
+}
+
+// SetCounter sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func SetCounter(c *xgb.Conn, Counter Counter, Value Int64) SetCounterCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'SetCounter' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequest(setCounterRequest(c, Counter, Value), cookie)
+	return SetCounterCookie{cookie}
+}
+
+// SetCounterChecked sends a checked request.
+// If an error occurs, it can be retrieved using SetCounterCookie.Check()
+func SetCounterChecked(c *xgb.Conn, Counter Counter, Value Int64) SetCounterCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'SetCounter' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, false)
+	c.NewRequest(setCounterRequest(c, Counter, Value), cookie)
+	return SetCounterCookie{cookie}
+}
+
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook SetCounterCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetCounterCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for SetCounter
+// setCounterRequest writes a SetCounter request to a byte slice.
+func setCounterRequest(c *xgb.Conn, Counter Counter, Value Int64) []byte {
+	return AppendSetCounterRequest(nil, c, Counter, Value)
+}
+
+// AppendSetCounterRequest appends a SetCounter request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetCounterRequest(buf []byte, c *xgb.Conn, Counter Counter, Value Int64) []byte {
+	size := 16
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["SYNC"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 3 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Counter))
+	b += 4
+
+	{
+		structBytes := Value.Bytes(order)
+		copy(buf[b:], structBytes)
+		b += len(structBytes)
+	}
+
+	return buf
+}
+
+// SetCounterRequest represents the data of a SetCounter request, as it is read when tracing a connection.
+type SetCounterRequest struct {
+	Counter Counter
+	Value   Int64
+}
+
+// setCounterRequestRead reads a byte slice in the byte order 'order' into a SetCounterRequest value.
+func setCounterRequestRead(buf []byte, order xgb.ByteOrder) *SetCounterRequest {
+	v := new(SetCounterRequest)
+	b := 4 // skip request header
+
+	v.Counter = Counter(order.Get32(buf[b:]))
+	b += 4
+
+	v.Value = Int64{}
+	b += Int64Read(buf[b:], order, &v.Value)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SYNC"][3] = xgb.RequestInfo{
+		Name: "SetCounter",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setCounterRequestRead(buf, order)
+		},
+	}
+}
+
+// SetPriorityCookie is a cookie used only for SetPriority requests.
+type SetPriorityCookie struct {
+	*xgb.Cookie
+}
+
+// SetPriority sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func SetPriority(c *xgb.Conn, Id uint32, Priority int32) SetPriorityCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'SetPriority' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequest(setPriorityRequest(c, Id, Priority), cookie)
+	return SetPriorityCookie{cookie}
+}
+
+// SetPriorityChecked sends a checked request.
+// If an error occurs, it can be retrieved using SetPriorityCookie.Check()
+func SetPriorityChecked(c *xgb.Conn, Id uint32, Priority int32) SetPriorityCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'SetPriority' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, false)
+	c.NewRequest(setPriorityRequest(c, Id, Priority), cookie)
+	return SetPriorityCookie{cookie}
+}
+
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook SetPriorityCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetPriorityCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for SetPriority
+// setPriorityRequest writes a SetPriority request to a byte slice.
+func setPriorityRequest(c *xgb.Conn, Id uint32, Priority int32) []byte {
+	return AppendSetPriorityRequest(nil, c, Id, Priority)
+}
+
+// AppendSetPriorityRequest appends a SetPriority request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetPriorityRequest(buf []byte, c *xgb.Conn, Id uint32, Priority int32) []byte {
+	size := 12
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["SYNC"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 12 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], Id)
+	b += 4
+
+	order.Put32(buf[b:], uint32(Priority))
+	b += 4
+
+	return buf
+}
+
+// SetPriorityRequest represents the data of a SetPriority request, as it is read when tracing a connection.
+type SetPriorityRequest struct {
+	Id       uint32
+	Priority int32
+}
+
+// setPriorityRequestRead reads a byte slice in the byte order 'order' into a SetPriorityRequest value.
+func setPriorityRequestRead(buf []byte, order xgb.ByteOrder) *SetPriorityRequest {
+	v := new(SetPriorityRequest)
+	b := 4 // skip request header
+
+	v.Id = order.Get32(buf[b:])
+	b += 4
+
+	v.Priority = int32(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SYNC"][12] = xgb.RequestInfo{
+		Name: "SetPriority",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setPriorityRequestRead(buf, order)
+		},
+	}
+}
+
+// TriggerFenceCookie is a cookie used only for TriggerFence requests.
+type TriggerFenceCookie struct {
+	*xgb.Cookie
+}
+
+// TriggerFence sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func TriggerFence(c *xgb.Conn, Fence Fence) TriggerFenceCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'TriggerFence' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequest(triggerFenceRequest(c, Fence), cookie)
+	return TriggerFenceCookie{cookie}
+}
+
+// TriggerFenceChecked sends a checked request.
+// If an error occurs, it can be retrieved using TriggerFenceCookie.Check()
+func TriggerFenceChecked(c *xgb.Conn, Fence Fence) TriggerFenceCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["SYNC"]; !ok {
+		panic("Cannot issue request 'TriggerFence' using the uninitialized extension 'SYNC'. sync.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(true, false)
+	c.NewRequest(triggerFenceRequest(c, Fence), cookie)
+	return TriggerFenceCookie{cookie}
+}
+
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook TriggerFenceCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook TriggerFenceCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for TriggerFence
+// triggerFenceRequest writes a TriggerFence request to a byte slice.
+func triggerFenceRequest(c *xgb.Conn, Fence Fence) []byte {
+	return AppendTriggerFenceRequest(nil, c, Fence)
+}
+
+// AppendTriggerFenceRequest appends a TriggerFence request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendTriggerFenceRequest(buf []byte, c *xgb.Conn, Fence Fence) []byte {
+	size := 8
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	c.ExtLock.RLock()
+	buf[b] = c.Extensions["SYNC"]
+	c.ExtLock.RUnlock()
+	b += 1
+
+	buf[b] = 15 // request opcode
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Fence))
+	b += 4
+
+	return buf
+}
+
+// TriggerFenceRequest represents the data of a TriggerFence request, as it is read when tracing a connection.
+type TriggerFenceRequest struct {
+	Fence Fence
+}
+
+// triggerFenceRequestRead reads a byte slice in the byte order 'order' into a TriggerFenceRequest value.
+func triggerFenceRequestRead(buf []byte, order xgb.ByteOrder) *TriggerFenceRequest {
+	v := new(TriggerFenceRequest)
+	b := 4 // skip request header
+
+	v.Fence = Fence(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SYNC"][15] = xgb.RequestInfo{
+		Name: "TriggerFence",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return triggerFenceRequestRead(buf, order)
+		},
+	}
+}
@@ -0,0 +1,403 @@
+package sync
+
+// Tests for the SYNC extension, against a fake X server that keeps its
+// counters and fences in maps.
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xgbtest"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// The opcode, first event and first error of SYNC on the fake server.
+const (
+	syncOpcode = 140
+	firstEvent = 90
+	firstError = 150
+)
+
+// fakeSync is a fake X server with a SYNC extension that supports counters
+// and fences. Its handlers are only called from the server's goroutine, so
+// the maps need no lock.
+type fakeSync struct {
+	*xgbtest.Server
+	counters map[Counter]Int64
+	fences   map[Fence]bool
+}
+
+func newFakeSync() *fakeSync {
+	s := &fakeSync{
+		Server:   xgbtest.NewServer(),
+		counters: make(map[Counter]Int64),
+		fences:   make(map[Fence]bool),
+	}
+	s.AddExtension("SYNC", syncOpcode, firstEvent, firstError)
+	s.HandleFunc(syncOpcode, 0, s.initialize)
+	s.HandleFunc(syncOpcode, 1, s.listSystemCounters)
+	s.HandleFunc(syncOpcode, 2, s.createCounter)
+	s.HandleFunc(syncOpcode, 4, s.changeCounter)
+	s.HandleFunc(syncOpcode, 5, s.queryCounter)
+	s.HandleFunc(syncOpcode, 14, s.createFence)
+	s.HandleFunc(syncOpcode, 15, s.setFence(true))
+	s.HandleFunc(syncOpcode, 16, s.setFence(false))
+	s.HandleFunc(syncOpcode, 18, s.queryFence)
+	return s
+}
+
+// conn connects to the server, and initializes SYNC.
+func (s *fakeSync) conn(t *testing.T) *xgb.Conn {
+	c, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Init(c); err != nil {
+		t.Fatalf("Init: %s", err)
+	}
+	return c
+}
+
+func (s *fakeSync) initialize(req *xgbtest.Request) []xgbtest.Response {
+	reply := make(xgbtest.Reply, 32)
+	reply[8], reply[9] = 3, 1
+	return []xgbtest.Response{reply}
+}
+
+func (s *fakeSync) listSystemCounters(
+	req *xgbtest.Request) []xgbtest.Response {
+
+	counters := []Systemcounter{
+		{Counter: 1, Resolution: Int64{Lo: 4}, Name: "SERVERTIME"},
+		{Counter: 2, Resolution: Int64{Lo: 4}, Name: "IDLETIME"},
+	}
+	reply := make(xgbtest.Reply, 32)
+	xgb.Put32(reply[8:], uint32(len(counters)))
+	for _, counter := range counters {
+		counter.NameLen = uint16(len(counter.Name))
+		reply = append(reply, counter.Bytes(req.ByteOrder)...)
+	}
+	return []xgbtest.Response{reply}
+}
+
+func (s *fakeSync) createCounter(req *xgbtest.Request) []xgbtest.Response {
+	var value Int64
+	Int64Read(req.Bytes[8:], req.ByteOrder, &value)
+	s.counters[Counter(xgb.Get32(req.Bytes[4:]))] = value
+	return nil
+}
+
+func (s *fakeSync) changeCounter(req *xgbtest.Request) []xgbtest.Response {
+	counter := Counter(xgb.Get32(req.Bytes[4:]))
+	value, ok := s.counters[counter]
+	if !ok {
+		return []xgbtest.Response{xgbtest.Error{
+			Code: firstError + BadCounter, BadValue: uint32(counter)}}
+	}
+	var amount Int64
+	Int64Read(req.Bytes[8:], req.ByteOrder, &amount)
+	s.counters[counter] = toInt64(fromInt64(value) + fromInt64(amount))
+	return nil
+}
+
+func (s *fakeSync) queryCounter(req *xgbtest.Request) []xgbtest.Response {
+	counter := Counter(xgb.Get32(req.Bytes[4:]))
+	value, ok := s.counters[counter]
+	if !ok {
+		return []xgbtest.Response{xgbtest.Error{
+			Code: firstError + BadCounter, BadValue: uint32(counter)}}
+	}
+	reply := make(xgbtest.Reply, 32)
+	copy(reply[8:], value.Bytes(req.ByteOrder))
+	return []xgbtest.Response{reply}
+}
+
+func (s *fakeSync) createFence(req *xgbtest.Request) []xgbtest.Response {
+	s.fences[Fence(xgb.Get32(req.Bytes[8:]))] = req.Bytes[12] != 0
+	return nil
+}
+
+func (s *fakeSync) setFence(triggered bool) xgbtest.Handler {
+	return func(req *xgbtest.Request) []xgbtest.Response {
+		s.fences[Fence(xgb.Get32(req.Bytes[4:]))] = triggered
+		return nil
+	}
+}
+
+func (s *fakeSync) queryFence(req *xgbtest.Request) []xgbtest.Response {
+	reply := make(xgbtest.Reply, 32)
+	if s.fences[Fence(xgb.Get32(req.Bytes[4:]))] {
+		reply[8] = 1
+	}
+	return []xgbtest.Response{reply}
+}
+
+func toInt64(v int64) Int64 {
+	return Int64{Hi: int32(v >> 32), Lo: uint32(v)}
+}
+
+func fromInt64(v Int64) int64 {
+	return int64(v.Hi)<<32 | int64(v.Lo)
+}
+
+func TestInitialize(t *testing.T) {
+	s := newFakeSync()
+	defer s.Close()
+	c := s.conn(t)
+	defer c.Close()
+
+	reply, err := Initialize(c, 3, 1).Reply()
+	if err != nil {
+		t.Fatalf("Initialize: %s", err)
+	}
+	if reply.MajorVersion != 3 || reply.MinorVersion != 1 {
+		t.Fatalf("Got version %d.%d, want 3.1.", reply.MajorVersion,
+			reply.MinorVersion)
+	}
+}
+
+func TestListSystemCounters(t *testing.T) {
+	s := newFakeSync()
+	defer s.Close()
+	c := s.conn(t)
+	defer c.Close()
+
+	reply, err := ListSystemCounters(c).Reply()
+	if err != nil {
+		t.Fatalf("ListSystemCounters: %s", err)
+	}
+	want := []string{"SERVERTIME", "IDLETIME"}
+	if len(reply.Counters) != len(want) {
+		t.Fatalf("Got %d counters, want %d.", len(reply.Counters), len(want))
+	}
+	for i, counter := range reply.Counters {
+		if counter.Name != want[i] || counter.Counter != Counter(i+1) {
+			t.Errorf("Counter %d is %q (%d), want %q (%d).", i,
+				counter.Name, counter.Counter, want[i], i+1)
+		}
+		if fromInt64(counter.Resolution) != 4 {
+			t.Errorf("Counter %q has resolution %d, want 4.", counter.Name,
+				fromInt64(counter.Resolution))
+		}
+	}
+}
+
+func TestCounter(t *testing.T) {
+	s := newFakeSync()
+	defer s.Close()
+	c := s.conn(t)
+	defer c.Close()
+
+	counter, err := NewCounterId(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Both halves of the value are used, and the amount is negative.
+	initial, amount := int64(1)<<40+5, int64(-7)
+	err = CreateCounterChecked(c, counter, toInt64(initial)).Check()
+	if err != nil {
+		t.Fatalf("CreateCounter: %s", err)
+	}
+	err = ChangeCounterChecked(c, counter, toInt64(amount)).Check()
+	if err != nil {
+		t.Fatalf("ChangeCounter: %s", err)
+	}
+	reply, err := QueryCounter(c, counter).Reply()
+	if err != nil {
+		t.Fatalf("QueryCounter: %s", err)
+	}
+	if got := fromInt64(reply.CounterValue); got != initial+amount {
+		t.Fatalf("Counter is %d, want %d.", got, initial+amount)
+	}
+
+	_, err = QueryCounter(c, counter+1).Reply()
+	if cerr, ok := err.(CounterError); !ok {
+		t.Fatalf("QueryCounter of a bad counter: got %v, want a "+
+			"CounterError.", err)
+	} else if cerr.BadCounter != uint32(counter+1) {
+		t.Fatalf("CounterError is about counter %d, want %d.",
+			cerr.BadCounter, counter+1)
+	}
+}
+
+func TestFence(t *testing.T) {
+	s := newFakeSync()
+	defer s.Close()
+	c := s.conn(t)
+	defer c.Close()
+
+	fence, err := NewFenceId(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := xproto.Setup(c).DefaultScreen(c).Root
+	CreateFence(c, xproto.Drawable(root), fence, false)
+	for _, triggered := range []bool{false, true, false} {
+		if triggered {
+			TriggerFence(c, fence)
+		} else {
+			ResetFence(c, fence)
+		}
+		reply, err := QueryFence(c, fence).Reply()
+		if err != nil {
+			t.Fatalf("QueryFence: %s", err)
+		}
+		if reply.Triggered != triggered {
+			t.Fatalf("Fence is triggered: %v, want %v.", reply.Triggered,
+				triggered)
+		}
+	}
+}
+
+// TestCreateAlarm checks that only the values selected by the value mask
+// are sent, in the order of the protocol.
+func TestCreateAlarm(t *testing.T) {
+	s := newFakeSync()
+	defer s.Close()
+	c := s.conn(t)
+	defer c.Close()
+
+	CreateAlarm(c, 5, CACounter|CAValue|CATestType|CAEvents,
+		CreateAlarmValueList{
+			Counter:   2,
+			ValueType: ValueTypeRelative, // not in the mask
+			Value:     toInt64(1 << 33),
+			TestType:  TestTypePositiveComparison,
+			Events:    1,
+		})
+	c.Sync()
+
+	reqs := s.Requests()
+	req := reqs[len(reqs)-2] // the last one is from Sync
+	if req.Opcode != syncOpcode || req.Minor != 8 {
+		t.Fatalf("Got request %d.%d, want CreateAlarm.", req.Opcode,
+			req.Minor)
+	}
+	want := []uint32{
+		5, uint32(CACounter | CAValue | CATestType | CAEvents), // id, mask
+		2,    // counter
+		2, 0, // value
+		uint32(TestTypePositiveComparison),
+		1, // events
+	}
+	if len(req.Bytes) != 4+4*len(want) {
+		t.Fatalf("CreateAlarm is %d bytes long, want %d.", len(req.Bytes),
+			4+4*len(want))
+	}
+	for i, v := range want {
+		if got := xgb.Get32(req.Bytes[4+4*i:]); got != v {
+			t.Errorf("Word %d of CreateAlarm is %d, want %d.", i+1, got, v)
+		}
+	}
+}
+
+// TestCreateAlarmValues checks that CreateAlarmValues computes the value
+// mask from the values that are set.
+func TestCreateAlarmValues(t *testing.T) {
+	s := newFakeSync()
+	defer s.Close()
+	c := s.conn(t)
+	defer c.Close()
+
+	values := new(CreateAlarmValues).
+		SetEvents(1).
+		SetCounter(2).
+		SetValue(toInt64(1 << 33))
+	if want := CACounter | CAValue | CAEvents; values.Mask() != want {
+		t.Fatalf("Value mask is %s, want %s.", values.Mask(), want)
+	}
+	CreateAlarm(c, 5, values.Mask(), values.List())
+	c.Sync()
+
+	reqs := s.Requests()
+	req := reqs[len(reqs)-2] // the last one is from Sync
+	want := []uint32{5, uint32(CACounter | CAValue | CAEvents), 2, 2, 0, 1}
+	if len(req.Bytes) != 4+4*len(want) {
+		t.Fatalf("CreateAlarm is %d bytes long, want %d.", len(req.Bytes),
+			4+4*len(want))
+	}
+	for i, v := range want {
+		if got := xgb.Get32(req.Bytes[4+4*i:]); got != v {
+			t.Errorf("Word %d of CreateAlarm is %d, want %d.", i+1, got, v)
+		}
+	}
+}
+
+func TestEvents(t *testing.T) {
+	s := newFakeSync()
+	defer s.Close()
+	c := s.conn(t)
+	defer c.Close()
+
+	counterEv := CounterNotifyEvent{
+		Counter:      3,
+		WaitValue:    toInt64(10),
+		CounterValue: toInt64(-1),
+		Timestamp:    1234,
+		Count:        2,
+		Destroyed:    true,
+	}
+	alarmEv := AlarmNotifyEvent{
+		Alarm:        5,
+		CounterValue: toInt64(1 << 33),
+		AlarmValue:   toInt64(1<<33 + 1),
+		Timestamp:    5678,
+		State:        AlarmStateInactive,
+	}
+	for _, ev := range []xgb.Event{counterEv, alarmEv} {
+		buf := ev.Bytes(c.ByteOrder())
+		buf[0] += firstEvent
+		if err := s.SendEvent(buf); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The sequence numbers are those of the last request, so they're left
+	// out of the comparison.
+	ev, err := c.WaitForEvent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := ev.(CounterNotifyEvent); !ok {
+		t.Errorf("Got %v, want a CounterNotify event.", ev)
+	} else if got.Sequence = 0; got != counterEv {
+		t.Errorf("Got %v, want %v.", got, counterEv)
+	}
+	ev, err = c.WaitForEvent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := ev.(AlarmNotifyEvent); !ok {
+		t.Errorf("Got %v, want an AlarmNotify event.", ev)
+	} else if got.Sequence = 0; got != alarmEv {
+		t.Errorf("Got %v, want %v.", got, alarmEv)
+	}
+}
+
+// TestEnumStrings checks that the values of typed enums print their names,
+// and that masks print the names of their bits.
+func TestEnumStrings(t *testing.T) {
+	tests := []struct {
+		v    fmt.Stringer
+		want string
+	}{
+		{AlarmStateInactive, "Inactive"},
+		{AlarmState(7), "AlarmState(7)"},
+		{TestTypeNegativeComparison, "NegativeComparison"},
+		{CA(0), "0"},
+		{CACounter | CAEvents, "Counter|Events"},
+		{CAValue | 1<<8, "Value|0x100"},
+	}
+	for _, test := range tests {
+		if got := test.v.String(); got != test.want {
+			t.Errorf("Got %q, want %q.", got, test.want)
+		}
+	}
+
+	ev := AlarmNotifyEvent{State: AlarmStateDestroyed}
+	if got := ev.String(); !strings.Contains(got, "State: Destroyed") {
+		t.Errorf("Got %q, want the name of the alarm state.", got)
+	}
+}
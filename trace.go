@@ -0,0 +1,268 @@
+package xgb
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// TraceFormat is the format of the log written by a connection's tracer.
+type TraceFormat int
+
+const (
+	// TraceText writes one human readable line per request, reply, event
+	// or error.
+	TraceText TraceFormat = iota
+
+	// TraceJSON writes one JSON object per line for each request, reply,
+	// event or error. (See Trace for the keys of each object.)
+	TraceJSON
+)
+
+// RequestInfo describes a kind of request, so that it and its reply can be
+// shown when tracing a connection.
+// It should not be used. It is exported for use in the extension
+// sub-packages.
+type RequestInfo struct {
+	// Name is the name of the request, i.e., "CreateWindow".
+	Name string
+
+	// NewRequest reads the data of the request back from the bytes that
+	// were sent. It is nil if the request can't be read.
+	NewRequest NewRequestFun
+
+	// NewReply constructs the reply to the request from raw bytes. It is nil
+	// if the request has no reply, or if its reply can't be constructed
+	// from bytes alone.
+	NewReply NewReplyFun
+}
+
+// NewRequestFun is the type of function use to read requests from raw
+// bytes, which are in the byte order 'order'. It should not be used. It is
+// exported for use in the extension sub-packages.
+type NewRequestFun func(buf []byte, order ByteOrder) interface{}
+
+// NewReplyFun is the type of function use to construct replies from raw
+// bytes, which are in the byte order 'order'. It should not be used. It is
+// exported for use in the extension sub-packages.
+type NewReplyFun func(buf []byte, order ByteOrder) interface{}
+
+// RequestInfos is a map from request opcodes to descriptions of the
+// corresponding core protocol requests. It is filled in when the xproto
+// package is initialized. It should not be used. It is exported for use in
+// the xproto sub-package.
+var RequestInfos = make(map[int]RequestInfo)
+
+// ExtRequestInfos is a map that stores descriptions of requests for each
+// extension, keyed by minor opcode. When an extension is initialized, they
+// are registered with the connection under the extension's major opcode.
+// It should not be used. It is exported for use in the extension
+// sub-packages.
+var ExtRequestInfos = make(map[string]map[int]RequestInfo)
+
+// extRequestInfos are the request descriptions of an extension that has been
+// initialized on a connection.
+type extRequestInfos struct {
+	name  string
+	infos map[int]RequestInfo
+}
+
+// RegisterRequestInfos registers the descriptions of the requests of
+// 'extension' in 'infos', keyed by minor opcode, with this connection for
+// the major opcode 'major'.
+// It should not be used. It is exported for use in the extension
+// sub-packages.
+func (c *Conn) RegisterRequestInfos(major byte, extension string,
+	infos map[int]RequestInfo) {
+
+	c.funcLock.Lock()
+	defer c.funcLock.Unlock()
+	c.requestInfos[major] = extRequestInfos{extension, infos}
+}
+
+// requestInfo returns the name and description of the request with opcodes
+// 'major' and 'minor'. The name of an extension request is prefixed by the
+// name of its extension. Unknown requests get a name made of their opcodes.
+func (c *Conn) requestInfo(major, minor byte) (string, RequestInfo) {
+	if major < 128 {
+		if info, ok := RequestInfos[int(major)]; ok {
+			return info.Name, info
+		}
+		return fmt.Sprintf("Unknown(%d)", major), RequestInfo{}
+	}
+
+	c.funcLock.RLock()
+	ext, ok := c.requestInfos[major]
+	c.funcLock.RUnlock()
+	if !ok {
+		return fmt.Sprintf("Unknown(%d, %d)", major, minor), RequestInfo{}
+	}
+	if info, ok := ext.infos[int(minor)]; ok {
+		return ext.name + "." + info.Name, info
+	}
+	return fmt.Sprintf("%s.Unknown(%d)", ext.name, minor), RequestInfo{}
+}
+
+// tracer writes a log of everything that goes over a connection.
+type tracer struct {
+	w      io.Writer
+	format TraceFormat
+}
+
+// traceRecord is a single entry in the log of a tracer. It is written as is
+// in the JSON format.
+type traceRecord struct {
+	Time     time.Time `json:"time"`
+	Kind     string    `json:"kind"`
+	Sequence *uint16   `json:"sequence,omitempty"`
+	Name     string    `json:"name"`
+	Length   int       `json:"length"`
+	Data     string    `json:"data,omitempty"`
+}
+
+// Trace starts writing a log of every request sent over this connection,
+// and of every reply, event and error received, to 'w' in the format given.
+// Requests and replies are named using the types of the core protocol and
+// of the extensions initialized on this connection.
+// A nil writer stops tracing.
+//
+// Each entry has a time, a kind ("request", "reply", "event" or "error"),
+// the sequence number of its request (except for events), a name, a length
+// in bytes and the decoded data, if any. In the JSON format, these are the
+// keys "time", "kind", "sequence", "name", "length" and "data".
+//
+// If writing to 'w' fails, tracing stops and the error is logged.
+func (c *Conn) Trace(w io.Writer, format TraceFormat) {
+	c.traceLock.Lock()
+	defer c.traceLock.Unlock()
+
+	if w == nil {
+		c.tracer = nil
+		return
+	}
+	c.tracer = &tracer{w: w, format: format}
+}
+
+// tracing returns whether this connection is being traced.
+func (c *Conn) tracing() bool {
+	c.traceLock.Lock()
+	defer c.traceLock.Unlock()
+	return c.tracer != nil
+}
+
+// trace writes 'rec' to the log of this connection's tracer.
+func (c *Conn) trace(rec traceRecord) {
+	c.traceLock.Lock()
+	defer c.traceLock.Unlock()
+
+	t := c.tracer
+	if t == nil {
+		return
+	}
+
+	var err error
+	switch t.format {
+	case TraceJSON:
+		var line []byte
+		if line, err = json.Marshal(rec); err == nil {
+			_, err = t.w.Write(append(line, '\n'))
+		}
+	default:
+		arrow, seq, data := "<-", "", ""
+		if rec.Kind == "request" {
+			arrow = "->"
+		}
+		if rec.Sequence != nil {
+			seq = fmt.Sprintf(" %d", *rec.Sequence)
+		}
+		if rec.Data != "" {
+			data = " " + rec.Data
+		}
+		_, err = fmt.Fprintf(t.w, "%s %s %s%s %s (%d bytes)%s\n",
+			rec.Time.Format("15:04:05.000000"), arrow, rec.Kind, seq,
+			rec.Name, rec.Length, data)
+	}
+	if err != nil {
+		c.logger().Printf("Could not write to the tracer, so tracing stops: %s",
+			err)
+		c.tracer = nil
+	}
+}
+
+// traceRequest traces the request in 'buf', which was sent with the
+// sequence number 'seq'.
+func (c *Conn) traceRequest(seq uint16, buf []byte) {
+	if !c.tracing() {
+		return
+	}
+	name, info := c.requestInfo(buf[0], buf[1])
+	sequence := seq // so that 'seq' doesn't escape when not tracing
+	rec := traceRecord{
+		Time:     time.Now(),
+		Kind:     "request",
+		Sequence: &sequence,
+		Name:     name,
+		Length:   len(buf),
+	}
+	if info.NewRequest != nil {
+		// The request is read as if it wasn't sent with BIG-REQUESTS.
+		if len(buf) >= 8 && c.order.Get16(buf[2:]) == 0 {
+			buf = append(buf[:4:4], buf[8:]...)
+		}
+		rec.Data = decodeTrace(info.NewRequest, buf, c.order)
+	}
+	c.trace(rec)
+}
+
+// decodeTrace returns the data that 'decode' reads from 'buf', in the byte
+// order 'order', as text. The bytes of a request may have been made by hand,
+// and those of a reply come from the X server, so if they are too short to
+// be read, no data is shown.
+func decodeTrace(decode func(buf []byte, order ByteOrder) interface{},
+	buf []byte, order ByteOrder) (data string) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			data = ""
+		}
+	}()
+	return fmt.Sprintf("%+v", decode(buf, order))
+}
+
+// traceResponse traces the reply in 'buf' or the error 'err' that answers
+// the request of 'cookie'.
+func (c *Conn) traceResponse(cookie *Cookie, err Error, buf []byte) {
+	if !c.tracing() {
+		return
+	}
+	name, info := c.requestInfo(cookie.major, cookie.minor)
+	rec := traceRecord{
+		Time:     time.Now(),
+		Sequence: &cookie.Sequence,
+		Name:     name,
+	}
+	if err != nil {
+		rec.Kind, rec.Length, rec.Data = "error", 32, err.Error()
+	} else {
+		rec.Kind, rec.Length = "reply", len(buf)
+		if info.NewReply != nil {
+			rec.Data = decodeTrace(info.NewReply, buf, c.order)
+		}
+	}
+	c.trace(rec)
+}
+
+// traceEvent traces the event 'ev', which was read from 'buf'.
+func (c *Conn) traceEvent(ev Event, buf []byte) {
+	if ev == nil || !c.tracing() {
+		return
+	}
+	c.trace(traceRecord{
+		Time:   time.Now(),
+		Kind:   "event",
+		Name:   fmt.Sprintf("%T", ev),
+		Length: len(buf),
+		Data:   ev.String(),
+	})
+}
@@ -0,0 +1,158 @@
+package xgb_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xcmisc"
+	"github.com/BurntSushi/xgb/xgbtest"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// traceBuffer is a bytes.Buffer that can be written to by a tracer while
+// a test reads it.
+type traceBuffer struct {
+	sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *traceBuffer) Write(p []byte) (int, error) {
+	b.Lock()
+	defer b.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *traceBuffer) lines() []string {
+	b.Lock()
+	defer b.Unlock()
+	return strings.Split(strings.TrimSpace(b.buf.String()), "\n")
+}
+
+// traceEntry is an entry of a trace in the JSON format.
+type traceEntry struct {
+	Kind     string
+	Sequence *uint16
+	Name     string
+	Length   int
+	Data     string
+}
+
+// traceSession connects to a fake X server with an XC-MISC extension,
+// traces the connection in the given format, and then sends a request with
+// a reply, an extension request with a reply, a request that fails and an
+// event. It returns the lines of the trace.
+func traceSession(t *testing.T, format xgb.TraceFormat) []string {
+	s := xgbtest.NewServer()
+	defer s.Close()
+	s.AddExtension("XC-MISC", 130, 0, 0)
+	s.HandleFunc(130, 1, func(*xgbtest.Request) []xgbtest.Response {
+		reply := make(xgbtest.Reply, 16)
+		xgb.Put32(reply[8:], 0x1000)
+		xgb.Put32(reply[12:], 0x10)
+		return []xgbtest.Response{reply}
+	})
+	s.Handle(8, 0, xgbtest.Error{Code: xproto.BadWindow}) // MapWindow
+	c, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := xcmisc.Init(c); err != nil {
+		t.Fatalf("xcmisc.Init: %s", err)
+	}
+
+	var buf traceBuffer
+	c.Trace(&buf, format)
+	if _, err := xproto.GetInputFocus(c).Reply(); err != nil {
+		t.Fatalf("GetInputFocus: %s", err)
+	}
+	if _, err := xcmisc.GetXIDRange(c).Reply(); err != nil {
+		t.Fatalf("GetXIDRange: %s", err)
+	}
+	if err := xproto.MapWindowChecked(c, 1).Check(); err == nil {
+		t.Fatalf("MapWindow should have failed.")
+	}
+	ev := make(xgbtest.Event, 32)
+	ev[0] = xproto.MapNotify
+	if err := s.SendEvent(ev); err != nil {
+		t.Fatalf("SendEvent: %s", err)
+	}
+	if _, err := c.WaitForEvent(); err != nil {
+		t.Fatalf("WaitForEvent: %s", err)
+	}
+	c.Trace(nil, format)
+	return buf.lines()
+}
+
+// TestTraceJSON checks that a trace in the JSON format has an entry for each
+// request and response, named and decoded with the generated types.
+func TestTraceJSON(t *testing.T) {
+	lines := traceSession(t, xgb.TraceJSON)
+
+	expected := []traceEntry{
+		{Kind: "request", Name: "GetInputFocus"},
+		{Kind: "reply", Name: "GetInputFocus", Data: "Focus:"},
+		{Kind: "request", Name: "XC-MISC.GetXIDRange"},
+		{Kind: "reply", Name: "XC-MISC.GetXIDRange", Data: "Count:16"},
+		{Kind: "request", Name: "MapWindow", Data: "Window:1"},
+		{Kind: "error", Name: "MapWindow", Data: "BadWindow"},
+		{Kind: "event", Name: "xproto.MapNotifyEvent"},
+	}
+	var entries []traceEntry
+	for _, line := range lines {
+		var entry traceEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("Could not decode trace line %q: %s", line, err)
+		}
+		// Check and Sync make round trips of their own.
+		if strings.HasPrefix(entry.Name, "GetInputFocus") &&
+			len(entries) > 1 {
+
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if len(entries) != len(expected) {
+		t.Fatalf("Expected %d trace entries, but got:\n%s",
+			len(expected), strings.Join(lines, "\n"))
+	}
+	for i, entry := range entries {
+		exp := expected[i]
+		if entry.Kind != exp.Kind || entry.Name != exp.Name ||
+			!strings.Contains(entry.Data, exp.Data) {
+
+			t.Fatalf("Trace entry %d is %+v, but expected %+v.",
+				i, entry, exp)
+		}
+		if (entry.Sequence == nil) != (entry.Kind == "event") {
+			t.Fatalf("Trace entry %d has the wrong sequence number.", i)
+		}
+	}
+}
+
+// TestTraceText checks the lines of a trace in the text format. The request
+// with sequence number 1 is the QueryExtension sent by xcmisc.Init.
+func TestTraceText(t *testing.T) {
+	lines := traceSession(t, xgb.TraceText)
+
+	for _, want := range []string{
+		"-> request 2 GetInputFocus (4 bytes) &{}",
+		"<- reply 2 GetInputFocus (32 bytes) &{",
+		"-> request 3 XC-MISC.GetXIDRange (4 bytes)",
+		"-> request 4 MapWindow (8 bytes) &{Window:1}",
+		"<- error 4 MapWindow (32 bytes) BadWindow {",
+		"<- event xproto.MapNotifyEvent (32 bytes) MapNotify {",
+	} {
+		found := false
+		for _, line := range lines {
+			found = found || strings.Contains(line, want)
+		}
+		if !found {
+			t.Fatalf("Expected a line with %q in the trace:\n%s",
+				want, strings.Join(lines, "\n"))
+		}
+	}
+}
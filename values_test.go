@@ -0,0 +1,107 @@
+package xgb_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xgbtest"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// TestValues checks that the values of a value list are sent in the order
+// defined by the protocol, whatever the order they were set in.
+func TestValues(t *testing.T) {
+	s := xgbtest.NewServer()
+	defer s.Close()
+	c, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	values := new(xproto.CwValues).
+		SetCursor(5).
+		SetEventMask(xproto.EventMaskExposure).
+		SetBackPixel(0xffffff).
+		SetEventMask(xproto.EventMaskKeyPress) // replaces the first one
+	mask := xproto.CwBackPixel | xproto.CwEventMask | xproto.CwCursor
+	if values.Mask() != mask {
+		t.Fatalf("Value mask is %d, want %d.", values.Mask(), mask)
+	}
+	xproto.ChangeWindowAttributes(c, 1, values.Mask(), values.List())
+
+	// ConfigureWindow has a 16 bit mask.
+	config := new(xproto.ConfigWindowValues).
+		SetStackMode(xproto.StackModeAbove).
+		SetX(10)
+	xproto.ConfigureWindow(c, 1, config.Mask(), config.List())
+	c.Sync()
+
+	reqs := s.Requests()
+	if len(reqs) != 3 {
+		t.Fatalf("Server received %d requests, want 3.", len(reqs))
+	}
+	want := []uint32{1, uint32(mask), 0xffffff,
+		uint32(xproto.EventMaskKeyPress), 5}
+	checkWords(t, "ChangeWindowAttributes", reqs[0].Bytes[4:], want)
+
+	req := reqs[1].Bytes
+	got := xproto.ConfigWindow(xgb.Get16(req[8:]))
+	if got != xproto.ConfigWindowX|xproto.ConfigWindowStackMode {
+
+		t.Errorf("ConfigureWindow has value mask %s, want %s.", got,
+			xproto.ConfigWindowX|xproto.ConfigWindowStackMode)
+	}
+	checkWords(t, "ConfigureWindow", req[12:],
+		[]uint32{10, uint32(xproto.StackModeAbove)})
+}
+
+// TestEnumStrings checks that the values of typed core enums print their
+// names, and that masks print the names of their bits along with any bits
+// that have none.
+func TestEnumStrings(t *testing.T) {
+	tests := []struct {
+		v    fmt.Stringer
+		want string
+	}{
+		{xproto.StackModeAbove, "Above"},
+		{xproto.StackMode(9), "StackMode(9)"},
+		{xproto.EventMask(0), "NoEvent"},
+		{xproto.EventMaskKeyPress | xproto.EventMaskExposure,
+			"KeyPress|Exposure"},
+		{xproto.EventMaskKeyPress | 1<<30, "KeyPress|0x40000000"},
+		{xproto.CwBackPixel | xproto.CwCursor, "BackPixel|Cursor"},
+	}
+	for _, test := range tests {
+		if got := test.v.String(); got != test.want {
+			t.Errorf("Got %q, want %q.", got, test.want)
+		}
+	}
+
+	ev := xproto.ConfigureRequestEvent{
+		StackMode: xproto.StackModeBelow,
+		ValueMask: xproto.ConfigWindowX | xproto.ConfigWindowStackMode,
+	}
+	if got := ev.String(); !strings.Contains(got, "StackMode: Below") ||
+		!strings.Contains(got, "ValueMask: X|StackMode") {
+
+		t.Errorf("Got %q, want the names of the stack mode and the mask bits.",
+			got)
+	}
+}
+
+// checkWords checks that 'buf' holds exactly the 4 byte words in 'want'.
+func checkWords(t *testing.T, name string, buf []byte, want []uint32) {
+	if len(buf) != 4*len(want) {
+		t.Errorf("%s has %d bytes of values, want %d.", name, len(buf),
+			4*len(want))
+		return
+	}
+	for i, v := range want {
+		if got := xgb.Get32(buf[4*i:]); got != v {
+			t.Errorf("Word %d of %s is %d, want %d.", i, name, got, v)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package xgb
+
+/*
+xcmisc.go uses the XC-MISC extension to recycle resource ids, once a client
+has used up the range of ids it was given at connection setup.
+
+The request used to do so is duplicated from xcmisc/xcmisc.go, since this
+package can't import it.
+*/
+
+// xcMisc asks the XC-MISC extension for resource ids. It is only used by
+// NewId, with Conn.xidLock held.
+type xcMisc struct {
+	conn    *Conn
+	opcode  byte
+	queried bool  // whether the extension has been looked for yet
+	err     error // why the extension can't be used, if it can't
+}
+
+func newXCMisc(conn *Conn) *xcMisc {
+	return &xcMisc{conn: conn}
+}
+
+// getXIdRange returns a range of 'count' resource ids starting at 'start'
+// that are not in use. If there are no such ids, or if the X server doesn't
+// support XC-MISC, an error is returned.
+func (x *xcMisc) getXIdRange() (start, count uint32, err error) {
+	if !x.queried {
+		x.queried = true
+		x.opcode, x.err = x.conn.queryExtension("XC-MISC")
+		if x.err != nil {
+			x.conn.logger().Printf("Resource ids can't be recycled: %s", x.err)
+		}
+	}
+	if x.err != nil {
+		return 0, 0, Errorf("There are no more available resource "+
+			"identifiers, and they can't be recycled: %s", x.err)
+	}
+
+	cookie := x.conn.NewCookie(true, true)
+	x.conn.NewRequest(x.conn.getXIdRangeRequest(x.opcode), cookie)
+	reply, err := cookie.Reply()
+	if err != nil {
+		return 0, 0, err
+	}
+	order := x.conn.order
+	start, count = order.Get32(reply[8:]), order.Get32(reply[12:])
+	if start == 0 || count == 0 {
+		return 0, 0, Errorf("There are no more available resource " +
+			"identifiers.")
+	}
+	return start, count, nil
+}
+
+// getXIdRangeRequest writes the raw bytes of an XC-MISC GetXIDRange request
+// to a buffer, given the major opcode of the extension.
+// It is duplicated from xcmisc/xcmisc.go.
+func (c *Conn) getXIdRangeRequest(opcode byte) []byte {
+	size := 4
+	b := 0
+	buf := make([]byte, size)
+
+	buf[b] = opcode
+	b += 1
+
+	buf[b] = 1 // request opcode
+	b += 1
+
+	c.order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	return buf
+}
@@ -4,6 +4,8 @@ package xcmisc
 // This file is automatically generated from xc_misc.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xproto"
@@ -22,18 +24,16 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["XC-MISC"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["XC-MISC"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["XC-MISC"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["XC-MISC"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["XC-MISC"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "XC-MISC", xgb.ExtRequestInfos["XC-MISC"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["XC-MISC"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["XC-MISC"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["XC-MISC"] = make(map[int]xgb.RequestInfo)
 }
 
 // Skipping definition for base type 'Bool'
@@ -60,6 +60,8 @@ func init() {
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // GetVersionCookie is a cookie used only for GetVersion requests.
 type GetVersionCookie struct {
 	*xgb.Cookie
@@ -109,26 +111,38 @@ func (cook GetVersionCookie) Reply() (*GetVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getVersionReply(buf), nil
+	return getVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetVersion request arrives.
+func (cook GetVersionCookie) ReplyContext(ctx context.Context) (*GetVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getVersionReply reads a byte slice into a GetVersionReply value.
-func getVersionReply(buf []byte) *GetVersionReply {
+// getVersionReply reads a byte slice in the byte order 'order' into a GetVersionReply value.
+func getVersionReply(buf []byte, order xgb.ByteOrder) *GetVersionReply {
 	v := new(GetVersionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ServerMajorVersion = xgb.Get16(buf[b:])
+	v.ServerMajorVersion = order.Get16(buf[b:])
 	b += 2
 
-	v.ServerMinorVersion = xgb.Get16(buf[b:])
+	v.ServerMinorVersion = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -137,9 +151,18 @@ func getVersionReply(buf []byte) *GetVersionReply {
 // Write request to wire for GetVersion
 // getVersionRequest writes a GetVersion request to a byte slice.
 func getVersionRequest(c *xgb.Conn, ClientMajorVersion uint16, ClientMinorVersion uint16) []byte {
+	return AppendGetVersionRequest(nil, c, ClientMajorVersion, ClientMinorVersion)
+}
+
+// AppendGetVersionRequest appends a GetVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetVersionRequest(buf []byte, c *xgb.Conn, ClientMajorVersion uint16, ClientMinorVersion uint16) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XC-MISC"]
@@ -149,18 +172,50 @@ func getVersionRequest(c *xgb.Conn, ClientMajorVersion uint16, ClientMinorVersio
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], ClientMajorVersion)
+	order.Put16(buf[b:], ClientMajorVersion)
 	b += 2
 
-	xgb.Put16(buf[b:], ClientMinorVersion)
+	order.Put16(buf[b:], ClientMinorVersion)
 	b += 2
 
 	return buf
 }
 
+// GetVersionRequest represents the data of a GetVersion request, as it is read when tracing a connection.
+type GetVersionRequest struct {
+	ClientMajorVersion uint16
+	ClientMinorVersion uint16
+}
+
+// getVersionRequestRead reads a byte slice in the byte order 'order' into a GetVersionRequest value.
+func getVersionRequestRead(buf []byte, order xgb.ByteOrder) *GetVersionRequest {
+	v := new(GetVersionRequest)
+	b := 4 // skip request header
+
+	v.ClientMajorVersion = order.Get16(buf[b:])
+	b += 2
+
+	v.ClientMinorVersion = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XC-MISC"][0] = xgb.RequestInfo{
+		Name: "GetVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getVersionReply(buf, order)
+		},
+	}
+}
+
 // GetXIDListCookie is a cookie used only for GetXIDList requests.
 type GetXIDListCookie struct {
 	*xgb.Cookie
@@ -211,30 +266,42 @@ func (cook GetXIDListCookie) Reply() (*GetXIDListReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getXIDListReply(buf), nil
+	return getXIDListReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getXIDListReply reads a byte slice into a GetXIDListReply value.
-func getXIDListReply(buf []byte) *GetXIDListReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetXIDList request arrives.
+func (cook GetXIDListCookie) ReplyContext(ctx context.Context) (*GetXIDListReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getXIDListReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// getXIDListReply reads a byte slice in the byte order 'order' into a GetXIDListReply value.
+func getXIDListReply(buf []byte, order xgb.ByteOrder) *GetXIDListReply {
 	v := new(GetXIDListReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.IdsLen = xgb.Get32(buf[b:])
+	v.IdsLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
 
 	v.Ids = make([]uint32, v.IdsLen)
 	for i := 0; i < int(v.IdsLen); i++ {
-		v.Ids[i] = xgb.Get32(buf[b:])
+		v.Ids[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -244,9 +311,18 @@ func getXIDListReply(buf []byte) *GetXIDListReply {
 // Write request to wire for GetXIDList
 // getXIDListRequest writes a GetXIDList request to a byte slice.
 func getXIDListRequest(c *xgb.Conn, Count uint32) []byte {
+	return AppendGetXIDListRequest(nil, c, Count)
+}
+
+// AppendGetXIDListRequest appends a GetXIDList request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetXIDListRequest(buf []byte, c *xgb.Conn, Count uint32) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XC-MISC"]
@@ -256,15 +332,43 @@ func getXIDListRequest(c *xgb.Conn, Count uint32) []byte {
 	buf[b] = 2 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Count)
+	order.Put32(buf[b:], Count)
 	b += 4
 
 	return buf
 }
 
+// GetXIDListRequest represents the data of a GetXIDList request, as it is read when tracing a connection.
+type GetXIDListRequest struct {
+	Count uint32
+}
+
+// getXIDListRequestRead reads a byte slice in the byte order 'order' into a GetXIDListRequest value.
+func getXIDListRequestRead(buf []byte, order xgb.ByteOrder) *GetXIDListRequest {
+	v := new(GetXIDListRequest)
+	b := 4 // skip request header
+
+	v.Count = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XC-MISC"][2] = xgb.RequestInfo{
+		Name: "GetXIDList",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getXIDListRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getXIDListReply(buf, order)
+		},
+	}
+}
+
 // GetXIDRangeCookie is a cookie used only for GetXIDRange requests.
 type GetXIDRangeCookie struct {
 	*xgb.Cookie
@@ -314,26 +418,38 @@ func (cook GetXIDRangeCookie) Reply() (*GetXIDRangeReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getXIDRangeReply(buf), nil
+	return getXIDRangeReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetXIDRange request arrives.
+func (cook GetXIDRangeCookie) ReplyContext(ctx context.Context) (*GetXIDRangeReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getXIDRangeReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getXIDRangeReply reads a byte slice into a GetXIDRangeReply value.
-func getXIDRangeReply(buf []byte) *GetXIDRangeReply {
+// getXIDRangeReply reads a byte slice in the byte order 'order' into a GetXIDRangeReply value.
+func getXIDRangeReply(buf []byte, order xgb.ByteOrder) *GetXIDRangeReply {
 	v := new(GetXIDRangeReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.StartId = xgb.Get32(buf[b:])
+	v.StartId = order.Get32(buf[b:])
 	b += 4
 
-	v.Count = xgb.Get32(buf[b:])
+	v.Count = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -342,9 +458,18 @@ func getXIDRangeReply(buf []byte) *GetXIDRangeReply {
 // Write request to wire for GetXIDRange
 // getXIDRangeRequest writes a GetXIDRange request to a byte slice.
 func getXIDRangeRequest(c *xgb.Conn) []byte {
+	return AppendGetXIDRangeRequest(nil, c)
+}
+
+// AppendGetXIDRangeRequest appends a GetXIDRange request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetXIDRangeRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XC-MISC"]
@@ -354,8 +479,30 @@ func getXIDRangeRequest(c *xgb.Conn) []byte {
 	buf[b] = 1 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
+
+// GetXIDRangeRequest represents the data of a GetXIDRange request, as it is read when tracing a connection.
+type GetXIDRangeRequest struct {
+}
+
+// getXIDRangeRequestRead reads a byte slice in the byte order 'order' into a GetXIDRangeRequest value.
+func getXIDRangeRequestRead(buf []byte, order xgb.ByteOrder) *GetXIDRangeRequest {
+	v := new(GetXIDRangeRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XC-MISC"][1] = xgb.RequestInfo{
+		Name: "GetXIDRange",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getXIDRangeRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getXIDRangeReply(buf, order)
+		},
+	}
+}
@@ -4,6 +4,8 @@ package xevie
 // This file is automatically generated from xevie.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xproto"
@@ -22,18 +24,16 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["XEVIE"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["XEVIE"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["XEVIE"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["XEVIE"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["XEVIE"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "XEVIE", xgb.ExtRequestInfos["XEVIE"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["XEVIE"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["XEVIE"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["XEVIE"] = make(map[int]xgb.RequestInfo)
 }
 
 const (
@@ -46,7 +46,7 @@ type Event struct {
 }
 
 // EventRead reads a byte slice into a Event value.
-func EventRead(buf []byte, v *Event) int {
+func EventRead(buf []byte, order xgb.ByteOrder, v *Event) int {
 	b := 0
 
 	b += 32 // padding
@@ -55,17 +55,17 @@ func EventRead(buf []byte, v *Event) int {
 }
 
 // EventReadList reads a byte slice into a list of Event values.
-func EventReadList(buf []byte, dest []Event) int {
+func EventReadList(buf []byte, order xgb.ByteOrder, dest []Event) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Event{}
-		b += EventRead(buf[b:], &dest[i])
+		b += EventRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Event value to a byte slice.
-func (v Event) Bytes() []byte {
+// Bytes writes a Event value to a byte slice, in the byte order 'order'.
+func (v Event) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -75,11 +75,11 @@ func (v Event) Bytes() []byte {
 }
 
 // EventListBytes writes a list of Event values to a byte slice.
-func EventListBytes(buf []byte, list []Event) int {
+func EventListBytes(buf []byte, order xgb.ByteOrder, list []Event) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -110,6 +110,8 @@ func EventListBytes(buf []byte, list []Event) int {
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // EndCookie is a cookie used only for End requests.
 type EndCookie struct {
 	*xgb.Cookie
@@ -158,20 +160,32 @@ func (cook EndCookie) Reply() (*EndReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return endReply(buf), nil
+	return endReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a End request arrives.
+func (cook EndCookie) ReplyContext(ctx context.Context) (*EndReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return endReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// endReply reads a byte slice into a EndReply value.
-func endReply(buf []byte) *EndReply {
+// endReply reads a byte slice in the byte order 'order' into a EndReply value.
+func endReply(buf []byte, order xgb.ByteOrder) *EndReply {
 	v := new(EndReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 24 // padding
@@ -182,9 +196,18 @@ func endReply(buf []byte) *EndReply {
 // Write request to wire for End
 // endRequest writes a End request to a byte slice.
 func endRequest(c *xgb.Conn, Cmap uint32) []byte {
+	return AppendEndRequest(nil, c, Cmap)
+}
+
+// AppendEndRequest appends a End request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendEndRequest(buf []byte, c *xgb.Conn, Cmap uint32) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XEVIE"]
@@ -194,15 +217,43 @@ func endRequest(c *xgb.Conn, Cmap uint32) []byte {
 	buf[b] = 2 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Cmap)
+	order.Put32(buf[b:], Cmap)
 	b += 4
 
 	return buf
 }
 
+// EndRequest represents the data of a End request, as it is read when tracing a connection.
+type EndRequest struct {
+	Cmap uint32
+}
+
+// endRequestRead reads a byte slice in the byte order 'order' into a EndRequest value.
+func endRequestRead(buf []byte, order xgb.ByteOrder) *EndRequest {
+	v := new(EndRequest)
+	b := 4 // skip request header
+
+	v.Cmap = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XEVIE"][2] = xgb.RequestInfo{
+		Name: "End",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return endRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return endReply(buf, order)
+		},
+	}
+}
+
 // QueryVersionCookie is a cookie used only for QueryVersion requests.
 type QueryVersionCookie struct {
 	*xgb.Cookie
@@ -253,26 +304,38 @@ func (cook QueryVersionCookie) Reply() (*QueryVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryVersionReply(buf), nil
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryVersion request arrives.
+func (cook QueryVersionCookie) ReplyContext(ctx context.Context) (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryVersionReply reads a byte slice into a QueryVersionReply value.
-func queryVersionReply(buf []byte) *QueryVersionReply {
+// queryVersionReply reads a byte slice in the byte order 'order' into a QueryVersionReply value.
+func queryVersionReply(buf []byte, order xgb.ByteOrder) *QueryVersionReply {
 	v := new(QueryVersionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ServerMajorVersion = xgb.Get16(buf[b:])
+	v.ServerMajorVersion = order.Get16(buf[b:])
 	b += 2
 
-	v.ServerMinorVersion = xgb.Get16(buf[b:])
+	v.ServerMinorVersion = order.Get16(buf[b:])
 	b += 2
 
 	b += 20 // padding
@@ -283,9 +346,18 @@ func queryVersionReply(buf []byte) *QueryVersionReply {
 // Write request to wire for QueryVersion
 // queryVersionRequest writes a QueryVersion request to a byte slice.
 func queryVersionRequest(c *xgb.Conn, ClientMajorVersion uint16, ClientMinorVersion uint16) []byte {
+	return AppendQueryVersionRequest(nil, c, ClientMajorVersion, ClientMinorVersion)
+}
+
+// AppendQueryVersionRequest appends a QueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryVersionRequest(buf []byte, c *xgb.Conn, ClientMajorVersion uint16, ClientMinorVersion uint16) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XEVIE"]
@@ -295,18 +367,50 @@ func queryVersionRequest(c *xgb.Conn, ClientMajorVersion uint16, ClientMinorVers
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], ClientMajorVersion)
+	order.Put16(buf[b:], ClientMajorVersion)
 	b += 2
 
-	xgb.Put16(buf[b:], ClientMinorVersion)
+	order.Put16(buf[b:], ClientMinorVersion)
 	b += 2
 
 	return buf
 }
 
+// QueryVersionRequest represents the data of a QueryVersion request, as it is read when tracing a connection.
+type QueryVersionRequest struct {
+	ClientMajorVersion uint16
+	ClientMinorVersion uint16
+}
+
+// queryVersionRequestRead reads a byte slice in the byte order 'order' into a QueryVersionRequest value.
+func queryVersionRequestRead(buf []byte, order xgb.ByteOrder) *QueryVersionRequest {
+	v := new(QueryVersionRequest)
+	b := 4 // skip request header
+
+	v.ClientMajorVersion = order.Get16(buf[b:])
+	b += 2
+
+	v.ClientMinorVersion = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XEVIE"][0] = xgb.RequestInfo{
+		Name: "QueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionReply(buf, order)
+		},
+	}
+}
+
 // SelectInputCookie is a cookie used only for SelectInput requests.
 type SelectInputCookie struct {
 	*xgb.Cookie
@@ -355,20 +459,32 @@ func (cook SelectInputCookie) Reply() (*SelectInputReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return selectInputReply(buf), nil
+	return selectInputReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// selectInputReply reads a byte slice into a SelectInputReply value.
-func selectInputReply(buf []byte) *SelectInputReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a SelectInput request arrives.
+func (cook SelectInputCookie) ReplyContext(ctx context.Context) (*SelectInputReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return selectInputReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// selectInputReply reads a byte slice in the byte order 'order' into a SelectInputReply value.
+func selectInputReply(buf []byte, order xgb.ByteOrder) *SelectInputReply {
 	v := new(SelectInputReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 24 // padding
@@ -379,9 +495,18 @@ func selectInputReply(buf []byte) *SelectInputReply {
 // Write request to wire for SelectInput
 // selectInputRequest writes a SelectInput request to a byte slice.
 func selectInputRequest(c *xgb.Conn, EventMask uint32) []byte {
+	return AppendSelectInputRequest(nil, c, EventMask)
+}
+
+// AppendSelectInputRequest appends a SelectInput request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSelectInputRequest(buf []byte, c *xgb.Conn, EventMask uint32) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XEVIE"]
@@ -391,15 +516,43 @@ func selectInputRequest(c *xgb.Conn, EventMask uint32) []byte {
 	buf[b] = 4 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], EventMask)
+	order.Put32(buf[b:], EventMask)
 	b += 4
 
 	return buf
 }
 
+// SelectInputRequest represents the data of a SelectInput request, as it is read when tracing a connection.
+type SelectInputRequest struct {
+	EventMask uint32
+}
+
+// selectInputRequestRead reads a byte slice in the byte order 'order' into a SelectInputRequest value.
+func selectInputRequestRead(buf []byte, order xgb.ByteOrder) *SelectInputRequest {
+	v := new(SelectInputRequest)
+	b := 4 // skip request header
+
+	v.EventMask = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XEVIE"][4] = xgb.RequestInfo{
+		Name: "SelectInput",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return selectInputRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return selectInputReply(buf, order)
+		},
+	}
+}
+
 // SendCookie is a cookie used only for Send requests.
 type SendCookie struct {
 	*xgb.Cookie
@@ -448,20 +601,32 @@ func (cook SendCookie) Reply() (*SendReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return sendReply(buf), nil
+	return sendReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// sendReply reads a byte slice into a SendReply value.
-func sendReply(buf []byte) *SendReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a Send request arrives.
+func (cook SendCookie) ReplyContext(ctx context.Context) (*SendReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return sendReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// sendReply reads a byte slice in the byte order 'order' into a SendReply value.
+func sendReply(buf []byte, order xgb.ByteOrder) *SendReply {
 	v := new(SendReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 24 // padding
@@ -472,9 +637,18 @@ func sendReply(buf []byte) *SendReply {
 // Write request to wire for Send
 // sendRequest writes a Send request to a byte slice.
 func sendRequest(c *xgb.Conn, Event Event, DataType uint32) []byte {
+	return AppendSendRequest(nil, c, Event, DataType)
+}
+
+// AppendSendRequest appends a Send request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSendRequest(buf []byte, c *xgb.Conn, Event Event, DataType uint32) []byte {
 	size := 104
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XEVIE"]
@@ -484,16 +658,16 @@ func sendRequest(c *xgb.Conn, Event Event, DataType uint32) []byte {
 	buf[b] = 3 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	{
-		structBytes := Event.Bytes()
+		structBytes := Event.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
-	xgb.Put32(buf[b:], DataType)
+	order.Put32(buf[b:], DataType)
 	b += 4
 
 	b += 64 // padding
@@ -501,6 +675,41 @@ func sendRequest(c *xgb.Conn, Event Event, DataType uint32) []byte {
 	return buf
 }
 
+// SendRequest represents the data of a Send request, as it is read when tracing a connection.
+type SendRequest struct {
+	Event    Event
+	DataType uint32
+	// padding: 64 bytes
+}
+
+// sendRequestRead reads a byte slice in the byte order 'order' into a SendRequest value.
+func sendRequestRead(buf []byte, order xgb.ByteOrder) *SendRequest {
+	v := new(SendRequest)
+	b := 4 // skip request header
+
+	v.Event = Event{}
+	b += EventRead(buf[b:], order, &v.Event)
+
+	v.DataType = order.Get32(buf[b:])
+	b += 4
+
+	b += 64 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XEVIE"][3] = xgb.RequestInfo{
+		Name: "Send",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return sendRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return sendReply(buf, order)
+		},
+	}
+}
+
 // StartCookie is a cookie used only for Start requests.
 type StartCookie struct {
 	*xgb.Cookie
@@ -549,20 +758,32 @@ func (cook StartCookie) Reply() (*StartReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return startReply(buf), nil
+	return startReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a Start request arrives.
+func (cook StartCookie) ReplyContext(ctx context.Context) (*StartReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return startReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// startReply reads a byte slice into a StartReply value.
-func startReply(buf []byte) *StartReply {
+// startReply reads a byte slice in the byte order 'order' into a StartReply value.
+func startReply(buf []byte, order xgb.ByteOrder) *StartReply {
 	v := new(StartReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 24 // padding
@@ -573,9 +794,18 @@ func startReply(buf []byte) *StartReply {
 // Write request to wire for Start
 // startRequest writes a Start request to a byte slice.
 func startRequest(c *xgb.Conn, Screen uint32) []byte {
+	return AppendStartRequest(nil, c, Screen)
+}
+
+// AppendStartRequest appends a Start request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendStartRequest(buf []byte, c *xgb.Conn, Screen uint32) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XEVIE"]
@@ -585,11 +815,39 @@ func startRequest(c *xgb.Conn, Screen uint32) []byte {
 	buf[b] = 1 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
 	return buf
 }
+
+// StartRequest represents the data of a Start request, as it is read when tracing a connection.
+type StartRequest struct {
+	Screen uint32
+}
+
+// startRequestRead reads a byte slice in the byte order 'order' into a StartRequest value.
+func startRequestRead(buf []byte, order xgb.ByteOrder) *StartRequest {
+	v := new(StartRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XEVIE"][1] = xgb.RequestInfo{
+		Name: "Start",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return startRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return startReply(buf, order)
+		},
+	}
+}
@@ -4,6 +4,8 @@ package xf86dri
 // This file is automatically generated from xf86dri.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xproto"
@@ -22,18 +24,16 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["XFree86-DRI"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["XFree86-DRI"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["XFree86-DRI"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["XFree86-DRI"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["XFree86-DRI"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "XFree86-DRI", xgb.ExtRequestInfos["XFree86-DRI"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["XFree86-DRI"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["XFree86-DRI"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["XFree86-DRI"] = make(map[int]xgb.RequestInfo)
 }
 
 type DrmClipRect struct {
@@ -44,60 +44,60 @@ type DrmClipRect struct {
 }
 
 // DrmClipRectRead reads a byte slice into a DrmClipRect value.
-func DrmClipRectRead(buf []byte, v *DrmClipRect) int {
+func DrmClipRectRead(buf []byte, order xgb.ByteOrder, v *DrmClipRect) int {
 	b := 0
 
-	v.X1 = int16(xgb.Get16(buf[b:]))
+	v.X1 = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Y1 = int16(xgb.Get16(buf[b:]))
+	v.Y1 = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.X2 = int16(xgb.Get16(buf[b:]))
+	v.X2 = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.X3 = int16(xgb.Get16(buf[b:]))
+	v.X3 = int16(order.Get16(buf[b:]))
 	b += 2
 
 	return b
 }
 
 // DrmClipRectReadList reads a byte slice into a list of DrmClipRect values.
-func DrmClipRectReadList(buf []byte, dest []DrmClipRect) int {
+func DrmClipRectReadList(buf []byte, order xgb.ByteOrder, dest []DrmClipRect) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = DrmClipRect{}
-		b += DrmClipRectRead(buf[b:], &dest[i])
+		b += DrmClipRectRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a DrmClipRect value to a byte slice.
-func (v DrmClipRect) Bytes() []byte {
+// Bytes writes a DrmClipRect value to a byte slice, in the byte order 'order'.
+func (v DrmClipRect) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 8)
 	b := 0
 
-	xgb.Put16(buf[b:], uint16(v.X1))
+	order.Put16(buf[b:], uint16(v.X1))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.Y1))
+	order.Put16(buf[b:], uint16(v.Y1))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.X2))
+	order.Put16(buf[b:], uint16(v.X2))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.X3))
+	order.Put16(buf[b:], uint16(v.X3))
 	b += 2
 
 	return buf[:b]
 }
 
 // DrmClipRectListBytes writes a list of DrmClipRect values to a byte slice.
-func DrmClipRectListBytes(buf []byte, list []DrmClipRect) int {
+func DrmClipRectListBytes(buf []byte, order xgb.ByteOrder, list []DrmClipRect) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -128,6 +128,8 @@ func DrmClipRectListBytes(buf []byte, list []DrmClipRect) int {
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // AuthConnectionCookie is a cookie used only for AuthConnection requests.
 type AuthConnectionCookie struct {
 	*xgb.Cookie
@@ -176,23 +178,35 @@ func (cook AuthConnectionCookie) Reply() (*AuthConnectionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return authConnectionReply(buf), nil
+	return authConnectionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a AuthConnection request arrives.
+func (cook AuthConnectionCookie) ReplyContext(ctx context.Context) (*AuthConnectionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return authConnectionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// authConnectionReply reads a byte slice into a AuthConnectionReply value.
-func authConnectionReply(buf []byte) *AuthConnectionReply {
+// authConnectionReply reads a byte slice in the byte order 'order' into a AuthConnectionReply value.
+func authConnectionReply(buf []byte, order xgb.ByteOrder) *AuthConnectionReply {
 	v := new(AuthConnectionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Authenticated = xgb.Get32(buf[b:])
+	v.Authenticated = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -201,9 +215,18 @@ func authConnectionReply(buf []byte) *AuthConnectionReply {
 // Write request to wire for AuthConnection
 // authConnectionRequest writes a AuthConnection request to a byte slice.
 func authConnectionRequest(c *xgb.Conn, Screen uint32, Magic uint32) []byte {
+	return AppendAuthConnectionRequest(nil, c, Screen, Magic)
+}
+
+// AppendAuthConnectionRequest appends a AuthConnection request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendAuthConnectionRequest(buf []byte, c *xgb.Conn, Screen uint32, Magic uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-DRI"]
@@ -213,18 +236,50 @@ func authConnectionRequest(c *xgb.Conn, Screen uint32, Magic uint32) []byte {
 	buf[b] = 11 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
-	xgb.Put32(buf[b:], Magic)
+	order.Put32(buf[b:], Magic)
 	b += 4
 
 	return buf
 }
 
+// AuthConnectionRequest represents the data of a AuthConnection request, as it is read when tracing a connection.
+type AuthConnectionRequest struct {
+	Screen uint32
+	Magic  uint32
+}
+
+// authConnectionRequestRead reads a byte slice in the byte order 'order' into a AuthConnectionRequest value.
+func authConnectionRequestRead(buf []byte, order xgb.ByteOrder) *AuthConnectionRequest {
+	v := new(AuthConnectionRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	v.Magic = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-DRI"][11] = xgb.RequestInfo{
+		Name: "AuthConnection",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return authConnectionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return authConnectionReply(buf, order)
+		},
+	}
+}
+
 // CloseConnectionCookie is a cookie used only for CloseConnection requests.
 type CloseConnectionCookie struct {
 	*xgb.Cookie
@@ -262,12 +317,26 @@ func (cook CloseConnectionCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CloseConnectionCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CloseConnection
 // closeConnectionRequest writes a CloseConnection request to a byte slice.
 func closeConnectionRequest(c *xgb.Conn, Screen uint32) []byte {
+	return AppendCloseConnectionRequest(nil, c, Screen)
+}
+
+// AppendCloseConnectionRequest appends a CloseConnection request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCloseConnectionRequest(buf []byte, c *xgb.Conn, Screen uint32) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-DRI"]
@@ -277,15 +346,40 @@ func closeConnectionRequest(c *xgb.Conn, Screen uint32) []byte {
 	buf[b] = 3 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
 	return buf
 }
 
+// CloseConnectionRequest represents the data of a CloseConnection request, as it is read when tracing a connection.
+type CloseConnectionRequest struct {
+	Screen uint32
+}
+
+// closeConnectionRequestRead reads a byte slice in the byte order 'order' into a CloseConnectionRequest value.
+func closeConnectionRequestRead(buf []byte, order xgb.ByteOrder) *CloseConnectionRequest {
+	v := new(CloseConnectionRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-DRI"][3] = xgb.RequestInfo{
+		Name: "CloseConnection",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return closeConnectionRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateContextCookie is a cookie used only for CreateContext requests.
 type CreateContextCookie struct {
 	*xgb.Cookie
@@ -334,23 +428,35 @@ func (cook CreateContextCookie) Reply() (*CreateContextReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return createContextReply(buf), nil
+	return createContextReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a CreateContext request arrives.
+func (cook CreateContextCookie) ReplyContext(ctx context.Context) (*CreateContextReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return createContextReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// createContextReply reads a byte slice into a CreateContextReply value.
-func createContextReply(buf []byte) *CreateContextReply {
+// createContextReply reads a byte slice in the byte order 'order' into a CreateContextReply value.
+func createContextReply(buf []byte, order xgb.ByteOrder) *CreateContextReply {
 	v := new(CreateContextReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.HwContext = xgb.Get32(buf[b:])
+	v.HwContext = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -359,9 +465,18 @@ func createContextReply(buf []byte) *CreateContextReply {
 // Write request to wire for CreateContext
 // createContextRequest writes a CreateContext request to a byte slice.
 func createContextRequest(c *xgb.Conn, Screen uint32, Visual uint32, Context uint32) []byte {
+	return AppendCreateContextRequest(nil, c, Screen, Visual, Context)
+}
+
+// AppendCreateContextRequest appends a CreateContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateContextRequest(buf []byte, c *xgb.Conn, Screen uint32, Visual uint32, Context uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-DRI"]
@@ -371,21 +486,57 @@ func createContextRequest(c *xgb.Conn, Screen uint32, Visual uint32, Context uin
 	buf[b] = 5 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
-	xgb.Put32(buf[b:], Visual)
+	order.Put32(buf[b:], Visual)
 	b += 4
 
-	xgb.Put32(buf[b:], Context)
+	order.Put32(buf[b:], Context)
 	b += 4
 
 	return buf
 }
 
+// CreateContextRequest represents the data of a CreateContext request, as it is read when tracing a connection.
+type CreateContextRequest struct {
+	Screen  uint32
+	Visual  uint32
+	Context uint32
+}
+
+// createContextRequestRead reads a byte slice in the byte order 'order' into a CreateContextRequest value.
+func createContextRequestRead(buf []byte, order xgb.ByteOrder) *CreateContextRequest {
+	v := new(CreateContextRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	v.Visual = order.Get32(buf[b:])
+	b += 4
+
+	v.Context = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-DRI"][5] = xgb.RequestInfo{
+		Name: "CreateContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createContextRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createContextReply(buf, order)
+		},
+	}
+}
+
 // CreateDrawableCookie is a cookie used only for CreateDrawable requests.
 type CreateDrawableCookie struct {
 	*xgb.Cookie
@@ -434,23 +585,35 @@ func (cook CreateDrawableCookie) Reply() (*CreateDrawableReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return createDrawableReply(buf), nil
+	return createDrawableReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// createDrawableReply reads a byte slice into a CreateDrawableReply value.
-func createDrawableReply(buf []byte) *CreateDrawableReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a CreateDrawable request arrives.
+func (cook CreateDrawableCookie) ReplyContext(ctx context.Context) (*CreateDrawableReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return createDrawableReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// createDrawableReply reads a byte slice in the byte order 'order' into a CreateDrawableReply value.
+func createDrawableReply(buf []byte, order xgb.ByteOrder) *CreateDrawableReply {
 	v := new(CreateDrawableReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.HwDrawableHandle = xgb.Get32(buf[b:])
+	v.HwDrawableHandle = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -459,9 +622,18 @@ func createDrawableReply(buf []byte) *CreateDrawableReply {
 // Write request to wire for CreateDrawable
 // createDrawableRequest writes a CreateDrawable request to a byte slice.
 func createDrawableRequest(c *xgb.Conn, Screen uint32, Drawable uint32) []byte {
+	return AppendCreateDrawableRequest(nil, c, Screen, Drawable)
+}
+
+// AppendCreateDrawableRequest appends a CreateDrawable request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateDrawableRequest(buf []byte, c *xgb.Conn, Screen uint32, Drawable uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-DRI"]
@@ -471,18 +643,50 @@ func createDrawableRequest(c *xgb.Conn, Screen uint32, Drawable uint32) []byte {
 	buf[b] = 7 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
-	xgb.Put32(buf[b:], Drawable)
+	order.Put32(buf[b:], Drawable)
 	b += 4
 
 	return buf
 }
 
+// CreateDrawableRequest represents the data of a CreateDrawable request, as it is read when tracing a connection.
+type CreateDrawableRequest struct {
+	Screen   uint32
+	Drawable uint32
+}
+
+// createDrawableRequestRead reads a byte slice in the byte order 'order' into a CreateDrawableRequest value.
+func createDrawableRequestRead(buf []byte, order xgb.ByteOrder) *CreateDrawableRequest {
+	v := new(CreateDrawableRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	v.Drawable = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-DRI"][7] = xgb.RequestInfo{
+		Name: "CreateDrawable",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createDrawableRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createDrawableReply(buf, order)
+		},
+	}
+}
+
 // DestroyContextCookie is a cookie used only for DestroyContext requests.
 type DestroyContextCookie struct {
 	*xgb.Cookie
@@ -520,12 +724,26 @@ func (cook DestroyContextCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DestroyContextCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DestroyContext
 // destroyContextRequest writes a DestroyContext request to a byte slice.
 func destroyContextRequest(c *xgb.Conn, Screen uint32, Context uint32) []byte {
+	return AppendDestroyContextRequest(nil, c, Screen, Context)
+}
+
+// AppendDestroyContextRequest appends a DestroyContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDestroyContextRequest(buf []byte, c *xgb.Conn, Screen uint32, Context uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-DRI"]
@@ -535,18 +753,47 @@ func destroyContextRequest(c *xgb.Conn, Screen uint32, Context uint32) []byte {
 	buf[b] = 6 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
-	xgb.Put32(buf[b:], Context)
+	order.Put32(buf[b:], Context)
 	b += 4
 
 	return buf
 }
 
+// DestroyContextRequest represents the data of a DestroyContext request, as it is read when tracing a connection.
+type DestroyContextRequest struct {
+	Screen  uint32
+	Context uint32
+}
+
+// destroyContextRequestRead reads a byte slice in the byte order 'order' into a DestroyContextRequest value.
+func destroyContextRequestRead(buf []byte, order xgb.ByteOrder) *DestroyContextRequest {
+	v := new(DestroyContextRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	v.Context = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-DRI"][6] = xgb.RequestInfo{
+		Name: "DestroyContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return destroyContextRequestRead(buf, order)
+		},
+	}
+}
+
 // DestroyDrawableCookie is a cookie used only for DestroyDrawable requests.
 type DestroyDrawableCookie struct {
 	*xgb.Cookie
@@ -584,12 +831,26 @@ func (cook DestroyDrawableCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DestroyDrawableCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DestroyDrawable
 // destroyDrawableRequest writes a DestroyDrawable request to a byte slice.
 func destroyDrawableRequest(c *xgb.Conn, Screen uint32, Drawable uint32) []byte {
+	return AppendDestroyDrawableRequest(nil, c, Screen, Drawable)
+}
+
+// AppendDestroyDrawableRequest appends a DestroyDrawable request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDestroyDrawableRequest(buf []byte, c *xgb.Conn, Screen uint32, Drawable uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-DRI"]
@@ -599,18 +860,47 @@ func destroyDrawableRequest(c *xgb.Conn, Screen uint32, Drawable uint32) []byte
 	buf[b] = 8 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
-	xgb.Put32(buf[b:], Drawable)
+	order.Put32(buf[b:], Drawable)
 	b += 4
 
 	return buf
 }
 
+// DestroyDrawableRequest represents the data of a DestroyDrawable request, as it is read when tracing a connection.
+type DestroyDrawableRequest struct {
+	Screen   uint32
+	Drawable uint32
+}
+
+// destroyDrawableRequestRead reads a byte slice in the byte order 'order' into a DestroyDrawableRequest value.
+func destroyDrawableRequestRead(buf []byte, order xgb.ByteOrder) *DestroyDrawableRequest {
+	v := new(DestroyDrawableRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	v.Drawable = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-DRI"][8] = xgb.RequestInfo{
+		Name: "DestroyDrawable",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return destroyDrawableRequestRead(buf, order)
+		},
+	}
+}
+
 // GetClientDriverNameCookie is a cookie used only for GetClientDriverName requests.
 type GetClientDriverNameCookie struct {
 	*xgb.Cookie
@@ -664,32 +954,44 @@ func (cook GetClientDriverNameCookie) Reply() (*GetClientDriverNameReply, error)
 	if buf == nil {
 		return nil, nil
 	}
-	return getClientDriverNameReply(buf), nil
+	return getClientDriverNameReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getClientDriverNameReply reads a byte slice into a GetClientDriverNameReply value.
-func getClientDriverNameReply(buf []byte) *GetClientDriverNameReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetClientDriverName request arrives.
+func (cook GetClientDriverNameCookie) ReplyContext(ctx context.Context) (*GetClientDriverNameReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getClientDriverNameReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// getClientDriverNameReply reads a byte slice in the byte order 'order' into a GetClientDriverNameReply value.
+func getClientDriverNameReply(buf []byte, order xgb.ByteOrder) *GetClientDriverNameReply {
 	v := new(GetClientDriverNameReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ClientDriverMajorVersion = xgb.Get32(buf[b:])
+	v.ClientDriverMajorVersion = order.Get32(buf[b:])
 	b += 4
 
-	v.ClientDriverMinorVersion = xgb.Get32(buf[b:])
+	v.ClientDriverMinorVersion = order.Get32(buf[b:])
 	b += 4
 
-	v.ClientDriverPatchVersion = xgb.Get32(buf[b:])
+	v.ClientDriverPatchVersion = order.Get32(buf[b:])
 	b += 4
 
-	v.ClientDriverNameLen = xgb.Get32(buf[b:])
+	v.ClientDriverNameLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 8 // padding
@@ -707,9 +1009,18 @@ func getClientDriverNameReply(buf []byte) *GetClientDriverNameReply {
 // Write request to wire for GetClientDriverName
 // getClientDriverNameRequest writes a GetClientDriverName request to a byte slice.
 func getClientDriverNameRequest(c *xgb.Conn, Screen uint32) []byte {
+	return AppendGetClientDriverNameRequest(nil, c, Screen)
+}
+
+// AppendGetClientDriverNameRequest appends a GetClientDriverName request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetClientDriverNameRequest(buf []byte, c *xgb.Conn, Screen uint32) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-DRI"]
@@ -719,15 +1030,43 @@ func getClientDriverNameRequest(c *xgb.Conn, Screen uint32) []byte {
 	buf[b] = 4 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
 	return buf
 }
 
+// GetClientDriverNameRequest represents the data of a GetClientDriverName request, as it is read when tracing a connection.
+type GetClientDriverNameRequest struct {
+	Screen uint32
+}
+
+// getClientDriverNameRequestRead reads a byte slice in the byte order 'order' into a GetClientDriverNameRequest value.
+func getClientDriverNameRequestRead(buf []byte, order xgb.ByteOrder) *GetClientDriverNameRequest {
+	v := new(GetClientDriverNameRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-DRI"][4] = xgb.RequestInfo{
+		Name: "GetClientDriverName",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getClientDriverNameRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getClientDriverNameReply(buf, order)
+		},
+	}
+}
+
 // GetDeviceInfoCookie is a cookie used only for GetDeviceInfo requests.
 type GetDeviceInfoCookie struct {
 	*xgb.Cookie
@@ -782,43 +1121,55 @@ func (cook GetDeviceInfoCookie) Reply() (*GetDeviceInfoReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getDeviceInfoReply(buf), nil
+	return getDeviceInfoReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getDeviceInfoReply reads a byte slice into a GetDeviceInfoReply value.
-func getDeviceInfoReply(buf []byte) *GetDeviceInfoReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetDeviceInfo request arrives.
+func (cook GetDeviceInfoCookie) ReplyContext(ctx context.Context) (*GetDeviceInfoReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getDeviceInfoReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// getDeviceInfoReply reads a byte slice in the byte order 'order' into a GetDeviceInfoReply value.
+func getDeviceInfoReply(buf []byte, order xgb.ByteOrder) *GetDeviceInfoReply {
 	v := new(GetDeviceInfoReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.FramebufferHandleLow = xgb.Get32(buf[b:])
+	v.FramebufferHandleLow = order.Get32(buf[b:])
 	b += 4
 
-	v.FramebufferHandleHigh = xgb.Get32(buf[b:])
+	v.FramebufferHandleHigh = order.Get32(buf[b:])
 	b += 4
 
-	v.FramebufferOriginOffset = xgb.Get32(buf[b:])
+	v.FramebufferOriginOffset = order.Get32(buf[b:])
 	b += 4
 
-	v.FramebufferSize = xgb.Get32(buf[b:])
+	v.FramebufferSize = order.Get32(buf[b:])
 	b += 4
 
-	v.FramebufferStride = xgb.Get32(buf[b:])
+	v.FramebufferStride = order.Get32(buf[b:])
 	b += 4
 
-	v.DevicePrivateSize = xgb.Get32(buf[b:])
+	v.DevicePrivateSize = order.Get32(buf[b:])
 	b += 4
 
 	v.DevicePrivate = make([]uint32, v.DevicePrivateSize)
 	for i := 0; i < int(v.DevicePrivateSize); i++ {
-		v.DevicePrivate[i] = xgb.Get32(buf[b:])
+		v.DevicePrivate[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -828,9 +1179,18 @@ func getDeviceInfoReply(buf []byte) *GetDeviceInfoReply {
 // Write request to wire for GetDeviceInfo
 // getDeviceInfoRequest writes a GetDeviceInfo request to a byte slice.
 func getDeviceInfoRequest(c *xgb.Conn, Screen uint32) []byte {
+	return AppendGetDeviceInfoRequest(nil, c, Screen)
+}
+
+// AppendGetDeviceInfoRequest appends a GetDeviceInfo request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetDeviceInfoRequest(buf []byte, c *xgb.Conn, Screen uint32) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-DRI"]
@@ -840,15 +1200,43 @@ func getDeviceInfoRequest(c *xgb.Conn, Screen uint32) []byte {
 	buf[b] = 10 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
 	return buf
 }
 
+// GetDeviceInfoRequest represents the data of a GetDeviceInfo request, as it is read when tracing a connection.
+type GetDeviceInfoRequest struct {
+	Screen uint32
+}
+
+// getDeviceInfoRequestRead reads a byte slice in the byte order 'order' into a GetDeviceInfoRequest value.
+func getDeviceInfoRequestRead(buf []byte, order xgb.ByteOrder) *GetDeviceInfoRequest {
+	v := new(GetDeviceInfoRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-DRI"][10] = xgb.RequestInfo{
+		Name: "GetDeviceInfo",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getDeviceInfoRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getDeviceInfoReply(buf, order)
+		},
+	}
+}
+
 // GetDrawableInfoCookie is a cookie used only for GetDrawableInfo requests.
 type GetDrawableInfoCookie struct {
 	*xgb.Cookie
@@ -909,59 +1297,71 @@ func (cook GetDrawableInfoCookie) Reply() (*GetDrawableInfoReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getDrawableInfoReply(buf), nil
+	return getDrawableInfoReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetDrawableInfo request arrives.
+func (cook GetDrawableInfoCookie) ReplyContext(ctx context.Context) (*GetDrawableInfoReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getDrawableInfoReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getDrawableInfoReply reads a byte slice into a GetDrawableInfoReply value.
-func getDrawableInfoReply(buf []byte) *GetDrawableInfoReply {
+// getDrawableInfoReply reads a byte slice in the byte order 'order' into a GetDrawableInfoReply value.
+func getDrawableInfoReply(buf []byte, order xgb.ByteOrder) *GetDrawableInfoReply {
 	v := new(GetDrawableInfoReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.DrawableTableIndex = xgb.Get32(buf[b:])
+	v.DrawableTableIndex = order.Get32(buf[b:])
 	b += 4
 
-	v.DrawableTableStamp = xgb.Get32(buf[b:])
+	v.DrawableTableStamp = order.Get32(buf[b:])
 	b += 4
 
-	v.DrawableOriginX = int16(xgb.Get16(buf[b:]))
+	v.DrawableOriginX = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.DrawableOriginY = int16(xgb.Get16(buf[b:]))
+	v.DrawableOriginY = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.DrawableSizeW = int16(xgb.Get16(buf[b:]))
+	v.DrawableSizeW = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.DrawableSizeH = int16(xgb.Get16(buf[b:]))
+	v.DrawableSizeH = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.NumClipRects = xgb.Get32(buf[b:])
+	v.NumClipRects = order.Get32(buf[b:])
 	b += 4
 
-	v.BackX = int16(xgb.Get16(buf[b:]))
+	v.BackX = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.BackY = int16(xgb.Get16(buf[b:]))
+	v.BackY = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.NumBackClipRects = xgb.Get32(buf[b:])
+	v.NumBackClipRects = order.Get32(buf[b:])
 	b += 4
 
 	v.ClipRects = make([]DrmClipRect, v.NumClipRects)
-	b += DrmClipRectReadList(buf[b:], v.ClipRects)
+	b += DrmClipRectReadList(buf[b:], order, v.ClipRects)
 
 	b = (b + 3) & ^3 // alignment gap
 
 	v.BackClipRects = make([]DrmClipRect, v.NumBackClipRects)
-	b += DrmClipRectReadList(buf[b:], v.BackClipRects)
+	b += DrmClipRectReadList(buf[b:], order, v.BackClipRects)
 
 	return v
 }
@@ -969,9 +1369,18 @@ func getDrawableInfoReply(buf []byte) *GetDrawableInfoReply {
 // Write request to wire for GetDrawableInfo
 // getDrawableInfoRequest writes a GetDrawableInfo request to a byte slice.
 func getDrawableInfoRequest(c *xgb.Conn, Screen uint32, Drawable uint32) []byte {
+	return AppendGetDrawableInfoRequest(nil, c, Screen, Drawable)
+}
+
+// AppendGetDrawableInfoRequest appends a GetDrawableInfo request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetDrawableInfoRequest(buf []byte, c *xgb.Conn, Screen uint32, Drawable uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-DRI"]
@@ -981,18 +1390,50 @@ func getDrawableInfoRequest(c *xgb.Conn, Screen uint32, Drawable uint32) []byte
 	buf[b] = 9 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
-	xgb.Put32(buf[b:], Drawable)
+	order.Put32(buf[b:], Drawable)
 	b += 4
 
 	return buf
 }
 
+// GetDrawableInfoRequest represents the data of a GetDrawableInfo request, as it is read when tracing a connection.
+type GetDrawableInfoRequest struct {
+	Screen   uint32
+	Drawable uint32
+}
+
+// getDrawableInfoRequestRead reads a byte slice in the byte order 'order' into a GetDrawableInfoRequest value.
+func getDrawableInfoRequestRead(buf []byte, order xgb.ByteOrder) *GetDrawableInfoRequest {
+	v := new(GetDrawableInfoRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	v.Drawable = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-DRI"][9] = xgb.RequestInfo{
+		Name: "GetDrawableInfo",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getDrawableInfoRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getDrawableInfoReply(buf, order)
+		},
+	}
+}
+
 // OpenConnectionCookie is a cookie used only for OpenConnection requests.
 type OpenConnectionCookie struct {
 	*xgb.Cookie
@@ -1045,29 +1486,41 @@ func (cook OpenConnectionCookie) Reply() (*OpenConnectionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return openConnectionReply(buf), nil
+	return openConnectionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// openConnectionReply reads a byte slice into a OpenConnectionReply value.
-func openConnectionReply(buf []byte) *OpenConnectionReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a OpenConnection request arrives.
+func (cook OpenConnectionCookie) ReplyContext(ctx context.Context) (*OpenConnectionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return openConnectionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// openConnectionReply reads a byte slice in the byte order 'order' into a OpenConnectionReply value.
+func openConnectionReply(buf []byte, order xgb.ByteOrder) *OpenConnectionReply {
 	v := new(OpenConnectionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.SareaHandleLow = xgb.Get32(buf[b:])
+	v.SareaHandleLow = order.Get32(buf[b:])
 	b += 4
 
-	v.SareaHandleHigh = xgb.Get32(buf[b:])
+	v.SareaHandleHigh = order.Get32(buf[b:])
 	b += 4
 
-	v.BusIdLen = xgb.Get32(buf[b:])
+	v.BusIdLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 12 // padding
@@ -1085,9 +1538,18 @@ func openConnectionReply(buf []byte) *OpenConnectionReply {
 // Write request to wire for OpenConnection
 // openConnectionRequest writes a OpenConnection request to a byte slice.
 func openConnectionRequest(c *xgb.Conn, Screen uint32) []byte {
+	return AppendOpenConnectionRequest(nil, c, Screen)
+}
+
+// AppendOpenConnectionRequest appends a OpenConnection request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendOpenConnectionRequest(buf []byte, c *xgb.Conn, Screen uint32) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-DRI"]
@@ -1097,15 +1559,43 @@ func openConnectionRequest(c *xgb.Conn, Screen uint32) []byte {
 	buf[b] = 2 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
 	return buf
 }
 
+// OpenConnectionRequest represents the data of a OpenConnection request, as it is read when tracing a connection.
+type OpenConnectionRequest struct {
+	Screen uint32
+}
+
+// openConnectionRequestRead reads a byte slice in the byte order 'order' into a OpenConnectionRequest value.
+func openConnectionRequestRead(buf []byte, order xgb.ByteOrder) *OpenConnectionRequest {
+	v := new(OpenConnectionRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-DRI"][2] = xgb.RequestInfo{
+		Name: "OpenConnection",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return openConnectionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return openConnectionReply(buf, order)
+		},
+	}
+}
+
 // QueryDirectRenderingCapableCookie is a cookie used only for QueryDirectRenderingCapable requests.
 type QueryDirectRenderingCapableCookie struct {
 	*xgb.Cookie
@@ -1154,20 +1644,32 @@ func (cook QueryDirectRenderingCapableCookie) Reply() (*QueryDirectRenderingCapa
 	if buf == nil {
 		return nil, nil
 	}
-	return queryDirectRenderingCapableReply(buf), nil
+	return queryDirectRenderingCapableReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryDirectRenderingCapableReply reads a byte slice into a QueryDirectRenderingCapableReply value.
-func queryDirectRenderingCapableReply(buf []byte) *QueryDirectRenderingCapableReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryDirectRenderingCapable request arrives.
+func (cook QueryDirectRenderingCapableCookie) ReplyContext(ctx context.Context) (*QueryDirectRenderingCapableReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryDirectRenderingCapableReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryDirectRenderingCapableReply reads a byte slice in the byte order 'order' into a QueryDirectRenderingCapableReply value.
+func queryDirectRenderingCapableReply(buf []byte, order xgb.ByteOrder) *QueryDirectRenderingCapableReply {
 	v := new(QueryDirectRenderingCapableReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	if buf[b] == 1 {
@@ -1183,9 +1685,18 @@ func queryDirectRenderingCapableReply(buf []byte) *QueryDirectRenderingCapableRe
 // Write request to wire for QueryDirectRenderingCapable
 // queryDirectRenderingCapableRequest writes a QueryDirectRenderingCapable request to a byte slice.
 func queryDirectRenderingCapableRequest(c *xgb.Conn, Screen uint32) []byte {
+	return AppendQueryDirectRenderingCapableRequest(nil, c, Screen)
+}
+
+// AppendQueryDirectRenderingCapableRequest appends a QueryDirectRenderingCapable request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryDirectRenderingCapableRequest(buf []byte, c *xgb.Conn, Screen uint32) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-DRI"]
@@ -1195,15 +1706,43 @@ func queryDirectRenderingCapableRequest(c *xgb.Conn, Screen uint32) []byte {
 	buf[b] = 1 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
 	return buf
 }
 
+// QueryDirectRenderingCapableRequest represents the data of a QueryDirectRenderingCapable request, as it is read when tracing a connection.
+type QueryDirectRenderingCapableRequest struct {
+	Screen uint32
+}
+
+// queryDirectRenderingCapableRequestRead reads a byte slice in the byte order 'order' into a QueryDirectRenderingCapableRequest value.
+func queryDirectRenderingCapableRequestRead(buf []byte, order xgb.ByteOrder) *QueryDirectRenderingCapableRequest {
+	v := new(QueryDirectRenderingCapableRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-DRI"][1] = xgb.RequestInfo{
+		Name: "QueryDirectRenderingCapable",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryDirectRenderingCapableRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryDirectRenderingCapableReply(buf, order)
+		},
+	}
+}
+
 // QueryVersionCookie is a cookie used only for QueryVersion requests.
 type QueryVersionCookie struct {
 	*xgb.Cookie
@@ -1254,29 +1793,41 @@ func (cook QueryVersionCookie) Reply() (*QueryVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryVersionReply(buf), nil
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryVersion request arrives.
+func (cook QueryVersionCookie) ReplyContext(ctx context.Context) (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryVersionReply reads a byte slice into a QueryVersionReply value.
-func queryVersionReply(buf []byte) *QueryVersionReply {
+// queryVersionReply reads a byte slice in the byte order 'order' into a QueryVersionReply value.
+func queryVersionReply(buf []byte, order xgb.ByteOrder) *QueryVersionReply {
 	v := new(QueryVersionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.DriMajorVersion = xgb.Get16(buf[b:])
+	v.DriMajorVersion = order.Get16(buf[b:])
 	b += 2
 
-	v.DriMinorVersion = xgb.Get16(buf[b:])
+	v.DriMinorVersion = order.Get16(buf[b:])
 	b += 2
 
-	v.DriMinorPatch = xgb.Get32(buf[b:])
+	v.DriMinorPatch = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -1285,9 +1836,18 @@ func queryVersionReply(buf []byte) *QueryVersionReply {
 // Write request to wire for QueryVersion
 // queryVersionRequest writes a QueryVersion request to a byte slice.
 func queryVersionRequest(c *xgb.Conn) []byte {
+	return AppendQueryVersionRequest(nil, c)
+}
+
+// AppendQueryVersionRequest appends a QueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryVersionRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-DRI"]
@@ -1297,8 +1857,30 @@ func queryVersionRequest(c *xgb.Conn) []byte {
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
+
+// QueryVersionRequest represents the data of a QueryVersion request, as it is read when tracing a connection.
+type QueryVersionRequest struct {
+}
+
+// queryVersionRequestRead reads a byte slice in the byte order 'order' into a QueryVersionRequest value.
+func queryVersionRequestRead(buf []byte, order xgb.ByteOrder) *QueryVersionRequest {
+	v := new(QueryVersionRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-DRI"][0] = xgb.RequestInfo{
+		Name: "QueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionReply(buf, order)
+		},
+	}
+}
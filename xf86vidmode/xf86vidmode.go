@@ -4,6 +4,8 @@ package xf86vidmode
 // This file is automatically generated from xf86vidmode.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xproto"
@@ -22,18 +24,16 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["XFree86-VidModeExtension"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["XFree86-VidModeExtension"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["XFree86-VidModeExtension"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["XFree86-VidModeExtension"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["XFree86-VidModeExtension"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "XFree86-VidModeExtension", xgb.ExtRequestInfos["XFree86-VidModeExtension"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["XFree86-VidModeExtension"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["XFree86-VidModeExtension"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"] = make(map[int]xgb.RequestInfo)
 }
 
 // BadBadClock is the error number for a BadBadClock.
@@ -44,15 +44,15 @@ type BadClockError struct {
 	NiceName string
 }
 
-// BadClockErrorNew constructs a BadClockError value that implements xgb.Error from a byte slice.
-func BadClockErrorNew(buf []byte) xgb.Error {
+// BadClockErrorNew constructs a BadClockError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadClockErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := BadClockError{}
 	v.NiceName = "BadClock"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -90,15 +90,15 @@ type BadHTimingsError struct {
 	NiceName string
 }
 
-// BadHTimingsErrorNew constructs a BadHTimingsError value that implements xgb.Error from a byte slice.
-func BadHTimingsErrorNew(buf []byte) xgb.Error {
+// BadHTimingsErrorNew constructs a BadHTimingsError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadHTimingsErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := BadHTimingsError{}
 	v.NiceName = "BadHTimings"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -136,15 +136,15 @@ type BadVTimingsError struct {
 	NiceName string
 }
 
-// BadVTimingsErrorNew constructs a BadVTimingsError value that implements xgb.Error from a byte slice.
-func BadVTimingsErrorNew(buf []byte) xgb.Error {
+// BadVTimingsErrorNew constructs a BadVTimingsError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadVTimingsErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := BadVTimingsError{}
 	v.NiceName = "BadVTimings"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -182,15 +182,15 @@ type ClientNotLocalError struct {
 	NiceName string
 }
 
-// ClientNotLocalErrorNew constructs a ClientNotLocalError value that implements xgb.Error from a byte slice.
-func ClientNotLocalErrorNew(buf []byte) xgb.Error {
+// ClientNotLocalErrorNew constructs a ClientNotLocalError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func ClientNotLocalErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := ClientNotLocalError{}
 	v.NiceName = "ClientNotLocal"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -220,10 +220,28 @@ func init() {
 	xgb.NewExtErrorFuncs["XFree86-VidModeExtension"][5] = ClientNotLocalErrorNew
 }
 
+type ClockFlag uint32
+
 const (
-	ClockFlagProgramable = 1
+	ClockFlagProgramable ClockFlag = 1
 )
 
+// String returns the names of the ClockFlag bits set in a value.
+func (v ClockFlag) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 1)
+	if v&ClockFlagProgramable != 0 {
+		names = append(names, "Programable")
+		v &^= ClockFlagProgramable
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint32(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
 type Dotclock uint32
 
 // BadExtensionDisabled is the error number for a BadExtensionDisabled.
@@ -234,15 +252,15 @@ type ExtensionDisabledError struct {
 	NiceName string
 }
 
-// ExtensionDisabledErrorNew constructs a ExtensionDisabledError value that implements xgb.Error from a byte slice.
-func ExtensionDisabledErrorNew(buf []byte) xgb.Error {
+// ExtensionDisabledErrorNew constructs a ExtensionDisabledError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func ExtensionDisabledErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := ExtensionDisabledError{}
 	v.NiceName = "ExtensionDisabled"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -272,22 +290,88 @@ func init() {
 	xgb.NewExtErrorFuncs["XFree86-VidModeExtension"][4] = ExtensionDisabledErrorNew
 }
 
+type ModeFlag uint32
+
 const (
-	ModeFlagPositiveHsync = 1
-	ModeFlagNegativeHsync = 2
-	ModeFlagPositiveVsync = 4
-	ModeFlagNegativeVsync = 8
-	ModeFlagInterlace     = 16
-	ModeFlagCompositeSync = 32
-	ModeFlagPositiveCsync = 64
-	ModeFlagNegativeCsync = 128
-	ModeFlagHSkew         = 256
-	ModeFlagBroadcast     = 512
-	ModeFlagPixmux        = 1024
-	ModeFlagDoubleClock   = 2048
-	ModeFlagHalfClock     = 4096
+	ModeFlagPositiveHsync ModeFlag = 1
+	ModeFlagNegativeHsync ModeFlag = 2
+	ModeFlagPositiveVsync ModeFlag = 4
+	ModeFlagNegativeVsync ModeFlag = 8
+	ModeFlagInterlace     ModeFlag = 16
+	ModeFlagCompositeSync ModeFlag = 32
+	ModeFlagPositiveCsync ModeFlag = 64
+	ModeFlagNegativeCsync ModeFlag = 128
+	ModeFlagHSkew         ModeFlag = 256
+	ModeFlagBroadcast     ModeFlag = 512
+	ModeFlagPixmux        ModeFlag = 1024
+	ModeFlagDoubleClock   ModeFlag = 2048
+	ModeFlagHalfClock     ModeFlag = 4096
 )
 
+// String returns the names of the ModeFlag bits set in a value.
+func (v ModeFlag) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 13)
+	if v&ModeFlagPositiveHsync != 0 {
+		names = append(names, "PositiveHsync")
+		v &^= ModeFlagPositiveHsync
+	}
+	if v&ModeFlagNegativeHsync != 0 {
+		names = append(names, "NegativeHsync")
+		v &^= ModeFlagNegativeHsync
+	}
+	if v&ModeFlagPositiveVsync != 0 {
+		names = append(names, "PositiveVsync")
+		v &^= ModeFlagPositiveVsync
+	}
+	if v&ModeFlagNegativeVsync != 0 {
+		names = append(names, "NegativeVsync")
+		v &^= ModeFlagNegativeVsync
+	}
+	if v&ModeFlagInterlace != 0 {
+		names = append(names, "Interlace")
+		v &^= ModeFlagInterlace
+	}
+	if v&ModeFlagCompositeSync != 0 {
+		names = append(names, "CompositeSync")
+		v &^= ModeFlagCompositeSync
+	}
+	if v&ModeFlagPositiveCsync != 0 {
+		names = append(names, "PositiveCsync")
+		v &^= ModeFlagPositiveCsync
+	}
+	if v&ModeFlagNegativeCsync != 0 {
+		names = append(names, "NegativeCsync")
+		v &^= ModeFlagNegativeCsync
+	}
+	if v&ModeFlagHSkew != 0 {
+		names = append(names, "HSkew")
+		v &^= ModeFlagHSkew
+	}
+	if v&ModeFlagBroadcast != 0 {
+		names = append(names, "Broadcast")
+		v &^= ModeFlagBroadcast
+	}
+	if v&ModeFlagPixmux != 0 {
+		names = append(names, "Pixmux")
+		v &^= ModeFlagPixmux
+	}
+	if v&ModeFlagDoubleClock != 0 {
+		names = append(names, "DoubleClock")
+		v &^= ModeFlagDoubleClock
+	}
+	if v&ModeFlagHalfClock != 0 {
+		names = append(names, "HalfClock")
+		v &^= ModeFlagHalfClock
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint32(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
 type ModeInfo struct {
 	Dotclock   Dotclock
 	Hdisplay   uint16
@@ -300,122 +384,122 @@ type ModeInfo struct {
 	Vsyncend   uint16
 	Vtotal     uint16
 	// padding: 4 bytes
-	Flags uint32
+	Flags ModeFlag
 	// padding: 12 bytes
 	Privsize uint32
 }
 
 // ModeInfoRead reads a byte slice into a ModeInfo value.
-func ModeInfoRead(buf []byte, v *ModeInfo) int {
+func ModeInfoRead(buf []byte, order xgb.ByteOrder, v *ModeInfo) int {
 	b := 0
 
-	v.Dotclock = Dotclock(xgb.Get32(buf[b:]))
+	v.Dotclock = Dotclock(order.Get32(buf[b:]))
 	b += 4
 
-	v.Hdisplay = xgb.Get16(buf[b:])
+	v.Hdisplay = order.Get16(buf[b:])
 	b += 2
 
-	v.Hsyncstart = xgb.Get16(buf[b:])
+	v.Hsyncstart = order.Get16(buf[b:])
 	b += 2
 
-	v.Hsyncend = xgb.Get16(buf[b:])
+	v.Hsyncend = order.Get16(buf[b:])
 	b += 2
 
-	v.Htotal = xgb.Get16(buf[b:])
+	v.Htotal = order.Get16(buf[b:])
 	b += 2
 
-	v.Hskew = xgb.Get32(buf[b:])
+	v.Hskew = order.Get32(buf[b:])
 	b += 4
 
-	v.Vdisplay = xgb.Get16(buf[b:])
+	v.Vdisplay = order.Get16(buf[b:])
 	b += 2
 
-	v.Vsyncstart = xgb.Get16(buf[b:])
+	v.Vsyncstart = order.Get16(buf[b:])
 	b += 2
 
-	v.Vsyncend = xgb.Get16(buf[b:])
+	v.Vsyncend = order.Get16(buf[b:])
 	b += 2
 
-	v.Vtotal = xgb.Get16(buf[b:])
+	v.Vtotal = order.Get16(buf[b:])
 	b += 2
 
 	b += 4 // padding
 
-	v.Flags = xgb.Get32(buf[b:])
+	v.Flags = ModeFlag(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
-	v.Privsize = xgb.Get32(buf[b:])
+	v.Privsize = order.Get32(buf[b:])
 	b += 4
 
 	return b
 }
 
 // ModeInfoReadList reads a byte slice into a list of ModeInfo values.
-func ModeInfoReadList(buf []byte, dest []ModeInfo) int {
+func ModeInfoReadList(buf []byte, order xgb.ByteOrder, dest []ModeInfo) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = ModeInfo{}
-		b += ModeInfoRead(buf[b:], &dest[i])
+		b += ModeInfoRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a ModeInfo value to a byte slice.
-func (v ModeInfo) Bytes() []byte {
+// Bytes writes a ModeInfo value to a byte slice, in the byte order 'order'.
+func (v ModeInfo) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 48)
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.Dotclock))
+	order.Put32(buf[b:], uint32(v.Dotclock))
 	b += 4
 
-	xgb.Put16(buf[b:], v.Hdisplay)
+	order.Put16(buf[b:], v.Hdisplay)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Hsyncstart)
+	order.Put16(buf[b:], v.Hsyncstart)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Hsyncend)
+	order.Put16(buf[b:], v.Hsyncend)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Htotal)
+	order.Put16(buf[b:], v.Htotal)
 	b += 2
 
-	xgb.Put32(buf[b:], v.Hskew)
+	order.Put32(buf[b:], v.Hskew)
 	b += 4
 
-	xgb.Put16(buf[b:], v.Vdisplay)
+	order.Put16(buf[b:], v.Vdisplay)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Vsyncstart)
+	order.Put16(buf[b:], v.Vsyncstart)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Vsyncend)
+	order.Put16(buf[b:], v.Vsyncend)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Vtotal)
+	order.Put16(buf[b:], v.Vtotal)
 	b += 2
 
 	b += 4 // padding
 
-	xgb.Put32(buf[b:], v.Flags)
+	order.Put32(buf[b:], uint32(v.Flags))
 	b += 4
 
 	b += 12 // padding
 
-	xgb.Put32(buf[b:], v.Privsize)
+	order.Put32(buf[b:], v.Privsize)
 	b += 4
 
 	return buf[:b]
 }
 
 // ModeInfoListBytes writes a list of ModeInfo values to a byte slice.
-func ModeInfoListBytes(buf []byte, list []ModeInfo) int {
+func ModeInfoListBytes(buf []byte, order xgb.ByteOrder, list []ModeInfo) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -430,15 +514,15 @@ type ModeUnsuitableError struct {
 	NiceName string
 }
 
-// ModeUnsuitableErrorNew constructs a ModeUnsuitableError value that implements xgb.Error from a byte slice.
-func ModeUnsuitableErrorNew(buf []byte) xgb.Error {
+// ModeUnsuitableErrorNew constructs a ModeUnsuitableError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func ModeUnsuitableErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := ModeUnsuitableError{}
 	v.NiceName = "ModeUnsuitable"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -468,11 +552,33 @@ func init() {
 	xgb.NewExtErrorFuncs["XFree86-VidModeExtension"][3] = ModeUnsuitableErrorNew
 }
 
+type Permission uint32
+
 const (
-	PermissionRead  = 1
-	PermissionWrite = 2
+	PermissionRead  Permission = 1
+	PermissionWrite Permission = 2
 )
 
+// String returns the names of the Permission bits set in a value.
+func (v Permission) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 2)
+	if v&PermissionRead != 0 {
+		names = append(names, "Read")
+		v &^= PermissionRead
+	}
+	if v&PermissionWrite != 0 {
+		names = append(names, "Write")
+		v &^= PermissionWrite
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint32(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
 type Syncrange uint32
 
 // BadZoomLocked is the error number for a BadZoomLocked.
@@ -483,15 +589,15 @@ type ZoomLockedError struct {
 	NiceName string
 }
 
-// ZoomLockedErrorNew constructs a ZoomLockedError value that implements xgb.Error from a byte slice.
-func ZoomLockedErrorNew(buf []byte) xgb.Error {
+// ZoomLockedErrorNew constructs a ZoomLockedError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func ZoomLockedErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := ZoomLockedError{}
 	v.NiceName = "ZoomLocked"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -545,6 +651,8 @@ func init() {
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // AddModeLineCookie is a cookie used only for AddModeLine requests.
 type AddModeLineCookie struct {
 	*xgb.Cookie
@@ -552,7 +660,7 @@ type AddModeLineCookie struct {
 
 // AddModeLine sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func AddModeLine(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags uint32, Privsize uint32, AfterDotclock Dotclock, AfterHdisplay uint16, AfterHsyncstart uint16, AfterHsyncend uint16, AfterHtotal uint16, AfterHskew uint16, AfterVdisplay uint16, AfterVsyncstart uint16, AfterVsyncend uint16, AfterVtotal uint16, AfterFlags uint32, Private []byte) AddModeLineCookie {
+func AddModeLine(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags ModeFlag, Privsize uint32, AfterDotclock Dotclock, AfterHdisplay uint16, AfterHsyncstart uint16, AfterHsyncend uint16, AfterHtotal uint16, AfterHskew uint16, AfterVdisplay uint16, AfterVsyncstart uint16, AfterVsyncend uint16, AfterVtotal uint16, AfterFlags ModeFlag, Private []byte) AddModeLineCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["XFree86-VidModeExtension"]; !ok {
@@ -565,7 +673,7 @@ func AddModeLine(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16,
 
 // AddModeLineChecked sends a checked request.
 // If an error occurs, it can be retrieved using AddModeLineCookie.Check()
-func AddModeLineChecked(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags uint32, Privsize uint32, AfterDotclock Dotclock, AfterHdisplay uint16, AfterHsyncstart uint16, AfterHsyncend uint16, AfterHtotal uint16, AfterHskew uint16, AfterVdisplay uint16, AfterVsyncstart uint16, AfterVsyncend uint16, AfterVtotal uint16, AfterFlags uint32, Private []byte) AddModeLineCookie {
+func AddModeLineChecked(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags ModeFlag, Privsize uint32, AfterDotclock Dotclock, AfterHdisplay uint16, AfterHsyncstart uint16, AfterHsyncend uint16, AfterHtotal uint16, AfterHskew uint16, AfterVdisplay uint16, AfterVsyncstart uint16, AfterVsyncend uint16, AfterVtotal uint16, AfterFlags ModeFlag, Private []byte) AddModeLineCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["XFree86-VidModeExtension"]; !ok {
@@ -582,12 +690,26 @@ func (cook AddModeLineCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook AddModeLineCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for AddModeLine
 // addModeLineRequest writes a AddModeLine request to a byte slice.
-func addModeLineRequest(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags uint32, Privsize uint32, AfterDotclock Dotclock, AfterHdisplay uint16, AfterHsyncstart uint16, AfterHsyncend uint16, AfterHtotal uint16, AfterHskew uint16, AfterVdisplay uint16, AfterVsyncstart uint16, AfterVsyncend uint16, AfterVtotal uint16, AfterFlags uint32, Private []byte) []byte {
+func addModeLineRequest(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags ModeFlag, Privsize uint32, AfterDotclock Dotclock, AfterHdisplay uint16, AfterHsyncstart uint16, AfterHsyncend uint16, AfterHtotal uint16, AfterHskew uint16, AfterVdisplay uint16, AfterVsyncstart uint16, AfterVsyncend uint16, AfterVtotal uint16, AfterFlags ModeFlag, Private []byte) []byte {
+	return AppendAddModeLineRequest(nil, c, Screen, Dotclock, Hdisplay, Hsyncstart, Hsyncend, Htotal, Hskew, Vdisplay, Vsyncstart, Vsyncend, Vtotal, Flags, Privsize, AfterDotclock, AfterHdisplay, AfterHsyncstart, AfterHsyncend, AfterHtotal, AfterHskew, AfterVdisplay, AfterVsyncstart, AfterVsyncend, AfterVtotal, AfterFlags, Private)
+}
+
+// AppendAddModeLineRequest appends a AddModeLine request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendAddModeLineRequest(buf []byte, c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags ModeFlag, Privsize uint32, AfterDotclock Dotclock, AfterHdisplay uint16, AfterHsyncstart uint16, AfterHsyncend uint16, AfterHtotal uint16, AfterHskew uint16, AfterVdisplay uint16, AfterVsyncstart uint16, AfterVsyncend uint16, AfterVtotal uint16, AfterFlags ModeFlag, Private []byte) []byte {
 	size := xgb.Pad((92 + xgb.Pad((int(Privsize) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-VidModeExtension"]
@@ -597,85 +719,85 @@ func addModeLineRequest(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay
 	buf[b] = 7 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Dotclock))
+	order.Put32(buf[b:], uint32(Dotclock))
 	b += 4
 
-	xgb.Put16(buf[b:], Hdisplay)
+	order.Put16(buf[b:], Hdisplay)
 	b += 2
 
-	xgb.Put16(buf[b:], Hsyncstart)
+	order.Put16(buf[b:], Hsyncstart)
 	b += 2
 
-	xgb.Put16(buf[b:], Hsyncend)
+	order.Put16(buf[b:], Hsyncend)
 	b += 2
 
-	xgb.Put16(buf[b:], Htotal)
+	order.Put16(buf[b:], Htotal)
 	b += 2
 
-	xgb.Put16(buf[b:], Hskew)
+	order.Put16(buf[b:], Hskew)
 	b += 2
 
-	xgb.Put16(buf[b:], Vdisplay)
+	order.Put16(buf[b:], Vdisplay)
 	b += 2
 
-	xgb.Put16(buf[b:], Vsyncstart)
+	order.Put16(buf[b:], Vsyncstart)
 	b += 2
 
-	xgb.Put16(buf[b:], Vsyncend)
+	order.Put16(buf[b:], Vsyncend)
 	b += 2
 
-	xgb.Put16(buf[b:], Vtotal)
+	order.Put16(buf[b:], Vtotal)
 	b += 2
 
 	b += 2 // padding
 
-	xgb.Put32(buf[b:], Flags)
+	order.Put32(buf[b:], uint32(Flags))
 	b += 4
 
 	b += 12 // padding
 
-	xgb.Put32(buf[b:], Privsize)
+	order.Put32(buf[b:], Privsize)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(AfterDotclock))
+	order.Put32(buf[b:], uint32(AfterDotclock))
 	b += 4
 
-	xgb.Put16(buf[b:], AfterHdisplay)
+	order.Put16(buf[b:], AfterHdisplay)
 	b += 2
 
-	xgb.Put16(buf[b:], AfterHsyncstart)
+	order.Put16(buf[b:], AfterHsyncstart)
 	b += 2
 
-	xgb.Put16(buf[b:], AfterHsyncend)
+	order.Put16(buf[b:], AfterHsyncend)
 	b += 2
 
-	xgb.Put16(buf[b:], AfterHtotal)
+	order.Put16(buf[b:], AfterHtotal)
 	b += 2
 
-	xgb.Put16(buf[b:], AfterHskew)
+	order.Put16(buf[b:], AfterHskew)
 	b += 2
 
-	xgb.Put16(buf[b:], AfterVdisplay)
+	order.Put16(buf[b:], AfterVdisplay)
 	b += 2
 
-	xgb.Put16(buf[b:], AfterVsyncstart)
+	order.Put16(buf[b:], AfterVsyncstart)
 	b += 2
 
-	xgb.Put16(buf[b:], AfterVsyncend)
+	order.Put16(buf[b:], AfterVsyncend)
 	b += 2
 
-	xgb.Put16(buf[b:], AfterVtotal)
+	order.Put16(buf[b:], AfterVtotal)
 	b += 2
 
 	b += 2 // padding
 
-	xgb.Put32(buf[b:], AfterFlags)
+	order.Put32(buf[b:], uint32(AfterFlags))
 	b += 4
 
 	b += 12 // padding
@@ -686,6 +808,140 @@ func addModeLineRequest(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay
 	return buf
 }
 
+// AddModeLineRequest represents the data of a AddModeLine request, as it is read when tracing a connection.
+type AddModeLineRequest struct {
+	Screen     uint32
+	Dotclock   Dotclock
+	Hdisplay   uint16
+	Hsyncstart uint16
+	Hsyncend   uint16
+	Htotal     uint16
+	Hskew      uint16
+	Vdisplay   uint16
+	Vsyncstart uint16
+	Vsyncend   uint16
+	Vtotal     uint16
+	// padding: 2 bytes
+	Flags ModeFlag
+	// padding: 12 bytes
+	Privsize        uint32
+	AfterDotclock   Dotclock
+	AfterHdisplay   uint16
+	AfterHsyncstart uint16
+	AfterHsyncend   uint16
+	AfterHtotal     uint16
+	AfterHskew      uint16
+	AfterVdisplay   uint16
+	AfterVsyncstart uint16
+	AfterVsyncend   uint16
+	AfterVtotal     uint16
+	// padding: 2 bytes
+	AfterFlags ModeFlag
+	// padding: 12 bytes
+	Private []byte // size: xgb.Pad((int(Privsize) * 1))
+}
+
+// addModeLineRequestRead reads a byte slice in the byte order 'order' into a AddModeLineRequest value.
+func addModeLineRequestRead(buf []byte, order xgb.ByteOrder) *AddModeLineRequest {
+	v := new(AddModeLineRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	v.Dotclock = Dotclock(order.Get32(buf[b:]))
+	b += 4
+
+	v.Hdisplay = order.Get16(buf[b:])
+	b += 2
+
+	v.Hsyncstart = order.Get16(buf[b:])
+	b += 2
+
+	v.Hsyncend = order.Get16(buf[b:])
+	b += 2
+
+	v.Htotal = order.Get16(buf[b:])
+	b += 2
+
+	v.Hskew = order.Get16(buf[b:])
+	b += 2
+
+	v.Vdisplay = order.Get16(buf[b:])
+	b += 2
+
+	v.Vsyncstart = order.Get16(buf[b:])
+	b += 2
+
+	v.Vsyncend = order.Get16(buf[b:])
+	b += 2
+
+	v.Vtotal = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	v.Flags = ModeFlag(order.Get32(buf[b:]))
+	b += 4
+
+	b += 12 // padding
+
+	v.Privsize = order.Get32(buf[b:])
+	b += 4
+
+	v.AfterDotclock = Dotclock(order.Get32(buf[b:]))
+	b += 4
+
+	v.AfterHdisplay = order.Get16(buf[b:])
+	b += 2
+
+	v.AfterHsyncstart = order.Get16(buf[b:])
+	b += 2
+
+	v.AfterHsyncend = order.Get16(buf[b:])
+	b += 2
+
+	v.AfterHtotal = order.Get16(buf[b:])
+	b += 2
+
+	v.AfterHskew = order.Get16(buf[b:])
+	b += 2
+
+	v.AfterVdisplay = order.Get16(buf[b:])
+	b += 2
+
+	v.AfterVsyncstart = order.Get16(buf[b:])
+	b += 2
+
+	v.AfterVsyncend = order.Get16(buf[b:])
+	b += 2
+
+	v.AfterVtotal = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	v.AfterFlags = ModeFlag(order.Get32(buf[b:]))
+	b += 4
+
+	b += 12 // padding
+
+	v.Private = make([]byte, v.Privsize)
+	copy(v.Private[:v.Privsize], buf[b:])
+	b += int(v.Privsize)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"][7] = xgb.RequestInfo{
+		Name: "AddModeLine",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return addModeLineRequestRead(buf, order)
+		},
+	}
+}
+
 // DeleteModeLineCookie is a cookie used only for DeleteModeLine requests.
 type DeleteModeLineCookie struct {
 	*xgb.Cookie
@@ -693,7 +949,7 @@ type DeleteModeLineCookie struct {
 
 // DeleteModeLine sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func DeleteModeLine(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags uint32, Privsize uint32, Private []byte) DeleteModeLineCookie {
+func DeleteModeLine(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags ModeFlag, Privsize uint32, Private []byte) DeleteModeLineCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["XFree86-VidModeExtension"]; !ok {
@@ -706,7 +962,7 @@ func DeleteModeLine(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint
 
 // DeleteModeLineChecked sends a checked request.
 // If an error occurs, it can be retrieved using DeleteModeLineCookie.Check()
-func DeleteModeLineChecked(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags uint32, Privsize uint32, Private []byte) DeleteModeLineCookie {
+func DeleteModeLineChecked(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags ModeFlag, Privsize uint32, Private []byte) DeleteModeLineCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["XFree86-VidModeExtension"]; !ok {
@@ -723,12 +979,26 @@ func (cook DeleteModeLineCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DeleteModeLineCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DeleteModeLine
 // deleteModeLineRequest writes a DeleteModeLine request to a byte slice.
-func deleteModeLineRequest(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags uint32, Privsize uint32, Private []byte) []byte {
+func deleteModeLineRequest(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags ModeFlag, Privsize uint32, Private []byte) []byte {
+	return AppendDeleteModeLineRequest(nil, c, Screen, Dotclock, Hdisplay, Hsyncstart, Hsyncend, Htotal, Hskew, Vdisplay, Vsyncstart, Vsyncend, Vtotal, Flags, Privsize, Private)
+}
+
+// AppendDeleteModeLineRequest appends a DeleteModeLine request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDeleteModeLineRequest(buf []byte, c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags ModeFlag, Privsize uint32, Private []byte) []byte {
 	size := xgb.Pad((52 + xgb.Pad((int(Privsize) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-VidModeExtension"]
@@ -738,50 +1008,50 @@ func deleteModeLineRequest(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdispl
 	buf[b] = 8 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Dotclock))
+	order.Put32(buf[b:], uint32(Dotclock))
 	b += 4
 
-	xgb.Put16(buf[b:], Hdisplay)
+	order.Put16(buf[b:], Hdisplay)
 	b += 2
 
-	xgb.Put16(buf[b:], Hsyncstart)
+	order.Put16(buf[b:], Hsyncstart)
 	b += 2
 
-	xgb.Put16(buf[b:], Hsyncend)
+	order.Put16(buf[b:], Hsyncend)
 	b += 2
 
-	xgb.Put16(buf[b:], Htotal)
+	order.Put16(buf[b:], Htotal)
 	b += 2
 
-	xgb.Put16(buf[b:], Hskew)
+	order.Put16(buf[b:], Hskew)
 	b += 2
 
-	xgb.Put16(buf[b:], Vdisplay)
+	order.Put16(buf[b:], Vdisplay)
 	b += 2
 
-	xgb.Put16(buf[b:], Vsyncstart)
+	order.Put16(buf[b:], Vsyncstart)
 	b += 2
 
-	xgb.Put16(buf[b:], Vsyncend)
+	order.Put16(buf[b:], Vsyncend)
 	b += 2
 
-	xgb.Put16(buf[b:], Vtotal)
+	order.Put16(buf[b:], Vtotal)
 	b += 2
 
 	b += 2 // padding
 
-	xgb.Put32(buf[b:], Flags)
+	order.Put32(buf[b:], uint32(Flags))
 	b += 4
 
 	b += 12 // padding
 
-	xgb.Put32(buf[b:], Privsize)
+	order.Put32(buf[b:], Privsize)
 	b += 4
 
 	copy(buf[b:], Private[:Privsize])
@@ -790,6 +1060,90 @@ func deleteModeLineRequest(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdispl
 	return buf
 }
 
+// DeleteModeLineRequest represents the data of a DeleteModeLine request, as it is read when tracing a connection.
+type DeleteModeLineRequest struct {
+	Screen     uint32
+	Dotclock   Dotclock
+	Hdisplay   uint16
+	Hsyncstart uint16
+	Hsyncend   uint16
+	Htotal     uint16
+	Hskew      uint16
+	Vdisplay   uint16
+	Vsyncstart uint16
+	Vsyncend   uint16
+	Vtotal     uint16
+	// padding: 2 bytes
+	Flags ModeFlag
+	// padding: 12 bytes
+	Privsize uint32
+	Private  []byte // size: xgb.Pad((int(Privsize) * 1))
+}
+
+// deleteModeLineRequestRead reads a byte slice in the byte order 'order' into a DeleteModeLineRequest value.
+func deleteModeLineRequestRead(buf []byte, order xgb.ByteOrder) *DeleteModeLineRequest {
+	v := new(DeleteModeLineRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	v.Dotclock = Dotclock(order.Get32(buf[b:]))
+	b += 4
+
+	v.Hdisplay = order.Get16(buf[b:])
+	b += 2
+
+	v.Hsyncstart = order.Get16(buf[b:])
+	b += 2
+
+	v.Hsyncend = order.Get16(buf[b:])
+	b += 2
+
+	v.Htotal = order.Get16(buf[b:])
+	b += 2
+
+	v.Hskew = order.Get16(buf[b:])
+	b += 2
+
+	v.Vdisplay = order.Get16(buf[b:])
+	b += 2
+
+	v.Vsyncstart = order.Get16(buf[b:])
+	b += 2
+
+	v.Vsyncend = order.Get16(buf[b:])
+	b += 2
+
+	v.Vtotal = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	v.Flags = ModeFlag(order.Get32(buf[b:]))
+	b += 4
+
+	b += 12 // padding
+
+	v.Privsize = order.Get32(buf[b:])
+	b += 4
+
+	v.Private = make([]byte, v.Privsize)
+	copy(v.Private[:v.Privsize], buf[b:])
+	b += int(v.Privsize)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"][8] = xgb.RequestInfo{
+		Name: "DeleteModeLine",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return deleteModeLineRequestRead(buf, order)
+		},
+	}
+}
+
 // GetAllModeLinesCookie is a cookie used only for GetAllModeLines requests.
 type GetAllModeLinesCookie struct {
 	*xgb.Cookie
@@ -840,29 +1194,41 @@ func (cook GetAllModeLinesCookie) Reply() (*GetAllModeLinesReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getAllModeLinesReply(buf), nil
+	return getAllModeLinesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetAllModeLines request arrives.
+func (cook GetAllModeLinesCookie) ReplyContext(ctx context.Context) (*GetAllModeLinesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getAllModeLinesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getAllModeLinesReply reads a byte slice into a GetAllModeLinesReply value.
-func getAllModeLinesReply(buf []byte) *GetAllModeLinesReply {
+// getAllModeLinesReply reads a byte slice in the byte order 'order' into a GetAllModeLinesReply value.
+func getAllModeLinesReply(buf []byte, order xgb.ByteOrder) *GetAllModeLinesReply {
 	v := new(GetAllModeLinesReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Modecount = xgb.Get32(buf[b:])
+	v.Modecount = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
 
 	v.Modeinfo = make([]ModeInfo, v.Modecount)
-	b += ModeInfoReadList(buf[b:], v.Modeinfo)
+	b += ModeInfoReadList(buf[b:], order, v.Modeinfo)
 
 	return v
 }
@@ -870,9 +1236,18 @@ func getAllModeLinesReply(buf []byte) *GetAllModeLinesReply {
 // Write request to wire for GetAllModeLines
 // getAllModeLinesRequest writes a GetAllModeLines request to a byte slice.
 func getAllModeLinesRequest(c *xgb.Conn, Screen uint16) []byte {
+	return AppendGetAllModeLinesRequest(nil, c, Screen)
+}
+
+// AppendGetAllModeLinesRequest appends a GetAllModeLines request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetAllModeLinesRequest(buf []byte, c *xgb.Conn, Screen uint16) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-VidModeExtension"]
@@ -882,10 +1257,10 @@ func getAllModeLinesRequest(c *xgb.Conn, Screen uint16) []byte {
 	buf[b] = 6 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], Screen)
+	order.Put16(buf[b:], Screen)
 	b += 2
 
 	b += 2 // padding
@@ -893,6 +1268,37 @@ func getAllModeLinesRequest(c *xgb.Conn, Screen uint16) []byte {
 	return buf
 }
 
+// GetAllModeLinesRequest represents the data of a GetAllModeLines request, as it is read when tracing a connection.
+type GetAllModeLinesRequest struct {
+	Screen uint16
+	// padding: 2 bytes
+}
+
+// getAllModeLinesRequestRead reads a byte slice in the byte order 'order' into a GetAllModeLinesRequest value.
+func getAllModeLinesRequestRead(buf []byte, order xgb.ByteOrder) *GetAllModeLinesRequest {
+	v := new(GetAllModeLinesRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"][6] = xgb.RequestInfo{
+		Name: "GetAllModeLines",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getAllModeLinesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getAllModeLinesReply(buf, order)
+		},
+	}
+}
+
 // GetDotClocksCookie is a cookie used only for GetDotClocks requests.
 type GetDotClocksCookie struct {
 	*xgb.Cookie
@@ -929,7 +1335,7 @@ type GetDotClocksReply struct {
 	Sequence uint16 // sequence number of the request for this reply
 	Length   uint32 // number of bytes in this reply
 	// padding: 1 bytes
-	Flags     uint32
+	Flags     ClockFlag
 	Clocks    uint32
 	Maxclocks uint32
 	// padding: 12 bytes
@@ -945,36 +1351,48 @@ func (cook GetDotClocksCookie) Reply() (*GetDotClocksReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getDotClocksReply(buf), nil
+	return getDotClocksReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetDotClocks request arrives.
+func (cook GetDotClocksCookie) ReplyContext(ctx context.Context) (*GetDotClocksReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getDotClocksReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getDotClocksReply reads a byte slice into a GetDotClocksReply value.
-func getDotClocksReply(buf []byte) *GetDotClocksReply {
+// getDotClocksReply reads a byte slice in the byte order 'order' into a GetDotClocksReply value.
+func getDotClocksReply(buf []byte, order xgb.ByteOrder) *GetDotClocksReply {
 	v := new(GetDotClocksReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Flags = xgb.Get32(buf[b:])
+	v.Flags = ClockFlag(order.Get32(buf[b:]))
 	b += 4
 
-	v.Clocks = xgb.Get32(buf[b:])
+	v.Clocks = order.Get32(buf[b:])
 	b += 4
 
-	v.Maxclocks = xgb.Get32(buf[b:])
+	v.Maxclocks = order.Get32(buf[b:])
 	b += 4
 
 	b += 12 // padding
 
 	v.Clock = make([]uint32, ((1 - (int(v.Flags) & 1)) * int(v.Clocks)))
 	for i := 0; i < int(((1 - (int(v.Flags) & 1)) * int(v.Clocks))); i++ {
-		v.Clock[i] = xgb.Get32(buf[b:])
+		v.Clock[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -984,9 +1402,18 @@ func getDotClocksReply(buf []byte) *GetDotClocksReply {
 // Write request to wire for GetDotClocks
 // getDotClocksRequest writes a GetDotClocks request to a byte slice.
 func getDotClocksRequest(c *xgb.Conn, Screen uint16) []byte {
+	return AppendGetDotClocksRequest(nil, c, Screen)
+}
+
+// AppendGetDotClocksRequest appends a GetDotClocks request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetDotClocksRequest(buf []byte, c *xgb.Conn, Screen uint16) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-VidModeExtension"]
@@ -996,10 +1423,10 @@ func getDotClocksRequest(c *xgb.Conn, Screen uint16) []byte {
 	buf[b] = 13 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], Screen)
+	order.Put16(buf[b:], Screen)
 	b += 2
 
 	b += 2 // padding
@@ -1007,6 +1434,37 @@ func getDotClocksRequest(c *xgb.Conn, Screen uint16) []byte {
 	return buf
 }
 
+// GetDotClocksRequest represents the data of a GetDotClocks request, as it is read when tracing a connection.
+type GetDotClocksRequest struct {
+	Screen uint16
+	// padding: 2 bytes
+}
+
+// getDotClocksRequestRead reads a byte slice in the byte order 'order' into a GetDotClocksRequest value.
+func getDotClocksRequestRead(buf []byte, order xgb.ByteOrder) *GetDotClocksRequest {
+	v := new(GetDotClocksRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"][13] = xgb.RequestInfo{
+		Name: "GetDotClocks",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getDotClocksRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getDotClocksReply(buf, order)
+		},
+	}
+}
+
 // GetGammaCookie is a cookie used only for GetGamma requests.
 type GetGammaCookie struct {
 	*xgb.Cookie
@@ -1058,29 +1516,41 @@ func (cook GetGammaCookie) Reply() (*GetGammaReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getGammaReply(buf), nil
+	return getGammaReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetGamma request arrives.
+func (cook GetGammaCookie) ReplyContext(ctx context.Context) (*GetGammaReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getGammaReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getGammaReply reads a byte slice into a GetGammaReply value.
-func getGammaReply(buf []byte) *GetGammaReply {
+// getGammaReply reads a byte slice in the byte order 'order' into a GetGammaReply value.
+func getGammaReply(buf []byte, order xgb.ByteOrder) *GetGammaReply {
 	v := new(GetGammaReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Red = xgb.Get32(buf[b:])
+	v.Red = order.Get32(buf[b:])
 	b += 4
 
-	v.Green = xgb.Get32(buf[b:])
+	v.Green = order.Get32(buf[b:])
 	b += 4
 
-	v.Blue = xgb.Get32(buf[b:])
+	v.Blue = order.Get32(buf[b:])
 	b += 4
 
 	b += 12 // padding
@@ -1091,9 +1561,18 @@ func getGammaReply(buf []byte) *GetGammaReply {
 // Write request to wire for GetGamma
 // getGammaRequest writes a GetGamma request to a byte slice.
 func getGammaRequest(c *xgb.Conn, Screen uint16) []byte {
+	return AppendGetGammaRequest(nil, c, Screen)
+}
+
+// AppendGetGammaRequest appends a GetGamma request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetGammaRequest(buf []byte, c *xgb.Conn, Screen uint16) []byte {
 	size := 32
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-VidModeExtension"]
@@ -1103,10 +1582,10 @@ func getGammaRequest(c *xgb.Conn, Screen uint16) []byte {
 	buf[b] = 16 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], Screen)
+	order.Put16(buf[b:], Screen)
 	b += 2
 
 	b += 26 // padding
@@ -1114,14 +1593,45 @@ func getGammaRequest(c *xgb.Conn, Screen uint16) []byte {
 	return buf
 }
 
-// GetGammaRampCookie is a cookie used only for GetGammaRamp requests.
-type GetGammaRampCookie struct {
-	*xgb.Cookie
+// GetGammaRequest represents the data of a GetGamma request, as it is read when tracing a connection.
+type GetGammaRequest struct {
+	Screen uint16
+	// padding: 26 bytes
 }
 
-// GetGammaRamp sends a checked request.
-// If an error occurs, it will be returned with the reply by calling GetGammaRampCookie.Reply()
-func GetGammaRamp(c *xgb.Conn, Screen uint16, Size uint16) GetGammaRampCookie {
+// getGammaRequestRead reads a byte slice in the byte order 'order' into a GetGammaRequest value.
+func getGammaRequestRead(buf []byte, order xgb.ByteOrder) *GetGammaRequest {
+	v := new(GetGammaRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get16(buf[b:])
+	b += 2
+
+	b += 26 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"][16] = xgb.RequestInfo{
+		Name: "GetGamma",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getGammaRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getGammaReply(buf, order)
+		},
+	}
+}
+
+// GetGammaRampCookie is a cookie used only for GetGammaRamp requests.
+type GetGammaRampCookie struct {
+	*xgb.Cookie
+}
+
+// GetGammaRamp sends a checked request.
+// If an error occurs, it will be returned with the reply by calling GetGammaRampCookie.Reply()
+func GetGammaRamp(c *xgb.Conn, Screen uint16, Size uint16) GetGammaRampCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["XFree86-VidModeExtension"]; !ok {
@@ -1168,30 +1678,42 @@ func (cook GetGammaRampCookie) Reply() (*GetGammaRampReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getGammaRampReply(buf), nil
+	return getGammaRampReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetGammaRamp request arrives.
+func (cook GetGammaRampCookie) ReplyContext(ctx context.Context) (*GetGammaRampReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getGammaRampReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getGammaRampReply reads a byte slice into a GetGammaRampReply value.
-func getGammaRampReply(buf []byte) *GetGammaRampReply {
+// getGammaRampReply reads a byte slice in the byte order 'order' into a GetGammaRampReply value.
+func getGammaRampReply(buf []byte, order xgb.ByteOrder) *GetGammaRampReply {
 	v := new(GetGammaRampReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Size = xgb.Get16(buf[b:])
+	v.Size = order.Get16(buf[b:])
 	b += 2
 
 	b += 22 // padding
 
 	v.Red = make([]uint16, ((int(v.Size) + 1) & -2))
 	for i := 0; i < int(((int(v.Size) + 1) & -2)); i++ {
-		v.Red[i] = xgb.Get16(buf[b:])
+		v.Red[i] = order.Get16(buf[b:])
 		b += 2
 	}
 
@@ -1199,7 +1721,7 @@ func getGammaRampReply(buf []byte) *GetGammaRampReply {
 
 	v.Green = make([]uint16, ((int(v.Size) + 1) & -2))
 	for i := 0; i < int(((int(v.Size) + 1) & -2)); i++ {
-		v.Green[i] = xgb.Get16(buf[b:])
+		v.Green[i] = order.Get16(buf[b:])
 		b += 2
 	}
 
@@ -1207,7 +1729,7 @@ func getGammaRampReply(buf []byte) *GetGammaRampReply {
 
 	v.Blue = make([]uint16, ((int(v.Size) + 1) & -2))
 	for i := 0; i < int(((int(v.Size) + 1) & -2)); i++ {
-		v.Blue[i] = xgb.Get16(buf[b:])
+		v.Blue[i] = order.Get16(buf[b:])
 		b += 2
 	}
 
@@ -1217,9 +1739,18 @@ func getGammaRampReply(buf []byte) *GetGammaRampReply {
 // Write request to wire for GetGammaRamp
 // getGammaRampRequest writes a GetGammaRamp request to a byte slice.
 func getGammaRampRequest(c *xgb.Conn, Screen uint16, Size uint16) []byte {
+	return AppendGetGammaRampRequest(nil, c, Screen, Size)
+}
+
+// AppendGetGammaRampRequest appends a GetGammaRamp request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetGammaRampRequest(buf []byte, c *xgb.Conn, Screen uint16, Size uint16) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-VidModeExtension"]
@@ -1229,18 +1760,50 @@ func getGammaRampRequest(c *xgb.Conn, Screen uint16, Size uint16) []byte {
 	buf[b] = 17 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], Screen)
+	order.Put16(buf[b:], Screen)
 	b += 2
 
-	xgb.Put16(buf[b:], Size)
+	order.Put16(buf[b:], Size)
 	b += 2
 
 	return buf
 }
 
+// GetGammaRampRequest represents the data of a GetGammaRamp request, as it is read when tracing a connection.
+type GetGammaRampRequest struct {
+	Screen uint16
+	Size   uint16
+}
+
+// getGammaRampRequestRead reads a byte slice in the byte order 'order' into a GetGammaRampRequest value.
+func getGammaRampRequestRead(buf []byte, order xgb.ByteOrder) *GetGammaRampRequest {
+	v := new(GetGammaRampRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get16(buf[b:])
+	b += 2
+
+	v.Size = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"][17] = xgb.RequestInfo{
+		Name: "GetGammaRamp",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getGammaRampRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getGammaRampReply(buf, order)
+		},
+	}
+}
+
 // GetGammaRampSizeCookie is a cookie used only for GetGammaRampSize requests.
 type GetGammaRampSizeCookie struct {
 	*xgb.Cookie
@@ -1290,23 +1853,35 @@ func (cook GetGammaRampSizeCookie) Reply() (*GetGammaRampSizeReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getGammaRampSizeReply(buf), nil
+	return getGammaRampSizeReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetGammaRampSize request arrives.
+func (cook GetGammaRampSizeCookie) ReplyContext(ctx context.Context) (*GetGammaRampSizeReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getGammaRampSizeReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getGammaRampSizeReply reads a byte slice into a GetGammaRampSizeReply value.
-func getGammaRampSizeReply(buf []byte) *GetGammaRampSizeReply {
+// getGammaRampSizeReply reads a byte slice in the byte order 'order' into a GetGammaRampSizeReply value.
+func getGammaRampSizeReply(buf []byte, order xgb.ByteOrder) *GetGammaRampSizeReply {
 	v := new(GetGammaRampSizeReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Size = xgb.Get16(buf[b:])
+	v.Size = order.Get16(buf[b:])
 	b += 2
 
 	b += 22 // padding
@@ -1317,9 +1892,18 @@ func getGammaRampSizeReply(buf []byte) *GetGammaRampSizeReply {
 // Write request to wire for GetGammaRampSize
 // getGammaRampSizeRequest writes a GetGammaRampSize request to a byte slice.
 func getGammaRampSizeRequest(c *xgb.Conn, Screen uint16) []byte {
+	return AppendGetGammaRampSizeRequest(nil, c, Screen)
+}
+
+// AppendGetGammaRampSizeRequest appends a GetGammaRampSize request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetGammaRampSizeRequest(buf []byte, c *xgb.Conn, Screen uint16) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-VidModeExtension"]
@@ -1329,10 +1913,10 @@ func getGammaRampSizeRequest(c *xgb.Conn, Screen uint16) []byte {
 	buf[b] = 19 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], Screen)
+	order.Put16(buf[b:], Screen)
 	b += 2
 
 	b += 2 // padding
@@ -1340,6 +1924,37 @@ func getGammaRampSizeRequest(c *xgb.Conn, Screen uint16) []byte {
 	return buf
 }
 
+// GetGammaRampSizeRequest represents the data of a GetGammaRampSize request, as it is read when tracing a connection.
+type GetGammaRampSizeRequest struct {
+	Screen uint16
+	// padding: 2 bytes
+}
+
+// getGammaRampSizeRequestRead reads a byte slice in the byte order 'order' into a GetGammaRampSizeRequest value.
+func getGammaRampSizeRequestRead(buf []byte, order xgb.ByteOrder) *GetGammaRampSizeRequest {
+	v := new(GetGammaRampSizeRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"][19] = xgb.RequestInfo{
+		Name: "GetGammaRampSize",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getGammaRampSizeRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getGammaRampSizeReply(buf, order)
+		},
+	}
+}
+
 // GetModeLineCookie is a cookie used only for GetModeLine requests.
 type GetModeLineCookie struct {
 	*xgb.Cookie
@@ -1387,7 +2002,7 @@ type GetModeLineReply struct {
 	Vsyncend   uint16
 	Vtotal     uint16
 	// padding: 2 bytes
-	Flags uint32
+	Flags ModeFlag
 	// padding: 12 bytes
 	Privsize uint32
 	Private  []byte // size: xgb.Pad((int(Privsize) * 1))
@@ -1402,60 +2017,72 @@ func (cook GetModeLineCookie) Reply() (*GetModeLineReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getModeLineReply(buf), nil
+	return getModeLineReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetModeLine request arrives.
+func (cook GetModeLineCookie) ReplyContext(ctx context.Context) (*GetModeLineReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getModeLineReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getModeLineReply reads a byte slice into a GetModeLineReply value.
-func getModeLineReply(buf []byte) *GetModeLineReply {
+// getModeLineReply reads a byte slice in the byte order 'order' into a GetModeLineReply value.
+func getModeLineReply(buf []byte, order xgb.ByteOrder) *GetModeLineReply {
 	v := new(GetModeLineReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Dotclock = Dotclock(xgb.Get32(buf[b:]))
+	v.Dotclock = Dotclock(order.Get32(buf[b:]))
 	b += 4
 
-	v.Hdisplay = xgb.Get16(buf[b:])
+	v.Hdisplay = order.Get16(buf[b:])
 	b += 2
 
-	v.Hsyncstart = xgb.Get16(buf[b:])
+	v.Hsyncstart = order.Get16(buf[b:])
 	b += 2
 
-	v.Hsyncend = xgb.Get16(buf[b:])
+	v.Hsyncend = order.Get16(buf[b:])
 	b += 2
 
-	v.Htotal = xgb.Get16(buf[b:])
+	v.Htotal = order.Get16(buf[b:])
 	b += 2
 
-	v.Hskew = xgb.Get16(buf[b:])
+	v.Hskew = order.Get16(buf[b:])
 	b += 2
 
-	v.Vdisplay = xgb.Get16(buf[b:])
+	v.Vdisplay = order.Get16(buf[b:])
 	b += 2
 
-	v.Vsyncstart = xgb.Get16(buf[b:])
+	v.Vsyncstart = order.Get16(buf[b:])
 	b += 2
 
-	v.Vsyncend = xgb.Get16(buf[b:])
+	v.Vsyncend = order.Get16(buf[b:])
 	b += 2
 
-	v.Vtotal = xgb.Get16(buf[b:])
+	v.Vtotal = order.Get16(buf[b:])
 	b += 2
 
 	b += 2 // padding
 
-	v.Flags = xgb.Get32(buf[b:])
+	v.Flags = ModeFlag(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
 
-	v.Privsize = xgb.Get32(buf[b:])
+	v.Privsize = order.Get32(buf[b:])
 	b += 4
 
 	v.Private = make([]byte, v.Privsize)
@@ -1468,9 +2095,18 @@ func getModeLineReply(buf []byte) *GetModeLineReply {
 // Write request to wire for GetModeLine
 // getModeLineRequest writes a GetModeLine request to a byte slice.
 func getModeLineRequest(c *xgb.Conn, Screen uint16) []byte {
+	return AppendGetModeLineRequest(nil, c, Screen)
+}
+
+// AppendGetModeLineRequest appends a GetModeLine request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetModeLineRequest(buf []byte, c *xgb.Conn, Screen uint16) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-VidModeExtension"]
@@ -1480,10 +2116,10 @@ func getModeLineRequest(c *xgb.Conn, Screen uint16) []byte {
 	buf[b] = 1 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], Screen)
+	order.Put16(buf[b:], Screen)
 	b += 2
 
 	b += 2 // padding
@@ -1491,6 +2127,37 @@ func getModeLineRequest(c *xgb.Conn, Screen uint16) []byte {
 	return buf
 }
 
+// GetModeLineRequest represents the data of a GetModeLine request, as it is read when tracing a connection.
+type GetModeLineRequest struct {
+	Screen uint16
+	// padding: 2 bytes
+}
+
+// getModeLineRequestRead reads a byte slice in the byte order 'order' into a GetModeLineRequest value.
+func getModeLineRequestRead(buf []byte, order xgb.ByteOrder) *GetModeLineRequest {
+	v := new(GetModeLineRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"][1] = xgb.RequestInfo{
+		Name: "GetModeLine",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getModeLineRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getModeLineReply(buf, order)
+		},
+	}
+}
+
 // GetMonitorCookie is a cookie used only for GetMonitor requests.
 type GetMonitorCookie struct {
 	*xgb.Cookie
@@ -1549,20 +2216,32 @@ func (cook GetMonitorCookie) Reply() (*GetMonitorReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getMonitorReply(buf), nil
+	return getMonitorReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetMonitor request arrives.
+func (cook GetMonitorCookie) ReplyContext(ctx context.Context) (*GetMonitorReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getMonitorReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getMonitorReply reads a byte slice into a GetMonitorReply value.
-func getMonitorReply(buf []byte) *GetMonitorReply {
+// getMonitorReply reads a byte slice in the byte order 'order' into a GetMonitorReply value.
+func getMonitorReply(buf []byte, order xgb.ByteOrder) *GetMonitorReply {
 	v := new(GetMonitorReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	v.VendorLength = buf[b]
@@ -1581,7 +2260,7 @@ func getMonitorReply(buf []byte) *GetMonitorReply {
 
 	v.Hsync = make([]Syncrange, v.NumHsync)
 	for i := 0; i < int(v.NumHsync); i++ {
-		v.Hsync[i] = Syncrange(xgb.Get32(buf[b:]))
+		v.Hsync[i] = Syncrange(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -1589,7 +2268,7 @@ func getMonitorReply(buf []byte) *GetMonitorReply {
 
 	v.Vsync = make([]Syncrange, v.NumVsync)
 	for i := 0; i < int(v.NumVsync); i++ {
-		v.Vsync[i] = Syncrange(xgb.Get32(buf[b:]))
+		v.Vsync[i] = Syncrange(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -1617,9 +2296,18 @@ func getMonitorReply(buf []byte) *GetMonitorReply {
 // Write request to wire for GetMonitor
 // getMonitorRequest writes a GetMonitor request to a byte slice.
 func getMonitorRequest(c *xgb.Conn, Screen uint16) []byte {
+	return AppendGetMonitorRequest(nil, c, Screen)
+}
+
+// AppendGetMonitorRequest appends a GetMonitor request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetMonitorRequest(buf []byte, c *xgb.Conn, Screen uint16) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-VidModeExtension"]
@@ -1629,10 +2317,10 @@ func getMonitorRequest(c *xgb.Conn, Screen uint16) []byte {
 	buf[b] = 4 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], Screen)
+	order.Put16(buf[b:], Screen)
 	b += 2
 
 	b += 2 // padding
@@ -1640,6 +2328,37 @@ func getMonitorRequest(c *xgb.Conn, Screen uint16) []byte {
 	return buf
 }
 
+// GetMonitorRequest represents the data of a GetMonitor request, as it is read when tracing a connection.
+type GetMonitorRequest struct {
+	Screen uint16
+	// padding: 2 bytes
+}
+
+// getMonitorRequestRead reads a byte slice in the byte order 'order' into a GetMonitorRequest value.
+func getMonitorRequestRead(buf []byte, order xgb.ByteOrder) *GetMonitorRequest {
+	v := new(GetMonitorRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"][4] = xgb.RequestInfo{
+		Name: "GetMonitor",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMonitorRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMonitorReply(buf, order)
+		},
+	}
+}
+
 // GetPermissionsCookie is a cookie used only for GetPermissions requests.
 type GetPermissionsCookie struct {
 	*xgb.Cookie
@@ -1676,7 +2395,7 @@ type GetPermissionsReply struct {
 	Sequence uint16 // sequence number of the request for this reply
 	Length   uint32 // number of bytes in this reply
 	// padding: 1 bytes
-	Permissions uint32
+	Permissions Permission
 	// padding: 20 bytes
 }
 
@@ -1689,23 +2408,35 @@ func (cook GetPermissionsCookie) Reply() (*GetPermissionsReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getPermissionsReply(buf), nil
+	return getPermissionsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetPermissions request arrives.
+func (cook GetPermissionsCookie) ReplyContext(ctx context.Context) (*GetPermissionsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getPermissionsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getPermissionsReply reads a byte slice into a GetPermissionsReply value.
-func getPermissionsReply(buf []byte) *GetPermissionsReply {
+// getPermissionsReply reads a byte slice in the byte order 'order' into a GetPermissionsReply value.
+func getPermissionsReply(buf []byte, order xgb.ByteOrder) *GetPermissionsReply {
 	v := new(GetPermissionsReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Permissions = xgb.Get32(buf[b:])
+	v.Permissions = Permission(order.Get32(buf[b:]))
 	b += 4
 
 	b += 20 // padding
@@ -1716,9 +2447,18 @@ func getPermissionsReply(buf []byte) *GetPermissionsReply {
 // Write request to wire for GetPermissions
 // getPermissionsRequest writes a GetPermissions request to a byte slice.
 func getPermissionsRequest(c *xgb.Conn, Screen uint16) []byte {
+	return AppendGetPermissionsRequest(nil, c, Screen)
+}
+
+// AppendGetPermissionsRequest appends a GetPermissions request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetPermissionsRequest(buf []byte, c *xgb.Conn, Screen uint16) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-VidModeExtension"]
@@ -1728,10 +2468,10 @@ func getPermissionsRequest(c *xgb.Conn, Screen uint16) []byte {
 	buf[b] = 20 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], Screen)
+	order.Put16(buf[b:], Screen)
 	b += 2
 
 	b += 2 // padding
@@ -1739,6 +2479,37 @@ func getPermissionsRequest(c *xgb.Conn, Screen uint16) []byte {
 	return buf
 }
 
+// GetPermissionsRequest represents the data of a GetPermissions request, as it is read when tracing a connection.
+type GetPermissionsRequest struct {
+	Screen uint16
+	// padding: 2 bytes
+}
+
+// getPermissionsRequestRead reads a byte slice in the byte order 'order' into a GetPermissionsRequest value.
+func getPermissionsRequestRead(buf []byte, order xgb.ByteOrder) *GetPermissionsRequest {
+	v := new(GetPermissionsRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"][20] = xgb.RequestInfo{
+		Name: "GetPermissions",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPermissionsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPermissionsReply(buf, order)
+		},
+	}
+}
+
 // GetViewPortCookie is a cookie used only for GetViewPort requests.
 type GetViewPortCookie struct {
 	*xgb.Cookie
@@ -1789,26 +2560,38 @@ func (cook GetViewPortCookie) Reply() (*GetViewPortReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getViewPortReply(buf), nil
+	return getViewPortReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetViewPort request arrives.
+func (cook GetViewPortCookie) ReplyContext(ctx context.Context) (*GetViewPortReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getViewPortReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getViewPortReply reads a byte slice into a GetViewPortReply value.
-func getViewPortReply(buf []byte) *GetViewPortReply {
+// getViewPortReply reads a byte slice in the byte order 'order' into a GetViewPortReply value.
+func getViewPortReply(buf []byte, order xgb.ByteOrder) *GetViewPortReply {
 	v := new(GetViewPortReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.X = xgb.Get32(buf[b:])
+	v.X = order.Get32(buf[b:])
 	b += 4
 
-	v.Y = xgb.Get32(buf[b:])
+	v.Y = order.Get32(buf[b:])
 	b += 4
 
 	b += 16 // padding
@@ -1819,9 +2602,18 @@ func getViewPortReply(buf []byte) *GetViewPortReply {
 // Write request to wire for GetViewPort
 // getViewPortRequest writes a GetViewPort request to a byte slice.
 func getViewPortRequest(c *xgb.Conn, Screen uint16) []byte {
+	return AppendGetViewPortRequest(nil, c, Screen)
+}
+
+// AppendGetViewPortRequest appends a GetViewPort request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetViewPortRequest(buf []byte, c *xgb.Conn, Screen uint16) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-VidModeExtension"]
@@ -1831,10 +2623,10 @@ func getViewPortRequest(c *xgb.Conn, Screen uint16) []byte {
 	buf[b] = 11 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], Screen)
+	order.Put16(buf[b:], Screen)
 	b += 2
 
 	b += 2 // padding
@@ -1842,6 +2634,37 @@ func getViewPortRequest(c *xgb.Conn, Screen uint16) []byte {
 	return buf
 }
 
+// GetViewPortRequest represents the data of a GetViewPort request, as it is read when tracing a connection.
+type GetViewPortRequest struct {
+	Screen uint16
+	// padding: 2 bytes
+}
+
+// getViewPortRequestRead reads a byte slice in the byte order 'order' into a GetViewPortRequest value.
+func getViewPortRequestRead(buf []byte, order xgb.ByteOrder) *GetViewPortRequest {
+	v := new(GetViewPortRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"][11] = xgb.RequestInfo{
+		Name: "GetViewPort",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getViewPortRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getViewPortReply(buf, order)
+		},
+	}
+}
+
 // LockModeSwitchCookie is a cookie used only for LockModeSwitch requests.
 type LockModeSwitchCookie struct {
 	*xgb.Cookie
@@ -1879,12 +2702,26 @@ func (cook LockModeSwitchCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook LockModeSwitchCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for LockModeSwitch
 // lockModeSwitchRequest writes a LockModeSwitch request to a byte slice.
 func lockModeSwitchRequest(c *xgb.Conn, Screen uint16, Lock uint16) []byte {
+	return AppendLockModeSwitchRequest(nil, c, Screen, Lock)
+}
+
+// AppendLockModeSwitchRequest appends a LockModeSwitch request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendLockModeSwitchRequest(buf []byte, c *xgb.Conn, Screen uint16, Lock uint16) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-VidModeExtension"]
@@ -1894,18 +2731,47 @@ func lockModeSwitchRequest(c *xgb.Conn, Screen uint16, Lock uint16) []byte {
 	buf[b] = 5 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], Screen)
+	order.Put16(buf[b:], Screen)
 	b += 2
 
-	xgb.Put16(buf[b:], Lock)
+	order.Put16(buf[b:], Lock)
 	b += 2
 
 	return buf
 }
 
+// LockModeSwitchRequest represents the data of a LockModeSwitch request, as it is read when tracing a connection.
+type LockModeSwitchRequest struct {
+	Screen uint16
+	Lock   uint16
+}
+
+// lockModeSwitchRequestRead reads a byte slice in the byte order 'order' into a LockModeSwitchRequest value.
+func lockModeSwitchRequestRead(buf []byte, order xgb.ByteOrder) *LockModeSwitchRequest {
+	v := new(LockModeSwitchRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get16(buf[b:])
+	b += 2
+
+	v.Lock = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"][5] = xgb.RequestInfo{
+		Name: "LockModeSwitch",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return lockModeSwitchRequestRead(buf, order)
+		},
+	}
+}
+
 // ModModeLineCookie is a cookie used only for ModModeLine requests.
 type ModModeLineCookie struct {
 	*xgb.Cookie
@@ -1913,7 +2779,7 @@ type ModModeLineCookie struct {
 
 // ModModeLine sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func ModModeLine(c *xgb.Conn, Screen uint32, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags uint32, Privsize uint32, Private []byte) ModModeLineCookie {
+func ModModeLine(c *xgb.Conn, Screen uint32, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags ModeFlag, Privsize uint32, Private []byte) ModModeLineCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["XFree86-VidModeExtension"]; !ok {
@@ -1926,7 +2792,7 @@ func ModModeLine(c *xgb.Conn, Screen uint32, Hdisplay uint16, Hsyncstart uint16,
 
 // ModModeLineChecked sends a checked request.
 // If an error occurs, it can be retrieved using ModModeLineCookie.Check()
-func ModModeLineChecked(c *xgb.Conn, Screen uint32, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags uint32, Privsize uint32, Private []byte) ModModeLineCookie {
+func ModModeLineChecked(c *xgb.Conn, Screen uint32, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags ModeFlag, Privsize uint32, Private []byte) ModModeLineCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["XFree86-VidModeExtension"]; !ok {
@@ -1943,12 +2809,26 @@ func (cook ModModeLineCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ModModeLineCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ModModeLine
 // modModeLineRequest writes a ModModeLine request to a byte slice.
-func modModeLineRequest(c *xgb.Conn, Screen uint32, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags uint32, Privsize uint32, Private []byte) []byte {
+func modModeLineRequest(c *xgb.Conn, Screen uint32, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags ModeFlag, Privsize uint32, Private []byte) []byte {
+	return AppendModModeLineRequest(nil, c, Screen, Hdisplay, Hsyncstart, Hsyncend, Htotal, Hskew, Vdisplay, Vsyncstart, Vsyncend, Vtotal, Flags, Privsize, Private)
+}
+
+// AppendModModeLineRequest appends a ModModeLine request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendModModeLineRequest(buf []byte, c *xgb.Conn, Screen uint32, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags ModeFlag, Privsize uint32, Private []byte) []byte {
 	size := xgb.Pad((48 + xgb.Pad((int(Privsize) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-VidModeExtension"]
@@ -1958,47 +2838,47 @@ func modModeLineRequest(c *xgb.Conn, Screen uint32, Hdisplay uint16, Hsyncstart
 	buf[b] = 2 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
-	xgb.Put16(buf[b:], Hdisplay)
+	order.Put16(buf[b:], Hdisplay)
 	b += 2
 
-	xgb.Put16(buf[b:], Hsyncstart)
+	order.Put16(buf[b:], Hsyncstart)
 	b += 2
 
-	xgb.Put16(buf[b:], Hsyncend)
+	order.Put16(buf[b:], Hsyncend)
 	b += 2
 
-	xgb.Put16(buf[b:], Htotal)
+	order.Put16(buf[b:], Htotal)
 	b += 2
 
-	xgb.Put16(buf[b:], Hskew)
+	order.Put16(buf[b:], Hskew)
 	b += 2
 
-	xgb.Put16(buf[b:], Vdisplay)
+	order.Put16(buf[b:], Vdisplay)
 	b += 2
 
-	xgb.Put16(buf[b:], Vsyncstart)
+	order.Put16(buf[b:], Vsyncstart)
 	b += 2
 
-	xgb.Put16(buf[b:], Vsyncend)
+	order.Put16(buf[b:], Vsyncend)
 	b += 2
 
-	xgb.Put16(buf[b:], Vtotal)
+	order.Put16(buf[b:], Vtotal)
 	b += 2
 
 	b += 2 // padding
 
-	xgb.Put32(buf[b:], Flags)
+	order.Put32(buf[b:], uint32(Flags))
 	b += 4
 
 	b += 12 // padding
 
-	xgb.Put32(buf[b:], Privsize)
+	order.Put32(buf[b:], Privsize)
 	b += 4
 
 	copy(buf[b:], Private[:Privsize])
@@ -2007,6 +2887,86 @@ func modModeLineRequest(c *xgb.Conn, Screen uint32, Hdisplay uint16, Hsyncstart
 	return buf
 }
 
+// ModModeLineRequest represents the data of a ModModeLine request, as it is read when tracing a connection.
+type ModModeLineRequest struct {
+	Screen     uint32
+	Hdisplay   uint16
+	Hsyncstart uint16
+	Hsyncend   uint16
+	Htotal     uint16
+	Hskew      uint16
+	Vdisplay   uint16
+	Vsyncstart uint16
+	Vsyncend   uint16
+	Vtotal     uint16
+	// padding: 2 bytes
+	Flags ModeFlag
+	// padding: 12 bytes
+	Privsize uint32
+	Private  []byte // size: xgb.Pad((int(Privsize) * 1))
+}
+
+// modModeLineRequestRead reads a byte slice in the byte order 'order' into a ModModeLineRequest value.
+func modModeLineRequestRead(buf []byte, order xgb.ByteOrder) *ModModeLineRequest {
+	v := new(ModModeLineRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	v.Hdisplay = order.Get16(buf[b:])
+	b += 2
+
+	v.Hsyncstart = order.Get16(buf[b:])
+	b += 2
+
+	v.Hsyncend = order.Get16(buf[b:])
+	b += 2
+
+	v.Htotal = order.Get16(buf[b:])
+	b += 2
+
+	v.Hskew = order.Get16(buf[b:])
+	b += 2
+
+	v.Vdisplay = order.Get16(buf[b:])
+	b += 2
+
+	v.Vsyncstart = order.Get16(buf[b:])
+	b += 2
+
+	v.Vsyncend = order.Get16(buf[b:])
+	b += 2
+
+	v.Vtotal = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	v.Flags = ModeFlag(order.Get32(buf[b:]))
+	b += 4
+
+	b += 12 // padding
+
+	v.Privsize = order.Get32(buf[b:])
+	b += 4
+
+	v.Private = make([]byte, v.Privsize)
+	copy(v.Private[:v.Privsize], buf[b:])
+	b += int(v.Privsize)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"][2] = xgb.RequestInfo{
+		Name: "ModModeLine",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return modModeLineRequestRead(buf, order)
+		},
+	}
+}
+
 // QueryVersionCookie is a cookie used only for QueryVersion requests.
 type QueryVersionCookie struct {
 	*xgb.Cookie
@@ -2056,26 +3016,38 @@ func (cook QueryVersionCookie) Reply() (*QueryVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryVersionReply(buf), nil
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryVersionReply reads a byte slice into a QueryVersionReply value.
-func queryVersionReply(buf []byte) *QueryVersionReply {
-	v := new(QueryVersionReply)
-	b := 1 // skip reply determinant
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryVersion request arrives.
+func (cook QueryVersionCookie) ReplyContext(ctx context.Context) (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryVersionReply reads a byte slice in the byte order 'order' into a QueryVersionReply value.
+func queryVersionReply(buf []byte, order xgb.ByteOrder) *QueryVersionReply {
+	v := new(QueryVersionReply)
+	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.MajorVersion = xgb.Get16(buf[b:])
+	v.MajorVersion = order.Get16(buf[b:])
 	b += 2
 
-	v.MinorVersion = xgb.Get16(buf[b:])
+	v.MinorVersion = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -2084,9 +3056,18 @@ func queryVersionReply(buf []byte) *QueryVersionReply {
 // Write request to wire for QueryVersion
 // queryVersionRequest writes a QueryVersion request to a byte slice.
 func queryVersionRequest(c *xgb.Conn) []byte {
+	return AppendQueryVersionRequest(nil, c)
+}
+
+// AppendQueryVersionRequest appends a QueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryVersionRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-VidModeExtension"]
@@ -2096,12 +3077,34 @@ func queryVersionRequest(c *xgb.Conn) []byte {
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// QueryVersionRequest represents the data of a QueryVersion request, as it is read when tracing a connection.
+type QueryVersionRequest struct {
+}
+
+// queryVersionRequestRead reads a byte slice in the byte order 'order' into a QueryVersionRequest value.
+func queryVersionRequestRead(buf []byte, order xgb.ByteOrder) *QueryVersionRequest {
+	v := new(QueryVersionRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"][0] = xgb.RequestInfo{
+		Name: "QueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionReply(buf, order)
+		},
+	}
+}
+
 // SetClientVersionCookie is a cookie used only for SetClientVersion requests.
 type SetClientVersionCookie struct {
 	*xgb.Cookie
@@ -2139,12 +3142,26 @@ func (cook SetClientVersionCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetClientVersionCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetClientVersion
 // setClientVersionRequest writes a SetClientVersion request to a byte slice.
 func setClientVersionRequest(c *xgb.Conn, Major uint16, Minor uint16) []byte {
+	return AppendSetClientVersionRequest(nil, c, Major, Minor)
+}
+
+// AppendSetClientVersionRequest appends a SetClientVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetClientVersionRequest(buf []byte, c *xgb.Conn, Major uint16, Minor uint16) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-VidModeExtension"]
@@ -2154,18 +3171,47 @@ func setClientVersionRequest(c *xgb.Conn, Major uint16, Minor uint16) []byte {
 	buf[b] = 14 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], Major)
+	order.Put16(buf[b:], Major)
 	b += 2
 
-	xgb.Put16(buf[b:], Minor)
+	order.Put16(buf[b:], Minor)
 	b += 2
 
 	return buf
 }
 
+// SetClientVersionRequest represents the data of a SetClientVersion request, as it is read when tracing a connection.
+type SetClientVersionRequest struct {
+	Major uint16
+	Minor uint16
+}
+
+// setClientVersionRequestRead reads a byte slice in the byte order 'order' into a SetClientVersionRequest value.
+func setClientVersionRequestRead(buf []byte, order xgb.ByteOrder) *SetClientVersionRequest {
+	v := new(SetClientVersionRequest)
+	b := 4 // skip request header
+
+	v.Major = order.Get16(buf[b:])
+	b += 2
+
+	v.Minor = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"][14] = xgb.RequestInfo{
+		Name: "SetClientVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setClientVersionRequestRead(buf, order)
+		},
+	}
+}
+
 // SetGammaCookie is a cookie used only for SetGamma requests.
 type SetGammaCookie struct {
 	*xgb.Cookie
@@ -2203,12 +3249,26 @@ func (cook SetGammaCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetGammaCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetGamma
 // setGammaRequest writes a SetGamma request to a byte slice.
 func setGammaRequest(c *xgb.Conn, Screen uint16, Red uint32, Green uint32, Blue uint32) []byte {
+	return AppendSetGammaRequest(nil, c, Screen, Red, Green, Blue)
+}
+
+// AppendSetGammaRequest appends a SetGamma request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetGammaRequest(buf []byte, c *xgb.Conn, Screen uint16, Red uint32, Green uint32, Blue uint32) []byte {
 	size := 32
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-VidModeExtension"]
@@ -2218,21 +3278,21 @@ func setGammaRequest(c *xgb.Conn, Screen uint16, Red uint32, Green uint32, Blue
 	buf[b] = 15 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], Screen)
+	order.Put16(buf[b:], Screen)
 	b += 2
 
 	b += 2 // padding
 
-	xgb.Put32(buf[b:], Red)
+	order.Put32(buf[b:], Red)
 	b += 4
 
-	xgb.Put32(buf[b:], Green)
+	order.Put32(buf[b:], Green)
 	b += 4
 
-	xgb.Put32(buf[b:], Blue)
+	order.Put32(buf[b:], Blue)
 	b += 4
 
 	b += 12 // padding
@@ -2240,6 +3300,49 @@ func setGammaRequest(c *xgb.Conn, Screen uint16, Red uint32, Green uint32, Blue
 	return buf
 }
 
+// SetGammaRequest represents the data of a SetGamma request, as it is read when tracing a connection.
+type SetGammaRequest struct {
+	Screen uint16
+	// padding: 2 bytes
+	Red   uint32
+	Green uint32
+	Blue  uint32
+	// padding: 12 bytes
+}
+
+// setGammaRequestRead reads a byte slice in the byte order 'order' into a SetGammaRequest value.
+func setGammaRequestRead(buf []byte, order xgb.ByteOrder) *SetGammaRequest {
+	v := new(SetGammaRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	v.Red = order.Get32(buf[b:])
+	b += 4
+
+	v.Green = order.Get32(buf[b:])
+	b += 4
+
+	v.Blue = order.Get32(buf[b:])
+	b += 4
+
+	b += 12 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"][15] = xgb.RequestInfo{
+		Name: "SetGamma",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setGammaRequestRead(buf, order)
+		},
+	}
+}
+
 // SetGammaRampCookie is a cookie used only for SetGammaRamp requests.
 type SetGammaRampCookie struct {
 	*xgb.Cookie
@@ -2277,12 +3380,26 @@ func (cook SetGammaRampCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetGammaRampCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetGammaRamp
 // setGammaRampRequest writes a SetGammaRamp request to a byte slice.
 func setGammaRampRequest(c *xgb.Conn, Screen uint16, Size uint16, Red []uint16, Green []uint16, Blue []uint16) []byte {
+	return AppendSetGammaRampRequest(nil, c, Screen, Size, Red, Green, Blue)
+}
+
+// AppendSetGammaRampRequest appends a SetGammaRamp request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetGammaRampRequest(buf []byte, c *xgb.Conn, Screen uint16, Size uint16, Red []uint16, Green []uint16, Blue []uint16) []byte {
 	size := xgb.Pad((((((8 + xgb.Pad((((int(Size) + 1) & -2) * 2))) + 2) + xgb.Pad((((int(Size) + 1) & -2) * 2))) + 2) + xgb.Pad((((int(Size) + 1) & -2) * 2))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-VidModeExtension"]
@@ -2295,36 +3412,92 @@ func setGammaRampRequest(c *xgb.Conn, Screen uint16, Size uint16, Red []uint16,
 	blen := b
 	b += 2
 
-	xgb.Put16(buf[b:], Screen)
+	order.Put16(buf[b:], Screen)
 	b += 2
 
-	xgb.Put16(buf[b:], Size)
+	order.Put16(buf[b:], Size)
 	b += 2
 
 	for i := 0; i < int(((int(Size) + 1) & -2)); i++ {
-		xgb.Put16(buf[b:], Red[i])
+		order.Put16(buf[b:], Red[i])
 		b += 2
 	}
 
 	b = (b + 1) & ^1 // alignment gap
 
 	for i := 0; i < int(((int(Size) + 1) & -2)); i++ {
-		xgb.Put16(buf[b:], Green[i])
+		order.Put16(buf[b:], Green[i])
 		b += 2
 	}
 
 	b = (b + 1) & ^1 // alignment gap
 
 	for i := 0; i < int(((int(Size) + 1) & -2)); i++ {
-		xgb.Put16(buf[b:], Blue[i])
+		order.Put16(buf[b:], Blue[i])
 		b += 2
 	}
 
-	b = xgb.Pad(b)
-	xgb.Put16(buf[blen:], uint16(b/4)) // write request size in 4-byte units
+	b = start + xgb.Pad(b-start)
+	order.Put16(buf[blen:], uint16((b-start)/4)) // write request size in 4-byte units
 	return buf[:b]
 }
 
+// SetGammaRampRequest represents the data of a SetGammaRamp request, as it is read when tracing a connection.
+type SetGammaRampRequest struct {
+	Screen uint16
+	Size   uint16
+	Red    []uint16 // size: xgb.Pad((((int(Size) + 1) & -2) * 2))
+	// alignment gap to multiple of 2
+	Green []uint16 // size: xgb.Pad((((int(Size) + 1) & -2) * 2))
+	// alignment gap to multiple of 2
+	Blue []uint16 // size: xgb.Pad((((int(Size) + 1) & -2) * 2))
+}
+
+// setGammaRampRequestRead reads a byte slice in the byte order 'order' into a SetGammaRampRequest value.
+func setGammaRampRequestRead(buf []byte, order xgb.ByteOrder) *SetGammaRampRequest {
+	v := new(SetGammaRampRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get16(buf[b:])
+	b += 2
+
+	v.Size = order.Get16(buf[b:])
+	b += 2
+
+	v.Red = make([]uint16, ((int(v.Size) + 1) & -2))
+	for i := 0; i < int(((int(v.Size) + 1) & -2)); i++ {
+		v.Red[i] = order.Get16(buf[b:])
+		b += 2
+	}
+
+	b = (b + 1) & ^1 // alignment gap
+
+	v.Green = make([]uint16, ((int(v.Size) + 1) & -2))
+	for i := 0; i < int(((int(v.Size) + 1) & -2)); i++ {
+		v.Green[i] = order.Get16(buf[b:])
+		b += 2
+	}
+
+	b = (b + 1) & ^1 // alignment gap
+
+	v.Blue = make([]uint16, ((int(v.Size) + 1) & -2))
+	for i := 0; i < int(((int(v.Size) + 1) & -2)); i++ {
+		v.Blue[i] = order.Get16(buf[b:])
+		b += 2
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"][18] = xgb.RequestInfo{
+		Name: "SetGammaRamp",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setGammaRampRequestRead(buf, order)
+		},
+	}
+}
+
 // SetViewPortCookie is a cookie used only for SetViewPort requests.
 type SetViewPortCookie struct {
 	*xgb.Cookie
@@ -2362,12 +3535,26 @@ func (cook SetViewPortCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetViewPortCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetViewPort
 // setViewPortRequest writes a SetViewPort request to a byte slice.
 func setViewPortRequest(c *xgb.Conn, Screen uint16, X uint32, Y uint32) []byte {
+	return AppendSetViewPortRequest(nil, c, Screen, X, Y)
+}
+
+// AppendSetViewPortRequest appends a SetViewPort request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetViewPortRequest(buf []byte, c *xgb.Conn, Screen uint16, X uint32, Y uint32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-VidModeExtension"]
@@ -2377,23 +3564,59 @@ func setViewPortRequest(c *xgb.Conn, Screen uint16, X uint32, Y uint32) []byte {
 	buf[b] = 12 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], Screen)
+	order.Put16(buf[b:], Screen)
 	b += 2
 
 	b += 2 // padding
 
-	xgb.Put32(buf[b:], X)
+	order.Put32(buf[b:], X)
 	b += 4
 
-	xgb.Put32(buf[b:], Y)
+	order.Put32(buf[b:], Y)
 	b += 4
 
 	return buf
 }
 
+// SetViewPortRequest represents the data of a SetViewPort request, as it is read when tracing a connection.
+type SetViewPortRequest struct {
+	Screen uint16
+	// padding: 2 bytes
+	X uint32
+	Y uint32
+}
+
+// setViewPortRequestRead reads a byte slice in the byte order 'order' into a SetViewPortRequest value.
+func setViewPortRequestRead(buf []byte, order xgb.ByteOrder) *SetViewPortRequest {
+	v := new(SetViewPortRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	v.X = order.Get32(buf[b:])
+	b += 4
+
+	v.Y = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"][12] = xgb.RequestInfo{
+		Name: "SetViewPort",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setViewPortRequestRead(buf, order)
+		},
+	}
+}
+
 // SwitchModeCookie is a cookie used only for SwitchMode requests.
 type SwitchModeCookie struct {
 	*xgb.Cookie
@@ -2431,12 +3654,26 @@ func (cook SwitchModeCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SwitchModeCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SwitchMode
 // switchModeRequest writes a SwitchMode request to a byte slice.
 func switchModeRequest(c *xgb.Conn, Screen uint16, Zoom uint16) []byte {
+	return AppendSwitchModeRequest(nil, c, Screen, Zoom)
+}
+
+// AppendSwitchModeRequest appends a SwitchMode request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSwitchModeRequest(buf []byte, c *xgb.Conn, Screen uint16, Zoom uint16) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-VidModeExtension"]
@@ -2446,18 +3683,47 @@ func switchModeRequest(c *xgb.Conn, Screen uint16, Zoom uint16) []byte {
 	buf[b] = 3 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], Screen)
+	order.Put16(buf[b:], Screen)
 	b += 2
 
-	xgb.Put16(buf[b:], Zoom)
+	order.Put16(buf[b:], Zoom)
 	b += 2
 
 	return buf
 }
 
+// SwitchModeRequest represents the data of a SwitchMode request, as it is read when tracing a connection.
+type SwitchModeRequest struct {
+	Screen uint16
+	Zoom   uint16
+}
+
+// switchModeRequestRead reads a byte slice in the byte order 'order' into a SwitchModeRequest value.
+func switchModeRequestRead(buf []byte, order xgb.ByteOrder) *SwitchModeRequest {
+	v := new(SwitchModeRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get16(buf[b:])
+	b += 2
+
+	v.Zoom = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"][3] = xgb.RequestInfo{
+		Name: "SwitchMode",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return switchModeRequestRead(buf, order)
+		},
+	}
+}
+
 // SwitchToModeCookie is a cookie used only for SwitchToMode requests.
 type SwitchToModeCookie struct {
 	*xgb.Cookie
@@ -2465,7 +3731,7 @@ type SwitchToModeCookie struct {
 
 // SwitchToMode sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func SwitchToMode(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags uint32, Privsize uint32, Private []byte) SwitchToModeCookie {
+func SwitchToMode(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags ModeFlag, Privsize uint32, Private []byte) SwitchToModeCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["XFree86-VidModeExtension"]; !ok {
@@ -2478,7 +3744,7 @@ func SwitchToMode(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16
 
 // SwitchToModeChecked sends a checked request.
 // If an error occurs, it can be retrieved using SwitchToModeCookie.Check()
-func SwitchToModeChecked(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags uint32, Privsize uint32, Private []byte) SwitchToModeCookie {
+func SwitchToModeChecked(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags ModeFlag, Privsize uint32, Private []byte) SwitchToModeCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["XFree86-VidModeExtension"]; !ok {
@@ -2495,12 +3761,26 @@ func (cook SwitchToModeCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SwitchToModeCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SwitchToMode
 // switchToModeRequest writes a SwitchToMode request to a byte slice.
-func switchToModeRequest(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags uint32, Privsize uint32, Private []byte) []byte {
+func switchToModeRequest(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags ModeFlag, Privsize uint32, Private []byte) []byte {
+	return AppendSwitchToModeRequest(nil, c, Screen, Dotclock, Hdisplay, Hsyncstart, Hsyncend, Htotal, Hskew, Vdisplay, Vsyncstart, Vsyncend, Vtotal, Flags, Privsize, Private)
+}
+
+// AppendSwitchToModeRequest appends a SwitchToMode request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSwitchToModeRequest(buf []byte, c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags ModeFlag, Privsize uint32, Private []byte) []byte {
 	size := xgb.Pad((52 + xgb.Pad((int(Privsize) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-VidModeExtension"]
@@ -2510,50 +3790,50 @@ func switchToModeRequest(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay
 	buf[b] = 10 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Dotclock))
+	order.Put32(buf[b:], uint32(Dotclock))
 	b += 4
 
-	xgb.Put16(buf[b:], Hdisplay)
+	order.Put16(buf[b:], Hdisplay)
 	b += 2
 
-	xgb.Put16(buf[b:], Hsyncstart)
+	order.Put16(buf[b:], Hsyncstart)
 	b += 2
 
-	xgb.Put16(buf[b:], Hsyncend)
+	order.Put16(buf[b:], Hsyncend)
 	b += 2
 
-	xgb.Put16(buf[b:], Htotal)
+	order.Put16(buf[b:], Htotal)
 	b += 2
 
-	xgb.Put16(buf[b:], Hskew)
+	order.Put16(buf[b:], Hskew)
 	b += 2
 
-	xgb.Put16(buf[b:], Vdisplay)
+	order.Put16(buf[b:], Vdisplay)
 	b += 2
 
-	xgb.Put16(buf[b:], Vsyncstart)
+	order.Put16(buf[b:], Vsyncstart)
 	b += 2
 
-	xgb.Put16(buf[b:], Vsyncend)
+	order.Put16(buf[b:], Vsyncend)
 	b += 2
 
-	xgb.Put16(buf[b:], Vtotal)
+	order.Put16(buf[b:], Vtotal)
 	b += 2
 
 	b += 2 // padding
 
-	xgb.Put32(buf[b:], Flags)
+	order.Put32(buf[b:], uint32(Flags))
 	b += 4
 
 	b += 12 // padding
 
-	xgb.Put32(buf[b:], Privsize)
+	order.Put32(buf[b:], Privsize)
 	b += 4
 
 	copy(buf[b:], Private[:Privsize])
@@ -2562,6 +3842,90 @@ func switchToModeRequest(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay
 	return buf
 }
 
+// SwitchToModeRequest represents the data of a SwitchToMode request, as it is read when tracing a connection.
+type SwitchToModeRequest struct {
+	Screen     uint32
+	Dotclock   Dotclock
+	Hdisplay   uint16
+	Hsyncstart uint16
+	Hsyncend   uint16
+	Htotal     uint16
+	Hskew      uint16
+	Vdisplay   uint16
+	Vsyncstart uint16
+	Vsyncend   uint16
+	Vtotal     uint16
+	// padding: 2 bytes
+	Flags ModeFlag
+	// padding: 12 bytes
+	Privsize uint32
+	Private  []byte // size: xgb.Pad((int(Privsize) * 1))
+}
+
+// switchToModeRequestRead reads a byte slice in the byte order 'order' into a SwitchToModeRequest value.
+func switchToModeRequestRead(buf []byte, order xgb.ByteOrder) *SwitchToModeRequest {
+	v := new(SwitchToModeRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	v.Dotclock = Dotclock(order.Get32(buf[b:]))
+	b += 4
+
+	v.Hdisplay = order.Get16(buf[b:])
+	b += 2
+
+	v.Hsyncstart = order.Get16(buf[b:])
+	b += 2
+
+	v.Hsyncend = order.Get16(buf[b:])
+	b += 2
+
+	v.Htotal = order.Get16(buf[b:])
+	b += 2
+
+	v.Hskew = order.Get16(buf[b:])
+	b += 2
+
+	v.Vdisplay = order.Get16(buf[b:])
+	b += 2
+
+	v.Vsyncstart = order.Get16(buf[b:])
+	b += 2
+
+	v.Vsyncend = order.Get16(buf[b:])
+	b += 2
+
+	v.Vtotal = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	v.Flags = ModeFlag(order.Get32(buf[b:]))
+	b += 4
+
+	b += 12 // padding
+
+	v.Privsize = order.Get32(buf[b:])
+	b += 4
+
+	v.Private = make([]byte, v.Privsize)
+	copy(v.Private[:v.Privsize], buf[b:])
+	b += int(v.Privsize)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"][10] = xgb.RequestInfo{
+		Name: "SwitchToMode",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return switchToModeRequestRead(buf, order)
+		},
+	}
+}
+
 // ValidateModeLineCookie is a cookie used only for ValidateModeLine requests.
 type ValidateModeLineCookie struct {
 	*xgb.Cookie
@@ -2569,7 +3933,7 @@ type ValidateModeLineCookie struct {
 
 // ValidateModeLine sends a checked request.
 // If an error occurs, it will be returned with the reply by calling ValidateModeLineCookie.Reply()
-func ValidateModeLine(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags uint32, Privsize uint32, Private []byte) ValidateModeLineCookie {
+func ValidateModeLine(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags ModeFlag, Privsize uint32, Private []byte) ValidateModeLineCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["XFree86-VidModeExtension"]; !ok {
@@ -2582,7 +3946,7 @@ func ValidateModeLine(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay ui
 
 // ValidateModeLineUnchecked sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func ValidateModeLineUnchecked(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags uint32, Privsize uint32, Private []byte) ValidateModeLineCookie {
+func ValidateModeLineUnchecked(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags ModeFlag, Privsize uint32, Private []byte) ValidateModeLineCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["XFree86-VidModeExtension"]; !ok {
@@ -2611,23 +3975,35 @@ func (cook ValidateModeLineCookie) Reply() (*ValidateModeLineReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return validateModeLineReply(buf), nil
+	return validateModeLineReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// validateModeLineReply reads a byte slice into a ValidateModeLineReply value.
-func validateModeLineReply(buf []byte) *ValidateModeLineReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a ValidateModeLine request arrives.
+func (cook ValidateModeLineCookie) ReplyContext(ctx context.Context) (*ValidateModeLineReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return validateModeLineReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// validateModeLineReply reads a byte slice in the byte order 'order' into a ValidateModeLineReply value.
+func validateModeLineReply(buf []byte, order xgb.ByteOrder) *ValidateModeLineReply {
 	v := new(ValidateModeLineReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Status = xgb.Get32(buf[b:])
+	v.Status = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
@@ -2637,10 +4013,19 @@ func validateModeLineReply(buf []byte) *ValidateModeLineReply {
 
 // Write request to wire for ValidateModeLine
 // validateModeLineRequest writes a ValidateModeLine request to a byte slice.
-func validateModeLineRequest(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags uint32, Privsize uint32, Private []byte) []byte {
+func validateModeLineRequest(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags ModeFlag, Privsize uint32, Private []byte) []byte {
+	return AppendValidateModeLineRequest(nil, c, Screen, Dotclock, Hdisplay, Hsyncstart, Hsyncend, Htotal, Hskew, Vdisplay, Vsyncstart, Vsyncend, Vtotal, Flags, Privsize, Private)
+}
+
+// AppendValidateModeLineRequest appends a ValidateModeLine request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendValidateModeLineRequest(buf []byte, c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdisplay uint16, Hsyncstart uint16, Hsyncend uint16, Htotal uint16, Hskew uint16, Vdisplay uint16, Vsyncstart uint16, Vsyncend uint16, Vtotal uint16, Flags ModeFlag, Privsize uint32, Private []byte) []byte {
 	size := xgb.Pad((52 + xgb.Pad((int(Privsize) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFree86-VidModeExtension"]
@@ -2650,50 +4035,50 @@ func validateModeLineRequest(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdis
 	buf[b] = 9 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Dotclock))
+	order.Put32(buf[b:], uint32(Dotclock))
 	b += 4
 
-	xgb.Put16(buf[b:], Hdisplay)
+	order.Put16(buf[b:], Hdisplay)
 	b += 2
 
-	xgb.Put16(buf[b:], Hsyncstart)
+	order.Put16(buf[b:], Hsyncstart)
 	b += 2
 
-	xgb.Put16(buf[b:], Hsyncend)
+	order.Put16(buf[b:], Hsyncend)
 	b += 2
 
-	xgb.Put16(buf[b:], Htotal)
+	order.Put16(buf[b:], Htotal)
 	b += 2
 
-	xgb.Put16(buf[b:], Hskew)
+	order.Put16(buf[b:], Hskew)
 	b += 2
 
-	xgb.Put16(buf[b:], Vdisplay)
+	order.Put16(buf[b:], Vdisplay)
 	b += 2
 
-	xgb.Put16(buf[b:], Vsyncstart)
+	order.Put16(buf[b:], Vsyncstart)
 	b += 2
 
-	xgb.Put16(buf[b:], Vsyncend)
+	order.Put16(buf[b:], Vsyncend)
 	b += 2
 
-	xgb.Put16(buf[b:], Vtotal)
+	order.Put16(buf[b:], Vtotal)
 	b += 2
 
 	b += 2 // padding
 
-	xgb.Put32(buf[b:], Flags)
+	order.Put32(buf[b:], uint32(Flags))
 	b += 4
 
 	b += 12 // padding
 
-	xgb.Put32(buf[b:], Privsize)
+	order.Put32(buf[b:], Privsize)
 	b += 4
 
 	copy(buf[b:], Private[:Privsize])
@@ -2701,3 +4086,90 @@ func validateModeLineRequest(c *xgb.Conn, Screen uint32, Dotclock Dotclock, Hdis
 
 	return buf
 }
+
+// ValidateModeLineRequest represents the data of a ValidateModeLine request, as it is read when tracing a connection.
+type ValidateModeLineRequest struct {
+	Screen     uint32
+	Dotclock   Dotclock
+	Hdisplay   uint16
+	Hsyncstart uint16
+	Hsyncend   uint16
+	Htotal     uint16
+	Hskew      uint16
+	Vdisplay   uint16
+	Vsyncstart uint16
+	Vsyncend   uint16
+	Vtotal     uint16
+	// padding: 2 bytes
+	Flags ModeFlag
+	// padding: 12 bytes
+	Privsize uint32
+	Private  []byte // size: xgb.Pad((int(Privsize) * 1))
+}
+
+// validateModeLineRequestRead reads a byte slice in the byte order 'order' into a ValidateModeLineRequest value.
+func validateModeLineRequestRead(buf []byte, order xgb.ByteOrder) *ValidateModeLineRequest {
+	v := new(ValidateModeLineRequest)
+	b := 4 // skip request header
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	v.Dotclock = Dotclock(order.Get32(buf[b:]))
+	b += 4
+
+	v.Hdisplay = order.Get16(buf[b:])
+	b += 2
+
+	v.Hsyncstart = order.Get16(buf[b:])
+	b += 2
+
+	v.Hsyncend = order.Get16(buf[b:])
+	b += 2
+
+	v.Htotal = order.Get16(buf[b:])
+	b += 2
+
+	v.Hskew = order.Get16(buf[b:])
+	b += 2
+
+	v.Vdisplay = order.Get16(buf[b:])
+	b += 2
+
+	v.Vsyncstart = order.Get16(buf[b:])
+	b += 2
+
+	v.Vsyncend = order.Get16(buf[b:])
+	b += 2
+
+	v.Vtotal = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	v.Flags = ModeFlag(order.Get32(buf[b:]))
+	b += 4
+
+	b += 12 // padding
+
+	v.Privsize = order.Get32(buf[b:])
+	b += 4
+
+	v.Private = make([]byte, v.Privsize)
+	copy(v.Private[:v.Privsize], buf[b:])
+	b += int(v.Privsize)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFree86-VidModeExtension"][9] = xgb.RequestInfo{
+		Name: "ValidateModeLine",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return validateModeLineRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return validateModeLineReply(buf, order)
+		},
+	}
+}
@@ -4,6 +4,8 @@ package xfixes
 // This file is automatically generated from xfixes.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/render"
@@ -24,18 +26,16 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["XFIXES"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["XFIXES"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["XFIXES"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["XFIXES"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["XFIXES"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "XFIXES", xgb.ExtRequestInfos["XFIXES"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["XFIXES"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["XFIXES"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["XFIXES"] = make(map[int]xgb.RequestInfo)
 }
 
 // BadBadRegion is the error number for a BadBadRegion.
@@ -46,15 +46,15 @@ type BadRegionError struct {
 	NiceName string
 }
 
-// BadRegionErrorNew constructs a BadRegionError value that implements xgb.Error from a byte slice.
-func BadRegionErrorNew(buf []byte) xgb.Error {
+// BadRegionErrorNew constructs a BadRegionError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadRegionErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := BadRegionError{}
 	v.NiceName = "BadRegion"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -94,13 +94,43 @@ func NewBarrierId(c *xgb.Conn) (Barrier, error) {
 	return Barrier(id), nil
 }
 
+type BarrierDirections uint32
+
 const (
-	BarrierDirectionsPositiveX = 1
-	BarrierDirectionsPositiveY = 2
-	BarrierDirectionsNegativeX = 4
-	BarrierDirectionsNegativeY = 8
+	BarrierDirectionsPositiveX BarrierDirections = 1
+	BarrierDirectionsPositiveY BarrierDirections = 2
+	BarrierDirectionsNegativeX BarrierDirections = 4
+	BarrierDirectionsNegativeY BarrierDirections = 8
 )
 
+// String returns the names of the BarrierDirections bits set in a value.
+func (v BarrierDirections) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 4)
+	if v&BarrierDirectionsPositiveX != 0 {
+		names = append(names, "PositiveX")
+		v &^= BarrierDirectionsPositiveX
+	}
+	if v&BarrierDirectionsPositiveY != 0 {
+		names = append(names, "PositiveY")
+		v &^= BarrierDirectionsPositiveY
+	}
+	if v&BarrierDirectionsNegativeX != 0 {
+		names = append(names, "NegativeX")
+		v &^= BarrierDirectionsNegativeX
+	}
+	if v&BarrierDirectionsNegativeY != 0 {
+		names = append(names, "NegativeY")
+		v &^= BarrierDirectionsNegativeY
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint32(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
 // CursorNotify is the event number for a CursorNotifyEvent.
 const CursorNotify = 1
 
@@ -114,27 +144,27 @@ type CursorNotifyEvent struct {
 	// padding: 12 bytes
 }
 
-// CursorNotifyEventNew constructs a CursorNotifyEvent value that implements xgb.Event from a byte slice.
-func CursorNotifyEventNew(buf []byte) xgb.Event {
+// CursorNotifyEventNew constructs a CursorNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func CursorNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := CursorNotifyEvent{}
 	b := 1 // don't read event number
 
 	v.Subtype = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Window = xproto.Window(xgb.Get32(buf[b:]))
+	v.Window = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.CursorSerial = xgb.Get32(buf[b:])
+	v.CursorSerial = order.Get32(buf[b:])
 	b += 4
 
-	v.Timestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.Name = xproto.Atom(xgb.Get32(buf[b:]))
+	v.Name = xproto.Atom(order.Get32(buf[b:]))
 	b += 4
 
 	b += 12 // padding
@@ -142,8 +172,8 @@ func CursorNotifyEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a CursorNotifyEvent value to a byte slice.
-func (v CursorNotifyEvent) Bytes() []byte {
+// Bytes writes a CursorNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v CursorNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -156,16 +186,16 @@ func (v CursorNotifyEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
-	xgb.Put32(buf[b:], v.CursorSerial)
+	order.Put32(buf[b:], v.CursorSerial)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Timestamp))
+	order.Put32(buf[b:], uint32(v.Timestamp))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Name))
+	order.Put32(buf[b:], uint32(v.Name))
 	b += 4
 
 	b += 12 // padding
@@ -192,6 +222,12 @@ func (v CursorNotifyEvent) String() string {
 	return "CursorNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the CursorNotify event is about.
+// It is used to subscribe to the events of a single window.
+func (v CursorNotifyEvent) EventWindow() uint32 {
+	return uint32(v.Window)
+}
+
 func init() {
 	xgb.NewExtEventFuncs["XFIXES"][1] = CursorNotifyEventNew
 }
@@ -200,10 +236,28 @@ const (
 	CursorNotifyDisplayCursor = 0
 )
 
+type CursorNotifyMask uint32
+
 const (
-	CursorNotifyMaskDisplayCursor = 1
+	CursorNotifyMaskDisplayCursor CursorNotifyMask = 1
 )
 
+// String returns the names of the CursorNotifyMask bits set in a value.
+func (v CursorNotifyMask) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 1)
+	if v&CursorNotifyMaskDisplayCursor != 0 {
+		names = append(names, "DisplayCursor")
+		v &^= CursorNotifyMaskDisplayCursor
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint32(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
 type Region uint32
 
 func NewRegionId(c *xgb.Conn) (Region, error) {
@@ -218,39 +272,119 @@ const (
 	RegionNone = 0
 )
 
+type SaveSetMapping byte
+
 const (
-	SaveSetMappingMap   = 0
-	SaveSetMappingUnmap = 1
+	SaveSetMappingMap   SaveSetMapping = 0
+	SaveSetMappingUnmap SaveSetMapping = 1
 )
 
+// String returns the name of the SaveSetMapping item with this value.
+func (v SaveSetMapping) String() string {
+	switch v {
+	case SaveSetMappingMap:
+		return "Map"
+	case SaveSetMappingUnmap:
+		return "Unmap"
+	}
+	return xgb.Sprintf("SaveSetMapping(%d)", byte(v))
+}
+
+type SaveSetMode byte
+
 const (
-	SaveSetModeInsert = 0
-	SaveSetModeDelete = 1
+	SaveSetModeInsert SaveSetMode = 0
+	SaveSetModeDelete SaveSetMode = 1
 )
 
+// String returns the name of the SaveSetMode item with this value.
+func (v SaveSetMode) String() string {
+	switch v {
+	case SaveSetModeInsert:
+		return "Insert"
+	case SaveSetModeDelete:
+		return "Delete"
+	}
+	return xgb.Sprintf("SaveSetMode(%d)", byte(v))
+}
+
+type SaveSetTarget byte
+
 const (
-	SaveSetTargetNearest = 0
-	SaveSetTargetRoot    = 1
+	SaveSetTargetNearest SaveSetTarget = 0
+	SaveSetTargetRoot    SaveSetTarget = 1
 )
 
+// String returns the name of the SaveSetTarget item with this value.
+func (v SaveSetTarget) String() string {
+	switch v {
+	case SaveSetTargetNearest:
+		return "Nearest"
+	case SaveSetTargetRoot:
+		return "Root"
+	}
+	return xgb.Sprintf("SaveSetTarget(%d)", byte(v))
+}
+
+type SelectionEvent byte
+
 const (
-	SelectionEventSetSelectionOwner      = 0
-	SelectionEventSelectionWindowDestroy = 1
-	SelectionEventSelectionClientClose   = 2
+	SelectionEventSetSelectionOwner      SelectionEvent = 0
+	SelectionEventSelectionWindowDestroy SelectionEvent = 1
+	SelectionEventSelectionClientClose   SelectionEvent = 2
 )
 
+// String returns the name of the SelectionEvent item with this value.
+func (v SelectionEvent) String() string {
+	switch v {
+	case SelectionEventSetSelectionOwner:
+		return "SetSelectionOwner"
+	case SelectionEventSelectionWindowDestroy:
+		return "SelectionWindowDestroy"
+	case SelectionEventSelectionClientClose:
+		return "SelectionClientClose"
+	}
+	return xgb.Sprintf("SelectionEvent(%d)", byte(v))
+}
+
+type SelectionEventMask uint32
+
 const (
-	SelectionEventMaskSetSelectionOwner      = 1
-	SelectionEventMaskSelectionWindowDestroy = 2
-	SelectionEventMaskSelectionClientClose   = 4
+	SelectionEventMaskSetSelectionOwner      SelectionEventMask = 1
+	SelectionEventMaskSelectionWindowDestroy SelectionEventMask = 2
+	SelectionEventMaskSelectionClientClose   SelectionEventMask = 4
 )
 
+// String returns the names of the SelectionEventMask bits set in a value.
+func (v SelectionEventMask) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 3)
+	if v&SelectionEventMaskSetSelectionOwner != 0 {
+		names = append(names, "SetSelectionOwner")
+		v &^= SelectionEventMaskSetSelectionOwner
+	}
+	if v&SelectionEventMaskSelectionWindowDestroy != 0 {
+		names = append(names, "SelectionWindowDestroy")
+		v &^= SelectionEventMaskSelectionWindowDestroy
+	}
+	if v&SelectionEventMaskSelectionClientClose != 0 {
+		names = append(names, "SelectionClientClose")
+		v &^= SelectionEventMaskSelectionClientClose
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint32(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
 // SelectionNotify is the event number for a SelectionNotifyEvent.
 const SelectionNotify = 0
 
 type SelectionNotifyEvent struct {
 	Sequence           uint16
-	Subtype            byte
+	Subtype            SelectionEvent
 	Window             xproto.Window
 	Owner              xproto.Window
 	Selection          xproto.Atom
@@ -259,30 +393,30 @@ type SelectionNotifyEvent struct {
 	// padding: 8 bytes
 }
 
-// SelectionNotifyEventNew constructs a SelectionNotifyEvent value that implements xgb.Event from a byte slice.
-func SelectionNotifyEventNew(buf []byte) xgb.Event {
+// SelectionNotifyEventNew constructs a SelectionNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func SelectionNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := SelectionNotifyEvent{}
 	b := 1 // don't read event number
 
-	v.Subtype = buf[b]
+	v.Subtype = SelectionEvent(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Window = xproto.Window(xgb.Get32(buf[b:]))
+	v.Window = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Owner = xproto.Window(xgb.Get32(buf[b:]))
+	v.Owner = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Selection = xproto.Atom(xgb.Get32(buf[b:]))
+	v.Selection = xproto.Atom(order.Get32(buf[b:]))
 	b += 4
 
-	v.Timestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Timestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.SelectionTimestamp = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.SelectionTimestamp = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
 	b += 8 // padding
@@ -290,8 +424,8 @@ func SelectionNotifyEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a SelectionNotifyEvent value to a byte slice.
-func (v SelectionNotifyEvent) Bytes() []byte {
+// Bytes writes a SelectionNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v SelectionNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -299,24 +433,24 @@ func (v SelectionNotifyEvent) Bytes() []byte {
 	buf[b] = 0
 	b += 1
 
-	buf[b] = v.Subtype
+	buf[b] = byte(v.Subtype)
 	b += 1
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Owner))
+	order.Put32(buf[b:], uint32(v.Owner))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Selection))
+	order.Put32(buf[b:], uint32(v.Selection))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Timestamp))
+	order.Put32(buf[b:], uint32(v.Timestamp))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.SelectionTimestamp))
+	order.Put32(buf[b:], uint32(v.SelectionTimestamp))
 	b += 4
 
 	b += 8 // padding
@@ -335,7 +469,7 @@ func (v SelectionNotifyEvent) SequenceId() uint16 {
 func (v SelectionNotifyEvent) String() string {
 	fieldVals := make([]string, 0, 7)
 	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
-	fieldVals = append(fieldVals, xgb.Sprintf("Subtype: %d", v.Subtype))
+	fieldVals = append(fieldVals, xgb.Sprintf("Subtype: %s", v.Subtype))
 	fieldVals = append(fieldVals, xgb.Sprintf("Window: %d", v.Window))
 	fieldVals = append(fieldVals, xgb.Sprintf("Owner: %d", v.Owner))
 	fieldVals = append(fieldVals, xgb.Sprintf("Selection: %d", v.Selection))
@@ -344,6 +478,12 @@ func (v SelectionNotifyEvent) String() string {
 	return "SelectionNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the SelectionNotify event is about.
+// It is used to subscribe to the events of a single window.
+func (v SelectionNotifyEvent) EventWindow() uint32 {
+	return uint32(v.Window)
+}
+
 func init() {
 	xgb.NewExtEventFuncs["XFIXES"][0] = SelectionNotifyEventNew
 }
@@ -372,6 +512,8 @@ func init() {
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // ChangeCursorCookie is a cookie used only for ChangeCursor requests.
 type ChangeCursorCookie struct {
 	*xgb.Cookie
@@ -409,12 +551,26 @@ func (cook ChangeCursorCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ChangeCursorCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ChangeCursor
 // changeCursorRequest writes a ChangeCursor request to a byte slice.
 func changeCursorRequest(c *xgb.Conn, Source xproto.Cursor, Destination xproto.Cursor) []byte {
+	return AppendChangeCursorRequest(nil, c, Source, Destination)
+}
+
+// AppendChangeCursorRequest appends a ChangeCursor request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendChangeCursorRequest(buf []byte, c *xgb.Conn, Source xproto.Cursor, Destination xproto.Cursor) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -424,18 +580,47 @@ func changeCursorRequest(c *xgb.Conn, Source xproto.Cursor, Destination xproto.C
 	buf[b] = 26 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Source))
+	order.Put32(buf[b:], uint32(Source))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Destination))
+	order.Put32(buf[b:], uint32(Destination))
 	b += 4
 
 	return buf
 }
 
+// ChangeCursorRequest represents the data of a ChangeCursor request, as it is read when tracing a connection.
+type ChangeCursorRequest struct {
+	Source      xproto.Cursor
+	Destination xproto.Cursor
+}
+
+// changeCursorRequestRead reads a byte slice in the byte order 'order' into a ChangeCursorRequest value.
+func changeCursorRequestRead(buf []byte, order xgb.ByteOrder) *ChangeCursorRequest {
+	v := new(ChangeCursorRequest)
+	b := 4 // skip request header
+
+	v.Source = xproto.Cursor(order.Get32(buf[b:]))
+	b += 4
+
+	v.Destination = xproto.Cursor(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][26] = xgb.RequestInfo{
+		Name: "ChangeCursor",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return changeCursorRequestRead(buf, order)
+		},
+	}
+}
+
 // ChangeCursorByNameCookie is a cookie used only for ChangeCursorByName requests.
 type ChangeCursorByNameCookie struct {
 	*xgb.Cookie
@@ -473,12 +658,26 @@ func (cook ChangeCursorByNameCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ChangeCursorByNameCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ChangeCursorByName
 // changeCursorByNameRequest writes a ChangeCursorByName request to a byte slice.
 func changeCursorByNameRequest(c *xgb.Conn, Src xproto.Cursor, Nbytes uint16, Name string) []byte {
+	return AppendChangeCursorByNameRequest(nil, c, Src, Nbytes, Name)
+}
+
+// AppendChangeCursorByNameRequest appends a ChangeCursorByName request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendChangeCursorByNameRequest(buf []byte, c *xgb.Conn, Src xproto.Cursor, Nbytes uint16, Name string) []byte {
 	size := xgb.Pad((12 + xgb.Pad((int(Nbytes) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -488,13 +687,13 @@ func changeCursorByNameRequest(c *xgb.Conn, Src xproto.Cursor, Nbytes uint16, Na
 	buf[b] = 27 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Src))
+	order.Put32(buf[b:], uint32(Src))
 	b += 4
 
-	xgb.Put16(buf[b:], Nbytes)
+	order.Put16(buf[b:], Nbytes)
 	b += 2
 
 	b += 2 // padding
@@ -505,6 +704,46 @@ func changeCursorByNameRequest(c *xgb.Conn, Src xproto.Cursor, Nbytes uint16, Na
 	return buf
 }
 
+// ChangeCursorByNameRequest represents the data of a ChangeCursorByName request, as it is read when tracing a connection.
+type ChangeCursorByNameRequest struct {
+	Src    xproto.Cursor
+	Nbytes uint16
+	// padding: 2 bytes
+	Name string // size: xgb.Pad((int(Nbytes) * 1))
+}
+
+// changeCursorByNameRequestRead reads a byte slice in the byte order 'order' into a ChangeCursorByNameRequest value.
+func changeCursorByNameRequestRead(buf []byte, order xgb.ByteOrder) *ChangeCursorByNameRequest {
+	v := new(ChangeCursorByNameRequest)
+	b := 4 // skip request header
+
+	v.Src = xproto.Cursor(order.Get32(buf[b:]))
+	b += 4
+
+	v.Nbytes = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	{
+		byteString := make([]byte, v.Nbytes)
+		copy(byteString[:v.Nbytes], buf[b:])
+		v.Name = string(byteString)
+		b += int(v.Nbytes)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][27] = xgb.RequestInfo{
+		Name: "ChangeCursorByName",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return changeCursorByNameRequestRead(buf, order)
+		},
+	}
+}
+
 // ChangeSaveSetCookie is a cookie used only for ChangeSaveSet requests.
 type ChangeSaveSetCookie struct {
 	*xgb.Cookie
@@ -512,7 +751,7 @@ type ChangeSaveSetCookie struct {
 
 // ChangeSaveSet sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func ChangeSaveSet(c *xgb.Conn, Mode byte, Target byte, Map byte, Window xproto.Window) ChangeSaveSetCookie {
+func ChangeSaveSet(c *xgb.Conn, Mode SaveSetMode, Target SaveSetTarget, Map SaveSetMapping, Window xproto.Window) ChangeSaveSetCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["XFIXES"]; !ok {
@@ -525,7 +764,7 @@ func ChangeSaveSet(c *xgb.Conn, Mode byte, Target byte, Map byte, Window xproto.
 
 // ChangeSaveSetChecked sends a checked request.
 // If an error occurs, it can be retrieved using ChangeSaveSetCookie.Check()
-func ChangeSaveSetChecked(c *xgb.Conn, Mode byte, Target byte, Map byte, Window xproto.Window) ChangeSaveSetCookie {
+func ChangeSaveSetChecked(c *xgb.Conn, Mode SaveSetMode, Target SaveSetTarget, Map SaveSetMapping, Window xproto.Window) ChangeSaveSetCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["XFIXES"]; !ok {
@@ -542,12 +781,26 @@ func (cook ChangeSaveSetCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ChangeSaveSetCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ChangeSaveSet
 // changeSaveSetRequest writes a ChangeSaveSet request to a byte slice.
-func changeSaveSetRequest(c *xgb.Conn, Mode byte, Target byte, Map byte, Window xproto.Window) []byte {
+func changeSaveSetRequest(c *xgb.Conn, Mode SaveSetMode, Target SaveSetTarget, Map SaveSetMapping, Window xproto.Window) []byte {
+	return AppendChangeSaveSetRequest(nil, c, Mode, Target, Map, Window)
+}
+
+// AppendChangeSaveSetRequest appends a ChangeSaveSet request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendChangeSaveSetRequest(buf []byte, c *xgb.Conn, Mode SaveSetMode, Target SaveSetTarget, Map SaveSetMapping, Window xproto.Window) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -557,26 +810,66 @@ func changeSaveSetRequest(c *xgb.Conn, Mode byte, Target byte, Map byte, Window
 	buf[b] = 1 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	buf[b] = Mode
+	buf[b] = byte(Mode)
 	b += 1
 
-	buf[b] = Target
+	buf[b] = byte(Target)
 	b += 1
 
-	buf[b] = Map
+	buf[b] = byte(Map)
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// ChangeSaveSetRequest represents the data of a ChangeSaveSet request, as it is read when tracing a connection.
+type ChangeSaveSetRequest struct {
+	Mode   SaveSetMode
+	Target SaveSetTarget
+	Map    SaveSetMapping
+	// padding: 1 bytes
+	Window xproto.Window
+}
+
+// changeSaveSetRequestRead reads a byte slice in the byte order 'order' into a ChangeSaveSetRequest value.
+func changeSaveSetRequestRead(buf []byte, order xgb.ByteOrder) *ChangeSaveSetRequest {
+	v := new(ChangeSaveSetRequest)
+	b := 4 // skip request header
+
+	v.Mode = SaveSetMode(buf[b])
+	b += 1
+
+	v.Target = SaveSetTarget(buf[b])
+	b += 1
+
+	v.Map = SaveSetMapping(buf[b])
+	b += 1
+
+	b += 1 // padding
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][1] = xgb.RequestInfo{
+		Name: "ChangeSaveSet",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return changeSaveSetRequestRead(buf, order)
+		},
+	}
+}
+
 // CopyRegionCookie is a cookie used only for CopyRegion requests.
 type CopyRegionCookie struct {
 	*xgb.Cookie
@@ -614,12 +907,26 @@ func (cook CopyRegionCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CopyRegionCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CopyRegion
 // copyRegionRequest writes a CopyRegion request to a byte slice.
 func copyRegionRequest(c *xgb.Conn, Source Region, Destination Region) []byte {
+	return AppendCopyRegionRequest(nil, c, Source, Destination)
+}
+
+// AppendCopyRegionRequest appends a CopyRegion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCopyRegionRequest(buf []byte, c *xgb.Conn, Source Region, Destination Region) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -629,18 +936,47 @@ func copyRegionRequest(c *xgb.Conn, Source Region, Destination Region) []byte {
 	buf[b] = 12 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Source))
+	order.Put32(buf[b:], uint32(Source))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Destination))
+	order.Put32(buf[b:], uint32(Destination))
 	b += 4
 
 	return buf
 }
 
+// CopyRegionRequest represents the data of a CopyRegion request, as it is read when tracing a connection.
+type CopyRegionRequest struct {
+	Source      Region
+	Destination Region
+}
+
+// copyRegionRequestRead reads a byte slice in the byte order 'order' into a CopyRegionRequest value.
+func copyRegionRequestRead(buf []byte, order xgb.ByteOrder) *CopyRegionRequest {
+	v := new(CopyRegionRequest)
+	b := 4 // skip request header
+
+	v.Source = Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.Destination = Region(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][12] = xgb.RequestInfo{
+		Name: "CopyRegion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return copyRegionRequestRead(buf, order)
+		},
+	}
+}
+
 // CreatePointerBarrierCookie is a cookie used only for CreatePointerBarrier requests.
 type CreatePointerBarrierCookie struct {
 	*xgb.Cookie
@@ -648,7 +984,7 @@ type CreatePointerBarrierCookie struct {
 
 // CreatePointerBarrier sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func CreatePointerBarrier(c *xgb.Conn, Barrier Barrier, Window xproto.Window, X1 uint16, Y1 uint16, X2 uint16, Y2 uint16, Directions uint32, NumDevices uint16, Devices []uint16) CreatePointerBarrierCookie {
+func CreatePointerBarrier(c *xgb.Conn, Barrier Barrier, Window xproto.Window, X1 uint16, Y1 uint16, X2 uint16, Y2 uint16, Directions BarrierDirections, NumDevices uint16, Devices []uint16) CreatePointerBarrierCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["XFIXES"]; !ok {
@@ -661,7 +997,7 @@ func CreatePointerBarrier(c *xgb.Conn, Barrier Barrier, Window xproto.Window, X1
 
 // CreatePointerBarrierChecked sends a checked request.
 // If an error occurs, it can be retrieved using CreatePointerBarrierCookie.Check()
-func CreatePointerBarrierChecked(c *xgb.Conn, Barrier Barrier, Window xproto.Window, X1 uint16, Y1 uint16, X2 uint16, Y2 uint16, Directions uint32, NumDevices uint16, Devices []uint16) CreatePointerBarrierCookie {
+func CreatePointerBarrierChecked(c *xgb.Conn, Barrier Barrier, Window xproto.Window, X1 uint16, Y1 uint16, X2 uint16, Y2 uint16, Directions BarrierDirections, NumDevices uint16, Devices []uint16) CreatePointerBarrierCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["XFIXES"]; !ok {
@@ -678,12 +1014,26 @@ func (cook CreatePointerBarrierCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreatePointerBarrierCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreatePointerBarrier
 // createPointerBarrierRequest writes a CreatePointerBarrier request to a byte slice.
-func createPointerBarrierRequest(c *xgb.Conn, Barrier Barrier, Window xproto.Window, X1 uint16, Y1 uint16, X2 uint16, Y2 uint16, Directions uint32, NumDevices uint16, Devices []uint16) []byte {
+func createPointerBarrierRequest(c *xgb.Conn, Barrier Barrier, Window xproto.Window, X1 uint16, Y1 uint16, X2 uint16, Y2 uint16, Directions BarrierDirections, NumDevices uint16, Devices []uint16) []byte {
+	return AppendCreatePointerBarrierRequest(nil, c, Barrier, Window, X1, Y1, X2, Y2, Directions, NumDevices, Devices)
+}
+
+// AppendCreatePointerBarrierRequest appends a CreatePointerBarrier request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreatePointerBarrierRequest(buf []byte, c *xgb.Conn, Barrier Barrier, Window xproto.Window, X1 uint16, Y1 uint16, X2 uint16, Y2 uint16, Directions BarrierDirections, NumDevices uint16, Devices []uint16) []byte {
 	size := xgb.Pad((28 + xgb.Pad((int(NumDevices) * 2))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -693,43 +1043,106 @@ func createPointerBarrierRequest(c *xgb.Conn, Barrier Barrier, Window xproto.Win
 	buf[b] = 31 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Barrier))
+	order.Put32(buf[b:], uint32(Barrier))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put16(buf[b:], X1)
+	order.Put16(buf[b:], X1)
 	b += 2
 
-	xgb.Put16(buf[b:], Y1)
+	order.Put16(buf[b:], Y1)
 	b += 2
 
-	xgb.Put16(buf[b:], X2)
+	order.Put16(buf[b:], X2)
 	b += 2
 
-	xgb.Put16(buf[b:], Y2)
+	order.Put16(buf[b:], Y2)
 	b += 2
 
-	xgb.Put32(buf[b:], Directions)
+	order.Put32(buf[b:], uint32(Directions))
 	b += 4
 
 	b += 2 // padding
 
-	xgb.Put16(buf[b:], NumDevices)
+	order.Put16(buf[b:], NumDevices)
 	b += 2
 
 	for i := 0; i < int(NumDevices); i++ {
-		xgb.Put16(buf[b:], Devices[i])
+		order.Put16(buf[b:], Devices[i])
 		b += 2
 	}
 
 	return buf
 }
 
+// CreatePointerBarrierRequest represents the data of a CreatePointerBarrier request, as it is read when tracing a connection.
+type CreatePointerBarrierRequest struct {
+	Barrier    Barrier
+	Window     xproto.Window
+	X1         uint16
+	Y1         uint16
+	X2         uint16
+	Y2         uint16
+	Directions BarrierDirections
+	// padding: 2 bytes
+	NumDevices uint16
+	Devices    []uint16 // size: xgb.Pad((int(NumDevices) * 2))
+}
+
+// createPointerBarrierRequestRead reads a byte slice in the byte order 'order' into a CreatePointerBarrierRequest value.
+func createPointerBarrierRequestRead(buf []byte, order xgb.ByteOrder) *CreatePointerBarrierRequest {
+	v := new(CreatePointerBarrierRequest)
+	b := 4 // skip request header
+
+	v.Barrier = Barrier(order.Get32(buf[b:]))
+	b += 4
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.X1 = order.Get16(buf[b:])
+	b += 2
+
+	v.Y1 = order.Get16(buf[b:])
+	b += 2
+
+	v.X2 = order.Get16(buf[b:])
+	b += 2
+
+	v.Y2 = order.Get16(buf[b:])
+	b += 2
+
+	v.Directions = BarrierDirections(order.Get32(buf[b:]))
+	b += 4
+
+	b += 2 // padding
+
+	v.NumDevices = order.Get16(buf[b:])
+	b += 2
+
+	v.Devices = make([]uint16, v.NumDevices)
+	for i := 0; i < int(v.NumDevices); i++ {
+		v.Devices[i] = order.Get16(buf[b:])
+		b += 2
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][31] = xgb.RequestInfo{
+		Name: "CreatePointerBarrier",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createPointerBarrierRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateRegionCookie is a cookie used only for CreateRegion requests.
 type CreateRegionCookie struct {
 	*xgb.Cookie
@@ -767,12 +1180,26 @@ func (cook CreateRegionCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateRegionCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateRegion
 // createRegionRequest writes a CreateRegion request to a byte slice.
 func createRegionRequest(c *xgb.Conn, Region Region, Rectangles []xproto.Rectangle) []byte {
+	return AppendCreateRegionRequest(nil, c, Region, Rectangles)
+}
+
+// AppendCreateRegionRequest appends a CreateRegion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateRegionRequest(buf []byte, c *xgb.Conn, Region Region, Rectangles []xproto.Rectangle) []byte {
 	size := xgb.Pad((8 + xgb.Pad((len(Rectangles) * 8))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -782,17 +1209,46 @@ func createRegionRequest(c *xgb.Conn, Region Region, Rectangles []xproto.Rectang
 	buf[b] = 5 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Region))
+	order.Put32(buf[b:], uint32(Region))
 	b += 4
 
-	b += xproto.RectangleListBytes(buf[b:], Rectangles)
+	b += xproto.RectangleListBytes(buf[b:], order, Rectangles)
 
 	return buf
 }
 
+// CreateRegionRequest represents the data of a CreateRegion request, as it is read when tracing a connection.
+type CreateRegionRequest struct {
+	Region     Region
+	Rectangles []xproto.Rectangle // size: xgb.Pad((len(Rectangles) * 8))
+}
+
+// createRegionRequestRead reads a byte slice in the byte order 'order' into a CreateRegionRequest value.
+func createRegionRequestRead(buf []byte, order xgb.ByteOrder) *CreateRegionRequest {
+	v := new(CreateRegionRequest)
+	b := 4 // skip request header
+
+	v.Region = Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.Rectangles = make([]xproto.Rectangle, (len(buf)-b)/8)
+	b += xproto.RectangleReadList(buf[b:], order, v.Rectangles)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][5] = xgb.RequestInfo{
+		Name: "CreateRegion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createRegionRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateRegionFromBitmapCookie is a cookie used only for CreateRegionFromBitmap requests.
 type CreateRegionFromBitmapCookie struct {
 	*xgb.Cookie
@@ -830,12 +1286,26 @@ func (cook CreateRegionFromBitmapCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateRegionFromBitmapCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateRegionFromBitmap
 // createRegionFromBitmapRequest writes a CreateRegionFromBitmap request to a byte slice.
 func createRegionFromBitmapRequest(c *xgb.Conn, Region Region, Bitmap xproto.Pixmap) []byte {
+	return AppendCreateRegionFromBitmapRequest(nil, c, Region, Bitmap)
+}
+
+// AppendCreateRegionFromBitmapRequest appends a CreateRegionFromBitmap request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateRegionFromBitmapRequest(buf []byte, c *xgb.Conn, Region Region, Bitmap xproto.Pixmap) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -845,18 +1315,47 @@ func createRegionFromBitmapRequest(c *xgb.Conn, Region Region, Bitmap xproto.Pix
 	buf[b] = 6 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Region))
+	order.Put32(buf[b:], uint32(Region))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Bitmap))
+	order.Put32(buf[b:], uint32(Bitmap))
 	b += 4
 
 	return buf
 }
 
+// CreateRegionFromBitmapRequest represents the data of a CreateRegionFromBitmap request, as it is read when tracing a connection.
+type CreateRegionFromBitmapRequest struct {
+	Region Region
+	Bitmap xproto.Pixmap
+}
+
+// createRegionFromBitmapRequestRead reads a byte slice in the byte order 'order' into a CreateRegionFromBitmapRequest value.
+func createRegionFromBitmapRequestRead(buf []byte, order xgb.ByteOrder) *CreateRegionFromBitmapRequest {
+	v := new(CreateRegionFromBitmapRequest)
+	b := 4 // skip request header
+
+	v.Region = Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.Bitmap = xproto.Pixmap(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][6] = xgb.RequestInfo{
+		Name: "CreateRegionFromBitmap",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createRegionFromBitmapRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateRegionFromGCCookie is a cookie used only for CreateRegionFromGC requests.
 type CreateRegionFromGCCookie struct {
 	*xgb.Cookie
@@ -894,12 +1393,26 @@ func (cook CreateRegionFromGCCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateRegionFromGCCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateRegionFromGC
 // createRegionFromGCRequest writes a CreateRegionFromGC request to a byte slice.
 func createRegionFromGCRequest(c *xgb.Conn, Region Region, Gc xproto.Gcontext) []byte {
+	return AppendCreateRegionFromGCRequest(nil, c, Region, Gc)
+}
+
+// AppendCreateRegionFromGCRequest appends a CreateRegionFromGC request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateRegionFromGCRequest(buf []byte, c *xgb.Conn, Region Region, Gc xproto.Gcontext) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -909,18 +1422,47 @@ func createRegionFromGCRequest(c *xgb.Conn, Region Region, Gc xproto.Gcontext) [
 	buf[b] = 8 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Region))
+	order.Put32(buf[b:], uint32(Region))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
 	return buf
 }
 
+// CreateRegionFromGCRequest represents the data of a CreateRegionFromGC request, as it is read when tracing a connection.
+type CreateRegionFromGCRequest struct {
+	Region Region
+	Gc     xproto.Gcontext
+}
+
+// createRegionFromGCRequestRead reads a byte slice in the byte order 'order' into a CreateRegionFromGCRequest value.
+func createRegionFromGCRequestRead(buf []byte, order xgb.ByteOrder) *CreateRegionFromGCRequest {
+	v := new(CreateRegionFromGCRequest)
+	b := 4 // skip request header
+
+	v.Region = Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = xproto.Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][8] = xgb.RequestInfo{
+		Name: "CreateRegionFromGC",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createRegionFromGCRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateRegionFromPictureCookie is a cookie used only for CreateRegionFromPicture requests.
 type CreateRegionFromPictureCookie struct {
 	*xgb.Cookie
@@ -958,12 +1500,26 @@ func (cook CreateRegionFromPictureCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateRegionFromPictureCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateRegionFromPicture
 // createRegionFromPictureRequest writes a CreateRegionFromPicture request to a byte slice.
 func createRegionFromPictureRequest(c *xgb.Conn, Region Region, Picture render.Picture) []byte {
+	return AppendCreateRegionFromPictureRequest(nil, c, Region, Picture)
+}
+
+// AppendCreateRegionFromPictureRequest appends a CreateRegionFromPicture request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateRegionFromPictureRequest(buf []byte, c *xgb.Conn, Region Region, Picture render.Picture) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -973,33 +1529,62 @@ func createRegionFromPictureRequest(c *xgb.Conn, Region Region, Picture render.P
 	buf[b] = 9 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Region))
+	order.Put32(buf[b:], uint32(Region))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Picture))
+	order.Put32(buf[b:], uint32(Picture))
 	b += 4
 
 	return buf
 }
 
-// CreateRegionFromWindowCookie is a cookie used only for CreateRegionFromWindow requests.
-type CreateRegionFromWindowCookie struct {
-	*xgb.Cookie
+// CreateRegionFromPictureRequest represents the data of a CreateRegionFromPicture request, as it is read when tracing a connection.
+type CreateRegionFromPictureRequest struct {
+	Region  Region
+	Picture render.Picture
 }
 
-// CreateRegionFromWindow sends an unchecked request.
-// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func CreateRegionFromWindow(c *xgb.Conn, Region Region, Window xproto.Window, Kind shape.Kind) CreateRegionFromWindowCookie {
-	c.ExtLock.RLock()
-	defer c.ExtLock.RUnlock()
-	if _, ok := c.Extensions["XFIXES"]; !ok {
-		panic("Cannot issue request 'CreateRegionFromWindow' using the uninitialized extension 'XFIXES'. xfixes.Init(connObj) must be called first.")
-	}
-	cookie := c.NewCookie(false, false)
-	c.NewRequest(createRegionFromWindowRequest(c, Region, Window, Kind), cookie)
+// createRegionFromPictureRequestRead reads a byte slice in the byte order 'order' into a CreateRegionFromPictureRequest value.
+func createRegionFromPictureRequestRead(buf []byte, order xgb.ByteOrder) *CreateRegionFromPictureRequest {
+	v := new(CreateRegionFromPictureRequest)
+	b := 4 // skip request header
+
+	v.Region = Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.Picture = render.Picture(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][9] = xgb.RequestInfo{
+		Name: "CreateRegionFromPicture",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createRegionFromPictureRequestRead(buf, order)
+		},
+	}
+}
+
+// CreateRegionFromWindowCookie is a cookie used only for CreateRegionFromWindow requests.
+type CreateRegionFromWindowCookie struct {
+	*xgb.Cookie
+}
+
+// CreateRegionFromWindow sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func CreateRegionFromWindow(c *xgb.Conn, Region Region, Window xproto.Window, Kind shape.Kind) CreateRegionFromWindowCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["XFIXES"]; !ok {
+		panic("Cannot issue request 'CreateRegionFromWindow' using the uninitialized extension 'XFIXES'. xfixes.Init(connObj) must be called first.")
+	}
+	cookie := c.NewCookie(false, false)
+	c.NewRequest(createRegionFromWindowRequest(c, Region, Window, Kind), cookie)
 	return CreateRegionFromWindowCookie{cookie}
 }
 
@@ -1022,12 +1607,26 @@ func (cook CreateRegionFromWindowCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateRegionFromWindowCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateRegionFromWindow
 // createRegionFromWindowRequest writes a CreateRegionFromWindow request to a byte slice.
 func createRegionFromWindowRequest(c *xgb.Conn, Region Region, Window xproto.Window, Kind shape.Kind) []byte {
+	return AppendCreateRegionFromWindowRequest(nil, c, Region, Window, Kind)
+}
+
+// AppendCreateRegionFromWindowRequest appends a CreateRegionFromWindow request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateRegionFromWindowRequest(buf []byte, c *xgb.Conn, Region Region, Window xproto.Window, Kind shape.Kind) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -1037,13 +1636,13 @@ func createRegionFromWindowRequest(c *xgb.Conn, Region Region, Window xproto.Win
 	buf[b] = 7 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Region))
+	order.Put32(buf[b:], uint32(Region))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	buf[b] = byte(Kind)
@@ -1054,6 +1653,42 @@ func createRegionFromWindowRequest(c *xgb.Conn, Region Region, Window xproto.Win
 	return buf
 }
 
+// CreateRegionFromWindowRequest represents the data of a CreateRegionFromWindow request, as it is read when tracing a connection.
+type CreateRegionFromWindowRequest struct {
+	Region Region
+	Window xproto.Window
+	Kind   shape.Kind
+	// padding: 3 bytes
+}
+
+// createRegionFromWindowRequestRead reads a byte slice in the byte order 'order' into a CreateRegionFromWindowRequest value.
+func createRegionFromWindowRequestRead(buf []byte, order xgb.ByteOrder) *CreateRegionFromWindowRequest {
+	v := new(CreateRegionFromWindowRequest)
+	b := 4 // skip request header
+
+	v.Region = Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Kind = shape.Kind(buf[b])
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][7] = xgb.RequestInfo{
+		Name: "CreateRegionFromWindow",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createRegionFromWindowRequestRead(buf, order)
+		},
+	}
+}
+
 // DeletePointerBarrierCookie is a cookie used only for DeletePointerBarrier requests.
 type DeletePointerBarrierCookie struct {
 	*xgb.Cookie
@@ -1091,12 +1726,26 @@ func (cook DeletePointerBarrierCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DeletePointerBarrierCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DeletePointerBarrier
 // deletePointerBarrierRequest writes a DeletePointerBarrier request to a byte slice.
 func deletePointerBarrierRequest(c *xgb.Conn, Barrier Barrier) []byte {
+	return AppendDeletePointerBarrierRequest(nil, c, Barrier)
+}
+
+// AppendDeletePointerBarrierRequest appends a DeletePointerBarrier request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDeletePointerBarrierRequest(buf []byte, c *xgb.Conn, Barrier Barrier) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -1106,15 +1755,40 @@ func deletePointerBarrierRequest(c *xgb.Conn, Barrier Barrier) []byte {
 	buf[b] = 32 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Barrier))
+	order.Put32(buf[b:], uint32(Barrier))
 	b += 4
 
 	return buf
 }
 
+// DeletePointerBarrierRequest represents the data of a DeletePointerBarrier request, as it is read when tracing a connection.
+type DeletePointerBarrierRequest struct {
+	Barrier Barrier
+}
+
+// deletePointerBarrierRequestRead reads a byte slice in the byte order 'order' into a DeletePointerBarrierRequest value.
+func deletePointerBarrierRequestRead(buf []byte, order xgb.ByteOrder) *DeletePointerBarrierRequest {
+	v := new(DeletePointerBarrierRequest)
+	b := 4 // skip request header
+
+	v.Barrier = Barrier(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][32] = xgb.RequestInfo{
+		Name: "DeletePointerBarrier",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return deletePointerBarrierRequestRead(buf, order)
+		},
+	}
+}
+
 // DestroyRegionCookie is a cookie used only for DestroyRegion requests.
 type DestroyRegionCookie struct {
 	*xgb.Cookie
@@ -1152,12 +1826,26 @@ func (cook DestroyRegionCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DestroyRegionCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DestroyRegion
 // destroyRegionRequest writes a DestroyRegion request to a byte slice.
 func destroyRegionRequest(c *xgb.Conn, Region Region) []byte {
+	return AppendDestroyRegionRequest(nil, c, Region)
+}
+
+// AppendDestroyRegionRequest appends a DestroyRegion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDestroyRegionRequest(buf []byte, c *xgb.Conn, Region Region) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -1167,15 +1855,40 @@ func destroyRegionRequest(c *xgb.Conn, Region Region) []byte {
 	buf[b] = 10 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Region))
+	order.Put32(buf[b:], uint32(Region))
 	b += 4
 
 	return buf
 }
 
+// DestroyRegionRequest represents the data of a DestroyRegion request, as it is read when tracing a connection.
+type DestroyRegionRequest struct {
+	Region Region
+}
+
+// destroyRegionRequestRead reads a byte slice in the byte order 'order' into a DestroyRegionRequest value.
+func destroyRegionRequestRead(buf []byte, order xgb.ByteOrder) *DestroyRegionRequest {
+	v := new(DestroyRegionRequest)
+	b := 4 // skip request header
+
+	v.Region = Region(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][10] = xgb.RequestInfo{
+		Name: "DestroyRegion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return destroyRegionRequestRead(buf, order)
+		},
+	}
+}
+
 // ExpandRegionCookie is a cookie used only for ExpandRegion requests.
 type ExpandRegionCookie struct {
 	*xgb.Cookie
@@ -1213,12 +1926,26 @@ func (cook ExpandRegionCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ExpandRegionCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ExpandRegion
 // expandRegionRequest writes a ExpandRegion request to a byte slice.
 func expandRegionRequest(c *xgb.Conn, Source Region, Destination Region, Left uint16, Right uint16, Top uint16, Bottom uint16) []byte {
+	return AppendExpandRegionRequest(nil, c, Source, Destination, Left, Right, Top, Bottom)
+}
+
+// AppendExpandRegionRequest appends a ExpandRegion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendExpandRegionRequest(buf []byte, c *xgb.Conn, Source Region, Destination Region, Left uint16, Right uint16, Top uint16, Bottom uint16) []byte {
 	size := 20
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -1228,30 +1955,75 @@ func expandRegionRequest(c *xgb.Conn, Source Region, Destination Region, Left ui
 	buf[b] = 28 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Source))
+	order.Put32(buf[b:], uint32(Source))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Destination))
+	order.Put32(buf[b:], uint32(Destination))
 	b += 4
 
-	xgb.Put16(buf[b:], Left)
+	order.Put16(buf[b:], Left)
 	b += 2
 
-	xgb.Put16(buf[b:], Right)
+	order.Put16(buf[b:], Right)
 	b += 2
 
-	xgb.Put16(buf[b:], Top)
+	order.Put16(buf[b:], Top)
 	b += 2
 
-	xgb.Put16(buf[b:], Bottom)
+	order.Put16(buf[b:], Bottom)
 	b += 2
 
 	return buf
 }
 
+// ExpandRegionRequest represents the data of a ExpandRegion request, as it is read when tracing a connection.
+type ExpandRegionRequest struct {
+	Source      Region
+	Destination Region
+	Left        uint16
+	Right       uint16
+	Top         uint16
+	Bottom      uint16
+}
+
+// expandRegionRequestRead reads a byte slice in the byte order 'order' into a ExpandRegionRequest value.
+func expandRegionRequestRead(buf []byte, order xgb.ByteOrder) *ExpandRegionRequest {
+	v := new(ExpandRegionRequest)
+	b := 4 // skip request header
+
+	v.Source = Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.Destination = Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.Left = order.Get16(buf[b:])
+	b += 2
+
+	v.Right = order.Get16(buf[b:])
+	b += 2
+
+	v.Top = order.Get16(buf[b:])
+	b += 2
+
+	v.Bottom = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][28] = xgb.RequestInfo{
+		Name: "ExpandRegion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return expandRegionRequestRead(buf, order)
+		},
+	}
+}
+
 // FetchRegionCookie is a cookie used only for FetchRegion requests.
 type FetchRegionCookie struct {
 	*xgb.Cookie
@@ -1302,29 +2074,41 @@ func (cook FetchRegionCookie) Reply() (*FetchRegionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return fetchRegionReply(buf), nil
+	return fetchRegionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a FetchRegion request arrives.
+func (cook FetchRegionCookie) ReplyContext(ctx context.Context) (*FetchRegionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return fetchRegionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// fetchRegionReply reads a byte slice into a FetchRegionReply value.
-func fetchRegionReply(buf []byte) *FetchRegionReply {
+// fetchRegionReply reads a byte slice in the byte order 'order' into a FetchRegionReply value.
+func fetchRegionReply(buf []byte, order xgb.ByteOrder) *FetchRegionReply {
 	v := new(FetchRegionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	v.Extents = xproto.Rectangle{}
-	b += xproto.RectangleRead(buf[b:], &v.Extents)
+	b += xproto.RectangleRead(buf[b:], order, &v.Extents)
 
 	b += 16 // padding
 
 	v.Rectangles = make([]xproto.Rectangle, (int(v.Length) / 2))
-	b += xproto.RectangleReadList(buf[b:], v.Rectangles)
+	b += xproto.RectangleReadList(buf[b:], order, v.Rectangles)
 
 	return v
 }
@@ -1332,9 +2116,18 @@ func fetchRegionReply(buf []byte) *FetchRegionReply {
 // Write request to wire for FetchRegion
 // fetchRegionRequest writes a FetchRegion request to a byte slice.
 func fetchRegionRequest(c *xgb.Conn, Region Region) []byte {
+	return AppendFetchRegionRequest(nil, c, Region)
+}
+
+// AppendFetchRegionRequest appends a FetchRegion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendFetchRegionRequest(buf []byte, c *xgb.Conn, Region Region) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -1344,15 +2137,43 @@ func fetchRegionRequest(c *xgb.Conn, Region Region) []byte {
 	buf[b] = 19 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Region))
+	order.Put32(buf[b:], uint32(Region))
 	b += 4
 
 	return buf
 }
 
+// FetchRegionRequest represents the data of a FetchRegion request, as it is read when tracing a connection.
+type FetchRegionRequest struct {
+	Region Region
+}
+
+// fetchRegionRequestRead reads a byte slice in the byte order 'order' into a FetchRegionRequest value.
+func fetchRegionRequestRead(buf []byte, order xgb.ByteOrder) *FetchRegionRequest {
+	v := new(FetchRegionRequest)
+	b := 4 // skip request header
+
+	v.Region = Region(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][19] = xgb.RequestInfo{
+		Name: "FetchRegion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return fetchRegionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return fetchRegionReply(buf, order)
+		},
+	}
+}
+
 // GetCursorImageCookie is a cookie used only for GetCursorImage requests.
 type GetCursorImageCookie struct {
 	*xgb.Cookie
@@ -1409,48 +2230,60 @@ func (cook GetCursorImageCookie) Reply() (*GetCursorImageReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getCursorImageReply(buf), nil
+	return getCursorImageReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetCursorImage request arrives.
+func (cook GetCursorImageCookie) ReplyContext(ctx context.Context) (*GetCursorImageReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getCursorImageReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getCursorImageReply reads a byte slice into a GetCursorImageReply value.
-func getCursorImageReply(buf []byte) *GetCursorImageReply {
+// getCursorImageReply reads a byte slice in the byte order 'order' into a GetCursorImageReply value.
+func getCursorImageReply(buf []byte, order xgb.ByteOrder) *GetCursorImageReply {
 	v := new(GetCursorImageReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.X = int16(xgb.Get16(buf[b:]))
+	v.X = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Y = int16(xgb.Get16(buf[b:]))
+	v.Y = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
-	v.Xhot = xgb.Get16(buf[b:])
+	v.Xhot = order.Get16(buf[b:])
 	b += 2
 
-	v.Yhot = xgb.Get16(buf[b:])
+	v.Yhot = order.Get16(buf[b:])
 	b += 2
 
-	v.CursorSerial = xgb.Get32(buf[b:])
+	v.CursorSerial = order.Get32(buf[b:])
 	b += 4
 
 	b += 8 // padding
 
 	v.CursorImage = make([]uint32, (int(v.Width) * int(v.Height)))
 	for i := 0; i < int((int(v.Width) * int(v.Height))); i++ {
-		v.CursorImage[i] = xgb.Get32(buf[b:])
+		v.CursorImage[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -1460,9 +2293,18 @@ func getCursorImageReply(buf []byte) *GetCursorImageReply {
 // Write request to wire for GetCursorImage
 // getCursorImageRequest writes a GetCursorImage request to a byte slice.
 func getCursorImageRequest(c *xgb.Conn) []byte {
+	return AppendGetCursorImageRequest(nil, c)
+}
+
+// AppendGetCursorImageRequest appends a GetCursorImage request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetCursorImageRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -1472,12 +2314,34 @@ func getCursorImageRequest(c *xgb.Conn) []byte {
 	buf[b] = 4 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// GetCursorImageRequest represents the data of a GetCursorImage request, as it is read when tracing a connection.
+type GetCursorImageRequest struct {
+}
+
+// getCursorImageRequestRead reads a byte slice in the byte order 'order' into a GetCursorImageRequest value.
+func getCursorImageRequestRead(buf []byte, order xgb.ByteOrder) *GetCursorImageRequest {
+	v := new(GetCursorImageRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][4] = xgb.RequestInfo{
+		Name: "GetCursorImage",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getCursorImageRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getCursorImageReply(buf, order)
+		},
+	}
+}
+
 // GetCursorImageAndNameCookie is a cookie used only for GetCursorImageAndName requests.
 type GetCursorImageAndNameCookie struct {
 	*xgb.Cookie
@@ -1538,47 +2402,59 @@ func (cook GetCursorImageAndNameCookie) Reply() (*GetCursorImageAndNameReply, er
 	if buf == nil {
 		return nil, nil
 	}
-	return getCursorImageAndNameReply(buf), nil
+	return getCursorImageAndNameReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetCursorImageAndName request arrives.
+func (cook GetCursorImageAndNameCookie) ReplyContext(ctx context.Context) (*GetCursorImageAndNameReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getCursorImageAndNameReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getCursorImageAndNameReply reads a byte slice into a GetCursorImageAndNameReply value.
-func getCursorImageAndNameReply(buf []byte) *GetCursorImageAndNameReply {
+// getCursorImageAndNameReply reads a byte slice in the byte order 'order' into a GetCursorImageAndNameReply value.
+func getCursorImageAndNameReply(buf []byte, order xgb.ByteOrder) *GetCursorImageAndNameReply {
 	v := new(GetCursorImageAndNameReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.X = int16(xgb.Get16(buf[b:]))
+	v.X = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Y = int16(xgb.Get16(buf[b:]))
+	v.Y = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
-	v.Xhot = xgb.Get16(buf[b:])
+	v.Xhot = order.Get16(buf[b:])
 	b += 2
 
-	v.Yhot = xgb.Get16(buf[b:])
+	v.Yhot = order.Get16(buf[b:])
 	b += 2
 
-	v.CursorSerial = xgb.Get32(buf[b:])
+	v.CursorSerial = order.Get32(buf[b:])
 	b += 4
 
-	v.CursorAtom = xproto.Atom(xgb.Get32(buf[b:]))
+	v.CursorAtom = xproto.Atom(order.Get32(buf[b:]))
 	b += 4
 
-	v.Nbytes = xgb.Get16(buf[b:])
+	v.Nbytes = order.Get16(buf[b:])
 	b += 2
 
 	b += 2 // padding
@@ -1594,7 +2470,7 @@ func getCursorImageAndNameReply(buf []byte) *GetCursorImageAndNameReply {
 
 	v.CursorImage = make([]uint32, (int(v.Width) * int(v.Height)))
 	for i := 0; i < int((int(v.Width) * int(v.Height))); i++ {
-		v.CursorImage[i] = xgb.Get32(buf[b:])
+		v.CursorImage[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -1604,9 +2480,18 @@ func getCursorImageAndNameReply(buf []byte) *GetCursorImageAndNameReply {
 // Write request to wire for GetCursorImageAndName
 // getCursorImageAndNameRequest writes a GetCursorImageAndName request to a byte slice.
 func getCursorImageAndNameRequest(c *xgb.Conn) []byte {
+	return AppendGetCursorImageAndNameRequest(nil, c)
+}
+
+// AppendGetCursorImageAndNameRequest appends a GetCursorImageAndName request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetCursorImageAndNameRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -1616,12 +2501,34 @@ func getCursorImageAndNameRequest(c *xgb.Conn) []byte {
 	buf[b] = 25 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// GetCursorImageAndNameRequest represents the data of a GetCursorImageAndName request, as it is read when tracing a connection.
+type GetCursorImageAndNameRequest struct {
+}
+
+// getCursorImageAndNameRequestRead reads a byte slice in the byte order 'order' into a GetCursorImageAndNameRequest value.
+func getCursorImageAndNameRequestRead(buf []byte, order xgb.ByteOrder) *GetCursorImageAndNameRequest {
+	v := new(GetCursorImageAndNameRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][25] = xgb.RequestInfo{
+		Name: "GetCursorImageAndName",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getCursorImageAndNameRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getCursorImageAndNameReply(buf, order)
+		},
+	}
+}
+
 // GetCursorNameCookie is a cookie used only for GetCursorName requests.
 type GetCursorNameCookie struct {
 	*xgb.Cookie
@@ -1673,26 +2580,38 @@ func (cook GetCursorNameCookie) Reply() (*GetCursorNameReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getCursorNameReply(buf), nil
+	return getCursorNameReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetCursorName request arrives.
+func (cook GetCursorNameCookie) ReplyContext(ctx context.Context) (*GetCursorNameReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getCursorNameReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getCursorNameReply reads a byte slice into a GetCursorNameReply value.
-func getCursorNameReply(buf []byte) *GetCursorNameReply {
+// getCursorNameReply reads a byte slice in the byte order 'order' into a GetCursorNameReply value.
+func getCursorNameReply(buf []byte, order xgb.ByteOrder) *GetCursorNameReply {
 	v := new(GetCursorNameReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Atom = xproto.Atom(xgb.Get32(buf[b:]))
+	v.Atom = xproto.Atom(order.Get32(buf[b:]))
 	b += 4
 
-	v.Nbytes = xgb.Get16(buf[b:])
+	v.Nbytes = order.Get16(buf[b:])
 	b += 2
 
 	b += 18 // padding
@@ -1710,9 +2629,18 @@ func getCursorNameReply(buf []byte) *GetCursorNameReply {
 // Write request to wire for GetCursorName
 // getCursorNameRequest writes a GetCursorName request to a byte slice.
 func getCursorNameRequest(c *xgb.Conn, Cursor xproto.Cursor) []byte {
+	return AppendGetCursorNameRequest(nil, c, Cursor)
+}
+
+// AppendGetCursorNameRequest appends a GetCursorName request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetCursorNameRequest(buf []byte, c *xgb.Conn, Cursor xproto.Cursor) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -1722,15 +2650,43 @@ func getCursorNameRequest(c *xgb.Conn, Cursor xproto.Cursor) []byte {
 	buf[b] = 24 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Cursor))
+	order.Put32(buf[b:], uint32(Cursor))
 	b += 4
 
 	return buf
 }
 
+// GetCursorNameRequest represents the data of a GetCursorName request, as it is read when tracing a connection.
+type GetCursorNameRequest struct {
+	Cursor xproto.Cursor
+}
+
+// getCursorNameRequestRead reads a byte slice in the byte order 'order' into a GetCursorNameRequest value.
+func getCursorNameRequestRead(buf []byte, order xgb.ByteOrder) *GetCursorNameRequest {
+	v := new(GetCursorNameRequest)
+	b := 4 // skip request header
+
+	v.Cursor = xproto.Cursor(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][24] = xgb.RequestInfo{
+		Name: "GetCursorName",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getCursorNameRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getCursorNameReply(buf, order)
+		},
+	}
+}
+
 // HideCursorCookie is a cookie used only for HideCursor requests.
 type HideCursorCookie struct {
 	*xgb.Cookie
@@ -1768,12 +2724,26 @@ func (cook HideCursorCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook HideCursorCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for HideCursor
 // hideCursorRequest writes a HideCursor request to a byte slice.
 func hideCursorRequest(c *xgb.Conn, Window xproto.Window) []byte {
+	return AppendHideCursorRequest(nil, c, Window)
+}
+
+// AppendHideCursorRequest appends a HideCursor request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendHideCursorRequest(buf []byte, c *xgb.Conn, Window xproto.Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -1783,15 +2753,40 @@ func hideCursorRequest(c *xgb.Conn, Window xproto.Window) []byte {
 	buf[b] = 29 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// HideCursorRequest represents the data of a HideCursor request, as it is read when tracing a connection.
+type HideCursorRequest struct {
+	Window xproto.Window
+}
+
+// hideCursorRequestRead reads a byte slice in the byte order 'order' into a HideCursorRequest value.
+func hideCursorRequestRead(buf []byte, order xgb.ByteOrder) *HideCursorRequest {
+	v := new(HideCursorRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][29] = xgb.RequestInfo{
+		Name: "HideCursor",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return hideCursorRequestRead(buf, order)
+		},
+	}
+}
+
 // IntersectRegionCookie is a cookie used only for IntersectRegion requests.
 type IntersectRegionCookie struct {
 	*xgb.Cookie
@@ -1829,12 +2824,26 @@ func (cook IntersectRegionCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook IntersectRegionCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for IntersectRegion
 // intersectRegionRequest writes a IntersectRegion request to a byte slice.
 func intersectRegionRequest(c *xgb.Conn, Source1 Region, Source2 Region, Destination Region) []byte {
+	return AppendIntersectRegionRequest(nil, c, Source1, Source2, Destination)
+}
+
+// AppendIntersectRegionRequest appends a IntersectRegion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendIntersectRegionRequest(buf []byte, c *xgb.Conn, Source1 Region, Source2 Region, Destination Region) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -1844,21 +2853,54 @@ func intersectRegionRequest(c *xgb.Conn, Source1 Region, Source2 Region, Destina
 	buf[b] = 14 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Source1))
+	order.Put32(buf[b:], uint32(Source1))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Source2))
+	order.Put32(buf[b:], uint32(Source2))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Destination))
+	order.Put32(buf[b:], uint32(Destination))
 	b += 4
 
 	return buf
 }
 
+// IntersectRegionRequest represents the data of a IntersectRegion request, as it is read when tracing a connection.
+type IntersectRegionRequest struct {
+	Source1     Region
+	Source2     Region
+	Destination Region
+}
+
+// intersectRegionRequestRead reads a byte slice in the byte order 'order' into a IntersectRegionRequest value.
+func intersectRegionRequestRead(buf []byte, order xgb.ByteOrder) *IntersectRegionRequest {
+	v := new(IntersectRegionRequest)
+	b := 4 // skip request header
+
+	v.Source1 = Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.Source2 = Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.Destination = Region(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][14] = xgb.RequestInfo{
+		Name: "IntersectRegion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return intersectRegionRequestRead(buf, order)
+		},
+	}
+}
+
 // InvertRegionCookie is a cookie used only for InvertRegion requests.
 type InvertRegionCookie struct {
 	*xgb.Cookie
@@ -1896,12 +2938,26 @@ func (cook InvertRegionCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook InvertRegionCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for InvertRegion
 // invertRegionRequest writes a InvertRegion request to a byte slice.
 func invertRegionRequest(c *xgb.Conn, Source Region, Bounds xproto.Rectangle, Destination Region) []byte {
+	return AppendInvertRegionRequest(nil, c, Source, Bounds, Destination)
+}
+
+// AppendInvertRegionRequest appends a InvertRegion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendInvertRegionRequest(buf []byte, c *xgb.Conn, Source Region, Bounds xproto.Rectangle, Destination Region) []byte {
 	size := 20
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -1911,24 +2967,57 @@ func invertRegionRequest(c *xgb.Conn, Source Region, Bounds xproto.Rectangle, De
 	buf[b] = 16 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Source))
+	order.Put32(buf[b:], uint32(Source))
 	b += 4
 
 	{
-		structBytes := Bounds.Bytes()
+		structBytes := Bounds.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 
-	xgb.Put32(buf[b:], uint32(Destination))
+	order.Put32(buf[b:], uint32(Destination))
 	b += 4
 
 	return buf
 }
 
+// InvertRegionRequest represents the data of a InvertRegion request, as it is read when tracing a connection.
+type InvertRegionRequest struct {
+	Source      Region
+	Bounds      xproto.Rectangle
+	Destination Region
+}
+
+// invertRegionRequestRead reads a byte slice in the byte order 'order' into a InvertRegionRequest value.
+func invertRegionRequestRead(buf []byte, order xgb.ByteOrder) *InvertRegionRequest {
+	v := new(InvertRegionRequest)
+	b := 4 // skip request header
+
+	v.Source = Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.Bounds = xproto.Rectangle{}
+	b += xproto.RectangleRead(buf[b:], order, &v.Bounds)
+
+	v.Destination = Region(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][16] = xgb.RequestInfo{
+		Name: "InvertRegion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return invertRegionRequestRead(buf, order)
+		},
+	}
+}
+
 // QueryVersionCookie is a cookie used only for QueryVersion requests.
 type QueryVersionCookie struct {
 	*xgb.Cookie
@@ -1979,26 +3068,38 @@ func (cook QueryVersionCookie) Reply() (*QueryVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryVersionReply(buf), nil
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryVersionReply reads a byte slice into a QueryVersionReply value.
-func queryVersionReply(buf []byte) *QueryVersionReply {
-	v := new(QueryVersionReply)
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryVersion request arrives.
+func (cook QueryVersionCookie) ReplyContext(ctx context.Context) (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryVersionReply reads a byte slice in the byte order 'order' into a QueryVersionReply value.
+func queryVersionReply(buf []byte, order xgb.ByteOrder) *QueryVersionReply {
+	v := new(QueryVersionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.MajorVersion = xgb.Get32(buf[b:])
+	v.MajorVersion = order.Get32(buf[b:])
 	b += 4
 
-	v.MinorVersion = xgb.Get32(buf[b:])
+	v.MinorVersion = order.Get32(buf[b:])
 	b += 4
 
 	b += 16 // padding
@@ -2009,9 +3110,18 @@ func queryVersionReply(buf []byte) *QueryVersionReply {
 // Write request to wire for QueryVersion
 // queryVersionRequest writes a QueryVersion request to a byte slice.
 func queryVersionRequest(c *xgb.Conn, ClientMajorVersion uint32, ClientMinorVersion uint32) []byte {
+	return AppendQueryVersionRequest(nil, c, ClientMajorVersion, ClientMinorVersion)
+}
+
+// AppendQueryVersionRequest appends a QueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryVersionRequest(buf []byte, c *xgb.Conn, ClientMajorVersion uint32, ClientMinorVersion uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -2021,18 +3131,50 @@ func queryVersionRequest(c *xgb.Conn, ClientMajorVersion uint32, ClientMinorVers
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], ClientMajorVersion)
+	order.Put32(buf[b:], ClientMajorVersion)
 	b += 4
 
-	xgb.Put32(buf[b:], ClientMinorVersion)
+	order.Put32(buf[b:], ClientMinorVersion)
 	b += 4
 
 	return buf
 }
 
+// QueryVersionRequest represents the data of a QueryVersion request, as it is read when tracing a connection.
+type QueryVersionRequest struct {
+	ClientMajorVersion uint32
+	ClientMinorVersion uint32
+}
+
+// queryVersionRequestRead reads a byte slice in the byte order 'order' into a QueryVersionRequest value.
+func queryVersionRequestRead(buf []byte, order xgb.ByteOrder) *QueryVersionRequest {
+	v := new(QueryVersionRequest)
+	b := 4 // skip request header
+
+	v.ClientMajorVersion = order.Get32(buf[b:])
+	b += 4
+
+	v.ClientMinorVersion = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][0] = xgb.RequestInfo{
+		Name: "QueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionReply(buf, order)
+		},
+	}
+}
+
 // RegionExtentsCookie is a cookie used only for RegionExtents requests.
 type RegionExtentsCookie struct {
 	*xgb.Cookie
@@ -2070,12 +3212,26 @@ func (cook RegionExtentsCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook RegionExtentsCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for RegionExtents
 // regionExtentsRequest writes a RegionExtents request to a byte slice.
 func regionExtentsRequest(c *xgb.Conn, Source Region, Destination Region) []byte {
+	return AppendRegionExtentsRequest(nil, c, Source, Destination)
+}
+
+// AppendRegionExtentsRequest appends a RegionExtents request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendRegionExtentsRequest(buf []byte, c *xgb.Conn, Source Region, Destination Region) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -2085,18 +3241,47 @@ func regionExtentsRequest(c *xgb.Conn, Source Region, Destination Region) []byte
 	buf[b] = 18 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Source))
+	order.Put32(buf[b:], uint32(Source))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Destination))
+	order.Put32(buf[b:], uint32(Destination))
 	b += 4
 
 	return buf
 }
 
+// RegionExtentsRequest represents the data of a RegionExtents request, as it is read when tracing a connection.
+type RegionExtentsRequest struct {
+	Source      Region
+	Destination Region
+}
+
+// regionExtentsRequestRead reads a byte slice in the byte order 'order' into a RegionExtentsRequest value.
+func regionExtentsRequestRead(buf []byte, order xgb.ByteOrder) *RegionExtentsRequest {
+	v := new(RegionExtentsRequest)
+	b := 4 // skip request header
+
+	v.Source = Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.Destination = Region(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][18] = xgb.RequestInfo{
+		Name: "RegionExtents",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return regionExtentsRequestRead(buf, order)
+		},
+	}
+}
+
 // SelectCursorInputCookie is a cookie used only for SelectCursorInput requests.
 type SelectCursorInputCookie struct {
 	*xgb.Cookie
@@ -2104,7 +3289,7 @@ type SelectCursorInputCookie struct {
 
 // SelectCursorInput sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func SelectCursorInput(c *xgb.Conn, Window xproto.Window, EventMask uint32) SelectCursorInputCookie {
+func SelectCursorInput(c *xgb.Conn, Window xproto.Window, EventMask CursorNotifyMask) SelectCursorInputCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["XFIXES"]; !ok {
@@ -2117,7 +3302,7 @@ func SelectCursorInput(c *xgb.Conn, Window xproto.Window, EventMask uint32) Sele
 
 // SelectCursorInputChecked sends a checked request.
 // If an error occurs, it can be retrieved using SelectCursorInputCookie.Check()
-func SelectCursorInputChecked(c *xgb.Conn, Window xproto.Window, EventMask uint32) SelectCursorInputCookie {
+func SelectCursorInputChecked(c *xgb.Conn, Window xproto.Window, EventMask CursorNotifyMask) SelectCursorInputCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["XFIXES"]; !ok {
@@ -2134,12 +3319,26 @@ func (cook SelectCursorInputCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SelectCursorInputCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SelectCursorInput
 // selectCursorInputRequest writes a SelectCursorInput request to a byte slice.
-func selectCursorInputRequest(c *xgb.Conn, Window xproto.Window, EventMask uint32) []byte {
+func selectCursorInputRequest(c *xgb.Conn, Window xproto.Window, EventMask CursorNotifyMask) []byte {
+	return AppendSelectCursorInputRequest(nil, c, Window, EventMask)
+}
+
+// AppendSelectCursorInputRequest appends a SelectCursorInput request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSelectCursorInputRequest(buf []byte, c *xgb.Conn, Window xproto.Window, EventMask CursorNotifyMask) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -2149,18 +3348,47 @@ func selectCursorInputRequest(c *xgb.Conn, Window xproto.Window, EventMask uint3
 	buf[b] = 3 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put32(buf[b:], EventMask)
+	order.Put32(buf[b:], uint32(EventMask))
 	b += 4
 
 	return buf
 }
 
+// SelectCursorInputRequest represents the data of a SelectCursorInput request, as it is read when tracing a connection.
+type SelectCursorInputRequest struct {
+	Window    xproto.Window
+	EventMask CursorNotifyMask
+}
+
+// selectCursorInputRequestRead reads a byte slice in the byte order 'order' into a SelectCursorInputRequest value.
+func selectCursorInputRequestRead(buf []byte, order xgb.ByteOrder) *SelectCursorInputRequest {
+	v := new(SelectCursorInputRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.EventMask = CursorNotifyMask(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][3] = xgb.RequestInfo{
+		Name: "SelectCursorInput",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return selectCursorInputRequestRead(buf, order)
+		},
+	}
+}
+
 // SelectSelectionInputCookie is a cookie used only for SelectSelectionInput requests.
 type SelectSelectionInputCookie struct {
 	*xgb.Cookie
@@ -2168,7 +3396,7 @@ type SelectSelectionInputCookie struct {
 
 // SelectSelectionInput sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func SelectSelectionInput(c *xgb.Conn, Window xproto.Window, Selection xproto.Atom, EventMask uint32) SelectSelectionInputCookie {
+func SelectSelectionInput(c *xgb.Conn, Window xproto.Window, Selection xproto.Atom, EventMask SelectionEventMask) SelectSelectionInputCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["XFIXES"]; !ok {
@@ -2181,7 +3409,7 @@ func SelectSelectionInput(c *xgb.Conn, Window xproto.Window, Selection xproto.At
 
 // SelectSelectionInputChecked sends a checked request.
 // If an error occurs, it can be retrieved using SelectSelectionInputCookie.Check()
-func SelectSelectionInputChecked(c *xgb.Conn, Window xproto.Window, Selection xproto.Atom, EventMask uint32) SelectSelectionInputCookie {
+func SelectSelectionInputChecked(c *xgb.Conn, Window xproto.Window, Selection xproto.Atom, EventMask SelectionEventMask) SelectSelectionInputCookie {
 	c.ExtLock.RLock()
 	defer c.ExtLock.RUnlock()
 	if _, ok := c.Extensions["XFIXES"]; !ok {
@@ -2198,12 +3426,26 @@ func (cook SelectSelectionInputCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SelectSelectionInputCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SelectSelectionInput
 // selectSelectionInputRequest writes a SelectSelectionInput request to a byte slice.
-func selectSelectionInputRequest(c *xgb.Conn, Window xproto.Window, Selection xproto.Atom, EventMask uint32) []byte {
+func selectSelectionInputRequest(c *xgb.Conn, Window xproto.Window, Selection xproto.Atom, EventMask SelectionEventMask) []byte {
+	return AppendSelectSelectionInputRequest(nil, c, Window, Selection, EventMask)
+}
+
+// AppendSelectSelectionInputRequest appends a SelectSelectionInput request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSelectSelectionInputRequest(buf []byte, c *xgb.Conn, Window xproto.Window, Selection xproto.Atom, EventMask SelectionEventMask) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -2213,21 +3455,54 @@ func selectSelectionInputRequest(c *xgb.Conn, Window xproto.Window, Selection xp
 	buf[b] = 2 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Selection))
+	order.Put32(buf[b:], uint32(Selection))
 	b += 4
 
-	xgb.Put32(buf[b:], EventMask)
+	order.Put32(buf[b:], uint32(EventMask))
 	b += 4
 
 	return buf
 }
 
+// SelectSelectionInputRequest represents the data of a SelectSelectionInput request, as it is read when tracing a connection.
+type SelectSelectionInputRequest struct {
+	Window    xproto.Window
+	Selection xproto.Atom
+	EventMask SelectionEventMask
+}
+
+// selectSelectionInputRequestRead reads a byte slice in the byte order 'order' into a SelectSelectionInputRequest value.
+func selectSelectionInputRequestRead(buf []byte, order xgb.ByteOrder) *SelectSelectionInputRequest {
+	v := new(SelectSelectionInputRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Selection = xproto.Atom(order.Get32(buf[b:]))
+	b += 4
+
+	v.EventMask = SelectionEventMask(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][2] = xgb.RequestInfo{
+		Name: "SelectSelectionInput",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return selectSelectionInputRequestRead(buf, order)
+		},
+	}
+}
+
 // SetCursorNameCookie is a cookie used only for SetCursorName requests.
 type SetCursorNameCookie struct {
 	*xgb.Cookie
@@ -2265,12 +3540,26 @@ func (cook SetCursorNameCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetCursorNameCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetCursorName
 // setCursorNameRequest writes a SetCursorName request to a byte slice.
 func setCursorNameRequest(c *xgb.Conn, Cursor xproto.Cursor, Nbytes uint16, Name string) []byte {
+	return AppendSetCursorNameRequest(nil, c, Cursor, Nbytes, Name)
+}
+
+// AppendSetCursorNameRequest appends a SetCursorName request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetCursorNameRequest(buf []byte, c *xgb.Conn, Cursor xproto.Cursor, Nbytes uint16, Name string) []byte {
 	size := xgb.Pad((12 + xgb.Pad((int(Nbytes) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -2280,13 +3569,13 @@ func setCursorNameRequest(c *xgb.Conn, Cursor xproto.Cursor, Nbytes uint16, Name
 	buf[b] = 23 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Cursor))
+	order.Put32(buf[b:], uint32(Cursor))
 	b += 4
 
-	xgb.Put16(buf[b:], Nbytes)
+	order.Put16(buf[b:], Nbytes)
 	b += 2
 
 	b += 2 // padding
@@ -2297,6 +3586,46 @@ func setCursorNameRequest(c *xgb.Conn, Cursor xproto.Cursor, Nbytes uint16, Name
 	return buf
 }
 
+// SetCursorNameRequest represents the data of a SetCursorName request, as it is read when tracing a connection.
+type SetCursorNameRequest struct {
+	Cursor xproto.Cursor
+	Nbytes uint16
+	// padding: 2 bytes
+	Name string // size: xgb.Pad((int(Nbytes) * 1))
+}
+
+// setCursorNameRequestRead reads a byte slice in the byte order 'order' into a SetCursorNameRequest value.
+func setCursorNameRequestRead(buf []byte, order xgb.ByteOrder) *SetCursorNameRequest {
+	v := new(SetCursorNameRequest)
+	b := 4 // skip request header
+
+	v.Cursor = xproto.Cursor(order.Get32(buf[b:]))
+	b += 4
+
+	v.Nbytes = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	{
+		byteString := make([]byte, v.Nbytes)
+		copy(byteString[:v.Nbytes], buf[b:])
+		v.Name = string(byteString)
+		b += int(v.Nbytes)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][23] = xgb.RequestInfo{
+		Name: "SetCursorName",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setCursorNameRequestRead(buf, order)
+		},
+	}
+}
+
 // SetGCClipRegionCookie is a cookie used only for SetGCClipRegion requests.
 type SetGCClipRegionCookie struct {
 	*xgb.Cookie
@@ -2334,12 +3663,26 @@ func (cook SetGCClipRegionCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetGCClipRegionCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetGCClipRegion
 // setGCClipRegionRequest writes a SetGCClipRegion request to a byte slice.
 func setGCClipRegionRequest(c *xgb.Conn, Gc xproto.Gcontext, Region Region, XOrigin int16, YOrigin int16) []byte {
+	return AppendSetGCClipRegionRequest(nil, c, Gc, Region, XOrigin, YOrigin)
+}
+
+// AppendSetGCClipRegionRequest appends a SetGCClipRegion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetGCClipRegionRequest(buf []byte, c *xgb.Conn, Gc xproto.Gcontext, Region Region, XOrigin int16, YOrigin int16) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -2349,24 +3692,61 @@ func setGCClipRegionRequest(c *xgb.Conn, Gc xproto.Gcontext, Region Region, XOri
 	buf[b] = 20 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Region))
+	order.Put32(buf[b:], uint32(Region))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(XOrigin))
+	order.Put16(buf[b:], uint16(XOrigin))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(YOrigin))
+	order.Put16(buf[b:], uint16(YOrigin))
 	b += 2
 
 	return buf
 }
 
+// SetGCClipRegionRequest represents the data of a SetGCClipRegion request, as it is read when tracing a connection.
+type SetGCClipRegionRequest struct {
+	Gc      xproto.Gcontext
+	Region  Region
+	XOrigin int16
+	YOrigin int16
+}
+
+// setGCClipRegionRequestRead reads a byte slice in the byte order 'order' into a SetGCClipRegionRequest value.
+func setGCClipRegionRequestRead(buf []byte, order xgb.ByteOrder) *SetGCClipRegionRequest {
+	v := new(SetGCClipRegionRequest)
+	b := 4 // skip request header
+
+	v.Gc = xproto.Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.Region = Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.XOrigin = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.YOrigin = int16(order.Get16(buf[b:]))
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][20] = xgb.RequestInfo{
+		Name: "SetGCClipRegion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setGCClipRegionRequestRead(buf, order)
+		},
+	}
+}
+
 // SetPictureClipRegionCookie is a cookie used only for SetPictureClipRegion requests.
 type SetPictureClipRegionCookie struct {
 	*xgb.Cookie
@@ -2404,12 +3784,26 @@ func (cook SetPictureClipRegionCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetPictureClipRegionCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetPictureClipRegion
 // setPictureClipRegionRequest writes a SetPictureClipRegion request to a byte slice.
 func setPictureClipRegionRequest(c *xgb.Conn, Picture render.Picture, Region Region, XOrigin int16, YOrigin int16) []byte {
+	return AppendSetPictureClipRegionRequest(nil, c, Picture, Region, XOrigin, YOrigin)
+}
+
+// AppendSetPictureClipRegionRequest appends a SetPictureClipRegion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetPictureClipRegionRequest(buf []byte, c *xgb.Conn, Picture render.Picture, Region Region, XOrigin int16, YOrigin int16) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -2419,24 +3813,61 @@ func setPictureClipRegionRequest(c *xgb.Conn, Picture render.Picture, Region Reg
 	buf[b] = 22 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Picture))
+	order.Put32(buf[b:], uint32(Picture))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Region))
+	order.Put32(buf[b:], uint32(Region))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(XOrigin))
+	order.Put16(buf[b:], uint16(XOrigin))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(YOrigin))
+	order.Put16(buf[b:], uint16(YOrigin))
 	b += 2
 
 	return buf
 }
 
+// SetPictureClipRegionRequest represents the data of a SetPictureClipRegion request, as it is read when tracing a connection.
+type SetPictureClipRegionRequest struct {
+	Picture render.Picture
+	Region  Region
+	XOrigin int16
+	YOrigin int16
+}
+
+// setPictureClipRegionRequestRead reads a byte slice in the byte order 'order' into a SetPictureClipRegionRequest value.
+func setPictureClipRegionRequestRead(buf []byte, order xgb.ByteOrder) *SetPictureClipRegionRequest {
+	v := new(SetPictureClipRegionRequest)
+	b := 4 // skip request header
+
+	v.Picture = render.Picture(order.Get32(buf[b:]))
+	b += 4
+
+	v.Region = Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.XOrigin = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.YOrigin = int16(order.Get16(buf[b:]))
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][22] = xgb.RequestInfo{
+		Name: "SetPictureClipRegion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setPictureClipRegionRequestRead(buf, order)
+		},
+	}
+}
+
 // SetRegionCookie is a cookie used only for SetRegion requests.
 type SetRegionCookie struct {
 	*xgb.Cookie
@@ -2474,12 +3905,26 @@ func (cook SetRegionCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetRegionCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetRegion
 // setRegionRequest writes a SetRegion request to a byte slice.
 func setRegionRequest(c *xgb.Conn, Region Region, Rectangles []xproto.Rectangle) []byte {
+	return AppendSetRegionRequest(nil, c, Region, Rectangles)
+}
+
+// AppendSetRegionRequest appends a SetRegion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetRegionRequest(buf []byte, c *xgb.Conn, Region Region, Rectangles []xproto.Rectangle) []byte {
 	size := xgb.Pad((8 + xgb.Pad((len(Rectangles) * 8))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -2489,17 +3934,46 @@ func setRegionRequest(c *xgb.Conn, Region Region, Rectangles []xproto.Rectangle)
 	buf[b] = 11 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Region))
+	order.Put32(buf[b:], uint32(Region))
 	b += 4
 
-	b += xproto.RectangleListBytes(buf[b:], Rectangles)
+	b += xproto.RectangleListBytes(buf[b:], order, Rectangles)
 
 	return buf
 }
 
+// SetRegionRequest represents the data of a SetRegion request, as it is read when tracing a connection.
+type SetRegionRequest struct {
+	Region     Region
+	Rectangles []xproto.Rectangle // size: xgb.Pad((len(Rectangles) * 8))
+}
+
+// setRegionRequestRead reads a byte slice in the byte order 'order' into a SetRegionRequest value.
+func setRegionRequestRead(buf []byte, order xgb.ByteOrder) *SetRegionRequest {
+	v := new(SetRegionRequest)
+	b := 4 // skip request header
+
+	v.Region = Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.Rectangles = make([]xproto.Rectangle, (len(buf)-b)/8)
+	b += xproto.RectangleReadList(buf[b:], order, v.Rectangles)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][11] = xgb.RequestInfo{
+		Name: "SetRegion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setRegionRequestRead(buf, order)
+		},
+	}
+}
+
 // SetWindowShapeRegionCookie is a cookie used only for SetWindowShapeRegion requests.
 type SetWindowShapeRegionCookie struct {
 	*xgb.Cookie
@@ -2537,12 +4011,26 @@ func (cook SetWindowShapeRegionCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetWindowShapeRegionCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetWindowShapeRegion
 // setWindowShapeRegionRequest writes a SetWindowShapeRegion request to a byte slice.
 func setWindowShapeRegionRequest(c *xgb.Conn, Dest xproto.Window, DestKind shape.Kind, XOffset int16, YOffset int16, Region Region) []byte {
+	return AppendSetWindowShapeRegionRequest(nil, c, Dest, DestKind, XOffset, YOffset, Region)
+}
+
+// AppendSetWindowShapeRegionRequest appends a SetWindowShapeRegion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetWindowShapeRegionRequest(buf []byte, c *xgb.Conn, Dest xproto.Window, DestKind shape.Kind, XOffset int16, YOffset int16, Region Region) []byte {
 	size := 20
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -2552,10 +4040,10 @@ func setWindowShapeRegionRequest(c *xgb.Conn, Dest xproto.Window, DestKind shape
 	buf[b] = 21 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Dest))
+	order.Put32(buf[b:], uint32(Dest))
 	b += 4
 
 	buf[b] = byte(DestKind)
@@ -2563,18 +4051,62 @@ func setWindowShapeRegionRequest(c *xgb.Conn, Dest xproto.Window, DestKind shape
 
 	b += 3 // padding
 
-	xgb.Put16(buf[b:], uint16(XOffset))
+	order.Put16(buf[b:], uint16(XOffset))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(YOffset))
+	order.Put16(buf[b:], uint16(YOffset))
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Region))
+	order.Put32(buf[b:], uint32(Region))
 	b += 4
 
 	return buf
 }
 
+// SetWindowShapeRegionRequest represents the data of a SetWindowShapeRegion request, as it is read when tracing a connection.
+type SetWindowShapeRegionRequest struct {
+	Dest     xproto.Window
+	DestKind shape.Kind
+	// padding: 3 bytes
+	XOffset int16
+	YOffset int16
+	Region  Region
+}
+
+// setWindowShapeRegionRequestRead reads a byte slice in the byte order 'order' into a SetWindowShapeRegionRequest value.
+func setWindowShapeRegionRequestRead(buf []byte, order xgb.ByteOrder) *SetWindowShapeRegionRequest {
+	v := new(SetWindowShapeRegionRequest)
+	b := 4 // skip request header
+
+	v.Dest = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.DestKind = shape.Kind(buf[b])
+	b += 1
+
+	b += 3 // padding
+
+	v.XOffset = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.YOffset = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Region = Region(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][21] = xgb.RequestInfo{
+		Name: "SetWindowShapeRegion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setWindowShapeRegionRequestRead(buf, order)
+		},
+	}
+}
+
 // ShowCursorCookie is a cookie used only for ShowCursor requests.
 type ShowCursorCookie struct {
 	*xgb.Cookie
@@ -2612,12 +4144,26 @@ func (cook ShowCursorCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ShowCursorCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ShowCursor
 // showCursorRequest writes a ShowCursor request to a byte slice.
 func showCursorRequest(c *xgb.Conn, Window xproto.Window) []byte {
+	return AppendShowCursorRequest(nil, c, Window)
+}
+
+// AppendShowCursorRequest appends a ShowCursor request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendShowCursorRequest(buf []byte, c *xgb.Conn, Window xproto.Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -2627,15 +4173,40 @@ func showCursorRequest(c *xgb.Conn, Window xproto.Window) []byte {
 	buf[b] = 30 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// ShowCursorRequest represents the data of a ShowCursor request, as it is read when tracing a connection.
+type ShowCursorRequest struct {
+	Window xproto.Window
+}
+
+// showCursorRequestRead reads a byte slice in the byte order 'order' into a ShowCursorRequest value.
+func showCursorRequestRead(buf []byte, order xgb.ByteOrder) *ShowCursorRequest {
+	v := new(ShowCursorRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][30] = xgb.RequestInfo{
+		Name: "ShowCursor",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return showCursorRequestRead(buf, order)
+		},
+	}
+}
+
 // SubtractRegionCookie is a cookie used only for SubtractRegion requests.
 type SubtractRegionCookie struct {
 	*xgb.Cookie
@@ -2673,12 +4244,26 @@ func (cook SubtractRegionCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SubtractRegionCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SubtractRegion
 // subtractRegionRequest writes a SubtractRegion request to a byte slice.
 func subtractRegionRequest(c *xgb.Conn, Source1 Region, Source2 Region, Destination Region) []byte {
+	return AppendSubtractRegionRequest(nil, c, Source1, Source2, Destination)
+}
+
+// AppendSubtractRegionRequest appends a SubtractRegion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSubtractRegionRequest(buf []byte, c *xgb.Conn, Source1 Region, Source2 Region, Destination Region) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -2688,21 +4273,54 @@ func subtractRegionRequest(c *xgb.Conn, Source1 Region, Source2 Region, Destinat
 	buf[b] = 15 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Source1))
+	order.Put32(buf[b:], uint32(Source1))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Source2))
+	order.Put32(buf[b:], uint32(Source2))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Destination))
+	order.Put32(buf[b:], uint32(Destination))
 	b += 4
 
 	return buf
 }
 
+// SubtractRegionRequest represents the data of a SubtractRegion request, as it is read when tracing a connection.
+type SubtractRegionRequest struct {
+	Source1     Region
+	Source2     Region
+	Destination Region
+}
+
+// subtractRegionRequestRead reads a byte slice in the byte order 'order' into a SubtractRegionRequest value.
+func subtractRegionRequestRead(buf []byte, order xgb.ByteOrder) *SubtractRegionRequest {
+	v := new(SubtractRegionRequest)
+	b := 4 // skip request header
+
+	v.Source1 = Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.Source2 = Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.Destination = Region(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][15] = xgb.RequestInfo{
+		Name: "SubtractRegion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return subtractRegionRequestRead(buf, order)
+		},
+	}
+}
+
 // TranslateRegionCookie is a cookie used only for TranslateRegion requests.
 type TranslateRegionCookie struct {
 	*xgb.Cookie
@@ -2740,12 +4358,26 @@ func (cook TranslateRegionCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook TranslateRegionCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for TranslateRegion
 // translateRegionRequest writes a TranslateRegion request to a byte slice.
 func translateRegionRequest(c *xgb.Conn, Region Region, Dx int16, Dy int16) []byte {
+	return AppendTranslateRegionRequest(nil, c, Region, Dx, Dy)
+}
+
+// AppendTranslateRegionRequest appends a TranslateRegion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendTranslateRegionRequest(buf []byte, c *xgb.Conn, Region Region, Dx int16, Dy int16) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -2755,21 +4387,54 @@ func translateRegionRequest(c *xgb.Conn, Region Region, Dx int16, Dy int16) []by
 	buf[b] = 17 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Region))
+	order.Put32(buf[b:], uint32(Region))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(Dx))
+	order.Put16(buf[b:], uint16(Dx))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(Dy))
+	order.Put16(buf[b:], uint16(Dy))
 	b += 2
 
 	return buf
 }
 
+// TranslateRegionRequest represents the data of a TranslateRegion request, as it is read when tracing a connection.
+type TranslateRegionRequest struct {
+	Region Region
+	Dx     int16
+	Dy     int16
+}
+
+// translateRegionRequestRead reads a byte slice in the byte order 'order' into a TranslateRegionRequest value.
+func translateRegionRequestRead(buf []byte, order xgb.ByteOrder) *TranslateRegionRequest {
+	v := new(TranslateRegionRequest)
+	b := 4 // skip request header
+
+	v.Region = Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.Dx = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Dy = int16(order.Get16(buf[b:]))
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][17] = xgb.RequestInfo{
+		Name: "TranslateRegion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return translateRegionRequestRead(buf, order)
+		},
+	}
+}
+
 // UnionRegionCookie is a cookie used only for UnionRegion requests.
 type UnionRegionCookie struct {
 	*xgb.Cookie
@@ -2807,12 +4472,26 @@ func (cook UnionRegionCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook UnionRegionCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for UnionRegion
 // unionRegionRequest writes a UnionRegion request to a byte slice.
 func unionRegionRequest(c *xgb.Conn, Source1 Region, Source2 Region, Destination Region) []byte {
+	return AppendUnionRegionRequest(nil, c, Source1, Source2, Destination)
+}
+
+// AppendUnionRegionRequest appends a UnionRegion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendUnionRegionRequest(buf []byte, c *xgb.Conn, Source1 Region, Source2 Region, Destination Region) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XFIXES"]
@@ -2822,17 +4501,50 @@ func unionRegionRequest(c *xgb.Conn, Source1 Region, Source2 Region, Destination
 	buf[b] = 13 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Source1))
+	order.Put32(buf[b:], uint32(Source1))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Source2))
+	order.Put32(buf[b:], uint32(Source2))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Destination))
+	order.Put32(buf[b:], uint32(Destination))
 	b += 4
 
 	return buf
 }
+
+// UnionRegionRequest represents the data of a UnionRegion request, as it is read when tracing a connection.
+type UnionRegionRequest struct {
+	Source1     Region
+	Source2     Region
+	Destination Region
+}
+
+// unionRegionRequestRead reads a byte slice in the byte order 'order' into a UnionRegionRequest value.
+func unionRegionRequestRead(buf []byte, order xgb.ByteOrder) *UnionRegionRequest {
+	v := new(UnionRegionRequest)
+	b := 4 // skip request header
+
+	v.Source1 = Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.Source2 = Region(order.Get32(buf[b:]))
+	b += 4
+
+	v.Destination = Region(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XFIXES"][13] = xgb.RequestInfo{
+		Name: "UnionRegion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return unionRegionRequestRead(buf, order)
+		},
+	}
+}
@@ -1,18 +1,30 @@
 package xgb
 
 import (
+	"context"
 	"errors"
 	"io"
 	"log"
 	"net"
 	"os"
+	"runtime"
 	"sync"
+	"time"
 )
 
 var (
 	// Where to log error-messages. Defaults to stderr.
 	// To disable logging, just set this to log.New(ioutil.Discard, "", 0)
 	Logger = log.New(os.Stderr, "XGB: ", log.Lshortfile)
+
+	// ErrConnClosed is returned by cookies, NewId and friends when the
+	// connection to the X server has been closed, either explicitly with
+	// Close or because of an I/O error. Use Conn.Err to find out which.
+	ErrConnClosed = errors.New("xgb: connection closed")
+
+	// ErrNoMoreReplies is returned by a cookie made with NewMultiCookie once
+	// the last of its replies has been returned.
+	ErrNoMoreReplies = errors.New("xgb: no more replies")
 )
 
 const (
@@ -22,11 +34,6 @@ const (
 	// buffer fills, a round trip request is made to clear the buffer.
 	cookieBuffer = 1000
 
-	// xidBuffer represents the queue size of the xid channel.
-	// I don't think this value matters much, since xid generation is not
-	// that expensive.
-	xidBuffer = 5
-
 	// seqBuffer represents the queue size of the sequence number channel.
 	// I don't think this value matters much, since sequence number generation
 	// is not that expensive.
@@ -36,18 +43,36 @@ const (
 	// can be made until new ones block. This value seems OK.
 	reqBuffer = 100
 
+	// genericEvent is the event number of the X Generic Event (XGE). Unlike
+	// all other events, generic events may be longer than 32 bytes, and are
+	// identified by the major opcode of the extension that sent them along
+	// with an extension specific event type.
+	genericEvent = 35
+
 	// eventBuffer represents the queue size of the number of events or errors
 	// that can be loaded off the wire and not grabbed with WaitForEvent
 	// until reading an event blocks. This value should be big enough to handle
 	// bursts of events.
 	eventBuffer = 5000
+
+	// multiReplyBuffer represents the queue size of the replies to a request
+	// answered by a stream of replies that can be read off the wire and not
+	// grabbed with Reply until reading a reply blocks.
+	multiReplyBuffer = 100
 )
 
 // A Conn represents a connection to an X server.
 type Conn struct {
+	// droppedEvents counts the events dropped by the overflow policy of
+	// the event queue. It is only accessed atomically, and comes first so
+	// that it is 64-bit aligned on 32-bit platforms.
+	droppedEvents uint64
+
 	host          string
 	conn          net.Conn
+	reader        *fdReader // reads from conn, keeping any received fds
 	display       string
+	order         ByteOrder
 	DisplayNumber int
 	DefaultScreen int
 	SetupBytes    []byte
@@ -55,12 +80,33 @@ type Conn struct {
 	setupResourceIdBase uint32
 	setupResourceIdMask uint32
 
+	// xidLock guards the state of resource id allocation, which is set up
+	// by the first call to NewId. (See nextXId.)
+	xidLock sync.Mutex
+	xidInc  uint32
+	xidLast uint32
+	xidMax  uint32
+	xcmisc  *xcMisc
+
+	// bigReqMaxLength is the maximum request length (in 4-byte units) once
+	// BIG-REQUESTS has been enabled by bigReqOnce, or zero if it couldn't be.
+	bigReqOnce      sync.Once
+	bigReqMaxLength uint32
+
 	eventChan  chan eventOrError
 	cookieChan chan *Cookie
-	xidChan    chan xid
 	seqChan    chan uint16
 	reqChan    chan *request
-	closing    chan chan struct{}
+
+	// quit is closed by Close to ask sendRequests to shut down gracefully.
+	// done is closed once the connection is no longer usable, and err
+	// holds the reason why.
+	quit      chan struct{}
+	quitOnce  sync.Once
+	done      chan struct{}
+	closeOnce sync.Once
+	errLock   sync.Mutex
+	err       error
 
 	// ExtLock is a lock used whenever new extensions are initialized.
 	// It should not be used. It is exported for use in the extension
@@ -70,6 +116,93 @@ type Conn struct {
 	// Extensions is a map from extension name to major opcode. It should
 	// not be used. It is exported for use in the extension sub-packages.
 	Extensions map[string]byte
+
+	// funcLock guards the constructors of extension events and errors,
+	// which each extension's Init registers with this connection. (Event
+	// and error numbers of extensions differ from server to server.)
+	funcLock          sync.RWMutex
+	eventFuncs        map[int]NewEventFun
+	errorFuncs        map[int]NewErrorFun
+	genericEventFuncs map[byte]map[int]NewEventFun
+	requestInfos      map[byte]extRequestInfos
+
+	// traceLock guards tracer, which logs everything that goes over the
+	// connection if it's set. (See Trace.)
+	traceLock sync.Mutex
+	tracer    *tracer
+
+	// eventQueueSize is the capacity of eventChan, and eventOverflow is
+	// what happens to events when it's full. With OverflowUnbounded, events
+	// are sent on eventSpill, which feeds eventChan.
+	eventQueueSize int
+	eventOverflow  OverflowPolicy
+	eventSpill     chan eventOrError
+
+	// subLock guards the subscriptions to events (see Subscribe), and
+	// subsClosed, which is set once the connection is closed.
+	subLock    sync.RWMutex
+	subs       []*Subscription
+	subsClosed bool
+
+	// log is where this connection logs error messages. If it's nil, the
+	// global Logger is used.
+	log *log.Logger
+}
+
+// Options are the settings of a connection made with NewConnOptions. The
+// zero value connects just like NewConn does.
+type Options struct {
+	// Display is the X server to connect to, in the same form as the
+	// DISPLAY environment variable (see NewConnDisplay). If it's empty, it
+	// will be taken from os.Getenv("DISPLAY").
+	Display string
+
+	// Dial makes the network connection to the X server, e.g. with the
+	// DialContext method of a net.Dialer. If it's nil, the connection is
+	// made with a net.Dialer with its default settings.
+	Dial func(ctx context.Context, network, address string) (net.Conn, error)
+
+	// AuthName and AuthData are the name and data of the authorization
+	// protocol to use, as found in an X authority file. The protocols
+	// supported are "MIT-MAGIC-COOKIE-1", whose data is the 16 byte cookie
+	// sent to the X server, and "XDM-AUTHORIZATION-1", whose data is the
+	// 8 byte authorization id followed by the 8 byte DES key that the data
+	// sent to the X server is computed with. Connecting fails with any
+	// other protocol, or with data of the wrong length. If AuthName is
+	// empty, they are taken from the X authority file instead.
+	AuthName string
+	AuthData []byte
+
+	// AuthorityFile is the X authority file to read. If it's empty, the
+	// file is $XAUTHORITY, or else $HOME/.Xauthority.
+	AuthorityFile string
+
+	// HandshakeTimeout limits the time spent connecting and doing the
+	// initial handshake. If it's zero, there is no time limit other than
+	// the deadline of the context given to NewConnOptions.
+	HandshakeTimeout time.Duration
+
+	// Screen overrides the default screen given in the display, if it
+	// isn't nil.
+	Screen *int
+
+	// Logger is where the connection logs error messages. If it's nil, the
+	// global Logger is used.
+	Logger *log.Logger
+
+	// EventQueueSize is the number of events (and errors) that can be read
+	// from the X server and not taken with WaitForEvent yet before the queue
+	// is full. If it's zero, the queue holds 5000.
+	EventQueueSize int
+
+	// EventOverflow says what happens to events that arrive when the queue
+	// is full. The default, OverflowBlock, stops reading from the X server
+	// until there's room, which delays replies too.
+	EventOverflow OverflowPolicy
+
+	// ByteOrder is the byte order of the connection, LSBFirst if it's zero.
+	// Connections with different byte orders can be open at the same time.
+	ByteOrder ByteOrder
 }
 
 // NewConn creates a new connection instance. It initializes locks, data
@@ -84,16 +217,41 @@ func NewConn() (*Conn, error) {
 // If 'display' is empty it will be taken from os.Getenv("DISPLAY").
 //
 // Examples:
+//
 //	NewConn(":1") -> net.Dial("unix", "", "/tmp/.X11-unix/X1")
+//	NewConn("unix:1") -> net.Dial("unix", "", "/tmp/.X11-unix/X1")
 //	NewConn("/tmp/launch-12/:0") -> net.Dial("unix", "", "/tmp/launch-12/:0")
+//	NewConn("/run/x.sock.1") -> net.Dial("unix", "", "/run/x.sock")
 //	NewConn("hostname:2.1") -> net.Dial("tcp", "", "hostname:6002")
 //	NewConn("tcp/hostname:1.0") -> net.Dial("tcp", "", "hostname:6001")
+//
+// On Linux, the local socket in the abstract namespace, i.e.
+// "@/tmp/.X11-unix/X1", is tried before the one in the file system. A
+// path to a socket, optionally followed by a screen number, is used as is
+// if the socket exists.
 func NewConnDisplay(display string) (*Conn, error) {
-	conn := &Conn{}
+	return NewConnOptions(context.Background(), Options{Display: display})
+}
+
+// NewConnOptions is just like NewConn, but connects with the settings in
+// 'opts' rather than ones taken from the environment wherever they're set.
+// If 'ctx' is done before the initial handshake is over, connecting is
+// given up on and the context's error is returned. Once the connection is
+// made, 'ctx' is no longer used.
+func NewConnOptions(ctx context.Context, opts Options) (*Conn, error) {
+	conn, err := newConn(opts)
+	if err != nil {
+		return nil, err
+	}
+	if opts.HandshakeTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.HandshakeTimeout)
+		defer cancel()
+	}
 
 	// First connect. This reads authority, checks DISPLAY environment
 	// variable, and loads the initial Setup info.
-	err := conn.connect(display)
+	err = conn.connect(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -101,15 +259,31 @@ func NewConnDisplay(display string) (*Conn, error) {
 	return postNewConn(conn)
 }
 
-// NewConnDisplay is just like NewConn, but allows a specific net.Conn
+// NewConnNet is just like NewConn, but allows a specific net.Conn
 // to be used.
 func NewConnNet(netConn net.Conn) (*Conn, error) {
-	conn := &Conn{}
+	return NewConnNetOptions(context.Background(), netConn, Options{})
+}
 
-	// First connect. This reads authority, checks DISPLAY environment
-	// variable, and loads the initial Setup info.
-	err := conn.connectNet(netConn)
+// NewConnNetOptions is just like NewConnNet, but does the initial handshake
+// over 'netConn' with the settings in 'opts', like NewConnOptions does.
+// Since there is nothing to dial, the Display and Dial options are not
+// used, and only the first usable entry of the X authority file is tried.
+func NewConnNetOptions(ctx context.Context, netConn net.Conn,
+	opts Options) (*Conn, error) {
+
+	conn, err := newConn(opts)
+	if err != nil {
+		return nil, err
+	}
+	if opts.HandshakeTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.HandshakeTimeout)
+		defer cancel()
+	}
 
+	// First connect. This reads authority, and loads the initial Setup info.
+	err = conn.connectNet(ctx, netConn, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -117,17 +291,46 @@ func NewConnNet(netConn net.Conn) (*Conn, error) {
 	return postNewConn(conn)
 }
 
+// newConn returns a connection with the settings in 'opts' that don't have
+// to do with connecting, which has yet to be done.
+func newConn(opts Options) (*Conn, error) {
+	conn := &Conn{
+		log:            opts.Logger,
+		order:          opts.ByteOrder,
+		eventOverflow:  opts.EventOverflow,
+		eventQueueSize: opts.EventQueueSize,
+	}
+	if conn.order == 0 {
+		conn.order = LSBFirst
+	}
+	if err := checkByteOrder(conn.order); err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
 func postNewConn(conn *Conn) (*Conn, error) {
 	conn.Extensions = make(map[string]byte)
+	conn.eventFuncs = make(map[int]NewEventFun)
+	conn.errorFuncs = make(map[int]NewErrorFun)
+	conn.genericEventFuncs = make(map[byte]map[int]NewEventFun)
+	conn.requestInfos = make(map[byte]extRequestInfos)
 
 	conn.cookieChan = make(chan *Cookie, cookieBuffer)
-	conn.xidChan = make(chan xid, xidBuffer)
 	conn.seqChan = make(chan uint16, seqBuffer)
 	conn.reqChan = make(chan *request, reqBuffer)
-	conn.eventChan = make(chan eventOrError, eventBuffer)
-	conn.closing = make(chan chan struct{}, 1)
+	if conn.eventQueueSize <= 0 {
+		conn.eventQueueSize = eventBuffer
+	}
+	conn.eventChan = make(chan eventOrError, conn.eventQueueSize)
+	if conn.eventOverflow == OverflowUnbounded {
+		conn.eventSpill = make(chan eventOrError)
+		go conn.pumpEvents()
+	}
+	conn.quit = make(chan struct{})
+	conn.done = make(chan struct{})
+	conn.reader = newFdReader(conn.conn, conn.logger())
 
-	go conn.generateXIds()
 	go conn.generateSeqIds()
 	go conn.sendRequests()
 	go conn.readResponses()
@@ -135,33 +338,92 @@ func postNewConn(conn *Conn) (*Conn, error) {
 	return conn, nil
 }
 
-// Close gracefully closes the connection to the X server.
+// logger returns where this connection logs error messages.
+func (c *Conn) logger() *log.Logger {
+	if c.log != nil {
+		return c.log
+	}
+	return Logger
+}
+
+// Close gracefully closes the connection to the X server. Requests issued
+// before Close are still sent, and their replies are still read. Requests
+// issued after Close fail with ErrConnClosed.
+// Close does not block; use Done to wait for the connection to shut down.
+// It is safe to call Close more than once.
 func (c *Conn) Close() {
-	close(c.reqChan)
+	c.quitOnce.Do(func() { close(c.quit) })
+}
+
+// Done returns a channel that is closed when the connection to the X server
+// is no longer usable, either because Close was called or because of an
+// unrecoverable I/O error.
+func (c *Conn) Done() <-chan struct{} {
+	return c.done
+}
+
+// Err returns nil while the connection is open. After Done is closed, Err
+// returns ErrConnClosed if the connection was closed with Close, or the
+// I/O error that brought the connection down otherwise.
+func (c *Conn) Err() error {
+	c.errLock.Lock()
+	defer c.errLock.Unlock()
+	return c.err
+}
+
+// shutdown marks the connection as dead for the reason given by 'err'
+// (ErrConnClosed if nil) and closes the underlying net.Conn. Only the first
+// call has any effect. Every cookie still waiting on the server is woken up
+// by the closing of the done channel.
+func (c *Conn) shutdown(err error) {
+	c.closeOnce.Do(func() {
+		if err == nil {
+			err = ErrConnClosed
+		}
+		c.errLock.Lock()
+		c.err = err
+		c.errLock.Unlock()
+
+		c.conn.Close()
+		close(c.done)
+	})
 }
 
 // Event is an interface that can contain any of the events returned by the
 // server. Use a type assertion switch to extract the Event structs.
+// Bytes writes the event in the byte order 'order', i.e., the one of the
+// connection it is sent on with SendEvent.
 type Event interface {
-	Bytes() []byte
+	Bytes(order ByteOrder) []byte
 	String() string
 }
 
-// NewEventFun is the type of function use to construct events from raw bytes.
+// NewEventFun is the type of function use to construct events from raw bytes,
+// which are in the byte order 'order'.
 // It should not be used. It is exported for use in the extension sub-packages.
-type NewEventFun func(buf []byte) Event
+type NewEventFun func(buf []byte, order ByteOrder) Event
 
 // NewEventFuncs is a map from event numbers to functions that create
-// the corresponding event. It should not be used. It is exported for use
-// in the extension sub-packages.
+// the corresponding core protocol event. It is filled in when the xproto
+// package is initialized. Extension events are registered with each
+// connection instead (see Conn.RegisterEventFuncs). It should not be used.
+// It is exported for use in the xproto sub-package.
 var NewEventFuncs = make(map[int]NewEventFun)
 
-// NewExtEventFuncs is a temporary map that stores event constructor functions
-// for each extension. When an extension is initialized, each event for that
-// extension is added to the 'NewEventFuncs' map. It should not be used. It is
-// exported for use in the extension sub-packages.
+// NewExtEventFuncs is a map that stores event constructor functions for each
+// extension, keyed by the event number relative to the extension's first
+// event. When an extension is initialized, its events are registered with the
+// connection. It should not be used. It is exported for use in the extension
+// sub-packages.
 var NewExtEventFuncs = make(map[string]map[int]NewEventFun)
 
+// NewExtGenericEventFuncs is a map that stores generic event constructor
+// functions for each extension, keyed by generic event type. When an
+// extension is initialized, they are registered with the connection under
+// the extension's major opcode. It should not be used. It is exported for
+// use in the extension sub-packages.
+var NewExtGenericEventFuncs = make(map[string]map[int]NewEventFun)
+
 // Error is an interface that can contain any of the errors returned by
 // the server. Use a type assertion switch to extract the Error structs.
 type Error interface {
@@ -170,21 +432,101 @@ type Error interface {
 	Error() string
 }
 
-// NewErrorFun is the type of function use to construct errors from raw bytes.
+// NewErrorFun is the type of function use to construct errors from raw bytes,
+// which are in the byte order 'order'.
 // It should not be used. It is exported for use in the extension sub-packages.
-type NewErrorFun func(buf []byte) Error
+type NewErrorFun func(buf []byte, order ByteOrder) Error
 
 // NewErrorFuncs is a map from error numbers to functions that create
-// the corresponding error. It should not be used. It is exported for use in
-// the extension sub-packages.
+// the corresponding core protocol error. It is filled in when the xproto
+// package is initialized. Extension errors are registered with each
+// connection instead (see Conn.RegisterErrorFuncs). It should not be used.
+// It is exported for use in the xproto sub-package.
 var NewErrorFuncs = make(map[int]NewErrorFun)
 
-// NewExtErrorFuncs is a temporary map that stores error constructor functions
-// for each extension. When an extension is initialized, each error for that
-// extension is added to the 'NewErrorFuncs' map. It should not be used. It is
-// exported for use in the extension sub-packages.
+// NewExtErrorFuncs is a map that stores error constructor functions for each
+// extension, keyed by the error number relative to the extension's first
+// error. When an extension is initialized, its errors are registered with the
+// connection. It should not be used. It is exported for use in the extension
+// sub-packages.
 var NewExtErrorFuncs = make(map[string]map[int]NewErrorFun)
 
+// RegisterEventFuncs registers the event constructors in 'funcs' with this
+// connection. Each event number in 'funcs' is relative to 'first', which is
+// the first event number the X server assigned to the extension.
+// It should not be used. It is exported for use in the extension
+// sub-packages.
+func (c *Conn) RegisterEventFuncs(first int, funcs map[int]NewEventFun) {
+	c.funcLock.Lock()
+	defer c.funcLock.Unlock()
+	for evNum, fun := range funcs {
+		c.eventFuncs[first+evNum] = fun
+	}
+}
+
+// RegisterErrorFuncs registers the error constructors in 'funcs' with this
+// connection. Each error number in 'funcs' is relative to 'first', which is
+// the first error number the X server assigned to the extension.
+// It should not be used. It is exported for use in the extension
+// sub-packages.
+func (c *Conn) RegisterErrorFuncs(first int, funcs map[int]NewErrorFun) {
+	c.funcLock.Lock()
+	defer c.funcLock.Unlock()
+	for errNum, fun := range funcs {
+		c.errorFuncs[first+errNum] = fun
+	}
+}
+
+// RegisterGenericEventFuncs registers the generic event constructors in
+// 'funcs', keyed by generic event type, with this connection for the
+// extension with the major opcode 'extension'.
+// It should not be used. It is exported for use in the extension
+// sub-packages.
+func (c *Conn) RegisterGenericEventFuncs(extension byte,
+	funcs map[int]NewEventFun) {
+
+	c.funcLock.Lock()
+	defer c.funcLock.Unlock()
+	c.genericEventFuncs[extension] = funcs
+}
+
+// eventFunc returns the constructor for events with number 'evNum', looking
+// at the extensions registered with this connection and then at the core
+// protocol.
+func (c *Conn) eventFunc(evNum int) (NewEventFun, bool) {
+	c.funcLock.RLock()
+	fun, ok := c.eventFuncs[evNum]
+	c.funcLock.RUnlock()
+	if !ok {
+		fun, ok = NewEventFuncs[evNum]
+	}
+	return fun, ok
+}
+
+// errorFunc returns the constructor for errors with number 'errNum', looking
+// at the extensions registered with this connection and then at the core
+// protocol.
+func (c *Conn) errorFunc(errNum int) (NewErrorFun, bool) {
+	c.funcLock.RLock()
+	fun, ok := c.errorFuncs[errNum]
+	c.funcLock.RUnlock()
+	if !ok {
+		fun, ok = NewErrorFuncs[errNum]
+	}
+	return fun, ok
+}
+
+// genericEventFunc returns the constructor for generic events of type
+// 'evType' sent by the extension with the major opcode 'extension'.
+func (c *Conn) genericEventFunc(extension byte,
+	evType int) (NewEventFun, bool) {
+
+	c.funcLock.RLock()
+	defer c.funcLock.RUnlock()
+	fun, ok := c.genericEventFuncs[extension][evType]
+	return fun, ok
+}
+
 // eventOrError corresponds to values that can be either an event or an
 // error.
 type eventOrError interface{}
@@ -197,29 +539,23 @@ type eventOrError interface{}
 // e.g., For a window id, use xproto.NewWindowId. For
 // a new pixmap id, use xproto.NewPixmapId. And so on.
 func (c *Conn) NewId() (uint32, error) {
-	xid := <-c.xidChan
-	if xid.err != nil {
-		return 0, xid.err
+	select {
+	case <-c.done:
+		return 0, ErrConnClosed
+	default:
 	}
-	return xid.id, nil
-}
 
-// xid encapsulates a resource identifier being sent over the Conn.xidChan
-// channel. If no new resource id can be generated, id is set to 0 and a
-// non-nil error is set in xid.err.
-type xid struct {
-	id  uint32
-	err error
+	c.xidLock.Lock()
+	defer c.xidLock.Unlock()
+	return c.nextXId()
 }
 
-// generateXids sends new Ids down the channel for NewId to use.
-// generateXids should be run in its own goroutine.
-// This needs to be updated to use the XC Misc extension once we run out of
-// new ids.
+// nextXId returns the id after the last one handed out by NewId, and must be
+// called with c.xidLock held.
+// Once the range of ids handed out by the X server at setup is used up, the
+// XC-MISC extension is used to find ranges of ids that have been freed.
 // Thanks to libxcb/src/xcb_xid.c. This code is greatly inspired by it.
-func (conn *Conn) generateXIds() {
-	defer close(conn.xidChan)
-
+func (c *Conn) nextXId() (uint32, error) {
 	// This requires some explanation. From the horse's mouth:
 	// "The resource-id-mask contains a single contiguous set of bits (at least
 	// 18).  The client allocates resource IDs for types WINDOW, PIXMAP,
@@ -236,25 +572,25 @@ func (conn *Conn) generateXIds() {
 	// 00111000 & 11001000 = 00001000.
 	// And we use that value to increment the last resource id to get a new one.
 	// (And then, of course, we OR it with resource-id-base.)
-	inc := conn.setupResourceIdMask & -conn.setupResourceIdMask
-	max := conn.setupResourceIdMask
-	last := uint32(0)
-	for {
-		// TODO: Use the XC Misc extension to look for released ids.
-		if last > 0 && last >= max-inc+1 {
-			conn.xidChan <- xid{
-				id: 0,
-				err: errors.New("There are no more available resource" +
-					"identifiers."),
-			}
-		}
-
-		last += inc
-		conn.xidChan <- xid{
-			id:  last | conn.setupResourceIdBase,
-			err: nil,
+	if c.xcmisc == nil {
+		c.xidInc = c.setupResourceIdMask & -c.setupResourceIdMask
+		c.xidMax = c.setupResourceIdMask
+		c.xcmisc = newXCMisc(c)
+	}
+	if c.xidLast > 0 && c.xidLast >= c.xidMax-c.xidInc+1 {
+		// The current range is used up, so ask the X server for ids
+		// that are no longer in use. The range is only replaced if
+		// one is found, so that every NewId tries again.
+		start, count, err := c.xcmisc.getXIdRange()
+		if err != nil {
+			return 0, err
 		}
+		c.xidLast = start
+		c.xidMax = start + (count-1)*c.xidInc
+	} else {
+		c.xidLast += c.xidInc
 	}
+	return c.xidLast | c.setupResourceIdBase, nil
 }
 
 // newSeqId fetches the next sequence id from the Conn.seqChan channel.
@@ -271,11 +607,13 @@ func (c *Conn) newSequenceId() uint16 {
 // N.B. As long as the cookie buffer is less than 2^16, there are no limitations
 // on the number (or kind) of requests made in sequence.
 func (c *Conn) generateSeqIds() {
-	defer close(c.seqChan)
-
 	seqid := uint16(1)
 	for {
-		c.seqChan <- seqid
+		select {
+		case c.seqChan <- seqid:
+		case <-c.done:
+			return
+		}
 		if seqid == uint16((1<<16)-1) {
 			seqid = 0
 		} else {
@@ -289,12 +627,45 @@ func (c *Conn) generateSeqIds() {
 // The cookie is used to match up the reply/error.
 type request struct {
 	buf    []byte
+	fds    []int
 	cookie *Cookie
 
-	// seq is closed when the request (cookie) has been sequenced by the Conn.
+	// batch is set instead of the fields above for a batch of requests.
+	// (See Batch.Flush.)
+	batch *Batch
+
+	// seq is sent a value when the request (cookie) has been sequenced by
+	// the Conn.
 	seq chan struct{}
 }
 
+// requestPool holds requests that can be used again, so that sending a
+// request doesn't allocate.
+var requestPool = sync.Pool{
+	New: func() interface{} {
+		return &request{seq: make(chan struct{}, 1)}
+	},
+}
+
+// queueRequest sends 'req' over the Conn.reqChan channel, and waits until it
+// has been sequenced. It reports false if the connection was closed first.
+// The request is put back in requestPool once it has been sequenced.
+func (c *Conn) queueRequest(req *request) bool {
+	select {
+	case c.reqChan <- req:
+	case <-c.done:
+		return false
+	}
+	select {
+	case <-req.seq:
+		*req = request{seq: req.seq}
+		requestPool.Put(req)
+		return true
+	case <-c.done:
+		return false
+	}
+}
+
 // NewRequest takes the bytes and a cookie of a particular request, constructs
 // a request type, and sends it over the Conn.reqChan channel.
 // Note that the sequence number is added to the cookie after it is sent
@@ -305,75 +676,184 @@ type request struct {
 // abides by the same rules, except that for replies, you'll get back the
 // raw byte data. This may be useful for performance critical sections where
 // every allocation counts, since all X requests in XGB allocate a new byte
-// slice. In contrast, NewRequest allocates one small request struct and
-// nothing else. (Except when the cookie buffer is full and has to be flushed.)
+// slice. In contrast, NewRequest allocates nothing at all. (Except when the
+// cookie buffer is full and has to be flushed.) To encode requests without
+// allocating either, and to send many of them at once, see Batch.
 //
 // If you're using NewRequest manually, you'll need to use NewCookie to create
 // a new cookie.
 //
 // In all likelihood, you should be able to copy and paste with some minor
 // edits the generated code for the request you want to issue.
+//
+// Requests that are too long for the length field in their header are sent
+// using the BIG-REQUESTS extension, which is enabled the first time it is
+// needed. If the X server doesn't support it, or if the request is too long
+// even so, the request is dropped and the cookie reports ErrRequestTooLarge.
+//
+// If the connection has been closed, the request is dropped and the cookie
+// reports ErrConnClosed.
 func (c *Conn) NewRequest(buf []byte, cookie *Cookie) {
-	seq := make(chan struct{})
-	c.reqChan <- &request{buf: buf, cookie: cookie, seq: seq}
-	<-seq
+	c.NewRequestFds(buf, nil, cookie)
+}
+
+// Fd is a file descriptor that is passed to the X server along with a
+// request. An *os.File is an Fd, and so is a RawFd.
+type Fd interface {
+	Fd() uintptr
+}
+
+// RawFd is a file descriptor given as a plain integer.
+type RawFd int
+
+// Fd returns the file descriptor.
+func (fd RawFd) Fd() uintptr {
+	return uintptr(fd)
+}
+
+// NewRequestFds is just like NewRequest, but also sends the file descriptors
+// in 'fds' along with the request. This only works over Unix domain sockets;
+// on any other kind of connection, the request is dropped and the cookie
+// reports ErrNoFdPassing.
+//
+// The file descriptors are not closed. Since they have been passed to the X
+// server by the time NewRequestFds returns, they may be closed right away.
+// (Note that calling Fd on an *os.File puts it in blocking mode.)
+func (c *Conn) NewRequestFds(buf []byte, fds []Fd, cookie *Cookie) {
+	if len(fds) > 0 && !canPassFds(c.conn) {
+		c.logger().Printf("Dropping request with %d file descriptor(s): %s",
+			len(fds), ErrNoFdPassing)
+		cookie.err = ErrNoFdPassing
+		return
+	}
+	fitted, err := c.fitRequest(buf)
+	if err != nil {
+		c.logger().Printf("Dropping request of %d bytes: %s", len(buf), err)
+		cookie.err = err
+		return
+	}
+	buf = fitted
+
+	var rawFds []int
+	if len(fds) > 0 {
+		rawFds = make([]int, len(fds))
+		for i, fd := range fds {
+			rawFds[i] = int(fd.Fd())
+		}
+	}
+	req := requestPool.Get().(*request)
+	req.buf, req.fds, req.cookie = buf, rawFds, cookie
+	c.queueRequest(req)
+
+	// Files must not be closed by their finalizers before they are sent.
+	runtime.KeepAlive(fds)
 }
 
 // sendRequests is run as a single goroutine that takes requests and writes
 // the bytes to the wire and adds the cookie to the cookie queue.
 // It is meant to be run as its own goroutine.
+// When Close is called, any requests already queued are sent, a final round
+// trip is made so that their replies are read, and the connection is shut
+// down.
 func (c *Conn) sendRequests() {
 	defer close(c.cookieChan)
 
-	for req := range c.reqChan {
-		// ho there! if the cookie channel is nearly full, force a round
-		// trip to clear out the cookie buffer.
-		// Note that we circumvent the request channel, because we're *in*
-		// the request channel.
-		if len(c.cookieChan) == cookieBuffer-1 {
-			if err := c.noop(); err != nil {
-				// Shut everything down.
-				break
+	for {
+		select {
+		case req := <-c.reqChan:
+			if err := c.sendRequest(req); err != nil {
+				c.shutdown(err)
+				return
+			}
+		case <-c.quit:
+			// Send whatever was queued before Close was called. This is
+			// the only goroutine receiving from reqChan, so this can't block.
+			for len(c.reqChan) > 0 {
+				if err := c.sendRequest(<-c.reqChan); err != nil {
+					c.shutdown(err)
+					return
+				}
 			}
+			c.noop() // Flush the response reading goroutine, ignore error.
+			c.shutdown(nil)
+			return
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// sendRequest sequences a single request, adds its cookie to the cookie
+// queue and writes it to the wire.
+func (c *Conn) sendRequest(req *request) error {
+	defer func() { req.seq <- struct{}{} }()
+
+	if req.batch != nil {
+		return c.sendBatch(req.batch)
+	}
+
+	// ho there! if the cookie channel is nearly full, force a round
+	// trip to clear out the cookie buffer.
+	// Note that we circumvent the request channel, because we're *in*
+	// the request channel.
+	if len(c.cookieChan) == cookieBuffer-1 {
+		if err := c.noop(); err != nil {
+			return err
 		}
-		req.cookie.Sequence = c.newSequenceId()
-		c.cookieChan <- req.cookie
-		c.writeBuffer(req.buf)
-		close(req.seq)
 	}
-	response := make(chan struct{})
-	c.closing <- response
-	c.noop() // Flush the response reading goroutine, ignore error.
-	<-response
-	c.conn.Close()
+	req.cookie.Sequence = c.newSequenceId()
+	req.cookie.major, req.cookie.minor = req.buf[0], req.buf[1]
+	c.cookieChan <- req.cookie
+	c.traceRequest(req.cookie.Sequence, req.buf)
+	if len(req.fds) > 0 {
+		return c.writeBufferFds(req.buf, req.fds)
+	}
+	return c.writeBuffer(req.buf)
 }
 
 // noop circumvents the usual request sending goroutines and forces a round
 // trip request manually.
 func (c *Conn) noop() error {
+	buf := c.getInputFocusRequest()
 	cookie := c.NewCookie(true, true)
 	cookie.Sequence = c.newSequenceId()
+	cookie.major = buf[0]
 	c.cookieChan <- cookie
-	if err := c.writeBuffer(c.getInputFocusRequest()); err != nil {
+	c.traceRequest(cookie.Sequence, buf)
+	if err := c.writeBuffer(buf); err != nil {
+		return err
+	}
+	// wait for the buffer to clear
+	if _, err := cookie.Reply(); err == ErrConnClosed {
 		return err
 	}
-	cookie.Reply() // wait for the buffer to clear
 	return nil
 }
 
 // writeBuffer is a convenience function for writing a byte slice to the wire.
 func (c *Conn) writeBuffer(buf []byte) error {
 	if _, err := c.conn.Write(buf); err != nil {
-		Logger.Printf("A write error is unrecoverable: %s", err)
+		c.logger().Printf("A write error is unrecoverable: %s", err)
 		return err
 	} else {
 		return nil
 	}
 }
 
+// writeBufferFds is like writeBuffer, but also passes the file descriptors
+// in 'fds' to the X server.
+func (c *Conn) writeBufferFds(buf []byte, fds []int) error {
+	if err := writeFds(c.conn, buf, fds); err != nil {
+		c.logger().Printf("A write error is unrecoverable: %s", err)
+		return err
+	}
+	return nil
+}
+
 // readResponses is a goroutine that reads events, errors and
 // replies off the wire.
-// When an event is read, it is always added to the event channel.
+// When an event is read, it is sent to the subscriptions that receive it,
+// or added to the event channel if there are none.
 // When an error is read, if it corresponds to an existing checked cookie,
 // it is sent to that cookie's error channel. Otherwise it is added to the
 // event channel.
@@ -381,65 +861,64 @@ func (c *Conn) writeBuffer(buf []byte) error {
 // channel. (It is an error if no such cookie exists in this case.)
 // Finally, cookies that came "before" this reply are always cleaned up.
 func (c *Conn) readResponses() {
-	defer close(c.eventChan)
+	defer c.closeEvents()
+	defer c.closeSubscriptions()
 
 	var (
 		err        Error
 		seq        uint16
 		replyBytes []byte
+		replyFds   []int
+
+		// multi is the cookie of a request answered by a stream of replies,
+		// while the rest of its replies are still to come.
+		multi *Cookie
 	)
 
 	for {
-		select {
-		case respond := <-c.closing:
-			respond <- struct{}{}
-			return
-		default:
-		}
-
 		buf := make([]byte, 32)
-		err, seq = nil, 0
-		if _, err := io.ReadFull(c.conn, buf); err != nil {
-			Logger.Printf("A read error is unrecoverable: %s", err)
-			c.eventChan <- err
-			c.Close()
-			continue
+		err, seq, replyFds = nil, 0, nil
+		if _, err := io.ReadFull(c.reader, buf); err != nil {
+			c.readFailed(err)
+			return
 		}
 		switch buf[0] {
 		case 0: // This is an error
 			// Use the constructor function for this error (that is auto
 			// generated) by looking it up by the error number.
-			newErrFun, ok := NewErrorFuncs[int(buf[1])]
+			newErrFun, ok := c.errorFunc(int(buf[1]))
 			if !ok {
-				Logger.Printf("BUG: Could not find error constructor function "+
-					"for error with number %d.", buf[1])
+				c.logger().Printf("BUG: Could not find error constructor "+
+					"function for error with number %d.", buf[1])
 				continue
 			}
-			err = newErrFun(buf)
+			err = newErrFun(buf, c.order)
 			seq = err.SequenceId()
 
 			// This error is either sent to the event channel or a specific
 			// cookie's error channel below.
 		case 1: // This is a reply
-			seq = Get16(buf[2:])
+			seq = c.order.Get16(buf[2:])
 
 			// check to see if this reply has more bytes to be read
-			size := Get32(buf[4:])
+			size := c.order.Get32(buf[4:])
 			if size > 0 {
 				byteCount := 32 + size*4
 				biggerBuf := make([]byte, byteCount)
 				copy(biggerBuf[:32], buf)
-				if _, err := io.ReadFull(c.conn, biggerBuf[32:]); err != nil {
-					Logger.Printf("A read error is unrecoverable: %s", err)
-					c.eventChan <- err
-					c.Close()
-					continue
+				if _, err := io.ReadFull(c.reader, biggerBuf[32:]); err != nil {
+					c.readFailed(err)
+					return
 				}
 				replyBytes = biggerBuf
 			} else {
 				replyBytes = buf
 			}
 
+			// The X server only sends file descriptors with replies, and
+			// they arrive along with the reply's bytes.
+			replyFds = c.reader.take()
+
 			// This reply is sent to its corresponding cookie below.
 		default: // This is an event
 			// Use the constructor function for this event (like for errors,
@@ -448,13 +927,30 @@ func (c *Conn) readResponses() {
 			// the most significant bit (which is set when it was sent from
 			// a SendEvent request).
 			evNum := int(buf[0] & 127)
-			newEventFun, ok := NewEventFuncs[evNum]
+			if evNum == genericEvent {
+				ev, ok := c.readGenericEvent(buf)
+				if !ok {
+					return
+				}
+				if ev != nil {
+					c.dispatch(ev)
+				}
+				continue
+			}
+			newEventFun, ok := c.eventFunc(evNum)
 			if !ok {
-				Logger.Printf("BUG: Could not find event construct function "+
-					"for event with number %d.", evNum)
+				c.logger().Printf("BUG: Could not find event construct "+
+					"function for event with number %d.", evNum)
 				continue
 			}
-			c.eventChan <- newEventFun(buf)
+			ev := newEventFun(buf, c.order)
+			if ev == nil {
+				c.logger().Printf("BUG: Could not construct event with number "+
+					"%d and sub-type %d.", evNum, buf[1])
+				continue
+			}
+			c.traceEvent(ev, buf)
+			c.dispatch(ev)
 			continue
 		}
 
@@ -466,15 +962,48 @@ func (c *Conn) readResponses() {
 		// are marked as successful if they are void and checked.
 		// If there's a cookie that requires a reply that is before this
 		// reply, then something is wrong.
+		// A cookie waiting on a stream of replies has already been taken
+		// off the cookie queue, so it's checked first.
+		if multi != nil {
+			if multi.Sequence == seq {
+				c.traceResponse(multi, err, replyBytes)
+				if !c.sendMultiReply(multi, err, replyBytes, replyFds) {
+					multi = nil
+				}
+				continue
+			}
+			c.logger().Printf("Found cookie with sequence id %d that is "+
+				"expecting more replies but will never get them. Currently "+
+				"on sequence number %d", multi.Sequence, seq)
+			close(multi.replyChan)
+			multi = nil
+		}
 		for cookie := range c.cookieChan {
 			// This is the cookie we're looking for. Process and break.
 			if cookie.Sequence == seq {
+				c.traceResponse(cookie, err, replyBytes)
+				if cookie.last != nil {
+					if c.sendMultiReply(cookie, err, replyBytes, replyFds) {
+						multi = cookie
+					}
+					break
+				}
+				if !cookie.deliver() {
+					// Nobody is waiting on this cookie any more, so drop
+					// its reply. Errors still go to the event channel so
+					// that they aren't lost entirely.
+					if err != nil {
+						c.queueEvent(err)
+					}
+					CloseFds(replyFds)
+					break
+				}
 				if err != nil { // this is an error to a request
 					// synchronous processing
 					if cookie.errorChan != nil {
 						cookie.errorChan <- err
 					} else { // asynchronous processing
-						c.eventChan <- err
+						c.queueEvent(err)
 						// if this is an unchecked reply, ping the cookie too
 						if cookie.pingChan != nil {
 							cookie.pingChan <- true
@@ -482,10 +1011,12 @@ func (c *Conn) readResponses() {
 					}
 				} else { // this is a reply
 					if cookie.replyChan == nil {
-						Logger.Printf("Reply with sequence id %d does not "+
+						c.logger().Printf("Reply with sequence id %d does not "+
 							"have a cookie with a valid reply channel.", seq)
+						CloseFds(replyFds)
 						continue
 					} else {
+						*cookie.fds = replyFds
 						cookie.replyChan <- replyBytes
 					}
 				}
@@ -493,14 +1024,22 @@ func (c *Conn) readResponses() {
 			}
 
 			switch {
+			// Abandoned cookies have no one to notify.
+			case cookie.isAbandoned():
+			// Requests with a stream of replies
+			case cookie.last != nil:
+				c.logger().Printf("Found cookie with sequence id %d that is "+
+					"expecting replies but will never get them. Currently "+
+					"on sequence number %d", cookie.Sequence, seq)
+				close(cookie.replyChan)
 			// Checked requests with replies
 			case cookie.replyChan != nil && cookie.errorChan != nil:
-				Logger.Printf("Found cookie with sequence id %d that is "+
+				c.logger().Printf("Found cookie with sequence id %d that is "+
 					"expecting a reply but will never get it. Currently "+
 					"on sequence number %d", cookie.Sequence, seq)
 			// Unchecked requests with replies
 			case cookie.replyChan != nil && cookie.pingChan != nil:
-				Logger.Printf("Found cookie with sequence id %d that is "+
+				c.logger().Printf("Found cookie with sequence id %d that is "+
 					"expecting a reply (and not an error) but will never "+
 					"get it. Currently on sequence number %d",
 					cookie.Sequence, seq)
@@ -514,10 +1053,90 @@ func (c *Conn) readResponses() {
 	}
 }
 
+// sendMultiReply sends a reply or an error to a cookie made with
+// NewMultiCookie, and reports whether more replies are to come. The stream
+// ends with its last reply or with an error, at which point the cookie's
+// reply channel is closed. Like with other cookies, errors go to the event
+// channel if the cookie is unchecked or abandoned, and replies to abandoned
+// cookies are dropped.
+func (c *Conn) sendMultiReply(cookie *Cookie, err Error, reply []byte,
+	fds []int) bool {
+
+	// No request with multiple replies comes with file descriptors.
+	CloseFds(fds)
+
+	if err != nil {
+		if cookie.errorChan != nil && !cookie.isAbandoned() {
+			cookie.errorChan <- err
+		} else {
+			c.queueEvent(err)
+		}
+		close(cookie.replyChan)
+		return false
+	}
+
+	last := cookie.last(reply)
+	if !cookie.isAbandoned() {
+		select {
+		case cookie.replyChan <- reply:
+		case <-c.done:
+		}
+	}
+	if last {
+		close(cookie.replyChan)
+	}
+	return !last
+}
+
+// readGenericEvent reads the rest of a generic event whose first 32 bytes
+// are in 'buf', and constructs it using the function registered for its
+// extension and event type. If no such function exists, the event is
+// dropped and nil is returned. If the rest of the event could not be read,
+// the connection is shut down and ok is false.
+func (c *Conn) readGenericEvent(buf []byte) (ev Event, ok bool) {
+	// check to see if this event has more bytes to be read
+	size := c.order.Get32(buf[4:])
+	if size > 0 {
+		biggerBuf := make([]byte, 32+size*4)
+		copy(biggerBuf[:32], buf)
+		if _, err := io.ReadFull(c.reader, biggerBuf[32:]); err != nil {
+			c.readFailed(err)
+			return nil, false
+		}
+		buf = biggerBuf
+	}
+
+	extension, evType := buf[1], int(c.order.Get16(buf[8:]))
+	newEventFun, ok := c.genericEventFunc(extension, evType)
+	if !ok {
+		c.logger().Printf("BUG: Could not find generic event construct "+
+			"function for extension with major opcode %d and event type %d.",
+			extension, evType)
+		return nil, true
+	}
+	ev = newEventFun(buf, c.order)
+	c.traceEvent(ev, buf)
+	return ev, true
+}
+
+// readFailed shuts down the connection after a read error. The error is
+// only logged if the connection wasn't already being shut down, since
+// closing the net.Conn is what makes a pending read fail in that case.
+func (c *Conn) readFailed(err error) {
+	select {
+	case <-c.done:
+	default:
+		c.logger().Printf("A read error is unrecoverable: %s", err)
+	}
+	c.shutdown(err)
+}
+
 // processEventOrError takes an eventOrError, type switches on it,
 // and returns it in Go idiomatic style.
 func processEventOrError(everr eventOrError) (Event, Error) {
 	switch ee := everr.(type) {
+	case nil: // the event channel has been closed
+		return nil, nil
 	case Event:
 		return ee, nil
 	case Error:
@@ -536,6 +1155,9 @@ func processEventOrError(everr eventOrError) (Event, Error) {
 // them in some cases).
 //
 // If both the event and error are nil, then the connection has been closed.
+// Use Err to find out why.
+//
+// Events received by a subscription (see Subscribe) are not returned.
 func (c *Conn) WaitForEvent() (Event, Error) {
 	return processEventOrError(<-c.eventChan)
 }
@@ -59,7 +59,7 @@ func addAlignGapsToFields(name string, fields []Field) []Field {
 			case sz >= 4:
 				r = append(r, &PadField{0, 4})
 			}
-		case *LocalField:
+		case *LocalField, *FdField, *FdListField:
 			// nothing
 		default:
 			fmt.Fprintf(os.Stderr,
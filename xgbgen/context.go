@@ -61,19 +61,27 @@ func (c *Context) Morph(xmlBytes []byte) {
 		"Edit at your peril!", c.protocol.Name)
 	c.Putln("")
 
-	// Write imports. We always need to import at least xgb.
+	// Write imports. We always need to import at least xgb and context
+	// (for the ReplyContext and CheckContext cookie methods).
 	// We also need to import xproto if it's an extension.
 	c.Putln("import (")
+	c.Putln("\"context\"")
+	c.Putln("")
 	c.Putln("\"github.com/BurntSushi/xgb\"")
 	c.Putln("")
 	if c.protocol.isExt() {
 		c.Putln("\"github.com/BurntSushi/xgb/xproto\"")
 	}
+	// Generic events are only sent once the Generic Event Extension has
+	// been negotiated, which Init does using the ge package.
+	if c.protocol.needsGe() {
+		c.Putln("\"github.com/BurntSushi/xgb/ge\"")
+	}
 
 	sort.Sort(Protocols(c.protocol.Imports))
 	for _, imp := range c.protocol.Imports {
 		// We always import xproto, so skip it if it's explicitly imported
-		if imp.Name == "xproto" {
+		if imp.Name == "xproto" || (imp.Name == "ge" && c.protocol.needsGe()) {
 			continue
 		}
 		c.Putln("\"github.com/BurntSushi/xgb/%s\"", imp.Name)
@@ -99,30 +107,73 @@ func (c *Context) Morph(xmlBytes []byte) {
 			"on the server.\")", xname)
 		c.Putln("}")
 		c.Putln("")
+		if c.protocol.needsGe() {
+			c.Putln("if err := ge.Init(c); err != nil {")
+			c.Putln("return err")
+			c.Putln("}")
+			c.Putln("if _, err := ge.QueryVersion(c, 1, 0).Reply(); " +
+				"err != nil {")
+			c.Putln("return err")
+			c.Putln("}")
+			c.Putln("")
+		}
 		c.Putln("c.ExtLock.Lock()")
 		c.Putln("c.Extensions[\"%s\"] = reply.MajorOpcode", xname)
 		c.Putln("c.ExtLock.Unlock()")
-		c.Putln("for evNum, fun := range xgb.NewExtEventFuncs[\"%s\"] {",
-			xname)
-		c.Putln("xgb.NewEventFuncs[int(reply.FirstEvent) + evNum] = fun")
-		c.Putln("}")
-		c.Putln("for errNum, fun := range xgb.NewExtErrorFuncs[\"%s\"] {",
-			xname)
-		c.Putln("xgb.NewErrorFuncs[int(reply.FirstError) + errNum] = fun")
-		c.Putln("}")
+		if c.protocol.hasSubEvents() {
+			c.Putln("c.RegisterEventFuncs(int(reply.FirstEvent), " +
+				"map[int]xgb.NewEventFun{0: newSubEvent})")
+		} else {
+			c.Putln("c.RegisterEventFuncs(int(reply.FirstEvent), "+
+				"xgb.NewExtEventFuncs[\"%s\"])", xname)
+		}
+		c.Putln("c.RegisterErrorFuncs(int(reply.FirstError), "+
+			"xgb.NewExtErrorFuncs[\"%s\"])", xname)
+		if c.protocol.hasGenericEvents() {
+			c.Putln("c.RegisterGenericEventFuncs(reply.MajorOpcode, "+
+				"xgb.NewExtGenericEventFuncs[\"%s\"])", xname)
+		}
+		c.Putln("c.RegisterRequestInfos(reply.MajorOpcode, \"%s\", "+
+			"xgb.ExtRequestInfos[\"%s\"])", xname, xname)
 		c.Putln("return nil")
 		c.Putln("}")
 		c.Putln("")
 
 		// Make sure newExtEventFuncs["EXT_NAME"] map is initialized.
-		// Same deal for newExtErrorFuncs["EXT_NAME"]
+		// Same deal for newExtErrorFuncs["EXT_NAME"] and
+		// ExtRequestInfos["EXT_NAME"].
 		c.Putln("func init() {")
 		c.Putln("xgb.NewExtEventFuncs[\"%s\"] = make(map[int]xgb.NewEventFun)",
 			xname)
 		c.Putln("xgb.NewExtErrorFuncs[\"%s\"] = make(map[int]xgb.NewErrorFun)",
 			xname)
+		if c.protocol.hasGenericEvents() {
+			c.Putln("xgb.NewExtGenericEventFuncs[\"%s\"] = "+
+				"make(map[int]xgb.NewEventFun)", xname)
+		}
+		c.Putln("xgb.ExtRequestInfos[\"%s\"] = "+
+			"make(map[int]xgb.RequestInfo)", xname)
 		c.Putln("}")
 		c.Putln("")
+
+		// All events share one event number, so read the sub-event type
+		// to figure out which constructor to use.
+		if c.protocol.hasSubEvents() {
+			c.Putln("// newSubEvent constructs the %s event identified by "+
+				"its %s field.", xname, subEventField)
+			c.Putln("// All %s events share the extension's first event "+
+				"number.", xname)
+			c.Putln("func newSubEvent(buf []byte, " +
+				"order xgb.ByteOrder) xgb.Event {")
+			c.Putln("newEventFun, ok := xgb.NewExtEventFuncs[\"%s\"]"+
+				"[int(buf[1])]", xname)
+			c.Putln("if !ok {")
+			c.Putln("return nil")
+			c.Putln("}")
+			c.Putln("return newEventFun(buf, order)")
+			c.Putln("}")
+			c.Putln("")
+		}
 	} else {
 		// In the xproto package, we must provide a Setup function that uses
 		// SetupBytes in xgb.Conn to return a SetupInfo structure.
@@ -130,7 +181,7 @@ func (c *Context) Morph(xmlBytes []byte) {
 		c.Putln("// connecting into a SetupInfo struct.")
 		c.Putln("func Setup(c *xgb.Conn) *SetupInfo {")
 		c.Putln("setup := new(SetupInfo)")
-		c.Putln("SetupInfoRead(c.SetupBytes, setup)")
+		c.Putln("SetupInfoRead(c.SetupBytes, c.ByteOrder(), setup)")
 		c.Putln("return setup")
 		c.Putln("}")
 		c.Putln("")
@@ -36,19 +36,19 @@ http://cgit.freedesktop.org/xcb/proto/tree/doc/xml-xcb.txt
 
 These types come with a lot of supporting methods to make their use in
 code generation easier. They can be found in expression.go, field.go,
-protocol.go, request_reply.go and type.go. Of particular interest are
-expression evaluation and size calculation (in bytes).
+protocol.go, protodoc.go, request_reply.go and type.go. Of particular
+interest are expression evaluation and size calculation (in bytes).
 
 These types also come with supporting methods that convert their
 representation into Go source code. I've quartered such methods in
-go.go, go_error.go, go_event.go, go_list.go, go_request_reply.go,
-go_single_field.go, go_struct.go and go_union.go. The idea is to keep
-as much of the Go specific code generation in one area as possible. Namely,
-while not *all* Go related code is found in the 'go*.go' files, *most*
-of it is. (If there's any interest in using xgbgen for other languages,
-I'd be happy to try and make xgbgen a little more friendly in this regard.
-I did, however, design xgbgen with this in mind, so it shouldn't involve
-anything as serious as a re-design.)
+go.go, go_error.go, go_event.go, go_list.go, go_protodoc.go,
+go_request_reply.go, go_single_field.go, go_struct.go and go_union.go. The
+idea is to keep as much of the Go specific code generation in one area as
+possible. Namely, while not *all* Go related code is found in the 'go*.go'
+files, *most* of it is. (If there's any interest in using xgbgen for other
+languages, I'd be happy to try and make xgbgen a little more friendly in
+this regard. I did, however, design xgbgen with this in mind, so it
+shouldn't involve anything as serious as a re-design.)
 
 Why
 
@@ -62,11 +62,11 @@ The core X protocol should be completely working. As far as I know, most
 extensions should work too, although I've only tested (and not much) the
 Xinerama and RandR extensions.
 
-XKB does not work. I don't have any real plans of working on this unless there
-is demand and I have some test cases to work with. (i.e., even if I could get
-something generated for XKB, I don't have the inclination to understand it
-enough to verify that it works.) XKB poses several extremely difficult
-problems that XCB also has trouble with. More info on that can be found at
+No XKB package has been generated or tested yet. xgbgen knows about the
+constructs that XKB relies on (nested switch/case fields, named bitcases,
+lists whose lengths are the sum of another list and events that are told
+apart by their 'xkbType' field), but XKB poses several difficult problems that
+XCB also has trouble with. More info on that can be found at
 http://cgit.freedesktop.org/xcb/libxcb/tree/doc/xkb_issues and
 http://cgit.freedesktop.org/xcb/libxcb/tree/doc/xkb_internals.
 
@@ -1,8 +1,10 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
 	"log"
+	"strings"
 )
 
 // Expression represents all the different forms of expressions possible in
@@ -105,9 +107,11 @@ func (e *BinaryOp) Eval() int {
 		return e.Expr1.Eval() * e.Expr2.Eval()
 	case "/":
 		return e.Expr1.Eval() / e.Expr2.Eval()
-	case "&amp;":
+	case "&":
 		return e.Expr1.Eval() & e.Expr2.Eval()
-	case "&lt;&lt;":
+	case "|":
+		return e.Expr1.Eval() | e.Expr2.Eval()
+	case "<<":
 		return int(uint(e.Expr1.Eval()) << uint(e.Expr2.Eval()))
 	}
 
@@ -292,8 +296,12 @@ func (e *Bit) Initialize(p *Protocol) {}
 
 // FieldRef represents a reference to some variable in the generated code
 // with name Name.
+// Up is the number of switches (from the inside out) that the field being
+// referred to is outside of. It is used to strip the names of those switches
+// from the prefix given to Reduce.
 type FieldRef struct {
 	Name string
+	Up   int
 }
 
 func (e *FieldRef) Concrete() bool {
@@ -306,6 +314,7 @@ func (e *FieldRef) Eval() int {
 }
 
 func (e *FieldRef) Reduce(prefix string) string {
+	prefix = outerPrefix(prefix, e.Up)
 	val := e.Name
 	if len(prefix) > 0 {
 		val = fmt.Sprintf("%s%s", prefix, val)
@@ -321,6 +330,78 @@ func (e *FieldRef) Initialize(p *Protocol) {
 	e.Name = SrcName(p, e.Name)
 }
 
+// outerPrefix strips the last 'up' names from a prefix like 'v.Items.'.
+func outerPrefix(prefix string, up int) string {
+	if up == 0 || len(prefix) == 0 {
+		return prefix
+	}
+	names := strings.Split(strings.TrimSuffix(prefix, "."), ".")
+	if up >= len(names) {
+		return ""
+	}
+	return strings.Join(names[:len(names)-up], ".") + "."
+}
+
+// walkExprFieldRefs calls 'fn' on every field reference in 'expr'.
+func walkExprFieldRefs(expr Expression, depth int,
+	fn func(ref *FieldRef, depth int)) {
+
+	switch e := expr.(type) {
+	case *FieldRef:
+		fn(e, depth)
+	case *Function:
+		walkExprFieldRefs(e.Expr, depth, fn)
+	case *BinaryOp:
+		walkExprFieldRefs(e.Expr1, depth, fn)
+		walkExprFieldRefs(e.Expr2, depth, fn)
+	case *UnaryOp:
+		walkExprFieldRefs(e.Expr, depth, fn)
+	case *Padding:
+		walkExprFieldRefs(e.Expr, depth, fn)
+	case *PopCount:
+		walkExprFieldRefs(e.Expr, depth, fn)
+	case *SumOf:
+		fn(e.Ref, depth)
+	}
+}
+
+// SwitchSize is a custom expression not found in the XML. It computes the
+// size of a switch field by adding up the sizes of the fields of each
+// bitcase that matches. Since that can't be written as a single Go
+// expression, it is written as a function literal that is called in place.
+type SwitchSize struct {
+	Switch *SwitchField
+}
+
+func (e *SwitchSize) Concrete() bool {
+	return false
+}
+
+func (e *SwitchSize) Eval() int {
+	log.Fatalf("Cannot evaluate a 'SwitchSize'. It is not concrete.")
+	panic("unreachable")
+}
+
+func (e *SwitchSize) Reduce(prefix string) string {
+	buf := bytes.NewBufferString("func() int {\nsize := 0\n")
+	for _, bitcase := range e.Switch.Bitcases {
+		fmt.Fprintf(buf, "if %s {\n", e.Switch.Condition(bitcase, prefix))
+		for _, field := range bitcase.Fields {
+			fmt.Fprintf(buf, "size += %s\n", field.Size().Reduce(
+				e.Switch.FieldPrefix(bitcase, prefix)))
+		}
+		fmt.Fprintf(buf, "}\n")
+	}
+	fmt.Fprintf(buf, "return size\n}()")
+	return buf.String()
+}
+
+func (e *SwitchSize) String() string {
+	return e.Reduce("")
+}
+
+func (e *SwitchSize) Initialize(p *Protocol) {}
+
 // EnumRef represents a reference to some enumeration field.
 // EnumKind is the "group" an EnumItem is the name of the specific enumeration
 // value inside that group.
@@ -339,7 +420,12 @@ func (e *EnumRef) Eval() int {
 }
 
 func (e *EnumRef) Reduce(prefix string) string {
-	return fmt.Sprintf("%s%s", e.EnumKind, e.EnumItem)
+	// Typed enum values are used in expressions as ints, like the fields
+	// that they are compared with.
+	if enum, ok := e.EnumKind.(*Enum); ok && enum.Typed() {
+		return fmt.Sprintf("int(%s%s)", enum.SrcName(), e.EnumItem)
+	}
+	return fmt.Sprintf("%s%s", e.EnumKind.SrcName(), e.EnumItem)
 }
 
 func (e *EnumRef) String() string {
@@ -351,10 +437,11 @@ func (e *EnumRef) Initialize(p *Protocol) {
 	e.EnumItem = SrcName(p, e.EnumItem)
 }
 
-// SumOf represents a summation of the variable in the generated code named by
-// Name. It is not currently used. (It's XKB voodoo.)
+// SumOf represents a summation of the values in the list referred to by Ref.
+// Since Go has no builtin for this, it is written as a function literal
+// that is called in place.
 type SumOf struct {
-	Name string
+	Ref *FieldRef
 }
 
 func (e *SumOf) Concrete() bool {
@@ -367,10 +454,9 @@ func (e *SumOf) Eval() int {
 }
 
 func (e *SumOf) Reduce(prefix string) string {
-	if len(prefix) > 0 {
-		return fmt.Sprintf("sum(%s%s)", prefix, e.Name)
-	}
-	return fmt.Sprintf("sum(%s)", e.Name)
+	return fmt.Sprintf("func() int {\nsum := 0\n"+
+		"for _, elem := range %s {\nsum += int(elem)\n}\nreturn sum\n}()",
+		e.Ref.Reduce(prefix))
 }
 
 func (e *SumOf) String() string {
@@ -378,5 +464,5 @@ func (e *SumOf) String() string {
 }
 
 func (e *SumOf) Initialize(p *Protocol) {
-	e.Name = SrcName(p, e.Name)
+	e.Ref.Initialize(p)
 }
@@ -79,11 +79,39 @@ type SingleField struct {
 	srcName string
 	xmlName string
 	Type    Type
+
+	// Enum is the enum that the values of this field are taken from, when
+	// the XML says so with an 'enum' or 'mask' attribute. If the enum has a
+	// type of its own, so does this field. (See Enum.Typed.)
+	Enum     *Enum
+	enumName string
+	isMask   bool
 }
 
 func (f *SingleField) Initialize(p *Protocol) {
 	f.srcName = SrcName(p, f.XmlName())
 	f.Type = f.Type.(*Translation).RealType(p)
+	if len(f.enumName) > 0 {
+		f.initEnum(p)
+	}
+}
+
+// initEnum looks up the enum of this field. Only integer fields can be typed
+// by their enum, and only enums of the protocol being generated are typed by
+// its fields (the others already are, or not, in their own package).
+func (f *SingleField) initEnum(p *Protocol) {
+	base, ok := f.Type.(*Base)
+	if !ok || !base.Integer() {
+		return
+	}
+	enum, ok := newTranslation(f.enumName).RealType(p).(*Enum)
+	if !ok {
+		return
+	}
+	f.Enum = enum
+	if p.hasType(enum) {
+		enum.use(p, base, f.isMask)
+	}
 }
 
 func (f *SingleField) SrcName() string {
@@ -98,9 +126,30 @@ func (f *SingleField) XmlName() string {
 }
 
 func (f *SingleField) SrcType() string {
+	if f.Typed() {
+		return f.Enum.SrcName()
+	}
 	return f.Type.SrcName()
 }
 
+// Typed returns true if this field has the type of its enum.
+func (f *SingleField) Typed() bool {
+	return f.Enum != nil && f.Enum.Typed()
+}
+
+// wireType is the type that this field is read and written as. A field typed
+// by its enum is converted to and from its base type, like a typedef.
+func (f *SingleField) wireType() Type {
+	if f.Typed() {
+		return &TypeDef{
+			srcName: f.SrcType(),
+			xmlName: f.Enum.XmlName(),
+			Old:     f.Type,
+		}
+	}
+	return f.Type
+}
+
 func (f *SingleField) Size() Size {
 	return f.Type.Size()
 }
@@ -194,6 +243,80 @@ type LocalField struct {
 	*SingleField
 }
 
+// FdField is a file descriptor that is sent or received along with a request
+// or reply as ancillary data on a Unix socket. It takes up no bytes in the
+// request or reply itself.
+type FdField struct {
+	srcName string
+	xmlName string
+
+	// sent is true for the file descriptors of a request, which are taken
+	// as an xgb.Fd so that an *os.File can be passed as well as an int.
+	sent bool
+
+	// last is true if no file descriptors of a reply are read after this
+	// one. (See Reply.Initialize.)
+	last bool
+}
+
+func (f *FdField) SrcName() string {
+	return f.srcName
+}
+
+func (f *FdField) XmlName() string {
+	return f.xmlName
+}
+
+func (f *FdField) SrcType() string {
+	if f.sent {
+		return "xgb.Fd"
+	}
+	return "int"
+}
+
+func (f *FdField) Size() Size {
+	return newFixedSize(0, true)
+}
+
+func (f *FdField) Initialize(p *Protocol) {
+	f.srcName = SrcName(p, f.XmlName())
+}
+
+// FdListField is a list of file descriptors, i.e., one for each plane of a
+// buffer. Like an FdField, it takes up no bytes in the request or reply.
+type FdListField struct {
+	srcName    string
+	xmlName    string
+	LengthExpr Expression
+
+	sent bool
+	last bool
+}
+
+func (f *FdListField) SrcName() string {
+	return f.srcName
+}
+
+func (f *FdListField) XmlName() string {
+	return f.xmlName
+}
+
+func (f *FdListField) SrcType() string {
+	if f.sent {
+		return "[]xgb.Fd"
+	}
+	return "[]int"
+}
+
+func (f *FdListField) Size() Size {
+	return newFixedSize(0, true)
+}
+
+func (f *FdListField) Initialize(p *Protocol) {
+	f.srcName = SrcName(p, f.XmlName())
+	f.LengthExpr.Initialize(p)
+}
+
 // ExprField is a field that is not parameterized, but is computed from values
 // of other fields.
 type ExprField struct {
@@ -233,6 +356,57 @@ type ValueField struct {
 	MaskType Type
 	MaskName string
 	ListName string
+
+	// MaskEnum is the enum naming the bits of the mask, if it is known.
+	// (See valueMaskEnums.)
+	MaskEnum *Enum
+}
+
+// valueMaskEnums maps the requests with a value mask and a value list to the
+// enum naming the bits of the mask. The XML descriptions don't say which enum
+// that is, so they're listed here by hand. Keys are "package.XmlName".
+var valueMaskEnums = map[string]string{
+	"xproto.ChangeGC":               "GC",
+	"xproto.ChangeKeyboardControl":  "KB",
+	"xproto.ChangeWindowAttributes": "CW",
+	"xproto.ConfigureWindow":        "ConfigWindow",
+	"xproto.CreateGC":               "GC",
+	"xproto.CreateWindow":           "CW",
+	"render.ChangePicture":          "CP",
+	"render.CreatePicture":          "CP",
+	"screensaver.SetAttributes":     "xproto:CW",
+}
+
+// valueEnums maps the values of value lists to the enums that they take
+// their values from, where there is one, so that the setters of the Values
+// builders are typed by them. Keys are "package.MaskEnum.Item", where the
+// mask enum is one in valueMaskEnums.
+var valueEnums = map[string]valueEnum{
+	"xproto.CW.BitGravity":          {"Gravity", false},
+	"xproto.CW.WinGravity":          {"Gravity", false},
+	"xproto.CW.BackingStore":        {"BackingStore", false},
+	"xproto.CW.EventMask":           {"EventMask", true},
+	"xproto.CW.DontPropagate":       {"EventMask", true},
+	"xproto.GC.Function":            {"GX", false},
+	"xproto.GC.LineStyle":           {"LineStyle", false},
+	"xproto.GC.CapStyle":            {"CapStyle", false},
+	"xproto.GC.JoinStyle":           {"JoinStyle", false},
+	"xproto.GC.FillStyle":           {"FillStyle", false},
+	"xproto.GC.FillRule":            {"FillRule", false},
+	"xproto.GC.SubwindowMode":       {"SubwindowMode", false},
+	"xproto.GC.ArcMode":             {"ArcMode", false},
+	"xproto.ConfigWindow.StackMode": {"StackMode", false},
+	"xproto.KB.LedMode":             {"LedMode", false},
+	"xproto.KB.AutoRepeatMode":      {"AutoRepeatMode", false},
+	"render.CP.Repeat":              {"Repeat", false},
+	"render.CP.SubwindowMode":       {"xproto:SubwindowMode", false},
+	"render.CP.PolyEdge":            {"PolyEdge", false},
+	"render.CP.PolyMode":            {"PolyMode", false},
+}
+
+type valueEnum struct {
+	Name   string
+	IsMask bool
 }
 
 func (f *ValueField) SrcName() string {
@@ -243,10 +417,28 @@ func (f *ValueField) XmlName() string {
 	panic("it is illegal to call XmlName on a ValueField field")
 }
 
+// SrcType is the type of the mask, which is that of its enum if the enum is
+// typed.
 func (f *ValueField) SrcType() string {
+	if f.MaskEnum != nil && f.MaskEnum.Typed() {
+		return f.MaskEnum.SrcName()
+	}
 	return f.MaskType.SrcName()
 }
 
+// maskWireType is the type that the mask is read and written as. (See
+// SingleField.wireType.)
+func (f *ValueField) maskWireType() Type {
+	if f.MaskEnum != nil && f.MaskEnum.Typed() {
+		return &TypeDef{
+			srcName: f.SrcType(),
+			xmlName: f.MaskEnum.XmlName(),
+			Old:     f.MaskType,
+		}
+	}
+	return f.MaskType
+}
+
 // Size computes the size in bytes of the combination of the mask and list
 // in this value field.
 // The expression to compute this looks complicated, but it's really just
@@ -286,18 +478,55 @@ func (f *ValueField) Initialize(p *Protocol) {
 	f.MaskType = f.MaskType.(*Translation).RealType(p)
 	f.MaskName = SrcName(p, f.MaskName)
 	f.ListName = SrcName(p, f.ListName)
+
+	rq, ok := f.Parent.(*Request)
+	if !ok {
+		return
+	}
+	if name, ok := valueMaskEnums[p.PkgName()+"."+rq.XmlName()]; ok {
+		f.MaskEnum = newTranslation(name).RealType(p).(*Enum)
+		f.MaskEnum.valueMaskType = f.MaskType.SrcName()
+		if base, ok := f.MaskType.(*Base); ok && p.hasType(f.MaskEnum) {
+			f.MaskEnum.use(p, base, true)
+			f.initValueEnums(p)
+		}
+	}
+}
+
+// initValueEnums looks up the enums of the values in the list, which are all
+// 32 bits wide. (See valueEnums.)
+func (f *ValueField) initValueEnums(p *Protocol) {
+	card32 := newTranslation("CARD32").RealType(p).(*Base)
+	for _, item := range f.MaskEnum.Items {
+		key := p.PkgName() + "." + f.MaskEnum.XmlName() + "." + item.xmlName
+		value, ok := valueEnums[key]
+		if !ok {
+			continue
+		}
+		item.ValueEnum = newTranslation(value.Name).RealType(p).(*Enum)
+		if p.hasType(item.ValueEnum) {
+			item.ValueEnum.use(p, card32, value.IsMask)
+		}
+	}
 }
 
 // SwitchField represents a 'switch' element in the XML protocol description
-// file. It is not currently used. (i.e., it is XKB voodoo.)
+// file. It is translated to a struct type containing the fields of all of
+// its bitcases (or cases), and only the fields of those bitcases that match
+// the switch expression are read from or written to the wire.
 type SwitchField struct {
 	Name     string
 	Expr     Expression
 	Bitcases []*Bitcase
+
+	// parentName is the name of the type (or request) containing this
+	// switch. It is used to give the switch's struct type a unique name.
+	parentName string
+	srcType    string
 }
 
 func (f *SwitchField) SrcName() string {
-	panic("it is illegal to call SrcName on a SwitchField field")
+	return f.Name
 }
 
 func (f *SwitchField) XmlName() string {
@@ -305,30 +534,139 @@ func (f *SwitchField) XmlName() string {
 }
 
 func (f *SwitchField) SrcType() string {
-	panic("it is illegal to call SrcType on a SwitchField field")
+	return f.srcType
 }
 
-// XXX: This is a bit tricky. The size has to be represented as a non-concrete
-// expression that finds *which* bitcase fields are included, and sums the
-// sizes of those fields.
+// Size is represented as a non-concrete expression that finds *which*
+// bitcase fields are included, and sums the sizes of those fields.
 func (f *SwitchField) Size() Size {
-	return newFixedSize(0, true)
+	exact := true
+	for _, bitcase := range f.Bitcases {
+		for _, field := range bitcase.Fields {
+			exact = exact && field.Size().exact
+		}
+	}
+	return newExpressionSize(&SwitchSize{Switch: f}, exact)
 }
 
 func (f *SwitchField) Initialize(p *Protocol) {
 	f.Name = SrcName(p, f.Name)
+	f.srcType = SrcName(p, f.parentName) + f.Name
 	f.Expr.Initialize(p)
 	for _, bitcase := range f.Bitcases {
-		bitcase.Expr.Initialize(p)
+		for _, expr := range bitcase.Exprs {
+			expr.Initialize(p)
+		}
+		if len(bitcase.Name) > 0 {
+			bitcase.Name = SrcName(p, bitcase.Name)
+			bitcase.srcType = f.srcType + bitcase.Name
+		}
+		nameSwitches(bitcase.Fields, bitcase.Parent(f))
 		for _, field := range bitcase.Fields {
 			field.Initialize(p)
 		}
 	}
+
+	// Fields in a bitcase may refer to other fields in the same bitcase or
+	// to fields in the type containing this switch. The latter must be
+	// told that they live some levels up, since the fields of a bitcase are
+	// read and written with this switch's name (and the bitcase's name, if
+	// it has one) added to the prefix.
+	for _, bitcase := range f.Bitcases {
+		local := make(map[string]bool)
+		for _, field := range bitcase.Fields {
+			switch field.(type) {
+			case *PadField:
+			default:
+				local[field.SrcName()] = true
+			}
+		}
+		walkFieldRefs(bitcase.Fields, 0, func(ref *FieldRef, depth int) {
+			if ref.Up == depth && !local[ref.Name] {
+				ref.Up += bitcase.Levels()
+			}
+		})
+	}
+}
+
+// Condition returns the Go expression that is true when 'bitcase' matches
+// this switch's expression.
+func (f *SwitchField) Condition(bitcase *Bitcase, prefix string) string {
+	expr := f.Expr.Reduce(prefix)
+	if _, ok := f.Expr.(*FieldRef); ok {
+		expr = fmt.Sprintf("int(%s)", expr)
+	}
+	conds := make([]string, len(bitcase.Exprs))
+	for i, bexpr := range bitcase.Exprs {
+		if bitcase.IsCase {
+			conds[i] = fmt.Sprintf("%s == %s", expr, bexpr.Reduce(prefix))
+		} else {
+			conds[i] = fmt.Sprintf("%s&%s != 0", expr, bexpr.Reduce(prefix))
+		}
+	}
+	return strings.Join(conds, " || ")
 }
 
-// Bitcase represents a single bitcase inside a switch expression.
-// It is not currently used. (i.e., it's XKB voodoo.)
+// FieldPrefix returns the prefix used to read and write the fields in
+// 'bitcase', given the prefix of the type containing this switch.
+func (f *SwitchField) FieldPrefix(bitcase *Bitcase, prefix string) string {
+	prefix = prefix + f.Name + "."
+	if len(bitcase.Name) > 0 {
+		prefix = prefix + bitcase.Name + "."
+	}
+	return prefix
+}
+
+// Bitcase represents a single bitcase (or case) inside a switch expression.
+// A bitcase matches when the switch expression has any of the bits in
+// one of Exprs set. A case matches when the switch expression is equal to
+// one of Exprs.
+// A named bitcase keeps its fields in a struct of their own, since the
+// fields of different named bitcases often share names (i.e., XKB).
 type Bitcase struct {
+	Name   string
 	Fields []Field
-	Expr   Expression
+	Exprs  []Expression
+	IsCase bool
+
+	srcType string
+}
+
+// Parent returns the name of the type that holds the fields of this bitcase.
+func (b *Bitcase) Parent(swtch *SwitchField) string {
+	if len(b.Name) > 0 {
+		return b.srcType
+	}
+	return swtch.srcType
+}
+
+// Levels returns the number of names this bitcase adds to a prefix.
+func (b *Bitcase) Levels() int {
+	if len(b.Name) > 0 {
+		return 2
+	}
+	return 1
+}
+
+// walkFieldRefs calls 'fn' on every field reference in the expressions used
+// by 'fields', descending into the bitcases of any switch fields.
+// 'depth' is the number of switches that have been descended into.
+func walkFieldRefs(fields []Field, depth int,
+	fn func(ref *FieldRef, depth int)) {
+
+	for _, field := range fields {
+		switch f := field.(type) {
+		case *ListField:
+			walkExprFieldRefs(f.LengthExpr, depth, fn)
+		case *FdListField:
+			walkExprFieldRefs(f.LengthExpr, depth, fn)
+		case *ExprField:
+			walkExprFieldRefs(f.Expr, depth, fn)
+		case *SwitchField:
+			walkExprFieldRefs(f.Expr, depth, fn)
+			for _, bitcase := range f.Bitcases {
+				walkFieldRefs(bitcase.Fields, depth+bitcase.Levels(), fn)
+			}
+		}
+	}
 }
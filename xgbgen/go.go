@@ -14,6 +14,7 @@ var BaseTypeMap = map[string]string{
 	"CARD8":  "byte",
 	"CARD16": "uint16",
 	"CARD32": "uint32",
+	"CARD64": "uint64",
 	"INT8":   "int8",
 	"INT16":  "int16",
 	"INT32":  "int32",
@@ -31,6 +32,7 @@ var BaseTypeSizes = map[string]uint{
 	"CARD8":  1,
 	"CARD16": 2,
 	"CARD32": 4,
+	"CARD64": 8,
 	"INT8":   1,
 	"INT16":  2,
 	"INT32":  4,
@@ -54,6 +56,13 @@ var TypeMap = map[string]string{
 	"DEPTH":      "DepthInfo",
 	"SCREEN":     "ScreenInfo",
 	"Setup":      "SetupInfo",
+
+	// SYNC names its enums in capitals, which would come out as Alarmstate
+	// and so on.
+	"ALARMSTATE": "AlarmState",
+	"CA":         "CA",
+	"TESTTYPE":   "TestType",
+	"VALUETYPE":  "ValueType",
 }
 
 // NameMap is the same as TypeMap, but for names.
@@ -70,11 +79,189 @@ func (b *Base) Define(c *Context) {
 
 // Enum types
 func (enum *Enum) Define(c *Context) {
-	c.Putln("const (")
+	if !enum.Typed() {
+		c.Putln("const (")
+		for _, item := range enum.Items {
+			c.Putln("%s%s = %d",
+				enum.SrcName(), item.srcName, item.Expr.Eval())
+		}
+		c.Putln(")")
+		c.Putln("")
+	} else {
+		c.Putln("type %s %s", enum.SrcName(), enum.BaseType())
+		c.Putln("")
+		c.Putln("const (")
+		for _, item := range enum.Items {
+			c.Putln("%s%s %s = %d", enum.SrcName(), item.srcName,
+				enum.SrcName(), item.Expr.Eval())
+		}
+		c.Putln(")")
+		c.Putln("")
+		if enum.isMask {
+			enum.DefineMaskString(c)
+		} else {
+			enum.DefineString(c)
+		}
+	}
+
+	if len(enum.valueMaskType) > 0 {
+		enum.DefineValues(c)
+	}
+}
+
+// Value returns the value of 'item' as a value of Go type 'typ', which needs
+// a conversion if the enum is typed.
+func (enum *Enum) Value(item string, typ string) string {
+	if enum.Typed() && typ != enum.SrcName() {
+		return fmt.Sprintf("%s(%s%s)", typ, enum.SrcName(), item)
+	}
+	return enum.SrcName() + item
+}
+
+// DefineString writes a String method that returns the name of the item
+// with the value of an enum, or the value itself if there is no such item.
+// Of the items sharing a value, the first one gives the name.
+func (enum *Enum) DefineString(c *Context) {
+	c.Putln("// String returns the name of the %s item with this value.",
+		enum.SrcName())
+	c.Putln("func (v %s) String() string {", enum.SrcName())
+	c.Putln("switch v {")
+	seen := make(map[int]bool)
+	for _, item := range enum.Items {
+		if seen[item.Expr.Eval()] {
+			continue
+		}
+		seen[item.Expr.Eval()] = true
+		c.Putln("case %s%s:", enum.SrcName(), item.srcName)
+		c.Putln("return \"%s\"", item.srcName)
+	}
+	c.Putln("}")
+	c.Putln("return xgb.Sprintf(\"%s(%%d)\", %s(v))",
+		enum.SrcName(), enum.BaseType())
+	c.Putln("}")
+	c.Putln("")
+}
+
+// DefineMaskString writes a String method that returns the names of the bits
+// set in a mask of an enum, joined by '|'. Bits without a name are left in a
+// hexadecimal remainder.
+func (enum *Enum) DefineMaskString(c *Context) {
+	var zero *EnumItem
+	bits := make([]*EnumItem, 0, len(enum.Items))
+	seen := make(map[int]bool)
+	for _, item := range enum.Items {
+		v := item.Expr.Eval()
+		if v == 0 && zero == nil {
+			zero = item
+		}
+		if v <= 0 || v&(v-1) != 0 || seen[v] {
+			continue
+		}
+		seen[v] = true
+		bits = append(bits, item)
+	}
+
+	c.Putln("// String returns the names of the %s bits set in a value.",
+		enum.SrcName())
+	c.Putln("func (v %s) String() string {", enum.SrcName())
+	c.Putln("if v == 0 {")
+	if zero != nil {
+		c.Putln("return \"%s\"", zero.srcName)
+	} else {
+		c.Putln("return \"0\"")
+	}
+	c.Putln("}")
+	c.Putln("names := make([]string, 0, %d)", len(bits))
+	for _, item := range bits {
+		c.Putln("if v&%s%s != 0 {", enum.SrcName(), item.srcName)
+		c.Putln("names = append(names, \"%s\")", item.srcName)
+		c.Putln("v &^= %s%s", enum.SrcName(), item.srcName)
+		c.Putln("}")
+	}
+	c.Putln("if v != 0 {")
+	c.Putln("names = append(names, xgb.Sprintf(\"%%#x\", %s(v)))",
+		enum.BaseType())
+	c.Putln("}")
+	c.Putln("return xgb.StringsJoin(names, \"|\")")
+	c.Putln("}")
+	c.Putln("")
+}
+
+// DefineValues writes a type that builds a value mask and a value list out of
+// the bits of this enum, with a setter for each bit. The setters of values
+// taken from a typed enum take that type. The values are kept by bit, so that
+// the list comes out in the order defined by the protocol.
+func (enum *Enum) DefineValues(c *Context) {
+	bits := make(map[int]*EnumItem)
+	size := 0
 	for _, item := range enum.Items {
-		c.Putln("%s%s = %d", enum.SrcName(), item.srcName, item.Expr.Eval())
+		v := item.Expr.Eval()
+		if v <= 0 || v&(v-1) != 0 {
+			continue
+		}
+		bit := 0
+		for v > 1 {
+			v >>= 1
+			bit++
+		}
+		bits[bit] = item
+		if bit >= size {
+			size = bit + 1
+		}
 	}
-	c.Putln(")")
+
+	maskType := enum.valueMaskType
+	if enum.Typed() {
+		maskType = enum.SrcName()
+	}
+	name := enum.SrcName() + "Values"
+	c.Putln("// %s builds a value mask and a value list out of %s values.",
+		name, enum.SrcName())
+	c.Putln("// The values are listed in the order defined by the protocol, " +
+		"no matter the order they are set in.")
+	c.Putln("type %s struct {", name)
+	c.Putln("mask %s", maskType)
+	c.Putln("values [%d]uint32", size)
+	c.Putln("}")
+	c.Putln("")
+	for bit := 0; bit < size; bit++ {
+		item, ok := bits[bit]
+		if !ok {
+			continue
+		}
+		c.Putln("// Set%s sets the value for %s%s.",
+			item.srcName, enum.SrcName(), item.srcName)
+		if item.ValueEnum != nil && item.ValueEnum.Typed() {
+			c.Putln("func (v *%s) Set%s(%s %s) *%s {", name, item.srcName,
+				item.srcName, item.ValueEnum.SrcName(), name)
+			c.Putln("v.mask |= %s", enum.Value(item.srcName, maskType))
+			c.Putln("v.values[%d] = uint32(%s)", bit, item.srcName)
+		} else {
+			c.Putln("func (v *%s) Set%s(%s uint32) *%s {",
+				name, item.srcName, item.srcName, name)
+			c.Putln("v.mask |= %s", enum.Value(item.srcName, maskType))
+			c.Putln("v.values[%d] = %s", bit, item.srcName)
+		}
+		c.Putln("return v")
+		c.Putln("}")
+		c.Putln("")
+	}
+	c.Putln("// Mask returns the value mask of the values that have been set.")
+	c.Putln("func (v *%s) Mask() %s {", name, maskType)
+	c.Putln("return v.mask")
+	c.Putln("}")
+	c.Putln("")
+	c.Putln("// List returns the values that have been set, in the order " +
+		"defined by the protocol.")
+	c.Putln("func (v *%s) List() []uint32 {", name)
+	c.Putln("list := make([]uint32, 0, len(v.values))")
+	c.Putln("for i, value := range v.values {")
+	c.Putln("if v.mask&(1<<uint(i)) != 0 {")
+	c.Putln("list = append(list, value)")
+	c.Putln("}")
+	c.Putln("}")
+	c.Putln("return list")
+	c.Putln("}")
 	c.Putln("")
 }
 
@@ -143,17 +330,59 @@ func (f *LocalField) Write(c *Context, prefix string) {
 		f.SrcName(), f.Size(), f.Type.SrcName())
 }
 
+// Fd fields
+func (f *FdField) Define(c *Context) {
+	c.Putln("%s int // file descriptor", f.SrcName())
+}
+
+// Read takes the next file descriptor received with the reply.
+func (f *FdField) Read(c *Context, prefix string) {
+	c.Putln("%s%s = fds[0]", prefix, f.SrcName())
+	if !f.last {
+		c.Putln("fds = fds[1:]")
+	}
+}
+
+func (f *FdField) Write(c *Context, prefix string) {
+	c.Putln("// file descriptor %s is sent out of band", f.SrcName())
+}
+
+// Fd list fields
+func (f *FdListField) Define(c *Context) {
+	c.Putln("%s []int // file descriptors", f.SrcName())
+}
+
+// Read takes the file descriptors of the list from those received with the
+// reply. If there are too few, the list is cut short. (The reply's Reply
+// method reports it.)
+func (f *FdListField) Read(c *Context, prefix string) {
+	c.Putln("{")
+	c.Putln("n := int(%s)", f.LengthExpr.Reduce(prefix))
+	c.Putln("if n > len(fds) {")
+	c.Putln("n = len(fds)")
+	c.Putln("}")
+	c.Putln("%s%s = fds[:n]", prefix, f.SrcName())
+	if !f.last {
+		c.Putln("fds = fds[n:]")
+	}
+	c.Putln("}")
+}
+
+func (f *FdListField) Write(c *Context, prefix string) {
+	c.Putln("// file descriptors %s are sent out of band", f.SrcName())
+}
+
 // Expr fields
+// They are only defined and read in the types of decoded requests, where
+// they hold the value that was computed when the request was written.
 func (f *ExprField) Define(c *Context) {
-	c.Putln("// expression field: %s %s (%s)",
+	c.Putln("%s %s // computed from %s",
 		f.SrcName(), f.Type.SrcName(), f.Expr)
-	panic("unreachable")
 }
 
 func (f *ExprField) Read(c *Context, prefix string) {
-	c.Putln("// reading expression field: %s (%s) (%s) :: %s",
-		f.SrcName(), f.Size(), f.Expr, f.Type.SrcName())
-	panic("unreachable")
+	ReadSimpleSingleField(c, fmt.Sprintf("%s%s", prefix, f.SrcName()),
+		f.Type)
 }
 
 func (f *ExprField) Write(c *Context, prefix string) {
@@ -168,48 +397,195 @@ func (f *ExprField) Write(c *Context, prefix string) {
 
 // Value field
 func (f *ValueField) Define(c *Context) {
-	c.Putln("%s %s", f.MaskName, f.SrcType())
+	if !f.maskIsField() {
+		c.Putln("%s %s", f.MaskName, f.SrcType())
+	}
 	c.Putln("%s []uint32", f.ListName)
 }
 
 func (f *ValueField) Read(c *Context, prefix string) {
-	ReadSimpleSingleField(c,
-		fmt.Sprintf("%s%s", prefix, f.MaskName), f.MaskType)
-	c.Putln("")
+	if !f.maskIsField() {
+		ReadSimpleSingleField(c,
+			fmt.Sprintf("%s%s", prefix, f.MaskName), f.maskWireType())
+		c.Putln("")
+	}
 	c.Putln("%s%s = make([]uint32, %s)",
 		prefix, f.ListName, f.ListLength().Reduce(prefix))
 	c.Putln("for i := 0; i < %s; i++ {", f.ListLength().Reduce(prefix))
-	c.Putln("%s%s[i] = xgb.Get32(buf[b:])", prefix, f.ListName)
+	c.Putln("%s%s[i] = order.Get32(buf[b:])", prefix, f.ListName)
 	c.Putln("b += 4")
 	c.Putln("}")
 	c.Putln("b = xgb.Pad(b)")
 }
 
 func (f *ValueField) Write(c *Context, prefix string) {
-	// big time mofos
-	if rq, ok := f.Parent.(*Request); !ok || rq.SrcName() != "ConfigureWindow" {
+	if !f.maskIsField() {
 		WriteSimpleSingleField(c,
-			fmt.Sprintf("%s%s", prefix, f.MaskName), f.MaskType)
+			fmt.Sprintf("%s%s", prefix, f.MaskName), f.maskWireType())
 	}
 	c.Putln("for i := 0; i < %s; i++ {", f.ListLength().Reduce(prefix))
-	c.Putln("xgb.Put32(buf[b:], %s%s[i])", prefix, f.ListName)
+	c.Putln("order.Put32(buf[b:], %s%s[i])", prefix, f.ListName)
 	c.Putln("b += 4")
 	c.Putln("}")
 	c.Putln("b = xgb.Pad(b)")
 }
 
+// maskIsField returns true if the mask of this value list is a field of its
+// own, which comes before the list rather than right before it.
+func (f *ValueField) maskIsField() bool {
+	// big time mofos
+	rq, ok := f.Parent.(*Request)
+	return ok && rq.SrcName() == "ConfigureWindow"
+}
+
 // Switch field
 func (f *SwitchField) Define(c *Context) {
-	c.Putln("// switch field: %s (%s)", f.Name, f.Expr)
-	panic("todo")
+	c.Putln("%s %s", f.Name, f.SrcType())
+}
+
+// DefineType writes the struct type containing the fields of every bitcase
+// in this switch, along with the types of any named bitcases and nested
+// switches.
+func (f *SwitchField) DefineType(c *Context) {
+	c.Putln("// %s is a switch on %s. Only the fields of the cases that match "+
+		"are sent over the wire.", f.SrcType(), f.Expr)
+	c.Putln("type %s struct {", f.SrcType())
+	for _, bitcase := range f.Bitcases {
+		c.Putln("// %s", f.Condition(bitcase, ""))
+		if len(bitcase.Name) > 0 {
+			c.Putln("%s %s", bitcase.Name, bitcase.srcType)
+			continue
+		}
+		for _, field := range bitcase.Fields {
+			field.Define(c)
+		}
+	}
+	c.Putln("}")
+	c.Putln("")
+
+	for _, bitcase := range f.Bitcases {
+		if len(bitcase.Name) > 0 {
+			c.Putln("// %s holds the fields of %s when %s.",
+				bitcase.srcType, f.SrcType(), f.Condition(bitcase, ""))
+			c.Putln("type %s struct {", bitcase.srcType)
+			for _, field := range bitcase.Fields {
+				field.Define(c)
+			}
+			c.Putln("}")
+			c.Putln("")
+		}
+		DefineSwitchTypes(c, bitcase.Fields)
+	}
 }
 
 func (f *SwitchField) Read(c *Context, prefix string) {
-	c.Putln("// reading switch field: %s (%s)", f.Name, f.Expr)
-	panic("todo")
+	for _, bitcase := range f.Bitcases {
+		c.Putln("if %s {", f.Condition(bitcase, prefix))
+		for i, field := range bitcase.Fields {
+			if i > 0 {
+				c.Putln("")
+			}
+			field.Read(c, f.FieldPrefix(bitcase, prefix))
+		}
+		c.Putln("}")
+	}
 }
 
 func (f *SwitchField) Write(c *Context, prefix string) {
-	c.Putln("// writing switch field: %s (%s)", f.Name, f.Expr)
-	panic("todo")
+	for _, bitcase := range f.Bitcases {
+		c.Putln("if %s {", f.Condition(bitcase, prefix))
+		for i, field := range bitcase.Fields {
+			if i > 0 {
+				c.Putln("")
+			}
+			field.Write(c, f.FieldPrefix(bitcase, prefix))
+		}
+		c.Putln("}")
+	}
+}
+
+// valueMask returns the field among 'fields' holding the mask that this switch
+// is on, if the switch is a value list: each of its bitcases is a single
+// field, which is sent when the bit of some enum is set in the mask.
+func (f *SwitchField) valueMask(fields []Field) Field {
+	ref, ok := f.Expr.(*FieldRef)
+	if !ok || ref.Up > 0 {
+		return nil
+	}
+	for _, bitcase := range f.Bitcases {
+		if bitcase.IsCase || len(bitcase.Name) > 0 ||
+			len(bitcase.Exprs) != 1 || len(bitcase.Fields) != 1 {
+
+			return nil
+		}
+		ref, ok := bitcase.Exprs[0].(*EnumRef)
+		if !ok {
+			return nil
+		}
+		if _, ok := ref.EnumKind.(*Enum); !ok {
+			return nil
+		}
+		if _, ok := bitcase.Fields[0].(*SingleField); !ok {
+			return nil
+		}
+	}
+	for _, field := range fields {
+		if mask, ok := field.(*SingleField); ok && mask.SrcName() == ref.Name {
+			return mask
+		}
+	}
+	return nil
+}
+
+// DefineValues writes a type that builds the mask and the value list of
+// request 'r', which are 'mask' and this switch, with a setter for each
+// value. The mask is computed from the values that are set.
+func (f *SwitchField) DefineValues(c *Context, r *Request, mask Field) {
+	name := r.SrcName() + "Values"
+	c.Putln("// %s builds the %s and %s of a %s request.",
+		name, mask.SrcName(), f.Name, r.SrcName())
+	c.Putln("// Only the values that are set are sent, in the order " +
+		"defined by the protocol.")
+	c.Putln("type %s struct {", name)
+	c.Putln("mask %s", mask.SrcType())
+	c.Putln("list %s", f.SrcType())
+	c.Putln("}")
+	c.Putln("")
+	for _, bitcase := range f.Bitcases {
+		field := bitcase.Fields[0]
+		ref := bitcase.Exprs[0].(*EnumRef)
+		enum := ref.EnumKind.(*Enum)
+		c.Putln("// Set%s sets %s, the value for %s%s.", field.SrcName(),
+			field.SrcName(), enum.SrcName(), ref.EnumItem)
+		c.Putln("func (v *%s) Set%s(%s %s) *%s {", name, field.SrcName(),
+			field.SrcName(), field.SrcType(), name)
+		c.Putln("v.mask |= %s", enum.Value(ref.EnumItem, mask.SrcType()))
+		c.Putln("v.list.%s = %s", field.SrcName(), field.SrcName())
+		c.Putln("return v")
+		c.Putln("}")
+		c.Putln("")
+	}
+	c.Putln("// Mask returns the %s of the values that have been set.",
+		mask.SrcName())
+	c.Putln("func (v *%s) Mask() %s {", name, mask.SrcType())
+	c.Putln("return v.mask")
+	c.Putln("}")
+	c.Putln("")
+	c.Putln("// List returns the %s holding the values that have been set.",
+		f.Name)
+	c.Putln("func (v *%s) List() %s {", name, f.SrcType())
+	c.Putln("return v.list")
+	c.Putln("}")
+	c.Putln("")
+}
+
+// DefineSwitchTypes writes the types of any switch fields in 'fields'.
+// It should be called by anything that defines fields before it defines
+// itself.
+func DefineSwitchTypes(c *Context, fields []Field) {
+	for _, field := range fields {
+		if swtch, ok := field.(*SwitchField); ok {
+			swtch.DefineType(c)
+		}
+	}
 }
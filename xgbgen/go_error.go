@@ -9,10 +9,15 @@ func (e *Error) Define(c *Context) {
 	c.Putln("// %s is the error number for a %s.", e.ErrConst(), e.ErrConst())
 	c.Putln("const %s = %d", e.ErrConst(), e.Number)
 	c.Putln("")
+	if e.Doc != nil {
+		c.Putln("// %s is the type of %s errors.", e.ErrType(), e.SrcName())
+		e.Doc.Comment(c)
+	}
 	c.Putln("type %s struct {", e.ErrType())
 	c.Putln("Sequence uint16")
 	c.Putln("NiceName string")
 	for _, field := range e.Fields {
+		e.Doc.FieldComment(c, field)
 		field.Define(c)
 	}
 	c.Putln("}")
@@ -39,15 +44,16 @@ func (e *Error) Define(c *Context) {
 
 func (e *Error) Read(c *Context) {
 	c.Putln("// %sNew constructs a %s value that implements xgb.Error from "+
-		"a byte slice.", e.ErrType(), e.ErrType())
-	c.Putln("func %sNew(buf []byte) xgb.Error {", e.ErrType())
+		"a byte slice in the byte order 'order'.", e.ErrType(), e.ErrType())
+	c.Putln("func %sNew(buf []byte, order xgb.ByteOrder) xgb.Error {",
+		e.ErrType())
 	c.Putln("v := %s{}", e.ErrType())
 	c.Putln("v.NiceName = \"%s\"", e.SrcName())
 	c.Putln("")
 	c.Putln("b := 1 // skip error determinant")
 	c.Putln("b += 1 // don't read error number")
 	c.Putln("")
-	c.Putln("v.Sequence = xgb.Get16(buf[b:])")
+	c.Putln("v.Sequence = order.Get16(buf[b:])")
 	c.Putln("b += 2")
 	c.Putln("")
 	for _, field := range e.Fields {
@@ -115,9 +121,10 @@ func (e *ErrorCopy) Define(c *Context) {
 
 func (e *ErrorCopy) Read(c *Context) {
 	c.Putln("// %sNew constructs a %s value that implements xgb.Error from "+
-		"a byte slice.", e.ErrType(), e.ErrType())
-	c.Putln("func %sNew(buf []byte) xgb.Error {", e.ErrType())
-	c.Putln("v := %s(%sNew(buf).(%s))",
+		"a byte slice in the byte order 'order'.", e.ErrType(), e.ErrType())
+	c.Putln("func %sNew(buf []byte, order xgb.ByteOrder) xgb.Error {",
+		e.ErrType())
+	c.Putln("v := %s(%sNew(buf, order).(%s))",
 		e.ErrType(), e.Old.(*Error).ErrType(), e.Old.(*Error).ErrType())
 	c.Putln("v.NiceName = \"%s\"", e.SrcName())
 	c.Putln("return v")
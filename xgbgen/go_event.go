@@ -9,11 +9,17 @@ func (e *Event) Define(c *Context) {
 	c.Putln("// %s is the event number for a %s.", e.SrcName(), e.EvType())
 	c.Putln("const %s = %d", e.SrcName(), e.Number)
 	c.Putln("")
+	DefineSwitchTypes(c, e.Fields)
+	if e.Doc != nil {
+		c.Putln("// %s is the type of %s events.", e.EvType(), e.SrcName())
+		e.Doc.Comment(c)
+	}
 	c.Putln("type %s struct {", e.EvType())
 	if !e.NoSequence {
 		c.Putln("Sequence uint16")
 	}
 	for _, field := range e.Fields {
+		e.Doc.FieldComment(c, field)
 		field.Define(c)
 	}
 	c.Putln("}")
@@ -47,13 +53,65 @@ func (e *Event) Define(c *Context) {
 	c.Putln("}")
 	c.Putln("")
 
+	// Lets the event be subscribed to for a single window.
+	if field := eventWindow(e.Fields); field != nil {
+		eventWindowComment(c, e.SrcName())
+		c.Putln("func (v %s) EventWindow() uint32 {", e.EvType())
+		c.Putln("return uint32(v.%s)", field.SrcName())
+		c.Putln("}")
+		c.Putln("")
+	}
+
 	// Let's the XGB event loop read this event.
+	registerEvent(c, e.Number, e.EvType(), e.Xge)
+}
+
+// eventWindow returns the field of an event that holds the window it is
+// about, if there is one: the window the event was selected on, or else
+// the window it reports on.
+//
+// Events with a field named EventWindow (i.e., Present's RedirectNotify)
+// can't have an EventWindow method, so they get no such field either.
+func eventWindow(fields []Field) Field {
+	for _, field := range fields {
+		f, ok := field.(*SingleField)
+		if ok && f.SrcName() == "EventWindow" {
+			return nil
+		}
+	}
+	for _, name := range []string{"event", "request_window", "window"} {
+		for _, field := range fields {
+			f, ok := field.(*SingleField)
+			if !ok || f.XmlName() != name {
+				continue
+			}
+			if f.SrcType() == "Window" || f.SrcType() == "xproto.Window" {
+				return f
+			}
+		}
+	}
+	return nil
+}
+
+func eventWindowComment(c *Context, name string) {
+	c.Putln("// EventWindow returns the window that the %s event is about.",
+		name)
+	c.Putln("// It is used to subscribe to the events of a single window.")
+}
+
+// registerEvent writes an init function that adds the constructor of an
+// event to the appropriate map, so that the XGB event loop can read it.
+func registerEvent(c *Context, number int, evType string, xge bool) {
 	c.Putln("func init() {")
-	if c.protocol.isExt() {
+	switch {
+	case xge:
+		c.Putln("xgb.NewExtGenericEventFuncs[\"%s\"][%d] = %sNew",
+			c.protocol.ExtXName, number, evType)
+	case c.protocol.isExt():
 		c.Putln("xgb.NewExtEventFuncs[\"%s\"][%d] = %sNew",
-			c.protocol.ExtXName, e.Number, e.EvType())
-	} else {
-		c.Putln("xgb.NewEventFuncs[%d] = %sNew", e.Number, e.EvType())
+			c.protocol.ExtXName, number, evType)
+	default:
+		c.Putln("xgb.NewEventFuncs[%d] = %sNew", number, evType)
 	}
 	c.Putln("}")
 	c.Putln("")
@@ -61,14 +119,19 @@ func (e *Event) Define(c *Context) {
 
 func (e *Event) Read(c *Context) {
 	c.Putln("// %sNew constructs a %s value that implements xgb.Event from "+
-		"a byte slice.", e.EvType(), e.EvType())
-	c.Putln("func %sNew(buf []byte) xgb.Event {", e.EvType())
+		"a byte slice in the byte order 'order'.", e.EvType(), e.EvType())
+	c.Putln("func %sNew(buf []byte, order xgb.ByteOrder) xgb.Event {",
+		e.EvType())
 	c.Putln("v := %s{}", e.EvType())
+	if e.Xge {
+		e.ReadXge(c)
+		return
+	}
 	c.Putln("b := 1 // don't read event number")
 	c.Putln("")
 	for i, field := range e.Fields {
 		if i == 1 && !e.NoSequence {
-			c.Putln("v.Sequence = xgb.Get16(buf[b:])")
+			c.Putln("v.Sequence = order.Get16(buf[b:])")
 			c.Putln("b += 2")
 			c.Putln("")
 		}
@@ -80,9 +143,35 @@ func (e *Event) Read(c *Context) {
 	c.Putln("")
 }
 
+// ReadXge is the body of Read for generic events, whose fields start after
+// the generic event header.
+func (e *Event) ReadXge(c *Context) {
+	c.Putln("b := 1 // don't read event number")
+	c.Putln("b += 1 // don't read extension opcode")
+	c.Putln("")
+	c.Putln("v.Sequence = order.Get16(buf[b:])")
+	c.Putln("b += 2")
+	c.Putln("")
+	c.Putln("b += 4 // don't read length")
+	c.Putln("b += 2 // don't read event type")
+	c.Putln("")
+	for _, field := range e.Fields {
+		field.Read(c, "v.")
+		c.Putln("")
+	}
+	c.Putln("return v")
+	c.Putln("}")
+	c.Putln("")
+}
+
 func (e *Event) Write(c *Context) {
-	c.Putln("// Bytes writes a %s value to a byte slice.", e.EvType())
-	c.Putln("func (v %s) Bytes() []byte {", e.EvType())
+	c.Putln("// Bytes writes a %s value to a byte slice, in the byte order "+
+		"'order'.", e.EvType())
+	c.Putln("func (v %s) Bytes(order xgb.ByteOrder) []byte {", e.EvType())
+	if e.Xge {
+		e.WriteXge(c)
+		return
+	}
 	c.Putln("buf := make([]byte, %s)", e.Size())
 	c.Putln("b := 0")
 	c.Putln("")
@@ -103,6 +192,39 @@ func (e *Event) Write(c *Context) {
 	c.Putln("")
 }
 
+// WriteXge is the body of Write for generic events. The extension opcode
+// is left as zero, since it isn't known until the extension is initialized.
+func (e *Event) WriteXge(c *Context) {
+	c.Putln("size := xgb.Pad(%s)", e.XgeSize().Reduce("v."))
+	c.Putln("if size < 32 {")
+	c.Putln("size = 32")
+	c.Putln("}")
+	c.Putln("buf := make([]byte, size)")
+	c.Putln("b := 0")
+	c.Putln("")
+	c.Putln("// write event number")
+	c.Putln("buf[b] = 35")
+	c.Putln("b += 1")
+	c.Putln("")
+	c.Putln("b += 1 // skip extension opcode")
+	c.Putln("b += 2 // skip sequence number")
+	c.Putln("")
+	c.Putln("order.Put32(buf[b:], uint32((size-32)/4)) " +
+		"// write length beyond 32 bytes in 4-byte units")
+	c.Putln("b += 4")
+	c.Putln("")
+	c.Putln("order.Put16(buf[b:], %d) // write event type", e.Number)
+	c.Putln("b += 2")
+	c.Putln("")
+	for _, field := range e.Fields {
+		field.Write(c, "v.")
+		c.Putln("")
+	}
+	c.Putln("return buf")
+	c.Putln("}")
+	c.Putln("")
+}
+
 // EventCopy types
 func (e *EventCopy) Define(c *Context) {
 	c.Putln("// %s is the event number for a %s.", e.SrcName(), e.EvType())
@@ -137,32 +259,34 @@ func (e *EventCopy) Define(c *Context) {
 	c.Putln("}")
 	c.Putln("")
 
-	// Let's the XGB event loop read this event.
-	c.Putln("func init() {")
-	if c.protocol.isExt() {
-		c.Putln("xgb.NewExtEventFuncs[\"%s\"][%d] = %sNew",
-			c.protocol.ExtXName, e.Number, e.EvType())
-	} else {
-		c.Putln("xgb.NewEventFuncs[%d] = %sNew", e.Number, e.EvType())
+	if eventWindow(e.Old.(*Event).Fields) != nil {
+		eventWindowComment(c, e.SrcName())
+		c.Putln("func (v %s) EventWindow() uint32 {", e.EvType())
+		c.Putln("return %s(v).EventWindow()", e.Old.(*Event).EvType())
+		c.Putln("}")
+		c.Putln("")
 	}
-	c.Putln("}")
-	c.Putln("")
+
+	// Let's the XGB event loop read this event.
+	registerEvent(c, e.Number, e.EvType(), e.Old.(*Event).Xge)
 }
 
 func (e *EventCopy) Read(c *Context) {
 	c.Putln("// %sNew constructs a %s value that implements xgb.Event from "+
-		"a byte slice.", e.EvType(), e.EvType())
-	c.Putln("func %sNew(buf []byte) xgb.Event {", e.EvType())
-	c.Putln("return %s(%sNew(buf).(%s))",
+		"a byte slice in the byte order 'order'.", e.EvType(), e.EvType())
+	c.Putln("func %sNew(buf []byte, order xgb.ByteOrder) xgb.Event {",
+		e.EvType())
+	c.Putln("return %s(%sNew(buf, order).(%s))",
 		e.EvType(), e.Old.(*Event).EvType(), e.Old.(*Event).EvType())
 	c.Putln("}")
 	c.Putln("")
 }
 
 func (e *EventCopy) Write(c *Context) {
-	c.Putln("// Bytes writes a %s value to a byte slice.", e.EvType())
-	c.Putln("func (v %s) Bytes() []byte {", e.EvType())
-	c.Putln("return %s(v).Bytes()", e.Old.(*Event).EvType())
+	c.Putln("// Bytes writes a %s value to a byte slice, in the byte order "+
+		"'order'.", e.EvType())
+	c.Putln("func (v %s) Bytes(order xgb.ByteOrder) []byte {", e.EvType())
+	c.Putln("return %s(v).Bytes(order)", e.Old.(*Event).EvType())
 	c.Putln("}")
 	c.Putln("")
 }
@@ -188,6 +312,14 @@ func EventFieldString(c *Context, fields []Field, evName string) {
 				continue
 			}
 
+			// Values of typed enums print their names.
+			if f.Typed() {
+				format := fmt.Sprintf("xgb.Sprintf(\"%s: %s\", v.%s)",
+					field.SrcName(), "%s", field.SrcName())
+				c.Putln("fieldVals = append(fieldVals, %s)", format)
+				continue
+			}
+
 			switch field.SrcType() {
 			case "string":
 				format := fmt.Sprintf("xgb.Sprintf(\"%s: %s\", v.%s)",
@@ -8,21 +8,33 @@ import (
 
 // List fields
 func (f *ListField) Define(c *Context) {
+	// Some sizes are function literals spanning several lines.
 	c.Putln("%s %s // size: %s",
-		f.SrcName(), f.SrcType(), f.Size())
+		f.SrcName(), f.SrcType(),
+		strings.Replace(f.Size().String(), "\n", " ", -1))
+}
+
+// readLength is the number of values to read into the list. A list without
+// a length takes up the rest of the buffer, which only happens at the end of
+// generic events (i.e., Present's RedirectNotify).
+func (f *ListField) readLength(prefix string) string {
+	if f.LengthExpr == nil {
+		return fmt.Sprintf("(len(buf)-b)/%s", f.Type.Size().Reduce(prefix))
+	}
+	return f.LengthExpr.Reduce(prefix)
 }
 
 func (f *ListField) Read(c *Context, prefix string) {
 	switch t := f.Type.(type) {
 	case *Resource:
-		length := f.LengthExpr.Reduce(prefix)
+		length := f.readLength(prefix)
 		c.Putln("%s%s = make([]%s, %s)",
 			prefix, f.SrcName(), t.SrcName(), length)
 		c.Putln("for i := 0; i < int(%s); i++ {", length)
 		ReadSimpleSingleField(c, fmt.Sprintf("%s%s[i]", prefix, f.SrcName()), t)
 		c.Putln("}")
 	case *Base:
-		length := f.LengthExpr.Reduce(prefix)
+		length := f.readLength(prefix)
 		if strings.ToLower(t.XmlName()) == "char" {
 			c.Putln("{")
 			c.Putln("byteString := make([]%s, %s)", t.SrcName(), length)
@@ -47,7 +59,7 @@ func (f *ListField) Read(c *Context, prefix string) {
 			c.Putln("}")
 		}
 	case *TypeDef:
-		length := f.LengthExpr.Reduce(prefix)
+		length := f.readLength(prefix)
 		c.Putln("%s%s = make([]%s, %s)",
 			prefix, f.SrcName(), t.SrcName(), length)
 		c.Putln("for i := 0; i < int(%s); i++ {", length)
@@ -55,13 +67,13 @@ func (f *ListField) Read(c *Context, prefix string) {
 		c.Putln("}")
 	case *Union:
 		c.Putln("%s%s = make([]%s, %s)",
-			prefix, f.SrcName(), t.SrcName(), f.LengthExpr.Reduce(prefix))
-		c.Putln("b += %sReadList(buf[b:], %s%s)",
+			prefix, f.SrcName(), t.SrcName(), f.readLength(prefix))
+		c.Putln("b += %sReadList(buf[b:], order, %s%s)",
 			t.SrcName(), prefix, f.SrcName())
 	case *Struct:
 		c.Putln("%s%s = make([]%s, %s)",
-			prefix, f.SrcName(), t.SrcName(), f.LengthExpr.Reduce(prefix))
-		c.Putln("b += %sReadList(buf[b:], %s%s)",
+			prefix, f.SrcName(), t.SrcName(), f.readLength(prefix))
+		c.Putln("b += %sReadList(buf[b:], order, %s%s)",
 			t.SrcName(), prefix, f.SrcName())
 	default:
 		log.Panicf("Cannot read list field '%s' with %T type.",
@@ -95,10 +107,10 @@ func (f *ListField) Write(c *Context, prefix string) {
 			fmt.Sprintf("%s%s[i]", prefix, f.SrcName()), t)
 		c.Putln("}")
 	case *Union:
-		c.Putln("b += %sListBytes(buf[b:], %s%s)",
+		c.Putln("b += %sListBytes(buf[b:], order, %s%s)",
 			t.SrcName(), prefix, f.SrcName())
 	case *Struct:
-		c.Putln("b += %sListBytes(buf[b:], %s%s)",
+		c.Putln("b += %sListBytes(buf[b:], order, %s%s)",
 			t.SrcName(), prefix, f.SrcName())
 	default:
 		log.Panicf("Cannot write list field '%s' with %T type.",
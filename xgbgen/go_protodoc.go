@@ -0,0 +1,193 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+)
+
+// docWidth is the width that the texts of doc comments are wrapped at, not
+// counting the comment marker.
+const docWidth = 76
+
+// docLines wraps the paragraphs of 'text' into lines of at most 'width'
+// columns. Paragraphs are separated by empty lines.
+func docLines(text string, width int) []string {
+	lines := make([]string, 0, 1)
+	for i, para := range strings.Split(text, "\n\n") {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		line := ""
+		for _, word := range strings.Fields(para) {
+			if len(line) > 0 && len(line)+1+len(word) > width {
+				lines = append(lines, line)
+				line = ""
+			}
+			if len(line) > 0 {
+				line += " "
+			}
+			line += word
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// putDocText writes 'text' as paragraphs of a doc comment, after the lines
+// that have been written already.
+func putDocText(c *Context, text string) {
+	if len(text) == 0 {
+		return
+	}
+	c.Putln("//")
+	for _, line := range docLines(text, docWidth) {
+		if len(line) == 0 {
+			c.Putln("//")
+		} else {
+			c.Putln("// %s", line)
+		}
+	}
+}
+
+// putDocItem writes an item of a list in a doc comment, describing 'name'
+// with 'text'. Items are written as a single paragraph.
+func putDocItem(c *Context, name, text string) {
+	if len(text) > 0 {
+		text = strings.Join(strings.Fields(text), " ")
+		name += ": " + text
+	}
+	for i, line := range docLines(name, docWidth-4) {
+		if i == 0 {
+			c.Putln("//   - %s", line)
+		} else {
+			c.Putln("//     %s", line)
+		}
+	}
+}
+
+// Comment writes the brief and the description of this doc as paragraphs of
+// the doc comment that is being written, followed by its references.
+func (d *Doc) Comment(c *Context) {
+	if d == nil {
+		return
+	}
+	d.summary(c)
+	d.SeeComment(c)
+}
+
+// summary writes the brief and the description of this doc. The brief is
+// made a sentence of its own.
+func (d *Doc) summary(c *Context) {
+	putDocText(c, d.sentence(d.Brief))
+	putDocText(c, d.Description)
+}
+
+// sentence makes a sentence out of the brief 'brief'.
+func (d *Doc) sentence(brief string) string {
+	if len(brief) == 0 {
+		return ""
+	}
+	brief = strings.ToUpper(brief[:1]) + brief[1:]
+	if !strings.HasSuffix(brief, ".") {
+		brief += "."
+	}
+	return brief
+}
+
+// RequestComment writes the doc comment of the request function 'name'. It
+// starts with the brief, which follows the name, and goes on with the
+// description, the parameters of the request and the errors that it may
+// produce. The 'lines' saying how the request is sent come last. Without a
+// brief, they come first, since they start with the name.
+func (d *Doc) RequestComment(c *Context, name string, lines ...string) {
+	if d == nil || len(d.Brief) == 0 {
+		for _, line := range lines {
+			c.Putln("// %s", line)
+		}
+		if d != nil {
+			d.requestDetails(c)
+		}
+		return
+	}
+
+	brief := d.sentence(d.Brief)
+	if len(brief) < 2 || !unicode.IsUpper(rune(brief[1])) {
+		brief = strings.ToLower(brief[:1]) + brief[1:]
+	}
+	for _, line := range docLines(name+" "+brief, docWidth) {
+		c.Putln("// %s", line)
+	}
+	d.requestDetails(c)
+	c.Putln("//")
+	for _, line := range lines {
+		c.Putln("// %s", line)
+	}
+}
+
+// requestDetails writes the description of a request and lists its
+// parameters, the errors that it may produce and its references.
+func (d *Doc) requestDetails(c *Context) {
+	putDocText(c, d.Description)
+	if len(d.Fields) > 0 {
+		c.Putln("//")
+		c.Putln("// Parameters:")
+		c.Putln("//")
+		for _, field := range d.Fields {
+			putDocItem(c, field.srcName, field.Text)
+		}
+	}
+	if len(d.Errors) > 0 {
+		c.Putln("//")
+		c.Putln("// Errors:")
+		c.Putln("//")
+		for _, docErr := range d.Errors {
+			putDocItem(c, docErr.srcName, docErr.Text)
+		}
+	}
+	d.SeeComment(c)
+}
+
+// SeeComment writes the references of this doc as the last paragraph of the
+// doc comment that is being written. Requests and events are referred to by
+// their Go names, and programs by their manual pages.
+func (d *Doc) SeeComment(c *Context) {
+	if d == nil || len(d.Sees) == 0 {
+		return
+	}
+	names := make([]string, len(d.Sees))
+	for i, see := range d.Sees {
+		switch see.Kind {
+		case "request":
+			names[i] = SrcName(c.protocol, see.Name)
+		case "event":
+			names[i] = SrcName(c.protocol, see.Name) + "Event"
+		case "program":
+			names[i] = see.Name + "(1)"
+		default:
+			names[i] = see.Name
+		}
+	}
+	putDocText(c, "See also "+strings.Join(names, ", ")+".")
+}
+
+// FieldComment writes the description of 'field', if there is one, as the
+// doc comment of its definition in a struct. It is set apart by an empty
+// line, so that it isn't joined with the comment of a padding before it.
+func (d *Doc) FieldComment(c *Context, field Field) {
+	switch field.(type) {
+	case *PadField, *ValueField, *SwitchField:
+		return
+	}
+	text := d.Field(field.XmlName())
+	if len(text) == 0 {
+		return
+	}
+	c.Putln("")
+	for _, line := range docLines(text, docWidth-4) {
+		if len(line) == 0 {
+			c.Putln("//")
+		} else {
+			c.Putln("// %s", line)
+		}
+	}
+}
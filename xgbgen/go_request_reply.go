@@ -6,6 +6,12 @@ import (
 )
 
 func (r *Request) Define(c *Context) {
+	DefineSwitchTypes(c, r.Fields)
+	if r.Reply != nil {
+		DefineSwitchTypes(c, r.Reply.Fields)
+	}
+	r.DefineValues(c)
+
 	c.Putln("// %s is a cookie used only for %s requests.",
 		r.CookieName(), r.SrcName())
 	c.Putln("type %s struct {", r.CookieName())
@@ -13,14 +19,15 @@ func (r *Request) Define(c *Context) {
 	c.Putln("}")
 	c.Putln("")
 	if r.Reply != nil {
-		c.Putln("// %s sends a checked request.", r.SrcName())
-		c.Putln("// If an error occurs, it will be returned with the reply "+
-			"by calling %s.Reply()", r.CookieName())
+		r.Doc.RequestComment(c, r.SrcName(),
+			fmt.Sprintf("%s sends a checked request.", r.SrcName()),
+			fmt.Sprintf("If an error occurs, it will be returned with the "+
+				"reply by calling %s.Reply()", r.CookieName()))
 		c.Putln("func %s(c *xgb.Conn, %s) %s {",
 			r.SrcName(), r.ParamNameTypes(), r.CookieName())
 		r.CheckExt(c)
-		c.Putln("cookie := c.NewCookie(true, true)")
-		c.Putln("c.NewRequest(%s(c, %s), cookie)", r.ReqName(), r.ParamNames())
+		r.NewReplyCookie(c, true)
+		r.NewRequest(c)
 		c.Putln("return %s{cookie}", r.CookieName())
 		c.Putln("}")
 		c.Putln("")
@@ -31,22 +38,23 @@ func (r *Request) Define(c *Context) {
 		c.Putln("func %sUnchecked(c *xgb.Conn, %s) %s {",
 			r.SrcName(), r.ParamNameTypes(), r.CookieName())
 		r.CheckExt(c)
-		c.Putln("cookie := c.NewCookie(false, true)")
-		c.Putln("c.NewRequest(%s(c, %s), cookie)", r.ReqName(), r.ParamNames())
+		r.NewReplyCookie(c, false)
+		r.NewRequest(c)
 		c.Putln("return %s{cookie}", r.CookieName())
 		c.Putln("}")
 		c.Putln("")
 
 		r.ReadReply(c)
 	} else {
-		c.Putln("// %s sends an unchecked request.", r.SrcName())
-		c.Putln("// If an error occurs, it can only be retrieved using " +
-			"xgb.WaitForEvent or xgb.PollForEvent.")
+		r.Doc.RequestComment(c, r.SrcName(),
+			fmt.Sprintf("%s sends an unchecked request.", r.SrcName()),
+			"If an error occurs, it can only be retrieved using "+
+				"xgb.WaitForEvent or xgb.PollForEvent.")
 		c.Putln("func %s(c *xgb.Conn, %s) %s {",
 			r.SrcName(), r.ParamNameTypes(), r.CookieName())
 		r.CheckExt(c)
 		c.Putln("cookie := c.NewCookie(false, false)")
-		c.Putln("c.NewRequest(%s(c, %s), cookie)", r.ReqName(), r.ParamNames())
+		r.NewRequest(c)
 		c.Putln("return %s{cookie}", r.CookieName())
 		c.Putln("}")
 		c.Putln("")
@@ -58,7 +66,7 @@ func (r *Request) Define(c *Context) {
 			r.SrcName(), r.ParamNameTypes(), r.CookieName())
 		r.CheckExt(c)
 		c.Putln("cookie := c.NewCookie(true, false)")
-		c.Putln("c.NewRequest(%s(c, %s), cookie)", r.ReqName(), r.ParamNames())
+		r.NewRequest(c)
 		c.Putln("return %s{cookie}", r.CookieName())
 		c.Putln("}")
 		c.Putln("")
@@ -71,8 +79,83 @@ func (r *Request) Define(c *Context) {
 		c.Putln("return cook.Cookie.Check()")
 		c.Putln("}")
 		c.Putln("")
+
+		c.Putln("// CheckContext is like Check, but returns ctx.Err() if ctx " +
+			"is done before the request's outcome is known.")
+		c.Putln("func (cook %s) CheckContext(ctx context.Context) error {",
+			r.CookieName())
+		c.Putln("return cook.Cookie.CheckContext(ctx)")
+		c.Putln("}")
+		c.Putln("")
 	}
 	r.WriteRequest(c)
+	r.ReadRequest(c)
+	r.RegisterInfo(c)
+}
+
+// RegisterInfo writes the description of this request that is used to
+// trace connections, i.e., its name and how to read it and construct its
+// reply. Replies that carry file descriptors can't be constructed from
+// bytes alone, so they are left out.
+func (r *Request) RegisterInfo(c *Context) {
+	c.Putln("func init() {")
+	if c.protocol.isExt() {
+		c.Putln("xgb.ExtRequestInfos[\"%s\"][%d] = xgb.RequestInfo{",
+			c.protocol.ExtXName, r.Opcode)
+	} else {
+		c.Putln("xgb.RequestInfos[%d] = xgb.RequestInfo{", r.Opcode)
+	}
+	c.Putln("Name: \"%s\",", r.SrcName())
+	c.Putln("NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {")
+	c.Putln("return %s(buf, order)", r.RequestReadName())
+	c.Putln("},")
+	if r.Reply != nil && !r.Reply.HasFds() {
+		c.Putln("NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {")
+		c.Putln("return %s(buf, order)", r.ReplyName())
+		c.Putln("},")
+	}
+	c.Putln("}")
+	c.Putln("}")
+	c.Putln("")
+}
+
+// NewReplyCookie writes the call that makes the cookie for a request with
+// a reply. Requests answered by a stream of replies get a multi-reply cookie.
+func (r *Request) NewReplyCookie(c *Context, checked bool) {
+	if r.MultiReply() {
+		c.Putln("cookie := c.NewMultiCookie(%t, %s)",
+			checked, r.LastReplyName())
+	} else {
+		c.Putln("cookie := c.NewCookie(%t, true)", checked)
+	}
+}
+
+// NewRequest writes the call that sends this request over the wire, along
+// with any file descriptors it carries.
+func (r *Request) NewRequest(c *Context) {
+	if fds := r.Fds(); len(fds) > 0 {
+		c.Putln("c.NewRequestFds(%s(c, %s), %s, cookie)",
+			r.ReqName(), r.ParamNames(), fds)
+	} else {
+		c.Putln("c.NewRequest(%s(c, %s), cookie)",
+			r.ReqName(), r.ParamNames())
+	}
+}
+
+// DefineValues writes a type that builds the value mask and value list of
+// this request, if it has a switch that is a value list. (Value lists that
+// aren't switches use the type of the enum naming their bits instead.)
+func (r *Request) DefineValues(c *Context) {
+	for _, field := range r.Fields {
+		swtch, ok := field.(*SwitchField)
+		if !ok {
+			continue
+		}
+		if mask := swtch.valueMask(r.Fields); mask != nil {
+			swtch.DefineValues(c, r, mask)
+			return
+		}
+	}
 }
 
 func (r *Request) CheckExt(c *Context) {
@@ -91,17 +174,26 @@ func (r *Request) CheckExt(c *Context) {
 func (r *Request) ReadReply(c *Context) {
 	c.Putln("// %s represents the data returned from a %s request.",
 		r.ReplyTypeName(), r.SrcName())
+	r.Reply.Doc.Comment(c)
 	c.Putln("type %s struct {", r.ReplyTypeName())
 	c.Putln("Sequence uint16 // sequence number of the request for this reply")
 	c.Putln("Length uint32 // number of bytes in this reply")
 	for _, field := range r.Reply.Fields {
+		r.Reply.Doc.FieldComment(c, field)
 		field.Define(c)
 	}
 	c.Putln("}")
 	c.Putln("")
 
-	c.Putln("// Reply blocks and returns the reply data for a %s request.",
-		r.SrcName())
+	if r.MultiReply() {
+		c.Putln("// Reply blocks and returns the next reply for a %s "+
+			"request.", r.SrcName())
+		c.Putln("// Once the last reply has been returned, Reply returns " +
+			"xgb.ErrNoMoreReplies.")
+	} else {
+		c.Putln("// Reply blocks and returns the reply data for a %s "+
+			"request.", r.SrcName())
+	}
 	c.Putln("func (cook %s) Reply() (*%s, error) {",
 		r.CookieName(), r.ReplyTypeName())
 	c.Putln("buf, err := cook.Cookie.Reply()")
@@ -111,14 +203,38 @@ func (r *Request) ReadReply(c *Context) {
 	c.Putln("if buf == nil {")
 	c.Putln("return nil, nil")
 	c.Putln("}")
-	c.Putln("return %s(buf), nil", r.ReplyName())
+	r.ReturnReply(c)
 	c.Putln("}")
 	c.Putln("")
 
-	c.Putln("// %s reads a byte slice into a %s value.",
-		r.ReplyName(), r.ReplyTypeName())
-	c.Putln("func %s(buf []byte) *%s {",
-		r.ReplyName(), r.ReplyTypeName())
+	which := "the reply"
+	if r.MultiReply() {
+		which = "the next reply"
+	}
+	c.Putln("// ReplyContext is like Reply, but returns ctx.Err() if ctx is "+
+		"done before %s for a %s request arrives.", which, r.SrcName())
+	c.Putln("func (cook %s) ReplyContext(ctx context.Context) (*%s, error) {",
+		r.CookieName(), r.ReplyTypeName())
+	c.Putln("buf, err := cook.Cookie.ReplyContext(ctx)")
+	c.Putln("if err != nil {")
+	c.Putln("return nil, err")
+	c.Putln("}")
+	c.Putln("if buf == nil {")
+	c.Putln("return nil, nil")
+	c.Putln("}")
+	r.ReturnReply(c)
+	c.Putln("}")
+	c.Putln("")
+
+	c.Putln("// %s reads a byte slice in the byte order 'order' into a %s "+
+		"value.", r.ReplyName(), r.ReplyTypeName())
+	if r.Reply.HasFds() {
+		c.Putln("func %s(buf []byte, order xgb.ByteOrder, fds []int) *%s {",
+			r.ReplyName(), r.ReplyTypeName())
+	} else {
+		c.Putln("func %s(buf []byte, order xgb.ByteOrder) *%s {",
+			r.ReplyName(), r.ReplyTypeName())
+	}
 	c.Putln("v := new(%s)", r.ReplyTypeName())
 	c.Putln("b := 1 // skip reply determinant")
 	c.Putln("")
@@ -126,10 +242,10 @@ func (r *Request) ReadReply(c *Context) {
 		field.Read(c, "v.")
 		c.Putln("")
 		if i == 0 {
-			c.Putln("v.Sequence = xgb.Get16(buf[b:])")
+			c.Putln("v.Sequence = order.Get16(buf[b:])")
 			c.Putln("b += 2")
 			c.Putln("")
-			c.Putln("v.Length = xgb.Get32(buf[b:]) // 4-byte units")
+			c.Putln("v.Length = order.Get32(buf[b:]) // 4-byte units")
 			c.Putln("b += 4")
 			c.Putln("")
 		}
@@ -137,13 +253,67 @@ func (r *Request) ReadReply(c *Context) {
 	c.Putln("return v")
 	c.Putln("}")
 	c.Putln("")
+
+	if r.MultiReply() {
+		c.Putln("// %s reports whether a byte slice holds the last reply "+
+			"to a %s request.", r.LastReplyName(), r.SrcName())
+		c.Putln("func %s(buf []byte) bool {", r.LastReplyName())
+		c.Putln("return %s", r.lastReply)
+		c.Putln("}")
+		c.Putln("")
+	}
+}
+
+// ReturnReply writes the code that turns the bytes in 'buf' into a reply
+// value and returns it. If the reply carries file descriptors, the right
+// number of them must have been received along with it, or else they are
+// all closed. The length of a list of file descriptors is only known once
+// the reply has been read.
+func (r *Request) ReturnReply(c *Context) {
+	if !r.Reply.HasFds() {
+		c.Putln("return %s(buf, cook.Cookie.ByteOrder()), nil", r.ReplyName())
+		return
+	}
+	n := r.Reply.NumFds()
+	lists := r.Reply.FdLists()
+	c.Putln("fds := cook.Cookie.Fds()")
+	if len(lists) == 0 {
+		c.Putln("if len(fds) != %d {", n)
+		c.Putln("xgb.CloseFds(fds)")
+		c.Putln("return nil, xgb.Errorf(\"Expected %d file descriptor(s) "+
+			"with the %s reply, but got %%d.\", len(fds))", n, r.SrcName())
+		c.Putln("}")
+		c.Putln("return %s(buf, cook.Cookie.ByteOrder(), fds), nil",
+			r.ReplyName())
+		return
+	}
+
+	if n > 0 {
+		c.Putln("if len(fds) < %d {", n)
+		c.Putln("xgb.CloseFds(fds)")
+		c.Putln("return nil, xgb.Errorf(\"Expected at least %d file "+
+			"descriptor(s) with the %s reply, but got %%d.\", len(fds))",
+			n, r.SrcName())
+		c.Putln("}")
+	}
+	want := fmt.Sprintf("%d", n)
+	for _, list := range lists {
+		want += fmt.Sprintf(" + int(%s)", list.LengthExpr.Reduce("v."))
+	}
+	c.Putln("v := %s(buf, cook.Cookie.ByteOrder(), fds)", r.ReplyName())
+	c.Putln("if n := %s; len(fds) != n {", want)
+	c.Putln("xgb.CloseFds(fds)")
+	c.Putln("return nil, xgb.Errorf(\"Expected %%d file descriptor(s) with "+
+		"the %s reply, but got %%d.\", n, len(fds))", r.SrcName())
+	c.Putln("}")
+	c.Putln("return v, nil")
 }
 
 func (r *Request) WriteRequest(c *Context) {
 	sz := r.Size(c)
 	writeSize1 := func() {
 		if sz.exact {
-			c.Putln("xgb.Put16(buf[b:], uint16(size / 4)) " +
+			c.Putln("order.Put16(buf[b:], uint16(size / 4)) " +
 				"// write request size in 4-byte units")
 		} else {
 			c.Putln("blen := b")
@@ -156,8 +326,8 @@ func (r *Request) WriteRequest(c *Context) {
 			c.Putln("return buf")
 			return
 		}
-		c.Putln("b = xgb.Pad(b)")
-		c.Putln("xgb.Put16(buf[blen:], uint16(b / 4)) " +
+		c.Putln("b = start + xgb.Pad(b-start)")
+		c.Putln("order.Put16(buf[blen:], uint16((b-start) / 4)) " +
 			"// write request size in 4-byte units")
 		c.Putln("return buf[:b]")
 	}
@@ -166,9 +336,26 @@ func (r *Request) WriteRequest(c *Context) {
 		r.ReqName(), r.SrcName())
 	c.Putln("func %s(c *xgb.Conn, %s) []byte {",
 		r.ReqName(), r.ParamNameTypes())
+	c.Putln("return %s(nil, c, %s)", r.AppendName(), r.ParamNames())
+	c.Putln("}")
+	c.Putln("")
+	c.Putln("// %s appends a %s request to 'buf', which must only hold "+
+		"whole requests,", r.AppendName(), r.SrcName())
+	c.Putln("// and returns the extended buffer. Nothing is allocated if " +
+		"'buf' has room for it.")
+	if len(r.Fds()) > 0 {
+		c.Putln("// Since the request carries file descriptors, it must be " +
+			"sent with xgb.Conn.NewRequestFds.")
+	} else {
+		c.Putln("// The request can be sent with an xgb.Batch.")
+	}
+	c.Putln("func %s(buf []byte, c *xgb.Conn, %s) []byte {",
+		r.AppendName(), r.ParamNameTypes())
 	c.Putln("size := %s", sz)
-	c.Putln("b := 0")
-	c.Putln("buf := make([]byte, size)")
+	c.Putln("start := len(buf)")
+	c.Putln("b := start")
+	c.Putln("buf = xgb.Grow(buf, size)")
+	c.Putln("order := c.ByteOrder()")
 	c.Putln("")
 	if c.protocol.isExt() {
 		c.Putln("c.ExtLock.RLock()")
@@ -200,6 +387,62 @@ func (r *Request) WriteRequest(c *Context) {
 	c.Putln("")
 }
 
+// ReadRequest writes the type holding the data of this request and the
+// function that reads it back from the bytes written by WriteRequest. (The
+// file descriptors of the request and its local fields aren't part of it.)
+func (r *Request) ReadRequest(c *Context) {
+	fields := make([]Field, 0, len(r.Fields))
+	read := false // whether anything is read from the buffer at all
+	for _, field := range r.Fields {
+		switch field.(type) {
+		case *LocalField, *FdField, *FdListField:
+			continue
+		case *PadField:
+		default:
+			read = true
+		}
+		fields = append(fields, field)
+	}
+
+	c.Putln("// %s represents the data of a %s request, as it is read "+
+		"when tracing a connection.", r.RequestTypeName(), r.SrcName())
+	c.Putln("type %s struct {", r.RequestTypeName())
+	for _, field := range fields {
+		field.Define(c)
+	}
+	c.Putln("}")
+	c.Putln("")
+
+	c.Putln("// %s reads a byte slice in the byte order 'order' into a %s "+
+		"value.", r.RequestReadName(), r.RequestTypeName())
+	c.Putln("func %s(buf []byte, order xgb.ByteOrder) *%s {",
+		r.RequestReadName(), r.RequestTypeName())
+	c.Putln("v := new(%s)", r.RequestTypeName())
+	if !read {
+		c.Putln("return v")
+		c.Putln("}")
+		c.Putln("")
+		return
+	}
+	if c.protocol.isExt() {
+		c.Putln("b := 4 // skip request header")
+	} else {
+		c.Putln("b := 1 // skip request opcode")
+	}
+	c.Putln("")
+	for i, field := range fields {
+		field.Read(c, "v.")
+		c.Putln("")
+		if i == 0 && !c.protocol.isExt() {
+			c.Putln("b += 2 // skip request size")
+			c.Putln("")
+		}
+	}
+	c.Putln("return v")
+	c.Putln("}")
+	c.Putln("")
+}
+
 func (r *Request) ParamNames() string {
 	names := make([]string, 0, len(r.Fields))
 	for _, field := range r.Fields {
@@ -221,6 +464,32 @@ func (r *Request) ParamNames() string {
 	return strings.Join(names, ", ")
 }
 
+// Fds returns the Go expression for the file descriptors that are sent
+// along with this request, or "" if there are none.
+func (r *Request) Fds() string {
+	names := make([]string, 0)
+	lists := make([]string, 0)
+	for _, field := range r.Fields {
+		switch f := field.(type) {
+		case *FdField:
+			names = append(names, f.SrcName())
+		case *FdListField:
+			lists = append(lists, f.SrcName())
+		}
+	}
+	if len(names) == 0 && len(lists) == 1 {
+		return lists[0]
+	}
+	fds := ""
+	if len(names) > 0 || len(lists) > 0 {
+		fds = fmt.Sprintf("[]xgb.Fd{%s}", strings.Join(names, ", "))
+	}
+	for _, list := range lists {
+		fds = fmt.Sprintf("append(%s, %s...)", fds, list)
+	}
+	return fds
+}
+
 func (r *Request) ParamNameTypes() string {
 	nameTypes := make([]string, 0, len(r.Fields))
 	for _, field := range r.Fields {
@@ -229,7 +498,7 @@ func (r *Request) ParamNameTypes() string {
 			// mofos...
 			if r.SrcName() != "ConfigureWindow" {
 				nameTypes = append(nameTypes,
-					fmt.Sprintf("%s %s", f.MaskName, f.MaskType.SrcName()))
+					fmt.Sprintf("%s %s", f.MaskName, f.SrcType()))
 			}
 			nameTypes = append(nameTypes,
 				fmt.Sprintf("%s []uint32", f.ListName))
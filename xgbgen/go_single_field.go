@@ -6,23 +6,23 @@ import (
 )
 
 func (f *SingleField) Define(c *Context) {
-	c.Putln("%s %s", f.SrcName(), f.Type.SrcName())
+	c.Putln("%s %s", f.SrcName(), f.SrcType())
 }
 
 func ReadSimpleSingleField(c *Context, name string, typ Type) {
 	switch t := typ.(type) {
 	case *Resource:
-		c.Putln("%s = %s(xgb.Get32(buf[b:]))", name, t.SrcName())
+		c.Putln("%s = %s(order.Get32(buf[b:]))", name, t.SrcName())
 	case *TypeDef:
 		switch t.Size().Eval() {
 		case 1:
 			c.Putln("%s = %s(buf[b])", name, t.SrcName())
 		case 2:
-			c.Putln("%s = %s(xgb.Get16(buf[b:]))", name, t.SrcName())
+			c.Putln("%s = %s(order.Get16(buf[b:]))", name, t.SrcName())
 		case 4:
-			c.Putln("%s = %s(xgb.Get32(buf[b:]))", name, t.SrcName())
+			c.Putln("%s = %s(order.Get32(buf[b:]))", name, t.SrcName())
 		case 8:
-			c.Putln("%s = %s(xgb.Get64(buf[b:]))", name, t.SrcName())
+			c.Putln("%s = %s(order.Get64(buf[b:]))", name, t.SrcName())
 		}
 	case *Base:
 		// If this is a bool, stop short and do something special.
@@ -40,11 +40,11 @@ func ReadSimpleSingleField(c *Context, name string, typ Type) {
 		case 1:
 			val = fmt.Sprintf("buf[b]")
 		case 2:
-			val = fmt.Sprintf("xgb.Get16(buf[b:])")
+			val = fmt.Sprintf("order.Get16(buf[b:])")
 		case 4:
-			val = fmt.Sprintf("xgb.Get32(buf[b:])")
+			val = fmt.Sprintf("order.Get32(buf[b:])")
 		case 8:
-			val = fmt.Sprintf("xgb.Get64(buf[b:])")
+			val = fmt.Sprintf("order.Get64(buf[b:])")
 		}
 
 		// We need to convert base types if they aren't uintXX or byte
@@ -68,13 +68,16 @@ func (f *SingleField) Read(c *Context, prefix string) {
 	case *TypeDef:
 		ReadSimpleSingleField(c, fmt.Sprintf("%s%s", prefix, f.SrcName()), t)
 	case *Base:
-		ReadSimpleSingleField(c, fmt.Sprintf("%s%s", prefix, f.SrcName()), t)
+		ReadSimpleSingleField(c, fmt.Sprintf("%s%s", prefix, f.SrcName()),
+			f.wireType())
 	case *Struct:
 		c.Putln("%s%s = %s{}", prefix, f.SrcName(), t.SrcName())
-		c.Putln("b += %sRead(buf[b:], &%s%s)", t.SrcName(), prefix, f.SrcName())
+		c.Putln("b += %sRead(buf[b:], order, &%s%s)", t.SrcName(), prefix,
+			f.SrcName())
 	case *Union:
 		c.Putln("%s%s = %s{}", prefix, f.SrcName(), t.SrcName())
-		c.Putln("b += %sRead(buf[b:], &%s%s)", t.SrcName(), prefix, f.SrcName())
+		c.Putln("b += %sRead(buf[b:], order, &%s%s)", t.SrcName(), prefix,
+			f.SrcName())
 	default:
 		log.Panicf("Cannot read field '%s' with %T type.", f.XmlName(), f.Type)
 	}
@@ -83,17 +86,17 @@ func (f *SingleField) Read(c *Context, prefix string) {
 func WriteSimpleSingleField(c *Context, name string, typ Type) {
 	switch t := typ.(type) {
 	case *Resource:
-		c.Putln("xgb.Put32(buf[b:], uint32(%s))", name)
+		c.Putln("order.Put32(buf[b:], uint32(%s))", name)
 	case *TypeDef:
 		switch t.Size().Eval() {
 		case 1:
 			c.Putln("buf[b] = byte(%s)", name)
 		case 2:
-			c.Putln("xgb.Put16(buf[b:], uint16(%s))", name)
+			c.Putln("order.Put16(buf[b:], uint16(%s))", name)
 		case 4:
-			c.Putln("xgb.Put32(buf[b:], uint32(%s))", name)
+			c.Putln("order.Put32(buf[b:], uint32(%s))", name)
 		case 8:
-			c.Putln("xgb.Put64(buf[b:], uint64(%s))", name)
+			c.Putln("order.Put64(buf[b:], uint64(%s))", name)
 		}
 	case *Base:
 		// If this is a bool, stop short and do something special.
@@ -115,21 +118,21 @@ func WriteSimpleSingleField(c *Context, name string, typ Type) {
 			}
 		case 2:
 			if t.SrcName() != "uint16" {
-				c.Putln("xgb.Put16(buf[b:], uint16(%s))", name)
+				c.Putln("order.Put16(buf[b:], uint16(%s))", name)
 			} else {
-				c.Putln("xgb.Put16(buf[b:], %s)", name)
+				c.Putln("order.Put16(buf[b:], %s)", name)
 			}
 		case 4:
 			if t.SrcName() != "uint32" {
-				c.Putln("xgb.Put32(buf[b:], uint32(%s))", name)
+				c.Putln("order.Put32(buf[b:], uint32(%s))", name)
 			} else {
-				c.Putln("xgb.Put32(buf[b:], %s)", name)
+				c.Putln("order.Put32(buf[b:], %s)", name)
 			}
 		case 8:
 			if t.SrcName() != "uint64" {
-				c.Putln("xgb.Put64(buf[b:], uint64(%s))", name)
+				c.Putln("order.Put64(buf[b:], uint64(%s))", name)
 			} else {
-				c.Putln("xgb.Put64(buf[b:], %s)", name)
+				c.Putln("order.Put64(buf[b:], %s)", name)
 			}
 		}
 	default:
@@ -147,16 +150,17 @@ func (f *SingleField) Write(c *Context, prefix string) {
 	case *TypeDef:
 		WriteSimpleSingleField(c, fmt.Sprintf("%s%s", prefix, f.SrcName()), t)
 	case *Base:
-		WriteSimpleSingleField(c, fmt.Sprintf("%s%s", prefix, f.SrcName()), t)
+		WriteSimpleSingleField(c, fmt.Sprintf("%s%s", prefix, f.SrcName()),
+			f.wireType())
 	case *Union:
 		c.Putln("{")
-		c.Putln("unionBytes := %s%s.Bytes()", prefix, f.SrcName())
+		c.Putln("unionBytes := %s%s.Bytes(order)", prefix, f.SrcName())
 		c.Putln("copy(buf[b:], unionBytes)")
 		c.Putln("b += len(unionBytes)")
 		c.Putln("}")
 	case *Struct:
 		c.Putln("{")
-		c.Putln("structBytes := %s%s.Bytes()", prefix, f.SrcName())
+		c.Putln("structBytes := %s%s.Bytes(order)", prefix, f.SrcName())
 		c.Putln("copy(buf[b:], structBytes)")
 		c.Putln("b += len(structBytes)")
 		c.Putln("}")
@@ -1,6 +1,7 @@
 package main
 
 func (s *Struct) Define(c *Context) {
+	DefineSwitchTypes(c, s.Fields)
 	c.Putln("type %s struct {", s.SrcName())
 	for _, field := range s.Fields {
 		field.Define(c)
@@ -28,13 +29,14 @@ func (s *Struct) Define(c *Context) {
 }
 
 // Read for a struct creates a function 'ReadStructName' that takes a source
-// byte slice (i.e., the buffer) and a destination struct, and returns
-// the number of bytes read off the buffer.
+// byte slice (i.e., the buffer), its byte order and a destination struct,
+// and returns the number of bytes read off the buffer.
 // 'ReadStructName' should only be used to read raw reply data from the wire.
 func (s *Struct) Read(c *Context) {
 	c.Putln("// %sRead reads a byte slice into a %s value.",
 		s.SrcName(), s.SrcName())
-	c.Putln("func %sRead(buf []byte, v *%s) int {", s.SrcName(), s.SrcName())
+	c.Putln("func %sRead(buf []byte, order xgb.ByteOrder, v *%s) int {",
+		s.SrcName(), s.SrcName())
 
 	c.Putln("b := 0")
 	c.Putln("")
@@ -54,12 +56,12 @@ func (s *Struct) Read(c *Context) {
 func (s *Struct) ReadList(c *Context) {
 	c.Putln("// %sReadList reads a byte slice into a list of %s values.",
 		s.SrcName(), s.SrcName())
-	c.Putln("func %sReadList(buf []byte, dest []%s) int {",
-		s.SrcName(), s.SrcName())
+	c.Putln("func %sReadList(buf []byte, order xgb.ByteOrder, "+
+		"dest []%s) int {", s.SrcName(), s.SrcName())
 	c.Putln("b := 0")
 	c.Putln("for i := 0; i < len(dest); i++ {")
 	c.Putln("dest[i] = %s{}", s.SrcName())
-	c.Putln("b += %sRead(buf[b:], &dest[i])", s.SrcName())
+	c.Putln("b += %sRead(buf[b:], order, &dest[i])", s.SrcName())
 	c.Putln("}")
 
 	c.Putln("return xgb.Pad(b)")
@@ -69,8 +71,9 @@ func (s *Struct) ReadList(c *Context) {
 }
 
 func (s *Struct) Write(c *Context) {
-	c.Putln("// Bytes writes a %s value to a byte slice.", s.SrcName())
-	c.Putln("func (v %s) Bytes() []byte {", s.SrcName())
+	c.Putln("// Bytes writes a %s value to a byte slice, in the byte order "+
+		"'order'.", s.SrcName())
+	c.Putln("func (v %s) Bytes(order xgb.ByteOrder) []byte {", s.SrcName())
 	c.Putln("buf := make([]byte, %s)", s.Size().Reduce("v."))
 	c.Putln("b := 0")
 	c.Putln("")
@@ -86,12 +89,12 @@ func (s *Struct) Write(c *Context) {
 func (s *Struct) WriteList(c *Context) {
 	c.Putln("// %sListBytes writes a list of %s values to a byte slice.",
 		s.SrcName(), s.SrcName())
-	c.Putln("func %sListBytes(buf []byte, list []%s) int {",
-		s.SrcName(), s.SrcName())
+	c.Putln("func %sListBytes(buf []byte, order xgb.ByteOrder, "+
+		"list []%s) int {", s.SrcName(), s.SrcName())
 	c.Putln("b := 0")
 	c.Putln("var structBytes []byte")
 	c.Putln("for _, item := range list {")
-	c.Putln("structBytes = item.Bytes()")
+	c.Putln("structBytes = item.Bytes(order)")
 	c.Putln("copy(buf[b:], structBytes)")
 	c.Putln("b += len(structBytes)")
 	c.Putln("}")
@@ -9,8 +9,9 @@ func (u *Union) Define(c *Context) {
 	c.Putln("// Instead use one of the following constructors for '%s':",
 		u.SrcName())
 	for _, field := range u.Fields {
-		c.Putln("//     %s%sNew(%s %s) %s", u.SrcName(), field.SrcName(),
-			field.SrcName(), field.SrcType(), u.SrcName())
+		c.Putln("//     %s%sNew(order xgb.ByteOrder, %s %s) %s",
+			u.SrcName(), field.SrcName(), field.SrcName(), field.SrcType(),
+			u.SrcName())
 	}
 
 	c.Putln("type %s struct {", u.SrcName())
@@ -41,7 +42,10 @@ func (u *Union) New(c *Context) {
 	for _, field := range u.Fields {
 		c.Putln("// %s%sNew constructs a new %s union type with the %s field.",
 			u.SrcName(), field.SrcName(), u.SrcName(), field.SrcName())
-		c.Putln("func %s%sNew(%s %s) %s {",
+		c.Putln("// Its fields are read back in the byte order 'order', so " +
+			"it must be sent over")
+		c.Putln("// a connection with the same byte order.")
+		c.Putln("func %s%sNew(order xgb.ByteOrder, %s %s) %s {",
 			u.SrcName(), field.SrcName(), field.SrcName(),
 			field.SrcType(), u.SrcName())
 		c.Putln("var b int")
@@ -68,7 +72,8 @@ func (u *Union) New(c *Context) {
 func (u *Union) Read(c *Context) {
 	c.Putln("// %sRead reads a byte slice into a %s value.",
 		u.SrcName(), u.SrcName())
-	c.Putln("func %sRead(buf []byte, v *%s) int {", u.SrcName(), u.SrcName())
+	c.Putln("func %sRead(buf []byte, order xgb.ByteOrder, v *%s) int {",
+		u.SrcName(), u.SrcName())
 	c.Putln("var b int")
 	c.Putln("")
 	for _, field := range u.Fields {
@@ -84,12 +89,12 @@ func (u *Union) Read(c *Context) {
 func (u *Union) ReadList(c *Context) {
 	c.Putln("// %sReadList reads a byte slice into a list of %s values.",
 		u.SrcName(), u.SrcName())
-	c.Putln("func %sReadList(buf []byte, dest []%s) int {",
-		u.SrcName(), u.SrcName())
+	c.Putln("func %sReadList(buf []byte, order xgb.ByteOrder, "+
+		"dest []%s) int {", u.SrcName(), u.SrcName())
 	c.Putln("b := 0")
 	c.Putln("for i := 0; i < len(dest); i++ {")
 	c.Putln("dest[i] = %s{}", u.SrcName())
-	c.Putln("b += %sRead(buf[b:], &dest[i])", u.SrcName())
+	c.Putln("b += %sRead(buf[b:], order, &dest[i])", u.SrcName())
 	c.Putln("}")
 	c.Putln("return xgb.Pad(b)")
 	c.Putln("}")
@@ -102,10 +107,11 @@ func (u *Union) ReadList(c *Context) {
 // *same* *fixed* size. Thus, we make sure to always read bytes into
 // every field which allows us to simply pick the first field and write it.
 func (u *Union) Write(c *Context) {
-	c.Putln("// Bytes writes a %s value to a byte slice.", u.SrcName())
+	c.Putln("// Bytes writes a %s value to a byte slice, in the byte order "+
+		"'order'.", u.SrcName())
 	c.Putln("// Each field in a union must contain the same data.")
 	c.Putln("// So simply pick the first field and write that to the wire.")
-	c.Putln("func (v %s) Bytes() []byte {", u.SrcName())
+	c.Putln("func (v %s) Bytes(order xgb.ByteOrder) []byte {", u.SrcName())
 	c.Putln("buf := make([]byte, %s)", u.Size().Reduce("v."))
 	c.Putln("b := 0")
 	c.Putln("")
@@ -118,12 +124,12 @@ func (u *Union) Write(c *Context) {
 func (u *Union) WriteList(c *Context) {
 	c.Putln("// %sListBytes writes a list of %s values to a byte slice.",
 		u.SrcName(), u.SrcName())
-	c.Putln("func %sListBytes(buf []byte, list []%s) int {",
-		u.SrcName(), u.SrcName())
+	c.Putln("func %sListBytes(buf []byte, order xgb.ByteOrder, "+
+		"list []%s) int {", u.SrcName(), u.SrcName())
 	c.Putln("b := 0")
 	c.Putln("var unionBytes []byte")
 	c.Putln("for _, item := range list {")
-	c.Putln("unionBytes = item.Bytes()")
+	c.Putln("unionBytes = item.Bytes(order)")
 	c.Putln("copy(buf[b:], unionBytes)")
 	c.Putln("b += xgb.Pad(len(unionBytes))")
 	c.Putln("}")
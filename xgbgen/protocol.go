@@ -39,6 +39,46 @@ func (p *Protocol) Initialize() {
 	for _, req := range p.Requests {
 		req.Initialize(p)
 	}
+	p.checkEnumNames()
+}
+
+// checkEnumNames makes sure that the typed enums of this protocol don't
+// share their names with another type, event or request, which is caught
+// here rather than by the Go compiler. Such an enum can be given another name
+// in TypeMap.
+func (p *Protocol) checkEnumNames() {
+	names := make(map[string]bool)
+	for _, typ := range p.Types {
+		switch t := typ.(type) {
+		case *Enum, *Error, *ErrorCopy:
+			// Enums are what is checked (untyped ones only name their
+			// items), and errors go by their Bad constants and Error types.
+		default:
+			names[t.SrcName()] = true
+		}
+	}
+	for _, req := range p.Requests {
+		names[req.SrcName()] = true
+	}
+	for _, typ := range p.Types {
+		if enum, ok := typ.(*Enum); ok && enum.Typed() &&
+			names[enum.SrcName()] {
+
+			log.Panicf("The type of enum '%s' has the same name as another "+
+				"type or request of '%s'.", enum.XmlName(), p.Name)
+		}
+	}
+}
+
+// hasType returns true if 'typ' is one of the types of this protocol, rather
+// than of one of its imports.
+func (p *Protocol) hasType(typ Type) bool {
+	for _, t := range p.Types {
+		if t == typ {
+			return true
+		}
+	}
+	return false
 }
 
 // PkgName returns the name of this package.
@@ -63,6 +103,48 @@ func (p *Protocol) ProtocolFind(name string) *Protocol {
 	panic("unreachable")
 }
 
+// hasGenericEvents returns true if this protocol defines any X Generic Events.
+func (p *Protocol) hasGenericEvents() bool {
+	for _, typ := range p.Types {
+		if ev, ok := typ.(*Event); ok && ev.Xge {
+			return true
+		}
+	}
+	return false
+}
+
+// subEventField is the name of the field that XKB uses to tell its events
+// apart, since they all share a single event number.
+const subEventField = "xkbType"
+
+// hasSubEvents returns true if all of the (non-generic) events in this
+// protocol share the extension's first event number, and are instead told
+// apart by the byte following the event number. (Namely, XKB.)
+func (p *Protocol) hasSubEvents() bool {
+	found := false
+	for _, typ := range p.Types {
+		ev, ok := typ.(*Event)
+		if !ok || ev.Xge {
+			continue
+		}
+		if len(ev.Fields) == 0 {
+			return false
+		}
+		first, ok := ev.Fields[0].(*SingleField)
+		if !ok || first.XmlName() != subEventField {
+			return false
+		}
+		found = true
+	}
+	return found
+}
+
+// needsGe returns true if this protocol must negotiate the Generic Event
+// Extension before its generic events are sent by the server.
+func (p *Protocol) needsGe() bool {
+	return p.hasGenericEvents() && p.Name != "ge"
+}
+
 // isExt returns true if this protocol is an extension.
 // i.e., it's name isn't "xproto".
 func (p *Protocol) isExt() bool {
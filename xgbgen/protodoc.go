@@ -0,0 +1,104 @@
+package main
+
+import (
+	"strings"
+)
+
+// Doc is the documentation of a request, reply, event or error, as found in
+// the 'doc' element of its protocol description. Its texts are made of
+// paragraphs, separated by blank lines, with their white space collapsed.
+type Doc struct {
+	Brief       string
+	Description string
+	Fields      []*DocField
+	Errors      []*DocError
+	Sees        []*DocSee
+}
+
+// DocField describes the field with XML name 'xmlName'.
+type DocField struct {
+	srcName string
+	xmlName string
+	Text    string
+}
+
+// DocError describes when a request produces the error with XML name
+// 'xmlName'.
+type DocError struct {
+	srcName string
+	xmlName string
+	Text    string
+}
+
+// DocSee refers to a request, an event or some program related to what is
+// documented. Kind is the 'type' of the reference in the XML.
+type DocSee struct {
+	Kind string
+	Name string
+}
+
+// docText cleans up the text of a doc element. Texts that only say that
+// something isn't documented yet are dropped.
+func docText(text string) string {
+	paras := make([]string, 0, 1)
+	for _, para := range strings.Split(strings.TrimSpace(text), "\n\n") {
+		if para = strings.Join(strings.Fields(para), " "); len(para) > 0 {
+			paras = append(paras, para)
+		}
+	}
+	text = strings.Join(paras, "\n\n")
+	if text == "NOT YET DOCUMENTED" {
+		return ""
+	}
+	return text
+}
+
+// Initialize sets the Go names of the fields and errors in this doc. A nil
+// doc may be initialized, so that everything with a doc can do so blindly.
+func (d *Doc) Initialize(p *Protocol) {
+	if d == nil {
+		return
+	}
+	for _, field := range d.Fields {
+		field.srcName = SrcName(p, field.xmlName)
+	}
+	for _, docErr := range d.Errors {
+		docErr.srcName = findErrorType(p, docErr.xmlName)
+	}
+}
+
+// Field returns the description of the field with XML name 'xmlName', or an
+// empty string if there is none.
+func (d *Doc) Field(xmlName string) string {
+	if d == nil {
+		return ""
+	}
+	for _, field := range d.Fields {
+		if field.xmlName == xmlName {
+			return field.Text
+		}
+	}
+	return ""
+}
+
+// findErrorType returns the Go type of the error with XML name 'xmlName' in
+// 'p' or one of its imports. Unlike Translation.RealType, it only looks at
+// errors, since they share their names with other types (i.e., Window).
+// If there is no such error, the XML name is returned as is.
+func findErrorType(p *Protocol, xmlName string) string {
+	protos := append([]*Protocol{p}, p.Imports...)
+	for _, proto := range protos {
+		for _, typ := range proto.Types {
+			if typ.XmlName() != xmlName {
+				continue
+			}
+			switch e := typ.(type) {
+			case *Error:
+				return e.ErrType()
+			case *ErrorCopy:
+				return e.ErrType()
+			}
+		}
+	}
+	return xmlName
+}
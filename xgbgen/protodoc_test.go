@@ -0,0 +1,129 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"testing"
+)
+
+// generateDocTest generates the package of the made up extension in
+// testdata/doc.xml and parses it.
+func generateDocTest(t *testing.T) *ast.File {
+	xmlBytes, err := ioutil.ReadFile("testdata/doc.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := newContext()
+	c.Morph(xmlBytes)
+
+	f, err := parser.ParseFile(token.NewFileSet(), "doctest.go", c.out,
+		parser.ParseComments)
+	if err != nil {
+		t.Fatalf("The generated code doesn't parse: %s", err)
+	}
+	return f
+}
+
+// docs returns the doc comments of the functions and types in 'f', by name.
+func docs(f *ast.File) map[string]string {
+	docs := make(map[string]string)
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv == nil {
+				docs[decl.Name.Name] = decl.Doc.Text()
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				if spec, ok := spec.(*ast.TypeSpec); ok {
+					docs[spec.Name.Name] = decl.Doc.Text()
+				}
+			}
+		}
+	}
+	return docs
+}
+
+// TestRequestDoc checks that the doc element of a request ends up in the doc
+// comment of its function, ahead of the lines saying how it is sent.
+func TestRequestDoc(t *testing.T) {
+	got := docs(generateDocTest(t))["Frob"]
+	want := `Frob frobs a widget.
+
+Frobs the widget ` + "`widget`" + ` as many times as it is told to.
+
+A Frobbed event is sent when it is done.
+
+Parameters:
+
+  - Widget: The widget to frob.
+  - Times: How many times to frob it.
+
+Errors:
+
+  - WidgetError: The widget ` + "`widget`" + ` does not exist.
+
+See also FrobbedEvent.
+
+Frob sends an unchecked request.
+If an error occurs, it can only be retrieved using ` +
+		"xgb.WaitForEvent or xgb.PollForEvent.\n"
+	if got != want {
+		t.Errorf("Got the doc comment\n%s\nwant\n%s", got, want)
+	}
+}
+
+// TestRequestDocNoBrief checks that a request without a brief keeps the lines
+// saying how it is sent first, since they start with its name.
+func TestRequestDocNoBrief(t *testing.T) {
+	got := docs(generateDocTest(t))["Count"]
+	want := `Count sends a checked request.
+If an error occurs, it will be returned with the reply by calling ` +
+		`CountCookie.Reply()
+
+Counts the frobs of a widget.
+`
+	if got != want {
+		t.Errorf("Got the doc comment\n%s\nwant\n%s", got, want)
+	}
+}
+
+// TestEventAndReplyDoc checks that the doc elements of events and replies
+// describe their types and fields.
+func TestEventAndReplyDoc(t *testing.T) {
+	f := generateDocTest(t)
+	want := "FrobbedEvent is the type of Frobbed events.\n\n" +
+		"A widget was frobbed.\n"
+	if got := docs(f)["FrobbedEvent"]; got != want {
+		t.Errorf("Got the doc comment\n%s\nwant\n%s", got, want)
+	}
+
+	fields := 0
+	ast.Inspect(f, func(n ast.Node) bool {
+		spec, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		name := spec.Name.Name
+		if name != "FrobbedEvent" && name != "CountReply" {
+			return false
+		}
+		for _, field := range spec.Type.(*ast.StructType).Fields.List {
+			if field.Names[0].Name != "Count" {
+				continue
+			}
+			fields++
+			want := "How many times the widget has been frobbed.\n"
+			if got := field.Doc.Text(); got != want {
+				t.Errorf("Got the doc comment %q on %s.Count, want %q.",
+					got, name, want)
+			}
+		}
+		return false
+	})
+	if fields != 2 {
+		t.Errorf("Found %d Count fields, want 2.", fields)
+	}
+}
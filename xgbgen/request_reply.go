@@ -15,6 +15,27 @@ type Request struct {
 	Combine bool    // Not currently used.
 	Fields  []Field // All fields in the request.
 	Reply   *Reply  // A reply, if one exists for this request.
+	Doc     *Doc    // The documentation of this request, if any.
+
+	// lastReply is a Go expression that is true when 'buf' holds the last
+	// reply to this request. It is only set for requests that are answered
+	// by a stream of replies. (See multiReplies.)
+	lastReply string
+}
+
+// multiReplies maps the requests that are answered by a stream of replies
+// to an expression, in terms of the reply bytes 'buf', that is true for the
+// last reply of the stream. The XML descriptions don't mark these requests,
+// so they're listed here by hand. Keys are "package.XmlName".
+var multiReplies = map[string]string{
+	// The last reply has an empty font name.
+	"xproto.ListFontsWithInfo": "buf[1] == 0",
+
+	// The last reply is in the EndOfData category.
+	"record.EnableContext": "buf[1] == 5",
+
+	// The last reply has its finished flag set.
+	"xprint.PrintGetDocumentData": "xgb.Get32(buf[12:]) != 0",
 }
 
 type Requests []*Request
@@ -33,10 +54,12 @@ func (r *Request) Initialize(p *Protocol) {
 
 	if r.Reply != nil {
 		r.Reply.Initialize(p)
+		r.lastReply = multiReplies[p.PkgName()+"."+r.xmlName]
 	}
 	for _, field := range r.Fields {
 		field.Initialize(p)
 	}
+	r.Doc.Initialize(p)
 }
 
 func (r *Request) SrcName() string {
@@ -70,6 +93,21 @@ func (r *Request) ReplyTypeName() string {
 	return fmt.Sprintf("%sReply", r.SrcName())
 }
 
+// MultiReply returns whether this request is answered by a stream of
+// replies rather than a single one.
+func (r *Request) MultiReply() bool {
+	return r.lastReply != ""
+}
+
+// LastReplyName gets the Go source name of the function that reports
+// whether a slice of bytes is the last reply to this request.
+// The generated function is not currently exported.
+func (r *Request) LastReplyName() string {
+	name := r.SrcName()
+	lower := string(unicode.ToLower(rune(name[0]))) + name[1:]
+	return fmt.Sprintf("%sLastReply", lower)
+}
+
 // ReqName gets the Go source name of the function that generates a byte
 // slice from a list of parameters.
 // The generated function is not currently exported.
@@ -79,6 +117,27 @@ func (r *Request) ReqName() string {
 	return fmt.Sprintf("%sRequest", lower)
 }
 
+// RequestTypeName gets the Go source name of the type holding the data of
+// this request, as it is read from the wire when tracing.
+func (r *Request) RequestTypeName() string {
+	return fmt.Sprintf("%sRequest", r.SrcName())
+}
+
+// RequestReadName gets the Go source name of the function that reads this
+// request from a slice of bytes.
+// The generated function is not currently exported.
+func (r *Request) RequestReadName() string {
+	name := r.SrcName()
+	lower := string(unicode.ToLower(rune(name[0]))) + name[1:]
+	return fmt.Sprintf("%sRequestRead", lower)
+}
+
+// AppendName gets the Go source name of the function that appends the bytes
+// of a request for a list of parameters to a byte slice.
+func (r *Request) AppendName() string {
+	return fmt.Sprintf("Append%sRequest", r.SrcName())
+}
+
 // CookieName gets the Go source name of the type that holds cookies for
 // this request.
 func (r *Request) CookieName() string {
@@ -128,6 +187,7 @@ func (r *Request) Size(c *Context) Size {
 // Reply encapsulates the fields associated with a 'reply' element.
 type Reply struct {
 	Fields []Field
+	Doc    *Doc
 }
 
 // Size gets the number of bytes in this request's reply.
@@ -147,8 +207,53 @@ func (r *Reply) Size() Size {
 	return size
 }
 
+// NumFds returns the number of file descriptors received with this reply,
+// not counting those in lists.
+func (r *Reply) NumFds() int {
+	n := 0
+	for _, field := range r.Fields {
+		if _, ok := field.(*FdField); ok {
+			n++
+		}
+	}
+	return n
+}
+
+// FdLists returns the lists of file descriptors received with this reply.
+func (r *Reply) FdLists() []*FdListField {
+	var lists []*FdListField
+	for _, field := range r.Fields {
+		if f, ok := field.(*FdListField); ok {
+			lists = append(lists, f)
+		}
+	}
+	return lists
+}
+
+// HasFds reports whether any file descriptors are received with this reply.
+func (r *Reply) HasFds() bool {
+	return r.NumFds() > 0 || len(r.FdLists()) > 0
+}
+
 func (r *Reply) Initialize(p *Protocol) {
 	for _, field := range r.Fields {
 		field.Initialize(p)
 	}
+	r.Doc.Initialize(p)
+
+	// The file descriptors left over after the last field that takes some
+	// are not needed any more.
+	var last Field
+	for _, field := range r.Fields {
+		switch field.(type) {
+		case *FdField, *FdListField:
+			last = field
+		}
+	}
+	switch f := last.(type) {
+	case *FdField:
+		f.last = true
+	case *FdListField:
+		f.last = true
+	}
 }
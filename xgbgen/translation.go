@@ -137,17 +137,58 @@ func (x *XMLEvent) Translate() *Event {
 		xmlName:    x.Name,
 		Number:     x.Number,
 		NoSequence: x.NoSequence,
+		Xge:        x.Xge,
 		Fields:     make([]Field, 0, len(x.Fields)),
+		Doc:        x.Doc.Translate(),
 	}
 	for _, field := range x.Fields {
 		if field.XMLName.Local == "doc" {
 			continue
 		}
+		// Some events (i.e., XKB) spell out the sequence number, which is
+		// always read and written automatically.
+		if len(ev.Fields) == 1 && field.XMLName.Local == "field" &&
+			field.Name == "sequence" {
+			continue
+		}
 		ev.Fields = append(ev.Fields, field.Translate(ev))
 	}
+	nameSwitches(ev.Fields, x.Name+"Event")
 	return ev
 }
 
+func (x *XMLDoc) Translate() *Doc {
+	if x == nil {
+		return nil
+	}
+
+	d := &Doc{
+		Brief:       docText(x.Brief),
+		Description: docText(x.Description),
+		Fields:      make([]*DocField, 0, len(x.Fields)),
+		Errors:      make([]*DocError, 0, len(x.Errors)),
+		Sees:        make([]*DocSee, len(x.Sees)),
+	}
+	for _, field := range x.Fields {
+		if text := docText(field.Text); len(text) > 0 {
+			d.Fields = append(d.Fields, &DocField{
+				xmlName: field.Name,
+				Text:    text,
+			})
+		}
+	}
+	for _, docErr := range x.Errors {
+		d.Errors = append(d.Errors, &DocError{
+			xmlName: docErr.Type,
+			Text:    docText(docErr.Text),
+		})
+	}
+	for i, see := range x.Sees {
+		d.Sees[i] = &DocSee{Kind: see.Type, Name: see.Name}
+	}
+	return d
+}
+
 func (x *XMLEventCopy) Translate() *EventCopy {
 	return &EventCopy{
 		xmlName: x.Name,
@@ -161,6 +202,7 @@ func (x *XMLError) Translate() *Error {
 		xmlName: x.Name,
 		Number:  x.Number,
 		Fields:  make([]Field, len(x.Fields)),
+		Doc:     x.Doc.Translate(),
 	}
 	for i, field := range x.Fields {
 		err.Fields[i] = field.Translate(err)
@@ -184,6 +226,7 @@ func (x *XMLStruct) Translate() *Struct {
 	for i, field := range x.Fields {
 		s.Fields[i] = field.Translate(s)
 	}
+	nameSwitches(s.Fields, x.Name)
 	return s
 }
 
@@ -205,9 +248,10 @@ func (x *XMLRequest) Translate() *Request {
 		Combine: x.Combine,
 		Fields:  make([]Field, 0, len(x.Fields)),
 		Reply:   x.Reply.Translate(),
+		Doc:     x.Doc.Translate(),
 	}
 	for _, field := range x.Fields {
-		if field.XMLName.Local == "doc" || field.XMLName.Local == "fd" {
+		if field.XMLName.Local == "doc" {
 			continue
 		}
 		r.Fields = append(r.Fields, field.Translate(r))
@@ -226,6 +270,10 @@ func (x *XMLRequest) Translate() *Request {
 		r.Fields = append(r.Fields, stringLenLocal)
 	}
 
+	nameSwitches(r.Fields, x.Name)
+	if r.Reply != nil {
+		nameSwitches(r.Reply.Fields, x.Name+"Reply")
+	}
 	return r
 }
 
@@ -236,9 +284,10 @@ func (x *XMLReply) Translate() *Reply {
 
 	r := &Reply{
 		Fields: make([]Field, 0, len(x.Fields)),
+		Doc:    x.Doc.Translate(),
 	}
 	for _, field := range x.Fields {
-		if field.XMLName.Local == "doc" || field.XMLName.Local == "fd" {
+		if field.XMLName.Local == "doc" {
 			continue
 		}
 		r.Fields = append(r.Fields, field.Translate(r))
@@ -310,7 +359,7 @@ func (x *XMLExpression) Translate() Expression {
 		}
 	case "sumof":
 		return &SumOf{
-			Name: x.Ref,
+			Ref: &FieldRef{Name: x.Ref},
 		}
 	}
 
@@ -325,13 +374,29 @@ func (x *XMLField) Translate(parent interface{}) Field {
 	case "pad":
 		return &PadField{
 			Bytes: x.Bytes,
+			Align: x.Align,
 		}
 	case "field":
-		return &SingleField{
+		f := &SingleField{
 			xmlName: x.Name,
 			Type:    newTranslation(x.Type),
 		}
+		switch {
+		case len(x.OptEnum) > 0:
+			f.enumName = x.OptEnum
+		case len(x.OptMask) > 0:
+			f.enumName, f.isMask = x.OptMask, true
+		}
+		return f
 	case "list":
+		if x.Type == "fd" {
+			_, sent := parent.(*Request)
+			return &FdListField{
+				xmlName:    x.Name,
+				LengthExpr: x.Expr.Translate(),
+				sent:       sent,
+			}
+		}
 		return &ListField{
 			xmlName:    x.Name,
 			Type:       newTranslation(x.Type),
@@ -342,6 +407,12 @@ func (x *XMLField) Translate(parent interface{}) Field {
 			xmlName: x.Name,
 			Type:    newTranslation(x.Type),
 		}}
+	case "fd":
+		_, sent := parent.(*Request)
+		return &FdField{
+			xmlName: x.Name,
+			sent:    sent,
+		}
 	case "exprfield":
 		return &ExprField{
 			xmlName: x.Name,
@@ -359,10 +430,13 @@ func (x *XMLField) Translate(parent interface{}) Field {
 		swtch := &SwitchField{
 			Name:     x.Name,
 			Expr:     x.Expr.Translate(),
-			Bitcases: make([]*Bitcase, len(x.Bitcases)),
+			Bitcases: make([]*Bitcase, 0, len(x.Bitcases)+len(x.Cases)),
+		}
+		for _, bitcase := range x.Bitcases {
+			swtch.Bitcases = append(swtch.Bitcases, bitcase.Translate(false))
 		}
-		for i, bitcase := range x.Bitcases {
-			swtch.Bitcases[i] = bitcase.Translate()
+		for _, cse := range x.Cases {
+			swtch.Bitcases = append(swtch.Bitcases, cse.Translate(true))
 		}
 		return swtch
 	}
@@ -371,17 +445,34 @@ func (x *XMLField) Translate(parent interface{}) Field {
 	panic("unreachable")
 }
 
-func (x *XMLBitcase) Translate() *Bitcase {
+func (x *XMLBitcase) Translate(isCase bool) *Bitcase {
 	b := &Bitcase{
-		Expr:   x.Expr().Translate(),
-		Fields: make([]Field, len(x.Fields)),
+		Name:   x.Name,
+		IsCase: isCase,
+		Fields: make([]Field, 0, len(x.Fields)),
 	}
-	for i, field := range x.Fields {
-		b.Fields[i] = field.Translate(b)
+	for _, expr := range x.Exprs() {
+		b.Exprs = append(b.Exprs, expr.Translate())
+	}
+	for _, field := range x.Fields {
+		if field.XMLName.Local == "doc" {
+			continue
+		}
+		b.Fields = append(b.Fields, field.Translate(b))
 	}
 	return b
 }
 
+// nameSwitches records the name of the type that contains 'fields' in any
+// switch fields found, so that each switch can be given a unique type name.
+func nameSwitches(fields []Field, parent string) {
+	for _, field := range fields {
+		if swtch, ok := field.(*SwitchField); ok {
+			swtch.parentName = parent
+		}
+	}
+}
+
 // SrcName is used to translate any identifier into a Go name.
 // Mostly used for fields, but used in a couple other places too (enum items).
 func SrcName(p *Protocol, name string) string {
@@ -409,6 +500,9 @@ func TypeSrcName(p *Protocol, typ Type) string {
 	if colon := strings.Index(t, ":"); colon > -1 {
 		namespace := t[:colon]
 		rest := t[colon+1:]
+		if newt, ok := TypeMap[rest]; ok {
+			return p.ProtocolFind(namespace).PkgName() + "." + newt
+		}
 		return p.ProtocolFind(namespace).PkgName() + "." + splitAndTitle(rest)
 	}
 
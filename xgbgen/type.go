@@ -106,16 +106,44 @@ func (b *Base) Initialize(p *Protocol) {
 	b.srcName = TypeSrcName(p, b)
 }
 
+// Integer returns true if this is one of the X integer types, which are the
+// only ones that may be given the type of an enum.
+func (b *Base) Integer() bool {
+	switch b.xmlName {
+	case "CARD8", "CARD16", "CARD32", "CARD64", "INT8", "INT16", "INT32",
+		"BYTE":
+		return true
+	}
+	return false
+}
+
 type Enum struct {
 	srcName string
 	xmlName string
 	Items   []*EnumItem
+
+	// valueMaskType is the Go type of the value masks made of the bits of
+	// this enum, if any request takes such a mask with a value list. Typed
+	// enums use their own type instead.
+	valueMaskType string
+
+	// protocol is set when a field of the enum's own protocol refers to it,
+	// or a value list uses it for its mask, and base is the widest type of
+	// those fields. isMask is true if one of them is a bit mask of the
+	// enum's items. (See Typed.)
+	protocol *Protocol
+	base     *Base
+	isMask   bool
 }
 
 type EnumItem struct {
 	srcName string
 	xmlName string
 	Expr    Expression
+
+	// ValueEnum is the enum that the value set for this bit of a value mask
+	// is taken from, if any. (See valueEnums.)
+	ValueEnum *Enum
 }
 
 func (enum *Enum) SrcName() string {
@@ -130,6 +158,41 @@ func (enum *Enum) Size() Size {
 	panic("Cannot take size of enum")
 }
 
+// use records that a field of type 'base' in protocol 'p' takes its values
+// from this enum.
+func (enum *Enum) use(p *Protocol, base *Base, isMask bool) {
+	enum.protocol = p
+	if enum.base == nil || base.Size().Eval() > enum.base.Size().Eval() {
+		enum.base = base
+	}
+	enum.isMask = enum.isMask || isMask
+}
+
+// Typed returns true if this enum is given a type of its own. That is the
+// case exactly when some field of its own protocol refers to it, with an
+// 'enum' or 'mask' attribute or as the mask of a value list. Enums that are
+// only referred to by 'altenum' attributes (i.e., Window, whose items are
+// special values of window ids) are left as untyped constants.
+func (enum *Enum) Typed() bool {
+	return enum.protocol != nil
+}
+
+// BaseType returns the Go type underlying the type of this enum. It is the
+// widest type of the fields referring to the enum, unless some item doesn't
+// fit into it.
+func (enum *Enum) BaseType() string {
+	size := enum.base.Size().Eval()
+	for _, item := range enum.Items {
+		for size < 8 && item.Expr.Eval()>>uint(8*size) != 0 {
+			size *= 2
+		}
+	}
+	if size == enum.base.Size().Eval() {
+		return enum.base.SrcName()
+	}
+	return fmt.Sprintf("uint%d", 8*size)
+}
+
 func (enum *Enum) Initialize(p *Protocol) {
 	enum.srcName = TypeSrcName(p, enum)
 	for _, item := range enum.Items {
@@ -190,6 +253,13 @@ type Event struct {
 	Number     int
 	NoSequence bool
 	Fields     []Field
+
+	// Xge is true when this is an X Generic Event. Its number is then an
+	// event type specific to its extension, and its fields start after the
+	// 10 byte generic event header.
+	Xge bool
+
+	Doc *Doc
 }
 
 func (e *Event) SrcName() string {
@@ -204,11 +274,23 @@ func (e *Event) Size() Size {
 	return newExpressionSize(&Value{v: 32}, true)
 }
 
+// XgeSize is the size of a generic event, which may be variable and may be
+// less than 32 bytes. (Generic events are always at least 32 bytes on the
+// wire.)
+func (e *Event) XgeSize() Size {
+	size := newFixedSize(10, true)
+	for _, field := range e.Fields {
+		size = size.Add(field.Size())
+	}
+	return size
+}
+
 func (e *Event) Initialize(p *Protocol) {
 	e.srcName = TypeSrcName(p, e)
 	for _, field := range e.Fields {
 		field.Initialize(p)
 	}
+	e.Doc.Initialize(p)
 }
 
 func (e *Event) EvType() string {
@@ -251,6 +333,7 @@ type Error struct {
 	xmlName string
 	Number  int
 	Fields  []Field
+	Doc     *Doc
 }
 
 func (e *Error) SrcName() string {
@@ -270,6 +353,7 @@ func (e *Error) Initialize(p *Protocol) {
 	for _, field := range e.Fields {
 		field.Initialize(p)
 	}
+	e.Doc.Initialize(p)
 }
 
 func (e *Error) ErrConst() string {
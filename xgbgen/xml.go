@@ -46,7 +46,7 @@ func (imports XMLImports) Eval() {
 		imp.xml = &XML{}
 		err = xml.Unmarshal(xmlBytes, imp.xml)
 		if err != nil {
-			log.Fatal("Could not parse X protocol description for import "+
+			log.Fatalf("Could not parse X protocol description for import "+
 				"'%s' because: %s", imp.Name, err)
 		}
 
@@ -108,23 +108,53 @@ type XMLRequest struct {
 	Combine bool        `xml:"combine-adjacent,attr"`
 	Fields  []*XMLField `xml:",any"`
 	Reply   *XMLReply   `xml:"reply"`
+	Doc     *XMLDoc     `xml:"doc"`
 }
 
 type XMLReply struct {
 	Fields []*XMLField `xml:",any"`
+	Doc    *XMLDoc     `xml:"doc"`
 }
 
 type XMLEvent struct {
 	Name       string      `xml:"name,attr"`
 	Number     int         `xml:"number,attr"`
 	NoSequence bool        `xml:"no-sequence-number,attr"`
+	Xge        bool        `xml:"xge,attr"`
 	Fields     []*XMLField `xml:",any"`
+	Doc        *XMLDoc     `xml:"doc"`
 }
 
 type XMLError struct {
 	Name   string      `xml:"name,attr"`
 	Number int         `xml:"number,attr"`
 	Fields []*XMLField `xml:",any"`
+	Doc    *XMLDoc     `xml:"doc"`
+}
+
+// XMLDoc is the 'doc' element of a request, reply, event or error. Its
+// examples are left out.
+type XMLDoc struct {
+	Brief       string         `xml:"brief"`
+	Description string         `xml:"description"`
+	Fields      []*XMLDocField `xml:"field"`
+	Errors      []*XMLDocError `xml:"error"`
+	Sees        []*XMLDocSee   `xml:"see"`
+}
+
+type XMLDocField struct {
+	Name string `xml:"name,attr"`
+	Text string `xml:",chardata"`
+}
+
+type XMLDocError struct {
+	Type string `xml:"type,attr"`
+	Text string `xml:",chardata"`
+}
+
+type XMLDocSee struct {
+	Type string `xml:"type,attr"`
+	Name string `xml:"name,attr"`
 }
 
 type XMLExpression struct {
@@ -9,7 +9,8 @@ type XMLField struct {
 	XMLName xml.Name
 
 	// For 'pad' element
-	Bytes uint `xml:"bytes,attr"`
+	Bytes uint   `xml:"bytes,attr"`
+	Align uint16 `xml:"align,attr"`
 
 	// For 'field', 'list', 'localfield', 'exprfield' and 'switch' elements.
 	Name string `xml:"name,attr"`
@@ -27,6 +28,7 @@ type XMLField struct {
 
 	// For 'switch' element.
 	Bitcases []*XMLBitcase `xml:"bitcase"`
+	Cases    []*XMLBitcase `xml:"case"`
 
 	// I don't know which elements these are for. The documentation is vague.
 	// They also seem to be completely optional.
@@ -39,47 +41,44 @@ type XMLField struct {
 // Namely, if the switch's expression (all bitcases are inside a switch),
 // and'd with the bitcase's expression is equal to the bitcase expression,
 // then the fields should be included in its parent structure.
+// The same type is used for 'case' elements, where the switch's expression
+// must instead be equal to the case's expression.
 // Note that since a bitcase is unique in that expressions and fields are
 // siblings, we must exhaustively search for one of them. Essentially,
 // it's the closest thing to a Union I can get to in Go without interfaces.
 // Would an '<expression>' tag have been too much to ask? :-(
+// A bitcase may also list several expressions (typically enumrefs), in
+// which case it matches if any one of them does.
 type XMLBitcase struct {
+	Name   string      `xml:"name,attr"`
 	Fields []*XMLField `xml:",any"`
 
 	// All the different expressions.
-	// When it comes time to choose one, use the 'Expr' method.
-	ExprOp    *XMLExpression `xml:"op"`
-	ExprUnOp  *XMLExpression `xml:"unop"`
-	ExprField *XMLExpression `xml:"fieldref"`
-	ExprValue *XMLExpression `xml:"value"`
-	ExprBit   *XMLExpression `xml:"bit"`
-	ExprEnum  *XMLExpression `xml:"enumref"`
-	ExprSum   *XMLExpression `xml:"sumof"`
-	ExprPop   *XMLExpression `xml:"popcount"`
+	// When it comes time to choose, use the 'Exprs' method.
+	ExprOp    []*XMLExpression `xml:"op"`
+	ExprUnOp  []*XMLExpression `xml:"unop"`
+	ExprField []*XMLExpression `xml:"fieldref"`
+	ExprValue []*XMLExpression `xml:"value"`
+	ExprBit   []*XMLExpression `xml:"bit"`
+	ExprEnum  []*XMLExpression `xml:"enumref"`
+	ExprSum   []*XMLExpression `xml:"sumof"`
+	ExprPop   []*XMLExpression `xml:"popcount"`
 }
 
-// Expr chooses the only non-nil Expr* field from Bitcase.
-// Panic if there is more than one non-nil expression.
-func (b *XMLBitcase) Expr() *XMLExpression {
-	choices := []*XMLExpression{
+// Exprs collects all of the Expr* fields from Bitcase.
+// Panic if there are no expressions at all.
+func (b *XMLBitcase) Exprs() []*XMLExpression {
+	choices := [][]*XMLExpression{
 		b.ExprOp, b.ExprUnOp, b.ExprField, b.ExprValue,
 		b.ExprBit, b.ExprEnum, b.ExprSum, b.ExprPop,
 	}
 
-	var choice *XMLExpression = nil
-	numNonNil := 0
+	exprs := make([]*XMLExpression, 0, 1)
 	for _, c := range choices {
-		if c != nil {
-			numNonNil++
-			choice = c
-		}
+		exprs = append(exprs, c...)
 	}
-
-	if choice == nil {
+	if len(exprs) == 0 {
 		log.Panicf("No top level expression found in a bitcase.")
 	}
-	if numNonNil > 1 {
-		log.Panicf("More than one top-level expression was found in a bitcase.")
-	}
-	return choice
+	return exprs
 }
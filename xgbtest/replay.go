@@ -0,0 +1,323 @@
+package xgbtest
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/BurntSushi/xgb"
+)
+
+// A recording is the magic string below, followed by a chunk for every
+// read or write on the recorded connection: a byte saying who sent it
+// (fromClient or fromServer), its length as 4 bytes and its bytes.
+const recordingMagic = "xgbtest recording 1\n"
+
+const (
+	fromClient = '>'
+	fromServer = '<'
+)
+
+// Recorder is a net.Conn that records a session between XGB and a real X
+// server, so that it can be played back by a Replay later. Use Record to
+// make one, and give it to xgb.NewConnNet:
+//
+//	conn, err := net.Dial("unix", "/tmp/.X11-unix/X0")
+//	...
+//	rec := xgbtest.Record(conn, file)
+//	X, err := xgb.NewConnNet(rec)
+//
+// The authorization data sent by the client is not recorded. Since a
+// Recorder isn't a *net.UnixConn, requests that pass file descriptors fail
+// with xgb.ErrNoFdPassing.
+type Recorder struct {
+	net.Conn
+
+	lock sync.Mutex
+	w    io.Writer
+	err  error
+
+	// sent is the number of bytes the client has sent so far, and head is
+	// the header of its setup request, while it's being sent.
+	sent int
+	head []byte
+}
+
+// Record returns a connection that forwards everything to 'conn', and
+// writes a recording of it to 'w'.
+func Record(conn net.Conn, w io.Writer) *Recorder {
+	r := &Recorder{Conn: conn, w: w}
+	r.err = r.write([]byte(recordingMagic))
+	return r
+}
+
+// Err returns the first error that occurred writing the recording, if any.
+// The recording is incomplete if it isn't nil.
+func (r *Recorder) Err() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.err
+}
+
+// Read reads from the X server, and records what was read.
+func (r *Recorder) Read(p []byte) (int, error) {
+	n, err := r.Conn.Read(p)
+	if n > 0 {
+		r.record(fromServer, p[:n])
+	}
+	return n, err
+}
+
+// Write records what the client sends, and then sends it to the X server.
+func (r *Recorder) Write(p []byte) (int, error) {
+	r.record(fromClient, p)
+	return r.Conn.Write(p)
+}
+
+// record writes a chunk of bytes sent by 'from' to the recording.
+func (r *Recorder) record(from byte, p []byte) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if from == fromClient {
+		p = r.scrub(p)
+	}
+	if r.err != nil {
+		return
+	}
+	head := make([]byte, 5)
+	head[0] = from
+	xgb.Put32(head[1:], uint32(len(p)))
+	if r.err = r.write(head); r.err == nil {
+		r.err = r.write(p)
+	}
+}
+
+func (r *Recorder) write(p []byte) error {
+	_, err := r.w.Write(p)
+	return err
+}
+
+// scrub returns the bytes 'p' sent by the client, with the authorization
+// data of its setup request zeroed.
+func (r *Recorder) scrub(p []byte) []byte {
+	start := r.sent
+	r.sent += len(p)
+	if len(r.head) < 12 {
+		n := 12 - len(r.head)
+		if n > len(p) {
+			n = len(p)
+		}
+		r.head = append(r.head, p[:n]...)
+		if len(r.head) < 12 {
+			return p
+		}
+	}
+
+	order := xgb.ByteOrder(r.head[0])
+	from := 12 + xgb.Pad(int(order.Get16(r.head[6:])))
+	to := from + int(order.Get16(r.head[8:]))
+	if start >= to || r.sent <= from {
+		return p
+	}
+	scrubbed := make([]byte, len(p))
+	copy(scrubbed, p)
+	for i := from; i < to; i++ {
+		if i >= start && i < r.sent {
+			scrubbed[i-start] = 0
+		}
+	}
+	return scrubbed
+}
+
+// step is a single step in playing back a recording: either a request the
+// client must send, or bytes the server sends.
+type step struct {
+	request  []byte
+	response []byte
+}
+
+// Replay is a fake X server that plays back a session recorded with Record.
+// It checks that every request the client sends is the same as the one in
+// the recording, and sends the client what the X server sent it at the same
+// point of the session. As soon as a request differs, the connection is
+// closed. The client connects with the byte order of the recorded session.
+//
+// A test typically runs the code that was recorded, closes the connection,
+// and checks the result of Wait:
+//
+//	rp, err := xgbtest.NewReplay(file)
+//	...
+//	X, err := rp.Conn()
+//	...
+//	X.Close()
+//	if err := rp.Wait(); err != nil {
+//		t.Fatal(err)
+//	}
+type Replay struct {
+	steps []step
+	order xgb.ByteOrder
+	err   error
+	done  chan struct{}
+
+	lock sync.Mutex
+	conn net.Conn
+}
+
+// NewReplay reads a recording made with Record.
+func NewReplay(r io.Reader) (*Replay, error) {
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(recordingMagic))
+	if _, err := io.ReadFull(br, magic); err != nil ||
+		string(magic) != recordingMagic {
+
+		return nil, errors.New("xgbtest: not a recording")
+	}
+
+	// The client's bytes are split into requests, so that each response is
+	// sent after the request that it followed in the recording. The setup
+	// request isn't checked, since the authorization data differs from
+	// machine to machine.
+	var (
+		steps   []step
+		pending []byte
+		order   xgb.ByteOrder // known once the setup request is read
+	)
+	for {
+		head := make([]byte, 5)
+		if _, err := io.ReadFull(br, head); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("xgbtest: truncated recording: %s", err)
+		}
+		chunk := make([]byte, xgb.Get32(head[1:]))
+		if _, err := io.ReadFull(br, chunk); err != nil {
+			return nil, fmt.Errorf("xgbtest: truncated recording: %s", err)
+		}
+
+		switch head[0] {
+		case fromServer:
+			steps = append(steps, step{response: chunk})
+			continue
+		case fromClient:
+		default:
+			return nil, fmt.Errorf("xgbtest: bad chunk in recording: %d",
+				head[0])
+		}
+		pending = append(pending, chunk...)
+		for len(pending) > 0 {
+			rd := bytes.NewReader(pending)
+			var req []byte
+			var err error
+			if order == 0 {
+				req, err = readSetupRequest(rd)
+			} else {
+				req, err = readRequestBytes(rd, order)
+			}
+			if err != nil { // the rest is in a later chunk
+				break
+			}
+			pending = pending[len(pending)-rd.Len():]
+			if order == 0 {
+				order = xgb.ByteOrder(req[0])
+			} else {
+				steps = append(steps, step{request: req})
+			}
+		}
+	}
+	if len(pending) > 0 {
+		return nil, errors.New("xgbtest: recording ends in the middle of " +
+			"a request")
+	}
+	return &Replay{steps: steps, order: order, done: make(chan struct{})}, nil
+}
+
+// Conn starts playing back the recording, and returns a new XGB connection
+// to the replay server. It may only be called once.
+func (rp *Replay) Conn() (*xgb.Conn, error) {
+	rp.lock.Lock()
+	if rp.conn != nil {
+		rp.lock.Unlock()
+		return nil, errors.New("xgbtest: the replay already has a client")
+	}
+	client, server := net.Pipe()
+	rp.conn = server
+	rp.lock.Unlock()
+
+	go rp.serve()
+	return xgb.NewConnNetOptions(context.Background(), client,
+		xgb.Options{ByteOrder: rp.order})
+}
+
+// Wait waits until the client has closed its connection, and returns the
+// first way in which the session differed from the recording, if any: a
+// request that differs, or a session that ended early or went on for
+// longer.
+func (rp *Replay) Wait() error {
+	<-rp.done
+	return rp.err
+}
+
+// Close shuts down the replay server. The client's connection fails as if
+// the X server went away.
+func (rp *Replay) Close() error {
+	rp.lock.Lock()
+	defer rp.lock.Unlock()
+	if rp.conn == nil {
+		return nil
+	}
+	return rp.conn.Close()
+}
+
+// serve plays back the recording, and then waits for the client to go away.
+func (rp *Replay) serve() {
+	defer close(rp.done)
+	defer rp.conn.Close()
+
+	rp.err = rp.play()
+	if rp.err != nil {
+		return
+	}
+	if n, _ := rp.conn.Read(make([]byte, 1)); n > 0 {
+		rp.err = errors.New("xgbtest: the client sent more requests than " +
+			"were recorded")
+	}
+}
+
+// play goes through each step of the recording, and returns an error as
+// soon as the client doesn't do what was recorded.
+func (rp *Replay) play() error {
+	if _, err := readSetupRequest(rp.conn); err != nil {
+		return fmt.Errorf("xgbtest: no setup request: %s", err)
+	}
+
+	sequence := 0
+	for _, step := range rp.steps {
+		if step.request == nil {
+			if _, err := rp.conn.Write(step.response); err != nil {
+				return fmt.Errorf("xgbtest: the client went away after "+
+					"request %d, before the end of the recording",
+					sequence)
+			}
+			continue
+		}
+
+		sequence++
+		req, err := readRequestBytes(rp.conn, rp.order)
+		if err != nil {
+			return fmt.Errorf("xgbtest: the client went away before "+
+				"request %d, before the end of the recording", sequence)
+		}
+		if !bytes.Equal(req, step.request) {
+			return fmt.Errorf("xgbtest: request %d differs from the "+
+				"recording:\n\tsent     % x\n\trecorded % x",
+				sequence, req, step.request)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package xgbtest
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// session is the code under test in the replay tests: it interns an atom,
+// maps a window and waits for the MapNotify event. It returns the atom and
+// the window in the event.
+func session(X *xgb.Conn, atom string) (xproto.Atom, xproto.Window, error) {
+	r, err := xproto.InternAtom(X, false, uint16(len(atom)), atom).Reply()
+	if err != nil {
+		return 0, 0, err
+	}
+	if err := xproto.MapWindowChecked(X, 7).Check(); err != nil {
+		return 0, 0, err
+	}
+	ev, err := X.WaitForEvent()
+	if err != nil {
+		return 0, 0, err
+	}
+	notify, _ := ev.(xproto.MapNotifyEvent)
+	return r.Atom, notify.Window, nil
+}
+
+// recordSession records a session with a fake X server, connecting with
+// the byte order 'order'.
+func recordSession(t *testing.T, order xgb.ByteOrder) []byte {
+	s := NewServer()
+	defer s.Close()
+	s.HandleFunc(internAtomOpcode, 0, func(req *Request) []Response {
+		reply := make(Reply, 12)
+		req.ByteOrder.Put32(reply[8:], 42) // atom
+		return []Response{reply}
+	})
+	s.HandleFunc(mapWindowOpcode, 0, func(req *Request) []Response {
+		ev := xproto.MapNotifyEvent{Window: 7}
+		return []Response{Event(ev.Bytes(req.ByteOrder))}
+	})
+
+	var recording bytes.Buffer
+	client, err := s.pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := Record(client, &recording)
+	X, err := xgb.NewConnNetOptions(context.Background(), rec,
+		xgb.Options{ByteOrder: order})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := session(X, "TEST"); err != nil {
+		t.Fatalf("Recorded session: %s", err)
+	}
+	X.Close()
+	<-X.Done()
+	if err := rec.Err(); err != nil {
+		t.Fatalf("Recording failed: %s", err)
+	}
+	return recording.Bytes()
+}
+
+// TestReplay checks that a recorded session plays back the same way, in
+// the byte order it was recorded in.
+func TestReplay(t *testing.T) {
+	for _, order := range []xgb.ByteOrder{xgb.LSBFirst, xgb.MSBFirst} {
+		rp, err := NewReplay(bytes.NewReader(recordSession(t, order)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		X, err := rp.Conn()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if X.ByteOrder() != order {
+			t.Errorf("Replayed a %s session with byte order %s.", order,
+				X.ByteOrder())
+		}
+
+		atom, win, err := session(X, "TEST")
+		if err != nil {
+			t.Fatalf("Replayed %s session: %s", order, err)
+		}
+		if atom != 42 || win != 7 {
+			t.Fatalf("Replayed %s session got atom %d and window %d, but "+
+				"expected 42 and 7.", order, atom, win)
+		}
+		X.Close()
+		if err := rp.Wait(); err != nil {
+			t.Fatalf("Replay of %s session: %s", order, err)
+		}
+	}
+}
+
+// TestReplayMismatch checks that a request that differs from the recording
+// is reported, and that the client isn't left hanging.
+func TestReplayMismatch(t *testing.T) {
+	rp, err := NewReplay(bytes.NewReader(recordSession(t, xgb.LSBFirst)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	X, err := rp.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := session(X, "OTHER"); err == nil {
+		t.Fatalf("The replayed session should have failed.")
+	}
+	err = rp.Wait()
+	if err == nil || !strings.Contains(err.Error(), "request 1 differs") {
+		t.Fatalf("Expected request 1 to differ, but got %v.", err)
+	}
+}
+
+// TestRecordScrubsAuth checks that authorization data is left out of a
+// recording, even when the setup request is sent in pieces.
+func TestRecordScrubsAuth(t *testing.T) {
+	setup := make([]byte, 12+20+16)
+	setup[0] = 'l'
+	xgb.Put16(setup[2:], 11)
+	xgb.Put16(setup[6:], 18) // "MIT-MAGIC-COOKIE-1"
+	xgb.Put16(setup[8:], 16)
+	copy(setup[12:], "MIT-MAGIC-COOKIE-1")
+	copy(setup[32:], "0123456789abcdef")
+
+	rec := &Recorder{}
+	var scrubbed []byte
+	for _, piece := range [][]byte{setup[:5], setup[5:40], setup[40:]} {
+		scrubbed = append(scrubbed, rec.scrub(piece)...)
+	}
+	if !bytes.Equal(scrubbed[:32], setup[:32]) {
+		t.Fatalf("The setup request changed before its authorization data.")
+	}
+	if !bytes.Equal(scrubbed[32:], make([]byte, 16)) {
+		t.Fatalf("The authorization data was recorded: %q", scrubbed[32:])
+	}
+	if string(setup[32:]) != "0123456789abcdef" {
+		t.Fatalf("Scrubbing changed what is sent to the X server.")
+	}
+}
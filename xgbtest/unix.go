@@ -0,0 +1,97 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package xgbtest
+
+import (
+	"io"
+	"net"
+	"os"
+	"syscall"
+)
+
+// maxFds is the most file descriptors that can be received along with a
+// single read.
+const maxFds = 16
+
+// fdConn is the server's end of a pair of connected Unix domain sockets.
+// The file descriptors received along with requests are kept until they
+// are taken with take.
+type fdConn struct {
+	*net.UnixConn
+	oob []byte
+	fds []int
+}
+
+// socketPair returns both ends of a new pair of connected Unix domain
+// sockets.
+func socketPair() (client, server net.Conn, err error) {
+	fds, err := syscall.Socketpair(syscall.AF_UNIX, syscall.SOCK_STREAM, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err = fileConn(fds[0], "xgbtest client")
+	if err != nil {
+		syscall.Close(fds[1])
+		return nil, nil, err
+	}
+	server, err = fileConn(fds[1], "xgbtest server")
+	if err != nil {
+		client.Close()
+		return nil, nil, err
+	}
+	return client, &fdConn{
+		UnixConn: server.(*net.UnixConn),
+		oob:      make([]byte, syscall.CmsgSpace(maxFds*4)),
+	}, nil
+}
+
+// fileConn returns a connection using the socket 'fd', which it takes over.
+func fileConn(fd int, name string) (net.Conn, error) {
+	f := os.NewFile(uintptr(fd), name)
+	defer f.Close() // net.FileConn makes its own copy
+	return net.FileConn(f)
+}
+
+func (c *fdConn) Read(p []byte) (int, error) {
+	n, oobn, _, _, err := c.ReadMsgUnix(p, c.oob)
+	if oobn > 0 {
+		c.parse(c.oob[:oobn])
+	}
+	// Unlike Read, ReadMsgUnix does not report the end of the stream.
+	if n == 0 && err == nil && len(p) > 0 {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// parse adds the file descriptors found in the control messages 'oob' to
+// the ones already received.
+func (c *fdConn) parse(oob []byte) {
+	msgs, err := syscall.ParseSocketControlMessage(oob)
+	if err != nil {
+		return
+	}
+	for i := range msgs {
+		if fds, err := syscall.ParseUnixRights(&msgs[i]); err == nil {
+			c.fds = append(c.fds, fds...)
+		}
+	}
+}
+
+// take returns all file descriptors received so far, and forgets them.
+func (c *fdConn) take() []int {
+	fds := c.fds
+	c.fds = nil
+	return fds
+}
+
+// writeFds writes 'buf', and passes the file descriptors in 'fds' along
+// with it.
+func (c *fdConn) writeFds(buf []byte, fds []int) error {
+	n, _, err := c.WriteMsgUnix(buf, syscall.UnixRights(fds...), nil)
+	if err == nil && n < len(buf) {
+		_, err = c.Write(buf[n:])
+	}
+	return err
+}
@@ -0,0 +1,31 @@
+//go:build windows || plan9
+// +build windows plan9
+
+package xgbtest
+
+import (
+	"errors"
+	"net"
+)
+
+// errNoUnix is returned when Server.Unix is set on this platform.
+var errNoUnix = errors.New(
+	"xgbtest: Unix domain sockets are not supported on this platform")
+
+// fdConn would be the server's end of a pair of connected Unix domain
+// sockets, which this platform doesn't have.
+type fdConn struct {
+	net.Conn
+}
+
+func socketPair() (client, server net.Conn, err error) {
+	return nil, nil, errNoUnix
+}
+
+func (c *fdConn) take() []int {
+	return nil
+}
+
+func (c *fdConn) writeFds(buf []byte, fds []int) error {
+	return errNoUnix
+}
@@ -0,0 +1,578 @@
+// Package xgbtest provides a fake X server that runs in memory, so that XGB
+// (and code built on top of it) can be tested without a real display.
+//
+// A Server answers requests with responses that are registered for each
+// opcode, either canned:
+//
+//	s := xgbtest.NewServer()
+//	s.Handle(8, 0, xgbtest.Error{Code: xproto.BadWindow}) // MapWindow
+//
+// or computed from the request:
+//
+//	s.HandleFunc(opcode, 0, func(req *xgbtest.Request) []xgbtest.Response {
+//		...
+//	})
+//
+// followed by
+//
+//	X, err := s.Conn()
+//
+// to get an *xgb.Conn connected to it. (To connect with xgb.NewConnOptions
+// instead, use s.Dial as the Dial option.) To pass file descriptors along
+// with requests and replies, set s.Unix before connecting, and to connect
+// with the MSBFirst byte order, set s.ByteOrder.
+//
+// Requests that have nothing registered for them get no response, which is
+// exactly right for requests without replies. A few requests are answered
+// by default, unless something else is registered for them: GetInputFocus
+// (which XGB uses to make round trips) and QueryExtension (which reports the
+// extensions added with AddExtension).
+//
+// Sessions with a real X server can also be recorded with Record, and
+// played back in a test with a Replay, which checks that the same requests
+// are sent and answers them the way the X server did.
+package xgbtest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// Core protocol opcodes of the requests the fake X server answers by default.
+const (
+	getInputFocusOpcode  = 43
+	queryExtensionOpcode = 98
+)
+
+// Request is a request received by the fake X server.
+type Request struct {
+	// Opcode is the major opcode of the request.
+	Opcode byte
+
+	// Minor is the minor opcode of an extension request. For core
+	// requests, it is the byte following the opcode, which some core
+	// requests use for data.
+	Minor byte
+
+	// Sequence is the sequence number of the request.
+	Sequence uint16
+
+	// ByteOrder is the byte order of the client, which the request is in.
+	// The responses to the request are sent in the same byte order.
+	ByteOrder xgb.ByteOrder
+
+	// Bytes is the whole request as it was sent, including its header.
+	Bytes []byte
+
+	// Fds are the file descriptors passed along with the request, which
+	// only happens if the server is connected over a Unix domain socket
+	// (see Server.Unix). The test is responsible for closing them.
+	Fds []int
+}
+
+// Response is something the fake X server sends in response to a request.
+// It is one of Reply, ReplyFds, Error or Event.
+type Response interface {
+	bytes(req *Request) []byte
+}
+
+// Reply is a reply to a request. The reply determinant, sequence number and
+// reply length are filled in by the server, and the reply is padded to at
+// least 32 bytes. (So a Reply can be as short as the fields it sets.) The
+// fields it sets must be in the byte order of the request.
+type Reply []byte
+
+func (r Reply) bytes(req *Request) []byte {
+	size := len(r)
+	if size < 32 {
+		size = 32
+	}
+	buf := make([]byte, xgb.Pad(size))
+	copy(buf, r)
+	buf[0] = 1
+	req.ByteOrder.Put16(buf[2:], req.Sequence)
+	req.ByteOrder.Put32(buf[4:], uint32((len(buf)-32)/4))
+	return buf
+}
+
+// ReplyFds is a reply that passes file descriptors along with it. It can
+// only be sent if the server is connected over a Unix domain socket (see
+// Server.Unix). The file descriptors are not closed.
+type ReplyFds struct {
+	Reply Reply
+	Fds   []int
+}
+
+func (r ReplyFds) bytes(req *Request) []byte {
+	return r.Reply.bytes(req)
+}
+
+// Error is an X error in response to a request.
+type Error struct {
+	// Code is the error number, i.e., xproto.BadWindow.
+	Code byte
+
+	// BadValue is the offending resource id or value, if any.
+	BadValue uint32
+}
+
+func (e Error) bytes(req *Request) []byte {
+	buf := make([]byte, 32)
+	buf[0] = 0
+	buf[1] = e.Code
+	req.ByteOrder.Put16(buf[2:], req.Sequence)
+	req.ByteOrder.Put32(buf[4:], e.BadValue)
+	if req.Opcode >= 128 { // only extension requests have minor opcodes
+		req.ByteOrder.Put16(buf[8:], uint16(req.Minor))
+	}
+	buf[10] = req.Opcode
+	return buf
+}
+
+// Event is an event sent after a request, i.e., a MapNotify event after a
+// MapWindow request. Use the Bytes method of any event to make one, with
+// the byte order of the request. The sequence number is filled in by the
+// server.
+type Event []byte
+
+func (ev Event) bytes(req *Request) []byte {
+	buf := make([]byte, len(ev))
+	copy(buf, ev)
+	// KeymapNotify events are the only ones without a sequence number.
+	if buf[0]&127 != xproto.KeymapNotify {
+		req.ByteOrder.Put16(buf[2:], req.Sequence)
+	}
+	return buf
+}
+
+// Handler computes the responses to a request. They are sent in order.
+type Handler func(req *Request) []Response
+
+// handlerKey identifies the requests that a handler is registered for.
+type handlerKey struct {
+	opcode, minor byte
+}
+
+// extension is an extension reported by QueryExtension.
+type extension struct {
+	opcode, firstEvent, firstError byte
+}
+
+// Server is a fake X server. Its zero value is not usable; use NewServer.
+// A Server accepts a single client, which is connected with Conn.
+type Server struct {
+	// Setup is the information sent to the client when it connects. It
+	// is filled in by NewServer with a single 1024x768 screen, and may be
+	// changed before calling Conn. The lengths of lists (i.e., RootsLen)
+	// are filled in automatically.
+	Setup xproto.SetupInfo
+
+	// Unix makes the server talk to its client over a pair of connected
+	// Unix domain sockets instead of an in-memory pipe, so that file
+	// descriptors can be passed both ways. It must be set before calling
+	// Conn or Dial. Conn and Dial fail if there are no Unix domain sockets
+	// on this platform.
+	Unix bool
+
+	// ByteOrder is the byte order that Conn connects with, LSBFirst if it's
+	// zero. (Clients that connect through Dial pick their own.) The server
+	// talks to its client in whatever byte order the client connects with.
+	ByteOrder xgb.ByteOrder
+
+	lock       sync.Mutex
+	handlers   map[handlerKey]Handler
+	extensions map[string]extension
+	requests   []*Request
+	sequence   uint16 // sequence number of the last request
+	order      xgb.ByteOrder
+	authName   string
+	authData   []byte
+
+	writeLock sync.Mutex
+	conn      net.Conn
+}
+
+// NewServer creates a new fake X server.
+func NewServer() *Server {
+	return &Server{
+		Setup:      defaultSetup(),
+		handlers:   make(map[handlerKey]Handler),
+		extensions: make(map[string]extension),
+	}
+}
+
+// defaultSetup returns the setup information of a server with a single
+// 1024x768 screen with a 24 bit TrueColor visual.
+func defaultSetup() xproto.SetupInfo {
+	visual := xproto.VisualInfo{
+		VisualId:        0x21,
+		Class:           xproto.VisualClassTrueColor,
+		BitsPerRgbValue: 8,
+		ColormapEntries: 256,
+		RedMask:         0xff0000,
+		GreenMask:       0xff00,
+		BlueMask:        0xff,
+	}
+	screen := xproto.ScreenInfo{
+		Root:                0x100,
+		DefaultColormap:     0x20,
+		WhitePixel:          0xffffff,
+		BlackPixel:          0,
+		WidthInPixels:       1024,
+		HeightInPixels:      768,
+		WidthInMillimeters:  271,
+		HeightInMillimeters: 203,
+		MinInstalledMaps:    1,
+		MaxInstalledMaps:    1,
+		RootVisual:          visual.VisualId,
+		RootDepth:           24,
+		AllowedDepths: []xproto.DepthInfo{
+			{Depth: 24, Visuals: []xproto.VisualInfo{visual}},
+		},
+	}
+	return xproto.SetupInfo{
+		Status:                   1,
+		ProtocolMajorVersion:     11,
+		ReleaseNumber:            1,
+		ResourceIdBase:           0x200000,
+		ResourceIdMask:           0x1fffff,
+		MaximumRequestLength:     1<<16 - 1,
+		ImageByteOrder:           xproto.ImageOrderLSBFirst,
+		BitmapFormatBitOrder:     xproto.ImageOrderLSBFirst,
+		BitmapFormatScanlineUnit: 32,
+		BitmapFormatScanlinePad:  32,
+		MinKeycode:               8,
+		MaxKeycode:               255,
+		Vendor:                   "XGB test server",
+		PixmapFormats: []xproto.Format{
+			{Depth: 24, BitsPerPixel: 32, ScanlinePad: 32},
+		},
+		Roots: []xproto.ScreenInfo{screen},
+	}
+}
+
+// Handle registers canned responses for requests with the major opcode
+// 'opcode'. For extension requests, 'minor' is the minor opcode; it is
+// ignored for core requests. Handle replaces anything registered for the
+// same requests before.
+func (s *Server) Handle(opcode, minor byte, responses ...Response) {
+	s.HandleFunc(opcode, minor, func(req *Request) []Response {
+		return responses
+	})
+}
+
+// HandleFunc is like Handle, but computes the responses to each request
+// with 'handler'. The handler is called from the server's own goroutine.
+func (s *Server) HandleFunc(opcode, minor byte, handler Handler) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.handlers[keyOf(opcode, minor)] = handler
+}
+
+// keyOf returns the key for requests with the given opcodes. Core requests
+// use the minor opcode byte for data, so it is ignored.
+func keyOf(opcode, minor byte) handlerKey {
+	if opcode < 128 {
+		minor = 0
+	}
+	return handlerKey{opcode, minor}
+}
+
+// AddExtension makes QueryExtension report that the extension called 'name'
+// is present, with the given major opcode, first event and first error.
+func (s *Server) AddExtension(name string, opcode, firstEvent,
+	firstError byte) {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.extensions[name] = extension{opcode, firstEvent, firstError}
+}
+
+// Requests returns every request the server has received so far.
+func (s *Server) Requests() []*Request {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]*Request(nil), s.requests...)
+}
+
+// SendEvent sends an event to the client right away, with the sequence
+// number of the last request received. The event must be in the byte order
+// of the client (see ClientByteOrder).
+func (s *Server) SendEvent(ev Event) error {
+	s.lock.Lock()
+	req := &Request{Sequence: s.sequence, ByteOrder: s.order}
+	s.lock.Unlock()
+	return s.write(ev.bytes(req))
+}
+
+// Conn starts the server and returns a new XGB connection to it.
+// It may only be called once.
+func (s *Server) Conn() (*xgb.Conn, error) {
+	client, err := s.pipe()
+	if err != nil {
+		return nil, err
+	}
+	return xgb.NewConnNetOptions(context.Background(), client,
+		xgb.Options{ByteOrder: s.ByteOrder})
+}
+
+// Dial starts the server and returns the client's end of a connection to
+// it, whatever the network and address. It may be used as the Dial option
+// of xgb.NewConnOptions, and may only be called once.
+func (s *Server) Dial(ctx context.Context, network,
+	address string) (net.Conn, error) {
+
+	return s.pipe()
+}
+
+// ClientByteOrder returns the byte order that the client connected with. It
+// is zero until the client has connected.
+func (s *Server) ClientByteOrder() xgb.ByteOrder {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.order
+}
+
+// Auth returns the name and data of the authorization protocol the client
+// sent when it connected.
+func (s *Server) Auth() (name string, data []byte) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.authName, s.authData
+}
+
+// pipe starts the server and returns the client's end of a connection to
+// it.
+func (s *Server) pipe() (net.Conn, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.conn != nil {
+		return nil, errors.New("xgbtest: the server already has a client")
+	}
+	var client, server net.Conn
+	if s.Unix {
+		var err error
+		if client, server, err = socketPair(); err != nil {
+			return nil, err
+		}
+	} else {
+		client, server = net.Pipe()
+	}
+	s.conn = server
+
+	go s.serve()
+	return client, nil
+}
+
+// Close shuts down the server. The client's connection fails as if the X
+// server went away.
+func (s *Server) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.conn == nil {
+		return nil
+	}
+	return s.conn.Close()
+}
+
+// write sends 'buf' to the client.
+func (s *Server) write(buf []byte) error {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+	_, err := s.conn.Write(buf)
+	return err
+}
+
+// send sends the response to 'req' to the client, along with the file
+// descriptors of a ReplyFds.
+func (s *Server) send(response Response, req *Request) error {
+	rf, ok := response.(ReplyFds)
+	if !ok || len(rf.Fds) == 0 {
+		return s.write(response.bytes(req))
+	}
+	fc, ok := s.conn.(*fdConn)
+	if !ok {
+		return errors.New("xgbtest: file descriptors can only be sent " +
+			"over Unix domain sockets (see Server.Unix)")
+	}
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+	return fc.writeFds(rf.bytes(req), rf.Fds)
+}
+
+// serve does the setup handshake, and then answers requests until the
+// connection is closed.
+func (s *Server) serve() {
+	defer s.conn.Close()
+
+	if err := s.handshake(); err != nil {
+		return
+	}
+	for {
+		req, err := s.readRequest()
+		if err != nil {
+			return
+		}
+		for _, response := range s.respond(req) {
+			if err := s.send(response, req); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// handshake reads the client's setup request, and sends the setup
+// information in return. Any authorization is accepted, and kept for Auth.
+func (s *Server) handshake() error {
+	setup, err := readSetupRequest(s.conn)
+	if err != nil {
+		return err
+	}
+	order := xgb.ByteOrder(setup[0])
+	nameLen, dataLen := int(order.Get16(setup[6:])), int(order.Get16(setup[8:]))
+	data := setup[12+xgb.Pad(nameLen):]
+
+	s.lock.Lock()
+	s.order = order
+	s.authName = string(setup[12 : 12+nameLen])
+	s.authData = data[:dataLen]
+	s.lock.Unlock()
+	return s.write(s.setupBytes(order))
+}
+
+// readSetupRequest reads the setup request a client starts a connection
+// with, including its authorization name and data. It starts with the byte
+// order of the client.
+func readSetupRequest(r io.Reader) ([]byte, error) {
+	head := make([]byte, 12)
+	if _, err := io.ReadFull(r, head); err != nil {
+		return nil, err
+	}
+	order := xgb.ByteOrder(head[0])
+	authNameLen, authDataLen := int(order.Get16(head[6:])),
+		int(order.Get16(head[8:]))
+	auth := make([]byte, xgb.Pad(authNameLen)+xgb.Pad(authDataLen))
+	if _, err := io.ReadFull(r, auth); err != nil {
+		return nil, err
+	}
+	return append(head, auth...), nil
+}
+
+// setupBytes returns s.Setup as it is sent over the wire, in the byte order
+// 'order'.
+func (s *Server) setupBytes(order xgb.ByteOrder) []byte {
+	setup := s.Setup
+	setup.VendorLen = uint16(len(setup.Vendor))
+	setup.PixmapFormatsLen = byte(len(setup.PixmapFormats))
+	setup.RootsLen = byte(len(setup.Roots))
+	setup.Roots = make([]xproto.ScreenInfo, len(s.Setup.Roots))
+	for i, root := range s.Setup.Roots {
+		root.AllowedDepthsLen = byte(len(root.AllowedDepths))
+		root.AllowedDepths = make([]xproto.DepthInfo, len(root.AllowedDepths))
+		for j, depth := range s.Setup.Roots[i].AllowedDepths {
+			depth.VisualsLen = uint16(len(depth.Visuals))
+			root.AllowedDepths[j] = depth
+		}
+		setup.Roots[i] = root
+	}
+
+	buf := setup.Bytes(order)
+	order.Put16(buf[6:], uint16((len(buf)-8)/4)) // length after the header
+	return buf
+}
+
+// readRequest reads the next request off the wire, and records it.
+func (s *Server) readRequest() (*Request, error) {
+	buf, err := readRequestBytes(s.conn, s.ClientByteOrder())
+	if err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.sequence++
+	req := &Request{
+		Opcode:    buf[0],
+		Minor:     buf[1],
+		Sequence:  s.sequence,
+		ByteOrder: s.order,
+		Bytes:     buf,
+	}
+	if fc, ok := s.conn.(*fdConn); ok {
+		req.Fds = fc.take()
+	}
+	s.requests = append(s.requests, req)
+	return req, nil
+}
+
+// readRequestBytes reads a whole request in the byte order 'order', as it
+// was sent. Requests using the extended length encoding of BIG-REQUESTS are
+// read in full, but are returned as they were sent.
+func readRequestBytes(r io.Reader, order xgb.ByteOrder) ([]byte, error) {
+	head := make([]byte, 4)
+	if _, err := io.ReadFull(r, head); err != nil {
+		return nil, err
+	}
+	buf := head
+	size := int(order.Get16(head[2:])) * 4
+	if size == 0 { // extended length
+		length := make([]byte, 4)
+		if _, err := io.ReadFull(r, length); err != nil {
+			return nil, err
+		}
+		buf = append(buf, length...)
+		size = int(order.Get32(length)) * 4
+	}
+	if size < len(buf) {
+		return nil, errors.New("xgbtest: request is shorter than its header")
+	}
+	rest := make([]byte, size-len(buf))
+	if _, err := io.ReadFull(r, rest); err != nil {
+		return nil, err
+	}
+	return append(buf, rest...), nil
+}
+
+// respond returns the responses to 'req'.
+func (s *Server) respond(req *Request) []Response {
+	s.lock.Lock()
+	handler, ok := s.handlers[keyOf(req.Opcode, req.Minor)]
+	s.lock.Unlock()
+	if ok {
+		return handler(req)
+	}
+
+	switch req.Opcode {
+	case getInputFocusOpcode:
+		return []Response{Reply{}} // focus is None
+	case queryExtensionOpcode:
+		return []Response{s.queryExtension(req)}
+	}
+	return nil
+}
+
+// queryExtension answers a QueryExtension request using the extensions
+// added with AddExtension.
+func (s *Server) queryExtension(req *Request) Response {
+	nameLen := int(req.ByteOrder.Get16(req.Bytes[4:]))
+	name := string(req.Bytes[8 : 8+nameLen])
+
+	s.lock.Lock()
+	ext, ok := s.extensions[name]
+	s.lock.Unlock()
+
+	reply := make(Reply, 32)
+	if ok {
+		reply[8] = 1 // present
+		reply[9] = ext.opcode
+		reply[10] = ext.firstEvent
+		reply[11] = ext.firstError
+	}
+	return reply
+}
@@ -0,0 +1,142 @@
+package xgbtest
+
+import (
+	"testing"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// Core protocol opcodes used in these tests.
+const (
+	mapWindowOpcode  = 8
+	internAtomOpcode = 16
+)
+
+// newConn starts a server and connects to it, and closes both when the test
+// is done.
+func newConn(t *testing.T, s *Server) *xgb.Conn {
+	X, err := s.Conn()
+	if err != nil {
+		t.Fatalf("Could not connect to the fake X server: %s", err)
+	}
+	t.Cleanup(func() {
+		X.Close()
+		<-X.Done()
+		s.Close()
+	})
+	return X
+}
+
+// TestSetup checks that the default setup information is read back
+// properly by the xproto package, in either byte order.
+func TestSetup(t *testing.T) {
+	for _, order := range []xgb.ByteOrder{xgb.LSBFirst, xgb.MSBFirst} {
+		s := NewServer()
+		s.ByteOrder = order
+		X := newConn(t, s)
+
+		screen := xproto.Setup(X).DefaultScreen(X)
+		if screen.Root != 0x100 || screen.WidthInPixels != 1024 {
+			t.Fatalf("%s: unexpected default screen: root %d with width %d.",
+				order, screen.Root, screen.WidthInPixels)
+		}
+		if len(screen.AllowedDepths) != 1 ||
+			len(screen.AllowedDepths[0].Visuals) != 1 {
+
+			t.Fatalf("%s: unexpected depths: %v", order, screen.AllowedDepths)
+		}
+	}
+}
+
+// TestReply checks that canned replies are delivered with the right
+// sequence number, and that requests are recorded.
+func TestReply(t *testing.T) {
+	s := NewServer()
+	reply := make(Reply, 12)
+	reply[8] = 42 // atom
+	s.Handle(internAtomOpcode, 0, reply)
+	X := newConn(t, s)
+
+	for i := 0; i < 3; i++ {
+		r, err := xproto.InternAtom(X, false, 4, "TEST").Reply()
+		if err != nil {
+			t.Fatalf("InternAtom: %s", err)
+		}
+		if r.Atom != 42 {
+			t.Fatalf("InternAtom: got atom %d, but expected 42.", r.Atom)
+		}
+	}
+	if n := len(s.Requests()); n != 3 {
+		t.Fatalf("The server received %d requests, but expected 3.", n)
+	}
+}
+
+// TestError checks that errors are delivered to checked requests.
+func TestError(t *testing.T) {
+	s := NewServer()
+	s.Handle(mapWindowOpcode, 0, Error{Code: xproto.BadWindow, BadValue: 7})
+	X := newConn(t, s)
+
+	err := xproto.MapWindowChecked(X, 7).Check()
+	bad, ok := err.(xproto.WindowError)
+	if !ok {
+		t.Fatalf("MapWindow: expected a BadWindow error, but got %v.", err)
+	}
+	if bad.BadValue != 7 || bad.MajorOpcode != mapWindowOpcode {
+		t.Fatalf("MapWindow: unexpected error %s.", bad)
+	}
+}
+
+// TestEvent checks that events are delivered, both in response to a
+// request and when they're sent directly.
+func TestEvent(t *testing.T) {
+	s := NewServer()
+	s.HandleFunc(mapWindowOpcode, 0, func(req *Request) []Response {
+		ev := xproto.MapNotifyEvent{Window: 7}
+		return []Response{Event(ev.Bytes(req.ByteOrder))}
+	})
+	X := newConn(t, s)
+
+	xproto.MapWindow(X, 7)
+	ev, err := X.WaitForEvent()
+	if ev, ok := ev.(xproto.MapNotifyEvent); !ok || ev.Window != 7 {
+		t.Fatalf("Expected a MapNotify event for window 7, but got %v "+
+			"(error %v).", ev, err)
+	}
+
+	focus := xproto.FocusInEvent{Event: 9}
+	if err := s.SendEvent(Event(focus.Bytes(X.ByteOrder()))); err != nil {
+		t.Fatalf("SendEvent: %s", err)
+	}
+	ev, err = X.WaitForEvent()
+	if ev, ok := ev.(xproto.FocusInEvent); !ok || ev.Event != 9 {
+		t.Fatalf("Expected a FocusIn event for window 9, but got %v "+
+			"(error %v).", ev, err)
+	}
+}
+
+// TestExtension checks that QueryExtension reports added extensions.
+func TestExtension(t *testing.T) {
+	s := NewServer()
+	s.AddExtension("TEST-EXT", 140, 90, 150)
+	X := newConn(t, s)
+
+	r, err := xproto.QueryExtension(X, 8, "TEST-EXT").Reply()
+	if err != nil {
+		t.Fatalf("QueryExtension: %s", err)
+	}
+	if !r.Present || r.MajorOpcode != 140 || r.FirstEvent != 90 ||
+		r.FirstError != 150 {
+
+		t.Fatalf("QueryExtension: unexpected reply %+v.", r)
+	}
+
+	r, err = xproto.QueryExtension(X, 7, "MISSING").Reply()
+	if err != nil {
+		t.Fatalf("QueryExtension: %s", err)
+	}
+	if r.Present {
+		t.Fatalf("QueryExtension: a missing extension is reported present.")
+	}
+}
@@ -0,0 +1,162 @@
+package xgb_test
+
+/*
+	Tests for resource id allocation.
+
+	These tests run against a fake X server that hands out a deliberately
+	small range of resource ids at setup, and then answers XC-MISC
+	GetXIDRange requests from a list of canned ranges.
+*/
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xgbtest"
+)
+
+// xcMiscOpcode is the major opcode the fake X server gives XC-MISC.
+const xcMiscOpcode = 130
+
+// xidRange is a range of resource ids returned by GetXIDRange.
+type xidRange struct {
+	start, count uint32
+}
+
+// fakeXIdServer starts a fake X server that gives out the resource ids
+// 0x400001 through 0x400007 at setup. If 'ranges' is nil, the server does
+// not support XC-MISC. Otherwise, each GetXIDRange request is answered with
+// the next range, and with an empty range once they run out.
+func fakeXIdServer(t *testing.T, ranges []xidRange) *xgb.Conn {
+	s := xgbtest.NewServer()
+	s.Setup.ResourceIdBase = 0x400000
+	s.Setup.ResourceIdMask = 0x7
+	if ranges != nil {
+		var lock sync.Mutex
+		getXIdRange := func(*xgbtest.Request) []xgbtest.Response {
+			lock.Lock()
+			defer lock.Unlock()
+
+			reply := make(xgbtest.Reply, 16)
+			if len(ranges) > 0 {
+				xgb.Put32(reply[8:], ranges[0].start)
+				xgb.Put32(reply[12:], ranges[0].count)
+				ranges = ranges[1:]
+			}
+			return []xgbtest.Response{reply}
+		}
+		s.AddExtension("XC-MISC", xcMiscOpcode, 0, 0)
+		s.HandleFunc(xcMiscOpcode, 1, getXIdRange)
+	}
+
+	c, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		c.Close()
+		<-c.Done()
+		s.Close()
+	})
+	return c
+}
+
+// newIds calls NewId 'n' times, and fails the test if any call fails.
+func newIds(t *testing.T, c *xgb.Conn, n int) []uint32 {
+	ids := make([]uint32, n)
+	for i := range ids {
+		id, err := c.NewId()
+		if err != nil {
+			t.Fatalf("NewId %d: %s", i, err)
+		}
+		ids[i] = id
+	}
+	return ids
+}
+
+// TestXIdRecycling uses up the ids given out at setup, and checks that
+// ranges of freed ids are then fetched with XC-MISC.
+func TestXIdRecycling(t *testing.T) {
+	c := fakeXIdServer(t, []xidRange{{0x400002, 2}, {0x400005, 1}})
+
+	want := []uint32{
+		0x400001, 0x400002, 0x400003, 0x400004, 0x400005, 0x400006, 0x400007,
+		0x400002, 0x400003, 0x400005,
+	}
+	for i, id := range newIds(t, c, len(want)) {
+		if id != want[i] {
+			t.Fatalf("Id %d is %#x, but expected %#x.", i, id, want[i])
+		}
+	}
+
+	// The fake server has no more ids to give out, and asking again must
+	// not make up ids beyond the end of the last range.
+	for i := 0; i < 3; i++ {
+		if id, err := c.NewId(); err == nil {
+			t.Fatalf("Expected an error once ids are exhausted, but got "+
+				"%#x.", id)
+		}
+	}
+}
+
+// TestXIdExhaustedWithoutXCMisc checks that NewId fails, rather than
+// handing out ids outside of the valid range, when the X server has no
+// XC-MISC extension.
+func TestXIdExhaustedWithoutXCMisc(t *testing.T) {
+	c := fakeXIdServer(t, nil)
+
+	newIds(t, c, 7)
+	for i := 0; i < 3; i++ {
+		if id, err := c.NewId(); err == nil {
+			t.Fatalf("Expected an error once ids are exhausted, but got "+
+				"%#x.", id)
+		}
+	}
+}
+
+// TestXIdRetry checks that NewId asks the X server for freed ids when it's
+// called, instead of reporting that there are none because there weren't
+// earlier.
+func TestXIdRetry(t *testing.T) {
+	s := xgbtest.NewServer()
+	s.Setup.ResourceIdBase = 0x400000
+	s.Setup.ResourceIdMask = 0x7
+	s.AddExtension("XC-MISC", xcMiscOpcode, 0, 0)
+	var lock sync.Mutex
+	freed := false
+	s.HandleFunc(xcMiscOpcode, 1, func(*xgbtest.Request) []xgbtest.Response {
+		lock.Lock()
+		defer lock.Unlock()
+
+		reply := make(xgbtest.Reply, 16)
+		if freed {
+			xgb.Put32(reply[8:], 0x400003)
+			xgb.Put32(reply[12:], 1)
+		}
+		return []xgbtest.Response{reply}
+	})
+	c, err := s.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	defer c.Close()
+
+	newIds(t, c, 7)
+	if id, err := c.NewId(); err == nil {
+		t.Fatalf("Expected an error while the X server has no ids to give "+
+			"out, but got %#x.", id)
+	}
+	lock.Lock()
+	freed = true
+	lock.Unlock()
+
+	id, err := c.NewId()
+	if err != nil {
+		t.Fatalf("NewId once the X server has freed ids: %s", err)
+	}
+	if id != 0x400003 {
+		t.Fatalf("Got id %#x, but expected %#x.", id, 0x400003)
+	}
+}
@@ -4,6 +4,8 @@ package xinerama
 // This file is automatically generated from xinerama.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xproto"
@@ -22,18 +24,16 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["XINERAMA"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["XINERAMA"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["XINERAMA"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["XINERAMA"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["XINERAMA"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "XINERAMA", xgb.ExtRequestInfos["XINERAMA"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["XINERAMA"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["XINERAMA"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["XINERAMA"] = make(map[int]xgb.RequestInfo)
 }
 
 type ScreenInfo struct {
@@ -44,60 +44,60 @@ type ScreenInfo struct {
 }
 
 // ScreenInfoRead reads a byte slice into a ScreenInfo value.
-func ScreenInfoRead(buf []byte, v *ScreenInfo) int {
+func ScreenInfoRead(buf []byte, order xgb.ByteOrder, v *ScreenInfo) int {
 	b := 0
 
-	v.XOrg = int16(xgb.Get16(buf[b:]))
+	v.XOrg = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.YOrg = int16(xgb.Get16(buf[b:]))
+	v.YOrg = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
 	return b
 }
 
 // ScreenInfoReadList reads a byte slice into a list of ScreenInfo values.
-func ScreenInfoReadList(buf []byte, dest []ScreenInfo) int {
+func ScreenInfoReadList(buf []byte, order xgb.ByteOrder, dest []ScreenInfo) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = ScreenInfo{}
-		b += ScreenInfoRead(buf[b:], &dest[i])
+		b += ScreenInfoRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a ScreenInfo value to a byte slice.
-func (v ScreenInfo) Bytes() []byte {
+// Bytes writes a ScreenInfo value to a byte slice, in the byte order 'order'.
+func (v ScreenInfo) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 8)
 	b := 0
 
-	xgb.Put16(buf[b:], uint16(v.XOrg))
+	order.Put16(buf[b:], uint16(v.XOrg))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.YOrg))
+	order.Put16(buf[b:], uint16(v.YOrg))
 	b += 2
 
-	xgb.Put16(buf[b:], v.Width)
+	order.Put16(buf[b:], v.Width)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Height)
+	order.Put16(buf[b:], v.Height)
 	b += 2
 
 	return buf[:b]
 }
 
 // ScreenInfoListBytes writes a list of ScreenInfo values to a byte slice.
-func ScreenInfoListBytes(buf []byte, list []ScreenInfo) int {
+func ScreenInfoListBytes(buf []byte, order xgb.ByteOrder, list []ScreenInfo) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -128,6 +128,8 @@ func ScreenInfoListBytes(buf []byte, list []ScreenInfo) int {
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // GetScreenCountCookie is a cookie used only for GetScreenCount requests.
 type GetScreenCountCookie struct {
 	*xgb.Cookie
@@ -176,24 +178,36 @@ func (cook GetScreenCountCookie) Reply() (*GetScreenCountReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getScreenCountReply(buf), nil
+	return getScreenCountReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetScreenCount request arrives.
+func (cook GetScreenCountCookie) ReplyContext(ctx context.Context) (*GetScreenCountReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getScreenCountReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getScreenCountReply reads a byte slice into a GetScreenCountReply value.
-func getScreenCountReply(buf []byte) *GetScreenCountReply {
+// getScreenCountReply reads a byte slice in the byte order 'order' into a GetScreenCountReply value.
+func getScreenCountReply(buf []byte, order xgb.ByteOrder) *GetScreenCountReply {
 	v := new(GetScreenCountReply)
 	b := 1 // skip reply determinant
 
 	v.ScreenCount = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Window = xproto.Window(xgb.Get32(buf[b:]))
+	v.Window = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
 	return v
@@ -202,9 +216,18 @@ func getScreenCountReply(buf []byte) *GetScreenCountReply {
 // Write request to wire for GetScreenCount
 // getScreenCountRequest writes a GetScreenCount request to a byte slice.
 func getScreenCountRequest(c *xgb.Conn, Window xproto.Window) []byte {
+	return AppendGetScreenCountRequest(nil, c, Window)
+}
+
+// AppendGetScreenCountRequest appends a GetScreenCount request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetScreenCountRequest(buf []byte, c *xgb.Conn, Window xproto.Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XINERAMA"]
@@ -214,15 +237,43 @@ func getScreenCountRequest(c *xgb.Conn, Window xproto.Window) []byte {
 	buf[b] = 2 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// GetScreenCountRequest represents the data of a GetScreenCount request, as it is read when tracing a connection.
+type GetScreenCountRequest struct {
+	Window xproto.Window
+}
+
+// getScreenCountRequestRead reads a byte slice in the byte order 'order' into a GetScreenCountRequest value.
+func getScreenCountRequestRead(buf []byte, order xgb.ByteOrder) *GetScreenCountRequest {
+	v := new(GetScreenCountRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XINERAMA"][2] = xgb.RequestInfo{
+		Name: "GetScreenCount",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getScreenCountRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getScreenCountReply(buf, order)
+		},
+	}
+}
+
 // GetScreenSizeCookie is a cookie used only for GetScreenSize requests.
 type GetScreenSizeCookie struct {
 	*xgb.Cookie
@@ -274,32 +325,44 @@ func (cook GetScreenSizeCookie) Reply() (*GetScreenSizeReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getScreenSizeReply(buf), nil
+	return getScreenSizeReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetScreenSize request arrives.
+func (cook GetScreenSizeCookie) ReplyContext(ctx context.Context) (*GetScreenSizeReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getScreenSizeReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getScreenSizeReply reads a byte slice into a GetScreenSizeReply value.
-func getScreenSizeReply(buf []byte) *GetScreenSizeReply {
+// getScreenSizeReply reads a byte slice in the byte order 'order' into a GetScreenSizeReply value.
+func getScreenSizeReply(buf []byte, order xgb.ByteOrder) *GetScreenSizeReply {
 	v := new(GetScreenSizeReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Width = xgb.Get32(buf[b:])
+	v.Width = order.Get32(buf[b:])
 	b += 4
 
-	v.Height = xgb.Get32(buf[b:])
+	v.Height = order.Get32(buf[b:])
 	b += 4
 
-	v.Window = xproto.Window(xgb.Get32(buf[b:]))
+	v.Window = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Screen = xgb.Get32(buf[b:])
+	v.Screen = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -308,9 +371,18 @@ func getScreenSizeReply(buf []byte) *GetScreenSizeReply {
 // Write request to wire for GetScreenSize
 // getScreenSizeRequest writes a GetScreenSize request to a byte slice.
 func getScreenSizeRequest(c *xgb.Conn, Window xproto.Window, Screen uint32) []byte {
+	return AppendGetScreenSizeRequest(nil, c, Window, Screen)
+}
+
+// AppendGetScreenSizeRequest appends a GetScreenSize request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetScreenSizeRequest(buf []byte, c *xgb.Conn, Window xproto.Window, Screen uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XINERAMA"]
@@ -320,18 +392,50 @@ func getScreenSizeRequest(c *xgb.Conn, Window xproto.Window, Screen uint32) []by
 	buf[b] = 3 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put32(buf[b:], Screen)
+	order.Put32(buf[b:], Screen)
 	b += 4
 
 	return buf
 }
 
+// GetScreenSizeRequest represents the data of a GetScreenSize request, as it is read when tracing a connection.
+type GetScreenSizeRequest struct {
+	Window xproto.Window
+	Screen uint32
+}
+
+// getScreenSizeRequestRead reads a byte slice in the byte order 'order' into a GetScreenSizeRequest value.
+func getScreenSizeRequestRead(buf []byte, order xgb.ByteOrder) *GetScreenSizeRequest {
+	v := new(GetScreenSizeRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Screen = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XINERAMA"][3] = xgb.RequestInfo{
+		Name: "GetScreenSize",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getScreenSizeRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getScreenSizeReply(buf, order)
+		},
+	}
+}
+
 // GetStateCookie is a cookie used only for GetState requests.
 type GetStateCookie struct {
 	*xgb.Cookie
@@ -380,24 +484,36 @@ func (cook GetStateCookie) Reply() (*GetStateReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getStateReply(buf), nil
+	return getStateReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetState request arrives.
+func (cook GetStateCookie) ReplyContext(ctx context.Context) (*GetStateReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getStateReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getStateReply reads a byte slice into a GetStateReply value.
-func getStateReply(buf []byte) *GetStateReply {
+// getStateReply reads a byte slice in the byte order 'order' into a GetStateReply value.
+func getStateReply(buf []byte, order xgb.ByteOrder) *GetStateReply {
 	v := new(GetStateReply)
 	b := 1 // skip reply determinant
 
 	v.State = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Window = xproto.Window(xgb.Get32(buf[b:]))
+	v.Window = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
 	return v
@@ -406,9 +522,18 @@ func getStateReply(buf []byte) *GetStateReply {
 // Write request to wire for GetState
 // getStateRequest writes a GetState request to a byte slice.
 func getStateRequest(c *xgb.Conn, Window xproto.Window) []byte {
+	return AppendGetStateRequest(nil, c, Window)
+}
+
+// AppendGetStateRequest appends a GetState request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetStateRequest(buf []byte, c *xgb.Conn, Window xproto.Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XINERAMA"]
@@ -418,15 +543,43 @@ func getStateRequest(c *xgb.Conn, Window xproto.Window) []byte {
 	buf[b] = 1 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// GetStateRequest represents the data of a GetState request, as it is read when tracing a connection.
+type GetStateRequest struct {
+	Window xproto.Window
+}
+
+// getStateRequestRead reads a byte slice in the byte order 'order' into a GetStateRequest value.
+func getStateRequestRead(buf []byte, order xgb.ByteOrder) *GetStateRequest {
+	v := new(GetStateRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XINERAMA"][1] = xgb.RequestInfo{
+		Name: "GetState",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getStateRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getStateReply(buf, order)
+		},
+	}
+}
+
 // IsActiveCookie is a cookie used only for IsActive requests.
 type IsActiveCookie struct {
 	*xgb.Cookie
@@ -475,23 +628,35 @@ func (cook IsActiveCookie) Reply() (*IsActiveReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return isActiveReply(buf), nil
+	return isActiveReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a IsActive request arrives.
+func (cook IsActiveCookie) ReplyContext(ctx context.Context) (*IsActiveReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return isActiveReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// isActiveReply reads a byte slice into a IsActiveReply value.
-func isActiveReply(buf []byte) *IsActiveReply {
+// isActiveReply reads a byte slice in the byte order 'order' into a IsActiveReply value.
+func isActiveReply(buf []byte, order xgb.ByteOrder) *IsActiveReply {
 	v := new(IsActiveReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.State = xgb.Get32(buf[b:])
+	v.State = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -500,9 +665,18 @@ func isActiveReply(buf []byte) *IsActiveReply {
 // Write request to wire for IsActive
 // isActiveRequest writes a IsActive request to a byte slice.
 func isActiveRequest(c *xgb.Conn) []byte {
+	return AppendIsActiveRequest(nil, c)
+}
+
+// AppendIsActiveRequest appends a IsActive request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendIsActiveRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XINERAMA"]
@@ -512,12 +686,34 @@ func isActiveRequest(c *xgb.Conn) []byte {
 	buf[b] = 4 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// IsActiveRequest represents the data of a IsActive request, as it is read when tracing a connection.
+type IsActiveRequest struct {
+}
+
+// isActiveRequestRead reads a byte slice in the byte order 'order' into a IsActiveRequest value.
+func isActiveRequestRead(buf []byte, order xgb.ByteOrder) *IsActiveRequest {
+	v := new(IsActiveRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XINERAMA"][4] = xgb.RequestInfo{
+		Name: "IsActive",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return isActiveRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return isActiveReply(buf, order)
+		},
+	}
+}
+
 // QueryScreensCookie is a cookie used only for QueryScreens requests.
 type QueryScreensCookie struct {
 	*xgb.Cookie
@@ -568,29 +764,41 @@ func (cook QueryScreensCookie) Reply() (*QueryScreensReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryScreensReply(buf), nil
+	return queryScreensReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryScreensReply reads a byte slice into a QueryScreensReply value.
-func queryScreensReply(buf []byte) *QueryScreensReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryScreens request arrives.
+func (cook QueryScreensCookie) ReplyContext(ctx context.Context) (*QueryScreensReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryScreensReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryScreensReply reads a byte slice in the byte order 'order' into a QueryScreensReply value.
+func queryScreensReply(buf []byte, order xgb.ByteOrder) *QueryScreensReply {
 	v := new(QueryScreensReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Number = xgb.Get32(buf[b:])
+	v.Number = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
 
 	v.ScreenInfo = make([]ScreenInfo, v.Number)
-	b += ScreenInfoReadList(buf[b:], v.ScreenInfo)
+	b += ScreenInfoReadList(buf[b:], order, v.ScreenInfo)
 
 	return v
 }
@@ -598,9 +806,18 @@ func queryScreensReply(buf []byte) *QueryScreensReply {
 // Write request to wire for QueryScreens
 // queryScreensRequest writes a QueryScreens request to a byte slice.
 func queryScreensRequest(c *xgb.Conn) []byte {
+	return AppendQueryScreensRequest(nil, c)
+}
+
+// AppendQueryScreensRequest appends a QueryScreens request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryScreensRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XINERAMA"]
@@ -610,12 +827,34 @@ func queryScreensRequest(c *xgb.Conn) []byte {
 	buf[b] = 5 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// QueryScreensRequest represents the data of a QueryScreens request, as it is read when tracing a connection.
+type QueryScreensRequest struct {
+}
+
+// queryScreensRequestRead reads a byte slice in the byte order 'order' into a QueryScreensRequest value.
+func queryScreensRequestRead(buf []byte, order xgb.ByteOrder) *QueryScreensRequest {
+	v := new(QueryScreensRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XINERAMA"][5] = xgb.RequestInfo{
+		Name: "QueryScreens",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryScreensRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryScreensReply(buf, order)
+		},
+	}
+}
+
 // QueryVersionCookie is a cookie used only for QueryVersion requests.
 type QueryVersionCookie struct {
 	*xgb.Cookie
@@ -665,26 +904,38 @@ func (cook QueryVersionCookie) Reply() (*QueryVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryVersionReply(buf), nil
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryVersion request arrives.
+func (cook QueryVersionCookie) ReplyContext(ctx context.Context) (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryVersionReply reads a byte slice into a QueryVersionReply value.
-func queryVersionReply(buf []byte) *QueryVersionReply {
+// queryVersionReply reads a byte slice in the byte order 'order' into a QueryVersionReply value.
+func queryVersionReply(buf []byte, order xgb.ByteOrder) *QueryVersionReply {
 	v := new(QueryVersionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Major = xgb.Get16(buf[b:])
+	v.Major = order.Get16(buf[b:])
 	b += 2
 
-	v.Minor = xgb.Get16(buf[b:])
+	v.Minor = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -693,9 +944,18 @@ func queryVersionReply(buf []byte) *QueryVersionReply {
 // Write request to wire for QueryVersion
 // queryVersionRequest writes a QueryVersion request to a byte slice.
 func queryVersionRequest(c *xgb.Conn, Major byte, Minor byte) []byte {
+	return AppendQueryVersionRequest(nil, c, Major, Minor)
+}
+
+// AppendQueryVersionRequest appends a QueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryVersionRequest(buf []byte, c *xgb.Conn, Major byte, Minor byte) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XINERAMA"]
@@ -705,7 +965,7 @@ func queryVersionRequest(c *xgb.Conn, Major byte, Minor byte) []byte {
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	buf[b] = Major
@@ -716,3 +976,35 @@ func queryVersionRequest(c *xgb.Conn, Major byte, Minor byte) []byte {
 
 	return buf
 }
+
+// QueryVersionRequest represents the data of a QueryVersion request, as it is read when tracing a connection.
+type QueryVersionRequest struct {
+	Major byte
+	Minor byte
+}
+
+// queryVersionRequestRead reads a byte slice in the byte order 'order' into a QueryVersionRequest value.
+func queryVersionRequestRead(buf []byte, order xgb.ByteOrder) *QueryVersionRequest {
+	v := new(QueryVersionRequest)
+	b := 4 // skip request header
+
+	v.Major = buf[b]
+	b += 1
+
+	v.Minor = buf[b]
+	b += 1
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XINERAMA"][0] = xgb.RequestInfo{
+		Name: "QueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionReply(buf, order)
+		},
+	}
+}
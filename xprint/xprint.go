@@ -4,6 +4,8 @@ package xprint
 // This file is automatically generated from xprint.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xproto"
@@ -22,18 +24,16 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["XpExtension"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["XpExtension"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["XpExtension"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["XpExtension"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["XpExtension"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "XpExtension", xgb.ExtRequestInfos["XpExtension"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["XpExtension"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["XpExtension"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["XpExtension"] = make(map[int]xgb.RequestInfo)
 }
 
 const (
@@ -55,25 +55,25 @@ type AttributNotifyEvent struct {
 	Context  Pcontext
 }
 
-// AttributNotifyEventNew constructs a AttributNotifyEvent value that implements xgb.Event from a byte slice.
-func AttributNotifyEventNew(buf []byte) xgb.Event {
+// AttributNotifyEventNew constructs a AttributNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func AttributNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := AttributNotifyEvent{}
 	b := 1 // don't read event number
 
 	v.Detail = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Context = Pcontext(xgb.Get32(buf[b:]))
+	v.Context = Pcontext(order.Get32(buf[b:]))
 	b += 4
 
 	return v
 }
 
-// Bytes writes a AttributNotifyEvent value to a byte slice.
-func (v AttributNotifyEvent) Bytes() []byte {
+// Bytes writes a AttributNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v AttributNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -86,7 +86,7 @@ func (v AttributNotifyEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Context))
+	order.Put32(buf[b:], uint32(v.Context))
 	b += 4
 
 	return buf
@@ -120,15 +120,15 @@ type BadContextError struct {
 	NiceName string
 }
 
-// BadContextErrorNew constructs a BadContextError value that implements xgb.Error from a byte slice.
-func BadContextErrorNew(buf []byte) xgb.Error {
+// BadContextErrorNew constructs a BadContextError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadContextErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := BadContextError{}
 	v.NiceName = "BadContext"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -166,15 +166,15 @@ type BadSequenceError struct {
 	NiceName string
 }
 
-// BadSequenceErrorNew constructs a BadSequenceError value that implements xgb.Error from a byte slice.
-func BadSequenceErrorNew(buf []byte) xgb.Error {
+// BadSequenceErrorNew constructs a BadSequenceError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadSequenceErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := BadSequenceError{}
 	v.NiceName = "BadSequence"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -234,18 +234,18 @@ type NotifyEvent struct {
 	Cancel   bool
 }
 
-// NotifyEventNew constructs a NotifyEvent value that implements xgb.Event from a byte slice.
-func NotifyEventNew(buf []byte) xgb.Event {
+// NotifyEventNew constructs a NotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func NotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := NotifyEvent{}
 	b := 1 // don't read event number
 
 	v.Detail = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Context = Pcontext(xgb.Get32(buf[b:]))
+	v.Context = Pcontext(order.Get32(buf[b:]))
 	b += 4
 
 	if buf[b] == 1 {
@@ -258,8 +258,8 @@ func NotifyEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a NotifyEvent value to a byte slice.
-func (v NotifyEvent) Bytes() []byte {
+// Bytes writes a NotifyEvent value to a byte slice, in the byte order 'order'.
+func (v NotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -272,7 +272,7 @@ func (v NotifyEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Context))
+	order.Put32(buf[b:], uint32(v.Context))
 	b += 4
 
 	if v.Cancel {
@@ -324,10 +324,10 @@ type Printer struct {
 }
 
 // PrinterRead reads a byte slice into a Printer value.
-func PrinterRead(buf []byte, v *Printer) int {
+func PrinterRead(buf []byte, order xgb.ByteOrder, v *Printer) int {
 	b := 0
 
-	v.NameLen = xgb.Get32(buf[b:])
+	v.NameLen = order.Get32(buf[b:])
 	b += 4
 
 	v.Name = make([]String8, v.NameLen)
@@ -336,7 +336,7 @@ func PrinterRead(buf []byte, v *Printer) int {
 		b += 1
 	}
 
-	v.DescLen = xgb.Get32(buf[b:])
+	v.DescLen = order.Get32(buf[b:])
 	b += 4
 
 	v.Description = make([]String8, v.DescLen)
@@ -349,21 +349,21 @@ func PrinterRead(buf []byte, v *Printer) int {
 }
 
 // PrinterReadList reads a byte slice into a list of Printer values.
-func PrinterReadList(buf []byte, dest []Printer) int {
+func PrinterReadList(buf []byte, order xgb.ByteOrder, dest []Printer) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Printer{}
-		b += PrinterRead(buf[b:], &dest[i])
+		b += PrinterRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Printer value to a byte slice.
-func (v Printer) Bytes() []byte {
+// Bytes writes a Printer value to a byte slice, in the byte order 'order'.
+func (v Printer) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, (((4 + xgb.Pad((int(v.NameLen) * 1))) + 4) + xgb.Pad((int(v.DescLen) * 1))))
 	b := 0
 
-	xgb.Put32(buf[b:], v.NameLen)
+	order.Put32(buf[b:], v.NameLen)
 	b += 4
 
 	for i := 0; i < int(v.NameLen); i++ {
@@ -371,7 +371,7 @@ func (v Printer) Bytes() []byte {
 		b += 1
 	}
 
-	xgb.Put32(buf[b:], v.DescLen)
+	order.Put32(buf[b:], v.DescLen)
 	b += 4
 
 	for i := 0; i < int(v.DescLen); i++ {
@@ -383,11 +383,11 @@ func (v Printer) Bytes() []byte {
 }
 
 // PrinterListBytes writes a list of Printer values to a byte slice.
-func PrinterListBytes(buf []byte, list []Printer) int {
+func PrinterListBytes(buf []byte, order xgb.ByteOrder, list []Printer) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -429,6 +429,8 @@ type String8 byte
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // CreateContextCookie is a cookie used only for CreateContext requests.
 type CreateContextCookie struct {
 	*xgb.Cookie
@@ -466,12 +468,26 @@ func (cook CreateContextCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateContextCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateContext
 // createContextRequest writes a CreateContext request to a byte slice.
 func createContextRequest(c *xgb.Conn, ContextId uint32, PrinterNameLen uint32, LocaleLen uint32, PrinterName []String8, Locale []String8) []byte {
+	return AppendCreateContextRequest(nil, c, ContextId, PrinterNameLen, LocaleLen, PrinterName, Locale)
+}
+
+// AppendCreateContextRequest appends a CreateContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateContextRequest(buf []byte, c *xgb.Conn, ContextId uint32, PrinterNameLen uint32, LocaleLen uint32, PrinterName []String8, Locale []String8) []byte {
 	size := xgb.Pad(((16 + xgb.Pad((int(PrinterNameLen) * 1))) + xgb.Pad((int(LocaleLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -481,16 +497,16 @@ func createContextRequest(c *xgb.Conn, ContextId uint32, PrinterNameLen uint32,
 	buf[b] = 2 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], ContextId)
+	order.Put32(buf[b:], ContextId)
 	b += 4
 
-	xgb.Put32(buf[b:], PrinterNameLen)
+	order.Put32(buf[b:], PrinterNameLen)
 	b += 4
 
-	xgb.Put32(buf[b:], LocaleLen)
+	order.Put32(buf[b:], LocaleLen)
 	b += 4
 
 	for i := 0; i < int(PrinterNameLen); i++ {
@@ -506,6 +522,53 @@ func createContextRequest(c *xgb.Conn, ContextId uint32, PrinterNameLen uint32,
 	return buf
 }
 
+// CreateContextRequest represents the data of a CreateContext request, as it is read when tracing a connection.
+type CreateContextRequest struct {
+	ContextId      uint32
+	PrinterNameLen uint32
+	LocaleLen      uint32
+	PrinterName    []String8 // size: xgb.Pad((int(PrinterNameLen) * 1))
+	Locale         []String8 // size: xgb.Pad((int(LocaleLen) * 1))
+}
+
+// createContextRequestRead reads a byte slice in the byte order 'order' into a CreateContextRequest value.
+func createContextRequestRead(buf []byte, order xgb.ByteOrder) *CreateContextRequest {
+	v := new(CreateContextRequest)
+	b := 4 // skip request header
+
+	v.ContextId = order.Get32(buf[b:])
+	b += 4
+
+	v.PrinterNameLen = order.Get32(buf[b:])
+	b += 4
+
+	v.LocaleLen = order.Get32(buf[b:])
+	b += 4
+
+	v.PrinterName = make([]String8, v.PrinterNameLen)
+	for i := 0; i < int(v.PrinterNameLen); i++ {
+		v.PrinterName[i] = String8(buf[b])
+		b += 1
+	}
+
+	v.Locale = make([]String8, v.LocaleLen)
+	for i := 0; i < int(v.LocaleLen); i++ {
+		v.Locale[i] = String8(buf[b])
+		b += 1
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][2] = xgb.RequestInfo{
+		Name: "CreateContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createContextRequestRead(buf, order)
+		},
+	}
+}
+
 // PrintDestroyContextCookie is a cookie used only for PrintDestroyContext requests.
 type PrintDestroyContextCookie struct {
 	*xgb.Cookie
@@ -543,12 +606,26 @@ func (cook PrintDestroyContextCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PrintDestroyContextCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PrintDestroyContext
 // printDestroyContextRequest writes a PrintDestroyContext request to a byte slice.
 func printDestroyContextRequest(c *xgb.Conn, Context uint32) []byte {
+	return AppendPrintDestroyContextRequest(nil, c, Context)
+}
+
+// AppendPrintDestroyContextRequest appends a PrintDestroyContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintDestroyContextRequest(buf []byte, c *xgb.Conn, Context uint32) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -558,15 +635,40 @@ func printDestroyContextRequest(c *xgb.Conn, Context uint32) []byte {
 	buf[b] = 5 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Context)
+	order.Put32(buf[b:], Context)
 	b += 4
 
 	return buf
 }
 
+// PrintDestroyContextRequest represents the data of a PrintDestroyContext request, as it is read when tracing a connection.
+type PrintDestroyContextRequest struct {
+	Context uint32
+}
+
+// printDestroyContextRequestRead reads a byte slice in the byte order 'order' into a PrintDestroyContextRequest value.
+func printDestroyContextRequestRead(buf []byte, order xgb.ByteOrder) *PrintDestroyContextRequest {
+	v := new(PrintDestroyContextRequest)
+	b := 4 // skip request header
+
+	v.Context = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][5] = xgb.RequestInfo{
+		Name: "PrintDestroyContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printDestroyContextRequestRead(buf, order)
+		},
+	}
+}
+
 // PrintEndDocCookie is a cookie used only for PrintEndDoc requests.
 type PrintEndDocCookie struct {
 	*xgb.Cookie
@@ -604,12 +706,26 @@ func (cook PrintEndDocCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PrintEndDocCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PrintEndDoc
 // printEndDocRequest writes a PrintEndDoc request to a byte slice.
 func printEndDocRequest(c *xgb.Conn, Cancel bool) []byte {
+	return AppendPrintEndDocRequest(nil, c, Cancel)
+}
+
+// AppendPrintEndDocRequest appends a PrintEndDoc request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintEndDocRequest(buf []byte, c *xgb.Conn, Cancel bool) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -619,7 +735,7 @@ func printEndDocRequest(c *xgb.Conn, Cancel bool) []byte {
 	buf[b] = 10 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	if Cancel {
@@ -632,6 +748,35 @@ func printEndDocRequest(c *xgb.Conn, Cancel bool) []byte {
 	return buf
 }
 
+// PrintEndDocRequest represents the data of a PrintEndDoc request, as it is read when tracing a connection.
+type PrintEndDocRequest struct {
+	Cancel bool
+}
+
+// printEndDocRequestRead reads a byte slice in the byte order 'order' into a PrintEndDocRequest value.
+func printEndDocRequestRead(buf []byte, order xgb.ByteOrder) *PrintEndDocRequest {
+	v := new(PrintEndDocRequest)
+	b := 4 // skip request header
+
+	if buf[b] == 1 {
+		v.Cancel = true
+	} else {
+		v.Cancel = false
+	}
+	b += 1
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][10] = xgb.RequestInfo{
+		Name: "PrintEndDoc",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printEndDocRequestRead(buf, order)
+		},
+	}
+}
+
 // PrintEndJobCookie is a cookie used only for PrintEndJob requests.
 type PrintEndJobCookie struct {
 	*xgb.Cookie
@@ -669,12 +814,26 @@ func (cook PrintEndJobCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PrintEndJobCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PrintEndJob
 // printEndJobRequest writes a PrintEndJob request to a byte slice.
 func printEndJobRequest(c *xgb.Conn, Cancel bool) []byte {
+	return AppendPrintEndJobRequest(nil, c, Cancel)
+}
+
+// AppendPrintEndJobRequest appends a PrintEndJob request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintEndJobRequest(buf []byte, c *xgb.Conn, Cancel bool) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -684,7 +843,7 @@ func printEndJobRequest(c *xgb.Conn, Cancel bool) []byte {
 	buf[b] = 8 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	if Cancel {
@@ -697,6 +856,35 @@ func printEndJobRequest(c *xgb.Conn, Cancel bool) []byte {
 	return buf
 }
 
+// PrintEndJobRequest represents the data of a PrintEndJob request, as it is read when tracing a connection.
+type PrintEndJobRequest struct {
+	Cancel bool
+}
+
+// printEndJobRequestRead reads a byte slice in the byte order 'order' into a PrintEndJobRequest value.
+func printEndJobRequestRead(buf []byte, order xgb.ByteOrder) *PrintEndJobRequest {
+	v := new(PrintEndJobRequest)
+	b := 4 // skip request header
+
+	if buf[b] == 1 {
+		v.Cancel = true
+	} else {
+		v.Cancel = false
+	}
+	b += 1
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][8] = xgb.RequestInfo{
+		Name: "PrintEndJob",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printEndJobRequestRead(buf, order)
+		},
+	}
+}
+
 // PrintEndPageCookie is a cookie used only for PrintEndPage requests.
 type PrintEndPageCookie struct {
 	*xgb.Cookie
@@ -734,12 +922,26 @@ func (cook PrintEndPageCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PrintEndPageCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PrintEndPage
 // printEndPageRequest writes a PrintEndPage request to a byte slice.
 func printEndPageRequest(c *xgb.Conn, Cancel bool) []byte {
+	return AppendPrintEndPageRequest(nil, c, Cancel)
+}
+
+// AppendPrintEndPageRequest appends a PrintEndPage request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintEndPageRequest(buf []byte, c *xgb.Conn, Cancel bool) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -749,7 +951,7 @@ func printEndPageRequest(c *xgb.Conn, Cancel bool) []byte {
 	buf[b] = 14 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	if Cancel {
@@ -764,6 +966,38 @@ func printEndPageRequest(c *xgb.Conn, Cancel bool) []byte {
 	return buf
 }
 
+// PrintEndPageRequest represents the data of a PrintEndPage request, as it is read when tracing a connection.
+type PrintEndPageRequest struct {
+	Cancel bool
+	// padding: 3 bytes
+}
+
+// printEndPageRequestRead reads a byte slice in the byte order 'order' into a PrintEndPageRequest value.
+func printEndPageRequestRead(buf []byte, order xgb.ByteOrder) *PrintEndPageRequest {
+	v := new(PrintEndPageRequest)
+	b := 4 // skip request header
+
+	if buf[b] == 1 {
+		v.Cancel = true
+	} else {
+		v.Cancel = false
+	}
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][14] = xgb.RequestInfo{
+		Name: "PrintEndPage",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printEndPageRequestRead(buf, order)
+		},
+	}
+}
+
 // PrintGetAttributesCookie is a cookie used only for PrintGetAttributes requests.
 type PrintGetAttributesCookie struct {
 	*xgb.Cookie
@@ -814,23 +1048,35 @@ func (cook PrintGetAttributesCookie) Reply() (*PrintGetAttributesReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return printGetAttributesReply(buf), nil
+	return printGetAttributesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a PrintGetAttributes request arrives.
+func (cook PrintGetAttributesCookie) ReplyContext(ctx context.Context) (*PrintGetAttributesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return printGetAttributesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// printGetAttributesReply reads a byte slice into a PrintGetAttributesReply value.
-func printGetAttributesReply(buf []byte) *PrintGetAttributesReply {
+// printGetAttributesReply reads a byte slice in the byte order 'order' into a PrintGetAttributesReply value.
+func printGetAttributesReply(buf []byte, order xgb.ByteOrder) *PrintGetAttributesReply {
 	v := new(PrintGetAttributesReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.StringLen = xgb.Get32(buf[b:])
+	v.StringLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
@@ -847,9 +1093,18 @@ func printGetAttributesReply(buf []byte) *PrintGetAttributesReply {
 // Write request to wire for PrintGetAttributes
 // printGetAttributesRequest writes a PrintGetAttributes request to a byte slice.
 func printGetAttributesRequest(c *xgb.Conn, Context Pcontext, Pool byte) []byte {
+	return AppendPrintGetAttributesRequest(nil, c, Context, Pool)
+}
+
+// AppendPrintGetAttributesRequest appends a PrintGetAttributes request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintGetAttributesRequest(buf []byte, c *xgb.Conn, Context Pcontext, Pool byte) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -859,10 +1114,10 @@ func printGetAttributesRequest(c *xgb.Conn, Context Pcontext, Pool byte) []byte
 	buf[b] = 17 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
 	buf[b] = Pool
@@ -873,6 +1128,41 @@ func printGetAttributesRequest(c *xgb.Conn, Context Pcontext, Pool byte) []byte
 	return buf
 }
 
+// PrintGetAttributesRequest represents the data of a PrintGetAttributes request, as it is read when tracing a connection.
+type PrintGetAttributesRequest struct {
+	Context Pcontext
+	Pool    byte
+	// padding: 3 bytes
+}
+
+// printGetAttributesRequestRead reads a byte slice in the byte order 'order' into a PrintGetAttributesRequest value.
+func printGetAttributesRequestRead(buf []byte, order xgb.ByteOrder) *PrintGetAttributesRequest {
+	v := new(PrintGetAttributesRequest)
+	b := 4 // skip request header
+
+	v.Context = Pcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.Pool = buf[b]
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][17] = xgb.RequestInfo{
+		Name: "PrintGetAttributes",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printGetAttributesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printGetAttributesReply(buf, order)
+		},
+	}
+}
+
 // PrintGetContextCookie is a cookie used only for PrintGetContext requests.
 type PrintGetContextCookie struct {
 	*xgb.Cookie
@@ -921,23 +1211,35 @@ func (cook PrintGetContextCookie) Reply() (*PrintGetContextReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return printGetContextReply(buf), nil
+	return printGetContextReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// printGetContextReply reads a byte slice into a PrintGetContextReply value.
-func printGetContextReply(buf []byte) *PrintGetContextReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a PrintGetContext request arrives.
+func (cook PrintGetContextCookie) ReplyContext(ctx context.Context) (*PrintGetContextReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return printGetContextReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// printGetContextReply reads a byte slice in the byte order 'order' into a PrintGetContextReply value.
+func printGetContextReply(buf []byte, order xgb.ByteOrder) *PrintGetContextReply {
 	v := new(PrintGetContextReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Context = xgb.Get32(buf[b:])
+	v.Context = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -946,9 +1248,18 @@ func printGetContextReply(buf []byte) *PrintGetContextReply {
 // Write request to wire for PrintGetContext
 // printGetContextRequest writes a PrintGetContext request to a byte slice.
 func printGetContextRequest(c *xgb.Conn) []byte {
+	return AppendPrintGetContextRequest(nil, c)
+}
+
+// AppendPrintGetContextRequest appends a PrintGetContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintGetContextRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -958,12 +1269,34 @@ func printGetContextRequest(c *xgb.Conn) []byte {
 	buf[b] = 4 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// PrintGetContextRequest represents the data of a PrintGetContext request, as it is read when tracing a connection.
+type PrintGetContextRequest struct {
+}
+
+// printGetContextRequestRead reads a byte slice in the byte order 'order' into a PrintGetContextRequest value.
+func printGetContextRequestRead(buf []byte, order xgb.ByteOrder) *PrintGetContextRequest {
+	v := new(PrintGetContextRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][4] = xgb.RequestInfo{
+		Name: "PrintGetContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printGetContextRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printGetContextReply(buf, order)
+		},
+	}
+}
+
 // PrintGetDocumentDataCookie is a cookie used only for PrintGetDocumentData requests.
 type PrintGetDocumentDataCookie struct {
 	*xgb.Cookie
@@ -977,7 +1310,7 @@ func PrintGetDocumentData(c *xgb.Conn, Context Pcontext, MaxBytes uint32) PrintG
 	if _, ok := c.Extensions["XpExtension"]; !ok {
 		panic("Cannot issue request 'PrintGetDocumentData' using the uninitialized extension 'XpExtension'. xprint.Init(connObj) must be called first.")
 	}
-	cookie := c.NewCookie(true, true)
+	cookie := c.NewMultiCookie(true, printGetDocumentDataLastReply)
 	c.NewRequest(printGetDocumentDataRequest(c, Context, MaxBytes), cookie)
 	return PrintGetDocumentDataCookie{cookie}
 }
@@ -990,7 +1323,7 @@ func PrintGetDocumentDataUnchecked(c *xgb.Conn, Context Pcontext, MaxBytes uint3
 	if _, ok := c.Extensions["XpExtension"]; !ok {
 		panic("Cannot issue request 'PrintGetDocumentData' using the uninitialized extension 'XpExtension'. xprint.Init(connObj) must be called first.")
 	}
-	cookie := c.NewCookie(false, true)
+	cookie := c.NewMultiCookie(false, printGetDocumentDataLastReply)
 	c.NewRequest(printGetDocumentDataRequest(c, Context, MaxBytes), cookie)
 	return PrintGetDocumentDataCookie{cookie}
 }
@@ -1007,7 +1340,8 @@ type PrintGetDocumentDataReply struct {
 	Data []byte // size: xgb.Pad((int(DataLen) * 1))
 }
 
-// Reply blocks and returns the reply data for a PrintGetDocumentData request.
+// Reply blocks and returns the next reply for a PrintGetDocumentData request.
+// Once the last reply has been returned, Reply returns xgb.ErrNoMoreReplies.
 func (cook PrintGetDocumentDataCookie) Reply() (*PrintGetDocumentDataReply, error) {
 	buf, err := cook.Cookie.Reply()
 	if err != nil {
@@ -1016,29 +1350,41 @@ func (cook PrintGetDocumentDataCookie) Reply() (*PrintGetDocumentDataReply, erro
 	if buf == nil {
 		return nil, nil
 	}
-	return printGetDocumentDataReply(buf), nil
+	return printGetDocumentDataReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the next reply for a PrintGetDocumentData request arrives.
+func (cook PrintGetDocumentDataCookie) ReplyContext(ctx context.Context) (*PrintGetDocumentDataReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return printGetDocumentDataReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// printGetDocumentDataReply reads a byte slice into a PrintGetDocumentDataReply value.
-func printGetDocumentDataReply(buf []byte) *PrintGetDocumentDataReply {
+// printGetDocumentDataReply reads a byte slice in the byte order 'order' into a PrintGetDocumentDataReply value.
+func printGetDocumentDataReply(buf []byte, order xgb.ByteOrder) *PrintGetDocumentDataReply {
 	v := new(PrintGetDocumentDataReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.StatusCode = xgb.Get32(buf[b:])
+	v.StatusCode = order.Get32(buf[b:])
 	b += 4
 
-	v.FinishedFlag = xgb.Get32(buf[b:])
+	v.FinishedFlag = order.Get32(buf[b:])
 	b += 4
 
-	v.DataLen = xgb.Get32(buf[b:])
+	v.DataLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 12 // padding
@@ -1050,12 +1396,26 @@ func printGetDocumentDataReply(buf []byte) *PrintGetDocumentDataReply {
 	return v
 }
 
+// printGetDocumentDataLastReply reports whether a byte slice holds the last reply to a PrintGetDocumentData request.
+func printGetDocumentDataLastReply(buf []byte) bool {
+	return xgb.Get32(buf[12:]) != 0
+}
+
 // Write request to wire for PrintGetDocumentData
 // printGetDocumentDataRequest writes a PrintGetDocumentData request to a byte slice.
 func printGetDocumentDataRequest(c *xgb.Conn, Context Pcontext, MaxBytes uint32) []byte {
+	return AppendPrintGetDocumentDataRequest(nil, c, Context, MaxBytes)
+}
+
+// AppendPrintGetDocumentDataRequest appends a PrintGetDocumentData request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintGetDocumentDataRequest(buf []byte, c *xgb.Conn, Context Pcontext, MaxBytes uint32) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -1065,18 +1425,50 @@ func printGetDocumentDataRequest(c *xgb.Conn, Context Pcontext, MaxBytes uint32)
 	buf[b] = 12 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
-	xgb.Put32(buf[b:], MaxBytes)
+	order.Put32(buf[b:], MaxBytes)
 	b += 4
 
 	return buf
 }
 
+// PrintGetDocumentDataRequest represents the data of a PrintGetDocumentData request, as it is read when tracing a connection.
+type PrintGetDocumentDataRequest struct {
+	Context  Pcontext
+	MaxBytes uint32
+}
+
+// printGetDocumentDataRequestRead reads a byte slice in the byte order 'order' into a PrintGetDocumentDataRequest value.
+func printGetDocumentDataRequestRead(buf []byte, order xgb.ByteOrder) *PrintGetDocumentDataRequest {
+	v := new(PrintGetDocumentDataRequest)
+	b := 4 // skip request header
+
+	v.Context = Pcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.MaxBytes = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][12] = xgb.RequestInfo{
+		Name: "PrintGetDocumentData",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printGetDocumentDataRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printGetDocumentDataReply(buf, order)
+		},
+	}
+}
+
 // PrintGetImageResolutionCookie is a cookie used only for PrintGetImageResolution requests.
 type PrintGetImageResolutionCookie struct {
 	*xgb.Cookie
@@ -1125,23 +1517,35 @@ func (cook PrintGetImageResolutionCookie) Reply() (*PrintGetImageResolutionReply
 	if buf == nil {
 		return nil, nil
 	}
-	return printGetImageResolutionReply(buf), nil
+	return printGetImageResolutionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a PrintGetImageResolution request arrives.
+func (cook PrintGetImageResolutionCookie) ReplyContext(ctx context.Context) (*PrintGetImageResolutionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return printGetImageResolutionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// printGetImageResolutionReply reads a byte slice into a PrintGetImageResolutionReply value.
-func printGetImageResolutionReply(buf []byte) *PrintGetImageResolutionReply {
+// printGetImageResolutionReply reads a byte slice in the byte order 'order' into a PrintGetImageResolutionReply value.
+func printGetImageResolutionReply(buf []byte, order xgb.ByteOrder) *PrintGetImageResolutionReply {
 	v := new(PrintGetImageResolutionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ImageResolution = xgb.Get16(buf[b:])
+	v.ImageResolution = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -1150,9 +1554,18 @@ func printGetImageResolutionReply(buf []byte) *PrintGetImageResolutionReply {
 // Write request to wire for PrintGetImageResolution
 // printGetImageResolutionRequest writes a PrintGetImageResolution request to a byte slice.
 func printGetImageResolutionRequest(c *xgb.Conn, Context Pcontext) []byte {
+	return AppendPrintGetImageResolutionRequest(nil, c, Context)
+}
+
+// AppendPrintGetImageResolutionRequest appends a PrintGetImageResolution request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintGetImageResolutionRequest(buf []byte, c *xgb.Conn, Context Pcontext) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -1162,15 +1575,43 @@ func printGetImageResolutionRequest(c *xgb.Conn, Context Pcontext) []byte {
 	buf[b] = 24 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
 	return buf
 }
 
+// PrintGetImageResolutionRequest represents the data of a PrintGetImageResolution request, as it is read when tracing a connection.
+type PrintGetImageResolutionRequest struct {
+	Context Pcontext
+}
+
+// printGetImageResolutionRequestRead reads a byte slice in the byte order 'order' into a PrintGetImageResolutionRequest value.
+func printGetImageResolutionRequestRead(buf []byte, order xgb.ByteOrder) *PrintGetImageResolutionRequest {
+	v := new(PrintGetImageResolutionRequest)
+	b := 4 // skip request header
+
+	v.Context = Pcontext(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][24] = xgb.RequestInfo{
+		Name: "PrintGetImageResolution",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printGetImageResolutionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printGetImageResolutionReply(buf, order)
+		},
+	}
+}
+
 // PrintGetOneAttributesCookie is a cookie used only for PrintGetOneAttributes requests.
 type PrintGetOneAttributesCookie struct {
 	*xgb.Cookie
@@ -1221,23 +1662,35 @@ func (cook PrintGetOneAttributesCookie) Reply() (*PrintGetOneAttributesReply, er
 	if buf == nil {
 		return nil, nil
 	}
-	return printGetOneAttributesReply(buf), nil
+	return printGetOneAttributesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a PrintGetOneAttributes request arrives.
+func (cook PrintGetOneAttributesCookie) ReplyContext(ctx context.Context) (*PrintGetOneAttributesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return printGetOneAttributesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// printGetOneAttributesReply reads a byte slice into a PrintGetOneAttributesReply value.
-func printGetOneAttributesReply(buf []byte) *PrintGetOneAttributesReply {
+// printGetOneAttributesReply reads a byte slice in the byte order 'order' into a PrintGetOneAttributesReply value.
+func printGetOneAttributesReply(buf []byte, order xgb.ByteOrder) *PrintGetOneAttributesReply {
 	v := new(PrintGetOneAttributesReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ValueLen = xgb.Get32(buf[b:])
+	v.ValueLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
@@ -1254,9 +1707,18 @@ func printGetOneAttributesReply(buf []byte) *PrintGetOneAttributesReply {
 // Write request to wire for PrintGetOneAttributes
 // printGetOneAttributesRequest writes a PrintGetOneAttributes request to a byte slice.
 func printGetOneAttributesRequest(c *xgb.Conn, Context Pcontext, NameLen uint32, Pool byte, Name []String8) []byte {
+	return AppendPrintGetOneAttributesRequest(nil, c, Context, NameLen, Pool, Name)
+}
+
+// AppendPrintGetOneAttributesRequest appends a PrintGetOneAttributes request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintGetOneAttributesRequest(buf []byte, c *xgb.Conn, Context Pcontext, NameLen uint32, Pool byte, Name []String8) []byte {
 	size := xgb.Pad((16 + xgb.Pad((int(NameLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -1266,13 +1728,13 @@ func printGetOneAttributesRequest(c *xgb.Conn, Context Pcontext, NameLen uint32,
 	buf[b] = 19 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
-	xgb.Put32(buf[b:], NameLen)
+	order.Put32(buf[b:], NameLen)
 	b += 4
 
 	buf[b] = Pool
@@ -1288,6 +1750,52 @@ func printGetOneAttributesRequest(c *xgb.Conn, Context Pcontext, NameLen uint32,
 	return buf
 }
 
+// PrintGetOneAttributesRequest represents the data of a PrintGetOneAttributes request, as it is read when tracing a connection.
+type PrintGetOneAttributesRequest struct {
+	Context Pcontext
+	NameLen uint32
+	Pool    byte
+	// padding: 3 bytes
+	Name []String8 // size: xgb.Pad((int(NameLen) * 1))
+}
+
+// printGetOneAttributesRequestRead reads a byte slice in the byte order 'order' into a PrintGetOneAttributesRequest value.
+func printGetOneAttributesRequestRead(buf []byte, order xgb.ByteOrder) *PrintGetOneAttributesRequest {
+	v := new(PrintGetOneAttributesRequest)
+	b := 4 // skip request header
+
+	v.Context = Pcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.NameLen = order.Get32(buf[b:])
+	b += 4
+
+	v.Pool = buf[b]
+	b += 1
+
+	b += 3 // padding
+
+	v.Name = make([]String8, v.NameLen)
+	for i := 0; i < int(v.NameLen); i++ {
+		v.Name[i] = String8(buf[b])
+		b += 1
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][19] = xgb.RequestInfo{
+		Name: "PrintGetOneAttributes",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printGetOneAttributesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printGetOneAttributesReply(buf, order)
+		},
+	}
+}
+
 // PrintGetPageDimensionsCookie is a cookie used only for PrintGetPageDimensions requests.
 type PrintGetPageDimensionsCookie struct {
 	*xgb.Cookie
@@ -1341,38 +1849,50 @@ func (cook PrintGetPageDimensionsCookie) Reply() (*PrintGetPageDimensionsReply,
 	if buf == nil {
 		return nil, nil
 	}
-	return printGetPageDimensionsReply(buf), nil
+	return printGetPageDimensionsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// printGetPageDimensionsReply reads a byte slice into a PrintGetPageDimensionsReply value.
-func printGetPageDimensionsReply(buf []byte) *PrintGetPageDimensionsReply {
-	v := new(PrintGetPageDimensionsReply)
-	b := 1 // skip reply determinant
-
-	b += 1 // padding
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a PrintGetPageDimensions request arrives.
+func (cook PrintGetPageDimensionsCookie) ReplyContext(ctx context.Context) (*PrintGetPageDimensionsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return printGetPageDimensionsReply(buf, cook.Cookie.ByteOrder()), nil
+}
 
-	v.Sequence = xgb.Get16(buf[b:])
+// printGetPageDimensionsReply reads a byte slice in the byte order 'order' into a PrintGetPageDimensionsReply value.
+func printGetPageDimensionsReply(buf []byte, order xgb.ByteOrder) *PrintGetPageDimensionsReply {
+	v := new(PrintGetPageDimensionsReply)
+	b := 1 // skip reply determinant
+
+	b += 1 // padding
+
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
-	v.OffsetX = xgb.Get16(buf[b:])
+	v.OffsetX = order.Get16(buf[b:])
 	b += 2
 
-	v.OffsetY = xgb.Get16(buf[b:])
+	v.OffsetY = order.Get16(buf[b:])
 	b += 2
 
-	v.ReproducibleWidth = xgb.Get16(buf[b:])
+	v.ReproducibleWidth = order.Get16(buf[b:])
 	b += 2
 
-	v.ReproducibleHeight = xgb.Get16(buf[b:])
+	v.ReproducibleHeight = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -1381,9 +1901,18 @@ func printGetPageDimensionsReply(buf []byte) *PrintGetPageDimensionsReply {
 // Write request to wire for PrintGetPageDimensions
 // printGetPageDimensionsRequest writes a PrintGetPageDimensions request to a byte slice.
 func printGetPageDimensionsRequest(c *xgb.Conn, Context Pcontext) []byte {
+	return AppendPrintGetPageDimensionsRequest(nil, c, Context)
+}
+
+// AppendPrintGetPageDimensionsRequest appends a PrintGetPageDimensions request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintGetPageDimensionsRequest(buf []byte, c *xgb.Conn, Context Pcontext) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -1393,15 +1922,43 @@ func printGetPageDimensionsRequest(c *xgb.Conn, Context Pcontext) []byte {
 	buf[b] = 21 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
 	return buf
 }
 
+// PrintGetPageDimensionsRequest represents the data of a PrintGetPageDimensions request, as it is read when tracing a connection.
+type PrintGetPageDimensionsRequest struct {
+	Context Pcontext
+}
+
+// printGetPageDimensionsRequestRead reads a byte slice in the byte order 'order' into a PrintGetPageDimensionsRequest value.
+func printGetPageDimensionsRequestRead(buf []byte, order xgb.ByteOrder) *PrintGetPageDimensionsRequest {
+	v := new(PrintGetPageDimensionsRequest)
+	b := 4 // skip request header
+
+	v.Context = Pcontext(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][21] = xgb.RequestInfo{
+		Name: "PrintGetPageDimensions",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printGetPageDimensionsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printGetPageDimensionsReply(buf, order)
+		},
+	}
+}
+
 // PrintGetPrinterListCookie is a cookie used only for PrintGetPrinterList requests.
 type PrintGetPrinterListCookie struct {
 	*xgb.Cookie
@@ -1452,29 +2009,41 @@ func (cook PrintGetPrinterListCookie) Reply() (*PrintGetPrinterListReply, error)
 	if buf == nil {
 		return nil, nil
 	}
-	return printGetPrinterListReply(buf), nil
+	return printGetPrinterListReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// printGetPrinterListReply reads a byte slice into a PrintGetPrinterListReply value.
-func printGetPrinterListReply(buf []byte) *PrintGetPrinterListReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a PrintGetPrinterList request arrives.
+func (cook PrintGetPrinterListCookie) ReplyContext(ctx context.Context) (*PrintGetPrinterListReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return printGetPrinterListReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// printGetPrinterListReply reads a byte slice in the byte order 'order' into a PrintGetPrinterListReply value.
+func printGetPrinterListReply(buf []byte, order xgb.ByteOrder) *PrintGetPrinterListReply {
 	v := new(PrintGetPrinterListReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ListCount = xgb.Get32(buf[b:])
+	v.ListCount = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
 
 	v.Printers = make([]Printer, v.ListCount)
-	b += PrinterReadList(buf[b:], v.Printers)
+	b += PrinterReadList(buf[b:], order, v.Printers)
 
 	return v
 }
@@ -1482,9 +2051,18 @@ func printGetPrinterListReply(buf []byte) *PrintGetPrinterListReply {
 // Write request to wire for PrintGetPrinterList
 // printGetPrinterListRequest writes a PrintGetPrinterList request to a byte slice.
 func printGetPrinterListRequest(c *xgb.Conn, PrinterNameLen uint32, LocaleLen uint32, PrinterName []String8, Locale []String8) []byte {
+	return AppendPrintGetPrinterListRequest(nil, c, PrinterNameLen, LocaleLen, PrinterName, Locale)
+}
+
+// AppendPrintGetPrinterListRequest appends a PrintGetPrinterList request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintGetPrinterListRequest(buf []byte, c *xgb.Conn, PrinterNameLen uint32, LocaleLen uint32, PrinterName []String8, Locale []String8) []byte {
 	size := xgb.Pad(((12 + xgb.Pad((int(PrinterNameLen) * 1))) + xgb.Pad((int(LocaleLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -1494,13 +2072,13 @@ func printGetPrinterListRequest(c *xgb.Conn, PrinterNameLen uint32, LocaleLen ui
 	buf[b] = 1 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], PrinterNameLen)
+	order.Put32(buf[b:], PrinterNameLen)
 	b += 4
 
-	xgb.Put32(buf[b:], LocaleLen)
+	order.Put32(buf[b:], LocaleLen)
 	b += 4
 
 	for i := 0; i < int(PrinterNameLen); i++ {
@@ -1516,6 +2094,52 @@ func printGetPrinterListRequest(c *xgb.Conn, PrinterNameLen uint32, LocaleLen ui
 	return buf
 }
 
+// PrintGetPrinterListRequest represents the data of a PrintGetPrinterList request, as it is read when tracing a connection.
+type PrintGetPrinterListRequest struct {
+	PrinterNameLen uint32
+	LocaleLen      uint32
+	PrinterName    []String8 // size: xgb.Pad((int(PrinterNameLen) * 1))
+	Locale         []String8 // size: xgb.Pad((int(LocaleLen) * 1))
+}
+
+// printGetPrinterListRequestRead reads a byte slice in the byte order 'order' into a PrintGetPrinterListRequest value.
+func printGetPrinterListRequestRead(buf []byte, order xgb.ByteOrder) *PrintGetPrinterListRequest {
+	v := new(PrintGetPrinterListRequest)
+	b := 4 // skip request header
+
+	v.PrinterNameLen = order.Get32(buf[b:])
+	b += 4
+
+	v.LocaleLen = order.Get32(buf[b:])
+	b += 4
+
+	v.PrinterName = make([]String8, v.PrinterNameLen)
+	for i := 0; i < int(v.PrinterNameLen); i++ {
+		v.PrinterName[i] = String8(buf[b])
+		b += 1
+	}
+
+	v.Locale = make([]String8, v.LocaleLen)
+	for i := 0; i < int(v.LocaleLen); i++ {
+		v.Locale[i] = String8(buf[b])
+		b += 1
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][1] = xgb.RequestInfo{
+		Name: "PrintGetPrinterList",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printGetPrinterListRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printGetPrinterListReply(buf, order)
+		},
+	}
+}
+
 // PrintGetScreenOfContextCookie is a cookie used only for PrintGetScreenOfContext requests.
 type PrintGetScreenOfContextCookie struct {
 	*xgb.Cookie
@@ -1564,23 +2188,35 @@ func (cook PrintGetScreenOfContextCookie) Reply() (*PrintGetScreenOfContextReply
 	if buf == nil {
 		return nil, nil
 	}
-	return printGetScreenOfContextReply(buf), nil
+	return printGetScreenOfContextReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a PrintGetScreenOfContext request arrives.
+func (cook PrintGetScreenOfContextCookie) ReplyContext(ctx context.Context) (*PrintGetScreenOfContextReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return printGetScreenOfContextReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// printGetScreenOfContextReply reads a byte slice into a PrintGetScreenOfContextReply value.
-func printGetScreenOfContextReply(buf []byte) *PrintGetScreenOfContextReply {
+// printGetScreenOfContextReply reads a byte slice in the byte order 'order' into a PrintGetScreenOfContextReply value.
+func printGetScreenOfContextReply(buf []byte, order xgb.ByteOrder) *PrintGetScreenOfContextReply {
 	v := new(PrintGetScreenOfContextReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Root = xproto.Window(xgb.Get32(buf[b:]))
+	v.Root = xproto.Window(order.Get32(buf[b:]))
 	b += 4
 
 	return v
@@ -1589,9 +2225,18 @@ func printGetScreenOfContextReply(buf []byte) *PrintGetScreenOfContextReply {
 // Write request to wire for PrintGetScreenOfContext
 // printGetScreenOfContextRequest writes a PrintGetScreenOfContext request to a byte slice.
 func printGetScreenOfContextRequest(c *xgb.Conn) []byte {
+	return AppendPrintGetScreenOfContextRequest(nil, c)
+}
+
+// AppendPrintGetScreenOfContextRequest appends a PrintGetScreenOfContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintGetScreenOfContextRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -1601,12 +2246,34 @@ func printGetScreenOfContextRequest(c *xgb.Conn) []byte {
 	buf[b] = 6 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// PrintGetScreenOfContextRequest represents the data of a PrintGetScreenOfContext request, as it is read when tracing a connection.
+type PrintGetScreenOfContextRequest struct {
+}
+
+// printGetScreenOfContextRequestRead reads a byte slice in the byte order 'order' into a PrintGetScreenOfContextRequest value.
+func printGetScreenOfContextRequestRead(buf []byte, order xgb.ByteOrder) *PrintGetScreenOfContextRequest {
+	v := new(PrintGetScreenOfContextRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][6] = xgb.RequestInfo{
+		Name: "PrintGetScreenOfContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printGetScreenOfContextRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printGetScreenOfContextReply(buf, order)
+		},
+	}
+}
+
 // PrintInputSelectedCookie is a cookie used only for PrintInputSelected requests.
 type PrintInputSelectedCookie struct {
 	*xgb.Cookie
@@ -1658,38 +2325,50 @@ func (cook PrintInputSelectedCookie) Reply() (*PrintInputSelectedReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return printInputSelectedReply(buf), nil
+	return printInputSelectedReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a PrintInputSelected request arrives.
+func (cook PrintInputSelectedCookie) ReplyContext(ctx context.Context) (*PrintInputSelectedReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return printInputSelectedReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// printInputSelectedReply reads a byte slice into a PrintInputSelectedReply value.
-func printInputSelectedReply(buf []byte) *PrintInputSelectedReply {
+// printInputSelectedReply reads a byte slice in the byte order 'order' into a PrintInputSelectedReply value.
+func printInputSelectedReply(buf []byte, order xgb.ByteOrder) *PrintInputSelectedReply {
 	v := new(PrintInputSelectedReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.EventMask = xgb.Get32(buf[b:])
+	v.EventMask = order.Get32(buf[b:])
 	b += 4
 
 	v.EventList = make([]uint32, xgb.PopCount(int(v.EventMask)))
 	for i := 0; i < xgb.PopCount(int(v.EventMask)); i++ {
-		v.EventList[i] = xgb.Get32(buf[b:])
+		v.EventList[i] = order.Get32(buf[b:])
 		b += 4
 	}
 	b = xgb.Pad(b)
 
-	v.AllEventsMask = xgb.Get32(buf[b:])
+	v.AllEventsMask = order.Get32(buf[b:])
 	b += 4
 
 	v.AllEventsList = make([]uint32, xgb.PopCount(int(v.AllEventsMask)))
 	for i := 0; i < xgb.PopCount(int(v.AllEventsMask)); i++ {
-		v.AllEventsList[i] = xgb.Get32(buf[b:])
+		v.AllEventsList[i] = order.Get32(buf[b:])
 		b += 4
 	}
 	b = xgb.Pad(b)
@@ -1700,9 +2379,18 @@ func printInputSelectedReply(buf []byte) *PrintInputSelectedReply {
 // Write request to wire for PrintInputSelected
 // printInputSelectedRequest writes a PrintInputSelected request to a byte slice.
 func printInputSelectedRequest(c *xgb.Conn, Context Pcontext) []byte {
+	return AppendPrintInputSelectedRequest(nil, c, Context)
+}
+
+// AppendPrintInputSelectedRequest appends a PrintInputSelected request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintInputSelectedRequest(buf []byte, c *xgb.Conn, Context Pcontext) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -1712,15 +2400,43 @@ func printInputSelectedRequest(c *xgb.Conn, Context Pcontext) []byte {
 	buf[b] = 16 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
 	return buf
 }
 
+// PrintInputSelectedRequest represents the data of a PrintInputSelected request, as it is read when tracing a connection.
+type PrintInputSelectedRequest struct {
+	Context Pcontext
+}
+
+// printInputSelectedRequestRead reads a byte slice in the byte order 'order' into a PrintInputSelectedRequest value.
+func printInputSelectedRequestRead(buf []byte, order xgb.ByteOrder) *PrintInputSelectedRequest {
+	v := new(PrintInputSelectedRequest)
+	b := 4 // skip request header
+
+	v.Context = Pcontext(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][16] = xgb.RequestInfo{
+		Name: "PrintInputSelected",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printInputSelectedRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printInputSelectedReply(buf, order)
+		},
+	}
+}
+
 // PrintPutDocumentDataCookie is a cookie used only for PrintPutDocumentData requests.
 type PrintPutDocumentDataCookie struct {
 	*xgb.Cookie
@@ -1758,12 +2474,26 @@ func (cook PrintPutDocumentDataCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PrintPutDocumentDataCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PrintPutDocumentData
 // printPutDocumentDataRequest writes a PrintPutDocumentData request to a byte slice.
 func printPutDocumentDataRequest(c *xgb.Conn, Drawable xproto.Drawable, LenData uint32, LenFmt uint16, LenOptions uint16, Data []byte, DocFormat []String8, Options []String8) []byte {
+	return AppendPrintPutDocumentDataRequest(nil, c, Drawable, LenData, LenFmt, LenOptions, Data, DocFormat, Options)
+}
+
+// AppendPrintPutDocumentDataRequest appends a PrintPutDocumentData request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintPutDocumentDataRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable, LenData uint32, LenFmt uint16, LenOptions uint16, Data []byte, DocFormat []String8, Options []String8) []byte {
 	size := xgb.Pad((((16 + xgb.Pad((int(LenData) * 1))) + xgb.Pad((len(DocFormat) * 1))) + xgb.Pad((len(Options) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -1773,19 +2503,19 @@ func printPutDocumentDataRequest(c *xgb.Conn, Drawable xproto.Drawable, LenData
 	buf[b] = 11 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], LenData)
+	order.Put32(buf[b:], LenData)
 	b += 4
 
-	xgb.Put16(buf[b:], LenFmt)
+	order.Put16(buf[b:], LenFmt)
 	b += 2
 
-	xgb.Put16(buf[b:], LenOptions)
+	order.Put16(buf[b:], LenOptions)
 	b += 2
 
 	copy(buf[b:], Data[:LenData])
@@ -1804,6 +2534,62 @@ func printPutDocumentDataRequest(c *xgb.Conn, Drawable xproto.Drawable, LenData
 	return buf
 }
 
+// PrintPutDocumentDataRequest represents the data of a PrintPutDocumentData request, as it is read when tracing a connection.
+type PrintPutDocumentDataRequest struct {
+	Drawable   xproto.Drawable
+	LenData    uint32
+	LenFmt     uint16
+	LenOptions uint16
+	Data       []byte    // size: xgb.Pad((int(LenData) * 1))
+	DocFormat  []String8 // size: xgb.Pad((len(DocFormat) * 1))
+	Options    []String8 // size: xgb.Pad((len(Options) * 1))
+}
+
+// printPutDocumentDataRequestRead reads a byte slice in the byte order 'order' into a PrintPutDocumentDataRequest value.
+func printPutDocumentDataRequestRead(buf []byte, order xgb.ByteOrder) *PrintPutDocumentDataRequest {
+	v := new(PrintPutDocumentDataRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.LenData = order.Get32(buf[b:])
+	b += 4
+
+	v.LenFmt = order.Get16(buf[b:])
+	b += 2
+
+	v.LenOptions = order.Get16(buf[b:])
+	b += 2
+
+	v.Data = make([]byte, v.LenData)
+	copy(v.Data[:v.LenData], buf[b:])
+	b += int(v.LenData)
+
+	v.DocFormat = make([]String8, (len(buf)-b)/1)
+	for i := 0; i < int((len(buf)-b)/1); i++ {
+		v.DocFormat[i] = String8(buf[b])
+		b += 1
+	}
+
+	v.Options = make([]String8, (len(buf)-b)/1)
+	for i := 0; i < int((len(buf)-b)/1); i++ {
+		v.Options[i] = String8(buf[b])
+		b += 1
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][11] = xgb.RequestInfo{
+		Name: "PrintPutDocumentData",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printPutDocumentDataRequestRead(buf, order)
+		},
+	}
+}
+
 // PrintQueryScreensCookie is a cookie used only for PrintQueryScreens requests.
 type PrintQueryScreensCookie struct {
 	*xgb.Cookie
@@ -1854,30 +2640,42 @@ func (cook PrintQueryScreensCookie) Reply() (*PrintQueryScreensReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return printQueryScreensReply(buf), nil
+	return printQueryScreensReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// printQueryScreensReply reads a byte slice into a PrintQueryScreensReply value.
-func printQueryScreensReply(buf []byte) *PrintQueryScreensReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a PrintQueryScreens request arrives.
+func (cook PrintQueryScreensCookie) ReplyContext(ctx context.Context) (*PrintQueryScreensReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return printQueryScreensReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// printQueryScreensReply reads a byte slice in the byte order 'order' into a PrintQueryScreensReply value.
+func printQueryScreensReply(buf []byte, order xgb.ByteOrder) *PrintQueryScreensReply {
 	v := new(PrintQueryScreensReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ListCount = xgb.Get32(buf[b:])
+	v.ListCount = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
 
 	v.Roots = make([]xproto.Window, v.ListCount)
 	for i := 0; i < int(v.ListCount); i++ {
-		v.Roots[i] = xproto.Window(xgb.Get32(buf[b:]))
+		v.Roots[i] = xproto.Window(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -1887,9 +2685,18 @@ func printQueryScreensReply(buf []byte) *PrintQueryScreensReply {
 // Write request to wire for PrintQueryScreens
 // printQueryScreensRequest writes a PrintQueryScreens request to a byte slice.
 func printQueryScreensRequest(c *xgb.Conn) []byte {
+	return AppendPrintQueryScreensRequest(nil, c)
+}
+
+// AppendPrintQueryScreensRequest appends a PrintQueryScreens request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintQueryScreensRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -1899,12 +2706,34 @@ func printQueryScreensRequest(c *xgb.Conn) []byte {
 	buf[b] = 22 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// PrintQueryScreensRequest represents the data of a PrintQueryScreens request, as it is read when tracing a connection.
+type PrintQueryScreensRequest struct {
+}
+
+// printQueryScreensRequestRead reads a byte slice in the byte order 'order' into a PrintQueryScreensRequest value.
+func printQueryScreensRequestRead(buf []byte, order xgb.ByteOrder) *PrintQueryScreensRequest {
+	v := new(PrintQueryScreensRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][22] = xgb.RequestInfo{
+		Name: "PrintQueryScreens",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printQueryScreensRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printQueryScreensReply(buf, order)
+		},
+	}
+}
+
 // PrintQueryVersionCookie is a cookie used only for PrintQueryVersion requests.
 type PrintQueryVersionCookie struct {
 	*xgb.Cookie
@@ -1954,26 +2783,38 @@ func (cook PrintQueryVersionCookie) Reply() (*PrintQueryVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return printQueryVersionReply(buf), nil
+	return printQueryVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// printQueryVersionReply reads a byte slice into a PrintQueryVersionReply value.
-func printQueryVersionReply(buf []byte) *PrintQueryVersionReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a PrintQueryVersion request arrives.
+func (cook PrintQueryVersionCookie) ReplyContext(ctx context.Context) (*PrintQueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return printQueryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// printQueryVersionReply reads a byte slice in the byte order 'order' into a PrintQueryVersionReply value.
+func printQueryVersionReply(buf []byte, order xgb.ByteOrder) *PrintQueryVersionReply {
 	v := new(PrintQueryVersionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.MajorVersion = xgb.Get16(buf[b:])
+	v.MajorVersion = order.Get16(buf[b:])
 	b += 2
 
-	v.MinorVersion = xgb.Get16(buf[b:])
+	v.MinorVersion = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -1982,9 +2823,18 @@ func printQueryVersionReply(buf []byte) *PrintQueryVersionReply {
 // Write request to wire for PrintQueryVersion
 // printQueryVersionRequest writes a PrintQueryVersion request to a byte slice.
 func printQueryVersionRequest(c *xgb.Conn) []byte {
+	return AppendPrintQueryVersionRequest(nil, c)
+}
+
+// AppendPrintQueryVersionRequest appends a PrintQueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintQueryVersionRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -1994,12 +2844,34 @@ func printQueryVersionRequest(c *xgb.Conn) []byte {
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// PrintQueryVersionRequest represents the data of a PrintQueryVersion request, as it is read when tracing a connection.
+type PrintQueryVersionRequest struct {
+}
+
+// printQueryVersionRequestRead reads a byte slice in the byte order 'order' into a PrintQueryVersionRequest value.
+func printQueryVersionRequestRead(buf []byte, order xgb.ByteOrder) *PrintQueryVersionRequest {
+	v := new(PrintQueryVersionRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][0] = xgb.RequestInfo{
+		Name: "PrintQueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printQueryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printQueryVersionReply(buf, order)
+		},
+	}
+}
+
 // PrintRehashPrinterListCookie is a cookie used only for PrintRehashPrinterList requests.
 type PrintRehashPrinterListCookie struct {
 	*xgb.Cookie
@@ -2037,12 +2909,26 @@ func (cook PrintRehashPrinterListCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PrintRehashPrinterListCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PrintRehashPrinterList
 // printRehashPrinterListRequest writes a PrintRehashPrinterList request to a byte slice.
 func printRehashPrinterListRequest(c *xgb.Conn) []byte {
+	return AppendPrintRehashPrinterListRequest(nil, c)
+}
+
+// AppendPrintRehashPrinterListRequest appends a PrintRehashPrinterList request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintRehashPrinterListRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -2052,12 +2938,31 @@ func printRehashPrinterListRequest(c *xgb.Conn) []byte {
 	buf[b] = 20 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// PrintRehashPrinterListRequest represents the data of a PrintRehashPrinterList request, as it is read when tracing a connection.
+type PrintRehashPrinterListRequest struct {
+}
+
+// printRehashPrinterListRequestRead reads a byte slice in the byte order 'order' into a PrintRehashPrinterListRequest value.
+func printRehashPrinterListRequestRead(buf []byte, order xgb.ByteOrder) *PrintRehashPrinterListRequest {
+	v := new(PrintRehashPrinterListRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][20] = xgb.RequestInfo{
+		Name: "PrintRehashPrinterList",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printRehashPrinterListRequestRead(buf, order)
+		},
+	}
+}
+
 // PrintSelectInputCookie is a cookie used only for PrintSelectInput requests.
 type PrintSelectInputCookie struct {
 	*xgb.Cookie
@@ -2095,12 +3000,26 @@ func (cook PrintSelectInputCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PrintSelectInputCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PrintSelectInput
 // printSelectInputRequest writes a PrintSelectInput request to a byte slice.
 func printSelectInputRequest(c *xgb.Conn, Context Pcontext, EventMask uint32, EventList []uint32) []byte {
+	return AppendPrintSelectInputRequest(nil, c, Context, EventMask, EventList)
+}
+
+// AppendPrintSelectInputRequest appends a PrintSelectInput request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintSelectInputRequest(buf []byte, c *xgb.Conn, Context Pcontext, EventMask uint32, EventList []uint32) []byte {
 	size := xgb.Pad((8 + (4 + xgb.Pad((4 * xgb.PopCount(int(EventMask)))))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -2110,16 +3029,16 @@ func printSelectInputRequest(c *xgb.Conn, Context Pcontext, EventMask uint32, Ev
 	buf[b] = 15 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
-	xgb.Put32(buf[b:], EventMask)
+	order.Put32(buf[b:], EventMask)
 	b += 4
 	for i := 0; i < xgb.PopCount(int(EventMask)); i++ {
-		xgb.Put32(buf[b:], EventList[i])
+		order.Put32(buf[b:], EventList[i])
 		b += 4
 	}
 	b = xgb.Pad(b)
@@ -2127,6 +3046,43 @@ func printSelectInputRequest(c *xgb.Conn, Context Pcontext, EventMask uint32, Ev
 	return buf
 }
 
+// PrintSelectInputRequest represents the data of a PrintSelectInput request, as it is read when tracing a connection.
+type PrintSelectInputRequest struct {
+	Context   Pcontext
+	EventMask uint32
+	EventList []uint32
+}
+
+// printSelectInputRequestRead reads a byte slice in the byte order 'order' into a PrintSelectInputRequest value.
+func printSelectInputRequestRead(buf []byte, order xgb.ByteOrder) *PrintSelectInputRequest {
+	v := new(PrintSelectInputRequest)
+	b := 4 // skip request header
+
+	v.Context = Pcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.EventMask = order.Get32(buf[b:])
+	b += 4
+
+	v.EventList = make([]uint32, xgb.PopCount(int(v.EventMask)))
+	for i := 0; i < xgb.PopCount(int(v.EventMask)); i++ {
+		v.EventList[i] = order.Get32(buf[b:])
+		b += 4
+	}
+	b = xgb.Pad(b)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][15] = xgb.RequestInfo{
+		Name: "PrintSelectInput",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printSelectInputRequestRead(buf, order)
+		},
+	}
+}
+
 // PrintSetAttributesCookie is a cookie used only for PrintSetAttributes requests.
 type PrintSetAttributesCookie struct {
 	*xgb.Cookie
@@ -2164,12 +3120,26 @@ func (cook PrintSetAttributesCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PrintSetAttributesCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PrintSetAttributes
 // printSetAttributesRequest writes a PrintSetAttributes request to a byte slice.
 func printSetAttributesRequest(c *xgb.Conn, Context Pcontext, StringLen uint32, Pool byte, Rule byte, Attributes []String8) []byte {
+	return AppendPrintSetAttributesRequest(nil, c, Context, StringLen, Pool, Rule, Attributes)
+}
+
+// AppendPrintSetAttributesRequest appends a PrintSetAttributes request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintSetAttributesRequest(buf []byte, c *xgb.Conn, Context Pcontext, StringLen uint32, Pool byte, Rule byte, Attributes []String8) []byte {
 	size := xgb.Pad((16 + xgb.Pad((len(Attributes) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -2179,13 +3149,13 @@ func printSetAttributesRequest(c *xgb.Conn, Context Pcontext, StringLen uint32,
 	buf[b] = 18 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
-	xgb.Put32(buf[b:], StringLen)
+	order.Put32(buf[b:], StringLen)
 	b += 4
 
 	buf[b] = Pool
@@ -2204,6 +3174,53 @@ func printSetAttributesRequest(c *xgb.Conn, Context Pcontext, StringLen uint32,
 	return buf
 }
 
+// PrintSetAttributesRequest represents the data of a PrintSetAttributes request, as it is read when tracing a connection.
+type PrintSetAttributesRequest struct {
+	Context   Pcontext
+	StringLen uint32
+	Pool      byte
+	Rule      byte
+	// padding: 2 bytes
+	Attributes []String8 // size: xgb.Pad((len(Attributes) * 1))
+}
+
+// printSetAttributesRequestRead reads a byte slice in the byte order 'order' into a PrintSetAttributesRequest value.
+func printSetAttributesRequestRead(buf []byte, order xgb.ByteOrder) *PrintSetAttributesRequest {
+	v := new(PrintSetAttributesRequest)
+	b := 4 // skip request header
+
+	v.Context = Pcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.StringLen = order.Get32(buf[b:])
+	b += 4
+
+	v.Pool = buf[b]
+	b += 1
+
+	v.Rule = buf[b]
+	b += 1
+
+	b += 2 // padding
+
+	v.Attributes = make([]String8, (len(buf)-b)/1)
+	for i := 0; i < int((len(buf)-b)/1); i++ {
+		v.Attributes[i] = String8(buf[b])
+		b += 1
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][18] = xgb.RequestInfo{
+		Name: "PrintSetAttributes",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printSetAttributesRequestRead(buf, order)
+		},
+	}
+}
+
 // PrintSetContextCookie is a cookie used only for PrintSetContext requests.
 type PrintSetContextCookie struct {
 	*xgb.Cookie
@@ -2241,12 +3258,26 @@ func (cook PrintSetContextCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PrintSetContextCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PrintSetContext
 // printSetContextRequest writes a PrintSetContext request to a byte slice.
 func printSetContextRequest(c *xgb.Conn, Context uint32) []byte {
+	return AppendPrintSetContextRequest(nil, c, Context)
+}
+
+// AppendPrintSetContextRequest appends a PrintSetContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintSetContextRequest(buf []byte, c *xgb.Conn, Context uint32) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -2256,15 +3287,40 @@ func printSetContextRequest(c *xgb.Conn, Context uint32) []byte {
 	buf[b] = 3 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Context)
+	order.Put32(buf[b:], Context)
 	b += 4
 
 	return buf
 }
 
+// PrintSetContextRequest represents the data of a PrintSetContext request, as it is read when tracing a connection.
+type PrintSetContextRequest struct {
+	Context uint32
+}
+
+// printSetContextRequestRead reads a byte slice in the byte order 'order' into a PrintSetContextRequest value.
+func printSetContextRequestRead(buf []byte, order xgb.ByteOrder) *PrintSetContextRequest {
+	v := new(PrintSetContextRequest)
+	b := 4 // skip request header
+
+	v.Context = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][3] = xgb.RequestInfo{
+		Name: "PrintSetContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printSetContextRequestRead(buf, order)
+		},
+	}
+}
+
 // PrintSetImageResolutionCookie is a cookie used only for PrintSetImageResolution requests.
 type PrintSetImageResolutionCookie struct {
 	*xgb.Cookie
@@ -2313,11 +3369,23 @@ func (cook PrintSetImageResolutionCookie) Reply() (*PrintSetImageResolutionReply
 	if buf == nil {
 		return nil, nil
 	}
-	return printSetImageResolutionReply(buf), nil
+	return printSetImageResolutionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// printSetImageResolutionReply reads a byte slice into a PrintSetImageResolutionReply value.
-func printSetImageResolutionReply(buf []byte) *PrintSetImageResolutionReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a PrintSetImageResolution request arrives.
+func (cook PrintSetImageResolutionCookie) ReplyContext(ctx context.Context) (*PrintSetImageResolutionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return printSetImageResolutionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// printSetImageResolutionReply reads a byte slice in the byte order 'order' into a PrintSetImageResolutionReply value.
+func printSetImageResolutionReply(buf []byte, order xgb.ByteOrder) *PrintSetImageResolutionReply {
 	v := new(PrintSetImageResolutionReply)
 	b := 1 // skip reply determinant
 
@@ -2328,13 +3396,13 @@ func printSetImageResolutionReply(buf []byte) *PrintSetImageResolutionReply {
 	}
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.PreviousResolutions = xgb.Get16(buf[b:])
+	v.PreviousResolutions = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -2343,9 +3411,18 @@ func printSetImageResolutionReply(buf []byte) *PrintSetImageResolutionReply {
 // Write request to wire for PrintSetImageResolution
 // printSetImageResolutionRequest writes a PrintSetImageResolution request to a byte slice.
 func printSetImageResolutionRequest(c *xgb.Conn, Context Pcontext, ImageResolution uint16) []byte {
+	return AppendPrintSetImageResolutionRequest(nil, c, Context, ImageResolution)
+}
+
+// AppendPrintSetImageResolutionRequest appends a PrintSetImageResolution request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintSetImageResolutionRequest(buf []byte, c *xgb.Conn, Context Pcontext, ImageResolution uint16) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -2355,18 +3432,50 @@ func printSetImageResolutionRequest(c *xgb.Conn, Context Pcontext, ImageResoluti
 	buf[b] = 23 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
-	xgb.Put16(buf[b:], ImageResolution)
+	order.Put16(buf[b:], ImageResolution)
 	b += 2
 
 	return buf
 }
 
+// PrintSetImageResolutionRequest represents the data of a PrintSetImageResolution request, as it is read when tracing a connection.
+type PrintSetImageResolutionRequest struct {
+	Context         Pcontext
+	ImageResolution uint16
+}
+
+// printSetImageResolutionRequestRead reads a byte slice in the byte order 'order' into a PrintSetImageResolutionRequest value.
+func printSetImageResolutionRequestRead(buf []byte, order xgb.ByteOrder) *PrintSetImageResolutionRequest {
+	v := new(PrintSetImageResolutionRequest)
+	b := 4 // skip request header
+
+	v.Context = Pcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.ImageResolution = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][23] = xgb.RequestInfo{
+		Name: "PrintSetImageResolution",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printSetImageResolutionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printSetImageResolutionReply(buf, order)
+		},
+	}
+}
+
 // PrintStartDocCookie is a cookie used only for PrintStartDoc requests.
 type PrintStartDocCookie struct {
 	*xgb.Cookie
@@ -2404,12 +3513,26 @@ func (cook PrintStartDocCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PrintStartDocCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PrintStartDoc
 // printStartDocRequest writes a PrintStartDoc request to a byte slice.
 func printStartDocRequest(c *xgb.Conn, DriverMode byte) []byte {
+	return AppendPrintStartDocRequest(nil, c, DriverMode)
+}
+
+// AppendPrintStartDocRequest appends a PrintStartDoc request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintStartDocRequest(buf []byte, c *xgb.Conn, DriverMode byte) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -2419,7 +3542,7 @@ func printStartDocRequest(c *xgb.Conn, DriverMode byte) []byte {
 	buf[b] = 9 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	buf[b] = DriverMode
@@ -2428,6 +3551,31 @@ func printStartDocRequest(c *xgb.Conn, DriverMode byte) []byte {
 	return buf
 }
 
+// PrintStartDocRequest represents the data of a PrintStartDoc request, as it is read when tracing a connection.
+type PrintStartDocRequest struct {
+	DriverMode byte
+}
+
+// printStartDocRequestRead reads a byte slice in the byte order 'order' into a PrintStartDocRequest value.
+func printStartDocRequestRead(buf []byte, order xgb.ByteOrder) *PrintStartDocRequest {
+	v := new(PrintStartDocRequest)
+	b := 4 // skip request header
+
+	v.DriverMode = buf[b]
+	b += 1
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][9] = xgb.RequestInfo{
+		Name: "PrintStartDoc",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printStartDocRequestRead(buf, order)
+		},
+	}
+}
+
 // PrintStartJobCookie is a cookie used only for PrintStartJob requests.
 type PrintStartJobCookie struct {
 	*xgb.Cookie
@@ -2465,12 +3613,26 @@ func (cook PrintStartJobCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PrintStartJobCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PrintStartJob
 // printStartJobRequest writes a PrintStartJob request to a byte slice.
 func printStartJobRequest(c *xgb.Conn, OutputMode byte) []byte {
+	return AppendPrintStartJobRequest(nil, c, OutputMode)
+}
+
+// AppendPrintStartJobRequest appends a PrintStartJob request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintStartJobRequest(buf []byte, c *xgb.Conn, OutputMode byte) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -2480,7 +3642,7 @@ func printStartJobRequest(c *xgb.Conn, OutputMode byte) []byte {
 	buf[b] = 7 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	buf[b] = OutputMode
@@ -2489,6 +3651,31 @@ func printStartJobRequest(c *xgb.Conn, OutputMode byte) []byte {
 	return buf
 }
 
+// PrintStartJobRequest represents the data of a PrintStartJob request, as it is read when tracing a connection.
+type PrintStartJobRequest struct {
+	OutputMode byte
+}
+
+// printStartJobRequestRead reads a byte slice in the byte order 'order' into a PrintStartJobRequest value.
+func printStartJobRequestRead(buf []byte, order xgb.ByteOrder) *PrintStartJobRequest {
+	v := new(PrintStartJobRequest)
+	b := 4 // skip request header
+
+	v.OutputMode = buf[b]
+	b += 1
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][7] = xgb.RequestInfo{
+		Name: "PrintStartJob",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printStartJobRequestRead(buf, order)
+		},
+	}
+}
+
 // PrintStartPageCookie is a cookie used only for PrintStartPage requests.
 type PrintStartPageCookie struct {
 	*xgb.Cookie
@@ -2526,12 +3713,26 @@ func (cook PrintStartPageCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PrintStartPageCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PrintStartPage
 // printStartPageRequest writes a PrintStartPage request to a byte slice.
 func printStartPageRequest(c *xgb.Conn, Window xproto.Window) []byte {
+	return AppendPrintStartPageRequest(nil, c, Window)
+}
+
+// AppendPrintStartPageRequest appends a PrintStartPage request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPrintStartPageRequest(buf []byte, c *xgb.Conn, Window xproto.Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XpExtension"]
@@ -2541,11 +3742,36 @@ func printStartPageRequest(c *xgb.Conn, Window xproto.Window) []byte {
 	buf[b] = 13 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
+
+// PrintStartPageRequest represents the data of a PrintStartPage request, as it is read when tracing a connection.
+type PrintStartPageRequest struct {
+	Window xproto.Window
+}
+
+// printStartPageRequestRead reads a byte slice in the byte order 'order' into a PrintStartPageRequest value.
+func printStartPageRequestRead(buf []byte, order xgb.ByteOrder) *PrintStartPageRequest {
+	v := new(PrintStartPageRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XpExtension"][13] = xgb.RequestInfo{
+		Name: "PrintStartPage",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return printStartPageRequestRead(buf, order)
+		},
+	}
+}
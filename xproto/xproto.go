@@ -4,6 +4,8 @@ package xproto
 // This file is automatically generated from xproto.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 )
 
@@ -11,7 +13,7 @@ import (
 // connecting into a SetupInfo struct.
 func Setup(c *xgb.Conn) *SetupInfo {
 	setup := new(SetupInfo)
-	SetupInfoRead(c.SetupBytes, setup)
+	SetupInfoRead(c.SetupBytes, c.ByteOrder(), setup)
 	return setup
 }
 
@@ -25,9 +27,9 @@ const BadAccess = 10
 
 type AccessError RequestError
 
-// AccessErrorNew constructs a AccessError value that implements xgb.Error from a byte slice.
-func AccessErrorNew(buf []byte) xgb.Error {
-	v := AccessError(RequestErrorNew(buf).(RequestError))
+// AccessErrorNew constructs a AccessError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func AccessErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := AccessError(RequestErrorNew(buf, order).(RequestError))
 	v.NiceName = "Access"
 	return v
 }
@@ -58,19 +60,32 @@ func init() {
 	xgb.NewErrorFuncs[10] = AccessErrorNew
 }
 
+type AccessControl byte
+
 const (
-	AccessControlDisable = 0
-	AccessControlEnable  = 1
+	AccessControlDisable AccessControl = 0
+	AccessControlEnable  AccessControl = 1
 )
 
+// String returns the name of the AccessControl item with this value.
+func (v AccessControl) String() string {
+	switch v {
+	case AccessControlDisable:
+		return "Disable"
+	case AccessControlEnable:
+		return "Enable"
+	}
+	return xgb.Sprintf("AccessControl(%d)", byte(v))
+}
+
 // BadAlloc is the error number for a BadAlloc.
 const BadAlloc = 11
 
 type AllocError RequestError
 
-// AllocErrorNew constructs a AllocError value that implements xgb.Error from a byte slice.
-func AllocErrorNew(buf []byte) xgb.Error {
-	v := AllocError(RequestErrorNew(buf).(RequestError))
+// AllocErrorNew constructs a AllocError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func AllocErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := AllocError(RequestErrorNew(buf, order).(RequestError))
 	v.NiceName = "Alloc"
 	return v
 }
@@ -101,17 +116,42 @@ func init() {
 	xgb.NewErrorFuncs[11] = AllocErrorNew
 }
 
+type Allow byte
+
 const (
-	AllowAsyncPointer   = 0
-	AllowSyncPointer    = 1
-	AllowReplayPointer  = 2
-	AllowAsyncKeyboard  = 3
-	AllowSyncKeyboard   = 4
-	AllowReplayKeyboard = 5
-	AllowAsyncBoth      = 6
-	AllowSyncBoth       = 7
+	AllowAsyncPointer   Allow = 0
+	AllowSyncPointer    Allow = 1
+	AllowReplayPointer  Allow = 2
+	AllowAsyncKeyboard  Allow = 3
+	AllowSyncKeyboard   Allow = 4
+	AllowReplayKeyboard Allow = 5
+	AllowAsyncBoth      Allow = 6
+	AllowSyncBoth       Allow = 7
 )
 
+// String returns the name of the Allow item with this value.
+func (v Allow) String() string {
+	switch v {
+	case AllowAsyncPointer:
+		return "AsyncPointer"
+	case AllowSyncPointer:
+		return "SyncPointer"
+	case AllowReplayPointer:
+		return "ReplayPointer"
+	case AllowAsyncKeyboard:
+		return "AsyncKeyboard"
+	case AllowSyncKeyboard:
+		return "SyncKeyboard"
+	case AllowReplayKeyboard:
+		return "ReplayKeyboard"
+	case AllowAsyncBoth:
+		return "AsyncBoth"
+	case AllowSyncBoth:
+		return "SyncBoth"
+	}
+	return xgb.Sprintf("Allow(%d)", byte(v))
+}
+
 type Arc struct {
 	X      int16
 	Y      int16
@@ -122,83 +162,96 @@ type Arc struct {
 }
 
 // ArcRead reads a byte slice into a Arc value.
-func ArcRead(buf []byte, v *Arc) int {
+func ArcRead(buf []byte, order xgb.ByteOrder, v *Arc) int {
 	b := 0
 
-	v.X = int16(xgb.Get16(buf[b:]))
+	v.X = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Y = int16(xgb.Get16(buf[b:]))
+	v.Y = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
-	v.Angle1 = int16(xgb.Get16(buf[b:]))
+	v.Angle1 = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Angle2 = int16(xgb.Get16(buf[b:]))
+	v.Angle2 = int16(order.Get16(buf[b:]))
 	b += 2
 
 	return b
 }
 
 // ArcReadList reads a byte slice into a list of Arc values.
-func ArcReadList(buf []byte, dest []Arc) int {
+func ArcReadList(buf []byte, order xgb.ByteOrder, dest []Arc) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Arc{}
-		b += ArcRead(buf[b:], &dest[i])
+		b += ArcRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Arc value to a byte slice.
-func (v Arc) Bytes() []byte {
+// Bytes writes a Arc value to a byte slice, in the byte order 'order'.
+func (v Arc) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 12)
 	b := 0
 
-	xgb.Put16(buf[b:], uint16(v.X))
+	order.Put16(buf[b:], uint16(v.X))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.Y))
+	order.Put16(buf[b:], uint16(v.Y))
 	b += 2
 
-	xgb.Put16(buf[b:], v.Width)
+	order.Put16(buf[b:], v.Width)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Height)
+	order.Put16(buf[b:], v.Height)
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.Angle1))
+	order.Put16(buf[b:], uint16(v.Angle1))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.Angle2))
+	order.Put16(buf[b:], uint16(v.Angle2))
 	b += 2
 
 	return buf[:b]
 }
 
 // ArcListBytes writes a list of Arc values to a byte slice.
-func ArcListBytes(buf []byte, list []Arc) int {
+func ArcListBytes(buf []byte, order xgb.ByteOrder, list []Arc) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 	return xgb.Pad(b)
 }
 
+type ArcMode uint32
+
 const (
-	ArcModeChord    = 0
-	ArcModePieSlice = 1
+	ArcModeChord    ArcMode = 0
+	ArcModePieSlice ArcMode = 1
 )
 
+// String returns the name of the ArcMode item with this value.
+func (v ArcMode) String() string {
+	switch v {
+	case ArcModeChord:
+		return "Chord"
+	case ArcModePieSlice:
+		return "PieSlice"
+	}
+	return xgb.Sprintf("ArcMode(%d)", uint32(v))
+}
+
 type Atom uint32
 
 func NewAtomId(c *xgb.Conn) (Atom, error) {
@@ -287,9 +340,9 @@ const BadAtom = 5
 
 type AtomError ValueError
 
-// AtomErrorNew constructs a AtomError value that implements xgb.Error from a byte slice.
-func AtomErrorNew(buf []byte) xgb.Error {
-	v := AtomError(ValueErrorNew(buf).(ValueError))
+// AtomErrorNew constructs a AtomError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func AtomErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := AtomError(ValueErrorNew(buf, order).(ValueError))
 	v.NiceName = "Atom"
 	return v
 }
@@ -320,40 +373,106 @@ func init() {
 	xgb.NewErrorFuncs[5] = AtomErrorNew
 }
 
+type AutoRepeatMode uint32
+
 const (
-	AutoRepeatModeOff     = 0
-	AutoRepeatModeOn      = 1
-	AutoRepeatModeDefault = 2
+	AutoRepeatModeOff     AutoRepeatMode = 0
+	AutoRepeatModeOn      AutoRepeatMode = 1
+	AutoRepeatModeDefault AutoRepeatMode = 2
 )
 
+// String returns the name of the AutoRepeatMode item with this value.
+func (v AutoRepeatMode) String() string {
+	switch v {
+	case AutoRepeatModeOff:
+		return "Off"
+	case AutoRepeatModeOn:
+		return "On"
+	case AutoRepeatModeDefault:
+		return "Default"
+	}
+	return xgb.Sprintf("AutoRepeatMode(%d)", uint32(v))
+}
+
 const (
 	BackPixmapNone           = 0
 	BackPixmapParentRelative = 1
 )
 
+type BackingStore uint32
+
 const (
-	BackingStoreNotUseful  = 0
-	BackingStoreWhenMapped = 1
-	BackingStoreAlways     = 2
+	BackingStoreNotUseful  BackingStore = 0
+	BackingStoreWhenMapped BackingStore = 1
+	BackingStoreAlways     BackingStore = 2
 )
 
+// String returns the name of the BackingStore item with this value.
+func (v BackingStore) String() string {
+	switch v {
+	case BackingStoreNotUseful:
+		return "NotUseful"
+	case BackingStoreWhenMapped:
+		return "WhenMapped"
+	case BackingStoreAlways:
+		return "Always"
+	}
+	return xgb.Sprintf("BackingStore(%d)", uint32(v))
+}
+
+type Blanking byte
+
 const (
-	BlankingNotPreferred = 0
-	BlankingPreferred    = 1
-	BlankingDefault      = 2
+	BlankingNotPreferred Blanking = 0
+	BlankingPreferred    Blanking = 1
+	BlankingDefault      Blanking = 2
 )
 
+// String returns the name of the Blanking item with this value.
+func (v Blanking) String() string {
+	switch v {
+	case BlankingNotPreferred:
+		return "NotPreferred"
+	case BlankingPreferred:
+		return "Preferred"
+	case BlankingDefault:
+		return "Default"
+	}
+	return xgb.Sprintf("Blanking(%d)", byte(v))
+}
+
 type Button byte
 
+type ButtonIndex byte
+
 const (
-	ButtonIndexAny = 0
-	ButtonIndex1   = 1
-	ButtonIndex2   = 2
-	ButtonIndex3   = 3
-	ButtonIndex4   = 4
-	ButtonIndex5   = 5
+	ButtonIndexAny ButtonIndex = 0
+	ButtonIndex1   ButtonIndex = 1
+	ButtonIndex2   ButtonIndex = 2
+	ButtonIndex3   ButtonIndex = 3
+	ButtonIndex4   ButtonIndex = 4
+	ButtonIndex5   ButtonIndex = 5
 )
 
+// String returns the name of the ButtonIndex item with this value.
+func (v ButtonIndex) String() string {
+	switch v {
+	case ButtonIndexAny:
+		return "Any"
+	case ButtonIndex1:
+		return "1"
+	case ButtonIndex2:
+		return "2"
+	case ButtonIndex3:
+		return "3"
+	case ButtonIndex4:
+		return "4"
+	case ButtonIndex5:
+		return "5"
+	}
+	return xgb.Sprintf("ButtonIndex(%d)", byte(v))
+}
+
 const (
 	ButtonMask1   = 256
 	ButtonMask2   = 512
@@ -366,58 +485,87 @@ const (
 // ButtonPress is the event number for a ButtonPressEvent.
 const ButtonPress = 4
 
+// ButtonPressEvent is the type of ButtonPress events.
+//
+// A mouse button was pressed/released.
+//
+// See also GrabButton, GrabPointer.
 type ButtonPressEvent struct {
-	Sequence   uint16
-	Detail     Button
-	Time       Timestamp
-	Root       Window
-	Event      Window
-	Child      Window
-	RootX      int16
-	RootY      int16
-	EventX     int16
-	EventY     int16
-	State      uint16
+	Sequence uint16
+
+	// The keycode (a number representing a physical key on the keyboard) of
+	// the key which was pressed.
+	Detail Button
+
+	// Time when the event was generated (in milliseconds).
+	Time Timestamp
+
+	// The root window of `child`.
+	Root  Window
+	Event Window
+	Child Window
+
+	// The X coordinate of the pointer relative to the `root` window at the
+	// time of the event.
+	RootX int16
+
+	// The Y coordinate of the pointer relative to the `root` window at the
+	// time of the event.
+	RootY int16
+
+	// If `same_screen` is true, this is the X coordinate relative to the
+	// `event` window's origin. Otherwise, `event_x` will be set to zero.
+	EventX int16
+
+	// If `same_screen` is true, this is the Y coordinate relative to the
+	// `event` window's origin. Otherwise, `event_y` will be set to zero.
+	EventY int16
+
+	// The logical state of the pointer buttons and modifier keys just prior to
+	// the event.
+	State KeyButMask
+
+	// Whether the `event` window is on the same screen as the `root` window.
 	SameScreen bool
 	// padding: 1 bytes
 }
 
-// ButtonPressEventNew constructs a ButtonPressEvent value that implements xgb.Event from a byte slice.
-func ButtonPressEventNew(buf []byte) xgb.Event {
+// ButtonPressEventNew constructs a ButtonPressEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func ButtonPressEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := ButtonPressEvent{}
 	b := 1 // don't read event number
 
 	v.Detail = Button(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Time = Timestamp(xgb.Get32(buf[b:]))
+	v.Time = Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.Root = Window(xgb.Get32(buf[b:]))
+	v.Root = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Event = Window(xgb.Get32(buf[b:]))
+	v.Event = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Child = Window(xgb.Get32(buf[b:]))
+	v.Child = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.RootX = int16(xgb.Get16(buf[b:]))
+	v.RootX = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.RootY = int16(xgb.Get16(buf[b:]))
+	v.RootY = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.EventX = int16(xgb.Get16(buf[b:]))
+	v.EventX = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.EventY = int16(xgb.Get16(buf[b:]))
+	v.EventY = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.State = xgb.Get16(buf[b:])
+	v.State = KeyButMask(order.Get16(buf[b:]))
 	b += 2
 
 	if buf[b] == 1 {
@@ -432,8 +580,8 @@ func ButtonPressEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a ButtonPressEvent value to a byte slice.
-func (v ButtonPressEvent) Bytes() []byte {
+// Bytes writes a ButtonPressEvent value to a byte slice, in the byte order 'order'.
+func (v ButtonPressEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -446,31 +594,31 @@ func (v ButtonPressEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Time))
+	order.Put32(buf[b:], uint32(v.Time))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Root))
+	order.Put32(buf[b:], uint32(v.Root))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Event))
+	order.Put32(buf[b:], uint32(v.Event))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Child))
+	order.Put32(buf[b:], uint32(v.Child))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(v.RootX))
+	order.Put16(buf[b:], uint16(v.RootX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.RootY))
+	order.Put16(buf[b:], uint16(v.RootY))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.EventX))
+	order.Put16(buf[b:], uint16(v.EventX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.EventY))
+	order.Put16(buf[b:], uint16(v.EventY))
 	b += 2
 
-	xgb.Put16(buf[b:], v.State)
+	order.Put16(buf[b:], uint16(v.State))
 	b += 2
 
 	if v.SameScreen {
@@ -505,11 +653,17 @@ func (v ButtonPressEvent) String() string {
 	fieldVals = append(fieldVals, xgb.Sprintf("RootY: %d", v.RootY))
 	fieldVals = append(fieldVals, xgb.Sprintf("EventX: %d", v.EventX))
 	fieldVals = append(fieldVals, xgb.Sprintf("EventY: %d", v.EventY))
-	fieldVals = append(fieldVals, xgb.Sprintf("State: %d", v.State))
+	fieldVals = append(fieldVals, xgb.Sprintf("State: %s", v.State))
 	fieldVals = append(fieldVals, xgb.Sprintf("SameScreen: %t", v.SameScreen))
 	return "ButtonPress {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the ButtonPress event is about.
+// It is used to subscribe to the events of a single window.
+func (v ButtonPressEvent) EventWindow() uint32 {
+	return uint32(v.Event)
+}
+
 func init() {
 	xgb.NewEventFuncs[4] = ButtonPressEventNew
 }
@@ -519,14 +673,14 @@ const ButtonRelease = 5
 
 type ButtonReleaseEvent ButtonPressEvent
 
-// ButtonReleaseEventNew constructs a ButtonReleaseEvent value that implements xgb.Event from a byte slice.
-func ButtonReleaseEventNew(buf []byte) xgb.Event {
-	return ButtonReleaseEvent(ButtonPressEventNew(buf).(ButtonPressEvent))
+// ButtonReleaseEventNew constructs a ButtonReleaseEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func ButtonReleaseEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
+	return ButtonReleaseEvent(ButtonPressEventNew(buf, order).(ButtonPressEvent))
 }
 
-// Bytes writes a ButtonReleaseEvent value to a byte slice.
-func (v ButtonReleaseEvent) Bytes() []byte {
-	return ButtonPressEvent(v).Bytes()
+// Bytes writes a ButtonReleaseEvent value to a byte slice, in the byte order 'order'.
+func (v ButtonReleaseEvent) Bytes(order xgb.ByteOrder) []byte {
+	return ButtonPressEvent(v).Bytes(order)
 }
 
 // SequenceId returns the sequence id attached to the ButtonRelease event.
@@ -548,29 +702,52 @@ func (v ButtonReleaseEvent) String() string {
 	fieldVals = append(fieldVals, xgb.Sprintf("RootY: %d", v.RootY))
 	fieldVals = append(fieldVals, xgb.Sprintf("EventX: %d", v.EventX))
 	fieldVals = append(fieldVals, xgb.Sprintf("EventY: %d", v.EventY))
-	fieldVals = append(fieldVals, xgb.Sprintf("State: %d", v.State))
+	fieldVals = append(fieldVals, xgb.Sprintf("State: %s", v.State))
 	fieldVals = append(fieldVals, xgb.Sprintf("SameScreen: %t", v.SameScreen))
 	return "ButtonRelease {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the ButtonRelease event is about.
+// It is used to subscribe to the events of a single window.
+func (v ButtonReleaseEvent) EventWindow() uint32 {
+	return ButtonPressEvent(v).EventWindow()
+}
+
 func init() {
 	xgb.NewEventFuncs[5] = ButtonReleaseEventNew
 }
 
+type CapStyle uint32
+
 const (
-	CapStyleNotLast    = 0
-	CapStyleButt       = 1
-	CapStyleRound      = 2
-	CapStyleProjecting = 3
+	CapStyleNotLast    CapStyle = 0
+	CapStyleButt       CapStyle = 1
+	CapStyleRound      CapStyle = 2
+	CapStyleProjecting CapStyle = 3
 )
 
+// String returns the name of the CapStyle item with this value.
+func (v CapStyle) String() string {
+	switch v {
+	case CapStyleNotLast:
+		return "NotLast"
+	case CapStyleButt:
+		return "Butt"
+	case CapStyleRound:
+		return "Round"
+	case CapStyleProjecting:
+		return "Projecting"
+	}
+	return xgb.Sprintf("CapStyle(%d)", uint32(v))
+}
+
 type Char2b struct {
 	Byte1 byte
 	Byte2 byte
 }
 
 // Char2bRead reads a byte slice into a Char2b value.
-func Char2bRead(buf []byte, v *Char2b) int {
+func Char2bRead(buf []byte, order xgb.ByteOrder, v *Char2b) int {
 	b := 0
 
 	v.Byte1 = buf[b]
@@ -583,17 +760,17 @@ func Char2bRead(buf []byte, v *Char2b) int {
 }
 
 // Char2bReadList reads a byte slice into a list of Char2b values.
-func Char2bReadList(buf []byte, dest []Char2b) int {
+func Char2bReadList(buf []byte, order xgb.ByteOrder, dest []Char2b) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Char2b{}
-		b += Char2bRead(buf[b:], &dest[i])
+		b += Char2bRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Char2b value to a byte slice.
-func (v Char2b) Bytes() []byte {
+// Bytes writes a Char2b value to a byte slice, in the byte order 'order'.
+func (v Char2b) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 2)
 	b := 0
 
@@ -607,11 +784,11 @@ func (v Char2b) Bytes() []byte {
 }
 
 // Char2bListBytes writes a list of Char2b values to a byte slice.
-func Char2bListBytes(buf []byte, list []Char2b) int {
+func Char2bListBytes(buf []byte, order xgb.ByteOrder, list []Char2b) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -628,83 +805,96 @@ type Charinfo struct {
 }
 
 // CharinfoRead reads a byte slice into a Charinfo value.
-func CharinfoRead(buf []byte, v *Charinfo) int {
+func CharinfoRead(buf []byte, order xgb.ByteOrder, v *Charinfo) int {
 	b := 0
 
-	v.LeftSideBearing = int16(xgb.Get16(buf[b:]))
+	v.LeftSideBearing = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.RightSideBearing = int16(xgb.Get16(buf[b:]))
+	v.RightSideBearing = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.CharacterWidth = int16(xgb.Get16(buf[b:]))
+	v.CharacterWidth = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Ascent = int16(xgb.Get16(buf[b:]))
+	v.Ascent = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Descent = int16(xgb.Get16(buf[b:]))
+	v.Descent = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Attributes = xgb.Get16(buf[b:])
+	v.Attributes = order.Get16(buf[b:])
 	b += 2
 
 	return b
 }
 
 // CharinfoReadList reads a byte slice into a list of Charinfo values.
-func CharinfoReadList(buf []byte, dest []Charinfo) int {
+func CharinfoReadList(buf []byte, order xgb.ByteOrder, dest []Charinfo) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Charinfo{}
-		b += CharinfoRead(buf[b:], &dest[i])
+		b += CharinfoRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Charinfo value to a byte slice.
-func (v Charinfo) Bytes() []byte {
+// Bytes writes a Charinfo value to a byte slice, in the byte order 'order'.
+func (v Charinfo) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 12)
 	b := 0
 
-	xgb.Put16(buf[b:], uint16(v.LeftSideBearing))
+	order.Put16(buf[b:], uint16(v.LeftSideBearing))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.RightSideBearing))
+	order.Put16(buf[b:], uint16(v.RightSideBearing))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.CharacterWidth))
+	order.Put16(buf[b:], uint16(v.CharacterWidth))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.Ascent))
+	order.Put16(buf[b:], uint16(v.Ascent))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.Descent))
+	order.Put16(buf[b:], uint16(v.Descent))
 	b += 2
 
-	xgb.Put16(buf[b:], v.Attributes)
+	order.Put16(buf[b:], v.Attributes)
 	b += 2
 
 	return buf[:b]
 }
 
 // CharinfoListBytes writes a list of Charinfo values to a byte slice.
-func CharinfoListBytes(buf []byte, list []Charinfo) int {
+func CharinfoListBytes(buf []byte, order xgb.ByteOrder, list []Charinfo) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 	return xgb.Pad(b)
 }
 
+type Circulate byte
+
 const (
-	CirculateRaiseLowest  = 0
-	CirculateLowerHighest = 1
+	CirculateRaiseLowest  Circulate = 0
+	CirculateLowerHighest Circulate = 1
 )
 
+// String returns the name of the Circulate item with this value.
+func (v Circulate) String() string {
+	switch v {
+	case CirculateRaiseLowest:
+		return "RaiseLowest"
+	case CirculateLowerHighest:
+		return "LowerHighest"
+	}
+	return xgb.Sprintf("Circulate(%d)", byte(v))
+}
+
 // CirculateNotify is the event number for a CirculateNotifyEvent.
 const CirculateNotify = 26
 
@@ -714,29 +904,29 @@ type CirculateNotifyEvent struct {
 	Event  Window
 	Window Window
 	// padding: 4 bytes
-	Place byte
+	Place Place
 	// padding: 3 bytes
 }
 
-// CirculateNotifyEventNew constructs a CirculateNotifyEvent value that implements xgb.Event from a byte slice.
-func CirculateNotifyEventNew(buf []byte) xgb.Event {
+// CirculateNotifyEventNew constructs a CirculateNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func CirculateNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := CirculateNotifyEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Event = Window(xgb.Get32(buf[b:]))
+	v.Event = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Window = Window(xgb.Get32(buf[b:]))
+	v.Window = Window(order.Get32(buf[b:]))
 	b += 4
 
 	b += 4 // padding
 
-	v.Place = buf[b]
+	v.Place = Place(buf[b])
 	b += 1
 
 	b += 3 // padding
@@ -744,8 +934,8 @@ func CirculateNotifyEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a CirculateNotifyEvent value to a byte slice.
-func (v CirculateNotifyEvent) Bytes() []byte {
+// Bytes writes a CirculateNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v CirculateNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -757,15 +947,15 @@ func (v CirculateNotifyEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Event))
+	order.Put32(buf[b:], uint32(v.Event))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
 	b += 4 // padding
 
-	buf[b] = v.Place
+	buf[b] = byte(v.Place)
 	b += 1
 
 	b += 3 // padding
@@ -786,10 +976,16 @@ func (v CirculateNotifyEvent) String() string {
 	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
 	fieldVals = append(fieldVals, xgb.Sprintf("Event: %d", v.Event))
 	fieldVals = append(fieldVals, xgb.Sprintf("Window: %d", v.Window))
-	fieldVals = append(fieldVals, xgb.Sprintf("Place: %d", v.Place))
+	fieldVals = append(fieldVals, xgb.Sprintf("Place: %s", v.Place))
 	return "CirculateNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the CirculateNotify event is about.
+// It is used to subscribe to the events of a single window.
+func (v CirculateNotifyEvent) EventWindow() uint32 {
+	return uint32(v.Event)
+}
+
 func init() {
 	xgb.NewEventFuncs[26] = CirculateNotifyEventNew
 }
@@ -799,14 +995,14 @@ const CirculateRequest = 27
 
 type CirculateRequestEvent CirculateNotifyEvent
 
-// CirculateRequestEventNew constructs a CirculateRequestEvent value that implements xgb.Event from a byte slice.
-func CirculateRequestEventNew(buf []byte) xgb.Event {
-	return CirculateRequestEvent(CirculateNotifyEventNew(buf).(CirculateNotifyEvent))
+// CirculateRequestEventNew constructs a CirculateRequestEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func CirculateRequestEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
+	return CirculateRequestEvent(CirculateNotifyEventNew(buf, order).(CirculateNotifyEvent))
 }
 
-// Bytes writes a CirculateRequestEvent value to a byte slice.
-func (v CirculateRequestEvent) Bytes() []byte {
-	return CirculateNotifyEvent(v).Bytes()
+// Bytes writes a CirculateRequestEvent value to a byte slice, in the byte order 'order'.
+func (v CirculateRequestEvent) Bytes(order xgb.ByteOrder) []byte {
+	return CirculateNotifyEvent(v).Bytes(order)
 }
 
 // SequenceId returns the sequence id attached to the CirculateRequest event.
@@ -821,10 +1017,16 @@ func (v CirculateRequestEvent) String() string {
 	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
 	fieldVals = append(fieldVals, xgb.Sprintf("Event: %d", v.Event))
 	fieldVals = append(fieldVals, xgb.Sprintf("Window: %d", v.Window))
-	fieldVals = append(fieldVals, xgb.Sprintf("Place: %d", v.Place))
+	fieldVals = append(fieldVals, xgb.Sprintf("Place: %s", v.Place))
 	return "CirculateRequest {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the CirculateRequest event is about.
+// It is used to subscribe to the events of a single window.
+func (v CirculateRequestEvent) EventWindow() uint32 {
+	return CirculateNotifyEvent(v).EventWindow()
+}
+
 func init() {
 	xgb.NewEventFuncs[27] = CirculateRequestEventNew
 }
@@ -832,39 +1034,56 @@ func init() {
 // ClientMessage is the event number for a ClientMessageEvent.
 const ClientMessage = 33
 
+// ClientMessageEvent is the type of ClientMessage events.
+//
+// A client sent a message.
+//
+// This event represents a ClientMessage, sent by another X11 client. An
+// example is a client sending the `_NET_WM_STATE` ClientMessage to the root
+// window to indicate the fullscreen window state, effectively requesting that
+// the window manager puts it into fullscreen mode.
+//
+// See also SendEvent.
 type ClientMessageEvent struct {
 	Sequence uint16
-	Format   byte
-	Window   Window
-	Type     Atom
-	Data     ClientMessageDataUnion
+
+	// Specifies how to interpret `data`. Can be either 8, 16 or 32.
+	Format byte
+	Window Window
+
+	// An atom which indicates how the data should be interpreted by the
+	// receiving client.
+	Type Atom
+
+	// The data itself (20 bytes max).
+	Data ClientMessageDataUnion
 }
 
-// ClientMessageEventNew constructs a ClientMessageEvent value that implements xgb.Event from a byte slice.
-func ClientMessageEventNew(buf []byte) xgb.Event {
+// ClientMessageEventNew constructs a ClientMessageEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func ClientMessageEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := ClientMessageEvent{}
 	b := 1 // don't read event number
 
 	v.Format = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Window = Window(xgb.Get32(buf[b:]))
+	v.Window = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Type = Atom(xgb.Get32(buf[b:]))
+	v.Type = Atom(order.Get32(buf[b:]))
 	b += 4
 
 	v.Data = ClientMessageDataUnion{}
-	b += ClientMessageDataUnionRead(buf[b:], &v.Data)
+	b += ClientMessageDataUnionRead(buf[b:], order, &v.Data)
 
 	return v
 }
 
-// Bytes writes a ClientMessageEvent value to a byte slice.
-func (v ClientMessageEvent) Bytes() []byte {
+// Bytes writes a ClientMessageEvent value to a byte slice, in the byte order 'order'.
+func (v ClientMessageEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -877,14 +1096,14 @@ func (v ClientMessageEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Type))
+	order.Put32(buf[b:], uint32(v.Type))
 	b += 4
 
 	{
-		unionBytes := v.Data.Bytes()
+		unionBytes := v.Data.Bytes(order)
 		copy(buf[b:], unionBytes)
 		b += len(unionBytes)
 	}
@@ -909,6 +1128,12 @@ func (v ClientMessageEvent) String() string {
 	return "ClientMessage {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the ClientMessage event is about.
+// It is used to subscribe to the events of a single window.
+func (v ClientMessageEvent) EventWindow() uint32 {
+	return uint32(v.Window)
+}
+
 func init() {
 	xgb.NewEventFuncs[33] = ClientMessageEventNew
 }
@@ -917,9 +1142,10 @@ func init() {
 // Note that to *create* a Union, you should *never* create
 // this struct directly (unless you know what you're doing).
 // Instead use one of the following constructors for 'ClientMessageDataUnion':
-//     ClientMessageDataUnionData8New(Data8 []byte) ClientMessageDataUnion
-//     ClientMessageDataUnionData16New(Data16 []uint16) ClientMessageDataUnion
-//     ClientMessageDataUnionData32New(Data32 []uint32) ClientMessageDataUnion
+//
+//	ClientMessageDataUnionData8New(order xgb.ByteOrder, Data8 []byte) ClientMessageDataUnion
+//	ClientMessageDataUnionData16New(order xgb.ByteOrder, Data16 []uint16) ClientMessageDataUnion
+//	ClientMessageDataUnionData32New(order xgb.ByteOrder, Data32 []uint32) ClientMessageDataUnion
 type ClientMessageDataUnion struct {
 	Data8  []byte   // size: 20
 	Data16 []uint16 // size: 20
@@ -927,7 +1153,9 @@ type ClientMessageDataUnion struct {
 }
 
 // ClientMessageDataUnionData8New constructs a new ClientMessageDataUnion union type with the Data8 field.
-func ClientMessageDataUnionData8New(Data8 []byte) ClientMessageDataUnion {
+// Its fields are read back in the byte order 'order', so it must be sent over
+// a connection with the same byte order.
+func ClientMessageDataUnionData8New(order xgb.ByteOrder, Data8 []byte) ClientMessageDataUnion {
 	var b int
 	buf := make([]byte, 20)
 
@@ -947,14 +1175,14 @@ func ClientMessageDataUnionData8New(Data8 []byte) ClientMessageDataUnion {
 	b = 0 // always read the same bytes
 	v.Data16 = make([]uint16, 10)
 	for i := 0; i < int(10); i++ {
-		v.Data16[i] = xgb.Get16(buf[b:])
+		v.Data16[i] = order.Get16(buf[b:])
 		b += 2
 	}
 
 	b = 0 // always read the same bytes
 	v.Data32 = make([]uint32, 5)
 	for i := 0; i < int(5); i++ {
-		v.Data32[i] = xgb.Get32(buf[b:])
+		v.Data32[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -962,12 +1190,14 @@ func ClientMessageDataUnionData8New(Data8 []byte) ClientMessageDataUnion {
 }
 
 // ClientMessageDataUnionData16New constructs a new ClientMessageDataUnion union type with the Data16 field.
-func ClientMessageDataUnionData16New(Data16 []uint16) ClientMessageDataUnion {
+// Its fields are read back in the byte order 'order', so it must be sent over
+// a connection with the same byte order.
+func ClientMessageDataUnionData16New(order xgb.ByteOrder, Data16 []uint16) ClientMessageDataUnion {
 	var b int
 	buf := make([]byte, 20)
 
 	for i := 0; i < int(10); i++ {
-		xgb.Put16(buf[b:], Data16[i])
+		order.Put16(buf[b:], Data16[i])
 		b += 2
 	}
 
@@ -984,14 +1214,14 @@ func ClientMessageDataUnionData16New(Data16 []uint16) ClientMessageDataUnion {
 	b = 0 // always read the same bytes
 	v.Data16 = make([]uint16, 10)
 	for i := 0; i < int(10); i++ {
-		v.Data16[i] = xgb.Get16(buf[b:])
+		v.Data16[i] = order.Get16(buf[b:])
 		b += 2
 	}
 
 	b = 0 // always read the same bytes
 	v.Data32 = make([]uint32, 5)
 	for i := 0; i < int(5); i++ {
-		v.Data32[i] = xgb.Get32(buf[b:])
+		v.Data32[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -999,12 +1229,14 @@ func ClientMessageDataUnionData16New(Data16 []uint16) ClientMessageDataUnion {
 }
 
 // ClientMessageDataUnionData32New constructs a new ClientMessageDataUnion union type with the Data32 field.
-func ClientMessageDataUnionData32New(Data32 []uint32) ClientMessageDataUnion {
+// Its fields are read back in the byte order 'order', so it must be sent over
+// a connection with the same byte order.
+func ClientMessageDataUnionData32New(order xgb.ByteOrder, Data32 []uint32) ClientMessageDataUnion {
 	var b int
 	buf := make([]byte, 20)
 
 	for i := 0; i < int(5); i++ {
-		xgb.Put32(buf[b:], Data32[i])
+		order.Put32(buf[b:], Data32[i])
 		b += 4
 	}
 
@@ -1021,14 +1253,14 @@ func ClientMessageDataUnionData32New(Data32 []uint32) ClientMessageDataUnion {
 	b = 0 // always read the same bytes
 	v.Data16 = make([]uint16, 10)
 	for i := 0; i < int(10); i++ {
-		v.Data16[i] = xgb.Get16(buf[b:])
+		v.Data16[i] = order.Get16(buf[b:])
 		b += 2
 	}
 
 	b = 0 // always read the same bytes
 	v.Data32 = make([]uint32, 5)
 	for i := 0; i < int(5); i++ {
-		v.Data32[i] = xgb.Get32(buf[b:])
+		v.Data32[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -1036,7 +1268,7 @@ func ClientMessageDataUnionData32New(Data32 []uint32) ClientMessageDataUnion {
 }
 
 // ClientMessageDataUnionRead reads a byte slice into a ClientMessageDataUnion value.
-func ClientMessageDataUnionRead(buf []byte, v *ClientMessageDataUnion) int {
+func ClientMessageDataUnionRead(buf []byte, order xgb.ByteOrder, v *ClientMessageDataUnion) int {
 	var b int
 
 	b = 0 // re-read the same bytes
@@ -1047,14 +1279,14 @@ func ClientMessageDataUnionRead(buf []byte, v *ClientMessageDataUnion) int {
 	b = 0 // re-read the same bytes
 	v.Data16 = make([]uint16, 10)
 	for i := 0; i < int(10); i++ {
-		v.Data16[i] = xgb.Get16(buf[b:])
+		v.Data16[i] = order.Get16(buf[b:])
 		b += 2
 	}
 
 	b = 0 // re-read the same bytes
 	v.Data32 = make([]uint32, 5)
 	for i := 0; i < int(5); i++ {
-		v.Data32[i] = xgb.Get32(buf[b:])
+		v.Data32[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -1062,19 +1294,19 @@ func ClientMessageDataUnionRead(buf []byte, v *ClientMessageDataUnion) int {
 }
 
 // ClientMessageDataUnionReadList reads a byte slice into a list of ClientMessageDataUnion values.
-func ClientMessageDataUnionReadList(buf []byte, dest []ClientMessageDataUnion) int {
+func ClientMessageDataUnionReadList(buf []byte, order xgb.ByteOrder, dest []ClientMessageDataUnion) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = ClientMessageDataUnion{}
-		b += ClientMessageDataUnionRead(buf[b:], &dest[i])
+		b += ClientMessageDataUnionRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a ClientMessageDataUnion value to a byte slice.
+// Bytes writes a ClientMessageDataUnion value to a byte slice, in the byte order 'order'.
 // Each field in a union must contain the same data.
 // So simply pick the first field and write that to the wire.
-func (v ClientMessageDataUnion) Bytes() []byte {
+func (v ClientMessageDataUnion) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 20)
 	b := 0
 
@@ -1084,62 +1316,120 @@ func (v ClientMessageDataUnion) Bytes() []byte {
 }
 
 // ClientMessageDataUnionListBytes writes a list of ClientMessageDataUnion values to a byte slice.
-func ClientMessageDataUnionListBytes(buf []byte, list []ClientMessageDataUnion) int {
+func ClientMessageDataUnionListBytes(buf []byte, order xgb.ByteOrder, list []ClientMessageDataUnion) int {
 	b := 0
 	var unionBytes []byte
 	for _, item := range list {
-		unionBytes = item.Bytes()
+		unionBytes = item.Bytes(order)
 		copy(buf[b:], unionBytes)
 		b += xgb.Pad(len(unionBytes))
 	}
 	return b
 }
 
+type ClipOrdering byte
+
 const (
-	ClipOrderingUnsorted = 0
-	ClipOrderingYSorted  = 1
-	ClipOrderingYXSorted = 2
-	ClipOrderingYXBanded = 3
+	ClipOrderingUnsorted ClipOrdering = 0
+	ClipOrderingYSorted  ClipOrdering = 1
+	ClipOrderingYXSorted ClipOrdering = 2
+	ClipOrderingYXBanded ClipOrdering = 3
 )
 
+// String returns the name of the ClipOrdering item with this value.
+func (v ClipOrdering) String() string {
+	switch v {
+	case ClipOrderingUnsorted:
+		return "Unsorted"
+	case ClipOrderingYSorted:
+		return "YSorted"
+	case ClipOrderingYXSorted:
+		return "YXSorted"
+	case ClipOrderingYXBanded:
+		return "YXBanded"
+	}
+	return xgb.Sprintf("ClipOrdering(%d)", byte(v))
+}
+
+type CloseDown byte
+
 const (
-	CloseDownDestroyAll      = 0
-	CloseDownRetainPermanent = 1
-	CloseDownRetainTemporary = 2
+	CloseDownDestroyAll      CloseDown = 0
+	CloseDownRetainPermanent CloseDown = 1
+	CloseDownRetainTemporary CloseDown = 2
 )
 
+// String returns the name of the CloseDown item with this value.
+func (v CloseDown) String() string {
+	switch v {
+	case CloseDownDestroyAll:
+		return "DestroyAll"
+	case CloseDownRetainPermanent:
+		return "RetainPermanent"
+	case CloseDownRetainTemporary:
+		return "RetainTemporary"
+	}
+	return xgb.Sprintf("CloseDown(%d)", byte(v))
+}
+
+type ColorFlag byte
+
 const (
-	ColorFlagRed   = 1
-	ColorFlagGreen = 2
-	ColorFlagBlue  = 4
+	ColorFlagRed   ColorFlag = 1
+	ColorFlagGreen ColorFlag = 2
+	ColorFlagBlue  ColorFlag = 4
 )
 
+// String returns the names of the ColorFlag bits set in a value.
+func (v ColorFlag) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 3)
+	if v&ColorFlagRed != 0 {
+		names = append(names, "Red")
+		v &^= ColorFlagRed
+	}
+	if v&ColorFlagGreen != 0 {
+		names = append(names, "Green")
+		v &^= ColorFlagGreen
+	}
+	if v&ColorFlagBlue != 0 {
+		names = append(names, "Blue")
+		v &^= ColorFlagBlue
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", byte(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
 type Coloritem struct {
 	Pixel uint32
 	Red   uint16
 	Green uint16
 	Blue  uint16
-	Flags byte
+	Flags ColorFlag
 	// padding: 1 bytes
 }
 
 // ColoritemRead reads a byte slice into a Coloritem value.
-func ColoritemRead(buf []byte, v *Coloritem) int {
+func ColoritemRead(buf []byte, order xgb.ByteOrder, v *Coloritem) int {
 	b := 0
 
-	v.Pixel = xgb.Get32(buf[b:])
+	v.Pixel = order.Get32(buf[b:])
 	b += 4
 
-	v.Red = xgb.Get16(buf[b:])
+	v.Red = order.Get16(buf[b:])
 	b += 2
 
-	v.Green = xgb.Get16(buf[b:])
+	v.Green = order.Get16(buf[b:])
 	b += 2
 
-	v.Blue = xgb.Get16(buf[b:])
+	v.Blue = order.Get16(buf[b:])
 	b += 2
 
-	v.Flags = buf[b]
+	v.Flags = ColorFlag(buf[b])
 	b += 1
 
 	b += 1 // padding
@@ -1148,33 +1438,33 @@ func ColoritemRead(buf []byte, v *Coloritem) int {
 }
 
 // ColoritemReadList reads a byte slice into a list of Coloritem values.
-func ColoritemReadList(buf []byte, dest []Coloritem) int {
+func ColoritemReadList(buf []byte, order xgb.ByteOrder, dest []Coloritem) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Coloritem{}
-		b += ColoritemRead(buf[b:], &dest[i])
+		b += ColoritemRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Coloritem value to a byte slice.
-func (v Coloritem) Bytes() []byte {
+// Bytes writes a Coloritem value to a byte slice, in the byte order 'order'.
+func (v Coloritem) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 12)
 	b := 0
 
-	xgb.Put32(buf[b:], v.Pixel)
+	order.Put32(buf[b:], v.Pixel)
 	b += 4
 
-	xgb.Put16(buf[b:], v.Red)
+	order.Put16(buf[b:], v.Red)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Green)
+	order.Put16(buf[b:], v.Green)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Blue)
+	order.Put16(buf[b:], v.Blue)
 	b += 2
 
-	buf[b] = v.Flags
+	buf[b] = byte(v.Flags)
 	b += 1
 
 	b += 1 // padding
@@ -1183,11 +1473,11 @@ func (v Coloritem) Bytes() []byte {
 }
 
 // ColoritemListBytes writes a list of Coloritem values to a byte slice.
-func ColoritemListBytes(buf []byte, list []Coloritem) int {
+func ColoritemListBytes(buf []byte, order xgb.ByteOrder, list []Coloritem) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -1209,9 +1499,9 @@ const BadColormap = 12
 
 type ColormapError ValueError
 
-// ColormapErrorNew constructs a ColormapError value that implements xgb.Error from a byte slice.
-func ColormapErrorNew(buf []byte) xgb.Error {
-	v := ColormapError(ValueErrorNew(buf).(ValueError))
+// ColormapErrorNew constructs a ColormapError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func ColormapErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := ColormapError(ValueErrorNew(buf, order).(ValueError))
 	v.NiceName = "Colormap"
 	return v
 }
@@ -1246,11 +1536,24 @@ const (
 	ColormapNone = 0
 )
 
+type ColormapAlloc byte
+
 const (
-	ColormapAllocNone = 0
-	ColormapAllocAll  = 1
+	ColormapAllocNone ColormapAlloc = 0
+	ColormapAllocAll  ColormapAlloc = 1
 )
 
+// String returns the name of the ColormapAlloc item with this value.
+func (v ColormapAlloc) String() string {
+	switch v {
+	case ColormapAllocNone:
+		return "None"
+	case ColormapAllocAll:
+		return "All"
+	}
+	return xgb.Sprintf("ColormapAlloc(%d)", byte(v))
+}
+
 // ColormapNotify is the event number for a ColormapNotifyEvent.
 const ColormapNotify = 32
 
@@ -1260,24 +1563,24 @@ type ColormapNotifyEvent struct {
 	Window   Window
 	Colormap Colormap
 	New      bool
-	State    byte
+	State    ColormapState
 	// padding: 2 bytes
 }
 
-// ColormapNotifyEventNew constructs a ColormapNotifyEvent value that implements xgb.Event from a byte slice.
-func ColormapNotifyEventNew(buf []byte) xgb.Event {
+// ColormapNotifyEventNew constructs a ColormapNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func ColormapNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := ColormapNotifyEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Window = Window(xgb.Get32(buf[b:]))
+	v.Window = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Colormap = Colormap(xgb.Get32(buf[b:]))
+	v.Colormap = Colormap(order.Get32(buf[b:]))
 	b += 4
 
 	if buf[b] == 1 {
@@ -1287,7 +1590,7 @@ func ColormapNotifyEventNew(buf []byte) xgb.Event {
 	}
 	b += 1
 
-	v.State = buf[b]
+	v.State = ColormapState(buf[b])
 	b += 1
 
 	b += 2 // padding
@@ -1295,8 +1598,8 @@ func ColormapNotifyEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a ColormapNotifyEvent value to a byte slice.
-func (v ColormapNotifyEvent) Bytes() []byte {
+// Bytes writes a ColormapNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v ColormapNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -1308,10 +1611,10 @@ func (v ColormapNotifyEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Colormap))
+	order.Put32(buf[b:], uint32(v.Colormap))
 	b += 4
 
 	if v.New {
@@ -1321,7 +1624,7 @@ func (v ColormapNotifyEvent) Bytes() []byte {
 	}
 	b += 1
 
-	buf[b] = v.State
+	buf[b] = byte(v.State)
 	b += 1
 
 	b += 2 // padding
@@ -1343,79 +1646,241 @@ func (v ColormapNotifyEvent) String() string {
 	fieldVals = append(fieldVals, xgb.Sprintf("Window: %d", v.Window))
 	fieldVals = append(fieldVals, xgb.Sprintf("Colormap: %d", v.Colormap))
 	fieldVals = append(fieldVals, xgb.Sprintf("New: %t", v.New))
-	fieldVals = append(fieldVals, xgb.Sprintf("State: %d", v.State))
+	fieldVals = append(fieldVals, xgb.Sprintf("State: %s", v.State))
 	return "ColormapNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the ColormapNotify event is about.
+// It is used to subscribe to the events of a single window.
+func (v ColormapNotifyEvent) EventWindow() uint32 {
+	return uint32(v.Window)
+}
+
 func init() {
 	xgb.NewEventFuncs[32] = ColormapNotifyEventNew
 }
 
+type ColormapState byte
+
 const (
-	ColormapStateUninstalled = 0
-	ColormapStateInstalled   = 1
+	ColormapStateUninstalled ColormapState = 0
+	ColormapStateInstalled   ColormapState = 1
 )
 
+// String returns the name of the ColormapState item with this value.
+func (v ColormapState) String() string {
+	switch v {
+	case ColormapStateUninstalled:
+		return "Uninstalled"
+	case ColormapStateInstalled:
+		return "Installed"
+	}
+	return xgb.Sprintf("ColormapState(%d)", byte(v))
+}
+
+type ConfigWindow uint16
+
 const (
-	ConfigWindowX           = 1
-	ConfigWindowY           = 2
-	ConfigWindowWidth       = 4
-	ConfigWindowHeight      = 8
-	ConfigWindowBorderWidth = 16
-	ConfigWindowSibling     = 32
-	ConfigWindowStackMode   = 64
+	ConfigWindowX           ConfigWindow = 1
+	ConfigWindowY           ConfigWindow = 2
+	ConfigWindowWidth       ConfigWindow = 4
+	ConfigWindowHeight      ConfigWindow = 8
+	ConfigWindowBorderWidth ConfigWindow = 16
+	ConfigWindowSibling     ConfigWindow = 32
+	ConfigWindowStackMode   ConfigWindow = 64
 )
 
+// String returns the names of the ConfigWindow bits set in a value.
+func (v ConfigWindow) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 7)
+	if v&ConfigWindowX != 0 {
+		names = append(names, "X")
+		v &^= ConfigWindowX
+	}
+	if v&ConfigWindowY != 0 {
+		names = append(names, "Y")
+		v &^= ConfigWindowY
+	}
+	if v&ConfigWindowWidth != 0 {
+		names = append(names, "Width")
+		v &^= ConfigWindowWidth
+	}
+	if v&ConfigWindowHeight != 0 {
+		names = append(names, "Height")
+		v &^= ConfigWindowHeight
+	}
+	if v&ConfigWindowBorderWidth != 0 {
+		names = append(names, "BorderWidth")
+		v &^= ConfigWindowBorderWidth
+	}
+	if v&ConfigWindowSibling != 0 {
+		names = append(names, "Sibling")
+		v &^= ConfigWindowSibling
+	}
+	if v&ConfigWindowStackMode != 0 {
+		names = append(names, "StackMode")
+		v &^= ConfigWindowStackMode
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint16(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
+// ConfigWindowValues builds a value mask and a value list out of ConfigWindow values.
+// The values are listed in the order defined by the protocol, no matter the order they are set in.
+type ConfigWindowValues struct {
+	mask   ConfigWindow
+	values [7]uint32
+}
+
+// SetX sets the value for ConfigWindowX.
+func (v *ConfigWindowValues) SetX(X uint32) *ConfigWindowValues {
+	v.mask |= ConfigWindowX
+	v.values[0] = X
+	return v
+}
+
+// SetY sets the value for ConfigWindowY.
+func (v *ConfigWindowValues) SetY(Y uint32) *ConfigWindowValues {
+	v.mask |= ConfigWindowY
+	v.values[1] = Y
+	return v
+}
+
+// SetWidth sets the value for ConfigWindowWidth.
+func (v *ConfigWindowValues) SetWidth(Width uint32) *ConfigWindowValues {
+	v.mask |= ConfigWindowWidth
+	v.values[2] = Width
+	return v
+}
+
+// SetHeight sets the value for ConfigWindowHeight.
+func (v *ConfigWindowValues) SetHeight(Height uint32) *ConfigWindowValues {
+	v.mask |= ConfigWindowHeight
+	v.values[3] = Height
+	return v
+}
+
+// SetBorderWidth sets the value for ConfigWindowBorderWidth.
+func (v *ConfigWindowValues) SetBorderWidth(BorderWidth uint32) *ConfigWindowValues {
+	v.mask |= ConfigWindowBorderWidth
+	v.values[4] = BorderWidth
+	return v
+}
+
+// SetSibling sets the value for ConfigWindowSibling.
+func (v *ConfigWindowValues) SetSibling(Sibling uint32) *ConfigWindowValues {
+	v.mask |= ConfigWindowSibling
+	v.values[5] = Sibling
+	return v
+}
+
+// SetStackMode sets the value for ConfigWindowStackMode.
+func (v *ConfigWindowValues) SetStackMode(StackMode StackMode) *ConfigWindowValues {
+	v.mask |= ConfigWindowStackMode
+	v.values[6] = uint32(StackMode)
+	return v
+}
+
+// Mask returns the value mask of the values that have been set.
+func (v *ConfigWindowValues) Mask() ConfigWindow {
+	return v.mask
+}
+
+// List returns the values that have been set, in the order defined by the protocol.
+func (v *ConfigWindowValues) List() []uint32 {
+	list := make([]uint32, 0, len(v.values))
+	for i, value := range v.values {
+		if v.mask&(1<<uint(i)) != 0 {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
 // ConfigureNotify is the event number for a ConfigureNotifyEvent.
 const ConfigureNotify = 22
 
+// ConfigureNotifyEvent is the type of ConfigureNotify events.
+//
+// A window was reconfigured.
+//
+// See also ConfigureWindow.
 type ConfigureNotifyEvent struct {
 	Sequence uint16
 	// padding: 1 bytes
-	Event            Window
-	Window           Window
-	AboveSibling     Window
-	X                int16
-	Y                int16
-	Width            uint16
-	Height           uint16
-	BorderWidth      uint16
+
+	// The reconfigured window or its parent, depending on whether
+	// `StructureNotify` or `SubstructureNotify` was selected.
+	Event Window
+
+	// The window whose size, position, border, and/or stacking order was
+	// changed.
+	Window Window
+
+	// If `WindowNone`, the `window` is on the bottom of the stack with respect
+	// to sibling windows. However, if set to a sibling window, the `window` is
+	// placed on top of this sibling window.
+	AboveSibling Window
+
+	// The X coordinate of the upper-left outside corner of `window`, relative
+	// to the parent window's origin.
+	X int16
+
+	// The Y coordinate of the upper-left outside corner of `window`, relative
+	// to the parent window's origin.
+	Y int16
+
+	// The inside width of `window`, not including the border.
+	Width uint16
+
+	// The inside height of `window`, not including the border.
+	Height uint16
+
+	// The border width of `window`.
+	BorderWidth uint16
+
+	// Window managers should ignore this window if `override_redirect` is 1.
 	OverrideRedirect bool
 	// padding: 1 bytes
 }
 
-// ConfigureNotifyEventNew constructs a ConfigureNotifyEvent value that implements xgb.Event from a byte slice.
-func ConfigureNotifyEventNew(buf []byte) xgb.Event {
+// ConfigureNotifyEventNew constructs a ConfigureNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func ConfigureNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := ConfigureNotifyEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Event = Window(xgb.Get32(buf[b:]))
+	v.Event = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Window = Window(xgb.Get32(buf[b:]))
+	v.Window = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.AboveSibling = Window(xgb.Get32(buf[b:]))
+	v.AboveSibling = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.X = int16(xgb.Get16(buf[b:]))
+	v.X = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Y = int16(xgb.Get16(buf[b:]))
+	v.Y = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
-	v.BorderWidth = xgb.Get16(buf[b:])
+	v.BorderWidth = order.Get16(buf[b:])
 	b += 2
 
 	if buf[b] == 1 {
@@ -1430,8 +1895,8 @@ func ConfigureNotifyEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a ConfigureNotifyEvent value to a byte slice.
-func (v ConfigureNotifyEvent) Bytes() []byte {
+// Bytes writes a ConfigureNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v ConfigureNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -1443,28 +1908,28 @@ func (v ConfigureNotifyEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Event))
+	order.Put32(buf[b:], uint32(v.Event))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.AboveSibling))
+	order.Put32(buf[b:], uint32(v.AboveSibling))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(v.X))
+	order.Put16(buf[b:], uint16(v.X))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.Y))
+	order.Put16(buf[b:], uint16(v.Y))
 	b += 2
 
-	xgb.Put16(buf[b:], v.Width)
+	order.Put16(buf[b:], v.Width)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Height)
+	order.Put16(buf[b:], v.Height)
 	b += 2
 
-	xgb.Put16(buf[b:], v.BorderWidth)
+	order.Put16(buf[b:], v.BorderWidth)
 	b += 2
 
 	if v.OverrideRedirect {
@@ -1502,6 +1967,12 @@ func (v ConfigureNotifyEvent) String() string {
 	return "ConfigureNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the ConfigureNotify event is about.
+// It is used to subscribe to the events of a single window.
+func (v ConfigureNotifyEvent) EventWindow() uint32 {
+	return uint32(v.Event)
+}
+
 func init() {
 	xgb.NewEventFuncs[22] = ConfigureNotifyEventNew
 }
@@ -1511,7 +1982,7 @@ const ConfigureRequest = 23
 
 type ConfigureRequestEvent struct {
 	Sequence    uint16
-	StackMode   byte
+	StackMode   StackMode
 	Parent      Window
 	Window      Window
 	Sibling     Window
@@ -1520,52 +1991,52 @@ type ConfigureRequestEvent struct {
 	Width       uint16
 	Height      uint16
 	BorderWidth uint16
-	ValueMask   uint16
+	ValueMask   ConfigWindow
 }
 
-// ConfigureRequestEventNew constructs a ConfigureRequestEvent value that implements xgb.Event from a byte slice.
-func ConfigureRequestEventNew(buf []byte) xgb.Event {
+// ConfigureRequestEventNew constructs a ConfigureRequestEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func ConfigureRequestEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := ConfigureRequestEvent{}
 	b := 1 // don't read event number
 
-	v.StackMode = buf[b]
+	v.StackMode = StackMode(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Parent = Window(xgb.Get32(buf[b:]))
+	v.Parent = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Window = Window(xgb.Get32(buf[b:]))
+	v.Window = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Sibling = Window(xgb.Get32(buf[b:]))
+	v.Sibling = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.X = int16(xgb.Get16(buf[b:]))
+	v.X = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Y = int16(xgb.Get16(buf[b:]))
+	v.Y = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
-	v.BorderWidth = xgb.Get16(buf[b:])
+	v.BorderWidth = order.Get16(buf[b:])
 	b += 2
 
-	v.ValueMask = xgb.Get16(buf[b:])
+	v.ValueMask = ConfigWindow(order.Get16(buf[b:]))
 	b += 2
 
 	return v
 }
 
-// Bytes writes a ConfigureRequestEvent value to a byte slice.
-func (v ConfigureRequestEvent) Bytes() []byte {
+// Bytes writes a ConfigureRequestEvent value to a byte slice, in the byte order 'order'.
+func (v ConfigureRequestEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -1573,36 +2044,36 @@ func (v ConfigureRequestEvent) Bytes() []byte {
 	buf[b] = 23
 	b += 1
 
-	buf[b] = v.StackMode
+	buf[b] = byte(v.StackMode)
 	b += 1
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Parent))
+	order.Put32(buf[b:], uint32(v.Parent))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Sibling))
+	order.Put32(buf[b:], uint32(v.Sibling))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(v.X))
+	order.Put16(buf[b:], uint16(v.X))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.Y))
+	order.Put16(buf[b:], uint16(v.Y))
 	b += 2
 
-	xgb.Put16(buf[b:], v.Width)
+	order.Put16(buf[b:], v.Width)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Height)
+	order.Put16(buf[b:], v.Height)
 	b += 2
 
-	xgb.Put16(buf[b:], v.BorderWidth)
+	order.Put16(buf[b:], v.BorderWidth)
 	b += 2
 
-	xgb.Put16(buf[b:], v.ValueMask)
+	order.Put16(buf[b:], uint16(v.ValueMask))
 	b += 2
 
 	return buf
@@ -1619,7 +2090,7 @@ func (v ConfigureRequestEvent) SequenceId() uint16 {
 func (v ConfigureRequestEvent) String() string {
 	fieldVals := make([]string, 0, 10)
 	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
-	fieldVals = append(fieldVals, xgb.Sprintf("StackMode: %d", v.StackMode))
+	fieldVals = append(fieldVals, xgb.Sprintf("StackMode: %s", v.StackMode))
 	fieldVals = append(fieldVals, xgb.Sprintf("Parent: %d", v.Parent))
 	fieldVals = append(fieldVals, xgb.Sprintf("Window: %d", v.Window))
 	fieldVals = append(fieldVals, xgb.Sprintf("Sibling: %d", v.Sibling))
@@ -1628,19 +2099,38 @@ func (v ConfigureRequestEvent) String() string {
 	fieldVals = append(fieldVals, xgb.Sprintf("Width: %d", v.Width))
 	fieldVals = append(fieldVals, xgb.Sprintf("Height: %d", v.Height))
 	fieldVals = append(fieldVals, xgb.Sprintf("BorderWidth: %d", v.BorderWidth))
-	fieldVals = append(fieldVals, xgb.Sprintf("ValueMask: %d", v.ValueMask))
+	fieldVals = append(fieldVals, xgb.Sprintf("ValueMask: %s", v.ValueMask))
 	return "ConfigureRequest {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the ConfigureRequest event is about.
+// It is used to subscribe to the events of a single window.
+func (v ConfigureRequestEvent) EventWindow() uint32 {
+	return uint32(v.Window)
+}
+
 func init() {
 	xgb.NewEventFuncs[23] = ConfigureRequestEventNew
 }
 
+type CoordMode byte
+
 const (
-	CoordModeOrigin   = 0
-	CoordModePrevious = 1
+	CoordModeOrigin   CoordMode = 0
+	CoordModePrevious CoordMode = 1
 )
 
+// String returns the name of the CoordMode item with this value.
+func (v CoordMode) String() string {
+	switch v {
+	case CoordModeOrigin:
+		return "Origin"
+	case CoordModePrevious:
+		return "Previous"
+	}
+	return xgb.Sprintf("CoordMode(%d)", byte(v))
+}
+
 // CreateNotify is the event number for a CreateNotifyEvent.
 const CreateNotify = 16
 
@@ -1658,35 +2148,35 @@ type CreateNotifyEvent struct {
 	// padding: 1 bytes
 }
 
-// CreateNotifyEventNew constructs a CreateNotifyEvent value that implements xgb.Event from a byte slice.
-func CreateNotifyEventNew(buf []byte) xgb.Event {
+// CreateNotifyEventNew constructs a CreateNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func CreateNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := CreateNotifyEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Parent = Window(xgb.Get32(buf[b:]))
+	v.Parent = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Window = Window(xgb.Get32(buf[b:]))
+	v.Window = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.X = int16(xgb.Get16(buf[b:]))
+	v.X = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Y = int16(xgb.Get16(buf[b:]))
+	v.Y = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
-	v.BorderWidth = xgb.Get16(buf[b:])
+	v.BorderWidth = order.Get16(buf[b:])
 	b += 2
 
 	if buf[b] == 1 {
@@ -1701,8 +2191,8 @@ func CreateNotifyEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a CreateNotifyEvent value to a byte slice.
-func (v CreateNotifyEvent) Bytes() []byte {
+// Bytes writes a CreateNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v CreateNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -1714,25 +2204,25 @@ func (v CreateNotifyEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Parent))
+	order.Put32(buf[b:], uint32(v.Parent))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(v.X))
+	order.Put16(buf[b:], uint16(v.X))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.Y))
+	order.Put16(buf[b:], uint16(v.Y))
 	b += 2
 
-	xgb.Put16(buf[b:], v.Width)
+	order.Put16(buf[b:], v.Width)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Height)
+	order.Put16(buf[b:], v.Height)
 	b += 2
 
-	xgb.Put16(buf[b:], v.BorderWidth)
+	order.Put16(buf[b:], v.BorderWidth)
 	b += 2
 
 	if v.OverrideRedirect {
@@ -1769,6 +2259,12 @@ func (v CreateNotifyEvent) String() string {
 	return "CreateNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the CreateNotify event is about.
+// It is used to subscribe to the events of a single window.
+func (v CreateNotifyEvent) EventWindow() uint32 {
+	return uint32(v.Window)
+}
+
 func init() {
 	xgb.NewEventFuncs[16] = CreateNotifyEventNew
 }
@@ -1788,9 +2284,9 @@ const BadCursor = 6
 
 type CursorError ValueError
 
-// CursorErrorNew constructs a CursorError value that implements xgb.Error from a byte slice.
-func CursorErrorNew(buf []byte) xgb.Error {
-	v := CursorError(ValueErrorNew(buf).(ValueError))
+// CursorErrorNew constructs a CursorError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func CursorErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := CursorError(ValueErrorNew(buf, order).(ValueError))
 	v.NiceName = "Cursor"
 	return v
 }
@@ -1825,24 +2321,226 @@ const (
 	CursorNone = 0
 )
 
+type Cw uint32
+
 const (
-	CwBackPixmap       = 1
-	CwBackPixel        = 2
-	CwBorderPixmap     = 4
-	CwBorderPixel      = 8
-	CwBitGravity       = 16
-	CwWinGravity       = 32
-	CwBackingStore     = 64
-	CwBackingPlanes    = 128
-	CwBackingPixel     = 256
-	CwOverrideRedirect = 512
-	CwSaveUnder        = 1024
-	CwEventMask        = 2048
-	CwDontPropagate    = 4096
-	CwColormap         = 8192
-	CwCursor           = 16384
+	CwBackPixmap       Cw = 1
+	CwBackPixel        Cw = 2
+	CwBorderPixmap     Cw = 4
+	CwBorderPixel      Cw = 8
+	CwBitGravity       Cw = 16
+	CwWinGravity       Cw = 32
+	CwBackingStore     Cw = 64
+	CwBackingPlanes    Cw = 128
+	CwBackingPixel     Cw = 256
+	CwOverrideRedirect Cw = 512
+	CwSaveUnder        Cw = 1024
+	CwEventMask        Cw = 2048
+	CwDontPropagate    Cw = 4096
+	CwColormap         Cw = 8192
+	CwCursor           Cw = 16384
 )
 
+// String returns the names of the Cw bits set in a value.
+func (v Cw) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 15)
+	if v&CwBackPixmap != 0 {
+		names = append(names, "BackPixmap")
+		v &^= CwBackPixmap
+	}
+	if v&CwBackPixel != 0 {
+		names = append(names, "BackPixel")
+		v &^= CwBackPixel
+	}
+	if v&CwBorderPixmap != 0 {
+		names = append(names, "BorderPixmap")
+		v &^= CwBorderPixmap
+	}
+	if v&CwBorderPixel != 0 {
+		names = append(names, "BorderPixel")
+		v &^= CwBorderPixel
+	}
+	if v&CwBitGravity != 0 {
+		names = append(names, "BitGravity")
+		v &^= CwBitGravity
+	}
+	if v&CwWinGravity != 0 {
+		names = append(names, "WinGravity")
+		v &^= CwWinGravity
+	}
+	if v&CwBackingStore != 0 {
+		names = append(names, "BackingStore")
+		v &^= CwBackingStore
+	}
+	if v&CwBackingPlanes != 0 {
+		names = append(names, "BackingPlanes")
+		v &^= CwBackingPlanes
+	}
+	if v&CwBackingPixel != 0 {
+		names = append(names, "BackingPixel")
+		v &^= CwBackingPixel
+	}
+	if v&CwOverrideRedirect != 0 {
+		names = append(names, "OverrideRedirect")
+		v &^= CwOverrideRedirect
+	}
+	if v&CwSaveUnder != 0 {
+		names = append(names, "SaveUnder")
+		v &^= CwSaveUnder
+	}
+	if v&CwEventMask != 0 {
+		names = append(names, "EventMask")
+		v &^= CwEventMask
+	}
+	if v&CwDontPropagate != 0 {
+		names = append(names, "DontPropagate")
+		v &^= CwDontPropagate
+	}
+	if v&CwColormap != 0 {
+		names = append(names, "Colormap")
+		v &^= CwColormap
+	}
+	if v&CwCursor != 0 {
+		names = append(names, "Cursor")
+		v &^= CwCursor
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint32(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
+// CwValues builds a value mask and a value list out of Cw values.
+// The values are listed in the order defined by the protocol, no matter the order they are set in.
+type CwValues struct {
+	mask   Cw
+	values [15]uint32
+}
+
+// SetBackPixmap sets the value for CwBackPixmap.
+func (v *CwValues) SetBackPixmap(BackPixmap uint32) *CwValues {
+	v.mask |= CwBackPixmap
+	v.values[0] = BackPixmap
+	return v
+}
+
+// SetBackPixel sets the value for CwBackPixel.
+func (v *CwValues) SetBackPixel(BackPixel uint32) *CwValues {
+	v.mask |= CwBackPixel
+	v.values[1] = BackPixel
+	return v
+}
+
+// SetBorderPixmap sets the value for CwBorderPixmap.
+func (v *CwValues) SetBorderPixmap(BorderPixmap uint32) *CwValues {
+	v.mask |= CwBorderPixmap
+	v.values[2] = BorderPixmap
+	return v
+}
+
+// SetBorderPixel sets the value for CwBorderPixel.
+func (v *CwValues) SetBorderPixel(BorderPixel uint32) *CwValues {
+	v.mask |= CwBorderPixel
+	v.values[3] = BorderPixel
+	return v
+}
+
+// SetBitGravity sets the value for CwBitGravity.
+func (v *CwValues) SetBitGravity(BitGravity Gravity) *CwValues {
+	v.mask |= CwBitGravity
+	v.values[4] = uint32(BitGravity)
+	return v
+}
+
+// SetWinGravity sets the value for CwWinGravity.
+func (v *CwValues) SetWinGravity(WinGravity Gravity) *CwValues {
+	v.mask |= CwWinGravity
+	v.values[5] = uint32(WinGravity)
+	return v
+}
+
+// SetBackingStore sets the value for CwBackingStore.
+func (v *CwValues) SetBackingStore(BackingStore BackingStore) *CwValues {
+	v.mask |= CwBackingStore
+	v.values[6] = uint32(BackingStore)
+	return v
+}
+
+// SetBackingPlanes sets the value for CwBackingPlanes.
+func (v *CwValues) SetBackingPlanes(BackingPlanes uint32) *CwValues {
+	v.mask |= CwBackingPlanes
+	v.values[7] = BackingPlanes
+	return v
+}
+
+// SetBackingPixel sets the value for CwBackingPixel.
+func (v *CwValues) SetBackingPixel(BackingPixel uint32) *CwValues {
+	v.mask |= CwBackingPixel
+	v.values[8] = BackingPixel
+	return v
+}
+
+// SetOverrideRedirect sets the value for CwOverrideRedirect.
+func (v *CwValues) SetOverrideRedirect(OverrideRedirect uint32) *CwValues {
+	v.mask |= CwOverrideRedirect
+	v.values[9] = OverrideRedirect
+	return v
+}
+
+// SetSaveUnder sets the value for CwSaveUnder.
+func (v *CwValues) SetSaveUnder(SaveUnder uint32) *CwValues {
+	v.mask |= CwSaveUnder
+	v.values[10] = SaveUnder
+	return v
+}
+
+// SetEventMask sets the value for CwEventMask.
+func (v *CwValues) SetEventMask(EventMask EventMask) *CwValues {
+	v.mask |= CwEventMask
+	v.values[11] = uint32(EventMask)
+	return v
+}
+
+// SetDontPropagate sets the value for CwDontPropagate.
+func (v *CwValues) SetDontPropagate(DontPropagate EventMask) *CwValues {
+	v.mask |= CwDontPropagate
+	v.values[12] = uint32(DontPropagate)
+	return v
+}
+
+// SetColormap sets the value for CwColormap.
+func (v *CwValues) SetColormap(Colormap uint32) *CwValues {
+	v.mask |= CwColormap
+	v.values[13] = Colormap
+	return v
+}
+
+// SetCursor sets the value for CwCursor.
+func (v *CwValues) SetCursor(Cursor uint32) *CwValues {
+	v.mask |= CwCursor
+	v.values[14] = Cursor
+	return v
+}
+
+// Mask returns the value mask of the values that have been set.
+func (v *CwValues) Mask() Cw {
+	return v.mask
+}
+
+// List returns the values that have been set, in the order defined by the protocol.
+func (v *CwValues) List() []uint32 {
+	list := make([]uint32, 0, len(v.values))
+	for i, value := range v.values {
+		if v.mask&(1<<uint(i)) != 0 {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
 type DepthInfo struct {
 	Depth byte
 	// padding: 1 bytes
@@ -1852,7 +2550,7 @@ type DepthInfo struct {
 }
 
 // DepthInfoRead reads a byte slice into a DepthInfo value.
-func DepthInfoRead(buf []byte, v *DepthInfo) int {
+func DepthInfoRead(buf []byte, order xgb.ByteOrder, v *DepthInfo) int {
 	b := 0
 
 	v.Depth = buf[b]
@@ -1860,29 +2558,29 @@ func DepthInfoRead(buf []byte, v *DepthInfo) int {
 
 	b += 1 // padding
 
-	v.VisualsLen = xgb.Get16(buf[b:])
+	v.VisualsLen = order.Get16(buf[b:])
 	b += 2
 
 	b += 4 // padding
 
 	v.Visuals = make([]VisualInfo, v.VisualsLen)
-	b += VisualInfoReadList(buf[b:], v.Visuals)
+	b += VisualInfoReadList(buf[b:], order, v.Visuals)
 
 	return b
 }
 
 // DepthInfoReadList reads a byte slice into a list of DepthInfo values.
-func DepthInfoReadList(buf []byte, dest []DepthInfo) int {
+func DepthInfoReadList(buf []byte, order xgb.ByteOrder, dest []DepthInfo) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = DepthInfo{}
-		b += DepthInfoRead(buf[b:], &dest[i])
+		b += DepthInfoRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a DepthInfo value to a byte slice.
-func (v DepthInfo) Bytes() []byte {
+// Bytes writes a DepthInfo value to a byte slice, in the byte order 'order'.
+func (v DepthInfo) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, (8 + xgb.Pad((int(v.VisualsLen) * 24))))
 	b := 0
 
@@ -1891,22 +2589,22 @@ func (v DepthInfo) Bytes() []byte {
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], v.VisualsLen)
+	order.Put16(buf[b:], v.VisualsLen)
 	b += 2
 
 	b += 4 // padding
 
-	b += VisualInfoListBytes(buf[b:], v.Visuals)
+	b += VisualInfoListBytes(buf[b:], order, v.Visuals)
 
 	return buf[:b]
 }
 
 // DepthInfoListBytes writes a list of DepthInfo values to a byte slice.
-func DepthInfoListBytes(buf []byte, list []DepthInfo) int {
+func DepthInfoListBytes(buf []byte, order xgb.ByteOrder, list []DepthInfo) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -1925,34 +2623,44 @@ func DepthInfoListSize(list []DepthInfo) int {
 // DestroyNotify is the event number for a DestroyNotifyEvent.
 const DestroyNotify = 17
 
+// DestroyNotifyEvent is the type of DestroyNotify events.
+//
+// A window is destroyed.
+//
+// See also DestroyWindow.
 type DestroyNotifyEvent struct {
 	Sequence uint16
 	// padding: 1 bytes
-	Event  Window
+
+	// The reconfigured window or its parent, depending on whether
+	// `StructureNotify` or `SubstructureNotify` was selected.
+	Event Window
+
+	// The window that is destroyed.
 	Window Window
 }
 
-// DestroyNotifyEventNew constructs a DestroyNotifyEvent value that implements xgb.Event from a byte slice.
-func DestroyNotifyEventNew(buf []byte) xgb.Event {
+// DestroyNotifyEventNew constructs a DestroyNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func DestroyNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := DestroyNotifyEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Event = Window(xgb.Get32(buf[b:]))
+	v.Event = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Window = Window(xgb.Get32(buf[b:]))
+	v.Window = Window(order.Get32(buf[b:]))
 	b += 4
 
 	return v
 }
 
-// Bytes writes a DestroyNotifyEvent value to a byte slice.
-func (v DestroyNotifyEvent) Bytes() []byte {
+// Bytes writes a DestroyNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v DestroyNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -1964,10 +2672,10 @@ func (v DestroyNotifyEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Event))
+	order.Put32(buf[b:], uint32(v.Event))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
 	return buf
@@ -1989,6 +2697,12 @@ func (v DestroyNotifyEvent) String() string {
 	return "DestroyNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the DestroyNotify event is about.
+// It is used to subscribe to the events of a single window.
+func (v DestroyNotifyEvent) EventWindow() uint32 {
+	return uint32(v.Event)
+}
+
 func init() {
 	xgb.NewEventFuncs[17] = DestroyNotifyEventNew
 }
@@ -2008,9 +2722,9 @@ const BadDrawable = 9
 
 type DrawableError ValueError
 
-// DrawableErrorNew constructs a DrawableError value that implements xgb.Error from a byte slice.
-func DrawableErrorNew(buf []byte) xgb.Error {
-	v := DrawableError(ValueErrorNew(buf).(ValueError))
+// DrawableErrorNew constructs a DrawableError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func DrawableErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := DrawableError(ValueErrorNew(buf, order).(ValueError))
 	v.NiceName = "Drawable"
 	return v
 }
@@ -2044,61 +2758,84 @@ func init() {
 // EnterNotify is the event number for a EnterNotifyEvent.
 const EnterNotify = 7
 
+// EnterNotifyEvent is the type of EnterNotify events.
+//
+// The pointer is in a different window.
 type EnterNotifyEvent struct {
-	Sequence        uint16
-	Detail          byte
-	Time            Timestamp
-	Root            Window
-	Event           Window
-	Child           Window
-	RootX           int16
-	RootY           int16
-	EventX          int16
+	Sequence uint16
+	Detail   NotifyDetail
+	Time     Timestamp
+
+	// The root window for the final cursor position.
+	Root Window
+
+	// The window on which the event was generated.
+	Event Window
+
+	// If the `event` window has subwindows and the final pointer position is
+	// in one of them, then `child` is set to that subwindow, `WindowNone`
+	// otherwise.
+	Child Window
+
+	// The pointer X coordinate relative to `root`'s origin at the time of the
+	// event.
+	RootX int16
+
+	// The pointer Y coordinate relative to `root`'s origin at the time of the
+	// event.
+	RootY int16
+
+	// If `event` is on the same screen as `root`, this is the pointer X
+	// coordinate relative to the event window's origin.
+	EventX int16
+
+	// If `event` is on the same screen as `root`, this is the pointer Y
+	// coordinate relative to the event window's origin.
 	EventY          int16
-	State           uint16
-	Mode            byte
+	State           KeyButMask
+	Mode            NotifyMode
 	SameScreenFocus byte
 }
 
-// EnterNotifyEventNew constructs a EnterNotifyEvent value that implements xgb.Event from a byte slice.
-func EnterNotifyEventNew(buf []byte) xgb.Event {
+// EnterNotifyEventNew constructs a EnterNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func EnterNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := EnterNotifyEvent{}
 	b := 1 // don't read event number
 
-	v.Detail = buf[b]
+	v.Detail = NotifyDetail(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Time = Timestamp(xgb.Get32(buf[b:]))
+	v.Time = Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.Root = Window(xgb.Get32(buf[b:]))
+	v.Root = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Event = Window(xgb.Get32(buf[b:]))
+	v.Event = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Child = Window(xgb.Get32(buf[b:]))
+	v.Child = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.RootX = int16(xgb.Get16(buf[b:]))
+	v.RootX = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.RootY = int16(xgb.Get16(buf[b:]))
+	v.RootY = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.EventX = int16(xgb.Get16(buf[b:]))
+	v.EventX = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.EventY = int16(xgb.Get16(buf[b:]))
+	v.EventY = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.State = xgb.Get16(buf[b:])
+	v.State = KeyButMask(order.Get16(buf[b:]))
 	b += 2
 
-	v.Mode = buf[b]
+	v.Mode = NotifyMode(buf[b])
 	b += 1
 
 	v.SameScreenFocus = buf[b]
@@ -2107,8 +2844,8 @@ func EnterNotifyEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a EnterNotifyEvent value to a byte slice.
-func (v EnterNotifyEvent) Bytes() []byte {
+// Bytes writes a EnterNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v EnterNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -2116,39 +2853,39 @@ func (v EnterNotifyEvent) Bytes() []byte {
 	buf[b] = 7
 	b += 1
 
-	buf[b] = v.Detail
+	buf[b] = byte(v.Detail)
 	b += 1
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Time))
+	order.Put32(buf[b:], uint32(v.Time))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Root))
+	order.Put32(buf[b:], uint32(v.Root))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Event))
+	order.Put32(buf[b:], uint32(v.Event))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Child))
+	order.Put32(buf[b:], uint32(v.Child))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(v.RootX))
+	order.Put16(buf[b:], uint16(v.RootX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.RootY))
+	order.Put16(buf[b:], uint16(v.RootY))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.EventX))
+	order.Put16(buf[b:], uint16(v.EventX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.EventY))
+	order.Put16(buf[b:], uint16(v.EventY))
 	b += 2
 
-	xgb.Put16(buf[b:], v.State)
+	order.Put16(buf[b:], uint16(v.State))
 	b += 2
 
-	buf[b] = v.Mode
+	buf[b] = byte(v.Mode)
 	b += 1
 
 	buf[b] = v.SameScreenFocus
@@ -2168,7 +2905,7 @@ func (v EnterNotifyEvent) SequenceId() uint16 {
 func (v EnterNotifyEvent) String() string {
 	fieldVals := make([]string, 0, 12)
 	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
-	fieldVals = append(fieldVals, xgb.Sprintf("Detail: %d", v.Detail))
+	fieldVals = append(fieldVals, xgb.Sprintf("Detail: %s", v.Detail))
 	fieldVals = append(fieldVals, xgb.Sprintf("Time: %d", v.Time))
 	fieldVals = append(fieldVals, xgb.Sprintf("Root: %d", v.Root))
 	fieldVals = append(fieldVals, xgb.Sprintf("Event: %d", v.Event))
@@ -2177,86 +2914,226 @@ func (v EnterNotifyEvent) String() string {
 	fieldVals = append(fieldVals, xgb.Sprintf("RootY: %d", v.RootY))
 	fieldVals = append(fieldVals, xgb.Sprintf("EventX: %d", v.EventX))
 	fieldVals = append(fieldVals, xgb.Sprintf("EventY: %d", v.EventY))
-	fieldVals = append(fieldVals, xgb.Sprintf("State: %d", v.State))
-	fieldVals = append(fieldVals, xgb.Sprintf("Mode: %d", v.Mode))
+	fieldVals = append(fieldVals, xgb.Sprintf("State: %s", v.State))
+	fieldVals = append(fieldVals, xgb.Sprintf("Mode: %s", v.Mode))
 	fieldVals = append(fieldVals, xgb.Sprintf("SameScreenFocus: %d", v.SameScreenFocus))
 	return "EnterNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the EnterNotify event is about.
+// It is used to subscribe to the events of a single window.
+func (v EnterNotifyEvent) EventWindow() uint32 {
+	return uint32(v.Event)
+}
+
 func init() {
 	xgb.NewEventFuncs[7] = EnterNotifyEventNew
 }
 
+type EventMask uint32
+
 const (
-	EventMaskNoEvent              = 0
-	EventMaskKeyPress             = 1
-	EventMaskKeyRelease           = 2
-	EventMaskButtonPress          = 4
-	EventMaskButtonRelease        = 8
-	EventMaskEnterWindow          = 16
-	EventMaskLeaveWindow          = 32
-	EventMaskPointerMotion        = 64
-	EventMaskPointerMotionHint    = 128
-	EventMaskButton1Motion        = 256
-	EventMaskButton2Motion        = 512
-	EventMaskButton3Motion        = 1024
-	EventMaskButton4Motion        = 2048
-	EventMaskButton5Motion        = 4096
-	EventMaskButtonMotion         = 8192
-	EventMaskKeymapState          = 16384
-	EventMaskExposure             = 32768
-	EventMaskVisibilityChange     = 65536
-	EventMaskStructureNotify      = 131072
-	EventMaskResizeRedirect       = 262144
-	EventMaskSubstructureNotify   = 524288
-	EventMaskSubstructureRedirect = 1048576
-	EventMaskFocusChange          = 2097152
-	EventMaskPropertyChange       = 4194304
-	EventMaskColorMapChange       = 8388608
-	EventMaskOwnerGrabButton      = 16777216
+	EventMaskNoEvent              EventMask = 0
+	EventMaskKeyPress             EventMask = 1
+	EventMaskKeyRelease           EventMask = 2
+	EventMaskButtonPress          EventMask = 4
+	EventMaskButtonRelease        EventMask = 8
+	EventMaskEnterWindow          EventMask = 16
+	EventMaskLeaveWindow          EventMask = 32
+	EventMaskPointerMotion        EventMask = 64
+	EventMaskPointerMotionHint    EventMask = 128
+	EventMaskButton1Motion        EventMask = 256
+	EventMaskButton2Motion        EventMask = 512
+	EventMaskButton3Motion        EventMask = 1024
+	EventMaskButton4Motion        EventMask = 2048
+	EventMaskButton5Motion        EventMask = 4096
+	EventMaskButtonMotion         EventMask = 8192
+	EventMaskKeymapState          EventMask = 16384
+	EventMaskExposure             EventMask = 32768
+	EventMaskVisibilityChange     EventMask = 65536
+	EventMaskStructureNotify      EventMask = 131072
+	EventMaskResizeRedirect       EventMask = 262144
+	EventMaskSubstructureNotify   EventMask = 524288
+	EventMaskSubstructureRedirect EventMask = 1048576
+	EventMaskFocusChange          EventMask = 2097152
+	EventMaskPropertyChange       EventMask = 4194304
+	EventMaskColorMapChange       EventMask = 8388608
+	EventMaskOwnerGrabButton      EventMask = 16777216
 )
 
-// Expose is the event number for a ExposeEvent.
-const Expose = 12
-
+// String returns the names of the EventMask bits set in a value.
+func (v EventMask) String() string {
+	if v == 0 {
+		return "NoEvent"
+	}
+	names := make([]string, 0, 25)
+	if v&EventMaskKeyPress != 0 {
+		names = append(names, "KeyPress")
+		v &^= EventMaskKeyPress
+	}
+	if v&EventMaskKeyRelease != 0 {
+		names = append(names, "KeyRelease")
+		v &^= EventMaskKeyRelease
+	}
+	if v&EventMaskButtonPress != 0 {
+		names = append(names, "ButtonPress")
+		v &^= EventMaskButtonPress
+	}
+	if v&EventMaskButtonRelease != 0 {
+		names = append(names, "ButtonRelease")
+		v &^= EventMaskButtonRelease
+	}
+	if v&EventMaskEnterWindow != 0 {
+		names = append(names, "EnterWindow")
+		v &^= EventMaskEnterWindow
+	}
+	if v&EventMaskLeaveWindow != 0 {
+		names = append(names, "LeaveWindow")
+		v &^= EventMaskLeaveWindow
+	}
+	if v&EventMaskPointerMotion != 0 {
+		names = append(names, "PointerMotion")
+		v &^= EventMaskPointerMotion
+	}
+	if v&EventMaskPointerMotionHint != 0 {
+		names = append(names, "PointerMotionHint")
+		v &^= EventMaskPointerMotionHint
+	}
+	if v&EventMaskButton1Motion != 0 {
+		names = append(names, "Button1Motion")
+		v &^= EventMaskButton1Motion
+	}
+	if v&EventMaskButton2Motion != 0 {
+		names = append(names, "Button2Motion")
+		v &^= EventMaskButton2Motion
+	}
+	if v&EventMaskButton3Motion != 0 {
+		names = append(names, "Button3Motion")
+		v &^= EventMaskButton3Motion
+	}
+	if v&EventMaskButton4Motion != 0 {
+		names = append(names, "Button4Motion")
+		v &^= EventMaskButton4Motion
+	}
+	if v&EventMaskButton5Motion != 0 {
+		names = append(names, "Button5Motion")
+		v &^= EventMaskButton5Motion
+	}
+	if v&EventMaskButtonMotion != 0 {
+		names = append(names, "ButtonMotion")
+		v &^= EventMaskButtonMotion
+	}
+	if v&EventMaskKeymapState != 0 {
+		names = append(names, "KeymapState")
+		v &^= EventMaskKeymapState
+	}
+	if v&EventMaskExposure != 0 {
+		names = append(names, "Exposure")
+		v &^= EventMaskExposure
+	}
+	if v&EventMaskVisibilityChange != 0 {
+		names = append(names, "VisibilityChange")
+		v &^= EventMaskVisibilityChange
+	}
+	if v&EventMaskStructureNotify != 0 {
+		names = append(names, "StructureNotify")
+		v &^= EventMaskStructureNotify
+	}
+	if v&EventMaskResizeRedirect != 0 {
+		names = append(names, "ResizeRedirect")
+		v &^= EventMaskResizeRedirect
+	}
+	if v&EventMaskSubstructureNotify != 0 {
+		names = append(names, "SubstructureNotify")
+		v &^= EventMaskSubstructureNotify
+	}
+	if v&EventMaskSubstructureRedirect != 0 {
+		names = append(names, "SubstructureRedirect")
+		v &^= EventMaskSubstructureRedirect
+	}
+	if v&EventMaskFocusChange != 0 {
+		names = append(names, "FocusChange")
+		v &^= EventMaskFocusChange
+	}
+	if v&EventMaskPropertyChange != 0 {
+		names = append(names, "PropertyChange")
+		v &^= EventMaskPropertyChange
+	}
+	if v&EventMaskColorMapChange != 0 {
+		names = append(names, "ColorMapChange")
+		v &^= EventMaskColorMapChange
+	}
+	if v&EventMaskOwnerGrabButton != 0 {
+		names = append(names, "OwnerGrabButton")
+		v &^= EventMaskOwnerGrabButton
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint32(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
+// Expose is the event number for a ExposeEvent.
+const Expose = 12
+
+// ExposeEvent is the type of Expose events.
+//
+// A window area needs to be redrawn.
 type ExposeEvent struct {
 	Sequence uint16
 	// padding: 1 bytes
+
+	// The exposed (damaged) window.
 	Window Window
-	X      uint16
-	Y      uint16
-	Width  uint16
+
+	// The X coordinate of the left-upper corner of the exposed rectangle,
+	// relative to the `window`'s origin.
+	X uint16
+
+	// The Y coordinate of the left-upper corner of the exposed rectangle,
+	// relative to the `window`'s origin.
+	Y uint16
+
+	// The width of the exposed rectangle.
+	Width uint16
+
+	// The height of the exposed rectangle.
 	Height uint16
-	Count  uint16
+
+	// The amount of `Expose` events following this one. Simple applications
+	// that do not want to optimize redisplay by distinguishing between
+	// subareas of its window can just ignore all Expose events with nonzero
+	// counts and perform full redisplays on events with zero counts.
+	Count uint16
 	// padding: 2 bytes
 }
 
-// ExposeEventNew constructs a ExposeEvent value that implements xgb.Event from a byte slice.
-func ExposeEventNew(buf []byte) xgb.Event {
+// ExposeEventNew constructs a ExposeEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func ExposeEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := ExposeEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Window = Window(xgb.Get32(buf[b:]))
+	v.Window = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.X = xgb.Get16(buf[b:])
+	v.X = order.Get16(buf[b:])
 	b += 2
 
-	v.Y = xgb.Get16(buf[b:])
+	v.Y = order.Get16(buf[b:])
 	b += 2
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
-	v.Count = xgb.Get16(buf[b:])
+	v.Count = order.Get16(buf[b:])
 	b += 2
 
 	b += 2 // padding
@@ -2264,8 +3141,8 @@ func ExposeEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a ExposeEvent value to a byte slice.
-func (v ExposeEvent) Bytes() []byte {
+// Bytes writes a ExposeEvent value to a byte slice, in the byte order 'order'.
+func (v ExposeEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -2277,22 +3154,22 @@ func (v ExposeEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
-	xgb.Put16(buf[b:], v.X)
+	order.Put16(buf[b:], v.X)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Y)
+	order.Put16(buf[b:], v.Y)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Width)
+	order.Put16(buf[b:], v.Width)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Height)
+	order.Put16(buf[b:], v.Height)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Count)
+	order.Put16(buf[b:], v.Count)
 	b += 2
 
 	b += 2 // padding
@@ -2320,62 +3197,142 @@ func (v ExposeEvent) String() string {
 	return "Expose {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the Expose event is about.
+// It is used to subscribe to the events of a single window.
+func (v ExposeEvent) EventWindow() uint32 {
+	return uint32(v.Window)
+}
+
 func init() {
 	xgb.NewEventFuncs[12] = ExposeEventNew
 }
 
+type Exposures byte
+
 const (
-	ExposuresNotAllowed = 0
-	ExposuresAllowed    = 1
-	ExposuresDefault    = 2
+	ExposuresNotAllowed Exposures = 0
+	ExposuresAllowed    Exposures = 1
+	ExposuresDefault    Exposures = 2
 )
 
+// String returns the name of the Exposures item with this value.
+func (v Exposures) String() string {
+	switch v {
+	case ExposuresNotAllowed:
+		return "NotAllowed"
+	case ExposuresAllowed:
+		return "Allowed"
+	case ExposuresDefault:
+		return "Default"
+	}
+	return xgb.Sprintf("Exposures(%d)", byte(v))
+}
+
+type Family byte
+
 const (
-	FamilyInternet          = 0
-	FamilyDECnet            = 1
-	FamilyChaos             = 2
-	FamilyServerInterpreted = 5
-	FamilyInternet6         = 6
+	FamilyInternet          Family = 0
+	FamilyDECnet            Family = 1
+	FamilyChaos             Family = 2
+	FamilyServerInterpreted Family = 5
+	FamilyInternet6         Family = 6
 )
 
+// String returns the name of the Family item with this value.
+func (v Family) String() string {
+	switch v {
+	case FamilyInternet:
+		return "Internet"
+	case FamilyDECnet:
+		return "DECnet"
+	case FamilyChaos:
+		return "Chaos"
+	case FamilyServerInterpreted:
+		return "ServerInterpreted"
+	case FamilyInternet6:
+		return "Internet6"
+	}
+	return xgb.Sprintf("Family(%d)", byte(v))
+}
+
+type FillRule uint32
+
 const (
-	FillRuleEvenOdd = 0
-	FillRuleWinding = 1
+	FillRuleEvenOdd FillRule = 0
+	FillRuleWinding FillRule = 1
 )
 
+// String returns the name of the FillRule item with this value.
+func (v FillRule) String() string {
+	switch v {
+	case FillRuleEvenOdd:
+		return "EvenOdd"
+	case FillRuleWinding:
+		return "Winding"
+	}
+	return xgb.Sprintf("FillRule(%d)", uint32(v))
+}
+
+type FillStyle uint32
+
 const (
-	FillStyleSolid          = 0
-	FillStyleTiled          = 1
-	FillStyleStippled       = 2
-	FillStyleOpaqueStippled = 3
+	FillStyleSolid          FillStyle = 0
+	FillStyleTiled          FillStyle = 1
+	FillStyleStippled       FillStyle = 2
+	FillStyleOpaqueStippled FillStyle = 3
 )
 
+// String returns the name of the FillStyle item with this value.
+func (v FillStyle) String() string {
+	switch v {
+	case FillStyleSolid:
+		return "Solid"
+	case FillStyleTiled:
+		return "Tiled"
+	case FillStyleStippled:
+		return "Stippled"
+	case FillStyleOpaqueStippled:
+		return "OpaqueStippled"
+	}
+	return xgb.Sprintf("FillStyle(%d)", uint32(v))
+}
+
 // FocusIn is the event number for a FocusInEvent.
 const FocusIn = 9
 
+// FocusInEvent is the type of FocusIn events.
+//
+// The input focus changed.
 type FocusInEvent struct {
 	Sequence uint16
-	Detail   byte
-	Event    Window
-	Mode     byte
+
+	// How the focus changed, with respect to the window hierarchy.
+	Detail NotifyDetail
+
+	// The window on which the focus event was generated. This is the window
+	// used by the X server to report the event.
+	Event Window
+
+	// Whether the focus change is the result of a grab, an ungrab or neither.
+	Mode NotifyMode
 	// padding: 3 bytes
 }
 
-// FocusInEventNew constructs a FocusInEvent value that implements xgb.Event from a byte slice.
-func FocusInEventNew(buf []byte) xgb.Event {
+// FocusInEventNew constructs a FocusInEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func FocusInEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := FocusInEvent{}
 	b := 1 // don't read event number
 
-	v.Detail = buf[b]
+	v.Detail = NotifyDetail(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Event = Window(xgb.Get32(buf[b:]))
+	v.Event = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Mode = buf[b]
+	v.Mode = NotifyMode(buf[b])
 	b += 1
 
 	b += 3 // padding
@@ -2383,8 +3340,8 @@ func FocusInEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a FocusInEvent value to a byte slice.
-func (v FocusInEvent) Bytes() []byte {
+// Bytes writes a FocusInEvent value to a byte slice, in the byte order 'order'.
+func (v FocusInEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -2392,15 +3349,15 @@ func (v FocusInEvent) Bytes() []byte {
 	buf[b] = 9
 	b += 1
 
-	buf[b] = v.Detail
+	buf[b] = byte(v.Detail)
 	b += 1
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Event))
+	order.Put32(buf[b:], uint32(v.Event))
 	b += 4
 
-	buf[b] = v.Mode
+	buf[b] = byte(v.Mode)
 	b += 1
 
 	b += 3 // padding
@@ -2419,12 +3376,18 @@ func (v FocusInEvent) SequenceId() uint16 {
 func (v FocusInEvent) String() string {
 	fieldVals := make([]string, 0, 4)
 	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
-	fieldVals = append(fieldVals, xgb.Sprintf("Detail: %d", v.Detail))
+	fieldVals = append(fieldVals, xgb.Sprintf("Detail: %s", v.Detail))
 	fieldVals = append(fieldVals, xgb.Sprintf("Event: %d", v.Event))
-	fieldVals = append(fieldVals, xgb.Sprintf("Mode: %d", v.Mode))
+	fieldVals = append(fieldVals, xgb.Sprintf("Mode: %s", v.Mode))
 	return "FocusIn {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the FocusIn event is about.
+// It is used to subscribe to the events of a single window.
+func (v FocusInEvent) EventWindow() uint32 {
+	return uint32(v.Event)
+}
+
 func init() {
 	xgb.NewEventFuncs[9] = FocusInEventNew
 }
@@ -2434,14 +3397,14 @@ const FocusOut = 10
 
 type FocusOutEvent FocusInEvent
 
-// FocusOutEventNew constructs a FocusOutEvent value that implements xgb.Event from a byte slice.
-func FocusOutEventNew(buf []byte) xgb.Event {
-	return FocusOutEvent(FocusInEventNew(buf).(FocusInEvent))
+// FocusOutEventNew constructs a FocusOutEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func FocusOutEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
+	return FocusOutEvent(FocusInEventNew(buf, order).(FocusInEvent))
 }
 
-// Bytes writes a FocusOutEvent value to a byte slice.
-func (v FocusOutEvent) Bytes() []byte {
-	return FocusInEvent(v).Bytes()
+// Bytes writes a FocusOutEvent value to a byte slice, in the byte order 'order'.
+func (v FocusOutEvent) Bytes(order xgb.ByteOrder) []byte {
+	return FocusInEvent(v).Bytes(order)
 }
 
 // SequenceId returns the sequence id attached to the FocusOut event.
@@ -2454,12 +3417,18 @@ func (v FocusOutEvent) SequenceId() uint16 {
 func (v FocusOutEvent) String() string {
 	fieldVals := make([]string, 0, 4)
 	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
-	fieldVals = append(fieldVals, xgb.Sprintf("Detail: %d", v.Detail))
+	fieldVals = append(fieldVals, xgb.Sprintf("Detail: %s", v.Detail))
 	fieldVals = append(fieldVals, xgb.Sprintf("Event: %d", v.Event))
-	fieldVals = append(fieldVals, xgb.Sprintf("Mode: %d", v.Mode))
+	fieldVals = append(fieldVals, xgb.Sprintf("Mode: %s", v.Mode))
 	return "FocusOut {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the FocusOut event is about.
+// It is used to subscribe to the events of a single window.
+func (v FocusOutEvent) EventWindow() uint32 {
+	return FocusInEvent(v).EventWindow()
+}
+
 func init() {
 	xgb.NewEventFuncs[10] = FocusOutEventNew
 }
@@ -2479,9 +3448,9 @@ const BadFont = 7
 
 type FontError ValueError
 
-// FontErrorNew constructs a FontError value that implements xgb.Error from a byte slice.
-func FontErrorNew(buf []byte) xgb.Error {
-	v := FontError(ValueErrorNew(buf).(ValueError))
+// FontErrorNew constructs a FontError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func FontErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := FontError(ValueErrorNew(buf, order).(ValueError))
 	v.NiceName = "Font"
 	return v
 }
@@ -2516,11 +3485,24 @@ const (
 	FontNone = 0
 )
 
+type FontDraw byte
+
 const (
-	FontDrawLeftToRight = 0
-	FontDrawRightToLeft = 1
+	FontDrawLeftToRight FontDraw = 0
+	FontDrawRightToLeft FontDraw = 1
 )
 
+// String returns the name of the FontDraw item with this value.
+func (v FontDraw) String() string {
+	switch v {
+	case FontDrawLeftToRight:
+		return "LeftToRight"
+	case FontDrawRightToLeft:
+		return "RightToLeft"
+	}
+	return xgb.Sprintf("FontDraw(%d)", byte(v))
+}
+
 type Fontable uint32
 
 func NewFontableId(c *xgb.Conn) (Fontable, error) {
@@ -2537,48 +3519,48 @@ type Fontprop struct {
 }
 
 // FontpropRead reads a byte slice into a Fontprop value.
-func FontpropRead(buf []byte, v *Fontprop) int {
+func FontpropRead(buf []byte, order xgb.ByteOrder, v *Fontprop) int {
 	b := 0
 
-	v.Name = Atom(xgb.Get32(buf[b:]))
+	v.Name = Atom(order.Get32(buf[b:]))
 	b += 4
 
-	v.Value = xgb.Get32(buf[b:])
+	v.Value = order.Get32(buf[b:])
 	b += 4
 
 	return b
 }
 
 // FontpropReadList reads a byte slice into a list of Fontprop values.
-func FontpropReadList(buf []byte, dest []Fontprop) int {
+func FontpropReadList(buf []byte, order xgb.ByteOrder, dest []Fontprop) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Fontprop{}
-		b += FontpropRead(buf[b:], &dest[i])
+		b += FontpropRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Fontprop value to a byte slice.
-func (v Fontprop) Bytes() []byte {
+// Bytes writes a Fontprop value to a byte slice, in the byte order 'order'.
+func (v Fontprop) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 8)
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.Name))
+	order.Put32(buf[b:], uint32(v.Name))
 	b += 4
 
-	xgb.Put32(buf[b:], v.Value)
+	order.Put32(buf[b:], v.Value)
 	b += 4
 
 	return buf[:b]
 }
 
 // FontpropListBytes writes a list of Fontprop values to a byte slice.
-func FontpropListBytes(buf []byte, list []Fontprop) int {
+func FontpropListBytes(buf []byte, order xgb.ByteOrder, list []Fontprop) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -2593,7 +3575,7 @@ type Format struct {
 }
 
 // FormatRead reads a byte slice into a Format value.
-func FormatRead(buf []byte, v *Format) int {
+func FormatRead(buf []byte, order xgb.ByteOrder, v *Format) int {
 	b := 0
 
 	v.Depth = buf[b]
@@ -2611,17 +3593,17 @@ func FormatRead(buf []byte, v *Format) int {
 }
 
 // FormatReadList reads a byte slice into a list of Format values.
-func FormatReadList(buf []byte, dest []Format) int {
+func FormatReadList(buf []byte, order xgb.ByteOrder, dest []Format) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Format{}
-		b += FormatRead(buf[b:], &dest[i])
+		b += FormatRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Format value to a byte slice.
-func (v Format) Bytes() []byte {
+// Bytes writes a Format value to a byte slice, in the byte order 'order'.
+func (v Format) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 8)
 	b := 0
 
@@ -2640,11 +3622,11 @@ func (v Format) Bytes() []byte {
 }
 
 // FormatListBytes writes a list of Format values to a byte slice.
-func FormatListBytes(buf []byte, list []Format) int {
+func FormatListBytes(buf []byte, order xgb.ByteOrder, list []Format) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -2656,9 +3638,9 @@ const BadGContext = 13
 
 type GContextError ValueError
 
-// GContextErrorNew constructs a GContextError value that implements xgb.Error from a byte slice.
-func GContextErrorNew(buf []byte) xgb.Error {
-	v := GContextError(ValueErrorNew(buf).(ValueError))
+// GContextErrorNew constructs a GContextError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func GContextErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := GContextError(ValueErrorNew(buf, order).(ValueError))
 	v.NiceName = "GContext"
 	return v
 }
@@ -2689,32 +3671,322 @@ func init() {
 	xgb.NewErrorFuncs[13] = GContextErrorNew
 }
 
+type Gc uint32
+
 const (
-	GcFunction           = 1
-	GcPlaneMask          = 2
-	GcForeground         = 4
-	GcBackground         = 8
-	GcLineWidth          = 16
-	GcLineStyle          = 32
-	GcCapStyle           = 64
-	GcJoinStyle          = 128
-	GcFillStyle          = 256
-	GcFillRule           = 512
-	GcTile               = 1024
-	GcStipple            = 2048
-	GcTileStippleOriginX = 4096
-	GcTileStippleOriginY = 8192
-	GcFont               = 16384
-	GcSubwindowMode      = 32768
-	GcGraphicsExposures  = 65536
-	GcClipOriginX        = 131072
-	GcClipOriginY        = 262144
-	GcClipMask           = 524288
-	GcDashOffset         = 1048576
-	GcDashList           = 2097152
-	GcArcMode            = 4194304
+	GcFunction           Gc = 1
+	GcPlaneMask          Gc = 2
+	GcForeground         Gc = 4
+	GcBackground         Gc = 8
+	GcLineWidth          Gc = 16
+	GcLineStyle          Gc = 32
+	GcCapStyle           Gc = 64
+	GcJoinStyle          Gc = 128
+	GcFillStyle          Gc = 256
+	GcFillRule           Gc = 512
+	GcTile               Gc = 1024
+	GcStipple            Gc = 2048
+	GcTileStippleOriginX Gc = 4096
+	GcTileStippleOriginY Gc = 8192
+	GcFont               Gc = 16384
+	GcSubwindowMode      Gc = 32768
+	GcGraphicsExposures  Gc = 65536
+	GcClipOriginX        Gc = 131072
+	GcClipOriginY        Gc = 262144
+	GcClipMask           Gc = 524288
+	GcDashOffset         Gc = 1048576
+	GcDashList           Gc = 2097152
+	GcArcMode            Gc = 4194304
 )
 
+// String returns the names of the Gc bits set in a value.
+func (v Gc) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 23)
+	if v&GcFunction != 0 {
+		names = append(names, "Function")
+		v &^= GcFunction
+	}
+	if v&GcPlaneMask != 0 {
+		names = append(names, "PlaneMask")
+		v &^= GcPlaneMask
+	}
+	if v&GcForeground != 0 {
+		names = append(names, "Foreground")
+		v &^= GcForeground
+	}
+	if v&GcBackground != 0 {
+		names = append(names, "Background")
+		v &^= GcBackground
+	}
+	if v&GcLineWidth != 0 {
+		names = append(names, "LineWidth")
+		v &^= GcLineWidth
+	}
+	if v&GcLineStyle != 0 {
+		names = append(names, "LineStyle")
+		v &^= GcLineStyle
+	}
+	if v&GcCapStyle != 0 {
+		names = append(names, "CapStyle")
+		v &^= GcCapStyle
+	}
+	if v&GcJoinStyle != 0 {
+		names = append(names, "JoinStyle")
+		v &^= GcJoinStyle
+	}
+	if v&GcFillStyle != 0 {
+		names = append(names, "FillStyle")
+		v &^= GcFillStyle
+	}
+	if v&GcFillRule != 0 {
+		names = append(names, "FillRule")
+		v &^= GcFillRule
+	}
+	if v&GcTile != 0 {
+		names = append(names, "Tile")
+		v &^= GcTile
+	}
+	if v&GcStipple != 0 {
+		names = append(names, "Stipple")
+		v &^= GcStipple
+	}
+	if v&GcTileStippleOriginX != 0 {
+		names = append(names, "TileStippleOriginX")
+		v &^= GcTileStippleOriginX
+	}
+	if v&GcTileStippleOriginY != 0 {
+		names = append(names, "TileStippleOriginY")
+		v &^= GcTileStippleOriginY
+	}
+	if v&GcFont != 0 {
+		names = append(names, "Font")
+		v &^= GcFont
+	}
+	if v&GcSubwindowMode != 0 {
+		names = append(names, "SubwindowMode")
+		v &^= GcSubwindowMode
+	}
+	if v&GcGraphicsExposures != 0 {
+		names = append(names, "GraphicsExposures")
+		v &^= GcGraphicsExposures
+	}
+	if v&GcClipOriginX != 0 {
+		names = append(names, "ClipOriginX")
+		v &^= GcClipOriginX
+	}
+	if v&GcClipOriginY != 0 {
+		names = append(names, "ClipOriginY")
+		v &^= GcClipOriginY
+	}
+	if v&GcClipMask != 0 {
+		names = append(names, "ClipMask")
+		v &^= GcClipMask
+	}
+	if v&GcDashOffset != 0 {
+		names = append(names, "DashOffset")
+		v &^= GcDashOffset
+	}
+	if v&GcDashList != 0 {
+		names = append(names, "DashList")
+		v &^= GcDashList
+	}
+	if v&GcArcMode != 0 {
+		names = append(names, "ArcMode")
+		v &^= GcArcMode
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint32(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
+// GcValues builds a value mask and a value list out of Gc values.
+// The values are listed in the order defined by the protocol, no matter the order they are set in.
+type GcValues struct {
+	mask   Gc
+	values [23]uint32
+}
+
+// SetFunction sets the value for GcFunction.
+func (v *GcValues) SetFunction(Function Gx) *GcValues {
+	v.mask |= GcFunction
+	v.values[0] = uint32(Function)
+	return v
+}
+
+// SetPlaneMask sets the value for GcPlaneMask.
+func (v *GcValues) SetPlaneMask(PlaneMask uint32) *GcValues {
+	v.mask |= GcPlaneMask
+	v.values[1] = PlaneMask
+	return v
+}
+
+// SetForeground sets the value for GcForeground.
+func (v *GcValues) SetForeground(Foreground uint32) *GcValues {
+	v.mask |= GcForeground
+	v.values[2] = Foreground
+	return v
+}
+
+// SetBackground sets the value for GcBackground.
+func (v *GcValues) SetBackground(Background uint32) *GcValues {
+	v.mask |= GcBackground
+	v.values[3] = Background
+	return v
+}
+
+// SetLineWidth sets the value for GcLineWidth.
+func (v *GcValues) SetLineWidth(LineWidth uint32) *GcValues {
+	v.mask |= GcLineWidth
+	v.values[4] = LineWidth
+	return v
+}
+
+// SetLineStyle sets the value for GcLineStyle.
+func (v *GcValues) SetLineStyle(LineStyle LineStyle) *GcValues {
+	v.mask |= GcLineStyle
+	v.values[5] = uint32(LineStyle)
+	return v
+}
+
+// SetCapStyle sets the value for GcCapStyle.
+func (v *GcValues) SetCapStyle(CapStyle CapStyle) *GcValues {
+	v.mask |= GcCapStyle
+	v.values[6] = uint32(CapStyle)
+	return v
+}
+
+// SetJoinStyle sets the value for GcJoinStyle.
+func (v *GcValues) SetJoinStyle(JoinStyle JoinStyle) *GcValues {
+	v.mask |= GcJoinStyle
+	v.values[7] = uint32(JoinStyle)
+	return v
+}
+
+// SetFillStyle sets the value for GcFillStyle.
+func (v *GcValues) SetFillStyle(FillStyle FillStyle) *GcValues {
+	v.mask |= GcFillStyle
+	v.values[8] = uint32(FillStyle)
+	return v
+}
+
+// SetFillRule sets the value for GcFillRule.
+func (v *GcValues) SetFillRule(FillRule FillRule) *GcValues {
+	v.mask |= GcFillRule
+	v.values[9] = uint32(FillRule)
+	return v
+}
+
+// SetTile sets the value for GcTile.
+func (v *GcValues) SetTile(Tile uint32) *GcValues {
+	v.mask |= GcTile
+	v.values[10] = Tile
+	return v
+}
+
+// SetStipple sets the value for GcStipple.
+func (v *GcValues) SetStipple(Stipple uint32) *GcValues {
+	v.mask |= GcStipple
+	v.values[11] = Stipple
+	return v
+}
+
+// SetTileStippleOriginX sets the value for GcTileStippleOriginX.
+func (v *GcValues) SetTileStippleOriginX(TileStippleOriginX uint32) *GcValues {
+	v.mask |= GcTileStippleOriginX
+	v.values[12] = TileStippleOriginX
+	return v
+}
+
+// SetTileStippleOriginY sets the value for GcTileStippleOriginY.
+func (v *GcValues) SetTileStippleOriginY(TileStippleOriginY uint32) *GcValues {
+	v.mask |= GcTileStippleOriginY
+	v.values[13] = TileStippleOriginY
+	return v
+}
+
+// SetFont sets the value for GcFont.
+func (v *GcValues) SetFont(Font uint32) *GcValues {
+	v.mask |= GcFont
+	v.values[14] = Font
+	return v
+}
+
+// SetSubwindowMode sets the value for GcSubwindowMode.
+func (v *GcValues) SetSubwindowMode(SubwindowMode SubwindowMode) *GcValues {
+	v.mask |= GcSubwindowMode
+	v.values[15] = uint32(SubwindowMode)
+	return v
+}
+
+// SetGraphicsExposures sets the value for GcGraphicsExposures.
+func (v *GcValues) SetGraphicsExposures(GraphicsExposures uint32) *GcValues {
+	v.mask |= GcGraphicsExposures
+	v.values[16] = GraphicsExposures
+	return v
+}
+
+// SetClipOriginX sets the value for GcClipOriginX.
+func (v *GcValues) SetClipOriginX(ClipOriginX uint32) *GcValues {
+	v.mask |= GcClipOriginX
+	v.values[17] = ClipOriginX
+	return v
+}
+
+// SetClipOriginY sets the value for GcClipOriginY.
+func (v *GcValues) SetClipOriginY(ClipOriginY uint32) *GcValues {
+	v.mask |= GcClipOriginY
+	v.values[18] = ClipOriginY
+	return v
+}
+
+// SetClipMask sets the value for GcClipMask.
+func (v *GcValues) SetClipMask(ClipMask uint32) *GcValues {
+	v.mask |= GcClipMask
+	v.values[19] = ClipMask
+	return v
+}
+
+// SetDashOffset sets the value for GcDashOffset.
+func (v *GcValues) SetDashOffset(DashOffset uint32) *GcValues {
+	v.mask |= GcDashOffset
+	v.values[20] = DashOffset
+	return v
+}
+
+// SetDashList sets the value for GcDashList.
+func (v *GcValues) SetDashList(DashList uint32) *GcValues {
+	v.mask |= GcDashList
+	v.values[21] = DashList
+	return v
+}
+
+// SetArcMode sets the value for GcArcMode.
+func (v *GcValues) SetArcMode(ArcMode ArcMode) *GcValues {
+	v.mask |= GcArcMode
+	v.values[22] = uint32(ArcMode)
+	return v
+}
+
+// Mask returns the value mask of the values that have been set.
+func (v *GcValues) Mask() Gc {
+	return v.mask
+}
+
+// List returns the values that have been set, in the order defined by the protocol.
+func (v *GcValues) List() []uint32 {
+	list := make([]uint32, 0, len(v.values))
+	for i, value := range v.values {
+		if v.mask&(1<<uint(i)) != 0 {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
 type Gcontext uint32
 
 func NewGcontextId(c *xgb.Conn) (Gcontext, error) {
@@ -2733,8 +4005,8 @@ type GeGenericEvent struct {
 	// padding: 22 bytes
 }
 
-// GeGenericEventNew constructs a GeGenericEvent value that implements xgb.Event from a byte slice.
-func GeGenericEventNew(buf []byte) xgb.Event {
+// GeGenericEventNew constructs a GeGenericEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func GeGenericEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := GeGenericEvent{}
 	b := 1 // don't read event number
 
@@ -2743,8 +4015,8 @@ func GeGenericEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a GeGenericEvent value to a byte slice.
-func (v GeGenericEvent) Bytes() []byte {
+// Bytes writes a GeGenericEvent value to a byte slice, in the byte order 'order'.
+func (v GeGenericEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -2783,19 +4055,51 @@ const (
 	GrabAny = 0
 )
 
+type GrabMode byte
+
 const (
-	GrabModeSync  = 0
-	GrabModeAsync = 1
+	GrabModeSync  GrabMode = 0
+	GrabModeAsync GrabMode = 1
 )
 
+// String returns the name of the GrabMode item with this value.
+func (v GrabMode) String() string {
+	switch v {
+	case GrabModeSync:
+		return "Sync"
+	case GrabModeAsync:
+		return "Async"
+	}
+	return xgb.Sprintf("GrabMode(%d)", byte(v))
+}
+
+type GrabStatus byte
+
 const (
-	GrabStatusSuccess        = 0
-	GrabStatusAlreadyGrabbed = 1
-	GrabStatusInvalidTime    = 2
-	GrabStatusNotViewable    = 3
-	GrabStatusFrozen         = 4
+	GrabStatusSuccess        GrabStatus = 0
+	GrabStatusAlreadyGrabbed GrabStatus = 1
+	GrabStatusInvalidTime    GrabStatus = 2
+	GrabStatusNotViewable    GrabStatus = 3
+	GrabStatusFrozen         GrabStatus = 4
 )
 
+// String returns the name of the GrabStatus item with this value.
+func (v GrabStatus) String() string {
+	switch v {
+	case GrabStatusSuccess:
+		return "Success"
+	case GrabStatusAlreadyGrabbed:
+		return "AlreadyGrabbed"
+	case GrabStatusInvalidTime:
+		return "InvalidTime"
+	case GrabStatusNotViewable:
+		return "NotViewable"
+	case GrabStatusFrozen:
+		return "Frozen"
+	}
+	return xgb.Sprintf("GrabStatus(%d)", byte(v))
+}
+
 // GraphicsExposure is the event number for a GraphicsExposureEvent.
 const GraphicsExposure = 13
 
@@ -2813,35 +4117,35 @@ type GraphicsExposureEvent struct {
 	// padding: 3 bytes
 }
 
-// GraphicsExposureEventNew constructs a GraphicsExposureEvent value that implements xgb.Event from a byte slice.
-func GraphicsExposureEventNew(buf []byte) xgb.Event {
+// GraphicsExposureEventNew constructs a GraphicsExposureEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func GraphicsExposureEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := GraphicsExposureEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Drawable = Drawable(xgb.Get32(buf[b:]))
+	v.Drawable = Drawable(order.Get32(buf[b:]))
 	b += 4
 
-	v.X = xgb.Get16(buf[b:])
+	v.X = order.Get16(buf[b:])
 	b += 2
 
-	v.Y = xgb.Get16(buf[b:])
+	v.Y = order.Get16(buf[b:])
 	b += 2
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
-	v.MinorOpcode = xgb.Get16(buf[b:])
+	v.MinorOpcode = order.Get16(buf[b:])
 	b += 2
 
-	v.Count = xgb.Get16(buf[b:])
+	v.Count = order.Get16(buf[b:])
 	b += 2
 
 	v.MajorOpcode = buf[b]
@@ -2852,8 +4156,8 @@ func GraphicsExposureEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a GraphicsExposureEvent value to a byte slice.
-func (v GraphicsExposureEvent) Bytes() []byte {
+// Bytes writes a GraphicsExposureEvent value to a byte slice, in the byte order 'order'.
+func (v GraphicsExposureEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -2865,25 +4169,25 @@ func (v GraphicsExposureEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Drawable))
+	order.Put32(buf[b:], uint32(v.Drawable))
 	b += 4
 
-	xgb.Put16(buf[b:], v.X)
+	order.Put16(buf[b:], v.X)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Y)
+	order.Put16(buf[b:], v.Y)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Width)
+	order.Put16(buf[b:], v.Width)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Height)
+	order.Put16(buf[b:], v.Height)
 	b += 2
 
-	xgb.Put16(buf[b:], v.MinorOpcode)
+	order.Put16(buf[b:], v.MinorOpcode)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Count)
+	order.Put16(buf[b:], v.Count)
 	b += 2
 
 	buf[b] = v.MajorOpcode
@@ -2920,21 +4224,52 @@ func init() {
 	xgb.NewEventFuncs[13] = GraphicsExposureEventNew
 }
 
+type Gravity uint32
+
 const (
-	GravityBitForget = 0
-	GravityWinUnmap  = 0
-	GravityNorthWest = 1
-	GravityNorth     = 2
-	GravityNorthEast = 3
-	GravityWest      = 4
-	GravityCenter    = 5
-	GravityEast      = 6
-	GravitySouthWest = 7
-	GravitySouth     = 8
-	GravitySouthEast = 9
-	GravityStatic    = 10
+	GravityBitForget Gravity = 0
+	GravityWinUnmap  Gravity = 0
+	GravityNorthWest Gravity = 1
+	GravityNorth     Gravity = 2
+	GravityNorthEast Gravity = 3
+	GravityWest      Gravity = 4
+	GravityCenter    Gravity = 5
+	GravityEast      Gravity = 6
+	GravitySouthWest Gravity = 7
+	GravitySouth     Gravity = 8
+	GravitySouthEast Gravity = 9
+	GravityStatic    Gravity = 10
 )
 
+// String returns the name of the Gravity item with this value.
+func (v Gravity) String() string {
+	switch v {
+	case GravityBitForget:
+		return "BitForget"
+	case GravityNorthWest:
+		return "NorthWest"
+	case GravityNorth:
+		return "North"
+	case GravityNorthEast:
+		return "NorthEast"
+	case GravityWest:
+		return "West"
+	case GravityCenter:
+		return "Center"
+	case GravityEast:
+		return "East"
+	case GravitySouthWest:
+		return "SouthWest"
+	case GravitySouth:
+		return "South"
+	case GravitySouthEast:
+		return "SouthEast"
+	case GravityStatic:
+		return "Static"
+	}
+	return xgb.Sprintf("Gravity(%d)", uint32(v))
+}
+
 // GravityNotify is the event number for a GravityNotifyEvent.
 const GravityNotify = 24
 
@@ -2947,33 +4282,33 @@ type GravityNotifyEvent struct {
 	Y      int16
 }
 
-// GravityNotifyEventNew constructs a GravityNotifyEvent value that implements xgb.Event from a byte slice.
-func GravityNotifyEventNew(buf []byte) xgb.Event {
+// GravityNotifyEventNew constructs a GravityNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func GravityNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := GravityNotifyEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Event = Window(xgb.Get32(buf[b:]))
+	v.Event = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Window = Window(xgb.Get32(buf[b:]))
+	v.Window = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.X = int16(xgb.Get16(buf[b:]))
+	v.X = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Y = int16(xgb.Get16(buf[b:]))
+	v.Y = int16(order.Get16(buf[b:]))
 	b += 2
 
 	return v
 }
 
-// Bytes writes a GravityNotifyEvent value to a byte slice.
-func (v GravityNotifyEvent) Bytes() []byte {
+// Bytes writes a GravityNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v GravityNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -2985,16 +4320,16 @@ func (v GravityNotifyEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Event))
+	order.Put32(buf[b:], uint32(v.Event))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(v.X))
+	order.Put16(buf[b:], uint16(v.X))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.Y))
+	order.Put16(buf[b:], uint16(v.Y))
 	b += 2
 
 	return buf
@@ -3018,46 +4353,93 @@ func (v GravityNotifyEvent) String() string {
 	return "GravityNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the GravityNotify event is about.
+// It is used to subscribe to the events of a single window.
+func (v GravityNotifyEvent) EventWindow() uint32 {
+	return uint32(v.Event)
+}
+
 func init() {
 	xgb.NewEventFuncs[24] = GravityNotifyEventNew
 }
 
+type Gx uint32
+
 const (
-	GxClear        = 0
-	GxAnd          = 1
-	GxAndReverse   = 2
-	GxCopy         = 3
-	GxAndInverted  = 4
-	GxNoop         = 5
-	GxXor          = 6
-	GxOr           = 7
-	GxNor          = 8
-	GxEquiv        = 9
-	GxInvert       = 10
-	GxOrReverse    = 11
-	GxCopyInverted = 12
-	GxOrInverted   = 13
-	GxNand         = 14
-	GxSet          = 15
+	GxClear        Gx = 0
+	GxAnd          Gx = 1
+	GxAndReverse   Gx = 2
+	GxCopy         Gx = 3
+	GxAndInverted  Gx = 4
+	GxNoop         Gx = 5
+	GxXor          Gx = 6
+	GxOr           Gx = 7
+	GxNor          Gx = 8
+	GxEquiv        Gx = 9
+	GxInvert       Gx = 10
+	GxOrReverse    Gx = 11
+	GxCopyInverted Gx = 12
+	GxOrInverted   Gx = 13
+	GxNand         Gx = 14
+	GxSet          Gx = 15
 )
 
+// String returns the name of the Gx item with this value.
+func (v Gx) String() string {
+	switch v {
+	case GxClear:
+		return "Clear"
+	case GxAnd:
+		return "And"
+	case GxAndReverse:
+		return "AndReverse"
+	case GxCopy:
+		return "Copy"
+	case GxAndInverted:
+		return "AndInverted"
+	case GxNoop:
+		return "Noop"
+	case GxXor:
+		return "Xor"
+	case GxOr:
+		return "Or"
+	case GxNor:
+		return "Nor"
+	case GxEquiv:
+		return "Equiv"
+	case GxInvert:
+		return "Invert"
+	case GxOrReverse:
+		return "OrReverse"
+	case GxCopyInverted:
+		return "CopyInverted"
+	case GxOrInverted:
+		return "OrInverted"
+	case GxNand:
+		return "Nand"
+	case GxSet:
+		return "Set"
+	}
+	return xgb.Sprintf("Gx(%d)", uint32(v))
+}
+
 type Host struct {
-	Family byte
+	Family Family
 	// padding: 1 bytes
 	AddressLen uint16
 	Address    []byte // size: xgb.Pad((int(AddressLen) * 1))
 }
 
 // HostRead reads a byte slice into a Host value.
-func HostRead(buf []byte, v *Host) int {
+func HostRead(buf []byte, order xgb.ByteOrder, v *Host) int {
 	b := 0
 
-	v.Family = buf[b]
+	v.Family = Family(buf[b])
 	b += 1
 
 	b += 1 // padding
 
-	v.AddressLen = xgb.Get16(buf[b:])
+	v.AddressLen = order.Get16(buf[b:])
 	b += 2
 
 	v.Address = make([]byte, v.AddressLen)
@@ -3068,26 +4450,26 @@ func HostRead(buf []byte, v *Host) int {
 }
 
 // HostReadList reads a byte slice into a list of Host values.
-func HostReadList(buf []byte, dest []Host) int {
+func HostReadList(buf []byte, order xgb.ByteOrder, dest []Host) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Host{}
-		b += HostRead(buf[b:], &dest[i])
+		b += HostRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Host value to a byte slice.
-func (v Host) Bytes() []byte {
+// Bytes writes a Host value to a byte slice, in the byte order 'order'.
+func (v Host) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, (4 + xgb.Pad((int(v.AddressLen) * 1))))
 	b := 0
 
-	buf[b] = v.Family
+	buf[b] = byte(v.Family)
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], v.AddressLen)
+	order.Put16(buf[b:], v.AddressLen)
 	b += 2
 
 	copy(buf[b:], v.Address[:v.AddressLen])
@@ -3097,11 +4479,11 @@ func (v Host) Bytes() []byte {
 }
 
 // HostListBytes writes a list of Host values to a byte slice.
-func HostListBytes(buf []byte, list []Host) int {
+func HostListBytes(buf []byte, order xgb.ByteOrder, list []Host) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -3117,19 +4499,32 @@ func HostListSize(list []Host) int {
 	return size
 }
 
+type HostMode byte
+
 const (
-	HostModeInsert = 0
-	HostModeDelete = 1
+	HostModeInsert HostMode = 0
+	HostModeDelete HostMode = 1
 )
 
+// String returns the name of the HostMode item with this value.
+func (v HostMode) String() string {
+	switch v {
+	case HostModeInsert:
+		return "Insert"
+	case HostModeDelete:
+		return "Delete"
+	}
+	return xgb.Sprintf("HostMode(%d)", byte(v))
+}
+
 // BadIDChoice is the error number for a BadIDChoice.
 const BadIDChoice = 14
 
 type IDChoiceError ValueError
 
-// IDChoiceErrorNew constructs a IDChoiceError value that implements xgb.Error from a byte slice.
-func IDChoiceErrorNew(buf []byte) xgb.Error {
-	v := IDChoiceError(ValueErrorNew(buf).(ValueError))
+// IDChoiceErrorNew constructs a IDChoiceError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func IDChoiceErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := IDChoiceError(ValueErrorNew(buf, order).(ValueError))
 	v.NiceName = "IDChoice"
 	return v
 }
@@ -3160,25 +4555,53 @@ func init() {
 	xgb.NewErrorFuncs[14] = IDChoiceErrorNew
 }
 
+type ImageFormat byte
+
 const (
-	ImageFormatXYBitmap = 0
-	ImageFormatXYPixmap = 1
-	ImageFormatZPixmap  = 2
+	ImageFormatXYBitmap ImageFormat = 0
+	ImageFormatXYPixmap ImageFormat = 1
+	ImageFormatZPixmap  ImageFormat = 2
 )
 
+// String returns the name of the ImageFormat item with this value.
+func (v ImageFormat) String() string {
+	switch v {
+	case ImageFormatXYBitmap:
+		return "XYBitmap"
+	case ImageFormatXYPixmap:
+		return "XYPixmap"
+	case ImageFormatZPixmap:
+		return "ZPixmap"
+	}
+	return xgb.Sprintf("ImageFormat(%d)", byte(v))
+}
+
+type ImageOrder byte
+
 const (
-	ImageOrderLSBFirst = 0
-	ImageOrderMSBFirst = 1
+	ImageOrderLSBFirst ImageOrder = 0
+	ImageOrderMSBFirst ImageOrder = 1
 )
 
+// String returns the name of the ImageOrder item with this value.
+func (v ImageOrder) String() string {
+	switch v {
+	case ImageOrderLSBFirst:
+		return "LSBFirst"
+	case ImageOrderMSBFirst:
+		return "MSBFirst"
+	}
+	return xgb.Sprintf("ImageOrder(%d)", byte(v))
+}
+
 // BadImplementation is the error number for a BadImplementation.
 const BadImplementation = 17
 
 type ImplementationError RequestError
 
-// ImplementationErrorNew constructs a ImplementationError value that implements xgb.Error from a byte slice.
-func ImplementationErrorNew(buf []byte) xgb.Error {
-	v := ImplementationError(RequestErrorNew(buf).(RequestError))
+// ImplementationErrorNew constructs a ImplementationError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func ImplementationErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := ImplementationError(RequestErrorNew(buf, order).(RequestError))
 	v.NiceName = "Implementation"
 	return v
 }
@@ -3209,101 +4632,353 @@ func init() {
 	xgb.NewErrorFuncs[17] = ImplementationErrorNew
 }
 
-const (
-	InputFocusNone           = 0
-	InputFocusPointerRoot    = 1
-	InputFocusParent         = 2
-	InputFocusFollowKeyboard = 3
-)
+type InputFocus byte
 
 const (
-	JoinStyleMiter = 0
-	JoinStyleRound = 1
-	JoinStyleBevel = 2
+	InputFocusNone           InputFocus = 0
+	InputFocusPointerRoot    InputFocus = 1
+	InputFocusParent         InputFocus = 2
+	InputFocusFollowKeyboard InputFocus = 3
 )
 
+// String returns the name of the InputFocus item with this value.
+func (v InputFocus) String() string {
+	switch v {
+	case InputFocusNone:
+		return "None"
+	case InputFocusPointerRoot:
+		return "PointerRoot"
+	case InputFocusParent:
+		return "Parent"
+	case InputFocusFollowKeyboard:
+		return "FollowKeyboard"
+	}
+	return xgb.Sprintf("InputFocus(%d)", byte(v))
+}
+
+type JoinStyle uint32
+
 const (
-	KbKeyClickPercent = 1
-	KbBellPercent     = 2
-	KbBellPitch       = 4
-	KbBellDuration    = 8
-	KbLed             = 16
-	KbLedMode         = 32
-	KbKey             = 64
-	KbAutoRepeatMode  = 128
+	JoinStyleMiter JoinStyle = 0
+	JoinStyleRound JoinStyle = 1
+	JoinStyleBevel JoinStyle = 2
 )
 
+// String returns the name of the JoinStyle item with this value.
+func (v JoinStyle) String() string {
+	switch v {
+	case JoinStyleMiter:
+		return "Miter"
+	case JoinStyleRound:
+		return "Round"
+	case JoinStyleBevel:
+		return "Bevel"
+	}
+	return xgb.Sprintf("JoinStyle(%d)", uint32(v))
+}
+
+type Kb uint32
+
 const (
-	KeyButMaskShift   = 1
-	KeyButMaskLock    = 2
-	KeyButMaskControl = 4
-	KeyButMaskMod1    = 8
-	KeyButMaskMod2    = 16
-	KeyButMaskMod3    = 32
-	KeyButMaskMod4    = 64
-	KeyButMaskMod5    = 128
-	KeyButMaskButton1 = 256
-	KeyButMaskButton2 = 512
-	KeyButMaskButton3 = 1024
-	KeyButMaskButton4 = 2048
-	KeyButMaskButton5 = 4096
+	KbKeyClickPercent Kb = 1
+	KbBellPercent     Kb = 2
+	KbBellPitch       Kb = 4
+	KbBellDuration    Kb = 8
+	KbLed             Kb = 16
+	KbLedMode         Kb = 32
+	KbKey             Kb = 64
+	KbAutoRepeatMode  Kb = 128
 )
 
-// KeyPress is the event number for a KeyPressEvent.
-const KeyPress = 2
+// String returns the names of the Kb bits set in a value.
+func (v Kb) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 8)
+	if v&KbKeyClickPercent != 0 {
+		names = append(names, "KeyClickPercent")
+		v &^= KbKeyClickPercent
+	}
+	if v&KbBellPercent != 0 {
+		names = append(names, "BellPercent")
+		v &^= KbBellPercent
+	}
+	if v&KbBellPitch != 0 {
+		names = append(names, "BellPitch")
+		v &^= KbBellPitch
+	}
+	if v&KbBellDuration != 0 {
+		names = append(names, "BellDuration")
+		v &^= KbBellDuration
+	}
+	if v&KbLed != 0 {
+		names = append(names, "Led")
+		v &^= KbLed
+	}
+	if v&KbLedMode != 0 {
+		names = append(names, "LedMode")
+		v &^= KbLedMode
+	}
+	if v&KbKey != 0 {
+		names = append(names, "Key")
+		v &^= KbKey
+	}
+	if v&KbAutoRepeatMode != 0 {
+		names = append(names, "AutoRepeatMode")
+		v &^= KbAutoRepeatMode
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint32(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
 
-type KeyPressEvent struct {
-	Sequence   uint16
-	Detail     Keycode
-	Time       Timestamp
-	Root       Window
-	Event      Window
-	Child      Window
-	RootX      int16
-	RootY      int16
-	EventX     int16
-	EventY     int16
-	State      uint16
-	SameScreen bool
-	// padding: 1 bytes
+// KbValues builds a value mask and a value list out of Kb values.
+// The values are listed in the order defined by the protocol, no matter the order they are set in.
+type KbValues struct {
+	mask   Kb
+	values [8]uint32
 }
 
-// KeyPressEventNew constructs a KeyPressEvent value that implements xgb.Event from a byte slice.
-func KeyPressEventNew(buf []byte) xgb.Event {
-	v := KeyPressEvent{}
-	b := 1 // don't read event number
+// SetKeyClickPercent sets the value for KbKeyClickPercent.
+func (v *KbValues) SetKeyClickPercent(KeyClickPercent uint32) *KbValues {
+	v.mask |= KbKeyClickPercent
+	v.values[0] = KeyClickPercent
+	return v
+}
 
-	v.Detail = Keycode(buf[b])
-	b += 1
+// SetBellPercent sets the value for KbBellPercent.
+func (v *KbValues) SetBellPercent(BellPercent uint32) *KbValues {
+	v.mask |= KbBellPercent
+	v.values[1] = BellPercent
+	return v
+}
 
-	v.Sequence = xgb.Get16(buf[b:])
-	b += 2
+// SetBellPitch sets the value for KbBellPitch.
+func (v *KbValues) SetBellPitch(BellPitch uint32) *KbValues {
+	v.mask |= KbBellPitch
+	v.values[2] = BellPitch
+	return v
+}
 
-	v.Time = Timestamp(xgb.Get32(buf[b:]))
-	b += 4
+// SetBellDuration sets the value for KbBellDuration.
+func (v *KbValues) SetBellDuration(BellDuration uint32) *KbValues {
+	v.mask |= KbBellDuration
+	v.values[3] = BellDuration
+	return v
+}
 
-	v.Root = Window(xgb.Get32(buf[b:]))
-	b += 4
+// SetLed sets the value for KbLed.
+func (v *KbValues) SetLed(Led uint32) *KbValues {
+	v.mask |= KbLed
+	v.values[4] = Led
+	return v
+}
 
-	v.Event = Window(xgb.Get32(buf[b:]))
-	b += 4
+// SetLedMode sets the value for KbLedMode.
+func (v *KbValues) SetLedMode(LedMode LedMode) *KbValues {
+	v.mask |= KbLedMode
+	v.values[5] = uint32(LedMode)
+	return v
+}
 
-	v.Child = Window(xgb.Get32(buf[b:]))
-	b += 4
+// SetKey sets the value for KbKey.
+func (v *KbValues) SetKey(Key uint32) *KbValues {
+	v.mask |= KbKey
+	v.values[6] = Key
+	return v
+}
 
-	v.RootX = int16(xgb.Get16(buf[b:]))
-	b += 2
+// SetAutoRepeatMode sets the value for KbAutoRepeatMode.
+func (v *KbValues) SetAutoRepeatMode(AutoRepeatMode AutoRepeatMode) *KbValues {
+	v.mask |= KbAutoRepeatMode
+	v.values[7] = uint32(AutoRepeatMode)
+	return v
+}
+
+// Mask returns the value mask of the values that have been set.
+func (v *KbValues) Mask() Kb {
+	return v.mask
+}
+
+// List returns the values that have been set, in the order defined by the protocol.
+func (v *KbValues) List() []uint32 {
+	list := make([]uint32, 0, len(v.values))
+	for i, value := range v.values {
+		if v.mask&(1<<uint(i)) != 0 {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
+type KeyButMask uint16
+
+const (
+	KeyButMaskShift   KeyButMask = 1
+	KeyButMaskLock    KeyButMask = 2
+	KeyButMaskControl KeyButMask = 4
+	KeyButMaskMod1    KeyButMask = 8
+	KeyButMaskMod2    KeyButMask = 16
+	KeyButMaskMod3    KeyButMask = 32
+	KeyButMaskMod4    KeyButMask = 64
+	KeyButMaskMod5    KeyButMask = 128
+	KeyButMaskButton1 KeyButMask = 256
+	KeyButMaskButton2 KeyButMask = 512
+	KeyButMaskButton3 KeyButMask = 1024
+	KeyButMaskButton4 KeyButMask = 2048
+	KeyButMaskButton5 KeyButMask = 4096
+)
+
+// String returns the names of the KeyButMask bits set in a value.
+func (v KeyButMask) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 13)
+	if v&KeyButMaskShift != 0 {
+		names = append(names, "Shift")
+		v &^= KeyButMaskShift
+	}
+	if v&KeyButMaskLock != 0 {
+		names = append(names, "Lock")
+		v &^= KeyButMaskLock
+	}
+	if v&KeyButMaskControl != 0 {
+		names = append(names, "Control")
+		v &^= KeyButMaskControl
+	}
+	if v&KeyButMaskMod1 != 0 {
+		names = append(names, "Mod1")
+		v &^= KeyButMaskMod1
+	}
+	if v&KeyButMaskMod2 != 0 {
+		names = append(names, "Mod2")
+		v &^= KeyButMaskMod2
+	}
+	if v&KeyButMaskMod3 != 0 {
+		names = append(names, "Mod3")
+		v &^= KeyButMaskMod3
+	}
+	if v&KeyButMaskMod4 != 0 {
+		names = append(names, "Mod4")
+		v &^= KeyButMaskMod4
+	}
+	if v&KeyButMaskMod5 != 0 {
+		names = append(names, "Mod5")
+		v &^= KeyButMaskMod5
+	}
+	if v&KeyButMaskButton1 != 0 {
+		names = append(names, "Button1")
+		v &^= KeyButMaskButton1
+	}
+	if v&KeyButMaskButton2 != 0 {
+		names = append(names, "Button2")
+		v &^= KeyButMaskButton2
+	}
+	if v&KeyButMaskButton3 != 0 {
+		names = append(names, "Button3")
+		v &^= KeyButMaskButton3
+	}
+	if v&KeyButMaskButton4 != 0 {
+		names = append(names, "Button4")
+		v &^= KeyButMaskButton4
+	}
+	if v&KeyButMaskButton5 != 0 {
+		names = append(names, "Button5")
+		v &^= KeyButMaskButton5
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint16(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
+// KeyPress is the event number for a KeyPressEvent.
+const KeyPress = 2
+
+// KeyPressEvent is the type of KeyPress events.
+//
+// A key was pressed/released.
+//
+// See also GrabKey, GrabKeyboard.
+type KeyPressEvent struct {
+	Sequence uint16
+
+	// The keycode (a number representing a physical key on the keyboard) of
+	// the key which was pressed.
+	Detail Keycode
+
+	// Time when the event was generated (in milliseconds).
+	Time Timestamp
+
+	// The root window of `child`.
+	Root  Window
+	Event Window
+	Child Window
+
+	// The X coordinate of the pointer relative to the `root` window at the
+	// time of the event.
+	RootX int16
+
+	// The Y coordinate of the pointer relative to the `root` window at the
+	// time of the event.
+	RootY int16
+
+	// If `same_screen` is true, this is the X coordinate relative to the
+	// `event` window's origin. Otherwise, `event_x` will be set to zero.
+	EventX int16
+
+	// If `same_screen` is true, this is the Y coordinate relative to the
+	// `event` window's origin. Otherwise, `event_y` will be set to zero.
+	EventY int16
+
+	// The logical state of the pointer buttons and modifier keys just prior to
+	// the event.
+	State KeyButMask
+
+	// Whether the `event` window is on the same screen as the `root` window.
+	SameScreen bool
+	// padding: 1 bytes
+}
+
+// KeyPressEventNew constructs a KeyPressEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func KeyPressEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
+	v := KeyPressEvent{}
+	b := 1 // don't read event number
+
+	v.Detail = Keycode(buf[b])
+	b += 1
+
+	v.Sequence = order.Get16(buf[b:])
+	b += 2
+
+	v.Time = Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	v.Root = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Event = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Child = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.RootX = int16(order.Get16(buf[b:]))
+	b += 2
 
-	v.RootY = int16(xgb.Get16(buf[b:]))
+	v.RootY = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.EventX = int16(xgb.Get16(buf[b:]))
+	v.EventX = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.EventY = int16(xgb.Get16(buf[b:]))
+	v.EventY = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.State = xgb.Get16(buf[b:])
+	v.State = KeyButMask(order.Get16(buf[b:]))
 	b += 2
 
 	if buf[b] == 1 {
@@ -3318,8 +4993,8 @@ func KeyPressEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a KeyPressEvent value to a byte slice.
-func (v KeyPressEvent) Bytes() []byte {
+// Bytes writes a KeyPressEvent value to a byte slice, in the byte order 'order'.
+func (v KeyPressEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -3332,31 +5007,31 @@ func (v KeyPressEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Time))
+	order.Put32(buf[b:], uint32(v.Time))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Root))
+	order.Put32(buf[b:], uint32(v.Root))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Event))
+	order.Put32(buf[b:], uint32(v.Event))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Child))
+	order.Put32(buf[b:], uint32(v.Child))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(v.RootX))
+	order.Put16(buf[b:], uint16(v.RootX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.RootY))
+	order.Put16(buf[b:], uint16(v.RootY))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.EventX))
+	order.Put16(buf[b:], uint16(v.EventX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.EventY))
+	order.Put16(buf[b:], uint16(v.EventY))
 	b += 2
 
-	xgb.Put16(buf[b:], v.State)
+	order.Put16(buf[b:], uint16(v.State))
 	b += 2
 
 	if v.SameScreen {
@@ -3391,11 +5066,17 @@ func (v KeyPressEvent) String() string {
 	fieldVals = append(fieldVals, xgb.Sprintf("RootY: %d", v.RootY))
 	fieldVals = append(fieldVals, xgb.Sprintf("EventX: %d", v.EventX))
 	fieldVals = append(fieldVals, xgb.Sprintf("EventY: %d", v.EventY))
-	fieldVals = append(fieldVals, xgb.Sprintf("State: %d", v.State))
+	fieldVals = append(fieldVals, xgb.Sprintf("State: %s", v.State))
 	fieldVals = append(fieldVals, xgb.Sprintf("SameScreen: %t", v.SameScreen))
 	return "KeyPress {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the KeyPress event is about.
+// It is used to subscribe to the events of a single window.
+func (v KeyPressEvent) EventWindow() uint32 {
+	return uint32(v.Event)
+}
+
 func init() {
 	xgb.NewEventFuncs[2] = KeyPressEventNew
 }
@@ -3405,14 +5086,14 @@ const KeyRelease = 3
 
 type KeyReleaseEvent KeyPressEvent
 
-// KeyReleaseEventNew constructs a KeyReleaseEvent value that implements xgb.Event from a byte slice.
-func KeyReleaseEventNew(buf []byte) xgb.Event {
-	return KeyReleaseEvent(KeyPressEventNew(buf).(KeyPressEvent))
+// KeyReleaseEventNew constructs a KeyReleaseEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func KeyReleaseEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
+	return KeyReleaseEvent(KeyPressEventNew(buf, order).(KeyPressEvent))
 }
 
-// Bytes writes a KeyReleaseEvent value to a byte slice.
-func (v KeyReleaseEvent) Bytes() []byte {
-	return KeyPressEvent(v).Bytes()
+// Bytes writes a KeyReleaseEvent value to a byte slice, in the byte order 'order'.
+func (v KeyReleaseEvent) Bytes(order xgb.ByteOrder) []byte {
+	return KeyPressEvent(v).Bytes(order)
 }
 
 // SequenceId returns the sequence id attached to the KeyRelease event.
@@ -3434,11 +5115,17 @@ func (v KeyReleaseEvent) String() string {
 	fieldVals = append(fieldVals, xgb.Sprintf("RootY: %d", v.RootY))
 	fieldVals = append(fieldVals, xgb.Sprintf("EventX: %d", v.EventX))
 	fieldVals = append(fieldVals, xgb.Sprintf("EventY: %d", v.EventY))
-	fieldVals = append(fieldVals, xgb.Sprintf("State: %d", v.State))
+	fieldVals = append(fieldVals, xgb.Sprintf("State: %s", v.State))
 	fieldVals = append(fieldVals, xgb.Sprintf("SameScreen: %t", v.SameScreen))
 	return "KeyRelease {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the KeyRelease event is about.
+// It is used to subscribe to the events of a single window.
+func (v KeyReleaseEvent) EventWindow() uint32 {
+	return KeyPressEvent(v).EventWindow()
+}
+
 func init() {
 	xgb.NewEventFuncs[3] = KeyReleaseEventNew
 }
@@ -3452,8 +5139,8 @@ type KeymapNotifyEvent struct {
 	Keys []byte // size: 32
 }
 
-// KeymapNotifyEventNew constructs a KeymapNotifyEvent value that implements xgb.Event from a byte slice.
-func KeymapNotifyEventNew(buf []byte) xgb.Event {
+// KeymapNotifyEventNew constructs a KeymapNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func KeymapNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := KeymapNotifyEvent{}
 	b := 1 // don't read event number
 
@@ -3464,8 +5151,8 @@ func KeymapNotifyEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a KeymapNotifyEvent value to a byte slice.
-func (v KeymapNotifyEvent) Bytes() []byte {
+// Bytes writes a KeymapNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v KeymapNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -3507,14 +5194,14 @@ const LeaveNotify = 8
 
 type LeaveNotifyEvent EnterNotifyEvent
 
-// LeaveNotifyEventNew constructs a LeaveNotifyEvent value that implements xgb.Event from a byte slice.
-func LeaveNotifyEventNew(buf []byte) xgb.Event {
-	return LeaveNotifyEvent(EnterNotifyEventNew(buf).(EnterNotifyEvent))
+// LeaveNotifyEventNew constructs a LeaveNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func LeaveNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
+	return LeaveNotifyEvent(EnterNotifyEventNew(buf, order).(EnterNotifyEvent))
 }
 
-// Bytes writes a LeaveNotifyEvent value to a byte slice.
-func (v LeaveNotifyEvent) Bytes() []byte {
-	return EnterNotifyEvent(v).Bytes()
+// Bytes writes a LeaveNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v LeaveNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
+	return EnterNotifyEvent(v).Bytes(order)
 }
 
 // SequenceId returns the sequence id attached to the LeaveNotify event.
@@ -3527,7 +5214,7 @@ func (v LeaveNotifyEvent) SequenceId() uint16 {
 func (v LeaveNotifyEvent) String() string {
 	fieldVals := make([]string, 0, 12)
 	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
-	fieldVals = append(fieldVals, xgb.Sprintf("Detail: %d", v.Detail))
+	fieldVals = append(fieldVals, xgb.Sprintf("Detail: %s", v.Detail))
 	fieldVals = append(fieldVals, xgb.Sprintf("Time: %d", v.Time))
 	fieldVals = append(fieldVals, xgb.Sprintf("Root: %d", v.Root))
 	fieldVals = append(fieldVals, xgb.Sprintf("Event: %d", v.Event))
@@ -3536,29 +5223,48 @@ func (v LeaveNotifyEvent) String() string {
 	fieldVals = append(fieldVals, xgb.Sprintf("RootY: %d", v.RootY))
 	fieldVals = append(fieldVals, xgb.Sprintf("EventX: %d", v.EventX))
 	fieldVals = append(fieldVals, xgb.Sprintf("EventY: %d", v.EventY))
-	fieldVals = append(fieldVals, xgb.Sprintf("State: %d", v.State))
-	fieldVals = append(fieldVals, xgb.Sprintf("Mode: %d", v.Mode))
+	fieldVals = append(fieldVals, xgb.Sprintf("State: %s", v.State))
+	fieldVals = append(fieldVals, xgb.Sprintf("Mode: %s", v.Mode))
 	fieldVals = append(fieldVals, xgb.Sprintf("SameScreenFocus: %d", v.SameScreenFocus))
 	return "LeaveNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the LeaveNotify event is about.
+// It is used to subscribe to the events of a single window.
+func (v LeaveNotifyEvent) EventWindow() uint32 {
+	return EnterNotifyEvent(v).EventWindow()
+}
+
 func init() {
 	xgb.NewEventFuncs[8] = LeaveNotifyEventNew
 }
 
+type LedMode uint32
+
 const (
-	LedModeOff = 0
-	LedModeOn  = 1
+	LedModeOff LedMode = 0
+	LedModeOn  LedMode = 1
 )
 
+// String returns the name of the LedMode item with this value.
+func (v LedMode) String() string {
+	switch v {
+	case LedModeOff:
+		return "Off"
+	case LedModeOn:
+		return "On"
+	}
+	return xgb.Sprintf("LedMode(%d)", uint32(v))
+}
+
 // BadLength is the error number for a BadLength.
 const BadLength = 16
 
 type LengthError RequestError
 
-// LengthErrorNew constructs a LengthError value that implements xgb.Error from a byte slice.
-func LengthErrorNew(buf []byte) xgb.Error {
-	v := LengthError(RequestErrorNew(buf).(RequestError))
+// LengthErrorNew constructs a LengthError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func LengthErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := LengthError(RequestErrorNew(buf, order).(RequestError))
 	v.NiceName = "Length"
 	return v
 }
@@ -3589,12 +5295,27 @@ func init() {
 	xgb.NewErrorFuncs[16] = LengthErrorNew
 }
 
+type LineStyle uint32
+
 const (
-	LineStyleSolid      = 0
-	LineStyleOnOffDash  = 1
-	LineStyleDoubleDash = 2
+	LineStyleSolid      LineStyle = 0
+	LineStyleOnOffDash  LineStyle = 1
+	LineStyleDoubleDash LineStyle = 2
 )
 
+// String returns the name of the LineStyle item with this value.
+func (v LineStyle) String() string {
+	switch v {
+	case LineStyleSolid:
+		return "Solid"
+	case LineStyleOnOffDash:
+		return "OnOffDash"
+	case LineStyleDoubleDash:
+		return "DoubleDash"
+	}
+	return xgb.Sprintf("LineStyle(%d)", uint32(v))
+}
+
 const (
 	MapIndexShift   = 0
 	MapIndexLock    = 1
@@ -3609,29 +5330,41 @@ const (
 // MapNotify is the event number for a MapNotifyEvent.
 const MapNotify = 19
 
+// MapNotifyEvent is the type of MapNotify events.
+//
+// A window was mapped.
+//
+// See also MapWindow.
 type MapNotifyEvent struct {
 	Sequence uint16
 	// padding: 1 bytes
-	Event            Window
-	Window           Window
+
+	// The window which was mapped or its parent, depending on whether
+	// `StructureNotify` or `SubstructureNotify` was selected.
+	Event Window
+
+	// The window that was mapped.
+	Window Window
+
+	// Window managers should ignore this window if `override_redirect` is 1.
 	OverrideRedirect bool
 	// padding: 3 bytes
 }
 
-// MapNotifyEventNew constructs a MapNotifyEvent value that implements xgb.Event from a byte slice.
-func MapNotifyEventNew(buf []byte) xgb.Event {
+// MapNotifyEventNew constructs a MapNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func MapNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := MapNotifyEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Event = Window(xgb.Get32(buf[b:]))
+	v.Event = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Window = Window(xgb.Get32(buf[b:]))
+	v.Window = Window(order.Get32(buf[b:]))
 	b += 4
 
 	if buf[b] == 1 {
@@ -3646,8 +5379,8 @@ func MapNotifyEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a MapNotifyEvent value to a byte slice.
-func (v MapNotifyEvent) Bytes() []byte {
+// Bytes writes a MapNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v MapNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -3659,10 +5392,10 @@ func (v MapNotifyEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Event))
+	order.Put32(buf[b:], uint32(v.Event))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
 	if v.OverrideRedirect {
@@ -3694,6 +5427,12 @@ func (v MapNotifyEvent) String() string {
 	return "MapNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the MapNotify event is about.
+// It is used to subscribe to the events of a single window.
+func (v MapNotifyEvent) EventWindow() uint32 {
+	return uint32(v.Event)
+}
+
 func init() {
 	xgb.NewEventFuncs[19] = MapNotifyEventNew
 }
@@ -3701,34 +5440,43 @@ func init() {
 // MapRequest is the event number for a MapRequestEvent.
 const MapRequest = 20
 
+// MapRequestEvent is the type of MapRequest events.
+//
+// Window wants to be mapped.
+//
+// See also MapWindow.
 type MapRequestEvent struct {
 	Sequence uint16
 	// padding: 1 bytes
+
+	// The parent of `window`.
 	Parent Window
+
+	// The window to be mapped.
 	Window Window
 }
 
-// MapRequestEventNew constructs a MapRequestEvent value that implements xgb.Event from a byte slice.
-func MapRequestEventNew(buf []byte) xgb.Event {
+// MapRequestEventNew constructs a MapRequestEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func MapRequestEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := MapRequestEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Parent = Window(xgb.Get32(buf[b:]))
+	v.Parent = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Window = Window(xgb.Get32(buf[b:]))
+	v.Window = Window(order.Get32(buf[b:]))
 	b += 4
 
 	return v
 }
 
-// Bytes writes a MapRequestEvent value to a byte slice.
-func (v MapRequestEvent) Bytes() []byte {
+// Bytes writes a MapRequestEvent value to a byte slice, in the byte order 'order'.
+func (v MapRequestEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -3740,10 +5488,10 @@ func (v MapRequestEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Parent))
+	order.Put32(buf[b:], uint32(v.Parent))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
 	return buf
@@ -3765,45 +5513,81 @@ func (v MapRequestEvent) String() string {
 	return "MapRequest {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the MapRequest event is about.
+// It is used to subscribe to the events of a single window.
+func (v MapRequestEvent) EventWindow() uint32 {
+	return uint32(v.Window)
+}
+
 func init() {
 	xgb.NewEventFuncs[20] = MapRequestEventNew
 }
 
+type MapState byte
+
 const (
-	MapStateUnmapped   = 0
-	MapStateUnviewable = 1
-	MapStateViewable   = 2
+	MapStateUnmapped   MapState = 0
+	MapStateUnviewable MapState = 1
+	MapStateViewable   MapState = 2
 )
 
+// String returns the name of the MapState item with this value.
+func (v MapState) String() string {
+	switch v {
+	case MapStateUnmapped:
+		return "Unmapped"
+	case MapStateUnviewable:
+		return "Unviewable"
+	case MapStateViewable:
+		return "Viewable"
+	}
+	return xgb.Sprintf("MapState(%d)", byte(v))
+}
+
+type Mapping byte
+
 const (
-	MappingModifier = 0
-	MappingKeyboard = 1
-	MappingPointer  = 2
+	MappingModifier Mapping = 0
+	MappingKeyboard Mapping = 1
+	MappingPointer  Mapping = 2
 )
 
+// String returns the name of the Mapping item with this value.
+func (v Mapping) String() string {
+	switch v {
+	case MappingModifier:
+		return "Modifier"
+	case MappingKeyboard:
+		return "Keyboard"
+	case MappingPointer:
+		return "Pointer"
+	}
+	return xgb.Sprintf("Mapping(%d)", byte(v))
+}
+
 // MappingNotify is the event number for a MappingNotifyEvent.
 const MappingNotify = 34
 
 type MappingNotifyEvent struct {
 	Sequence uint16
 	// padding: 1 bytes
-	Request      byte
+	Request      Mapping
 	FirstKeycode Keycode
 	Count        byte
 	// padding: 1 bytes
 }
 
-// MappingNotifyEventNew constructs a MappingNotifyEvent value that implements xgb.Event from a byte slice.
-func MappingNotifyEventNew(buf []byte) xgb.Event {
+// MappingNotifyEventNew constructs a MappingNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func MappingNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := MappingNotifyEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Request = buf[b]
+	v.Request = Mapping(buf[b])
 	b += 1
 
 	v.FirstKeycode = Keycode(buf[b])
@@ -3817,8 +5601,8 @@ func MappingNotifyEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a MappingNotifyEvent value to a byte slice.
-func (v MappingNotifyEvent) Bytes() []byte {
+// Bytes writes a MappingNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v MappingNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -3830,7 +5614,7 @@ func (v MappingNotifyEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	buf[b] = v.Request
+	buf[b] = byte(v.Request)
 	b += 1
 
 	buf[b] = byte(v.FirstKeycode)
@@ -3855,7 +5639,7 @@ func (v MappingNotifyEvent) SequenceId() uint16 {
 func (v MappingNotifyEvent) String() string {
 	fieldVals := make([]string, 0, 5)
 	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
-	fieldVals = append(fieldVals, xgb.Sprintf("Request: %d", v.Request))
+	fieldVals = append(fieldVals, xgb.Sprintf("Request: %s", v.Request))
 	fieldVals = append(fieldVals, xgb.Sprintf("FirstKeycode: %d", v.FirstKeycode))
 	fieldVals = append(fieldVals, xgb.Sprintf("Count: %d", v.Count))
 	return "MappingNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
@@ -3865,20 +5649,35 @@ func init() {
 	xgb.NewEventFuncs[34] = MappingNotifyEventNew
 }
 
+type MappingStatus byte
+
 const (
-	MappingStatusSuccess = 0
-	MappingStatusBusy    = 1
-	MappingStatusFailure = 2
+	MappingStatusSuccess MappingStatus = 0
+	MappingStatusBusy    MappingStatus = 1
+	MappingStatusFailure MappingStatus = 2
 )
 
+// String returns the name of the MappingStatus item with this value.
+func (v MappingStatus) String() string {
+	switch v {
+	case MappingStatusSuccess:
+		return "Success"
+	case MappingStatusBusy:
+		return "Busy"
+	case MappingStatusFailure:
+		return "Failure"
+	}
+	return xgb.Sprintf("MappingStatus(%d)", byte(v))
+}
+
 // BadMatch is the error number for a BadMatch.
 const BadMatch = 8
 
 type MatchError RequestError
 
-// MatchErrorNew constructs a MatchError value that implements xgb.Error from a byte slice.
-func MatchErrorNew(buf []byte) xgb.Error {
-	v := MatchError(RequestErrorNew(buf).(RequestError))
+// MatchErrorNew constructs a MatchError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func MatchErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := MatchError(RequestErrorNew(buf, order).(RequestError))
 	v.NiceName = "Match"
 	return v
 }
@@ -3909,78 +5708,169 @@ func init() {
 	xgb.NewErrorFuncs[8] = MatchErrorNew
 }
 
+type ModMask uint16
+
 const (
-	ModMaskShift   = 1
-	ModMaskLock    = 2
-	ModMaskControl = 4
-	ModMask1       = 8
-	ModMask2       = 16
-	ModMask3       = 32
-	ModMask4       = 64
-	ModMask5       = 128
-	ModMaskAny     = 32768
+	ModMaskShift   ModMask = 1
+	ModMaskLock    ModMask = 2
+	ModMaskControl ModMask = 4
+	ModMask1       ModMask = 8
+	ModMask2       ModMask = 16
+	ModMask3       ModMask = 32
+	ModMask4       ModMask = 64
+	ModMask5       ModMask = 128
+	ModMaskAny     ModMask = 32768
 )
 
+// String returns the names of the ModMask bits set in a value.
+func (v ModMask) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 9)
+	if v&ModMaskShift != 0 {
+		names = append(names, "Shift")
+		v &^= ModMaskShift
+	}
+	if v&ModMaskLock != 0 {
+		names = append(names, "Lock")
+		v &^= ModMaskLock
+	}
+	if v&ModMaskControl != 0 {
+		names = append(names, "Control")
+		v &^= ModMaskControl
+	}
+	if v&ModMask1 != 0 {
+		names = append(names, "1")
+		v &^= ModMask1
+	}
+	if v&ModMask2 != 0 {
+		names = append(names, "2")
+		v &^= ModMask2
+	}
+	if v&ModMask3 != 0 {
+		names = append(names, "3")
+		v &^= ModMask3
+	}
+	if v&ModMask4 != 0 {
+		names = append(names, "4")
+		v &^= ModMask4
+	}
+	if v&ModMask5 != 0 {
+		names = append(names, "5")
+		v &^= ModMask5
+	}
+	if v&ModMaskAny != 0 {
+		names = append(names, "Any")
+		v &^= ModMaskAny
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint16(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
+type Motion byte
+
 const (
-	MotionNormal = 0
-	MotionHint   = 1
+	MotionNormal Motion = 0
+	MotionHint   Motion = 1
 )
 
+// String returns the name of the Motion item with this value.
+func (v Motion) String() string {
+	switch v {
+	case MotionNormal:
+		return "Normal"
+	case MotionHint:
+		return "Hint"
+	}
+	return xgb.Sprintf("Motion(%d)", byte(v))
+}
+
 // MotionNotify is the event number for a MotionNotifyEvent.
 const MotionNotify = 6
 
+// MotionNotifyEvent is the type of MotionNotify events.
+//
+// The pointer was moved.
+//
+// See also GrabKey, GrabKeyboard.
 type MotionNotifyEvent struct {
-	Sequence   uint16
-	Detail     byte
-	Time       Timestamp
-	Root       Window
-	Event      Window
-	Child      Window
-	RootX      int16
-	RootY      int16
-	EventX     int16
-	EventY     int16
-	State      uint16
+	Sequence uint16
+
+	// Whether this is a normal event or a motion hint.
+	Detail Motion
+
+	// Time when the event was generated (in milliseconds).
+	Time Timestamp
+
+	// The root window of `child`.
+	Root  Window
+	Event Window
+	Child Window
+
+	// The X coordinate of the pointer relative to the `root` window at the
+	// time of the event.
+	RootX int16
+
+	// The Y coordinate of the pointer relative to the `root` window at the
+	// time of the event.
+	RootY int16
+
+	// If `same_screen` is true, this is the X coordinate relative to the
+	// `event` window's origin. Otherwise, `event_x` will be set to zero.
+	EventX int16
+
+	// If `same_screen` is true, this is the Y coordinate relative to the
+	// `event` window's origin. Otherwise, `event_y` will be set to zero.
+	EventY int16
+
+	// The logical state of the pointer buttons and modifier keys just prior to
+	// the event.
+	State KeyButMask
+
+	// Whether the `event` window is on the same screen as the `root` window.
 	SameScreen bool
 	// padding: 1 bytes
 }
 
-// MotionNotifyEventNew constructs a MotionNotifyEvent value that implements xgb.Event from a byte slice.
-func MotionNotifyEventNew(buf []byte) xgb.Event {
+// MotionNotifyEventNew constructs a MotionNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func MotionNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := MotionNotifyEvent{}
 	b := 1 // don't read event number
 
-	v.Detail = buf[b]
+	v.Detail = Motion(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Time = Timestamp(xgb.Get32(buf[b:]))
+	v.Time = Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.Root = Window(xgb.Get32(buf[b:]))
+	v.Root = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Event = Window(xgb.Get32(buf[b:]))
+	v.Event = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Child = Window(xgb.Get32(buf[b:]))
+	v.Child = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.RootX = int16(xgb.Get16(buf[b:]))
+	v.RootX = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.RootY = int16(xgb.Get16(buf[b:]))
+	v.RootY = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.EventX = int16(xgb.Get16(buf[b:]))
+	v.EventX = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.EventY = int16(xgb.Get16(buf[b:]))
+	v.EventY = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.State = xgb.Get16(buf[b:])
+	v.State = KeyButMask(order.Get16(buf[b:]))
 	b += 2
 
 	if buf[b] == 1 {
@@ -3995,8 +5885,8 @@ func MotionNotifyEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a MotionNotifyEvent value to a byte slice.
-func (v MotionNotifyEvent) Bytes() []byte {
+// Bytes writes a MotionNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v MotionNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -4004,36 +5894,36 @@ func (v MotionNotifyEvent) Bytes() []byte {
 	buf[b] = 6
 	b += 1
 
-	buf[b] = v.Detail
+	buf[b] = byte(v.Detail)
 	b += 1
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Time))
+	order.Put32(buf[b:], uint32(v.Time))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Root))
+	order.Put32(buf[b:], uint32(v.Root))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Event))
+	order.Put32(buf[b:], uint32(v.Event))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Child))
+	order.Put32(buf[b:], uint32(v.Child))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(v.RootX))
+	order.Put16(buf[b:], uint16(v.RootX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.RootY))
+	order.Put16(buf[b:], uint16(v.RootY))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.EventX))
+	order.Put16(buf[b:], uint16(v.EventX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.EventY))
+	order.Put16(buf[b:], uint16(v.EventY))
 	b += 2
 
-	xgb.Put16(buf[b:], v.State)
+	order.Put16(buf[b:], uint16(v.State))
 	b += 2
 
 	if v.SameScreen {
@@ -4059,7 +5949,7 @@ func (v MotionNotifyEvent) SequenceId() uint16 {
 func (v MotionNotifyEvent) String() string {
 	fieldVals := make([]string, 0, 12)
 	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
-	fieldVals = append(fieldVals, xgb.Sprintf("Detail: %d", v.Detail))
+	fieldVals = append(fieldVals, xgb.Sprintf("Detail: %s", v.Detail))
 	fieldVals = append(fieldVals, xgb.Sprintf("Time: %d", v.Time))
 	fieldVals = append(fieldVals, xgb.Sprintf("Root: %d", v.Root))
 	fieldVals = append(fieldVals, xgb.Sprintf("Event: %d", v.Event))
@@ -4068,11 +5958,17 @@ func (v MotionNotifyEvent) String() string {
 	fieldVals = append(fieldVals, xgb.Sprintf("RootY: %d", v.RootY))
 	fieldVals = append(fieldVals, xgb.Sprintf("EventX: %d", v.EventX))
 	fieldVals = append(fieldVals, xgb.Sprintf("EventY: %d", v.EventY))
-	fieldVals = append(fieldVals, xgb.Sprintf("State: %d", v.State))
+	fieldVals = append(fieldVals, xgb.Sprintf("State: %s", v.State))
 	fieldVals = append(fieldVals, xgb.Sprintf("SameScreen: %t", v.SameScreen))
 	return "MotionNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the MotionNotify event is about.
+// It is used to subscribe to the events of a single window.
+func (v MotionNotifyEvent) EventWindow() uint32 {
+	return uint32(v.Event)
+}
+
 func init() {
 	xgb.NewEventFuncs[6] = MotionNotifyEventNew
 }
@@ -4082,9 +5978,9 @@ const BadName = 15
 
 type NameError RequestError
 
-// NameErrorNew constructs a NameError value that implements xgb.Error from a byte slice.
-func NameErrorNew(buf []byte) xgb.Error {
-	v := NameError(RequestErrorNew(buf).(RequestError))
+// NameErrorNew constructs a NameError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func NameErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := NameError(RequestErrorNew(buf, order).(RequestError))
 	v.NiceName = "Name"
 	return v
 }
@@ -4127,20 +6023,20 @@ type NoExposureEvent struct {
 	// padding: 1 bytes
 }
 
-// NoExposureEventNew constructs a NoExposureEvent value that implements xgb.Event from a byte slice.
-func NoExposureEventNew(buf []byte) xgb.Event {
+// NoExposureEventNew constructs a NoExposureEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func NoExposureEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := NoExposureEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Drawable = Drawable(xgb.Get32(buf[b:]))
+	v.Drawable = Drawable(order.Get32(buf[b:]))
 	b += 4
 
-	v.MinorOpcode = xgb.Get16(buf[b:])
+	v.MinorOpcode = order.Get16(buf[b:])
 	b += 2
 
 	v.MajorOpcode = buf[b]
@@ -4151,8 +6047,8 @@ func NoExposureEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a NoExposureEvent value to a byte slice.
-func (v NoExposureEvent) Bytes() []byte {
+// Bytes writes a NoExposureEvent value to a byte slice, in the byte order 'order'.
+func (v NoExposureEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -4164,10 +6060,10 @@ func (v NoExposureEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Drawable))
+	order.Put32(buf[b:], uint32(v.Drawable))
 	b += 4
 
-	xgb.Put16(buf[b:], v.MinorOpcode)
+	order.Put16(buf[b:], v.MinorOpcode)
 	b += 2
 
 	buf[b] = v.MajorOpcode
@@ -4199,24 +6095,66 @@ func init() {
 	xgb.NewEventFuncs[14] = NoExposureEventNew
 }
 
+type NotifyDetail byte
+
 const (
-	NotifyDetailAncestor         = 0
-	NotifyDetailVirtual          = 1
-	NotifyDetailInferior         = 2
-	NotifyDetailNonlinear        = 3
-	NotifyDetailNonlinearVirtual = 4
-	NotifyDetailPointer          = 5
-	NotifyDetailPointerRoot      = 6
-	NotifyDetailNone             = 7
+	NotifyDetailAncestor         NotifyDetail = 0
+	NotifyDetailVirtual          NotifyDetail = 1
+	NotifyDetailInferior         NotifyDetail = 2
+	NotifyDetailNonlinear        NotifyDetail = 3
+	NotifyDetailNonlinearVirtual NotifyDetail = 4
+	NotifyDetailPointer          NotifyDetail = 5
+	NotifyDetailPointerRoot      NotifyDetail = 6
+	NotifyDetailNone             NotifyDetail = 7
 )
 
+// String returns the name of the NotifyDetail item with this value.
+func (v NotifyDetail) String() string {
+	switch v {
+	case NotifyDetailAncestor:
+		return "Ancestor"
+	case NotifyDetailVirtual:
+		return "Virtual"
+	case NotifyDetailInferior:
+		return "Inferior"
+	case NotifyDetailNonlinear:
+		return "Nonlinear"
+	case NotifyDetailNonlinearVirtual:
+		return "NonlinearVirtual"
+	case NotifyDetailPointer:
+		return "Pointer"
+	case NotifyDetailPointerRoot:
+		return "PointerRoot"
+	case NotifyDetailNone:
+		return "None"
+	}
+	return xgb.Sprintf("NotifyDetail(%d)", byte(v))
+}
+
+type NotifyMode byte
+
 const (
-	NotifyModeNormal       = 0
-	NotifyModeGrab         = 1
-	NotifyModeUngrab       = 2
-	NotifyModeWhileGrabbed = 3
+	NotifyModeNormal       NotifyMode = 0
+	NotifyModeGrab         NotifyMode = 1
+	NotifyModeUngrab       NotifyMode = 2
+	NotifyModeWhileGrabbed NotifyMode = 3
 )
 
+// String returns the name of the NotifyMode item with this value.
+func (v NotifyMode) String() string {
+	switch v {
+	case NotifyModeNormal:
+		return "Normal"
+	case NotifyModeGrab:
+		return "Grab"
+	case NotifyModeUngrab:
+		return "Ungrab"
+	case NotifyModeWhileGrabbed:
+		return "WhileGrabbed"
+	}
+	return xgb.Sprintf("NotifyMode(%d)", byte(v))
+}
+
 type Pixmap uint32
 
 func NewPixmapId(c *xgb.Conn) (Pixmap, error) {
@@ -4236,9 +6174,9 @@ const BadPixmap = 4
 
 type PixmapError ValueError
 
-// PixmapErrorNew constructs a PixmapError value that implements xgb.Error from a byte slice.
-func PixmapErrorNew(buf []byte) xgb.Error {
-	v := PixmapError(ValueErrorNew(buf).(ValueError))
+// PixmapErrorNew constructs a PixmapError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func PixmapErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := PixmapError(ValueErrorNew(buf, order).(ValueError))
 	v.NiceName = "Pixmap"
 	return v
 }
@@ -4269,115 +6207,184 @@ func init() {
 	xgb.NewErrorFuncs[4] = PixmapErrorNew
 }
 
+type Place byte
+
 const (
-	PlaceOnTop    = 0
-	PlaceOnBottom = 1
+	PlaceOnTop    Place = 0
+	PlaceOnBottom Place = 1
 )
 
+// String returns the name of the Place item with this value.
+func (v Place) String() string {
+	switch v {
+	case PlaceOnTop:
+		return "OnTop"
+	case PlaceOnBottom:
+		return "OnBottom"
+	}
+	return xgb.Sprintf("Place(%d)", byte(v))
+}
+
 type Point struct {
 	X int16
 	Y int16
 }
 
 // PointRead reads a byte slice into a Point value.
-func PointRead(buf []byte, v *Point) int {
+func PointRead(buf []byte, order xgb.ByteOrder, v *Point) int {
 	b := 0
 
-	v.X = int16(xgb.Get16(buf[b:]))
+	v.X = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Y = int16(xgb.Get16(buf[b:]))
+	v.Y = int16(order.Get16(buf[b:]))
 	b += 2
 
 	return b
 }
 
 // PointReadList reads a byte slice into a list of Point values.
-func PointReadList(buf []byte, dest []Point) int {
+func PointReadList(buf []byte, order xgb.ByteOrder, dest []Point) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Point{}
-		b += PointRead(buf[b:], &dest[i])
+		b += PointRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Point value to a byte slice.
-func (v Point) Bytes() []byte {
+// Bytes writes a Point value to a byte slice, in the byte order 'order'.
+func (v Point) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 4)
 	b := 0
 
-	xgb.Put16(buf[b:], uint16(v.X))
+	order.Put16(buf[b:], uint16(v.X))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.Y))
+	order.Put16(buf[b:], uint16(v.Y))
 	b += 2
 
 	return buf[:b]
 }
 
 // PointListBytes writes a list of Point values to a byte slice.
-func PointListBytes(buf []byte, list []Point) int {
+func PointListBytes(buf []byte, order xgb.ByteOrder, list []Point) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 	return xgb.Pad(b)
 }
 
+type PolyShape byte
+
 const (
-	PolyShapeComplex   = 0
-	PolyShapeNonconvex = 1
-	PolyShapeConvex    = 2
+	PolyShapeComplex   PolyShape = 0
+	PolyShapeNonconvex PolyShape = 1
+	PolyShapeConvex    PolyShape = 2
 )
 
+// String returns the name of the PolyShape item with this value.
+func (v PolyShape) String() string {
+	switch v {
+	case PolyShapeComplex:
+		return "Complex"
+	case PolyShapeNonconvex:
+		return "Nonconvex"
+	case PolyShapeConvex:
+		return "Convex"
+	}
+	return xgb.Sprintf("PolyShape(%d)", byte(v))
+}
+
+type PropMode byte
+
 const (
-	PropModeReplace = 0
-	PropModePrepend = 1
-	PropModeAppend  = 2
+	PropModeReplace PropMode = 0
+	PropModePrepend PropMode = 1
+	PropModeAppend  PropMode = 2
 )
 
+// String returns the name of the PropMode item with this value.
+func (v PropMode) String() string {
+	switch v {
+	case PropModeReplace:
+		return "Replace"
+	case PropModePrepend:
+		return "Prepend"
+	case PropModeAppend:
+		return "Append"
+	}
+	return xgb.Sprintf("PropMode(%d)", byte(v))
+}
+
+type Property byte
+
 const (
-	PropertyNewValue = 0
-	PropertyDelete   = 1
+	PropertyNewValue Property = 0
+	PropertyDelete   Property = 1
 )
 
+// String returns the name of the Property item with this value.
+func (v Property) String() string {
+	switch v {
+	case PropertyNewValue:
+		return "NewValue"
+	case PropertyDelete:
+		return "Delete"
+	}
+	return xgb.Sprintf("Property(%d)", byte(v))
+}
+
 // PropertyNotify is the event number for a PropertyNotifyEvent.
 const PropertyNotify = 28
 
+// PropertyNotifyEvent is the type of PropertyNotify events.
+//
+// A window property changed.
+//
+// See also ChangeProperty.
 type PropertyNotifyEvent struct {
 	Sequence uint16
 	// padding: 1 bytes
+
+	// The window whose associated property was changed.
 	Window Window
-	Atom   Atom
-	Time   Timestamp
-	State  byte
+
+	// The property's atom, to indicate which property was changed.
+	Atom Atom
+
+	// A timestamp of the server time when the property was changed.
+	Time Timestamp
+
+	// Whether the property was changed or deleted.
+	State Property
 	// padding: 3 bytes
 }
 
-// PropertyNotifyEventNew constructs a PropertyNotifyEvent value that implements xgb.Event from a byte slice.
-func PropertyNotifyEventNew(buf []byte) xgb.Event {
+// PropertyNotifyEventNew constructs a PropertyNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func PropertyNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := PropertyNotifyEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Window = Window(xgb.Get32(buf[b:]))
+	v.Window = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Atom = Atom(xgb.Get32(buf[b:]))
+	v.Atom = Atom(order.Get32(buf[b:]))
 	b += 4
 
-	v.Time = Timestamp(xgb.Get32(buf[b:]))
+	v.Time = Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.State = buf[b]
+	v.State = Property(buf[b])
 	b += 1
 
 	b += 3 // padding
@@ -4385,8 +6392,8 @@ func PropertyNotifyEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a PropertyNotifyEvent value to a byte slice.
-func (v PropertyNotifyEvent) Bytes() []byte {
+// Bytes writes a PropertyNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v PropertyNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -4398,16 +6405,16 @@ func (v PropertyNotifyEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Atom))
+	order.Put32(buf[b:], uint32(v.Atom))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Time))
+	order.Put32(buf[b:], uint32(v.Time))
 	b += 4
 
-	buf[b] = v.State
+	buf[b] = byte(v.State)
 	b += 1
 
 	b += 3 // padding
@@ -4429,20 +6436,41 @@ func (v PropertyNotifyEvent) String() string {
 	fieldVals = append(fieldVals, xgb.Sprintf("Window: %d", v.Window))
 	fieldVals = append(fieldVals, xgb.Sprintf("Atom: %d", v.Atom))
 	fieldVals = append(fieldVals, xgb.Sprintf("Time: %d", v.Time))
-	fieldVals = append(fieldVals, xgb.Sprintf("State: %d", v.State))
+	fieldVals = append(fieldVals, xgb.Sprintf("State: %s", v.State))
 	return "PropertyNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the PropertyNotify event is about.
+// It is used to subscribe to the events of a single window.
+func (v PropertyNotifyEvent) EventWindow() uint32 {
+	return uint32(v.Window)
+}
+
 func init() {
 	xgb.NewEventFuncs[28] = PropertyNotifyEventNew
 }
 
+type QueryShapeOf byte
+
 const (
-	QueryShapeOfLargestCursor  = 0
-	QueryShapeOfFastestTile    = 1
-	QueryShapeOfFastestStipple = 2
+	QueryShapeOfLargestCursor  QueryShapeOf = 0
+	QueryShapeOfFastestTile    QueryShapeOf = 1
+	QueryShapeOfFastestStipple QueryShapeOf = 2
 )
 
+// String returns the name of the QueryShapeOf item with this value.
+func (v QueryShapeOf) String() string {
+	switch v {
+	case QueryShapeOfLargestCursor:
+		return "LargestCursor"
+	case QueryShapeOfFastestTile:
+		return "FastestTile"
+	case QueryShapeOfFastestStipple:
+		return "FastestStipple"
+	}
+	return xgb.Sprintf("QueryShapeOf(%d)", byte(v))
+}
+
 type Rectangle struct {
 	X      int16
 	Y      int16
@@ -4451,60 +6479,60 @@ type Rectangle struct {
 }
 
 // RectangleRead reads a byte slice into a Rectangle value.
-func RectangleRead(buf []byte, v *Rectangle) int {
+func RectangleRead(buf []byte, order xgb.ByteOrder, v *Rectangle) int {
 	b := 0
 
-	v.X = int16(xgb.Get16(buf[b:]))
+	v.X = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Y = int16(xgb.Get16(buf[b:]))
+	v.Y = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
 	return b
 }
 
 // RectangleReadList reads a byte slice into a list of Rectangle values.
-func RectangleReadList(buf []byte, dest []Rectangle) int {
+func RectangleReadList(buf []byte, order xgb.ByteOrder, dest []Rectangle) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Rectangle{}
-		b += RectangleRead(buf[b:], &dest[i])
+		b += RectangleRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Rectangle value to a byte slice.
-func (v Rectangle) Bytes() []byte {
+// Bytes writes a Rectangle value to a byte slice, in the byte order 'order'.
+func (v Rectangle) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 8)
 	b := 0
 
-	xgb.Put16(buf[b:], uint16(v.X))
+	order.Put16(buf[b:], uint16(v.X))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.Y))
+	order.Put16(buf[b:], uint16(v.Y))
 	b += 2
 
-	xgb.Put16(buf[b:], v.Width)
+	order.Put16(buf[b:], v.Width)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Height)
+	order.Put16(buf[b:], v.Height)
 	b += 2
 
 	return buf[:b]
 }
 
 // RectangleListBytes writes a list of Rectangle values to a byte slice.
-func RectangleListBytes(buf []byte, list []Rectangle) int {
+func RectangleListBytes(buf []byte, order xgb.ByteOrder, list []Rectangle) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -4526,29 +6554,29 @@ type ReparentNotifyEvent struct {
 	// padding: 3 bytes
 }
 
-// ReparentNotifyEventNew constructs a ReparentNotifyEvent value that implements xgb.Event from a byte slice.
-func ReparentNotifyEventNew(buf []byte) xgb.Event {
+// ReparentNotifyEventNew constructs a ReparentNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func ReparentNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := ReparentNotifyEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Event = Window(xgb.Get32(buf[b:]))
+	v.Event = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Window = Window(xgb.Get32(buf[b:]))
+	v.Window = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Parent = Window(xgb.Get32(buf[b:]))
+	v.Parent = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.X = int16(xgb.Get16(buf[b:]))
+	v.X = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Y = int16(xgb.Get16(buf[b:]))
+	v.Y = int16(order.Get16(buf[b:]))
 	b += 2
 
 	if buf[b] == 1 {
@@ -4563,8 +6591,8 @@ func ReparentNotifyEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a ReparentNotifyEvent value to a byte slice.
-func (v ReparentNotifyEvent) Bytes() []byte {
+// Bytes writes a ReparentNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v ReparentNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -4576,19 +6604,19 @@ func (v ReparentNotifyEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Event))
+	order.Put32(buf[b:], uint32(v.Event))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Parent))
+	order.Put32(buf[b:], uint32(v.Parent))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(v.X))
+	order.Put16(buf[b:], uint16(v.X))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.Y))
+	order.Put16(buf[b:], uint16(v.Y))
 	b += 2
 
 	if v.OverrideRedirect {
@@ -4623,6 +6651,12 @@ func (v ReparentNotifyEvent) String() string {
 	return "ReparentNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the ReparentNotify event is about.
+// It is used to subscribe to the events of a single window.
+func (v ReparentNotifyEvent) EventWindow() uint32 {
+	return uint32(v.Event)
+}
+
 func init() {
 	xgb.NewEventFuncs[21] = ReparentNotifyEventNew
 }
@@ -4639,21 +6673,21 @@ type RequestError struct {
 	// padding: 1 bytes
 }
 
-// RequestErrorNew constructs a RequestError value that implements xgb.Error from a byte slice.
-func RequestErrorNew(buf []byte) xgb.Error {
+// RequestErrorNew constructs a RequestError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func RequestErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := RequestError{}
 	v.NiceName = "Request"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.BadValue = xgb.Get32(buf[b:])
+	v.BadValue = order.Get32(buf[b:])
 	b += 4
 
-	v.MinorOpcode = xgb.Get16(buf[b:])
+	v.MinorOpcode = order.Get16(buf[b:])
 	b += 2
 
 	v.MajorOpcode = buf[b]
@@ -4702,30 +6736,30 @@ type ResizeRequestEvent struct {
 	Height uint16
 }
 
-// ResizeRequestEventNew constructs a ResizeRequestEvent value that implements xgb.Event from a byte slice.
-func ResizeRequestEventNew(buf []byte) xgb.Event {
+// ResizeRequestEventNew constructs a ResizeRequestEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func ResizeRequestEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := ResizeRequestEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Window = Window(xgb.Get32(buf[b:]))
+	v.Window = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
 	return v
 }
 
-// Bytes writes a ResizeRequestEvent value to a byte slice.
-func (v ResizeRequestEvent) Bytes() []byte {
+// Bytes writes a ResizeRequestEvent value to a byte slice, in the byte order 'order'.
+func (v ResizeRequestEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -4737,13 +6771,13 @@ func (v ResizeRequestEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
-	xgb.Put16(buf[b:], v.Width)
+	order.Put16(buf[b:], v.Width)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Height)
+	order.Put16(buf[b:], v.Height)
 	b += 2
 
 	return buf
@@ -4766,6 +6800,12 @@ func (v ResizeRequestEvent) String() string {
 	return "ResizeRequest {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the ResizeRequest event is about.
+// It is used to subscribe to the events of a single window.
+func (v ResizeRequestEvent) EventWindow() uint32 {
+	return uint32(v.Window)
+}
+
 func init() {
 	xgb.NewEventFuncs[25] = ResizeRequestEventNew
 }
@@ -4778,16 +6818,16 @@ type Rgb struct {
 }
 
 // RgbRead reads a byte slice into a Rgb value.
-func RgbRead(buf []byte, v *Rgb) int {
+func RgbRead(buf []byte, order xgb.ByteOrder, v *Rgb) int {
 	b := 0
 
-	v.Red = xgb.Get16(buf[b:])
+	v.Red = order.Get16(buf[b:])
 	b += 2
 
-	v.Green = xgb.Get16(buf[b:])
+	v.Green = order.Get16(buf[b:])
 	b += 2
 
-	v.Blue = xgb.Get16(buf[b:])
+	v.Blue = order.Get16(buf[b:])
 	b += 2
 
 	b += 2 // padding
@@ -4796,27 +6836,27 @@ func RgbRead(buf []byte, v *Rgb) int {
 }
 
 // RgbReadList reads a byte slice into a list of Rgb values.
-func RgbReadList(buf []byte, dest []Rgb) int {
+func RgbReadList(buf []byte, order xgb.ByteOrder, dest []Rgb) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Rgb{}
-		b += RgbRead(buf[b:], &dest[i])
+		b += RgbRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Rgb value to a byte slice.
-func (v Rgb) Bytes() []byte {
+// Bytes writes a Rgb value to a byte slice, in the byte order 'order'.
+func (v Rgb) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 8)
 	b := 0
 
-	xgb.Put16(buf[b:], v.Red)
+	order.Put16(buf[b:], v.Red)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Green)
+	order.Put16(buf[b:], v.Green)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Blue)
+	order.Put16(buf[b:], v.Blue)
 	b += 2
 
 	b += 2 // padding
@@ -4825,11 +6865,11 @@ func (v Rgb) Bytes() []byte {
 }
 
 // RgbListBytes writes a list of Rgb values to a byte slice.
-func RgbListBytes(buf []byte, list []Rgb) int {
+func RgbListBytes(buf []byte, order xgb.ByteOrder, list []Rgb) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -4841,7 +6881,7 @@ type ScreenInfo struct {
 	DefaultColormap     Colormap
 	WhitePixel          uint32
 	BlackPixel          uint32
-	CurrentInputMasks   uint32
+	CurrentInputMasks   EventMask
 	WidthInPixels       uint16
 	HeightInPixels      uint16
 	WidthInMillimeters  uint16
@@ -4849,7 +6889,7 @@ type ScreenInfo struct {
 	MinInstalledMaps    uint16
 	MaxInstalledMaps    uint16
 	RootVisual          Visualid
-	BackingStores       byte
+	BackingStores       BackingStore
 	SaveUnders          bool
 	RootDepth           byte
 	AllowedDepthsLen    byte
@@ -4857,46 +6897,46 @@ type ScreenInfo struct {
 }
 
 // ScreenInfoRead reads a byte slice into a ScreenInfo value.
-func ScreenInfoRead(buf []byte, v *ScreenInfo) int {
+func ScreenInfoRead(buf []byte, order xgb.ByteOrder, v *ScreenInfo) int {
 	b := 0
 
-	v.Root = Window(xgb.Get32(buf[b:]))
+	v.Root = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.DefaultColormap = Colormap(xgb.Get32(buf[b:]))
+	v.DefaultColormap = Colormap(order.Get32(buf[b:]))
 	b += 4
 
-	v.WhitePixel = xgb.Get32(buf[b:])
+	v.WhitePixel = order.Get32(buf[b:])
 	b += 4
 
-	v.BlackPixel = xgb.Get32(buf[b:])
+	v.BlackPixel = order.Get32(buf[b:])
 	b += 4
 
-	v.CurrentInputMasks = xgb.Get32(buf[b:])
+	v.CurrentInputMasks = EventMask(order.Get32(buf[b:]))
 	b += 4
 
-	v.WidthInPixels = xgb.Get16(buf[b:])
+	v.WidthInPixels = order.Get16(buf[b:])
 	b += 2
 
-	v.HeightInPixels = xgb.Get16(buf[b:])
+	v.HeightInPixels = order.Get16(buf[b:])
 	b += 2
 
-	v.WidthInMillimeters = xgb.Get16(buf[b:])
+	v.WidthInMillimeters = order.Get16(buf[b:])
 	b += 2
 
-	v.HeightInMillimeters = xgb.Get16(buf[b:])
+	v.HeightInMillimeters = order.Get16(buf[b:])
 	b += 2
 
-	v.MinInstalledMaps = xgb.Get16(buf[b:])
+	v.MinInstalledMaps = order.Get16(buf[b:])
 	b += 2
 
-	v.MaxInstalledMaps = xgb.Get16(buf[b:])
+	v.MaxInstalledMaps = order.Get16(buf[b:])
 	b += 2
 
-	v.RootVisual = Visualid(xgb.Get32(buf[b:]))
+	v.RootVisual = Visualid(order.Get32(buf[b:]))
 	b += 4
 
-	v.BackingStores = buf[b]
+	v.BackingStores = BackingStore(buf[b])
 	b += 1
 
 	if buf[b] == 1 {
@@ -4913,63 +6953,63 @@ func ScreenInfoRead(buf []byte, v *ScreenInfo) int {
 	b += 1
 
 	v.AllowedDepths = make([]DepthInfo, v.AllowedDepthsLen)
-	b += DepthInfoReadList(buf[b:], v.AllowedDepths)
+	b += DepthInfoReadList(buf[b:], order, v.AllowedDepths)
 
 	return b
 }
 
 // ScreenInfoReadList reads a byte slice into a list of ScreenInfo values.
-func ScreenInfoReadList(buf []byte, dest []ScreenInfo) int {
+func ScreenInfoReadList(buf []byte, order xgb.ByteOrder, dest []ScreenInfo) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = ScreenInfo{}
-		b += ScreenInfoRead(buf[b:], &dest[i])
+		b += ScreenInfoRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a ScreenInfo value to a byte slice.
-func (v ScreenInfo) Bytes() []byte {
+// Bytes writes a ScreenInfo value to a byte slice, in the byte order 'order'.
+func (v ScreenInfo) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, (40 + DepthInfoListSize(v.AllowedDepths)))
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.Root))
+	order.Put32(buf[b:], uint32(v.Root))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.DefaultColormap))
+	order.Put32(buf[b:], uint32(v.DefaultColormap))
 	b += 4
 
-	xgb.Put32(buf[b:], v.WhitePixel)
+	order.Put32(buf[b:], v.WhitePixel)
 	b += 4
 
-	xgb.Put32(buf[b:], v.BlackPixel)
+	order.Put32(buf[b:], v.BlackPixel)
 	b += 4
 
-	xgb.Put32(buf[b:], v.CurrentInputMasks)
+	order.Put32(buf[b:], uint32(v.CurrentInputMasks))
 	b += 4
 
-	xgb.Put16(buf[b:], v.WidthInPixels)
+	order.Put16(buf[b:], v.WidthInPixels)
 	b += 2
 
-	xgb.Put16(buf[b:], v.HeightInPixels)
+	order.Put16(buf[b:], v.HeightInPixels)
 	b += 2
 
-	xgb.Put16(buf[b:], v.WidthInMillimeters)
+	order.Put16(buf[b:], v.WidthInMillimeters)
 	b += 2
 
-	xgb.Put16(buf[b:], v.HeightInMillimeters)
+	order.Put16(buf[b:], v.HeightInMillimeters)
 	b += 2
 
-	xgb.Put16(buf[b:], v.MinInstalledMaps)
+	order.Put16(buf[b:], v.MinInstalledMaps)
 	b += 2
 
-	xgb.Put16(buf[b:], v.MaxInstalledMaps)
+	order.Put16(buf[b:], v.MaxInstalledMaps)
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(v.RootVisual))
+	order.Put32(buf[b:], uint32(v.RootVisual))
 	b += 4
 
-	buf[b] = v.BackingStores
+	buf[b] = byte(v.BackingStores)
 	b += 1
 
 	if v.SaveUnders {
@@ -4985,17 +7025,17 @@ func (v ScreenInfo) Bytes() []byte {
 	buf[b] = v.AllowedDepthsLen
 	b += 1
 
-	b += DepthInfoListBytes(buf[b:], v.AllowedDepths)
+	b += DepthInfoListBytes(buf[b:], order, v.AllowedDepths)
 
 	return buf[:b]
 }
 
 // ScreenInfoListBytes writes a list of ScreenInfo values to a byte slice.
-func ScreenInfoListBytes(buf []byte, list []ScreenInfo) int {
+func ScreenInfoListBytes(buf []byte, order xgb.ByteOrder, list []ScreenInfo) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -5011,11 +7051,24 @@ func ScreenInfoListSize(list []ScreenInfo) int {
 	return size
 }
 
+type ScreenSaver byte
+
 const (
-	ScreenSaverReset  = 0
-	ScreenSaverActive = 1
+	ScreenSaverReset  ScreenSaver = 0
+	ScreenSaverActive ScreenSaver = 1
 )
 
+// String returns the name of the ScreenSaver item with this value.
+func (v ScreenSaver) String() string {
+	switch v {
+	case ScreenSaverReset:
+		return "Reset"
+	case ScreenSaverActive:
+		return "Active"
+	}
+	return xgb.Sprintf("ScreenSaver(%d)", byte(v))
+}
+
 type Segment struct {
 	X1 int16
 	Y1 int16
@@ -5024,60 +7077,60 @@ type Segment struct {
 }
 
 // SegmentRead reads a byte slice into a Segment value.
-func SegmentRead(buf []byte, v *Segment) int {
+func SegmentRead(buf []byte, order xgb.ByteOrder, v *Segment) int {
 	b := 0
 
-	v.X1 = int16(xgb.Get16(buf[b:]))
+	v.X1 = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Y1 = int16(xgb.Get16(buf[b:]))
+	v.Y1 = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.X2 = int16(xgb.Get16(buf[b:]))
+	v.X2 = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Y2 = int16(xgb.Get16(buf[b:]))
+	v.Y2 = int16(order.Get16(buf[b:]))
 	b += 2
 
 	return b
 }
 
 // SegmentReadList reads a byte slice into a list of Segment values.
-func SegmentReadList(buf []byte, dest []Segment) int {
+func SegmentReadList(buf []byte, order xgb.ByteOrder, dest []Segment) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Segment{}
-		b += SegmentRead(buf[b:], &dest[i])
+		b += SegmentRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Segment value to a byte slice.
-func (v Segment) Bytes() []byte {
+// Bytes writes a Segment value to a byte slice, in the byte order 'order'.
+func (v Segment) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 8)
 	b := 0
 
-	xgb.Put16(buf[b:], uint16(v.X1))
+	order.Put16(buf[b:], uint16(v.X1))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.Y1))
+	order.Put16(buf[b:], uint16(v.Y1))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.X2))
+	order.Put16(buf[b:], uint16(v.X2))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.Y2))
+	order.Put16(buf[b:], uint16(v.Y2))
 	b += 2
 
 	return buf[:b]
 }
 
 // SegmentListBytes writes a list of Segment values to a byte slice.
-func SegmentListBytes(buf []byte, list []Segment) int {
+func SegmentListBytes(buf []byte, order xgb.ByteOrder, list []Segment) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -5095,30 +7148,30 @@ type SelectionClearEvent struct {
 	Selection Atom
 }
 
-// SelectionClearEventNew constructs a SelectionClearEvent value that implements xgb.Event from a byte slice.
-func SelectionClearEventNew(buf []byte) xgb.Event {
+// SelectionClearEventNew constructs a SelectionClearEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func SelectionClearEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := SelectionClearEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Time = Timestamp(xgb.Get32(buf[b:]))
+	v.Time = Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.Owner = Window(xgb.Get32(buf[b:]))
+	v.Owner = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Selection = Atom(xgb.Get32(buf[b:]))
+	v.Selection = Atom(order.Get32(buf[b:]))
 	b += 4
 
 	return v
 }
 
-// Bytes writes a SelectionClearEvent value to a byte slice.
-func (v SelectionClearEvent) Bytes() []byte {
+// Bytes writes a SelectionClearEvent value to a byte slice, in the byte order 'order'.
+func (v SelectionClearEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -5130,13 +7183,13 @@ func (v SelectionClearEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Time))
+	order.Put32(buf[b:], uint32(v.Time))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Owner))
+	order.Put32(buf[b:], uint32(v.Owner))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Selection))
+	order.Put32(buf[b:], uint32(v.Selection))
 	b += 4
 
 	return buf
@@ -5176,36 +7229,36 @@ type SelectionNotifyEvent struct {
 	Property  Atom
 }
 
-// SelectionNotifyEventNew constructs a SelectionNotifyEvent value that implements xgb.Event from a byte slice.
-func SelectionNotifyEventNew(buf []byte) xgb.Event {
+// SelectionNotifyEventNew constructs a SelectionNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func SelectionNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := SelectionNotifyEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Time = Timestamp(xgb.Get32(buf[b:]))
+	v.Time = Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.Requestor = Window(xgb.Get32(buf[b:]))
+	v.Requestor = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Selection = Atom(xgb.Get32(buf[b:]))
+	v.Selection = Atom(order.Get32(buf[b:]))
 	b += 4
 
-	v.Target = Atom(xgb.Get32(buf[b:]))
+	v.Target = Atom(order.Get32(buf[b:]))
 	b += 4
 
-	v.Property = Atom(xgb.Get32(buf[b:]))
+	v.Property = Atom(order.Get32(buf[b:]))
 	b += 4
 
 	return v
 }
 
-// Bytes writes a SelectionNotifyEvent value to a byte slice.
-func (v SelectionNotifyEvent) Bytes() []byte {
+// Bytes writes a SelectionNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v SelectionNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -5217,19 +7270,19 @@ func (v SelectionNotifyEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Time))
+	order.Put32(buf[b:], uint32(v.Time))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Requestor))
+	order.Put32(buf[b:], uint32(v.Requestor))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Selection))
+	order.Put32(buf[b:], uint32(v.Selection))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Target))
+	order.Put32(buf[b:], uint32(v.Target))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Property))
+	order.Put32(buf[b:], uint32(v.Property))
 	b += 4
 
 	return buf
@@ -5272,39 +7325,39 @@ type SelectionRequestEvent struct {
 	Property  Atom
 }
 
-// SelectionRequestEventNew constructs a SelectionRequestEvent value that implements xgb.Event from a byte slice.
-func SelectionRequestEventNew(buf []byte) xgb.Event {
+// SelectionRequestEventNew constructs a SelectionRequestEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func SelectionRequestEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := SelectionRequestEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Time = Timestamp(xgb.Get32(buf[b:]))
+	v.Time = Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.Owner = Window(xgb.Get32(buf[b:]))
+	v.Owner = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Requestor = Window(xgb.Get32(buf[b:]))
+	v.Requestor = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Selection = Atom(xgb.Get32(buf[b:]))
+	v.Selection = Atom(order.Get32(buf[b:]))
 	b += 4
 
-	v.Target = Atom(xgb.Get32(buf[b:]))
+	v.Target = Atom(order.Get32(buf[b:]))
 	b += 4
 
-	v.Property = Atom(xgb.Get32(buf[b:]))
+	v.Property = Atom(order.Get32(buf[b:]))
 	b += 4
 
 	return v
 }
 
-// Bytes writes a SelectionRequestEvent value to a byte slice.
-func (v SelectionRequestEvent) Bytes() []byte {
+// Bytes writes a SelectionRequestEvent value to a byte slice, in the byte order 'order'.
+func (v SelectionRequestEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -5316,22 +7369,22 @@ func (v SelectionRequestEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Time))
+	order.Put32(buf[b:], uint32(v.Time))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Owner))
+	order.Put32(buf[b:], uint32(v.Owner))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Requestor))
+	order.Put32(buf[b:], uint32(v.Requestor))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Selection))
+	order.Put32(buf[b:], uint32(v.Selection))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Target))
+	order.Put32(buf[b:], uint32(v.Target))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Property))
+	order.Put32(buf[b:], uint32(v.Property))
 	b += 4
 
 	return buf
@@ -5366,11 +7419,24 @@ const (
 	SendEventDestItemFocus     = 1
 )
 
+type SetMode byte
+
 const (
-	SetModeInsert = 0
-	SetModeDelete = 1
+	SetModeInsert SetMode = 0
+	SetModeDelete SetMode = 1
 )
 
+// String returns the name of the SetMode item with this value.
+func (v SetMode) String() string {
+	switch v {
+	case SetModeInsert:
+		return "Insert"
+	case SetModeDelete:
+		return "Delete"
+	}
+	return xgb.Sprintf("SetMode(%d)", byte(v))
+}
+
 type SetupAuthenticate struct {
 	Status byte
 	// padding: 5 bytes
@@ -5379,7 +7445,7 @@ type SetupAuthenticate struct {
 }
 
 // SetupAuthenticateRead reads a byte slice into a SetupAuthenticate value.
-func SetupAuthenticateRead(buf []byte, v *SetupAuthenticate) int {
+func SetupAuthenticateRead(buf []byte, order xgb.ByteOrder, v *SetupAuthenticate) int {
 	b := 0
 
 	v.Status = buf[b]
@@ -5387,7 +7453,7 @@ func SetupAuthenticateRead(buf []byte, v *SetupAuthenticate) int {
 
 	b += 5 // padding
 
-	v.Length = xgb.Get16(buf[b:])
+	v.Length = order.Get16(buf[b:])
 	b += 2
 
 	{
@@ -5401,17 +7467,17 @@ func SetupAuthenticateRead(buf []byte, v *SetupAuthenticate) int {
 }
 
 // SetupAuthenticateReadList reads a byte slice into a list of SetupAuthenticate values.
-func SetupAuthenticateReadList(buf []byte, dest []SetupAuthenticate) int {
+func SetupAuthenticateReadList(buf []byte, order xgb.ByteOrder, dest []SetupAuthenticate) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = SetupAuthenticate{}
-		b += SetupAuthenticateRead(buf[b:], &dest[i])
+		b += SetupAuthenticateRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a SetupAuthenticate value to a byte slice.
-func (v SetupAuthenticate) Bytes() []byte {
+// Bytes writes a SetupAuthenticate value to a byte slice, in the byte order 'order'.
+func (v SetupAuthenticate) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, (8 + xgb.Pad(((int(v.Length) * 4) * 1))))
 	b := 0
 
@@ -5420,7 +7486,7 @@ func (v SetupAuthenticate) Bytes() []byte {
 
 	b += 5 // padding
 
-	xgb.Put16(buf[b:], v.Length)
+	order.Put16(buf[b:], v.Length)
 	b += 2
 
 	copy(buf[b:], v.Reason[:(int(v.Length)*4)])
@@ -5430,11 +7496,11 @@ func (v SetupAuthenticate) Bytes() []byte {
 }
 
 // SetupAuthenticateListBytes writes a list of SetupAuthenticate values to a byte slice.
-func SetupAuthenticateListBytes(buf []byte, list []SetupAuthenticate) int {
+func SetupAuthenticateListBytes(buf []byte, order xgb.ByteOrder, list []SetupAuthenticate) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -5460,7 +7526,7 @@ type SetupFailed struct {
 }
 
 // SetupFailedRead reads a byte slice into a SetupFailed value.
-func SetupFailedRead(buf []byte, v *SetupFailed) int {
+func SetupFailedRead(buf []byte, order xgb.ByteOrder, v *SetupFailed) int {
 	b := 0
 
 	v.Status = buf[b]
@@ -5469,13 +7535,13 @@ func SetupFailedRead(buf []byte, v *SetupFailed) int {
 	v.ReasonLen = buf[b]
 	b += 1
 
-	v.ProtocolMajorVersion = xgb.Get16(buf[b:])
+	v.ProtocolMajorVersion = order.Get16(buf[b:])
 	b += 2
 
-	v.ProtocolMinorVersion = xgb.Get16(buf[b:])
+	v.ProtocolMinorVersion = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get16(buf[b:])
+	v.Length = order.Get16(buf[b:])
 	b += 2
 
 	{
@@ -5489,17 +7555,17 @@ func SetupFailedRead(buf []byte, v *SetupFailed) int {
 }
 
 // SetupFailedReadList reads a byte slice into a list of SetupFailed values.
-func SetupFailedReadList(buf []byte, dest []SetupFailed) int {
+func SetupFailedReadList(buf []byte, order xgb.ByteOrder, dest []SetupFailed) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = SetupFailed{}
-		b += SetupFailedRead(buf[b:], &dest[i])
+		b += SetupFailedRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a SetupFailed value to a byte slice.
-func (v SetupFailed) Bytes() []byte {
+// Bytes writes a SetupFailed value to a byte slice, in the byte order 'order'.
+func (v SetupFailed) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, (8 + xgb.Pad((int(v.ReasonLen) * 1))))
 	b := 0
 
@@ -5509,13 +7575,13 @@ func (v SetupFailed) Bytes() []byte {
 	buf[b] = v.ReasonLen
 	b += 1
 
-	xgb.Put16(buf[b:], v.ProtocolMajorVersion)
+	order.Put16(buf[b:], v.ProtocolMajorVersion)
 	b += 2
 
-	xgb.Put16(buf[b:], v.ProtocolMinorVersion)
+	order.Put16(buf[b:], v.ProtocolMinorVersion)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Length)
+	order.Put16(buf[b:], v.Length)
 	b += 2
 
 	copy(buf[b:], v.Reason[:v.ReasonLen])
@@ -5525,11 +7591,11 @@ func (v SetupFailed) Bytes() []byte {
 }
 
 // SetupFailedListBytes writes a list of SetupFailed values to a byte slice.
-func SetupFailedListBytes(buf []byte, list []SetupFailed) int {
+func SetupFailedListBytes(buf []byte, order xgb.ByteOrder, list []SetupFailed) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -5559,8 +7625,8 @@ type SetupInfo struct {
 	MaximumRequestLength     uint16
 	RootsLen                 byte
 	PixmapFormatsLen         byte
-	ImageByteOrder           byte
-	BitmapFormatBitOrder     byte
+	ImageByteOrder           ImageOrder
+	BitmapFormatBitOrder     ImageOrder
 	BitmapFormatScanlineUnit byte
 	BitmapFormatScanlinePad  byte
 	MinKeycode               Keycode
@@ -5574,7 +7640,7 @@ type SetupInfo struct {
 }
 
 // SetupInfoRead reads a byte slice into a SetupInfo value.
-func SetupInfoRead(buf []byte, v *SetupInfo) int {
+func SetupInfoRead(buf []byte, order xgb.ByteOrder, v *SetupInfo) int {
 	b := 0
 
 	v.Status = buf[b]
@@ -5582,31 +7648,31 @@ func SetupInfoRead(buf []byte, v *SetupInfo) int {
 
 	b += 1 // padding
 
-	v.ProtocolMajorVersion = xgb.Get16(buf[b:])
+	v.ProtocolMajorVersion = order.Get16(buf[b:])
 	b += 2
 
-	v.ProtocolMinorVersion = xgb.Get16(buf[b:])
+	v.ProtocolMinorVersion = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get16(buf[b:])
+	v.Length = order.Get16(buf[b:])
 	b += 2
 
-	v.ReleaseNumber = xgb.Get32(buf[b:])
+	v.ReleaseNumber = order.Get32(buf[b:])
 	b += 4
 
-	v.ResourceIdBase = xgb.Get32(buf[b:])
+	v.ResourceIdBase = order.Get32(buf[b:])
 	b += 4
 
-	v.ResourceIdMask = xgb.Get32(buf[b:])
+	v.ResourceIdMask = order.Get32(buf[b:])
 	b += 4
 
-	v.MotionBufferSize = xgb.Get32(buf[b:])
+	v.MotionBufferSize = order.Get32(buf[b:])
 	b += 4
 
-	v.VendorLen = xgb.Get16(buf[b:])
+	v.VendorLen = order.Get16(buf[b:])
 	b += 2
 
-	v.MaximumRequestLength = xgb.Get16(buf[b:])
+	v.MaximumRequestLength = order.Get16(buf[b:])
 	b += 2
 
 	v.RootsLen = buf[b]
@@ -5615,10 +7681,10 @@ func SetupInfoRead(buf []byte, v *SetupInfo) int {
 	v.PixmapFormatsLen = buf[b]
 	b += 1
 
-	v.ImageByteOrder = buf[b]
+	v.ImageByteOrder = ImageOrder(buf[b])
 	b += 1
 
-	v.BitmapFormatBitOrder = buf[b]
+	v.BitmapFormatBitOrder = ImageOrder(buf[b])
 	b += 1
 
 	v.BitmapFormatScanlineUnit = buf[b]
@@ -5645,28 +7711,28 @@ func SetupInfoRead(buf []byte, v *SetupInfo) int {
 	b = (b + 3) & ^3 // alignment gap
 
 	v.PixmapFormats = make([]Format, v.PixmapFormatsLen)
-	b += FormatReadList(buf[b:], v.PixmapFormats)
+	b += FormatReadList(buf[b:], order, v.PixmapFormats)
 
 	b = (b + 3) & ^3 // alignment gap
 
 	v.Roots = make([]ScreenInfo, v.RootsLen)
-	b += ScreenInfoReadList(buf[b:], v.Roots)
+	b += ScreenInfoReadList(buf[b:], order, v.Roots)
 
 	return b
 }
 
 // SetupInfoReadList reads a byte slice into a list of SetupInfo values.
-func SetupInfoReadList(buf []byte, dest []SetupInfo) int {
+func SetupInfoReadList(buf []byte, order xgb.ByteOrder, dest []SetupInfo) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = SetupInfo{}
-		b += SetupInfoRead(buf[b:], &dest[i])
+		b += SetupInfoRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a SetupInfo value to a byte slice.
-func (v SetupInfo) Bytes() []byte {
+// Bytes writes a SetupInfo value to a byte slice, in the byte order 'order'.
+func (v SetupInfo) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, (((((40 + xgb.Pad((int(v.VendorLen) * 1))) + 4) + xgb.Pad((int(v.PixmapFormatsLen) * 8))) + 4) + ScreenInfoListSize(v.Roots)))
 	b := 0
 
@@ -5675,31 +7741,31 @@ func (v SetupInfo) Bytes() []byte {
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], v.ProtocolMajorVersion)
+	order.Put16(buf[b:], v.ProtocolMajorVersion)
 	b += 2
 
-	xgb.Put16(buf[b:], v.ProtocolMinorVersion)
+	order.Put16(buf[b:], v.ProtocolMinorVersion)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Length)
+	order.Put16(buf[b:], v.Length)
 	b += 2
 
-	xgb.Put32(buf[b:], v.ReleaseNumber)
+	order.Put32(buf[b:], v.ReleaseNumber)
 	b += 4
 
-	xgb.Put32(buf[b:], v.ResourceIdBase)
+	order.Put32(buf[b:], v.ResourceIdBase)
 	b += 4
 
-	xgb.Put32(buf[b:], v.ResourceIdMask)
+	order.Put32(buf[b:], v.ResourceIdMask)
 	b += 4
 
-	xgb.Put32(buf[b:], v.MotionBufferSize)
+	order.Put32(buf[b:], v.MotionBufferSize)
 	b += 4
 
-	xgb.Put16(buf[b:], v.VendorLen)
+	order.Put16(buf[b:], v.VendorLen)
 	b += 2
 
-	xgb.Put16(buf[b:], v.MaximumRequestLength)
+	order.Put16(buf[b:], v.MaximumRequestLength)
 	b += 2
 
 	buf[b] = v.RootsLen
@@ -5708,10 +7774,10 @@ func (v SetupInfo) Bytes() []byte {
 	buf[b] = v.PixmapFormatsLen
 	b += 1
 
-	buf[b] = v.ImageByteOrder
+	buf[b] = byte(v.ImageByteOrder)
 	b += 1
 
-	buf[b] = v.BitmapFormatBitOrder
+	buf[b] = byte(v.BitmapFormatBitOrder)
 	b += 1
 
 	buf[b] = v.BitmapFormatScanlineUnit
@@ -5733,21 +7799,21 @@ func (v SetupInfo) Bytes() []byte {
 
 	b = (b + 3) & ^3 // alignment gap
 
-	b += FormatListBytes(buf[b:], v.PixmapFormats)
+	b += FormatListBytes(buf[b:], order, v.PixmapFormats)
 
 	b = (b + 3) & ^3 // alignment gap
 
-	b += ScreenInfoListBytes(buf[b:], v.Roots)
+	b += ScreenInfoListBytes(buf[b:], order, v.Roots)
 
 	return buf[:b]
 }
 
 // SetupInfoListBytes writes a list of SetupInfo values to a byte slice.
-func SetupInfoListBytes(buf []byte, list []SetupInfo) int {
+func SetupInfoListBytes(buf []byte, order xgb.ByteOrder, list []SetupInfo) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -5776,7 +7842,7 @@ type SetupRequest struct {
 }
 
 // SetupRequestRead reads a byte slice into a SetupRequest value.
-func SetupRequestRead(buf []byte, v *SetupRequest) int {
+func SetupRequestRead(buf []byte, order xgb.ByteOrder, v *SetupRequest) int {
 	b := 0
 
 	v.ByteOrder = buf[b]
@@ -5784,16 +7850,16 @@ func SetupRequestRead(buf []byte, v *SetupRequest) int {
 
 	b += 1 // padding
 
-	v.ProtocolMajorVersion = xgb.Get16(buf[b:])
+	v.ProtocolMajorVersion = order.Get16(buf[b:])
 	b += 2
 
-	v.ProtocolMinorVersion = xgb.Get16(buf[b:])
+	v.ProtocolMinorVersion = order.Get16(buf[b:])
 	b += 2
 
-	v.AuthorizationProtocolNameLen = xgb.Get16(buf[b:])
+	v.AuthorizationProtocolNameLen = order.Get16(buf[b:])
 	b += 2
 
-	v.AuthorizationProtocolDataLen = xgb.Get16(buf[b:])
+	v.AuthorizationProtocolDataLen = order.Get16(buf[b:])
 	b += 2
 
 	b += 2 // padding
@@ -5816,17 +7882,17 @@ func SetupRequestRead(buf []byte, v *SetupRequest) int {
 }
 
 // SetupRequestReadList reads a byte slice into a list of SetupRequest values.
-func SetupRequestReadList(buf []byte, dest []SetupRequest) int {
+func SetupRequestReadList(buf []byte, order xgb.ByteOrder, dest []SetupRequest) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = SetupRequest{}
-		b += SetupRequestRead(buf[b:], &dest[i])
+		b += SetupRequestRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a SetupRequest value to a byte slice.
-func (v SetupRequest) Bytes() []byte {
+// Bytes writes a SetupRequest value to a byte slice, in the byte order 'order'.
+func (v SetupRequest) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, ((12 + xgb.Pad((int(v.AuthorizationProtocolNameLen) * 1))) + xgb.Pad((int(v.AuthorizationProtocolDataLen) * 1))))
 	b := 0
 
@@ -5835,16 +7901,16 @@ func (v SetupRequest) Bytes() []byte {
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], v.ProtocolMajorVersion)
+	order.Put16(buf[b:], v.ProtocolMajorVersion)
 	b += 2
 
-	xgb.Put16(buf[b:], v.ProtocolMinorVersion)
+	order.Put16(buf[b:], v.ProtocolMinorVersion)
 	b += 2
 
-	xgb.Put16(buf[b:], v.AuthorizationProtocolNameLen)
+	order.Put16(buf[b:], v.AuthorizationProtocolNameLen)
 	b += 2
 
-	xgb.Put16(buf[b:], v.AuthorizationProtocolDataLen)
+	order.Put16(buf[b:], v.AuthorizationProtocolDataLen)
 	b += 2
 
 	b += 2 // padding
@@ -5859,11 +7925,11 @@ func (v SetupRequest) Bytes() []byte {
 }
 
 // SetupRequestListBytes writes a list of SetupRequest values to a byte slice.
-func SetupRequestListBytes(buf []byte, list []SetupRequest) int {
+func SetupRequestListBytes(buf []byte, order xgb.ByteOrder, list []SetupRequest) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -5879,21 +7945,40 @@ func SetupRequestListSize(list []SetupRequest) int {
 	return size
 }
 
+type StackMode uint32
+
 const (
-	StackModeAbove    = 0
-	StackModeBelow    = 1
-	StackModeTopIf    = 2
-	StackModeBottomIf = 3
-	StackModeOpposite = 4
+	StackModeAbove    StackMode = 0
+	StackModeBelow    StackMode = 1
+	StackModeTopIf    StackMode = 2
+	StackModeBottomIf StackMode = 3
+	StackModeOpposite StackMode = 4
 )
 
+// String returns the name of the StackMode item with this value.
+func (v StackMode) String() string {
+	switch v {
+	case StackModeAbove:
+		return "Above"
+	case StackModeBelow:
+		return "Below"
+	case StackModeTopIf:
+		return "TopIf"
+	case StackModeBottomIf:
+		return "BottomIf"
+	case StackModeOpposite:
+		return "Opposite"
+	}
+	return xgb.Sprintf("StackMode(%d)", uint32(v))
+}
+
 type Str struct {
 	NameLen byte
 	Name    string // size: xgb.Pad((int(NameLen) * 1))
 }
 
 // StrRead reads a byte slice into a Str value.
-func StrRead(buf []byte, v *Str) int {
+func StrRead(buf []byte, order xgb.ByteOrder, v *Str) int {
 	b := 0
 
 	v.NameLen = buf[b]
@@ -5910,17 +7995,17 @@ func StrRead(buf []byte, v *Str) int {
 }
 
 // StrReadList reads a byte slice into a list of Str values.
-func StrReadList(buf []byte, dest []Str) int {
+func StrReadList(buf []byte, order xgb.ByteOrder, dest []Str) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Str{}
-		b += StrRead(buf[b:], &dest[i])
+		b += StrRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Str value to a byte slice.
-func (v Str) Bytes() []byte {
+// Bytes writes a Str value to a byte slice, in the byte order 'order'.
+func (v Str) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, (1 + xgb.Pad((int(v.NameLen) * 1))))
 	b := 0
 
@@ -5934,11 +8019,11 @@ func (v Str) Bytes() []byte {
 }
 
 // StrListBytes writes a list of Str values to a byte slice.
-func StrListBytes(buf []byte, list []Str) int {
+func StrListBytes(buf []byte, order xgb.ByteOrder, list []Str) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -5954,11 +8039,24 @@ func StrListSize(list []Str) int {
 	return size
 }
 
+type SubwindowMode uint32
+
 const (
-	SubwindowModeClipByChildren   = 0
-	SubwindowModeIncludeInferiors = 1
+	SubwindowModeClipByChildren   SubwindowMode = 0
+	SubwindowModeIncludeInferiors SubwindowMode = 1
 )
 
+// String returns the name of the SubwindowMode item with this value.
+func (v SubwindowMode) String() string {
+	switch v {
+	case SubwindowModeClipByChildren:
+		return "ClipByChildren"
+	case SubwindowModeIncludeInferiors:
+		return "IncludeInferiors"
+	}
+	return xgb.Sprintf("SubwindowMode(%d)", uint32(v))
+}
+
 const (
 	TimeCurrentTime = 0
 )
@@ -5970,54 +8068,54 @@ type Timecoord struct {
 }
 
 // TimecoordRead reads a byte slice into a Timecoord value.
-func TimecoordRead(buf []byte, v *Timecoord) int {
+func TimecoordRead(buf []byte, order xgb.ByteOrder, v *Timecoord) int {
 	b := 0
 
-	v.Time = Timestamp(xgb.Get32(buf[b:]))
+	v.Time = Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.X = int16(xgb.Get16(buf[b:]))
+	v.X = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Y = int16(xgb.Get16(buf[b:]))
+	v.Y = int16(order.Get16(buf[b:]))
 	b += 2
 
 	return b
 }
 
 // TimecoordReadList reads a byte slice into a list of Timecoord values.
-func TimecoordReadList(buf []byte, dest []Timecoord) int {
+func TimecoordReadList(buf []byte, order xgb.ByteOrder, dest []Timecoord) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Timecoord{}
-		b += TimecoordRead(buf[b:], &dest[i])
+		b += TimecoordRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Timecoord value to a byte slice.
-func (v Timecoord) Bytes() []byte {
+// Bytes writes a Timecoord value to a byte slice, in the byte order 'order'.
+func (v Timecoord) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 8)
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.Time))
+	order.Put32(buf[b:], uint32(v.Time))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(v.X))
+	order.Put16(buf[b:], uint16(v.X))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(v.Y))
+	order.Put16(buf[b:], uint16(v.Y))
 	b += 2
 
 	return buf[:b]
 }
 
 // TimecoordListBytes writes a list of Timecoord values to a byte slice.
-func TimecoordListBytes(buf []byte, list []Timecoord) int {
+func TimecoordListBytes(buf []byte, order xgb.ByteOrder, list []Timecoord) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -6029,29 +8127,42 @@ type Timestamp uint32
 // UnmapNotify is the event number for a UnmapNotifyEvent.
 const UnmapNotify = 18
 
+// UnmapNotifyEvent is the type of UnmapNotify events.
+//
+// A window is unmapped.
+//
+// See also UnmapWindow.
 type UnmapNotifyEvent struct {
 	Sequence uint16
 	// padding: 1 bytes
-	Event         Window
-	Window        Window
+
+	// The reconfigured window or its parent, depending on whether
+	// `StructureNotify` or `SubstructureNotify` was selected.
+	Event Window
+
+	// The window that was unmapped.
+	Window Window
+
+	// Set to 1 if the event was generated as a result of a resizing of the
+	// window's parent when `window` had a win_gravity of `UnmapGravity`.
 	FromConfigure bool
 	// padding: 3 bytes
 }
 
-// UnmapNotifyEventNew constructs a UnmapNotifyEvent value that implements xgb.Event from a byte slice.
-func UnmapNotifyEventNew(buf []byte) xgb.Event {
+// UnmapNotifyEventNew constructs a UnmapNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func UnmapNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := UnmapNotifyEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Event = Window(xgb.Get32(buf[b:]))
+	v.Event = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Window = Window(xgb.Get32(buf[b:]))
+	v.Window = Window(order.Get32(buf[b:]))
 	b += 4
 
 	if buf[b] == 1 {
@@ -6066,8 +8177,8 @@ func UnmapNotifyEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a UnmapNotifyEvent value to a byte slice.
-func (v UnmapNotifyEvent) Bytes() []byte {
+// Bytes writes a UnmapNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v UnmapNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -6079,10 +8190,10 @@ func (v UnmapNotifyEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Event))
+	order.Put32(buf[b:], uint32(v.Event))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
 	if v.FromConfigure {
@@ -6114,6 +8225,12 @@ func (v UnmapNotifyEvent) String() string {
 	return "UnmapNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the UnmapNotify event is about.
+// It is used to subscribe to the events of a single window.
+func (v UnmapNotifyEvent) EventWindow() uint32 {
+	return uint32(v.Event)
+}
+
 func init() {
 	xgb.NewEventFuncs[18] = UnmapNotifyEventNew
 }
@@ -6130,21 +8247,21 @@ type ValueError struct {
 	// padding: 1 bytes
 }
 
-// ValueErrorNew constructs a ValueError value that implements xgb.Error from a byte slice.
-func ValueErrorNew(buf []byte) xgb.Error {
+// ValueErrorNew constructs a ValueError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func ValueErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := ValueError{}
 	v.NiceName = "Value"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.BadValue = xgb.Get32(buf[b:])
+	v.BadValue = order.Get32(buf[b:])
 	b += 4
 
-	v.MinorOpcode = xgb.Get16(buf[b:])
+	v.MinorOpcode = order.Get16(buf[b:])
 	b += 2
 
 	v.MajorOpcode = buf[b]
@@ -6182,12 +8299,27 @@ func init() {
 	xgb.NewErrorFuncs[2] = ValueErrorNew
 }
 
+type Visibility byte
+
 const (
-	VisibilityUnobscured        = 0
-	VisibilityPartiallyObscured = 1
-	VisibilityFullyObscured     = 2
+	VisibilityUnobscured        Visibility = 0
+	VisibilityPartiallyObscured Visibility = 1
+	VisibilityFullyObscured     Visibility = 2
 )
 
+// String returns the name of the Visibility item with this value.
+func (v Visibility) String() string {
+	switch v {
+	case VisibilityUnobscured:
+		return "Unobscured"
+	case VisibilityPartiallyObscured:
+		return "PartiallyObscured"
+	case VisibilityFullyObscured:
+		return "FullyObscured"
+	}
+	return xgb.Sprintf("Visibility(%d)", byte(v))
+}
+
 // VisibilityNotify is the event number for a VisibilityNotifyEvent.
 const VisibilityNotify = 15
 
@@ -6195,24 +8327,24 @@ type VisibilityNotifyEvent struct {
 	Sequence uint16
 	// padding: 1 bytes
 	Window Window
-	State  byte
+	State  Visibility
 	// padding: 3 bytes
 }
 
-// VisibilityNotifyEventNew constructs a VisibilityNotifyEvent value that implements xgb.Event from a byte slice.
-func VisibilityNotifyEventNew(buf []byte) xgb.Event {
+// VisibilityNotifyEventNew constructs a VisibilityNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func VisibilityNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := VisibilityNotifyEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Window = Window(xgb.Get32(buf[b:]))
+	v.Window = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.State = buf[b]
+	v.State = Visibility(buf[b])
 	b += 1
 
 	b += 3 // padding
@@ -6220,8 +8352,8 @@ func VisibilityNotifyEventNew(buf []byte) xgb.Event {
 	return v
 }
 
-// Bytes writes a VisibilityNotifyEvent value to a byte slice.
-func (v VisibilityNotifyEvent) Bytes() []byte {
+// Bytes writes a VisibilityNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v VisibilityNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -6233,10 +8365,10 @@ func (v VisibilityNotifyEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Window))
+	order.Put32(buf[b:], uint32(v.Window))
 	b += 4
 
-	buf[b] = v.State
+	buf[b] = byte(v.State)
 	b += 1
 
 	b += 3 // padding
@@ -6256,26 +8388,53 @@ func (v VisibilityNotifyEvent) String() string {
 	fieldVals := make([]string, 0, 4)
 	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
 	fieldVals = append(fieldVals, xgb.Sprintf("Window: %d", v.Window))
-	fieldVals = append(fieldVals, xgb.Sprintf("State: %d", v.State))
+	fieldVals = append(fieldVals, xgb.Sprintf("State: %s", v.State))
 	return "VisibilityNotify {" + xgb.StringsJoin(fieldVals, ", ") + "}"
 }
 
+// EventWindow returns the window that the VisibilityNotify event is about.
+// It is used to subscribe to the events of a single window.
+func (v VisibilityNotifyEvent) EventWindow() uint32 {
+	return uint32(v.Window)
+}
+
 func init() {
 	xgb.NewEventFuncs[15] = VisibilityNotifyEventNew
 }
 
+type VisualClass byte
+
 const (
-	VisualClassStaticGray  = 0
-	VisualClassGrayScale   = 1
-	VisualClassStaticColor = 2
-	VisualClassPseudoColor = 3
-	VisualClassTrueColor   = 4
-	VisualClassDirectColor = 5
+	VisualClassStaticGray  VisualClass = 0
+	VisualClassGrayScale   VisualClass = 1
+	VisualClassStaticColor VisualClass = 2
+	VisualClassPseudoColor VisualClass = 3
+	VisualClassTrueColor   VisualClass = 4
+	VisualClassDirectColor VisualClass = 5
 )
 
+// String returns the name of the VisualClass item with this value.
+func (v VisualClass) String() string {
+	switch v {
+	case VisualClassStaticGray:
+		return "StaticGray"
+	case VisualClassGrayScale:
+		return "GrayScale"
+	case VisualClassStaticColor:
+		return "StaticColor"
+	case VisualClassPseudoColor:
+		return "PseudoColor"
+	case VisualClassTrueColor:
+		return "TrueColor"
+	case VisualClassDirectColor:
+		return "DirectColor"
+	}
+	return xgb.Sprintf("VisualClass(%d)", byte(v))
+}
+
 type VisualInfo struct {
 	VisualId        Visualid
-	Class           byte
+	Class           VisualClass
 	BitsPerRgbValue byte
 	ColormapEntries uint16
 	RedMask         uint32
@@ -6285,28 +8444,28 @@ type VisualInfo struct {
 }
 
 // VisualInfoRead reads a byte slice into a VisualInfo value.
-func VisualInfoRead(buf []byte, v *VisualInfo) int {
+func VisualInfoRead(buf []byte, order xgb.ByteOrder, v *VisualInfo) int {
 	b := 0
 
-	v.VisualId = Visualid(xgb.Get32(buf[b:]))
+	v.VisualId = Visualid(order.Get32(buf[b:]))
 	b += 4
 
-	v.Class = buf[b]
+	v.Class = VisualClass(buf[b])
 	b += 1
 
 	v.BitsPerRgbValue = buf[b]
 	b += 1
 
-	v.ColormapEntries = xgb.Get16(buf[b:])
+	v.ColormapEntries = order.Get16(buf[b:])
 	b += 2
 
-	v.RedMask = xgb.Get32(buf[b:])
+	v.RedMask = order.Get32(buf[b:])
 	b += 4
 
-	v.GreenMask = xgb.Get32(buf[b:])
+	v.GreenMask = order.Get32(buf[b:])
 	b += 4
 
-	v.BlueMask = xgb.Get32(buf[b:])
+	v.BlueMask = order.Get32(buf[b:])
 	b += 4
 
 	b += 4 // padding
@@ -6315,39 +8474,39 @@ func VisualInfoRead(buf []byte, v *VisualInfo) int {
 }
 
 // VisualInfoReadList reads a byte slice into a list of VisualInfo values.
-func VisualInfoReadList(buf []byte, dest []VisualInfo) int {
+func VisualInfoReadList(buf []byte, order xgb.ByteOrder, dest []VisualInfo) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = VisualInfo{}
-		b += VisualInfoRead(buf[b:], &dest[i])
+		b += VisualInfoRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a VisualInfo value to a byte slice.
-func (v VisualInfo) Bytes() []byte {
+// Bytes writes a VisualInfo value to a byte slice, in the byte order 'order'.
+func (v VisualInfo) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 24)
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.VisualId))
+	order.Put32(buf[b:], uint32(v.VisualId))
 	b += 4
 
-	buf[b] = v.Class
+	buf[b] = byte(v.Class)
 	b += 1
 
 	buf[b] = v.BitsPerRgbValue
 	b += 1
 
-	xgb.Put16(buf[b:], v.ColormapEntries)
+	order.Put16(buf[b:], v.ColormapEntries)
 	b += 2
 
-	xgb.Put32(buf[b:], v.RedMask)
+	order.Put32(buf[b:], v.RedMask)
 	b += 4
 
-	xgb.Put32(buf[b:], v.GreenMask)
+	order.Put32(buf[b:], v.GreenMask)
 	b += 4
 
-	xgb.Put32(buf[b:], v.BlueMask)
+	order.Put32(buf[b:], v.BlueMask)
 	b += 4
 
 	b += 4 // padding
@@ -6356,11 +8515,11 @@ func (v VisualInfo) Bytes() []byte {
 }
 
 // VisualInfoListBytes writes a list of VisualInfo values to a byte slice.
-func VisualInfoListBytes(buf []byte, list []VisualInfo) int {
+func VisualInfoListBytes(buf []byte, order xgb.ByteOrder, list []VisualInfo) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -6384,9 +8543,9 @@ const BadWindow = 3
 
 type WindowError ValueError
 
-// WindowErrorNew constructs a WindowError value that implements xgb.Error from a byte slice.
-func WindowErrorNew(buf []byte) xgb.Error {
-	v := WindowError(ValueErrorNew(buf).(ValueError))
+// WindowErrorNew constructs a WindowError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func WindowErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
+	v := WindowError(ValueErrorNew(buf, order).(ValueError))
 	v.NiceName = "Window"
 	return v
 }
@@ -6421,12 +8580,27 @@ const (
 	WindowNone = 0
 )
 
+type WindowClass uint16
+
 const (
-	WindowClassCopyFromParent = 0
-	WindowClassInputOutput    = 1
-	WindowClassInputOnly      = 2
+	WindowClassCopyFromParent WindowClass = 0
+	WindowClassInputOutput    WindowClass = 1
+	WindowClassInputOnly      WindowClass = 2
 )
 
+// String returns the name of the WindowClass item with this value.
+func (v WindowClass) String() string {
+	switch v {
+	case WindowClassCopyFromParent:
+		return "CopyFromParent"
+	case WindowClassInputOutput:
+		return "InputOutput"
+	case WindowClassInputOnly:
+		return "InputOnly"
+	}
+	return xgb.Sprintf("WindowClass(%d)", uint16(v))
+}
+
 // Skipping definition for base type 'Bool'
 
 // Skipping definition for base type 'Byte'
@@ -6451,6 +8625,8 @@ const (
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // AllocColorCookie is a cookie used only for AllocColor requests.
 type AllocColorCookie struct {
 	*xgb.Cookie
@@ -6493,34 +8669,46 @@ func (cook AllocColorCookie) Reply() (*AllocColorReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return allocColorReply(buf), nil
+	return allocColorReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a AllocColor request arrives.
+func (cook AllocColorCookie) ReplyContext(ctx context.Context) (*AllocColorReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return allocColorReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// allocColorReply reads a byte slice into a AllocColorReply value.
-func allocColorReply(buf []byte) *AllocColorReply {
+// allocColorReply reads a byte slice in the byte order 'order' into a AllocColorReply value.
+func allocColorReply(buf []byte, order xgb.ByteOrder) *AllocColorReply {
 	v := new(AllocColorReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Red = xgb.Get16(buf[b:])
+	v.Red = order.Get16(buf[b:])
 	b += 2
 
-	v.Green = xgb.Get16(buf[b:])
+	v.Green = order.Get16(buf[b:])
 	b += 2
 
-	v.Blue = xgb.Get16(buf[b:])
+	v.Blue = order.Get16(buf[b:])
 	b += 2
 
 	b += 2 // padding
 
-	v.Pixel = xgb.Get32(buf[b:])
+	v.Pixel = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -6529,28 +8717,37 @@ func allocColorReply(buf []byte) *AllocColorReply {
 // Write request to wire for AllocColor
 // allocColorRequest writes a AllocColor request to a byte slice.
 func allocColorRequest(c *xgb.Conn, Cmap Colormap, Red uint16, Green uint16, Blue uint16) []byte {
+	return AppendAllocColorRequest(nil, c, Cmap, Red, Green, Blue)
+}
+
+// AppendAllocColorRequest appends a AllocColor request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendAllocColorRequest(buf []byte, c *xgb.Conn, Cmap Colormap, Red uint16, Green uint16, Blue uint16) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 84 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Cmap))
+	order.Put32(buf[b:], uint32(Cmap))
 	b += 4
 
-	xgb.Put16(buf[b:], Red)
+	order.Put16(buf[b:], Red)
 	b += 2
 
-	xgb.Put16(buf[b:], Green)
+	order.Put16(buf[b:], Green)
 	b += 2
 
-	xgb.Put16(buf[b:], Blue)
+	order.Put16(buf[b:], Blue)
 	b += 2
 
 	b += 2 // padding
@@ -6558,6 +8755,54 @@ func allocColorRequest(c *xgb.Conn, Cmap Colormap, Red uint16, Green uint16, Blu
 	return buf
 }
 
+// AllocColorRequest represents the data of a AllocColor request, as it is read when tracing a connection.
+type AllocColorRequest struct {
+	// padding: 1 bytes
+	Cmap  Colormap
+	Red   uint16
+	Green uint16
+	Blue  uint16
+	// padding: 2 bytes
+}
+
+// allocColorRequestRead reads a byte slice in the byte order 'order' into a AllocColorRequest value.
+func allocColorRequestRead(buf []byte, order xgb.ByteOrder) *AllocColorRequest {
+	v := new(AllocColorRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Cmap = Colormap(order.Get32(buf[b:]))
+	b += 4
+
+	v.Red = order.Get16(buf[b:])
+	b += 2
+
+	v.Green = order.Get16(buf[b:])
+	b += 2
+
+	v.Blue = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[84] = xgb.RequestInfo{
+		Name: "AllocColor",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return allocColorRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return allocColorReply(buf, order)
+		},
+	}
+}
+
 // AllocColorCellsCookie is a cookie used only for AllocColorCells requests.
 type AllocColorCellsCookie struct {
 	*xgb.Cookie
@@ -6601,33 +8846,45 @@ func (cook AllocColorCellsCookie) Reply() (*AllocColorCellsReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return allocColorCellsReply(buf), nil
+	return allocColorCellsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// allocColorCellsReply reads a byte slice into a AllocColorCellsReply value.
-func allocColorCellsReply(buf []byte) *AllocColorCellsReply {
-	v := new(AllocColorCellsReply)
-	b := 1 // skip reply determinant
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a AllocColorCells request arrives.
+func (cook AllocColorCellsCookie) ReplyContext(ctx context.Context) (*AllocColorCellsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return allocColorCellsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// allocColorCellsReply reads a byte slice in the byte order 'order' into a AllocColorCellsReply value.
+func allocColorCellsReply(buf []byte, order xgb.ByteOrder) *AllocColorCellsReply {
+	v := new(AllocColorCellsReply)
+	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.PixelsLen = xgb.Get16(buf[b:])
+	v.PixelsLen = order.Get16(buf[b:])
 	b += 2
 
-	v.MasksLen = xgb.Get16(buf[b:])
+	v.MasksLen = order.Get16(buf[b:])
 	b += 2
 
 	b += 20 // padding
 
 	v.Pixels = make([]uint32, v.PixelsLen)
 	for i := 0; i < int(v.PixelsLen); i++ {
-		v.Pixels[i] = xgb.Get32(buf[b:])
+		v.Pixels[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -6635,7 +8892,7 @@ func allocColorCellsReply(buf []byte) *AllocColorCellsReply {
 
 	v.Masks = make([]uint32, v.MasksLen)
 	for i := 0; i < int(v.MasksLen); i++ {
-		v.Masks[i] = xgb.Get32(buf[b:])
+		v.Masks[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -6645,9 +8902,18 @@ func allocColorCellsReply(buf []byte) *AllocColorCellsReply {
 // Write request to wire for AllocColorCells
 // allocColorCellsRequest writes a AllocColorCells request to a byte slice.
 func allocColorCellsRequest(c *xgb.Conn, Contiguous bool, Cmap Colormap, Colors uint16, Planes uint16) []byte {
+	return AppendAllocColorCellsRequest(nil, c, Contiguous, Cmap, Colors, Planes)
+}
+
+// AppendAllocColorCellsRequest appends a AllocColorCells request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendAllocColorCellsRequest(buf []byte, c *xgb.Conn, Contiguous bool, Cmap Colormap, Colors uint16, Planes uint16) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 86 // request opcode
 	b += 1
@@ -6659,21 +8925,67 @@ func allocColorCellsRequest(c *xgb.Conn, Contiguous bool, Cmap Colormap, Colors
 	}
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Cmap))
+	order.Put32(buf[b:], uint32(Cmap))
 	b += 4
 
-	xgb.Put16(buf[b:], Colors)
+	order.Put16(buf[b:], Colors)
 	b += 2
 
-	xgb.Put16(buf[b:], Planes)
+	order.Put16(buf[b:], Planes)
 	b += 2
 
 	return buf
 }
 
+// AllocColorCellsRequest represents the data of a AllocColorCells request, as it is read when tracing a connection.
+type AllocColorCellsRequest struct {
+	Contiguous bool
+	Cmap       Colormap
+	Colors     uint16
+	Planes     uint16
+}
+
+// allocColorCellsRequestRead reads a byte slice in the byte order 'order' into a AllocColorCellsRequest value.
+func allocColorCellsRequestRead(buf []byte, order xgb.ByteOrder) *AllocColorCellsRequest {
+	v := new(AllocColorCellsRequest)
+	b := 1 // skip request opcode
+
+	if buf[b] == 1 {
+		v.Contiguous = true
+	} else {
+		v.Contiguous = false
+	}
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Cmap = Colormap(order.Get32(buf[b:]))
+	b += 4
+
+	v.Colors = order.Get16(buf[b:])
+	b += 2
+
+	v.Planes = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[86] = xgb.RequestInfo{
+		Name: "AllocColorCells",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return allocColorCellsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return allocColorCellsReply(buf, order)
+		},
+	}
+}
+
 // AllocColorPlanesCookie is a cookie used only for AllocColorPlanes requests.
 type AllocColorPlanesCookie struct {
 	*xgb.Cookie
@@ -6718,41 +9030,53 @@ func (cook AllocColorPlanesCookie) Reply() (*AllocColorPlanesReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return allocColorPlanesReply(buf), nil
+	return allocColorPlanesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a AllocColorPlanes request arrives.
+func (cook AllocColorPlanesCookie) ReplyContext(ctx context.Context) (*AllocColorPlanesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return allocColorPlanesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// allocColorPlanesReply reads a byte slice into a AllocColorPlanesReply value.
-func allocColorPlanesReply(buf []byte) *AllocColorPlanesReply {
+// allocColorPlanesReply reads a byte slice in the byte order 'order' into a AllocColorPlanesReply value.
+func allocColorPlanesReply(buf []byte, order xgb.ByteOrder) *AllocColorPlanesReply {
 	v := new(AllocColorPlanesReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.PixelsLen = xgb.Get16(buf[b:])
+	v.PixelsLen = order.Get16(buf[b:])
 	b += 2
 
 	b += 2 // padding
 
-	v.RedMask = xgb.Get32(buf[b:])
+	v.RedMask = order.Get32(buf[b:])
 	b += 4
 
-	v.GreenMask = xgb.Get32(buf[b:])
+	v.GreenMask = order.Get32(buf[b:])
 	b += 4
 
-	v.BlueMask = xgb.Get32(buf[b:])
+	v.BlueMask = order.Get32(buf[b:])
 	b += 4
 
 	b += 8 // padding
 
 	v.Pixels = make([]uint32, v.PixelsLen)
 	for i := 0; i < int(v.PixelsLen); i++ {
-		v.Pixels[i] = xgb.Get32(buf[b:])
+		v.Pixels[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -6762,9 +9086,18 @@ func allocColorPlanesReply(buf []byte) *AllocColorPlanesReply {
 // Write request to wire for AllocColorPlanes
 // allocColorPlanesRequest writes a AllocColorPlanes request to a byte slice.
 func allocColorPlanesRequest(c *xgb.Conn, Contiguous bool, Cmap Colormap, Colors uint16, Reds uint16, Greens uint16, Blues uint16) []byte {
+	return AppendAllocColorPlanesRequest(nil, c, Contiguous, Cmap, Colors, Reds, Greens, Blues)
+}
+
+// AppendAllocColorPlanesRequest appends a AllocColorPlanes request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendAllocColorPlanesRequest(buf []byte, c *xgb.Conn, Contiguous bool, Cmap Colormap, Colors uint16, Reds uint16, Greens uint16, Blues uint16) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 87 // request opcode
 	b += 1
@@ -6776,27 +9109,81 @@ func allocColorPlanesRequest(c *xgb.Conn, Contiguous bool, Cmap Colormap, Colors
 	}
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Cmap))
+	order.Put32(buf[b:], uint32(Cmap))
 	b += 4
 
-	xgb.Put16(buf[b:], Colors)
+	order.Put16(buf[b:], Colors)
 	b += 2
 
-	xgb.Put16(buf[b:], Reds)
+	order.Put16(buf[b:], Reds)
 	b += 2
 
-	xgb.Put16(buf[b:], Greens)
+	order.Put16(buf[b:], Greens)
 	b += 2
 
-	xgb.Put16(buf[b:], Blues)
+	order.Put16(buf[b:], Blues)
 	b += 2
 
 	return buf
 }
 
+// AllocColorPlanesRequest represents the data of a AllocColorPlanes request, as it is read when tracing a connection.
+type AllocColorPlanesRequest struct {
+	Contiguous bool
+	Cmap       Colormap
+	Colors     uint16
+	Reds       uint16
+	Greens     uint16
+	Blues      uint16
+}
+
+// allocColorPlanesRequestRead reads a byte slice in the byte order 'order' into a AllocColorPlanesRequest value.
+func allocColorPlanesRequestRead(buf []byte, order xgb.ByteOrder) *AllocColorPlanesRequest {
+	v := new(AllocColorPlanesRequest)
+	b := 1 // skip request opcode
+
+	if buf[b] == 1 {
+		v.Contiguous = true
+	} else {
+		v.Contiguous = false
+	}
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Cmap = Colormap(order.Get32(buf[b:]))
+	b += 4
+
+	v.Colors = order.Get16(buf[b:])
+	b += 2
+
+	v.Reds = order.Get16(buf[b:])
+	b += 2
+
+	v.Greens = order.Get16(buf[b:])
+	b += 2
+
+	v.Blues = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[87] = xgb.RequestInfo{
+		Name: "AllocColorPlanes",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return allocColorPlanesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return allocColorPlanesReply(buf, order)
+		},
+	}
+}
+
 // AllocNamedColorCookie is a cookie used only for AllocNamedColor requests.
 type AllocNamedColorCookie struct {
 	*xgb.Cookie
@@ -6841,41 +9228,53 @@ func (cook AllocNamedColorCookie) Reply() (*AllocNamedColorReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return allocNamedColorReply(buf), nil
+	return allocNamedColorReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a AllocNamedColor request arrives.
+func (cook AllocNamedColorCookie) ReplyContext(ctx context.Context) (*AllocNamedColorReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return allocNamedColorReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// allocNamedColorReply reads a byte slice into a AllocNamedColorReply value.
-func allocNamedColorReply(buf []byte) *AllocNamedColorReply {
+// allocNamedColorReply reads a byte slice in the byte order 'order' into a AllocNamedColorReply value.
+func allocNamedColorReply(buf []byte, order xgb.ByteOrder) *AllocNamedColorReply {
 	v := new(AllocNamedColorReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Pixel = xgb.Get32(buf[b:])
+	v.Pixel = order.Get32(buf[b:])
 	b += 4
 
-	v.ExactRed = xgb.Get16(buf[b:])
+	v.ExactRed = order.Get16(buf[b:])
 	b += 2
 
-	v.ExactGreen = xgb.Get16(buf[b:])
+	v.ExactGreen = order.Get16(buf[b:])
 	b += 2
 
-	v.ExactBlue = xgb.Get16(buf[b:])
+	v.ExactBlue = order.Get16(buf[b:])
 	b += 2
 
-	v.VisualRed = xgb.Get16(buf[b:])
+	v.VisualRed = order.Get16(buf[b:])
 	b += 2
 
-	v.VisualGreen = xgb.Get16(buf[b:])
+	v.VisualGreen = order.Get16(buf[b:])
 	b += 2
 
-	v.VisualBlue = xgb.Get16(buf[b:])
+	v.VisualBlue = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -6884,22 +9283,31 @@ func allocNamedColorReply(buf []byte) *AllocNamedColorReply {
 // Write request to wire for AllocNamedColor
 // allocNamedColorRequest writes a AllocNamedColor request to a byte slice.
 func allocNamedColorRequest(c *xgb.Conn, Cmap Colormap, NameLen uint16, Name string) []byte {
+	return AppendAllocNamedColorRequest(nil, c, Cmap, NameLen, Name)
+}
+
+// AppendAllocNamedColorRequest appends a AllocNamedColor request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendAllocNamedColorRequest(buf []byte, c *xgb.Conn, Cmap Colormap, NameLen uint16, Name string) []byte {
 	size := xgb.Pad((12 + xgb.Pad((int(NameLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 85 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Cmap))
+	order.Put32(buf[b:], uint32(Cmap))
 	b += 4
 
-	xgb.Put16(buf[b:], NameLen)
+	order.Put16(buf[b:], NameLen)
 	b += 2
 
 	b += 2 // padding
@@ -6910,14 +9318,80 @@ func allocNamedColorRequest(c *xgb.Conn, Cmap Colormap, NameLen uint16, Name str
 	return buf
 }
 
+// AllocNamedColorRequest represents the data of a AllocNamedColor request, as it is read when tracing a connection.
+type AllocNamedColorRequest struct {
+	// padding: 1 bytes
+	Cmap    Colormap
+	NameLen uint16
+	// padding: 2 bytes
+	Name string // size: xgb.Pad((int(NameLen) * 1))
+}
+
+// allocNamedColorRequestRead reads a byte slice in the byte order 'order' into a AllocNamedColorRequest value.
+func allocNamedColorRequestRead(buf []byte, order xgb.ByteOrder) *AllocNamedColorRequest {
+	v := new(AllocNamedColorRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Cmap = Colormap(order.Get32(buf[b:]))
+	b += 4
+
+	v.NameLen = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	{
+		byteString := make([]byte, v.NameLen)
+		copy(byteString[:v.NameLen], buf[b:])
+		v.Name = string(byteString)
+		b += int(v.NameLen)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[85] = xgb.RequestInfo{
+		Name: "AllocNamedColor",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return allocNamedColorRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return allocNamedColorReply(buf, order)
+		},
+	}
+}
+
 // AllowEventsCookie is a cookie used only for AllowEvents requests.
 type AllowEventsCookie struct {
 	*xgb.Cookie
 }
 
+// AllowEvents release queued events.
+//
+// Releases queued events if the client has caused a device (pointer/keyboard)
+// to freeze due to grabbing it actively. This request has no effect if `time`
+// is earlier than the last-grab time of the most recent active grab for this
+// client or if `time` is later than the current X server time.
+//
+// Parameters:
+//
+//   - Mode: Which of the frozen devices to release, and how.
+//   - Time: Timestamp to avoid race conditions when running X over the
+//     network. The special value `TimeCurrentTime` will be replaced with the
+//     current server time.
+//
+// Errors:
+//
+//   - ValueError: You specified an invalid `mode`.
+//
 // AllowEvents sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func AllowEvents(c *xgb.Conn, Mode byte, Time Timestamp) AllowEventsCookie {
+func AllowEvents(c *xgb.Conn, Mode Allow, Time Timestamp) AllowEventsCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(allowEventsRequest(c, Mode, Time), cookie)
 	return AllowEventsCookie{cookie}
@@ -6925,7 +9399,7 @@ func AllowEvents(c *xgb.Conn, Mode byte, Time Timestamp) AllowEventsCookie {
 
 // AllowEventsChecked sends a checked request.
 // If an error occurs, it can be retrieved using AllowEventsCookie.Check()
-func AllowEventsChecked(c *xgb.Conn, Mode byte, Time Timestamp) AllowEventsCookie {
+func AllowEventsChecked(c *xgb.Conn, Mode Allow, Time Timestamp) AllowEventsCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(allowEventsRequest(c, Mode, Time), cookie)
 	return AllowEventsCookie{cookie}
@@ -6937,28 +9411,73 @@ func (cook AllowEventsCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook AllowEventsCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for AllowEvents
 // allowEventsRequest writes a AllowEvents request to a byte slice.
-func allowEventsRequest(c *xgb.Conn, Mode byte, Time Timestamp) []byte {
+func allowEventsRequest(c *xgb.Conn, Mode Allow, Time Timestamp) []byte {
+	return AppendAllowEventsRequest(nil, c, Mode, Time)
+}
+
+// AppendAllowEventsRequest appends a AllowEvents request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendAllowEventsRequest(buf []byte, c *xgb.Conn, Mode Allow, Time Timestamp) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 35 // request opcode
 	b += 1
 
-	buf[b] = Mode
+	buf[b] = byte(Mode)
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Time))
+	order.Put32(buf[b:], uint32(Time))
 	b += 4
 
 	return buf
 }
 
+// AllowEventsRequest represents the data of a AllowEvents request, as it is read when tracing a connection.
+type AllowEventsRequest struct {
+	Mode Allow
+	Time Timestamp
+}
+
+// allowEventsRequestRead reads a byte slice in the byte order 'order' into a AllowEventsRequest value.
+func allowEventsRequestRead(buf []byte, order xgb.ByteOrder) *AllowEventsRequest {
+	v := new(AllowEventsRequest)
+	b := 1 // skip request opcode
+
+	v.Mode = Allow(buf[b])
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Time = Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[35] = xgb.RequestInfo{
+		Name: "AllowEvents",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return allowEventsRequestRead(buf, order)
+		},
+	}
+}
+
 // BellCookie is a cookie used only for Bell requests.
 type BellCookie struct {
 	*xgb.Cookie
@@ -6986,12 +9505,26 @@ func (cook BellCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook BellCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for Bell
 // bellRequest writes a Bell request to a byte slice.
 func bellRequest(c *xgb.Conn, Percent int8) []byte {
+	return AppendBellRequest(nil, c, Percent)
+}
+
+// AppendBellRequest appends a Bell request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendBellRequest(buf []byte, c *xgb.Conn, Percent int8) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 104 // request opcode
 	b += 1
@@ -6999,12 +9532,39 @@ func bellRequest(c *xgb.Conn, Percent int8) []byte {
 	buf[b] = byte(Percent)
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// BellRequest represents the data of a Bell request, as it is read when tracing a connection.
+type BellRequest struct {
+	Percent int8
+}
+
+// bellRequestRead reads a byte slice in the byte order 'order' into a BellRequest value.
+func bellRequestRead(buf []byte, order xgb.ByteOrder) *BellRequest {
+	v := new(BellRequest)
+	b := 1 // skip request opcode
+
+	v.Percent = int8(buf[b])
+	b += 1
+
+	b += 2 // skip request size
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[104] = xgb.RequestInfo{
+		Name: "Bell",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return bellRequestRead(buf, order)
+		},
+	}
+}
+
 // ChangeActivePointerGrabCookie is a cookie used only for ChangeActivePointerGrab requests.
 type ChangeActivePointerGrabCookie struct {
 	*xgb.Cookie
@@ -7012,7 +9572,7 @@ type ChangeActivePointerGrabCookie struct {
 
 // ChangeActivePointerGrab sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func ChangeActivePointerGrab(c *xgb.Conn, Cursor Cursor, Time Timestamp, EventMask uint16) ChangeActivePointerGrabCookie {
+func ChangeActivePointerGrab(c *xgb.Conn, Cursor Cursor, Time Timestamp, EventMask EventMask) ChangeActivePointerGrabCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(changeActivePointerGrabRequest(c, Cursor, Time, EventMask), cookie)
 	return ChangeActivePointerGrabCookie{cookie}
@@ -7020,7 +9580,7 @@ func ChangeActivePointerGrab(c *xgb.Conn, Cursor Cursor, Time Timestamp, EventMa
 
 // ChangeActivePointerGrabChecked sends a checked request.
 // If an error occurs, it can be retrieved using ChangeActivePointerGrabCookie.Check()
-func ChangeActivePointerGrabChecked(c *xgb.Conn, Cursor Cursor, Time Timestamp, EventMask uint16) ChangeActivePointerGrabCookie {
+func ChangeActivePointerGrabChecked(c *xgb.Conn, Cursor Cursor, Time Timestamp, EventMask EventMask) ChangeActivePointerGrabCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(changeActivePointerGrabRequest(c, Cursor, Time, EventMask), cookie)
 	return ChangeActivePointerGrabCookie{cookie}
@@ -7032,28 +9592,42 @@ func (cook ChangeActivePointerGrabCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ChangeActivePointerGrabCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ChangeActivePointerGrab
 // changeActivePointerGrabRequest writes a ChangeActivePointerGrab request to a byte slice.
-func changeActivePointerGrabRequest(c *xgb.Conn, Cursor Cursor, Time Timestamp, EventMask uint16) []byte {
+func changeActivePointerGrabRequest(c *xgb.Conn, Cursor Cursor, Time Timestamp, EventMask EventMask) []byte {
+	return AppendChangeActivePointerGrabRequest(nil, c, Cursor, Time, EventMask)
+}
+
+// AppendChangeActivePointerGrabRequest appends a ChangeActivePointerGrab request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendChangeActivePointerGrabRequest(buf []byte, c *xgb.Conn, Cursor Cursor, Time Timestamp, EventMask EventMask) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 30 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Cursor))
+	order.Put32(buf[b:], uint32(Cursor))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Time))
+	order.Put32(buf[b:], uint32(Time))
 	b += 4
 
-	xgb.Put16(buf[b:], EventMask)
+	order.Put16(buf[b:], uint16(EventMask))
 	b += 2
 
 	b += 2 // padding
@@ -7061,14 +9635,76 @@ func changeActivePointerGrabRequest(c *xgb.Conn, Cursor Cursor, Time Timestamp,
 	return buf
 }
 
+// ChangeActivePointerGrabRequest represents the data of a ChangeActivePointerGrab request, as it is read when tracing a connection.
+type ChangeActivePointerGrabRequest struct {
+	// padding: 1 bytes
+	Cursor    Cursor
+	Time      Timestamp
+	EventMask EventMask
+	// padding: 2 bytes
+}
+
+// changeActivePointerGrabRequestRead reads a byte slice in the byte order 'order' into a ChangeActivePointerGrabRequest value.
+func changeActivePointerGrabRequestRead(buf []byte, order xgb.ByteOrder) *ChangeActivePointerGrabRequest {
+	v := new(ChangeActivePointerGrabRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Cursor = Cursor(order.Get32(buf[b:]))
+	b += 4
+
+	v.Time = Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	v.EventMask = EventMask(order.Get16(buf[b:]))
+	b += 2
+
+	b += 2 // padding
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[30] = xgb.RequestInfo{
+		Name: "ChangeActivePointerGrab",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return changeActivePointerGrabRequestRead(buf, order)
+		},
+	}
+}
+
 // ChangeGCCookie is a cookie used only for ChangeGC requests.
 type ChangeGCCookie struct {
 	*xgb.Cookie
 }
 
+// ChangeGC change graphics context components.
+//
+// Changes the components specified by `value_mask` for the specified graphics
+// context.
+//
+// Parameters:
+//
+//   - Gc: The graphics context to change.
+//   - ValueMask: The mask of the components that are given in `value_list`,
+//     and whose values are in the order of the bits of the mask.
+//
+// Errors:
+//
+//   - FontError: TODO: reasons?
+//   - GContextError: TODO: reasons?
+//   - MatchError: TODO: reasons?
+//   - PixmapError: TODO: reasons?
+//   - ValueError: TODO: reasons?
+//   - AllocError: The X server could not allocate the requested resources (no
+//     memory?).
+//
 // ChangeGC sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func ChangeGC(c *xgb.Conn, Gc Gcontext, ValueMask uint32, ValueList []uint32) ChangeGCCookie {
+func ChangeGC(c *xgb.Conn, Gc Gcontext, ValueMask Gc, ValueList []uint32) ChangeGCCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(changeGCRequest(c, Gc, ValueMask, ValueList), cookie)
 	return ChangeGCCookie{cookie}
@@ -7076,7 +9712,7 @@ func ChangeGC(c *xgb.Conn, Gc Gcontext, ValueMask uint32, ValueList []uint32) Ch
 
 // ChangeGCChecked sends a checked request.
 // If an error occurs, it can be retrieved using ChangeGCCookie.Check()
-func ChangeGCChecked(c *xgb.Conn, Gc Gcontext, ValueMask uint32, ValueList []uint32) ChangeGCCookie {
+func ChangeGCChecked(c *xgb.Conn, Gc Gcontext, ValueMask Gc, ValueList []uint32) ChangeGCCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(changeGCRequest(c, Gc, ValueMask, ValueList), cookie)
 	return ChangeGCCookie{cookie}
@@ -7088,28 +9724,42 @@ func (cook ChangeGCCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ChangeGCCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ChangeGC
 // changeGCRequest writes a ChangeGC request to a byte slice.
-func changeGCRequest(c *xgb.Conn, Gc Gcontext, ValueMask uint32, ValueList []uint32) []byte {
+func changeGCRequest(c *xgb.Conn, Gc Gcontext, ValueMask Gc, ValueList []uint32) []byte {
+	return AppendChangeGCRequest(nil, c, Gc, ValueMask, ValueList)
+}
+
+// AppendChangeGCRequest appends a ChangeGC request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendChangeGCRequest(buf []byte, c *xgb.Conn, Gc Gcontext, ValueMask Gc, ValueList []uint32) []byte {
 	size := xgb.Pad((8 + (4 + xgb.Pad((4 * xgb.PopCount(int(ValueMask)))))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 56 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	xgb.Put32(buf[b:], ValueMask)
+	order.Put32(buf[b:], uint32(ValueMask))
 	b += 4
 	for i := 0; i < xgb.PopCount(int(ValueMask)); i++ {
-		xgb.Put32(buf[b:], ValueList[i])
+		order.Put32(buf[b:], ValueList[i])
 		b += 4
 	}
 	b = xgb.Pad(b)
@@ -7117,6 +9767,48 @@ func changeGCRequest(c *xgb.Conn, Gc Gcontext, ValueMask uint32, ValueList []uin
 	return buf
 }
 
+// ChangeGCRequest represents the data of a ChangeGC request, as it is read when tracing a connection.
+type ChangeGCRequest struct {
+	// padding: 1 bytes
+	Gc        Gcontext
+	ValueMask Gc
+	ValueList []uint32
+}
+
+// changeGCRequestRead reads a byte slice in the byte order 'order' into a ChangeGCRequest value.
+func changeGCRequestRead(buf []byte, order xgb.ByteOrder) *ChangeGCRequest {
+	v := new(ChangeGCRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Gc = Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.ValueMask = Gc(order.Get32(buf[b:]))
+	b += 4
+
+	v.ValueList = make([]uint32, xgb.PopCount(int(v.ValueMask)))
+	for i := 0; i < xgb.PopCount(int(v.ValueMask)); i++ {
+		v.ValueList[i] = order.Get32(buf[b:])
+		b += 4
+	}
+	b = xgb.Pad(b)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[56] = xgb.RequestInfo{
+		Name: "ChangeGC",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return changeGCRequestRead(buf, order)
+		},
+	}
+}
+
 // ChangeHostsCookie is a cookie used only for ChangeHosts requests.
 type ChangeHostsCookie struct {
 	*xgb.Cookie
@@ -7124,7 +9816,7 @@ type ChangeHostsCookie struct {
 
 // ChangeHosts sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func ChangeHosts(c *xgb.Conn, Mode byte, Family byte, AddressLen uint16, Address []byte) ChangeHostsCookie {
+func ChangeHosts(c *xgb.Conn, Mode HostMode, Family Family, AddressLen uint16, Address []byte) ChangeHostsCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(changeHostsRequest(c, Mode, Family, AddressLen, Address), cookie)
 	return ChangeHostsCookie{cookie}
@@ -7132,7 +9824,7 @@ func ChangeHosts(c *xgb.Conn, Mode byte, Family byte, AddressLen uint16, Address
 
 // ChangeHostsChecked sends a checked request.
 // If an error occurs, it can be retrieved using ChangeHostsCookie.Check()
-func ChangeHostsChecked(c *xgb.Conn, Mode byte, Family byte, AddressLen uint16, Address []byte) ChangeHostsCookie {
+func ChangeHostsChecked(c *xgb.Conn, Mode HostMode, Family Family, AddressLen uint16, Address []byte) ChangeHostsCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(changeHostsRequest(c, Mode, Family, AddressLen, Address), cookie)
 	return ChangeHostsCookie{cookie}
@@ -7144,28 +9836,42 @@ func (cook ChangeHostsCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ChangeHostsCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ChangeHosts
 // changeHostsRequest writes a ChangeHosts request to a byte slice.
-func changeHostsRequest(c *xgb.Conn, Mode byte, Family byte, AddressLen uint16, Address []byte) []byte {
+func changeHostsRequest(c *xgb.Conn, Mode HostMode, Family Family, AddressLen uint16, Address []byte) []byte {
+	return AppendChangeHostsRequest(nil, c, Mode, Family, AddressLen, Address)
+}
+
+// AppendChangeHostsRequest appends a ChangeHosts request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendChangeHostsRequest(buf []byte, c *xgb.Conn, Mode HostMode, Family Family, AddressLen uint16, Address []byte) []byte {
 	size := xgb.Pad((8 + xgb.Pad((int(AddressLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 109 // request opcode
 	b += 1
 
-	buf[b] = Mode
+	buf[b] = byte(Mode)
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	buf[b] = Family
+	buf[b] = byte(Family)
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], AddressLen)
+	order.Put16(buf[b:], AddressLen)
 	b += 2
 
 	copy(buf[b:], Address[:AddressLen])
@@ -7174,6 +9880,49 @@ func changeHostsRequest(c *xgb.Conn, Mode byte, Family byte, AddressLen uint16,
 	return buf
 }
 
+// ChangeHostsRequest represents the data of a ChangeHosts request, as it is read when tracing a connection.
+type ChangeHostsRequest struct {
+	Mode   HostMode
+	Family Family
+	// padding: 1 bytes
+	AddressLen uint16
+	Address    []byte // size: xgb.Pad((int(AddressLen) * 1))
+}
+
+// changeHostsRequestRead reads a byte slice in the byte order 'order' into a ChangeHostsRequest value.
+func changeHostsRequestRead(buf []byte, order xgb.ByteOrder) *ChangeHostsRequest {
+	v := new(ChangeHostsRequest)
+	b := 1 // skip request opcode
+
+	v.Mode = HostMode(buf[b])
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Family = Family(buf[b])
+	b += 1
+
+	b += 1 // padding
+
+	v.AddressLen = order.Get16(buf[b:])
+	b += 2
+
+	v.Address = make([]byte, v.AddressLen)
+	copy(v.Address[:v.AddressLen], buf[b:])
+	b += int(v.AddressLen)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[109] = xgb.RequestInfo{
+		Name: "ChangeHosts",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return changeHostsRequestRead(buf, order)
+		},
+	}
+}
+
 // ChangeKeyboardControlCookie is a cookie used only for ChangeKeyboardControl requests.
 type ChangeKeyboardControlCookie struct {
 	*xgb.Cookie
@@ -7181,7 +9930,7 @@ type ChangeKeyboardControlCookie struct {
 
 // ChangeKeyboardControl sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func ChangeKeyboardControl(c *xgb.Conn, ValueMask uint32, ValueList []uint32) ChangeKeyboardControlCookie {
+func ChangeKeyboardControl(c *xgb.Conn, ValueMask Kb, ValueList []uint32) ChangeKeyboardControlCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(changeKeyboardControlRequest(c, ValueMask, ValueList), cookie)
 	return ChangeKeyboardControlCookie{cookie}
@@ -7189,7 +9938,7 @@ func ChangeKeyboardControl(c *xgb.Conn, ValueMask uint32, ValueList []uint32) Ch
 
 // ChangeKeyboardControlChecked sends a checked request.
 // If an error occurs, it can be retrieved using ChangeKeyboardControlCookie.Check()
-func ChangeKeyboardControlChecked(c *xgb.Conn, ValueMask uint32, ValueList []uint32) ChangeKeyboardControlCookie {
+func ChangeKeyboardControlChecked(c *xgb.Conn, ValueMask Kb, ValueList []uint32) ChangeKeyboardControlCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(changeKeyboardControlRequest(c, ValueMask, ValueList), cookie)
 	return ChangeKeyboardControlCookie{cookie}
@@ -7201,25 +9950,39 @@ func (cook ChangeKeyboardControlCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ChangeKeyboardControlCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ChangeKeyboardControl
 // changeKeyboardControlRequest writes a ChangeKeyboardControl request to a byte slice.
-func changeKeyboardControlRequest(c *xgb.Conn, ValueMask uint32, ValueList []uint32) []byte {
+func changeKeyboardControlRequest(c *xgb.Conn, ValueMask Kb, ValueList []uint32) []byte {
+	return AppendChangeKeyboardControlRequest(nil, c, ValueMask, ValueList)
+}
+
+// AppendChangeKeyboardControlRequest appends a ChangeKeyboardControl request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendChangeKeyboardControlRequest(buf []byte, c *xgb.Conn, ValueMask Kb, ValueList []uint32) []byte {
 	size := xgb.Pad((4 + (4 + xgb.Pad((4 * xgb.PopCount(int(ValueMask)))))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 102 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], ValueMask)
+	order.Put32(buf[b:], uint32(ValueMask))
 	b += 4
 	for i := 0; i < xgb.PopCount(int(ValueMask)); i++ {
-		xgb.Put32(buf[b:], ValueList[i])
+		order.Put32(buf[b:], ValueList[i])
 		b += 4
 	}
 	b = xgb.Pad(b)
@@ -7227,20 +9990,58 @@ func changeKeyboardControlRequest(c *xgb.Conn, ValueMask uint32, ValueList []uin
 	return buf
 }
 
-// ChangeKeyboardMappingCookie is a cookie used only for ChangeKeyboardMapping requests.
-type ChangeKeyboardMappingCookie struct {
-	*xgb.Cookie
+// ChangeKeyboardControlRequest represents the data of a ChangeKeyboardControl request, as it is read when tracing a connection.
+type ChangeKeyboardControlRequest struct {
+	// padding: 1 bytes
+	ValueMask Kb
+	ValueList []uint32
 }
 
-// ChangeKeyboardMapping sends an unchecked request.
-// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func ChangeKeyboardMapping(c *xgb.Conn, KeycodeCount byte, FirstKeycode Keycode, KeysymsPerKeycode byte, Keysyms []Keysym) ChangeKeyboardMappingCookie {
-	cookie := c.NewCookie(false, false)
-	c.NewRequest(changeKeyboardMappingRequest(c, KeycodeCount, FirstKeycode, KeysymsPerKeycode, Keysyms), cookie)
-	return ChangeKeyboardMappingCookie{cookie}
-}
+// changeKeyboardControlRequestRead reads a byte slice in the byte order 'order' into a ChangeKeyboardControlRequest value.
+func changeKeyboardControlRequestRead(buf []byte, order xgb.ByteOrder) *ChangeKeyboardControlRequest {
+	v := new(ChangeKeyboardControlRequest)
+	b := 1 // skip request opcode
 
-// ChangeKeyboardMappingChecked sends a checked request.
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.ValueMask = Kb(order.Get32(buf[b:]))
+	b += 4
+
+	v.ValueList = make([]uint32, xgb.PopCount(int(v.ValueMask)))
+	for i := 0; i < xgb.PopCount(int(v.ValueMask)); i++ {
+		v.ValueList[i] = order.Get32(buf[b:])
+		b += 4
+	}
+	b = xgb.Pad(b)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[102] = xgb.RequestInfo{
+		Name: "ChangeKeyboardControl",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return changeKeyboardControlRequestRead(buf, order)
+		},
+	}
+}
+
+// ChangeKeyboardMappingCookie is a cookie used only for ChangeKeyboardMapping requests.
+type ChangeKeyboardMappingCookie struct {
+	*xgb.Cookie
+}
+
+// ChangeKeyboardMapping sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func ChangeKeyboardMapping(c *xgb.Conn, KeycodeCount byte, FirstKeycode Keycode, KeysymsPerKeycode byte, Keysyms []Keysym) ChangeKeyboardMappingCookie {
+	cookie := c.NewCookie(false, false)
+	c.NewRequest(changeKeyboardMappingRequest(c, KeycodeCount, FirstKeycode, KeysymsPerKeycode, Keysyms), cookie)
+	return ChangeKeyboardMappingCookie{cookie}
+}
+
+// ChangeKeyboardMappingChecked sends a checked request.
 // If an error occurs, it can be retrieved using ChangeKeyboardMappingCookie.Check()
 func ChangeKeyboardMappingChecked(c *xgb.Conn, KeycodeCount byte, FirstKeycode Keycode, KeysymsPerKeycode byte, Keysyms []Keysym) ChangeKeyboardMappingCookie {
 	cookie := c.NewCookie(true, false)
@@ -7254,12 +10055,26 @@ func (cook ChangeKeyboardMappingCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ChangeKeyboardMappingCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ChangeKeyboardMapping
 // changeKeyboardMappingRequest writes a ChangeKeyboardMapping request to a byte slice.
 func changeKeyboardMappingRequest(c *xgb.Conn, KeycodeCount byte, FirstKeycode Keycode, KeysymsPerKeycode byte, Keysyms []Keysym) []byte {
+	return AppendChangeKeyboardMappingRequest(nil, c, KeycodeCount, FirstKeycode, KeysymsPerKeycode, Keysyms)
+}
+
+// AppendChangeKeyboardMappingRequest appends a ChangeKeyboardMapping request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendChangeKeyboardMappingRequest(buf []byte, c *xgb.Conn, KeycodeCount byte, FirstKeycode Keycode, KeysymsPerKeycode byte, Keysyms []Keysym) []byte {
 	size := xgb.Pad((8 + xgb.Pad(((int(KeycodeCount) * int(KeysymsPerKeycode)) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 100 // request opcode
 	b += 1
@@ -7267,7 +10082,7 @@ func changeKeyboardMappingRequest(c *xgb.Conn, KeycodeCount byte, FirstKeycode K
 	buf[b] = KeycodeCount
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	buf[b] = byte(FirstKeycode)
@@ -7279,13 +10094,58 @@ func changeKeyboardMappingRequest(c *xgb.Conn, KeycodeCount byte, FirstKeycode K
 	b += 2 // padding
 
 	for i := 0; i < int((int(KeycodeCount) * int(KeysymsPerKeycode))); i++ {
-		xgb.Put32(buf[b:], uint32(Keysyms[i]))
+		order.Put32(buf[b:], uint32(Keysyms[i]))
 		b += 4
 	}
 
 	return buf
 }
 
+// ChangeKeyboardMappingRequest represents the data of a ChangeKeyboardMapping request, as it is read when tracing a connection.
+type ChangeKeyboardMappingRequest struct {
+	KeycodeCount      byte
+	FirstKeycode      Keycode
+	KeysymsPerKeycode byte
+	// padding: 2 bytes
+	Keysyms []Keysym // size: xgb.Pad(((int(KeycodeCount) * int(KeysymsPerKeycode)) * 4))
+}
+
+// changeKeyboardMappingRequestRead reads a byte slice in the byte order 'order' into a ChangeKeyboardMappingRequest value.
+func changeKeyboardMappingRequestRead(buf []byte, order xgb.ByteOrder) *ChangeKeyboardMappingRequest {
+	v := new(ChangeKeyboardMappingRequest)
+	b := 1 // skip request opcode
+
+	v.KeycodeCount = buf[b]
+	b += 1
+
+	b += 2 // skip request size
+
+	v.FirstKeycode = Keycode(buf[b])
+	b += 1
+
+	v.KeysymsPerKeycode = buf[b]
+	b += 1
+
+	b += 2 // padding
+
+	v.Keysyms = make([]Keysym, (int(v.KeycodeCount) * int(v.KeysymsPerKeycode)))
+	for i := 0; i < int((int(v.KeycodeCount) * int(v.KeysymsPerKeycode))); i++ {
+		v.Keysyms[i] = Keysym(order.Get32(buf[b:]))
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[100] = xgb.RequestInfo{
+		Name: "ChangeKeyboardMapping",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return changeKeyboardMappingRequestRead(buf, order)
+		},
+	}
+}
+
 // ChangePointerControlCookie is a cookie used only for ChangePointerControl requests.
 type ChangePointerControlCookie struct {
 	*xgb.Cookie
@@ -7313,28 +10173,42 @@ func (cook ChangePointerControlCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ChangePointerControlCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ChangePointerControl
 // changePointerControlRequest writes a ChangePointerControl request to a byte slice.
 func changePointerControlRequest(c *xgb.Conn, AccelerationNumerator int16, AccelerationDenominator int16, Threshold int16, DoAcceleration bool, DoThreshold bool) []byte {
+	return AppendChangePointerControlRequest(nil, c, AccelerationNumerator, AccelerationDenominator, Threshold, DoAcceleration, DoThreshold)
+}
+
+// AppendChangePointerControlRequest appends a ChangePointerControl request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendChangePointerControlRequest(buf []byte, c *xgb.Conn, AccelerationNumerator int16, AccelerationDenominator int16, Threshold int16, DoAcceleration bool, DoThreshold bool) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 105 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(AccelerationNumerator))
+	order.Put16(buf[b:], uint16(AccelerationNumerator))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(AccelerationDenominator))
+	order.Put16(buf[b:], uint16(AccelerationDenominator))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(Threshold))
+	order.Put16(buf[b:], uint16(Threshold))
 	b += 2
 
 	if DoAcceleration {
@@ -7354,14 +10228,101 @@ func changePointerControlRequest(c *xgb.Conn, AccelerationNumerator int16, Accel
 	return buf
 }
 
+// ChangePointerControlRequest represents the data of a ChangePointerControl request, as it is read when tracing a connection.
+type ChangePointerControlRequest struct {
+	// padding: 1 bytes
+	AccelerationNumerator   int16
+	AccelerationDenominator int16
+	Threshold               int16
+	DoAcceleration          bool
+	DoThreshold             bool
+}
+
+// changePointerControlRequestRead reads a byte slice in the byte order 'order' into a ChangePointerControlRequest value.
+func changePointerControlRequestRead(buf []byte, order xgb.ByteOrder) *ChangePointerControlRequest {
+	v := new(ChangePointerControlRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.AccelerationNumerator = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.AccelerationDenominator = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Threshold = int16(order.Get16(buf[b:]))
+	b += 2
+
+	if buf[b] == 1 {
+		v.DoAcceleration = true
+	} else {
+		v.DoAcceleration = false
+	}
+	b += 1
+
+	if buf[b] == 1 {
+		v.DoThreshold = true
+	} else {
+		v.DoThreshold = false
+	}
+	b += 1
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[105] = xgb.RequestInfo{
+		Name: "ChangePointerControl",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return changePointerControlRequestRead(buf, order)
+		},
+	}
+}
+
 // ChangePropertyCookie is a cookie used only for ChangeProperty requests.
 type ChangePropertyCookie struct {
 	*xgb.Cookie
 }
 
+// ChangeProperty changes a window property.
+//
+// Sets or updates a property on the specified `window`. Properties are for
+// example the window title (`WM_NAME`) or its minimum size
+// (`WM_NORMAL_HINTS`). Protocols such as EWMH also use properties - for
+// example EWMH defines the window title, encoded as UTF-8 string, in the
+// `_NET_WM_NAME` property.
+//
+// Parameters:
+//
+//   - Window: The window whose property you want to change.
+//   - Mode: Whether the data replaces the property, or is put before or after
+//     its current data.
+//   - Property: The property you want to change (an atom).
+//   - Type: The type of the property you want to change (an atom).
+//   - Format: Specifies whether the data should be viewed as a list of 8-bit,
+//     16-bit or 32-bit quantities. Possible values are 8, 16 and 32. This
+//     information allows the X server to correctly perform byte-swap
+//     operations as necessary.
+//   - DataLen: Specifies the number of elements (see `format`).
+//   - Data: The property data.
+//
+// Errors:
+//
+//   - MatchError: The property already exists with another `type` or `format`,
+//     and `mode` isn't `PropModeReplace`.
+//   - ValueError: The `format` is not 8, 16 or 32, or the `mode` is not valid.
+//   - WindowError: The specified `window` does not exist.
+//   - AtomError: `property` or `type` do not refer to a valid atom.
+//   - AllocError: The X server could not store the property (no memory?).
+//
+// See also xprop(1), InternAtom.
+//
 // ChangeProperty sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func ChangeProperty(c *xgb.Conn, Mode byte, Window Window, Property Atom, Type Atom, Format byte, DataLen uint32, Data []byte) ChangePropertyCookie {
+func ChangeProperty(c *xgb.Conn, Mode PropMode, Window Window, Property Atom, Type Atom, Format byte, DataLen uint32, Data []byte) ChangePropertyCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(changePropertyRequest(c, Mode, Window, Property, Type, Format, DataLen, Data), cookie)
 	return ChangePropertyCookie{cookie}
@@ -7369,7 +10330,7 @@ func ChangeProperty(c *xgb.Conn, Mode byte, Window Window, Property Atom, Type A
 
 // ChangePropertyChecked sends a checked request.
 // If an error occurs, it can be retrieved using ChangePropertyCookie.Check()
-func ChangePropertyChecked(c *xgb.Conn, Mode byte, Window Window, Property Atom, Type Atom, Format byte, DataLen uint32, Data []byte) ChangePropertyCookie {
+func ChangePropertyChecked(c *xgb.Conn, Mode PropMode, Window Window, Property Atom, Type Atom, Format byte, DataLen uint32, Data []byte) ChangePropertyCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(changePropertyRequest(c, Mode, Window, Property, Type, Format, DataLen, Data), cookie)
 	return ChangePropertyCookie{cookie}
@@ -7381,29 +10342,43 @@ func (cook ChangePropertyCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ChangePropertyCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ChangeProperty
 // changePropertyRequest writes a ChangeProperty request to a byte slice.
-func changePropertyRequest(c *xgb.Conn, Mode byte, Window Window, Property Atom, Type Atom, Format byte, DataLen uint32, Data []byte) []byte {
+func changePropertyRequest(c *xgb.Conn, Mode PropMode, Window Window, Property Atom, Type Atom, Format byte, DataLen uint32, Data []byte) []byte {
+	return AppendChangePropertyRequest(nil, c, Mode, Window, Property, Type, Format, DataLen, Data)
+}
+
+// AppendChangePropertyRequest appends a ChangeProperty request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendChangePropertyRequest(buf []byte, c *xgb.Conn, Mode PropMode, Window Window, Property Atom, Type Atom, Format byte, DataLen uint32, Data []byte) []byte {
 	size := xgb.Pad((24 + xgb.Pad((((int(DataLen) * int(Format)) / 8) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 18 // request opcode
 	b += 1
 
-	buf[b] = Mode
+	buf[b] = byte(Mode)
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Property))
+	order.Put32(buf[b:], uint32(Property))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Type))
+	order.Put32(buf[b:], uint32(Type))
 	b += 4
 
 	buf[b] = Format
@@ -7411,7 +10386,7 @@ func changePropertyRequest(c *xgb.Conn, Mode byte, Window Window, Property Atom,
 
 	b += 3 // padding
 
-	xgb.Put32(buf[b:], DataLen)
+	order.Put32(buf[b:], DataLen)
 	b += 4
 
 	copy(buf[b:], Data[:((int(DataLen)*int(Format))/8)])
@@ -7420,6 +10395,61 @@ func changePropertyRequest(c *xgb.Conn, Mode byte, Window Window, Property Atom,
 	return buf
 }
 
+// ChangePropertyRequest represents the data of a ChangeProperty request, as it is read when tracing a connection.
+type ChangePropertyRequest struct {
+	Mode     PropMode
+	Window   Window
+	Property Atom
+	Type     Atom
+	Format   byte
+	// padding: 3 bytes
+	DataLen uint32
+	Data    []byte // size: xgb.Pad((((int(DataLen) * int(Format)) / 8) * 1))
+}
+
+// changePropertyRequestRead reads a byte slice in the byte order 'order' into a ChangePropertyRequest value.
+func changePropertyRequestRead(buf []byte, order xgb.ByteOrder) *ChangePropertyRequest {
+	v := new(ChangePropertyRequest)
+	b := 1 // skip request opcode
+
+	v.Mode = PropMode(buf[b])
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Window = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Property = Atom(order.Get32(buf[b:]))
+	b += 4
+
+	v.Type = Atom(order.Get32(buf[b:]))
+	b += 4
+
+	v.Format = buf[b]
+	b += 1
+
+	b += 3 // padding
+
+	v.DataLen = order.Get32(buf[b:])
+	b += 4
+
+	v.Data = make([]byte, ((int(v.DataLen) * int(v.Format)) / 8))
+	copy(v.Data[:((int(v.DataLen)*int(v.Format))/8)], buf[b:])
+	b += int(((int(v.DataLen) * int(v.Format)) / 8))
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[18] = xgb.RequestInfo{
+		Name: "ChangeProperty",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return changePropertyRequestRead(buf, order)
+		},
+	}
+}
+
 // ChangeSaveSetCookie is a cookie used only for ChangeSaveSet requests.
 type ChangeSaveSetCookie struct {
 	*xgb.Cookie
@@ -7427,7 +10457,7 @@ type ChangeSaveSetCookie struct {
 
 // ChangeSaveSet sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func ChangeSaveSet(c *xgb.Conn, Mode byte, Window Window) ChangeSaveSetCookie {
+func ChangeSaveSet(c *xgb.Conn, Mode SetMode, Window Window) ChangeSaveSetCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(changeSaveSetRequest(c, Mode, Window), cookie)
 	return ChangeSaveSetCookie{cookie}
@@ -7435,7 +10465,7 @@ func ChangeSaveSet(c *xgb.Conn, Mode byte, Window Window) ChangeSaveSetCookie {
 
 // ChangeSaveSetChecked sends a checked request.
 // If an error occurs, it can be retrieved using ChangeSaveSetCookie.Check()
-func ChangeSaveSetChecked(c *xgb.Conn, Mode byte, Window Window) ChangeSaveSetCookie {
+func ChangeSaveSetChecked(c *xgb.Conn, Mode SetMode, Window Window) ChangeSaveSetCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(changeSaveSetRequest(c, Mode, Window), cookie)
 	return ChangeSaveSetCookie{cookie}
@@ -7447,36 +10477,101 @@ func (cook ChangeSaveSetCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ChangeSaveSetCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ChangeSaveSet
 // changeSaveSetRequest writes a ChangeSaveSet request to a byte slice.
-func changeSaveSetRequest(c *xgb.Conn, Mode byte, Window Window) []byte {
+func changeSaveSetRequest(c *xgb.Conn, Mode SetMode, Window Window) []byte {
+	return AppendChangeSaveSetRequest(nil, c, Mode, Window)
+}
+
+// AppendChangeSaveSetRequest appends a ChangeSaveSet request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendChangeSaveSetRequest(buf []byte, c *xgb.Conn, Mode SetMode, Window Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 6 // request opcode
 	b += 1
 
-	buf[b] = Mode
+	buf[b] = byte(Mode)
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// ChangeSaveSetRequest represents the data of a ChangeSaveSet request, as it is read when tracing a connection.
+type ChangeSaveSetRequest struct {
+	Mode   SetMode
+	Window Window
+}
+
+// changeSaveSetRequestRead reads a byte slice in the byte order 'order' into a ChangeSaveSetRequest value.
+func changeSaveSetRequestRead(buf []byte, order xgb.ByteOrder) *ChangeSaveSetRequest {
+	v := new(ChangeSaveSetRequest)
+	b := 1 // skip request opcode
+
+	v.Mode = SetMode(buf[b])
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Window = Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[6] = xgb.RequestInfo{
+		Name: "ChangeSaveSet",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return changeSaveSetRequestRead(buf, order)
+		},
+	}
+}
+
 // ChangeWindowAttributesCookie is a cookie used only for ChangeWindowAttributes requests.
 type ChangeWindowAttributesCookie struct {
 	*xgb.Cookie
 }
 
+// ChangeWindowAttributes change window attributes.
+//
+// Changes the attributes specified by `value_mask` for the specified `window`.
+//
+// Parameters:
+//
+//   - Window: The window to change.
+//   - ValueMask: The mask of the attributes that are given in `value_list`,
+//     and whose values are in the order of the bits of the mask.
+//
+// Errors:
+//
+//   - AccessError: The attributes include an EventMask with ButtonPress
+//     events, which another client already selects, or a window's colormap is
+//     being changed and the client isn't allowed to.
+//   - MatchError: A value does not match the window's depth or visual.
+//   - PixmapError: A pixmap in the value list does not exist.
+//   - ValueError: A value is out of range.
+//   - WindowError: The specified `window` does not exist.
+//
 // ChangeWindowAttributes sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func ChangeWindowAttributes(c *xgb.Conn, Window Window, ValueMask uint32, ValueList []uint32) ChangeWindowAttributesCookie {
+func ChangeWindowAttributes(c *xgb.Conn, Window Window, ValueMask Cw, ValueList []uint32) ChangeWindowAttributesCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(changeWindowAttributesRequest(c, Window, ValueMask, ValueList), cookie)
 	return ChangeWindowAttributesCookie{cookie}
@@ -7484,7 +10579,7 @@ func ChangeWindowAttributes(c *xgb.Conn, Window Window, ValueMask uint32, ValueL
 
 // ChangeWindowAttributesChecked sends a checked request.
 // If an error occurs, it can be retrieved using ChangeWindowAttributesCookie.Check()
-func ChangeWindowAttributesChecked(c *xgb.Conn, Window Window, ValueMask uint32, ValueList []uint32) ChangeWindowAttributesCookie {
+func ChangeWindowAttributesChecked(c *xgb.Conn, Window Window, ValueMask Cw, ValueList []uint32) ChangeWindowAttributesCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(changeWindowAttributesRequest(c, Window, ValueMask, ValueList), cookie)
 	return ChangeWindowAttributesCookie{cookie}
@@ -7496,28 +10591,42 @@ func (cook ChangeWindowAttributesCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ChangeWindowAttributesCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ChangeWindowAttributes
 // changeWindowAttributesRequest writes a ChangeWindowAttributes request to a byte slice.
-func changeWindowAttributesRequest(c *xgb.Conn, Window Window, ValueMask uint32, ValueList []uint32) []byte {
+func changeWindowAttributesRequest(c *xgb.Conn, Window Window, ValueMask Cw, ValueList []uint32) []byte {
+	return AppendChangeWindowAttributesRequest(nil, c, Window, ValueMask, ValueList)
+}
+
+// AppendChangeWindowAttributesRequest appends a ChangeWindowAttributes request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendChangeWindowAttributesRequest(buf []byte, c *xgb.Conn, Window Window, ValueMask Cw, ValueList []uint32) []byte {
 	size := xgb.Pad((8 + (4 + xgb.Pad((4 * xgb.PopCount(int(ValueMask)))))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 2 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put32(buf[b:], ValueMask)
+	order.Put32(buf[b:], uint32(ValueMask))
 	b += 4
 	for i := 0; i < xgb.PopCount(int(ValueMask)); i++ {
-		xgb.Put32(buf[b:], ValueList[i])
+		order.Put32(buf[b:], ValueList[i])
 		b += 4
 	}
 	b = xgb.Pad(b)
@@ -7525,6 +10634,48 @@ func changeWindowAttributesRequest(c *xgb.Conn, Window Window, ValueMask uint32,
 	return buf
 }
 
+// ChangeWindowAttributesRequest represents the data of a ChangeWindowAttributes request, as it is read when tracing a connection.
+type ChangeWindowAttributesRequest struct {
+	// padding: 1 bytes
+	Window    Window
+	ValueMask Cw
+	ValueList []uint32
+}
+
+// changeWindowAttributesRequestRead reads a byte slice in the byte order 'order' into a ChangeWindowAttributesRequest value.
+func changeWindowAttributesRequestRead(buf []byte, order xgb.ByteOrder) *ChangeWindowAttributesRequest {
+	v := new(ChangeWindowAttributesRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Window = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.ValueMask = Cw(order.Get32(buf[b:]))
+	b += 4
+
+	v.ValueList = make([]uint32, xgb.PopCount(int(v.ValueMask)))
+	for i := 0; i < xgb.PopCount(int(v.ValueMask)); i++ {
+		v.ValueList[i] = order.Get32(buf[b:])
+		b += 4
+	}
+	b = xgb.Pad(b)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[2] = xgb.RequestInfo{
+		Name: "ChangeWindowAttributes",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return changeWindowAttributesRequestRead(buf, order)
+		},
+	}
+}
+
 // CirculateWindowCookie is a cookie used only for CirculateWindow requests.
 type CirculateWindowCookie struct {
 	*xgb.Cookie
@@ -7532,7 +10683,7 @@ type CirculateWindowCookie struct {
 
 // CirculateWindow sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func CirculateWindow(c *xgb.Conn, Direction byte, Window Window) CirculateWindowCookie {
+func CirculateWindow(c *xgb.Conn, Direction Circulate, Window Window) CirculateWindowCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(circulateWindowRequest(c, Direction, Window), cookie)
 	return CirculateWindowCookie{cookie}
@@ -7540,7 +10691,7 @@ func CirculateWindow(c *xgb.Conn, Direction byte, Window Window) CirculateWindow
 
 // CirculateWindowChecked sends a checked request.
 // If an error occurs, it can be retrieved using CirculateWindowCookie.Check()
-func CirculateWindowChecked(c *xgb.Conn, Direction byte, Window Window) CirculateWindowCookie {
+func CirculateWindowChecked(c *xgb.Conn, Direction Circulate, Window Window) CirculateWindowCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(circulateWindowRequest(c, Direction, Window), cookie)
 	return CirculateWindowCookie{cookie}
@@ -7552,28 +10703,73 @@ func (cook CirculateWindowCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CirculateWindowCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CirculateWindow
 // circulateWindowRequest writes a CirculateWindow request to a byte slice.
-func circulateWindowRequest(c *xgb.Conn, Direction byte, Window Window) []byte {
+func circulateWindowRequest(c *xgb.Conn, Direction Circulate, Window Window) []byte {
+	return AppendCirculateWindowRequest(nil, c, Direction, Window)
+}
+
+// AppendCirculateWindowRequest appends a CirculateWindow request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCirculateWindowRequest(buf []byte, c *xgb.Conn, Direction Circulate, Window Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 13 // request opcode
 	b += 1
 
-	buf[b] = Direction
+	buf[b] = byte(Direction)
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// CirculateWindowRequest represents the data of a CirculateWindow request, as it is read when tracing a connection.
+type CirculateWindowRequest struct {
+	Direction Circulate
+	Window    Window
+}
+
+// circulateWindowRequestRead reads a byte slice in the byte order 'order' into a CirculateWindowRequest value.
+func circulateWindowRequestRead(buf []byte, order xgb.ByteOrder) *CirculateWindowRequest {
+	v := new(CirculateWindowRequest)
+	b := 1 // skip request opcode
+
+	v.Direction = Circulate(buf[b])
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Window = Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[13] = xgb.RequestInfo{
+		Name: "CirculateWindow",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return circulateWindowRequestRead(buf, order)
+		},
+	}
+}
+
 // ClearAreaCookie is a cookie used only for ClearArea requests.
 type ClearAreaCookie struct {
 	*xgb.Cookie
@@ -7601,12 +10797,26 @@ func (cook ClearAreaCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ClearAreaCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ClearArea
 // clearAreaRequest writes a ClearArea request to a byte slice.
 func clearAreaRequest(c *xgb.Conn, Exposures bool, Window Window, X int16, Y int16, Width uint16, Height uint16) []byte {
+	return AppendClearAreaRequest(nil, c, Exposures, Window, X, Y, Width, Height)
+}
+
+// AppendClearAreaRequest appends a ClearArea request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendClearAreaRequest(buf []byte, c *xgb.Conn, Exposures bool, Window Window, X int16, Y int16, Width uint16, Height uint16) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 61 // request opcode
 	b += 1
@@ -7618,27 +10828,78 @@ func clearAreaRequest(c *xgb.Conn, Exposures bool, Window Window, X int16, Y int
 	}
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(X))
+	order.Put16(buf[b:], uint16(X))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(Y))
+	order.Put16(buf[b:], uint16(Y))
 	b += 2
 
-	xgb.Put16(buf[b:], Width)
+	order.Put16(buf[b:], Width)
 	b += 2
 
-	xgb.Put16(buf[b:], Height)
+	order.Put16(buf[b:], Height)
 	b += 2
 
 	return buf
 }
 
+// ClearAreaRequest represents the data of a ClearArea request, as it is read when tracing a connection.
+type ClearAreaRequest struct {
+	Exposures bool
+	Window    Window
+	X         int16
+	Y         int16
+	Width     uint16
+	Height    uint16
+}
+
+// clearAreaRequestRead reads a byte slice in the byte order 'order' into a ClearAreaRequest value.
+func clearAreaRequestRead(buf []byte, order xgb.ByteOrder) *ClearAreaRequest {
+	v := new(ClearAreaRequest)
+	b := 1 // skip request opcode
+
+	if buf[b] == 1 {
+		v.Exposures = true
+	} else {
+		v.Exposures = false
+	}
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Window = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.X = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Y = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[61] = xgb.RequestInfo{
+		Name: "ClearArea",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return clearAreaRequestRead(buf, order)
+		},
+	}
+}
+
 // CloseFontCookie is a cookie used only for CloseFont requests.
 type CloseFontCookie struct {
 	*xgb.Cookie
@@ -7666,35 +10927,98 @@ func (cook CloseFontCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CloseFontCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CloseFont
 // closeFontRequest writes a CloseFont request to a byte slice.
 func closeFontRequest(c *xgb.Conn, Font Font) []byte {
+	return AppendCloseFontRequest(nil, c, Font)
+}
+
+// AppendCloseFontRequest appends a CloseFont request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCloseFontRequest(buf []byte, c *xgb.Conn, Font Font) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 46 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Font))
+	order.Put32(buf[b:], uint32(Font))
 	b += 4
 
 	return buf
 }
 
+// CloseFontRequest represents the data of a CloseFont request, as it is read when tracing a connection.
+type CloseFontRequest struct {
+	// padding: 1 bytes
+	Font Font
+}
+
+// closeFontRequestRead reads a byte slice in the byte order 'order' into a CloseFontRequest value.
+func closeFontRequestRead(buf []byte, order xgb.ByteOrder) *CloseFontRequest {
+	v := new(CloseFontRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Font = Font(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[46] = xgb.RequestInfo{
+		Name: "CloseFont",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return closeFontRequestRead(buf, order)
+		},
+	}
+}
+
 // ConfigureWindowCookie is a cookie used only for ConfigureWindow requests.
 type ConfigureWindowCookie struct {
 	*xgb.Cookie
 }
 
+// ConfigureWindow configures window attributes.
+//
+// Configures a window's size, position, border width and stacking order.
+//
+// Parameters:
+//
+//   - Window: The window to configure.
+//   - ValueMask: Bitmask of attributes to change.
+//
+// Errors:
+//
+//   - MatchError: You specified a Sibling without also specifying StackMode or
+//     the window is not actually a Sibling.
+//   - WindowError: The specified window does not exist. TODO: any other
+//     reason?
+//   - ValueError: TODO: reasons?
+//
+// See also MapNotifyEvent, ExposeEvent.
+//
 // ConfigureWindow sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func ConfigureWindow(c *xgb.Conn, Window Window, ValueMask uint16, ValueList []uint32) ConfigureWindowCookie {
+func ConfigureWindow(c *xgb.Conn, Window Window, ValueMask ConfigWindow, ValueList []uint32) ConfigureWindowCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(configureWindowRequest(c, Window, ValueMask, ValueList), cookie)
 	return ConfigureWindowCookie{cookie}
@@ -7702,7 +11026,7 @@ func ConfigureWindow(c *xgb.Conn, Window Window, ValueMask uint16, ValueList []u
 
 // ConfigureWindowChecked sends a checked request.
 // If an error occurs, it can be retrieved using ConfigureWindowCookie.Check()
-func ConfigureWindowChecked(c *xgb.Conn, Window Window, ValueMask uint16, ValueList []uint32) ConfigureWindowCookie {
+func ConfigureWindowChecked(c *xgb.Conn, Window Window, ValueMask ConfigWindow, ValueList []uint32) ConfigureWindowCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(configureWindowRequest(c, Window, ValueMask, ValueList), cookie)
 	return ConfigureWindowCookie{cookie}
@@ -7714,31 +11038,45 @@ func (cook ConfigureWindowCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ConfigureWindowCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ConfigureWindow
 // configureWindowRequest writes a ConfigureWindow request to a byte slice.
-func configureWindowRequest(c *xgb.Conn, Window Window, ValueMask uint16, ValueList []uint32) []byte {
+func configureWindowRequest(c *xgb.Conn, Window Window, ValueMask ConfigWindow, ValueList []uint32) []byte {
+	return AppendConfigureWindowRequest(nil, c, Window, ValueMask, ValueList)
+}
+
+// AppendConfigureWindowRequest appends a ConfigureWindow request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendConfigureWindowRequest(buf []byte, c *xgb.Conn, Window Window, ValueMask ConfigWindow, ValueList []uint32) []byte {
 	size := xgb.Pad((10 + (2 + xgb.Pad((4 * xgb.PopCount(int(ValueMask)))))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 12 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put16(buf[b:], ValueMask)
+	order.Put16(buf[b:], uint16(ValueMask))
 	b += 2
 
 	b += 2 // padding
 
 	for i := 0; i < xgb.PopCount(int(ValueMask)); i++ {
-		xgb.Put32(buf[b:], ValueList[i])
+		order.Put32(buf[b:], ValueList[i])
 		b += 4
 	}
 	b = xgb.Pad(b)
@@ -7746,6 +11084,51 @@ func configureWindowRequest(c *xgb.Conn, Window Window, ValueMask uint16, ValueL
 	return buf
 }
 
+// ConfigureWindowRequest represents the data of a ConfigureWindow request, as it is read when tracing a connection.
+type ConfigureWindowRequest struct {
+	// padding: 1 bytes
+	Window    Window
+	ValueMask ConfigWindow
+	// padding: 2 bytes
+	ValueList []uint32
+}
+
+// configureWindowRequestRead reads a byte slice in the byte order 'order' into a ConfigureWindowRequest value.
+func configureWindowRequestRead(buf []byte, order xgb.ByteOrder) *ConfigureWindowRequest {
+	v := new(ConfigureWindowRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Window = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.ValueMask = ConfigWindow(order.Get16(buf[b:]))
+	b += 2
+
+	b += 2 // padding
+
+	v.ValueList = make([]uint32, xgb.PopCount(int(v.ValueMask)))
+	for i := 0; i < xgb.PopCount(int(v.ValueMask)); i++ {
+		v.ValueList[i] = order.Get32(buf[b:])
+		b += 4
+	}
+	b = xgb.Pad(b)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[12] = xgb.RequestInfo{
+		Name: "ConfigureWindow",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return configureWindowRequestRead(buf, order)
+		},
+	}
+}
+
 // ConvertSelectionCookie is a cookie used only for ConvertSelection requests.
 type ConvertSelectionCookie struct {
 	*xgb.Cookie
@@ -7773,39 +11156,99 @@ func (cook ConvertSelectionCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ConvertSelectionCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ConvertSelection
 // convertSelectionRequest writes a ConvertSelection request to a byte slice.
 func convertSelectionRequest(c *xgb.Conn, Requestor Window, Selection Atom, Target Atom, Property Atom, Time Timestamp) []byte {
+	return AppendConvertSelectionRequest(nil, c, Requestor, Selection, Target, Property, Time)
+}
+
+// AppendConvertSelectionRequest appends a ConvertSelection request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendConvertSelectionRequest(buf []byte, c *xgb.Conn, Requestor Window, Selection Atom, Target Atom, Property Atom, Time Timestamp) []byte {
 	size := 24
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 24 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Requestor))
+	order.Put32(buf[b:], uint32(Requestor))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Selection))
+	order.Put32(buf[b:], uint32(Selection))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Target))
+	order.Put32(buf[b:], uint32(Target))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Property))
+	order.Put32(buf[b:], uint32(Property))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Time))
+	order.Put32(buf[b:], uint32(Time))
 	b += 4
 
 	return buf
 }
 
+// ConvertSelectionRequest represents the data of a ConvertSelection request, as it is read when tracing a connection.
+type ConvertSelectionRequest struct {
+	// padding: 1 bytes
+	Requestor Window
+	Selection Atom
+	Target    Atom
+	Property  Atom
+	Time      Timestamp
+}
+
+// convertSelectionRequestRead reads a byte slice in the byte order 'order' into a ConvertSelectionRequest value.
+func convertSelectionRequestRead(buf []byte, order xgb.ByteOrder) *ConvertSelectionRequest {
+	v := new(ConvertSelectionRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Requestor = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Selection = Atom(order.Get32(buf[b:]))
+	b += 4
+
+	v.Target = Atom(order.Get32(buf[b:]))
+	b += 4
+
+	v.Property = Atom(order.Get32(buf[b:]))
+	b += 4
+
+	v.Time = Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[24] = xgb.RequestInfo{
+		Name: "ConvertSelection",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return convertSelectionRequestRead(buf, order)
+		},
+	}
+}
+
 // CopyAreaCookie is a cookie used only for CopyArea requests.
 type CopyAreaCookie struct {
 	*xgb.Cookie
@@ -7833,51 +11276,127 @@ func (cook CopyAreaCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CopyAreaCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CopyArea
 // copyAreaRequest writes a CopyArea request to a byte slice.
 func copyAreaRequest(c *xgb.Conn, SrcDrawable Drawable, DstDrawable Drawable, Gc Gcontext, SrcX int16, SrcY int16, DstX int16, DstY int16, Width uint16, Height uint16) []byte {
+	return AppendCopyAreaRequest(nil, c, SrcDrawable, DstDrawable, Gc, SrcX, SrcY, DstX, DstY, Width, Height)
+}
+
+// AppendCopyAreaRequest appends a CopyArea request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCopyAreaRequest(buf []byte, c *xgb.Conn, SrcDrawable Drawable, DstDrawable Drawable, Gc Gcontext, SrcX int16, SrcY int16, DstX int16, DstY int16, Width uint16, Height uint16) []byte {
 	size := 28
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 62 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(SrcDrawable))
+	order.Put32(buf[b:], uint32(SrcDrawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(DstDrawable))
+	order.Put32(buf[b:], uint32(DstDrawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(SrcX))
+	order.Put16(buf[b:], uint16(SrcX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(SrcY))
+	order.Put16(buf[b:], uint16(SrcY))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DstX))
+	order.Put16(buf[b:], uint16(DstX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DstY))
+	order.Put16(buf[b:], uint16(DstY))
 	b += 2
 
-	xgb.Put16(buf[b:], Width)
+	order.Put16(buf[b:], Width)
 	b += 2
 
-	xgb.Put16(buf[b:], Height)
+	order.Put16(buf[b:], Height)
 	b += 2
 
 	return buf
 }
 
+// CopyAreaRequest represents the data of a CopyArea request, as it is read when tracing a connection.
+type CopyAreaRequest struct {
+	// padding: 1 bytes
+	SrcDrawable Drawable
+	DstDrawable Drawable
+	Gc          Gcontext
+	SrcX        int16
+	SrcY        int16
+	DstX        int16
+	DstY        int16
+	Width       uint16
+	Height      uint16
+}
+
+// copyAreaRequestRead reads a byte slice in the byte order 'order' into a CopyAreaRequest value.
+func copyAreaRequestRead(buf []byte, order xgb.ByteOrder) *CopyAreaRequest {
+	v := new(CopyAreaRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.SrcDrawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.DstDrawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.SrcX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.SrcY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.DstX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.DstY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[62] = xgb.RequestInfo{
+		Name: "CopyArea",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return copyAreaRequestRead(buf, order)
+		},
+	}
+}
+
 // CopyColormapAndFreeCookie is a cookie used only for CopyColormapAndFree requests.
 type CopyColormapAndFreeCookie struct {
 	*xgb.Cookie
@@ -7905,30 +11424,78 @@ func (cook CopyColormapAndFreeCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CopyColormapAndFreeCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CopyColormapAndFree
 // copyColormapAndFreeRequest writes a CopyColormapAndFree request to a byte slice.
 func copyColormapAndFreeRequest(c *xgb.Conn, Mid Colormap, SrcCmap Colormap) []byte {
+	return AppendCopyColormapAndFreeRequest(nil, c, Mid, SrcCmap)
+}
+
+// AppendCopyColormapAndFreeRequest appends a CopyColormapAndFree request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCopyColormapAndFreeRequest(buf []byte, c *xgb.Conn, Mid Colormap, SrcCmap Colormap) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 80 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Mid))
+	order.Put32(buf[b:], uint32(Mid))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(SrcCmap))
+	order.Put32(buf[b:], uint32(SrcCmap))
 	b += 4
 
 	return buf
 }
 
+// CopyColormapAndFreeRequest represents the data of a CopyColormapAndFree request, as it is read when tracing a connection.
+type CopyColormapAndFreeRequest struct {
+	// padding: 1 bytes
+	Mid     Colormap
+	SrcCmap Colormap
+}
+
+// copyColormapAndFreeRequestRead reads a byte slice in the byte order 'order' into a CopyColormapAndFreeRequest value.
+func copyColormapAndFreeRequestRead(buf []byte, order xgb.ByteOrder) *CopyColormapAndFreeRequest {
+	v := new(CopyColormapAndFreeRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Mid = Colormap(order.Get32(buf[b:]))
+	b += 4
+
+	v.SrcCmap = Colormap(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[80] = xgb.RequestInfo{
+		Name: "CopyColormapAndFree",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return copyColormapAndFreeRequestRead(buf, order)
+		},
+	}
+}
+
 // CopyGCCookie is a cookie used only for CopyGC requests.
 type CopyGCCookie struct {
 	*xgb.Cookie
@@ -7936,7 +11503,7 @@ type CopyGCCookie struct {
 
 // CopyGC sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func CopyGC(c *xgb.Conn, SrcGc Gcontext, DstGc Gcontext, ValueMask uint32) CopyGCCookie {
+func CopyGC(c *xgb.Conn, SrcGc Gcontext, DstGc Gcontext, ValueMask Gc) CopyGCCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(copyGCRequest(c, SrcGc, DstGc, ValueMask), cookie)
 	return CopyGCCookie{cookie}
@@ -7944,7 +11511,7 @@ func CopyGC(c *xgb.Conn, SrcGc Gcontext, DstGc Gcontext, ValueMask uint32) CopyG
 
 // CopyGCChecked sends a checked request.
 // If an error occurs, it can be retrieved using CopyGCCookie.Check()
-func CopyGCChecked(c *xgb.Conn, SrcGc Gcontext, DstGc Gcontext, ValueMask uint32) CopyGCCookie {
+func CopyGCChecked(c *xgb.Conn, SrcGc Gcontext, DstGc Gcontext, ValueMask Gc) CopyGCCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(copyGCRequest(c, SrcGc, DstGc, ValueMask), cookie)
 	return CopyGCCookie{cookie}
@@ -7956,33 +11523,85 @@ func (cook CopyGCCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CopyGCCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CopyGC
 // copyGCRequest writes a CopyGC request to a byte slice.
-func copyGCRequest(c *xgb.Conn, SrcGc Gcontext, DstGc Gcontext, ValueMask uint32) []byte {
+func copyGCRequest(c *xgb.Conn, SrcGc Gcontext, DstGc Gcontext, ValueMask Gc) []byte {
+	return AppendCopyGCRequest(nil, c, SrcGc, DstGc, ValueMask)
+}
+
+// AppendCopyGCRequest appends a CopyGC request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCopyGCRequest(buf []byte, c *xgb.Conn, SrcGc Gcontext, DstGc Gcontext, ValueMask Gc) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 57 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(SrcGc))
+	order.Put32(buf[b:], uint32(SrcGc))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(DstGc))
+	order.Put32(buf[b:], uint32(DstGc))
 	b += 4
 
-	xgb.Put32(buf[b:], ValueMask)
+	order.Put32(buf[b:], uint32(ValueMask))
 	b += 4
 
 	return buf
 }
 
+// CopyGCRequest represents the data of a CopyGC request, as it is read when tracing a connection.
+type CopyGCRequest struct {
+	// padding: 1 bytes
+	SrcGc     Gcontext
+	DstGc     Gcontext
+	ValueMask Gc
+}
+
+// copyGCRequestRead reads a byte slice in the byte order 'order' into a CopyGCRequest value.
+func copyGCRequestRead(buf []byte, order xgb.ByteOrder) *CopyGCRequest {
+	v := new(CopyGCRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.SrcGc = Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.DstGc = Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.ValueMask = Gc(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[57] = xgb.RequestInfo{
+		Name: "CopyGC",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return copyGCRequestRead(buf, order)
+		},
+	}
+}
+
 // CopyPlaneCookie is a cookie used only for CopyPlane requests.
 type CopyPlaneCookie struct {
 	*xgb.Cookie
@@ -8010,62 +11629,156 @@ func (cook CopyPlaneCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CopyPlaneCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CopyPlane
 // copyPlaneRequest writes a CopyPlane request to a byte slice.
 func copyPlaneRequest(c *xgb.Conn, SrcDrawable Drawable, DstDrawable Drawable, Gc Gcontext, SrcX int16, SrcY int16, DstX int16, DstY int16, Width uint16, Height uint16, BitPlane uint32) []byte {
+	return AppendCopyPlaneRequest(nil, c, SrcDrawable, DstDrawable, Gc, SrcX, SrcY, DstX, DstY, Width, Height, BitPlane)
+}
+
+// AppendCopyPlaneRequest appends a CopyPlane request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCopyPlaneRequest(buf []byte, c *xgb.Conn, SrcDrawable Drawable, DstDrawable Drawable, Gc Gcontext, SrcX int16, SrcY int16, DstX int16, DstY int16, Width uint16, Height uint16, BitPlane uint32) []byte {
 	size := 32
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 63 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(SrcDrawable))
+	order.Put32(buf[b:], uint32(SrcDrawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(DstDrawable))
+	order.Put32(buf[b:], uint32(DstDrawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(SrcX))
+	order.Put16(buf[b:], uint16(SrcX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(SrcY))
+	order.Put16(buf[b:], uint16(SrcY))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DstX))
+	order.Put16(buf[b:], uint16(DstX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DstY))
+	order.Put16(buf[b:], uint16(DstY))
 	b += 2
 
-	xgb.Put16(buf[b:], Width)
+	order.Put16(buf[b:], Width)
 	b += 2
 
-	xgb.Put16(buf[b:], Height)
+	order.Put16(buf[b:], Height)
 	b += 2
 
-	xgb.Put32(buf[b:], BitPlane)
+	order.Put32(buf[b:], BitPlane)
 	b += 4
 
 	return buf
 }
 
+// CopyPlaneRequest represents the data of a CopyPlane request, as it is read when tracing a connection.
+type CopyPlaneRequest struct {
+	// padding: 1 bytes
+	SrcDrawable Drawable
+	DstDrawable Drawable
+	Gc          Gcontext
+	SrcX        int16
+	SrcY        int16
+	DstX        int16
+	DstY        int16
+	Width       uint16
+	Height      uint16
+	BitPlane    uint32
+}
+
+// copyPlaneRequestRead reads a byte slice in the byte order 'order' into a CopyPlaneRequest value.
+func copyPlaneRequestRead(buf []byte, order xgb.ByteOrder) *CopyPlaneRequest {
+	v := new(CopyPlaneRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.SrcDrawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.DstDrawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.SrcX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.SrcY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.DstX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.DstY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	v.BitPlane = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[63] = xgb.RequestInfo{
+		Name: "CopyPlane",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return copyPlaneRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateColormapCookie is a cookie used only for CreateColormap requests.
 type CreateColormapCookie struct {
 	*xgb.Cookie
 }
 
+// CreateColormap create a colormap.
+//
+// Creates a colormap of the specified `visual` type for the screen on which
+// `window` resides.
+//
+// Parameters:
+//
+//   - Alloc: Whether no entries or all entries of the colormap are allocated
+//     to this client.
+//   - Mid: The ID with which you will refer to the colormap, created by
+//     NewColormapId.
+//   - Window: The window whose screen the colormap is created for.
+//   - Visual: The visual type of the colormap.
+//
 // CreateColormap sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func CreateColormap(c *xgb.Conn, Alloc byte, Mid Colormap, Window Window, Visual Visualid) CreateColormapCookie {
+func CreateColormap(c *xgb.Conn, Alloc ColormapAlloc, Mid Colormap, Window Window, Visual Visualid) CreateColormapCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(createColormapRequest(c, Alloc, Mid, Window, Visual), cookie)
 	return CreateColormapCookie{cookie}
@@ -8073,7 +11786,7 @@ func CreateColormap(c *xgb.Conn, Alloc byte, Mid Colormap, Window Window, Visual
 
 // CreateColormapChecked sends a checked request.
 // If an error occurs, it can be retrieved using CreateColormapCookie.Check()
-func CreateColormapChecked(c *xgb.Conn, Alloc byte, Mid Colormap, Window Window, Visual Visualid) CreateColormapCookie {
+func CreateColormapChecked(c *xgb.Conn, Alloc ColormapAlloc, Mid Colormap, Window Window, Visual Visualid) CreateColormapCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(createColormapRequest(c, Alloc, Mid, Window, Visual), cookie)
 	return CreateColormapCookie{cookie}
@@ -8085,34 +11798,87 @@ func (cook CreateColormapCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateColormapCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateColormap
 // createColormapRequest writes a CreateColormap request to a byte slice.
-func createColormapRequest(c *xgb.Conn, Alloc byte, Mid Colormap, Window Window, Visual Visualid) []byte {
+func createColormapRequest(c *xgb.Conn, Alloc ColormapAlloc, Mid Colormap, Window Window, Visual Visualid) []byte {
+	return AppendCreateColormapRequest(nil, c, Alloc, Mid, Window, Visual)
+}
+
+// AppendCreateColormapRequest appends a CreateColormap request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateColormapRequest(buf []byte, c *xgb.Conn, Alloc ColormapAlloc, Mid Colormap, Window Window, Visual Visualid) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 78 // request opcode
 	b += 1
 
-	buf[b] = Alloc
+	buf[b] = byte(Alloc)
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Mid))
+	order.Put32(buf[b:], uint32(Mid))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Visual))
+	order.Put32(buf[b:], uint32(Visual))
 	b += 4
 
 	return buf
 }
 
+// CreateColormapRequest represents the data of a CreateColormap request, as it is read when tracing a connection.
+type CreateColormapRequest struct {
+	Alloc  ColormapAlloc
+	Mid    Colormap
+	Window Window
+	Visual Visualid
+}
+
+// createColormapRequestRead reads a byte slice in the byte order 'order' into a CreateColormapRequest value.
+func createColormapRequestRead(buf []byte, order xgb.ByteOrder) *CreateColormapRequest {
+	v := new(CreateColormapRequest)
+	b := 1 // skip request opcode
+
+	v.Alloc = ColormapAlloc(buf[b])
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Mid = Colormap(order.Get32(buf[b:]))
+	b += 4
+
+	v.Window = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Visual = Visualid(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[78] = xgb.RequestInfo{
+		Name: "CreateColormap",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createColormapRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateCursorCookie is a cookie used only for CreateCursor requests.
 type CreateCursorCookie struct {
 	*xgb.Cookie
@@ -8140,65 +11906,173 @@ func (cook CreateCursorCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateCursorCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateCursor
 // createCursorRequest writes a CreateCursor request to a byte slice.
 func createCursorRequest(c *xgb.Conn, Cid Cursor, Source Pixmap, Mask Pixmap, ForeRed uint16, ForeGreen uint16, ForeBlue uint16, BackRed uint16, BackGreen uint16, BackBlue uint16, X uint16, Y uint16) []byte {
+	return AppendCreateCursorRequest(nil, c, Cid, Source, Mask, ForeRed, ForeGreen, ForeBlue, BackRed, BackGreen, BackBlue, X, Y)
+}
+
+// AppendCreateCursorRequest appends a CreateCursor request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateCursorRequest(buf []byte, c *xgb.Conn, Cid Cursor, Source Pixmap, Mask Pixmap, ForeRed uint16, ForeGreen uint16, ForeBlue uint16, BackRed uint16, BackGreen uint16, BackBlue uint16, X uint16, Y uint16) []byte {
 	size := 32
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 93 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Cid))
+	order.Put32(buf[b:], uint32(Cid))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Source))
+	order.Put32(buf[b:], uint32(Source))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Mask))
+	order.Put32(buf[b:], uint32(Mask))
 	b += 4
 
-	xgb.Put16(buf[b:], ForeRed)
+	order.Put16(buf[b:], ForeRed)
 	b += 2
 
-	xgb.Put16(buf[b:], ForeGreen)
+	order.Put16(buf[b:], ForeGreen)
 	b += 2
 
-	xgb.Put16(buf[b:], ForeBlue)
+	order.Put16(buf[b:], ForeBlue)
 	b += 2
 
-	xgb.Put16(buf[b:], BackRed)
+	order.Put16(buf[b:], BackRed)
 	b += 2
 
-	xgb.Put16(buf[b:], BackGreen)
+	order.Put16(buf[b:], BackGreen)
 	b += 2
 
-	xgb.Put16(buf[b:], BackBlue)
+	order.Put16(buf[b:], BackBlue)
 	b += 2
 
-	xgb.Put16(buf[b:], X)
+	order.Put16(buf[b:], X)
 	b += 2
 
-	xgb.Put16(buf[b:], Y)
+	order.Put16(buf[b:], Y)
 	b += 2
 
 	return buf
 }
 
+// CreateCursorRequest represents the data of a CreateCursor request, as it is read when tracing a connection.
+type CreateCursorRequest struct {
+	// padding: 1 bytes
+	Cid       Cursor
+	Source    Pixmap
+	Mask      Pixmap
+	ForeRed   uint16
+	ForeGreen uint16
+	ForeBlue  uint16
+	BackRed   uint16
+	BackGreen uint16
+	BackBlue  uint16
+	X         uint16
+	Y         uint16
+}
+
+// createCursorRequestRead reads a byte slice in the byte order 'order' into a CreateCursorRequest value.
+func createCursorRequestRead(buf []byte, order xgb.ByteOrder) *CreateCursorRequest {
+	v := new(CreateCursorRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Cid = Cursor(order.Get32(buf[b:]))
+	b += 4
+
+	v.Source = Pixmap(order.Get32(buf[b:]))
+	b += 4
+
+	v.Mask = Pixmap(order.Get32(buf[b:]))
+	b += 4
+
+	v.ForeRed = order.Get16(buf[b:])
+	b += 2
+
+	v.ForeGreen = order.Get16(buf[b:])
+	b += 2
+
+	v.ForeBlue = order.Get16(buf[b:])
+	b += 2
+
+	v.BackRed = order.Get16(buf[b:])
+	b += 2
+
+	v.BackGreen = order.Get16(buf[b:])
+	b += 2
+
+	v.BackBlue = order.Get16(buf[b:])
+	b += 2
+
+	v.X = order.Get16(buf[b:])
+	b += 2
+
+	v.Y = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[93] = xgb.RequestInfo{
+		Name: "CreateCursor",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createCursorRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateGCCookie is a cookie used only for CreateGC requests.
 type CreateGCCookie struct {
 	*xgb.Cookie
 }
 
+// CreateGC creates a graphics context.
+//
+// Creates a graphics context. The graphics context can be used with any
+// drawable that has the same root and depth as the specified drawable.
+//
+// Parameters:
+//
+//   - Cid: The ID with which you will refer to the graphics context, created
+//     by NewGcontextId.
+//   - Drawable: Drawable to get the root/depth from.
+//
+// Errors:
+//
+//   - DrawableError: The specified `drawable` (Window or Pixmap) does not
+//     exist.
+//   - MatchError: TODO: reasons?
+//   - FontError: TODO: reasons?
+//   - PixmapError: TODO: reasons?
+//   - ValueError: TODO: reasons?
+//   - AllocError: The X server could not allocate the requested resources (no
+//     memory?).
+//
+// See also FreeGC.
+//
 // CreateGC sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func CreateGC(c *xgb.Conn, Cid Gcontext, Drawable Drawable, ValueMask uint32, ValueList []uint32) CreateGCCookie {
+func CreateGC(c *xgb.Conn, Cid Gcontext, Drawable Drawable, ValueMask Gc, ValueList []uint32) CreateGCCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(createGCRequest(c, Cid, Drawable, ValueMask, ValueList), cookie)
 	return CreateGCCookie{cookie}
@@ -8206,7 +12080,7 @@ func CreateGC(c *xgb.Conn, Cid Gcontext, Drawable Drawable, ValueMask uint32, Va
 
 // CreateGCChecked sends a checked request.
 // If an error occurs, it can be retrieved using CreateGCCookie.Check()
-func CreateGCChecked(c *xgb.Conn, Cid Gcontext, Drawable Drawable, ValueMask uint32, ValueList []uint32) CreateGCCookie {
+func CreateGCChecked(c *xgb.Conn, Cid Gcontext, Drawable Drawable, ValueMask Gc, ValueList []uint32) CreateGCCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(createGCRequest(c, Cid, Drawable, ValueMask, ValueList), cookie)
 	return CreateGCCookie{cookie}
@@ -8218,31 +12092,45 @@ func (cook CreateGCCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateGCCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateGC
 // createGCRequest writes a CreateGC request to a byte slice.
-func createGCRequest(c *xgb.Conn, Cid Gcontext, Drawable Drawable, ValueMask uint32, ValueList []uint32) []byte {
+func createGCRequest(c *xgb.Conn, Cid Gcontext, Drawable Drawable, ValueMask Gc, ValueList []uint32) []byte {
+	return AppendCreateGCRequest(nil, c, Cid, Drawable, ValueMask, ValueList)
+}
+
+// AppendCreateGCRequest appends a CreateGC request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateGCRequest(buf []byte, c *xgb.Conn, Cid Gcontext, Drawable Drawable, ValueMask Gc, ValueList []uint32) []byte {
 	size := xgb.Pad((12 + (4 + xgb.Pad((4 * xgb.PopCount(int(ValueMask)))))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 55 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Cid))
+	order.Put32(buf[b:], uint32(Cid))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], ValueMask)
+	order.Put32(buf[b:], uint32(ValueMask))
 	b += 4
 	for i := 0; i < xgb.PopCount(int(ValueMask)); i++ {
-		xgb.Put32(buf[b:], ValueList[i])
+		order.Put32(buf[b:], ValueList[i])
 		b += 4
 	}
 	b = xgb.Pad(b)
@@ -8250,11 +12138,94 @@ func createGCRequest(c *xgb.Conn, Cid Gcontext, Drawable Drawable, ValueMask uin
 	return buf
 }
 
+// CreateGCRequest represents the data of a CreateGC request, as it is read when tracing a connection.
+type CreateGCRequest struct {
+	// padding: 1 bytes
+	Cid       Gcontext
+	Drawable  Drawable
+	ValueMask Gc
+	ValueList []uint32
+}
+
+// createGCRequestRead reads a byte slice in the byte order 'order' into a CreateGCRequest value.
+func createGCRequestRead(buf []byte, order xgb.ByteOrder) *CreateGCRequest {
+	v := new(CreateGCRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Cid = Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.ValueMask = Gc(order.Get32(buf[b:]))
+	b += 4
+
+	v.ValueList = make([]uint32, xgb.PopCount(int(v.ValueMask)))
+	for i := 0; i < xgb.PopCount(int(v.ValueMask)); i++ {
+		v.ValueList[i] = order.Get32(buf[b:])
+		b += 4
+	}
+	b = xgb.Pad(b)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[55] = xgb.RequestInfo{
+		Name: "CreateGC",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createGCRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateGlyphCursorCookie is a cookie used only for CreateGlyphCursor requests.
 type CreateGlyphCursorCookie struct {
 	*xgb.Cookie
 }
 
+// CreateGlyphCursor create cursor.
+//
+// Creates a cursor from a font glyph. X provides a set of standard cursor
+// shapes in a special font named cursor. Applications are encouraged to use
+// this interface for their cursors because the font can be customized for the
+// individual display type.
+//
+// All pixels which are set to 1 in the source will use the foreground color
+// (as specified by `fore_red`, `fore_green` and `fore_blue`). All pixels set
+// to 0 will use the background color (as specified by `back_red`, `back_green`
+// and `back_blue`).
+//
+// Parameters:
+//
+//   - Cid: The ID with which you will refer to the cursor, created by
+//     NewCursorId.
+//   - SourceFont: In which font to look for the cursor glyph.
+//   - MaskFont: In which font to look for the mask glyph.
+//   - SourceChar: The glyph of `source_font` to use.
+//   - MaskChar: The glyph of `mask_font` to use as a mask: Pixels which are
+//     set to 1 define which source pixels are displayed. All pixels which are
+//     set to 0 are not displayed.
+//   - ForeRed: The red value of the foreground color.
+//   - ForeGreen: The green value of the foreground color.
+//   - ForeBlue: The blue value of the foreground color.
+//   - BackRed: The red value of the background color.
+//   - BackGreen: The green value of the background color.
+//   - BackBlue: The blue value of the background color.
+//
+// Errors:
+//
+//   - AllocError: The X server could not allocate the requested resources (no
+//     memory?).
+//   - FontError: The specified `source_font` or `mask_font` does not exist.
+//   - ValueError: Either `source_char` or `mask_char` are not defined in
+//     `source_font` or `mask_font`, respectively.
+//
 // CreateGlyphCursor sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
 func CreateGlyphCursor(c *xgb.Conn, Cid Cursor, SourceFont Font, MaskFont Font, SourceChar uint16, MaskChar uint16, ForeRed uint16, ForeGreen uint16, ForeBlue uint16, BackRed uint16, BackGreen uint16, BackBlue uint16) CreateGlyphCursorCookie {
@@ -8277,62 +12248,170 @@ func (cook CreateGlyphCursorCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateGlyphCursorCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreateGlyphCursor
 // createGlyphCursorRequest writes a CreateGlyphCursor request to a byte slice.
 func createGlyphCursorRequest(c *xgb.Conn, Cid Cursor, SourceFont Font, MaskFont Font, SourceChar uint16, MaskChar uint16, ForeRed uint16, ForeGreen uint16, ForeBlue uint16, BackRed uint16, BackGreen uint16, BackBlue uint16) []byte {
+	return AppendCreateGlyphCursorRequest(nil, c, Cid, SourceFont, MaskFont, SourceChar, MaskChar, ForeRed, ForeGreen, ForeBlue, BackRed, BackGreen, BackBlue)
+}
+
+// AppendCreateGlyphCursorRequest appends a CreateGlyphCursor request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateGlyphCursorRequest(buf []byte, c *xgb.Conn, Cid Cursor, SourceFont Font, MaskFont Font, SourceChar uint16, MaskChar uint16, ForeRed uint16, ForeGreen uint16, ForeBlue uint16, BackRed uint16, BackGreen uint16, BackBlue uint16) []byte {
 	size := 32
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 94 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Cid))
+	order.Put32(buf[b:], uint32(Cid))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(SourceFont))
+	order.Put32(buf[b:], uint32(SourceFont))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(MaskFont))
+	order.Put32(buf[b:], uint32(MaskFont))
 	b += 4
 
-	xgb.Put16(buf[b:], SourceChar)
+	order.Put16(buf[b:], SourceChar)
 	b += 2
 
-	xgb.Put16(buf[b:], MaskChar)
+	order.Put16(buf[b:], MaskChar)
 	b += 2
 
-	xgb.Put16(buf[b:], ForeRed)
+	order.Put16(buf[b:], ForeRed)
 	b += 2
 
-	xgb.Put16(buf[b:], ForeGreen)
+	order.Put16(buf[b:], ForeGreen)
 	b += 2
 
-	xgb.Put16(buf[b:], ForeBlue)
+	order.Put16(buf[b:], ForeBlue)
 	b += 2
 
-	xgb.Put16(buf[b:], BackRed)
+	order.Put16(buf[b:], BackRed)
 	b += 2
 
-	xgb.Put16(buf[b:], BackGreen)
+	order.Put16(buf[b:], BackGreen)
 	b += 2
 
-	xgb.Put16(buf[b:], BackBlue)
+	order.Put16(buf[b:], BackBlue)
 	b += 2
 
 	return buf
 }
 
+// CreateGlyphCursorRequest represents the data of a CreateGlyphCursor request, as it is read when tracing a connection.
+type CreateGlyphCursorRequest struct {
+	// padding: 1 bytes
+	Cid        Cursor
+	SourceFont Font
+	MaskFont   Font
+	SourceChar uint16
+	MaskChar   uint16
+	ForeRed    uint16
+	ForeGreen  uint16
+	ForeBlue   uint16
+	BackRed    uint16
+	BackGreen  uint16
+	BackBlue   uint16
+}
+
+// createGlyphCursorRequestRead reads a byte slice in the byte order 'order' into a CreateGlyphCursorRequest value.
+func createGlyphCursorRequestRead(buf []byte, order xgb.ByteOrder) *CreateGlyphCursorRequest {
+	v := new(CreateGlyphCursorRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Cid = Cursor(order.Get32(buf[b:]))
+	b += 4
+
+	v.SourceFont = Font(order.Get32(buf[b:]))
+	b += 4
+
+	v.MaskFont = Font(order.Get32(buf[b:]))
+	b += 4
+
+	v.SourceChar = order.Get16(buf[b:])
+	b += 2
+
+	v.MaskChar = order.Get16(buf[b:])
+	b += 2
+
+	v.ForeRed = order.Get16(buf[b:])
+	b += 2
+
+	v.ForeGreen = order.Get16(buf[b:])
+	b += 2
+
+	v.ForeBlue = order.Get16(buf[b:])
+	b += 2
+
+	v.BackRed = order.Get16(buf[b:])
+	b += 2
+
+	v.BackGreen = order.Get16(buf[b:])
+	b += 2
+
+	v.BackBlue = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[94] = xgb.RequestInfo{
+		Name: "CreateGlyphCursor",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createGlyphCursorRequestRead(buf, order)
+		},
+	}
+}
+
 // CreatePixmapCookie is a cookie used only for CreatePixmap requests.
 type CreatePixmapCookie struct {
 	*xgb.Cookie
 }
 
+// CreatePixmap creates a pixmap.
+//
+// Creates a pixmap. The pixmap can only be used on the same screen as
+// `drawable` is on and only with drawables of the same `depth`.
+//
+// Parameters:
+//
+//   - Depth: TODO
+//   - Pid: The ID with which you will refer to the new pixmap, created by
+//     NewPixmapId.
+//   - Drawable: Drawable to get the screen from.
+//   - Width: The width of the new pixmap.
+//   - Height: The height of the new pixmap.
+//
+// Errors:
+//
+//   - ValueError: TODO: reasons?
+//   - DrawableError: The specified `drawable` (Window or Pixmap) does not
+//     exist.
+//   - AllocError: The X server could not allocate the requested resources (no
+//     memory?).
+//
+// See also FreePixmap.
+//
 // CreatePixmap sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
 func CreatePixmap(c *xgb.Conn, Depth byte, Pid Pixmap, Drawable Drawable, Width uint16, Height uint16) CreatePixmapCookie {
@@ -8355,12 +12434,26 @@ func (cook CreatePixmapCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreatePixmapCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for CreatePixmap
 // createPixmapRequest writes a CreatePixmap request to a byte slice.
 func createPixmapRequest(c *xgb.Conn, Depth byte, Pid Pixmap, Drawable Drawable, Width uint16, Height uint16) []byte {
+	return AppendCreatePixmapRequest(nil, c, Depth, Pid, Drawable, Width, Height)
+}
+
+// AppendCreatePixmapRequest appends a CreatePixmap request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreatePixmapRequest(buf []byte, c *xgb.Conn, Depth byte, Pid Pixmap, Drawable Drawable, Width uint16, Height uint16) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 53 // request opcode
 	b += 1
@@ -8368,32 +12461,125 @@ func createPixmapRequest(c *xgb.Conn, Depth byte, Pid Pixmap, Drawable Drawable,
 	buf[b] = Depth
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Pid))
+	order.Put32(buf[b:], uint32(Pid))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put16(buf[b:], Width)
+	order.Put16(buf[b:], Width)
 	b += 2
 
-	xgb.Put16(buf[b:], Height)
+	order.Put16(buf[b:], Height)
 	b += 2
 
 	return buf
 }
 
+// CreatePixmapRequest represents the data of a CreatePixmap request, as it is read when tracing a connection.
+type CreatePixmapRequest struct {
+	Depth    byte
+	Pid      Pixmap
+	Drawable Drawable
+	Width    uint16
+	Height   uint16
+}
+
+// createPixmapRequestRead reads a byte slice in the byte order 'order' into a CreatePixmapRequest value.
+func createPixmapRequestRead(buf []byte, order xgb.ByteOrder) *CreatePixmapRequest {
+	v := new(CreatePixmapRequest)
+	b := 1 // skip request opcode
+
+	v.Depth = buf[b]
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Pid = Pixmap(order.Get32(buf[b:]))
+	b += 4
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[53] = xgb.RequestInfo{
+		Name: "CreatePixmap",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createPixmapRequestRead(buf, order)
+		},
+	}
+}
+
 // CreateWindowCookie is a cookie used only for CreateWindow requests.
 type CreateWindowCookie struct {
 	*xgb.Cookie
 }
 
+// CreateWindow creates a window.
+//
+// Creates an unmapped window as child of the specified `parent` window. A
+// CreateNotify event will be generated. The new window is placed on top in the
+// stacking order with respect to siblings.
+//
+// The coordinate system has the X axis horizontal and the Y axis vertical with
+// the origin [0, 0] at the upper-left corner. Coordinates are integral, in
+// terms of pixels, and coincide with pixel centers. Each window and pixmap has
+// its own coordinate system. For a window, the origin is inside the border at
+// the inside, upper-left corner.
+//
+// The created window is not yet displayed (mapped), call MapWindow to display
+// it.
+//
+// Parameters:
+//
+//   - Depth: Specifies the new window's depth. The special value
+//     `XCB_COPY_FROM_PARENT` means the depth is taken from the `parent`
+//     window.
+//   - Wid: The ID with which you will refer to the new window, created by
+//     NewWindowId.
+//   - Parent: The parent window of the new window.
+//   - X: The X coordinate of the new window.
+//   - Y: The Y coordinate of the new window.
+//   - Width: The width of the new window.
+//   - Height: The height of the new window.
+//   - BorderWidth: Must be zero if the `class` is `InputOnly` or a
+//     `xcb_match_error_t` occurs.
+//   - Class: Whether the window is an input and output window, or input only.
+//   - Visual: Specifies the id for the new window's visual. The special value
+//     `XCB_COPY_FROM_PARENT` means the visual is taken from the `parent`
+//     window.
+//
+// Errors:
+//
+//   - AllocError: The X server could not allocate the requested resources (no
+//     memory?).
+//   - ColormapError: A colormap in the value list does not exist.
+//   - CursorError: A cursor in the value list does not exist.
+//   - IDChoiceError: The `wid` is not in the range assigned to this client, or
+//     is already in use.
+//   - MatchError: The `border_width` of an `InputOnly` window is not zero, or
+//     a value does not match the window's depth or visual.
+//   - PixmapError: A pixmap in the value list does not exist.
+//   - ValueError: A value is out of range.
+//   - WindowError: The `parent` window does not exist.
+//
+// See also CreateNotifyEvent, MapWindow.
+//
 // CreateWindow sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func CreateWindow(c *xgb.Conn, Depth byte, Wid Window, Parent Window, X int16, Y int16, Width uint16, Height uint16, BorderWidth uint16, Class uint16, Visual Visualid, ValueMask uint32, ValueList []uint32) CreateWindowCookie {
+func CreateWindow(c *xgb.Conn, Depth byte, Wid Window, Parent Window, X int16, Y int16, Width uint16, Height uint16, BorderWidth uint16, Class WindowClass, Visual Visualid, ValueMask Cw, ValueList []uint32) CreateWindowCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(createWindowRequest(c, Depth, Wid, Parent, X, Y, Width, Height, BorderWidth, Class, Visual, ValueMask, ValueList), cookie)
 	return CreateWindowCookie{cookie}
@@ -8401,70 +12587,159 @@ func CreateWindow(c *xgb.Conn, Depth byte, Wid Window, Parent Window, X int16, Y
 
 // CreateWindowChecked sends a checked request.
 // If an error occurs, it can be retrieved using CreateWindowCookie.Check()
-func CreateWindowChecked(c *xgb.Conn, Depth byte, Wid Window, Parent Window, X int16, Y int16, Width uint16, Height uint16, BorderWidth uint16, Class uint16, Visual Visualid, ValueMask uint32, ValueList []uint32) CreateWindowCookie {
+func CreateWindowChecked(c *xgb.Conn, Depth byte, Wid Window, Parent Window, X int16, Y int16, Width uint16, Height uint16, BorderWidth uint16, Class WindowClass, Visual Visualid, ValueMask Cw, ValueList []uint32) CreateWindowCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(createWindowRequest(c, Depth, Wid, Parent, X, Y, Width, Height, BorderWidth, Class, Visual, ValueMask, ValueList), cookie)
 	return CreateWindowCookie{cookie}
 }
 
-// Check returns an error if one occurred for checked requests that are not expecting a reply.
-// This cannot be called for requests expecting a reply, nor for unchecked requests.
-func (cook CreateWindowCookie) Check() error {
-	return cook.Cookie.Check()
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook CreateWindowCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook CreateWindowCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for CreateWindow
+// createWindowRequest writes a CreateWindow request to a byte slice.
+func createWindowRequest(c *xgb.Conn, Depth byte, Wid Window, Parent Window, X int16, Y int16, Width uint16, Height uint16, BorderWidth uint16, Class WindowClass, Visual Visualid, ValueMask Cw, ValueList []uint32) []byte {
+	return AppendCreateWindowRequest(nil, c, Depth, Wid, Parent, X, Y, Width, Height, BorderWidth, Class, Visual, ValueMask, ValueList)
+}
+
+// AppendCreateWindowRequest appends a CreateWindow request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateWindowRequest(buf []byte, c *xgb.Conn, Depth byte, Wid Window, Parent Window, X int16, Y int16, Width uint16, Height uint16, BorderWidth uint16, Class WindowClass, Visual Visualid, ValueMask Cw, ValueList []uint32) []byte {
+	size := xgb.Pad((28 + (4 + xgb.Pad((4 * xgb.PopCount(int(ValueMask)))))))
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	buf[b] = 1 // request opcode
+	b += 1
+
+	buf[b] = Depth
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Wid))
+	b += 4
+
+	order.Put32(buf[b:], uint32(Parent))
+	b += 4
+
+	order.Put16(buf[b:], uint16(X))
+	b += 2
+
+	order.Put16(buf[b:], uint16(Y))
+	b += 2
+
+	order.Put16(buf[b:], Width)
+	b += 2
+
+	order.Put16(buf[b:], Height)
+	b += 2
+
+	order.Put16(buf[b:], BorderWidth)
+	b += 2
+
+	order.Put16(buf[b:], uint16(Class))
+	b += 2
+
+	order.Put32(buf[b:], uint32(Visual))
+	b += 4
+
+	order.Put32(buf[b:], uint32(ValueMask))
+	b += 4
+	for i := 0; i < xgb.PopCount(int(ValueMask)); i++ {
+		order.Put32(buf[b:], ValueList[i])
+		b += 4
+	}
+	b = xgb.Pad(b)
+
+	return buf
 }
 
-// Write request to wire for CreateWindow
-// createWindowRequest writes a CreateWindow request to a byte slice.
-func createWindowRequest(c *xgb.Conn, Depth byte, Wid Window, Parent Window, X int16, Y int16, Width uint16, Height uint16, BorderWidth uint16, Class uint16, Visual Visualid, ValueMask uint32, ValueList []uint32) []byte {
-	size := xgb.Pad((28 + (4 + xgb.Pad((4 * xgb.PopCount(int(ValueMask)))))))
-	b := 0
-	buf := make([]byte, size)
+// CreateWindowRequest represents the data of a CreateWindow request, as it is read when tracing a connection.
+type CreateWindowRequest struct {
+	Depth       byte
+	Wid         Window
+	Parent      Window
+	X           int16
+	Y           int16
+	Width       uint16
+	Height      uint16
+	BorderWidth uint16
+	Class       WindowClass
+	Visual      Visualid
+	ValueMask   Cw
+	ValueList   []uint32
+}
 
-	buf[b] = 1 // request opcode
-	b += 1
+// createWindowRequestRead reads a byte slice in the byte order 'order' into a CreateWindowRequest value.
+func createWindowRequestRead(buf []byte, order xgb.ByteOrder) *CreateWindowRequest {
+	v := new(CreateWindowRequest)
+	b := 1 // skip request opcode
 
-	buf[b] = Depth
+	v.Depth = buf[b]
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
-	b += 2
+	b += 2 // skip request size
 
-	xgb.Put32(buf[b:], uint32(Wid))
+	v.Wid = Window(order.Get32(buf[b:]))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Parent))
+	v.Parent = Window(order.Get32(buf[b:]))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(X))
+	v.X = int16(order.Get16(buf[b:]))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(Y))
+	v.Y = int16(order.Get16(buf[b:]))
 	b += 2
 
-	xgb.Put16(buf[b:], Width)
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	xgb.Put16(buf[b:], Height)
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
-	xgb.Put16(buf[b:], BorderWidth)
+	v.BorderWidth = order.Get16(buf[b:])
 	b += 2
 
-	xgb.Put16(buf[b:], Class)
+	v.Class = WindowClass(order.Get16(buf[b:]))
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Visual))
+	v.Visual = Visualid(order.Get32(buf[b:]))
 	b += 4
 
-	xgb.Put32(buf[b:], ValueMask)
+	v.ValueMask = Cw(order.Get32(buf[b:]))
 	b += 4
-	for i := 0; i < xgb.PopCount(int(ValueMask)); i++ {
-		xgb.Put32(buf[b:], ValueList[i])
+
+	v.ValueList = make([]uint32, xgb.PopCount(int(v.ValueMask)))
+	for i := 0; i < xgb.PopCount(int(v.ValueMask)); i++ {
+		v.ValueList[i] = order.Get32(buf[b:])
 		b += 4
 	}
 	b = xgb.Pad(b)
 
-	return buf
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[1] = xgb.RequestInfo{
+		Name: "CreateWindow",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createWindowRequestRead(buf, order)
+		},
+	}
 }
 
 // DeletePropertyCookie is a cookie used only for DeleteProperty requests.
@@ -8494,30 +12769,78 @@ func (cook DeletePropertyCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DeletePropertyCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DeleteProperty
 // deletePropertyRequest writes a DeleteProperty request to a byte slice.
 func deletePropertyRequest(c *xgb.Conn, Window Window, Property Atom) []byte {
+	return AppendDeletePropertyRequest(nil, c, Window, Property)
+}
+
+// AppendDeletePropertyRequest appends a DeleteProperty request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDeletePropertyRequest(buf []byte, c *xgb.Conn, Window Window, Property Atom) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 19 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Property))
+	order.Put32(buf[b:], uint32(Property))
 	b += 4
 
 	return buf
 }
 
+// DeletePropertyRequest represents the data of a DeleteProperty request, as it is read when tracing a connection.
+type DeletePropertyRequest struct {
+	// padding: 1 bytes
+	Window   Window
+	Property Atom
+}
+
+// deletePropertyRequestRead reads a byte slice in the byte order 'order' into a DeletePropertyRequest value.
+func deletePropertyRequestRead(buf []byte, order xgb.ByteOrder) *DeletePropertyRequest {
+	v := new(DeletePropertyRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Window = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Property = Atom(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[19] = xgb.RequestInfo{
+		Name: "DeleteProperty",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return deletePropertyRequestRead(buf, order)
+		},
+	}
+}
+
 // DestroySubwindowsCookie is a cookie used only for DestroySubwindows requests.
 type DestroySubwindowsCookie struct {
 	*xgb.Cookie
@@ -8545,32 +12868,95 @@ func (cook DestroySubwindowsCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DestroySubwindowsCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DestroySubwindows
 // destroySubwindowsRequest writes a DestroySubwindows request to a byte slice.
 func destroySubwindowsRequest(c *xgb.Conn, Window Window) []byte {
+	return AppendDestroySubwindowsRequest(nil, c, Window)
+}
+
+// AppendDestroySubwindowsRequest appends a DestroySubwindows request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDestroySubwindowsRequest(buf []byte, c *xgb.Conn, Window Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 5 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// DestroySubwindowsRequest represents the data of a DestroySubwindows request, as it is read when tracing a connection.
+type DestroySubwindowsRequest struct {
+	// padding: 1 bytes
+	Window Window
+}
+
+// destroySubwindowsRequestRead reads a byte slice in the byte order 'order' into a DestroySubwindowsRequest value.
+func destroySubwindowsRequestRead(buf []byte, order xgb.ByteOrder) *DestroySubwindowsRequest {
+	v := new(DestroySubwindowsRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Window = Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[5] = xgb.RequestInfo{
+		Name: "DestroySubwindows",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return destroySubwindowsRequestRead(buf, order)
+		},
+	}
+}
+
 // DestroyWindowCookie is a cookie used only for DestroyWindow requests.
 type DestroyWindowCookie struct {
 	*xgb.Cookie
 }
 
+// DestroyWindow destroys a window.
+//
+// Destroys the specified window and all of its subwindows. A DestroyNotify
+// event is generated for each destroyed window (a DestroyNotify event is first
+// generated for any given window's inferiors). If the window was mapped, it
+// will be automatically unmapped before destroying.
+//
+// Calling DestroyWindow on the root window will do nothing.
+//
+// Parameters:
+//
+//   - Window: The window to destroy.
+//
+// Errors:
+//
+//   - WindowError: The specified window does not exist.
+//
+// See also DestroyNotifyEvent, MapWindow, UnmapWindow.
+//
 // DestroyWindow sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
 func DestroyWindow(c *xgb.Conn, Window Window) DestroyWindowCookie {
@@ -8593,27 +12979,71 @@ func (cook DestroyWindowCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DestroyWindowCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DestroyWindow
 // destroyWindowRequest writes a DestroyWindow request to a byte slice.
 func destroyWindowRequest(c *xgb.Conn, Window Window) []byte {
+	return AppendDestroyWindowRequest(nil, c, Window)
+}
+
+// AppendDestroyWindowRequest appends a DestroyWindow request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDestroyWindowRequest(buf []byte, c *xgb.Conn, Window Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 4 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// DestroyWindowRequest represents the data of a DestroyWindow request, as it is read when tracing a connection.
+type DestroyWindowRequest struct {
+	// padding: 1 bytes
+	Window Window
+}
+
+// destroyWindowRequestRead reads a byte slice in the byte order 'order' into a DestroyWindowRequest value.
+func destroyWindowRequestRead(buf []byte, order xgb.ByteOrder) *DestroyWindowRequest {
+	v := new(DestroyWindowRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Window = Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[4] = xgb.RequestInfo{
+		Name: "DestroyWindow",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return destroyWindowRequestRead(buf, order)
+		},
+	}
+}
+
 // FillPolyCookie is a cookie used only for FillPoly requests.
 type FillPolyCookie struct {
 	*xgb.Cookie
@@ -8621,7 +13051,7 @@ type FillPolyCookie struct {
 
 // FillPoly sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func FillPoly(c *xgb.Conn, Drawable Drawable, Gc Gcontext, Shape byte, CoordinateMode byte, Points []Point) FillPolyCookie {
+func FillPoly(c *xgb.Conn, Drawable Drawable, Gc Gcontext, Shape PolyShape, CoordinateMode CoordMode, Points []Point) FillPolyCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(fillPolyRequest(c, Drawable, Gc, Shape, CoordinateMode, Points), cookie)
 	return FillPolyCookie{cookie}
@@ -8629,7 +13059,7 @@ func FillPoly(c *xgb.Conn, Drawable Drawable, Gc Gcontext, Shape byte, Coordinat
 
 // FillPolyChecked sends a checked request.
 // If an error occurs, it can be retrieved using FillPolyCookie.Check()
-func FillPolyChecked(c *xgb.Conn, Drawable Drawable, Gc Gcontext, Shape byte, CoordinateMode byte, Points []Point) FillPolyCookie {
+func FillPolyChecked(c *xgb.Conn, Drawable Drawable, Gc Gcontext, Shape PolyShape, CoordinateMode CoordMode, Points []Point) FillPolyCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(fillPolyRequest(c, Drawable, Gc, Shape, CoordinateMode, Points), cookie)
 	return FillPolyCookie{cookie}
@@ -8641,40 +13071,103 @@ func (cook FillPolyCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook FillPolyCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for FillPoly
 // fillPolyRequest writes a FillPoly request to a byte slice.
-func fillPolyRequest(c *xgb.Conn, Drawable Drawable, Gc Gcontext, Shape byte, CoordinateMode byte, Points []Point) []byte {
+func fillPolyRequest(c *xgb.Conn, Drawable Drawable, Gc Gcontext, Shape PolyShape, CoordinateMode CoordMode, Points []Point) []byte {
+	return AppendFillPolyRequest(nil, c, Drawable, Gc, Shape, CoordinateMode, Points)
+}
+
+// AppendFillPolyRequest appends a FillPoly request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendFillPolyRequest(buf []byte, c *xgb.Conn, Drawable Drawable, Gc Gcontext, Shape PolyShape, CoordinateMode CoordMode, Points []Point) []byte {
 	size := xgb.Pad((16 + xgb.Pad((len(Points) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 69 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	buf[b] = Shape
+	buf[b] = byte(Shape)
 	b += 1
 
-	buf[b] = CoordinateMode
+	buf[b] = byte(CoordinateMode)
 	b += 1
 
 	b += 2 // padding
 
-	b += PointListBytes(buf[b:], Points)
+	b += PointListBytes(buf[b:], order, Points)
 
 	return buf
 }
 
+// FillPolyRequest represents the data of a FillPoly request, as it is read when tracing a connection.
+type FillPolyRequest struct {
+	// padding: 1 bytes
+	Drawable       Drawable
+	Gc             Gcontext
+	Shape          PolyShape
+	CoordinateMode CoordMode
+	// padding: 2 bytes
+	Points []Point // size: xgb.Pad((len(Points) * 4))
+}
+
+// fillPolyRequestRead reads a byte slice in the byte order 'order' into a FillPolyRequest value.
+func fillPolyRequestRead(buf []byte, order xgb.ByteOrder) *FillPolyRequest {
+	v := new(FillPolyRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.Shape = PolyShape(buf[b])
+	b += 1
+
+	v.CoordinateMode = CoordMode(buf[b])
+	b += 1
+
+	b += 2 // padding
+
+	v.Points = make([]Point, (len(buf)-b)/4)
+	b += PointReadList(buf[b:], order, v.Points)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[69] = xgb.RequestInfo{
+		Name: "FillPoly",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return fillPolyRequestRead(buf, order)
+		},
+	}
+}
+
 // ForceScreenSaverCookie is a cookie used only for ForceScreenSaver requests.
 type ForceScreenSaverCookie struct {
 	*xgb.Cookie
@@ -8682,7 +13175,7 @@ type ForceScreenSaverCookie struct {
 
 // ForceScreenSaver sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func ForceScreenSaver(c *xgb.Conn, Mode byte) ForceScreenSaverCookie {
+func ForceScreenSaver(c *xgb.Conn, Mode ScreenSaver) ForceScreenSaverCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(forceScreenSaverRequest(c, Mode), cookie)
 	return ForceScreenSaverCookie{cookie}
@@ -8690,7 +13183,7 @@ func ForceScreenSaver(c *xgb.Conn, Mode byte) ForceScreenSaverCookie {
 
 // ForceScreenSaverChecked sends a checked request.
 // If an error occurs, it can be retrieved using ForceScreenSaverCookie.Check()
-func ForceScreenSaverChecked(c *xgb.Conn, Mode byte) ForceScreenSaverCookie {
+func ForceScreenSaverChecked(c *xgb.Conn, Mode ScreenSaver) ForceScreenSaverCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(forceScreenSaverRequest(c, Mode), cookie)
 	return ForceScreenSaverCookie{cookie}
@@ -8702,25 +13195,66 @@ func (cook ForceScreenSaverCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ForceScreenSaverCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ForceScreenSaver
 // forceScreenSaverRequest writes a ForceScreenSaver request to a byte slice.
-func forceScreenSaverRequest(c *xgb.Conn, Mode byte) []byte {
+func forceScreenSaverRequest(c *xgb.Conn, Mode ScreenSaver) []byte {
+	return AppendForceScreenSaverRequest(nil, c, Mode)
+}
+
+// AppendForceScreenSaverRequest appends a ForceScreenSaver request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendForceScreenSaverRequest(buf []byte, c *xgb.Conn, Mode ScreenSaver) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 115 // request opcode
 	b += 1
 
-	buf[b] = Mode
+	buf[b] = byte(Mode)
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// ForceScreenSaverRequest represents the data of a ForceScreenSaver request, as it is read when tracing a connection.
+type ForceScreenSaverRequest struct {
+	Mode ScreenSaver
+}
+
+// forceScreenSaverRequestRead reads a byte slice in the byte order 'order' into a ForceScreenSaverRequest value.
+func forceScreenSaverRequestRead(buf []byte, order xgb.ByteOrder) *ForceScreenSaverRequest {
+	v := new(ForceScreenSaverRequest)
+	b := 1 // skip request opcode
+
+	v.Mode = ScreenSaver(buf[b])
+	b += 1
+
+	b += 2 // skip request size
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[115] = xgb.RequestInfo{
+		Name: "ForceScreenSaver",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return forceScreenSaverRequestRead(buf, order)
+		},
+	}
+}
+
 // FreeColormapCookie is a cookie used only for FreeColormap requests.
 type FreeColormapCookie struct {
 	*xgb.Cookie
@@ -8748,27 +13282,71 @@ func (cook FreeColormapCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook FreeColormapCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for FreeColormap
 // freeColormapRequest writes a FreeColormap request to a byte slice.
 func freeColormapRequest(c *xgb.Conn, Cmap Colormap) []byte {
+	return AppendFreeColormapRequest(nil, c, Cmap)
+}
+
+// AppendFreeColormapRequest appends a FreeColormap request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendFreeColormapRequest(buf []byte, c *xgb.Conn, Cmap Colormap) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 79 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Cmap))
+	order.Put32(buf[b:], uint32(Cmap))
 	b += 4
 
 	return buf
 }
 
+// FreeColormapRequest represents the data of a FreeColormap request, as it is read when tracing a connection.
+type FreeColormapRequest struct {
+	// padding: 1 bytes
+	Cmap Colormap
+}
+
+// freeColormapRequestRead reads a byte slice in the byte order 'order' into a FreeColormapRequest value.
+func freeColormapRequestRead(buf []byte, order xgb.ByteOrder) *FreeColormapRequest {
+	v := new(FreeColormapRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Cmap = Colormap(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[79] = xgb.RequestInfo{
+		Name: "FreeColormap",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return freeColormapRequestRead(buf, order)
+		},
+	}
+}
+
 // FreeColorsCookie is a cookie used only for FreeColors requests.
 type FreeColorsCookie struct {
 	*xgb.Cookie
@@ -8796,40 +13374,108 @@ func (cook FreeColorsCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook FreeColorsCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for FreeColors
 // freeColorsRequest writes a FreeColors request to a byte slice.
 func freeColorsRequest(c *xgb.Conn, Cmap Colormap, PlaneMask uint32, Pixels []uint32) []byte {
+	return AppendFreeColorsRequest(nil, c, Cmap, PlaneMask, Pixels)
+}
+
+// AppendFreeColorsRequest appends a FreeColors request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendFreeColorsRequest(buf []byte, c *xgb.Conn, Cmap Colormap, PlaneMask uint32, Pixels []uint32) []byte {
 	size := xgb.Pad((12 + xgb.Pad((len(Pixels) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 88 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Cmap))
+	order.Put32(buf[b:], uint32(Cmap))
 	b += 4
 
-	xgb.Put32(buf[b:], PlaneMask)
+	order.Put32(buf[b:], PlaneMask)
 	b += 4
 
 	for i := 0; i < int(len(Pixels)); i++ {
-		xgb.Put32(buf[b:], Pixels[i])
+		order.Put32(buf[b:], Pixels[i])
 		b += 4
 	}
 
 	return buf
 }
 
+// FreeColorsRequest represents the data of a FreeColors request, as it is read when tracing a connection.
+type FreeColorsRequest struct {
+	// padding: 1 bytes
+	Cmap      Colormap
+	PlaneMask uint32
+	Pixels    []uint32 // size: xgb.Pad((len(Pixels) * 4))
+}
+
+// freeColorsRequestRead reads a byte slice in the byte order 'order' into a FreeColorsRequest value.
+func freeColorsRequestRead(buf []byte, order xgb.ByteOrder) *FreeColorsRequest {
+	v := new(FreeColorsRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Cmap = Colormap(order.Get32(buf[b:]))
+	b += 4
+
+	v.PlaneMask = order.Get32(buf[b:])
+	b += 4
+
+	v.Pixels = make([]uint32, (len(buf)-b)/4)
+	for i := 0; i < int((len(buf)-b)/4); i++ {
+		v.Pixels[i] = order.Get32(buf[b:])
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[88] = xgb.RequestInfo{
+		Name: "FreeColors",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return freeColorsRequestRead(buf, order)
+		},
+	}
+}
+
 // FreeCursorCookie is a cookie used only for FreeCursor requests.
 type FreeCursorCookie struct {
 	*xgb.Cookie
 }
 
+// FreeCursor deletes a cursor.
+//
+// Deletes the association between the cursor resource ID and the specified
+// cursor. The cursor is freed when no other resource references it.
+//
+// Parameters:
+//
+//   - Cursor: The cursor to destroy.
+//
+// Errors:
+//
+//   - CursorError: The specified cursor does not exist.
+//
 // FreeCursor sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
 func FreeCursor(c *xgb.Conn, Cursor Cursor) FreeCursorCookie {
@@ -8852,27 +13498,71 @@ func (cook FreeCursorCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook FreeCursorCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for FreeCursor
 // freeCursorRequest writes a FreeCursor request to a byte slice.
 func freeCursorRequest(c *xgb.Conn, Cursor Cursor) []byte {
+	return AppendFreeCursorRequest(nil, c, Cursor)
+}
+
+// AppendFreeCursorRequest appends a FreeCursor request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendFreeCursorRequest(buf []byte, c *xgb.Conn, Cursor Cursor) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 95 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Cursor))
+	order.Put32(buf[b:], uint32(Cursor))
 	b += 4
 
 	return buf
 }
 
+// FreeCursorRequest represents the data of a FreeCursor request, as it is read when tracing a connection.
+type FreeCursorRequest struct {
+	// padding: 1 bytes
+	Cursor Cursor
+}
+
+// freeCursorRequestRead reads a byte slice in the byte order 'order' into a FreeCursorRequest value.
+func freeCursorRequestRead(buf []byte, order xgb.ByteOrder) *FreeCursorRequest {
+	v := new(FreeCursorRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Cursor = Cursor(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[95] = xgb.RequestInfo{
+		Name: "FreeCursor",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return freeCursorRequestRead(buf, order)
+		},
+	}
+}
+
 // FreeGCCookie is a cookie used only for FreeGC requests.
 type FreeGCCookie struct {
 	*xgb.Cookie
@@ -8900,32 +13590,89 @@ func (cook FreeGCCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook FreeGCCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for FreeGC
 // freeGCRequest writes a FreeGC request to a byte slice.
 func freeGCRequest(c *xgb.Conn, Gc Gcontext) []byte {
+	return AppendFreeGCRequest(nil, c, Gc)
+}
+
+// AppendFreeGCRequest appends a FreeGC request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendFreeGCRequest(buf []byte, c *xgb.Conn, Gc Gcontext) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 60 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
 	return buf
 }
 
+// FreeGCRequest represents the data of a FreeGC request, as it is read when tracing a connection.
+type FreeGCRequest struct {
+	// padding: 1 bytes
+	Gc Gcontext
+}
+
+// freeGCRequestRead reads a byte slice in the byte order 'order' into a FreeGCRequest value.
+func freeGCRequestRead(buf []byte, order xgb.ByteOrder) *FreeGCRequest {
+	v := new(FreeGCRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Gc = Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[60] = xgb.RequestInfo{
+		Name: "FreeGC",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return freeGCRequestRead(buf, order)
+		},
+	}
+}
+
 // FreePixmapCookie is a cookie used only for FreePixmap requests.
 type FreePixmapCookie struct {
 	*xgb.Cookie
 }
 
+// FreePixmap destroys a pixmap.
+//
+// Deletes the association between the pixmap ID and the pixmap. The pixmap
+// storage will be freed when there are no more references to it.
+//
+// Parameters:
+//
+//   - Pixmap: The pixmap to destroy.
+//
+// Errors:
+//
+//   - PixmapError: The specified pixmap does not exist.
+//
 // FreePixmap sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
 func FreePixmap(c *xgb.Conn, Pixmap Pixmap) FreePixmapCookie {
@@ -8948,25 +13695,69 @@ func (cook FreePixmapCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook FreePixmapCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for FreePixmap
 // freePixmapRequest writes a FreePixmap request to a byte slice.
 func freePixmapRequest(c *xgb.Conn, Pixmap Pixmap) []byte {
+	return AppendFreePixmapRequest(nil, c, Pixmap)
+}
+
+// AppendFreePixmapRequest appends a FreePixmap request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendFreePixmapRequest(buf []byte, c *xgb.Conn, Pixmap Pixmap) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 54 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Pixmap))
+	order.Put32(buf[b:], uint32(Pixmap))
+	b += 4
+
+	return buf
+}
+
+// FreePixmapRequest represents the data of a FreePixmap request, as it is read when tracing a connection.
+type FreePixmapRequest struct {
+	// padding: 1 bytes
+	Pixmap Pixmap
+}
+
+// freePixmapRequestRead reads a byte slice in the byte order 'order' into a FreePixmapRequest value.
+func freePixmapRequestRead(buf []byte, order xgb.ByteOrder) *FreePixmapRequest {
+	v := new(FreePixmapRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Pixmap = Pixmap(order.Get32(buf[b:]))
 	b += 4
 
-	return buf
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[54] = xgb.RequestInfo{
+		Name: "FreePixmap",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return freePixmapRequestRead(buf, order)
+		},
+	}
 }
 
 // GetAtomNameCookie is a cookie used only for GetAtomName requests.
@@ -9009,23 +13800,35 @@ func (cook GetAtomNameCookie) Reply() (*GetAtomNameReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getAtomNameReply(buf), nil
+	return getAtomNameReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetAtomName request arrives.
+func (cook GetAtomNameCookie) ReplyContext(ctx context.Context) (*GetAtomNameReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getAtomNameReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getAtomNameReply reads a byte slice into a GetAtomNameReply value.
-func getAtomNameReply(buf []byte) *GetAtomNameReply {
+// getAtomNameReply reads a byte slice in the byte order 'order' into a GetAtomNameReply value.
+func getAtomNameReply(buf []byte, order xgb.ByteOrder) *GetAtomNameReply {
 	v := new(GetAtomNameReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.NameLen = xgb.Get16(buf[b:])
+	v.NameLen = order.Get16(buf[b:])
 	b += 2
 
 	b += 22 // padding
@@ -9043,24 +13846,66 @@ func getAtomNameReply(buf []byte) *GetAtomNameReply {
 // Write request to wire for GetAtomName
 // getAtomNameRequest writes a GetAtomName request to a byte slice.
 func getAtomNameRequest(c *xgb.Conn, Atom Atom) []byte {
+	return AppendGetAtomNameRequest(nil, c, Atom)
+}
+
+// AppendGetAtomNameRequest appends a GetAtomName request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetAtomNameRequest(buf []byte, c *xgb.Conn, Atom Atom) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 17 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Atom))
+	order.Put32(buf[b:], uint32(Atom))
 	b += 4
 
 	return buf
 }
 
+// GetAtomNameRequest represents the data of a GetAtomName request, as it is read when tracing a connection.
+type GetAtomNameRequest struct {
+	// padding: 1 bytes
+	Atom Atom
+}
+
+// getAtomNameRequestRead reads a byte slice in the byte order 'order' into a GetAtomNameRequest value.
+func getAtomNameRequestRead(buf []byte, order xgb.ByteOrder) *GetAtomNameRequest {
+	v := new(GetAtomNameRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Atom = Atom(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[17] = xgb.RequestInfo{
+		Name: "GetAtomName",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getAtomNameRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getAtomNameReply(buf, order)
+		},
+	}
+}
+
 // GetFontPathCookie is a cookie used only for GetFontPath requests.
 type GetFontPathCookie struct {
 	*xgb.Cookie
@@ -9101,29 +13946,41 @@ func (cook GetFontPathCookie) Reply() (*GetFontPathReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getFontPathReply(buf), nil
+	return getFontPathReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetFontPath request arrives.
+func (cook GetFontPathCookie) ReplyContext(ctx context.Context) (*GetFontPathReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getFontPathReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getFontPathReply reads a byte slice into a GetFontPathReply value.
-func getFontPathReply(buf []byte) *GetFontPathReply {
+// getFontPathReply reads a byte slice in the byte order 'order' into a GetFontPathReply value.
+func getFontPathReply(buf []byte, order xgb.ByteOrder) *GetFontPathReply {
 	v := new(GetFontPathReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.PathLen = xgb.Get16(buf[b:])
+	v.PathLen = order.Get16(buf[b:])
 	b += 2
 
 	b += 22 // padding
 
 	v.Path = make([]Str, v.PathLen)
-	b += StrReadList(buf[b:], v.Path)
+	b += StrReadList(buf[b:], order, v.Path)
 
 	return v
 }
@@ -9131,25 +13988,72 @@ func getFontPathReply(buf []byte) *GetFontPathReply {
 // Write request to wire for GetFontPath
 // getFontPathRequest writes a GetFontPath request to a byte slice.
 func getFontPathRequest(c *xgb.Conn) []byte {
+	return AppendGetFontPathRequest(nil, c)
+}
+
+// AppendGetFontPathRequest appends a GetFontPath request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetFontPathRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 52 // request opcode
 	b += 1
 
-	b += 1                             // padding
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 1                               // padding
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// GetFontPathRequest represents the data of a GetFontPath request, as it is read when tracing a connection.
+type GetFontPathRequest struct {
+}
+
+// getFontPathRequestRead reads a byte slice in the byte order 'order' into a GetFontPathRequest value.
+func getFontPathRequestRead(buf []byte, order xgb.ByteOrder) *GetFontPathRequest {
+	v := new(GetFontPathRequest)
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[52] = xgb.RequestInfo{
+		Name: "GetFontPath",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getFontPathRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getFontPathReply(buf, order)
+		},
+	}
+}
+
 // GetGeometryCookie is a cookie used only for GetGeometry requests.
 type GetGeometryCookie struct {
 	*xgb.Cookie
 }
 
+// GetGeometry get current window geometry.
+//
+// Gets the current geometry of the specified drawable (either `Window` or
+// `Pixmap`).
+//
+// Parameters:
+//
+//   - Drawable: The drawable (`Window` or `Pixmap`) of which the geometry will
+//     be received.
+//
+// Errors:
+//
+//   - DrawableError: The specified drawable does not exist.
+//
+// See also xwininfo(1).
+//
 // GetGeometry sends a checked request.
 // If an error occurs, it will be returned with the reply by calling GetGeometryCookie.Reply()
 func GetGeometry(c *xgb.Conn, Drawable Drawable) GetGeometryCookie {
@@ -9168,14 +14072,34 @@ func GetGeometryUnchecked(c *xgb.Conn, Drawable Drawable) GetGeometryCookie {
 
 // GetGeometryReply represents the data returned from a GetGeometry request.
 type GetGeometryReply struct {
-	Sequence    uint16 // sequence number of the request for this reply
-	Length      uint32 // number of bytes in this reply
-	Depth       byte
-	Root        Window
-	X           int16
-	Y           int16
-	Width       uint16
-	Height      uint16
+	Sequence uint16 // sequence number of the request for this reply
+	Length   uint32 // number of bytes in this reply
+
+	// The depth of the drawable (bits per pixel for the object).
+	Depth byte
+
+	// Root window of the screen containing `drawable`.
+	Root Window
+
+	// The X coordinate of `drawable`. If `drawable` is a window, the
+	// coordinate specifies the upper-left outer corner relative to its
+	// parent's origin. If `drawable` is a pixmap, the X coordinate is always
+	// 0.
+	X int16
+
+	// The Y coordinate of `drawable`. If `drawable` is a window, the
+	// coordinate specifies the upper-left outer corner relative to its
+	// parent's origin. If `drawable` is a pixmap, the Y coordinate is always
+	// 0.
+	Y int16
+
+	// The width of `drawable`.
+	Width uint16
+
+	// The height of `drawable`.
+	Height uint16
+
+	// The border width (in pixels).
 	BorderWidth uint16
 	// padding: 2 bytes
 }
@@ -9189,39 +14113,51 @@ func (cook GetGeometryCookie) Reply() (*GetGeometryReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getGeometryReply(buf), nil
+	return getGeometryReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetGeometry request arrives.
+func (cook GetGeometryCookie) ReplyContext(ctx context.Context) (*GetGeometryReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getGeometryReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getGeometryReply reads a byte slice into a GetGeometryReply value.
-func getGeometryReply(buf []byte) *GetGeometryReply {
+// getGeometryReply reads a byte slice in the byte order 'order' into a GetGeometryReply value.
+func getGeometryReply(buf []byte, order xgb.ByteOrder) *GetGeometryReply {
 	v := new(GetGeometryReply)
 	b := 1 // skip reply determinant
 
 	v.Depth = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Root = Window(xgb.Get32(buf[b:]))
+	v.Root = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.X = int16(xgb.Get16(buf[b:]))
+	v.X = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Y = int16(xgb.Get16(buf[b:]))
+	v.Y = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
-	v.BorderWidth = xgb.Get16(buf[b:])
+	v.BorderWidth = order.Get16(buf[b:])
 	b += 2
 
 	b += 2 // padding
@@ -9232,24 +14168,66 @@ func getGeometryReply(buf []byte) *GetGeometryReply {
 // Write request to wire for GetGeometry
 // getGeometryRequest writes a GetGeometry request to a byte slice.
 func getGeometryRequest(c *xgb.Conn, Drawable Drawable) []byte {
+	return AppendGetGeometryRequest(nil, c, Drawable)
+}
+
+// AppendGetGeometryRequest appends a GetGeometry request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetGeometryRequest(buf []byte, c *xgb.Conn, Drawable Drawable) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 14 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
 	return buf
 }
 
+// GetGeometryRequest represents the data of a GetGeometry request, as it is read when tracing a connection.
+type GetGeometryRequest struct {
+	// padding: 1 bytes
+	Drawable Drawable
+}
+
+// getGeometryRequestRead reads a byte slice in the byte order 'order' into a GetGeometryRequest value.
+func getGeometryRequestRead(buf []byte, order xgb.ByteOrder) *GetGeometryRequest {
+	v := new(GetGeometryRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[14] = xgb.RequestInfo{
+		Name: "GetGeometry",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getGeometryRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getGeometryReply(buf, order)
+		},
+	}
+}
+
 // GetImageCookie is a cookie used only for GetImage requests.
 type GetImageCookie struct {
 	*xgb.Cookie
@@ -9257,7 +14235,7 @@ type GetImageCookie struct {
 
 // GetImage sends a checked request.
 // If an error occurs, it will be returned with the reply by calling GetImageCookie.Reply()
-func GetImage(c *xgb.Conn, Format byte, Drawable Drawable, X int16, Y int16, Width uint16, Height uint16, PlaneMask uint32) GetImageCookie {
+func GetImage(c *xgb.Conn, Format ImageFormat, Drawable Drawable, X int16, Y int16, Width uint16, Height uint16, PlaneMask uint32) GetImageCookie {
 	cookie := c.NewCookie(true, true)
 	c.NewRequest(getImageRequest(c, Format, Drawable, X, Y, Width, Height, PlaneMask), cookie)
 	return GetImageCookie{cookie}
@@ -9265,7 +14243,7 @@ func GetImage(c *xgb.Conn, Format byte, Drawable Drawable, X int16, Y int16, Wid
 
 // GetImageUnchecked sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func GetImageUnchecked(c *xgb.Conn, Format byte, Drawable Drawable, X int16, Y int16, Width uint16, Height uint16, PlaneMask uint32) GetImageCookie {
+func GetImageUnchecked(c *xgb.Conn, Format ImageFormat, Drawable Drawable, X int16, Y int16, Width uint16, Height uint16, PlaneMask uint32) GetImageCookie {
 	cookie := c.NewCookie(false, true)
 	c.NewRequest(getImageRequest(c, Format, Drawable, X, Y, Width, Height, PlaneMask), cookie)
 	return GetImageCookie{cookie}
@@ -9290,24 +14268,36 @@ func (cook GetImageCookie) Reply() (*GetImageReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getImageReply(buf), nil
+	return getImageReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetImage request arrives.
+func (cook GetImageCookie) ReplyContext(ctx context.Context) (*GetImageReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getImageReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getImageReply reads a byte slice into a GetImageReply value.
-func getImageReply(buf []byte) *GetImageReply {
+// getImageReply reads a byte slice in the byte order 'order' into a GetImageReply value.
+func getImageReply(buf []byte, order xgb.ByteOrder) *GetImageReply {
 	v := new(GetImageReply)
 	b := 1 // skip reply determinant
 
 	v.Depth = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Visual = Visualid(xgb.Get32(buf[b:]))
+	v.Visual = Visualid(order.Get32(buf[b:]))
 	b += 4
 
 	b += 20 // padding
@@ -9321,41 +14311,104 @@ func getImageReply(buf []byte) *GetImageReply {
 
 // Write request to wire for GetImage
 // getImageRequest writes a GetImage request to a byte slice.
-func getImageRequest(c *xgb.Conn, Format byte, Drawable Drawable, X int16, Y int16, Width uint16, Height uint16, PlaneMask uint32) []byte {
+func getImageRequest(c *xgb.Conn, Format ImageFormat, Drawable Drawable, X int16, Y int16, Width uint16, Height uint16, PlaneMask uint32) []byte {
+	return AppendGetImageRequest(nil, c, Format, Drawable, X, Y, Width, Height, PlaneMask)
+}
+
+// AppendGetImageRequest appends a GetImage request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetImageRequest(buf []byte, c *xgb.Conn, Format ImageFormat, Drawable Drawable, X int16, Y int16, Width uint16, Height uint16, PlaneMask uint32) []byte {
 	size := 20
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 73 // request opcode
 	b += 1
 
-	buf[b] = Format
+	buf[b] = byte(Format)
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(X))
+	order.Put16(buf[b:], uint16(X))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(Y))
+	order.Put16(buf[b:], uint16(Y))
 	b += 2
 
-	xgb.Put16(buf[b:], Width)
+	order.Put16(buf[b:], Width)
 	b += 2
 
-	xgb.Put16(buf[b:], Height)
+	order.Put16(buf[b:], Height)
 	b += 2
 
-	xgb.Put32(buf[b:], PlaneMask)
+	order.Put32(buf[b:], PlaneMask)
 	b += 4
 
 	return buf
 }
 
+// GetImageRequest represents the data of a GetImage request, as it is read when tracing a connection.
+type GetImageRequest struct {
+	Format    ImageFormat
+	Drawable  Drawable
+	X         int16
+	Y         int16
+	Width     uint16
+	Height    uint16
+	PlaneMask uint32
+}
+
+// getImageRequestRead reads a byte slice in the byte order 'order' into a GetImageRequest value.
+func getImageRequestRead(buf []byte, order xgb.ByteOrder) *GetImageRequest {
+	v := new(GetImageRequest)
+	b := 1 // skip request opcode
+
+	v.Format = ImageFormat(buf[b])
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.X = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Y = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	v.PlaneMask = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[73] = xgb.RequestInfo{
+		Name: "GetImage",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getImageRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getImageReply(buf, order)
+		},
+	}
+}
+
 // GetInputFocusCookie is a cookie used only for GetInputFocus requests.
 type GetInputFocusCookie struct {
 	*xgb.Cookie
@@ -9381,7 +14434,7 @@ func GetInputFocusUnchecked(c *xgb.Conn) GetInputFocusCookie {
 type GetInputFocusReply struct {
 	Sequence uint16 // sequence number of the request for this reply
 	Length   uint32 // number of bytes in this reply
-	RevertTo byte
+	RevertTo InputFocus
 	Focus    Window
 }
 
@@ -9394,24 +14447,36 @@ func (cook GetInputFocusCookie) Reply() (*GetInputFocusReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getInputFocusReply(buf), nil
+	return getInputFocusReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetInputFocus request arrives.
+func (cook GetInputFocusCookie) ReplyContext(ctx context.Context) (*GetInputFocusReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getInputFocusReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getInputFocusReply reads a byte slice into a GetInputFocusReply value.
-func getInputFocusReply(buf []byte) *GetInputFocusReply {
+// getInputFocusReply reads a byte slice in the byte order 'order' into a GetInputFocusReply value.
+func getInputFocusReply(buf []byte, order xgb.ByteOrder) *GetInputFocusReply {
 	v := new(GetInputFocusReply)
 	b := 1 // skip reply determinant
 
-	v.RevertTo = buf[b]
+	v.RevertTo = InputFocus(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Focus = Window(xgb.Get32(buf[b:]))
+	v.Focus = Window(order.Get32(buf[b:]))
 	b += 4
 
 	return v
@@ -9420,20 +14485,51 @@ func getInputFocusReply(buf []byte) *GetInputFocusReply {
 // Write request to wire for GetInputFocus
 // getInputFocusRequest writes a GetInputFocus request to a byte slice.
 func getInputFocusRequest(c *xgb.Conn) []byte {
+	return AppendGetInputFocusRequest(nil, c)
+}
+
+// AppendGetInputFocusRequest appends a GetInputFocus request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetInputFocusRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 43 // request opcode
 	b += 1
 
-	b += 1                             // padding
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 1                               // padding
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// GetInputFocusRequest represents the data of a GetInputFocus request, as it is read when tracing a connection.
+type GetInputFocusRequest struct {
+}
+
+// getInputFocusRequestRead reads a byte slice in the byte order 'order' into a GetInputFocusRequest value.
+func getInputFocusRequestRead(buf []byte, order xgb.ByteOrder) *GetInputFocusRequest {
+	v := new(GetInputFocusRequest)
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[43] = xgb.RequestInfo{
+		Name: "GetInputFocus",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getInputFocusRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getInputFocusReply(buf, order)
+		},
+	}
+}
+
 // GetKeyboardControlCookie is a cookie used only for GetKeyboardControl requests.
 type GetKeyboardControlCookie struct {
 	*xgb.Cookie
@@ -9459,7 +14555,7 @@ func GetKeyboardControlUnchecked(c *xgb.Conn) GetKeyboardControlCookie {
 type GetKeyboardControlReply struct {
 	Sequence         uint16 // sequence number of the request for this reply
 	Length           uint32 // number of bytes in this reply
-	GlobalAutoRepeat byte
+	GlobalAutoRepeat AutoRepeatMode
 	LedMask          uint32
 	KeyClickPercent  byte
 	BellPercent      byte
@@ -9478,24 +14574,36 @@ func (cook GetKeyboardControlCookie) Reply() (*GetKeyboardControlReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getKeyboardControlReply(buf), nil
+	return getKeyboardControlReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetKeyboardControl request arrives.
+func (cook GetKeyboardControlCookie) ReplyContext(ctx context.Context) (*GetKeyboardControlReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getKeyboardControlReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getKeyboardControlReply reads a byte slice into a GetKeyboardControlReply value.
-func getKeyboardControlReply(buf []byte) *GetKeyboardControlReply {
+// getKeyboardControlReply reads a byte slice in the byte order 'order' into a GetKeyboardControlReply value.
+func getKeyboardControlReply(buf []byte, order xgb.ByteOrder) *GetKeyboardControlReply {
 	v := new(GetKeyboardControlReply)
 	b := 1 // skip reply determinant
 
-	v.GlobalAutoRepeat = buf[b]
+	v.GlobalAutoRepeat = AutoRepeatMode(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.LedMask = xgb.Get32(buf[b:])
+	v.LedMask = order.Get32(buf[b:])
 	b += 4
 
 	v.KeyClickPercent = buf[b]
@@ -9504,10 +14612,10 @@ func getKeyboardControlReply(buf []byte) *GetKeyboardControlReply {
 	v.BellPercent = buf[b]
 	b += 1
 
-	v.BellPitch = xgb.Get16(buf[b:])
+	v.BellPitch = order.Get16(buf[b:])
 	b += 2
 
-	v.BellDuration = xgb.Get16(buf[b:])
+	v.BellDuration = order.Get16(buf[b:])
 	b += 2
 
 	b += 2 // padding
@@ -9522,20 +14630,51 @@ func getKeyboardControlReply(buf []byte) *GetKeyboardControlReply {
 // Write request to wire for GetKeyboardControl
 // getKeyboardControlRequest writes a GetKeyboardControl request to a byte slice.
 func getKeyboardControlRequest(c *xgb.Conn) []byte {
+	return AppendGetKeyboardControlRequest(nil, c)
+}
+
+// AppendGetKeyboardControlRequest appends a GetKeyboardControl request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetKeyboardControlRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 103 // request opcode
 	b += 1
 
-	b += 1                             // padding
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 1                               // padding
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// GetKeyboardControlRequest represents the data of a GetKeyboardControl request, as it is read when tracing a connection.
+type GetKeyboardControlRequest struct {
+}
+
+// getKeyboardControlRequestRead reads a byte slice in the byte order 'order' into a GetKeyboardControlRequest value.
+func getKeyboardControlRequestRead(buf []byte, order xgb.ByteOrder) *GetKeyboardControlRequest {
+	v := new(GetKeyboardControlRequest)
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[103] = xgb.RequestInfo{
+		Name: "GetKeyboardControl",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getKeyboardControlRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getKeyboardControlReply(buf, order)
+		},
+	}
+}
+
 // GetKeyboardMappingCookie is a cookie used only for GetKeyboardMapping requests.
 type GetKeyboardMappingCookie struct {
 	*xgb.Cookie
@@ -9575,28 +14714,40 @@ func (cook GetKeyboardMappingCookie) Reply() (*GetKeyboardMappingReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getKeyboardMappingReply(buf), nil
+	return getKeyboardMappingReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetKeyboardMapping request arrives.
+func (cook GetKeyboardMappingCookie) ReplyContext(ctx context.Context) (*GetKeyboardMappingReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getKeyboardMappingReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getKeyboardMappingReply reads a byte slice into a GetKeyboardMappingReply value.
-func getKeyboardMappingReply(buf []byte) *GetKeyboardMappingReply {
+// getKeyboardMappingReply reads a byte slice in the byte order 'order' into a GetKeyboardMappingReply value.
+func getKeyboardMappingReply(buf []byte, order xgb.ByteOrder) *GetKeyboardMappingReply {
 	v := new(GetKeyboardMappingReply)
 	b := 1 // skip reply determinant
 
 	v.KeysymsPerKeycode = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 24 // padding
 
 	v.Keysyms = make([]Keysym, v.Length)
 	for i := 0; i < int(v.Length); i++ {
-		v.Keysyms[i] = Keysym(xgb.Get32(buf[b:]))
+		v.Keysyms[i] = Keysym(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -9606,16 +14757,25 @@ func getKeyboardMappingReply(buf []byte) *GetKeyboardMappingReply {
 // Write request to wire for GetKeyboardMapping
 // getKeyboardMappingRequest writes a GetKeyboardMapping request to a byte slice.
 func getKeyboardMappingRequest(c *xgb.Conn, FirstKeycode Keycode, Count byte) []byte {
+	return AppendGetKeyboardMappingRequest(nil, c, FirstKeycode, Count)
+}
+
+// AppendGetKeyboardMappingRequest appends a GetKeyboardMapping request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetKeyboardMappingRequest(buf []byte, c *xgb.Conn, FirstKeycode Keycode, Count byte) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 101 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	buf[b] = byte(FirstKeycode)
@@ -9627,6 +14787,43 @@ func getKeyboardMappingRequest(c *xgb.Conn, FirstKeycode Keycode, Count byte) []
 	return buf
 }
 
+// GetKeyboardMappingRequest represents the data of a GetKeyboardMapping request, as it is read when tracing a connection.
+type GetKeyboardMappingRequest struct {
+	// padding: 1 bytes
+	FirstKeycode Keycode
+	Count        byte
+}
+
+// getKeyboardMappingRequestRead reads a byte slice in the byte order 'order' into a GetKeyboardMappingRequest value.
+func getKeyboardMappingRequestRead(buf []byte, order xgb.ByteOrder) *GetKeyboardMappingRequest {
+	v := new(GetKeyboardMappingRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.FirstKeycode = Keycode(buf[b])
+	b += 1
+
+	v.Count = buf[b]
+	b += 1
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[101] = xgb.RequestInfo{
+		Name: "GetKeyboardMapping",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getKeyboardMappingRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getKeyboardMappingReply(buf, order)
+		},
+	}
+}
+
 // GetModifierMappingCookie is a cookie used only for GetModifierMapping requests.
 type GetModifierMappingCookie struct {
 	*xgb.Cookie
@@ -9666,21 +14863,33 @@ func (cook GetModifierMappingCookie) Reply() (*GetModifierMappingReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getModifierMappingReply(buf), nil
+	return getModifierMappingReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getModifierMappingReply reads a byte slice into a GetModifierMappingReply value.
-func getModifierMappingReply(buf []byte) *GetModifierMappingReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetModifierMapping request arrives.
+func (cook GetModifierMappingCookie) ReplyContext(ctx context.Context) (*GetModifierMappingReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getModifierMappingReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// getModifierMappingReply reads a byte slice in the byte order 'order' into a GetModifierMappingReply value.
+func getModifierMappingReply(buf []byte, order xgb.ByteOrder) *GetModifierMappingReply {
 	v := new(GetModifierMappingReply)
 	b := 1 // skip reply determinant
 
 	v.KeycodesPerModifier = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 24 // padding
@@ -9697,20 +14906,51 @@ func getModifierMappingReply(buf []byte) *GetModifierMappingReply {
 // Write request to wire for GetModifierMapping
 // getModifierMappingRequest writes a GetModifierMapping request to a byte slice.
 func getModifierMappingRequest(c *xgb.Conn) []byte {
+	return AppendGetModifierMappingRequest(nil, c)
+}
+
+// AppendGetModifierMappingRequest appends a GetModifierMapping request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetModifierMappingRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 119 // request opcode
 	b += 1
 
-	b += 1                             // padding
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 1                               // padding
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// GetModifierMappingRequest represents the data of a GetModifierMapping request, as it is read when tracing a connection.
+type GetModifierMappingRequest struct {
+}
+
+// getModifierMappingRequestRead reads a byte slice in the byte order 'order' into a GetModifierMappingRequest value.
+func getModifierMappingRequestRead(buf []byte, order xgb.ByteOrder) *GetModifierMappingRequest {
+	v := new(GetModifierMappingRequest)
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[119] = xgb.RequestInfo{
+		Name: "GetModifierMapping",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getModifierMappingRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getModifierMappingReply(buf, order)
+		},
+	}
+}
+
 // GetMotionEventsCookie is a cookie used only for GetMotionEvents requests.
 type GetMotionEventsCookie struct {
 	*xgb.Cookie
@@ -9751,29 +14991,41 @@ func (cook GetMotionEventsCookie) Reply() (*GetMotionEventsReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getMotionEventsReply(buf), nil
+	return getMotionEventsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetMotionEvents request arrives.
+func (cook GetMotionEventsCookie) ReplyContext(ctx context.Context) (*GetMotionEventsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getMotionEventsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getMotionEventsReply reads a byte slice into a GetMotionEventsReply value.
-func getMotionEventsReply(buf []byte) *GetMotionEventsReply {
+// getMotionEventsReply reads a byte slice in the byte order 'order' into a GetMotionEventsReply value.
+func getMotionEventsReply(buf []byte, order xgb.ByteOrder) *GetMotionEventsReply {
 	v := new(GetMotionEventsReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.EventsLen = xgb.Get32(buf[b:])
+	v.EventsLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
 
 	v.Events = make([]Timecoord, v.EventsLen)
-	b += TimecoordReadList(buf[b:], v.Events)
+	b += TimecoordReadList(buf[b:], order, v.Events)
 
 	return v
 }
@@ -9781,28 +15033,78 @@ func getMotionEventsReply(buf []byte) *GetMotionEventsReply {
 // Write request to wire for GetMotionEvents
 // getMotionEventsRequest writes a GetMotionEvents request to a byte slice.
 func getMotionEventsRequest(c *xgb.Conn, Window Window, Start Timestamp, Stop Timestamp) []byte {
+	return AppendGetMotionEventsRequest(nil, c, Window, Start, Stop)
+}
+
+// AppendGetMotionEventsRequest appends a GetMotionEvents request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetMotionEventsRequest(buf []byte, c *xgb.Conn, Window Window, Start Timestamp, Stop Timestamp) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 39 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
+	b += 4
+
+	order.Put32(buf[b:], uint32(Start))
+	b += 4
+
+	order.Put32(buf[b:], uint32(Stop))
+	b += 4
+
+	return buf
+}
+
+// GetMotionEventsRequest represents the data of a GetMotionEvents request, as it is read when tracing a connection.
+type GetMotionEventsRequest struct {
+	// padding: 1 bytes
+	Window Window
+	Start  Timestamp
+	Stop   Timestamp
+}
+
+// getMotionEventsRequestRead reads a byte slice in the byte order 'order' into a GetMotionEventsRequest value.
+func getMotionEventsRequestRead(buf []byte, order xgb.ByteOrder) *GetMotionEventsRequest {
+	v := new(GetMotionEventsRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Window = Window(order.Get32(buf[b:]))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Start))
+	v.Start = Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Stop))
+	v.Stop = Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	return buf
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[39] = xgb.RequestInfo{
+		Name: "GetMotionEvents",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMotionEventsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getMotionEventsReply(buf, order)
+		},
+	}
 }
 
 // GetPointerControlCookie is a cookie used only for GetPointerControl requests.
@@ -9846,29 +15148,41 @@ func (cook GetPointerControlCookie) Reply() (*GetPointerControlReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getPointerControlReply(buf), nil
+	return getPointerControlReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetPointerControl request arrives.
+func (cook GetPointerControlCookie) ReplyContext(ctx context.Context) (*GetPointerControlReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getPointerControlReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getPointerControlReply reads a byte slice into a GetPointerControlReply value.
-func getPointerControlReply(buf []byte) *GetPointerControlReply {
+// getPointerControlReply reads a byte slice in the byte order 'order' into a GetPointerControlReply value.
+func getPointerControlReply(buf []byte, order xgb.ByteOrder) *GetPointerControlReply {
 	v := new(GetPointerControlReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.AccelerationNumerator = xgb.Get16(buf[b:])
+	v.AccelerationNumerator = order.Get16(buf[b:])
 	b += 2
 
-	v.AccelerationDenominator = xgb.Get16(buf[b:])
+	v.AccelerationDenominator = order.Get16(buf[b:])
 	b += 2
 
-	v.Threshold = xgb.Get16(buf[b:])
+	v.Threshold = order.Get16(buf[b:])
 	b += 2
 
 	b += 18 // padding
@@ -9879,20 +15193,51 @@ func getPointerControlReply(buf []byte) *GetPointerControlReply {
 // Write request to wire for GetPointerControl
 // getPointerControlRequest writes a GetPointerControl request to a byte slice.
 func getPointerControlRequest(c *xgb.Conn) []byte {
+	return AppendGetPointerControlRequest(nil, c)
+}
+
+// AppendGetPointerControlRequest appends a GetPointerControl request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetPointerControlRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 106 // request opcode
 	b += 1
 
-	b += 1                             // padding
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 1                               // padding
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// GetPointerControlRequest represents the data of a GetPointerControl request, as it is read when tracing a connection.
+type GetPointerControlRequest struct {
+}
+
+// getPointerControlRequestRead reads a byte slice in the byte order 'order' into a GetPointerControlRequest value.
+func getPointerControlRequestRead(buf []byte, order xgb.ByteOrder) *GetPointerControlRequest {
+	v := new(GetPointerControlRequest)
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[106] = xgb.RequestInfo{
+		Name: "GetPointerControl",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPointerControlRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPointerControlReply(buf, order)
+		},
+	}
+}
+
 // GetPointerMappingCookie is a cookie used only for GetPointerMapping requests.
 type GetPointerMappingCookie struct {
 	*xgb.Cookie
@@ -9932,21 +15277,33 @@ func (cook GetPointerMappingCookie) Reply() (*GetPointerMappingReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getPointerMappingReply(buf), nil
+	return getPointerMappingReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetPointerMapping request arrives.
+func (cook GetPointerMappingCookie) ReplyContext(ctx context.Context) (*GetPointerMappingReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getPointerMappingReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getPointerMappingReply reads a byte slice into a GetPointerMappingReply value.
-func getPointerMappingReply(buf []byte) *GetPointerMappingReply {
+// getPointerMappingReply reads a byte slice in the byte order 'order' into a GetPointerMappingReply value.
+func getPointerMappingReply(buf []byte, order xgb.ByteOrder) *GetPointerMappingReply {
 	v := new(GetPointerMappingReply)
 	b := 1 // skip reply determinant
 
 	v.MapLen = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 24 // padding
@@ -9961,25 +15318,93 @@ func getPointerMappingReply(buf []byte) *GetPointerMappingReply {
 // Write request to wire for GetPointerMapping
 // getPointerMappingRequest writes a GetPointerMapping request to a byte slice.
 func getPointerMappingRequest(c *xgb.Conn) []byte {
+	return AppendGetPointerMappingRequest(nil, c)
+}
+
+// AppendGetPointerMappingRequest appends a GetPointerMapping request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetPointerMappingRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 117 // request opcode
 	b += 1
 
-	b += 1                             // padding
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 1                               // padding
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// GetPointerMappingRequest represents the data of a GetPointerMapping request, as it is read when tracing a connection.
+type GetPointerMappingRequest struct {
+}
+
+// getPointerMappingRequestRead reads a byte slice in the byte order 'order' into a GetPointerMappingRequest value.
+func getPointerMappingRequestRead(buf []byte, order xgb.ByteOrder) *GetPointerMappingRequest {
+	v := new(GetPointerMappingRequest)
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[117] = xgb.RequestInfo{
+		Name: "GetPointerMapping",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPointerMappingRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPointerMappingReply(buf, order)
+		},
+	}
+}
+
 // GetPropertyCookie is a cookie used only for GetProperty requests.
 type GetPropertyCookie struct {
 	*xgb.Cookie
 }
 
+// GetProperty gets a window property.
+//
+// Gets the specified `property` from the specified `window`. Properties are
+// for example the window title (`WM_NAME`) or its minimum size
+// (`WM_NORMAL_HINTS`). Protocols such as EWMH also use properties - for
+// example EWMH defines the window title, encoded as UTF-8 string, in the
+// `_NET_WM_NAME` property.
+//
+// TODO: talk about `type`
+//
+// TODO: talk about `delete`
+//
+// TODO: talk about the offset/length thing. what's a valid use case?
+//
+// Parameters:
+//
+//   - Window: The window whose property you want to get.
+//   - Delete: Whether the property should actually be deleted. For deleting a
+//     property, the specified `type` has to match the actual property type.
+//   - Property: The property you want to get (an atom).
+//   - Type: The type of the property you want to get (an atom).
+//   - LongOffset: Specifies the offset (in 32-bit multiples) in the specified
+//     property where the data is to be retrieved.
+//   - LongLength: Specifies how many 32-bit multiples of data should be
+//     retrieved (e.g. if you set `long_length` to 4, you will receive 16 bytes
+//     of data).
+//
+// Errors:
+//
+//   - WindowError: The specified `window` does not exist.
+//   - AtomError: `property` or `type` do not refer to a valid atom.
+//   - ValueError: The specified `long_offset` is beyond the actual property
+//     length (e.g. the property has a length of 3 bytes and you are setting
+//     `long_offset` to 1, resulting in a byte offset of 4).
+//
+// See also xprop(1), InternAtom.
+//
 // GetProperty sends a checked request.
 // If an error occurs, it will be returned with the reply by calling GetPropertyCookie.Reply()
 func GetProperty(c *xgb.Conn, Delete bool, Window Window, Property Atom, Type Atom, LongOffset uint32, LongLength uint32) GetPropertyCookie {
@@ -9998,10 +15423,18 @@ func GetPropertyUnchecked(c *xgb.Conn, Delete bool, Window Window, Property Atom
 
 // GetPropertyReply represents the data returned from a GetProperty request.
 type GetPropertyReply struct {
-	Sequence   uint16 // sequence number of the request for this reply
-	Length     uint32 // number of bytes in this reply
-	Format     byte
-	Type       Atom
+	Sequence uint16 // sequence number of the request for this reply
+	Length   uint32 // number of bytes in this reply
+
+	// Specifies whether the value is returned as 8-, 16- or 32-bit quantities.
+	// Possible values are 8, 16 and 32.
+	Format byte
+
+	// The actual type of the property (an atom).
+	Type Atom
+
+	// The number of bytes remaining to be read in the property if a partial
+	// read was performed (using `long_offset` and `long_length`).
 	BytesAfter uint32
 	ValueLen   uint32
 	// padding: 12 bytes
@@ -10017,30 +15450,42 @@ func (cook GetPropertyCookie) Reply() (*GetPropertyReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getPropertyReply(buf), nil
+	return getPropertyReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetProperty request arrives.
+func (cook GetPropertyCookie) ReplyContext(ctx context.Context) (*GetPropertyReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getPropertyReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getPropertyReply reads a byte slice into a GetPropertyReply value.
-func getPropertyReply(buf []byte) *GetPropertyReply {
+// getPropertyReply reads a byte slice in the byte order 'order' into a GetPropertyReply value.
+func getPropertyReply(buf []byte, order xgb.ByteOrder) *GetPropertyReply {
 	v := new(GetPropertyReply)
 	b := 1 // skip reply determinant
 
 	v.Format = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Type = Atom(xgb.Get32(buf[b:]))
+	v.Type = Atom(order.Get32(buf[b:]))
 	b += 4
 
-	v.BytesAfter = xgb.Get32(buf[b:])
+	v.BytesAfter = order.Get32(buf[b:])
 	b += 4
 
-	v.ValueLen = xgb.Get32(buf[b:])
+	v.ValueLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 12 // padding
@@ -10055,9 +15500,18 @@ func getPropertyReply(buf []byte) *GetPropertyReply {
 // Write request to wire for GetProperty
 // getPropertyRequest writes a GetProperty request to a byte slice.
 func getPropertyRequest(c *xgb.Conn, Delete bool, Window Window, Property Atom, Type Atom, LongOffset uint32, LongLength uint32) []byte {
+	return AppendGetPropertyRequest(nil, c, Delete, Window, Property, Type, LongOffset, LongLength)
+}
+
+// AppendGetPropertyRequest appends a GetProperty request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetPropertyRequest(buf []byte, c *xgb.Conn, Delete bool, Window Window, Property Atom, Type Atom, LongOffset uint32, LongLength uint32) []byte {
 	size := 24
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 20 // request opcode
 	b += 1
@@ -10069,27 +15523,81 @@ func getPropertyRequest(c *xgb.Conn, Delete bool, Window Window, Property Atom,
 	}
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Property))
+	order.Put32(buf[b:], uint32(Property))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Type))
+	order.Put32(buf[b:], uint32(Type))
 	b += 4
 
-	xgb.Put32(buf[b:], LongOffset)
+	order.Put32(buf[b:], LongOffset)
 	b += 4
 
-	xgb.Put32(buf[b:], LongLength)
+	order.Put32(buf[b:], LongLength)
 	b += 4
 
 	return buf
 }
 
+// GetPropertyRequest represents the data of a GetProperty request, as it is read when tracing a connection.
+type GetPropertyRequest struct {
+	Delete     bool
+	Window     Window
+	Property   Atom
+	Type       Atom
+	LongOffset uint32
+	LongLength uint32
+}
+
+// getPropertyRequestRead reads a byte slice in the byte order 'order' into a GetPropertyRequest value.
+func getPropertyRequestRead(buf []byte, order xgb.ByteOrder) *GetPropertyRequest {
+	v := new(GetPropertyRequest)
+	b := 1 // skip request opcode
+
+	if buf[b] == 1 {
+		v.Delete = true
+	} else {
+		v.Delete = false
+	}
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Window = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Property = Atom(order.Get32(buf[b:]))
+	b += 4
+
+	v.Type = Atom(order.Get32(buf[b:]))
+	b += 4
+
+	v.LongOffset = order.Get32(buf[b:])
+	b += 4
+
+	v.LongLength = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[20] = xgb.RequestInfo{
+		Name: "GetProperty",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPropertyRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPropertyReply(buf, order)
+		},
+	}
+}
+
 // GetScreenSaverCookie is a cookie used only for GetScreenSaver requests.
 type GetScreenSaverCookie struct {
 	*xgb.Cookie
@@ -10118,8 +15626,8 @@ type GetScreenSaverReply struct {
 	// padding: 1 bytes
 	Timeout        uint16
 	Interval       uint16
-	PreferBlanking byte
-	AllowExposures byte
+	PreferBlanking Blanking
+	AllowExposures Exposures
 	// padding: 18 bytes
 }
 
@@ -10132,32 +15640,44 @@ func (cook GetScreenSaverCookie) Reply() (*GetScreenSaverReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getScreenSaverReply(buf), nil
+	return getScreenSaverReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetScreenSaver request arrives.
+func (cook GetScreenSaverCookie) ReplyContext(ctx context.Context) (*GetScreenSaverReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getScreenSaverReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getScreenSaverReply reads a byte slice into a GetScreenSaverReply value.
-func getScreenSaverReply(buf []byte) *GetScreenSaverReply {
+// getScreenSaverReply reads a byte slice in the byte order 'order' into a GetScreenSaverReply value.
+func getScreenSaverReply(buf []byte, order xgb.ByteOrder) *GetScreenSaverReply {
 	v := new(GetScreenSaverReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Timeout = xgb.Get16(buf[b:])
+	v.Timeout = order.Get16(buf[b:])
 	b += 2
 
-	v.Interval = xgb.Get16(buf[b:])
+	v.Interval = order.Get16(buf[b:])
 	b += 2
 
-	v.PreferBlanking = buf[b]
+	v.PreferBlanking = Blanking(buf[b])
 	b += 1
 
-	v.AllowExposures = buf[b]
+	v.AllowExposures = Exposures(buf[b])
 	b += 1
 
 	b += 18 // padding
@@ -10168,25 +15688,72 @@ func getScreenSaverReply(buf []byte) *GetScreenSaverReply {
 // Write request to wire for GetScreenSaver
 // getScreenSaverRequest writes a GetScreenSaver request to a byte slice.
 func getScreenSaverRequest(c *xgb.Conn) []byte {
+	return AppendGetScreenSaverRequest(nil, c)
+}
+
+// AppendGetScreenSaverRequest appends a GetScreenSaver request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetScreenSaverRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 108 // request opcode
 	b += 1
 
-	b += 1                             // padding
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 1                               // padding
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// GetScreenSaverRequest represents the data of a GetScreenSaver request, as it is read when tracing a connection.
+type GetScreenSaverRequest struct {
+}
+
+// getScreenSaverRequestRead reads a byte slice in the byte order 'order' into a GetScreenSaverRequest value.
+func getScreenSaverRequestRead(buf []byte, order xgb.ByteOrder) *GetScreenSaverRequest {
+	v := new(GetScreenSaverRequest)
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[108] = xgb.RequestInfo{
+		Name: "GetScreenSaver",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getScreenSaverRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getScreenSaverReply(buf, order)
+		},
+	}
+}
+
 // GetSelectionOwnerCookie is a cookie used only for GetSelectionOwner requests.
 type GetSelectionOwnerCookie struct {
 	*xgb.Cookie
 }
 
+// GetSelectionOwner gets the owner of a selection.
+//
+// Gets the owner of the specified selection.
+//
+// TODO: briefly explain what a selection is.
+//
+// Parameters:
+//
+//   - Selection: The selection.
+//
+// Errors:
+//
+//   - AtomError: `selection` does not refer to a valid atom.
+//
+// See also SetSelectionOwner.
+//
 // GetSelectionOwner sends a checked request.
 // If an error occurs, it will be returned with the reply by calling GetSelectionOwnerCookie.Reply()
 func GetSelectionOwner(c *xgb.Conn, Selection Atom) GetSelectionOwnerCookie {
@@ -10208,6 +15775,8 @@ type GetSelectionOwnerReply struct {
 	Sequence uint16 // sequence number of the request for this reply
 	Length   uint32 // number of bytes in this reply
 	// padding: 1 bytes
+
+	// The current selection owner window.
 	Owner Window
 }
 
@@ -10220,23 +15789,35 @@ func (cook GetSelectionOwnerCookie) Reply() (*GetSelectionOwnerReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getSelectionOwnerReply(buf), nil
+	return getSelectionOwnerReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetSelectionOwner request arrives.
+func (cook GetSelectionOwnerCookie) ReplyContext(ctx context.Context) (*GetSelectionOwnerReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getSelectionOwnerReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getSelectionOwnerReply reads a byte slice into a GetSelectionOwnerReply value.
-func getSelectionOwnerReply(buf []byte) *GetSelectionOwnerReply {
+// getSelectionOwnerReply reads a byte slice in the byte order 'order' into a GetSelectionOwnerReply value.
+func getSelectionOwnerReply(buf []byte, order xgb.ByteOrder) *GetSelectionOwnerReply {
 	v := new(GetSelectionOwnerReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Owner = Window(xgb.Get32(buf[b:]))
+	v.Owner = Window(order.Get32(buf[b:]))
 	b += 4
 
 	return v
@@ -10245,29 +15826,83 @@ func getSelectionOwnerReply(buf []byte) *GetSelectionOwnerReply {
 // Write request to wire for GetSelectionOwner
 // getSelectionOwnerRequest writes a GetSelectionOwner request to a byte slice.
 func getSelectionOwnerRequest(c *xgb.Conn, Selection Atom) []byte {
+	return AppendGetSelectionOwnerRequest(nil, c, Selection)
+}
+
+// AppendGetSelectionOwnerRequest appends a GetSelectionOwner request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetSelectionOwnerRequest(buf []byte, c *xgb.Conn, Selection Atom) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 23 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Selection))
+	order.Put32(buf[b:], uint32(Selection))
 	b += 4
 
 	return buf
 }
 
+// GetSelectionOwnerRequest represents the data of a GetSelectionOwner request, as it is read when tracing a connection.
+type GetSelectionOwnerRequest struct {
+	// padding: 1 bytes
+	Selection Atom
+}
+
+// getSelectionOwnerRequestRead reads a byte slice in the byte order 'order' into a GetSelectionOwnerRequest value.
+func getSelectionOwnerRequestRead(buf []byte, order xgb.ByteOrder) *GetSelectionOwnerRequest {
+	v := new(GetSelectionOwnerRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Selection = Atom(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[23] = xgb.RequestInfo{
+		Name: "GetSelectionOwner",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getSelectionOwnerRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getSelectionOwnerReply(buf, order)
+		},
+	}
+}
+
 // GetWindowAttributesCookie is a cookie used only for GetWindowAttributes requests.
 type GetWindowAttributesCookie struct {
 	*xgb.Cookie
 }
 
+// GetWindowAttributes gets window attributes.
+//
+// Gets the current attributes for the specified `window`.
+//
+// Parameters:
+//
+//   - Window: The window to get the attributes from.
+//
+// Errors:
+//
+//   - DrawableError: The specified `window` does not exist.
+//
 // GetWindowAttributes sends a checked request.
 // If an error occurs, it will be returned with the reply by calling GetWindowAttributesCookie.Reply()
 func GetWindowAttributes(c *xgb.Conn, Window Window) GetWindowAttributesCookie {
@@ -10286,23 +15921,41 @@ func GetWindowAttributesUnchecked(c *xgb.Conn, Window Window) GetWindowAttribute
 
 // GetWindowAttributesReply represents the data returned from a GetWindowAttributes request.
 type GetWindowAttributesReply struct {
-	Sequence           uint16 // sequence number of the request for this reply
-	Length             uint32 // number of bytes in this reply
-	BackingStore       byte
-	Visual             Visualid
-	Class              uint16
-	BitGravity         byte
-	WinGravity         byte
-	BackingPlanes      uint32
-	BackingPixel       uint32
-	SaveUnder          bool
-	MapIsInstalled     bool
-	MapState           byte
-	OverrideRedirect   bool
-	Colormap           Colormap
-	AllEventMasks      uint32
-	YourEventMask      uint32
-	DoNotPropagateMask uint16
+	Sequence     uint16 // sequence number of the request for this reply
+	Length       uint32 // number of bytes in this reply
+	BackingStore BackingStore
+
+	// The associated visual structure of `window`.
+	Visual     Visualid
+	Class      WindowClass
+	BitGravity Gravity
+	WinGravity Gravity
+
+	// Planes to be preserved if possible.
+	BackingPlanes uint32
+
+	// Value to be used when restoring planes.
+	BackingPixel uint32
+
+	// Boolean, should bits under be saved?
+	SaveUnder      bool
+	MapIsInstalled bool
+	MapState       MapState
+
+	// Window managers should ignore this window if `override_redirect` is 1.
+	OverrideRedirect bool
+
+	// Color map to be associated with window.
+	Colormap Colormap
+
+	// Set of events all people have interest in.
+	AllEventMasks EventMask
+
+	// My event mask.
+	YourEventMask EventMask
+
+	// Set of events that should not propagate.
+	DoNotPropagateMask EventMask
 	// padding: 2 bytes
 }
 
@@ -10315,39 +15968,51 @@ func (cook GetWindowAttributesCookie) Reply() (*GetWindowAttributesReply, error)
 	if buf == nil {
 		return nil, nil
 	}
-	return getWindowAttributesReply(buf), nil
+	return getWindowAttributesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetWindowAttributes request arrives.
+func (cook GetWindowAttributesCookie) ReplyContext(ctx context.Context) (*GetWindowAttributesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getWindowAttributesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getWindowAttributesReply reads a byte slice into a GetWindowAttributesReply value.
-func getWindowAttributesReply(buf []byte) *GetWindowAttributesReply {
+// getWindowAttributesReply reads a byte slice in the byte order 'order' into a GetWindowAttributesReply value.
+func getWindowAttributesReply(buf []byte, order xgb.ByteOrder) *GetWindowAttributesReply {
 	v := new(GetWindowAttributesReply)
 	b := 1 // skip reply determinant
 
-	v.BackingStore = buf[b]
+	v.BackingStore = BackingStore(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Visual = Visualid(xgb.Get32(buf[b:]))
+	v.Visual = Visualid(order.Get32(buf[b:]))
 	b += 4
 
-	v.Class = xgb.Get16(buf[b:])
+	v.Class = WindowClass(order.Get16(buf[b:]))
 	b += 2
 
-	v.BitGravity = buf[b]
+	v.BitGravity = Gravity(buf[b])
 	b += 1
 
-	v.WinGravity = buf[b]
+	v.WinGravity = Gravity(buf[b])
 	b += 1
 
-	v.BackingPlanes = xgb.Get32(buf[b:])
+	v.BackingPlanes = order.Get32(buf[b:])
 	b += 4
 
-	v.BackingPixel = xgb.Get32(buf[b:])
+	v.BackingPixel = order.Get32(buf[b:])
 	b += 4
 
 	if buf[b] == 1 {
@@ -10364,7 +16029,7 @@ func getWindowAttributesReply(buf []byte) *GetWindowAttributesReply {
 	}
 	b += 1
 
-	v.MapState = buf[b]
+	v.MapState = MapState(buf[b])
 	b += 1
 
 	if buf[b] == 1 {
@@ -10374,16 +16039,16 @@ func getWindowAttributesReply(buf []byte) *GetWindowAttributesReply {
 	}
 	b += 1
 
-	v.Colormap = Colormap(xgb.Get32(buf[b:]))
+	v.Colormap = Colormap(order.Get32(buf[b:]))
 	b += 4
 
-	v.AllEventMasks = xgb.Get32(buf[b:])
+	v.AllEventMasks = EventMask(order.Get32(buf[b:]))
 	b += 4
 
-	v.YourEventMask = xgb.Get32(buf[b:])
+	v.YourEventMask = EventMask(order.Get32(buf[b:]))
 	b += 4
 
-	v.DoNotPropagateMask = xgb.Get16(buf[b:])
+	v.DoNotPropagateMask = EventMask(order.Get16(buf[b:]))
 	b += 2
 
 	b += 2 // padding
@@ -10394,24 +16059,66 @@ func getWindowAttributesReply(buf []byte) *GetWindowAttributesReply {
 // Write request to wire for GetWindowAttributes
 // getWindowAttributesRequest writes a GetWindowAttributes request to a byte slice.
 func getWindowAttributesRequest(c *xgb.Conn, Window Window) []byte {
+	return AppendGetWindowAttributesRequest(nil, c, Window)
+}
+
+// AppendGetWindowAttributesRequest appends a GetWindowAttributes request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetWindowAttributesRequest(buf []byte, c *xgb.Conn, Window Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 3 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// GetWindowAttributesRequest represents the data of a GetWindowAttributes request, as it is read when tracing a connection.
+type GetWindowAttributesRequest struct {
+	// padding: 1 bytes
+	Window Window
+}
+
+// getWindowAttributesRequestRead reads a byte slice in the byte order 'order' into a GetWindowAttributesRequest value.
+func getWindowAttributesRequestRead(buf []byte, order xgb.ByteOrder) *GetWindowAttributesRequest {
+	v := new(GetWindowAttributesRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Window = Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[3] = xgb.RequestInfo{
+		Name: "GetWindowAttributes",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getWindowAttributesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getWindowAttributesReply(buf, order)
+		},
+	}
+}
+
 // GrabButtonCookie is a cookie used only for GrabButton requests.
 type GrabButtonCookie struct {
 	*xgb.Cookie
@@ -10419,7 +16126,7 @@ type GrabButtonCookie struct {
 
 // GrabButton sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func GrabButton(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, EventMask uint16, PointerMode byte, KeyboardMode byte, ConfineTo Window, Cursor Cursor, Button byte, Modifiers uint16) GrabButtonCookie {
+func GrabButton(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, EventMask EventMask, PointerMode GrabMode, KeyboardMode GrabMode, ConfineTo Window, Cursor Cursor, Button ButtonIndex, Modifiers ModMask) GrabButtonCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(grabButtonRequest(c, OwnerEvents, GrabWindow, EventMask, PointerMode, KeyboardMode, ConfineTo, Cursor, Button, Modifiers), cookie)
 	return GrabButtonCookie{cookie}
@@ -10427,7 +16134,7 @@ func GrabButton(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, EventMask uint
 
 // GrabButtonChecked sends a checked request.
 // If an error occurs, it can be retrieved using GrabButtonCookie.Check()
-func GrabButtonChecked(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, EventMask uint16, PointerMode byte, KeyboardMode byte, ConfineTo Window, Cursor Cursor, Button byte, Modifiers uint16) GrabButtonCookie {
+func GrabButtonChecked(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, EventMask EventMask, PointerMode GrabMode, KeyboardMode GrabMode, ConfineTo Window, Cursor Cursor, Button ButtonIndex, Modifiers ModMask) GrabButtonCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(grabButtonRequest(c, OwnerEvents, GrabWindow, EventMask, PointerMode, KeyboardMode, ConfineTo, Cursor, Button, Modifiers), cookie)
 	return GrabButtonCookie{cookie}
@@ -10439,12 +16146,26 @@ func (cook GrabButtonCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook GrabButtonCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for GrabButton
 // grabButtonRequest writes a GrabButton request to a byte slice.
-func grabButtonRequest(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, EventMask uint16, PointerMode byte, KeyboardMode byte, ConfineTo Window, Cursor Cursor, Button byte, Modifiers uint16) []byte {
+func grabButtonRequest(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, EventMask EventMask, PointerMode GrabMode, KeyboardMode GrabMode, ConfineTo Window, Cursor Cursor, Button ButtonIndex, Modifiers ModMask) []byte {
+	return AppendGrabButtonRequest(nil, c, OwnerEvents, GrabWindow, EventMask, PointerMode, KeyboardMode, ConfineTo, Cursor, Button, Modifiers)
+}
+
+// AppendGrabButtonRequest appends a GrabButton request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGrabButtonRequest(buf []byte, c *xgb.Conn, OwnerEvents bool, GrabWindow Window, EventMask EventMask, PointerMode GrabMode, KeyboardMode GrabMode, ConfineTo Window, Cursor Cursor, Button ButtonIndex, Modifiers ModMask) []byte {
 	size := 24
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 28 // request opcode
 	b += 1
@@ -10456,46 +16177,176 @@ func grabButtonRequest(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, EventMa
 	}
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(GrabWindow))
+	order.Put32(buf[b:], uint32(GrabWindow))
 	b += 4
 
-	xgb.Put16(buf[b:], EventMask)
+	order.Put16(buf[b:], uint16(EventMask))
 	b += 2
 
-	buf[b] = PointerMode
+	buf[b] = byte(PointerMode)
 	b += 1
 
-	buf[b] = KeyboardMode
+	buf[b] = byte(KeyboardMode)
 	b += 1
 
-	xgb.Put32(buf[b:], uint32(ConfineTo))
+	order.Put32(buf[b:], uint32(ConfineTo))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Cursor))
+	order.Put32(buf[b:], uint32(Cursor))
 	b += 4
 
-	buf[b] = Button
+	buf[b] = byte(Button)
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], Modifiers)
+	order.Put16(buf[b:], uint16(Modifiers))
 	b += 2
 
 	return buf
 }
 
+// GrabButtonRequest represents the data of a GrabButton request, as it is read when tracing a connection.
+type GrabButtonRequest struct {
+	OwnerEvents  bool
+	GrabWindow   Window
+	EventMask    EventMask
+	PointerMode  GrabMode
+	KeyboardMode GrabMode
+	ConfineTo    Window
+	Cursor       Cursor
+	Button       ButtonIndex
+	// padding: 1 bytes
+	Modifiers ModMask
+}
+
+// grabButtonRequestRead reads a byte slice in the byte order 'order' into a GrabButtonRequest value.
+func grabButtonRequestRead(buf []byte, order xgb.ByteOrder) *GrabButtonRequest {
+	v := new(GrabButtonRequest)
+	b := 1 // skip request opcode
+
+	if buf[b] == 1 {
+		v.OwnerEvents = true
+	} else {
+		v.OwnerEvents = false
+	}
+	b += 1
+
+	b += 2 // skip request size
+
+	v.GrabWindow = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.EventMask = EventMask(order.Get16(buf[b:]))
+	b += 2
+
+	v.PointerMode = GrabMode(buf[b])
+	b += 1
+
+	v.KeyboardMode = GrabMode(buf[b])
+	b += 1
+
+	v.ConfineTo = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Cursor = Cursor(order.Get32(buf[b:]))
+	b += 4
+
+	v.Button = ButtonIndex(buf[b])
+	b += 1
+
+	b += 1 // padding
+
+	v.Modifiers = ModMask(order.Get16(buf[b:]))
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[28] = xgb.RequestInfo{
+		Name: "GrabButton",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return grabButtonRequestRead(buf, order)
+		},
+	}
+}
+
 // GrabKeyCookie is a cookie used only for GrabKey requests.
 type GrabKeyCookie struct {
 	*xgb.Cookie
 }
 
+// GrabKey grab keyboard key(s).
+//
+// Establishes a passive grab on the keyboard. In the future, the keyboard is
+// actively grabbed (as for `GrabKeyboard`), the last-keyboard-grab time is set
+// to the time at which the key was pressed (as transmitted in the KeyPress
+// event), and the KeyPress event is reported if all of the following
+// conditions are true:
+//
+// The keyboard is not grabbed and the specified key (which can itself be a
+// modifier key) is logically pressed when the specified modifier keys are
+// logically down, and no other modifier keys are logically down.
+//
+// Either the grab_window is an ancestor of (or is) the focus window, or the
+// grab_window is a descendant of the focus window and contains the pointer.
+//
+// A passive grab on the same key combination does not exist on any ancestor of
+// grab_window.
+//
+// The interpretation of the remaining arguments is as for XGrabKeyboard. The
+// active grab is terminated automatically when the logical state of the
+// keyboard has the specified key released (independent of the logical state of
+// the modifier keys), at which point a KeyRelease event is reported to the
+// grabbing window.
+//
+// Note that the logical state of a device (as seen by client applications) may
+// lag the physical state if device event processing is frozen.
+//
+// A modifiers argument of `ModMaskAny` is equivalent to issuing the request
+// for all possible modifier combinations (including the combination of no
+// modifiers). It is not required that all modifiers specified have currently
+// assigned KeyCodes. A key argument of `GrabAny` is equivalent to issuing the
+// request for all possible KeyCodes. Otherwise, the specified key must be in
+// the range specified by min_keycode and max_keycode in the connection setup,
+// or a BadValue error results.
+//
+// If some other client has issued a XGrabKey with the same key combination on
+// the same window, a BadAccess error results. When using `ModMaskAny` or
+// `GrabAny`, the request fails completely, and a BadAccess error results (no
+// grabs are established) if there is a conflicting grab for any combination.
+//
+// Parameters:
+//
+//   - OwnerEvents: If 1, the `grab_window` will still get the key events. If
+//     0, events are not reported to the `grab_window`.
+//   - GrabWindow: Specifies the window on which the key should be grabbed.
+//   - Key: The keycode of the key to grab. The special value `GrabAny` means
+//     grab any key.
+//   - Modifiers: The modifiers to grab. Using the special value `ModMaskAny`
+//     means grab the key with all possible modifier combinations.
+//   - PointerMode: Whether pointer events keep being processed while the
+//     keyboard is grabbed.
+//   - KeyboardMode: Whether keyboard events keep being processed while the
+//     keyboard is grabbed.
+//
+// Errors:
+//
+//   - AccessError: Another client has already issued a GrabKey with the same
+//     button/key combination on the same window.
+//   - ValueError: The key is not `GrabAny` and not in the range specified by
+//     `min_keycode` and `max_keycode` in the connection setup.
+//   - WindowError: The specified `window` does not exist.
+//
+// See also GrabKeyboard.
+//
 // GrabKey sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func GrabKey(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, Modifiers uint16, Key Keycode, PointerMode byte, KeyboardMode byte) GrabKeyCookie {
+func GrabKey(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, Modifiers ModMask, Key Keycode, PointerMode GrabMode, KeyboardMode GrabMode) GrabKeyCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(grabKeyRequest(c, OwnerEvents, GrabWindow, Modifiers, Key, PointerMode, KeyboardMode), cookie)
 	return GrabKeyCookie{cookie}
@@ -10503,56 +16354,124 @@ func GrabKey(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, Modifiers uint16,
 
 // GrabKeyChecked sends a checked request.
 // If an error occurs, it can be retrieved using GrabKeyCookie.Check()
-func GrabKeyChecked(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, Modifiers uint16, Key Keycode, PointerMode byte, KeyboardMode byte) GrabKeyCookie {
+func GrabKeyChecked(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, Modifiers ModMask, Key Keycode, PointerMode GrabMode, KeyboardMode GrabMode) GrabKeyCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(grabKeyRequest(c, OwnerEvents, GrabWindow, Modifiers, Key, PointerMode, KeyboardMode), cookie)
 	return GrabKeyCookie{cookie}
 }
 
-// Check returns an error if one occurred for checked requests that are not expecting a reply.
-// This cannot be called for requests expecting a reply, nor for unchecked requests.
-func (cook GrabKeyCookie) Check() error {
-	return cook.Cookie.Check()
+// Check returns an error if one occurred for checked requests that are not expecting a reply.
+// This cannot be called for requests expecting a reply, nor for unchecked requests.
+func (cook GrabKeyCookie) Check() error {
+	return cook.Cookie.Check()
+}
+
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook GrabKeyCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for GrabKey
+// grabKeyRequest writes a GrabKey request to a byte slice.
+func grabKeyRequest(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, Modifiers ModMask, Key Keycode, PointerMode GrabMode, KeyboardMode GrabMode) []byte {
+	return AppendGrabKeyRequest(nil, c, OwnerEvents, GrabWindow, Modifiers, Key, PointerMode, KeyboardMode)
+}
+
+// AppendGrabKeyRequest appends a GrabKey request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGrabKeyRequest(buf []byte, c *xgb.Conn, OwnerEvents bool, GrabWindow Window, Modifiers ModMask, Key Keycode, PointerMode GrabMode, KeyboardMode GrabMode) []byte {
+	size := 16
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	buf[b] = 33 // request opcode
+	b += 1
+
+	if OwnerEvents {
+		buf[b] = 1
+	} else {
+		buf[b] = 0
+	}
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(GrabWindow))
+	b += 4
+
+	order.Put16(buf[b:], uint16(Modifiers))
+	b += 2
+
+	buf[b] = byte(Key)
+	b += 1
+
+	buf[b] = byte(PointerMode)
+	b += 1
+
+	buf[b] = byte(KeyboardMode)
+	b += 1
+
+	b += 3 // padding
+
+	return buf
 }
 
-// Write request to wire for GrabKey
-// grabKeyRequest writes a GrabKey request to a byte slice.
-func grabKeyRequest(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, Modifiers uint16, Key Keycode, PointerMode byte, KeyboardMode byte) []byte {
-	size := 16
-	b := 0
-	buf := make([]byte, size)
+// GrabKeyRequest represents the data of a GrabKey request, as it is read when tracing a connection.
+type GrabKeyRequest struct {
+	OwnerEvents  bool
+	GrabWindow   Window
+	Modifiers    ModMask
+	Key          Keycode
+	PointerMode  GrabMode
+	KeyboardMode GrabMode
+	// padding: 3 bytes
+}
 
-	buf[b] = 33 // request opcode
-	b += 1
+// grabKeyRequestRead reads a byte slice in the byte order 'order' into a GrabKeyRequest value.
+func grabKeyRequestRead(buf []byte, order xgb.ByteOrder) *GrabKeyRequest {
+	v := new(GrabKeyRequest)
+	b := 1 // skip request opcode
 
-	if OwnerEvents {
-		buf[b] = 1
+	if buf[b] == 1 {
+		v.OwnerEvents = true
 	} else {
-		buf[b] = 0
+		v.OwnerEvents = false
 	}
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
-	b += 2
+	b += 2 // skip request size
 
-	xgb.Put32(buf[b:], uint32(GrabWindow))
+	v.GrabWindow = Window(order.Get32(buf[b:]))
 	b += 4
 
-	xgb.Put16(buf[b:], Modifiers)
+	v.Modifiers = ModMask(order.Get16(buf[b:]))
 	b += 2
 
-	buf[b] = byte(Key)
+	v.Key = Keycode(buf[b])
 	b += 1
 
-	buf[b] = PointerMode
+	v.PointerMode = GrabMode(buf[b])
 	b += 1
 
-	buf[b] = KeyboardMode
+	v.KeyboardMode = GrabMode(buf[b])
 	b += 1
 
 	b += 3 // padding
 
-	return buf
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[33] = xgb.RequestInfo{
+		Name: "GrabKey",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return grabKeyRequestRead(buf, order)
+		},
+	}
 }
 
 // GrabKeyboardCookie is a cookie used only for GrabKeyboard requests.
@@ -10560,9 +16479,40 @@ type GrabKeyboardCookie struct {
 	*xgb.Cookie
 }
 
+// GrabKeyboard grab the keyboard.
+//
+// Actively grabs control of the keyboard and generates FocusIn and FocusOut
+// events. Further key events are reported only to the grabbing client.
+//
+// Any active keyboard grab by this client is overridden. If the keyboard is
+// actively grabbed by some other client, `GrabStatusAlreadyGrabbed` is
+// returned. If `grab_window` is not viewable, `GrabStatusNotViewable` is
+// returned. If the keyboard is frozen by an active grab of another client,
+// `GrabStatusFrozen` is returned. If the specified `time` is earlier than the
+// last-keyboard-grab time or later than the current X server time,
+// `GrabStatusInvalidTime` is returned. Otherwise, the last-keyboard-grab time
+// is set to the specified time.
+//
+// Parameters:
+//
+//   - OwnerEvents: If 1, the `grab_window` will still get the keyboard events.
+//     If 0, events are not reported to the `grab_window`.
+//   - GrabWindow: Specifies the window on which the keyboard should be
+//     grabbed.
+//   - Time: Timestamp to avoid race conditions when running X over the
+//     network. The special value `TimeCurrentTime` will be replaced with the
+//     current server time.
+//
+// Errors:
+//
+//   - ValueError: TODO: reasons?
+//   - WindowError: The specified `window` does not exist.
+//
+// See also GrabPointer.
+//
 // GrabKeyboard sends a checked request.
 // If an error occurs, it will be returned with the reply by calling GrabKeyboardCookie.Reply()
-func GrabKeyboard(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, Time Timestamp, PointerMode byte, KeyboardMode byte) GrabKeyboardCookie {
+func GrabKeyboard(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, Time Timestamp, PointerMode GrabMode, KeyboardMode GrabMode) GrabKeyboardCookie {
 	cookie := c.NewCookie(true, true)
 	c.NewRequest(grabKeyboardRequest(c, OwnerEvents, GrabWindow, Time, PointerMode, KeyboardMode), cookie)
 	return GrabKeyboardCookie{cookie}
@@ -10570,7 +16520,7 @@ func GrabKeyboard(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, Time Timesta
 
 // GrabKeyboardUnchecked sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func GrabKeyboardUnchecked(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, Time Timestamp, PointerMode byte, KeyboardMode byte) GrabKeyboardCookie {
+func GrabKeyboardUnchecked(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, Time Timestamp, PointerMode GrabMode, KeyboardMode GrabMode) GrabKeyboardCookie {
 	cookie := c.NewCookie(false, true)
 	c.NewRequest(grabKeyboardRequest(c, OwnerEvents, GrabWindow, Time, PointerMode, KeyboardMode), cookie)
 	return GrabKeyboardCookie{cookie}
@@ -10580,7 +16530,7 @@ func GrabKeyboardUnchecked(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, Tim
 type GrabKeyboardReply struct {
 	Sequence uint16 // sequence number of the request for this reply
 	Length   uint32 // number of bytes in this reply
-	Status   byte
+	Status   GrabStatus
 }
 
 // Reply blocks and returns the reply data for a GrabKeyboard request.
@@ -10592,21 +16542,33 @@ func (cook GrabKeyboardCookie) Reply() (*GrabKeyboardReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return grabKeyboardReply(buf), nil
+	return grabKeyboardReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GrabKeyboard request arrives.
+func (cook GrabKeyboardCookie) ReplyContext(ctx context.Context) (*GrabKeyboardReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return grabKeyboardReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// grabKeyboardReply reads a byte slice into a GrabKeyboardReply value.
-func grabKeyboardReply(buf []byte) *GrabKeyboardReply {
+// grabKeyboardReply reads a byte slice in the byte order 'order' into a GrabKeyboardReply value.
+func grabKeyboardReply(buf []byte, order xgb.ByteOrder) *GrabKeyboardReply {
 	v := new(GrabKeyboardReply)
 	b := 1 // skip reply determinant
 
-	v.Status = buf[b]
+	v.Status = GrabStatus(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	return v
@@ -10614,10 +16576,19 @@ func grabKeyboardReply(buf []byte) *GrabKeyboardReply {
 
 // Write request to wire for GrabKeyboard
 // grabKeyboardRequest writes a GrabKeyboard request to a byte slice.
-func grabKeyboardRequest(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, Time Timestamp, PointerMode byte, KeyboardMode byte) []byte {
+func grabKeyboardRequest(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, Time Timestamp, PointerMode GrabMode, KeyboardMode GrabMode) []byte {
+	return AppendGrabKeyboardRequest(nil, c, OwnerEvents, GrabWindow, Time, PointerMode, KeyboardMode)
+}
+
+// AppendGrabKeyboardRequest appends a GrabKeyboard request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGrabKeyboardRequest(buf []byte, c *xgb.Conn, OwnerEvents bool, GrabWindow Window, Time Timestamp, PointerMode GrabMode, KeyboardMode GrabMode) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 31 // request opcode
 	b += 1
@@ -10629,19 +16600,19 @@ func grabKeyboardRequest(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, Time
 	}
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(GrabWindow))
+	order.Put32(buf[b:], uint32(GrabWindow))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Time))
+	order.Put32(buf[b:], uint32(Time))
 	b += 4
 
-	buf[b] = PointerMode
+	buf[b] = byte(PointerMode)
 	b += 1
 
-	buf[b] = KeyboardMode
+	buf[b] = byte(KeyboardMode)
 	b += 1
 
 	b += 2 // padding
@@ -10649,14 +16620,103 @@ func grabKeyboardRequest(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, Time
 	return buf
 }
 
+// GrabKeyboardRequest represents the data of a GrabKeyboard request, as it is read when tracing a connection.
+type GrabKeyboardRequest struct {
+	OwnerEvents  bool
+	GrabWindow   Window
+	Time         Timestamp
+	PointerMode  GrabMode
+	KeyboardMode GrabMode
+	// padding: 2 bytes
+}
+
+// grabKeyboardRequestRead reads a byte slice in the byte order 'order' into a GrabKeyboardRequest value.
+func grabKeyboardRequestRead(buf []byte, order xgb.ByteOrder) *GrabKeyboardRequest {
+	v := new(GrabKeyboardRequest)
+	b := 1 // skip request opcode
+
+	if buf[b] == 1 {
+		v.OwnerEvents = true
+	} else {
+		v.OwnerEvents = false
+	}
+	b += 1
+
+	b += 2 // skip request size
+
+	v.GrabWindow = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Time = Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	v.PointerMode = GrabMode(buf[b])
+	b += 1
+
+	v.KeyboardMode = GrabMode(buf[b])
+	b += 1
+
+	b += 2 // padding
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[31] = xgb.RequestInfo{
+		Name: "GrabKeyboard",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return grabKeyboardRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return grabKeyboardReply(buf, order)
+		},
+	}
+}
+
 // GrabPointerCookie is a cookie used only for GrabPointer requests.
 type GrabPointerCookie struct {
 	*xgb.Cookie
 }
 
+// GrabPointer grab the pointer.
+//
+// Actively grabs control of the pointer. Further pointer events are reported
+// only to the grabbing client. Overrides any active pointer grab by this
+// client.
+//
+// Parameters:
+//
+//   - OwnerEvents: If 1, the `grab_window` will still get the pointer events.
+//     If 0, events are not reported to the `grab_window`.
+//   - GrabWindow: Specifies the window on which the pointer should be grabbed.
+//   - EventMask: Specifies which pointer events are reported to the client.
+//     TODO: which values?
+//   - ConfineTo: Specifies the window to confine the pointer in (the user will
+//     not be able to move the pointer out of that window). The special value
+//     `WindowNone` means don't confine the pointer.
+//   - Cursor: Specifies the cursor that should be displayed or `CursorNone` to
+//     not change the cursor.
+//   - Time: The time argument allows you to avoid certain circumstances that
+//     come up if applications take a long time to respond or if there are long
+//     network delays. Consider a situation where you have two applications,
+//     both of which normally grab the pointer when clicked on. If both
+//     applications specify the timestamp from the event, the second
+//     application may wake up faster and successfully grab the pointer before
+//     the first application. The first application then will get an indication
+//     that the other application grabbed the pointer before its request was
+//     processed. The special value `TimeCurrentTime` will be replaced with the
+//     current server time.
+//
+// Errors:
+//
+//   - ValueError: TODO: reasons?
+//   - WindowError: The specified `window` does not exist.
+//
+// See also GrabKeyboard.
+//
 // GrabPointer sends a checked request.
 // If an error occurs, it will be returned with the reply by calling GrabPointerCookie.Reply()
-func GrabPointer(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, EventMask uint16, PointerMode byte, KeyboardMode byte, ConfineTo Window, Cursor Cursor, Time Timestamp) GrabPointerCookie {
+func GrabPointer(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, EventMask EventMask, PointerMode GrabMode, KeyboardMode GrabMode, ConfineTo Window, Cursor Cursor, Time Timestamp) GrabPointerCookie {
 	cookie := c.NewCookie(true, true)
 	c.NewRequest(grabPointerRequest(c, OwnerEvents, GrabWindow, EventMask, PointerMode, KeyboardMode, ConfineTo, Cursor, Time), cookie)
 	return GrabPointerCookie{cookie}
@@ -10664,7 +16724,7 @@ func GrabPointer(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, EventMask uin
 
 // GrabPointerUnchecked sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func GrabPointerUnchecked(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, EventMask uint16, PointerMode byte, KeyboardMode byte, ConfineTo Window, Cursor Cursor, Time Timestamp) GrabPointerCookie {
+func GrabPointerUnchecked(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, EventMask EventMask, PointerMode GrabMode, KeyboardMode GrabMode, ConfineTo Window, Cursor Cursor, Time Timestamp) GrabPointerCookie {
 	cookie := c.NewCookie(false, true)
 	c.NewRequest(grabPointerRequest(c, OwnerEvents, GrabWindow, EventMask, PointerMode, KeyboardMode, ConfineTo, Cursor, Time), cookie)
 	return GrabPointerCookie{cookie}
@@ -10674,7 +16734,7 @@ func GrabPointerUnchecked(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, Even
 type GrabPointerReply struct {
 	Sequence uint16 // sequence number of the request for this reply
 	Length   uint32 // number of bytes in this reply
-	Status   byte
+	Status   GrabStatus
 }
 
 // Reply blocks and returns the reply data for a GrabPointer request.
@@ -10686,21 +16746,33 @@ func (cook GrabPointerCookie) Reply() (*GrabPointerReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return grabPointerReply(buf), nil
+	return grabPointerReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GrabPointer request arrives.
+func (cook GrabPointerCookie) ReplyContext(ctx context.Context) (*GrabPointerReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return grabPointerReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// grabPointerReply reads a byte slice into a GrabPointerReply value.
-func grabPointerReply(buf []byte) *GrabPointerReply {
+// grabPointerReply reads a byte slice in the byte order 'order' into a GrabPointerReply value.
+func grabPointerReply(buf []byte, order xgb.ByteOrder) *GrabPointerReply {
 	v := new(GrabPointerReply)
 	b := 1 // skip reply determinant
 
-	v.Status = buf[b]
+	v.Status = GrabStatus(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	return v
@@ -10708,10 +16780,19 @@ func grabPointerReply(buf []byte) *GrabPointerReply {
 
 // Write request to wire for GrabPointer
 // grabPointerRequest writes a GrabPointer request to a byte slice.
-func grabPointerRequest(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, EventMask uint16, PointerMode byte, KeyboardMode byte, ConfineTo Window, Cursor Cursor, Time Timestamp) []byte {
+func grabPointerRequest(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, EventMask EventMask, PointerMode GrabMode, KeyboardMode GrabMode, ConfineTo Window, Cursor Cursor, Time Timestamp) []byte {
+	return AppendGrabPointerRequest(nil, c, OwnerEvents, GrabWindow, EventMask, PointerMode, KeyboardMode, ConfineTo, Cursor, Time)
+}
+
+// AppendGrabPointerRequest appends a GrabPointer request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGrabPointerRequest(buf []byte, c *xgb.Conn, OwnerEvents bool, GrabWindow Window, EventMask EventMask, PointerMode GrabMode, KeyboardMode GrabMode, ConfineTo Window, Cursor Cursor, Time Timestamp) []byte {
 	size := 24
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 26 // request opcode
 	b += 1
@@ -10723,33 +16804,95 @@ func grabPointerRequest(c *xgb.Conn, OwnerEvents bool, GrabWindow Window, EventM
 	}
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(GrabWindow))
+	order.Put32(buf[b:], uint32(GrabWindow))
 	b += 4
 
-	xgb.Put16(buf[b:], EventMask)
+	order.Put16(buf[b:], uint16(EventMask))
 	b += 2
 
-	buf[b] = PointerMode
+	buf[b] = byte(PointerMode)
 	b += 1
 
-	buf[b] = KeyboardMode
+	buf[b] = byte(KeyboardMode)
 	b += 1
 
-	xgb.Put32(buf[b:], uint32(ConfineTo))
+	order.Put32(buf[b:], uint32(ConfineTo))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Cursor))
+	order.Put32(buf[b:], uint32(Cursor))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Time))
+	order.Put32(buf[b:], uint32(Time))
 	b += 4
 
 	return buf
 }
 
+// GrabPointerRequest represents the data of a GrabPointer request, as it is read when tracing a connection.
+type GrabPointerRequest struct {
+	OwnerEvents  bool
+	GrabWindow   Window
+	EventMask    EventMask
+	PointerMode  GrabMode
+	KeyboardMode GrabMode
+	ConfineTo    Window
+	Cursor       Cursor
+	Time         Timestamp
+}
+
+// grabPointerRequestRead reads a byte slice in the byte order 'order' into a GrabPointerRequest value.
+func grabPointerRequestRead(buf []byte, order xgb.ByteOrder) *GrabPointerRequest {
+	v := new(GrabPointerRequest)
+	b := 1 // skip request opcode
+
+	if buf[b] == 1 {
+		v.OwnerEvents = true
+	} else {
+		v.OwnerEvents = false
+	}
+	b += 1
+
+	b += 2 // skip request size
+
+	v.GrabWindow = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.EventMask = EventMask(order.Get16(buf[b:]))
+	b += 2
+
+	v.PointerMode = GrabMode(buf[b])
+	b += 1
+
+	v.KeyboardMode = GrabMode(buf[b])
+	b += 1
+
+	v.ConfineTo = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Cursor = Cursor(order.Get32(buf[b:]))
+	b += 4
+
+	v.Time = Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[26] = xgb.RequestInfo{
+		Name: "GrabPointer",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return grabPointerRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return grabPointerReply(buf, order)
+		},
+	}
+}
+
 // GrabServerCookie is a cookie used only for GrabServer requests.
 type GrabServerCookie struct {
 	*xgb.Cookie
@@ -10777,28 +16920,97 @@ func (cook GrabServerCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook GrabServerCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for GrabServer
 // grabServerRequest writes a GrabServer request to a byte slice.
 func grabServerRequest(c *xgb.Conn) []byte {
+	return AppendGrabServerRequest(nil, c)
+}
+
+// AppendGrabServerRequest appends a GrabServer request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGrabServerRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 36 // request opcode
 	b += 1
 
-	b += 1                             // padding
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 1                               // padding
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// GrabServerRequest represents the data of a GrabServer request, as it is read when tracing a connection.
+type GrabServerRequest struct {
+}
+
+// grabServerRequestRead reads a byte slice in the byte order 'order' into a GrabServerRequest value.
+func grabServerRequestRead(buf []byte, order xgb.ByteOrder) *GrabServerRequest {
+	v := new(GrabServerRequest)
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[36] = xgb.RequestInfo{
+		Name: "GrabServer",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return grabServerRequestRead(buf, order)
+		},
+	}
+}
+
 // ImageText16Cookie is a cookie used only for ImageText16 requests.
 type ImageText16Cookie struct {
 	*xgb.Cookie
 }
 
+// ImageText16 draws text.
+//
+// Fills the destination rectangle with the background pixel from `gc`, then
+// paints the text with the foreground pixel from `gc`. The upper-left corner
+// of the filled rectangle is at [x, y - font-ascent]. The width is
+// overall-width, the height is font-ascent + font-descent. The overall-width,
+// font-ascent and font-descent are as returned by `QueryTextExtents` (TODO).
+//
+// Note that using X core fonts is deprecated (but still supported) in favor of
+// client-side rendering using Xft.
+//
+// Parameters:
+//
+//   - Drawable: The drawable (Window or Pixmap) to draw text on.
+//   - StringLen: The length of the `string` in characters. Note that this
+//     parameter limited by 255 due to using 8 bits!
+//   - String: The string to draw. Only the first 255 characters are relevant
+//     due to the data type of `string_len`. Every character uses 2 bytes
+//     (hence the 16 in this request's name).
+//   - X: The x coordinate of the first character, relative to the origin of
+//     `drawable`.
+//   - Y: The y coordinate of the first character, relative to the origin of
+//     `drawable`.
+//   - Gc: The graphics context to use. The following graphics context
+//     components are used: plane-mask, foreground, background, font,
+//     subwindow-mode, clip-x-origin, clip-y-origin, and clip-mask.
+//
+// Errors:
+//
+//   - DrawableError: The specified `drawable` (Window or Pixmap) does not
+//     exist.
+//   - GContextError: The specified graphics context does not exist.
+//   - MatchError: TODO: reasons?
+//
+// See also ImageText8.
+//
 // ImageText16 sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
 func ImageText16(c *xgb.Conn, StringLen byte, Drawable Drawable, Gc Gcontext, X int16, Y int16, String []Char2b) ImageText16Cookie {
@@ -10821,12 +17033,26 @@ func (cook ImageText16Cookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ImageText16Cookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ImageText16
 // imageText16Request writes a ImageText16 request to a byte slice.
 func imageText16Request(c *xgb.Conn, StringLen byte, Drawable Drawable, Gc Gcontext, X int16, Y int16, String []Char2b) []byte {
+	return AppendImageText16Request(nil, c, StringLen, Drawable, Gc, X, Y, String)
+}
+
+// AppendImageText16Request appends a ImageText16 request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendImageText16Request(buf []byte, c *xgb.Conn, StringLen byte, Drawable Drawable, Gc Gcontext, X int16, Y int16, String []Char2b) []byte {
 	size := xgb.Pad((16 + xgb.Pad((int(StringLen) * 2))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 77 // request opcode
 	b += 1
@@ -10834,31 +17060,113 @@ func imageText16Request(c *xgb.Conn, StringLen byte, Drawable Drawable, Gc Gcont
 	buf[b] = StringLen
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(X))
+	order.Put16(buf[b:], uint16(X))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(Y))
+	order.Put16(buf[b:], uint16(Y))
 	b += 2
 
-	b += Char2bListBytes(buf[b:], String)
+	b += Char2bListBytes(buf[b:], order, String)
 
 	return buf
 }
 
+// ImageText16Request represents the data of a ImageText16 request, as it is read when tracing a connection.
+type ImageText16Request struct {
+	StringLen byte
+	Drawable  Drawable
+	Gc        Gcontext
+	X         int16
+	Y         int16
+	String    []Char2b // size: xgb.Pad((int(StringLen) * 2))
+}
+
+// imageText16RequestRead reads a byte slice in the byte order 'order' into a ImageText16Request value.
+func imageText16RequestRead(buf []byte, order xgb.ByteOrder) *ImageText16Request {
+	v := new(ImageText16Request)
+	b := 1 // skip request opcode
+
+	v.StringLen = buf[b]
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.X = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Y = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.String = make([]Char2b, v.StringLen)
+	b += Char2bReadList(buf[b:], order, v.String)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[77] = xgb.RequestInfo{
+		Name: "ImageText16",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return imageText16RequestRead(buf, order)
+		},
+	}
+}
+
 // ImageText8Cookie is a cookie used only for ImageText8 requests.
 type ImageText8Cookie struct {
 	*xgb.Cookie
 }
 
+// ImageText8 draws text.
+//
+// Fills the destination rectangle with the background pixel from `gc`, then
+// paints the text with the foreground pixel from `gc`. The upper-left corner
+// of the filled rectangle is at [x, y - font-ascent]. The width is
+// overall-width, the height is font-ascent + font-descent. The overall-width,
+// font-ascent and font-descent are as returned by `QueryTextExtents` (TODO).
+//
+// Note that using X core fonts is deprecated (but still supported) in favor of
+// client-side rendering using Xft.
+//
+// Parameters:
+//
+//   - Drawable: The drawable (Window or Pixmap) to draw text on.
+//   - StringLen: The length of the `string`. Note that this parameter limited
+//     by 255 due to using 8 bits!
+//   - String: The string to draw. Only the first 255 characters are relevant
+//     due to the data type of `string_len`.
+//   - X: The x coordinate of the first character, relative to the origin of
+//     `drawable`.
+//   - Y: The y coordinate of the first character, relative to the origin of
+//     `drawable`.
+//   - Gc: The graphics context to use. The following graphics context
+//     components are used: plane-mask, foreground, background, font,
+//     subwindow-mode, clip-x-origin, clip-y-origin, and clip-mask.
+//
+// Errors:
+//
+//   - DrawableError: The specified `drawable` (Window or Pixmap) does not
+//     exist.
+//   - GContextError: The specified graphics context does not exist.
+//   - MatchError: TODO: reasons?
+//
+// See also ImageText16.
+//
 // ImageText8 sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
 func ImageText8(c *xgb.Conn, StringLen byte, Drawable Drawable, Gc Gcontext, X int16, Y int16, String string) ImageText8Cookie {
@@ -10881,12 +17189,26 @@ func (cook ImageText8Cookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ImageText8Cookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ImageText8
 // imageText8Request writes a ImageText8 request to a byte slice.
 func imageText8Request(c *xgb.Conn, StringLen byte, Drawable Drawable, Gc Gcontext, X int16, Y int16, String string) []byte {
+	return AppendImageText8Request(nil, c, StringLen, Drawable, Gc, X, Y, String)
+}
+
+// AppendImageText8Request appends a ImageText8 request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendImageText8Request(buf []byte, c *xgb.Conn, StringLen byte, Drawable Drawable, Gc Gcontext, X int16, Y int16, String string) []byte {
 	size := xgb.Pad((16 + xgb.Pad((int(StringLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 76 // request opcode
 	b += 1
@@ -10894,19 +17216,19 @@ func imageText8Request(c *xgb.Conn, StringLen byte, Drawable Drawable, Gc Gconte
 	buf[b] = StringLen
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(X))
+	order.Put16(buf[b:], uint16(X))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(Y))
+	order.Put16(buf[b:], uint16(Y))
 	b += 2
 
 	copy(buf[b:], String[:StringLen])
@@ -10915,6 +17237,57 @@ func imageText8Request(c *xgb.Conn, StringLen byte, Drawable Drawable, Gc Gconte
 	return buf
 }
 
+// ImageText8Request represents the data of a ImageText8 request, as it is read when tracing a connection.
+type ImageText8Request struct {
+	StringLen byte
+	Drawable  Drawable
+	Gc        Gcontext
+	X         int16
+	Y         int16
+	String    string // size: xgb.Pad((int(StringLen) * 1))
+}
+
+// imageText8RequestRead reads a byte slice in the byte order 'order' into a ImageText8Request value.
+func imageText8RequestRead(buf []byte, order xgb.ByteOrder) *ImageText8Request {
+	v := new(ImageText8Request)
+	b := 1 // skip request opcode
+
+	v.StringLen = buf[b]
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.X = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Y = int16(order.Get16(buf[b:]))
+	b += 2
+
+	{
+		byteString := make([]byte, v.StringLen)
+		copy(byteString[:v.StringLen], buf[b:])
+		v.String = string(byteString)
+		b += int(v.StringLen)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[76] = xgb.RequestInfo{
+		Name: "ImageText8",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return imageText8RequestRead(buf, order)
+		},
+	}
+}
+
 // InstallColormapCookie is a cookie used only for InstallColormap requests.
 type InstallColormapCookie struct {
 	*xgb.Cookie
@@ -10942,32 +17315,100 @@ func (cook InstallColormapCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook InstallColormapCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for InstallColormap
 // installColormapRequest writes a InstallColormap request to a byte slice.
 func installColormapRequest(c *xgb.Conn, Cmap Colormap) []byte {
+	return AppendInstallColormapRequest(nil, c, Cmap)
+}
+
+// AppendInstallColormapRequest appends a InstallColormap request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendInstallColormapRequest(buf []byte, c *xgb.Conn, Cmap Colormap) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 81 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Cmap))
+	order.Put32(buf[b:], uint32(Cmap))
 	b += 4
 
 	return buf
 }
 
+// InstallColormapRequest represents the data of a InstallColormap request, as it is read when tracing a connection.
+type InstallColormapRequest struct {
+	// padding: 1 bytes
+	Cmap Colormap
+}
+
+// installColormapRequestRead reads a byte slice in the byte order 'order' into a InstallColormapRequest value.
+func installColormapRequestRead(buf []byte, order xgb.ByteOrder) *InstallColormapRequest {
+	v := new(InstallColormapRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Cmap = Colormap(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[81] = xgb.RequestInfo{
+		Name: "InstallColormap",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return installColormapRequestRead(buf, order)
+		},
+	}
+}
+
 // InternAtomCookie is a cookie used only for InternAtom requests.
 type InternAtomCookie struct {
 	*xgb.Cookie
 }
 
+// InternAtom get atom identifier by name.
+//
+// Retrieves the identifier (Atom) for the atom with the specified name. Atoms
+// are used in protocols like EWMH, for example to store window titles
+// (`_NET_WM_NAME` atom) as property of a window.
+//
+// If `only_if_exists` is 0, the atom will be created if it does not already
+// exist. If `only_if_exists` is 1, `AtomNone` will be returned if the atom
+// does not yet exist.
+//
+// Parameters:
+//
+//   - NameLen: The length of the following `name`.
+//   - Name: The name of the atom.
+//   - OnlyIfExists: Return a valid atom id only if the atom already exists.
+//
+// Errors:
+//
+//   - AllocError: TODO: reasons?
+//   - ValueError: A value other than 0 or 1 was specified for
+//     `only_if_exists`.
+//
+// See also xlsatoms(1), GetAtomName.
+//
 // InternAtom sends a checked request.
 // If an error occurs, it will be returned with the reply by calling InternAtomCookie.Reply()
 func InternAtom(c *xgb.Conn, OnlyIfExists bool, NameLen uint16, Name string) InternAtomCookie {
@@ -11001,23 +17442,35 @@ func (cook InternAtomCookie) Reply() (*InternAtomReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return internAtomReply(buf), nil
+	return internAtomReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a InternAtom request arrives.
+func (cook InternAtomCookie) ReplyContext(ctx context.Context) (*InternAtomReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return internAtomReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// internAtomReply reads a byte slice into a InternAtomReply value.
-func internAtomReply(buf []byte) *InternAtomReply {
+// internAtomReply reads a byte slice in the byte order 'order' into a InternAtomReply value.
+func internAtomReply(buf []byte, order xgb.ByteOrder) *InternAtomReply {
 	v := new(InternAtomReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Atom = Atom(xgb.Get32(buf[b:]))
+	v.Atom = Atom(order.Get32(buf[b:]))
 	b += 4
 
 	return v
@@ -11026,9 +17479,18 @@ func internAtomReply(buf []byte) *InternAtomReply {
 // Write request to wire for InternAtom
 // internAtomRequest writes a InternAtom request to a byte slice.
 func internAtomRequest(c *xgb.Conn, OnlyIfExists bool, NameLen uint16, Name string) []byte {
+	return AppendInternAtomRequest(nil, c, OnlyIfExists, NameLen, Name)
+}
+
+// AppendInternAtomRequest appends a InternAtom request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendInternAtomRequest(buf []byte, c *xgb.Conn, OnlyIfExists bool, NameLen uint16, Name string) []byte {
 	size := xgb.Pad((8 + xgb.Pad((int(NameLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 16 // request opcode
 	b += 1
@@ -11040,10 +17502,10 @@ func internAtomRequest(c *xgb.Conn, OnlyIfExists bool, NameLen uint16, Name stri
 	}
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], NameLen)
+	order.Put16(buf[b:], NameLen)
 	b += 2
 
 	b += 2 // padding
@@ -11054,11 +17516,77 @@ func internAtomRequest(c *xgb.Conn, OnlyIfExists bool, NameLen uint16, Name stri
 	return buf
 }
 
+// InternAtomRequest represents the data of a InternAtom request, as it is read when tracing a connection.
+type InternAtomRequest struct {
+	OnlyIfExists bool
+	NameLen      uint16
+	// padding: 2 bytes
+	Name string // size: xgb.Pad((int(NameLen) * 1))
+}
+
+// internAtomRequestRead reads a byte slice in the byte order 'order' into a InternAtomRequest value.
+func internAtomRequestRead(buf []byte, order xgb.ByteOrder) *InternAtomRequest {
+	v := new(InternAtomRequest)
+	b := 1 // skip request opcode
+
+	if buf[b] == 1 {
+		v.OnlyIfExists = true
+	} else {
+		v.OnlyIfExists = false
+	}
+	b += 1
+
+	b += 2 // skip request size
+
+	v.NameLen = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	{
+		byteString := make([]byte, v.NameLen)
+		copy(byteString[:v.NameLen], buf[b:])
+		v.Name = string(byteString)
+		b += int(v.NameLen)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[16] = xgb.RequestInfo{
+		Name: "InternAtom",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return internAtomRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return internAtomReply(buf, order)
+		},
+	}
+}
+
 // KillClientCookie is a cookie used only for KillClient requests.
 type KillClientCookie struct {
 	*xgb.Cookie
 }
 
+// KillClient kills a client.
+//
+// Forces a close down of the client that created the specified `resource`.
+//
+// Parameters:
+//
+//   - Resource: Any resource belonging to the client (for example a Window),
+//     used to identify the client connection. The special value of
+//     `KillAllTemporary`, the resources of all clients that have terminated in
+//     `RetainTemporary` (TODO) are destroyed.
+//
+// Errors:
+//
+//   - ValueError: The specified `resource` does not exist.
+//
+// See also xkill(1).
+//
 // KillClient sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
 func KillClient(c *xgb.Conn, Resource uint32) KillClientCookie {
@@ -11081,25 +17609,69 @@ func (cook KillClientCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook KillClientCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for KillClient
 // killClientRequest writes a KillClient request to a byte slice.
 func killClientRequest(c *xgb.Conn, Resource uint32) []byte {
+	return AppendKillClientRequest(nil, c, Resource)
+}
+
+// AppendKillClientRequest appends a KillClient request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendKillClientRequest(buf []byte, c *xgb.Conn, Resource uint32) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	buf[b] = 113 // request opcode
+	b += 1
+
+	b += 1 // padding
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], Resource)
+	b += 4
+
+	return buf
+}
 
-	buf[b] = 113 // request opcode
-	b += 1
+// KillClientRequest represents the data of a KillClient request, as it is read when tracing a connection.
+type KillClientRequest struct {
+	// padding: 1 bytes
+	Resource uint32
+}
+
+// killClientRequestRead reads a byte slice in the byte order 'order' into a KillClientRequest value.
+func killClientRequestRead(buf []byte, order xgb.ByteOrder) *KillClientRequest {
+	v := new(KillClientRequest)
+	b := 1 // skip request opcode
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
-	b += 2
+	b += 2 // skip request size
 
-	xgb.Put32(buf[b:], Resource)
+	v.Resource = order.Get32(buf[b:])
 	b += 4
 
-	return buf
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[113] = xgb.RequestInfo{
+		Name: "KillClient",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return killClientRequestRead(buf, order)
+		},
+	}
 }
 
 // ListExtensionsCookie is a cookie used only for ListExtensions requests.
@@ -11141,27 +17713,39 @@ func (cook ListExtensionsCookie) Reply() (*ListExtensionsReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return listExtensionsReply(buf), nil
+	return listExtensionsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a ListExtensions request arrives.
+func (cook ListExtensionsCookie) ReplyContext(ctx context.Context) (*ListExtensionsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return listExtensionsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// listExtensionsReply reads a byte slice into a ListExtensionsReply value.
-func listExtensionsReply(buf []byte) *ListExtensionsReply {
+// listExtensionsReply reads a byte slice in the byte order 'order' into a ListExtensionsReply value.
+func listExtensionsReply(buf []byte, order xgb.ByteOrder) *ListExtensionsReply {
 	v := new(ListExtensionsReply)
 	b := 1 // skip reply determinant
 
 	v.NamesLen = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 24 // padding
 
 	v.Names = make([]Str, v.NamesLen)
-	b += StrReadList(buf[b:], v.Names)
+	b += StrReadList(buf[b:], order, v.Names)
 
 	return v
 }
@@ -11169,20 +17753,51 @@ func listExtensionsReply(buf []byte) *ListExtensionsReply {
 // Write request to wire for ListExtensions
 // listExtensionsRequest writes a ListExtensions request to a byte slice.
 func listExtensionsRequest(c *xgb.Conn) []byte {
+	return AppendListExtensionsRequest(nil, c)
+}
+
+// AppendListExtensionsRequest appends a ListExtensions request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendListExtensionsRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 99 // request opcode
 	b += 1
 
-	b += 1                             // padding
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 1                               // padding
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// ListExtensionsRequest represents the data of a ListExtensions request, as it is read when tracing a connection.
+type ListExtensionsRequest struct {
+}
+
+// listExtensionsRequestRead reads a byte slice in the byte order 'order' into a ListExtensionsRequest value.
+func listExtensionsRequestRead(buf []byte, order xgb.ByteOrder) *ListExtensionsRequest {
+	v := new(ListExtensionsRequest)
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[99] = xgb.RequestInfo{
+		Name: "ListExtensions",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listExtensionsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listExtensionsReply(buf, order)
+		},
+	}
+}
+
 // ListFontsCookie is a cookie used only for ListFonts requests.
 type ListFontsCookie struct {
 	*xgb.Cookie
@@ -11223,29 +17838,41 @@ func (cook ListFontsCookie) Reply() (*ListFontsReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return listFontsReply(buf), nil
+	return listFontsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a ListFonts request arrives.
+func (cook ListFontsCookie) ReplyContext(ctx context.Context) (*ListFontsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return listFontsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// listFontsReply reads a byte slice into a ListFontsReply value.
-func listFontsReply(buf []byte) *ListFontsReply {
+// listFontsReply reads a byte slice in the byte order 'order' into a ListFontsReply value.
+func listFontsReply(buf []byte, order xgb.ByteOrder) *ListFontsReply {
 	v := new(ListFontsReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.NamesLen = xgb.Get16(buf[b:])
+	v.NamesLen = order.Get16(buf[b:])
 	b += 2
 
 	b += 22 // padding
 
 	v.Names = make([]Str, v.NamesLen)
-	b += StrReadList(buf[b:], v.Names)
+	b += StrReadList(buf[b:], order, v.Names)
 
 	return v
 }
@@ -11253,22 +17880,31 @@ func listFontsReply(buf []byte) *ListFontsReply {
 // Write request to wire for ListFonts
 // listFontsRequest writes a ListFonts request to a byte slice.
 func listFontsRequest(c *xgb.Conn, MaxNames uint16, PatternLen uint16, Pattern string) []byte {
+	return AppendListFontsRequest(nil, c, MaxNames, PatternLen, Pattern)
+}
+
+// AppendListFontsRequest appends a ListFonts request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendListFontsRequest(buf []byte, c *xgb.Conn, MaxNames uint16, PatternLen uint16, Pattern string) []byte {
 	size := xgb.Pad((8 + xgb.Pad((int(PatternLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 49 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], MaxNames)
+	order.Put16(buf[b:], MaxNames)
 	b += 2
 
-	xgb.Put16(buf[b:], PatternLen)
+	order.Put16(buf[b:], PatternLen)
 	b += 2
 
 	copy(buf[b:], Pattern[:PatternLen])
@@ -11277,6 +17913,51 @@ func listFontsRequest(c *xgb.Conn, MaxNames uint16, PatternLen uint16, Pattern s
 	return buf
 }
 
+// ListFontsRequest represents the data of a ListFonts request, as it is read when tracing a connection.
+type ListFontsRequest struct {
+	// padding: 1 bytes
+	MaxNames   uint16
+	PatternLen uint16
+	Pattern    string // size: xgb.Pad((int(PatternLen) * 1))
+}
+
+// listFontsRequestRead reads a byte slice in the byte order 'order' into a ListFontsRequest value.
+func listFontsRequestRead(buf []byte, order xgb.ByteOrder) *ListFontsRequest {
+	v := new(ListFontsRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.MaxNames = order.Get16(buf[b:])
+	b += 2
+
+	v.PatternLen = order.Get16(buf[b:])
+	b += 2
+
+	{
+		byteString := make([]byte, v.PatternLen)
+		copy(byteString[:v.PatternLen], buf[b:])
+		v.Pattern = string(byteString)
+		b += int(v.PatternLen)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[49] = xgb.RequestInfo{
+		Name: "ListFonts",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listFontsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listFontsReply(buf, order)
+		},
+	}
+}
+
 // ListFontsWithInfoCookie is a cookie used only for ListFontsWithInfo requests.
 type ListFontsWithInfoCookie struct {
 	*xgb.Cookie
@@ -11285,7 +17966,7 @@ type ListFontsWithInfoCookie struct {
 // ListFontsWithInfo sends a checked request.
 // If an error occurs, it will be returned with the reply by calling ListFontsWithInfoCookie.Reply()
 func ListFontsWithInfo(c *xgb.Conn, MaxNames uint16, PatternLen uint16, Pattern string) ListFontsWithInfoCookie {
-	cookie := c.NewCookie(true, true)
+	cookie := c.NewMultiCookie(true, listFontsWithInfoLastReply)
 	c.NewRequest(listFontsWithInfoRequest(c, MaxNames, PatternLen, Pattern), cookie)
 	return ListFontsWithInfoCookie{cookie}
 }
@@ -11293,7 +17974,7 @@ func ListFontsWithInfo(c *xgb.Conn, MaxNames uint16, PatternLen uint16, Pattern
 // ListFontsWithInfoUnchecked sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
 func ListFontsWithInfoUnchecked(c *xgb.Conn, MaxNames uint16, PatternLen uint16, Pattern string) ListFontsWithInfoCookie {
-	cookie := c.NewCookie(false, true)
+	cookie := c.NewMultiCookie(false, listFontsWithInfoLastReply)
 	c.NewRequest(listFontsWithInfoRequest(c, MaxNames, PatternLen, Pattern), cookie)
 	return ListFontsWithInfoCookie{cookie}
 }
@@ -11311,7 +17992,7 @@ type ListFontsWithInfoReply struct {
 	MaxCharOrByte2 uint16
 	DefaultChar    uint16
 	PropertiesLen  uint16
-	DrawDirection  byte
+	DrawDirection  FontDraw
 	MinByte1       byte
 	MaxByte1       byte
 	AllCharsExist  bool
@@ -11322,7 +18003,8 @@ type ListFontsWithInfoReply struct {
 	Name           string     // size: xgb.Pad((int(NameLen) * 1))
 }
 
-// Reply blocks and returns the reply data for a ListFontsWithInfo request.
+// Reply blocks and returns the next reply for a ListFontsWithInfo request.
+// Once the last reply has been returned, Reply returns xgb.ErrNoMoreReplies.
 func (cook ListFontsWithInfoCookie) Reply() (*ListFontsWithInfoReply, error) {
 	buf, err := cook.Cookie.Reply()
 	if err != nil {
@@ -11331,46 +18013,58 @@ func (cook ListFontsWithInfoCookie) Reply() (*ListFontsWithInfoReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return listFontsWithInfoReply(buf), nil
+	return listFontsWithInfoReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the next reply for a ListFontsWithInfo request arrives.
+func (cook ListFontsWithInfoCookie) ReplyContext(ctx context.Context) (*ListFontsWithInfoReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return listFontsWithInfoReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// listFontsWithInfoReply reads a byte slice into a ListFontsWithInfoReply value.
-func listFontsWithInfoReply(buf []byte) *ListFontsWithInfoReply {
+// listFontsWithInfoReply reads a byte slice in the byte order 'order' into a ListFontsWithInfoReply value.
+func listFontsWithInfoReply(buf []byte, order xgb.ByteOrder) *ListFontsWithInfoReply {
 	v := new(ListFontsWithInfoReply)
 	b := 1 // skip reply determinant
 
 	v.NameLen = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	v.MinBounds = Charinfo{}
-	b += CharinfoRead(buf[b:], &v.MinBounds)
+	b += CharinfoRead(buf[b:], order, &v.MinBounds)
 
 	b += 4 // padding
 
 	v.MaxBounds = Charinfo{}
-	b += CharinfoRead(buf[b:], &v.MaxBounds)
+	b += CharinfoRead(buf[b:], order, &v.MaxBounds)
 
 	b += 4 // padding
 
-	v.MinCharOrByte2 = xgb.Get16(buf[b:])
+	v.MinCharOrByte2 = order.Get16(buf[b:])
 	b += 2
 
-	v.MaxCharOrByte2 = xgb.Get16(buf[b:])
+	v.MaxCharOrByte2 = order.Get16(buf[b:])
 	b += 2
 
-	v.DefaultChar = xgb.Get16(buf[b:])
+	v.DefaultChar = order.Get16(buf[b:])
 	b += 2
 
-	v.PropertiesLen = xgb.Get16(buf[b:])
+	v.PropertiesLen = order.Get16(buf[b:])
 	b += 2
 
-	v.DrawDirection = buf[b]
+	v.DrawDirection = FontDraw(buf[b])
 	b += 1
 
 	v.MinByte1 = buf[b]
@@ -11386,17 +18080,17 @@ func listFontsWithInfoReply(buf []byte) *ListFontsWithInfoReply {
 	}
 	b += 1
 
-	v.FontAscent = int16(xgb.Get16(buf[b:]))
+	v.FontAscent = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.FontDescent = int16(xgb.Get16(buf[b:]))
+	v.FontDescent = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.RepliesHint = xgb.Get32(buf[b:])
+	v.RepliesHint = order.Get32(buf[b:])
 	b += 4
 
 	v.Properties = make([]Fontprop, v.PropertiesLen)
-	b += FontpropReadList(buf[b:], v.Properties)
+	b += FontpropReadList(buf[b:], order, v.Properties)
 
 	{
 		byteString := make([]byte, v.NameLen)
@@ -11408,25 +18102,39 @@ func listFontsWithInfoReply(buf []byte) *ListFontsWithInfoReply {
 	return v
 }
 
+// listFontsWithInfoLastReply reports whether a byte slice holds the last reply to a ListFontsWithInfo request.
+func listFontsWithInfoLastReply(buf []byte) bool {
+	return buf[1] == 0
+}
+
 // Write request to wire for ListFontsWithInfo
 // listFontsWithInfoRequest writes a ListFontsWithInfo request to a byte slice.
 func listFontsWithInfoRequest(c *xgb.Conn, MaxNames uint16, PatternLen uint16, Pattern string) []byte {
+	return AppendListFontsWithInfoRequest(nil, c, MaxNames, PatternLen, Pattern)
+}
+
+// AppendListFontsWithInfoRequest appends a ListFontsWithInfo request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendListFontsWithInfoRequest(buf []byte, c *xgb.Conn, MaxNames uint16, PatternLen uint16, Pattern string) []byte {
 	size := xgb.Pad((8 + xgb.Pad((int(PatternLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 50 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], MaxNames)
+	order.Put16(buf[b:], MaxNames)
 	b += 2
 
-	xgb.Put16(buf[b:], PatternLen)
+	order.Put16(buf[b:], PatternLen)
 	b += 2
 
 	copy(buf[b:], Pattern[:PatternLen])
@@ -11435,6 +18143,51 @@ func listFontsWithInfoRequest(c *xgb.Conn, MaxNames uint16, PatternLen uint16, P
 	return buf
 }
 
+// ListFontsWithInfoRequest represents the data of a ListFontsWithInfo request, as it is read when tracing a connection.
+type ListFontsWithInfoRequest struct {
+	// padding: 1 bytes
+	MaxNames   uint16
+	PatternLen uint16
+	Pattern    string // size: xgb.Pad((int(PatternLen) * 1))
+}
+
+// listFontsWithInfoRequestRead reads a byte slice in the byte order 'order' into a ListFontsWithInfoRequest value.
+func listFontsWithInfoRequestRead(buf []byte, order xgb.ByteOrder) *ListFontsWithInfoRequest {
+	v := new(ListFontsWithInfoRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.MaxNames = order.Get16(buf[b:])
+	b += 2
+
+	v.PatternLen = order.Get16(buf[b:])
+	b += 2
+
+	{
+		byteString := make([]byte, v.PatternLen)
+		copy(byteString[:v.PatternLen], buf[b:])
+		v.Pattern = string(byteString)
+		b += int(v.PatternLen)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[50] = xgb.RequestInfo{
+		Name: "ListFontsWithInfo",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listFontsWithInfoRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listFontsWithInfoReply(buf, order)
+		},
+	}
+}
+
 // ListHostsCookie is a cookie used only for ListHosts requests.
 type ListHostsCookie struct {
 	*xgb.Cookie
@@ -11460,7 +18213,7 @@ func ListHostsUnchecked(c *xgb.Conn) ListHostsCookie {
 type ListHostsReply struct {
 	Sequence uint16 // sequence number of the request for this reply
 	Length   uint32 // number of bytes in this reply
-	Mode     byte
+	Mode     AccessControl
 	HostsLen uint16
 	// padding: 22 bytes
 	Hosts []Host // size: HostListSize(Hosts)
@@ -11475,30 +18228,42 @@ func (cook ListHostsCookie) Reply() (*ListHostsReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return listHostsReply(buf), nil
+	return listHostsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a ListHosts request arrives.
+func (cook ListHostsCookie) ReplyContext(ctx context.Context) (*ListHostsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return listHostsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// listHostsReply reads a byte slice into a ListHostsReply value.
-func listHostsReply(buf []byte) *ListHostsReply {
+// listHostsReply reads a byte slice in the byte order 'order' into a ListHostsReply value.
+func listHostsReply(buf []byte, order xgb.ByteOrder) *ListHostsReply {
 	v := new(ListHostsReply)
 	b := 1 // skip reply determinant
 
-	v.Mode = buf[b]
+	v.Mode = AccessControl(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.HostsLen = xgb.Get16(buf[b:])
+	v.HostsLen = order.Get16(buf[b:])
 	b += 2
 
 	b += 22 // padding
 
 	v.Hosts = make([]Host, v.HostsLen)
-	b += HostReadList(buf[b:], v.Hosts)
+	b += HostReadList(buf[b:], order, v.Hosts)
 
 	return v
 }
@@ -11506,20 +18271,51 @@ func listHostsReply(buf []byte) *ListHostsReply {
 // Write request to wire for ListHosts
 // listHostsRequest writes a ListHosts request to a byte slice.
 func listHostsRequest(c *xgb.Conn) []byte {
+	return AppendListHostsRequest(nil, c)
+}
+
+// AppendListHostsRequest appends a ListHosts request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendListHostsRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 110 // request opcode
 	b += 1
 
-	b += 1                             // padding
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 1                               // padding
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// ListHostsRequest represents the data of a ListHosts request, as it is read when tracing a connection.
+type ListHostsRequest struct {
+}
+
+// listHostsRequestRead reads a byte slice in the byte order 'order' into a ListHostsRequest value.
+func listHostsRequestRead(buf []byte, order xgb.ByteOrder) *ListHostsRequest {
+	v := new(ListHostsRequest)
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[110] = xgb.RequestInfo{
+		Name: "ListHosts",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listHostsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listHostsReply(buf, order)
+		},
+	}
+}
+
 // ListInstalledColormapsCookie is a cookie used only for ListInstalledColormaps requests.
 type ListInstalledColormapsCookie struct {
 	*xgb.Cookie
@@ -11560,30 +18356,42 @@ func (cook ListInstalledColormapsCookie) Reply() (*ListInstalledColormapsReply,
 	if buf == nil {
 		return nil, nil
 	}
-	return listInstalledColormapsReply(buf), nil
+	return listInstalledColormapsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a ListInstalledColormaps request arrives.
+func (cook ListInstalledColormapsCookie) ReplyContext(ctx context.Context) (*ListInstalledColormapsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return listInstalledColormapsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// listInstalledColormapsReply reads a byte slice into a ListInstalledColormapsReply value.
-func listInstalledColormapsReply(buf []byte) *ListInstalledColormapsReply {
+// listInstalledColormapsReply reads a byte slice in the byte order 'order' into a ListInstalledColormapsReply value.
+func listInstalledColormapsReply(buf []byte, order xgb.ByteOrder) *ListInstalledColormapsReply {
 	v := new(ListInstalledColormapsReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.CmapsLen = xgb.Get16(buf[b:])
+	v.CmapsLen = order.Get16(buf[b:])
 	b += 2
 
 	b += 22 // padding
 
 	v.Cmaps = make([]Colormap, v.CmapsLen)
 	for i := 0; i < int(v.CmapsLen); i++ {
-		v.Cmaps[i] = Colormap(xgb.Get32(buf[b:]))
+		v.Cmaps[i] = Colormap(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -11593,24 +18401,66 @@ func listInstalledColormapsReply(buf []byte) *ListInstalledColormapsReply {
 // Write request to wire for ListInstalledColormaps
 // listInstalledColormapsRequest writes a ListInstalledColormaps request to a byte slice.
 func listInstalledColormapsRequest(c *xgb.Conn, Window Window) []byte {
+	return AppendListInstalledColormapsRequest(nil, c, Window)
+}
+
+// AppendListInstalledColormapsRequest appends a ListInstalledColormaps request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendListInstalledColormapsRequest(buf []byte, c *xgb.Conn, Window Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 83 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// ListInstalledColormapsRequest represents the data of a ListInstalledColormaps request, as it is read when tracing a connection.
+type ListInstalledColormapsRequest struct {
+	// padding: 1 bytes
+	Window Window
+}
+
+// listInstalledColormapsRequestRead reads a byte slice in the byte order 'order' into a ListInstalledColormapsRequest value.
+func listInstalledColormapsRequestRead(buf []byte, order xgb.ByteOrder) *ListInstalledColormapsRequest {
+	v := new(ListInstalledColormapsRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Window = Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[83] = xgb.RequestInfo{
+		Name: "ListInstalledColormaps",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listInstalledColormapsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listInstalledColormapsReply(buf, order)
+		},
+	}
+}
+
 // ListPropertiesCookie is a cookie used only for ListProperties requests.
 type ListPropertiesCookie struct {
 	*xgb.Cookie
@@ -11651,30 +18501,42 @@ func (cook ListPropertiesCookie) Reply() (*ListPropertiesReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return listPropertiesReply(buf), nil
+	return listPropertiesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a ListProperties request arrives.
+func (cook ListPropertiesCookie) ReplyContext(ctx context.Context) (*ListPropertiesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return listPropertiesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// listPropertiesReply reads a byte slice into a ListPropertiesReply value.
-func listPropertiesReply(buf []byte) *ListPropertiesReply {
+// listPropertiesReply reads a byte slice in the byte order 'order' into a ListPropertiesReply value.
+func listPropertiesReply(buf []byte, order xgb.ByteOrder) *ListPropertiesReply {
 	v := new(ListPropertiesReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.AtomsLen = xgb.Get16(buf[b:])
+	v.AtomsLen = order.Get16(buf[b:])
 	b += 2
 
 	b += 22 // padding
 
 	v.Atoms = make([]Atom, v.AtomsLen)
 	for i := 0; i < int(v.AtomsLen); i++ {
-		v.Atoms[i] = Atom(xgb.Get32(buf[b:]))
+		v.Atoms[i] = Atom(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -11684,24 +18546,66 @@ func listPropertiesReply(buf []byte) *ListPropertiesReply {
 // Write request to wire for ListProperties
 // listPropertiesRequest writes a ListProperties request to a byte slice.
 func listPropertiesRequest(c *xgb.Conn, Window Window) []byte {
+	return AppendListPropertiesRequest(nil, c, Window)
+}
+
+// AppendListPropertiesRequest appends a ListProperties request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendListPropertiesRequest(buf []byte, c *xgb.Conn, Window Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 21 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// ListPropertiesRequest represents the data of a ListProperties request, as it is read when tracing a connection.
+type ListPropertiesRequest struct {
+	// padding: 1 bytes
+	Window Window
+}
+
+// listPropertiesRequestRead reads a byte slice in the byte order 'order' into a ListPropertiesRequest value.
+func listPropertiesRequestRead(buf []byte, order xgb.ByteOrder) *ListPropertiesRequest {
+	v := new(ListPropertiesRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Window = Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[21] = xgb.RequestInfo{
+		Name: "ListProperties",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listPropertiesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listPropertiesReply(buf, order)
+		},
+	}
+}
+
 // LookupColorCookie is a cookie used only for LookupColor requests.
 type LookupColorCookie struct {
 	*xgb.Cookie
@@ -11745,38 +18649,50 @@ func (cook LookupColorCookie) Reply() (*LookupColorReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return lookupColorReply(buf), nil
+	return lookupColorReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a LookupColor request arrives.
+func (cook LookupColorCookie) ReplyContext(ctx context.Context) (*LookupColorReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return lookupColorReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// lookupColorReply reads a byte slice into a LookupColorReply value.
-func lookupColorReply(buf []byte) *LookupColorReply {
+// lookupColorReply reads a byte slice in the byte order 'order' into a LookupColorReply value.
+func lookupColorReply(buf []byte, order xgb.ByteOrder) *LookupColorReply {
 	v := new(LookupColorReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ExactRed = xgb.Get16(buf[b:])
+	v.ExactRed = order.Get16(buf[b:])
 	b += 2
 
-	v.ExactGreen = xgb.Get16(buf[b:])
+	v.ExactGreen = order.Get16(buf[b:])
 	b += 2
 
-	v.ExactBlue = xgb.Get16(buf[b:])
+	v.ExactBlue = order.Get16(buf[b:])
 	b += 2
 
-	v.VisualRed = xgb.Get16(buf[b:])
+	v.VisualRed = order.Get16(buf[b:])
 	b += 2
 
-	v.VisualGreen = xgb.Get16(buf[b:])
+	v.VisualGreen = order.Get16(buf[b:])
 	b += 2
 
-	v.VisualBlue = xgb.Get16(buf[b:])
+	v.VisualBlue = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -11785,22 +18701,31 @@ func lookupColorReply(buf []byte) *LookupColorReply {
 // Write request to wire for LookupColor
 // lookupColorRequest writes a LookupColor request to a byte slice.
 func lookupColorRequest(c *xgb.Conn, Cmap Colormap, NameLen uint16, Name string) []byte {
+	return AppendLookupColorRequest(nil, c, Cmap, NameLen, Name)
+}
+
+// AppendLookupColorRequest appends a LookupColor request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendLookupColorRequest(buf []byte, c *xgb.Conn, Cmap Colormap, NameLen uint16, Name string) []byte {
 	size := xgb.Pad((12 + xgb.Pad((int(NameLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 92 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Cmap))
+	order.Put32(buf[b:], uint32(Cmap))
 	b += 4
 
-	xgb.Put16(buf[b:], NameLen)
+	order.Put16(buf[b:], NameLen)
 	b += 2
 
 	b += 2 // padding
@@ -11811,6 +18736,54 @@ func lookupColorRequest(c *xgb.Conn, Cmap Colormap, NameLen uint16, Name string)
 	return buf
 }
 
+// LookupColorRequest represents the data of a LookupColor request, as it is read when tracing a connection.
+type LookupColorRequest struct {
+	// padding: 1 bytes
+	Cmap    Colormap
+	NameLen uint16
+	// padding: 2 bytes
+	Name string // size: xgb.Pad((int(NameLen) * 1))
+}
+
+// lookupColorRequestRead reads a byte slice in the byte order 'order' into a LookupColorRequest value.
+func lookupColorRequestRead(buf []byte, order xgb.ByteOrder) *LookupColorRequest {
+	v := new(LookupColorRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Cmap = Colormap(order.Get32(buf[b:]))
+	b += 4
+
+	v.NameLen = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	{
+		byteString := make([]byte, v.NameLen)
+		copy(byteString[:v.NameLen], buf[b:])
+		v.Name = string(byteString)
+		b += int(v.NameLen)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[92] = xgb.RequestInfo{
+		Name: "LookupColor",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return lookupColorRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return lookupColorReply(buf, order)
+		},
+	}
+}
+
 // MapSubwindowsCookie is a cookie used only for MapSubwindows requests.
 type MapSubwindowsCookie struct {
 	*xgb.Cookie
@@ -11838,32 +18811,109 @@ func (cook MapSubwindowsCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook MapSubwindowsCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for MapSubwindows
 // mapSubwindowsRequest writes a MapSubwindows request to a byte slice.
 func mapSubwindowsRequest(c *xgb.Conn, Window Window) []byte {
+	return AppendMapSubwindowsRequest(nil, c, Window)
+}
+
+// AppendMapSubwindowsRequest appends a MapSubwindows request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendMapSubwindowsRequest(buf []byte, c *xgb.Conn, Window Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 9 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// MapSubwindowsRequest represents the data of a MapSubwindows request, as it is read when tracing a connection.
+type MapSubwindowsRequest struct {
+	// padding: 1 bytes
+	Window Window
+}
+
+// mapSubwindowsRequestRead reads a byte slice in the byte order 'order' into a MapSubwindowsRequest value.
+func mapSubwindowsRequestRead(buf []byte, order xgb.ByteOrder) *MapSubwindowsRequest {
+	v := new(MapSubwindowsRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Window = Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[9] = xgb.RequestInfo{
+		Name: "MapSubwindows",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return mapSubwindowsRequestRead(buf, order)
+		},
+	}
+}
+
 // MapWindowCookie is a cookie used only for MapWindow requests.
 type MapWindowCookie struct {
 	*xgb.Cookie
 }
 
+// MapWindow makes a window visible.
+//
+// Maps the specified window. This means making the window visible (as long as
+// its parent is visible).
+//
+// This MapWindow request will be translated to a MapRequest request if a
+// window manager is running. The window manager then decides to either map the
+// window or not. Set the override-redirect window attribute to true if you
+// want to bypass this mechanism.
+//
+// If the window manager decides to map the window (or if no window manager is
+// running), a MapNotify event is generated.
+//
+// If the window becomes viewable and no earlier contents for it are
+// remembered, the X server tiles the window with its background. If the
+// window's background is undefined, the existing screen contents are not
+// altered, and the X server generates zero or more Expose events.
+//
+// If the window type is InputOutput, an Expose event will be generated when
+// the window becomes visible. The normal response to an Expose event should be
+// to repaint the window.
+//
+// Parameters:
+//
+//   - Window: The window to make visible.
+//
+// Errors:
+//
+//   - MatchError: The specified window was created with `InputOnly` and is not
+//     a valid target.
+//
+// See also MapNotifyEvent, ExposeEvent, UnmapWindow.
+//
 // MapWindow sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
 func MapWindow(c *xgb.Conn, Window Window) MapWindowCookie {
@@ -11886,25 +18936,69 @@ func (cook MapWindowCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook MapWindowCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for MapWindow
 // mapWindowRequest writes a MapWindow request to a byte slice.
 func mapWindowRequest(c *xgb.Conn, Window Window) []byte {
+	return AppendMapWindowRequest(nil, c, Window)
+}
+
+// AppendMapWindowRequest appends a MapWindow request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendMapWindowRequest(buf []byte, c *xgb.Conn, Window Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	buf[b] = 8 // request opcode
+	b += 1
+
+	b += 1 // padding
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Window))
+	b += 4
+
+	return buf
+}
 
-	buf[b] = 8 // request opcode
-	b += 1
+// MapWindowRequest represents the data of a MapWindow request, as it is read when tracing a connection.
+type MapWindowRequest struct {
+	// padding: 1 bytes
+	Window Window
+}
+
+// mapWindowRequestRead reads a byte slice in the byte order 'order' into a MapWindowRequest value.
+func mapWindowRequestRead(buf []byte, order xgb.ByteOrder) *MapWindowRequest {
+	v := new(MapWindowRequest)
+	b := 1 // skip request opcode
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
-	b += 2
+	b += 2 // skip request size
 
-	xgb.Put32(buf[b:], uint32(Window))
+	v.Window = Window(order.Get32(buf[b:]))
 	b += 4
 
-	return buf
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[8] = xgb.RequestInfo{
+		Name: "MapWindow",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return mapWindowRequestRead(buf, order)
+		},
+	}
 }
 
 // NoOperationCookie is a cookie used only for NoOperation requests.
@@ -11934,28 +19028,81 @@ func (cook NoOperationCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook NoOperationCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for NoOperation
 // noOperationRequest writes a NoOperation request to a byte slice.
 func noOperationRequest(c *xgb.Conn) []byte {
+	return AppendNoOperationRequest(nil, c)
+}
+
+// AppendNoOperationRequest appends a NoOperation request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendNoOperationRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 127 // request opcode
 	b += 1
 
-	b += 1                             // padding
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 1                               // padding
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// NoOperationRequest represents the data of a NoOperation request, as it is read when tracing a connection.
+type NoOperationRequest struct {
+}
+
+// noOperationRequestRead reads a byte slice in the byte order 'order' into a NoOperationRequest value.
+func noOperationRequestRead(buf []byte, order xgb.ByteOrder) *NoOperationRequest {
+	v := new(NoOperationRequest)
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[127] = xgb.RequestInfo{
+		Name: "NoOperation",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return noOperationRequestRead(buf, order)
+		},
+	}
+}
+
 // OpenFontCookie is a cookie used only for OpenFont requests.
 type OpenFontCookie struct {
 	*xgb.Cookie
 }
 
+// OpenFont opens a font.
+//
+// Opens any X core font matching the given `name` (for example
+// "-misc-fixed-*").
+//
+// Note that X core fonts are deprecated (but still supported) in favor of
+// client-side rendering using Xft.
+//
+// Parameters:
+//
+//   - Fid: The ID with which you will refer to the font, created by NewFontId.
+//   - NameLen: Length (in bytes) of `name`.
+//   - Name: A pattern describing an X core font.
+//
+// Errors:
+//
+//   - NameError: No font matches the given `name`.
+//
+// See also xfontsel(1), xlsfonts(1).
+//
 // OpenFont sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
 func OpenFont(c *xgb.Conn, Fid Font, NameLen uint16, Name string) OpenFontCookie {
@@ -11978,25 +19125,39 @@ func (cook OpenFontCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook OpenFontCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for OpenFont
 // openFontRequest writes a OpenFont request to a byte slice.
 func openFontRequest(c *xgb.Conn, Fid Font, NameLen uint16, Name string) []byte {
+	return AppendOpenFontRequest(nil, c, Fid, NameLen, Name)
+}
+
+// AppendOpenFontRequest appends a OpenFont request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendOpenFontRequest(buf []byte, c *xgb.Conn, Fid Font, NameLen uint16, Name string) []byte {
 	size := xgb.Pad((12 + xgb.Pad((int(NameLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 45 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Fid))
+	order.Put32(buf[b:], uint32(Fid))
 	b += 4
 
-	xgb.Put16(buf[b:], NameLen)
+	order.Put16(buf[b:], NameLen)
 	b += 2
 
 	b += 2 // padding
@@ -12007,6 +19168,51 @@ func openFontRequest(c *xgb.Conn, Fid Font, NameLen uint16, Name string) []byte
 	return buf
 }
 
+// OpenFontRequest represents the data of a OpenFont request, as it is read when tracing a connection.
+type OpenFontRequest struct {
+	// padding: 1 bytes
+	Fid     Font
+	NameLen uint16
+	// padding: 2 bytes
+	Name string // size: xgb.Pad((int(NameLen) * 1))
+}
+
+// openFontRequestRead reads a byte slice in the byte order 'order' into a OpenFontRequest value.
+func openFontRequestRead(buf []byte, order xgb.ByteOrder) *OpenFontRequest {
+	v := new(OpenFontRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Fid = Font(order.Get32(buf[b:]))
+	b += 4
+
+	v.NameLen = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	{
+		byteString := make([]byte, v.NameLen)
+		copy(byteString[:v.NameLen], buf[b:])
+		v.Name = string(byteString)
+		b += int(v.NameLen)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[45] = xgb.RequestInfo{
+		Name: "OpenFont",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return openFontRequestRead(buf, order)
+		},
+	}
+}
+
 // PolyArcCookie is a cookie used only for PolyArc requests.
 type PolyArcCookie struct {
 	*xgb.Cookie
@@ -12034,32 +19240,84 @@ func (cook PolyArcCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PolyArcCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PolyArc
 // polyArcRequest writes a PolyArc request to a byte slice.
 func polyArcRequest(c *xgb.Conn, Drawable Drawable, Gc Gcontext, Arcs []Arc) []byte {
+	return AppendPolyArcRequest(nil, c, Drawable, Gc, Arcs)
+}
+
+// AppendPolyArcRequest appends a PolyArc request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPolyArcRequest(buf []byte, c *xgb.Conn, Drawable Drawable, Gc Gcontext, Arcs []Arc) []byte {
 	size := xgb.Pad((12 + xgb.Pad((len(Arcs) * 12))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 68 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	b += ArcListBytes(buf[b:], Arcs)
+	b += ArcListBytes(buf[b:], order, Arcs)
 
 	return buf
 }
 
+// PolyArcRequest represents the data of a PolyArc request, as it is read when tracing a connection.
+type PolyArcRequest struct {
+	// padding: 1 bytes
+	Drawable Drawable
+	Gc       Gcontext
+	Arcs     []Arc // size: xgb.Pad((len(Arcs) * 12))
+}
+
+// polyArcRequestRead reads a byte slice in the byte order 'order' into a PolyArcRequest value.
+func polyArcRequestRead(buf []byte, order xgb.ByteOrder) *PolyArcRequest {
+	v := new(PolyArcRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.Arcs = make([]Arc, (len(buf)-b)/12)
+	b += ArcReadList(buf[b:], order, v.Arcs)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[68] = xgb.RequestInfo{
+		Name: "PolyArc",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return polyArcRequestRead(buf, order)
+		},
+	}
+}
+
 // PolyFillArcCookie is a cookie used only for PolyFillArc requests.
 type PolyFillArcCookie struct {
 	*xgb.Cookie
@@ -12087,32 +19345,84 @@ func (cook PolyFillArcCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PolyFillArcCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PolyFillArc
 // polyFillArcRequest writes a PolyFillArc request to a byte slice.
 func polyFillArcRequest(c *xgb.Conn, Drawable Drawable, Gc Gcontext, Arcs []Arc) []byte {
+	return AppendPolyFillArcRequest(nil, c, Drawable, Gc, Arcs)
+}
+
+// AppendPolyFillArcRequest appends a PolyFillArc request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPolyFillArcRequest(buf []byte, c *xgb.Conn, Drawable Drawable, Gc Gcontext, Arcs []Arc) []byte {
 	size := xgb.Pad((12 + xgb.Pad((len(Arcs) * 12))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 71 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	b += ArcListBytes(buf[b:], Arcs)
+	b += ArcListBytes(buf[b:], order, Arcs)
 
 	return buf
 }
 
+// PolyFillArcRequest represents the data of a PolyFillArc request, as it is read when tracing a connection.
+type PolyFillArcRequest struct {
+	// padding: 1 bytes
+	Drawable Drawable
+	Gc       Gcontext
+	Arcs     []Arc // size: xgb.Pad((len(Arcs) * 12))
+}
+
+// polyFillArcRequestRead reads a byte slice in the byte order 'order' into a PolyFillArcRequest value.
+func polyFillArcRequestRead(buf []byte, order xgb.ByteOrder) *PolyFillArcRequest {
+	v := new(PolyFillArcRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.Arcs = make([]Arc, (len(buf)-b)/12)
+	b += ArcReadList(buf[b:], order, v.Arcs)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[71] = xgb.RequestInfo{
+		Name: "PolyFillArc",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return polyFillArcRequestRead(buf, order)
+		},
+	}
+}
+
 // PolyFillRectangleCookie is a cookie used only for PolyFillRectangle requests.
 type PolyFillRectangleCookie struct {
 	*xgb.Cookie
@@ -12140,40 +19450,116 @@ func (cook PolyFillRectangleCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PolyFillRectangleCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PolyFillRectangle
 // polyFillRectangleRequest writes a PolyFillRectangle request to a byte slice.
 func polyFillRectangleRequest(c *xgb.Conn, Drawable Drawable, Gc Gcontext, Rectangles []Rectangle) []byte {
+	return AppendPolyFillRectangleRequest(nil, c, Drawable, Gc, Rectangles)
+}
+
+// AppendPolyFillRectangleRequest appends a PolyFillRectangle request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPolyFillRectangleRequest(buf []byte, c *xgb.Conn, Drawable Drawable, Gc Gcontext, Rectangles []Rectangle) []byte {
 	size := xgb.Pad((12 + xgb.Pad((len(Rectangles) * 8))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 70 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	b += RectangleListBytes(buf[b:], Rectangles)
+	b += RectangleListBytes(buf[b:], order, Rectangles)
 
 	return buf
 }
 
+// PolyFillRectangleRequest represents the data of a PolyFillRectangle request, as it is read when tracing a connection.
+type PolyFillRectangleRequest struct {
+	// padding: 1 bytes
+	Drawable   Drawable
+	Gc         Gcontext
+	Rectangles []Rectangle // size: xgb.Pad((len(Rectangles) * 8))
+}
+
+// polyFillRectangleRequestRead reads a byte slice in the byte order 'order' into a PolyFillRectangleRequest value.
+func polyFillRectangleRequestRead(buf []byte, order xgb.ByteOrder) *PolyFillRectangleRequest {
+	v := new(PolyFillRectangleRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.Rectangles = make([]Rectangle, (len(buf)-b)/8)
+	b += RectangleReadList(buf[b:], order, v.Rectangles)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[70] = xgb.RequestInfo{
+		Name: "PolyFillRectangle",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return polyFillRectangleRequestRead(buf, order)
+		},
+	}
+}
+
 // PolyLineCookie is a cookie used only for PolyLine requests.
 type PolyLineCookie struct {
 	*xgb.Cookie
 }
 
+// PolyLine draw lines.
+//
+// Draws `points_len`-1 lines between each pair of points (point[i],
+// point[i+1]) in the `points` array. The lines are drawn in the order listed
+// in the array. They join correctly at all intermediate points, and if the
+// first and last points coincide, the first and last lines also join
+// correctly. For any given line, a pixel is not drawn more than once. If thin
+// (zero line-width) lines intersect, the intersecting pixels are drawn
+// multiple times. If wide lines intersect, the intersecting pixels are drawn
+// only once, as though the entire request were a single, filled shape.
+//
+// Parameters:
+//
+//   - Drawable: The drawable to draw the line(s) on.
+//   - Gc: The graphics context to use.
+//   - Points: An array of points.
+//
+// Errors:
+//
+//   - DrawableError: TODO: reasons?
+//   - GContextError: TODO: reasons?
+//   - MatchError: TODO: reasons?
+//   - ValueError: TODO: reasons?
+//
 // PolyLine sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func PolyLine(c *xgb.Conn, CoordinateMode byte, Drawable Drawable, Gc Gcontext, Points []Point) PolyLineCookie {
+func PolyLine(c *xgb.Conn, CoordinateMode CoordMode, Drawable Drawable, Gc Gcontext, Points []Point) PolyLineCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(polyLineRequest(c, CoordinateMode, Drawable, Gc, Points), cookie)
 	return PolyLineCookie{cookie}
@@ -12181,7 +19567,7 @@ func PolyLine(c *xgb.Conn, CoordinateMode byte, Drawable Drawable, Gc Gcontext,
 
 // PolyLineChecked sends a checked request.
 // If an error occurs, it can be retrieved using PolyLineCookie.Check()
-func PolyLineChecked(c *xgb.Conn, CoordinateMode byte, Drawable Drawable, Gc Gcontext, Points []Point) PolyLineCookie {
+func PolyLineChecked(c *xgb.Conn, CoordinateMode CoordMode, Drawable Drawable, Gc Gcontext, Points []Point) PolyLineCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(polyLineRequest(c, CoordinateMode, Drawable, Gc, Points), cookie)
 	return PolyLineCookie{cookie}
@@ -12193,33 +19579,86 @@ func (cook PolyLineCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PolyLineCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PolyLine
 // polyLineRequest writes a PolyLine request to a byte slice.
-func polyLineRequest(c *xgb.Conn, CoordinateMode byte, Drawable Drawable, Gc Gcontext, Points []Point) []byte {
+func polyLineRequest(c *xgb.Conn, CoordinateMode CoordMode, Drawable Drawable, Gc Gcontext, Points []Point) []byte {
+	return AppendPolyLineRequest(nil, c, CoordinateMode, Drawable, Gc, Points)
+}
+
+// AppendPolyLineRequest appends a PolyLine request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPolyLineRequest(buf []byte, c *xgb.Conn, CoordinateMode CoordMode, Drawable Drawable, Gc Gcontext, Points []Point) []byte {
 	size := xgb.Pad((12 + xgb.Pad((len(Points) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 65 // request opcode
 	b += 1
 
-	buf[b] = CoordinateMode
+	buf[b] = byte(CoordinateMode)
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	b += PointListBytes(buf[b:], Points)
+	b += PointListBytes(buf[b:], order, Points)
 
 	return buf
 }
 
+// PolyLineRequest represents the data of a PolyLine request, as it is read when tracing a connection.
+type PolyLineRequest struct {
+	CoordinateMode CoordMode
+	Drawable       Drawable
+	Gc             Gcontext
+	Points         []Point // size: xgb.Pad((len(Points) * 4))
+}
+
+// polyLineRequestRead reads a byte slice in the byte order 'order' into a PolyLineRequest value.
+func polyLineRequestRead(buf []byte, order xgb.ByteOrder) *PolyLineRequest {
+	v := new(PolyLineRequest)
+	b := 1 // skip request opcode
+
+	v.CoordinateMode = CoordMode(buf[b])
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.Points = make([]Point, (len(buf)-b)/4)
+	b += PointReadList(buf[b:], order, v.Points)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[65] = xgb.RequestInfo{
+		Name: "PolyLine",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return polyLineRequestRead(buf, order)
+		},
+	}
+}
+
 // PolyPointCookie is a cookie used only for PolyPoint requests.
 type PolyPointCookie struct {
 	*xgb.Cookie
@@ -12227,7 +19666,7 @@ type PolyPointCookie struct {
 
 // PolyPoint sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func PolyPoint(c *xgb.Conn, CoordinateMode byte, Drawable Drawable, Gc Gcontext, Points []Point) PolyPointCookie {
+func PolyPoint(c *xgb.Conn, CoordinateMode CoordMode, Drawable Drawable, Gc Gcontext, Points []Point) PolyPointCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(polyPointRequest(c, CoordinateMode, Drawable, Gc, Points), cookie)
 	return PolyPointCookie{cookie}
@@ -12235,7 +19674,7 @@ func PolyPoint(c *xgb.Conn, CoordinateMode byte, Drawable Drawable, Gc Gcontext,
 
 // PolyPointChecked sends a checked request.
 // If an error occurs, it can be retrieved using PolyPointCookie.Check()
-func PolyPointChecked(c *xgb.Conn, CoordinateMode byte, Drawable Drawable, Gc Gcontext, Points []Point) PolyPointCookie {
+func PolyPointChecked(c *xgb.Conn, CoordinateMode CoordMode, Drawable Drawable, Gc Gcontext, Points []Point) PolyPointCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(polyPointRequest(c, CoordinateMode, Drawable, Gc, Points), cookie)
 	return PolyPointCookie{cookie}
@@ -12247,33 +19686,86 @@ func (cook PolyPointCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PolyPointCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PolyPoint
 // polyPointRequest writes a PolyPoint request to a byte slice.
-func polyPointRequest(c *xgb.Conn, CoordinateMode byte, Drawable Drawable, Gc Gcontext, Points []Point) []byte {
+func polyPointRequest(c *xgb.Conn, CoordinateMode CoordMode, Drawable Drawable, Gc Gcontext, Points []Point) []byte {
+	return AppendPolyPointRequest(nil, c, CoordinateMode, Drawable, Gc, Points)
+}
+
+// AppendPolyPointRequest appends a PolyPoint request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPolyPointRequest(buf []byte, c *xgb.Conn, CoordinateMode CoordMode, Drawable Drawable, Gc Gcontext, Points []Point) []byte {
 	size := xgb.Pad((12 + xgb.Pad((len(Points) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 64 // request opcode
 	b += 1
 
-	buf[b] = CoordinateMode
+	buf[b] = byte(CoordinateMode)
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	b += PointListBytes(buf[b:], Points)
+	b += PointListBytes(buf[b:], order, Points)
 
 	return buf
 }
 
+// PolyPointRequest represents the data of a PolyPoint request, as it is read when tracing a connection.
+type PolyPointRequest struct {
+	CoordinateMode CoordMode
+	Drawable       Drawable
+	Gc             Gcontext
+	Points         []Point // size: xgb.Pad((len(Points) * 4))
+}
+
+// polyPointRequestRead reads a byte slice in the byte order 'order' into a PolyPointRequest value.
+func polyPointRequestRead(buf []byte, order xgb.ByteOrder) *PolyPointRequest {
+	v := new(PolyPointRequest)
+	b := 1 // skip request opcode
+
+	v.CoordinateMode = CoordMode(buf[b])
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.Points = make([]Point, (len(buf)-b)/4)
+	b += PointReadList(buf[b:], order, v.Points)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[64] = xgb.RequestInfo{
+		Name: "PolyPoint",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return polyPointRequestRead(buf, order)
+		},
+	}
+}
+
 // PolyRectangleCookie is a cookie used only for PolyRectangle requests.
 type PolyRectangleCookie struct {
 	*xgb.Cookie
@@ -12301,37 +19793,114 @@ func (cook PolyRectangleCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PolyRectangleCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PolyRectangle
 // polyRectangleRequest writes a PolyRectangle request to a byte slice.
 func polyRectangleRequest(c *xgb.Conn, Drawable Drawable, Gc Gcontext, Rectangles []Rectangle) []byte {
+	return AppendPolyRectangleRequest(nil, c, Drawable, Gc, Rectangles)
+}
+
+// AppendPolyRectangleRequest appends a PolyRectangle request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPolyRectangleRequest(buf []byte, c *xgb.Conn, Drawable Drawable, Gc Gcontext, Rectangles []Rectangle) []byte {
 	size := xgb.Pad((12 + xgb.Pad((len(Rectangles) * 8))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 67 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	b += RectangleListBytes(buf[b:], Rectangles)
+	b += RectangleListBytes(buf[b:], order, Rectangles)
 
 	return buf
 }
 
+// PolyRectangleRequest represents the data of a PolyRectangle request, as it is read when tracing a connection.
+type PolyRectangleRequest struct {
+	// padding: 1 bytes
+	Drawable   Drawable
+	Gc         Gcontext
+	Rectangles []Rectangle // size: xgb.Pad((len(Rectangles) * 8))
+}
+
+// polyRectangleRequestRead reads a byte slice in the byte order 'order' into a PolyRectangleRequest value.
+func polyRectangleRequestRead(buf []byte, order xgb.ByteOrder) *PolyRectangleRequest {
+	v := new(PolyRectangleRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.Rectangles = make([]Rectangle, (len(buf)-b)/8)
+	b += RectangleReadList(buf[b:], order, v.Rectangles)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[67] = xgb.RequestInfo{
+		Name: "PolyRectangle",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return polyRectangleRequestRead(buf, order)
+		},
+	}
+}
+
 // PolySegmentCookie is a cookie used only for PolySegment requests.
 type PolySegmentCookie struct {
 	*xgb.Cookie
 }
 
+// PolySegment draw lines.
+//
+// Draws multiple, unconnected lines. For each segment, a line is drawn between
+// (x1, y1) and (x2, y2). The lines are drawn in the order listed in the array
+// of `Segment` structures and does not perform joining at coincident
+// endpoints. For any given line, a pixel is not drawn more than once. If lines
+// intersect, the intersecting pixels are drawn multiple times.
+//
+// TODO: include the xcb_segment_t data structure
+//
+// TODO: an example
+//
+// Parameters:
+//
+//   - Drawable: A drawable (Window or Pixmap) to draw on.
+//   - Gc: The graphics context to use. TODO: document which attributes of a gc
+//     are used
+//   - Segments: An array of `Segment` structures.
+//
+// Errors:
+//
+//   - DrawableError: The specified `drawable` does not exist.
+//   - GContextError: The specified `gc` does not exist.
+//   - MatchError: TODO: reasons?
+//
 // PolySegment sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
 func PolySegment(c *xgb.Conn, Drawable Drawable, Gc Gcontext, Segments []Segment) PolySegmentCookie {
@@ -12354,32 +19923,84 @@ func (cook PolySegmentCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PolySegmentCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PolySegment
 // polySegmentRequest writes a PolySegment request to a byte slice.
 func polySegmentRequest(c *xgb.Conn, Drawable Drawable, Gc Gcontext, Segments []Segment) []byte {
+	return AppendPolySegmentRequest(nil, c, Drawable, Gc, Segments)
+}
+
+// AppendPolySegmentRequest appends a PolySegment request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPolySegmentRequest(buf []byte, c *xgb.Conn, Drawable Drawable, Gc Gcontext, Segments []Segment) []byte {
 	size := xgb.Pad((12 + xgb.Pad((len(Segments) * 8))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 66 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	b += SegmentListBytes(buf[b:], Segments)
+	b += SegmentListBytes(buf[b:], order, Segments)
 
 	return buf
 }
 
+// PolySegmentRequest represents the data of a PolySegment request, as it is read when tracing a connection.
+type PolySegmentRequest struct {
+	// padding: 1 bytes
+	Drawable Drawable
+	Gc       Gcontext
+	Segments []Segment // size: xgb.Pad((len(Segments) * 8))
+}
+
+// polySegmentRequestRead reads a byte slice in the byte order 'order' into a PolySegmentRequest value.
+func polySegmentRequestRead(buf []byte, order xgb.ByteOrder) *PolySegmentRequest {
+	v := new(PolySegmentRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.Segments = make([]Segment, (len(buf)-b)/8)
+	b += SegmentReadList(buf[b:], order, v.Segments)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[66] = xgb.RequestInfo{
+		Name: "PolySegment",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return polySegmentRequestRead(buf, order)
+		},
+	}
+}
+
 // PolyText16Cookie is a cookie used only for PolyText16 requests.
 type PolyText16Cookie struct {
 	*xgb.Cookie
@@ -12407,37 +20028,98 @@ func (cook PolyText16Cookie) Check() error {
 	return cook.Cookie.Check()
 }
 
-// Write request to wire for PolyText16
-// polyText16Request writes a PolyText16 request to a byte slice.
-func polyText16Request(c *xgb.Conn, Drawable Drawable, Gc Gcontext, X int16, Y int16, Items []byte) []byte {
-	size := xgb.Pad((16 + xgb.Pad((len(Items) * 1))))
-	b := 0
-	buf := make([]byte, size)
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PolyText16Cookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
+// Write request to wire for PolyText16
+// polyText16Request writes a PolyText16 request to a byte slice.
+func polyText16Request(c *xgb.Conn, Drawable Drawable, Gc Gcontext, X int16, Y int16, Items []byte) []byte {
+	return AppendPolyText16Request(nil, c, Drawable, Gc, X, Y, Items)
+}
+
+// AppendPolyText16Request appends a PolyText16 request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPolyText16Request(buf []byte, c *xgb.Conn, Drawable Drawable, Gc Gcontext, X int16, Y int16, Items []byte) []byte {
+	size := xgb.Pad((16 + xgb.Pad((len(Items) * 1))))
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
+
+	buf[b] = 75 // request opcode
+	b += 1
+
+	b += 1 // padding
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Drawable))
+	b += 4
+
+	order.Put32(buf[b:], uint32(Gc))
+	b += 4
+
+	order.Put16(buf[b:], uint16(X))
+	b += 2
+
+	order.Put16(buf[b:], uint16(Y))
+	b += 2
+
+	copy(buf[b:], Items[:len(Items)])
+	b += int(len(Items))
+
+	return buf
+}
+
+// PolyText16Request represents the data of a PolyText16 request, as it is read when tracing a connection.
+type PolyText16Request struct {
+	// padding: 1 bytes
+	Drawable Drawable
+	Gc       Gcontext
+	X        int16
+	Y        int16
+	Items    []byte // size: xgb.Pad((len(Items) * 1))
+}
 
-	buf[b] = 75 // request opcode
-	b += 1
+// polyText16RequestRead reads a byte slice in the byte order 'order' into a PolyText16Request value.
+func polyText16RequestRead(buf []byte, order xgb.ByteOrder) *PolyText16Request {
+	v := new(PolyText16Request)
+	b := 1 // skip request opcode
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
-	b += 2
+	b += 2 // skip request size
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	v.Drawable = Drawable(order.Get32(buf[b:]))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	v.Gc = Gcontext(order.Get32(buf[b:]))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(X))
+	v.X = int16(order.Get16(buf[b:]))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(Y))
+	v.Y = int16(order.Get16(buf[b:]))
 	b += 2
 
-	copy(buf[b:], Items[:len(Items)])
-	b += int(len(Items))
+	v.Items = make([]byte, (len(buf)-b)/1)
+	copy(v.Items[:(len(buf)-b)/1], buf[b:])
+	b += int((len(buf) - b) / 1)
 
-	return buf
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[75] = xgb.RequestInfo{
+		Name: "PolyText16",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return polyText16RequestRead(buf, order)
+		},
+	}
 }
 
 // PolyText8Cookie is a cookie used only for PolyText8 requests.
@@ -12467,31 +20149,45 @@ func (cook PolyText8Cookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PolyText8Cookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PolyText8
 // polyText8Request writes a PolyText8 request to a byte slice.
 func polyText8Request(c *xgb.Conn, Drawable Drawable, Gc Gcontext, X int16, Y int16, Items []byte) []byte {
+	return AppendPolyText8Request(nil, c, Drawable, Gc, X, Y, Items)
+}
+
+// AppendPolyText8Request appends a PolyText8 request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPolyText8Request(buf []byte, c *xgb.Conn, Drawable Drawable, Gc Gcontext, X int16, Y int16, Items []byte) []byte {
 	size := xgb.Pad((16 + xgb.Pad((len(Items) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 74 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(X))
+	order.Put16(buf[b:], uint16(X))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(Y))
+	order.Put16(buf[b:], uint16(Y))
 	b += 2
 
 	copy(buf[b:], Items[:len(Items)])
@@ -12500,6 +20196,53 @@ func polyText8Request(c *xgb.Conn, Drawable Drawable, Gc Gcontext, X int16, Y in
 	return buf
 }
 
+// PolyText8Request represents the data of a PolyText8 request, as it is read when tracing a connection.
+type PolyText8Request struct {
+	// padding: 1 bytes
+	Drawable Drawable
+	Gc       Gcontext
+	X        int16
+	Y        int16
+	Items    []byte // size: xgb.Pad((len(Items) * 1))
+}
+
+// polyText8RequestRead reads a byte slice in the byte order 'order' into a PolyText8Request value.
+func polyText8RequestRead(buf []byte, order xgb.ByteOrder) *PolyText8Request {
+	v := new(PolyText8Request)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.X = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Y = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Items = make([]byte, (len(buf)-b)/1)
+	copy(v.Items[:(len(buf)-b)/1], buf[b:])
+	b += int((len(buf) - b) / 1)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[74] = xgb.RequestInfo{
+		Name: "PolyText8",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return polyText8RequestRead(buf, order)
+		},
+	}
+}
+
 // PutImageCookie is a cookie used only for PutImage requests.
 type PutImageCookie struct {
 	*xgb.Cookie
@@ -12507,7 +20250,7 @@ type PutImageCookie struct {
 
 // PutImage sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func PutImage(c *xgb.Conn, Format byte, Drawable Drawable, Gc Gcontext, Width uint16, Height uint16, DstX int16, DstY int16, LeftPad byte, Depth byte, Data []byte) PutImageCookie {
+func PutImage(c *xgb.Conn, Format ImageFormat, Drawable Drawable, Gc Gcontext, Width uint16, Height uint16, DstX int16, DstY int16, LeftPad byte, Depth byte, Data []byte) PutImageCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(putImageRequest(c, Format, Drawable, Gc, Width, Height, DstX, DstY, LeftPad, Depth, Data), cookie)
 	return PutImageCookie{cookie}
@@ -12515,7 +20258,7 @@ func PutImage(c *xgb.Conn, Format byte, Drawable Drawable, Gc Gcontext, Width ui
 
 // PutImageChecked sends a checked request.
 // If an error occurs, it can be retrieved using PutImageCookie.Check()
-func PutImageChecked(c *xgb.Conn, Format byte, Drawable Drawable, Gc Gcontext, Width uint16, Height uint16, DstX int16, DstY int16, LeftPad byte, Depth byte, Data []byte) PutImageCookie {
+func PutImageChecked(c *xgb.Conn, Format ImageFormat, Drawable Drawable, Gc Gcontext, Width uint16, Height uint16, DstX int16, DstY int16, LeftPad byte, Depth byte, Data []byte) PutImageCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(putImageRequest(c, Format, Drawable, Gc, Width, Height, DstX, DstY, LeftPad, Depth, Data), cookie)
 	return PutImageCookie{cookie}
@@ -12527,38 +20270,52 @@ func (cook PutImageCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PutImageCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PutImage
 // putImageRequest writes a PutImage request to a byte slice.
-func putImageRequest(c *xgb.Conn, Format byte, Drawable Drawable, Gc Gcontext, Width uint16, Height uint16, DstX int16, DstY int16, LeftPad byte, Depth byte, Data []byte) []byte {
+func putImageRequest(c *xgb.Conn, Format ImageFormat, Drawable Drawable, Gc Gcontext, Width uint16, Height uint16, DstX int16, DstY int16, LeftPad byte, Depth byte, Data []byte) []byte {
+	return AppendPutImageRequest(nil, c, Format, Drawable, Gc, Width, Height, DstX, DstY, LeftPad, Depth, Data)
+}
+
+// AppendPutImageRequest appends a PutImage request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPutImageRequest(buf []byte, c *xgb.Conn, Format ImageFormat, Drawable Drawable, Gc Gcontext, Width uint16, Height uint16, DstX int16, DstY int16, LeftPad byte, Depth byte, Data []byte) []byte {
 	size := xgb.Pad((24 + xgb.Pad((len(Data) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 72 // request opcode
 	b += 1
 
-	buf[b] = Format
+	buf[b] = byte(Format)
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	xgb.Put16(buf[b:], Width)
+	order.Put16(buf[b:], Width)
 	b += 2
 
-	xgb.Put16(buf[b:], Height)
+	order.Put16(buf[b:], Height)
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DstX))
+	order.Put16(buf[b:], uint16(DstX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DstY))
+	order.Put16(buf[b:], uint16(DstY))
 	b += 2
 
 	buf[b] = LeftPad
@@ -12575,6 +20332,73 @@ func putImageRequest(c *xgb.Conn, Format byte, Drawable Drawable, Gc Gcontext, W
 	return buf
 }
 
+// PutImageRequest represents the data of a PutImage request, as it is read when tracing a connection.
+type PutImageRequest struct {
+	Format   ImageFormat
+	Drawable Drawable
+	Gc       Gcontext
+	Width    uint16
+	Height   uint16
+	DstX     int16
+	DstY     int16
+	LeftPad  byte
+	Depth    byte
+	// padding: 2 bytes
+	Data []byte // size: xgb.Pad((len(Data) * 1))
+}
+
+// putImageRequestRead reads a byte slice in the byte order 'order' into a PutImageRequest value.
+func putImageRequestRead(buf []byte, order xgb.ByteOrder) *PutImageRequest {
+	v := new(PutImageRequest)
+	b := 1 // skip request opcode
+
+	v.Format = ImageFormat(buf[b])
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	v.DstX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.DstY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.LeftPad = buf[b]
+	b += 1
+
+	v.Depth = buf[b]
+	b += 1
+
+	b += 2 // padding
+
+	v.Data = make([]byte, (len(buf)-b)/1)
+	copy(v.Data[:(len(buf)-b)/1], buf[b:])
+	b += int((len(buf) - b) / 1)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[72] = xgb.RequestInfo{
+		Name: "PutImage",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return putImageRequestRead(buf, order)
+		},
+	}
+}
+
 // QueryBestSizeCookie is a cookie used only for QueryBestSize requests.
 type QueryBestSizeCookie struct {
 	*xgb.Cookie
@@ -12582,7 +20406,7 @@ type QueryBestSizeCookie struct {
 
 // QueryBestSize sends a checked request.
 // If an error occurs, it will be returned with the reply by calling QueryBestSizeCookie.Reply()
-func QueryBestSize(c *xgb.Conn, Class byte, Drawable Drawable, Width uint16, Height uint16) QueryBestSizeCookie {
+func QueryBestSize(c *xgb.Conn, Class QueryShapeOf, Drawable Drawable, Width uint16, Height uint16) QueryBestSizeCookie {
 	cookie := c.NewCookie(true, true)
 	c.NewRequest(queryBestSizeRequest(c, Class, Drawable, Width, Height), cookie)
 	return QueryBestSizeCookie{cookie}
@@ -12590,7 +20414,7 @@ func QueryBestSize(c *xgb.Conn, Class byte, Drawable Drawable, Width uint16, Hei
 
 // QueryBestSizeUnchecked sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func QueryBestSizeUnchecked(c *xgb.Conn, Class byte, Drawable Drawable, Width uint16, Height uint16) QueryBestSizeCookie {
+func QueryBestSizeUnchecked(c *xgb.Conn, Class QueryShapeOf, Drawable Drawable, Width uint16, Height uint16) QueryBestSizeCookie {
 	cookie := c.NewCookie(false, true)
 	c.NewRequest(queryBestSizeRequest(c, Class, Drawable, Width, Height), cookie)
 	return QueryBestSizeCookie{cookie}
@@ -12614,26 +20438,38 @@ func (cook QueryBestSizeCookie) Reply() (*QueryBestSizeReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryBestSizeReply(buf), nil
+	return queryBestSizeReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryBestSize request arrives.
+func (cook QueryBestSizeCookie) ReplyContext(ctx context.Context) (*QueryBestSizeReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryBestSizeReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryBestSizeReply reads a byte slice into a QueryBestSizeReply value.
-func queryBestSizeReply(buf []byte) *QueryBestSizeReply {
+// queryBestSizeReply reads a byte slice in the byte order 'order' into a QueryBestSizeReply value.
+func queryBestSizeReply(buf []byte, order xgb.ByteOrder) *QueryBestSizeReply {
 	v := new(QueryBestSizeReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -12641,32 +20477,83 @@ func queryBestSizeReply(buf []byte) *QueryBestSizeReply {
 
 // Write request to wire for QueryBestSize
 // queryBestSizeRequest writes a QueryBestSize request to a byte slice.
-func queryBestSizeRequest(c *xgb.Conn, Class byte, Drawable Drawable, Width uint16, Height uint16) []byte {
+func queryBestSizeRequest(c *xgb.Conn, Class QueryShapeOf, Drawable Drawable, Width uint16, Height uint16) []byte {
+	return AppendQueryBestSizeRequest(nil, c, Class, Drawable, Width, Height)
+}
+
+// AppendQueryBestSizeRequest appends a QueryBestSize request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryBestSizeRequest(buf []byte, c *xgb.Conn, Class QueryShapeOf, Drawable Drawable, Width uint16, Height uint16) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 97 // request opcode
 	b += 1
 
-	buf[b] = Class
+	buf[b] = byte(Class)
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put16(buf[b:], Width)
+	order.Put16(buf[b:], Width)
 	b += 2
 
-	xgb.Put16(buf[b:], Height)
+	order.Put16(buf[b:], Height)
 	b += 2
 
 	return buf
 }
 
+// QueryBestSizeRequest represents the data of a QueryBestSize request, as it is read when tracing a connection.
+type QueryBestSizeRequest struct {
+	Class    QueryShapeOf
+	Drawable Drawable
+	Width    uint16
+	Height   uint16
+}
+
+// queryBestSizeRequestRead reads a byte slice in the byte order 'order' into a QueryBestSizeRequest value.
+func queryBestSizeRequestRead(buf []byte, order xgb.ByteOrder) *QueryBestSizeRequest {
+	v := new(QueryBestSizeRequest)
+	b := 1 // skip request opcode
+
+	v.Class = QueryShapeOf(buf[b])
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Drawable = Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[97] = xgb.RequestInfo{
+		Name: "QueryBestSize",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryBestSizeRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryBestSizeReply(buf, order)
+		},
+	}
+}
+
 // QueryColorsCookie is a cookie used only for QueryColors requests.
 type QueryColorsCookie struct {
 	*xgb.Cookie
@@ -12707,29 +20594,41 @@ func (cook QueryColorsCookie) Reply() (*QueryColorsReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryColorsReply(buf), nil
+	return queryColorsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryColors request arrives.
+func (cook QueryColorsCookie) ReplyContext(ctx context.Context) (*QueryColorsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryColorsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryColorsReply reads a byte slice into a QueryColorsReply value.
-func queryColorsReply(buf []byte) *QueryColorsReply {
+// queryColorsReply reads a byte slice in the byte order 'order' into a QueryColorsReply value.
+func queryColorsReply(buf []byte, order xgb.ByteOrder) *QueryColorsReply {
 	v := new(QueryColorsReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ColorsLen = xgb.Get16(buf[b:])
+	v.ColorsLen = order.Get16(buf[b:])
 	b += 2
 
 	b += 22 // padding
 
 	v.Colors = make([]Rgb, v.ColorsLen)
-	b += RgbReadList(buf[b:], v.Colors)
+	b += RgbReadList(buf[b:], order, v.Colors)
 
 	return v
 }
@@ -12737,29 +20636,78 @@ func queryColorsReply(buf []byte) *QueryColorsReply {
 // Write request to wire for QueryColors
 // queryColorsRequest writes a QueryColors request to a byte slice.
 func queryColorsRequest(c *xgb.Conn, Cmap Colormap, Pixels []uint32) []byte {
+	return AppendQueryColorsRequest(nil, c, Cmap, Pixels)
+}
+
+// AppendQueryColorsRequest appends a QueryColors request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryColorsRequest(buf []byte, c *xgb.Conn, Cmap Colormap, Pixels []uint32) []byte {
 	size := xgb.Pad((8 + xgb.Pad((len(Pixels) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 91 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Cmap))
+	order.Put32(buf[b:], uint32(Cmap))
 	b += 4
 
 	for i := 0; i < int(len(Pixels)); i++ {
-		xgb.Put32(buf[b:], Pixels[i])
+		order.Put32(buf[b:], Pixels[i])
 		b += 4
 	}
 
 	return buf
 }
 
+// QueryColorsRequest represents the data of a QueryColors request, as it is read when tracing a connection.
+type QueryColorsRequest struct {
+	// padding: 1 bytes
+	Cmap   Colormap
+	Pixels []uint32 // size: xgb.Pad((len(Pixels) * 4))
+}
+
+// queryColorsRequestRead reads a byte slice in the byte order 'order' into a QueryColorsRequest value.
+func queryColorsRequestRead(buf []byte, order xgb.ByteOrder) *QueryColorsRequest {
+	v := new(QueryColorsRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Cmap = Colormap(order.Get32(buf[b:]))
+	b += 4
+
+	v.Pixels = make([]uint32, (len(buf)-b)/4)
+	for i := 0; i < int((len(buf)-b)/4); i++ {
+		v.Pixels[i] = order.Get32(buf[b:])
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[91] = xgb.RequestInfo{
+		Name: "QueryColors",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryColorsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryColorsReply(buf, order)
+		},
+	}
+}
+
 // QueryExtensionCookie is a cookie used only for QueryExtension requests.
 type QueryExtensionCookie struct {
 	*xgb.Cookie
@@ -12801,20 +20749,32 @@ func (cook QueryExtensionCookie) Reply() (*QueryExtensionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryExtensionReply(buf), nil
+	return queryExtensionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryExtension request arrives.
+func (cook QueryExtensionCookie) ReplyContext(ctx context.Context) (*QueryExtensionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryExtensionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryExtensionReply reads a byte slice into a QueryExtensionReply value.
-func queryExtensionReply(buf []byte) *QueryExtensionReply {
+// queryExtensionReply reads a byte slice in the byte order 'order' into a QueryExtensionReply value.
+func queryExtensionReply(buf []byte, order xgb.ByteOrder) *QueryExtensionReply {
 	v := new(QueryExtensionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	if buf[b] == 1 {
@@ -12839,19 +20799,28 @@ func queryExtensionReply(buf []byte) *QueryExtensionReply {
 // Write request to wire for QueryExtension
 // queryExtensionRequest writes a QueryExtension request to a byte slice.
 func queryExtensionRequest(c *xgb.Conn, NameLen uint16, Name string) []byte {
+	return AppendQueryExtensionRequest(nil, c, NameLen, Name)
+}
+
+// AppendQueryExtensionRequest appends a QueryExtension request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryExtensionRequest(buf []byte, c *xgb.Conn, NameLen uint16, Name string) []byte {
 	size := xgb.Pad((8 + xgb.Pad((int(NameLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 98 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], NameLen)
+	order.Put16(buf[b:], NameLen)
 	b += 2
 
 	b += 2 // padding
@@ -12862,6 +20831,50 @@ func queryExtensionRequest(c *xgb.Conn, NameLen uint16, Name string) []byte {
 	return buf
 }
 
+// QueryExtensionRequest represents the data of a QueryExtension request, as it is read when tracing a connection.
+type QueryExtensionRequest struct {
+	// padding: 1 bytes
+	NameLen uint16
+	// padding: 2 bytes
+	Name string // size: xgb.Pad((int(NameLen) * 1))
+}
+
+// queryExtensionRequestRead reads a byte slice in the byte order 'order' into a QueryExtensionRequest value.
+func queryExtensionRequestRead(buf []byte, order xgb.ByteOrder) *QueryExtensionRequest {
+	v := new(QueryExtensionRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.NameLen = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	{
+		byteString := make([]byte, v.NameLen)
+		copy(byteString[:v.NameLen], buf[b:])
+		v.Name = string(byteString)
+		b += int(v.NameLen)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[98] = xgb.RequestInfo{
+		Name: "QueryExtension",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryExtensionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryExtensionReply(buf, order)
+		},
+	}
+}
+
 // QueryFontCookie is a cookie used only for QueryFont requests.
 type QueryFontCookie struct {
 	*xgb.Cookie
@@ -12896,7 +20909,7 @@ type QueryFontReply struct {
 	MaxCharOrByte2 uint16
 	DefaultChar    uint16
 	PropertiesLen  uint16
-	DrawDirection  byte
+	DrawDirection  FontDraw
 	MinByte1       byte
 	MaxByte1       byte
 	AllCharsExist  bool
@@ -12917,45 +20930,57 @@ func (cook QueryFontCookie) Reply() (*QueryFontReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryFontReply(buf), nil
+	return queryFontReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryFont request arrives.
+func (cook QueryFontCookie) ReplyContext(ctx context.Context) (*QueryFontReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryFontReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryFontReply reads a byte slice into a QueryFontReply value.
-func queryFontReply(buf []byte) *QueryFontReply {
+// queryFontReply reads a byte slice in the byte order 'order' into a QueryFontReply value.
+func queryFontReply(buf []byte, order xgb.ByteOrder) *QueryFontReply {
 	v := new(QueryFontReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	v.MinBounds = Charinfo{}
-	b += CharinfoRead(buf[b:], &v.MinBounds)
+	b += CharinfoRead(buf[b:], order, &v.MinBounds)
 
 	b += 4 // padding
 
 	v.MaxBounds = Charinfo{}
-	b += CharinfoRead(buf[b:], &v.MaxBounds)
+	b += CharinfoRead(buf[b:], order, &v.MaxBounds)
 
 	b += 4 // padding
 
-	v.MinCharOrByte2 = xgb.Get16(buf[b:])
+	v.MinCharOrByte2 = order.Get16(buf[b:])
 	b += 2
 
-	v.MaxCharOrByte2 = xgb.Get16(buf[b:])
+	v.MaxCharOrByte2 = order.Get16(buf[b:])
 	b += 2
 
-	v.DefaultChar = xgb.Get16(buf[b:])
+	v.DefaultChar = order.Get16(buf[b:])
 	b += 2
 
-	v.PropertiesLen = xgb.Get16(buf[b:])
+	v.PropertiesLen = order.Get16(buf[b:])
 	b += 2
 
-	v.DrawDirection = buf[b]
+	v.DrawDirection = FontDraw(buf[b])
 	b += 1
 
 	v.MinByte1 = buf[b]
@@ -12971,22 +20996,22 @@ func queryFontReply(buf []byte) *QueryFontReply {
 	}
 	b += 1
 
-	v.FontAscent = int16(xgb.Get16(buf[b:]))
+	v.FontAscent = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.FontDescent = int16(xgb.Get16(buf[b:]))
+	v.FontDescent = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.CharInfosLen = xgb.Get32(buf[b:])
+	v.CharInfosLen = order.Get32(buf[b:])
 	b += 4
 
 	v.Properties = make([]Fontprop, v.PropertiesLen)
-	b += FontpropReadList(buf[b:], v.Properties)
+	b += FontpropReadList(buf[b:], order, v.Properties)
 
 	b = (b + 3) & ^3 // alignment gap
 
 	v.CharInfos = make([]Charinfo, v.CharInfosLen)
-	b += CharinfoReadList(buf[b:], v.CharInfos)
+	b += CharinfoReadList(buf[b:], order, v.CharInfos)
 
 	return v
 }
@@ -12994,24 +21019,66 @@ func queryFontReply(buf []byte) *QueryFontReply {
 // Write request to wire for QueryFont
 // queryFontRequest writes a QueryFont request to a byte slice.
 func queryFontRequest(c *xgb.Conn, Font Fontable) []byte {
+	return AppendQueryFontRequest(nil, c, Font)
+}
+
+// AppendQueryFontRequest appends a QueryFont request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryFontRequest(buf []byte, c *xgb.Conn, Font Fontable) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 47 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Font))
+	order.Put32(buf[b:], uint32(Font))
 	b += 4
 
 	return buf
 }
 
+// QueryFontRequest represents the data of a QueryFont request, as it is read when tracing a connection.
+type QueryFontRequest struct {
+	// padding: 1 bytes
+	Font Fontable
+}
+
+// queryFontRequestRead reads a byte slice in the byte order 'order' into a QueryFontRequest value.
+func queryFontRequestRead(buf []byte, order xgb.ByteOrder) *QueryFontRequest {
+	v := new(QueryFontRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Font = Fontable(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[47] = xgb.RequestInfo{
+		Name: "QueryFont",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryFontRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryFontReply(buf, order)
+		},
+	}
+}
+
 // QueryKeymapCookie is a cookie used only for QueryKeymap requests.
 type QueryKeymapCookie struct {
 	*xgb.Cookie
@@ -13050,20 +21117,32 @@ func (cook QueryKeymapCookie) Reply() (*QueryKeymapReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryKeymapReply(buf), nil
+	return queryKeymapReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryKeymap request arrives.
+func (cook QueryKeymapCookie) ReplyContext(ctx context.Context) (*QueryKeymapReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryKeymapReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryKeymapReply reads a byte slice into a QueryKeymapReply value.
-func queryKeymapReply(buf []byte) *QueryKeymapReply {
+// queryKeymapReply reads a byte slice in the byte order 'order' into a QueryKeymapReply value.
+func queryKeymapReply(buf []byte, order xgb.ByteOrder) *QueryKeymapReply {
 	v := new(QueryKeymapReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	v.Keys = make([]byte, 32)
@@ -13076,25 +21155,70 @@ func queryKeymapReply(buf []byte) *QueryKeymapReply {
 // Write request to wire for QueryKeymap
 // queryKeymapRequest writes a QueryKeymap request to a byte slice.
 func queryKeymapRequest(c *xgb.Conn) []byte {
+	return AppendQueryKeymapRequest(nil, c)
+}
+
+// AppendQueryKeymapRequest appends a QueryKeymap request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryKeymapRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 44 // request opcode
 	b += 1
 
-	b += 1                             // padding
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 1                               // padding
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// QueryKeymapRequest represents the data of a QueryKeymap request, as it is read when tracing a connection.
+type QueryKeymapRequest struct {
+}
+
+// queryKeymapRequestRead reads a byte slice in the byte order 'order' into a QueryKeymapRequest value.
+func queryKeymapRequestRead(buf []byte, order xgb.ByteOrder) *QueryKeymapRequest {
+	v := new(QueryKeymapRequest)
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[44] = xgb.RequestInfo{
+		Name: "QueryKeymap",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryKeymapRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryKeymapReply(buf, order)
+		},
+	}
+}
+
 // QueryPointerCookie is a cookie used only for QueryPointer requests.
 type QueryPointerCookie struct {
 	*xgb.Cookie
 }
 
+// QueryPointer get pointer coordinates.
+//
+// Gets the root window the pointer is logically on and the pointer coordinates
+// relative to the root window's origin.
+//
+// Parameters:
+//
+//   - Window: A window to check if the pointer is on the same screen as
+//     `window` (see the `same_screen` field in the reply).
+//
+// Errors:
+//
+//   - WindowError: The specified `window` does not exist.
+//
 // QueryPointer sends a checked request.
 // If an error occurs, it will be returned with the reply by calling QueryPointerCookie.Reply()
 func QueryPointer(c *xgb.Conn, Window Window) QueryPointerCookie {
@@ -13113,16 +21237,41 @@ func QueryPointerUnchecked(c *xgb.Conn, Window Window) QueryPointerCookie {
 
 // QueryPointerReply represents the data returned from a QueryPointer request.
 type QueryPointerReply struct {
-	Sequence   uint16 // sequence number of the request for this reply
-	Length     uint32 // number of bytes in this reply
+	Sequence uint16 // sequence number of the request for this reply
+	Length   uint32 // number of bytes in this reply
+
+	// If `same_screen` is False, then the pointer is not on the same screen as
+	// the argument window, `child` is None, and `win_x` and `win_y` are zero.
+	// If `same_screen` is True, then `win_x` and `win_y` are the pointer
+	// coordinates relative to the argument window's origin, and child is the
+	// child containing the pointer, if any.
 	SameScreen bool
-	Root       Window
-	Child      Window
-	RootX      int16
-	RootY      int16
-	WinX       int16
-	WinY       int16
-	Mask       uint16
+
+	// The root window the pointer is logically on.
+	Root Window
+
+	// The child window containing the pointer, if any, if `same_screen` is
+	// true. If `same_screen` is false, `WindowNone` is returned.
+	Child Window
+
+	// The pointer X position, relative to `root`.
+	RootX int16
+
+	// The pointer Y position, relative to `root`.
+	RootY int16
+
+	// The pointer X coordinate, relative to `child`, if `same_screen` is true.
+	// Zero otherwise.
+	WinX int16
+
+	// The pointer Y coordinate, relative to `child`, if `same_screen` is true.
+	// Zero otherwise.
+	WinY int16
+
+	// The current logical state of the modifier keys and the buttons. Note
+	// that the logical state of a device (as seen by means of the protocol)
+	// may lag the physical state if device event processing is frozen.
+	Mask KeyButMask
 	// padding: 2 bytes
 }
 
@@ -13135,11 +21284,23 @@ func (cook QueryPointerCookie) Reply() (*QueryPointerReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryPointerReply(buf), nil
+	return queryPointerReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryPointer request arrives.
+func (cook QueryPointerCookie) ReplyContext(ctx context.Context) (*QueryPointerReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryPointerReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryPointerReply reads a byte slice into a QueryPointerReply value.
-func queryPointerReply(buf []byte) *QueryPointerReply {
+// queryPointerReply reads a byte slice in the byte order 'order' into a QueryPointerReply value.
+func queryPointerReply(buf []byte, order xgb.ByteOrder) *QueryPointerReply {
 	v := new(QueryPointerReply)
 	b := 1 // skip reply determinant
 
@@ -13150,31 +21311,31 @@ func queryPointerReply(buf []byte) *QueryPointerReply {
 	}
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Root = Window(xgb.Get32(buf[b:]))
+	v.Root = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Child = Window(xgb.Get32(buf[b:]))
+	v.Child = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.RootX = int16(xgb.Get16(buf[b:]))
+	v.RootX = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.RootY = int16(xgb.Get16(buf[b:]))
+	v.RootY = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.WinX = int16(xgb.Get16(buf[b:]))
+	v.WinX = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.WinY = int16(xgb.Get16(buf[b:]))
+	v.WinY = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.Mask = xgb.Get16(buf[b:])
+	v.Mask = KeyButMask(order.Get16(buf[b:]))
 	b += 2
 
 	b += 2 // padding
@@ -13185,29 +21346,106 @@ func queryPointerReply(buf []byte) *QueryPointerReply {
 // Write request to wire for QueryPointer
 // queryPointerRequest writes a QueryPointer request to a byte slice.
 func queryPointerRequest(c *xgb.Conn, Window Window) []byte {
+	return AppendQueryPointerRequest(nil, c, Window)
+}
+
+// AppendQueryPointerRequest appends a QueryPointer request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryPointerRequest(buf []byte, c *xgb.Conn, Window Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 38 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// QueryPointerRequest represents the data of a QueryPointer request, as it is read when tracing a connection.
+type QueryPointerRequest struct {
+	// padding: 1 bytes
+	Window Window
+}
+
+// queryPointerRequestRead reads a byte slice in the byte order 'order' into a QueryPointerRequest value.
+func queryPointerRequestRead(buf []byte, order xgb.ByteOrder) *QueryPointerRequest {
+	v := new(QueryPointerRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Window = Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[38] = xgb.RequestInfo{
+		Name: "QueryPointer",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryPointerRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryPointerReply(buf, order)
+		},
+	}
+}
+
 // QueryTextExtentsCookie is a cookie used only for QueryTextExtents requests.
 type QueryTextExtentsCookie struct {
 	*xgb.Cookie
 }
 
+// QueryTextExtents get text extents.
+//
+// Query text extents from the X11 server. This request returns the bounding
+// box of the specified 16-bit character string in the specified `font` or the
+// font contained in the specified graphics context.
+//
+// `font_ascent` is set to the maximum of the ascent metrics of all characters
+// in the string. `font_descent` is set to the maximum of the descent metrics.
+// `overall_width` is set to the sum of the character-width metrics of all
+// characters in the string. For each character in the string, let W be the sum
+// of the character-width metrics of all characters preceding it in the string.
+// Let L be the left-side-bearing metric of the character plus W. Let R be the
+// right-side-bearing metric of the character plus W. The lbearing member is
+// set to the minimum L of all characters in the string. The rbearing member is
+// set to the maximum R.
+//
+// For fonts defined with linear indexing rather than 2-byte matrix indexing,
+// each `Char2b` structure is interpreted as a 16-bit number with byte1 as the
+// most significant byte. If the font has no defined default character,
+// undefined characters in the string are taken to have all zero metrics.
+//
+// Characters with all zero metrics are ignored. If the font has no defined
+// default_char, the undefined characters in the string are also ignored.
+//
+// Parameters:
+//
+//   - Font: The `font` to calculate text extents in. You can also pass a
+//     graphics context.
+//   - String: The text to get text extents for.
+//
+// Errors:
+//
+//   - GContextError: The specified graphics context does not exist.
+//   - FontError: The specified `font` does not exist.
+//
 // QueryTextExtents sends a checked request.
 // If an error occurs, it will be returned with the reply by calling QueryTextExtentsCookie.Reply()
 func QueryTextExtents(c *xgb.Conn, Font Fontable, String []Char2b, StringLen uint16) QueryTextExtentsCookie {
@@ -13228,7 +21466,7 @@ func QueryTextExtentsUnchecked(c *xgb.Conn, Font Fontable, String []Char2b, Stri
 type QueryTextExtentsReply struct {
 	Sequence       uint16 // sequence number of the request for this reply
 	Length         uint32 // number of bytes in this reply
-	DrawDirection  byte
+	DrawDirection  FontDraw
 	FontAscent     int16
 	FontDescent    int16
 	OverallAscent  int16
@@ -13247,42 +21485,54 @@ func (cook QueryTextExtentsCookie) Reply() (*QueryTextExtentsReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryTextExtentsReply(buf), nil
+	return queryTextExtentsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryTextExtents request arrives.
+func (cook QueryTextExtentsCookie) ReplyContext(ctx context.Context) (*QueryTextExtentsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryTextExtentsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryTextExtentsReply reads a byte slice into a QueryTextExtentsReply value.
-func queryTextExtentsReply(buf []byte) *QueryTextExtentsReply {
+// queryTextExtentsReply reads a byte slice in the byte order 'order' into a QueryTextExtentsReply value.
+func queryTextExtentsReply(buf []byte, order xgb.ByteOrder) *QueryTextExtentsReply {
 	v := new(QueryTextExtentsReply)
 	b := 1 // skip reply determinant
 
-	v.DrawDirection = buf[b]
+	v.DrawDirection = FontDraw(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.FontAscent = int16(xgb.Get16(buf[b:]))
+	v.FontAscent = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.FontDescent = int16(xgb.Get16(buf[b:]))
+	v.FontDescent = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.OverallAscent = int16(xgb.Get16(buf[b:]))
+	v.OverallAscent = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.OverallDescent = int16(xgb.Get16(buf[b:]))
+	v.OverallDescent = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.OverallWidth = int32(xgb.Get32(buf[b:]))
+	v.OverallWidth = int32(order.Get32(buf[b:]))
 	b += 4
 
-	v.OverallLeft = int32(xgb.Get32(buf[b:]))
+	v.OverallLeft = int32(order.Get32(buf[b:]))
 	b += 4
 
-	v.OverallRight = int32(xgb.Get32(buf[b:]))
+	v.OverallRight = int32(order.Get32(buf[b:]))
 	b += 4
 
 	return v
@@ -13291,9 +21541,18 @@ func queryTextExtentsReply(buf []byte) *QueryTextExtentsReply {
 // Write request to wire for QueryTextExtents
 // queryTextExtentsRequest writes a QueryTextExtents request to a byte slice.
 func queryTextExtentsRequest(c *xgb.Conn, Font Fontable, String []Char2b, StringLen uint16) []byte {
+	return AppendQueryTextExtentsRequest(nil, c, Font, String, StringLen)
+}
+
+// AppendQueryTextExtentsRequest appends a QueryTextExtents request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryTextExtentsRequest(buf []byte, c *xgb.Conn, Font Fontable, String []Char2b, StringLen uint16) []byte {
 	size := xgb.Pad((8 + xgb.Pad((len(String) * 2))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 48 // request opcode
 	b += 1
@@ -13301,24 +21560,78 @@ func queryTextExtentsRequest(c *xgb.Conn, Font Fontable, String []Char2b, String
 	buf[b] = byte((int(StringLen) & 1))
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Font))
+	order.Put32(buf[b:], uint32(Font))
 	b += 4
 
-	b += Char2bListBytes(buf[b:], String)
+	b += Char2bListBytes(buf[b:], order, String)
 
 	// skip writing local field: StringLen (2) :: uint16
 
 	return buf
 }
 
+// QueryTextExtentsRequest represents the data of a QueryTextExtents request, as it is read when tracing a connection.
+type QueryTextExtentsRequest struct {
+	OddLength bool // computed from (int(StringLen) & 1)
+	Font      Fontable
+	String    []Char2b // size: xgb.Pad((len(String) * 2))
+}
+
+// queryTextExtentsRequestRead reads a byte slice in the byte order 'order' into a QueryTextExtentsRequest value.
+func queryTextExtentsRequestRead(buf []byte, order xgb.ByteOrder) *QueryTextExtentsRequest {
+	v := new(QueryTextExtentsRequest)
+	b := 1 // skip request opcode
+
+	if buf[b] == 1 {
+		v.OddLength = true
+	} else {
+		v.OddLength = false
+	}
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Font = Fontable(order.Get32(buf[b:]))
+	b += 4
+
+	v.String = make([]Char2b, (len(buf)-b)/2)
+	b += Char2bReadList(buf[b:], order, v.String)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[48] = xgb.RequestInfo{
+		Name: "QueryTextExtents",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryTextExtentsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryTextExtentsReply(buf, order)
+		},
+	}
+}
+
 // QueryTreeCookie is a cookie used only for QueryTree requests.
 type QueryTreeCookie struct {
 	*xgb.Cookie
 }
 
+// QueryTree query the window tree.
+//
+// Gets the root window ID, parent window ID and list of children windows for
+// the specified `window`. The children are listed in bottom-to-top stacking
+// order.
+//
+// Parameters:
+//
+//   - Window: The `window` to query.
+//
+// See also xwininfo(1).
+//
 // QueryTree sends a checked request.
 // If an error occurs, it will be returned with the reply by calling QueryTreeCookie.Reply()
 func QueryTree(c *xgb.Conn, Window Window) QueryTreeCookie {
@@ -13340,8 +21653,14 @@ type QueryTreeReply struct {
 	Sequence uint16 // sequence number of the request for this reply
 	Length   uint32 // number of bytes in this reply
 	// padding: 1 bytes
-	Root        Window
-	Parent      Window
+
+	// The root window of `window`.
+	Root Window
+
+	// The parent window of `window`.
+	Parent Window
+
+	// The number of child windows.
 	ChildrenLen uint16
 	// padding: 14 bytes
 	Children []Window // size: xgb.Pad((int(ChildrenLen) * 4))
@@ -13356,36 +21675,48 @@ func (cook QueryTreeCookie) Reply() (*QueryTreeReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryTreeReply(buf), nil
+	return queryTreeReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryTree request arrives.
+func (cook QueryTreeCookie) ReplyContext(ctx context.Context) (*QueryTreeReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryTreeReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryTreeReply reads a byte slice into a QueryTreeReply value.
-func queryTreeReply(buf []byte) *QueryTreeReply {
+// queryTreeReply reads a byte slice in the byte order 'order' into a QueryTreeReply value.
+func queryTreeReply(buf []byte, order xgb.ByteOrder) *QueryTreeReply {
 	v := new(QueryTreeReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Root = Window(xgb.Get32(buf[b:]))
+	v.Root = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.Parent = Window(xgb.Get32(buf[b:]))
+	v.Parent = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.ChildrenLen = xgb.Get16(buf[b:])
+	v.ChildrenLen = order.Get16(buf[b:])
 	b += 2
 
 	b += 14 // padding
 
 	v.Children = make([]Window, v.ChildrenLen)
 	for i := 0; i < int(v.ChildrenLen); i++ {
-		v.Children[i] = Window(xgb.Get32(buf[b:]))
+		v.Children[i] = Window(order.Get32(buf[b:]))
 		b += 4
 	}
 
@@ -13395,24 +21726,66 @@ func queryTreeReply(buf []byte) *QueryTreeReply {
 // Write request to wire for QueryTree
 // queryTreeRequest writes a QueryTree request to a byte slice.
 func queryTreeRequest(c *xgb.Conn, Window Window) []byte {
+	return AppendQueryTreeRequest(nil, c, Window)
+}
+
+// AppendQueryTreeRequest appends a QueryTree request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryTreeRequest(buf []byte, c *xgb.Conn, Window Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 15 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// QueryTreeRequest represents the data of a QueryTree request, as it is read when tracing a connection.
+type QueryTreeRequest struct {
+	// padding: 1 bytes
+	Window Window
+}
+
+// queryTreeRequestRead reads a byte slice in the byte order 'order' into a QueryTreeRequest value.
+func queryTreeRequestRead(buf []byte, order xgb.ByteOrder) *QueryTreeRequest {
+	v := new(QueryTreeRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Window = Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[15] = xgb.RequestInfo{
+		Name: "QueryTree",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryTreeRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryTreeReply(buf, order)
+		},
+	}
+}
+
 // RecolorCursorCookie is a cookie used only for RecolorCursor requests.
 type RecolorCursorCookie struct {
 	*xgb.Cookie
@@ -13440,50 +21813,145 @@ func (cook RecolorCursorCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook RecolorCursorCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for RecolorCursor
 // recolorCursorRequest writes a RecolorCursor request to a byte slice.
 func recolorCursorRequest(c *xgb.Conn, Cursor Cursor, ForeRed uint16, ForeGreen uint16, ForeBlue uint16, BackRed uint16, BackGreen uint16, BackBlue uint16) []byte {
+	return AppendRecolorCursorRequest(nil, c, Cursor, ForeRed, ForeGreen, ForeBlue, BackRed, BackGreen, BackBlue)
+}
+
+// AppendRecolorCursorRequest appends a RecolorCursor request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendRecolorCursorRequest(buf []byte, c *xgb.Conn, Cursor Cursor, ForeRed uint16, ForeGreen uint16, ForeBlue uint16, BackRed uint16, BackGreen uint16, BackBlue uint16) []byte {
 	size := 20
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 96 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Cursor))
+	order.Put32(buf[b:], uint32(Cursor))
 	b += 4
 
-	xgb.Put16(buf[b:], ForeRed)
+	order.Put16(buf[b:], ForeRed)
 	b += 2
 
-	xgb.Put16(buf[b:], ForeGreen)
+	order.Put16(buf[b:], ForeGreen)
 	b += 2
 
-	xgb.Put16(buf[b:], ForeBlue)
+	order.Put16(buf[b:], ForeBlue)
 	b += 2
 
-	xgb.Put16(buf[b:], BackRed)
+	order.Put16(buf[b:], BackRed)
 	b += 2
 
-	xgb.Put16(buf[b:], BackGreen)
+	order.Put16(buf[b:], BackGreen)
 	b += 2
 
-	xgb.Put16(buf[b:], BackBlue)
+	order.Put16(buf[b:], BackBlue)
 	b += 2
 
 	return buf
 }
 
+// RecolorCursorRequest represents the data of a RecolorCursor request, as it is read when tracing a connection.
+type RecolorCursorRequest struct {
+	// padding: 1 bytes
+	Cursor    Cursor
+	ForeRed   uint16
+	ForeGreen uint16
+	ForeBlue  uint16
+	BackRed   uint16
+	BackGreen uint16
+	BackBlue  uint16
+}
+
+// recolorCursorRequestRead reads a byte slice in the byte order 'order' into a RecolorCursorRequest value.
+func recolorCursorRequestRead(buf []byte, order xgb.ByteOrder) *RecolorCursorRequest {
+	v := new(RecolorCursorRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Cursor = Cursor(order.Get32(buf[b:]))
+	b += 4
+
+	v.ForeRed = order.Get16(buf[b:])
+	b += 2
+
+	v.ForeGreen = order.Get16(buf[b:])
+	b += 2
+
+	v.ForeBlue = order.Get16(buf[b:])
+	b += 2
+
+	v.BackRed = order.Get16(buf[b:])
+	b += 2
+
+	v.BackGreen = order.Get16(buf[b:])
+	b += 2
+
+	v.BackBlue = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[96] = xgb.RequestInfo{
+		Name: "RecolorCursor",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return recolorCursorRequestRead(buf, order)
+		},
+	}
+}
+
 // ReparentWindowCookie is a cookie used only for ReparentWindow requests.
 type ReparentWindowCookie struct {
 	*xgb.Cookie
 }
 
+// ReparentWindow reparents a window.
+//
+// Makes the specified window a child of the specified parent window. If the
+// window is mapped, it will automatically be unmapped before reparenting and
+// re-mapped after reparenting. The window is placed in the stacking order on
+// top with respect to sibling windows.
+//
+// After reparenting, a ReparentNotify event is generated.
+//
+// Parameters:
+//
+//   - Window: The window to reparent.
+//   - Parent: The new parent of the window.
+//   - X: The X position of the window within its new parent.
+//   - Y: The Y position of the window within its new parent.
+//
+// Errors:
+//
+//   - MatchError: The new parent window is not on the same screen as the old
+//     parent window. The new parent window is the specified window or an
+//     inferior of the specified window. The new parent is InputOnly and the
+//     window is not. The specified window has a ParentRelative background and
+//     the new parent window is not the same depth as the specified window.
+//   - WindowError: The specified window does not exist.
+//
+// See also ReparentNotifyEvent, MapWindow, UnmapWindow.
+//
 // ReparentWindow sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
 func ReparentWindow(c *xgb.Conn, Window Window, Parent Window, X int16, Y int16) ReparentWindowCookie {
@@ -13506,36 +21974,92 @@ func (cook ReparentWindowCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ReparentWindowCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ReparentWindow
 // reparentWindowRequest writes a ReparentWindow request to a byte slice.
 func reparentWindowRequest(c *xgb.Conn, Window Window, Parent Window, X int16, Y int16) []byte {
+	return AppendReparentWindowRequest(nil, c, Window, Parent, X, Y)
+}
+
+// AppendReparentWindowRequest appends a ReparentWindow request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendReparentWindowRequest(buf []byte, c *xgb.Conn, Window Window, Parent Window, X int16, Y int16) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 7 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Parent))
+	order.Put32(buf[b:], uint32(Parent))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(X))
+	order.Put16(buf[b:], uint16(X))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(Y))
+	order.Put16(buf[b:], uint16(Y))
 	b += 2
 
 	return buf
 }
 
+// ReparentWindowRequest represents the data of a ReparentWindow request, as it is read when tracing a connection.
+type ReparentWindowRequest struct {
+	// padding: 1 bytes
+	Window Window
+	Parent Window
+	X      int16
+	Y      int16
+}
+
+// reparentWindowRequestRead reads a byte slice in the byte order 'order' into a ReparentWindowRequest value.
+func reparentWindowRequestRead(buf []byte, order xgb.ByteOrder) *ReparentWindowRequest {
+	v := new(ReparentWindowRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Window = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Parent = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.X = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Y = int16(order.Get16(buf[b:]))
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[7] = xgb.RequestInfo{
+		Name: "ReparentWindow",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return reparentWindowRequestRead(buf, order)
+		},
+	}
+}
+
 // RotatePropertiesCookie is a cookie used only for RotateProperties requests.
 type RotatePropertiesCookie struct {
 	*xgb.Cookie
@@ -13563,46 +22087,144 @@ func (cook RotatePropertiesCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook RotatePropertiesCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for RotateProperties
 // rotatePropertiesRequest writes a RotateProperties request to a byte slice.
 func rotatePropertiesRequest(c *xgb.Conn, Window Window, AtomsLen uint16, Delta int16, Atoms []Atom) []byte {
+	return AppendRotatePropertiesRequest(nil, c, Window, AtomsLen, Delta, Atoms)
+}
+
+// AppendRotatePropertiesRequest appends a RotateProperties request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendRotatePropertiesRequest(buf []byte, c *xgb.Conn, Window Window, AtomsLen uint16, Delta int16, Atoms []Atom) []byte {
 	size := xgb.Pad((12 + xgb.Pad((int(AtomsLen) * 4))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 114 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put16(buf[b:], AtomsLen)
+	order.Put16(buf[b:], AtomsLen)
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(Delta))
+	order.Put16(buf[b:], uint16(Delta))
 	b += 2
 
 	for i := 0; i < int(AtomsLen); i++ {
-		xgb.Put32(buf[b:], uint32(Atoms[i]))
+		order.Put32(buf[b:], uint32(Atoms[i]))
 		b += 4
 	}
 
 	return buf
 }
 
+// RotatePropertiesRequest represents the data of a RotateProperties request, as it is read when tracing a connection.
+type RotatePropertiesRequest struct {
+	// padding: 1 bytes
+	Window   Window
+	AtomsLen uint16
+	Delta    int16
+	Atoms    []Atom // size: xgb.Pad((int(AtomsLen) * 4))
+}
+
+// rotatePropertiesRequestRead reads a byte slice in the byte order 'order' into a RotatePropertiesRequest value.
+func rotatePropertiesRequestRead(buf []byte, order xgb.ByteOrder) *RotatePropertiesRequest {
+	v := new(RotatePropertiesRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Window = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.AtomsLen = order.Get16(buf[b:])
+	b += 2
+
+	v.Delta = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Atoms = make([]Atom, v.AtomsLen)
+	for i := 0; i < int(v.AtomsLen); i++ {
+		v.Atoms[i] = Atom(order.Get32(buf[b:]))
+		b += 4
+	}
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[114] = xgb.RequestInfo{
+		Name: "RotateProperties",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return rotatePropertiesRequestRead(buf, order)
+		},
+	}
+}
+
 // SendEventCookie is a cookie used only for SendEvent requests.
 type SendEventCookie struct {
 	*xgb.Cookie
 }
 
+// SendEvent send an event.
+//
+// Identifies the `destination` window, determines which clients should receive
+// the specified event and ignores any active grabs.
+//
+// The `event` must be one of the core events or an event defined by an
+// extension, so that the X server can correctly byte-swap the contents as
+// necessary. The contents of `event` are otherwise unaltered and unchecked
+// except for the `send_event` field which is forced to 'true'.
+//
+// Parameters:
+//
+//   - Destination: The window to send this event to. Every client which
+//     selects any event within `event_mask` on `destination` will get the
+//     event. The special value `SendEventDestPointerWindow` refers to the
+//     window that contains the mouse pointer. The special value
+//     `SendEventDestItemFocus` refers to the window which has the keyboard
+//     focus.
+//   - EventMask: Event_mask for determining which clients should receive the
+//     specified event. See `destination` and `propagate`.
+//   - Propagate: If `propagate` is true and no clients have selected any event
+//     on `destination`, the destination is replaced with the closest ancestor
+//     of `destination` for which some client has selected a type in
+//     `event_mask` and for which no intervening window has that type in its
+//     do-not-propagate-mask. If no such window exists or if the window is an
+//     ancestor of the focus window and `InputFocusPointerRoot` was originally
+//     specified as the destination, the event is not sent to any clients.
+//     Otherwise, the event is reported to every client selecting on the final
+//     destination any of the types specified in `event_mask`.
+//   - Event: The event to send to the specified `destination`.
+//
+// Errors:
+//
+//   - WindowError: The specified `destination` window does not exist.
+//   - ValueError: The given `event` is neither a core event nor an event
+//     defined by an extension.
+//
+// See also ConfigureNotifyEvent.
+//
 // SendEvent sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func SendEvent(c *xgb.Conn, Propagate bool, Destination Window, EventMask uint32, Event string) SendEventCookie {
+func SendEvent(c *xgb.Conn, Propagate bool, Destination Window, EventMask EventMask, Event string) SendEventCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(sendEventRequest(c, Propagate, Destination, EventMask, Event), cookie)
 	return SendEventCookie{cookie}
@@ -13610,7 +22232,7 @@ func SendEvent(c *xgb.Conn, Propagate bool, Destination Window, EventMask uint32
 
 // SendEventChecked sends a checked request.
 // If an error occurs, it can be retrieved using SendEventCookie.Check()
-func SendEventChecked(c *xgb.Conn, Propagate bool, Destination Window, EventMask uint32, Event string) SendEventCookie {
+func SendEventChecked(c *xgb.Conn, Propagate bool, Destination Window, EventMask EventMask, Event string) SendEventCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(sendEventRequest(c, Propagate, Destination, EventMask, Event), cookie)
 	return SendEventCookie{cookie}
@@ -13622,12 +22244,26 @@ func (cook SendEventCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SendEventCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SendEvent
 // sendEventRequest writes a SendEvent request to a byte slice.
-func sendEventRequest(c *xgb.Conn, Propagate bool, Destination Window, EventMask uint32, Event string) []byte {
+func sendEventRequest(c *xgb.Conn, Propagate bool, Destination Window, EventMask EventMask, Event string) []byte {
+	return AppendSendEventRequest(nil, c, Propagate, Destination, EventMask, Event)
+}
+
+// AppendSendEventRequest appends a SendEvent request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSendEventRequest(buf []byte, c *xgb.Conn, Propagate bool, Destination Window, EventMask EventMask, Event string) []byte {
 	size := 44
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 25 // request opcode
 	b += 1
@@ -13639,13 +22275,13 @@ func sendEventRequest(c *xgb.Conn, Propagate bool, Destination Window, EventMask
 	}
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Destination))
+	order.Put32(buf[b:], uint32(Destination))
 	b += 4
 
-	xgb.Put32(buf[b:], EventMask)
+	order.Put32(buf[b:], uint32(EventMask))
 	b += 4
 
 	copy(buf[b:], Event[:32])
@@ -13654,6 +22290,53 @@ func sendEventRequest(c *xgb.Conn, Propagate bool, Destination Window, EventMask
 	return buf
 }
 
+// SendEventRequest represents the data of a SendEvent request, as it is read when tracing a connection.
+type SendEventRequest struct {
+	Propagate   bool
+	Destination Window
+	EventMask   EventMask
+	Event       string // size: 32
+}
+
+// sendEventRequestRead reads a byte slice in the byte order 'order' into a SendEventRequest value.
+func sendEventRequestRead(buf []byte, order xgb.ByteOrder) *SendEventRequest {
+	v := new(SendEventRequest)
+	b := 1 // skip request opcode
+
+	if buf[b] == 1 {
+		v.Propagate = true
+	} else {
+		v.Propagate = false
+	}
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Destination = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.EventMask = EventMask(order.Get32(buf[b:]))
+	b += 4
+
+	{
+		byteString := make([]byte, 32)
+		copy(byteString[:32], buf[b:])
+		v.Event = string(byteString)
+		b += int(32)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[25] = xgb.RequestInfo{
+		Name: "SendEvent",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return sendEventRequestRead(buf, order)
+		},
+	}
+}
+
 // SetAccessControlCookie is a cookie used only for SetAccessControl requests.
 type SetAccessControlCookie struct {
 	*xgb.Cookie
@@ -13661,7 +22344,7 @@ type SetAccessControlCookie struct {
 
 // SetAccessControl sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func SetAccessControl(c *xgb.Conn, Mode byte) SetAccessControlCookie {
+func SetAccessControl(c *xgb.Conn, Mode AccessControl) SetAccessControlCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(setAccessControlRequest(c, Mode), cookie)
 	return SetAccessControlCookie{cookie}
@@ -13669,7 +22352,7 @@ func SetAccessControl(c *xgb.Conn, Mode byte) SetAccessControlCookie {
 
 // SetAccessControlChecked sends a checked request.
 // If an error occurs, it can be retrieved using SetAccessControlCookie.Check()
-func SetAccessControlChecked(c *xgb.Conn, Mode byte) SetAccessControlCookie {
+func SetAccessControlChecked(c *xgb.Conn, Mode AccessControl) SetAccessControlCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(setAccessControlRequest(c, Mode), cookie)
 	return SetAccessControlCookie{cookie}
@@ -13681,25 +22364,66 @@ func (cook SetAccessControlCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetAccessControlCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetAccessControl
 // setAccessControlRequest writes a SetAccessControl request to a byte slice.
-func setAccessControlRequest(c *xgb.Conn, Mode byte) []byte {
+func setAccessControlRequest(c *xgb.Conn, Mode AccessControl) []byte {
+	return AppendSetAccessControlRequest(nil, c, Mode)
+}
+
+// AppendSetAccessControlRequest appends a SetAccessControl request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetAccessControlRequest(buf []byte, c *xgb.Conn, Mode AccessControl) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 111 // request opcode
 	b += 1
 
-	buf[b] = Mode
+	buf[b] = byte(Mode)
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// SetAccessControlRequest represents the data of a SetAccessControl request, as it is read when tracing a connection.
+type SetAccessControlRequest struct {
+	Mode AccessControl
+}
+
+// setAccessControlRequestRead reads a byte slice in the byte order 'order' into a SetAccessControlRequest value.
+func setAccessControlRequestRead(buf []byte, order xgb.ByteOrder) *SetAccessControlRequest {
+	v := new(SetAccessControlRequest)
+	b := 1 // skip request opcode
+
+	v.Mode = AccessControl(buf[b])
+	b += 1
+
+	b += 2 // skip request size
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[111] = xgb.RequestInfo{
+		Name: "SetAccessControl",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setAccessControlRequestRead(buf, order)
+		},
+	}
+}
+
 // SetClipRectanglesCookie is a cookie used only for SetClipRectangles requests.
 type SetClipRectanglesCookie struct {
 	*xgb.Cookie
@@ -13707,7 +22431,7 @@ type SetClipRectanglesCookie struct {
 
 // SetClipRectangles sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func SetClipRectangles(c *xgb.Conn, Ordering byte, Gc Gcontext, ClipXOrigin int16, ClipYOrigin int16, Rectangles []Rectangle) SetClipRectanglesCookie {
+func SetClipRectangles(c *xgb.Conn, Ordering ClipOrdering, Gc Gcontext, ClipXOrigin int16, ClipYOrigin int16, Rectangles []Rectangle) SetClipRectanglesCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(setClipRectanglesRequest(c, Ordering, Gc, ClipXOrigin, ClipYOrigin, Rectangles), cookie)
 	return SetClipRectanglesCookie{cookie}
@@ -13715,7 +22439,7 @@ func SetClipRectangles(c *xgb.Conn, Ordering byte, Gc Gcontext, ClipXOrigin int1
 
 // SetClipRectanglesChecked sends a checked request.
 // If an error occurs, it can be retrieved using SetClipRectanglesCookie.Check()
-func SetClipRectanglesChecked(c *xgb.Conn, Ordering byte, Gc Gcontext, ClipXOrigin int16, ClipYOrigin int16, Rectangles []Rectangle) SetClipRectanglesCookie {
+func SetClipRectanglesChecked(c *xgb.Conn, Ordering ClipOrdering, Gc Gcontext, ClipXOrigin int16, ClipYOrigin int16, Rectangles []Rectangle) SetClipRectanglesCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(setClipRectanglesRequest(c, Ordering, Gc, ClipXOrigin, ClipYOrigin, Rectangles), cookie)
 	return SetClipRectanglesCookie{cookie}
@@ -13727,36 +22451,93 @@ func (cook SetClipRectanglesCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetClipRectanglesCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetClipRectangles
 // setClipRectanglesRequest writes a SetClipRectangles request to a byte slice.
-func setClipRectanglesRequest(c *xgb.Conn, Ordering byte, Gc Gcontext, ClipXOrigin int16, ClipYOrigin int16, Rectangles []Rectangle) []byte {
+func setClipRectanglesRequest(c *xgb.Conn, Ordering ClipOrdering, Gc Gcontext, ClipXOrigin int16, ClipYOrigin int16, Rectangles []Rectangle) []byte {
+	return AppendSetClipRectanglesRequest(nil, c, Ordering, Gc, ClipXOrigin, ClipYOrigin, Rectangles)
+}
+
+// AppendSetClipRectanglesRequest appends a SetClipRectangles request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetClipRectanglesRequest(buf []byte, c *xgb.Conn, Ordering ClipOrdering, Gc Gcontext, ClipXOrigin int16, ClipYOrigin int16, Rectangles []Rectangle) []byte {
 	size := xgb.Pad((12 + xgb.Pad((len(Rectangles) * 8))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 59 // request opcode
 	b += 1
 
-	buf[b] = Ordering
+	buf[b] = byte(Ordering)
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(ClipXOrigin))
+	order.Put16(buf[b:], uint16(ClipXOrigin))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(ClipYOrigin))
+	order.Put16(buf[b:], uint16(ClipYOrigin))
 	b += 2
 
-	b += RectangleListBytes(buf[b:], Rectangles)
+	b += RectangleListBytes(buf[b:], order, Rectangles)
 
 	return buf
 }
 
+// SetClipRectanglesRequest represents the data of a SetClipRectangles request, as it is read when tracing a connection.
+type SetClipRectanglesRequest struct {
+	Ordering    ClipOrdering
+	Gc          Gcontext
+	ClipXOrigin int16
+	ClipYOrigin int16
+	Rectangles  []Rectangle // size: xgb.Pad((len(Rectangles) * 8))
+}
+
+// setClipRectanglesRequestRead reads a byte slice in the byte order 'order' into a SetClipRectanglesRequest value.
+func setClipRectanglesRequestRead(buf []byte, order xgb.ByteOrder) *SetClipRectanglesRequest {
+	v := new(SetClipRectanglesRequest)
+	b := 1 // skip request opcode
+
+	v.Ordering = ClipOrdering(buf[b])
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Gc = Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.ClipXOrigin = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.ClipYOrigin = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Rectangles = make([]Rectangle, (len(buf)-b)/8)
+	b += RectangleReadList(buf[b:], order, v.Rectangles)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[59] = xgb.RequestInfo{
+		Name: "SetClipRectangles",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setClipRectanglesRequestRead(buf, order)
+		},
+	}
+}
+
 // SetCloseDownModeCookie is a cookie used only for SetCloseDownMode requests.
 type SetCloseDownModeCookie struct {
 	*xgb.Cookie
@@ -13764,7 +22545,7 @@ type SetCloseDownModeCookie struct {
 
 // SetCloseDownMode sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func SetCloseDownMode(c *xgb.Conn, Mode byte) SetCloseDownModeCookie {
+func SetCloseDownMode(c *xgb.Conn, Mode CloseDown) SetCloseDownModeCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(setCloseDownModeRequest(c, Mode), cookie)
 	return SetCloseDownModeCookie{cookie}
@@ -13772,7 +22553,7 @@ func SetCloseDownMode(c *xgb.Conn, Mode byte) SetCloseDownModeCookie {
 
 // SetCloseDownModeChecked sends a checked request.
 // If an error occurs, it can be retrieved using SetCloseDownModeCookie.Check()
-func SetCloseDownModeChecked(c *xgb.Conn, Mode byte) SetCloseDownModeCookie {
+func SetCloseDownModeChecked(c *xgb.Conn, Mode CloseDown) SetCloseDownModeCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(setCloseDownModeRequest(c, Mode), cookie)
 	return SetCloseDownModeCookie{cookie}
@@ -13784,25 +22565,66 @@ func (cook SetCloseDownModeCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetCloseDownModeCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetCloseDownMode
 // setCloseDownModeRequest writes a SetCloseDownMode request to a byte slice.
-func setCloseDownModeRequest(c *xgb.Conn, Mode byte) []byte {
+func setCloseDownModeRequest(c *xgb.Conn, Mode CloseDown) []byte {
+	return AppendSetCloseDownModeRequest(nil, c, Mode)
+}
+
+// AppendSetCloseDownModeRequest appends a SetCloseDownMode request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetCloseDownModeRequest(buf []byte, c *xgb.Conn, Mode CloseDown) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 112 // request opcode
 	b += 1
 
-	buf[b] = Mode
+	buf[b] = byte(Mode)
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// SetCloseDownModeRequest represents the data of a SetCloseDownMode request, as it is read when tracing a connection.
+type SetCloseDownModeRequest struct {
+	Mode CloseDown
+}
+
+// setCloseDownModeRequestRead reads a byte slice in the byte order 'order' into a SetCloseDownModeRequest value.
+func setCloseDownModeRequestRead(buf []byte, order xgb.ByteOrder) *SetCloseDownModeRequest {
+	v := new(SetCloseDownModeRequest)
+	b := 1 // skip request opcode
+
+	v.Mode = CloseDown(buf[b])
+	b += 1
+
+	b += 2 // skip request size
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[112] = xgb.RequestInfo{
+		Name: "SetCloseDownMode",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setCloseDownModeRequestRead(buf, order)
+		},
+	}
+}
+
 // SetDashesCookie is a cookie used only for SetDashes requests.
 type SetDashesCookie struct {
 	*xgb.Cookie
@@ -13830,28 +22652,42 @@ func (cook SetDashesCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetDashesCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetDashes
 // setDashesRequest writes a SetDashes request to a byte slice.
 func setDashesRequest(c *xgb.Conn, Gc Gcontext, DashOffset uint16, DashesLen uint16, Dashes []byte) []byte {
+	return AppendSetDashesRequest(nil, c, Gc, DashOffset, DashesLen, Dashes)
+}
+
+// AppendSetDashesRequest appends a SetDashes request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetDashesRequest(buf []byte, c *xgb.Conn, Gc Gcontext, DashOffset uint16, DashesLen uint16, Dashes []byte) []byte {
 	size := xgb.Pad((12 + xgb.Pad((int(DashesLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 58 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	xgb.Put16(buf[b:], DashOffset)
+	order.Put16(buf[b:], DashOffset)
 	b += 2
 
-	xgb.Put16(buf[b:], DashesLen)
+	order.Put16(buf[b:], DashesLen)
 	b += 2
 
 	copy(buf[b:], Dashes[:DashesLen])
@@ -13860,6 +22696,49 @@ func setDashesRequest(c *xgb.Conn, Gc Gcontext, DashOffset uint16, DashesLen uin
 	return buf
 }
 
+// SetDashesRequest represents the data of a SetDashes request, as it is read when tracing a connection.
+type SetDashesRequest struct {
+	// padding: 1 bytes
+	Gc         Gcontext
+	DashOffset uint16
+	DashesLen  uint16
+	Dashes     []byte // size: xgb.Pad((int(DashesLen) * 1))
+}
+
+// setDashesRequestRead reads a byte slice in the byte order 'order' into a SetDashesRequest value.
+func setDashesRequestRead(buf []byte, order xgb.ByteOrder) *SetDashesRequest {
+	v := new(SetDashesRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Gc = Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.DashOffset = order.Get16(buf[b:])
+	b += 2
+
+	v.DashesLen = order.Get16(buf[b:])
+	b += 2
+
+	v.Dashes = make([]byte, v.DashesLen)
+	copy(v.Dashes[:v.DashesLen], buf[b:])
+	b += int(v.DashesLen)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[58] = xgb.RequestInfo{
+		Name: "SetDashes",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setDashesRequestRead(buf, order)
+		},
+	}
+}
+
 // SetFontPathCookie is a cookie used only for SetFontPath requests.
 type SetFontPathCookie struct {
 	*xgb.Cookie
@@ -13887,39 +22766,121 @@ func (cook SetFontPathCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetFontPathCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetFontPath
 // setFontPathRequest writes a SetFontPath request to a byte slice.
 func setFontPathRequest(c *xgb.Conn, FontQty uint16, Font []Str) []byte {
+	return AppendSetFontPathRequest(nil, c, FontQty, Font)
+}
+
+// AppendSetFontPathRequest appends a SetFontPath request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetFontPathRequest(buf []byte, c *xgb.Conn, FontQty uint16, Font []Str) []byte {
 	size := xgb.Pad((8 + StrListSize(Font)))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 51 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], FontQty)
+	order.Put16(buf[b:], FontQty)
 	b += 2
 
 	b += 2 // padding
 
-	b += StrListBytes(buf[b:], Font)
+	b += StrListBytes(buf[b:], order, Font)
 
 	return buf
 }
 
+// SetFontPathRequest represents the data of a SetFontPath request, as it is read when tracing a connection.
+type SetFontPathRequest struct {
+	// padding: 1 bytes
+	FontQty uint16
+	// padding: 2 bytes
+	Font []Str // size: StrListSize(Font)
+}
+
+// setFontPathRequestRead reads a byte slice in the byte order 'order' into a SetFontPathRequest value.
+func setFontPathRequestRead(buf []byte, order xgb.ByteOrder) *SetFontPathRequest {
+	v := new(SetFontPathRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.FontQty = order.Get16(buf[b:])
+	b += 2
+
+	b += 2 // padding
+
+	v.Font = make([]Str, v.FontQty)
+	b += StrReadList(buf[b:], order, v.Font)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[51] = xgb.RequestInfo{
+		Name: "SetFontPath",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setFontPathRequestRead(buf, order)
+		},
+	}
+}
+
 // SetInputFocusCookie is a cookie used only for SetInputFocus requests.
 type SetInputFocusCookie struct {
 	*xgb.Cookie
 }
 
+// SetInputFocus sets input focus.
+//
+// Changes the input focus and the last-focus-change time. If the specified
+// `time` is earlier than the current last-focus-change time, the request is
+// ignored (to avoid race conditions when running X over the network).
+//
+// A FocusIn and FocusOut event is generated when focus is changed.
+//
+// Parameters:
+//
+//   - Focus: The window to focus. All keyboard events will be reported to this
+//     window. The window must be viewable (TODO), or a `xcb_match_error_t`
+//     occurs (TODO). If `focus` is `WindowNone` (TODO), all keyboard events
+//     are discarded until a new focus window is set. If `focus` is
+//     `InputFocusPointerRoot` (TODO), focus is on the root window of the
+//     screen on which the pointer is on currently.
+//   - RevertTo: Specifies what happens when the `focus` window becomes
+//     unviewable (if `focus` is neither `WindowNone` nor
+//     `InputFocusPointerRoot`).
+//   - Time: Timestamp to avoid race conditions when running X over the
+//     network. The special value `TimeCurrentTime` will be replaced with the
+//     current server time.
+//
+// Errors:
+//
+//   - WindowError: The specified `focus` window does not exist.
+//   - MatchError: The specified `focus` window is not viewable.
+//   - ValueError: TODO: Reasons?
+//
+// See also FocusInEvent, FocusOutEvent.
+//
 // SetInputFocus sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func SetInputFocus(c *xgb.Conn, RevertTo byte, Focus Window, Time Timestamp) SetInputFocusCookie {
+func SetInputFocus(c *xgb.Conn, RevertTo InputFocus, Focus Window, Time Timestamp) SetInputFocusCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(setInputFocusRequest(c, RevertTo, Focus, Time), cookie)
 	return SetInputFocusCookie{cookie}
@@ -13927,7 +22888,7 @@ func SetInputFocus(c *xgb.Conn, RevertTo byte, Focus Window, Time Timestamp) Set
 
 // SetInputFocusChecked sends a checked request.
 // If an error occurs, it can be retrieved using SetInputFocusCookie.Check()
-func SetInputFocusChecked(c *xgb.Conn, RevertTo byte, Focus Window, Time Timestamp) SetInputFocusCookie {
+func SetInputFocusChecked(c *xgb.Conn, RevertTo InputFocus, Focus Window, Time Timestamp) SetInputFocusCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(setInputFocusRequest(c, RevertTo, Focus, Time), cookie)
 	return SetInputFocusCookie{cookie}
@@ -13939,31 +22900,80 @@ func (cook SetInputFocusCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetInputFocusCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetInputFocus
 // setInputFocusRequest writes a SetInputFocus request to a byte slice.
-func setInputFocusRequest(c *xgb.Conn, RevertTo byte, Focus Window, Time Timestamp) []byte {
+func setInputFocusRequest(c *xgb.Conn, RevertTo InputFocus, Focus Window, Time Timestamp) []byte {
+	return AppendSetInputFocusRequest(nil, c, RevertTo, Focus, Time)
+}
+
+// AppendSetInputFocusRequest appends a SetInputFocus request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetInputFocusRequest(buf []byte, c *xgb.Conn, RevertTo InputFocus, Focus Window, Time Timestamp) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 42 // request opcode
 	b += 1
 
-	buf[b] = RevertTo
+	buf[b] = byte(RevertTo)
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Focus))
+	order.Put32(buf[b:], uint32(Focus))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Time))
+	order.Put32(buf[b:], uint32(Time))
 	b += 4
 
 	return buf
 }
 
+// SetInputFocusRequest represents the data of a SetInputFocus request, as it is read when tracing a connection.
+type SetInputFocusRequest struct {
+	RevertTo InputFocus
+	Focus    Window
+	Time     Timestamp
+}
+
+// setInputFocusRequestRead reads a byte slice in the byte order 'order' into a SetInputFocusRequest value.
+func setInputFocusRequestRead(buf []byte, order xgb.ByteOrder) *SetInputFocusRequest {
+	v := new(SetInputFocusRequest)
+	b := 1 // skip request opcode
+
+	v.RevertTo = InputFocus(buf[b])
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Focus = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Time = Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[42] = xgb.RequestInfo{
+		Name: "SetInputFocus",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setInputFocusRequestRead(buf, order)
+		},
+	}
+}
+
 // SetModifierMappingCookie is a cookie used only for SetModifierMapping requests.
 type SetModifierMappingCookie struct {
 	*xgb.Cookie
@@ -13989,7 +22999,7 @@ func SetModifierMappingUnchecked(c *xgb.Conn, KeycodesPerModifier byte, Keycodes
 type SetModifierMappingReply struct {
 	Sequence uint16 // sequence number of the request for this reply
 	Length   uint32 // number of bytes in this reply
-	Status   byte
+	Status   MappingStatus
 }
 
 // Reply blocks and returns the reply data for a SetModifierMapping request.
@@ -14001,21 +23011,33 @@ func (cook SetModifierMappingCookie) Reply() (*SetModifierMappingReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return setModifierMappingReply(buf), nil
+	return setModifierMappingReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a SetModifierMapping request arrives.
+func (cook SetModifierMappingCookie) ReplyContext(ctx context.Context) (*SetModifierMappingReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return setModifierMappingReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// setModifierMappingReply reads a byte slice into a SetModifierMappingReply value.
-func setModifierMappingReply(buf []byte) *SetModifierMappingReply {
+// setModifierMappingReply reads a byte slice in the byte order 'order' into a SetModifierMappingReply value.
+func setModifierMappingReply(buf []byte, order xgb.ByteOrder) *SetModifierMappingReply {
 	v := new(SetModifierMappingReply)
 	b := 1 // skip reply determinant
 
-	v.Status = buf[b]
+	v.Status = MappingStatus(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	return v
@@ -14024,9 +23046,18 @@ func setModifierMappingReply(buf []byte) *SetModifierMappingReply {
 // Write request to wire for SetModifierMapping
 // setModifierMappingRequest writes a SetModifierMapping request to a byte slice.
 func setModifierMappingRequest(c *xgb.Conn, KeycodesPerModifier byte, Keycodes []Keycode) []byte {
+	return AppendSetModifierMappingRequest(nil, c, KeycodesPerModifier, Keycodes)
+}
+
+// AppendSetModifierMappingRequest appends a SetModifierMapping request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetModifierMappingRequest(buf []byte, c *xgb.Conn, KeycodesPerModifier byte, Keycodes []Keycode) []byte {
 	size := xgb.Pad((4 + xgb.Pad(((int(KeycodesPerModifier) * 8) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 118 // request opcode
 	b += 1
@@ -14034,7 +23065,7 @@ func setModifierMappingRequest(c *xgb.Conn, KeycodesPerModifier byte, Keycodes [
 	buf[b] = KeycodesPerModifier
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	for i := 0; i < int((int(KeycodesPerModifier) * 8)); i++ {
@@ -14045,6 +23076,43 @@ func setModifierMappingRequest(c *xgb.Conn, KeycodesPerModifier byte, Keycodes [
 	return buf
 }
 
+// SetModifierMappingRequest represents the data of a SetModifierMapping request, as it is read when tracing a connection.
+type SetModifierMappingRequest struct {
+	KeycodesPerModifier byte
+	Keycodes            []Keycode // size: xgb.Pad(((int(KeycodesPerModifier) * 8) * 1))
+}
+
+// setModifierMappingRequestRead reads a byte slice in the byte order 'order' into a SetModifierMappingRequest value.
+func setModifierMappingRequestRead(buf []byte, order xgb.ByteOrder) *SetModifierMappingRequest {
+	v := new(SetModifierMappingRequest)
+	b := 1 // skip request opcode
+
+	v.KeycodesPerModifier = buf[b]
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Keycodes = make([]Keycode, (int(v.KeycodesPerModifier) * 8))
+	for i := 0; i < int((int(v.KeycodesPerModifier) * 8)); i++ {
+		v.Keycodes[i] = Keycode(buf[b])
+		b += 1
+	}
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[118] = xgb.RequestInfo{
+		Name: "SetModifierMapping",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setModifierMappingRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setModifierMappingReply(buf, order)
+		},
+	}
+}
+
 // SetPointerMappingCookie is a cookie used only for SetPointerMapping requests.
 type SetPointerMappingCookie struct {
 	*xgb.Cookie
@@ -14070,7 +23138,7 @@ func SetPointerMappingUnchecked(c *xgb.Conn, MapLen byte, Map []byte) SetPointer
 type SetPointerMappingReply struct {
 	Sequence uint16 // sequence number of the request for this reply
 	Length   uint32 // number of bytes in this reply
-	Status   byte
+	Status   MappingStatus
 }
 
 // Reply blocks and returns the reply data for a SetPointerMapping request.
@@ -14082,21 +23150,33 @@ func (cook SetPointerMappingCookie) Reply() (*SetPointerMappingReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return setPointerMappingReply(buf), nil
+	return setPointerMappingReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a SetPointerMapping request arrives.
+func (cook SetPointerMappingCookie) ReplyContext(ctx context.Context) (*SetPointerMappingReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return setPointerMappingReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// setPointerMappingReply reads a byte slice into a SetPointerMappingReply value.
-func setPointerMappingReply(buf []byte) *SetPointerMappingReply {
+// setPointerMappingReply reads a byte slice in the byte order 'order' into a SetPointerMappingReply value.
+func setPointerMappingReply(buf []byte, order xgb.ByteOrder) *SetPointerMappingReply {
 	v := new(SetPointerMappingReply)
 	b := 1 // skip reply determinant
 
-	v.Status = buf[b]
+	v.Status = MappingStatus(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	return v
@@ -14105,9 +23185,18 @@ func setPointerMappingReply(buf []byte) *SetPointerMappingReply {
 // Write request to wire for SetPointerMapping
 // setPointerMappingRequest writes a SetPointerMapping request to a byte slice.
 func setPointerMappingRequest(c *xgb.Conn, MapLen byte, Map []byte) []byte {
+	return AppendSetPointerMappingRequest(nil, c, MapLen, Map)
+}
+
+// AppendSetPointerMappingRequest appends a SetPointerMapping request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetPointerMappingRequest(buf []byte, c *xgb.Conn, MapLen byte, Map []byte) []byte {
 	size := xgb.Pad((4 + xgb.Pad((int(MapLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 116 // request opcode
 	b += 1
@@ -14115,7 +23204,7 @@ func setPointerMappingRequest(c *xgb.Conn, MapLen byte, Map []byte) []byte {
 	buf[b] = MapLen
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	copy(buf[b:], Map[:MapLen])
@@ -14124,6 +23213,41 @@ func setPointerMappingRequest(c *xgb.Conn, MapLen byte, Map []byte) []byte {
 	return buf
 }
 
+// SetPointerMappingRequest represents the data of a SetPointerMapping request, as it is read when tracing a connection.
+type SetPointerMappingRequest struct {
+	MapLen byte
+	Map    []byte // size: xgb.Pad((int(MapLen) * 1))
+}
+
+// setPointerMappingRequestRead reads a byte slice in the byte order 'order' into a SetPointerMappingRequest value.
+func setPointerMappingRequestRead(buf []byte, order xgb.ByteOrder) *SetPointerMappingRequest {
+	v := new(SetPointerMappingRequest)
+	b := 1 // skip request opcode
+
+	v.MapLen = buf[b]
+	b += 1
+
+	b += 2 // skip request size
+
+	v.Map = make([]byte, v.MapLen)
+	copy(v.Map[:v.MapLen], buf[b:])
+	b += int(v.MapLen)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[116] = xgb.RequestInfo{
+		Name: "SetPointerMapping",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setPointerMappingRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setPointerMappingReply(buf, order)
+		},
+	}
+}
+
 // SetScreenSaverCookie is a cookie used only for SetScreenSaver requests.
 type SetScreenSaverCookie struct {
 	*xgb.Cookie
@@ -14131,7 +23255,7 @@ type SetScreenSaverCookie struct {
 
 // SetScreenSaver sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func SetScreenSaver(c *xgb.Conn, Timeout int16, Interval int16, PreferBlanking byte, AllowExposures byte) SetScreenSaverCookie {
+func SetScreenSaver(c *xgb.Conn, Timeout int16, Interval int16, PreferBlanking Blanking, AllowExposures Exposures) SetScreenSaverCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(setScreenSaverRequest(c, Timeout, Interval, PreferBlanking, AllowExposures), cookie)
 	return SetScreenSaverCookie{cookie}
@@ -14139,7 +23263,7 @@ func SetScreenSaver(c *xgb.Conn, Timeout int16, Interval int16, PreferBlanking b
 
 // SetScreenSaverChecked sends a checked request.
 // If an error occurs, it can be retrieved using SetScreenSaverCookie.Check()
-func SetScreenSaverChecked(c *xgb.Conn, Timeout int16, Interval int16, PreferBlanking byte, AllowExposures byte) SetScreenSaverCookie {
+func SetScreenSaverChecked(c *xgb.Conn, Timeout int16, Interval int16, PreferBlanking Blanking, AllowExposures Exposures) SetScreenSaverCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(setScreenSaverRequest(c, Timeout, Interval, PreferBlanking, AllowExposures), cookie)
 	return SetScreenSaverCookie{cookie}
@@ -14151,41 +23275,122 @@ func (cook SetScreenSaverCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetScreenSaverCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetScreenSaver
 // setScreenSaverRequest writes a SetScreenSaver request to a byte slice.
-func setScreenSaverRequest(c *xgb.Conn, Timeout int16, Interval int16, PreferBlanking byte, AllowExposures byte) []byte {
+func setScreenSaverRequest(c *xgb.Conn, Timeout int16, Interval int16, PreferBlanking Blanking, AllowExposures Exposures) []byte {
+	return AppendSetScreenSaverRequest(nil, c, Timeout, Interval, PreferBlanking, AllowExposures)
+}
+
+// AppendSetScreenSaverRequest appends a SetScreenSaver request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetScreenSaverRequest(buf []byte, c *xgb.Conn, Timeout int16, Interval int16, PreferBlanking Blanking, AllowExposures Exposures) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 107 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(Timeout))
+	order.Put16(buf[b:], uint16(Timeout))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(Interval))
+	order.Put16(buf[b:], uint16(Interval))
 	b += 2
 
-	buf[b] = PreferBlanking
+	buf[b] = byte(PreferBlanking)
 	b += 1
 
-	buf[b] = AllowExposures
+	buf[b] = byte(AllowExposures)
 	b += 1
 
 	return buf
 }
 
+// SetScreenSaverRequest represents the data of a SetScreenSaver request, as it is read when tracing a connection.
+type SetScreenSaverRequest struct {
+	// padding: 1 bytes
+	Timeout        int16
+	Interval       int16
+	PreferBlanking Blanking
+	AllowExposures Exposures
+}
+
+// setScreenSaverRequestRead reads a byte slice in the byte order 'order' into a SetScreenSaverRequest value.
+func setScreenSaverRequestRead(buf []byte, order xgb.ByteOrder) *SetScreenSaverRequest {
+	v := new(SetScreenSaverRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Timeout = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.Interval = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.PreferBlanking = Blanking(buf[b])
+	b += 1
+
+	v.AllowExposures = Exposures(buf[b])
+	b += 1
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[107] = xgb.RequestInfo{
+		Name: "SetScreenSaver",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setScreenSaverRequestRead(buf, order)
+		},
+	}
+}
+
 // SetSelectionOwnerCookie is a cookie used only for SetSelectionOwner requests.
 type SetSelectionOwnerCookie struct {
 	*xgb.Cookie
 }
 
+// SetSelectionOwner sets the owner of a selection.
+//
+// Makes `window` the owner of the selection `selection` and updates the
+// last-change time of the specified selection.
+//
+// TODO: briefly explain what a selection is.
+//
+// Parameters:
+//
+//   - Selection: The selection.
+//   - Owner: The new owner of the selection. The special value `WindowNone`
+//     means that the selection will have no owner.
+//   - Time: Timestamp to avoid race conditions when running X over the
+//     network. The selection will not be changed if `time` is earlier than the
+//     current last-change time of the `selection` or is later than the current
+//     X server time. Otherwise, the last-change time is set to the specified
+//     time. The special value `TimeCurrentTime` will be replaced with the
+//     current server time.
+//
+// Errors:
+//
+//   - AtomError: `selection` does not refer to a valid atom.
+//
+// See also GetSelectionOwner.
+//
 // SetSelectionOwner sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
 func SetSelectionOwner(c *xgb.Conn, Owner Window, Selection Atom, Time Timestamp) SetSelectionOwnerCookie {
@@ -14208,33 +23413,85 @@ func (cook SetSelectionOwnerCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetSelectionOwnerCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetSelectionOwner
 // setSelectionOwnerRequest writes a SetSelectionOwner request to a byte slice.
 func setSelectionOwnerRequest(c *xgb.Conn, Owner Window, Selection Atom, Time Timestamp) []byte {
+	return AppendSetSelectionOwnerRequest(nil, c, Owner, Selection, Time)
+}
+
+// AppendSetSelectionOwnerRequest appends a SetSelectionOwner request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetSelectionOwnerRequest(buf []byte, c *xgb.Conn, Owner Window, Selection Atom, Time Timestamp) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 22 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Owner))
+	order.Put32(buf[b:], uint32(Owner))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Selection))
+	order.Put32(buf[b:], uint32(Selection))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Time))
+	order.Put32(buf[b:], uint32(Time))
 	b += 4
 
 	return buf
 }
 
+// SetSelectionOwnerRequest represents the data of a SetSelectionOwner request, as it is read when tracing a connection.
+type SetSelectionOwnerRequest struct {
+	// padding: 1 bytes
+	Owner     Window
+	Selection Atom
+	Time      Timestamp
+}
+
+// setSelectionOwnerRequestRead reads a byte slice in the byte order 'order' into a SetSelectionOwnerRequest value.
+func setSelectionOwnerRequestRead(buf []byte, order xgb.ByteOrder) *SetSelectionOwnerRequest {
+	v := new(SetSelectionOwnerRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Owner = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Selection = Atom(order.Get32(buf[b:]))
+	b += 4
+
+	v.Time = Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[22] = xgb.RequestInfo{
+		Name: "SetSelectionOwner",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setSelectionOwnerRequestRead(buf, order)
+		},
+	}
+}
+
 // StoreColorsCookie is a cookie used only for StoreColors requests.
 type StoreColorsCookie struct {
 	*xgb.Cookie
@@ -14262,29 +23519,77 @@ func (cook StoreColorsCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook StoreColorsCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for StoreColors
 // storeColorsRequest writes a StoreColors request to a byte slice.
 func storeColorsRequest(c *xgb.Conn, Cmap Colormap, Items []Coloritem) []byte {
+	return AppendStoreColorsRequest(nil, c, Cmap, Items)
+}
+
+// AppendStoreColorsRequest appends a StoreColors request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendStoreColorsRequest(buf []byte, c *xgb.Conn, Cmap Colormap, Items []Coloritem) []byte {
 	size := xgb.Pad((8 + xgb.Pad((len(Items) * 12))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 89 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Cmap))
+	order.Put32(buf[b:], uint32(Cmap))
 	b += 4
 
-	b += ColoritemListBytes(buf[b:], Items)
+	b += ColoritemListBytes(buf[b:], order, Items)
 
 	return buf
 }
 
+// StoreColorsRequest represents the data of a StoreColors request, as it is read when tracing a connection.
+type StoreColorsRequest struct {
+	// padding: 1 bytes
+	Cmap  Colormap
+	Items []Coloritem // size: xgb.Pad((len(Items) * 12))
+}
+
+// storeColorsRequestRead reads a byte slice in the byte order 'order' into a StoreColorsRequest value.
+func storeColorsRequestRead(buf []byte, order xgb.ByteOrder) *StoreColorsRequest {
+	v := new(StoreColorsRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Cmap = Colormap(order.Get32(buf[b:]))
+	b += 4
+
+	v.Items = make([]Coloritem, (len(buf)-b)/12)
+	b += ColoritemReadList(buf[b:], order, v.Items)
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[89] = xgb.RequestInfo{
+		Name: "StoreColors",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return storeColorsRequestRead(buf, order)
+		},
+	}
+}
+
 // StoreNamedColorCookie is a cookie used only for StoreNamedColor requests.
 type StoreNamedColorCookie struct {
 	*xgb.Cookie
@@ -14292,7 +23597,7 @@ type StoreNamedColorCookie struct {
 
 // StoreNamedColor sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func StoreNamedColor(c *xgb.Conn, Flags byte, Cmap Colormap, Pixel uint32, NameLen uint16, Name string) StoreNamedColorCookie {
+func StoreNamedColor(c *xgb.Conn, Flags ColorFlag, Cmap Colormap, Pixel uint32, NameLen uint16, Name string) StoreNamedColorCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(storeNamedColorRequest(c, Flags, Cmap, Pixel, NameLen, Name), cookie)
 	return StoreNamedColorCookie{cookie}
@@ -14300,7 +23605,7 @@ func StoreNamedColor(c *xgb.Conn, Flags byte, Cmap Colormap, Pixel uint32, NameL
 
 // StoreNamedColorChecked sends a checked request.
 // If an error occurs, it can be retrieved using StoreNamedColorCookie.Check()
-func StoreNamedColorChecked(c *xgb.Conn, Flags byte, Cmap Colormap, Pixel uint32, NameLen uint16, Name string) StoreNamedColorCookie {
+func StoreNamedColorChecked(c *xgb.Conn, Flags ColorFlag, Cmap Colormap, Pixel uint32, NameLen uint16, Name string) StoreNamedColorCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(storeNamedColorRequest(c, Flags, Cmap, Pixel, NameLen, Name), cookie)
 	return StoreNamedColorCookie{cookie}
@@ -14312,37 +23617,101 @@ func (cook StoreNamedColorCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook StoreNamedColorCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for StoreNamedColor
 // storeNamedColorRequest writes a StoreNamedColor request to a byte slice.
-func storeNamedColorRequest(c *xgb.Conn, Flags byte, Cmap Colormap, Pixel uint32, NameLen uint16, Name string) []byte {
+func storeNamedColorRequest(c *xgb.Conn, Flags ColorFlag, Cmap Colormap, Pixel uint32, NameLen uint16, Name string) []byte {
+	return AppendStoreNamedColorRequest(nil, c, Flags, Cmap, Pixel, NameLen, Name)
+}
+
+// AppendStoreNamedColorRequest appends a StoreNamedColor request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendStoreNamedColorRequest(buf []byte, c *xgb.Conn, Flags ColorFlag, Cmap Colormap, Pixel uint32, NameLen uint16, Name string) []byte {
 	size := xgb.Pad((16 + xgb.Pad((int(NameLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 90 // request opcode
 	b += 1
 
-	buf[b] = Flags
+	buf[b] = byte(Flags)
+	b += 1
+
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 2
+
+	order.Put32(buf[b:], uint32(Cmap))
+	b += 4
+
+	order.Put32(buf[b:], Pixel)
+	b += 4
+
+	order.Put16(buf[b:], NameLen)
+	b += 2
+
+	b += 2 // padding
+
+	copy(buf[b:], Name[:NameLen])
+	b += int(NameLen)
+
+	return buf
+}
+
+// StoreNamedColorRequest represents the data of a StoreNamedColor request, as it is read when tracing a connection.
+type StoreNamedColorRequest struct {
+	Flags   ColorFlag
+	Cmap    Colormap
+	Pixel   uint32
+	NameLen uint16
+	// padding: 2 bytes
+	Name string // size: xgb.Pad((int(NameLen) * 1))
+}
+
+// storeNamedColorRequestRead reads a byte slice in the byte order 'order' into a StoreNamedColorRequest value.
+func storeNamedColorRequestRead(buf []byte, order xgb.ByteOrder) *StoreNamedColorRequest {
+	v := new(StoreNamedColorRequest)
+	b := 1 // skip request opcode
+
+	v.Flags = ColorFlag(buf[b])
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
-	b += 2
+	b += 2 // skip request size
 
-	xgb.Put32(buf[b:], uint32(Cmap))
+	v.Cmap = Colormap(order.Get32(buf[b:]))
 	b += 4
 
-	xgb.Put32(buf[b:], Pixel)
+	v.Pixel = order.Get32(buf[b:])
 	b += 4
 
-	xgb.Put16(buf[b:], NameLen)
+	v.NameLen = order.Get16(buf[b:])
 	b += 2
 
 	b += 2 // padding
 
-	copy(buf[b:], Name[:NameLen])
-	b += int(NameLen)
+	{
+		byteString := make([]byte, v.NameLen)
+		copy(byteString[:v.NameLen], buf[b:])
+		v.Name = string(byteString)
+		b += int(v.NameLen)
+	}
 
-	return buf
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[90] = xgb.RequestInfo{
+		Name: "StoreNamedColor",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return storeNamedColorRequestRead(buf, order)
+		},
+	}
 }
 
 // TranslateCoordinatesCookie is a cookie used only for TranslateCoordinates requests.
@@ -14385,11 +23754,23 @@ func (cook TranslateCoordinatesCookie) Reply() (*TranslateCoordinatesReply, erro
 	if buf == nil {
 		return nil, nil
 	}
-	return translateCoordinatesReply(buf), nil
+	return translateCoordinatesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a TranslateCoordinates request arrives.
+func (cook TranslateCoordinatesCookie) ReplyContext(ctx context.Context) (*TranslateCoordinatesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return translateCoordinatesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// translateCoordinatesReply reads a byte slice into a TranslateCoordinatesReply value.
-func translateCoordinatesReply(buf []byte) *TranslateCoordinatesReply {
+// translateCoordinatesReply reads a byte slice in the byte order 'order' into a TranslateCoordinatesReply value.
+func translateCoordinatesReply(buf []byte, order xgb.ByteOrder) *TranslateCoordinatesReply {
 	v := new(TranslateCoordinatesReply)
 	b := 1 // skip reply determinant
 
@@ -14400,19 +23781,19 @@ func translateCoordinatesReply(buf []byte) *TranslateCoordinatesReply {
 	}
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Child = Window(xgb.Get32(buf[b:]))
+	v.Child = Window(order.Get32(buf[b:]))
 	b += 4
 
-	v.DstX = int16(xgb.Get16(buf[b:]))
+	v.DstX = int16(order.Get16(buf[b:]))
 	b += 2
 
-	v.DstY = int16(xgb.Get16(buf[b:]))
+	v.DstY = int16(order.Get16(buf[b:]))
 	b += 2
 
 	return v
@@ -14421,33 +23802,87 @@ func translateCoordinatesReply(buf []byte) *TranslateCoordinatesReply {
 // Write request to wire for TranslateCoordinates
 // translateCoordinatesRequest writes a TranslateCoordinates request to a byte slice.
 func translateCoordinatesRequest(c *xgb.Conn, SrcWindow Window, DstWindow Window, SrcX int16, SrcY int16) []byte {
+	return AppendTranslateCoordinatesRequest(nil, c, SrcWindow, DstWindow, SrcX, SrcY)
+}
+
+// AppendTranslateCoordinatesRequest appends a TranslateCoordinates request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendTranslateCoordinatesRequest(buf []byte, c *xgb.Conn, SrcWindow Window, DstWindow Window, SrcX int16, SrcY int16) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 40 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(SrcWindow))
+	order.Put32(buf[b:], uint32(SrcWindow))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(DstWindow))
+	order.Put32(buf[b:], uint32(DstWindow))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(SrcX))
+	order.Put16(buf[b:], uint16(SrcX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(SrcY))
+	order.Put16(buf[b:], uint16(SrcY))
 	b += 2
 
 	return buf
 }
 
+// TranslateCoordinatesRequest represents the data of a TranslateCoordinates request, as it is read when tracing a connection.
+type TranslateCoordinatesRequest struct {
+	// padding: 1 bytes
+	SrcWindow Window
+	DstWindow Window
+	SrcX      int16
+	SrcY      int16
+}
+
+// translateCoordinatesRequestRead reads a byte slice in the byte order 'order' into a TranslateCoordinatesRequest value.
+func translateCoordinatesRequestRead(buf []byte, order xgb.ByteOrder) *TranslateCoordinatesRequest {
+	v := new(TranslateCoordinatesRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.SrcWindow = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.DstWindow = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.SrcX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.SrcY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[40] = xgb.RequestInfo{
+		Name: "TranslateCoordinates",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return translateCoordinatesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return translateCoordinatesReply(buf, order)
+		},
+	}
+}
+
 // UngrabButtonCookie is a cookie used only for UngrabButton requests.
 type UngrabButtonCookie struct {
 	*xgb.Cookie
@@ -14455,7 +23890,7 @@ type UngrabButtonCookie struct {
 
 // UngrabButton sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func UngrabButton(c *xgb.Conn, Button byte, GrabWindow Window, Modifiers uint16) UngrabButtonCookie {
+func UngrabButton(c *xgb.Conn, Button ButtonIndex, GrabWindow Window, Modifiers ModMask) UngrabButtonCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(ungrabButtonRequest(c, Button, GrabWindow, Modifiers), cookie)
 	return UngrabButtonCookie{cookie}
@@ -14463,7 +23898,7 @@ func UngrabButton(c *xgb.Conn, Button byte, GrabWindow Window, Modifiers uint16)
 
 // UngrabButtonChecked sends a checked request.
 // If an error occurs, it can be retrieved using UngrabButtonCookie.Check()
-func UngrabButtonChecked(c *xgb.Conn, Button byte, GrabWindow Window, Modifiers uint16) UngrabButtonCookie {
+func UngrabButtonChecked(c *xgb.Conn, Button ButtonIndex, GrabWindow Window, Modifiers ModMask) UngrabButtonCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(ungrabButtonRequest(c, Button, GrabWindow, Modifiers), cookie)
 	return UngrabButtonCookie{cookie}
@@ -14475,26 +23910,40 @@ func (cook UngrabButtonCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook UngrabButtonCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for UngrabButton
 // ungrabButtonRequest writes a UngrabButton request to a byte slice.
-func ungrabButtonRequest(c *xgb.Conn, Button byte, GrabWindow Window, Modifiers uint16) []byte {
+func ungrabButtonRequest(c *xgb.Conn, Button ButtonIndex, GrabWindow Window, Modifiers ModMask) []byte {
+	return AppendUngrabButtonRequest(nil, c, Button, GrabWindow, Modifiers)
+}
+
+// AppendUngrabButtonRequest appends a UngrabButton request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendUngrabButtonRequest(buf []byte, c *xgb.Conn, Button ButtonIndex, GrabWindow Window, Modifiers ModMask) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 29 // request opcode
 	b += 1
 
-	buf[b] = Button
+	buf[b] = byte(Button)
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(GrabWindow))
+	order.Put32(buf[b:], uint32(GrabWindow))
 	b += 4
 
-	xgb.Put16(buf[b:], Modifiers)
+	order.Put16(buf[b:], uint16(Modifiers))
 	b += 2
 
 	b += 2 // padding
@@ -14502,14 +23951,74 @@ func ungrabButtonRequest(c *xgb.Conn, Button byte, GrabWindow Window, Modifiers
 	return buf
 }
 
+// UngrabButtonRequest represents the data of a UngrabButton request, as it is read when tracing a connection.
+type UngrabButtonRequest struct {
+	Button     ButtonIndex
+	GrabWindow Window
+	Modifiers  ModMask
+	// padding: 2 bytes
+}
+
+// ungrabButtonRequestRead reads a byte slice in the byte order 'order' into a UngrabButtonRequest value.
+func ungrabButtonRequestRead(buf []byte, order xgb.ByteOrder) *UngrabButtonRequest {
+	v := new(UngrabButtonRequest)
+	b := 1 // skip request opcode
+
+	v.Button = ButtonIndex(buf[b])
+	b += 1
+
+	b += 2 // skip request size
+
+	v.GrabWindow = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Modifiers = ModMask(order.Get16(buf[b:]))
+	b += 2
+
+	b += 2 // padding
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[29] = xgb.RequestInfo{
+		Name: "UngrabButton",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return ungrabButtonRequestRead(buf, order)
+		},
+	}
+}
+
 // UngrabKeyCookie is a cookie used only for UngrabKey requests.
 type UngrabKeyCookie struct {
 	*xgb.Cookie
 }
 
+// UngrabKey release a key combination.
+//
+// Releases the key combination on `grab_window` if you grabbed it using
+// `GrabKey` before.
+//
+// Parameters:
+//
+//   - Key: The keycode of the specified key combination. Using the special
+//     value `GrabAny` means releasing all possible key codes.
+//   - GrabWindow: The window on which the grabbed key combination will be
+//     released.
+//   - Modifiers: The modifiers of the specified key combination. Using the
+//     special value `ModMaskAny` means releasing the key combination with
+//     every possible modifier combination.
+//
+// Errors:
+//
+//   - WindowError: The specified `grab_window` does not exist.
+//   - ValueError: TODO: reasons?
+//
+// See also GrabKey, xev(1).
+//
 // UngrabKey sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func UngrabKey(c *xgb.Conn, Key Keycode, GrabWindow Window, Modifiers uint16) UngrabKeyCookie {
+func UngrabKey(c *xgb.Conn, Key Keycode, GrabWindow Window, Modifiers ModMask) UngrabKeyCookie {
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(ungrabKeyRequest(c, Key, GrabWindow, Modifiers), cookie)
 	return UngrabKeyCookie{cookie}
@@ -14517,7 +24026,7 @@ func UngrabKey(c *xgb.Conn, Key Keycode, GrabWindow Window, Modifiers uint16) Un
 
 // UngrabKeyChecked sends a checked request.
 // If an error occurs, it can be retrieved using UngrabKeyCookie.Check()
-func UngrabKeyChecked(c *xgb.Conn, Key Keycode, GrabWindow Window, Modifiers uint16) UngrabKeyCookie {
+func UngrabKeyChecked(c *xgb.Conn, Key Keycode, GrabWindow Window, Modifiers ModMask) UngrabKeyCookie {
 	cookie := c.NewCookie(true, false)
 	c.NewRequest(ungrabKeyRequest(c, Key, GrabWindow, Modifiers), cookie)
 	return UngrabKeyCookie{cookie}
@@ -14529,12 +24038,26 @@ func (cook UngrabKeyCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook UngrabKeyCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for UngrabKey
 // ungrabKeyRequest writes a UngrabKey request to a byte slice.
-func ungrabKeyRequest(c *xgb.Conn, Key Keycode, GrabWindow Window, Modifiers uint16) []byte {
+func ungrabKeyRequest(c *xgb.Conn, Key Keycode, GrabWindow Window, Modifiers ModMask) []byte {
+	return AppendUngrabKeyRequest(nil, c, Key, GrabWindow, Modifiers)
+}
+
+// AppendUngrabKeyRequest appends a UngrabKey request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendUngrabKeyRequest(buf []byte, c *xgb.Conn, Key Keycode, GrabWindow Window, Modifiers ModMask) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 34 // request opcode
 	b += 1
@@ -14542,13 +24065,13 @@ func ungrabKeyRequest(c *xgb.Conn, Key Keycode, GrabWindow Window, Modifiers uin
 	buf[b] = byte(Key)
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(GrabWindow))
+	order.Put32(buf[b:], uint32(GrabWindow))
 	b += 4
 
-	xgb.Put16(buf[b:], Modifiers)
+	order.Put16(buf[b:], uint16(Modifiers))
 	b += 2
 
 	b += 2 // padding
@@ -14556,6 +24079,44 @@ func ungrabKeyRequest(c *xgb.Conn, Key Keycode, GrabWindow Window, Modifiers uin
 	return buf
 }
 
+// UngrabKeyRequest represents the data of a UngrabKey request, as it is read when tracing a connection.
+type UngrabKeyRequest struct {
+	Key        Keycode
+	GrabWindow Window
+	Modifiers  ModMask
+	// padding: 2 bytes
+}
+
+// ungrabKeyRequestRead reads a byte slice in the byte order 'order' into a UngrabKeyRequest value.
+func ungrabKeyRequestRead(buf []byte, order xgb.ByteOrder) *UngrabKeyRequest {
+	v := new(UngrabKeyRequest)
+	b := 1 // skip request opcode
+
+	v.Key = Keycode(buf[b])
+	b += 1
+
+	b += 2 // skip request size
+
+	v.GrabWindow = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Modifiers = ModMask(order.Get16(buf[b:]))
+	b += 2
+
+	b += 2 // padding
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[34] = xgb.RequestInfo{
+		Name: "UngrabKey",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return ungrabKeyRequestRead(buf, order)
+		},
+	}
+}
+
 // UngrabKeyboardCookie is a cookie used only for UngrabKeyboard requests.
 type UngrabKeyboardCookie struct {
 	*xgb.Cookie
@@ -14583,32 +24144,92 @@ func (cook UngrabKeyboardCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook UngrabKeyboardCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for UngrabKeyboard
 // ungrabKeyboardRequest writes a UngrabKeyboard request to a byte slice.
 func ungrabKeyboardRequest(c *xgb.Conn, Time Timestamp) []byte {
+	return AppendUngrabKeyboardRequest(nil, c, Time)
+}
+
+// AppendUngrabKeyboardRequest appends a UngrabKeyboard request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendUngrabKeyboardRequest(buf []byte, c *xgb.Conn, Time Timestamp) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 32 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Time))
+	order.Put32(buf[b:], uint32(Time))
 	b += 4
 
 	return buf
 }
 
+// UngrabKeyboardRequest represents the data of a UngrabKeyboard request, as it is read when tracing a connection.
+type UngrabKeyboardRequest struct {
+	// padding: 1 bytes
+	Time Timestamp
+}
+
+// ungrabKeyboardRequestRead reads a byte slice in the byte order 'order' into a UngrabKeyboardRequest value.
+func ungrabKeyboardRequestRead(buf []byte, order xgb.ByteOrder) *UngrabKeyboardRequest {
+	v := new(UngrabKeyboardRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Time = Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[32] = xgb.RequestInfo{
+		Name: "UngrabKeyboard",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return ungrabKeyboardRequestRead(buf, order)
+		},
+	}
+}
+
 // UngrabPointerCookie is a cookie used only for UngrabPointer requests.
 type UngrabPointerCookie struct {
 	*xgb.Cookie
 }
 
+// UngrabPointer release the pointer.
+//
+// Releases the pointer and any queued events if you actively grabbed the
+// pointer before using `GrabPointer`, `GrabButton` or within a normal button
+// press.
+//
+// EnterNotify and LeaveNotify events are generated.
+//
+// Parameters:
+//
+//   - Time: Timestamp to avoid race conditions when running X over the
+//     network. The pointer will not be released if `time` is earlier than the
+//     last-pointer-grab time or later than the current X server time.
+//
+// See also GrabPointer, GrabButton, EnterNotifyEvent, LeaveNotifyEvent.
+//
 // UngrabPointer sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
 func UngrabPointer(c *xgb.Conn, Time Timestamp) UngrabPointerCookie {
@@ -14631,27 +24252,71 @@ func (cook UngrabPointerCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook UngrabPointerCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for UngrabPointer
 // ungrabPointerRequest writes a UngrabPointer request to a byte slice.
 func ungrabPointerRequest(c *xgb.Conn, Time Timestamp) []byte {
+	return AppendUngrabPointerRequest(nil, c, Time)
+}
+
+// AppendUngrabPointerRequest appends a UngrabPointer request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendUngrabPointerRequest(buf []byte, c *xgb.Conn, Time Timestamp) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 27 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Time))
+	order.Put32(buf[b:], uint32(Time))
 	b += 4
 
 	return buf
 }
 
+// UngrabPointerRequest represents the data of a UngrabPointer request, as it is read when tracing a connection.
+type UngrabPointerRequest struct {
+	// padding: 1 bytes
+	Time Timestamp
+}
+
+// ungrabPointerRequestRead reads a byte slice in the byte order 'order' into a UngrabPointerRequest value.
+func ungrabPointerRequestRead(buf []byte, order xgb.ByteOrder) *UngrabPointerRequest {
+	v := new(UngrabPointerRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Time = Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[27] = xgb.RequestInfo{
+		Name: "UngrabPointer",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return ungrabPointerRequestRead(buf, order)
+		},
+	}
+}
+
 // UngrabServerCookie is a cookie used only for UngrabServer requests.
 type UngrabServerCookie struct {
 	*xgb.Cookie
@@ -14679,23 +24344,56 @@ func (cook UngrabServerCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook UngrabServerCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for UngrabServer
 // ungrabServerRequest writes a UngrabServer request to a byte slice.
 func ungrabServerRequest(c *xgb.Conn) []byte {
+	return AppendUngrabServerRequest(nil, c)
+}
+
+// AppendUngrabServerRequest appends a UngrabServer request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendUngrabServerRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 37 // request opcode
 	b += 1
 
-	b += 1                             // padding
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	b += 1                               // padding
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// UngrabServerRequest represents the data of a UngrabServer request, as it is read when tracing a connection.
+type UngrabServerRequest struct {
+}
+
+// ungrabServerRequestRead reads a byte slice in the byte order 'order' into a UngrabServerRequest value.
+func ungrabServerRequestRead(buf []byte, order xgb.ByteOrder) *UngrabServerRequest {
+	v := new(UngrabServerRequest)
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[37] = xgb.RequestInfo{
+		Name: "UngrabServer",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return ungrabServerRequestRead(buf, order)
+		},
+	}
+}
+
 // UninstallColormapCookie is a cookie used only for UninstallColormap requests.
 type UninstallColormapCookie struct {
 	*xgb.Cookie
@@ -14723,27 +24421,71 @@ func (cook UninstallColormapCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook UninstallColormapCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for UninstallColormap
 // uninstallColormapRequest writes a UninstallColormap request to a byte slice.
 func uninstallColormapRequest(c *xgb.Conn, Cmap Colormap) []byte {
+	return AppendUninstallColormapRequest(nil, c, Cmap)
+}
+
+// AppendUninstallColormapRequest appends a UninstallColormap request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendUninstallColormapRequest(buf []byte, c *xgb.Conn, Cmap Colormap) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 82 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Cmap))
+	order.Put32(buf[b:], uint32(Cmap))
 	b += 4
 
 	return buf
 }
 
+// UninstallColormapRequest represents the data of a UninstallColormap request, as it is read when tracing a connection.
+type UninstallColormapRequest struct {
+	// padding: 1 bytes
+	Cmap Colormap
+}
+
+// uninstallColormapRequestRead reads a byte slice in the byte order 'order' into a UninstallColormapRequest value.
+func uninstallColormapRequestRead(buf []byte, order xgb.ByteOrder) *UninstallColormapRequest {
+	v := new(UninstallColormapRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Cmap = Colormap(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[82] = xgb.RequestInfo{
+		Name: "UninstallColormap",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return uninstallColormapRequestRead(buf, order)
+		},
+	}
+}
+
 // UnmapSubwindowsCookie is a cookie used only for UnmapSubwindows requests.
 type UnmapSubwindowsCookie struct {
 	*xgb.Cookie
@@ -14771,32 +24513,94 @@ func (cook UnmapSubwindowsCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook UnmapSubwindowsCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for UnmapSubwindows
 // unmapSubwindowsRequest writes a UnmapSubwindows request to a byte slice.
 func unmapSubwindowsRequest(c *xgb.Conn, Window Window) []byte {
+	return AppendUnmapSubwindowsRequest(nil, c, Window)
+}
+
+// AppendUnmapSubwindowsRequest appends a UnmapSubwindows request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendUnmapSubwindowsRequest(buf []byte, c *xgb.Conn, Window Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 11 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// UnmapSubwindowsRequest represents the data of a UnmapSubwindows request, as it is read when tracing a connection.
+type UnmapSubwindowsRequest struct {
+	// padding: 1 bytes
+	Window Window
+}
+
+// unmapSubwindowsRequestRead reads a byte slice in the byte order 'order' into a UnmapSubwindowsRequest value.
+func unmapSubwindowsRequestRead(buf []byte, order xgb.ByteOrder) *UnmapSubwindowsRequest {
+	v := new(UnmapSubwindowsRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Window = Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[11] = xgb.RequestInfo{
+		Name: "UnmapSubwindows",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return unmapSubwindowsRequestRead(buf, order)
+		},
+	}
+}
+
 // UnmapWindowCookie is a cookie used only for UnmapWindow requests.
 type UnmapWindowCookie struct {
 	*xgb.Cookie
 }
 
+// UnmapWindow makes a window invisible.
+//
+// Unmaps the specified window. This means making the window invisible (and all
+// its child windows).
+//
+// Unmapping a window leads to the UnmapNotify event being generated. Also,
+// Expose events are generated for formerly obscured windows.
+//
+// Parameters:
+//
+//   - Window: The window to make invisible.
+//
+// Errors:
+//
+//   - WindowError: The specified window does not exist.
+//
+// See also UnmapNotifyEvent, ExposeEvent, MapWindow.
+//
 // UnmapWindow sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
 func UnmapWindow(c *xgb.Conn, Window Window) UnmapWindowCookie {
@@ -14819,32 +24623,107 @@ func (cook UnmapWindowCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook UnmapWindowCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for UnmapWindow
 // unmapWindowRequest writes a UnmapWindow request to a byte slice.
 func unmapWindowRequest(c *xgb.Conn, Window Window) []byte {
+	return AppendUnmapWindowRequest(nil, c, Window)
+}
+
+// AppendUnmapWindowRequest appends a UnmapWindow request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendUnmapWindowRequest(buf []byte, c *xgb.Conn, Window Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 10 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// UnmapWindowRequest represents the data of a UnmapWindow request, as it is read when tracing a connection.
+type UnmapWindowRequest struct {
+	// padding: 1 bytes
+	Window Window
+}
+
+// unmapWindowRequestRead reads a byte slice in the byte order 'order' into a UnmapWindowRequest value.
+func unmapWindowRequestRead(buf []byte, order xgb.ByteOrder) *UnmapWindowRequest {
+	v := new(UnmapWindowRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.Window = Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[10] = xgb.RequestInfo{
+		Name: "UnmapWindow",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return unmapWindowRequestRead(buf, order)
+		},
+	}
+}
+
 // WarpPointerCookie is a cookie used only for WarpPointer requests.
 type WarpPointerCookie struct {
 	*xgb.Cookie
 }
 
+// WarpPointer move mouse pointer.
+//
+// Moves the mouse pointer to the specified position.
+//
+// If `src_window` is not `WindowNone`, the move will only take place if the
+// pointer is inside `src_window` and within the rectangle specified by
+// (`src_x`, `src_y`, `src_width`, `src_height`). The rectangle coordinates are
+// relative to `src_window`.
+//
+// If `dst_window` is not `WindowNone`, the pointer will be moved to the
+// offsets (`dst_x`, `dst_y`) relative to `dst_window`. If `dst_window` is
+// `WindowNone`, the pointer will be moved by the offsets (`dst_x`, `dst_y`)
+// relative to the current position of the pointer.
+//
+// Parameters:
+//
+//   - SrcWindow: If `src_window` is not `WindowNone`, the move will only take
+//     place if the pointer is inside `src_window` and within the rectangle
+//     specified by (`src_x`, `src_y`, `src_width`, `src_height`). The
+//     rectangle coordinates are relative to `src_window`.
+//   - DstWindow: If `dst_window` is not `WindowNone`, the pointer will be
+//     moved to the offsets (`dst_x`, `dst_y`) relative to `dst_window`. If
+//     `dst_window` is `WindowNone`, the pointer will be moved by the offsets
+//     (`dst_x`, `dst_y`) relative to the current position of the pointer.
+//
+// Errors:
+//
+//   - WindowError: TODO: reasons?
+//
+// See also SetInputFocus.
+//
 // WarpPointer sends an unchecked request.
 // If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
 func WarpPointer(c *xgb.Conn, SrcWindow Window, DstWindow Window, SrcX int16, SrcY int16, SrcWidth uint16, SrcHeight uint16, DstX int16, DstY int16) WarpPointerCookie {
@@ -14867,44 +24746,116 @@ func (cook WarpPointerCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook WarpPointerCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for WarpPointer
 // warpPointerRequest writes a WarpPointer request to a byte slice.
 func warpPointerRequest(c *xgb.Conn, SrcWindow Window, DstWindow Window, SrcX int16, SrcY int16, SrcWidth uint16, SrcHeight uint16, DstX int16, DstY int16) []byte {
+	return AppendWarpPointerRequest(nil, c, SrcWindow, DstWindow, SrcX, SrcY, SrcWidth, SrcHeight, DstX, DstY)
+}
+
+// AppendWarpPointerRequest appends a WarpPointer request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendWarpPointerRequest(buf []byte, c *xgb.Conn, SrcWindow Window, DstWindow Window, SrcX int16, SrcY int16, SrcWidth uint16, SrcHeight uint16, DstX int16, DstY int16) []byte {
 	size := 24
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	buf[b] = 41 // request opcode
 	b += 1
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(SrcWindow))
+	order.Put32(buf[b:], uint32(SrcWindow))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(DstWindow))
+	order.Put32(buf[b:], uint32(DstWindow))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(SrcX))
+	order.Put16(buf[b:], uint16(SrcX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(SrcY))
+	order.Put16(buf[b:], uint16(SrcY))
 	b += 2
 
-	xgb.Put16(buf[b:], SrcWidth)
+	order.Put16(buf[b:], SrcWidth)
 	b += 2
 
-	xgb.Put16(buf[b:], SrcHeight)
+	order.Put16(buf[b:], SrcHeight)
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DstX))
+	order.Put16(buf[b:], uint16(DstX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DstY))
+	order.Put16(buf[b:], uint16(DstY))
 	b += 2
 
 	return buf
 }
+
+// WarpPointerRequest represents the data of a WarpPointer request, as it is read when tracing a connection.
+type WarpPointerRequest struct {
+	// padding: 1 bytes
+	SrcWindow Window
+	DstWindow Window
+	SrcX      int16
+	SrcY      int16
+	SrcWidth  uint16
+	SrcHeight uint16
+	DstX      int16
+	DstY      int16
+}
+
+// warpPointerRequestRead reads a byte slice in the byte order 'order' into a WarpPointerRequest value.
+func warpPointerRequestRead(buf []byte, order xgb.ByteOrder) *WarpPointerRequest {
+	v := new(WarpPointerRequest)
+	b := 1 // skip request opcode
+
+	b += 1 // padding
+
+	b += 2 // skip request size
+
+	v.SrcWindow = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.DstWindow = Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.SrcX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.SrcY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.SrcWidth = order.Get16(buf[b:])
+	b += 2
+
+	v.SrcHeight = order.Get16(buf[b:])
+	b += 2
+
+	v.DstX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.DstY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.RequestInfos[41] = xgb.RequestInfo{
+		Name: "WarpPointer",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return warpPointerRequestRead(buf, order)
+		},
+	}
+}
@@ -155,7 +155,7 @@ func TestWindowEvents(t *testing.T) {
 	// We don't listen in the CreateWindow request so that we don't get
 	// a MapNotify event.
 	err = ChangeWindowAttributesChecked(X, wid,
-		CwEventMask, []uint32{EventMaskStructureNotify}).Check()
+		CwEventMask, []uint32{uint32(EventMaskStructureNotify)}).Check()
 	if err != nil {
 		t.Fatalf("ChangeWindowAttributes: %s", err)
 	}
@@ -4,6 +4,8 @@ package xselinux
 // This file is automatically generated from xselinux.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xproto"
@@ -22,18 +24,16 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["SELinux"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["SELinux"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["SELinux"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["SELinux"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["SELinux"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "SELinux", xgb.ExtRequestInfos["SELinux"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["SELinux"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["SELinux"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["SELinux"] = make(map[int]xgb.RequestInfo)
 }
 
 type ListItem struct {
@@ -45,16 +45,16 @@ type ListItem struct {
 }
 
 // ListItemRead reads a byte slice into a ListItem value.
-func ListItemRead(buf []byte, v *ListItem) int {
+func ListItemRead(buf []byte, order xgb.ByteOrder, v *ListItem) int {
 	b := 0
 
-	v.Name = xproto.Atom(xgb.Get32(buf[b:]))
+	v.Name = xproto.Atom(order.Get32(buf[b:]))
 	b += 4
 
-	v.ObjectContextLen = xgb.Get32(buf[b:])
+	v.ObjectContextLen = order.Get32(buf[b:])
 	b += 4
 
-	v.DataContextLen = xgb.Get32(buf[b:])
+	v.DataContextLen = order.Get32(buf[b:])
 	b += 4
 
 	{
@@ -75,27 +75,27 @@ func ListItemRead(buf []byte, v *ListItem) int {
 }
 
 // ListItemReadList reads a byte slice into a list of ListItem values.
-func ListItemReadList(buf []byte, dest []ListItem) int {
+func ListItemReadList(buf []byte, order xgb.ByteOrder, dest []ListItem) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = ListItem{}
-		b += ListItemRead(buf[b:], &dest[i])
+		b += ListItemRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a ListItem value to a byte slice.
-func (v ListItem) Bytes() []byte {
+// Bytes writes a ListItem value to a byte slice, in the byte order 'order'.
+func (v ListItem) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, ((12 + xgb.Pad((int(v.ObjectContextLen) * 1))) + xgb.Pad((int(v.DataContextLen) * 1))))
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.Name))
+	order.Put32(buf[b:], uint32(v.Name))
 	b += 4
 
-	xgb.Put32(buf[b:], v.ObjectContextLen)
+	order.Put32(buf[b:], v.ObjectContextLen)
 	b += 4
 
-	xgb.Put32(buf[b:], v.DataContextLen)
+	order.Put32(buf[b:], v.DataContextLen)
 	b += 4
 
 	copy(buf[b:], v.ObjectContext[:v.ObjectContextLen])
@@ -108,11 +108,11 @@ func (v ListItem) Bytes() []byte {
 }
 
 // ListItemListBytes writes a list of ListItem values to a byte slice.
-func ListItemListBytes(buf []byte, list []ListItem) int {
+func ListItemListBytes(buf []byte, order xgb.ByteOrder, list []ListItem) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -152,6 +152,8 @@ func ListItemListSize(list []ListItem) int {
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // GetClientContextCookie is a cookie used only for GetClientContext requests.
 type GetClientContextCookie struct {
 	*xgb.Cookie
@@ -202,23 +204,35 @@ func (cook GetClientContextCookie) Reply() (*GetClientContextReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getClientContextReply(buf), nil
+	return getClientContextReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetClientContext request arrives.
+func (cook GetClientContextCookie) ReplyContext(ctx context.Context) (*GetClientContextReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getClientContextReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getClientContextReply reads a byte slice into a GetClientContextReply value.
-func getClientContextReply(buf []byte) *GetClientContextReply {
+// getClientContextReply reads a byte slice in the byte order 'order' into a GetClientContextReply value.
+func getClientContextReply(buf []byte, order xgb.ByteOrder) *GetClientContextReply {
 	v := new(GetClientContextReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ContextLen = xgb.Get32(buf[b:])
+	v.ContextLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
@@ -236,9 +250,18 @@ func getClientContextReply(buf []byte) *GetClientContextReply {
 // Write request to wire for GetClientContext
 // getClientContextRequest writes a GetClientContext request to a byte slice.
 func getClientContextRequest(c *xgb.Conn, Resource uint32) []byte {
+	return AppendGetClientContextRequest(nil, c, Resource)
+}
+
+// AppendGetClientContextRequest appends a GetClientContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetClientContextRequest(buf []byte, c *xgb.Conn, Resource uint32) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -248,15 +271,43 @@ func getClientContextRequest(c *xgb.Conn, Resource uint32) []byte {
 	buf[b] = 22 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Resource)
+	order.Put32(buf[b:], Resource)
 	b += 4
 
 	return buf
 }
 
+// GetClientContextRequest represents the data of a GetClientContext request, as it is read when tracing a connection.
+type GetClientContextRequest struct {
+	Resource uint32
+}
+
+// getClientContextRequestRead reads a byte slice in the byte order 'order' into a GetClientContextRequest value.
+func getClientContextRequestRead(buf []byte, order xgb.ByteOrder) *GetClientContextRequest {
+	v := new(GetClientContextRequest)
+	b := 4 // skip request header
+
+	v.Resource = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][22] = xgb.RequestInfo{
+		Name: "GetClientContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getClientContextRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getClientContextReply(buf, order)
+		},
+	}
+}
+
 // GetDeviceContextCookie is a cookie used only for GetDeviceContext requests.
 type GetDeviceContextCookie struct {
 	*xgb.Cookie
@@ -307,23 +358,35 @@ func (cook GetDeviceContextCookie) Reply() (*GetDeviceContextReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getDeviceContextReply(buf), nil
+	return getDeviceContextReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetDeviceContext request arrives.
+func (cook GetDeviceContextCookie) ReplyContext(ctx context.Context) (*GetDeviceContextReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getDeviceContextReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getDeviceContextReply reads a byte slice into a GetDeviceContextReply value.
-func getDeviceContextReply(buf []byte) *GetDeviceContextReply {
+// getDeviceContextReply reads a byte slice in the byte order 'order' into a GetDeviceContextReply value.
+func getDeviceContextReply(buf []byte, order xgb.ByteOrder) *GetDeviceContextReply {
 	v := new(GetDeviceContextReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ContextLen = xgb.Get32(buf[b:])
+	v.ContextLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
@@ -341,9 +404,18 @@ func getDeviceContextReply(buf []byte) *GetDeviceContextReply {
 // Write request to wire for GetDeviceContext
 // getDeviceContextRequest writes a GetDeviceContext request to a byte slice.
 func getDeviceContextRequest(c *xgb.Conn, Device uint32) []byte {
+	return AppendGetDeviceContextRequest(nil, c, Device)
+}
+
+// AppendGetDeviceContextRequest appends a GetDeviceContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetDeviceContextRequest(buf []byte, c *xgb.Conn, Device uint32) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -353,15 +425,43 @@ func getDeviceContextRequest(c *xgb.Conn, Device uint32) []byte {
 	buf[b] = 4 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Device)
+	order.Put32(buf[b:], Device)
 	b += 4
 
 	return buf
 }
 
+// GetDeviceContextRequest represents the data of a GetDeviceContext request, as it is read when tracing a connection.
+type GetDeviceContextRequest struct {
+	Device uint32
+}
+
+// getDeviceContextRequestRead reads a byte slice in the byte order 'order' into a GetDeviceContextRequest value.
+func getDeviceContextRequestRead(buf []byte, order xgb.ByteOrder) *GetDeviceContextRequest {
+	v := new(GetDeviceContextRequest)
+	b := 4 // skip request header
+
+	v.Device = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][4] = xgb.RequestInfo{
+		Name: "GetDeviceContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getDeviceContextRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getDeviceContextReply(buf, order)
+		},
+	}
+}
+
 // GetDeviceCreateContextCookie is a cookie used only for GetDeviceCreateContext requests.
 type GetDeviceCreateContextCookie struct {
 	*xgb.Cookie
@@ -412,23 +512,35 @@ func (cook GetDeviceCreateContextCookie) Reply() (*GetDeviceCreateContextReply,
 	if buf == nil {
 		return nil, nil
 	}
-	return getDeviceCreateContextReply(buf), nil
+	return getDeviceCreateContextReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetDeviceCreateContext request arrives.
+func (cook GetDeviceCreateContextCookie) ReplyContext(ctx context.Context) (*GetDeviceCreateContextReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getDeviceCreateContextReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getDeviceCreateContextReply reads a byte slice into a GetDeviceCreateContextReply value.
-func getDeviceCreateContextReply(buf []byte) *GetDeviceCreateContextReply {
+// getDeviceCreateContextReply reads a byte slice in the byte order 'order' into a GetDeviceCreateContextReply value.
+func getDeviceCreateContextReply(buf []byte, order xgb.ByteOrder) *GetDeviceCreateContextReply {
 	v := new(GetDeviceCreateContextReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ContextLen = xgb.Get32(buf[b:])
+	v.ContextLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
@@ -446,9 +558,18 @@ func getDeviceCreateContextReply(buf []byte) *GetDeviceCreateContextReply {
 // Write request to wire for GetDeviceCreateContext
 // getDeviceCreateContextRequest writes a GetDeviceCreateContext request to a byte slice.
 func getDeviceCreateContextRequest(c *xgb.Conn) []byte {
+	return AppendGetDeviceCreateContextRequest(nil, c)
+}
+
+// AppendGetDeviceCreateContextRequest appends a GetDeviceCreateContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetDeviceCreateContextRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -458,12 +579,34 @@ func getDeviceCreateContextRequest(c *xgb.Conn) []byte {
 	buf[b] = 2 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// GetDeviceCreateContextRequest represents the data of a GetDeviceCreateContext request, as it is read when tracing a connection.
+type GetDeviceCreateContextRequest struct {
+}
+
+// getDeviceCreateContextRequestRead reads a byte slice in the byte order 'order' into a GetDeviceCreateContextRequest value.
+func getDeviceCreateContextRequestRead(buf []byte, order xgb.ByteOrder) *GetDeviceCreateContextRequest {
+	v := new(GetDeviceCreateContextRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][2] = xgb.RequestInfo{
+		Name: "GetDeviceCreateContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getDeviceCreateContextRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getDeviceCreateContextReply(buf, order)
+		},
+	}
+}
+
 // GetPropertyContextCookie is a cookie used only for GetPropertyContext requests.
 type GetPropertyContextCookie struct {
 	*xgb.Cookie
@@ -514,23 +657,35 @@ func (cook GetPropertyContextCookie) Reply() (*GetPropertyContextReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getPropertyContextReply(buf), nil
+	return getPropertyContextReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetPropertyContext request arrives.
+func (cook GetPropertyContextCookie) ReplyContext(ctx context.Context) (*GetPropertyContextReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getPropertyContextReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getPropertyContextReply reads a byte slice into a GetPropertyContextReply value.
-func getPropertyContextReply(buf []byte) *GetPropertyContextReply {
+// getPropertyContextReply reads a byte slice in the byte order 'order' into a GetPropertyContextReply value.
+func getPropertyContextReply(buf []byte, order xgb.ByteOrder) *GetPropertyContextReply {
 	v := new(GetPropertyContextReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ContextLen = xgb.Get32(buf[b:])
+	v.ContextLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
@@ -548,9 +703,18 @@ func getPropertyContextReply(buf []byte) *GetPropertyContextReply {
 // Write request to wire for GetPropertyContext
 // getPropertyContextRequest writes a GetPropertyContext request to a byte slice.
 func getPropertyContextRequest(c *xgb.Conn, Window xproto.Window, Property xproto.Atom) []byte {
+	return AppendGetPropertyContextRequest(nil, c, Window, Property)
+}
+
+// AppendGetPropertyContextRequest appends a GetPropertyContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetPropertyContextRequest(buf []byte, c *xgb.Conn, Window xproto.Window, Property xproto.Atom) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -560,18 +724,50 @@ func getPropertyContextRequest(c *xgb.Conn, Window xproto.Window, Property xprot
 	buf[b] = 12 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Property))
+	order.Put32(buf[b:], uint32(Property))
 	b += 4
 
 	return buf
 }
 
+// GetPropertyContextRequest represents the data of a GetPropertyContext request, as it is read when tracing a connection.
+type GetPropertyContextRequest struct {
+	Window   xproto.Window
+	Property xproto.Atom
+}
+
+// getPropertyContextRequestRead reads a byte slice in the byte order 'order' into a GetPropertyContextRequest value.
+func getPropertyContextRequestRead(buf []byte, order xgb.ByteOrder) *GetPropertyContextRequest {
+	v := new(GetPropertyContextRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Property = xproto.Atom(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][12] = xgb.RequestInfo{
+		Name: "GetPropertyContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPropertyContextRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPropertyContextReply(buf, order)
+		},
+	}
+}
+
 // GetPropertyCreateContextCookie is a cookie used only for GetPropertyCreateContext requests.
 type GetPropertyCreateContextCookie struct {
 	*xgb.Cookie
@@ -622,23 +818,35 @@ func (cook GetPropertyCreateContextCookie) Reply() (*GetPropertyCreateContextRep
 	if buf == nil {
 		return nil, nil
 	}
-	return getPropertyCreateContextReply(buf), nil
+	return getPropertyCreateContextReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetPropertyCreateContext request arrives.
+func (cook GetPropertyCreateContextCookie) ReplyContext(ctx context.Context) (*GetPropertyCreateContextReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getPropertyCreateContextReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getPropertyCreateContextReply reads a byte slice into a GetPropertyCreateContextReply value.
-func getPropertyCreateContextReply(buf []byte) *GetPropertyCreateContextReply {
+// getPropertyCreateContextReply reads a byte slice in the byte order 'order' into a GetPropertyCreateContextReply value.
+func getPropertyCreateContextReply(buf []byte, order xgb.ByteOrder) *GetPropertyCreateContextReply {
 	v := new(GetPropertyCreateContextReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ContextLen = xgb.Get32(buf[b:])
+	v.ContextLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
@@ -656,9 +864,18 @@ func getPropertyCreateContextReply(buf []byte) *GetPropertyCreateContextReply {
 // Write request to wire for GetPropertyCreateContext
 // getPropertyCreateContextRequest writes a GetPropertyCreateContext request to a byte slice.
 func getPropertyCreateContextRequest(c *xgb.Conn) []byte {
+	return AppendGetPropertyCreateContextRequest(nil, c)
+}
+
+// AppendGetPropertyCreateContextRequest appends a GetPropertyCreateContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetPropertyCreateContextRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -668,12 +885,34 @@ func getPropertyCreateContextRequest(c *xgb.Conn) []byte {
 	buf[b] = 9 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// GetPropertyCreateContextRequest represents the data of a GetPropertyCreateContext request, as it is read when tracing a connection.
+type GetPropertyCreateContextRequest struct {
+}
+
+// getPropertyCreateContextRequestRead reads a byte slice in the byte order 'order' into a GetPropertyCreateContextRequest value.
+func getPropertyCreateContextRequestRead(buf []byte, order xgb.ByteOrder) *GetPropertyCreateContextRequest {
+	v := new(GetPropertyCreateContextRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][9] = xgb.RequestInfo{
+		Name: "GetPropertyCreateContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPropertyCreateContextRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPropertyCreateContextReply(buf, order)
+		},
+	}
+}
+
 // GetPropertyDataContextCookie is a cookie used only for GetPropertyDataContext requests.
 type GetPropertyDataContextCookie struct {
 	*xgb.Cookie
@@ -724,23 +963,35 @@ func (cook GetPropertyDataContextCookie) Reply() (*GetPropertyDataContextReply,
 	if buf == nil {
 		return nil, nil
 	}
-	return getPropertyDataContextReply(buf), nil
+	return getPropertyDataContextReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetPropertyDataContext request arrives.
+func (cook GetPropertyDataContextCookie) ReplyContext(ctx context.Context) (*GetPropertyDataContextReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getPropertyDataContextReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getPropertyDataContextReply reads a byte slice into a GetPropertyDataContextReply value.
-func getPropertyDataContextReply(buf []byte) *GetPropertyDataContextReply {
+// getPropertyDataContextReply reads a byte slice in the byte order 'order' into a GetPropertyDataContextReply value.
+func getPropertyDataContextReply(buf []byte, order xgb.ByteOrder) *GetPropertyDataContextReply {
 	v := new(GetPropertyDataContextReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ContextLen = xgb.Get32(buf[b:])
+	v.ContextLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
@@ -758,9 +1009,18 @@ func getPropertyDataContextReply(buf []byte) *GetPropertyDataContextReply {
 // Write request to wire for GetPropertyDataContext
 // getPropertyDataContextRequest writes a GetPropertyDataContext request to a byte slice.
 func getPropertyDataContextRequest(c *xgb.Conn, Window xproto.Window, Property xproto.Atom) []byte {
+	return AppendGetPropertyDataContextRequest(nil, c, Window, Property)
+}
+
+// AppendGetPropertyDataContextRequest appends a GetPropertyDataContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetPropertyDataContextRequest(buf []byte, c *xgb.Conn, Window xproto.Window, Property xproto.Atom) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -770,18 +1030,50 @@ func getPropertyDataContextRequest(c *xgb.Conn, Window xproto.Window, Property x
 	buf[b] = 13 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Property))
+	order.Put32(buf[b:], uint32(Property))
 	b += 4
 
 	return buf
 }
 
+// GetPropertyDataContextRequest represents the data of a GetPropertyDataContext request, as it is read when tracing a connection.
+type GetPropertyDataContextRequest struct {
+	Window   xproto.Window
+	Property xproto.Atom
+}
+
+// getPropertyDataContextRequestRead reads a byte slice in the byte order 'order' into a GetPropertyDataContextRequest value.
+func getPropertyDataContextRequestRead(buf []byte, order xgb.ByteOrder) *GetPropertyDataContextRequest {
+	v := new(GetPropertyDataContextRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Property = xproto.Atom(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][13] = xgb.RequestInfo{
+		Name: "GetPropertyDataContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPropertyDataContextRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPropertyDataContextReply(buf, order)
+		},
+	}
+}
+
 // GetPropertyUseContextCookie is a cookie used only for GetPropertyUseContext requests.
 type GetPropertyUseContextCookie struct {
 	*xgb.Cookie
@@ -832,23 +1124,35 @@ func (cook GetPropertyUseContextCookie) Reply() (*GetPropertyUseContextReply, er
 	if buf == nil {
 		return nil, nil
 	}
-	return getPropertyUseContextReply(buf), nil
+	return getPropertyUseContextReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetPropertyUseContext request arrives.
+func (cook GetPropertyUseContextCookie) ReplyContext(ctx context.Context) (*GetPropertyUseContextReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getPropertyUseContextReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getPropertyUseContextReply reads a byte slice into a GetPropertyUseContextReply value.
-func getPropertyUseContextReply(buf []byte) *GetPropertyUseContextReply {
+// getPropertyUseContextReply reads a byte slice in the byte order 'order' into a GetPropertyUseContextReply value.
+func getPropertyUseContextReply(buf []byte, order xgb.ByteOrder) *GetPropertyUseContextReply {
 	v := new(GetPropertyUseContextReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ContextLen = xgb.Get32(buf[b:])
+	v.ContextLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
@@ -866,9 +1170,18 @@ func getPropertyUseContextReply(buf []byte) *GetPropertyUseContextReply {
 // Write request to wire for GetPropertyUseContext
 // getPropertyUseContextRequest writes a GetPropertyUseContext request to a byte slice.
 func getPropertyUseContextRequest(c *xgb.Conn) []byte {
+	return AppendGetPropertyUseContextRequest(nil, c)
+}
+
+// AppendGetPropertyUseContextRequest appends a GetPropertyUseContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetPropertyUseContextRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -878,12 +1191,34 @@ func getPropertyUseContextRequest(c *xgb.Conn) []byte {
 	buf[b] = 11 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// GetPropertyUseContextRequest represents the data of a GetPropertyUseContext request, as it is read when tracing a connection.
+type GetPropertyUseContextRequest struct {
+}
+
+// getPropertyUseContextRequestRead reads a byte slice in the byte order 'order' into a GetPropertyUseContextRequest value.
+func getPropertyUseContextRequestRead(buf []byte, order xgb.ByteOrder) *GetPropertyUseContextRequest {
+	v := new(GetPropertyUseContextRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][11] = xgb.RequestInfo{
+		Name: "GetPropertyUseContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPropertyUseContextRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPropertyUseContextReply(buf, order)
+		},
+	}
+}
+
 // GetSelectionContextCookie is a cookie used only for GetSelectionContext requests.
 type GetSelectionContextCookie struct {
 	*xgb.Cookie
@@ -934,23 +1269,35 @@ func (cook GetSelectionContextCookie) Reply() (*GetSelectionContextReply, error)
 	if buf == nil {
 		return nil, nil
 	}
-	return getSelectionContextReply(buf), nil
+	return getSelectionContextReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getSelectionContextReply reads a byte slice into a GetSelectionContextReply value.
-func getSelectionContextReply(buf []byte) *GetSelectionContextReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetSelectionContext request arrives.
+func (cook GetSelectionContextCookie) ReplyContext(ctx context.Context) (*GetSelectionContextReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getSelectionContextReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// getSelectionContextReply reads a byte slice in the byte order 'order' into a GetSelectionContextReply value.
+func getSelectionContextReply(buf []byte, order xgb.ByteOrder) *GetSelectionContextReply {
 	v := new(GetSelectionContextReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ContextLen = xgb.Get32(buf[b:])
+	v.ContextLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
@@ -968,9 +1315,18 @@ func getSelectionContextReply(buf []byte) *GetSelectionContextReply {
 // Write request to wire for GetSelectionContext
 // getSelectionContextRequest writes a GetSelectionContext request to a byte slice.
 func getSelectionContextRequest(c *xgb.Conn, Selection xproto.Atom) []byte {
+	return AppendGetSelectionContextRequest(nil, c, Selection)
+}
+
+// AppendGetSelectionContextRequest appends a GetSelectionContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetSelectionContextRequest(buf []byte, c *xgb.Conn, Selection xproto.Atom) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -980,15 +1336,43 @@ func getSelectionContextRequest(c *xgb.Conn, Selection xproto.Atom) []byte {
 	buf[b] = 19 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Selection))
+	order.Put32(buf[b:], uint32(Selection))
 	b += 4
 
 	return buf
 }
 
+// GetSelectionContextRequest represents the data of a GetSelectionContext request, as it is read when tracing a connection.
+type GetSelectionContextRequest struct {
+	Selection xproto.Atom
+}
+
+// getSelectionContextRequestRead reads a byte slice in the byte order 'order' into a GetSelectionContextRequest value.
+func getSelectionContextRequestRead(buf []byte, order xgb.ByteOrder) *GetSelectionContextRequest {
+	v := new(GetSelectionContextRequest)
+	b := 4 // skip request header
+
+	v.Selection = xproto.Atom(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][19] = xgb.RequestInfo{
+		Name: "GetSelectionContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getSelectionContextRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getSelectionContextReply(buf, order)
+		},
+	}
+}
+
 // GetSelectionCreateContextCookie is a cookie used only for GetSelectionCreateContext requests.
 type GetSelectionCreateContextCookie struct {
 	*xgb.Cookie
@@ -1039,23 +1423,35 @@ func (cook GetSelectionCreateContextCookie) Reply() (*GetSelectionCreateContextR
 	if buf == nil {
 		return nil, nil
 	}
-	return getSelectionCreateContextReply(buf), nil
+	return getSelectionCreateContextReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetSelectionCreateContext request arrives.
+func (cook GetSelectionCreateContextCookie) ReplyContext(ctx context.Context) (*GetSelectionCreateContextReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getSelectionCreateContextReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getSelectionCreateContextReply reads a byte slice into a GetSelectionCreateContextReply value.
-func getSelectionCreateContextReply(buf []byte) *GetSelectionCreateContextReply {
+// getSelectionCreateContextReply reads a byte slice in the byte order 'order' into a GetSelectionCreateContextReply value.
+func getSelectionCreateContextReply(buf []byte, order xgb.ByteOrder) *GetSelectionCreateContextReply {
 	v := new(GetSelectionCreateContextReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ContextLen = xgb.Get32(buf[b:])
+	v.ContextLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
@@ -1073,9 +1469,18 @@ func getSelectionCreateContextReply(buf []byte) *GetSelectionCreateContextReply
 // Write request to wire for GetSelectionCreateContext
 // getSelectionCreateContextRequest writes a GetSelectionCreateContext request to a byte slice.
 func getSelectionCreateContextRequest(c *xgb.Conn) []byte {
+	return AppendGetSelectionCreateContextRequest(nil, c)
+}
+
+// AppendGetSelectionCreateContextRequest appends a GetSelectionCreateContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetSelectionCreateContextRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -1085,12 +1490,34 @@ func getSelectionCreateContextRequest(c *xgb.Conn) []byte {
 	buf[b] = 16 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// GetSelectionCreateContextRequest represents the data of a GetSelectionCreateContext request, as it is read when tracing a connection.
+type GetSelectionCreateContextRequest struct {
+}
+
+// getSelectionCreateContextRequestRead reads a byte slice in the byte order 'order' into a GetSelectionCreateContextRequest value.
+func getSelectionCreateContextRequestRead(buf []byte, order xgb.ByteOrder) *GetSelectionCreateContextRequest {
+	v := new(GetSelectionCreateContextRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][16] = xgb.RequestInfo{
+		Name: "GetSelectionCreateContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getSelectionCreateContextRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getSelectionCreateContextReply(buf, order)
+		},
+	}
+}
+
 // GetSelectionDataContextCookie is a cookie used only for GetSelectionDataContext requests.
 type GetSelectionDataContextCookie struct {
 	*xgb.Cookie
@@ -1141,23 +1568,35 @@ func (cook GetSelectionDataContextCookie) Reply() (*GetSelectionDataContextReply
 	if buf == nil {
 		return nil, nil
 	}
-	return getSelectionDataContextReply(buf), nil
+	return getSelectionDataContextReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetSelectionDataContext request arrives.
+func (cook GetSelectionDataContextCookie) ReplyContext(ctx context.Context) (*GetSelectionDataContextReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getSelectionDataContextReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getSelectionDataContextReply reads a byte slice into a GetSelectionDataContextReply value.
-func getSelectionDataContextReply(buf []byte) *GetSelectionDataContextReply {
+// getSelectionDataContextReply reads a byte slice in the byte order 'order' into a GetSelectionDataContextReply value.
+func getSelectionDataContextReply(buf []byte, order xgb.ByteOrder) *GetSelectionDataContextReply {
 	v := new(GetSelectionDataContextReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ContextLen = xgb.Get32(buf[b:])
+	v.ContextLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
@@ -1175,9 +1614,18 @@ func getSelectionDataContextReply(buf []byte) *GetSelectionDataContextReply {
 // Write request to wire for GetSelectionDataContext
 // getSelectionDataContextRequest writes a GetSelectionDataContext request to a byte slice.
 func getSelectionDataContextRequest(c *xgb.Conn, Selection xproto.Atom) []byte {
+	return AppendGetSelectionDataContextRequest(nil, c, Selection)
+}
+
+// AppendGetSelectionDataContextRequest appends a GetSelectionDataContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetSelectionDataContextRequest(buf []byte, c *xgb.Conn, Selection xproto.Atom) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -1187,15 +1635,43 @@ func getSelectionDataContextRequest(c *xgb.Conn, Selection xproto.Atom) []byte {
 	buf[b] = 20 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Selection))
+	order.Put32(buf[b:], uint32(Selection))
 	b += 4
 
 	return buf
 }
 
+// GetSelectionDataContextRequest represents the data of a GetSelectionDataContext request, as it is read when tracing a connection.
+type GetSelectionDataContextRequest struct {
+	Selection xproto.Atom
+}
+
+// getSelectionDataContextRequestRead reads a byte slice in the byte order 'order' into a GetSelectionDataContextRequest value.
+func getSelectionDataContextRequestRead(buf []byte, order xgb.ByteOrder) *GetSelectionDataContextRequest {
+	v := new(GetSelectionDataContextRequest)
+	b := 4 // skip request header
+
+	v.Selection = xproto.Atom(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][20] = xgb.RequestInfo{
+		Name: "GetSelectionDataContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getSelectionDataContextRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getSelectionDataContextReply(buf, order)
+		},
+	}
+}
+
 // GetSelectionUseContextCookie is a cookie used only for GetSelectionUseContext requests.
 type GetSelectionUseContextCookie struct {
 	*xgb.Cookie
@@ -1246,23 +1722,35 @@ func (cook GetSelectionUseContextCookie) Reply() (*GetSelectionUseContextReply,
 	if buf == nil {
 		return nil, nil
 	}
-	return getSelectionUseContextReply(buf), nil
+	return getSelectionUseContextReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetSelectionUseContext request arrives.
+func (cook GetSelectionUseContextCookie) ReplyContext(ctx context.Context) (*GetSelectionUseContextReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getSelectionUseContextReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getSelectionUseContextReply reads a byte slice into a GetSelectionUseContextReply value.
-func getSelectionUseContextReply(buf []byte) *GetSelectionUseContextReply {
+// getSelectionUseContextReply reads a byte slice in the byte order 'order' into a GetSelectionUseContextReply value.
+func getSelectionUseContextReply(buf []byte, order xgb.ByteOrder) *GetSelectionUseContextReply {
 	v := new(GetSelectionUseContextReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ContextLen = xgb.Get32(buf[b:])
+	v.ContextLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
@@ -1280,9 +1768,18 @@ func getSelectionUseContextReply(buf []byte) *GetSelectionUseContextReply {
 // Write request to wire for GetSelectionUseContext
 // getSelectionUseContextRequest writes a GetSelectionUseContext request to a byte slice.
 func getSelectionUseContextRequest(c *xgb.Conn) []byte {
+	return AppendGetSelectionUseContextRequest(nil, c)
+}
+
+// AppendGetSelectionUseContextRequest appends a GetSelectionUseContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetSelectionUseContextRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -1292,12 +1789,34 @@ func getSelectionUseContextRequest(c *xgb.Conn) []byte {
 	buf[b] = 18 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// GetSelectionUseContextRequest represents the data of a GetSelectionUseContext request, as it is read when tracing a connection.
+type GetSelectionUseContextRequest struct {
+}
+
+// getSelectionUseContextRequestRead reads a byte slice in the byte order 'order' into a GetSelectionUseContextRequest value.
+func getSelectionUseContextRequestRead(buf []byte, order xgb.ByteOrder) *GetSelectionUseContextRequest {
+	v := new(GetSelectionUseContextRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][18] = xgb.RequestInfo{
+		Name: "GetSelectionUseContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getSelectionUseContextRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getSelectionUseContextReply(buf, order)
+		},
+	}
+}
+
 // GetWindowContextCookie is a cookie used only for GetWindowContext requests.
 type GetWindowContextCookie struct {
 	*xgb.Cookie
@@ -1348,23 +1867,35 @@ func (cook GetWindowContextCookie) Reply() (*GetWindowContextReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getWindowContextReply(buf), nil
+	return getWindowContextReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetWindowContext request arrives.
+func (cook GetWindowContextCookie) ReplyContext(ctx context.Context) (*GetWindowContextReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getWindowContextReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getWindowContextReply reads a byte slice into a GetWindowContextReply value.
-func getWindowContextReply(buf []byte) *GetWindowContextReply {
+// getWindowContextReply reads a byte slice in the byte order 'order' into a GetWindowContextReply value.
+func getWindowContextReply(buf []byte, order xgb.ByteOrder) *GetWindowContextReply {
 	v := new(GetWindowContextReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ContextLen = xgb.Get32(buf[b:])
+	v.ContextLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
@@ -1382,9 +1913,18 @@ func getWindowContextReply(buf []byte) *GetWindowContextReply {
 // Write request to wire for GetWindowContext
 // getWindowContextRequest writes a GetWindowContext request to a byte slice.
 func getWindowContextRequest(c *xgb.Conn, Window xproto.Window) []byte {
+	return AppendGetWindowContextRequest(nil, c, Window)
+}
+
+// AppendGetWindowContextRequest appends a GetWindowContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetWindowContextRequest(buf []byte, c *xgb.Conn, Window xproto.Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -1394,15 +1934,43 @@ func getWindowContextRequest(c *xgb.Conn, Window xproto.Window) []byte {
 	buf[b] = 7 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// GetWindowContextRequest represents the data of a GetWindowContext request, as it is read when tracing a connection.
+type GetWindowContextRequest struct {
+	Window xproto.Window
+}
+
+// getWindowContextRequestRead reads a byte slice in the byte order 'order' into a GetWindowContextRequest value.
+func getWindowContextRequestRead(buf []byte, order xgb.ByteOrder) *GetWindowContextRequest {
+	v := new(GetWindowContextRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][7] = xgb.RequestInfo{
+		Name: "GetWindowContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getWindowContextRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getWindowContextReply(buf, order)
+		},
+	}
+}
+
 // GetWindowCreateContextCookie is a cookie used only for GetWindowCreateContext requests.
 type GetWindowCreateContextCookie struct {
 	*xgb.Cookie
@@ -1453,23 +2021,35 @@ func (cook GetWindowCreateContextCookie) Reply() (*GetWindowCreateContextReply,
 	if buf == nil {
 		return nil, nil
 	}
-	return getWindowCreateContextReply(buf), nil
+	return getWindowCreateContextReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getWindowCreateContextReply reads a byte slice into a GetWindowCreateContextReply value.
-func getWindowCreateContextReply(buf []byte) *GetWindowCreateContextReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetWindowCreateContext request arrives.
+func (cook GetWindowCreateContextCookie) ReplyContext(ctx context.Context) (*GetWindowCreateContextReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getWindowCreateContextReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// getWindowCreateContextReply reads a byte slice in the byte order 'order' into a GetWindowCreateContextReply value.
+func getWindowCreateContextReply(buf []byte, order xgb.ByteOrder) *GetWindowCreateContextReply {
 	v := new(GetWindowCreateContextReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ContextLen = xgb.Get32(buf[b:])
+	v.ContextLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
@@ -1487,9 +2067,18 @@ func getWindowCreateContextReply(buf []byte) *GetWindowCreateContextReply {
 // Write request to wire for GetWindowCreateContext
 // getWindowCreateContextRequest writes a GetWindowCreateContext request to a byte slice.
 func getWindowCreateContextRequest(c *xgb.Conn) []byte {
+	return AppendGetWindowCreateContextRequest(nil, c)
+}
+
+// AppendGetWindowCreateContextRequest appends a GetWindowCreateContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetWindowCreateContextRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -1499,12 +2088,34 @@ func getWindowCreateContextRequest(c *xgb.Conn) []byte {
 	buf[b] = 6 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// GetWindowCreateContextRequest represents the data of a GetWindowCreateContext request, as it is read when tracing a connection.
+type GetWindowCreateContextRequest struct {
+}
+
+// getWindowCreateContextRequestRead reads a byte slice in the byte order 'order' into a GetWindowCreateContextRequest value.
+func getWindowCreateContextRequestRead(buf []byte, order xgb.ByteOrder) *GetWindowCreateContextRequest {
+	v := new(GetWindowCreateContextRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][6] = xgb.RequestInfo{
+		Name: "GetWindowCreateContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getWindowCreateContextRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getWindowCreateContextReply(buf, order)
+		},
+	}
+}
+
 // ListPropertiesCookie is a cookie used only for ListProperties requests.
 type ListPropertiesCookie struct {
 	*xgb.Cookie
@@ -1555,29 +2166,41 @@ func (cook ListPropertiesCookie) Reply() (*ListPropertiesReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return listPropertiesReply(buf), nil
+	return listPropertiesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a ListProperties request arrives.
+func (cook ListPropertiesCookie) ReplyContext(ctx context.Context) (*ListPropertiesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return listPropertiesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// listPropertiesReply reads a byte slice into a ListPropertiesReply value.
-func listPropertiesReply(buf []byte) *ListPropertiesReply {
+// listPropertiesReply reads a byte slice in the byte order 'order' into a ListPropertiesReply value.
+func listPropertiesReply(buf []byte, order xgb.ByteOrder) *ListPropertiesReply {
 	v := new(ListPropertiesReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.PropertiesLen = xgb.Get32(buf[b:])
+	v.PropertiesLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
 
 	v.Properties = make([]ListItem, v.PropertiesLen)
-	b += ListItemReadList(buf[b:], v.Properties)
+	b += ListItemReadList(buf[b:], order, v.Properties)
 
 	return v
 }
@@ -1585,9 +2208,18 @@ func listPropertiesReply(buf []byte) *ListPropertiesReply {
 // Write request to wire for ListProperties
 // listPropertiesRequest writes a ListProperties request to a byte slice.
 func listPropertiesRequest(c *xgb.Conn, Window xproto.Window) []byte {
+	return AppendListPropertiesRequest(nil, c, Window)
+}
+
+// AppendListPropertiesRequest appends a ListProperties request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendListPropertiesRequest(buf []byte, c *xgb.Conn, Window xproto.Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -1597,15 +2229,43 @@ func listPropertiesRequest(c *xgb.Conn, Window xproto.Window) []byte {
 	buf[b] = 14 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// ListPropertiesRequest represents the data of a ListProperties request, as it is read when tracing a connection.
+type ListPropertiesRequest struct {
+	Window xproto.Window
+}
+
+// listPropertiesRequestRead reads a byte slice in the byte order 'order' into a ListPropertiesRequest value.
+func listPropertiesRequestRead(buf []byte, order xgb.ByteOrder) *ListPropertiesRequest {
+	v := new(ListPropertiesRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][14] = xgb.RequestInfo{
+		Name: "ListProperties",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listPropertiesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listPropertiesReply(buf, order)
+		},
+	}
+}
+
 // ListSelectionsCookie is a cookie used only for ListSelections requests.
 type ListSelectionsCookie struct {
 	*xgb.Cookie
@@ -1656,29 +2316,41 @@ func (cook ListSelectionsCookie) Reply() (*ListSelectionsReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return listSelectionsReply(buf), nil
+	return listSelectionsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// listSelectionsReply reads a byte slice into a ListSelectionsReply value.
-func listSelectionsReply(buf []byte) *ListSelectionsReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a ListSelections request arrives.
+func (cook ListSelectionsCookie) ReplyContext(ctx context.Context) (*ListSelectionsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return listSelectionsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// listSelectionsReply reads a byte slice in the byte order 'order' into a ListSelectionsReply value.
+func listSelectionsReply(buf []byte, order xgb.ByteOrder) *ListSelectionsReply {
 	v := new(ListSelectionsReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.SelectionsLen = xgb.Get32(buf[b:])
+	v.SelectionsLen = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
 
 	v.Selections = make([]ListItem, v.SelectionsLen)
-	b += ListItemReadList(buf[b:], v.Selections)
+	b += ListItemReadList(buf[b:], order, v.Selections)
 
 	return v
 }
@@ -1686,9 +2358,18 @@ func listSelectionsReply(buf []byte) *ListSelectionsReply {
 // Write request to wire for ListSelections
 // listSelectionsRequest writes a ListSelections request to a byte slice.
 func listSelectionsRequest(c *xgb.Conn) []byte {
+	return AppendListSelectionsRequest(nil, c)
+}
+
+// AppendListSelectionsRequest appends a ListSelections request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendListSelectionsRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -1698,12 +2379,34 @@ func listSelectionsRequest(c *xgb.Conn) []byte {
 	buf[b] = 21 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// ListSelectionsRequest represents the data of a ListSelections request, as it is read when tracing a connection.
+type ListSelectionsRequest struct {
+}
+
+// listSelectionsRequestRead reads a byte slice in the byte order 'order' into a ListSelectionsRequest value.
+func listSelectionsRequestRead(buf []byte, order xgb.ByteOrder) *ListSelectionsRequest {
+	v := new(ListSelectionsRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][21] = xgb.RequestInfo{
+		Name: "ListSelections",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listSelectionsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listSelectionsReply(buf, order)
+		},
+	}
+}
+
 // QueryVersionCookie is a cookie used only for QueryVersion requests.
 type QueryVersionCookie struct {
 	*xgb.Cookie
@@ -1753,26 +2456,38 @@ func (cook QueryVersionCookie) Reply() (*QueryVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryVersionReply(buf), nil
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryVersion request arrives.
+func (cook QueryVersionCookie) ReplyContext(ctx context.Context) (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryVersionReply reads a byte slice into a QueryVersionReply value.
-func queryVersionReply(buf []byte) *QueryVersionReply {
+// queryVersionReply reads a byte slice in the byte order 'order' into a QueryVersionReply value.
+func queryVersionReply(buf []byte, order xgb.ByteOrder) *QueryVersionReply {
 	v := new(QueryVersionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ServerMajor = xgb.Get16(buf[b:])
+	v.ServerMajor = order.Get16(buf[b:])
 	b += 2
 
-	v.ServerMinor = xgb.Get16(buf[b:])
+	v.ServerMinor = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -1781,9 +2496,18 @@ func queryVersionReply(buf []byte) *QueryVersionReply {
 // Write request to wire for QueryVersion
 // queryVersionRequest writes a QueryVersion request to a byte slice.
 func queryVersionRequest(c *xgb.Conn, ClientMajor byte, ClientMinor byte) []byte {
+	return AppendQueryVersionRequest(nil, c, ClientMajor, ClientMinor)
+}
+
+// AppendQueryVersionRequest appends a QueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryVersionRequest(buf []byte, c *xgb.Conn, ClientMajor byte, ClientMinor byte) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -1793,7 +2517,7 @@ func queryVersionRequest(c *xgb.Conn, ClientMajor byte, ClientMinor byte) []byte
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	buf[b] = ClientMajor
@@ -1805,6 +2529,38 @@ func queryVersionRequest(c *xgb.Conn, ClientMajor byte, ClientMinor byte) []byte
 	return buf
 }
 
+// QueryVersionRequest represents the data of a QueryVersion request, as it is read when tracing a connection.
+type QueryVersionRequest struct {
+	ClientMajor byte
+	ClientMinor byte
+}
+
+// queryVersionRequestRead reads a byte slice in the byte order 'order' into a QueryVersionRequest value.
+func queryVersionRequestRead(buf []byte, order xgb.ByteOrder) *QueryVersionRequest {
+	v := new(QueryVersionRequest)
+	b := 4 // skip request header
+
+	v.ClientMajor = buf[b]
+	b += 1
+
+	v.ClientMinor = buf[b]
+	b += 1
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][0] = xgb.RequestInfo{
+		Name: "QueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionReply(buf, order)
+		},
+	}
+}
+
 // SetDeviceContextCookie is a cookie used only for SetDeviceContext requests.
 type SetDeviceContextCookie struct {
 	*xgb.Cookie
@@ -1842,12 +2598,26 @@ func (cook SetDeviceContextCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetDeviceContextCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetDeviceContext
 // setDeviceContextRequest writes a SetDeviceContext request to a byte slice.
 func setDeviceContextRequest(c *xgb.Conn, Device uint32, ContextLen uint32, Context string) []byte {
+	return AppendSetDeviceContextRequest(nil, c, Device, ContextLen, Context)
+}
+
+// AppendSetDeviceContextRequest appends a SetDeviceContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetDeviceContextRequest(buf []byte, c *xgb.Conn, Device uint32, ContextLen uint32, Context string) []byte {
 	size := xgb.Pad((12 + xgb.Pad((int(ContextLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -1857,13 +2627,13 @@ func setDeviceContextRequest(c *xgb.Conn, Device uint32, ContextLen uint32, Cont
 	buf[b] = 3 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], Device)
+	order.Put32(buf[b:], Device)
 	b += 4
 
-	xgb.Put32(buf[b:], ContextLen)
+	order.Put32(buf[b:], ContextLen)
 	b += 4
 
 	copy(buf[b:], Context[:ContextLen])
@@ -1872,6 +2642,43 @@ func setDeviceContextRequest(c *xgb.Conn, Device uint32, ContextLen uint32, Cont
 	return buf
 }
 
+// SetDeviceContextRequest represents the data of a SetDeviceContext request, as it is read when tracing a connection.
+type SetDeviceContextRequest struct {
+	Device     uint32
+	ContextLen uint32
+	Context    string // size: xgb.Pad((int(ContextLen) * 1))
+}
+
+// setDeviceContextRequestRead reads a byte slice in the byte order 'order' into a SetDeviceContextRequest value.
+func setDeviceContextRequestRead(buf []byte, order xgb.ByteOrder) *SetDeviceContextRequest {
+	v := new(SetDeviceContextRequest)
+	b := 4 // skip request header
+
+	v.Device = order.Get32(buf[b:])
+	b += 4
+
+	v.ContextLen = order.Get32(buf[b:])
+	b += 4
+
+	{
+		byteString := make([]byte, v.ContextLen)
+		copy(byteString[:v.ContextLen], buf[b:])
+		v.Context = string(byteString)
+		b += int(v.ContextLen)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][3] = xgb.RequestInfo{
+		Name: "SetDeviceContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setDeviceContextRequestRead(buf, order)
+		},
+	}
+}
+
 // SetDeviceCreateContextCookie is a cookie used only for SetDeviceCreateContext requests.
 type SetDeviceCreateContextCookie struct {
 	*xgb.Cookie
@@ -1909,12 +2716,26 @@ func (cook SetDeviceCreateContextCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetDeviceCreateContextCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetDeviceCreateContext
 // setDeviceCreateContextRequest writes a SetDeviceCreateContext request to a byte slice.
 func setDeviceCreateContextRequest(c *xgb.Conn, ContextLen uint32, Context string) []byte {
+	return AppendSetDeviceCreateContextRequest(nil, c, ContextLen, Context)
+}
+
+// AppendSetDeviceCreateContextRequest appends a SetDeviceCreateContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetDeviceCreateContextRequest(buf []byte, c *xgb.Conn, ContextLen uint32, Context string) []byte {
 	size := xgb.Pad((8 + xgb.Pad((int(ContextLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -1924,10 +2745,10 @@ func setDeviceCreateContextRequest(c *xgb.Conn, ContextLen uint32, Context strin
 	buf[b] = 1 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], ContextLen)
+	order.Put32(buf[b:], ContextLen)
 	b += 4
 
 	copy(buf[b:], Context[:ContextLen])
@@ -1936,6 +2757,39 @@ func setDeviceCreateContextRequest(c *xgb.Conn, ContextLen uint32, Context strin
 	return buf
 }
 
+// SetDeviceCreateContextRequest represents the data of a SetDeviceCreateContext request, as it is read when tracing a connection.
+type SetDeviceCreateContextRequest struct {
+	ContextLen uint32
+	Context    string // size: xgb.Pad((int(ContextLen) * 1))
+}
+
+// setDeviceCreateContextRequestRead reads a byte slice in the byte order 'order' into a SetDeviceCreateContextRequest value.
+func setDeviceCreateContextRequestRead(buf []byte, order xgb.ByteOrder) *SetDeviceCreateContextRequest {
+	v := new(SetDeviceCreateContextRequest)
+	b := 4 // skip request header
+
+	v.ContextLen = order.Get32(buf[b:])
+	b += 4
+
+	{
+		byteString := make([]byte, v.ContextLen)
+		copy(byteString[:v.ContextLen], buf[b:])
+		v.Context = string(byteString)
+		b += int(v.ContextLen)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][1] = xgb.RequestInfo{
+		Name: "SetDeviceCreateContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setDeviceCreateContextRequestRead(buf, order)
+		},
+	}
+}
+
 // SetPropertyCreateContextCookie is a cookie used only for SetPropertyCreateContext requests.
 type SetPropertyCreateContextCookie struct {
 	*xgb.Cookie
@@ -1973,12 +2827,26 @@ func (cook SetPropertyCreateContextCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetPropertyCreateContextCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetPropertyCreateContext
 // setPropertyCreateContextRequest writes a SetPropertyCreateContext request to a byte slice.
 func setPropertyCreateContextRequest(c *xgb.Conn, ContextLen uint32, Context string) []byte {
+	return AppendSetPropertyCreateContextRequest(nil, c, ContextLen, Context)
+}
+
+// AppendSetPropertyCreateContextRequest appends a SetPropertyCreateContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetPropertyCreateContextRequest(buf []byte, c *xgb.Conn, ContextLen uint32, Context string) []byte {
 	size := xgb.Pad((8 + xgb.Pad((int(ContextLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -1988,10 +2856,10 @@ func setPropertyCreateContextRequest(c *xgb.Conn, ContextLen uint32, Context str
 	buf[b] = 8 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], ContextLen)
+	order.Put32(buf[b:], ContextLen)
 	b += 4
 
 	copy(buf[b:], Context[:ContextLen])
@@ -2000,6 +2868,39 @@ func setPropertyCreateContextRequest(c *xgb.Conn, ContextLen uint32, Context str
 	return buf
 }
 
+// SetPropertyCreateContextRequest represents the data of a SetPropertyCreateContext request, as it is read when tracing a connection.
+type SetPropertyCreateContextRequest struct {
+	ContextLen uint32
+	Context    string // size: xgb.Pad((int(ContextLen) * 1))
+}
+
+// setPropertyCreateContextRequestRead reads a byte slice in the byte order 'order' into a SetPropertyCreateContextRequest value.
+func setPropertyCreateContextRequestRead(buf []byte, order xgb.ByteOrder) *SetPropertyCreateContextRequest {
+	v := new(SetPropertyCreateContextRequest)
+	b := 4 // skip request header
+
+	v.ContextLen = order.Get32(buf[b:])
+	b += 4
+
+	{
+		byteString := make([]byte, v.ContextLen)
+		copy(byteString[:v.ContextLen], buf[b:])
+		v.Context = string(byteString)
+		b += int(v.ContextLen)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][8] = xgb.RequestInfo{
+		Name: "SetPropertyCreateContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setPropertyCreateContextRequestRead(buf, order)
+		},
+	}
+}
+
 // SetPropertyUseContextCookie is a cookie used only for SetPropertyUseContext requests.
 type SetPropertyUseContextCookie struct {
 	*xgb.Cookie
@@ -2037,12 +2938,26 @@ func (cook SetPropertyUseContextCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetPropertyUseContextCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetPropertyUseContext
 // setPropertyUseContextRequest writes a SetPropertyUseContext request to a byte slice.
 func setPropertyUseContextRequest(c *xgb.Conn, ContextLen uint32, Context string) []byte {
+	return AppendSetPropertyUseContextRequest(nil, c, ContextLen, Context)
+}
+
+// AppendSetPropertyUseContextRequest appends a SetPropertyUseContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetPropertyUseContextRequest(buf []byte, c *xgb.Conn, ContextLen uint32, Context string) []byte {
 	size := xgb.Pad((8 + xgb.Pad((int(ContextLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -2052,10 +2967,10 @@ func setPropertyUseContextRequest(c *xgb.Conn, ContextLen uint32, Context string
 	buf[b] = 10 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], ContextLen)
+	order.Put32(buf[b:], ContextLen)
 	b += 4
 
 	copy(buf[b:], Context[:ContextLen])
@@ -2064,6 +2979,39 @@ func setPropertyUseContextRequest(c *xgb.Conn, ContextLen uint32, Context string
 	return buf
 }
 
+// SetPropertyUseContextRequest represents the data of a SetPropertyUseContext request, as it is read when tracing a connection.
+type SetPropertyUseContextRequest struct {
+	ContextLen uint32
+	Context    string // size: xgb.Pad((int(ContextLen) * 1))
+}
+
+// setPropertyUseContextRequestRead reads a byte slice in the byte order 'order' into a SetPropertyUseContextRequest value.
+func setPropertyUseContextRequestRead(buf []byte, order xgb.ByteOrder) *SetPropertyUseContextRequest {
+	v := new(SetPropertyUseContextRequest)
+	b := 4 // skip request header
+
+	v.ContextLen = order.Get32(buf[b:])
+	b += 4
+
+	{
+		byteString := make([]byte, v.ContextLen)
+		copy(byteString[:v.ContextLen], buf[b:])
+		v.Context = string(byteString)
+		b += int(v.ContextLen)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][10] = xgb.RequestInfo{
+		Name: "SetPropertyUseContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setPropertyUseContextRequestRead(buf, order)
+		},
+	}
+}
+
 // SetSelectionCreateContextCookie is a cookie used only for SetSelectionCreateContext requests.
 type SetSelectionCreateContextCookie struct {
 	*xgb.Cookie
@@ -2101,12 +3049,26 @@ func (cook SetSelectionCreateContextCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetSelectionCreateContextCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetSelectionCreateContext
 // setSelectionCreateContextRequest writes a SetSelectionCreateContext request to a byte slice.
 func setSelectionCreateContextRequest(c *xgb.Conn, ContextLen uint32, Context string) []byte {
+	return AppendSetSelectionCreateContextRequest(nil, c, ContextLen, Context)
+}
+
+// AppendSetSelectionCreateContextRequest appends a SetSelectionCreateContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetSelectionCreateContextRequest(buf []byte, c *xgb.Conn, ContextLen uint32, Context string) []byte {
 	size := xgb.Pad((8 + xgb.Pad((int(ContextLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -2116,10 +3078,10 @@ func setSelectionCreateContextRequest(c *xgb.Conn, ContextLen uint32, Context st
 	buf[b] = 15 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], ContextLen)
+	order.Put32(buf[b:], ContextLen)
 	b += 4
 
 	copy(buf[b:], Context[:ContextLen])
@@ -2128,6 +3090,39 @@ func setSelectionCreateContextRequest(c *xgb.Conn, ContextLen uint32, Context st
 	return buf
 }
 
+// SetSelectionCreateContextRequest represents the data of a SetSelectionCreateContext request, as it is read when tracing a connection.
+type SetSelectionCreateContextRequest struct {
+	ContextLen uint32
+	Context    string // size: xgb.Pad((int(ContextLen) * 1))
+}
+
+// setSelectionCreateContextRequestRead reads a byte slice in the byte order 'order' into a SetSelectionCreateContextRequest value.
+func setSelectionCreateContextRequestRead(buf []byte, order xgb.ByteOrder) *SetSelectionCreateContextRequest {
+	v := new(SetSelectionCreateContextRequest)
+	b := 4 // skip request header
+
+	v.ContextLen = order.Get32(buf[b:])
+	b += 4
+
+	{
+		byteString := make([]byte, v.ContextLen)
+		copy(byteString[:v.ContextLen], buf[b:])
+		v.Context = string(byteString)
+		b += int(v.ContextLen)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][15] = xgb.RequestInfo{
+		Name: "SetSelectionCreateContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setSelectionCreateContextRequestRead(buf, order)
+		},
+	}
+}
+
 // SetSelectionUseContextCookie is a cookie used only for SetSelectionUseContext requests.
 type SetSelectionUseContextCookie struct {
 	*xgb.Cookie
@@ -2165,12 +3160,26 @@ func (cook SetSelectionUseContextCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetSelectionUseContextCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetSelectionUseContext
 // setSelectionUseContextRequest writes a SetSelectionUseContext request to a byte slice.
 func setSelectionUseContextRequest(c *xgb.Conn, ContextLen uint32, Context string) []byte {
+	return AppendSetSelectionUseContextRequest(nil, c, ContextLen, Context)
+}
+
+// AppendSetSelectionUseContextRequest appends a SetSelectionUseContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetSelectionUseContextRequest(buf []byte, c *xgb.Conn, ContextLen uint32, Context string) []byte {
 	size := xgb.Pad((8 + xgb.Pad((int(ContextLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -2180,10 +3189,10 @@ func setSelectionUseContextRequest(c *xgb.Conn, ContextLen uint32, Context strin
 	buf[b] = 17 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], ContextLen)
+	order.Put32(buf[b:], ContextLen)
 	b += 4
 
 	copy(buf[b:], Context[:ContextLen])
@@ -2192,6 +3201,39 @@ func setSelectionUseContextRequest(c *xgb.Conn, ContextLen uint32, Context strin
 	return buf
 }
 
+// SetSelectionUseContextRequest represents the data of a SetSelectionUseContext request, as it is read when tracing a connection.
+type SetSelectionUseContextRequest struct {
+	ContextLen uint32
+	Context    string // size: xgb.Pad((int(ContextLen) * 1))
+}
+
+// setSelectionUseContextRequestRead reads a byte slice in the byte order 'order' into a SetSelectionUseContextRequest value.
+func setSelectionUseContextRequestRead(buf []byte, order xgb.ByteOrder) *SetSelectionUseContextRequest {
+	v := new(SetSelectionUseContextRequest)
+	b := 4 // skip request header
+
+	v.ContextLen = order.Get32(buf[b:])
+	b += 4
+
+	{
+		byteString := make([]byte, v.ContextLen)
+		copy(byteString[:v.ContextLen], buf[b:])
+		v.Context = string(byteString)
+		b += int(v.ContextLen)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][17] = xgb.RequestInfo{
+		Name: "SetSelectionUseContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setSelectionUseContextRequestRead(buf, order)
+		},
+	}
+}
+
 // SetWindowCreateContextCookie is a cookie used only for SetWindowCreateContext requests.
 type SetWindowCreateContextCookie struct {
 	*xgb.Cookie
@@ -2229,12 +3271,26 @@ func (cook SetWindowCreateContextCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetWindowCreateContextCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetWindowCreateContext
 // setWindowCreateContextRequest writes a SetWindowCreateContext request to a byte slice.
 func setWindowCreateContextRequest(c *xgb.Conn, ContextLen uint32, Context string) []byte {
+	return AppendSetWindowCreateContextRequest(nil, c, ContextLen, Context)
+}
+
+// AppendSetWindowCreateContextRequest appends a SetWindowCreateContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetWindowCreateContextRequest(buf []byte, c *xgb.Conn, ContextLen uint32, Context string) []byte {
 	size := xgb.Pad((8 + xgb.Pad((int(ContextLen) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["SELinux"]
@@ -2244,10 +3300,10 @@ func setWindowCreateContextRequest(c *xgb.Conn, ContextLen uint32, Context strin
 	buf[b] = 5 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], ContextLen)
+	order.Put32(buf[b:], ContextLen)
 	b += 4
 
 	copy(buf[b:], Context[:ContextLen])
@@ -2255,3 +3311,36 @@ func setWindowCreateContextRequest(c *xgb.Conn, ContextLen uint32, Context strin
 
 	return buf
 }
+
+// SetWindowCreateContextRequest represents the data of a SetWindowCreateContext request, as it is read when tracing a connection.
+type SetWindowCreateContextRequest struct {
+	ContextLen uint32
+	Context    string // size: xgb.Pad((int(ContextLen) * 1))
+}
+
+// setWindowCreateContextRequestRead reads a byte slice in the byte order 'order' into a SetWindowCreateContextRequest value.
+func setWindowCreateContextRequestRead(buf []byte, order xgb.ByteOrder) *SetWindowCreateContextRequest {
+	v := new(SetWindowCreateContextRequest)
+	b := 4 // skip request header
+
+	v.ContextLen = order.Get32(buf[b:])
+	b += 4
+
+	{
+		byteString := make([]byte, v.ContextLen)
+		copy(byteString[:v.ContextLen], buf[b:])
+		v.Context = string(byteString)
+		b += int(v.ContextLen)
+	}
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["SELinux"][5] = xgb.RequestInfo{
+		Name: "SetWindowCreateContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setWindowCreateContextRequestRead(buf, order)
+		},
+	}
+}
@@ -4,6 +4,8 @@ package xtest
 // This file is automatically generated from xtest.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xproto"
@@ -22,18 +24,16 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["XTEST"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["XTEST"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["XTEST"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["XTEST"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["XTEST"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "XTEST", xgb.ExtRequestInfos["XTEST"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["XTEST"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["XTEST"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["XTEST"] = make(map[int]xgb.RequestInfo)
 }
 
 const (
@@ -65,6 +65,8 @@ const (
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // CompareCursorCookie is a cookie used only for CompareCursor requests.
 type CompareCursorCookie struct {
 	*xgb.Cookie
@@ -112,11 +114,23 @@ func (cook CompareCursorCookie) Reply() (*CompareCursorReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return compareCursorReply(buf), nil
+	return compareCursorReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a CompareCursor request arrives.
+func (cook CompareCursorCookie) ReplyContext(ctx context.Context) (*CompareCursorReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return compareCursorReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// compareCursorReply reads a byte slice into a CompareCursorReply value.
-func compareCursorReply(buf []byte) *CompareCursorReply {
+// compareCursorReply reads a byte slice in the byte order 'order' into a CompareCursorReply value.
+func compareCursorReply(buf []byte, order xgb.ByteOrder) *CompareCursorReply {
 	v := new(CompareCursorReply)
 	b := 1 // skip reply determinant
 
@@ -127,10 +141,10 @@ func compareCursorReply(buf []byte) *CompareCursorReply {
 	}
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	return v
@@ -139,9 +153,18 @@ func compareCursorReply(buf []byte) *CompareCursorReply {
 // Write request to wire for CompareCursor
 // compareCursorRequest writes a CompareCursor request to a byte slice.
 func compareCursorRequest(c *xgb.Conn, Window xproto.Window, Cursor xproto.Cursor) []byte {
+	return AppendCompareCursorRequest(nil, c, Window, Cursor)
+}
+
+// AppendCompareCursorRequest appends a CompareCursor request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCompareCursorRequest(buf []byte, c *xgb.Conn, Window xproto.Window, Cursor xproto.Cursor) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XTEST"]
@@ -151,18 +174,50 @@ func compareCursorRequest(c *xgb.Conn, Window xproto.Window, Cursor xproto.Curso
 	buf[b] = 1 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Cursor))
+	order.Put32(buf[b:], uint32(Cursor))
 	b += 4
 
 	return buf
 }
 
+// CompareCursorRequest represents the data of a CompareCursor request, as it is read when tracing a connection.
+type CompareCursorRequest struct {
+	Window xproto.Window
+	Cursor xproto.Cursor
+}
+
+// compareCursorRequestRead reads a byte slice in the byte order 'order' into a CompareCursorRequest value.
+func compareCursorRequestRead(buf []byte, order xgb.ByteOrder) *CompareCursorRequest {
+	v := new(CompareCursorRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	v.Cursor = xproto.Cursor(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XTEST"][1] = xgb.RequestInfo{
+		Name: "CompareCursor",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return compareCursorRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return compareCursorReply(buf, order)
+		},
+	}
+}
+
 // FakeInputCookie is a cookie used only for FakeInput requests.
 type FakeInputCookie struct {
 	*xgb.Cookie
@@ -200,12 +255,26 @@ func (cook FakeInputCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook FakeInputCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for FakeInput
 // fakeInputRequest writes a FakeInput request to a byte slice.
 func fakeInputRequest(c *xgb.Conn, Type byte, Detail byte, Time uint32, Root xproto.Window, RootX int16, RootY int16, Deviceid byte) []byte {
+	return AppendFakeInputRequest(nil, c, Type, Detail, Time, Root, RootX, RootY, Deviceid)
+}
+
+// AppendFakeInputRequest appends a FakeInput request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendFakeInputRequest(buf []byte, c *xgb.Conn, Type byte, Detail byte, Time uint32, Root xproto.Window, RootX int16, RootY int16, Deviceid byte) []byte {
 	size := 36
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XTEST"]
@@ -215,7 +284,7 @@ func fakeInputRequest(c *xgb.Conn, Type byte, Detail byte, Time uint32, Root xpr
 	buf[b] = 2 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	buf[b] = Type
@@ -226,18 +295,18 @@ func fakeInputRequest(c *xgb.Conn, Type byte, Detail byte, Time uint32, Root xpr
 
 	b += 2 // padding
 
-	xgb.Put32(buf[b:], Time)
+	order.Put32(buf[b:], Time)
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Root))
+	order.Put32(buf[b:], uint32(Root))
 	b += 4
 
 	b += 8 // padding
 
-	xgb.Put16(buf[b:], uint16(RootX))
+	order.Put16(buf[b:], uint16(RootX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(RootY))
+	order.Put16(buf[b:], uint16(RootY))
 	b += 2
 
 	b += 7 // padding
@@ -248,6 +317,64 @@ func fakeInputRequest(c *xgb.Conn, Type byte, Detail byte, Time uint32, Root xpr
 	return buf
 }
 
+// FakeInputRequest represents the data of a FakeInput request, as it is read when tracing a connection.
+type FakeInputRequest struct {
+	Type   byte
+	Detail byte
+	// padding: 2 bytes
+	Time uint32
+	Root xproto.Window
+	// padding: 8 bytes
+	RootX int16
+	RootY int16
+	// padding: 7 bytes
+	Deviceid byte
+}
+
+// fakeInputRequestRead reads a byte slice in the byte order 'order' into a FakeInputRequest value.
+func fakeInputRequestRead(buf []byte, order xgb.ByteOrder) *FakeInputRequest {
+	v := new(FakeInputRequest)
+	b := 4 // skip request header
+
+	v.Type = buf[b]
+	b += 1
+
+	v.Detail = buf[b]
+	b += 1
+
+	b += 2 // padding
+
+	v.Time = order.Get32(buf[b:])
+	b += 4
+
+	v.Root = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	b += 8 // padding
+
+	v.RootX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.RootY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	b += 7 // padding
+
+	v.Deviceid = buf[b]
+	b += 1
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XTEST"][2] = xgb.RequestInfo{
+		Name: "FakeInput",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return fakeInputRequestRead(buf, order)
+		},
+	}
+}
+
 // GetVersionCookie is a cookie used only for GetVersion requests.
 type GetVersionCookie struct {
 	*xgb.Cookie
@@ -296,24 +423,36 @@ func (cook GetVersionCookie) Reply() (*GetVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getVersionReply(buf), nil
+	return getVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetVersion request arrives.
+func (cook GetVersionCookie) ReplyContext(ctx context.Context) (*GetVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getVersionReply reads a byte slice into a GetVersionReply value.
-func getVersionReply(buf []byte) *GetVersionReply {
+// getVersionReply reads a byte slice in the byte order 'order' into a GetVersionReply value.
+func getVersionReply(buf []byte, order xgb.ByteOrder) *GetVersionReply {
 	v := new(GetVersionReply)
 	b := 1 // skip reply determinant
 
 	v.MajorVersion = buf[b]
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.MinorVersion = xgb.Get16(buf[b:])
+	v.MinorVersion = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -322,9 +461,18 @@ func getVersionReply(buf []byte) *GetVersionReply {
 // Write request to wire for GetVersion
 // getVersionRequest writes a GetVersion request to a byte slice.
 func getVersionRequest(c *xgb.Conn, MajorVersion byte, MinorVersion uint16) []byte {
+	return AppendGetVersionRequest(nil, c, MajorVersion, MinorVersion)
+}
+
+// AppendGetVersionRequest appends a GetVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetVersionRequest(buf []byte, c *xgb.Conn, MajorVersion byte, MinorVersion uint16) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XTEST"]
@@ -334,7 +482,7 @@ func getVersionRequest(c *xgb.Conn, MajorVersion byte, MinorVersion uint16) []by
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	buf[b] = MajorVersion
@@ -342,12 +490,47 @@ func getVersionRequest(c *xgb.Conn, MajorVersion byte, MinorVersion uint16) []by
 
 	b += 1 // padding
 
-	xgb.Put16(buf[b:], MinorVersion)
+	order.Put16(buf[b:], MinorVersion)
 	b += 2
 
 	return buf
 }
 
+// GetVersionRequest represents the data of a GetVersion request, as it is read when tracing a connection.
+type GetVersionRequest struct {
+	MajorVersion byte
+	// padding: 1 bytes
+	MinorVersion uint16
+}
+
+// getVersionRequestRead reads a byte slice in the byte order 'order' into a GetVersionRequest value.
+func getVersionRequestRead(buf []byte, order xgb.ByteOrder) *GetVersionRequest {
+	v := new(GetVersionRequest)
+	b := 4 // skip request header
+
+	v.MajorVersion = buf[b]
+	b += 1
+
+	b += 1 // padding
+
+	v.MinorVersion = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XTEST"][0] = xgb.RequestInfo{
+		Name: "GetVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getVersionReply(buf, order)
+		},
+	}
+}
+
 // GrabControlCookie is a cookie used only for GrabControl requests.
 type GrabControlCookie struct {
 	*xgb.Cookie
@@ -385,12 +568,26 @@ func (cook GrabControlCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook GrabControlCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for GrabControl
 // grabControlRequest writes a GrabControl request to a byte slice.
 func grabControlRequest(c *xgb.Conn, Impervious bool) []byte {
+	return AppendGrabControlRequest(nil, c, Impervious)
+}
+
+// AppendGrabControlRequest appends a GrabControl request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGrabControlRequest(buf []byte, c *xgb.Conn, Impervious bool) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XTEST"]
@@ -400,7 +597,7 @@ func grabControlRequest(c *xgb.Conn, Impervious bool) []byte {
 	buf[b] = 3 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	if Impervious {
@@ -414,3 +611,35 @@ func grabControlRequest(c *xgb.Conn, Impervious bool) []byte {
 
 	return buf
 }
+
+// GrabControlRequest represents the data of a GrabControl request, as it is read when tracing a connection.
+type GrabControlRequest struct {
+	Impervious bool
+	// padding: 3 bytes
+}
+
+// grabControlRequestRead reads a byte slice in the byte order 'order' into a GrabControlRequest value.
+func grabControlRequestRead(buf []byte, order xgb.ByteOrder) *GrabControlRequest {
+	v := new(GrabControlRequest)
+	b := 4 // skip request header
+
+	if buf[b] == 1 {
+		v.Impervious = true
+	} else {
+		v.Impervious = false
+	}
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XTEST"][3] = xgb.RequestInfo{
+		Name: "GrabControl",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return grabControlRequestRead(buf, order)
+		},
+	}
+}
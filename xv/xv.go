@@ -4,6 +4,8 @@ package xv
 // This file is automatically generated from xv.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/shm"
@@ -23,18 +25,16 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["XVideo"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["XVideo"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["XVideo"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["XVideo"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["XVideo"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "XVideo", xgb.ExtRequestInfos["XVideo"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["XVideo"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["XVideo"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["XVideo"] = make(map[int]xgb.RequestInfo)
 }
 
 type AdaptorInfo struct {
@@ -42,7 +42,7 @@ type AdaptorInfo struct {
 	NameSize   uint16
 	NumPorts   uint16
 	NumFormats uint16
-	Type       byte
+	Type       Type
 	// padding: 1 bytes
 	Name string // size: xgb.Pad((int(NameSize) * 1))
 	// padding: 0 bytes
@@ -50,22 +50,22 @@ type AdaptorInfo struct {
 }
 
 // AdaptorInfoRead reads a byte slice into a AdaptorInfo value.
-func AdaptorInfoRead(buf []byte, v *AdaptorInfo) int {
+func AdaptorInfoRead(buf []byte, order xgb.ByteOrder, v *AdaptorInfo) int {
 	b := 0
 
-	v.BaseId = Port(xgb.Get32(buf[b:]))
+	v.BaseId = Port(order.Get32(buf[b:]))
 	b += 4
 
-	v.NameSize = xgb.Get16(buf[b:])
+	v.NameSize = order.Get16(buf[b:])
 	b += 2
 
-	v.NumPorts = xgb.Get16(buf[b:])
+	v.NumPorts = order.Get16(buf[b:])
 	b += 2
 
-	v.NumFormats = xgb.Get16(buf[b:])
+	v.NumFormats = order.Get16(buf[b:])
 	b += 2
 
-	v.Type = buf[b]
+	v.Type = Type(buf[b])
 	b += 1
 
 	b += 1 // padding
@@ -80,39 +80,39 @@ func AdaptorInfoRead(buf []byte, v *AdaptorInfo) int {
 	b += 0 // padding
 
 	v.Formats = make([]Format, v.NumFormats)
-	b += FormatReadList(buf[b:], v.Formats)
+	b += FormatReadList(buf[b:], order, v.Formats)
 
 	return b
 }
 
 // AdaptorInfoReadList reads a byte slice into a list of AdaptorInfo values.
-func AdaptorInfoReadList(buf []byte, dest []AdaptorInfo) int {
+func AdaptorInfoReadList(buf []byte, order xgb.ByteOrder, dest []AdaptorInfo) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = AdaptorInfo{}
-		b += AdaptorInfoRead(buf[b:], &dest[i])
+		b += AdaptorInfoRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a AdaptorInfo value to a byte slice.
-func (v AdaptorInfo) Bytes() []byte {
+// Bytes writes a AdaptorInfo value to a byte slice, in the byte order 'order'.
+func (v AdaptorInfo) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, (((12 + xgb.Pad((int(v.NameSize) * 1))) + 0) + xgb.Pad((int(v.NumFormats) * 8))))
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.BaseId))
+	order.Put32(buf[b:], uint32(v.BaseId))
 	b += 4
 
-	xgb.Put16(buf[b:], v.NameSize)
+	order.Put16(buf[b:], v.NameSize)
 	b += 2
 
-	xgb.Put16(buf[b:], v.NumPorts)
+	order.Put16(buf[b:], v.NumPorts)
 	b += 2
 
-	xgb.Put16(buf[b:], v.NumFormats)
+	order.Put16(buf[b:], v.NumFormats)
 	b += 2
 
-	buf[b] = v.Type
+	buf[b] = byte(v.Type)
 	b += 1
 
 	b += 1 // padding
@@ -122,17 +122,17 @@ func (v AdaptorInfo) Bytes() []byte {
 
 	b += 0 // padding
 
-	b += FormatListBytes(buf[b:], v.Formats)
+	b += FormatListBytes(buf[b:], order, v.Formats)
 
 	return buf[:b]
 }
 
 // AdaptorInfoListBytes writes a list of AdaptorInfo values to a byte slice.
-func AdaptorInfoListBytes(buf []byte, list []AdaptorInfo) int {
+func AdaptorInfoListBytes(buf []byte, order xgb.ByteOrder, list []AdaptorInfo) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -148,13 +148,35 @@ func AdaptorInfoListSize(list []AdaptorInfo) int {
 	return size
 }
 
+type AttributeFlag uint32
+
 const (
-	AttributeFlagGettable = 1
-	AttributeFlagSettable = 2
+	AttributeFlagGettable AttributeFlag = 1
+	AttributeFlagSettable AttributeFlag = 2
 )
 
+// String returns the names of the AttributeFlag bits set in a value.
+func (v AttributeFlag) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 2)
+	if v&AttributeFlagGettable != 0 {
+		names = append(names, "Gettable")
+		v &^= AttributeFlagGettable
+	}
+	if v&AttributeFlagSettable != 0 {
+		names = append(names, "Settable")
+		v &^= AttributeFlagSettable
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", uint32(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
 type AttributeInfo struct {
-	Flags uint32
+	Flags AttributeFlag
 	Min   int32
 	Max   int32
 	Size  uint32
@@ -162,19 +184,19 @@ type AttributeInfo struct {
 }
 
 // AttributeInfoRead reads a byte slice into a AttributeInfo value.
-func AttributeInfoRead(buf []byte, v *AttributeInfo) int {
+func AttributeInfoRead(buf []byte, order xgb.ByteOrder, v *AttributeInfo) int {
 	b := 0
 
-	v.Flags = xgb.Get32(buf[b:])
+	v.Flags = AttributeFlag(order.Get32(buf[b:]))
 	b += 4
 
-	v.Min = int32(xgb.Get32(buf[b:]))
+	v.Min = int32(order.Get32(buf[b:]))
 	b += 4
 
-	v.Max = int32(xgb.Get32(buf[b:]))
+	v.Max = int32(order.Get32(buf[b:]))
 	b += 4
 
-	v.Size = xgb.Get32(buf[b:])
+	v.Size = order.Get32(buf[b:])
 	b += 4
 
 	{
@@ -188,30 +210,30 @@ func AttributeInfoRead(buf []byte, v *AttributeInfo) int {
 }
 
 // AttributeInfoReadList reads a byte slice into a list of AttributeInfo values.
-func AttributeInfoReadList(buf []byte, dest []AttributeInfo) int {
+func AttributeInfoReadList(buf []byte, order xgb.ByteOrder, dest []AttributeInfo) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = AttributeInfo{}
-		b += AttributeInfoRead(buf[b:], &dest[i])
+		b += AttributeInfoRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a AttributeInfo value to a byte slice.
-func (v AttributeInfo) Bytes() []byte {
+// Bytes writes a AttributeInfo value to a byte slice, in the byte order 'order'.
+func (v AttributeInfo) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, (16 + xgb.Pad((int(v.Size) * 1))))
 	b := 0
 
-	xgb.Put32(buf[b:], v.Flags)
+	order.Put32(buf[b:], uint32(v.Flags))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Min))
+	order.Put32(buf[b:], uint32(v.Min))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Max))
+	order.Put32(buf[b:], uint32(v.Max))
 	b += 4
 
-	xgb.Put32(buf[b:], v.Size)
+	order.Put32(buf[b:], v.Size)
 	b += 4
 
 	copy(buf[b:], v.Name[:v.Size])
@@ -221,11 +243,11 @@ func (v AttributeInfo) Bytes() []byte {
 }
 
 // AttributeInfoListBytes writes a list of AttributeInfo values to a byte slice.
-func AttributeInfoListBytes(buf []byte, list []AttributeInfo) int {
+func AttributeInfoListBytes(buf []byte, order xgb.ByteOrder, list []AttributeInfo) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -249,15 +271,15 @@ type BadControlError struct {
 	NiceName string
 }
 
-// BadControlErrorNew constructs a BadControlError value that implements xgb.Error from a byte slice.
-func BadControlErrorNew(buf []byte) xgb.Error {
+// BadControlErrorNew constructs a BadControlError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadControlErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := BadControlError{}
 	v.NiceName = "BadControl"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -295,15 +317,15 @@ type BadEncodingError struct {
 	NiceName string
 }
 
-// BadEncodingErrorNew constructs a BadEncodingError value that implements xgb.Error from a byte slice.
-func BadEncodingErrorNew(buf []byte) xgb.Error {
+// BadEncodingErrorNew constructs a BadEncodingError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadEncodingErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := BadEncodingError{}
 	v.NiceName = "BadEncoding"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -341,15 +363,15 @@ type BadPortError struct {
 	NiceName string
 }
 
-// BadPortErrorNew constructs a BadPortError value that implements xgb.Error from a byte slice.
-func BadPortErrorNew(buf []byte) xgb.Error {
+// BadPortErrorNew constructs a BadPortError value that implements xgb.Error from a byte slice in the byte order 'order'.
+func BadPortErrorNew(buf []byte, order xgb.ByteOrder) xgb.Error {
 	v := BadPortError{}
 	v.NiceName = "BadPort"
 
 	b := 1 // skip error determinant
 	b += 1 // don't read error number
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -400,25 +422,25 @@ type EncodingInfo struct {
 }
 
 // EncodingInfoRead reads a byte slice into a EncodingInfo value.
-func EncodingInfoRead(buf []byte, v *EncodingInfo) int {
+func EncodingInfoRead(buf []byte, order xgb.ByteOrder, v *EncodingInfo) int {
 	b := 0
 
-	v.Encoding = Encoding(xgb.Get32(buf[b:]))
+	v.Encoding = Encoding(order.Get32(buf[b:]))
 	b += 4
 
-	v.NameSize = xgb.Get16(buf[b:])
+	v.NameSize = order.Get16(buf[b:])
 	b += 2
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
 	b += 2 // padding
 
 	v.Rate = Rational{}
-	b += RationalRead(buf[b:], &v.Rate)
+	b += RationalRead(buf[b:], order, &v.Rate)
 
 	{
 		byteString := make([]byte, v.NameSize)
@@ -431,36 +453,36 @@ func EncodingInfoRead(buf []byte, v *EncodingInfo) int {
 }
 
 // EncodingInfoReadList reads a byte slice into a list of EncodingInfo values.
-func EncodingInfoReadList(buf []byte, dest []EncodingInfo) int {
+func EncodingInfoReadList(buf []byte, order xgb.ByteOrder, dest []EncodingInfo) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = EncodingInfo{}
-		b += EncodingInfoRead(buf[b:], &dest[i])
+		b += EncodingInfoRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a EncodingInfo value to a byte slice.
-func (v EncodingInfo) Bytes() []byte {
+// Bytes writes a EncodingInfo value to a byte slice, in the byte order 'order'.
+func (v EncodingInfo) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, (20 + xgb.Pad((int(v.NameSize) * 1))))
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.Encoding))
+	order.Put32(buf[b:], uint32(v.Encoding))
 	b += 4
 
-	xgb.Put16(buf[b:], v.NameSize)
+	order.Put16(buf[b:], v.NameSize)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Width)
+	order.Put16(buf[b:], v.Width)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Height)
+	order.Put16(buf[b:], v.Height)
 	b += 2
 
 	b += 2 // padding
 
 	{
-		structBytes := v.Rate.Bytes()
+		structBytes := v.Rate.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -472,11 +494,11 @@ func (v EncodingInfo) Bytes() []byte {
 }
 
 // EncodingInfoListBytes writes a list of EncodingInfo values to a byte slice.
-func EncodingInfoListBytes(buf []byte, list []EncodingInfo) int {
+func EncodingInfoListBytes(buf []byte, order xgb.ByteOrder, list []EncodingInfo) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -499,10 +521,10 @@ type Format struct {
 }
 
 // FormatRead reads a byte slice into a Format value.
-func FormatRead(buf []byte, v *Format) int {
+func FormatRead(buf []byte, order xgb.ByteOrder, v *Format) int {
 	b := 0
 
-	v.Visual = xproto.Visualid(xgb.Get32(buf[b:]))
+	v.Visual = xproto.Visualid(order.Get32(buf[b:]))
 	b += 4
 
 	v.Depth = buf[b]
@@ -514,21 +536,21 @@ func FormatRead(buf []byte, v *Format) int {
 }
 
 // FormatReadList reads a byte slice into a list of Format values.
-func FormatReadList(buf []byte, dest []Format) int {
+func FormatReadList(buf []byte, order xgb.ByteOrder, dest []Format) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Format{}
-		b += FormatRead(buf[b:], &dest[i])
+		b += FormatRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Format value to a byte slice.
-func (v Format) Bytes() []byte {
+// Bytes writes a Format value to a byte slice, in the byte order 'order'.
+func (v Format) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 8)
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.Visual))
+	order.Put32(buf[b:], uint32(v.Visual))
 	b += 4
 
 	buf[b] = v.Depth
@@ -540,26 +562,47 @@ func (v Format) Bytes() []byte {
 }
 
 // FormatListBytes writes a list of Format values to a byte slice.
-func FormatListBytes(buf []byte, list []Format) int {
+func FormatListBytes(buf []byte, order xgb.ByteOrder, list []Format) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 	return xgb.Pad(b)
 }
 
+type GrabPortStatus byte
+
 const (
-	GrabPortStatusSuccess        = 0
-	GrabPortStatusBadExtension   = 1
-	GrabPortStatusAlreadyGrabbed = 2
-	GrabPortStatusInvalidTime    = 3
-	GrabPortStatusBadReply       = 4
-	GrabPortStatusBadAlloc       = 5
+	GrabPortStatusSuccess        GrabPortStatus = 0
+	GrabPortStatusBadExtension   GrabPortStatus = 1
+	GrabPortStatusAlreadyGrabbed GrabPortStatus = 2
+	GrabPortStatusInvalidTime    GrabPortStatus = 3
+	GrabPortStatusBadReply       GrabPortStatus = 4
+	GrabPortStatusBadAlloc       GrabPortStatus = 5
 )
 
+// String returns the name of the GrabPortStatus item with this value.
+func (v GrabPortStatus) String() string {
+	switch v {
+	case GrabPortStatusSuccess:
+		return "Success"
+	case GrabPortStatusBadExtension:
+		return "BadExtension"
+	case GrabPortStatusAlreadyGrabbed:
+		return "AlreadyGrabbed"
+	case GrabPortStatusInvalidTime:
+		return "InvalidTime"
+	case GrabPortStatusBadReply:
+		return "BadReply"
+	case GrabPortStatusBadAlloc:
+		return "BadAlloc"
+	}
+	return xgb.Sprintf("GrabPortStatus(%d)", byte(v))
+}
+
 type Image struct {
 	Id        uint32
 	Width     uint16
@@ -573,27 +616,27 @@ type Image struct {
 }
 
 // ImageRead reads a byte slice into a Image value.
-func ImageRead(buf []byte, v *Image) int {
+func ImageRead(buf []byte, order xgb.ByteOrder, v *Image) int {
 	b := 0
 
-	v.Id = xgb.Get32(buf[b:])
+	v.Id = order.Get32(buf[b:])
 	b += 4
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
-	v.DataSize = xgb.Get32(buf[b:])
+	v.DataSize = order.Get32(buf[b:])
 	b += 4
 
-	v.NumPlanes = xgb.Get32(buf[b:])
+	v.NumPlanes = order.Get32(buf[b:])
 	b += 4
 
 	v.Pitches = make([]uint32, v.NumPlanes)
 	for i := 0; i < int(v.NumPlanes); i++ {
-		v.Pitches[i] = xgb.Get32(buf[b:])
+		v.Pitches[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -601,7 +644,7 @@ func ImageRead(buf []byte, v *Image) int {
 
 	v.Offsets = make([]uint32, v.NumPlanes)
 	for i := 0; i < int(v.NumPlanes); i++ {
-		v.Offsets[i] = xgb.Get32(buf[b:])
+		v.Offsets[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -613,44 +656,44 @@ func ImageRead(buf []byte, v *Image) int {
 }
 
 // ImageReadList reads a byte slice into a list of Image values.
-func ImageReadList(buf []byte, dest []Image) int {
+func ImageReadList(buf []byte, order xgb.ByteOrder, dest []Image) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Image{}
-		b += ImageRead(buf[b:], &dest[i])
+		b += ImageRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Image value to a byte slice.
-func (v Image) Bytes() []byte {
+// Bytes writes a Image value to a byte slice, in the byte order 'order'.
+func (v Image) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, ((((16 + xgb.Pad((int(v.NumPlanes) * 4))) + 4) + xgb.Pad((int(v.NumPlanes) * 4))) + xgb.Pad((int(v.DataSize) * 1))))
 	b := 0
 
-	xgb.Put32(buf[b:], v.Id)
+	order.Put32(buf[b:], v.Id)
 	b += 4
 
-	xgb.Put16(buf[b:], v.Width)
+	order.Put16(buf[b:], v.Width)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Height)
+	order.Put16(buf[b:], v.Height)
 	b += 2
 
-	xgb.Put32(buf[b:], v.DataSize)
+	order.Put32(buf[b:], v.DataSize)
 	b += 4
 
-	xgb.Put32(buf[b:], v.NumPlanes)
+	order.Put32(buf[b:], v.NumPlanes)
 	b += 4
 
 	for i := 0; i < int(v.NumPlanes); i++ {
-		xgb.Put32(buf[b:], v.Pitches[i])
+		order.Put32(buf[b:], v.Pitches[i])
 		b += 4
 	}
 
 	b = (b + 3) & ^3 // alignment gap
 
 	for i := 0; i < int(v.NumPlanes); i++ {
-		xgb.Put32(buf[b:], v.Offsets[i])
+		order.Put32(buf[b:], v.Offsets[i])
 		b += 4
 	}
 
@@ -661,11 +704,11 @@ func (v Image) Bytes() []byte {
 }
 
 // ImageListBytes writes a list of Image values to a byte slice.
-func ImageListBytes(buf []byte, list []Image) int {
+func ImageListBytes(buf []byte, order xgb.ByteOrder, list []Image) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -683,8 +726,8 @@ func ImageListSize(list []Image) int {
 
 type ImageFormatInfo struct {
 	Id        uint32
-	Type      byte
-	ByteOrder byte
+	Type      ImageFormatInfoType
+	ByteOrder xproto.ImageOrder
 	// padding: 2 bytes
 	Guid      []byte // size: 16
 	Bpp       byte
@@ -695,7 +738,7 @@ type ImageFormatInfo struct {
 	RedMask   uint32
 	GreenMask uint32
 	BlueMask  uint32
-	Format    byte
+	Format    ImageFormatInfoFormat
 	// padding: 3 bytes
 	YSampleBits    uint32
 	USampleBits    uint32
@@ -707,21 +750,21 @@ type ImageFormatInfo struct {
 	VvertUPeriod   uint32
 	VvertVPeriod   uint32
 	VcompOrder     []byte // size: 32
-	VscanlineOrder byte
+	VscanlineOrder ScanlineOrder
 	// padding: 11 bytes
 }
 
 // ImageFormatInfoRead reads a byte slice into a ImageFormatInfo value.
-func ImageFormatInfoRead(buf []byte, v *ImageFormatInfo) int {
+func ImageFormatInfoRead(buf []byte, order xgb.ByteOrder, v *ImageFormatInfo) int {
 	b := 0
 
-	v.Id = xgb.Get32(buf[b:])
+	v.Id = order.Get32(buf[b:])
 	b += 4
 
-	v.Type = buf[b]
+	v.Type = ImageFormatInfoType(buf[b])
 	b += 1
 
-	v.ByteOrder = buf[b]
+	v.ByteOrder = xproto.ImageOrder(buf[b])
 	b += 1
 
 	b += 2 // padding
@@ -743,52 +786,52 @@ func ImageFormatInfoRead(buf []byte, v *ImageFormatInfo) int {
 
 	b += 3 // padding
 
-	v.RedMask = xgb.Get32(buf[b:])
+	v.RedMask = order.Get32(buf[b:])
 	b += 4
 
-	v.GreenMask = xgb.Get32(buf[b:])
+	v.GreenMask = order.Get32(buf[b:])
 	b += 4
 
-	v.BlueMask = xgb.Get32(buf[b:])
+	v.BlueMask = order.Get32(buf[b:])
 	b += 4
 
-	v.Format = buf[b]
+	v.Format = ImageFormatInfoFormat(buf[b])
 	b += 1
 
 	b += 3 // padding
 
-	v.YSampleBits = xgb.Get32(buf[b:])
+	v.YSampleBits = order.Get32(buf[b:])
 	b += 4
 
-	v.USampleBits = xgb.Get32(buf[b:])
+	v.USampleBits = order.Get32(buf[b:])
 	b += 4
 
-	v.VSampleBits = xgb.Get32(buf[b:])
+	v.VSampleBits = order.Get32(buf[b:])
 	b += 4
 
-	v.VhorzYPeriod = xgb.Get32(buf[b:])
+	v.VhorzYPeriod = order.Get32(buf[b:])
 	b += 4
 
-	v.VhorzUPeriod = xgb.Get32(buf[b:])
+	v.VhorzUPeriod = order.Get32(buf[b:])
 	b += 4
 
-	v.VhorzVPeriod = xgb.Get32(buf[b:])
+	v.VhorzVPeriod = order.Get32(buf[b:])
 	b += 4
 
-	v.VvertYPeriod = xgb.Get32(buf[b:])
+	v.VvertYPeriod = order.Get32(buf[b:])
 	b += 4
 
-	v.VvertUPeriod = xgb.Get32(buf[b:])
+	v.VvertUPeriod = order.Get32(buf[b:])
 	b += 4
 
-	v.VvertVPeriod = xgb.Get32(buf[b:])
+	v.VvertVPeriod = order.Get32(buf[b:])
 	b += 4
 
 	v.VcompOrder = make([]byte, 32)
 	copy(v.VcompOrder[:32], buf[b:])
 	b += int(32)
 
-	v.VscanlineOrder = buf[b]
+	v.VscanlineOrder = ScanlineOrder(buf[b])
 	b += 1
 
 	b += 11 // padding
@@ -797,27 +840,27 @@ func ImageFormatInfoRead(buf []byte, v *ImageFormatInfo) int {
 }
 
 // ImageFormatInfoReadList reads a byte slice into a list of ImageFormatInfo values.
-func ImageFormatInfoReadList(buf []byte, dest []ImageFormatInfo) int {
+func ImageFormatInfoReadList(buf []byte, order xgb.ByteOrder, dest []ImageFormatInfo) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = ImageFormatInfo{}
-		b += ImageFormatInfoRead(buf[b:], &dest[i])
+		b += ImageFormatInfoRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a ImageFormatInfo value to a byte slice.
-func (v ImageFormatInfo) Bytes() []byte {
+// Bytes writes a ImageFormatInfo value to a byte slice, in the byte order 'order'.
+func (v ImageFormatInfo) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 128)
 	b := 0
 
-	xgb.Put32(buf[b:], v.Id)
+	order.Put32(buf[b:], v.Id)
 	b += 4
 
-	buf[b] = v.Type
+	buf[b] = byte(v.Type)
 	b += 1
 
-	buf[b] = v.ByteOrder
+	buf[b] = byte(v.ByteOrder)
 	b += 1
 
 	b += 2 // padding
@@ -838,51 +881,51 @@ func (v ImageFormatInfo) Bytes() []byte {
 
 	b += 3 // padding
 
-	xgb.Put32(buf[b:], v.RedMask)
+	order.Put32(buf[b:], v.RedMask)
 	b += 4
 
-	xgb.Put32(buf[b:], v.GreenMask)
+	order.Put32(buf[b:], v.GreenMask)
 	b += 4
 
-	xgb.Put32(buf[b:], v.BlueMask)
+	order.Put32(buf[b:], v.BlueMask)
 	b += 4
 
-	buf[b] = v.Format
+	buf[b] = byte(v.Format)
 	b += 1
 
 	b += 3 // padding
 
-	xgb.Put32(buf[b:], v.YSampleBits)
+	order.Put32(buf[b:], v.YSampleBits)
 	b += 4
 
-	xgb.Put32(buf[b:], v.USampleBits)
+	order.Put32(buf[b:], v.USampleBits)
 	b += 4
 
-	xgb.Put32(buf[b:], v.VSampleBits)
+	order.Put32(buf[b:], v.VSampleBits)
 	b += 4
 
-	xgb.Put32(buf[b:], v.VhorzYPeriod)
+	order.Put32(buf[b:], v.VhorzYPeriod)
 	b += 4
 
-	xgb.Put32(buf[b:], v.VhorzUPeriod)
+	order.Put32(buf[b:], v.VhorzUPeriod)
 	b += 4
 
-	xgb.Put32(buf[b:], v.VhorzVPeriod)
+	order.Put32(buf[b:], v.VhorzVPeriod)
 	b += 4
 
-	xgb.Put32(buf[b:], v.VvertYPeriod)
+	order.Put32(buf[b:], v.VvertYPeriod)
 	b += 4
 
-	xgb.Put32(buf[b:], v.VvertUPeriod)
+	order.Put32(buf[b:], v.VvertUPeriod)
 	b += 4
 
-	xgb.Put32(buf[b:], v.VvertVPeriod)
+	order.Put32(buf[b:], v.VvertVPeriod)
 	b += 4
 
 	copy(buf[b:], v.VcompOrder[:32])
 	b += int(32)
 
-	buf[b] = v.VscanlineOrder
+	buf[b] = byte(v.VscanlineOrder)
 	b += 1
 
 	b += 11 // padding
@@ -891,11 +934,11 @@ func (v ImageFormatInfo) Bytes() []byte {
 }
 
 // ImageFormatInfoListBytes writes a list of ImageFormatInfo values to a byte slice.
-func ImageFormatInfoListBytes(buf []byte, list []ImageFormatInfo) int {
+func ImageFormatInfoListBytes(buf []byte, order xgb.ByteOrder, list []ImageFormatInfo) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -911,16 +954,42 @@ func ImageFormatInfoListSize(list []ImageFormatInfo) int {
 	return size
 }
 
+type ImageFormatInfoFormat byte
+
 const (
-	ImageFormatInfoFormatPacked = 0
-	ImageFormatInfoFormatPlanar = 1
+	ImageFormatInfoFormatPacked ImageFormatInfoFormat = 0
+	ImageFormatInfoFormatPlanar ImageFormatInfoFormat = 1
 )
 
+// String returns the name of the ImageFormatInfoFormat item with this value.
+func (v ImageFormatInfoFormat) String() string {
+	switch v {
+	case ImageFormatInfoFormatPacked:
+		return "Packed"
+	case ImageFormatInfoFormatPlanar:
+		return "Planar"
+	}
+	return xgb.Sprintf("ImageFormatInfoFormat(%d)", byte(v))
+}
+
+type ImageFormatInfoType byte
+
 const (
-	ImageFormatInfoTypeRgb = 0
-	ImageFormatInfoTypeYuv = 1
+	ImageFormatInfoTypeRgb ImageFormatInfoType = 0
+	ImageFormatInfoTypeYuv ImageFormatInfoType = 1
 )
 
+// String returns the name of the ImageFormatInfoType item with this value.
+func (v ImageFormatInfoType) String() string {
+	switch v {
+	case ImageFormatInfoTypeRgb:
+		return "Rgb"
+	case ImageFormatInfoTypeYuv:
+		return "Yuv"
+	}
+	return xgb.Sprintf("ImageFormatInfoType(%d)", byte(v))
+}
+
 type Port uint32
 
 func NewPortId(c *xgb.Conn) (Port, error) {
@@ -943,33 +1012,33 @@ type PortNotifyEvent struct {
 	Value     int32
 }
 
-// PortNotifyEventNew constructs a PortNotifyEvent value that implements xgb.Event from a byte slice.
-func PortNotifyEventNew(buf []byte) xgb.Event {
+// PortNotifyEventNew constructs a PortNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func PortNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := PortNotifyEvent{}
 	b := 1 // don't read event number
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Time = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Time = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.Port = Port(xgb.Get32(buf[b:]))
+	v.Port = Port(order.Get32(buf[b:]))
 	b += 4
 
-	v.Attribute = xproto.Atom(xgb.Get32(buf[b:]))
+	v.Attribute = xproto.Atom(order.Get32(buf[b:]))
 	b += 4
 
-	v.Value = int32(xgb.Get32(buf[b:]))
+	v.Value = int32(order.Get32(buf[b:]))
 	b += 4
 
 	return v
 }
 
-// Bytes writes a PortNotifyEvent value to a byte slice.
-func (v PortNotifyEvent) Bytes() []byte {
+// Bytes writes a PortNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v PortNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -981,16 +1050,16 @@ func (v PortNotifyEvent) Bytes() []byte {
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Time))
+	order.Put32(buf[b:], uint32(v.Time))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Port))
+	order.Put32(buf[b:], uint32(v.Port))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Attribute))
+	order.Put32(buf[b:], uint32(v.Attribute))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Value))
+	order.Put32(buf[b:], uint32(v.Value))
 	b += 4
 
 	return buf
@@ -1024,103 +1093,150 @@ type Rational struct {
 }
 
 // RationalRead reads a byte slice into a Rational value.
-func RationalRead(buf []byte, v *Rational) int {
+func RationalRead(buf []byte, order xgb.ByteOrder, v *Rational) int {
 	b := 0
 
-	v.Numerator = int32(xgb.Get32(buf[b:]))
+	v.Numerator = int32(order.Get32(buf[b:]))
 	b += 4
 
-	v.Denominator = int32(xgb.Get32(buf[b:]))
+	v.Denominator = int32(order.Get32(buf[b:]))
 	b += 4
 
 	return b
 }
 
 // RationalReadList reads a byte slice into a list of Rational values.
-func RationalReadList(buf []byte, dest []Rational) int {
+func RationalReadList(buf []byte, order xgb.ByteOrder, dest []Rational) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = Rational{}
-		b += RationalRead(buf[b:], &dest[i])
+		b += RationalRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a Rational value to a byte slice.
-func (v Rational) Bytes() []byte {
+// Bytes writes a Rational value to a byte slice, in the byte order 'order'.
+func (v Rational) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 8)
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.Numerator))
+	order.Put32(buf[b:], uint32(v.Numerator))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Denominator))
+	order.Put32(buf[b:], uint32(v.Denominator))
 	b += 4
 
 	return buf[:b]
 }
 
 // RationalListBytes writes a list of Rational values to a byte slice.
-func RationalListBytes(buf []byte, list []Rational) int {
+func RationalListBytes(buf []byte, order xgb.ByteOrder, list []Rational) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
 	return xgb.Pad(b)
 }
 
+type ScanlineOrder byte
+
 const (
-	ScanlineOrderTopToBottom = 0
-	ScanlineOrderBottomToTop = 1
+	ScanlineOrderTopToBottom ScanlineOrder = 0
+	ScanlineOrderBottomToTop ScanlineOrder = 1
 )
 
+// String returns the name of the ScanlineOrder item with this value.
+func (v ScanlineOrder) String() string {
+	switch v {
+	case ScanlineOrderTopToBottom:
+		return "TopToBottom"
+	case ScanlineOrderBottomToTop:
+		return "BottomToTop"
+	}
+	return xgb.Sprintf("ScanlineOrder(%d)", byte(v))
+}
+
+type Type byte
+
 const (
-	TypeInputMask  = 1
-	TypeOutputMask = 2
-	TypeVideoMask  = 4
-	TypeStillMask  = 8
-	TypeImageMask  = 16
+	TypeInputMask  Type = 1
+	TypeOutputMask Type = 2
+	TypeVideoMask  Type = 4
+	TypeStillMask  Type = 8
+	TypeImageMask  Type = 16
 )
 
+// String returns the names of the Type bits set in a value.
+func (v Type) String() string {
+	if v == 0 {
+		return "0"
+	}
+	names := make([]string, 0, 5)
+	if v&TypeInputMask != 0 {
+		names = append(names, "InputMask")
+		v &^= TypeInputMask
+	}
+	if v&TypeOutputMask != 0 {
+		names = append(names, "OutputMask")
+		v &^= TypeOutputMask
+	}
+	if v&TypeVideoMask != 0 {
+		names = append(names, "VideoMask")
+		v &^= TypeVideoMask
+	}
+	if v&TypeStillMask != 0 {
+		names = append(names, "StillMask")
+		v &^= TypeStillMask
+	}
+	if v&TypeImageMask != 0 {
+		names = append(names, "ImageMask")
+		v &^= TypeImageMask
+	}
+	if v != 0 {
+		names = append(names, xgb.Sprintf("%#x", byte(v)))
+	}
+	return xgb.StringsJoin(names, "|")
+}
+
 // VideoNotify is the event number for a VideoNotifyEvent.
 const VideoNotify = 0
 
 type VideoNotifyEvent struct {
 	Sequence uint16
-	Reason   byte
+	Reason   VideoNotifyReason
 	Time     xproto.Timestamp
 	Drawable xproto.Drawable
 	Port     Port
 }
 
-// VideoNotifyEventNew constructs a VideoNotifyEvent value that implements xgb.Event from a byte slice.
-func VideoNotifyEventNew(buf []byte) xgb.Event {
+// VideoNotifyEventNew constructs a VideoNotifyEvent value that implements xgb.Event from a byte slice in the byte order 'order'.
+func VideoNotifyEventNew(buf []byte, order xgb.ByteOrder) xgb.Event {
 	v := VideoNotifyEvent{}
 	b := 1 // don't read event number
 
-	v.Reason = buf[b]
+	v.Reason = VideoNotifyReason(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Time = xproto.Timestamp(xgb.Get32(buf[b:]))
+	v.Time = xproto.Timestamp(order.Get32(buf[b:]))
 	b += 4
 
-	v.Drawable = xproto.Drawable(xgb.Get32(buf[b:]))
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
 	b += 4
 
-	v.Port = Port(xgb.Get32(buf[b:]))
+	v.Port = Port(order.Get32(buf[b:]))
 	b += 4
 
 	return v
 }
 
-// Bytes writes a VideoNotifyEvent value to a byte slice.
-func (v VideoNotifyEvent) Bytes() []byte {
+// Bytes writes a VideoNotifyEvent value to a byte slice, in the byte order 'order'.
+func (v VideoNotifyEvent) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 32)
 	b := 0
 
@@ -1128,18 +1244,18 @@ func (v VideoNotifyEvent) Bytes() []byte {
 	buf[b] = 0
 	b += 1
 
-	buf[b] = v.Reason
+	buf[b] = byte(v.Reason)
 	b += 1
 
 	b += 2 // skip sequence number
 
-	xgb.Put32(buf[b:], uint32(v.Time))
+	order.Put32(buf[b:], uint32(v.Time))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Drawable))
+	order.Put32(buf[b:], uint32(v.Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(v.Port))
+	order.Put32(buf[b:], uint32(v.Port))
 	b += 4
 
 	return buf
@@ -1156,7 +1272,7 @@ func (v VideoNotifyEvent) SequenceId() uint16 {
 func (v VideoNotifyEvent) String() string {
 	fieldVals := make([]string, 0, 4)
 	fieldVals = append(fieldVals, xgb.Sprintf("Sequence: %d", v.Sequence))
-	fieldVals = append(fieldVals, xgb.Sprintf("Reason: %d", v.Reason))
+	fieldVals = append(fieldVals, xgb.Sprintf("Reason: %s", v.Reason))
 	fieldVals = append(fieldVals, xgb.Sprintf("Time: %d", v.Time))
 	fieldVals = append(fieldVals, xgb.Sprintf("Drawable: %d", v.Drawable))
 	fieldVals = append(fieldVals, xgb.Sprintf("Port: %d", v.Port))
@@ -1167,14 +1283,33 @@ func init() {
 	xgb.NewExtEventFuncs["XVideo"][0] = VideoNotifyEventNew
 }
 
+type VideoNotifyReason byte
+
 const (
-	VideoNotifyReasonStarted   = 0
-	VideoNotifyReasonStopped   = 1
-	VideoNotifyReasonBusy      = 2
-	VideoNotifyReasonPreempted = 3
-	VideoNotifyReasonHardError = 4
+	VideoNotifyReasonStarted   VideoNotifyReason = 0
+	VideoNotifyReasonStopped   VideoNotifyReason = 1
+	VideoNotifyReasonBusy      VideoNotifyReason = 2
+	VideoNotifyReasonPreempted VideoNotifyReason = 3
+	VideoNotifyReasonHardError VideoNotifyReason = 4
 )
 
+// String returns the name of the VideoNotifyReason item with this value.
+func (v VideoNotifyReason) String() string {
+	switch v {
+	case VideoNotifyReasonStarted:
+		return "Started"
+	case VideoNotifyReasonStopped:
+		return "Stopped"
+	case VideoNotifyReasonBusy:
+		return "Busy"
+	case VideoNotifyReasonPreempted:
+		return "Preempted"
+	case VideoNotifyReasonHardError:
+		return "HardError"
+	}
+	return xgb.Sprintf("VideoNotifyReason(%d)", byte(v))
+}
+
 // Skipping definition for base type 'Bool'
 
 // Skipping definition for base type 'Byte'
@@ -1199,6 +1334,8 @@ const (
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // GetPortAttributeCookie is a cookie used only for GetPortAttribute requests.
 type GetPortAttributeCookie struct {
 	*xgb.Cookie
@@ -1247,23 +1384,35 @@ func (cook GetPortAttributeCookie) Reply() (*GetPortAttributeReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return getPortAttributeReply(buf), nil
+	return getPortAttributeReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GetPortAttribute request arrives.
+func (cook GetPortAttributeCookie) ReplyContext(ctx context.Context) (*GetPortAttributeReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return getPortAttributeReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// getPortAttributeReply reads a byte slice into a GetPortAttributeReply value.
-func getPortAttributeReply(buf []byte) *GetPortAttributeReply {
+// getPortAttributeReply reads a byte slice in the byte order 'order' into a GetPortAttributeReply value.
+func getPortAttributeReply(buf []byte, order xgb.ByteOrder) *GetPortAttributeReply {
 	v := new(GetPortAttributeReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Value = int32(xgb.Get32(buf[b:]))
+	v.Value = int32(order.Get32(buf[b:]))
 	b += 4
 
 	return v
@@ -1272,9 +1421,18 @@ func getPortAttributeReply(buf []byte) *GetPortAttributeReply {
 // Write request to wire for GetPortAttribute
 // getPortAttributeRequest writes a GetPortAttribute request to a byte slice.
 func getPortAttributeRequest(c *xgb.Conn, Port Port, Attribute xproto.Atom) []byte {
+	return AppendGetPortAttributeRequest(nil, c, Port, Attribute)
+}
+
+// AppendGetPortAttributeRequest appends a GetPortAttribute request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetPortAttributeRequest(buf []byte, c *xgb.Conn, Port Port, Attribute xproto.Atom) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo"]
@@ -1284,18 +1442,50 @@ func getPortAttributeRequest(c *xgb.Conn, Port Port, Attribute xproto.Atom) []by
 	buf[b] = 14 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Port))
+	order.Put32(buf[b:], uint32(Port))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Attribute))
+	order.Put32(buf[b:], uint32(Attribute))
 	b += 4
 
 	return buf
 }
 
+// GetPortAttributeRequest represents the data of a GetPortAttribute request, as it is read when tracing a connection.
+type GetPortAttributeRequest struct {
+	Port      Port
+	Attribute xproto.Atom
+}
+
+// getPortAttributeRequestRead reads a byte slice in the byte order 'order' into a GetPortAttributeRequest value.
+func getPortAttributeRequestRead(buf []byte, order xgb.ByteOrder) *GetPortAttributeRequest {
+	v := new(GetPortAttributeRequest)
+	b := 4 // skip request header
+
+	v.Port = Port(order.Get32(buf[b:]))
+	b += 4
+
+	v.Attribute = xproto.Atom(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo"][14] = xgb.RequestInfo{
+		Name: "GetPortAttribute",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPortAttributeRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getPortAttributeReply(buf, order)
+		},
+	}
+}
+
 // GetStillCookie is a cookie used only for GetStill requests.
 type GetStillCookie struct {
 	*xgb.Cookie
@@ -1333,12 +1523,26 @@ func (cook GetStillCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook GetStillCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for GetStill
 // getStillRequest writes a GetStill request to a byte slice.
 func getStillRequest(c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xproto.Gcontext, VidX int16, VidY int16, VidW uint16, VidH uint16, DrwX int16, DrwY int16, DrwW uint16, DrwH uint16) []byte {
+	return AppendGetStillRequest(nil, c, Port, Drawable, Gc, VidX, VidY, VidW, VidH, DrwX, DrwY, DrwW, DrwH)
+}
+
+// AppendGetStillRequest appends a GetStill request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetStillRequest(buf []byte, c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xproto.Gcontext, VidX int16, VidY int16, VidW uint16, VidH uint16, DrwX int16, DrwY int16, DrwW uint16, DrwH uint16) []byte {
 	size := 32
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo"]
@@ -1348,45 +1552,110 @@ func getStillRequest(c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xproto
 	buf[b] = 8 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Port))
+	order.Put32(buf[b:], uint32(Port))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(VidX))
+	order.Put16(buf[b:], uint16(VidX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(VidY))
+	order.Put16(buf[b:], uint16(VidY))
 	b += 2
 
-	xgb.Put16(buf[b:], VidW)
+	order.Put16(buf[b:], VidW)
 	b += 2
 
-	xgb.Put16(buf[b:], VidH)
+	order.Put16(buf[b:], VidH)
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DrwX))
+	order.Put16(buf[b:], uint16(DrwX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DrwY))
+	order.Put16(buf[b:], uint16(DrwY))
 	b += 2
 
-	xgb.Put16(buf[b:], DrwW)
+	order.Put16(buf[b:], DrwW)
 	b += 2
 
-	xgb.Put16(buf[b:], DrwH)
+	order.Put16(buf[b:], DrwH)
 	b += 2
 
 	return buf
 }
 
+// GetStillRequest represents the data of a GetStill request, as it is read when tracing a connection.
+type GetStillRequest struct {
+	Port     Port
+	Drawable xproto.Drawable
+	Gc       xproto.Gcontext
+	VidX     int16
+	VidY     int16
+	VidW     uint16
+	VidH     uint16
+	DrwX     int16
+	DrwY     int16
+	DrwW     uint16
+	DrwH     uint16
+}
+
+// getStillRequestRead reads a byte slice in the byte order 'order' into a GetStillRequest value.
+func getStillRequestRead(buf []byte, order xgb.ByteOrder) *GetStillRequest {
+	v := new(GetStillRequest)
+	b := 4 // skip request header
+
+	v.Port = Port(order.Get32(buf[b:]))
+	b += 4
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = xproto.Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.VidX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.VidY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.VidW = order.Get16(buf[b:])
+	b += 2
+
+	v.VidH = order.Get16(buf[b:])
+	b += 2
+
+	v.DrwX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.DrwY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.DrwW = order.Get16(buf[b:])
+	b += 2
+
+	v.DrwH = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo"][8] = xgb.RequestInfo{
+		Name: "GetStill",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getStillRequestRead(buf, order)
+		},
+	}
+}
+
 // GetVideoCookie is a cookie used only for GetVideo requests.
 type GetVideoCookie struct {
 	*xgb.Cookie
@@ -1424,12 +1693,26 @@ func (cook GetVideoCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook GetVideoCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for GetVideo
 // getVideoRequest writes a GetVideo request to a byte slice.
 func getVideoRequest(c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xproto.Gcontext, VidX int16, VidY int16, VidW uint16, VidH uint16, DrwX int16, DrwY int16, DrwW uint16, DrwH uint16) []byte {
+	return AppendGetVideoRequest(nil, c, Port, Drawable, Gc, VidX, VidY, VidW, VidH, DrwX, DrwY, DrwW, DrwH)
+}
+
+// AppendGetVideoRequest appends a GetVideo request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGetVideoRequest(buf []byte, c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xproto.Gcontext, VidX int16, VidY int16, VidW uint16, VidH uint16, DrwX int16, DrwY int16, DrwW uint16, DrwH uint16) []byte {
 	size := 32
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo"]
@@ -1439,45 +1722,110 @@ func getVideoRequest(c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xproto
 	buf[b] = 7 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Port))
+	order.Put32(buf[b:], uint32(Port))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(VidX))
+	order.Put16(buf[b:], uint16(VidX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(VidY))
+	order.Put16(buf[b:], uint16(VidY))
 	b += 2
 
-	xgb.Put16(buf[b:], VidW)
+	order.Put16(buf[b:], VidW)
 	b += 2
 
-	xgb.Put16(buf[b:], VidH)
+	order.Put16(buf[b:], VidH)
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DrwX))
+	order.Put16(buf[b:], uint16(DrwX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DrwY))
+	order.Put16(buf[b:], uint16(DrwY))
 	b += 2
 
-	xgb.Put16(buf[b:], DrwW)
+	order.Put16(buf[b:], DrwW)
 	b += 2
 
-	xgb.Put16(buf[b:], DrwH)
+	order.Put16(buf[b:], DrwH)
 	b += 2
 
 	return buf
 }
 
+// GetVideoRequest represents the data of a GetVideo request, as it is read when tracing a connection.
+type GetVideoRequest struct {
+	Port     Port
+	Drawable xproto.Drawable
+	Gc       xproto.Gcontext
+	VidX     int16
+	VidY     int16
+	VidW     uint16
+	VidH     uint16
+	DrwX     int16
+	DrwY     int16
+	DrwW     uint16
+	DrwH     uint16
+}
+
+// getVideoRequestRead reads a byte slice in the byte order 'order' into a GetVideoRequest value.
+func getVideoRequestRead(buf []byte, order xgb.ByteOrder) *GetVideoRequest {
+	v := new(GetVideoRequest)
+	b := 4 // skip request header
+
+	v.Port = Port(order.Get32(buf[b:]))
+	b += 4
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = xproto.Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.VidX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.VidY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.VidW = order.Get16(buf[b:])
+	b += 2
+
+	v.VidH = order.Get16(buf[b:])
+	b += 2
+
+	v.DrwX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.DrwY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.DrwW = order.Get16(buf[b:])
+	b += 2
+
+	v.DrwH = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo"][7] = xgb.RequestInfo{
+		Name: "GetVideo",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return getVideoRequestRead(buf, order)
+		},
+	}
+}
+
 // GrabPortCookie is a cookie used only for GrabPort requests.
 type GrabPortCookie struct {
 	*xgb.Cookie
@@ -1513,7 +1861,7 @@ func GrabPortUnchecked(c *xgb.Conn, Port Port, Time xproto.Timestamp) GrabPortCo
 type GrabPortReply struct {
 	Sequence uint16 // sequence number of the request for this reply
 	Length   uint32 // number of bytes in this reply
-	Result   byte
+	Result   GrabPortStatus
 }
 
 // Reply blocks and returns the reply data for a GrabPort request.
@@ -1525,21 +1873,33 @@ func (cook GrabPortCookie) Reply() (*GrabPortReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return grabPortReply(buf), nil
+	return grabPortReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a GrabPort request arrives.
+func (cook GrabPortCookie) ReplyContext(ctx context.Context) (*GrabPortReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return grabPortReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// grabPortReply reads a byte slice into a GrabPortReply value.
-func grabPortReply(buf []byte) *GrabPortReply {
+// grabPortReply reads a byte slice in the byte order 'order' into a GrabPortReply value.
+func grabPortReply(buf []byte, order xgb.ByteOrder) *GrabPortReply {
 	v := new(GrabPortReply)
 	b := 1 // skip reply determinant
 
-	v.Result = buf[b]
+	v.Result = GrabPortStatus(buf[b])
 	b += 1
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	return v
@@ -1548,9 +1908,18 @@ func grabPortReply(buf []byte) *GrabPortReply {
 // Write request to wire for GrabPort
 // grabPortRequest writes a GrabPort request to a byte slice.
 func grabPortRequest(c *xgb.Conn, Port Port, Time xproto.Timestamp) []byte {
+	return AppendGrabPortRequest(nil, c, Port, Time)
+}
+
+// AppendGrabPortRequest appends a GrabPort request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendGrabPortRequest(buf []byte, c *xgb.Conn, Port Port, Time xproto.Timestamp) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo"]
@@ -1560,18 +1929,50 @@ func grabPortRequest(c *xgb.Conn, Port Port, Time xproto.Timestamp) []byte {
 	buf[b] = 3 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Port))
+	order.Put32(buf[b:], uint32(Port))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Time))
+	order.Put32(buf[b:], uint32(Time))
 	b += 4
 
 	return buf
 }
 
+// GrabPortRequest represents the data of a GrabPort request, as it is read when tracing a connection.
+type GrabPortRequest struct {
+	Port Port
+	Time xproto.Timestamp
+}
+
+// grabPortRequestRead reads a byte slice in the byte order 'order' into a GrabPortRequest value.
+func grabPortRequestRead(buf []byte, order xgb.ByteOrder) *GrabPortRequest {
+	v := new(GrabPortRequest)
+	b := 4 // skip request header
+
+	v.Port = Port(order.Get32(buf[b:]))
+	b += 4
+
+	v.Time = xproto.Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo"][3] = xgb.RequestInfo{
+		Name: "GrabPort",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return grabPortRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return grabPortReply(buf, order)
+		},
+	}
+}
+
 // ListImageFormatsCookie is a cookie used only for ListImageFormats requests.
 type ListImageFormatsCookie struct {
 	*xgb.Cookie
@@ -1622,29 +2023,41 @@ func (cook ListImageFormatsCookie) Reply() (*ListImageFormatsReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return listImageFormatsReply(buf), nil
+	return listImageFormatsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a ListImageFormats request arrives.
+func (cook ListImageFormatsCookie) ReplyContext(ctx context.Context) (*ListImageFormatsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return listImageFormatsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// listImageFormatsReply reads a byte slice into a ListImageFormatsReply value.
-func listImageFormatsReply(buf []byte) *ListImageFormatsReply {
+// listImageFormatsReply reads a byte slice in the byte order 'order' into a ListImageFormatsReply value.
+func listImageFormatsReply(buf []byte, order xgb.ByteOrder) *ListImageFormatsReply {
 	v := new(ListImageFormatsReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.NumFormats = xgb.Get32(buf[b:])
+	v.NumFormats = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
 
 	v.Format = make([]ImageFormatInfo, v.NumFormats)
-	b += ImageFormatInfoReadList(buf[b:], v.Format)
+	b += ImageFormatInfoReadList(buf[b:], order, v.Format)
 
 	return v
 }
@@ -1652,9 +2065,18 @@ func listImageFormatsReply(buf []byte) *ListImageFormatsReply {
 // Write request to wire for ListImageFormats
 // listImageFormatsRequest writes a ListImageFormats request to a byte slice.
 func listImageFormatsRequest(c *xgb.Conn, Port Port) []byte {
+	return AppendListImageFormatsRequest(nil, c, Port)
+}
+
+// AppendListImageFormatsRequest appends a ListImageFormats request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendListImageFormatsRequest(buf []byte, c *xgb.Conn, Port Port) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo"]
@@ -1664,15 +2086,43 @@ func listImageFormatsRequest(c *xgb.Conn, Port Port) []byte {
 	buf[b] = 16 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Port))
+	order.Put32(buf[b:], uint32(Port))
 	b += 4
 
 	return buf
 }
 
+// ListImageFormatsRequest represents the data of a ListImageFormats request, as it is read when tracing a connection.
+type ListImageFormatsRequest struct {
+	Port Port
+}
+
+// listImageFormatsRequestRead reads a byte slice in the byte order 'order' into a ListImageFormatsRequest value.
+func listImageFormatsRequestRead(buf []byte, order xgb.ByteOrder) *ListImageFormatsRequest {
+	v := new(ListImageFormatsRequest)
+	b := 4 // skip request header
+
+	v.Port = Port(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo"][16] = xgb.RequestInfo{
+		Name: "ListImageFormats",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listImageFormatsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listImageFormatsReply(buf, order)
+		},
+	}
+}
+
 // PutImageCookie is a cookie used only for PutImage requests.
 type PutImageCookie struct {
 	*xgb.Cookie
@@ -1710,12 +2160,26 @@ func (cook PutImageCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PutImageCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PutImage
 // putImageRequest writes a PutImage request to a byte slice.
 func putImageRequest(c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xproto.Gcontext, Id uint32, SrcX int16, SrcY int16, SrcW uint16, SrcH uint16, DrwX int16, DrwY int16, DrwW uint16, DrwH uint16, Width uint16, Height uint16, Data []byte) []byte {
+	return AppendPutImageRequest(nil, c, Port, Drawable, Gc, Id, SrcX, SrcY, SrcW, SrcH, DrwX, DrwY, DrwW, DrwH, Width, Height, Data)
+}
+
+// AppendPutImageRequest appends a PutImage request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPutImageRequest(buf []byte, c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xproto.Gcontext, Id uint32, SrcX int16, SrcY int16, SrcW uint16, SrcH uint16, DrwX int16, DrwY int16, DrwW uint16, DrwH uint16, Width uint16, Height uint16, Data []byte) []byte {
 	size := xgb.Pad((40 + xgb.Pad((len(Data) * 1))))
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo"]
@@ -1725,49 +2189,49 @@ func putImageRequest(c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xproto
 	buf[b] = 18 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Port))
+	order.Put32(buf[b:], uint32(Port))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	xgb.Put32(buf[b:], Id)
+	order.Put32(buf[b:], Id)
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(SrcX))
+	order.Put16(buf[b:], uint16(SrcX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(SrcY))
+	order.Put16(buf[b:], uint16(SrcY))
 	b += 2
 
-	xgb.Put16(buf[b:], SrcW)
+	order.Put16(buf[b:], SrcW)
 	b += 2
 
-	xgb.Put16(buf[b:], SrcH)
+	order.Put16(buf[b:], SrcH)
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DrwX))
+	order.Put16(buf[b:], uint16(DrwX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DrwY))
+	order.Put16(buf[b:], uint16(DrwY))
 	b += 2
 
-	xgb.Put16(buf[b:], DrwW)
+	order.Put16(buf[b:], DrwW)
 	b += 2
 
-	xgb.Put16(buf[b:], DrwH)
+	order.Put16(buf[b:], DrwH)
 	b += 2
 
-	xgb.Put16(buf[b:], Width)
+	order.Put16(buf[b:], Width)
 	b += 2
 
-	xgb.Put16(buf[b:], Height)
+	order.Put16(buf[b:], Height)
 	b += 2
 
 	copy(buf[b:], Data[:len(Data)])
@@ -1776,6 +2240,88 @@ func putImageRequest(c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xproto
 	return buf
 }
 
+// PutImageRequest represents the data of a PutImage request, as it is read when tracing a connection.
+type PutImageRequest struct {
+	Port     Port
+	Drawable xproto.Drawable
+	Gc       xproto.Gcontext
+	Id       uint32
+	SrcX     int16
+	SrcY     int16
+	SrcW     uint16
+	SrcH     uint16
+	DrwX     int16
+	DrwY     int16
+	DrwW     uint16
+	DrwH     uint16
+	Width    uint16
+	Height   uint16
+	Data     []byte // size: xgb.Pad((len(Data) * 1))
+}
+
+// putImageRequestRead reads a byte slice in the byte order 'order' into a PutImageRequest value.
+func putImageRequestRead(buf []byte, order xgb.ByteOrder) *PutImageRequest {
+	v := new(PutImageRequest)
+	b := 4 // skip request header
+
+	v.Port = Port(order.Get32(buf[b:]))
+	b += 4
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = xproto.Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.Id = order.Get32(buf[b:])
+	b += 4
+
+	v.SrcX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.SrcY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.SrcW = order.Get16(buf[b:])
+	b += 2
+
+	v.SrcH = order.Get16(buf[b:])
+	b += 2
+
+	v.DrwX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.DrwY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.DrwW = order.Get16(buf[b:])
+	b += 2
+
+	v.DrwH = order.Get16(buf[b:])
+	b += 2
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	v.Data = make([]byte, (len(buf)-b)/1)
+	copy(v.Data[:(len(buf)-b)/1], buf[b:])
+	b += int((len(buf) - b) / 1)
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo"][18] = xgb.RequestInfo{
+		Name: "PutImage",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return putImageRequestRead(buf, order)
+		},
+	}
+}
+
 // PutStillCookie is a cookie used only for PutStill requests.
 type PutStillCookie struct {
 	*xgb.Cookie
@@ -1813,12 +2359,26 @@ func (cook PutStillCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PutStillCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PutStill
 // putStillRequest writes a PutStill request to a byte slice.
 func putStillRequest(c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xproto.Gcontext, VidX int16, VidY int16, VidW uint16, VidH uint16, DrwX int16, DrwY int16, DrwW uint16, DrwH uint16) []byte {
+	return AppendPutStillRequest(nil, c, Port, Drawable, Gc, VidX, VidY, VidW, VidH, DrwX, DrwY, DrwW, DrwH)
+}
+
+// AppendPutStillRequest appends a PutStill request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPutStillRequest(buf []byte, c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xproto.Gcontext, VidX int16, VidY int16, VidW uint16, VidH uint16, DrwX int16, DrwY int16, DrwW uint16, DrwH uint16) []byte {
 	size := 32
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo"]
@@ -1828,58 +2388,123 @@ func putStillRequest(c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xproto
 	buf[b] = 6 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Port))
+	order.Put32(buf[b:], uint32(Port))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(VidX))
+	order.Put16(buf[b:], uint16(VidX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(VidY))
+	order.Put16(buf[b:], uint16(VidY))
 	b += 2
 
-	xgb.Put16(buf[b:], VidW)
+	order.Put16(buf[b:], VidW)
 	b += 2
 
-	xgb.Put16(buf[b:], VidH)
+	order.Put16(buf[b:], VidH)
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DrwX))
+	order.Put16(buf[b:], uint16(DrwX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DrwY))
+	order.Put16(buf[b:], uint16(DrwY))
 	b += 2
 
-	xgb.Put16(buf[b:], DrwW)
+	order.Put16(buf[b:], DrwW)
 	b += 2
 
-	xgb.Put16(buf[b:], DrwH)
+	order.Put16(buf[b:], DrwH)
 	b += 2
 
 	return buf
 }
 
-// PutVideoCookie is a cookie used only for PutVideo requests.
-type PutVideoCookie struct {
-	*xgb.Cookie
-}
+// PutStillRequest represents the data of a PutStill request, as it is read when tracing a connection.
+type PutStillRequest struct {
+	Port     Port
+	Drawable xproto.Drawable
+	Gc       xproto.Gcontext
+	VidX     int16
+	VidY     int16
+	VidW     uint16
+	VidH     uint16
+	DrwX     int16
+	DrwY     int16
+	DrwW     uint16
+	DrwH     uint16
+}
+
+// putStillRequestRead reads a byte slice in the byte order 'order' into a PutStillRequest value.
+func putStillRequestRead(buf []byte, order xgb.ByteOrder) *PutStillRequest {
+	v := new(PutStillRequest)
+	b := 4 // skip request header
+
+	v.Port = Port(order.Get32(buf[b:]))
+	b += 4
 
-// PutVideo sends an unchecked request.
-// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
-func PutVideo(c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xproto.Gcontext, VidX int16, VidY int16, VidW uint16, VidH uint16, DrwX int16, DrwY int16, DrwW uint16, DrwH uint16) PutVideoCookie {
-	c.ExtLock.RLock()
-	defer c.ExtLock.RUnlock()
-	if _, ok := c.Extensions["XVideo"]; !ok {
-		panic("Cannot issue request 'PutVideo' using the uninitialized extension 'XVideo'. xv.Init(connObj) must be called first.")
-	}
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = xproto.Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.VidX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.VidY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.VidW = order.Get16(buf[b:])
+	b += 2
+
+	v.VidH = order.Get16(buf[b:])
+	b += 2
+
+	v.DrwX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.DrwY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.DrwW = order.Get16(buf[b:])
+	b += 2
+
+	v.DrwH = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo"][6] = xgb.RequestInfo{
+		Name: "PutStill",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return putStillRequestRead(buf, order)
+		},
+	}
+}
+
+// PutVideoCookie is a cookie used only for PutVideo requests.
+type PutVideoCookie struct {
+	*xgb.Cookie
+}
+
+// PutVideo sends an unchecked request.
+// If an error occurs, it can only be retrieved using xgb.WaitForEvent or xgb.PollForEvent.
+func PutVideo(c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xproto.Gcontext, VidX int16, VidY int16, VidW uint16, VidH uint16, DrwX int16, DrwY int16, DrwW uint16, DrwH uint16) PutVideoCookie {
+	c.ExtLock.RLock()
+	defer c.ExtLock.RUnlock()
+	if _, ok := c.Extensions["XVideo"]; !ok {
+		panic("Cannot issue request 'PutVideo' using the uninitialized extension 'XVideo'. xv.Init(connObj) must be called first.")
+	}
 	cookie := c.NewCookie(false, false)
 	c.NewRequest(putVideoRequest(c, Port, Drawable, Gc, VidX, VidY, VidW, VidH, DrwX, DrwY, DrwW, DrwH), cookie)
 	return PutVideoCookie{cookie}
@@ -1904,12 +2529,26 @@ func (cook PutVideoCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook PutVideoCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for PutVideo
 // putVideoRequest writes a PutVideo request to a byte slice.
 func putVideoRequest(c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xproto.Gcontext, VidX int16, VidY int16, VidW uint16, VidH uint16, DrwX int16, DrwY int16, DrwW uint16, DrwH uint16) []byte {
+	return AppendPutVideoRequest(nil, c, Port, Drawable, Gc, VidX, VidY, VidW, VidH, DrwX, DrwY, DrwW, DrwH)
+}
+
+// AppendPutVideoRequest appends a PutVideo request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendPutVideoRequest(buf []byte, c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xproto.Gcontext, VidX int16, VidY int16, VidW uint16, VidH uint16, DrwX int16, DrwY int16, DrwW uint16, DrwH uint16) []byte {
 	size := 32
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo"]
@@ -1919,45 +2558,110 @@ func putVideoRequest(c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xproto
 	buf[b] = 5 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Port))
+	order.Put32(buf[b:], uint32(Port))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(VidX))
+	order.Put16(buf[b:], uint16(VidX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(VidY))
+	order.Put16(buf[b:], uint16(VidY))
 	b += 2
 
-	xgb.Put16(buf[b:], VidW)
+	order.Put16(buf[b:], VidW)
 	b += 2
 
-	xgb.Put16(buf[b:], VidH)
+	order.Put16(buf[b:], VidH)
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DrwX))
+	order.Put16(buf[b:], uint16(DrwX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DrwY))
+	order.Put16(buf[b:], uint16(DrwY))
 	b += 2
 
-	xgb.Put16(buf[b:], DrwW)
+	order.Put16(buf[b:], DrwW)
 	b += 2
 
-	xgb.Put16(buf[b:], DrwH)
+	order.Put16(buf[b:], DrwH)
 	b += 2
 
 	return buf
 }
 
+// PutVideoRequest represents the data of a PutVideo request, as it is read when tracing a connection.
+type PutVideoRequest struct {
+	Port     Port
+	Drawable xproto.Drawable
+	Gc       xproto.Gcontext
+	VidX     int16
+	VidY     int16
+	VidW     uint16
+	VidH     uint16
+	DrwX     int16
+	DrwY     int16
+	DrwW     uint16
+	DrwH     uint16
+}
+
+// putVideoRequestRead reads a byte slice in the byte order 'order' into a PutVideoRequest value.
+func putVideoRequestRead(buf []byte, order xgb.ByteOrder) *PutVideoRequest {
+	v := new(PutVideoRequest)
+	b := 4 // skip request header
+
+	v.Port = Port(order.Get32(buf[b:]))
+	b += 4
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = xproto.Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.VidX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.VidY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.VidW = order.Get16(buf[b:])
+	b += 2
+
+	v.VidH = order.Get16(buf[b:])
+	b += 2
+
+	v.DrwX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.DrwY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.DrwW = order.Get16(buf[b:])
+	b += 2
+
+	v.DrwH = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo"][5] = xgb.RequestInfo{
+		Name: "PutVideo",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return putVideoRequestRead(buf, order)
+		},
+	}
+}
+
 // QueryAdaptorsCookie is a cookie used only for QueryAdaptors requests.
 type QueryAdaptorsCookie struct {
 	*xgb.Cookie
@@ -2008,29 +2712,41 @@ func (cook QueryAdaptorsCookie) Reply() (*QueryAdaptorsReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryAdaptorsReply(buf), nil
+	return queryAdaptorsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryAdaptorsReply reads a byte slice into a QueryAdaptorsReply value.
-func queryAdaptorsReply(buf []byte) *QueryAdaptorsReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryAdaptors request arrives.
+func (cook QueryAdaptorsCookie) ReplyContext(ctx context.Context) (*QueryAdaptorsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryAdaptorsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryAdaptorsReply reads a byte slice in the byte order 'order' into a QueryAdaptorsReply value.
+func queryAdaptorsReply(buf []byte, order xgb.ByteOrder) *QueryAdaptorsReply {
 	v := new(QueryAdaptorsReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.NumAdaptors = xgb.Get16(buf[b:])
+	v.NumAdaptors = order.Get16(buf[b:])
 	b += 2
 
 	b += 22 // padding
 
 	v.Info = make([]AdaptorInfo, v.NumAdaptors)
-	b += AdaptorInfoReadList(buf[b:], v.Info)
+	b += AdaptorInfoReadList(buf[b:], order, v.Info)
 
 	return v
 }
@@ -2038,9 +2754,18 @@ func queryAdaptorsReply(buf []byte) *QueryAdaptorsReply {
 // Write request to wire for QueryAdaptors
 // queryAdaptorsRequest writes a QueryAdaptors request to a byte slice.
 func queryAdaptorsRequest(c *xgb.Conn, Window xproto.Window) []byte {
+	return AppendQueryAdaptorsRequest(nil, c, Window)
+}
+
+// AppendQueryAdaptorsRequest appends a QueryAdaptors request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryAdaptorsRequest(buf []byte, c *xgb.Conn, Window xproto.Window) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo"]
@@ -2050,15 +2775,43 @@ func queryAdaptorsRequest(c *xgb.Conn, Window xproto.Window) []byte {
 	buf[b] = 1 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Window))
+	order.Put32(buf[b:], uint32(Window))
 	b += 4
 
 	return buf
 }
 
+// QueryAdaptorsRequest represents the data of a QueryAdaptors request, as it is read when tracing a connection.
+type QueryAdaptorsRequest struct {
+	Window xproto.Window
+}
+
+// queryAdaptorsRequestRead reads a byte slice in the byte order 'order' into a QueryAdaptorsRequest value.
+func queryAdaptorsRequestRead(buf []byte, order xgb.ByteOrder) *QueryAdaptorsRequest {
+	v := new(QueryAdaptorsRequest)
+	b := 4 // skip request header
+
+	v.Window = xproto.Window(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo"][1] = xgb.RequestInfo{
+		Name: "QueryAdaptors",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryAdaptorsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryAdaptorsReply(buf, order)
+		},
+	}
+}
+
 // QueryBestSizeCookie is a cookie used only for QueryBestSize requests.
 type QueryBestSizeCookie struct {
 	*xgb.Cookie
@@ -2108,26 +2861,38 @@ func (cook QueryBestSizeCookie) Reply() (*QueryBestSizeReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryBestSizeReply(buf), nil
+	return queryBestSizeReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryBestSizeReply reads a byte slice into a QueryBestSizeReply value.
-func queryBestSizeReply(buf []byte) *QueryBestSizeReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryBestSize request arrives.
+func (cook QueryBestSizeCookie) ReplyContext(ctx context.Context) (*QueryBestSizeReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryBestSizeReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryBestSizeReply reads a byte slice in the byte order 'order' into a QueryBestSizeReply value.
+func queryBestSizeReply(buf []byte, order xgb.ByteOrder) *QueryBestSizeReply {
 	v := new(QueryBestSizeReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.ActualWidth = xgb.Get16(buf[b:])
+	v.ActualWidth = order.Get16(buf[b:])
 	b += 2
 
-	v.ActualHeight = xgb.Get16(buf[b:])
+	v.ActualHeight = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -2136,9 +2901,18 @@ func queryBestSizeReply(buf []byte) *QueryBestSizeReply {
 // Write request to wire for QueryBestSize
 // queryBestSizeRequest writes a QueryBestSize request to a byte slice.
 func queryBestSizeRequest(c *xgb.Conn, Port Port, VidW uint16, VidH uint16, DrwW uint16, DrwH uint16, Motion bool) []byte {
+	return AppendQueryBestSizeRequest(nil, c, Port, VidW, VidH, DrwW, DrwH, Motion)
+}
+
+// AppendQueryBestSizeRequest appends a QueryBestSize request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryBestSizeRequest(buf []byte, c *xgb.Conn, Port Port, VidW uint16, VidH uint16, DrwW uint16, DrwH uint16, Motion bool) []byte {
 	size := 20
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo"]
@@ -2148,22 +2922,22 @@ func queryBestSizeRequest(c *xgb.Conn, Port Port, VidW uint16, VidH uint16, DrwW
 	buf[b] = 12 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Port))
+	order.Put32(buf[b:], uint32(Port))
 	b += 4
 
-	xgb.Put16(buf[b:], VidW)
+	order.Put16(buf[b:], VidW)
 	b += 2
 
-	xgb.Put16(buf[b:], VidH)
+	order.Put16(buf[b:], VidH)
 	b += 2
 
-	xgb.Put16(buf[b:], DrwW)
+	order.Put16(buf[b:], DrwW)
 	b += 2
 
-	xgb.Put16(buf[b:], DrwH)
+	order.Put16(buf[b:], DrwH)
 	b += 2
 
 	if Motion {
@@ -2178,6 +2952,61 @@ func queryBestSizeRequest(c *xgb.Conn, Port Port, VidW uint16, VidH uint16, DrwW
 	return buf
 }
 
+// QueryBestSizeRequest represents the data of a QueryBestSize request, as it is read when tracing a connection.
+type QueryBestSizeRequest struct {
+	Port   Port
+	VidW   uint16
+	VidH   uint16
+	DrwW   uint16
+	DrwH   uint16
+	Motion bool
+	// padding: 3 bytes
+}
+
+// queryBestSizeRequestRead reads a byte slice in the byte order 'order' into a QueryBestSizeRequest value.
+func queryBestSizeRequestRead(buf []byte, order xgb.ByteOrder) *QueryBestSizeRequest {
+	v := new(QueryBestSizeRequest)
+	b := 4 // skip request header
+
+	v.Port = Port(order.Get32(buf[b:]))
+	b += 4
+
+	v.VidW = order.Get16(buf[b:])
+	b += 2
+
+	v.VidH = order.Get16(buf[b:])
+	b += 2
+
+	v.DrwW = order.Get16(buf[b:])
+	b += 2
+
+	v.DrwH = order.Get16(buf[b:])
+	b += 2
+
+	if buf[b] == 1 {
+		v.Motion = true
+	} else {
+		v.Motion = false
+	}
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo"][12] = xgb.RequestInfo{
+		Name: "QueryBestSize",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryBestSizeRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryBestSizeReply(buf, order)
+		},
+	}
+}
+
 // QueryEncodingsCookie is a cookie used only for QueryEncodings requests.
 type QueryEncodingsCookie struct {
 	*xgb.Cookie
@@ -2228,29 +3057,41 @@ func (cook QueryEncodingsCookie) Reply() (*QueryEncodingsReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryEncodingsReply(buf), nil
+	return queryEncodingsReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryEncodingsReply reads a byte slice into a QueryEncodingsReply value.
-func queryEncodingsReply(buf []byte) *QueryEncodingsReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryEncodings request arrives.
+func (cook QueryEncodingsCookie) ReplyContext(ctx context.Context) (*QueryEncodingsReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryEncodingsReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryEncodingsReply reads a byte slice in the byte order 'order' into a QueryEncodingsReply value.
+func queryEncodingsReply(buf []byte, order xgb.ByteOrder) *QueryEncodingsReply {
 	v := new(QueryEncodingsReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.NumEncodings = xgb.Get16(buf[b:])
+	v.NumEncodings = order.Get16(buf[b:])
 	b += 2
 
 	b += 22 // padding
 
 	v.Info = make([]EncodingInfo, v.NumEncodings)
-	b += EncodingInfoReadList(buf[b:], v.Info)
+	b += EncodingInfoReadList(buf[b:], order, v.Info)
 
 	return v
 }
@@ -2258,9 +3099,18 @@ func queryEncodingsReply(buf []byte) *QueryEncodingsReply {
 // Write request to wire for QueryEncodings
 // queryEncodingsRequest writes a QueryEncodings request to a byte slice.
 func queryEncodingsRequest(c *xgb.Conn, Port Port) []byte {
+	return AppendQueryEncodingsRequest(nil, c, Port)
+}
+
+// AppendQueryEncodingsRequest appends a QueryEncodings request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryEncodingsRequest(buf []byte, c *xgb.Conn, Port Port) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo"]
@@ -2270,15 +3120,43 @@ func queryEncodingsRequest(c *xgb.Conn, Port Port) []byte {
 	buf[b] = 2 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Port))
+	order.Put32(buf[b:], uint32(Port))
 	b += 4
 
 	return buf
 }
 
+// QueryEncodingsRequest represents the data of a QueryEncodings request, as it is read when tracing a connection.
+type QueryEncodingsRequest struct {
+	Port Port
+}
+
+// queryEncodingsRequestRead reads a byte slice in the byte order 'order' into a QueryEncodingsRequest value.
+func queryEncodingsRequestRead(buf []byte, order xgb.ByteOrder) *QueryEncodingsRequest {
+	v := new(QueryEncodingsRequest)
+	b := 4 // skip request header
+
+	v.Port = Port(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo"][2] = xgb.RequestInfo{
+		Name: "QueryEncodings",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryEncodingsRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryEncodingsReply(buf, order)
+		},
+	}
+}
+
 // QueryExtensionCookie is a cookie used only for QueryExtension requests.
 type QueryExtensionCookie struct {
 	*xgb.Cookie
@@ -2328,26 +3206,38 @@ func (cook QueryExtensionCookie) Reply() (*QueryExtensionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryExtensionReply(buf), nil
+	return queryExtensionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryExtensionReply reads a byte slice into a QueryExtensionReply value.
-func queryExtensionReply(buf []byte) *QueryExtensionReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryExtension request arrives.
+func (cook QueryExtensionCookie) ReplyContext(ctx context.Context) (*QueryExtensionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryExtensionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryExtensionReply reads a byte slice in the byte order 'order' into a QueryExtensionReply value.
+func queryExtensionReply(buf []byte, order xgb.ByteOrder) *QueryExtensionReply {
 	v := new(QueryExtensionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Major = xgb.Get16(buf[b:])
+	v.Major = order.Get16(buf[b:])
 	b += 2
 
-	v.Minor = xgb.Get16(buf[b:])
+	v.Minor = order.Get16(buf[b:])
 	b += 2
 
 	return v
@@ -2356,9 +3246,18 @@ func queryExtensionReply(buf []byte) *QueryExtensionReply {
 // Write request to wire for QueryExtension
 // queryExtensionRequest writes a QueryExtension request to a byte slice.
 func queryExtensionRequest(c *xgb.Conn) []byte {
+	return AppendQueryExtensionRequest(nil, c)
+}
+
+// AppendQueryExtensionRequest appends a QueryExtension request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryExtensionRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo"]
@@ -2368,12 +3267,34 @@ func queryExtensionRequest(c *xgb.Conn) []byte {
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
 
+// QueryExtensionRequest represents the data of a QueryExtension request, as it is read when tracing a connection.
+type QueryExtensionRequest struct {
+}
+
+// queryExtensionRequestRead reads a byte slice in the byte order 'order' into a QueryExtensionRequest value.
+func queryExtensionRequestRead(buf []byte, order xgb.ByteOrder) *QueryExtensionRequest {
+	v := new(QueryExtensionRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo"][0] = xgb.RequestInfo{
+		Name: "QueryExtension",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryExtensionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryExtensionReply(buf, order)
+		},
+	}
+}
+
 // QueryImageAttributesCookie is a cookie used only for QueryImageAttributes requests.
 type QueryImageAttributesCookie struct {
 	*xgb.Cookie
@@ -2429,39 +3350,51 @@ func (cook QueryImageAttributesCookie) Reply() (*QueryImageAttributesReply, erro
 	if buf == nil {
 		return nil, nil
 	}
-	return queryImageAttributesReply(buf), nil
+	return queryImageAttributesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryImageAttributesReply reads a byte slice into a QueryImageAttributesReply value.
-func queryImageAttributesReply(buf []byte) *QueryImageAttributesReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryImageAttributes request arrives.
+func (cook QueryImageAttributesCookie) ReplyContext(ctx context.Context) (*QueryImageAttributesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryImageAttributesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryImageAttributesReply reads a byte slice in the byte order 'order' into a QueryImageAttributesReply value.
+func queryImageAttributesReply(buf []byte, order xgb.ByteOrder) *QueryImageAttributesReply {
 	v := new(QueryImageAttributesReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.NumPlanes = xgb.Get32(buf[b:])
+	v.NumPlanes = order.Get32(buf[b:])
 	b += 4
 
-	v.DataSize = xgb.Get32(buf[b:])
+	v.DataSize = order.Get32(buf[b:])
 	b += 4
 
-	v.Width = xgb.Get16(buf[b:])
+	v.Width = order.Get16(buf[b:])
 	b += 2
 
-	v.Height = xgb.Get16(buf[b:])
+	v.Height = order.Get16(buf[b:])
 	b += 2
 
 	b += 12 // padding
 
 	v.Pitches = make([]uint32, v.NumPlanes)
 	for i := 0; i < int(v.NumPlanes); i++ {
-		v.Pitches[i] = xgb.Get32(buf[b:])
+		v.Pitches[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -2469,7 +3402,7 @@ func queryImageAttributesReply(buf []byte) *QueryImageAttributesReply {
 
 	v.Offsets = make([]uint32, v.NumPlanes)
 	for i := 0; i < int(v.NumPlanes); i++ {
-		v.Offsets[i] = xgb.Get32(buf[b:])
+		v.Offsets[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -2479,9 +3412,18 @@ func queryImageAttributesReply(buf []byte) *QueryImageAttributesReply {
 // Write request to wire for QueryImageAttributes
 // queryImageAttributesRequest writes a QueryImageAttributes request to a byte slice.
 func queryImageAttributesRequest(c *xgb.Conn, Port Port, Id uint32, Width uint16, Height uint16) []byte {
+	return AppendQueryImageAttributesRequest(nil, c, Port, Id, Width, Height)
+}
+
+// AppendQueryImageAttributesRequest appends a QueryImageAttributes request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryImageAttributesRequest(buf []byte, c *xgb.Conn, Port Port, Id uint32, Width uint16, Height uint16) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo"]
@@ -2491,24 +3433,64 @@ func queryImageAttributesRequest(c *xgb.Conn, Port Port, Id uint32, Width uint16
 	buf[b] = 17 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Port))
+	order.Put32(buf[b:], uint32(Port))
 	b += 4
 
-	xgb.Put32(buf[b:], Id)
+	order.Put32(buf[b:], Id)
 	b += 4
 
-	xgb.Put16(buf[b:], Width)
+	order.Put16(buf[b:], Width)
 	b += 2
 
-	xgb.Put16(buf[b:], Height)
+	order.Put16(buf[b:], Height)
 	b += 2
 
 	return buf
 }
 
+// QueryImageAttributesRequest represents the data of a QueryImageAttributes request, as it is read when tracing a connection.
+type QueryImageAttributesRequest struct {
+	Port   Port
+	Id     uint32
+	Width  uint16
+	Height uint16
+}
+
+// queryImageAttributesRequestRead reads a byte slice in the byte order 'order' into a QueryImageAttributesRequest value.
+func queryImageAttributesRequestRead(buf []byte, order xgb.ByteOrder) *QueryImageAttributesRequest {
+	v := new(QueryImageAttributesRequest)
+	b := 4 // skip request header
+
+	v.Port = Port(order.Get32(buf[b:]))
+	b += 4
+
+	v.Id = order.Get32(buf[b:])
+	b += 4
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo"][17] = xgb.RequestInfo{
+		Name: "QueryImageAttributes",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryImageAttributesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryImageAttributesReply(buf, order)
+		},
+	}
+}
+
 // QueryPortAttributesCookie is a cookie used only for QueryPortAttributes requests.
 type QueryPortAttributesCookie struct {
 	*xgb.Cookie
@@ -2560,32 +3542,44 @@ func (cook QueryPortAttributesCookie) Reply() (*QueryPortAttributesReply, error)
 	if buf == nil {
 		return nil, nil
 	}
-	return queryPortAttributesReply(buf), nil
+	return queryPortAttributesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryPortAttributesReply reads a byte slice into a QueryPortAttributesReply value.
-func queryPortAttributesReply(buf []byte) *QueryPortAttributesReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryPortAttributes request arrives.
+func (cook QueryPortAttributesCookie) ReplyContext(ctx context.Context) (*QueryPortAttributesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryPortAttributesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// queryPortAttributesReply reads a byte slice in the byte order 'order' into a QueryPortAttributesReply value.
+func queryPortAttributesReply(buf []byte, order xgb.ByteOrder) *QueryPortAttributesReply {
 	v := new(QueryPortAttributesReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.NumAttributes = xgb.Get32(buf[b:])
+	v.NumAttributes = order.Get32(buf[b:])
 	b += 4
 
-	v.TextSize = xgb.Get32(buf[b:])
+	v.TextSize = order.Get32(buf[b:])
 	b += 4
 
 	b += 16 // padding
 
 	v.Attributes = make([]AttributeInfo, v.NumAttributes)
-	b += AttributeInfoReadList(buf[b:], v.Attributes)
+	b += AttributeInfoReadList(buf[b:], order, v.Attributes)
 
 	return v
 }
@@ -2593,9 +3587,18 @@ func queryPortAttributesReply(buf []byte) *QueryPortAttributesReply {
 // Write request to wire for QueryPortAttributes
 // queryPortAttributesRequest writes a QueryPortAttributes request to a byte slice.
 func queryPortAttributesRequest(c *xgb.Conn, Port Port) []byte {
+	return AppendQueryPortAttributesRequest(nil, c, Port)
+}
+
+// AppendQueryPortAttributesRequest appends a QueryPortAttributes request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryPortAttributesRequest(buf []byte, c *xgb.Conn, Port Port) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo"]
@@ -2605,15 +3608,43 @@ func queryPortAttributesRequest(c *xgb.Conn, Port Port) []byte {
 	buf[b] = 15 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Port))
+	order.Put32(buf[b:], uint32(Port))
 	b += 4
 
 	return buf
 }
 
+// QueryPortAttributesRequest represents the data of a QueryPortAttributes request, as it is read when tracing a connection.
+type QueryPortAttributesRequest struct {
+	Port Port
+}
+
+// queryPortAttributesRequestRead reads a byte slice in the byte order 'order' into a QueryPortAttributesRequest value.
+func queryPortAttributesRequestRead(buf []byte, order xgb.ByteOrder) *QueryPortAttributesRequest {
+	v := new(QueryPortAttributesRequest)
+	b := 4 // skip request header
+
+	v.Port = Port(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo"][15] = xgb.RequestInfo{
+		Name: "QueryPortAttributes",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryPortAttributesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryPortAttributesReply(buf, order)
+		},
+	}
+}
+
 // SelectPortNotifyCookie is a cookie used only for SelectPortNotify requests.
 type SelectPortNotifyCookie struct {
 	*xgb.Cookie
@@ -2651,12 +3682,26 @@ func (cook SelectPortNotifyCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SelectPortNotifyCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SelectPortNotify
 // selectPortNotifyRequest writes a SelectPortNotify request to a byte slice.
 func selectPortNotifyRequest(c *xgb.Conn, Port Port, Onoff bool) []byte {
+	return AppendSelectPortNotifyRequest(nil, c, Port, Onoff)
+}
+
+// AppendSelectPortNotifyRequest appends a SelectPortNotify request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSelectPortNotifyRequest(buf []byte, c *xgb.Conn, Port Port, Onoff bool) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo"]
@@ -2666,10 +3711,10 @@ func selectPortNotifyRequest(c *xgb.Conn, Port Port, Onoff bool) []byte {
 	buf[b] = 11 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Port))
+	order.Put32(buf[b:], uint32(Port))
 	b += 4
 
 	if Onoff {
@@ -2684,6 +3729,42 @@ func selectPortNotifyRequest(c *xgb.Conn, Port Port, Onoff bool) []byte {
 	return buf
 }
 
+// SelectPortNotifyRequest represents the data of a SelectPortNotify request, as it is read when tracing a connection.
+type SelectPortNotifyRequest struct {
+	Port  Port
+	Onoff bool
+	// padding: 3 bytes
+}
+
+// selectPortNotifyRequestRead reads a byte slice in the byte order 'order' into a SelectPortNotifyRequest value.
+func selectPortNotifyRequestRead(buf []byte, order xgb.ByteOrder) *SelectPortNotifyRequest {
+	v := new(SelectPortNotifyRequest)
+	b := 4 // skip request header
+
+	v.Port = Port(order.Get32(buf[b:]))
+	b += 4
+
+	if buf[b] == 1 {
+		v.Onoff = true
+	} else {
+		v.Onoff = false
+	}
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo"][11] = xgb.RequestInfo{
+		Name: "SelectPortNotify",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return selectPortNotifyRequestRead(buf, order)
+		},
+	}
+}
+
 // SelectVideoNotifyCookie is a cookie used only for SelectVideoNotify requests.
 type SelectVideoNotifyCookie struct {
 	*xgb.Cookie
@@ -2721,12 +3802,26 @@ func (cook SelectVideoNotifyCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SelectVideoNotifyCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SelectVideoNotify
 // selectVideoNotifyRequest writes a SelectVideoNotify request to a byte slice.
 func selectVideoNotifyRequest(c *xgb.Conn, Drawable xproto.Drawable, Onoff bool) []byte {
+	return AppendSelectVideoNotifyRequest(nil, c, Drawable, Onoff)
+}
+
+// AppendSelectVideoNotifyRequest appends a SelectVideoNotify request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSelectVideoNotifyRequest(buf []byte, c *xgb.Conn, Drawable xproto.Drawable, Onoff bool) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo"]
@@ -2736,10 +3831,10 @@ func selectVideoNotifyRequest(c *xgb.Conn, Drawable xproto.Drawable, Onoff bool)
 	buf[b] = 10 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
 	if Onoff {
@@ -2754,6 +3849,42 @@ func selectVideoNotifyRequest(c *xgb.Conn, Drawable xproto.Drawable, Onoff bool)
 	return buf
 }
 
+// SelectVideoNotifyRequest represents the data of a SelectVideoNotify request, as it is read when tracing a connection.
+type SelectVideoNotifyRequest struct {
+	Drawable xproto.Drawable
+	Onoff    bool
+	// padding: 3 bytes
+}
+
+// selectVideoNotifyRequestRead reads a byte slice in the byte order 'order' into a SelectVideoNotifyRequest value.
+func selectVideoNotifyRequestRead(buf []byte, order xgb.ByteOrder) *SelectVideoNotifyRequest {
+	v := new(SelectVideoNotifyRequest)
+	b := 4 // skip request header
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	if buf[b] == 1 {
+		v.Onoff = true
+	} else {
+		v.Onoff = false
+	}
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo"][10] = xgb.RequestInfo{
+		Name: "SelectVideoNotify",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return selectVideoNotifyRequestRead(buf, order)
+		},
+	}
+}
+
 // SetPortAttributeCookie is a cookie used only for SetPortAttribute requests.
 type SetPortAttributeCookie struct {
 	*xgb.Cookie
@@ -2791,12 +3922,26 @@ func (cook SetPortAttributeCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook SetPortAttributeCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for SetPortAttribute
 // setPortAttributeRequest writes a SetPortAttribute request to a byte slice.
 func setPortAttributeRequest(c *xgb.Conn, Port Port, Attribute xproto.Atom, Value int32) []byte {
+	return AppendSetPortAttributeRequest(nil, c, Port, Attribute, Value)
+}
+
+// AppendSetPortAttributeRequest appends a SetPortAttribute request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendSetPortAttributeRequest(buf []byte, c *xgb.Conn, Port Port, Attribute xproto.Atom, Value int32) []byte {
 	size := 16
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo"]
@@ -2806,21 +3951,54 @@ func setPortAttributeRequest(c *xgb.Conn, Port Port, Attribute xproto.Atom, Valu
 	buf[b] = 13 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Port))
+	order.Put32(buf[b:], uint32(Port))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Attribute))
+	order.Put32(buf[b:], uint32(Attribute))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Value))
+	order.Put32(buf[b:], uint32(Value))
 	b += 4
 
 	return buf
 }
 
+// SetPortAttributeRequest represents the data of a SetPortAttribute request, as it is read when tracing a connection.
+type SetPortAttributeRequest struct {
+	Port      Port
+	Attribute xproto.Atom
+	Value     int32
+}
+
+// setPortAttributeRequestRead reads a byte slice in the byte order 'order' into a SetPortAttributeRequest value.
+func setPortAttributeRequestRead(buf []byte, order xgb.ByteOrder) *SetPortAttributeRequest {
+	v := new(SetPortAttributeRequest)
+	b := 4 // skip request header
+
+	v.Port = Port(order.Get32(buf[b:]))
+	b += 4
+
+	v.Attribute = xproto.Atom(order.Get32(buf[b:]))
+	b += 4
+
+	v.Value = int32(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo"][13] = xgb.RequestInfo{
+		Name: "SetPortAttribute",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return setPortAttributeRequestRead(buf, order)
+		},
+	}
+}
+
 // ShmPutImageCookie is a cookie used only for ShmPutImage requests.
 type ShmPutImageCookie struct {
 	*xgb.Cookie
@@ -2858,12 +4036,26 @@ func (cook ShmPutImageCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook ShmPutImageCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for ShmPutImage
 // shmPutImageRequest writes a ShmPutImage request to a byte slice.
 func shmPutImageRequest(c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xproto.Gcontext, Shmseg shm.Seg, Id uint32, Offset uint32, SrcX int16, SrcY int16, SrcW uint16, SrcH uint16, DrwX int16, DrwY int16, DrwW uint16, DrwH uint16, Width uint16, Height uint16, SendEvent byte) []byte {
+	return AppendShmPutImageRequest(nil, c, Port, Drawable, Gc, Shmseg, Id, Offset, SrcX, SrcY, SrcW, SrcH, DrwX, DrwY, DrwW, DrwH, Width, Height, SendEvent)
+}
+
+// AppendShmPutImageRequest appends a ShmPutImage request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendShmPutImageRequest(buf []byte, c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xproto.Gcontext, Shmseg shm.Seg, Id uint32, Offset uint32, SrcX int16, SrcY int16, SrcW uint16, SrcH uint16, DrwX int16, DrwY int16, DrwW uint16, DrwH uint16, Width uint16, Height uint16, SendEvent byte) []byte {
 	size := 52
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo"]
@@ -2873,55 +4065,55 @@ func shmPutImageRequest(c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xpr
 	buf[b] = 19 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Port))
+	order.Put32(buf[b:], uint32(Port))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Gc))
+	order.Put32(buf[b:], uint32(Gc))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Shmseg))
+	order.Put32(buf[b:], uint32(Shmseg))
 	b += 4
 
-	xgb.Put32(buf[b:], Id)
+	order.Put32(buf[b:], Id)
 	b += 4
 
-	xgb.Put32(buf[b:], Offset)
+	order.Put32(buf[b:], Offset)
 	b += 4
 
-	xgb.Put16(buf[b:], uint16(SrcX))
+	order.Put16(buf[b:], uint16(SrcX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(SrcY))
+	order.Put16(buf[b:], uint16(SrcY))
 	b += 2
 
-	xgb.Put16(buf[b:], SrcW)
+	order.Put16(buf[b:], SrcW)
 	b += 2
 
-	xgb.Put16(buf[b:], SrcH)
+	order.Put16(buf[b:], SrcH)
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DrwX))
+	order.Put16(buf[b:], uint16(DrwX))
 	b += 2
 
-	xgb.Put16(buf[b:], uint16(DrwY))
+	order.Put16(buf[b:], uint16(DrwY))
 	b += 2
 
-	xgb.Put16(buf[b:], DrwW)
+	order.Put16(buf[b:], DrwW)
 	b += 2
 
-	xgb.Put16(buf[b:], DrwH)
+	order.Put16(buf[b:], DrwH)
 	b += 2
 
-	xgb.Put16(buf[b:], Width)
+	order.Put16(buf[b:], Width)
 	b += 2
 
-	xgb.Put16(buf[b:], Height)
+	order.Put16(buf[b:], Height)
 	b += 2
 
 	buf[b] = SendEvent
@@ -2932,6 +4124,98 @@ func shmPutImageRequest(c *xgb.Conn, Port Port, Drawable xproto.Drawable, Gc xpr
 	return buf
 }
 
+// ShmPutImageRequest represents the data of a ShmPutImage request, as it is read when tracing a connection.
+type ShmPutImageRequest struct {
+	Port      Port
+	Drawable  xproto.Drawable
+	Gc        xproto.Gcontext
+	Shmseg    shm.Seg
+	Id        uint32
+	Offset    uint32
+	SrcX      int16
+	SrcY      int16
+	SrcW      uint16
+	SrcH      uint16
+	DrwX      int16
+	DrwY      int16
+	DrwW      uint16
+	DrwH      uint16
+	Width     uint16
+	Height    uint16
+	SendEvent byte
+	// padding: 3 bytes
+}
+
+// shmPutImageRequestRead reads a byte slice in the byte order 'order' into a ShmPutImageRequest value.
+func shmPutImageRequestRead(buf []byte, order xgb.ByteOrder) *ShmPutImageRequest {
+	v := new(ShmPutImageRequest)
+	b := 4 // skip request header
+
+	v.Port = Port(order.Get32(buf[b:]))
+	b += 4
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	v.Gc = xproto.Gcontext(order.Get32(buf[b:]))
+	b += 4
+
+	v.Shmseg = shm.Seg(order.Get32(buf[b:]))
+	b += 4
+
+	v.Id = order.Get32(buf[b:])
+	b += 4
+
+	v.Offset = order.Get32(buf[b:])
+	b += 4
+
+	v.SrcX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.SrcY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.SrcW = order.Get16(buf[b:])
+	b += 2
+
+	v.SrcH = order.Get16(buf[b:])
+	b += 2
+
+	v.DrwX = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.DrwY = int16(order.Get16(buf[b:]))
+	b += 2
+
+	v.DrwW = order.Get16(buf[b:])
+	b += 2
+
+	v.DrwH = order.Get16(buf[b:])
+	b += 2
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	v.SendEvent = buf[b]
+	b += 1
+
+	b += 3 // padding
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo"][19] = xgb.RequestInfo{
+		Name: "ShmPutImage",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return shmPutImageRequestRead(buf, order)
+		},
+	}
+}
+
 // StopVideoCookie is a cookie used only for StopVideo requests.
 type StopVideoCookie struct {
 	*xgb.Cookie
@@ -2969,12 +4253,26 @@ func (cook StopVideoCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook StopVideoCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for StopVideo
 // stopVideoRequest writes a StopVideo request to a byte slice.
 func stopVideoRequest(c *xgb.Conn, Port Port, Drawable xproto.Drawable) []byte {
+	return AppendStopVideoRequest(nil, c, Port, Drawable)
+}
+
+// AppendStopVideoRequest appends a StopVideo request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendStopVideoRequest(buf []byte, c *xgb.Conn, Port Port, Drawable xproto.Drawable) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo"]
@@ -2984,18 +4282,47 @@ func stopVideoRequest(c *xgb.Conn, Port Port, Drawable xproto.Drawable) []byte {
 	buf[b] = 9 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Port))
+	order.Put32(buf[b:], uint32(Port))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Drawable))
+	order.Put32(buf[b:], uint32(Drawable))
 	b += 4
 
 	return buf
 }
 
+// StopVideoRequest represents the data of a StopVideo request, as it is read when tracing a connection.
+type StopVideoRequest struct {
+	Port     Port
+	Drawable xproto.Drawable
+}
+
+// stopVideoRequestRead reads a byte slice in the byte order 'order' into a StopVideoRequest value.
+func stopVideoRequestRead(buf []byte, order xgb.ByteOrder) *StopVideoRequest {
+	v := new(StopVideoRequest)
+	b := 4 // skip request header
+
+	v.Port = Port(order.Get32(buf[b:]))
+	b += 4
+
+	v.Drawable = xproto.Drawable(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo"][9] = xgb.RequestInfo{
+		Name: "StopVideo",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return stopVideoRequestRead(buf, order)
+		},
+	}
+}
+
 // UngrabPortCookie is a cookie used only for UngrabPort requests.
 type UngrabPortCookie struct {
 	*xgb.Cookie
@@ -3033,12 +4360,26 @@ func (cook UngrabPortCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook UngrabPortCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for UngrabPort
 // ungrabPortRequest writes a UngrabPort request to a byte slice.
 func ungrabPortRequest(c *xgb.Conn, Port Port, Time xproto.Timestamp) []byte {
+	return AppendUngrabPortRequest(nil, c, Port, Time)
+}
+
+// AppendUngrabPortRequest appends a UngrabPort request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendUngrabPortRequest(buf []byte, c *xgb.Conn, Port Port, Time xproto.Timestamp) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo"]
@@ -3048,14 +4389,43 @@ func ungrabPortRequest(c *xgb.Conn, Port Port, Time xproto.Timestamp) []byte {
 	buf[b] = 4 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(Port))
+	order.Put32(buf[b:], uint32(Port))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Time))
+	order.Put32(buf[b:], uint32(Time))
 	b += 4
 
 	return buf
 }
+
+// UngrabPortRequest represents the data of a UngrabPort request, as it is read when tracing a connection.
+type UngrabPortRequest struct {
+	Port Port
+	Time xproto.Timestamp
+}
+
+// ungrabPortRequestRead reads a byte slice in the byte order 'order' into a UngrabPortRequest value.
+func ungrabPortRequestRead(buf []byte, order xgb.ByteOrder) *UngrabPortRequest {
+	v := new(UngrabPortRequest)
+	b := 4 // skip request header
+
+	v.Port = Port(order.Get32(buf[b:]))
+	b += 4
+
+	v.Time = xproto.Timestamp(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo"][4] = xgb.RequestInfo{
+		Name: "UngrabPort",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return ungrabPortRequestRead(buf, order)
+		},
+	}
+}
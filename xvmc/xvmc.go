@@ -4,6 +4,8 @@ package xvmc
 // This file is automatically generated from xvmc.xml. Edit at your peril!
 
 import (
+	"context"
+
 	"github.com/BurntSushi/xgb"
 
 	"github.com/BurntSushi/xgb/xproto"
@@ -23,18 +25,16 @@ func Init(c *xgb.Conn) error {
 	c.ExtLock.Lock()
 	c.Extensions["XVideo-MotionCompensation"] = reply.MajorOpcode
 	c.ExtLock.Unlock()
-	for evNum, fun := range xgb.NewExtEventFuncs["XVideo-MotionCompensation"] {
-		xgb.NewEventFuncs[int(reply.FirstEvent)+evNum] = fun
-	}
-	for errNum, fun := range xgb.NewExtErrorFuncs["XVideo-MotionCompensation"] {
-		xgb.NewErrorFuncs[int(reply.FirstError)+errNum] = fun
-	}
+	c.RegisterEventFuncs(int(reply.FirstEvent), xgb.NewExtEventFuncs["XVideo-MotionCompensation"])
+	c.RegisterErrorFuncs(int(reply.FirstError), xgb.NewExtErrorFuncs["XVideo-MotionCompensation"])
+	c.RegisterRequestInfos(reply.MajorOpcode, "XVideo-MotionCompensation", xgb.ExtRequestInfos["XVideo-MotionCompensation"])
 	return nil
 }
 
 func init() {
 	xgb.NewExtEventFuncs["XVideo-MotionCompensation"] = make(map[int]xgb.NewEventFun)
 	xgb.NewExtErrorFuncs["XVideo-MotionCompensation"] = make(map[int]xgb.NewErrorFun)
+	xgb.ExtRequestInfos["XVideo-MotionCompensation"] = make(map[int]xgb.RequestInfo)
 }
 
 type Context uint32
@@ -80,90 +80,90 @@ type SurfaceInfo struct {
 }
 
 // SurfaceInfoRead reads a byte slice into a SurfaceInfo value.
-func SurfaceInfoRead(buf []byte, v *SurfaceInfo) int {
+func SurfaceInfoRead(buf []byte, order xgb.ByteOrder, v *SurfaceInfo) int {
 	b := 0
 
-	v.Id = Surface(xgb.Get32(buf[b:]))
+	v.Id = Surface(order.Get32(buf[b:]))
 	b += 4
 
-	v.ChromaFormat = xgb.Get16(buf[b:])
+	v.ChromaFormat = order.Get16(buf[b:])
 	b += 2
 
-	v.Pad0 = xgb.Get16(buf[b:])
+	v.Pad0 = order.Get16(buf[b:])
 	b += 2
 
-	v.MaxWidth = xgb.Get16(buf[b:])
+	v.MaxWidth = order.Get16(buf[b:])
 	b += 2
 
-	v.MaxHeight = xgb.Get16(buf[b:])
+	v.MaxHeight = order.Get16(buf[b:])
 	b += 2
 
-	v.SubpictureMaxWidth = xgb.Get16(buf[b:])
+	v.SubpictureMaxWidth = order.Get16(buf[b:])
 	b += 2
 
-	v.SubpictureMaxHeight = xgb.Get16(buf[b:])
+	v.SubpictureMaxHeight = order.Get16(buf[b:])
 	b += 2
 
-	v.McType = xgb.Get32(buf[b:])
+	v.McType = order.Get32(buf[b:])
 	b += 4
 
-	v.Flags = xgb.Get32(buf[b:])
+	v.Flags = order.Get32(buf[b:])
 	b += 4
 
 	return b
 }
 
 // SurfaceInfoReadList reads a byte slice into a list of SurfaceInfo values.
-func SurfaceInfoReadList(buf []byte, dest []SurfaceInfo) int {
+func SurfaceInfoReadList(buf []byte, order xgb.ByteOrder, dest []SurfaceInfo) int {
 	b := 0
 	for i := 0; i < len(dest); i++ {
 		dest[i] = SurfaceInfo{}
-		b += SurfaceInfoRead(buf[b:], &dest[i])
+		b += SurfaceInfoRead(buf[b:], order, &dest[i])
 	}
 	return xgb.Pad(b)
 }
 
-// Bytes writes a SurfaceInfo value to a byte slice.
-func (v SurfaceInfo) Bytes() []byte {
+// Bytes writes a SurfaceInfo value to a byte slice, in the byte order 'order'.
+func (v SurfaceInfo) Bytes(order xgb.ByteOrder) []byte {
 	buf := make([]byte, 24)
 	b := 0
 
-	xgb.Put32(buf[b:], uint32(v.Id))
+	order.Put32(buf[b:], uint32(v.Id))
 	b += 4
 
-	xgb.Put16(buf[b:], v.ChromaFormat)
+	order.Put16(buf[b:], v.ChromaFormat)
 	b += 2
 
-	xgb.Put16(buf[b:], v.Pad0)
+	order.Put16(buf[b:], v.Pad0)
 	b += 2
 
-	xgb.Put16(buf[b:], v.MaxWidth)
+	order.Put16(buf[b:], v.MaxWidth)
 	b += 2
 
-	xgb.Put16(buf[b:], v.MaxHeight)
+	order.Put16(buf[b:], v.MaxHeight)
 	b += 2
 
-	xgb.Put16(buf[b:], v.SubpictureMaxWidth)
+	order.Put16(buf[b:], v.SubpictureMaxWidth)
 	b += 2
 
-	xgb.Put16(buf[b:], v.SubpictureMaxHeight)
+	order.Put16(buf[b:], v.SubpictureMaxHeight)
 	b += 2
 
-	xgb.Put32(buf[b:], v.McType)
+	order.Put32(buf[b:], v.McType)
 	b += 4
 
-	xgb.Put32(buf[b:], v.Flags)
+	order.Put32(buf[b:], v.Flags)
 	b += 4
 
 	return buf[:b]
 }
 
 // SurfaceInfoListBytes writes a list of SurfaceInfo values to a byte slice.
-func SurfaceInfoListBytes(buf []byte, list []SurfaceInfo) int {
+func SurfaceInfoListBytes(buf []byte, order xgb.ByteOrder, list []SurfaceInfo) int {
 	b := 0
 	var structBytes []byte
 	for _, item := range list {
-		structBytes = item.Bytes()
+		structBytes = item.Bytes(order)
 		copy(buf[b:], structBytes)
 		b += len(structBytes)
 	}
@@ -194,6 +194,8 @@ func SurfaceInfoListBytes(buf []byte, list []SurfaceInfo) int {
 
 // Skipping definition for base type 'Card32'
 
+// Skipping definition for base type 'Card64'
+
 // CreateContextCookie is a cookie used only for CreateContext requests.
 type CreateContextCookie struct {
 	*xgb.Cookie
@@ -246,36 +248,48 @@ func (cook CreateContextCookie) Reply() (*CreateContextReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return createContextReply(buf), nil
+	return createContextReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a CreateContext request arrives.
+func (cook CreateContextCookie) ReplyContext(ctx context.Context) (*CreateContextReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return createContextReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// createContextReply reads a byte slice into a CreateContextReply value.
-func createContextReply(buf []byte) *CreateContextReply {
+// createContextReply reads a byte slice in the byte order 'order' into a CreateContextReply value.
+func createContextReply(buf []byte, order xgb.ByteOrder) *CreateContextReply {
 	v := new(CreateContextReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.WidthActual = xgb.Get16(buf[b:])
+	v.WidthActual = order.Get16(buf[b:])
 	b += 2
 
-	v.HeightActual = xgb.Get16(buf[b:])
+	v.HeightActual = order.Get16(buf[b:])
 	b += 2
 
-	v.FlagsReturn = xgb.Get32(buf[b:])
+	v.FlagsReturn = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
 
 	v.PrivData = make([]uint32, v.Length)
 	for i := 0; i < int(v.Length); i++ {
-		v.PrivData[i] = xgb.Get32(buf[b:])
+		v.PrivData[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -285,9 +299,18 @@ func createContextReply(buf []byte) *CreateContextReply {
 // Write request to wire for CreateContext
 // createContextRequest writes a CreateContext request to a byte slice.
 func createContextRequest(c *xgb.Conn, ContextId Context, PortId xv.Port, SurfaceId Surface, Width uint16, Height uint16, Flags uint32) []byte {
+	return AppendCreateContextRequest(nil, c, ContextId, PortId, SurfaceId, Width, Height, Flags)
+}
+
+// AppendCreateContextRequest appends a CreateContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateContextRequest(buf []byte, c *xgb.Conn, ContextId Context, PortId xv.Port, SurfaceId Surface, Width uint16, Height uint16, Flags uint32) []byte {
 	size := 24
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo-MotionCompensation"]
@@ -297,30 +320,78 @@ func createContextRequest(c *xgb.Conn, ContextId Context, PortId xv.Port, Surfac
 	buf[b] = 2 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextId))
+	order.Put32(buf[b:], uint32(ContextId))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(PortId))
+	order.Put32(buf[b:], uint32(PortId))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(SurfaceId))
+	order.Put32(buf[b:], uint32(SurfaceId))
 	b += 4
 
-	xgb.Put16(buf[b:], Width)
+	order.Put16(buf[b:], Width)
 	b += 2
 
-	xgb.Put16(buf[b:], Height)
+	order.Put16(buf[b:], Height)
 	b += 2
 
-	xgb.Put32(buf[b:], Flags)
+	order.Put32(buf[b:], Flags)
 	b += 4
 
 	return buf
 }
 
+// CreateContextRequest represents the data of a CreateContext request, as it is read when tracing a connection.
+type CreateContextRequest struct {
+	ContextId Context
+	PortId    xv.Port
+	SurfaceId Surface
+	Width     uint16
+	Height    uint16
+	Flags     uint32
+}
+
+// createContextRequestRead reads a byte slice in the byte order 'order' into a CreateContextRequest value.
+func createContextRequestRead(buf []byte, order xgb.ByteOrder) *CreateContextRequest {
+	v := new(CreateContextRequest)
+	b := 4 // skip request header
+
+	v.ContextId = Context(order.Get32(buf[b:]))
+	b += 4
+
+	v.PortId = xv.Port(order.Get32(buf[b:]))
+	b += 4
+
+	v.SurfaceId = Surface(order.Get32(buf[b:]))
+	b += 4
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	v.Flags = order.Get32(buf[b:])
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo-MotionCompensation"][2] = xgb.RequestInfo{
+		Name: "CreateContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createContextRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createContextReply(buf, order)
+		},
+	}
+}
+
 // CreateSubpictureCookie is a cookie used only for CreateSubpicture requests.
 type CreateSubpictureCookie struct {
 	*xgb.Cookie
@@ -375,32 +446,44 @@ func (cook CreateSubpictureCookie) Reply() (*CreateSubpictureReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return createSubpictureReply(buf), nil
+	return createSubpictureReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a CreateSubpicture request arrives.
+func (cook CreateSubpictureCookie) ReplyContext(ctx context.Context) (*CreateSubpictureReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return createSubpictureReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// createSubpictureReply reads a byte slice into a CreateSubpictureReply value.
-func createSubpictureReply(buf []byte) *CreateSubpictureReply {
+// createSubpictureReply reads a byte slice in the byte order 'order' into a CreateSubpictureReply value.
+func createSubpictureReply(buf []byte, order xgb.ByteOrder) *CreateSubpictureReply {
 	v := new(CreateSubpictureReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.WidthActual = xgb.Get16(buf[b:])
+	v.WidthActual = order.Get16(buf[b:])
 	b += 2
 
-	v.HeightActual = xgb.Get16(buf[b:])
+	v.HeightActual = order.Get16(buf[b:])
 	b += 2
 
-	v.NumPaletteEntries = xgb.Get16(buf[b:])
+	v.NumPaletteEntries = order.Get16(buf[b:])
 	b += 2
 
-	v.EntryBytes = xgb.Get16(buf[b:])
+	v.EntryBytes = order.Get16(buf[b:])
 	b += 2
 
 	v.ComponentOrder = make([]byte, 4)
@@ -411,7 +494,7 @@ func createSubpictureReply(buf []byte) *CreateSubpictureReply {
 
 	v.PrivData = make([]uint32, v.Length)
 	for i := 0; i < int(v.Length); i++ {
-		v.PrivData[i] = xgb.Get32(buf[b:])
+		v.PrivData[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -421,9 +504,18 @@ func createSubpictureReply(buf []byte) *CreateSubpictureReply {
 // Write request to wire for CreateSubpicture
 // createSubpictureRequest writes a CreateSubpicture request to a byte slice.
 func createSubpictureRequest(c *xgb.Conn, SubpictureId Subpicture, Context Context, XvimageId uint32, Width uint16, Height uint16) []byte {
+	return AppendCreateSubpictureRequest(nil, c, SubpictureId, Context, XvimageId, Width, Height)
+}
+
+// AppendCreateSubpictureRequest appends a CreateSubpicture request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateSubpictureRequest(buf []byte, c *xgb.Conn, SubpictureId Subpicture, Context Context, XvimageId uint32, Width uint16, Height uint16) []byte {
 	size := 20
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo-MotionCompensation"]
@@ -433,27 +525,71 @@ func createSubpictureRequest(c *xgb.Conn, SubpictureId Subpicture, Context Conte
 	buf[b] = 6 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(SubpictureId))
+	order.Put32(buf[b:], uint32(SubpictureId))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(Context))
+	order.Put32(buf[b:], uint32(Context))
 	b += 4
 
-	xgb.Put32(buf[b:], XvimageId)
+	order.Put32(buf[b:], XvimageId)
 	b += 4
 
-	xgb.Put16(buf[b:], Width)
+	order.Put16(buf[b:], Width)
 	b += 2
 
-	xgb.Put16(buf[b:], Height)
+	order.Put16(buf[b:], Height)
 	b += 2
 
 	return buf
 }
 
+// CreateSubpictureRequest represents the data of a CreateSubpicture request, as it is read when tracing a connection.
+type CreateSubpictureRequest struct {
+	SubpictureId Subpicture
+	Context      Context
+	XvimageId    uint32
+	Width        uint16
+	Height       uint16
+}
+
+// createSubpictureRequestRead reads a byte slice in the byte order 'order' into a CreateSubpictureRequest value.
+func createSubpictureRequestRead(buf []byte, order xgb.ByteOrder) *CreateSubpictureRequest {
+	v := new(CreateSubpictureRequest)
+	b := 4 // skip request header
+
+	v.SubpictureId = Subpicture(order.Get32(buf[b:]))
+	b += 4
+
+	v.Context = Context(order.Get32(buf[b:]))
+	b += 4
+
+	v.XvimageId = order.Get32(buf[b:])
+	b += 4
+
+	v.Width = order.Get16(buf[b:])
+	b += 2
+
+	v.Height = order.Get16(buf[b:])
+	b += 2
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo-MotionCompensation"][6] = xgb.RequestInfo{
+		Name: "CreateSubpicture",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createSubpictureRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createSubpictureReply(buf, order)
+		},
+	}
+}
+
 // CreateSurfaceCookie is a cookie used only for CreateSurface requests.
 type CreateSurfaceCookie struct {
 	*xgb.Cookie
@@ -503,27 +639,39 @@ func (cook CreateSurfaceCookie) Reply() (*CreateSurfaceReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return createSurfaceReply(buf), nil
+	return createSurfaceReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// createSurfaceReply reads a byte slice into a CreateSurfaceReply value.
-func createSurfaceReply(buf []byte) *CreateSurfaceReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a CreateSurface request arrives.
+func (cook CreateSurfaceCookie) ReplyContext(ctx context.Context) (*CreateSurfaceReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return createSurfaceReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// createSurfaceReply reads a byte slice in the byte order 'order' into a CreateSurfaceReply value.
+func createSurfaceReply(buf []byte, order xgb.ByteOrder) *CreateSurfaceReply {
 	v := new(CreateSurfaceReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
 	b += 24 // padding
 
 	v.PrivData = make([]uint32, v.Length)
 	for i := 0; i < int(v.Length); i++ {
-		v.PrivData[i] = xgb.Get32(buf[b:])
+		v.PrivData[i] = order.Get32(buf[b:])
 		b += 4
 	}
 
@@ -533,9 +681,18 @@ func createSurfaceReply(buf []byte) *CreateSurfaceReply {
 // Write request to wire for CreateSurface
 // createSurfaceRequest writes a CreateSurface request to a byte slice.
 func createSurfaceRequest(c *xgb.Conn, SurfaceId Surface, ContextId Context) []byte {
+	return AppendCreateSurfaceRequest(nil, c, SurfaceId, ContextId)
+}
+
+// AppendCreateSurfaceRequest appends a CreateSurface request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendCreateSurfaceRequest(buf []byte, c *xgb.Conn, SurfaceId Surface, ContextId Context) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo-MotionCompensation"]
@@ -545,18 +702,50 @@ func createSurfaceRequest(c *xgb.Conn, SurfaceId Surface, ContextId Context) []b
 	buf[b] = 4 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(SurfaceId))
+	order.Put32(buf[b:], uint32(SurfaceId))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(ContextId))
+	order.Put32(buf[b:], uint32(ContextId))
 	b += 4
 
 	return buf
 }
 
+// CreateSurfaceRequest represents the data of a CreateSurface request, as it is read when tracing a connection.
+type CreateSurfaceRequest struct {
+	SurfaceId Surface
+	ContextId Context
+}
+
+// createSurfaceRequestRead reads a byte slice in the byte order 'order' into a CreateSurfaceRequest value.
+func createSurfaceRequestRead(buf []byte, order xgb.ByteOrder) *CreateSurfaceRequest {
+	v := new(CreateSurfaceRequest)
+	b := 4 // skip request header
+
+	v.SurfaceId = Surface(order.Get32(buf[b:]))
+	b += 4
+
+	v.ContextId = Context(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo-MotionCompensation"][4] = xgb.RequestInfo{
+		Name: "CreateSurface",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createSurfaceRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return createSurfaceReply(buf, order)
+		},
+	}
+}
+
 // DestroyContextCookie is a cookie used only for DestroyContext requests.
 type DestroyContextCookie struct {
 	*xgb.Cookie
@@ -594,12 +783,26 @@ func (cook DestroyContextCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DestroyContextCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DestroyContext
 // destroyContextRequest writes a DestroyContext request to a byte slice.
 func destroyContextRequest(c *xgb.Conn, ContextId Context) []byte {
+	return AppendDestroyContextRequest(nil, c, ContextId)
+}
+
+// AppendDestroyContextRequest appends a DestroyContext request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDestroyContextRequest(buf []byte, c *xgb.Conn, ContextId Context) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo-MotionCompensation"]
@@ -609,15 +812,40 @@ func destroyContextRequest(c *xgb.Conn, ContextId Context) []byte {
 	buf[b] = 3 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(ContextId))
+	order.Put32(buf[b:], uint32(ContextId))
 	b += 4
 
 	return buf
 }
 
+// DestroyContextRequest represents the data of a DestroyContext request, as it is read when tracing a connection.
+type DestroyContextRequest struct {
+	ContextId Context
+}
+
+// destroyContextRequestRead reads a byte slice in the byte order 'order' into a DestroyContextRequest value.
+func destroyContextRequestRead(buf []byte, order xgb.ByteOrder) *DestroyContextRequest {
+	v := new(DestroyContextRequest)
+	b := 4 // skip request header
+
+	v.ContextId = Context(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo-MotionCompensation"][3] = xgb.RequestInfo{
+		Name: "DestroyContext",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return destroyContextRequestRead(buf, order)
+		},
+	}
+}
+
 // DestroySubpictureCookie is a cookie used only for DestroySubpicture requests.
 type DestroySubpictureCookie struct {
 	*xgb.Cookie
@@ -655,12 +883,26 @@ func (cook DestroySubpictureCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DestroySubpictureCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DestroySubpicture
 // destroySubpictureRequest writes a DestroySubpicture request to a byte slice.
 func destroySubpictureRequest(c *xgb.Conn, SubpictureId Subpicture) []byte {
+	return AppendDestroySubpictureRequest(nil, c, SubpictureId)
+}
+
+// AppendDestroySubpictureRequest appends a DestroySubpicture request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDestroySubpictureRequest(buf []byte, c *xgb.Conn, SubpictureId Subpicture) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo-MotionCompensation"]
@@ -670,15 +912,40 @@ func destroySubpictureRequest(c *xgb.Conn, SubpictureId Subpicture) []byte {
 	buf[b] = 7 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(SubpictureId))
+	order.Put32(buf[b:], uint32(SubpictureId))
 	b += 4
 
 	return buf
 }
 
+// DestroySubpictureRequest represents the data of a DestroySubpicture request, as it is read when tracing a connection.
+type DestroySubpictureRequest struct {
+	SubpictureId Subpicture
+}
+
+// destroySubpictureRequestRead reads a byte slice in the byte order 'order' into a DestroySubpictureRequest value.
+func destroySubpictureRequestRead(buf []byte, order xgb.ByteOrder) *DestroySubpictureRequest {
+	v := new(DestroySubpictureRequest)
+	b := 4 // skip request header
+
+	v.SubpictureId = Subpicture(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo-MotionCompensation"][7] = xgb.RequestInfo{
+		Name: "DestroySubpicture",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return destroySubpictureRequestRead(buf, order)
+		},
+	}
+}
+
 // DestroySurfaceCookie is a cookie used only for DestroySurface requests.
 type DestroySurfaceCookie struct {
 	*xgb.Cookie
@@ -716,12 +983,26 @@ func (cook DestroySurfaceCookie) Check() error {
 	return cook.Cookie.Check()
 }
 
+// CheckContext is like Check, but returns ctx.Err() if ctx is done before the request's outcome is known.
+func (cook DestroySurfaceCookie) CheckContext(ctx context.Context) error {
+	return cook.Cookie.CheckContext(ctx)
+}
+
 // Write request to wire for DestroySurface
 // destroySurfaceRequest writes a DestroySurface request to a byte slice.
 func destroySurfaceRequest(c *xgb.Conn, SurfaceId Surface) []byte {
+	return AppendDestroySurfaceRequest(nil, c, SurfaceId)
+}
+
+// AppendDestroySurfaceRequest appends a DestroySurface request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendDestroySurfaceRequest(buf []byte, c *xgb.Conn, SurfaceId Surface) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo-MotionCompensation"]
@@ -731,15 +1012,40 @@ func destroySurfaceRequest(c *xgb.Conn, SurfaceId Surface) []byte {
 	buf[b] = 5 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(SurfaceId))
+	order.Put32(buf[b:], uint32(SurfaceId))
 	b += 4
 
 	return buf
 }
 
+// DestroySurfaceRequest represents the data of a DestroySurface request, as it is read when tracing a connection.
+type DestroySurfaceRequest struct {
+	SurfaceId Surface
+}
+
+// destroySurfaceRequestRead reads a byte slice in the byte order 'order' into a DestroySurfaceRequest value.
+func destroySurfaceRequestRead(buf []byte, order xgb.ByteOrder) *DestroySurfaceRequest {
+	v := new(DestroySurfaceRequest)
+	b := 4 // skip request header
+
+	v.SurfaceId = Surface(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo-MotionCompensation"][5] = xgb.RequestInfo{
+		Name: "DestroySurface",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return destroySurfaceRequestRead(buf, order)
+		},
+	}
+}
+
 // ListSubpictureTypesCookie is a cookie used only for ListSubpictureTypes requests.
 type ListSubpictureTypesCookie struct {
 	*xgb.Cookie
@@ -790,29 +1096,41 @@ func (cook ListSubpictureTypesCookie) Reply() (*ListSubpictureTypesReply, error)
 	if buf == nil {
 		return nil, nil
 	}
-	return listSubpictureTypesReply(buf), nil
+	return listSubpictureTypesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a ListSubpictureTypes request arrives.
+func (cook ListSubpictureTypesCookie) ReplyContext(ctx context.Context) (*ListSubpictureTypesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return listSubpictureTypesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// listSubpictureTypesReply reads a byte slice into a ListSubpictureTypesReply value.
-func listSubpictureTypesReply(buf []byte) *ListSubpictureTypesReply {
+// listSubpictureTypesReply reads a byte slice in the byte order 'order' into a ListSubpictureTypesReply value.
+func listSubpictureTypesReply(buf []byte, order xgb.ByteOrder) *ListSubpictureTypesReply {
 	v := new(ListSubpictureTypesReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Num = xgb.Get32(buf[b:])
+	v.Num = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
 
 	v.Types = make([]xv.ImageFormatInfo, v.Num)
-	b += xv.ImageFormatInfoReadList(buf[b:], v.Types)
+	b += xv.ImageFormatInfoReadList(buf[b:], order, v.Types)
 
 	return v
 }
@@ -820,9 +1138,18 @@ func listSubpictureTypesReply(buf []byte) *ListSubpictureTypesReply {
 // Write request to wire for ListSubpictureTypes
 // listSubpictureTypesRequest writes a ListSubpictureTypes request to a byte slice.
 func listSubpictureTypesRequest(c *xgb.Conn, PortId xv.Port, SurfaceId Surface) []byte {
+	return AppendListSubpictureTypesRequest(nil, c, PortId, SurfaceId)
+}
+
+// AppendListSubpictureTypesRequest appends a ListSubpictureTypes request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendListSubpictureTypesRequest(buf []byte, c *xgb.Conn, PortId xv.Port, SurfaceId Surface) []byte {
 	size := 12
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo-MotionCompensation"]
@@ -832,18 +1159,50 @@ func listSubpictureTypesRequest(c *xgb.Conn, PortId xv.Port, SurfaceId Surface)
 	buf[b] = 8 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(PortId))
+	order.Put32(buf[b:], uint32(PortId))
 	b += 4
 
-	xgb.Put32(buf[b:], uint32(SurfaceId))
+	order.Put32(buf[b:], uint32(SurfaceId))
 	b += 4
 
 	return buf
 }
 
+// ListSubpictureTypesRequest represents the data of a ListSubpictureTypes request, as it is read when tracing a connection.
+type ListSubpictureTypesRequest struct {
+	PortId    xv.Port
+	SurfaceId Surface
+}
+
+// listSubpictureTypesRequestRead reads a byte slice in the byte order 'order' into a ListSubpictureTypesRequest value.
+func listSubpictureTypesRequestRead(buf []byte, order xgb.ByteOrder) *ListSubpictureTypesRequest {
+	v := new(ListSubpictureTypesRequest)
+	b := 4 // skip request header
+
+	v.PortId = xv.Port(order.Get32(buf[b:]))
+	b += 4
+
+	v.SurfaceId = Surface(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo-MotionCompensation"][8] = xgb.RequestInfo{
+		Name: "ListSubpictureTypes",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listSubpictureTypesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listSubpictureTypesReply(buf, order)
+		},
+	}
+}
+
 // ListSurfaceTypesCookie is a cookie used only for ListSurfaceTypes requests.
 type ListSurfaceTypesCookie struct {
 	*xgb.Cookie
@@ -894,29 +1253,41 @@ func (cook ListSurfaceTypesCookie) Reply() (*ListSurfaceTypesReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return listSurfaceTypesReply(buf), nil
+	return listSurfaceTypesReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// listSurfaceTypesReply reads a byte slice into a ListSurfaceTypesReply value.
-func listSurfaceTypesReply(buf []byte) *ListSurfaceTypesReply {
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a ListSurfaceTypes request arrives.
+func (cook ListSurfaceTypesCookie) ReplyContext(ctx context.Context) (*ListSurfaceTypesReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return listSurfaceTypesReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// listSurfaceTypesReply reads a byte slice in the byte order 'order' into a ListSurfaceTypesReply value.
+func listSurfaceTypesReply(buf []byte, order xgb.ByteOrder) *ListSurfaceTypesReply {
 	v := new(ListSurfaceTypesReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Num = xgb.Get32(buf[b:])
+	v.Num = order.Get32(buf[b:])
 	b += 4
 
 	b += 20 // padding
 
 	v.Surfaces = make([]SurfaceInfo, v.Num)
-	b += SurfaceInfoReadList(buf[b:], v.Surfaces)
+	b += SurfaceInfoReadList(buf[b:], order, v.Surfaces)
 
 	return v
 }
@@ -924,9 +1295,18 @@ func listSurfaceTypesReply(buf []byte) *ListSurfaceTypesReply {
 // Write request to wire for ListSurfaceTypes
 // listSurfaceTypesRequest writes a ListSurfaceTypes request to a byte slice.
 func listSurfaceTypesRequest(c *xgb.Conn, PortId xv.Port) []byte {
+	return AppendListSurfaceTypesRequest(nil, c, PortId)
+}
+
+// AppendListSurfaceTypesRequest appends a ListSurfaceTypes request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendListSurfaceTypesRequest(buf []byte, c *xgb.Conn, PortId xv.Port) []byte {
 	size := 8
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo-MotionCompensation"]
@@ -936,15 +1316,43 @@ func listSurfaceTypesRequest(c *xgb.Conn, PortId xv.Port) []byte {
 	buf[b] = 1 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
-	xgb.Put32(buf[b:], uint32(PortId))
+	order.Put32(buf[b:], uint32(PortId))
 	b += 4
 
 	return buf
 }
 
+// ListSurfaceTypesRequest represents the data of a ListSurfaceTypes request, as it is read when tracing a connection.
+type ListSurfaceTypesRequest struct {
+	PortId xv.Port
+}
+
+// listSurfaceTypesRequestRead reads a byte slice in the byte order 'order' into a ListSurfaceTypesRequest value.
+func listSurfaceTypesRequestRead(buf []byte, order xgb.ByteOrder) *ListSurfaceTypesRequest {
+	v := new(ListSurfaceTypesRequest)
+	b := 4 // skip request header
+
+	v.PortId = xv.Port(order.Get32(buf[b:]))
+	b += 4
+
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo-MotionCompensation"][1] = xgb.RequestInfo{
+		Name: "ListSurfaceTypes",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listSurfaceTypesRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return listSurfaceTypesReply(buf, order)
+		},
+	}
+}
+
 // QueryVersionCookie is a cookie used only for QueryVersion requests.
 type QueryVersionCookie struct {
 	*xgb.Cookie
@@ -994,26 +1402,38 @@ func (cook QueryVersionCookie) Reply() (*QueryVersionReply, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	return queryVersionReply(buf), nil
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
+}
+
+// ReplyContext is like Reply, but returns ctx.Err() if ctx is done before the reply for a QueryVersion request arrives.
+func (cook QueryVersionCookie) ReplyContext(ctx context.Context) (*QueryVersionReply, error) {
+	buf, err := cook.Cookie.ReplyContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, nil
+	}
+	return queryVersionReply(buf, cook.Cookie.ByteOrder()), nil
 }
 
-// queryVersionReply reads a byte slice into a QueryVersionReply value.
-func queryVersionReply(buf []byte) *QueryVersionReply {
+// queryVersionReply reads a byte slice in the byte order 'order' into a QueryVersionReply value.
+func queryVersionReply(buf []byte, order xgb.ByteOrder) *QueryVersionReply {
 	v := new(QueryVersionReply)
 	b := 1 // skip reply determinant
 
 	b += 1 // padding
 
-	v.Sequence = xgb.Get16(buf[b:])
+	v.Sequence = order.Get16(buf[b:])
 	b += 2
 
-	v.Length = xgb.Get32(buf[b:]) // 4-byte units
+	v.Length = order.Get32(buf[b:]) // 4-byte units
 	b += 4
 
-	v.Major = xgb.Get32(buf[b:])
+	v.Major = order.Get32(buf[b:])
 	b += 4
 
-	v.Minor = xgb.Get32(buf[b:])
+	v.Minor = order.Get32(buf[b:])
 	b += 4
 
 	return v
@@ -1022,9 +1442,18 @@ func queryVersionReply(buf []byte) *QueryVersionReply {
 // Write request to wire for QueryVersion
 // queryVersionRequest writes a QueryVersion request to a byte slice.
 func queryVersionRequest(c *xgb.Conn) []byte {
+	return AppendQueryVersionRequest(nil, c)
+}
+
+// AppendQueryVersionRequest appends a QueryVersion request to 'buf', which must only hold whole requests,
+// and returns the extended buffer. Nothing is allocated if 'buf' has room for it.
+// The request can be sent with an xgb.Batch.
+func AppendQueryVersionRequest(buf []byte, c *xgb.Conn) []byte {
 	size := 4
-	b := 0
-	buf := make([]byte, size)
+	start := len(buf)
+	b := start
+	buf = xgb.Grow(buf, size)
+	order := c.ByteOrder()
 
 	c.ExtLock.RLock()
 	buf[b] = c.Extensions["XVideo-MotionCompensation"]
@@ -1034,8 +1463,30 @@ func queryVersionRequest(c *xgb.Conn) []byte {
 	buf[b] = 0 // request opcode
 	b += 1
 
-	xgb.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
+	order.Put16(buf[b:], uint16(size/4)) // write request size in 4-byte units
 	b += 2
 
 	return buf
 }
+
+// QueryVersionRequest represents the data of a QueryVersion request, as it is read when tracing a connection.
+type QueryVersionRequest struct {
+}
+
+// queryVersionRequestRead reads a byte slice in the byte order 'order' into a QueryVersionRequest value.
+func queryVersionRequestRead(buf []byte, order xgb.ByteOrder) *QueryVersionRequest {
+	v := new(QueryVersionRequest)
+	return v
+}
+
+func init() {
+	xgb.ExtRequestInfos["XVideo-MotionCompensation"][0] = xgb.RequestInfo{
+		Name: "QueryVersion",
+		NewRequest: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionRequestRead(buf, order)
+		},
+		NewReply: func(buf []byte, order xgb.ByteOrder) interface{} {
+			return queryVersionReply(buf, order)
+		},
+	}
+}